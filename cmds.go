@@ -4,6 +4,7 @@ import (
 	_ "github.com/operator-framework/operator-lifecycle-manager/cmd/catalog"
 	_ "github.com/operator-framework/operator-lifecycle-manager/cmd/olm"
 	_ "github.com/operator-framework/operator-lifecycle-manager/cmd/package-server"
+	_ "github.com/operator-framework/operator-lifecycle-manager/cmd/scoped-rbac"
 	_ "github.com/operator-framework/operator-lifecycle-manager/util/cpb"
 
 	_ "github.com/grpc-ecosystem/grpc-health-probe"
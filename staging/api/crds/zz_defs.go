@@ -85,7 +85,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _operatorsCoreosCom_catalogsourcesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x3b\x6b\x73\xdc\x36\x92\xdf\xfd\x2b\xba\x74\x57\x65\x29\x37\x43\x59\xce\x96\x6f\x77\x2e\x4e\x4a\x91\xed\x9c\x2a\xb1\xad\xb2\x6c\x5f\xdd\x5a\xbe\x5b\x0c\xd9\xc3\x41\x04\x02\x34\x00\x4a\x9a\x6c\xe5\xbf\x6f\x75\x03\x20\x39\x2f\xce\x48\x71\xe6\x8b\x45\x3c\x1a\xfd\x7e\x01\x16\xb5\xfc\x88\xd6\x49\xa3\x27\x20\x6a\x89\x77\x1e\x35\x7d\xb9\xec\xfa\xaf\x2e\x93\xe6\xf8\xe6\xe4\xd1\xb5\xd4\xc5\x04\xce\x1a\xe7\x4d\xf5\x0e\x9d\x69\x6c\x8e\x2f\x70\x26\xb5\xf4\xd2\xe8\x47\x15\x7a\x51\x08\x2f\x26\x8f\x00\x84\xd6\xc6\x0b\x1a\x76\xf4\x09\x90\x1b\xed\xad\x51\x0a\xed\xb8\x44\x9d\x5d\x37\x53\x9c\x36\x52\x15\x68\x19\x78\x3a\xfa\xe6\x49\xf6\x2c\x7b\xfa\x08\x20\xb7\xc8\xdb\xdf\xcb\x0a\x9d\x17\x55\x3d\x01\xdd\x28\xf5\x08\x40\x8b\x0a\x27\x90\x0b\x2f\x94\x29\x03\x12\x2e\x33\x35\x5a\xe1\x8d\x75\x59\x6e\x2c\x1a\xfa\xa7\x7a\xe4\x6a\xcc\xe9\xf4\xd2\x9a\xa6\x9e\xc0\xc6\x35\x01\x5e\x42\x52\x78\x2c\x8d\x95\xe9\x1b\x60\x0c\x46\x55\xfc\x77\x24\x3e\x1c\x7b\xc9\xc7\xf2\xb8\x92\xce\xff\xbc\x3e\xf7\x8b\x74\x9e\xe7\x6b\xd5\x58\xa1\x56\x11\xe6\x29\x37\x37\xd6\xbf\xe9\x8e\xa7\xe3\x72\xe1\x9d\xcd\xc3\xb4\xd4\x65\xa3\x84\x5d\xd9\xfb\x08\xc0\xe5\xa6\xc6\x09\xf0\xd6\x5a\xe4\x58\x3c\x02\x88\x2c\x8c\xa0\xc6\x20\x8a\x82\xc5\x22\xd4\x85\x95\xda\xa3\x3d\x33\xaa\xa9\x74\x7b\x14\xad\x29\xd0\xe5\x56\xd6\x9e\x59\xff\x7e\x8e\x50\x5b\xf4\x7e\xc1\x2c\x01\x33\x03\x3f\xc7\x74\x76\xbb\x0b\xe0\x57\x67\xf4\x85\xf0\xf3\x09\x64\xc4\xe1\xac\x90\xae\x56\x62\x41\xd8\xf4\x56\x05\x31\xbd\x08\x73\xbd\x71\xbf\x20\xd4\x9d\xb7\x52\x97\x43\xa8\xd0\xba\xfd\x71\x08\xac\x79\xbf\xa8\xd7\x51\x58\x19\xdc\xf7\xfc\xba\x99\x2a\xe9\xe6\x68\xf7\x47\xa2\xdd\xb2\x86\xc3\xc5\x86\x99\x2d\x88\xf4\x80\x26\x83\xca\xd6\x8c\x61\xed\x80\xd3\x72\x9d\xc6\x42\xf8\x34\x18\x16\xdd\x9c\x08\x55\xcf\xc5\x49\x1c\x74\xf9\x1c\x2b\xd1\xe9\x83\xa9\x51\x9f\x5e\x9c\x7f\xfc\xf6\x72\x65\x02\x96\xb9\xb3\xa4\xe7\x20\x1d\x08\xb0\x58\x1b\x27\xbd\xb1\x0b\xe2\xd6\xd9\xe5\x47\x37\x82\xb3\x77\x2f\xdc\x08\x84\x2e\x5a\xc3\x83\x5a\xe4\xd7\xa2\x44\x97\xad\xe1\x6a\xa6\xbf\x62\xee\x7b\xc3\x16\xbf\x34\xd2\x62\xd1\xc7\x82\xd8\x93\x78\xb2\x32\x4c\xfc\xef\x0d\xd5\x96\xce\xf4\x3d\x43\x0e\xbf\x9e\x97\x5b\x1a\x5f\xa1\xf0\x31\xb1\x21\xac\x83\x82\x1c\x1c\x3a\x56\x81\x68\x63\x58\x44\xde\x05\xd5\x90\x8e\xe8\xb7\xe8\x50\x07\x97\x47\xc3\x42\x47\x9a\x32\xb8\x44\x4b\x1b\xc9\xdc\x1b\x55\x90\x27\xbc\x41\xeb\xc1\x62\x6e\x4a\x2d\x7f\x6b\xa1\x39\xf0\x86\x8f\x51\xc2\xa3\xf3\xc0\x56\xab\x85\x82\x1b\xa1\x1a\x0c\xac\xac\xc4\x02\x2c\x12\x5c\x68\x74\x0f\x02\x2f\x71\x19\xbc\x36\x16\x41\xea\x99\x99\xc0\xdc\xfb\xda\x4d\x8e\x8f\x4b\xe9\x93\x0f\xcf\x4d\x55\x35\x5a\xfa\xc5\x31\xbb\x63\x39\x6d\xc8\x1d\x1e\x17\x78\x83\xea\xd8\xc9\x72\x2c\x6c\x3e\x97\x1e\x73\xdf\x58\x3c\x16\xb5\x1c\x33\xb2\x9a\xfd\x78\x56\x15\xff\x66\xa3\xd7\x77\x8f\x57\xd8\xb7\x51\x99\x21\xb9\xcd\x41\x5e\x93\xf3\x0c\x5a\x14\xb6\x07\x5a\x3a\x96\xd2\x10\x71\xe5\xdd\xcb\xcb\xf7\x90\x10\x08\x6c\x0f\x1c\xee\x96\xba\x8e\xd9\xc4\x28\xa9\x67\x68\xc3\xca\x99\x35\x15\x43\x41\x5d\xd4\x46\x6a\x1f\x4c\x5a\x49\xd4\x1e\x5c\x33\xad\xa4\x77\xac\x73\xe8\x3c\xc9\x21\x83\x33\x0e\x61\x30\x45\x68\x6a\xb2\xa4\x22\x83\x73\x0d\x67\xa2\x42\x75\x26\x1c\xfe\xe9\xac\x26\x8e\xba\x31\xb1\x6f\x7f\x66\xf7\x23\xf0\xfa\x86\x35\x1b\x03\x48\x11\x72\xaf\xc5\xdb\x8c\x12\x82\x05\x6e\xf2\xc0\x30\x60\x8b\xf4\x13\x45\x61\xd1\x6d\x98\x58\x33\xc8\xb0\x30\xe8\xc9\xdc\x38\x92\x9f\xf0\xf0\xf6\x97\xd7\x90\x0b\x0d\x8d\x43\x32\x9e\xdc\x68\x4d\x0a\xe1\x0d\x08\x8a\x65\x63\xbc\x93\x8e\x15\xc8\x62\x29\x9d\xb7\x8b\x0c\x5e\x19\x5b\x09\x3f\x81\xef\xd2\xd0\x98\xc1\x19\x0b\xb2\xfe\x7e\xf2\x5d\x6d\xac\xff\x1e\xde\x6a\xb5\x20\xa0\x05\xdc\xce\x51\xc3\x65\x4b\x1b\x3c\xef\x7d\xfc\x64\xeb\x3c\x83\xf3\x52\x1b\x9b\x56\x92\x56\x9d\x57\xa2\x44\x98\x49\x54\xac\xd7\x0e\x7d\xb6\x2a\xc1\x41\x29\x42\x48\x97\x66\xb2\x7c\x2d\xea\x9d\xac\x39\x4b\x2b\xe9\x2c\x3a\xbe\x1f\xbc\xbb\x49\x6f\x58\x95\x89\x24\xfa\x53\xe4\xd7\x20\xe2\x29\x95\xa8\xc7\x8e\xcd\xa6\xc7\xa6\xfd\x38\x70\x96\x00\x10\xff\xba\xe1\xf3\xe8\xb9\xb2\xfb\x92\xdd\xa7\xec\xde\x7b\xbb\x34\x64\x27\xd3\x5e\x6f\x8a\x22\x7b\x9c\x51\xda\x3a\xbf\x30\x45\x20\x7b\xe7\x29\x3f\xf5\x57\x03\xde\xd5\xc6\xa1\x83\x42\xce\x66\x68\xc9\xef\x98\x1b\xb4\x56\x16\xe8\x60\x66\x2c\xcb\xab\x36\x05\xdb\x64\x2b\xbf\xa5\x50\x7b\x61\x8a\x7d\x05\x43\x47\x73\xc0\x08\xca\x18\xd5\x70\x2b\xb9\x1b\xad\x1d\x76\x18\x2f\xfd\xb4\x29\xf0\x12\x15\xe6\xde\xd8\xcd\x2b\x56\x78\xf2\xa6\xb7\x21\x7a\xfd\xf4\x75\x3b\x97\xf9\x1c\xaa\xc6\xb1\xd7\xf5\xb6\xc1\x25\xbe\x78\x03\x33\xe9\xc1\x68\x10\x7c\x2c\xf9\xfa\xf5\x9d\x95\xf0\xf9\x3c\xae\x78\xec\x40\x89\x29\x2a\xb7\x0a\x67\x8a\x1c\x72\x8b\x46\x61\x41\x00\xd9\x97\x30\xcc\x2d\x24\xec\xe0\x12\x04\x57\xd6\xe6\xdb\xc3\x3c\x83\x5d\x5a\x16\x18\x2f\x8d\x95\x7e\x71\xa6\x84\x73\xdb\x74\x7a\x8d\xbb\xe7\x33\x56\x1f\x39\x93\x58\x8c\x40\xea\x42\x52\x49\xe3\x12\xed\x8f\x5d\x0b\x37\xa3\xb5\x14\xe0\x7a\xeb\x13\x87\xd2\x1a\xb8\x95\x4a\x11\xb3\x0a\x9c\x89\x46\xb1\x93\xfc\x0d\xad\x01\xc9\xda\x69\x59\xaf\xb4\x49\xd3\xc3\xcc\x1b\xa0\xd5\x1b\x45\x09\x62\x57\xbd\xec\xa0\xf2\x7d\xb7\x1e\x84\xc5\x7e\x76\x1e\xc3\x10\x11\xca\xe4\xf6\x40\x0f\xa3\x27\xac\x5d\x2a\x53\xfa\x3f\xe9\xb1\x1a\x90\xe5\x7a\xe9\x40\x5a\x46\x59\x47\x87\x28\xab\xba\xf7\x82\xb4\x8e\xd3\xbc\x30\x83\x0e\x84\x5e\x80\x17\x21\x23\x11\x51\x7f\xa3\xc4\xbc\x95\xb5\x42\xf8\xee\x1a\x17\xa3\x90\x03\xe2\x6c\x86\xb9\xff\x1e\x1a\x97\xb2\x22\x5e\x4f\x1f\x6d\x92\xfd\x5d\xfa\xeb\xfb\x6d\x14\xef\xa5\xcf\xbb\x6d\x3f\xfc\x02\x4a\x43\x2b\x56\x38\xf4\x92\x37\xac\x28\x67\xe0\x40\x80\x45\xfc\x61\xb2\x32\x78\x59\xd5\x7e\x01\x15\x0a\xed\x92\x65\x2b\xb5\xb4\xd8\x65\xf0\x3f\xe4\x07\x7b\x6a\x2c\x94\x32\xb7\x6d\x4e\xcc\x1a\xf2\xc6\x5c\x46\x7b\x1f\xc1\x85\xc5\x19\xda\x6e\x84\xdd\xe4\x1b\xf3\xf2\x0e\xf3\xc6\x6f\xf5\x00\x7d\xbe\x0d\xa8\x72\xf8\x5d\xe3\xe2\x1e\x0c\xf9\x19\x17\x29\x76\x07\xca\xae\x71\x11\x94\x81\x87\x3a\x1d\x12\x75\xad\x24\x86\xf4\x74\x88\x33\xd7\xb8\x70\x6c\xdf\xb4\xff\x3a\x40\x47\x5a\x3f\xea\xb4\x24\xb9\xd9\x97\x94\x21\xb9\xff\x0a\xfa\x9a\x9b\x6a\x2a\x75\x38\x2c\x80\x4e\xa2\x60\xe8\x89\xa1\xba\xe0\x4f\x3e\xe6\x6b\xb0\x2b\x21\x75\x0f\x9e\xbd\x4d\x74\x74\xb9\x3f\x08\xc2\xe8\x31\xa5\xf1\x2a\x58\xfc\x5c\xd6\xa9\xa4\x62\xd4\x33\xf8\x28\x94\xec\xea\xd1\xa0\x1b\x81\x03\x4c\xd5\xcb\x2f\x8d\x50\x19\xbc\x08\xfe\x8c\xa9\x8f\x43\x71\x11\x31\xf2\x4b\x23\x6f\x84\xa2\xf8\xed\x0d\x79\xc8\x22\x17\xb6\xe0\x08\x13\xeb\x34\x67\x82\xf4\x04\x3b\x02\x4a\x4f\x93\xb5\x77\x32\x72\x5c\x23\x42\x2d\xac\x97\x79\xa3\x84\x4d\xbd\xa7\xc5\x57\xe1\x68\xa7\x34\x97\x98\x1b\x5d\x0c\x5a\xf0\x56\xef\x1a\xf7\xf6\x79\xcc\x21\x02\xad\x34\x05\xa7\x28\xb2\xc2\x55\x25\x3d\x5c\x0e\xe3\x66\x96\xac\xba\x35\xb1\x11\x18\x8a\x1e\xb7\xd2\xc5\x32\xae\x4d\x95\x65\x48\xa5\x8f\x7a\xee\xb1\xb5\x8a\x0c\x7e\x5c\xa4\x48\x33\x02\xe9\x43\xec\xf1\x94\xd7\x8c\x52\x02\x10\x55\x36\x32\xbb\x33\xa8\x99\xb1\x48\xe9\xed\x61\x61\x78\x0f\xde\xc8\xdc\x1f\x65\xf0\x77\x0a\x66\x24\x78\x8d\xa5\xf0\xf2\x06\x93\x8a\xa7\xc0\xe7\x2d\x52\xe9\x07\xc2\xc1\x13\x38\xe4\x6d\x20\xab\x0a\x0b\x29\x3c\xaa\xc5\x11\x4c\x17\x7c\x8c\x5b\x38\x8f\xd5\x3e\xa2\xa3\xa2\xbe\x5c\xea\x03\xad\xff\x66\xb1\x44\x91\xda\x3f\xfb\xcb\xc0\x4a\x46\xf6\x1e\x92\xfd\xc8\x85\xf5\x92\xab\x09\xb5\xf6\x8a\x08\xdb\x18\x64\x5a\x2f\xd2\xfa\x0d\xe9\xa2\x2d\x8c\x3a\xbb\x4a\x9d\x8d\x29\xb6\x6e\xa6\x15\xf0\xaf\xa4\x07\x82\x0a\x0b\xd6\xf2\xa0\xb9\x7f\x40\xc7\x65\x3e\x54\x1a\x6c\x8d\x68\xdb\x2b\x57\xe0\xea\x75\x2a\x1c\x3e\xfb\xcb\x96\x92\x20\xf4\x9d\x48\xe6\xeb\xd5\x2d\xec\x11\x28\x3b\xe0\xdb\x84\xb5\xd3\xac\xdb\xe3\x1f\x04\x41\x52\x11\xb0\xb3\x5c\x69\x4b\x05\xa1\x5b\x79\x8f\x53\x45\xc8\x0d\x7c\x21\x35\xda\x00\x8d\x9c\x9f\xd4\xce\x0b\xed\x25\x7b\xb6\xb6\x76\x4c\xb5\xe4\xad\xf4\xf3\xfb\x94\x2b\xac\x6b\xd1\xd1\x04\xe5\x8a\xdd\x81\x35\xff\x70\xef\xb2\x32\x25\xb4\xbb\xfb\x0c\x17\x29\xf5\x0d\x67\x0a\xe7\x64\x49\x59\x26\xdc\xa2\x2c\xe7\x3e\x85\x93\xe5\x6c\x93\x46\xe3\x11\xf2\x37\xb6\xa6\xaa\x0d\x02\xd2\x73\x04\x98\x22\x31\xd0\x35\x15\x16\xc9\x67\x14\x58\xa3\x2e\x50\xe7\x0b\xee\x6a\xa9\x1b\xb4\x19\x7c\x70\x24\x29\xf8\x6f\x59\xce\x89\x85\xe1\xd0\x7e\xaa\xc4\x59\x01\x85\xea\x65\x0c\x24\x65\xf6\x94\xd7\x58\xaa\x68\x88\xfd\x94\x03\x25\x08\x58\xac\xac\x77\x50\x34\xdc\x6b\x5b\x45\xa2\x21\x3e\x64\x9c\xc5\x5a\xa1\xcb\xb6\x91\xd0\x96\x04\xc1\xe0\x89\xa4\xd2\x84\xae\x1a\x77\x7e\xc9\x77\x7a\xd3\xf9\x51\x19\x5a\x21\x2d\x0c\xa9\xfd\xb7\x4f\x03\xdc\x54\x4c\x44\xd7\x63\x40\xac\x12\x43\x9a\x03\x8d\x0e\xcc\xc7\x7e\x3d\x92\xdc\xcc\x93\x00\x6a\xd3\x3e\x76\xc7\xa2\x5a\x45\xb9\xf3\xe9\x56\xe8\x6b\x2c\x40\xe1\x9d\xcc\x4d\x69\x45\x3d\x97\xb9\x50\x6a\xc1\x66\xca\xe5\xa0\xf4\x8e\xbb\x28\x03\x6d\x9b\x6d\x6e\xbc\xbd\x02\xb8\x77\xfb\xc2\x61\x6e\xd1\xef\x6e\x85\x5d\x86\x75\x5d\x50\xa6\x08\x48\x2c\x8e\x00\x82\x8e\x44\x9d\x4b\xfd\x1e\x91\xe7\x64\x48\xac\xba\x46\x7b\x8c\x09\x48\x4f\x95\x33\x38\xe7\x90\x3a\x45\xc7\x5a\x7e\x8d\x58\x07\x4d\x53\xd2\x79\x70\x95\x50\x6a\x04\x4e\xea\x1c\x01\x45\x3e\x0f\xec\xd4\x88\xa9\xa0\xf6\x56\x62\x48\x83\x28\xd4\x2e\x5a\xd9\xa0\xf6\x9b\x93\x9a\xe1\xba\x6b\xa0\xe6\x1a\x66\x63\xeb\x53\x76\x73\xb2\xf3\x45\x29\x26\xc6\x3b\x27\xd7\x5d\xef\xdd\xe3\xe8\xd0\x27\xbe\xf4\x94\x7c\x94\xbb\x7d\xcd\x87\xa5\xe5\xed\x3d\xc3\xdc\xdc\xa6\x8e\xf3\x9a\x91\x93\xe3\x75\x49\xb6\x85\x74\x39\x59\x3a\x16\x70\x66\xb4\xe3\xfc\x34\x5c\x3c\xf0\xc5\xc1\x8d\x50\x41\x15\x12\xe0\xda\x28\xc5\x26\xdf\xa4\x72\x82\xf2\x78\x0d\x58\x4d\xb1\x28\xb0\x20\xb2\x02\x2a\x5b\xc2\xdc\x1f\x6c\x15\xa5\xf8\x70\x61\x94\x1a\x8e\x62\x83\x75\xe9\x3e\x55\x69\x62\xc0\x50\x6e\xb4\x1c\xfa\x12\xc7\xa4\x6b\x6d\xa6\x40\x8f\xb6\x92\x3a\xa6\x47\x94\xea\xb6\x8c\x9d\xa2\xbf\x45\xd4\x90\xcf\x31\xbf\x6e\x4d\x29\xde\xdb\xac\x48\x2d\x5e\x1a\x2d\x7b\xac\xee\x4a\xcc\x28\xc5\x85\x86\x43\x04\x49\x35\x81\xc6\xdb\xb4\x67\xc5\x46\x7b\xce\x5e\xdc\x08\xa9\xc4\x54\x21\x47\xcd\xf6\x6b\xb4\x74\x7f\x94\xe2\x79\xdd\x28\x45\x49\xac\x2e\xa0\x7c\x77\x71\x06\xde\x8a\xd9\x4c\xe6\x34\x55\x48\x8b\xb9\x8f\x3d\x89\x6d\x24\x0c\xa5\x6b\x5b\x2d\xc2\x79\xe1\x9b\x35\x19\x0d\x08\x78\x48\xb0\x54\x87\xc8\xad\x0d\xa2\x25\x51\xbe\x5b\x2e\x56\x08\x0d\x0c\xc5\xd6\x52\x33\x35\x83\x37\x86\x6b\x04\xe1\xe1\x35\x3a\x0a\xbb\xcc\xa0\x77\x28\x9c\xd1\x3d\xef\xca\xd9\xaf\x95\xa5\xd4\x42\x45\xa2\xf8\xd6\x87\x52\x74\x69\x74\x5b\x7b\x88\x05\x19\x65\x25\x4b\x2b\x7c\xeb\x14\x3b\xbc\x63\x74\x89\x71\x71\xd6\xf8\xc6\x62\x06\xa7\x7a\xc1\xf2\x9e\xa1\xa0\x01\x82\xec\xad\x29\x9a\x9c\xf2\x25\x72\xb0\x8d\xeb\x03\xf9\xaa\x6e\x74\x89\x6b\x07\x67\xe9\x90\x94\xe8\x39\x32\x00\x21\x63\xf3\xd4\x68\x04\xe1\x6a\xaa\xe3\x92\x4e\x36\x96\x9b\xd8\x2d\x83\x39\x58\x9c\x5e\x9c\x43\x7a\x1a\x92\xc1\x78\x3c\x86\xf7\x34\xec\xbc\x6d\x72\x8e\x2f\x64\x42\xba\x88\x91\x22\x68\x1f\x13\x29\x38\xed\x64\x32\x20\x76\x3e\x42\x0a\x56\x0b\x3f\x87\x2c\x30\x3e\xeb\xb1\x02\xe0\x15\xc5\x9a\x3b\x51\xd5\xac\xf7\xe4\xba\x5f\x19\x73\x19\x24\x14\x0e\xfc\x27\x13\x7a\x7c\xbc\xaa\x14\x66\x4a\x39\x6a\xec\x20\xb2\x6e\xcc\x8c\x79\xec\x96\x69\xca\xd2\xe6\x9f\xb5\xb9\xd5\x9b\x50\xe0\x33\x85\xc5\x09\x5c\x1d\x9c\x26\x13\xbc\x3a\x18\xc1\xd5\xc1\x85\x35\x25\xe5\xae\x52\x97\x34\x40\x9a\x75\x75\xf0\x02\x4b\x2b\x0a\x2c\xae\x0e\x12\xe8\xff\xa8\xa9\xd4\x7a\x8d\xb6\xc4\x9f\x71\xf1\x9c\x01\x2e\x4d\xa5\xf0\xf0\xbc\xa2\x35\xed\x1c\xc5\x64\x0a\x5c\xcf\x2b\x51\x2f\x0d\xbe\x16\xf5\x12\xa0\xb3\x4e\x01\x3f\x7d\xae\xd0\x8b\x9b\x93\xac\x13\xf5\x3f\x7e\x75\x46\x4f\xae\x0e\x3a\x9a\x46\xa6\x22\x95\xa9\xfd\xe2\xea\x00\x96\x30\x98\x5c\x1d\x30\x0e\x69\x3c\x21\x3d\xb9\x3a\xa0\xd3\x68\xd8\x1a\x6f\xa6\xcd\x6c\x72\x75\x30\x5d\x78\x74\xa3\x93\x91\xc5\x7a\x44\x99\xd4\xf3\xee\x84\xab\x83\x7f\xc0\x95\x4e\x48\x73\xa1\x18\x24\xed\xe0\xf7\x83\x81\x78\x3f\x10\x14\x86\x8b\x3b\xaa\xde\x94\x70\xfe\xbd\x15\xda\xc9\xf4\x8c\x62\xeb\xd2\x2a\x38\x83\xad\xf3\x96\x1d\xc4\xd6\xe9\xa0\x25\x5b\xa7\xb7\x84\xd6\x7d\xc2\xda\x3a\x0d\x7b\xb6\xa4\xd7\x37\xa6\x7c\x87\x66\xba\x26\x4e\x2b\x23\x0a\x10\x71\x35\x19\x2a\x25\xf9\x64\xff\xd1\xf9\x51\x3a\xa9\x59\x6e\x59\x34\xee\xb6\xfa\x6f\xaf\x40\x1b\x5d\xa0\x55\x0b\x4a\x37\x3a\xa8\xf9\x9c\xaa\x81\x22\x83\xd0\x54\x10\x6d\x0b\xe7\x9a\x0c\x8c\x43\x97\xee\x75\xb6\x19\xaf\x16\x22\x39\x96\xe0\x10\x22\x18\x8e\x82\x79\x8e\xb5\xe7\x30\xf8\xf0\x9b\x16\xe8\x75\x5c\x28\xed\x1a\xfb\xed\xea\x11\x95\x63\x4f\xc6\xc7\xd5\xf1\xd2\xba\xa9\x04\xc5\x15\x51\x10\xbe\xdd\x5c\xa8\xed\x42\x2d\x16\xfc\xad\x98\x9a\x26\xf6\x7e\x5b\x39\x44\x56\xc7\x28\xc3\x59\x5b\xed\x17\x3b\xdb\x29\x7b\x11\x5f\x89\xbb\x5f\x50\x97\x7e\x3e\x81\x6f\x9f\xfe\xe7\xb3\xbf\x6e\x59\x18\x9c\x26\x16\x3f\xa1\x8e\x8d\xa2\x3d\xd9\xb0\xbe\x71\xb5\xa3\xd8\xbd\x74\x2a\xbb\x35\x6d\x07\xbc\xd3\xa0\x5b\xc1\xf7\x98\x31\x96\x36\x35\xf1\x85\xa2\x40\x68\x44\xe4\x38\xa2\x0c\x6a\x23\x30\xd9\x3a\x77\xb5\x80\x93\xa7\x23\x98\x46\x16\xaf\xbb\xf5\x4f\x77\x9f\xb3\x0d\x28\x4b\x07\x7f\x1b\xad\xe0\x23\x1d\x90\xa8\xcc\x8c\x15\x27\xd4\x9f\x16\x43\x98\x4c\x9d\x82\xf5\x30\x89\x2d\xbe\xbb\x04\xb7\xab\x59\xb8\x5f\xa3\xb0\x92\x5a\x56\x4d\x35\x81\x27\x5b\x96\x04\x97\xb6\xa7\x34\xc3\xe2\x2e\x4b\x10\xe4\xba\x4a\x2b\x2a\xca\x87\x72\x90\x05\x6a\x2f\x67\x12\x6d\x5f\xb5\xb9\x17\x10\x36\xa6\xab\xd8\x96\x8b\x7c\x4b\x4b\x7e\xa8\xa7\xec\x17\x21\x09\xb2\x1c\x9d\xe3\xd5\x4e\xde\x77\x50\x8b\x1a\x83\x35\x84\xea\x06\xf0\xae\x0e\x79\x6c\xef\x8e\xa2\x42\xa1\xa5\x2e\xd3\xed\x6f\x6a\x34\x87\x68\x7c\x3b\x47\x0e\x3d\x6d\x13\x33\x5c\x06\xe4\x54\x49\x15\x5c\x54\x09\x28\x1b\x61\x85\xf6\x54\xe3\x9e\x5e\x9c\x87\x04\x7e\xb5\xe1\x29\xba\x27\x41\xc9\x1a\x83\xa9\x06\x67\x45\x28\xc6\x67\x44\x6c\xb1\x5f\xcf\x54\x4f\x9e\x3c\x1d\x14\x79\xbb\x6e\xfb\xfd\x9e\xf0\x1e\xad\x9e\xc0\xff\x7d\x3a\x1d\xff\x5d\x8c\x7f\xfb\x7c\x18\xff\x78\x32\xfe\xdb\xff\x8f\x26\x9f\xbf\xe9\x7d\x7e\x3e\xfa\xe1\xdf\xb7\x40\xda\x9c\xe9\x6f\x51\x9f\x18\x44\x52\x12\x99\x24\x3a\xe2\x08\x63\x66\xf0\xde\x36\x38\x82\x57\x42\x39\x1c\xc1\x07\xcd\xa1\xe1\x0f\x32\x0d\x75\x53\x0d\x55\x82\x63\x38\xa0\x53\x37\x27\x1f\xed\x12\x46\x69\x78\x4d\x44\x77\xa8\xb6\xdd\x8f\x49\xa9\x0f\xd1\xf3\x34\xbd\xa7\x67\xfc\x56\x89\x0c\xc9\x64\x31\xfd\xcd\x72\x53\x1d\xf7\x9e\xa6\x51\xde\xfd\x5a\xe8\x05\x74\x6e\x2d\x24\xab\xab\x9a\xee\x3c\xf9\x26\x91\x5b\xe3\x5c\xfb\xb6\xce\x81\x92\xd7\x08\xa7\x5d\x51\x49\xce\x72\x8a\xb9\xe0\x44\xdd\x4e\xa5\xb7\x22\x74\x84\x53\x6e\xd9\xb5\x9b\x66\x8d\x82\x43\xaa\x65\x33\x7e\x4f\xb1\xe6\x5d\x8f\x62\x6b\x77\x2a\x95\xf4\x8b\x50\x67\xe7\x46\xcf\x94\x8c\xf5\x41\x55\x1b\xeb\x85\xf6\xb1\x09\x89\x25\xde\x81\xec\xee\xc5\xa5\x83\xc3\x42\xbb\x93\x93\xa7\xdf\x5e\x36\xd3\xc2\x54\x42\xea\x57\x95\x3f\x3e\xfa\xe1\xf0\x4b\x23\x14\x5f\x01\xbf\x11\x15\xbe\xaa\xfc\xd1\xd7\x0b\x8b\x27\xcf\xf6\xb0\xa2\xc3\x4f\xc1\x56\x3e\x1f\x7e\x1a\xc7\xbf\xbe\x49\x43\x47\x3f\x1c\x5e\x65\x83\xf3\x47\xdf\x10\x0d\x3d\x0b\xfc\xfc\x69\xdc\x99\x5f\xf6\xf9\x9b\xa3\x1f\x7a\x73\x47\x9b\x8c\xf1\x6e\x7c\xdd\x4c\xd1\x6a\xf4\xe8\xc6\x54\x0d\x8c\x2b\x51\x8f\xaf\x71\xb1\xc5\x38\xb7\xa6\xa3\xeb\x80\x02\xc7\x2a\x51\x6f\x2a\xcd\xc3\x13\xb3\x77\xc8\xcf\x9a\xf2\x8d\x4a\xfe\x07\xaf\x67\xb4\xd8\x92\x92\x85\x29\x7e\x04\xff\x80\x96\x14\xc5\x9d\xd0\x86\x1b\x4a\xa7\xf7\xd0\x96\xfd\xf2\x47\x3d\xf0\x8c\x67\xe7\x21\x2d\x9d\x0f\x86\x90\xec\x7b\xcb\x0b\xe8\xbd\xe1\x34\x72\x6b\xa5\xb5\xdc\xe0\x3c\x7f\x11\x52\x5f\x76\x3d\x9c\xce\xcd\x0d\xd5\x79\x8d\x96\x5f\x1a\x84\xf3\x17\xd1\x1f\x8d\x40\xea\x5c\x35\x05\x65\x0a\x1f\x3e\x9c\xbf\xa0\xe2\xfe\xc7\xe8\x6e\x6e\x11\x0a\xa3\x1f\x7b\x78\xfb\xe6\x97\xff\xe5\x4e\x01\xaf\x18\x85\x80\x1e\x2e\xab\x84\x92\xe1\xf1\x74\x0a\xc0\xf0\x23\x12\xac\x78\x72\x2e\xea\xb6\xb9\xc2\xee\x4e\x17\x30\x47\x55\x53\x02\x71\x8d\xe0\x1a\x1b\xb1\x23\xc0\xe1\x3a\x98\x78\x0d\xf1\xb2\xb8\x44\xcf\x4a\xae\xf8\x11\xf0\x43\x98\x16\x9f\xa5\x4a\xa3\x2f\x29\x0b\xfc\x13\xec\x83\x14\xf9\x6d\xcc\x59\xf9\x8c\x07\x18\xc3\xc0\x5b\xdc\x9d\x14\x42\x34\xa6\xb3\x40\xe9\x9f\x6e\x49\x6b\xf4\x3e\xe8\xc4\xd0\xec\xe4\x6b\xcf\x77\x3b\x9a\xd3\x6b\x6f\xbc\x96\x4b\xe7\x95\xff\x12\xc1\x8d\xd7\xf6\xe6\x74\x2e\x1c\x4c\x11\x35\xf7\x7a\x43\x6b\x10\x75\xd4\x3a\xec\xba\xb4\x4d\x3d\xf6\x66\x5c\x6c\x16\xde\x0e\xce\xed\xe6\xda\x40\xe5\xba\x44\xdb\xe9\xbd\x0b\xd5\xdb\xf9\x62\x13\x0f\x5c\xe8\x75\xf2\xab\xa2\x94\x83\xdc\x97\xb0\xed\x85\xc9\x4a\xcb\x97\x2b\x8b\xd8\xd4\x88\x75\xc6\x3a\x4a\x54\x3d\x2e\x75\x36\xbc\xe1\xab\xbe\xe5\xae\xdf\xfd\x71\x0c\x62\xbe\x44\x7b\x23\x1f\x14\xfc\x76\x19\x66\x1e\x1e\xa1\x9c\xfe\xf9\x66\x45\xa9\xd7\x83\x0f\xe1\xf6\x5f\x6e\x76\xdc\xed\x0c\x00\x70\x81\x83\x43\x8f\x5d\xef\x03\xe3\xbe\xc1\x32\x78\x93\x09\x3f\x3c\x4e\x43\xde\x58\xbe\x8f\xef\x8f\x35\xd3\x36\x51\xee\xa0\xc7\x1a\x08\xfe\xf9\xfb\xa3\x7f\x05\x00\x00\xff\xff\x53\x7d\x47\x3d\x4a\x39\x00\x00")
+var _operatorsCoreosCom_catalogsourcesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\x7b\x77\xe3\xb6\xb5\x28\xfe\x7f\x3f\x05\x96\x7b\xd6\xcf\x76\x2a\xc9\xe3\xa4\x37\xed\xf1\x69\x9b\xe5\xd8\x9e\x1c\xdf\xcc\xc3\x77\xec\x49\x7e\xe7\x64\x72\x5b\x88\x84\x24\xd4\x24\xc0\x00\xa0\x35\xca\x49\xbf\xfb\x5d\xd8\x1b\x00\x41\xea\x45\xca\xf2\x63\x12\xa9\x6b\x35\x63\x89\x00\x81\x8d\x8d\xfd\x7e\xd0\x82\x7f\xc7\x94\xe6\x52\x9c\x10\x5a\x70\xf6\xd1\x30\x61\xff\xd2\x83\xdb\x3f\xeb\x01\x97\x47\x77\xc7\xbf\xbb\xe5\x22\x3d\x21\x67\xa5\x36\x32\x7f\xc7\xb4\x2c\x55\xc2\xce\xd9\x88\x0b\x6e\xb8\x14\xbf\xcb\x99\xa1\x29\x35\xf4\xe4\x77\x84\x50\x21\xa4\xa1\xf6\x6b\x6d\xff\x24\x24\x91\xc2\x28\x99\x65\x4c\xf5\xc7\x4c\x0c\x6e\xcb\x21\x1b\x96\x3c\x4b\x99\x82\xc9\xfd\xab\xef\x5e\x0c\xbe\x1c\x7c\xfe\x3b\x42\x12\xc5\x60\xf8\x0d\xcf\x99\x36\x34\x2f\x4e\x88\x28\xb3\xec\x77\x84\x08\x9a\xb3\x13\x92\x50\x43\x33\x39\xc6\x45\xe8\x81\x2c\x98\xa2\x46\x2a\x3d\x48\xa4\x62\xd2\xfe\x27\xff\x9d\x2e\x58\x62\xdf\x3e\x56\xb2\x2c\x4e\xc8\xc2\x67\x70\x3e\xbf\x48\x6a\xd8\x58\x2a\xee\xff\x26\xa4\x4f\x64\x96\xc3\xbf\xdd\xe6\xf1\xb5\xd7\xf0\x5a\xf8\x3e\xe3\xda\x7c\x3b\xff\xdb\x2b\xae\x0d\xfc\x5e\x64\xa5\xa2\x59\x73\xc1\xf0\x93\x9e\x48\x65\xde\x54\xaf\xb7\xaf\x4b\xa8\xd1\x2a\xc1\x9f\xb9\x18\x97\x19\x55\x8d\xb1\xbf\x23\x44\x27\xb2\x60\x27\x04\x86\x16\x34\x61\xe9\xef\x08\x71\x20\x74\x53\xf5\x09\x4d\x53\x38\x16\x9a\x5d\x29\x2e\x0c\x53\x67\x32\x2b\x73\x11\x5e\x65\x9f\x49\x99\x4e\x14\x2f\x0c\x80\xfe\x66\xc2\x48\xa1\x98\x31\x33\x00\x09\x91\x23\x62\x26\xcc\xbf\x3b\x8c\x22\xe4\x9f\x5a\x8a\x2b\x6a\x26\x27\x64\x60\x21\x3c\x48\xb9\x2e\x32\x3a\xb3\xab\x89\x9e\xc2\x63\x3a\xc7\xdf\xa2\xef\xcd\xcc\x2e\x5d\x1b\xc5\xc5\x78\xd5\x52\xec\x73\xed\xd7\x80\xa0\xb9\x99\x15\xf3\x4b\x68\x7c\xd9\xf6\xfd\x45\x39\xcc\xb8\x9e\x30\xd5\x7e\x11\x61\xc8\xdc\x1a\xae\x16\xfc\xb2\x64\x21\xd1\xa4\xfe\x42\x0d\xe6\x2e\xc3\xdc\x0b\x4e\xc7\xf3\x7b\x4c\xa9\xf1\x5f\xe2\x43\x77\xc7\x34\x2b\x26\xf4\xd8\x7d\xa9\x93\x09\xcb\x69\x85\x0f\xb2\x60\xe2\xf4\xea\xf2\xbb\x2f\xae\x1b\x3f\x90\x3a\x74\x6a\x78\x4e\xb8\x26\x94\x28\x56\x48\xcd\x8d\x54\x33\x0b\xad\xb3\xeb\xef\x74\x8f\x9c\xbd\x3b\xd7\x3d\x42\x45\x1a\x2e\x1e\x29\x68\x72\x4b\xc7\x4c\x0f\xe6\xd6\x2a\x87\xff\x64\x89\x89\xbe\x56\xec\xa7\x92\x2b\x96\xc6\xab\xb0\xe0\xf1\x30\x69\x7c\x6d\xe1\x1f\x7d\x55\x28\xfb\x4e\x13\x5d\x64\xfc\x44\x54\xae\xf6\x7d\x63\x87\xfb\x16\x0c\xf8\x1c\x49\x2d\x81\x63\x1a\x50\xc0\xdd\x31\x96\x3a\xd8\x21\x6a\x70\x6d\xf7\xaf\x98\x66\x02\x49\x9e\xfd\x9a\x0a\xb7\xa7\x01\xb9\x66\xca\x0e\xb4\xd7\xbd\xcc\x52\x4b\x09\xef\x98\x32\x44\xb1\x44\x8e\x05\xff\x39\xcc\xa6\x89\x91\xf0\x9a\x8c\x1a\xa6\x0d\x81\x5b\x2b\x68\x46\xee\x68\x56\x32\x04\x65\x4e\x67\x44\x31\x3b\x2f\x29\x45\x34\x03\x3c\xa2\x07\xe4\xb5\x54\x8c\x70\x31\x92\x27\x64\x62\x4c\xa1\x4f\x8e\x8e\xc6\xdc\x78\x1a\x9e\xc8\x3c\x2f\x05\x37\xb3\x23\x20\xc7\x7c\x58\x5a\x72\x78\x94\xb2\x3b\x96\x1d\x69\x3e\xee\x53\x95\x4c\xb8\x61\x89\x29\x15\x3b\xa2\x05\xef\xc3\x62\x05\xd0\xf1\x41\x9e\xfe\x5e\x39\xaa\xaf\xf7\x1b\xe0\x5b\x88\xcc\xc4\x93\xcd\x95\xb0\xb6\xc4\x13\xb1\x08\x87\xe3\x5e\x2a\x90\xda\xaf\x2c\x54\xde\x5d\x5c\xdf\x10\xbf\x00\x04\x3b\x42\xb8\x7a\x54\x57\xc0\xb6\x80\xe2\x62\xc4\x14\x3e\x39\x52\x32\x87\x59\x98\x48\x0b\xc9\x85\xc1\x2b\x9d\x71\x26\x0c\xd1\xe5\x30\xe7\x46\x03\xce\x31\x6d\xec\x39\x0c\xc8\x19\xb0\x30\x32\x64\xa4\x2c\xec\x4d\x4a\x07\xe4\x52\x90\x33\x9a\xb3\xec\x8c\x6a\xf6\xe0\xa0\xb6\x10\xd5\x7d\x0b\xbe\xf6\xc0\x8e\x39\xf0\xfc\x80\xb9\x3b\x46\x88\xe7\x90\xad\x1e\x5e\x76\x29\x09\xde\xc0\x45\x14\x98\xac\xb8\x8b\xf6\x43\xd3\x54\x31\xbd\xe0\x87\xb9\x0b\x89\x0f\x22\x9e\x4c\xa4\xb6\xe7\x47\x0d\x79\xfb\xea\x35\x49\xa8\x20\xa5\x66\xf6\xf2\x24\x52\x08\x8b\x10\x46\x12\x6a\x79\x59\x9f\x7d\xe4\x1a\x10\x48\xb1\x31\xd7\x46\xcd\x06\xe4\xa5\x54\x39\x35\x27\xe4\x2f\xfe\xab\x3e\x4c\x27\x15\xe1\xc5\xdf\x4e\xfe\x52\x48\x65\xfe\x46\xde\x8a\x6c\x66\x27\x4d\xc9\x74\xc2\x04\xb9\x0e\x7b\x23\x7f\x8d\xfe\xf8\x46\x15\xc9\x80\x5c\x8e\x85\x54\xfe\x49\x8b\x55\x97\x39\x1d\x33\x32\xe2\x2c\x03\xbc\xd6\xcc\x0c\x9a\x27\xb8\xf2\x14\x09\x8a\x4b\x23\x3e\x7e\x4d\x8b\xb5\xa0\x39\xf3\x4f\xda\x77\xd9\xd7\xc7\xcc\xbb\xfa\xd1\x48\x40\x65\xbb\x25\xfb\x4f\x9a\xdc\x12\xea\xde\x92\xd3\xa2\xaf\xe1\xda\x44\x60\x6a\x07\x81\x33\x3f\x81\x85\x5f\xf5\xf5\xa5\xa3\x5c\x83\xae\xdb\x8e\x77\xd6\x79\x6c\x25\x86\xac\x05\xda\xeb\x45\x5c\xa4\xc5\x3b\xc6\xaa\x48\xae\x64\x8a\xdb\x5e\xfb\x96\x6f\xe2\xa7\x09\xfb\x58\x48\xcd\x34\x49\xf9\x68\xc4\x94\xa5\x3b\xf2\x8e\x29\xc5\x53\xa6\xc9\x48\x2a\x38\xaf\x42\xa6\x70\x27\xc3\xf9\xd5\x58\xed\x95\x4c\xdb\x1e\x8c\x7d\x35\x30\x0c\x44\x46\x87\x86\x4b\xb7\xbb\xf0\xb6\x93\x35\x97\xd7\x7e\xe8\x08\xc4\xff\xd9\xe2\x5f\x1b\xf0\x38\x75\x0f\x93\x89\xcc\x52\x44\x56\xcb\xf9\xd2\x32\xb3\x57\x34\x91\x42\x1b\x45\xb9\x30\xba\x21\x72\x39\xda\xb2\xaf\x2d\x7c\x16\x6d\xa2\xc5\x46\xda\x6c\xc6\x7e\x84\x4c\xd9\xe9\x9a\x4d\xcd\x6d\xec\x1c\xfe\x18\x32\x0d\xc3\x03\x50\xe2\xdd\xa9\x32\xab\x9f\xf3\xb2\x7d\xb4\xdc\x4b\xdb\xfd\xe0\x73\x6c\xc4\x94\x62\xe9\x79\x69\xb1\xfa\x3a\xac\xca\x91\x2e\xfc\xfa\xe2\x23\x4b\xca\x65\x37\x6f\xe9\xd6\x6f\xaa\x43\x64\x8a\x4c\x79\x96\xb9\xd7\x59\x32\xe3\x7f\xb0\xfb\x05\xe9\xc6\x82\x47\x23\xe9\xd6\xd4\x70\x3d\x9a\x01\x38\x02\xc0\xd8\x47\xcb\xc9\x41\x8f\x81\x7b\xc0\x47\x9c\xa5\x64\x38\x73\x4c\xdc\x92\xd4\x1e\x19\x96\x86\x70\x03\x1c\x3e\x99\x48\xa9\x19\xa1\x08\x77\x98\xf7\x8e\x4b\x90\x9f\x88\x14\xcc\x52\xa5\xdc\xb2\x69\x87\x4f\xd1\xf4\x03\x58\x79\x35\x8c\x6b\x92\x5b\x3e\x10\x60\xe5\xc9\xa9\x9d\x66\xca\xcd\x04\xfe\x18\x5b\x41\xdc\xca\x66\xba\xcc\xed\xa4\x53\xc6\xc7\x13\xa3\x7b\x84\x0f\xd8\x00\x4e\x97\xd1\x64\x12\x4d\x9b\x33\x66\x34\xa1\x59\xe6\x97\x10\xa3\x04\x72\xd3\xdc\x0a\x2e\xe4\x20\x48\x36\x4e\x0a\xe9\x05\x6e\xdb\x3c\xb5\x85\xe0\xea\x11\x66\x92\xc1\x61\x8f\x24\x32\x2f\x4a\x63\x61\x62\xd7\x38\x9c\x11\x6e\xac\xf4\x8d\x52\x94\x92\xe5\x18\x77\xc2\x32\xf7\x62\x2f\xc2\x22\xbf\xb2\x24\xc3\x6a\x8e\x62\x4c\xf6\x70\x73\x7b\x5e\x2a\xb5\xd3\x71\xdc\x04\xec\x2f\xa7\x26\x99\x38\xc1\x38\x91\x4a\x31\x5d\x48\x01\x23\xe1\x97\x8b\x6a\x6d\xff\x11\x06\x1d\xe8\xc3\x0a\x98\x13\x3e\x9e\x78\x58\x52\xc5\xe0\xbb\xfa\x19\xac\xba\x23\xd5\x3d\xa1\x4a\xd5\x34\xcc\x45\x1f\x6e\x58\xbe\xe6\x96\xcc\xa1\xf6\xa9\x20\x2c\x2f\xcc\x2c\xc2\x89\xe8\xf4\x0c\x53\x79\x80\x01\x1c\x30\x5c\x57\x8d\xfb\xe3\x79\x91\xf1\x84\x1b\x87\x21\xe4\x05\x39\x00\x14\xe1\x66\x5f\x03\xba\xf6\x65\x71\x38\x20\xa7\x60\xd4\x68\xf1\x02\x21\xc3\xfc\x6e\x22\xfb\x52\x2d\xab\xb9\xd6\xee\xad\x25\x51\xc1\xcf\x72\x49\x6f\xfe\xd3\x77\xeb\x67\x22\x69\xca\x7e\x8b\x1f\x47\x98\xac\x7d\xb4\x2d\x79\xf3\x4f\xfb\x35\xb4\x79\xba\x79\xd4\x88\xd2\x9a\x65\x2c\xb1\x8a\xaa\x85\x7d\x8f\x50\xad\x65\xc2\xad\xec\x5f\x21\x6d\x1d\xd3\x71\x27\xeb\x61\x4f\xba\xc2\x9f\x74\xde\xbf\xfd\x34\x2f\x5e\xdb\x71\x73\xd0\xc8\xb8\x15\x89\x47\x0d\xa8\xd4\x08\xd6\x70\x06\xbf\xee\x6b\x92\xd1\x21\xcb\x74\x3b\x20\x90\x4e\xb7\xb6\xfa\xb4\xbc\xbf\x4b\x37\xb4\x74\x23\x4e\xf3\x0c\x07\x6f\x89\xb6\xd5\xd8\x28\x17\xda\x69\xd5\x3d\x42\xc9\x2d\x9b\xa1\x02\x6e\xf5\x7a\x6f\xce\x80\x87\x15\x43\x76\x63\x91\xe3\x96\xcd\xe0\x21\xa7\x8d\x77\x58\x6e\x67\xe4\xc0\x4f\x97\x6b\x5a\x7d\xfa\x76\xa1\x1d\x47\xf8\x4d\x77\x18\xd6\x1d\x7f\xf1\x73\xcb\x56\x4a\x5e\x8b\x3e\x73\x22\x09\xe0\x24\x9c\x07\x1c\x12\xf0\x2f\x7f\xc6\xb4\x28\x32\xce\x40\xcb\xef\xf8\x9a\x95\xba\xc1\xaa\x8f\x87\xde\xbd\xf6\xf5\x2e\x98\x39\x10\x21\xf7\x35\x22\x9f\xbd\xe9\x13\x5e\xa0\xd6\xab\x19\x5c\x5c\x6f\x0f\xfa\x8e\x66\xbc\x32\xc0\x69\xe0\xb3\x97\xa2\x47\xde\x48\x63\xff\x73\x61\xf5\x63\xdd\x23\xe7\x92\xe9\x37\xd2\xc0\x9f\x03\xf2\x8d\x41\x5c\x7f\xd5\x92\xb2\x6d\x01\x40\xb8\xde\x7b\x81\xe7\x54\x20\x4d\xb1\xdb\x8f\x2d\x49\x7a\x40\x2e\x51\x6c\x09\x17\x97\x6b\x72\x29\xac\x70\xe8\xc0\x00\xb6\x3d\x78\xd6\x4d\x91\x97\x1a\x4c\x3f\x42\x8a\x3e\xc8\x00\x0b\xe7\x40\xe8\xd9\x79\x62\xf8\xad\x98\x6e\xf9\x54\xdf\x80\x05\xe2\xd5\xd2\xc1\x13\x7a\x07\x22\x1d\x17\xe3\x2c\x08\x6f\x3d\x32\x9d\xf0\x64\x82\x52\xf7\x90\xa1\xc1\xb0\x50\xcc\x32\x2c\xaa\x2d\xa9\xb2\xdf\x8c\x99\xb2\xc2\x2e\xf7\xf3\xa1\xb9\x32\xa3\x09\x4b\x49\x0a\xa2\x25\x9a\xde\xa8\x61\x63\x9e\x90\x9c\xa9\x31\x23\x85\xe5\x24\x9b\x9d\x7e\x37\xc2\x8e\x9f\xce\xe4\x3d\x7e\x61\x27\x74\x03\x16\xf9\xd2\xca\xba\x8f\xc4\x1d\x41\xae\xde\x71\xc7\x1d\x77\x6c\x7c\x76\xdc\x31\x7c\x76\xdc\x71\xcd\x67\xc7\x1d\x77\xdc\xf1\xc1\xb9\x23\xea\xb2\x1b\x28\xcf\xdf\xa3\x89\xa3\xa9\x2d\x03\xa7\xf5\xce\xbb\xba\xda\x6c\xf9\xcd\xb5\x23\x38\x37\xa0\x6a\x73\x74\x9d\x28\x2a\xc6\x8c\x1c\xf7\x8f\x5f\xbc\xe8\xa2\x54\xbb\x83\x6c\x35\x62\xe4\xfc\x3f\x5c\x98\x2f\x3e\x5f\x39\x62\x99\xfd\x6d\x0b\x56\x53\x87\xe3\xc1\x90\x57\x93\x1d\x96\x18\x3e\x81\x3a\x09\x69\x48\xce\x0c\xa1\xa6\x66\x2a\xe2\x39\xeb\x05\x07\x02\x20\xbc\xf3\x5d\x7a\x0b\x6c\x4a\xa4\x70\x76\x3c\x0b\xfc\xc1\x66\x2b\x48\x18\x45\x47\xdb\x90\x85\x55\xc8\xdc\xbe\x95\x0b\xe3\xaf\x8b\x5d\x02\xf3\x50\x21\x07\x6c\x30\x1e\x90\xb4\x84\x61\x54\x38\x67\xea\x21\xae\x56\xcf\xb4\x61\x39\x58\x72\xa5\x82\xff\xd8\x65\x1b\x35\xb3\x0f\xb3\x3b\x26\x4c\x49\xb3\x6c\x46\xd8\x1d\x4f\x4c\xd8\x1f\xf8\x72\xb9\x41\x63\x7b\x3b\x13\x61\x2b\xd1\xa1\xbd\xb8\xd0\x9f\xc3\x60\xbd\x66\x4c\x17\x6e\x3f\x37\x77\x9b\x3b\xd9\xe0\x85\xb8\x93\xc1\x52\x61\xd5\xd8\x79\xd1\x06\x0e\xff\x04\xe4\x7a\xfb\x6e\xbd\xc9\x95\x74\xa6\x64\x1d\xa8\x57\x53\x2c\x2d\xb3\xcc\x22\x06\x5a\x61\xe7\x37\xb0\xc0\x3a\x8a\x5b\xaa\x21\x33\x1a\xde\xd1\xc4\x7c\xfa\xe6\xdc\x42\xc5\x3e\x73\x23\x0b\x99\xc9\xf1\x2c\x86\x34\x06\x1d\xf1\xbc\xf0\xc6\x71\x4a\x74\x39\x74\x42\x83\x45\xbf\x37\x8d\xa3\xd9\x59\xfe\x76\x96\xbf\x9d\x6e\x33\xf7\xd9\xe9\x36\xe1\xb3\xd3\x6d\xd6\x7c\x76\xba\xcd\x4e\xb7\xd9\x59\xfe\xc8\x8e\x3b\xae\x80\xc9\x8e\x3b\x92\x1d\x77\x5c\xba\xaf\x1d\x77\x5c\x09\x9e\x1d\x77\xdc\x71\xc7\x45\x9f\x42\xa6\xf7\x08\x74\x2c\x64\xba\x22\xce\x11\xad\x3e\x89\xec\x67\x32\xa1\xc6\x45\xeb\xdb\x21\xce\xce\xa7\x69\x8e\x86\xa8\x1e\xf9\x59\x0a\x86\xc1\x6b\xf6\x6c\xc0\x9c\x24\xcd\x84\x29\xfb\xf8\x81\x3e\x5c\x19\xd8\xb4\x8b\x93\xdc\xc5\x49\x3e\xfb\x38\xc9\x09\xd5\x78\xae\x48\x94\x96\x87\x4d\x46\x17\xf2\x86\xa9\xfc\x13\x8d\x9a\xb4\xe8\xe2\x8e\x1b\xf2\xa0\xaa\x23\xc5\x9d\xa7\xce\x5f\xc0\xd2\xab\xfa\x7e\x9d\xbc\x0c\x9b\xa2\x69\xca\x52\x52\x30\xd5\x47\x14\x91\x64\xc4\x45\xba\x60\xaf\x1e\x3e\x4f\x1a\xfd\x58\xdf\xc7\x13\x86\x40\xd6\x17\xb2\x81\xcd\x35\x36\x1c\xd7\x28\xfc\xb3\x08\x88\xec\x2a\xd5\xf7\x89\x71\x46\xde\x6f\x5b\xca\xf5\xdd\x45\x73\x10\xa8\xbd\x49\x78\x73\xbd\x12\xc4\xf2\x9f\x4a\xa6\x66\x90\x15\x52\x09\xac\x21\xe3\xce\xf9\xc8\xb8\x26\x09\xd5\xc8\x29\xba\xaa\x96\x1d\xd5\xa8\xcd\xf4\x94\xcd\x2d\xd1\xa4\x09\x97\xe6\x54\xa8\x93\x7a\x1d\x1c\x61\xb6\x50\x09\x5f\xe0\x05\xa8\xac\xff\x9d\xd6\xb3\xa9\xe8\xb6\x91\xe0\xb6\x10\x29\x9e\xb1\x72\x4e\x36\x57\xd0\xc9\xc6\x4a\x3a\xd9\x48\x51\x27\x9b\x2a\xeb\xe4\x1e\x0a\x3b\xd9\x4c\x69\x27\x4d\x54\xb0\x27\xe4\xa4\xac\x87\xd1\xdf\xc9\x7d\x54\x54\x72\x0f\x3d\x9e\x34\xb7\x1a\xd0\x54\x3d\x94\x52\x0f\xb8\x5e\xd3\xeb\x1f\x1b\x58\x9b\xe9\xf4\xa4\x09\x2a\xa7\x0c\x73\x50\x68\x3f\x11\x0d\xff\x51\xd4\x6d\x72\x2f\x95\x9b\x6c\xae\x76\x93\xcd\x31\x03\x58\xdd\x2b\x70\xa7\xde\x97\x61\xe2\x2c\xc8\x22\x20\x33\x77\x44\xfe\xc7\x72\x02\x38\x97\x7f\x91\x82\x72\xa5\xad\x7c\xe7\x6c\x26\xf1\x6f\x4e\x3b\x8f\xa7\xc9\x31\xb1\xd8\x92\xea\x3b\x9a\x59\xde\x83\x71\x1c\x4e\x2f\xb2\xb3\x37\xd9\x74\x8f\x4c\x27\x56\xdb\xb4\x54\x2a\x64\x41\xef\xdd\xb2\xd9\x5e\x6f\x0e\x91\xf6\x2e\xc5\x1e\xf2\xa8\x39\xd4\x09\x0c\x4d\x8a\x6c\x46\xf6\xe0\xb7\xbd\x6d\x73\xf6\x0d\x18\x57\x5c\x58\x65\x53\xbe\xb0\x01\x96\x08\x5f\xec\x65\xfb\xc2\x26\x72\x11\x74\x6c\xf8\xb7\xe8\x8a\xc1\x40\xa8\x45\xc4\x5c\x42\xd4\x08\xe0\x18\x7c\x9f\x7a\xe5\xb7\x14\xae\xfe\x85\xcf\x44\x77\x93\x21\x93\x9a\x0f\x69\x72\x07\x2f\x05\xd3\x20\xd8\xb1\x60\x22\x8a\x06\xc3\xb3\x03\x0c\x07\xa9\xb8\x9d\x48\x9b\x01\x22\xd5\x08\x90\x11\x73\x46\x85\x26\x7b\xde\xf6\xb4\xaf\xab\x27\xf6\x06\x55\x76\x5f\x98\xf1\xe0\x7f\xfe\x75\x58\xcb\xe8\xab\x26\x74\x94\x2b\x60\xf3\x90\x19\xda\xcf\xd8\x1d\xcb\x60\x1d\xdc\x21\xe9\x44\x46\xa5\x00\x22\xf5\xf6\x4d\xf3\xec\xc8\x88\x51\x53\x2a\x48\xcc\x66\x82\x0e\xb3\x2e\xd8\xbb\x13\xe6\x77\xc2\xfc\x4e\x98\xdf\x09\xf3\x2b\x3e\x3b\x61\xbe\xc3\x67\x27\xcc\xef\x84\xf9\x55\x2f\xde\x09\xf3\x3b\x61\x7e\xfd\xcb\x37\x13\xe6\x37\x0d\x45\x8a\x45\x6b\xe7\xff\xc3\x0a\x6a\xd4\xf0\xa4\x0a\x53\xf2\x4f\xe1\xbf\xb6\x2b\xd2\xc7\xe2\xfa\x62\x81\x3e\x16\xfa\xe7\xd4\x97\xc1\x1a\xe9\x3d\xc8\xf7\x73\x23\x57\x0a\xf6\xcf\x2b\xda\x6a\x03\xd4\x88\x5c\x16\x1b\xe2\xc6\x8d\x77\xb6\xbb\x82\x83\x43\x56\x79\xe2\x53\x72\xe0\x7d\x3a\x87\x16\xf6\x42\x9a\xfa\x8f\xc2\xf0\x7e\xf5\x44\xf0\xf2\x80\x03\xb3\x96\xd1\x53\x73\x7c\x04\xbf\x7e\xf0\x45\x57\xc7\x69\x29\x08\x53\xb5\x35\x70\xed\xca\x2a\x42\x3c\x86\x2a\x85\xb0\xb3\x4a\xe1\x1d\xd4\x48\x72\xb0\x0e\xa0\x43\x3c\x94\x95\x60\x3d\x20\x30\x55\x50\x8a\x3c\xaa\xd4\x60\xe9\x45\x97\x2c\x20\x85\xf3\xb9\xda\x6f\xbc\x5f\xd9\xe3\x24\xec\x88\x87\xb7\x0f\xc8\x05\xa0\x61\x3c\x31\xd7\x00\x1f\x9a\x65\x72\xda\x5d\x41\x7b\xf8\xc4\xab\x69\xe7\xc4\xab\x86\x87\x70\x97\x77\xf5\x1b\xc9\xbb\x82\x1f\xf1\x0a\x6d\x3d\x01\x8b\x7c\xef\x0a\x1f\x2a\x06\xa0\xca\xcb\xcc\xf0\xa2\x8a\xc6\xd2\xf8\xaa\x0c\x85\xcc\x91\x8b\x6d\xa9\xe3\xa5\x7d\x1b\x4d\x26\x4d\xfc\x84\xf9\x20\x7a\x4b\xc3\xa5\x75\xf1\x23\x34\xcb\x5c\xd6\x92\x97\x48\x31\x48\x86\x3f\x75\xec\xc3\xb9\xab\x15\x1b\x94\x19\x20\x32\x07\x96\x16\x66\xf6\x40\x2d\x55\x5b\x41\x44\x51\x27\xba\x63\x9e\xf3\x8e\xf9\x1d\x13\x15\x25\x3d\xd0\x87\x87\x9e\x85\x6f\x95\xc2\x3f\x08\x85\xfe\x4b\x44\x49\xff\xd6\x86\x46\xc3\x86\x02\x95\xae\xc0\x57\xd1\xe8\xa7\x0c\xf2\xe8\x12\x49\xd0\xcd\xc4\xb0\x41\x04\xc1\x23\x46\x0f\x7c\x3a\xb9\x6b\x4f\x6c\x60\x7c\x8a\xe8\xfd\x67\x6f\x54\xdc\x85\xef\x57\x9f\xfb\x86\xef\x3f\xb8\xe1\xf0\x69\xa3\xf8\x3f\x01\x63\xe1\x53\x46\xf1\xef\x0c\x84\x2b\x0f\xe5\xb9\x05\xd7\xd7\x3f\x1b\x19\x04\x77\xc6\xc0\x8d\xb9\x70\x47\x86\x73\x5f\x23\x60\x47\x8c\xd8\xd0\x93\xbf\xf3\xe2\xff\x6a\xbc\xf8\x3b\xa1\xba\xe5\x67\x27\x54\x2f\x05\xca\x4e\xa8\x26\x3b\xa1\x7a\xdd\xf6\x76\x42\xf5\x4a\xf0\xec\x84\xea\x95\x87\xb2\x13\xaa\x77\x42\x35\xf9\xd4\x84\xea\x4d\x4a\x8d\xed\xbc\xe9\xf7\xf1\xa6\x77\x25\x16\x9d\x48\x44\x47\x34\xe8\xec\x3d\xdf\x79\xce\x9f\x8b\xe7\xbc\x75\xd1\x02\x61\xf8\x7d\x0b\x17\xc4\x67\xb5\xac\x7a\x01\xbd\x93\x3c\x25\x45\x69\x5c\x4e\xf8\xae\x82\xc1\x36\x2a\x18\xd4\x20\xbf\x2b\x63\xd0\xaa\x8c\xc1\x32\x98\xed\x6a\x19\xec\x6a\x19\x6c\xd9\xcd\xbd\xab\x65\xb0\xab\x65\xb0\xab\x65\xe0\x3f\xbb\xf4\x27\xb2\x4b\x7f\x6a\xf5\xd9\xa5\x3f\x2d\xff\xec\xd2\x9f\x9e\xad\xf1\x95\xec\xd2\x9f\x9e\xb7\x21\x96\xec\xd2\x9f\x76\xc6\xd9\x96\x07\xf5\x09\xa6\x3f\xed\x6a\x19\xfc\x86\xa3\x20\xc8\x4e\x98\xdf\x09\xf3\x3b\x61\x7e\x27\xcc\xaf\xfe\xec\x84\xf9\x0e\x9f\x9d\x30\xbf\x13\xe6\x57\xbd\x78\x27\xcc\xef\x84\xf9\xf5\x2f\xdf\xd5\x32\xf8\x74\xa2\x2f\xc8\xae\x96\xc1\x2e\x22\x63\x57\xcb\xe0\xb7\x5b\xcb\xa0\x16\x1d\xf0\x74\x05\x0d\xba\x2f\x63\x57\xd5\x60\x57\xd5\x60\x57\xd5\x60\x57\xd5\xc0\x7f\x76\x55\x0d\xf0\xf3\x9c\x4c\x8d\xbb\x04\xac\xa5\x40\xd9\x25\x60\x91\x5d\x02\xd6\xba\xed\x7d\x02\x66\xc3\x5d\x02\xd6\x33\x34\x15\xee\x12\xb0\x76\x66\xc1\xe6\xe1\x7c\x22\x09\x58\xbb\xaa\x06\xbf\x51\x7f\xfe\x4e\xa8\x6e\xf9\xd9\x09\xd5\x4b\x81\xb2\x13\xaa\xc9\x4e\xa8\x5e\xb7\xbd\x9d\x50\xbd\x12\x3c\x3b\xa1\x7a\xe5\xa1\xec\x84\xea\x9d\x50\x4d\x3e\x35\xa1\x7a\x57\xd5\x60\x57\xd5\x60\x57\xd5\xe0\x13\xf4\xa1\xaf\x3d\x69\x47\xe1\x98\xba\x66\x49\xa9\xb8\x99\x9d\x49\x61\xd8\xc7\xa5\x3e\xf4\xda\xd1\x9e\x2d\x19\x0c\xf9\xed\x96\x51\xda\xbb\xe7\x73\xb9\xdd\x23\xf0\x46\xfb\x8c\x83\x80\x62\x63\xae\x8d\xb2\xca\xa6\xb2\x1a\x77\x58\x50\xcf\x41\x2b\x65\x23\x5a\x66\x46\xa3\x38\x35\x55\xdc\x58\xa5\x90\x28\x29\x0d\x19\xf1\x8c\xa1\xbb\x75\x40\xae\x14\xbf\xe3\x19\xb3\x2f\x0d\x73\xe8\x1e\x29\xfc\xd7\x84\xe9\x84\xa2\x74\xe6\x73\xce\xed\xa3\xb4\xa0\x43\x9e\x71\x03\xd4\xc9\x79\xa6\xd7\x80\xb8\x05\xe9\x6e\x23\x7a\xc3\x5b\xc2\xaa\x2f\xc2\xea\x5a\x57\x95\xd8\x3f\x5d\x32\x03\x00\x40\xc9\x4c\x5b\x5c\x87\x5a\x10\xd4\xae\x28\x61\x5a\x93\x84\x0a\x32\xa6\x5c\x60\xa9\x82\x00\x1d\xc0\x57\x01\x0e\xe8\x82\x2a\xcb\x55\xdd\x00\x27\x1c\x0d\xa5\xcc\x48\xca\x15\x4b\x4c\x36\xab\xe6\x0f\xd9\xf9\x7f\x17\x6c\xfa\x77\x3b\x9b\x26\xa3\x8c\x8e\xd1\x79\x3f\x74\xc6\x13\xe1\xe2\x27\xdc\xa9\x54\x53\x2f\xdd\x80\x55\x1f\x54\xc9\x08\xcd\xa6\x74\xa6\xd1\x6a\x50\x9f\x83\xeb\x13\x72\x7c\x68\xef\x8b\xbd\xb4\xd1\xe1\x7f\x7e\x08\x75\x02\xce\x4e\xaf\xfe\x7e\xfd\x5f\xd7\x7f\x3f\x3d\x7f\x7d\xf9\x66\x7f\x05\x48\xf1\x34\xed\xfe\x18\x15\x4b\x9f\x8b\xf1\xa4\xf5\x01\x59\x96\x55\x43\x30\x8b\xc2\x69\x7a\x94\x2a\x59\xe0\x9e\x3c\xb1\xa9\x30\x76\x40\xce\x23\x84\xb7\x7b\x76\x17\xc0\x6b\x12\xb5\x09\xc7\x8a\x8a\x60\x3b\x8a\xc1\xa3\x4a\x61\x78\xce\x1e\xb1\xf8\x07\x4d\xd7\x6a\xc1\x75\xa3\xc0\xdc\xfd\x7b\xd2\x30\x83\x33\xbf\x90\x59\xa5\xd7\x91\xab\xb7\xd7\x97\xff\x7f\xe3\x08\x67\x05\x6b\xe9\x33\x6f\xc1\x60\x2d\x26\x74\x82\xda\x3b\x96\xcb\xbb\xdf\x36\xdc\x02\xcd\x5a\x89\x6f\x75\xa8\x95\x22\x26\x1d\x22\x9a\x83\xe4\x10\x6f\x73\x85\x24\xc9\x2a\xae\xb5\x5f\xab\x8b\x09\xdc\xc1\x3e\x22\x0c\xc7\xf8\x9d\x9a\x3e\x02\xfc\xc8\x51\xba\x89\xd4\xa6\x7e\x8f\x47\x34\xd3\x2d\x2e\xe3\x3a\x2a\x64\x09\xe7\x6b\x59\x8a\x95\x21\x6e\xb5\x9d\x87\x11\x24\x65\x42\x7a\x3b\x99\x7d\x1b\xc4\x82\x28\x99\x90\x1c\x7e\x36\x92\x94\x9a\x41\x55\x99\x1a\x25\x71\x46\x47\x4f\x84\xb8\xf6\xfb\xba\x0a\x33\x23\x9f\x2e\x75\x28\xc5\xd2\x20\x42\x15\x0b\x1f\x81\xd5\x84\xa6\xa0\x4e\x15\xd4\x4c\xd0\xd4\x91\x53\x7d\x6b\x65\x20\xfb\x85\xe3\x37\x4e\xbd\xc2\x19\xc3\xab\x6e\xec\xba\xbd\x81\x18\xf8\x0c\xc6\x6f\xb5\x30\x14\xb7\x42\x2d\xbb\xb6\xb7\x22\x9b\xbd\x93\xd2\xbc\x0c\xb2\x45\x6b\x60\x7f\xef\xb8\x2d\xc6\x84\x04\x30\x58\x76\x44\x61\xee\x3e\x6c\x7c\x4e\x74\x39\xaf\x80\xbb\x25\x44\x51\xa5\x38\xd5\xdf\x28\x59\xae\xa4\x2e\x73\xcc\xea\x9b\xcb\x73\xc0\xe5\x12\xf1\x98\x09\xa3\x66\x18\x0f\xe7\xa4\xb5\x05\x3c\xfc\xbd\x3d\xf7\xc6\x49\x5b\xb9\xa0\x14\x9a\x99\x01\x79\x4d\x67\x84\x66\x5a\x7a\x61\x80\x83\xf9\xbf\x21\x30\x0e\x08\xb9\x1c\xf9\x9f\x87\xd2\x4c\x48\x53\xa2\xb4\x68\x32\x3f\x2e\xb2\xec\x44\xc2\x3e\x17\x73\xc3\x0d\xbd\x65\x9a\x14\x8a\x25\x2c\x65\x22\x69\x01\xe1\xf5\x01\x99\x51\x18\xe6\x97\x7f\x5c\x7d\x12\x6f\xa4\xb0\x28\xd5\xfa\x2c\x2e\x45\xca\x13\x67\xd5\x76\xfa\x70\x05\x79\xb0\x5d\x39\xb9\x87\x82\x41\x0c\x10\xaa\xd4\x4c\xa1\x51\x4c\x95\x2e\x8a\xf2\xdb\x72\xc8\x32\x66\x50\x18\xbb\xa3\x19\x4f\xa9\xc1\x32\x47\x3c\xa7\x63\x46\xa8\x09\x07\x67\x24\x61\x42\x97\xca\x17\xa8\x32\x24\x95\x0c\x15\x0e\xf7\xaa\xf7\x97\xe7\xe4\x05\x39\xb0\xef\x3a\x84\xe3\x18\x51\x9e\x41\xf1\x2d\x43\x55\x73\x8d\x7c\xe4\xa7\x80\x25\x01\x2e\x58\x05\x0f\xd0\xbb\x47\x84\x24\xba\x4c\x26\x7e\x4d\x56\xe2\xf3\x02\x63\xc1\x94\x05\x2c\x4b\x3f\x5d\xd4\x69\x75\x39\xdf\x6b\xb6\xd2\x0c\x3d\x77\x37\xdf\x6f\x70\x37\x63\x16\x64\xf1\xa3\xbe\x53\x44\x82\x9c\x19\x9a\x52\x43\xdd\x9d\xf5\x0f\x7c\xba\xe0\xdf\xd6\xcd\xd5\xec\x15\x17\xe5\xc7\xb7\x70\x06\xdd\x84\xfe\xeb\x0b\x18\x1a\x74\x5d\xe4\x52\x0d\xab\x55\x38\x2f\x7f\x45\x3c\x18\x7a\x4b\xd8\x28\x5c\x11\xab\x35\x5a\xd2\x60\x39\x0a\x15\xa9\xcc\xe7\x5e\x16\x0a\xc3\x45\x2f\xf8\x64\x0f\x73\x6b\xea\x09\xb8\xa4\x3b\x89\xda\xaf\xc0\x89\xcd\x75\x00\x30\x7a\xb5\xd1\xf4\x03\x54\xb2\x32\x4f\x36\x0e\xb4\x95\x44\xde\x42\x3d\x50\x32\x63\xdd\xd4\x03\x99\x31\x34\xbb\xfb\x45\xdb\x29\x1e\x75\xcd\xf0\x60\x97\x35\x83\x50\x57\x5b\x33\x88\xa7\x8f\xb9\xe6\x72\x0d\x31\x9e\x5b\xb3\xa5\xde\xf5\x35\x03\x79\x7d\xac\x35\x6b\x96\x24\x32\x2f\xae\x94\xb4\x62\x64\x27\xd2\xe4\x86\x12\x89\x64\xcd\xcb\xfd\x3e\xf8\xa4\x4e\x94\x9a\x0f\x53\x30\x19\xc9\x3b\x6e\x55\x77\x6a\x90\x46\xf8\x0c\x86\xff\x2f\x22\x59\x70\x55\x9a\x74\xcc\xcf\x22\xef\x98\x52\x3c\x65\x61\xa4\xfb\x61\x0b\xd4\xa0\x9d\x3f\xbe\xbf\x4e\x1b\x6d\x4d\x54\x64\x42\x33\xab\xed\xb5\x38\x09\xd2\x3c\x8d\xe6\x60\xc2\x83\xd4\x07\xe6\x3a\xf8\xce\x1b\xa6\xb9\x20\x14\x74\x06\xaf\x63\x82\x49\xb9\x32\xa9\x97\xda\xb2\x6c\x7b\xc0\x7e\xa4\x77\x71\x5a\x6a\x2b\xc5\x88\x8f\x4b\x05\xe9\x33\xd5\x68\x23\xc9\x54\xaa\xdb\x01\x79\xed\x1e\xa5\xb0\x40\xe6\x12\x9c\xa8\x99\xf4\xe6\xd2\x27\x6e\x51\xa4\xdc\x07\x4c\xf1\x93\x7a\x34\xf1\xaf\x06\x0e\xc5\xa5\x70\x33\x83\xd2\xe3\xf9\xce\x08\x6f\x37\xd7\x64\xef\x95\x07\xc0\xde\xd3\x11\x9f\x3d\x5c\x4d\x80\x3c\xea\xb2\xb7\x5c\x40\xda\x44\x73\x63\x5e\x48\x75\x9c\xbc\x72\xe7\x87\xeb\x71\x42\x3e\x08\x12\x36\x46\xfa\x1b\x1f\xe5\x3b\x64\xfa\x5e\x35\xec\xaf\x56\xad\xc3\x4b\x9a\xd3\xbc\x17\x70\x4e\xf6\xbd\x7d\x2b\x76\xcf\x3f\xe7\xf7\xb2\xce\x2f\xd5\xea\x0c\xa6\x5c\xa4\x72\xaa\x37\x91\x99\xbe\xc7\xa1\x5e\x80\x48\x2c\xba\x18\x2e\xc6\x35\x6f\x1f\xcd\xb2\x9a\x69\x62\x91\xe0\xe4\x4f\x03\x32\xaa\xc0\x7c\x30\x27\xb0\x84\x83\x44\x20\xfd\x6a\x85\x9e\x71\xae\xe9\x99\xb2\xaf\x35\x9c\x66\xd7\x05\x4b\x3a\x5d\x8e\x6f\x5e\x5f\x9f\xd6\x87\xdb\x7b\x8b\xfe\x33\xbb\x79\xfb\x3b\xa1\x69\xce\xc1\xe9\x4e\xa6\x6c\x38\x91\xf2\x96\x1c\x4c\x8c\x29\xf4\xc9\xd1\xd1\x98\x9b\x49\x39\x1c\x24\x32\x3f\xb2\x74\x43\x09\x66\x98\xee\x6b\x3e\xd6\x47\x0e\x51\xfa\x76\x85\x87\x84\x8b\x0c\x72\xbc\x3c\x8e\x57\x65\x82\xdd\x4b\x92\xb0\x0a\x80\x35\xf8\xf3\x82\xd5\x7b\x7e\x99\x6f\x68\xce\x5c\x6c\xe4\xb6\x28\xcb\x3c\x28\xed\x4b\xee\x09\x4e\x58\xa7\x0b\x95\xb2\x5b\x5a\xb9\x67\xe4\xd4\x5b\xdb\x90\xe3\x3c\x56\x51\xec\xb4\x8b\xff\xac\xc6\x91\x94\x19\xa6\x72\x38\x3a\x3e\x22\x34\xa2\x4f\x15\x7d\x09\x96\x8a\x7d\x3b\xd2\x1b\x5a\xf7\x63\x49\xa3\x1e\x41\x4a\xb3\x62\x12\x87\x90\xc2\x5d\xf5\x3c\xc4\xc7\x91\x0e\x67\x50\x76\x5a\x0a\x40\x15\x90\xb8\xd0\x08\x08\xf0\x73\xb4\x24\x5a\xea\x59\x65\xc7\x8d\xed\x87\x03\x72\xcd\xa2\x0a\xeb\xb8\x86\x29\x37\x13\x59\xa2\x49\xa3\x66\x6c\x84\x95\x28\xa6\xc1\xc2\x25\x08\x53\x4a\x2a\xe7\x9c\xf2\x96\x0c\xe7\x8e\xbe\x92\x29\x78\xb7\xc0\x01\x6c\xff\xda\xd7\xb1\x29\x19\x78\xf3\x84\xde\xe1\x6a\xa1\x98\x3b\x1b\x8d\x58\x02\x9c\x36\x06\x30\x92\x97\x03\x6e\x1a\xde\x5f\x8b\x0c\x30\x9e\x92\x9c\x7f\xb4\x6f\x89\x47\xc5\x46\x6b\x91\x82\x89\x68\xf1\xcf\x87\x56\xe3\x13\x21\xac\xa4\x67\x4f\x31\x7e\xd2\xbb\xe1\x8c\xdd\xa2\xfd\xe1\x0d\x33\x56\x64\xc0\x0d\xc4\xfa\xa4\x15\x0f\x54\xd9\x12\x3b\xd7\x19\x48\x48\x6c\x24\xe9\x7c\xcd\xc0\x58\xe2\x06\xda\x63\xf2\x8c\xe5\x3e\xc6\x13\x88\x32\x79\x20\x03\xca\xf3\xe1\x3e\xa4\x0d\xfd\xb0\xd2\xca\xba\x20\xfb\xda\x79\xbc\x89\x06\x34\xa2\x7d\x5d\x1d\x77\x27\x7e\x02\xaa\x79\x07\x84\xd5\x0b\xa0\x58\xb9\xa9\x22\x31\xec\x6d\x9d\x1f\x09\x01\x17\xee\x89\x7d\x8d\x3a\x98\x6e\xce\xd3\xc8\x21\x47\x8a\x01\x73\x6e\xee\xe4\xef\x16\x8d\xb5\x16\xb0\x85\x4c\xcf\xb9\x56\x25\x80\xed\xeb\x32\x1d\xb3\x76\x51\x18\x57\xf3\xe3\xe0\x22\x47\x62\x51\xc6\x73\x6e\x90\xcd\xdc\xc9\xac\x14\x86\xaa\x19\x49\xc3\x20\xbd\x2c\x18\x43\xb1\x22\xe3\x09\xd5\x03\xf2\xd6\xd2\x5f\x2b\x2d\x21\xb9\x7b\xe7\x7e\xb0\xe7\xa9\xd9\xd2\xf8\xd4\x2d\x45\x4a\xe4\xf4\xe3\x7b\x41\xef\x28\xcf\x2c\x85\x6f\x2d\x55\xbe\xae\x0d\x0b\x7c\xb6\xcc\x87\x56\x19\x55\xa4\x60\x2a\x61\xc2\xd8\x3b\x0c\x69\xbd\x6e\x4b\xd8\x4a\x81\x02\xbb\x29\xa3\xf1\x2e\x1a\x14\xd2\xf6\xd7\xa9\xab\x54\xcc\xde\x8e\xda\x28\xa1\xed\x2a\x3f\xf4\xdb\xb2\xf5\x8f\xfd\x48\xd0\xe2\xc2\xf4\xa5\xea\xe3\xa0\x13\xb8\x5e\xcb\x41\xcc\xc5\x69\x77\x00\x47\x83\x3a\x82\x17\x7c\x18\x2c\xa7\x56\x19\xfa\xf5\x43\xb8\x50\x5c\x02\x51\xce\xa8\xd6\xab\x18\x5a\xb3\xc4\x46\x74\x87\x79\xe4\x13\x62\x2e\xa8\xd0\xcf\x0b\x4c\xc4\x8a\x07\x0d\x55\xc8\x12\x3f\xff\x4c\xd0\x79\xbc\xca\x28\x15\xf9\x99\x29\xe9\x62\x78\x30\xe3\x46\x48\xff\xf3\xea\x2b\xbd\x02\x4c\xfe\xa0\x5b\x6d\x31\xa6\x23\x31\xf6\x8c\xe6\x48\x11\x04\xc8\xd9\x7f\x87\x72\x2c\xd4\xd0\x4c\x8e\x5d\xa9\x0c\xcd\x1c\x4b\x9c\x23\x61\xa5\x55\xcc\x2d\xd7\x39\x67\x45\x26\x67\x98\x73\x22\xb4\x61\x34\x45\xe9\xcc\x05\x21\x17\x32\xed\x11\x2d\x23\x8f\x1b\xbe\x80\x68\x43\x67\x3a\xc2\xd2\xe9\x04\x8c\x35\xc6\xf5\x22\x81\xb0\x00\x8b\xb1\x96\xb1\x58\x92\x19\xf8\x4c\x8f\xb8\x7a\x21\x80\xd5\xa0\xe5\xa7\x8a\xf2\xe5\x78\xdd\x06\x5d\xdb\x14\x7f\xc9\xb9\xe0\x79\x99\x9f\x90\xe3\x25\x27\xe4\x0a\x18\xb4\x3c\x22\xf7\x74\xb0\xdb\x79\x3d\x0d\xbb\xbe\xa8\xea\xf7\x35\x11\x7d\x75\x91\xca\x75\x9e\xb1\xa0\x39\x7e\x91\x93\xb3\xab\xf7\x20\xea\xfc\xaf\x17\xaf\x39\xc9\x59\x2e\xd5\xac\x17\x24\x71\xe4\x5f\x0f\xcc\x65\xf0\x25\xed\xa3\xef\x5e\x21\x53\x4d\x43\x6f\x27\x8c\x5b\xff\x68\x41\x4f\x28\x46\x55\xc8\xd1\x02\x38\xf9\xf0\x42\xf2\x5a\x42\x29\x96\x91\x3c\x21\x5e\x65\xae\x88\xcb\x80\xcb\xa3\x54\x26\xfa\x28\x91\x22\x61\x85\x81\x7f\x80\xc5\x0d\x6c\x6b\x47\x39\x15\x74\xcc\xfa\x61\xda\x7e\x25\xd6\x1f\xad\x8f\x77\x5b\x6b\x62\xe8\x1e\x6a\x5e\x50\x63\x98\x12\x27\xe4\xff\x1e\x7c\xf8\xc3\x2f\xfd\xc3\xaf\x0e\x0e\x7e\x78\xd1\xff\xf7\x1f\xff\x70\xf0\x61\x00\xff\xf8\xec\xf0\xab\xc3\x5f\xfc\x1f\x7f\x38\x3c\x3c\x38\xf8\xe1\xdb\xd7\xdf\xdc\x5c\x5d\xfc\xc8\x0f\x7f\xf9\x41\x94\xf9\x2d\xfe\xf5\xcb\xc1\x0f\xec\xe2\xc7\x96\x93\x1c\x1e\x7e\xf5\x6f\xab\xe3\xd2\xd6\x33\x8a\x6e\xac\xa2\x03\xb3\xb8\x07\x43\x76\xd7\xa3\x03\x3e\xbe\x73\x23\x9a\x18\x89\xc4\x60\x25\x46\xaa\xd0\xac\xe6\x72\x44\xc2\x3c\x5c\x13\x99\x73\x63\x09\x9b\x95\xa6\x69\x1c\x9b\xcb\x4d\x2d\x30\xd7\xdd\x05\x60\x25\xd8\xdf\x8a\x7d\xb4\x94\x9d\x9b\x6c\x16\x33\x25\xe8\x4c\x36\xe5\x58\x78\x89\x5a\x0d\xaa\xc0\x24\x0e\xc0\xe9\xbe\x37\x91\x82\x5e\xb3\xbb\x1f\xbb\xfb\xb1\xf8\x7e\xe8\x0d\x62\xd5\x9b\x7a\xf1\x44\x66\x69\x10\xa5\x9c\xb5\x29\xc4\xa8\x53\x63\x14\x1f\x96\xa6\x62\x6a\x41\x18\x00\x64\xda\xd7\x28\x2e\x00\x7f\xb7\x88\xdf\x74\x14\x38\xe5\x13\x4c\x06\xdc\x78\x21\xe4\x81\x19\xd8\xa8\x63\xc4\xd7\xde\x29\x5e\x4d\x9a\x11\x5d\x16\xfe\x22\x66\x64\x6c\x67\x99\xf3\x62\xd6\x4d\xef\xe8\xc5\x28\x64\x3a\x20\xd7\x32\x47\xb5\x32\xc7\xc0\x42\xc7\xe0\x6a\x11\x48\x46\x92\x64\x02\x95\xf1\xc0\x42\x3f\xb5\x53\x4c\x78\x81\xd0\xa5\x26\x0c\x07\x45\xdd\xfe\x1c\x8c\xbb\x85\x4c\xc1\xa7\x72\x8c\xde\x2d\x39\x85\x78\xe9\x6f\x2e\xcf\x83\x3c\x6b\x9f\x7a\x79\x0d\x5b\x27\x9f\x0f\x9c\x93\xd3\x8c\x79\x4a\x86\x68\x37\xd3\xcc\x90\x03\xc1\xa6\x18\x73\x47\x12\x68\xab\x17\xb2\xe9\xdd\xab\xfd\x6c\xe1\xe5\x6e\xca\x43\xf2\x85\xf3\xab\x31\xe5\x0d\xdd\x43\xee\x72\xbe\xde\xbe\xdb\x77\x95\x02\xd5\xb4\xaf\xa6\xfd\x7e\xbf\x6f\xd7\xea\x23\x9e\x16\x44\x61\x41\xe1\x3b\x99\x72\xd7\x90\xb0\x82\x84\x95\x7d\xaa\x57\x68\x9f\x39\x82\xab\x5b\xe9\x9c\xd9\x6e\xd4\x8b\x43\xa2\x33\x38\xad\x2b\x99\xf1\xa4\x7d\x9b\xcb\xfd\x05\x83\x9d\xdb\x4b\x93\x21\x9b\xd0\x3b\x2e\x41\xb8\x07\x5c\x80\x24\x9b\x25\xfb\xf7\xd7\xce\x1d\xce\x90\x8d\x2c\x2b\x18\x32\x50\x0f\x3f\x16\x52\xc3\xf9\x69\x9e\x3a\x53\xeb\x4d\x6c\xbd\xf5\xe6\x62\x8b\xbc\x50\x74\xaf\x86\x9c\x68\x39\xb2\x08\x2f\x95\xf1\xdb\x25\x43\x6a\xa7\x0c\xcb\x39\x68\x1c\xc7\xe1\x80\x5c\xba\x13\x04\xb3\xab\x90\xce\x60\x4b\xa4\x20\xac\x98\xb0\x9c\x29\x9a\xd5\x5f\x04\x11\x6e\x54\x9f\x58\xe2\xa0\x2c\x32\x20\x7f\xca\x69\x81\x66\x59\x48\x2a\x4b\xb9\xf2\x5e\xc4\x90\x79\xc8\xc8\xde\x5b\x88\x17\x7c\xcd\x35\x18\xb7\xf6\xe0\xf9\xbd\x53\x48\x86\xd8\x5b\xa4\xec\xf9\xdf\x2c\xba\x83\x4f\x6b\x3d\x87\x5b\x17\x0a\xfb\x9c\x23\x48\x9f\x3a\x6e\xc9\x59\x17\xab\x52\x05\xab\xe3\x3a\x76\x11\xa5\xcf\x37\xa2\xf4\xa9\x51\xe9\xb7\x1c\x4e\xfa\xd4\xb0\x7f\xd2\x6b\xfc\x90\xe1\xa5\x6d\xc2\x24\x1e\x3c\xbe\xf4\x93\x3c\xdd\x5d\xbc\xe9\x2e\xde\x74\x17\x6f\xda\xfc\x3c\x50\xbc\x29\x6b\x28\x94\xbe\x5c\xc2\x2e\x10\x74\x17\x08\xba\xf8\xb3\x0b\x04\xfd\x44\x02\x41\x63\x83\x12\x68\x91\xed\x25\x9c\xd3\x50\x1c\x06\x0c\x51\x73\xd5\x5e\x46\x5c\xe9\x50\x9e\x00\x04\x58\x2e\x1a\x02\x09\x54\xe6\xf6\x16\xda\x39\x92\x88\x8e\xd3\x9c\xaa\x99\x55\x57\x51\x2e\xa9\x89\x47\x42\xfa\x57\x87\x73\x86\x64\x75\xb0\x59\xcf\xba\x88\x13\xeb\xb2\xae\x5b\x64\x5c\xb7\x37\xbc\xb6\x94\x3b\x67\x3a\x31\xab\xcb\x3b\xd5\x0d\xa7\xf8\x3c\x18\x4c\xa3\x32\x85\xa1\xa2\x4a\xea\x67\xc4\xf0\x90\x28\xee\x66\x60\xa5\x39\x8d\xf6\xb1\x52\x38\x8b\x4b\xf4\xfc\xc1\xb2\xaa\x05\x87\x24\x87\x76\x1c\x5e\x1f\xcc\x68\x29\x56\xd7\xdb\xda\x1a\xb4\x17\x6c\x3d\x58\xb0\x28\xb9\x65\x4a\xb0\x8c\x14\x54\xd1\x9c\x19\xa6\x7c\x64\x11\x5b\x5d\xc2\xa9\x75\xad\xa7\xb6\xe5\x04\xfb\x00\xfe\xb5\x0f\x81\xd0\xbc\xda\x27\xd1\xba\x8c\x94\x68\x11\x86\xd7\x0c\xfc\x72\x11\xad\xd4\xbf\x06\x8c\x71\xeb\x80\x45\xba\x15\x28\x82\x3d\x76\x5c\xd8\x77\xbe\x94\xcf\x03\xad\xec\x39\x45\xc1\xdb\xdb\x07\x8c\x26\x26\x7f\xbb\x70\xf8\x5d\x38\xfc\x0a\x80\xef\xc2\xe1\xdb\xef\x62\x17\x0e\xbf\x0b\x87\xdf\x85\xc3\xff\x76\xc3\xe1\x8d\xcc\x18\x46\x9c\xb4\x0b\xa7\xbb\xa9\x9e\x07\x57\xdf\xb2\xe0\x82\x7d\x1d\x4f\xbd\x3a\x72\x60\x95\xdc\xbb\x46\xe6\x9d\x43\x15\x88\x97\xb7\x97\xbf\x5a\x28\x50\x21\x63\x68\x32\x71\x4a\x18\xfe\x62\x25\x62\x31\x23\x16\x4f\x8c\x0f\x9a\xf6\xa5\x0f\x19\x31\x0a\xfa\x95\xfd\x25\x94\x76\xed\xe1\x95\xfe\x1b\x29\xb5\x27\x0b\xa1\x82\x63\x28\xc7\xfa\x17\xff\xaf\xbf\x2d\x3f\x98\x56\x42\x41\x3b\x91\x00\x97\xd4\x41\x23\xb8\x40\xa7\x6f\x3d\x16\x17\x21\xe0\xfc\xc1\x46\xe2\xb6\x7c\x05\x47\xac\x04\xea\x72\x14\xb2\xac\xf6\xb0\xf6\xc1\xab\x95\xe8\xe6\x09\x5a\xe4\x0c\x7e\x23\x5d\xe3\x3e\xd6\x23\x57\x8a\x8d\x98\xaa\xbe\x81\xeb\xf0\x46\x62\x0b\xbf\x35\xc8\xdc\x92\x15\xae\xad\xfe\x5d\x03\xc8\xb7\x55\xad\x6f\xdc\x59\xad\xd6\x77\x85\xc1\xb5\x52\xae\xab\x20\x73\xcb\x66\x55\x7d\x68\x57\x49\x1c\x3c\xe5\xbd\x0a\x4b\xbc\x45\x0b\xcb\x3d\xff\x87\xcf\x5c\xcf\x87\x5c\xe0\xcb\x70\x6a\x7f\x14\x30\xbb\x07\xa8\x48\xe1\x4f\x78\xcd\x36\xc0\xd5\xae\xa4\x78\x0d\x66\x6f\x3b\x14\x10\x0f\x64\x6d\x71\xe9\xf0\xa8\x5e\xf8\xc5\x4f\x25\xcd\xea\x64\xda\x7d\xe5\x1e\x9a\x2b\xa1\x3c\xe5\x59\x9a\x50\x85\x3a\x3b\xde\xd1\x10\x00\x8d\x9d\xe3\x12\x2a\xc2\x6d\xaf\xce\x48\x3b\xd5\x89\x2a\xc3\x93\x32\xa3\xca\x12\x30\x36\x96\x6a\xb6\x15\x88\x56\x48\x73\xcd\x12\x29\xd2\x2e\x1a\xfb\x4d\x73\x6c\x0c\x63\x83\x51\x3c\xdc\xb5\xc4\x03\x27\x75\x1d\x49\x0f\xea\x09\x49\x72\xe4\x6f\x75\xb8\x62\xb5\x10\xc6\x9a\x9c\xc6\xb1\xa7\xe6\x61\x44\x1e\xc3\xad\x18\x90\xaf\x67\xde\x42\x07\xb1\x93\x4e\x7c\x81\x18\x21\xf7\x4e\x87\xb2\x0e\xd8\xd5\x85\x1a\x49\xc5\xee\x98\x22\x07\xa9\x84\x31\x10\x6b\x7e\x38\x20\xff\xcd\x94\x44\x79\x86\x8d\xd1\x32\xeb\x50\x3c\xc4\x45\xb9\x38\x27\xaa\xc9\x0b\x72\x80\xdd\x26\x79\x9e\xb3\x94\x53\xc3\xb2\xd9\xa1\xd7\x17\x5c\x15\xb2\x47\x35\x42\xb5\xd2\xdb\x17\xe8\xec\x35\x52\x83\xec\xbe\x71\x84\x81\x07\xc9\x15\x15\xe2\x6b\xe2\x42\x10\xcd\x3d\x99\x09\x07\xfc\x4f\x10\xeb\x88\x62\x63\xc0\x72\xc4\xdc\x7b\xe2\xb8\xaf\xe8\x7b\x5d\x28\x46\xd3\x33\x29\xb4\x51\x70\xa9\x5a\x4a\x0f\x4b\x46\x47\x41\xbf\x13\x17\x7b\x17\x32\x6e\x96\xc4\xe5\x5b\xfa\xa1\x61\x1e\x42\x13\x25\xb5\xd3\x37\xb3\x52\x9b\x66\xac\x3e\x3e\xe6\xd9\x76\x98\xd8\x0d\xc3\xe6\xa3\x20\xf7\x43\x51\x59\xa8\x24\x2b\x45\x78\x90\xb8\x7a\x7e\x4c\x1b\x2b\x07\xde\x27\x95\x6c\x6b\x92\xce\x12\x38\x46\xa5\xca\x01\x8c\x7e\xeb\x95\xa8\x82\x39\x1f\xb9\x74\xb0\xc0\x76\xa2\xfe\x50\xef\x29\xb4\xb4\x31\xff\xf5\x49\x4e\x3f\x5e\xdf\xb2\xe9\xca\x67\xda\x75\xd6\xec\xc3\x09\xbc\x17\xae\xd3\xab\xd5\x17\xef\x29\x50\x75\xe8\xbb\x59\xf7\x7d\xd7\xcb\x77\x3b\x5b\x32\xe4\x7f\x8a\x06\xf0\x9d\x55\xb9\x12\x38\x91\x0e\x37\x3a\xcc\x50\x28\xf1\x5d\x42\x71\x5c\x23\x2b\xcd\xbc\x91\x65\x84\x65\xb7\x41\x0b\xe2\x6a\xae\x7f\x2e\xc2\x90\xa4\x32\xa7\x5c\xb4\xb9\xf7\x2d\x4c\xbc\xed\x2d\xae\xdd\xbb\x0d\x3d\x87\x2e\x43\x5d\x0a\xd7\xb7\x2e\x5a\xff\xa9\x74\x13\xea\xd8\xd4\xa1\x5b\xf7\xa0\xf6\x5d\x83\x3a\x75\x0b\xea\xda\x25\xa8\x43\x77\xa0\xc7\xe9\x0a\xd4\xb9\xcd\x49\xd7\x2e\x40\x9f\x4a\xf7\x9f\xce\x80\xe8\xd6\xed\x67\xd7\xe5\x67\x3d\xf0\xbb\x74\xf7\xe9\xd8\xd5\xa7\xc3\xe9\x76\xea\xe2\xb3\xeb\xde\xd3\x99\xbb\xb5\x24\xf0\x9b\x76\xeb\x69\x79\xd2\x4e\x0e\xec\x20\x65\xed\xbf\xc6\x21\x8d\x0c\xc1\x94\x8d\x15\xc3\x28\x20\x50\x45\x5d\x77\x15\x57\x00\x81\xdd\x31\x0b\xbe\xd4\xea\x0e\x90\x13\x95\x3a\xab\xd5\x3f\xe6\x84\xc7\xbf\x9e\xcb\x37\xd2\x78\xdb\xd4\x3f\xbc\xa6\x1b\xe7\xc5\x42\x4e\x05\xa4\x14\xa6\x7c\x34\x62\x0a\x02\x35\x87\xcc\x4c\x99\xeb\xf0\x50\xc9\x66\x75\x91\xdb\x61\x96\xa1\x6a\x0c\xf6\x75\x27\x9b\xf9\x83\x1f\x67\x72\x48\x33\x9f\xec\x38\x20\x2f\xa5\x22\xec\x23\xcd\x8b\x8c\x61\x30\x08\xf9\xa2\xff\xb3\xd5\x49\x9c\x7e\xd3\x23\x1e\x16\x2e\x41\xc8\x48\x72\x8c\x78\x54\x84\xa0\x85\xe0\x8d\xa8\x77\x97\xf1\x4a\x93\x26\xc7\x47\xc7\x47\x2f\x4e\xc8\x2f\xc4\x4e\x7d\xec\xfe\xfb\xb9\xfb\xef\x17\xe4\x17\xf2\x0b\x21\xe4\x8a\x90\xda\x7f\x09\xfc\xb7\x4f\xf8\x28\x5e\xc3\xb1\x5d\x66\x22\x73\xb7\x61\x30\xbd\x84\x78\xa9\x50\xb9\xc4\x48\x37\x35\x04\x1e\x24\x32\x67\xb0\x86\xe3\xff\xf0\xcf\xd8\xe1\x50\x32\xc5\x3d\x79\x7c\x00\x4b\x3a\x24\x53\xd0\x72\x73\x7a\x8b\x22\xf0\x69\x62\x4a\x9a\xd9\x97\x1f\x7c\xde\x7f\x71\x48\xa4\xa8\x3f\x7e\xc7\xa5\x15\x84\xfc\x0a\x0f\x8e\x0f\x07\x73\x4b\xfe\x7c\xc1\x92\x1b\x75\x56\x5c\xc4\x8c\x9d\x74\x39\xd6\x78\x84\x39\x15\xb3\x29\x9d\x05\xb4\xf1\x2a\x80\x55\xb1\xc8\x84\x8f\x27\xe0\x8d\x08\xd1\xbd\x60\x88\x06\x2c\xe0\x3e\xab\x01\x27\x9d\x11\x6e\x06\xe4\xd2\xec\xef\x63\xd9\x75\x94\xb1\x7c\xab\x54\x1f\x47\x75\xe7\xed\x09\xc7\x70\xe8\x2f\x9a\xed\x75\x56\x25\xd0\x6c\x68\x0f\x59\x9a\xaf\x4f\xba\x34\x59\x5a\xa8\xc7\x46\x66\x58\x4b\x34\xe5\x08\x63\xc9\xb0\x16\xce\x00\x4a\xf0\x38\x18\x39\x37\x59\xd4\xc0\x08\xd4\x27\x2f\xeb\x72\xf0\xb1\x26\x34\x8b\x2d\xd0\x89\x84\x54\x2b\xc5\x7c\x29\x1d\xdf\x5f\x89\x42\x9e\x87\xd3\x7d\xc9\xf7\xd5\x93\x18\x8f\x05\xc6\x7f\x9c\xe8\x6f\xe8\xf4\xdc\x1b\x96\xc9\x2d\x33\x9e\x62\x2b\x88\xbb\x28\x4a\x43\x86\x34\xa3\xc2\x72\xe3\x39\xdd\xcc\x48\x9c\x0c\x47\xc2\xc1\x2e\x38\xd7\x6d\x18\x1e\xe7\x30\xb3\x0b\x69\xfd\xbe\x39\x38\x72\x12\x38\x53\x42\xca\xa8\x03\x39\x5a\x57\xab\x94\x16\xb1\xbf\x5f\x61\x2f\x40\x16\x49\x4c\x12\x0c\x13\xf6\xf6\xd5\xa8\x2b\x39\x70\xf6\xc4\x43\x62\x58\x96\xe1\xd9\xfb\xab\x87\x9d\xb4\x8c\x0c\x5f\xc0\x95\xe8\x93\xfa\x4d\x5b\x38\x30\x1e\xe4\x4b\x88\x58\xfa\x29\x66\x21\x9a\xb3\x47\x08\x19\x96\xc6\x5e\x4c\x7b\xf5\x5b\x5d\x4d\x24\x3f\x13\x96\x15\x44\xb1\xb4\x4c\x70\x72\x42\xf4\x2d\x9b\x5a\x59\xa2\xda\x29\xc1\x2a\xb4\x1e\xe1\xf6\x6a\x40\xdd\xc3\x4c\x74\x51\x27\x3c\x7c\x04\xe8\x04\xe4\x92\x8f\x08\xbb\x63\x6a\x46\x0a\xa9\x35\xb7\xe7\x00\x37\x81\x6a\xcd\xc7\x20\x6f\x84\xac\x00\x3b\x12\x97\xe5\x89\xdd\x9e\x23\x6e\x7b\x96\x1c\x6a\x59\x43\xee\xc7\x61\x28\x5f\x58\x62\xbe\x9a\xa1\x5c\xc1\xff\xe6\x19\xcb\xe5\x88\x2c\xc0\xc1\xb0\x96\x1a\xf2\x74\xe1\x35\x9f\x03\x4b\xf8\xe2\x30\x62\x39\x5f\x1c\x7d\x7e\x74\x7c\x60\xd7\xfa\xf9\xa1\x5d\x75\x8d\x99\x1c\x07\x66\x12\x46\xba\x15\x31\x5d\x63\x27\x56\x15\x80\x76\x19\x53\xa9\x52\x67\x92\x75\xc0\x84\x15\x69\xe3\x0c\xd9\x3c\xf7\xd4\xa1\x07\x78\x57\x21\xeb\x54\xc2\xcd\x01\xae\xc6\x0d\xf9\x2c\x97\x8a\x7d\x16\x3d\xbf\x94\x0d\xb4\xa1\xee\x2b\xe8\x05\xe6\x79\x42\x4b\x92\x76\x76\xdb\xef\xa2\x01\x40\x52\x2b\xd9\x10\xfb\xaf\xb4\xa9\x9e\x72\x61\xc9\x20\x56\x71\x70\xf5\x93\x46\x78\x61\x2d\x1a\xba\xe1\xf8\xa2\x47\xf2\x17\x47\xbb\x8a\x44\x4a\x8a\x6b\x04\xbf\xee\x88\x50\xf7\xd4\x82\x40\xb4\xc7\xb1\x96\xda\xb5\x5c\x51\x33\x59\xf9\xd4\xca\x70\xca\x76\x16\x92\xf0\xa2\x0e\x5c\xc3\x3e\xee\x01\x53\x0f\xbb\xa0\xbe\xad\x4e\x94\x05\x5d\x79\x2a\x72\xb4\x6c\x0e\x08\xc6\xd5\x63\xdb\x44\x18\x4a\xf6\x4f\xf6\xb7\xc2\x0c\x71\x3b\x4a\x16\x74\xbc\xb6\x2b\x5d\x53\x89\x6c\x0c\x8d\xe3\xa2\x2c\x2b\xcc\xab\x6b\x50\xb8\xa7\xec\xc5\xf4\xe5\x7a\x21\x5e\xde\xc8\x18\x18\xbe\x65\x27\x92\x0b\x3a\x23\x54\xc9\x52\x78\x45\x24\x78\xd2\x5e\x37\x5e\xfc\xc6\x5e\x0c\x9f\x11\xbd\xa0\x4f\xbd\xa5\xe3\xc7\x83\xe3\x17\x5b\x01\xd8\xfa\x00\xd9\xf9\xb6\x98\x51\xfd\x42\xbb\xbf\x2a\x60\x16\xef\xcc\x56\xd6\xe5\x7b\x1a\x75\x58\xdb\x6b\x67\x38\xaf\x5a\x16\x71\x9f\x04\x0c\x5f\x4d\x15\x37\x2c\xf2\x93\x1d\x40\x12\x2e\x91\x2a\x0e\xf8\x39\xec\xd8\x01\xab\x7d\x34\x94\x2e\x87\xf7\xbc\x67\xee\x42\x01\xca\x55\xd7\x2c\x8e\x90\x5d\x7e\xe5\xe2\x4d\xed\xed\x91\x03\x7c\x72\x5f\x43\x5b\xa7\xc3\xad\x1c\x99\xdb\xe0\xc5\xc7\xa2\x8b\x0f\xe5\xe2\x63\x41\x45\xea\xfa\x68\x6d\x6b\xb7\x5f\x33\xab\x30\x68\xa2\x79\xce\x33\xaa\xb0\x9e\xc2\x35\xae\x0f\x98\x31\x13\x77\x5c\x49\x01\xa2\xd5\x1d\x55\x28\x76\x00\x67\xb2\xa2\xa0\x26\xff\x76\xf0\xdd\xe9\xbb\xbf\xbf\x39\x7d\x7d\x71\x88\xa5\xd2\xfc\x2a\xab\x30\xa0\x78\x25\xd1\x74\x6b\x41\xed\xd7\x61\xe1\x04\x34\xc2\xaf\xcb\xbe\x27\x2f\xad\x4c\x92\xcd\x08\xfb\x68\x85\x0a\x7e\x77\xdf\xdb\x84\x2f\xef\xc2\xee\xe7\x38\xbd\x9b\xa2\x45\x4d\x19\x4a\xce\x20\xab\xea\x35\x2d\x20\xf1\x02\x0d\x94\x67\xa7\x64\x58\x8a\x34\x63\xce\x89\x9a\xf8\x67\x7a\xa1\xc6\x44\xa1\xe4\x3f\xa1\xa3\x6c\x0f\xad\x9e\xe7\x1c\x41\x93\xca\xa9\x98\x52\x95\x9e\x5e\x5d\x86\x65\xc0\xea\xda\xb4\x3a\xdd\xae\xbc\x50\xb7\x9c\x63\xe8\xb2\x43\x40\x5f\x54\xa6\x56\x83\x93\x26\xd0\x99\x0f\x82\x5c\x6b\xe9\x37\x5e\x0d\x5d\x99\x31\xb8\x45\x51\x62\x0b\x42\x02\x9d\xea\x8b\x8c\x6a\xc3\x93\xaf\x33\x99\xdc\x5e\x1b\xa9\x3a\x69\x99\xa7\xdf\x5f\xcf\x8d\xaf\xc1\x53\x90\xd3\xef\xaf\xc9\x39\xd7\xb7\xa1\xbc\x57\x28\xc9\x15\xc7\xf0\xd1\x90\xc0\xb7\xaf\x91\xe3\xe6\x34\x99\x70\xc1\x3c\xb3\x15\xa1\xce\x8a\x8b\x42\x82\x84\x9e\x8e\x15\xb9\xb4\x91\x8a\x8e\xd9\x91\xc3\xb8\xdf\xd3\xa9\x66\xb8\xfc\xa1\x5d\xbe\xfd\x99\xb5\x11\xc5\xb7\x9a\x3a\x83\x8b\xb9\x3c\xdf\x92\x9b\x76\xa4\x6f\x5a\xe4\x1a\x36\x0f\xb2\xea\x2e\x18\x9a\x31\x46\xa4\x1a\x4e\x6c\x26\x4b\x32\xa5\x68\x2f\x07\x7a\x3c\x20\x37\xbc\x38\x21\x17\x51\x55\x0d\xcc\x87\xab\x4f\x65\x75\xbe\x90\x6b\xe5\x62\x7c\xe0\x84\xd1\x6c\x6e\x49\x89\x6f\x3c\x78\x81\x0a\xad\x3e\x21\x7b\xec\xa3\xf9\xe3\x5e\x8f\xec\x7d\x1c\x69\xfb\x1f\x61\x46\x50\x69\x26\x0f\x55\xdc\xb8\x18\x31\x55\x99\x80\x70\xc0\x7c\x90\xef\xf6\x11\x84\xdc\xbc\x3d\x7f\x7b\x02\x92\x63\x2a\xc9\x14\x12\x59\xef\x2c\xbb\x71\x01\xe7\x8e\x0a\x44\x60\x00\x4e\x97\xc8\xbc\x50\x32\xe7\x51\xb4\x29\x20\xf8\x6a\x7c\x23\xdd\x3c\x2d\x10\x03\xb7\x5e\x3c\x9e\x3f\x7f\x08\xb5\xf5\x83\x1b\xa5\xa8\x96\x9d\xfe\xe5\xc8\xd7\xe3\xeb\x39\xbb\x7d\x68\x1b\xe9\x1f\xb2\xe7\xed\x66\xb1\xd4\x2a\x3e\xe1\x97\x52\xf9\x9f\x8e\x52\x76\x77\xa4\x53\x7a\xdc\x83\xd7\xe0\xf1\xb9\x72\x5b\x61\x4d\x54\x93\xbd\xe3\xbd\x01\xb9\xf6\x9c\xbf\x17\xaf\xb1\x7a\x6e\x24\x55\x98\x10\x1c\x32\x2f\xf6\xc8\x81\x54\x30\xb3\x55\xe3\x33\xe6\x03\xfb\x43\xae\x15\x70\xa6\xc3\x35\x4a\x38\xe9\x64\x66\x25\x1d\x4c\xad\xa4\xb5\x54\x3c\x7f\x6e\xd7\x0e\x56\x7b\x56\x1c\xde\x03\xc1\x56\x5a\x1a\x6b\xa9\x26\x58\x5a\x26\x8c\xbc\x73\x73\x57\x1b\xe6\xa2\x6e\x01\xb0\xf2\x0c\x4c\xb0\xf2\x50\xf7\x40\x66\xde\x7b\x02\xaa\x4b\x3a\xe5\x26\x90\x40\x52\xbb\x42\xf3\xbd\xe0\x3f\x95\x8c\x5c\x9e\x7b\xfa\x57\x30\xa5\xb9\x36\xf6\x76\xa7\x35\x1e\xc6\x91\xb1\x1d\x9c\xe6\xf4\x67\x29\xc8\xc5\xd7\xd7\xee\xa5\x87\x4f\x0a\x9e\xb5\x44\x82\xfe\x5c\x2a\x66\xd9\x71\x07\x2e\x7f\xea\xc7\x34\x39\xbb\xfd\x9e\x9c\x53\x43\x91\xc1\xbb\x82\x9e\x55\xcf\x61\xc0\xc2\x21\x44\x4c\xf9\x9e\xbe\x6b\xe5\x23\xf2\x30\x4c\xd6\x9e\xde\x9b\x36\x39\xaa\xf6\xc1\xf7\xef\x2e\xb7\xc4\x8c\x13\xa0\xf1\xe3\xd7\x32\xed\xcc\x91\x21\x1d\xeb\x0c\xc7\x43\x53\xe8\x13\xf2\x46\x0a\xd6\x83\xeb\x0c\xf2\xb6\xfb\xe7\xf7\x56\xfb\x6d\x4d\xbc\x5a\xb1\x11\x0f\xad\x8e\x6b\xbe\x89\x6c\x06\x40\x3b\x2c\x6a\xc0\xbd\x71\x0c\x65\x98\xc9\x21\x71\xf8\xbe\xed\xf5\xbe\x7f\x77\xb9\xc1\x72\xdf\xbf\xbb\x7c\xdc\xa5\x6e\x24\x9e\x35\xa5\xb3\x8a\x07\x57\x15\x32\x9a\x62\x57\x7b\x99\x6b\xb0\x2d\x69\x6b\x9b\x70\xba\xe5\xa2\x45\x1c\x5a\xfd\xca\x5c\x7c\x2c\x40\xd7\xf4\xbe\xc2\xeb\x09\xb5\xc4\x81\xe4\x65\x66\x20\xfb\x07\x0e\xd5\x9e\xb2\xb6\x94\xdd\x1f\xaf\xd5\xe8\x80\x3e\x91\x73\x86\x2e\xb2\xf4\xc4\x07\x93\x84\x11\x8b\x07\xbc\x86\xa2\xbe\xe9\x09\xd2\x55\x82\x35\x7e\xd3\x08\x9b\x0e\xd0\x5c\x25\xc2\x4f\xae\x6e\x3b\x76\xa8\xd7\xcc\x1c\x0e\x6a\x85\x8b\x35\x2c\x79\xab\x97\x79\x43\xd1\x62\xce\x58\x46\x0e\xec\x4c\x47\x60\x6c\x3b\x1c\x54\x52\x05\xe4\x1b\x43\x90\x3f\x8a\x1e\x35\x91\xc3\xa5\x8b\x37\x25\x8e\xb6\xa8\xb2\x9e\xdd\x03\xe0\x5f\xae\x2d\x71\x33\xcf\xd0\xec\x98\x85\x0c\x0d\x7e\xb8\x66\xea\x8e\x27\xec\x59\xf3\x34\xb4\xb1\xb4\xe2\x6a\x80\x56\x6b\x9f\x6c\xcf\xd7\x7e\xdd\x38\x45\xb0\xc4\x94\x03\x6e\xc7\x3d\xc6\xc9\xe2\x38\x49\x23\xec\x17\xb1\xec\xda\x91\x92\x53\x47\x4a\x80\x6f\x5a\xdc\x5a\x1d\x22\x1f\x6f\xa4\xd5\xed\x0f\x27\xdf\x71\x1b\x40\x3b\x49\x0b\xe4\x6a\xbd\x9a\x84\x15\x93\x51\x97\xe4\xa9\x33\x56\x4c\x5e\x5e\xd7\xcd\x73\xf6\x3b\xf2\xf2\x7a\xc1\xbd\xc4\x88\x1b\xbb\x6a\x8d\x46\xbb\x7d\x4d\x32\x3e\x62\x86\xaf\xd9\xc2\x03\xdc\xcc\x5c\x0a\x6e\xa4\xd2\x5b\xba\x6d\x7e\xba\xae\xfc\xf0\x9d\x5f\x30\x79\xed\x66\xc0\x20\xca\x44\x66\x19\x83\x96\x36\x16\x49\x01\xa4\xfe\x15\x8b\x94\x17\x17\x66\xa0\x07\xb7\x7f\x06\xf5\xc5\x29\x2a\x47\x78\xa0\x47\xef\x2e\x4e\xcf\x5f\x5f\x0c\xf2\xf4\xf7\x13\x39\xed\x1b\xd9\x2f\x35\xeb\x73\xd3\x96\x87\x6d\x39\x34\xbf\x93\xb1\x64\x9d\xd3\x86\xcc\x81\x14\x6b\xa8\xd0\x0c\x4a\xe0\x41\x88\x04\x98\x72\xbc\x83\x4a\x4a\xd3\x23\x8a\x82\x5f\x10\xd2\x8e\xc0\x12\x54\x66\x19\x42\xd9\x28\xc6\x7a\xb1\x4a\xbd\xb2\xf2\x7f\xe7\x0d\x6d\x6a\x44\xa8\x36\xf5\xb0\x04\xfa\xf1\x91\xab\x0b\xad\x5f\x2f\x44\xac\x82\xdc\x75\x98\xc3\x07\xbe\x81\xdb\xcb\x48\x08\x65\x83\x88\xee\x91\x54\x50\xf9\xa0\x8e\x01\xcc\x24\xb0\xd9\x23\x28\x6f\xec\x38\xc6\xa3\x03\xaa\x1d\x2f\x81\xc5\xbd\x63\x6b\xdb\x33\xac\x03\xd3\x3b\x36\xc2\x90\x77\x1f\x71\xeb\xa4\x28\x5a\x9a\x09\xc6\xfb\x01\x71\x72\xc0\x58\x08\x37\x17\x43\xff\xe8\x80\x6a\x19\x6b\xdd\x2d\x8b\xa5\x5d\x31\xab\x79\xb8\xc6\xca\xb6\x03\xa6\xe9\x6c\x7f\x92\x77\x56\xd6\x65\xd3\xa3\xa9\x54\xb7\x5c\x8c\xfb\x53\x6e\x26\x7d\xdc\xa7\x3e\x82\xba\x6a\x47\xbf\x87\xff\x38\xcb\xf7\x69\x9a\xba\xd0\x87\x52\xb3\x51\x99\x61\x10\x83\x1e\x10\x5a\xf0\xef\x98\xd2\x10\x92\x67\xf5\xb7\x1e\x29\x79\xfa\xd5\x7a\xc8\x92\x6e\x68\xd8\xa6\x5a\xe9\x4a\xba\xad\xfc\x15\x55\x34\x95\x1a\x2b\x87\xd8\x0d\xd6\x10\x8c\xa6\x39\x17\xcf\xf0\x22\x26\x5c\xa4\xeb\xf6\x5f\xdf\xfb\x19\x8c\xa8\xcb\x51\x38\x8b\xb7\x9e\x07\x4f\x1c\xf5\x7a\x0d\x56\xe4\x74\x3e\xb9\xba\x47\xae\xd5\xa5\xcb\x67\xfa\xa7\xac\x8f\x6f\xe9\x17\x69\x05\x95\x9d\x7b\x6d\xfb\x06\x9c\x47\x70\x9a\x6d\xe9\x7c\xc9\x6f\x4f\xa0\xb9\x37\xa4\xba\xc8\x30\xf7\xe2\xcd\x50\x71\x49\xfb\x44\x41\x60\xbd\x78\x13\xbd\xee\x8a\xd5\x77\x5d\xad\xc9\x2a\x21\x22\x91\x42\xb8\x52\x33\x6f\x0b\x26\xae\x0d\x4d\x6e\x5b\xdb\xa3\x77\xfc\xf4\xc9\xf9\xe9\xa6\xde\x32\x1f\x24\x93\x06\x4c\xc0\x6c\x0d\xe7\xba\xad\x62\x68\x10\xf9\x9f\x09\x5d\x09\x41\x4a\x5d\x2c\x11\x21\xf8\xa9\xce\x44\xc3\xd7\xce\xf8\x00\xe1\x6a\x85\x2c\xca\x0c\x6b\xa6\x70\x1f\xca\xb6\x1d\xa6\xd7\xfe\x12\x38\x39\x66\x13\xbf\x53\x45\x0f\x72\x99\x32\xec\x2d\xe5\xcf\x57\x33\x53\x6f\x0a\x25\x42\xf7\x2a\xec\x65\x35\x0c\x65\x65\x22\xce\x26\x88\x4c\x8c\xcf\xe0\x09\xc9\x75\x2f\x5e\xbc\x78\x81\xa9\x4e\x7f\xfa\xd3\x9f\x08\xf4\xf3\x4b\x59\xc2\xf3\xf9\x07\xb1\x0f\xe7\xf1\xf1\x80\xfc\xd7\xe9\xeb\x57\x10\x7f\x55\x18\x8d\xe5\xd8\x70\x66\x88\x28\x8b\x07\xeb\x1e\xf9\xdf\xd7\x6f\xdf\x78\x31\x41\x37\x7e\x05\x95\x22\x6c\xaf\x1e\xd8\xf7\xe2\xcb\x3f\xfe\x71\x40\xce\xb9\x82\x5c\x08\xce\x74\x1c\xba\x58\xf8\x70\x3e\x48\xcd\x82\x5a\x3c\x9e\x3f\x63\x6a\x83\x65\x13\x2e\xb4\x17\x6b\x06\x63\x76\x92\xc5\x94\x8c\x27\x06\xd3\x2e\xf0\xb2\x87\x0a\xf4\x50\xdf\xc8\x55\xeb\x72\x81\x23\xb0\xb8\x1e\xc9\xf8\x2d\xf3\x2d\xa1\xaa\xa4\x52\x57\xe2\xd0\xa5\xb7\xe1\x64\xd5\x59\x69\x66\x9e\x34\x92\xa1\xa5\xa5\xa6\xd9\x59\xb8\x56\x30\x16\x12\xac\x6e\xd9\xac\x8f\x98\x50\x50\x1e\x82\xeb\xc0\xdd\x8c\x51\xd3\x75\x42\x9d\xb0\x34\xba\xa7\xa1\xd9\x8e\x8f\x3f\xc4\xcc\xad\x88\x2e\x41\xfe\x17\x16\x4f\x87\xe4\x5e\x11\x15\xfb\xf4\xd9\x67\xae\xd8\xa9\xff\xda\x15\xbe\xaa\xf7\x41\x86\x02\x02\x5c\xdb\x57\xdc\xb2\x99\x5e\xf5\xe6\xaa\x12\xa0\xc5\x23\x8d\x27\x5a\x8a\xb9\xd1\x16\xb9\xb0\x40\xbe\x66\x2e\xd0\x86\xfa\x7a\x05\xd5\x1c\x18\x3a\xeb\xd2\x04\xdd\xb3\x1e\x4a\x67\x55\x24\x66\x1c\xb0\xcf\x4c\xe9\x40\x03\xb1\x4a\xf6\xdd\x50\x47\x12\x76\x98\x53\x75\xcb\x7c\xcd\x78\x9a\x0d\x20\x4a\x59\x87\xa4\xf5\x50\x70\x1f\x74\x04\x3a\xab\x45\xf9\xdb\x97\xec\x0f\x06\xfb\x78\x41\xa4\x72\x2d\x93\x00\xdb\xed\xf7\xcf\xa1\x4e\xc7\x6b\x68\x74\xe6\x6b\x02\x40\xb5\x07\x09\x65\xc3\xaa\xe8\x64\xea\xfb\xdb\xb5\x36\x34\x3e\x83\xda\x1a\xc5\xea\x64\x12\xff\x79\xac\xba\x1a\x37\xee\xfe\x18\xe9\xaf\xc0\x83\xd5\x8b\xc8\x5b\xb1\xb7\x05\x6b\xec\xca\xe4\x5c\x65\xb5\x8c\x7d\x52\x5c\x6d\x71\x0f\x76\x47\x0b\x22\x09\xa1\x51\x6e\xfb\xf9\x33\xaf\x18\x5d\xda\xb2\x30\xfc\x74\x61\x64\xf8\x69\xe7\x24\xc0\xcf\xdc\x3d\x08\x34\x13\xc9\xe2\xa8\x02\x11\xd4\x82\x2c\x02\xb3\x31\x12\xeb\xd1\x3a\xca\x4f\x05\xa1\x43\x2d\xb3\xd2\xe0\xd0\xea\xc7\x98\xe0\xc2\xa4\xbe\x6c\x05\x50\xd9\xf0\x58\x44\x7e\x81\xf1\x20\xcd\x6b\x47\x89\xf1\xd3\xe1\x32\x6e\x50\x8a\xff\xd7\xa2\x69\x75\x80\x92\xe7\xab\x5d\xfd\xb0\x2e\xc0\x73\x3a\x61\xce\xab\x14\x71\x77\x4b\x60\xec\xb5\x01\xd1\xc1\x33\x6a\xd7\xce\x65\x6b\xd6\x85\x44\xf3\x2e\xaa\xd2\xf5\x25\x39\x08\xe5\xbc\x83\x9b\xfb\x52\x18\xa6\x46\x34\x61\x87\xb1\x0a\x55\x75\x2a\xf5\x91\x35\x3e\x37\x60\x42\x45\x9a\xb9\x42\xe2\x4c\x01\xca\xb3\x8f\x86\x29\x41\x33\x78\x45\xaa\xf8\x1d\x53\x9a\x1c\x7c\xcd\xac\x3c\x88\x65\xc0\x5b\x65\x3b\x6d\x37\xac\x10\x96\xb1\x2d\xa5\x0d\x26\xeb\x1a\x52\x01\x83\x16\x55\xa9\xaf\xc0\xe4\x2b\x2d\x58\x90\xea\x58\x2d\x1d\x58\x54\x02\x7a\x0c\xa4\x62\x26\x4b\xe5\xec\xde\xbe\x6d\x0a\x94\xa0\x4b\x0c\x4e\x4c\xb5\x4b\x07\x86\x4c\x78\x9f\x23\xea\x0a\x34\x3e\xe3\x20\xb9\x55\x21\x6e\x23\x27\x3e\xcb\x3b\x9e\x7a\x16\x09\xbe\xa5\xaa\x22\x47\x41\x75\x94\x77\x42\xb5\x96\x09\x07\x9d\x37\x82\x30\x0a\xe3\xc0\x48\xeb\xb5\xfd\xbc\x47\x21\xb6\xee\x4a\x6c\x05\xbc\x55\x90\x09\x99\xb2\xab\x72\x98\x71\x3d\xb9\xde\xd0\x14\xf8\x66\xc1\x14\x18\x30\x30\xe7\xa8\x5b\x6a\x1e\xd4\x4c\x68\x0e\x2c\xcf\x92\x71\xcb\x6c\x5d\xc7\x23\x0b\x44\x3f\x3a\xc6\x4c\x09\x89\x11\x19\x73\x35\x66\xed\x4f\xd1\x3a\x5c\x86\x16\x56\x95\x4e\xd9\x7b\x51\xd4\xbe\x4f\x68\x96\xe9\x66\x26\xad\x27\xb4\x28\x73\xf8\xac\x2d\x3c\x53\x3e\x82\xce\xac\xb8\x7a\x57\x06\xd4\xde\xf4\x50\xd7\x6b\xe1\xc6\x74\xa3\x8e\xa8\x4f\x6e\xa3\x59\xe6\x07\x44\x19\x86\x90\x47\x0c\x28\xb3\xe5\xfa\x4d\x3b\x1b\xe8\xc3\xd9\x40\x37\xf4\x34\x5c\x87\xf2\xac\x34\xca\x4e\xf6\x1d\xe8\x68\x48\x48\xa9\x04\xef\x05\x59\xc8\x75\x97\xc4\x56\xbd\x02\xf8\xce\x53\xe3\x4a\x65\x75\xb5\xcb\x7c\xd7\x18\x0e\x6c\xda\xea\x1d\x70\x79\xfb\xa1\x29\x50\x85\x99\x4e\x21\x08\x57\x60\xfe\xca\x57\x3c\x07\xd8\x0d\x7e\xb9\xaf\x49\x2a\x93\x12\xfa\xb3\x05\xa0\x55\x0e\xb0\xb6\x15\x2f\x9f\x53\x39\xb4\x28\xc3\xb5\x83\xf8\x74\x1e\xe5\xc5\x46\x82\x92\x9f\x8c\xd8\xef\xe9\xd0\xb7\x3f\x09\xa9\xa9\xbf\x5e\xd3\xb3\xeb\xcf\xd9\xce\xd2\x4c\x76\xc6\xeb\x9d\xf1\xba\xf9\x79\x70\xe3\xb5\x1d\x53\xaf\x66\x5c\xbb\xae\xbe\xdc\x01\x5f\x51\xcd\xba\xbe\xb3\x87\xb4\x82\x46\x04\x06\xa9\x7b\x33\x0e\xbe\x21\xb7\xe1\x15\xa9\xce\x36\x92\xf5\x3c\x05\x02\x56\xfd\xf4\x16\xd3\x07\xb2\x83\xc2\xee\x5a\x09\xd3\xf8\x59\x16\x82\x8b\xd5\xb5\xd0\xe9\x10\x79\x2f\x0a\x99\x9e\x60\xb1\x2b\x2a\x84\x44\xee\xa7\x7b\xae\x52\x5e\xcf\xe9\x5d\x22\xad\xfa\x5b\x62\x9d\x7c\xcf\x1a\x3b\x5a\xcb\x5a\x03\x9f\x74\x3e\x00\x02\x87\x00\x7b\x5b\x53\xde\x28\xfe\x74\x3d\x0d\xfb\xa9\x44\xc2\xf6\x63\x9a\x82\x0d\x8e\xf7\x87\xa0\x93\x09\xcb\x29\xfc\xf3\xa5\xdf\x00\xf4\xd2\x53\xdc\x18\x86\xf9\xd0\x4c\xe5\x9a\xc8\x51\xaf\x96\xae\xb3\x77\x77\xbc\xb6\x47\x70\xfc\xe9\x6c\xe2\x26\x1e\x03\xd7\x57\x98\x59\xb1\xdd\xab\x9a\xf9\xd1\x62\x1f\xf0\xc3\x0c\x7b\x07\x35\x7c\x53\x40\xb3\x10\x3e\x0f\xba\xb5\xc7\xb7\xde\xf7\x82\x8d\xec\x13\x60\xeb\x3b\xeb\xfd\xdc\xe7\x11\xad\xf7\x11\xe1\xf6\xc4\xc0\x01\x20\xb6\xe8\xc7\xe6\x36\x6f\xd6\x1f\x32\x2f\x56\x0e\xaa\x6a\x68\x16\xe5\xbc\x41\x5f\xaa\xba\xdb\x74\x7f\x30\xd8\xdf\xf7\x66\x7e\x87\x9f\xa5\x19\xf5\xff\x4c\x98\x48\x64\xea\xdb\xa7\x63\x9b\x69\xcb\xf4\x2b\xed\x3c\x5e\x4b\xee\xdf\x15\xbb\x5e\x61\xee\x6e\x47\xd2\xe1\x06\xfb\x6c\xf8\x97\xf7\x62\x91\x15\x63\x0c\xd9\xf5\xcd\xae\x7f\x8e\x43\xfa\xdf\x35\xc9\x78\xce\x5d\x65\x7a\xd7\xc9\x45\x93\x03\xfc\x72\x90\x14\x65\xcf\x3d\x30\xc8\x59\x2e\xd5\xac\x17\x1e\xb2\x3f\xd6\x46\xb9\x27\xb0\x16\x54\x52\x2a\xc5\x84\xc9\x66\xcf\x97\xbf\x7a\x10\x3c\x20\x7b\x0d\x50\x6f\x97\x35\x56\x7d\x1a\x51\xc7\xc1\x07\x00\x96\xa8\xa8\xe2\x65\x28\xfc\xa1\x7b\xc1\x58\x07\xdf\x32\x71\x47\xee\xa8\xd2\x6d\x61\x4e\x36\xe5\xa8\x29\xbf\xe3\xba\x7d\xc3\x85\xb9\xcd\x55\x66\x1f\x28\x03\x58\x9a\xa2\x34\x8e\x3a\x79\xdc\xf5\xd5\x8a\x02\xce\x36\x04\x87\xe3\x75\x6d\xed\xe3\x4f\x41\x8d\x61\x4a\x9c\x90\xff\x7b\xf0\xe1\x0f\xbf\xf4\x0f\xbf\x3a\x38\xf8\xe1\x45\xff\xdf\x7f\xfc\xc3\xc1\x87\x01\xfc\xe3\xb3\xc3\xaf\x0e\x7f\xf1\x7f\xfc\xe1\xf0\xf0\xe0\xe0\x87\x6f\x5f\x7f\x73\x73\x75\xf1\x23\x3f\xfc\xe5\x07\x51\xe6\xb7\xf8\xd7\x2f\x07\x3f\xb0\x8b\x1f\x5b\x4e\x72\x78\xf8\xd5\xbf\x75\x58\x24\x15\xb3\xb7\xad\x49\x00\x7e\xfa\x1b\xb1\x81\xfa\xd8\x8e\x47\x4f\xc8\xc7\x7e\xd4\xed\x98\x0b\xd3\x97\xaa\x8f\x93\x9c\x40\x51\xc1\xd6\x53\xf9\xa3\xdd\xfc\x8e\x54\x4c\xa6\x2a\x8e\xe5\x05\xb3\x07\xb8\x04\xbe\x02\x5b\x97\xe4\x81\x0b\x5f\xb5\xad\x16\xf9\x68\x58\x5e\x48\x45\xd5\x8c\xa4\xce\xd6\x30\x5b\x90\x80\x19\x65\x60\xde\xbb\x2a\x0a\xac\x3d\xe5\x6a\x4b\xf9\x03\x1d\x92\x2f\x59\xca\xcb\xbc\xab\x29\xeb\x7b\xa8\x86\xe5\x2a\x69\x79\xe7\x26\x4e\x15\xca\x1a\xd2\xe4\x16\x65\xd3\x00\x43\xe4\xf4\x71\xc9\x9d\xbd\x46\x3b\x3e\x30\x86\x81\xa7\x4f\xa6\xcc\x02\xd8\x3f\x8c\x73\xd7\x0c\x57\xe8\xf2\x70\xbe\xff\xaa\x3c\xb7\x54\xe4\x35\x30\xbd\x47\x3a\x13\xd2\x31\xc9\x8c\xff\xcc\x5e\x59\xee\xdd\xb9\x84\x95\x04\xb1\xdb\x65\x06\x8f\xa0\x3c\x78\xe5\x58\xae\xb1\x1f\x80\x7a\xc0\x6c\x6f\x98\xb7\xb0\xb7\x6f\x47\xe1\x01\x7b\x5d\x6b\xf4\xd5\xf1\x04\xea\x48\x82\x88\x0e\xb0\x0b\xf0\xbe\x89\x9a\x49\x94\xda\xbe\x09\xfa\x7d\x46\xcf\x54\x2f\x9a\xfa\x72\x96\x90\x33\x8a\xad\x21\x1a\x8a\x85\xfd\xe5\xda\x43\x20\x52\xca\x20\x3d\xc1\x4b\xe1\xba\x04\x19\xd0\xbd\xc5\xc9\x42\x72\xd4\xe8\xc3\x1f\xaa\x17\xce\x61\x95\xe0\x59\x1d\xad\x7c\xe9\xb6\xb0\xf1\x52\xb8\x28\x82\x39\x1c\x59\x8c\x22\xa5\x66\xaa\x3f\x2e\x79\xba\x09\x72\x3c\x63\xee\xd6\x9a\xa7\x75\xe7\x64\x1d\xf9\xd7\x3d\xb8\x56\x88\xb2\xe8\x40\xf7\xf7\x2e\x42\x68\x46\x8d\xf0\xc7\x25\xe1\xea\x61\x1a\x34\x14\x4b\xf7\x57\xce\xfb\x7b\x6e\x82\xde\xea\x18\x42\x32\x4b\x5c\x9a\x2c\xaf\xd5\x73\xc4\x69\x11\xf3\x20\x2a\xb5\x6f\xff\xcf\xeb\xb7\xde\x58\x3f\x64\x23\xa9\xaa\x66\x00\xa0\xee\xb8\x58\xda\x94\x65\xcc\xf8\x46\x8e\xa1\x5b\x80\x26\x8a\xe5\xf2\xce\x22\xf3\x07\x41\xde\xfb\x4e\xa9\x7c\x74\x42\xe8\x61\x2d\x55\xc1\xb5\xe6\x11\x8c\xa5\x18\x60\x1b\x35\x1d\x50\xa5\xd0\x3d\x32\x3c\xf4\xc1\x26\x1a\xbb\x5a\xa8\x3c\x2a\xa4\x0a\x4a\xb3\x62\x16\x00\x90\xf0\xab\x64\x4e\xb4\xa0\x85\x9e\x48\x03\x7a\x1f\x2d\x68\xc2\xcd\xcc\x82\xdb\x28\x9a\xdc\x42\x1d\x55\xc5\xdc\x1b\x7b\x24\x39\x74\xf1\x5a\x31\x04\xeb\x61\xbf\x66\xa2\x64\x39\x9e\x40\x24\x2b\x3e\x95\x64\x54\x7b\x00\x2c\x1c\xef\xb4\x19\x4d\xd2\x99\xa0\x39\x4f\x42\xd1\x3c\x25\xef\xb8\xe6\xd2\x59\x73\x71\x5e\x8b\xf5\xe4\x2a\xd4\x3d\x43\x23\xf1\x59\x46\x79\x4e\x0e\x34\x63\x24\x20\x06\xfe\x72\x8d\x62\x0b\x1a\x2f\x14\xb3\xc3\x63\x0b\xb2\x0c\x85\xc4\x85\xab\x38\x50\x51\xba\xe0\xa2\x42\x46\x09\xd7\x2d\x5d\xfc\xea\xc3\x70\x74\x8b\x57\x26\x55\x5c\x74\xfe\x8e\x89\x54\x46\xee\xc9\xd3\xab\x4b\x1d\xab\x1d\xae\xdf\x02\xce\x04\x3f\x64\x52\x8c\xe3\x94\xfd\x0a\x4b\x2d\x59\x15\xd0\x3b\xe3\x8e\xa7\x25\xcd\x90\xa0\xba\xc5\x9c\x5d\x5f\xe2\x70\x3e\x9e\x98\xfe\x94\x81\xd9\x05\xf9\x4e\x15\xda\xe4\x5f\xca\xe7\xc2\x72\xb8\x06\x02\x6c\x9c\xd9\x00\x4d\x58\xd0\x9d\x82\xce\xa0\xbe\x8b\x0b\x21\xa9\x79\x46\x7d\x6d\x2d\x9c\x22\xc0\x3d\x02\x3a\x2c\xef\x34\x74\x52\xb0\x12\x03\xd8\xa5\x2c\x94\x01\x6b\xe7\xd7\x06\x6d\x21\xaa\x5a\x77\xe1\x6b\xd7\x0c\x0f\xfa\xaa\x80\x14\xf7\x41\xa0\x85\x09\xdc\x1d\xc3\x28\xf6\xaa\xea\x78\xe1\x2a\x1c\x41\x60\xbd\xbb\x86\xdf\x30\xc1\x14\x4f\x1a\xa8\x13\x86\x8e\xa9\x81\xcb\xc7\x84\x1d\x96\x0e\x56\xab\x46\x0f\x20\xe3\xdd\x55\xa8\x74\xc3\xf2\x22\xa3\xa6\xab\xef\x72\xef\xfb\xc8\x0a\x17\xf9\x4d\xec\x2d\xa5\x22\xed\xd3\xcc\xe2\xe7\xd5\x77\x67\x2e\x2e\x1a\xef\x5d\x2d\x2e\xe0\xa6\xea\x6e\xe2\xab\x62\x5b\x29\x65\xe1\x75\x83\x04\xf8\x21\x4b\x81\x4c\xf9\xfe\xca\x56\x15\x9d\x0a\x6c\x56\x63\xff\xb8\xfa\xee\xac\x47\xf8\x80\x0d\xfc\x5f\xe1\x51\x4f\x27\x8d\x1c\x63\x54\x61\x88\x14\x05\xec\x86\xa5\xc4\xb6\xad\x78\xec\x3f\xfe\x62\x17\x69\x7f\xfd\x5b\xff\x2f\x51\x6d\xcf\xbf\xfd\xc3\x9e\xb7\xb2\x0f\xd4\xbf\x8d\x43\xd3\x42\x51\xfd\x7f\x5c\xc9\xd4\x6a\xd1\x03\x57\x9a\xfa\x1f\xae\x47\x1a\x13\xc6\x0a\xa6\x57\x12\x9c\xfe\x3c\x45\x9c\x87\x77\x2b\xf6\x4f\x6f\xa7\x74\x4d\x56\x1c\x65\x49\xa8\x61\x02\x58\x83\xcf\xe1\x10\xd2\xe0\x70\x6c\xcf\x02\xeb\x3f\x18\xc5\x1d\x53\x8c\x94\x70\xe9\x91\xb0\x9c\x0a\xc2\x3e\x72\x0d\x39\x9e\xb8\x57\x00\x07\x75\x71\x6f\x9e\xdb\xd9\x69\x2d\x84\x43\x46\x2e\xb4\x6c\xb1\x6b\xfb\x4c\x48\xf3\x59\x38\x7e\x1f\xf1\x01\x2c\x4d\x12\x7a\x27\xb9\xaf\x2f\x6e\xef\xa3\xc0\xa6\x9e\xa1\xca\xf4\x70\x46\x72\xae\x0d\xbd\x65\x03\x72\x6d\xb9\x59\xec\x5c\x43\xe8\x09\x02\xb5\x20\x59\x4a\x4a\x61\x78\x06\xbf\x56\xf3\xd8\x25\xc7\x5c\xee\x72\x44\x74\x99\x40\x5b\x1f\xc5\xfa\x9e\x6f\xba\xa7\xe6\x28\x4e\xb5\x97\x5e\x38\xec\x09\x45\x65\xa3\x48\x61\x28\x36\x09\x12\x0e\xbd\xe6\xa2\xc1\xec\x3a\xa5\x48\x2a\x5e\x09\xc0\x84\x3e\x4f\x96\x3d\x66\xde\xc3\x8c\x7a\x8f\xb3\x87\x0a\x96\x30\xad\xa9\x9a\x61\x13\x15\x1e\xea\x6b\xbb\x00\x20\xec\xdf\x4c\x05\x56\x4d\x57\x0c\x3b\xf2\x94\x89\xc1\xda\xe3\x43\x25\x6f\x99\xa8\xda\x43\x7b\xc2\x14\xc2\xc0\xaa\x70\x1c\x70\x9f\x49\x92\x4c\xa8\x18\x47\xfd\xc8\x73\x9a\x02\xec\xbf\x0d\x72\x95\xdf\x8f\x85\x00\x1d\x59\x51\x86\x1b\x00\xc5\xd0\x32\xac\x60\xd5\xfd\x20\x88\x57\xdc\x7b\x95\xd9\xd5\x6e\x89\x67\x6b\x68\x57\x27\xfa\x45\x3a\xda\x08\xfb\x20\x25\x6c\x39\x8c\x2c\x67\x86\xa6\xd4\xd0\x0d\x42\xc9\x5e\x57\x3d\x0f\x9d\x3f\xd3\xf5\xb8\x0d\x7e\x4e\xc7\xed\xbc\x80\x27\x0b\x1e\xa7\x4b\xc1\x4d\x9c\x78\xc8\x63\x27\x39\x8b\x53\xce\xef\x80\x11\x62\x71\xd5\x78\x98\xde\xcf\x86\xe4\xa2\xea\xa7\x59\x91\x93\x76\x5e\xad\x8e\x06\x5d\x0b\xfa\x0d\x60\x74\x53\xb9\xde\x92\x7a\xb8\xd8\x42\x41\x07\xb9\x04\x13\x86\x63\x1b\x34\x9f\x9d\xe6\x40\x57\x0a\x44\xf2\x06\x10\x01\xca\x63\x66\x74\x15\xf0\x82\x74\xd8\x12\x17\xc7\xef\x9c\xfa\x0b\x44\xda\x01\xd6\x69\x90\x8b\x25\x2e\x04\xbb\x96\x8e\xce\x5a\xca\xff\x20\x70\xdd\xc4\x86\x8d\x15\xfa\x5f\xcb\xb4\x8b\xd9\xbb\x51\xd8\xbe\x9a\xa2\x8a\x02\xc5\x78\x5e\x0d\x66\x04\x7c\x07\x38\xbf\x74\x2d\xc7\x0e\x89\xdc\x84\xde\x6d\x6e\xf3\xaa\x24\xb1\x7e\x28\x0a\x0c\xaf\xeb\xc3\xeb\xfa\xc7\xed\x6d\x83\xdd\xdb\xc0\x76\x6e\x04\xbb\x91\x0d\xde\x92\x94\xeb\x8e\xd6\xd3\x66\xc5\xf2\x40\xed\x9d\x3b\x32\xb8\x80\x5d\xca\x04\xe3\x96\x4e\x9c\x90\xcf\x6a\xfc\xdd\xc9\x51\x41\x2b\xc3\x48\xdf\x03\xaf\xa6\x0d\xdc\x21\xf8\x84\xf4\xfa\xe3\x87\x8d\xc9\x40\xb0\x58\xac\xb1\xf8\x88\xe2\x20\xec\x59\xc1\x0c\x7a\xc5\x85\x44\x06\x8b\x58\x4a\x66\x99\x6f\x30\x86\x6a\x5a\xc3\x1d\x0f\xb5\x44\xd1\x38\xdc\x0b\xea\x70\x90\x2e\x05\x9b\x06\x31\x82\x6a\xac\xda\xe2\x5d\x67\x2c\x6a\xe3\xb5\x70\xbe\x10\xf5\x7c\x2a\x66\xb8\xf4\xf3\x70\x2c\xcb\x84\xf3\x9e\x77\xa7\x5b\xc0\xc3\x5a\x68\x36\xa5\x33\x8d\x8d\x1c\x83\xb6\x10\xde\xef\x2a\xa4\x55\x13\xbf\x63\xa3\x56\x4d\xcf\xe6\x11\xac\x93\x73\x6d\x13\xf7\x1a\xe4\x5d\x72\xd1\x26\x96\xa9\x1a\xb0\xb2\x0b\x47\xf3\xb3\x89\x3f\x0e\x02\x5e\xc0\x0f\xdf\xcd\xb9\x52\x2f\x79\x7a\x75\x09\x53\x78\x69\x7c\x0c\x7f\x78\x5e\x13\xbc\x0f\x43\x66\xb1\xba\xca\xa8\x06\x0c\x89\xc7\x2e\x08\x49\xa8\x50\xeb\x5b\x28\x8b\xea\x0c\xd0\xa1\x85\x98\x62\x10\x52\x02\x6f\xc4\xbe\x85\x54\xcc\x1c\x0f\x37\x13\xae\xd2\x7e\x41\x95\x99\xa1\x7a\xda\xab\xbd\x2d\x84\xe7\x77\xda\xf8\x86\x7e\xa1\x76\x15\x87\x97\x42\x18\x36\xef\xa0\xeb\x0d\xff\x4b\xe1\xfa\x18\xfb\x69\x9f\x00\xb0\x70\x3f\x6f\xa2\x7c\x78\xaf\x0b\x3e\xd9\x7e\xd2\x98\x7c\x6c\xca\x31\x1a\x5e\x5b\x24\xfc\x71\x17\x2a\x19\x07\x50\x07\x8e\x0e\xca\x8f\x5d\x40\x0f\x1a\x7b\x56\xcd\xe0\x23\xb3\xa1\x93\x0a\x7c\xf8\x8d\x6b\x14\xe4\x7a\x94\x66\xb5\x77\xc5\x13\x84\x7b\x41\x0e\x84\x14\x78\x57\xf0\xd9\x43\x8c\x3e\x5a\x62\xed\x82\x47\x5c\xb7\x39\x53\xd3\x7a\xa2\xbb\xe9\xd9\x02\x17\xd0\xae\x09\x68\x35\xe8\x43\xba\x4c\x12\xc6\x82\x06\x1d\xf7\x7b\xa9\xee\xb2\x5b\x32\x34\x83\x63\x1a\xbb\x8f\x72\xa1\x0d\xcd\xb2\x4a\x73\x75\xe0\x92\xc0\xd9\xbc\x71\x31\x62\x78\xb5\xd4\x1c\xa7\xc4\x43\x97\x7b\x8c\x98\x29\x45\x82\xde\x7f\x6e\x66\x7e\x05\x31\x07\x82\x61\xa0\x32\x68\x54\x68\xf9\x08\x2d\x59\x91\xe8\x1f\x80\x09\xc4\xc8\xf5\xd8\xaf\xf3\x22\x57\xb6\xc1\x52\x9e\x21\x4d\x6e\xa7\x54\xa5\x1a\xb2\x8e\xa8\xe1\x58\x88\xbb\x57\x9b\xf6\x20\x5a\x83\x7d\x7b\x8d\x77\x1d\x06\x05\xc3\x35\x32\xad\xbf\x86\xd0\xd2\xc8\x9c\x42\xcb\x62\xec\x53\x57\xd9\x25\xf3\x50\xb7\xb0\xd1\x41\x10\xe8\xaa\xdb\x06\xc8\xe1\x0a\xa3\x3c\xcd\x54\x12\x9e\x5b\x99\x80\x42\x03\x8a\x51\xc8\x31\xf2\x46\xd4\x55\x2b\xb5\x82\xcf\xf7\x60\xc2\x8e\x9e\x42\x85\xd8\xaa\x4b\x1a\xa6\x0f\x36\xd2\x60\x1c\x74\x49\x3a\xbd\x06\xcb\x26\x7e\x94\xc5\x6a\xbb\xda\x08\x59\x7b\xf6\x80\xa6\xcc\xca\x02\x7a\x25\xca\xea\xc1\xa2\x35\xf1\xb1\xc0\xac\x12\xae\xbd\x22\xe7\x42\xe2\x0e\x52\x25\x8b\xc2\x99\x43\xf2\xc3\xf9\x35\x81\x67\x42\xdd\x31\x0d\xbe\x2f\x1f\x66\x67\x41\x31\x66\x82\x29\xe8\x76\x6c\x25\x2e\xa8\x76\x01\xb7\xb7\xf9\x12\x88\xec\x22\x51\xf1\xb3\x83\xd3\xac\x98\xd0\x43\xf2\xde\x35\xea\x09\xf8\x1b\xe2\xf6\x5a\x49\x4c\x68\x60\xf1\x16\xcd\x9d\xa8\xd3\xf2\xb3\x13\x75\x76\xa2\xce\x6f\x5b\xd4\x09\x01\x63\x9b\x8a\x39\xef\x42\x94\x64\xe4\xb9\x8d\x23\x0e\xaa\x30\xca\x87\xb7\x5b\x84\x77\x3d\x30\x05\xdc\x8c\xda\x60\xe8\xc4\x3d\x30\x67\xff\x15\x06\x5f\x54\xdd\xa6\x4d\x14\x0f\x52\xc5\xa2\x58\x69\xa3\x34\x2c\x02\xbd\x6f\x74\xd9\x15\xd6\xb5\xdc\xd2\x23\xec\x2a\xd2\x0f\xd3\xf6\xab\xf0\x8f\x16\xa5\xc5\xe3\xcf\x46\x50\x27\xf7\x48\xa3\x8c\x3f\xcf\x38\x02\xa4\xb1\xd9\xee\x31\x8e\xe4\x9e\x71\x8e\xe4\x3e\xb1\x8e\x64\x9b\xf1\x8e\x24\x44\x4d\xdf\xe7\xc6\xbc\xf3\xf1\xda\x8d\x3b\xe3\x88\xd3\xaa\x3b\x53\xcb\xd6\x0f\xf3\x70\xed\x3b\xd6\x39\x6f\x5f\xb8\x03\x60\x2f\x8b\xa3\x6e\xdd\x6d\x05\xc5\x07\x5d\x7a\xec\x63\xa8\x8d\x1b\xf1\xfa\xaa\x95\xb4\x91\xe0\xfe\xcf\x0b\x2c\xb3\x03\xb7\xae\xef\x62\xa3\xbc\x62\xb1\xbb\xc1\xbb\x1b\xdc\x76\xfc\x53\xde\x60\x8c\x2b\xee\x12\xf6\x5e\x97\xab\xd1\x89\x47\x7e\x2a\x99\x9a\x11\x79\xc7\xa2\x78\x1a\x28\x02\xac\x79\xea\x22\x52\x9c\xcd\xa1\xbd\x2c\xfb\x88\x3c\x1f\x2c\x1a\x17\x1f\xad\x64\x04\x19\x62\xf7\xa0\x65\xcd\xa9\xea\x49\xc0\x08\x2d\x0f\x74\x4f\xbc\x2c\x15\xd1\x03\x57\x1d\xac\xfa\x06\xf4\xfd\xd3\x37\xe7\x9b\x29\x00\xdd\xfc\x3b\x64\x13\x1f\xcf\xdc\xe6\x4f\x57\x6c\x10\x01\x11\x7e\xa9\xf7\x3f\x0a\x5a\x3a\xb9\x65\xb3\x9e\x73\x09\xbb\xba\xe6\xfe\x61\x8c\x6c\xa8\x17\xe3\x6c\x5b\x04\x62\x11\x80\x36\xa0\x8a\x9b\x69\xd5\xf8\x69\x5f\xbe\xb1\x3e\xca\x03\xa1\x2b\xf1\xdd\x98\x6c\x77\x2a\xf3\x18\x7f\x6a\xa8\xe0\x4a\x93\x42\xe0\x1c\xe0\x04\x94\xb4\xf3\x41\xc5\x01\x0d\x20\x90\x1a\xa8\x45\xd7\x43\x24\x9b\xab\x86\xf8\xf1\x80\xbd\xf7\x56\x03\x9a\xd6\xa2\x62\x6f\xd9\x6c\x5f\xbb\x7c\x3c\x29\xf4\x84\x17\xbe\x8a\x3a\x50\x02\x87\xb9\xe4\x3b\x70\x95\xfb\x29\xf0\xce\x5f\x8a\x1e\x79\x23\x8d\xfd\xcf\x05\x44\xcd\xa0\x21\x4f\x32\xfd\x46\x1a\xf8\xe6\xd1\x81\x85\xcb\xbd\x37\xa8\x9c\x0d\x8f\x83\x05\x0e\xa3\xbb\x20\x17\xc2\x47\x63\x00\x48\x9c\x03\x32\x80\x95\x6b\x72\x29\x88\x54\x1e\x26\xc6\x97\xdd\xd5\x6e\x0a\x6f\x73\x89\x0c\xa6\x0b\xe6\x70\xa0\x94\xaa\x06\xc9\x15\xd3\x05\xdb\x2b\xf7\xbf\x80\x4d\x06\x8c\xd5\x21\x84\x04\x8a\xc7\x52\xc3\xc6\x3c\x21\x39\x53\x63\xc8\xbc\x4c\x26\x9b\x1f\x50\x77\xba\x8d\x9f\x8d\xa8\x77\xfc\xe2\xce\x98\x01\xac\xee\x15\x04\xf1\xdc\x97\x61\xe2\x2c\xc8\x22\x72\x5a\x58\xa4\xf8\x1f\xcb\x09\xe0\x5c\xfe\x05\xc5\x9e\xf5\x80\x9c\xfa\x0e\x9c\xf1\x6f\xce\xd0\x16\x4f\x63\x67\xb0\x72\xfc\x4f\x25\xbf\xa3\x19\xc3\xd0\x36\x2a\x42\x5d\x4c\x39\x9a\x63\xd3\x3d\x57\xf1\xd9\x52\xa9\xe0\x38\xd9\xbb\x65\xb3\xbd\xde\x1c\x22\xed\x5d\x8a\xbd\x2a\xfd\xb9\x86\x3a\x81\xa1\x81\x4d\x7d\x0f\x7e\xdb\xdb\x36\x67\x7f\x22\x71\x7e\x03\x2c\x71\x46\xa0\xb3\x8c\x6a\xdd\x2d\x73\x74\x79\xfd\xb1\xeb\x68\xce\x2a\x83\xc7\x05\x2c\x26\x18\x10\xb5\x3d\x5b\x15\xc4\xd1\x77\x0f\xae\xe9\x04\xa5\x3b\xd7\x3e\xa4\x7d\xe9\x83\x26\x55\x0d\x13\x84\x44\x89\x69\x9c\x6b\x56\xf9\x24\x97\xc0\xeb\x3b\xf0\x7a\xc8\x51\x5c\x2f\x91\x6b\x50\x71\xb9\x4f\x9d\x10\xd2\x10\x2e\x92\xac\x4c\xb1\xce\x23\x0c\x05\x05\xb9\xab\x48\xbf\x01\x70\xee\x81\x3c\xdf\x85\x09\xbc\x3c\xe2\xbd\x9f\x73\x31\xab\x4d\x37\x15\xb8\x06\x83\xc7\x07\x61\xb5\xed\xbd\x8e\xd6\x44\x08\xd6\xcb\x59\x9e\xd5\x65\x8c\x97\x7c\xa8\x18\x39\x9b\x50\x21\x58\x16\xe5\x8b\x3a\x43\x46\x68\xe1\x04\x82\x87\x6b\xdc\xb4\x5f\xef\xdc\xe4\xe9\x98\x08\xd9\xc9\x5b\xef\x5e\xfb\x69\x37\x52\xda\x5a\x27\x6c\x57\xd5\x70\x22\xa7\x24\x95\x64\x0a\xb5\xfc\xef\x2c\x3b\x02\x4f\xa4\xf6\x8c\x2c\x5a\x29\xc4\x06\x24\x32\x2f\x94\xcc\xb9\xf6\x11\xe0\xee\xe0\xb6\x9a\x60\x99\x95\x2d\xea\xe6\x2c\x2b\xb8\xf2\xf2\x8c\x18\xaa\xc6\xcc\xd8\x69\x88\x28\xf3\x21\x6b\x9d\xfe\xf9\x10\x05\xbb\x9e\x7b\x87\xa8\xed\x36\x79\x42\xd0\x7f\xff\xfd\x9b\xce\xad\x60\x17\x9d\xe0\x54\xaa\x2c\x9d\xf2\x14\x9d\x5e\x9a\x1c\xd8\x89\x0f\x9f\x7f\xdf\xd6\xe9\x94\xa7\xf7\x03\x80\x8f\xec\xb1\x00\x20\x00\x01\xd7\xb9\x88\x43\x4d\x69\x78\xc1\x21\xb9\xe0\x98\x1b\x63\xff\xc2\xaa\x2d\xf9\x90\x8b\x2a\x0b\x2b\x1c\x06\xd0\x55\x7b\x1f\xbc\x36\xa1\x99\xc1\xac\x06\x48\x0c\x90\x66\x42\x34\xcf\xcb\xcc\x50\xc1\x64\xa9\xb3\x59\x6b\xb4\x78\x1a\x20\x8f\x32\xf6\x11\xb1\xb8\x0b\xbf\x0a\x83\xea\x7c\x6b\x8c\xb9\x5f\x1e\xe6\x73\x8c\xab\x0a\x17\x4a\x8f\x02\x13\x0b\xc9\x32\xec\x23\x4b\x5c\x64\x6b\x91\x95\x63\xbe\x26\x78\xff\x37\x56\xe2\xbb\x2a\xa2\x5c\x6a\x56\x65\xb6\xb7\x6d\x62\xf2\x74\x15\xb9\x1f\x94\x59\xdf\x2c\x2e\xbb\x9d\xb2\x82\x89\x14\x2a\x82\x45\xb8\x8a\xcb\xdd\x2a\xac\x5c\x75\xad\xcd\x29\xd4\xc5\x47\xa3\xa8\x25\x37\x39\x24\x55\xba\x62\x5d\x7c\x44\xa8\x68\x4f\x3a\x9e\x47\x15\x5c\xf2\x9b\xe3\xd1\x0f\xde\x24\xf9\x7e\xb5\xd7\x91\x8a\x3a\xb4\xd7\xf5\x80\xd5\x05\x35\xd2\xdd\x5b\xe2\xc8\xd2\xfb\xd6\x4a\xd7\x0b\xca\x43\x37\x56\xb5\x6b\x1e\xf9\x49\x14\x4e\x1f\x41\x4e\x6a\x97\x72\x42\x2f\x71\x44\x43\xb3\x75\x5f\x36\x9b\x11\xaf\xd0\x64\x1d\xde\x46\x24\x1d\x6a\x77\xba\x89\x5c\x5e\x0d\xd1\x16\x96\x55\x08\x57\x29\xc4\x3a\x62\xf5\x10\xf5\xb0\xa9\xa1\x9a\x99\x76\x56\x8d\xf9\xb0\x34\xcf\xe9\x71\x16\x2c\xc0\x0e\x01\xd1\x3e\x31\x93\xf4\xff\xe6\x64\x02\x51\x7b\xd2\x4a\x03\x1e\x20\xbe\xe2\x10\x0b\x6e\x5a\x9c\x23\xb5\xc7\x90\x50\xd3\xba\x5b\x4c\x2b\x7a\xef\x56\xf0\xfe\x7d\xe7\x96\xa2\x76\x48\x63\xc7\x83\x50\x6f\xa0\x14\xfc\xa7\x32\x96\xd4\xa1\x36\x43\xd8\xa3\x7b\x7e\x5b\x1b\x19\x27\xac\x32\x11\x9d\x73\x7d\xdb\xa5\x68\xd6\x37\x67\x17\xf5\xc1\x75\x84\xff\xe6\xec\x82\xb8\x6f\x5b\x59\x71\xba\x98\x71\xee\x5b\xd3\x69\x9c\xb0\xca\x34\x9a\x72\x7d\xfb\xe8\x0d\xbb\x8b\xf4\xcd\xba\x38\xe3\xc7\xb6\x32\xf9\xba\x22\x51\xf1\x9b\x99\x2c\xc9\xd4\x65\xd2\x3b\xa1\xf6\x86\x17\x27\xe4\x42\xe8\x52\xb1\xca\xfb\xd9\x94\x6f\x2d\x27\x7d\x4e\x8d\xbd\xef\x85\x1b\xcf\xd9\xcc\x55\x50\x65\x40\xb2\xed\x5c\x47\x0c\x4a\xe5\xbb\xc1\x7e\x0b\x6b\x8e\xfe\x72\xe4\x63\xd0\x7a\x2e\x4b\x38\x14\xdb\xf2\x0f\xd9\xc3\x8e\x0a\x63\xc4\xc7\xfb\x32\x94\xa6\x21\x47\x29\xbb\x3b\xd2\x29\x3d\xee\xc1\x6b\x7c\x2a\xab\xa9\xad\x89\x6a\xb2\x77\xbc\x37\x20\xd7\x3c\xe7\x19\x55\xd9\xac\x56\x1b\xb8\x7a\xce\xb2\x00\x3f\x21\x38\xb3\x5e\xec\x91\x03\xa9\x60\xe6\x84\x0a\x92\x31\x9f\x27\xe3\x2e\xd4\x0c\x45\xc0\xc3\xc7\xa6\x22\xe4\x41\x6d\x84\x48\x50\xba\xa2\xc1\x7b\x64\x37\xb5\x32\x28\xe7\x15\xc5\xe6\xc2\x92\xf1\x01\x79\xbf\xa8\xf5\x35\xdc\x0d\xff\xc4\x53\x81\xf2\x41\x75\xb3\x7b\xf6\xcd\x9f\x53\xe8\x9e\x0e\x4c\xeb\xb5\xba\x31\x37\xef\x58\x21\x3b\x09\x00\x38\xa4\x61\x09\xe3\xc6\x7e\x21\x35\x87\x7a\x99\xd4\x40\xf7\x59\x65\x78\x52\x66\xd4\xca\xc4\x68\x07\x1b\x90\xf3\x8b\xab\x77\x17\x67\xa7\x37\x17\xe7\x27\xc4\xcf\xc4\x63\x69\x6d\x40\x6e\xe2\x2a\x42\x51\xc8\xab\x2b\xd5\x12\xde\xd5\x73\xc4\x87\x8a\xaa\x0c\x21\xd4\x86\xa0\x82\x5c\x0a\x6e\xaa\x2a\xbd\x18\xa4\x95\x49\xe1\xc2\xae\xec\x68\x67\x87\x1b\x73\x0c\x9d\x10\x6e\x32\xfb\x73\x7d\x36\xb8\x1d\x58\xf1\x33\x2c\x65\x8d\x16\xf7\x00\x92\x43\x05\xdc\x6d\xc9\xee\xbe\x30\x67\xc7\xeb\x71\x83\x06\xf6\xaa\x36\x2a\x52\xfc\x50\x0e\xdc\x57\x45\x59\xd0\x28\x99\x58\x5e\xb2\x3f\xd8\xf7\x82\x42\x36\x57\xfa\x3d\x4c\x1a\x17\x7e\xaa\xe3\xd6\x80\x90\xb7\x3e\x84\x19\xb2\x56\x17\x57\x91\xc7\x52\x02\x51\x2d\xf2\x06\x86\xfa\xd6\x00\xe5\x30\x7e\xa9\xab\x14\x35\xe6\x77\x4c\xe0\xc6\xb6\x4b\x90\xfc\xeb\x3b\xc2\xfc\x5d\xb5\xee\xf7\xef\x5e\x6d\x77\x49\x78\xcf\x3a\x2e\xe8\x4c\xe6\x39\xd6\x0f\x9a\x84\xec\xb3\x2a\x81\x2c\xdc\xf6\xad\x29\x2c\x58\x09\x69\xb4\x06\xa9\x1b\x74\xca\x0f\x6a\x28\x28\xe1\x6b\x17\x8d\x2f\x2a\x39\xb5\x7b\x99\x5f\x57\x74\x4b\xfb\x92\x1a\x8e\x64\x1f\x85\x15\x1f\xbd\xbb\x38\x3d\x7f\x7d\x31\xc8\xd3\x47\x27\x19\x4c\xa4\x85\xe4\xc2\xe8\xf5\x6a\xc9\xba\xa6\x26\xed\xc9\x4a\x78\x69\x57\xae\x7b\xe1\x07\xc6\x21\x0e\x7e\xb6\xa8\x56\x59\xca\x0c\xe5\x99\x8e\xce\xd1\xc8\x42\x66\x72\xbc\xb8\xe6\x6f\x87\x03\xfa\x3d\x56\x1e\xe9\xd3\xbe\x3d\xf9\xed\xca\xeb\x6d\x5a\x35\xd4\xe1\xe1\x5b\x33\x40\x8d\xc1\xb0\xd7\x20\x07\x43\x47\x85\x67\xba\xdd\x07\x11\xbc\xe6\x60\x80\xda\x20\x5c\x62\x5f\xc6\xad\xaa\x8b\x16\xb5\x49\x69\x2b\x91\x3d\x34\xe8\xd6\x0b\x63\x96\x06\xad\xef\x85\x53\x87\xd9\x7f\xba\x31\x75\x22\x57\x28\xd6\x0f\x85\x7c\xa0\x7b\x87\x54\x11\x77\x8d\x69\x9e\x37\xbc\x78\x33\x0d\x3e\x95\xcd\x9a\x06\x98\x4a\xf6\x09\x56\x2b\xcc\x43\xcf\xb2\x59\x55\x1a\xd0\xa9\xc2\x74\x8c\x05\x7a\x94\xb3\xdf\x16\x8a\xdf\xf1\x8c\x8d\xa1\x08\x28\x17\xe3\xa8\x97\xa2\xcf\x58\x87\xe2\xf0\x6c\x6e\x5d\xf6\xa8\xb4\x89\x4b\x3f\x03\x5e\xbc\x79\x7b\x03\x85\x65\xc1\x29\x78\x6f\x01\xdb\xbe\x10\x1a\x8d\xf4\xfb\x7d\xd0\xfb\x0f\xfe\x69\x65\xc5\x34\x3b\x24\xdf\x33\xf7\x1e\x09\xc5\x6f\x15\x74\x9b\x99\xc8\x50\x7d\x14\xd6\x5a\x41\x16\xd0\x11\x9d\xe6\xee\xa9\x23\xfb\xa4\x15\x8c\x90\xdd\xd4\x9e\x87\xe6\x9a\x58\xce\x0f\xfd\x3d\x8f\x2f\x57\x6e\x91\xf4\x6f\x4c\xe5\xbc\x55\x74\x11\x7e\x06\x8f\x4c\xe1\xe8\x21\x25\x7a\x96\x67\x5c\xdc\x56\x15\xa3\x46\xd2\xe2\x10\xc6\xe8\x73\x71\xeb\x31\x56\x31\x9a\x2d\xa7\x94\x9b\xe0\xc7\x56\xa9\xa4\xd9\xc0\x78\x77\x33\x2b\xd0\x17\x1e\xae\xbd\x73\xf5\xc6\x24\x6e\x6f\xef\xd9\xed\x97\xeb\x6e\x9d\xd6\xf7\x2f\xaf\xcf\xae\x6b\x5d\x42\xad\x4e\x07\xdf\x3d\xa6\x71\x79\x19\x4b\x80\xed\x3c\xa1\x64\xc7\x7f\x5a\xe7\xa9\xed\x93\xac\x5c\xff\x0c\x86\xf9\x5c\x49\x65\x68\xb6\x25\x22\x90\x4c\x68\x71\x5a\x9a\xc9\x39\xd7\x89\xbc\x63\x9d\x55\x9d\xe9\x04\xab\xf6\xfa\x82\x71\xdc\x1f\x3a\xce\x46\xce\xfe\xf3\xf4\x8a\xd0\xd2\x9e\xa2\x71\x65\x25\xb7\xea\xe2\xf6\xeb\xbf\xc6\x80\xfa\xad\xac\xde\xcd\xf5\xe0\x6b\xdf\x39\x04\xb6\xe8\x10\x80\x3b\xfe\x9c\x9d\x00\x5c\x70\xc3\xa9\x91\x2d\x7b\x59\xd5\xf5\xf7\x52\x1b\x99\x3b\xf4\xbc\xf4\x13\x81\x57\x16\x18\x6e\x6d\xee\x7a\x8d\x7e\x10\xb4\x01\x38\x97\xc2\x8a\xc5\x34\x61\x8d\x08\xc0\x1e\x54\x6e\xc4\xb9\x79\x78\xe6\x2f\x2e\x32\x13\x4a\x3e\x65\x7f\x3b\xa9\x55\xd2\x9e\x6b\x84\xe0\x8d\x0a\x55\x71\xfd\xad\x5a\x62\xf8\x4f\x5d\x6f\xb6\x33\x7b\xe1\xae\xfe\x4f\x49\x33\x84\xc6\x9b\x6d\xdb\x88\xea\x90\xed\xb8\x48\x7f\x9e\x1e\xe6\x6f\x82\xd6\x5c\x6a\xac\x16\x85\x4f\x18\x45\x85\xb6\x07\x51\xd7\x8d\xf6\x9d\x6b\x67\x9f\x1c\x98\xa4\x68\xdd\xae\xfd\x81\x22\xb3\x71\xa9\x0e\xee\xaf\x42\x44\x76\xdb\x55\x3d\x88\xb7\x05\x70\xb7\xab\x69\xa3\xb6\x11\x64\xb6\xe4\x15\xd7\xc6\x97\xc5\x87\x2f\xb8\x76\x35\x5d\x41\xd2\xb9\xb2\xaa\x13\x2f\xfe\x4e\xd3\x54\x9d\x20\x27\xf1\x2d\x75\x15\xc8\x3b\xbe\xee\x12\x15\xc1\x1f\x77\x60\x66\x85\x2b\xcd\x76\x73\x76\x45\xb0\x2b\xc6\x9f\xbf\xc4\x76\x9e\x5f\x7c\xfe\xe5\x8b\xd6\x07\xfa\x74\xe1\xcf\x1b\x5a\x0e\xb6\xee\xb1\x79\x16\x51\x73\x20\x2e\x60\xbc\x1c\xd0\x43\x77\x77\x11\x8f\xec\xa1\x06\x2a\xbd\x99\x50\xb1\x8b\x30\x7b\xd2\x08\x33\x12\x92\x1e\x90\x26\xdc\x9f\xaa\x20\x41\xb9\x7a\x7e\x04\x65\x2d\x2c\xd6\x63\x4d\x1d\x5b\xf0\xfe\x5a\xfd\x2e\xf2\x3e\x41\xcc\xf5\xf9\x9b\xeb\xbf\xbf\x3a\xfd\xfa\xe2\x15\xac\xd2\xc5\x55\x59\x34\xe0\x62\xe3\x38\xa2\xf6\x68\xd5\x46\x13\x5c\x0f\x8c\x6e\x7e\x8e\x37\x2f\xaf\x1b\x8a\xb2\xfd\xa6\xa3\x73\xe3\xbe\xd2\xb2\x18\xb5\xda\xfb\xe3\x9a\xae\xa0\x6d\x04\x53\xdb\x4b\x71\xd8\xd8\xc2\x15\x95\x64\xaa\x29\x43\xf6\xa4\x70\x85\xf7\xd6\x57\xd6\x9e\x00\x79\x06\x46\x7c\xbb\x5f\x84\xc1\xd6\xcd\xf7\x0f\x04\xab\xb6\x2c\x5e\x75\xcf\x7d\xd9\xbf\x86\x51\xde\xc9\x63\x2f\x29\x46\xe4\x28\x4b\xaf\x2d\xa5\x66\x3a\x14\xb9\x7f\xa6\x98\x52\x2c\xaa\x88\xdb\x85\x7a\x2d\x2c\xa9\x5b\xeb\x07\x55\x73\x6c\xd4\x32\x06\x96\xd5\x90\xf6\xbe\x7d\xea\xd4\x4b\x5d\xd0\x64\xab\x95\x1f\xab\xaf\xf0\x1b\x48\xa9\x7e\x7c\x02\x08\xaf\xdd\x62\x40\x69\x98\xaf\x2b\x22\x9f\xf9\x81\xcd\x44\xae\x4e\x27\xe4\xfb\x29\x14\xd2\x27\xc9\xc5\x19\x5f\x4f\x7c\x7c\xe4\x51\xa8\xe7\xf7\x1b\xaa\x2e\xdb\x56\x5b\x8a\x89\x34\x52\x6c\x1c\x24\x7e\xb5\x60\x78\xfd\x1e\xe3\x13\x67\x55\x93\x90\xa8\x43\x1f\x44\x18\x06\x83\xbe\x15\xe3\x3c\x97\x90\xc2\x9b\xf6\xeb\x86\xfd\x47\x97\x3c\xd2\xcb\xf3\x2d\xdd\xb9\x4f\x29\xf9\xb0\xab\x09\x76\xab\x21\x14\x69\xe7\x8c\x8b\xcb\x73\x27\x77\xf9\xac\x0a\xed\xd0\x8e\x2c\xc7\xbb\xad\xf1\x45\xa9\xcc\x54\xaa\xee\xa9\xc6\x57\xb5\x81\x0d\xaf\xbe\xfb\x6d\x2e\x9b\xe8\x39\xde\x11\x5c\xe3\x13\xdf\x93\x6b\x70\x98\x36\x6a\x45\x37\x6f\x46\x88\x62\x7f\x80\xcb\xf3\xb4\x97\x66\x43\x2e\xf4\xb0\x29\xa9\x5b\x15\xbc\x3d\x96\x75\xdc\xe1\x77\x6e\x98\x33\x10\xd8\xb3\xa9\x88\x04\x0d\x97\xd0\x4d\xbf\x35\xa2\xa0\x24\xf6\xed\xeb\x40\x0f\x2e\x0d\xcb\xb1\xc1\x2f\xcd\x32\x0b\x4b\x29\xe2\xb2\xc1\x2e\xed\xb4\x47\xb0\xf2\x6e\x4e\x0b\xdf\x2d\x59\x4e\xc5\x94\xaa\x94\x9c\x5e\x5d\x6e\xe7\xea\x77\x08\x2d\x46\xfc\x69\x57\x09\xaa\xde\x56\x51\xa6\x8c\x0c\xb9\xd1\x55\xc3\x33\x66\x62\x6d\xd0\x92\xb7\xe0\x23\xb2\x97\xd4\x5e\x48\xf7\xbe\x88\xfb\x09\x22\x13\x43\xb3\x46\x03\xfa\x17\x2f\x5e\xa0\xf1\xea\xc5\x9f\xfe\xf4\x27\x6c\x42\x93\xb2\x84\xe7\xf3\x0f\xc2\x53\xff\xeb\xf8\x78\x40\xfe\xeb\xf4\xf5\x2b\x68\x88\x57\x18\x8d\xe5\x2e\x70\x66\x6c\xc9\x1d\x0d\xd6\x3d\xf2\xbf\xaf\xdf\xbe\xa9\x5a\x69\xd4\x7f\x75\xdd\x8c\xdd\xf6\x06\xe4\x3c\x0a\x01\x8a\xcd\x53\xd4\x4c\x5c\xef\x17\x43\xe8\x68\x84\x6d\x1e\x87\xbe\xcb\x28\x5e\x29\x9f\xd9\x0c\x2d\x99\xb1\x47\x83\x3d\xfe\x0c\x62\x93\xac\x22\x8d\xc6\x3c\x9f\x5c\x8f\xa1\x56\x30\x57\xa0\x7f\xb0\x94\x1e\x36\xf5\x1e\x69\xe8\xd4\x50\x95\x82\x53\x4c\x5b\x99\xd2\xb5\x9e\xc3\xc9\xc2\xd2\xed\x22\x9e\xd2\x07\xd3\xba\x83\x40\x0d\xb1\x7c\xe1\xda\xaa\x3b\xf8\x3f\xd1\xad\xb8\x2e\x38\xf6\x81\x7c\x22\x75\x9e\x1f\x56\x83\x67\xe5\x52\xd6\x03\xb9\x20\x34\x93\xd0\xe5\x28\x1c\x6d\xc5\x8f\xa2\x2e\xe3\xeb\xb7\xd2\xb9\xf2\x5e\xd7\xea\xab\x48\x85\x5e\xd3\xd6\x3d\x4e\xea\x26\xed\x28\xb5\x9f\x0e\x65\x69\xbc\x0b\x18\xe7\xc4\xf6\x7e\xd8\x63\xba\x43\xe5\xc0\x0d\x8a\x0d\x6e\x52\x74\xb6\x73\xdd\xca\x3a\x99\xaf\x09\x01\x3d\xc2\x68\x32\x21\xb7\x6c\xd6\x47\xc2\x54\x50\xc8\x46\x09\x5d\xa4\x5c\x6d\xc7\xba\xbf\x24\x61\xa9\x95\x6c\x1d\xb0\xbc\x47\xbd\xc2\xa2\x90\xcd\xe2\xc5\x47\xed\x24\x1d\x57\x33\x52\x44\x0a\xbc\x2f\x4c\x1c\xf5\x61\x0d\x45\x22\xb1\x09\x73\x3d\xeb\xc2\xde\x2f\x96\xda\x61\x7a\xd5\x9b\xab\x30\x02\x4b\xe8\x1c\xab\x2a\xc5\xdc\x68\xd7\x74\xd8\x89\x6d\xf0\x42\xea\x4b\xf1\x46\xa1\x08\xd0\xda\xcc\xb5\xb3\x71\xcf\x7a\x28\x05\x40\xd4\xb2\x42\x34\x33\xa5\x03\x0d\xf6\x4d\x2a\x45\xc6\xb4\x26\x1c\x76\x98\x53\x75\xcb\x7c\x51\x12\x9a\x0d\xc8\x95\x5d\x64\xa8\x7c\x84\x35\x70\xef\x30\x8c\xcc\xde\xd1\x38\xdd\xc5\xbe\x64\x7f\x30\xd8\x47\x0a\xbe\x20\xf9\xa5\x03\x66\x6c\x56\x40\x75\x83\xc2\xa9\x8d\x96\xc6\x85\xc6\x32\xb0\x56\x6a\x83\x32\xc7\x12\xb2\xb8\xcc\xc4\x73\x28\xda\xba\xfc\xce\xfc\x76\x36\xa8\xf6\xb9\x69\x91\xea\x4d\x4a\x54\xb7\x72\x27\xd4\x3f\x9b\x97\xa6\xde\xa8\x30\xf5\x5c\x6f\x65\x77\x44\xee\x9a\x75\xaf\xd4\x7b\x8f\x42\xca\x79\xa7\x22\x9f\xfe\xb3\xac\x26\x4c\xde\x46\xea\x73\xdd\xca\x32\xf6\x49\x89\x79\x97\xa3\x45\xbd\xb6\x7c\xba\x5b\x25\x27\x07\xa2\x69\x21\xf0\xf4\xf2\x5d\xb7\xee\x1c\xa4\xb3\xc0\xd7\xfc\x74\x11\x00\x9b\x9f\x76\x4e\xb9\xe6\x67\xee\x36\x05\xea\x5e\x44\x21\xe9\x00\x4a\x23\xa1\x12\xb3\x09\x57\x6e\x00\xed\xdf\x1d\x8f\xa2\x56\x56\xd1\x32\x2b\x4d\x48\xcb\x59\xc0\x1a\x60\x52\x5f\xb7\x19\x93\x21\xfd\x63\x11\xa3\x00\x16\x89\xf4\xb7\x2b\xcf\xc0\xcf\x46\x57\xba\x6b\x87\xb1\x5f\x6d\xe0\xc6\x3d\x60\xe8\x65\x86\x8d\xe1\x78\xed\xaa\x21\xf8\x08\xe2\x9a\x0c\x03\xc1\x1b\x46\xa3\x80\xe4\xc5\x11\xd7\xa9\xa7\xf3\xce\xda\x19\x56\xdc\x12\x9d\x15\xe1\xf4\xea\x72\x8b\x12\x7d\x34\xeb\xaf\x5a\xa6\x07\xd3\x4d\xad\x6f\xca\x79\xb5\x73\x67\xe0\xb5\x14\xe6\xd9\x8b\x86\x73\xcb\x7e\x69\xe9\x62\x64\x56\x6d\x14\x65\x73\x2d\xdc\x03\x05\x8d\x0a\xb9\x79\x07\x1f\xdc\xd7\xe7\x2e\x46\x3e\xa2\x48\x08\xf0\xe8\xd4\x00\xda\x7f\xe6\x5b\x90\xc1\x66\xc9\x35\xf4\x26\x41\x1d\x2f\x52\x16\x0b\x99\x9e\xb8\x56\xb9\x42\x48\xec\xfa\xa5\x7b\xd8\xdc\x44\xf7\x50\x09\xb4\x82\x42\xe4\x96\x55\x91\x01\x7c\x63\xd1\x60\xa3\x36\x35\xf7\x69\x54\x63\x0f\x10\x76\x7e\xd5\xf5\x14\xc9\x3d\xfb\xce\x90\x88\x0b\x6d\xd6\xc9\xa2\x6e\xac\xc6\x99\x42\x1f\xeb\x64\xc2\x72\x8a\x45\xe1\xfc\xf6\x2c\x95\x99\x2a\x6e\x0c\xc3\xaa\x3e\x4c\xe5\x9a\xc8\x51\xaf\xd6\x21\x6e\xef\xee\x78\x6f\x93\x7e\x1e\xf7\x6c\xb9\x42\xaa\x53\xd8\x02\x30\xae\x6a\xd2\x99\xc5\x6b\x50\x17\x32\xa8\xe4\x28\x1a\x46\x06\xcb\x60\xee\x10\x7a\x8f\xbe\xf1\xa7\x54\x91\x7a\x41\x48\xd8\xa9\x48\x3b\x15\x69\x2b\x2a\x52\xc4\x58\x3c\xc1\x71\x80\x8a\xd5\xa6\xb8\xa2\x94\xd7\x9d\xaa\xac\x9e\xa8\x4a\x8c\x45\x4d\xaf\x35\x49\x55\xb7\xa2\x59\xd5\x67\xdf\xeb\x52\x0e\x8f\x4b\x33\xea\xff\x99\x30\x91\xc8\x14\x0f\xdf\xce\xaf\xb4\x01\xd1\xa6\x52\x3f\xe2\xb5\xe4\xfe\x5d\xb1\x25\x0e\xe6\xde\xf4\xe8\x36\xa2\x03\xde\x57\xf7\x72\x4b\x0c\xbe\x62\xeb\x21\x09\xd6\x6d\x3f\xe4\xc8\x3b\xfe\x5e\x79\x09\xb1\x17\x30\x20\xb7\x6f\x73\x4a\x0e\xf0\xcb\x41\x52\x94\x3d\xf7\xc0\x20\x67\xb9\x54\xb3\x5e\x78\xc8\xfe\x58\x1b\xe5\x9e\x38\x04\x99\x20\x29\x95\x55\xf6\xb2\xd9\xa7\x2a\x1d\x78\x00\x3d\xb2\x70\x10\xce\xa9\x5b\x37\x98\xf8\xd3\x08\xbf\x0b\x85\xae\x40\x95\xaf\xba\xe3\x8c\x42\xf1\x3d\xdd\x0b\x2a\x2a\x7c\xcb\xc4\x1d\xb9\xa3\xaa\x43\xeb\xea\xf8\x73\x4f\x79\x20\xe5\x77\x5c\x6f\xd6\xb0\x6e\xa1\xd6\xcc\x5d\x59\x2f\x59\x9a\xa2\x34\x8e\x52\xfa\x5b\xe1\x53\xbd\xc3\x6d\x68\x08\x45\xc7\x7b\x1b\x2d\xe3\x93\x69\x0a\x8b\x9f\x0d\x5b\xc3\xe2\xe7\xbe\x0d\x62\xeb\xb3\x6c\x8c\x36\x5b\x6d\xf7\xec\x3f\x1e\x2d\xb6\x71\x0f\x2b\x16\x59\xd5\x27\xf0\xc2\xe9\x23\x5d\x34\x8c\x07\xd9\xa2\xad\xc6\x15\x42\xff\x35\x9b\x69\xb6\xe4\x7a\x75\x99\x7a\xbf\x71\xbf\xeb\xb5\xab\x89\xbf\x73\xba\xb6\x42\xbe\x9d\xd3\x75\xe7\x74\x6d\xfb\xd9\x39\x5d\x77\x16\x85\xfa\xe7\x93\xb6\x28\xec\x9c\xae\x3b\xa7\xeb\xfd\x60\xf8\x20\x4e\x57\x27\xc6\x55\x1e\xd7\x47\x75\xb8\xba\xb6\x2e\xa7\x49\x22\x4b\x61\x6e\xe4\x2d\x6b\xed\x41\x68\x25\xcc\xcf\xcd\xfe\x78\x92\x7d\x77\xc1\xa2\x93\x78\xb0\x89\x60\x40\xcb\x94\x5b\xe1\x7d\x63\x04\x3a\x75\x13\x78\x39\xdd\x92\x62\x91\xb2\x34\xcc\xec\x2f\xa9\xb1\xb0\x1e\x90\x53\xa2\x58\xc2\x0b\xee\xba\x77\x53\xfc\x1e\x31\x2c\x54\xd9\xe7\x46\xb3\x6c\xe4\xaa\x9d\x8b\xb8\x29\x4c\x25\x82\x3b\x0a\xb7\xf0\x35\xc8\x73\xa4\x2f\x92\xed\x3b\xe4\x28\xf6\x4f\xcf\xac\xdc\x6a\x6e\xe2\x19\x62\xa3\x08\x6c\xa5\xd6\x8b\x06\x5e\x56\x70\x97\x81\xfc\xd0\x17\x9b\x7d\x2c\xb8\x02\xe4\xbd\x66\x89\x14\x6d\x3a\x62\x2e\x39\xa0\x8b\xe6\x4c\xfe\xa4\x9c\x45\x13\x1b\xe0\x87\xbe\x97\x77\x34\xe3\x29\x37\xb3\xe0\x6b\x73\x5d\x96\x28\xde\x98\x70\x8c\xba\x02\x23\xa1\x45\xa1\x24\x4d\x26\x4c\x47\xeb\x46\x91\xc3\x25\x62\x85\xa8\x73\xec\x04\x06\x52\x07\x8c\xb1\xac\x2f\x9b\x11\x25\x8d\x77\x97\x2f\x79\xe1\x4d\x34\x19\x0c\x47\xfe\x65\xd4\x0c\x7c\xea\x32\x9e\x02\x57\xc5\x47\xf1\x1f\x9a\xc8\x2c\xf5\xf5\x3d\xfe\xfc\xc2\x8a\x79\x89\xc3\x41\x4b\xe5\xa0\x02\x84\x91\x24\xb3\xac\xd8\x52\xbe\xe5\x83\x3f\xff\x23\x99\xc8\x52\xe9\x41\x9c\x24\x74\x0c\xdf\xa1\x8a\xe6\xc5\x44\x43\x32\x46\xb5\x21\xc7\x2f\x48\xce\x45\x69\x39\x50\x67\xb4\xe9\x2e\xd9\x44\x32\xcd\x97\x7f\x6c\x3d\xae\xab\x34\x33\xef\x91\x74\x58\x55\x60\x25\x5e\x27\xd4\xb8\x9b\x84\xc9\x65\x58\xc7\xba\x21\xe2\x38\xa2\x1b\x43\x5b\x18\xf9\x00\xf7\xeb\xa7\x52\x0e\x67\xa6\x4b\x22\xe2\xff\xc1\x11\xf5\x0c\x44\xff\x65\x9b\xea\x22\x55\x71\x91\x95\x2f\x7d\x90\x5e\x09\x63\xae\xcd\x9a\x4e\x09\x55\x8e\xe2\xca\xc7\xda\xb3\x95\xb1\x95\xf7\x3b\xa6\xa5\x80\x8e\xe0\x65\x5d\x6f\x1e\x4a\x12\x86\x3d\x0d\xcf\xab\x4e\x3b\x42\xe2\xfc\x6b\xa7\x7f\xe2\x62\x5b\x1e\x41\xb6\x50\xa3\xbb\xe5\x56\xdb\x49\x57\x1e\x25\x3a\xef\x15\x87\xd5\x6f\x81\xe6\x62\x8c\x25\xb5\xf3\x32\x33\xbc\xc8\xaa\x7d\x87\x01\x8e\x90\xc7\x66\x33\x1a\x59\x7a\x28\x26\xe7\x62\x29\x26\x30\x31\x1e\x84\xb9\x98\x30\x58\x19\x5a\x59\x7e\x50\x50\x45\x03\xf0\xa0\x6f\xaa\x3e\x74\x16\x38\x0a\x7e\x40\xa4\x3c\x96\x9c\x2b\x9a\x85\x8d\xc6\xbe\x9f\x6d\x22\x8d\x61\x82\x8a\x16\x06\xe6\xba\xaa\x07\x83\x88\x9c\x86\x10\x30\xec\xb0\xd1\xc0\x16\x27\xd4\x7c\x4d\x93\x5b\x26\x52\x6c\x3f\x04\xdb\x4e\x67\x82\xe6\xae\x14\x55\xd4\x53\xb9\x31\x5e\xf7\x9c\xa9\x01\x33\xe5\x7c\xaa\x2e\x72\xdd\x6d\xc2\xa0\xd4\x9d\x6b\xbd\xbc\xd7\xd8\xcb\x78\xd5\x3d\xd7\x68\x84\x51\xfc\x2e\x61\x9e\xff\xdb\x57\x6d\x73\xe9\x77\x2d\xf2\xd1\xe7\x16\xef\x42\x15\x79\x84\xbf\x40\xee\x83\xf1\x1b\xaa\x4e\xd1\xcc\x5e\xed\x59\x48\xcf\x6c\x1c\xee\x70\xb6\xdd\x86\x2a\x6a\xd8\x25\x8d\x76\xff\xdd\xd7\xe7\xf5\x4b\xfc\x8e\xa6\x52\x93\xaf\x33\x99\xdc\x92\x73\x06\x42\xd7\x43\x36\x04\x51\xc3\xf4\x29\x0b\x46\xe7\x74\xbc\xce\x3b\xd6\x27\xb9\x14\xdc\x48\xb5\x9a\x5e\xec\xfa\x13\x3e\x49\x39\x62\x35\x4c\x9f\x75\x31\x62\x8b\x60\x9b\x74\x23\x54\x70\x0d\x61\xb8\xaf\xe5\xb7\xe1\xa5\xfa\xfd\x44\x4e\xfb\x46\xf6\x4b\xcd\xfa\xbc\x85\xbf\xb5\xc3\xee\x6e\xd9\x0c\x9c\xcc\x1d\xf7\xf7\x2d\x0e\xab\x29\x07\x46\x82\x4d\x09\xbe\xb7\x2c\xfa\xdd\xd7\xe7\x96\x37\x0c\x62\x61\xef\x88\x99\xe4\x28\x61\xc5\xe4\xc8\xbd\xf8\x59\x02\xc5\x53\x8b\xae\x50\x39\x25\x89\xcc\x32\x97\xef\x2c\x47\xe4\x8c\x15\x93\x30\xd9\x63\xef\xf4\xe9\x4a\xdd\x16\x52\x76\x2d\xf9\x19\x5d\x18\x3b\xda\xdd\x97\x08\x71\xd4\xb0\x5b\x1f\x83\xc7\x42\x95\x67\xdd\x89\xf1\x01\x81\xf3\xc0\x5d\xf5\x6b\xbd\xf4\xe3\xd0\xcb\x7a\x39\x60\x1f\xc3\x51\x23\x37\x97\x23\x94\xa4\x53\x96\x12\x79\xc7\x94\xe2\x29\xd3\x24\xd0\x9b\x58\xf5\xe4\xd9\x63\xc3\x6d\x57\x99\xf8\xc9\x2b\x13\x6f\xa0\xe3\x44\xe4\xc9\x8e\x9e\x27\x4f\x34\xcd\xb9\x78\x76\x04\x4a\x27\x34\x63\x97\x6f\x3b\x28\x13\xd7\x38\xa2\xae\x4f\xf8\x2f\xa3\x82\x62\x6b\xca\x74\x7d\x1b\xf0\x85\x08\x99\xae\xb3\x8f\x3e\x80\x56\x30\xa6\x86\x4d\xd7\xb2\xbf\x7e\x45\xa0\xd6\x3f\x09\x72\xe7\x53\xea\x0f\x4f\x54\x1a\x2f\xc2\x72\xac\xfb\xb5\x4d\xf6\xe9\xce\xa9\xab\xd1\xc5\x6f\xa4\x51\x49\xd6\x23\xea\xe9\xd5\x25\xf9\x06\x67\xde\x6e\xa5\x3e\x25\x0d\x4a\x77\xe7\x32\xa7\xbc\x73\xa3\x8d\x49\xbd\x31\xb5\x5f\xee\x55\x98\x96\xe0\xbc\x71\x8f\x90\x11\x1f\x97\x56\x03\x73\x5a\xd3\xae\x88\xda\xa3\x08\x20\x95\xfc\x11\x59\x82\x7c\xc4\x61\x25\x73\xf8\x13\x04\xa6\x10\x5c\x93\x44\x33\xa1\x39\xf8\x49\x22\x67\xb5\x6b\xf7\x86\xfd\x05\x31\xbc\x10\x85\x94\x1e\x79\x25\xc7\x5c\xf8\x5b\x29\x9d\x1b\x6d\x44\x79\xd6\x16\x18\x3b\xa9\xe2\xc9\xa5\x0a\xad\xb3\x0b\x41\x87\x59\x9b\x28\x80\x3a\x59\xcf\x28\xf8\x39\x19\x8c\x3e\x4a\xb9\xb6\xff\x25\xd7\xd7\xaf\xc0\x26\x5e\x0a\x2f\xeb\x82\xbd\xd8\x91\xb5\x10\xe9\x8f\x17\x70\xbb\x77\x06\x29\xcd\x06\x35\xee\x2e\x45\x6a\x17\xcb\x74\x2d\xec\xc4\xcd\x87\x95\xfe\x42\xe4\x2c\x7a\xee\x87\x8c\xdc\x4c\x78\x72\x7b\x15\x99\xbe\xa5\xb2\xdf\x89\xe8\xab\x1a\x13\x6a\xfe\xb6\x4d\x82\xe8\x96\x7a\xd5\x5d\x81\xbd\x89\xe8\xf9\xb5\xdb\xb0\x9d\x86\x50\xad\x65\xc2\x2b\x3f\x07\x98\x4b\x2a\x82\x9f\x02\xc1\xdf\xee\x26\x80\xa7\xdf\x93\x37\xf9\x43\xf3\x5d\x4f\x75\xcc\x8b\xb8\xf0\x7b\xdd\xea\xc2\x11\x35\x36\xa8\xd2\x7d\x53\xab\xcb\xed\x65\xd3\x86\xd1\xde\x47\x71\xbb\x43\xf2\x52\x92\xef\xb2\x38\x77\x4c\xa1\x3e\xb7\xab\xcb\xb7\xb5\xad\xb6\x49\x64\x58\xa4\x0d\x37\x3c\x75\xf8\x9d\x33\xe3\xc3\x65\x2a\x64\x51\x66\x18\x2b\x71\xff\xe2\xe2\xde\x3a\x8b\xef\xd9\x92\x59\xff\x31\x0a\x6d\x76\x0d\x04\xfe\x75\xd4\xdc\x8c\x44\xb2\x17\x5f\xfe\xf1\x8f\x9f\x7a\x15\xce\xb6\x2a\xf0\x43\x94\xe1\x6c\x69\x12\xdd\x65\xda\xec\x32\x6d\x62\x54\x7c\xc8\x32\xaa\x5b\xce\xa5\xe9\x18\xe2\xda\x2d\xbc\xb5\x7d\xb6\x4c\xeb\x20\xd8\xae\x01\xb0\x1d\xf2\x61\xb6\x94\x05\xd3\x39\x16\xb4\x4b\xc6\xcb\x2e\xcf\xe5\xd7\x96\xe7\xb2\x49\x0c\x68\xf7\x9c\x96\x2e\xb1\x9f\xbf\xa6\xfc\x95\x0e\x97\xb1\x7d\x9e\x45\xf7\xec\x8a\xee\xf5\xec\xba\x5b\xb6\x36\x69\x69\x14\xdb\x67\x9c\x16\x51\x75\x10\xf4\x8d\x07\xb1\x3e\x96\x91\xf6\x62\x3d\x8a\x0e\x41\x3a\x28\x50\x38\xbd\xec\xd2\x4b\xd0\xe9\xe4\x6f\xaf\x1b\xae\x8d\xf0\xf5\xd3\x78\x34\x7e\x9d\x2e\x83\x5d\x63\x90\xe7\x6d\xd3\xd6\xb5\xda\x22\xde\x92\x00\x77\x1d\x18\xb1\x1c\xc6\x35\x0d\xab\x3b\x72\x7a\x75\x69\xd5\x65\x48\x9f\xa1\x99\x1e\x90\x05\x7c\xda\xdb\x25\x1d\x5f\xf7\xfc\x99\x1a\xc3\xf2\xc2\xb4\x3f\xec\x9d\x49\xfb\xc9\x4d\xda\x1b\xdb\xe3\xbe\x0b\x03\x43\x07\xc8\x32\xa7\xa2\x6f\x6f\x14\x18\xb7\x6b\x5e\xb0\x06\x09\x1e\x10\x1f\x95\x8b\xb0\xa0\x8a\x61\xd1\xa7\x7a\xc7\x5b\x1a\xf5\x3f\x7c\x18\x23\x24\xcc\xbd\xf1\xce\x91\x81\x36\x6e\x5a\x22\xe7\xc2\x3e\xdd\x76\x02\x14\xfc\xa5\x8a\xb8\x70\x4d\x6f\x36\x13\x86\xcc\xfa\x0a\x12\x51\xaa\xa7\xea\x92\x30\x8a\xc2\x34\xcb\xe4\x14\xdf\x1d\x33\x30\x0b\x7d\xbb\x16\x97\x61\x35\x64\x24\xe7\x56\xa9\x76\xc6\xcf\x78\x39\xe8\x8a\xb4\x12\x35\x53\x28\xb0\x2a\xe7\xcd\xba\x66\x26\x3e\x68\xab\x90\x0a\x0c\x84\xb6\xff\xf6\x81\x37\x58\x15\xd7\xd1\x84\x21\x9b\xd0\x3b\x2e\x4b\x85\xa3\x8d\x24\x7b\xee\x27\x60\x09\x33\x59\x06\xd3\x14\x76\x49\x0c\xbb\xd3\x0b\xe0\xf4\xa6\xfa\x11\x44\xf9\x54\x7a\x5b\x42\x9f\x7d\xe4\xda\xcc\xef\xc5\x83\xc8\x17\x6d\xdb\x16\xde\xdc\xe9\xc2\xb2\x85\xce\x1d\xd1\xbe\x8b\xc7\xd5\x05\x93\xbb\x6b\xf8\xe9\x13\xea\x87\xb6\xb6\x16\xe9\x4e\xd6\xd9\xb6\xac\x13\xdc\x55\x19\x4f\x66\x9d\x3b\x85\x55\x6e\x2a\x3b\x9c\x7c\x4d\x35\x4b\xc9\x6b\x2a\xe8\x18\xd5\xb2\x83\xeb\xab\xaf\x5f\x1f\xda\x63\x03\xb5\xef\xf2\x7c\xa1\x2f\xeb\x3a\x5e\xc3\x9b\x6d\xa6\x41\xcc\xed\x70\x03\x4e\xd4\x71\x8f\x5b\x4d\xe3\x20\x81\x9b\xb4\x2b\x10\x3b\x9f\x7a\xd9\xec\xf1\xd8\x20\x0a\x77\x79\x7a\xcf\xae\x8e\x3c\x59\x5c\xc6\x77\x0d\x91\x58\x4d\x1a\xfa\x64\x48\x35\xfb\xf2\x8f\x29\x35\x74\xc9\x03\x39\x4b\x39\xb5\x2f\x59\xf0\xfb\x3a\x32\x51\x4d\xbe\x0c\xa4\x6b\xcf\x27\xbc\x7e\xa3\x19\x96\x26\x04\xd4\x3d\x02\x10\xf7\xcf\x21\x7f\x08\x09\x8a\x54\xfd\x90\xc2\x16\x0a\x25\xba\xfc\x00\x23\x09\x17\xda\x50\x2b\x58\x1b\x06\x15\x30\xf1\xc9\x3e\xe6\xa5\xc3\x7d\x1b\x10\x50\x32\xc0\xd8\x37\xb5\x62\x00\xb6\x91\x86\x46\x90\x7f\x8d\xfe\xf8\x46\x15\x89\x0b\x83\x05\x7e\x82\xc6\x33\x1f\x2b\x85\xae\x07\xae\x09\x1f\x0b\xcb\xf2\x17\xe1\xfb\xca\xfd\x17\x8a\x4b\xc5\xcd\x42\x0d\xab\xd1\x01\xdb\x3d\xe9\xde\x49\xb5\xe6\x63\x61\xb9\xdb\x94\x81\x8d\xce\x39\x16\x12\x6a\x68\x26\xc7\xa4\x2a\x2c\xe7\x5e\xc1\x7f\x06\xf9\x21\x27\x5a\xba\xbb\x10\xac\x6f\x89\x14\xba\xcc\x2b\xc2\x9d\xb2\x82\x89\x94\x89\x04\x8b\x83\x66\xd0\x4d\xfc\xbd\xb6\x27\x45\xfe\x93\x8f\xad\x10\xed\x5e\xca\x43\x90\x82\xcb\xa6\xe1\xba\xb9\x02\xae\x2d\xe8\x1c\x31\xb7\x92\x0c\xc9\xe4\x34\xcc\xc0\xd2\xc6\xf3\x9a\xa4\x25\x18\xa9\x9a\x8b\x28\x51\x52\xc2\xe0\x51\x31\x0e\xa2\x9f\x87\xa0\xb3\xab\xda\x2d\x8d\x25\xa6\xb4\x14\xd2\x45\x0e\x19\x49\x04\x1b\x53\x17\x45\x84\x9a\x87\x9f\x03\x4c\x80\x38\x6f\x4d\xdd\x42\xaf\x40\x63\x33\x40\xa9\x4b\x81\xc0\x67\x69\xf5\xf2\xa9\x8f\xc0\x78\x81\x53\x2d\x1a\x67\x7c\x2b\xeb\xfa\x92\x2b\xbd\x4e\x51\x71\xcb\x52\x92\xb1\x8f\x3c\x91\x63\x45\x8b\x89\x4b\x66\x1c\x02\xa9\x95\x02\xac\x61\x40\x57\x17\xe9\x27\xab\x0d\xa1\x45\x39\xcc\xb8\x9e\x2c\x0e\xd7\x5d\x89\xa3\xae\x79\xe4\x5a\x14\x45\x9d\x58\x57\xb2\x17\x98\xd0\xe5\xc8\x4f\x80\x38\xe2\x70\xce\x5b\xd9\x7d\x7a\xa3\x2b\x4c\x0b\x12\x9b\x2f\x54\x8b\x30\x1c\x90\x4b\xf0\x4b\x0d\x99\x36\x98\x17\xc3\x0a\xc4\x34\x68\x97\xa0\x73\x9a\x65\x3d\xa2\xb9\x48\x18\xba\x0a\xd1\x71\xc6\x82\xec\x60\x14\x77\x45\x4e\xd9\x1d\xb3\xf4\xc2\x9d\x0d\x13\x46\x2d\x8c\x69\x5c\xed\x83\x5a\xe1\x75\x5a\x0d\xc6\x40\x53\xd6\x43\xb2\xa2\x45\x4e\xe7\xf3\x39\x6e\x4b\x2b\xd1\xae\x7c\x75\x59\xa4\xd4\xb0\x6b\xa3\xa8\x61\xe3\xf5\xb4\xe6\x7d\xed\x71\x67\x71\xd5\x90\x51\x86\x33\x35\x2f\x2d\x12\x5e\xed\xcf\x36\xe5\x3a\xb1\x37\x1d\x1b\xcd\x69\xae\xf1\x4c\x29\x94\x2d\x60\xea\x8e\x66\x4e\xc9\x70\x13\x17\x32\xcb\xe0\xca\xfb\x12\x1b\x56\x30\xa7\x82\xb0\x7c\xc8\xd2\x14\xe2\x35\xdd\x52\x96\xb0\xb9\x35\x2c\x76\x1d\x17\x74\x88\x77\x3a\xa5\x6a\x29\x17\xab\x01\xe8\x2c\x1a\x00\x89\x73\x90\xf9\x19\xe1\xb0\x47\xe1\x26\xb8\x02\x1d\xf0\xcf\x0d\x19\x88\xc6\x96\x2f\x59\xf2\x31\x92\x8a\x11\x3d\xa5\x05\xa8\x93\x7e\x54\x21\x53\xdd\x0b\x74\x5b\xb0\xa9\x87\xb7\x9d\x4e\x96\x26\xcc\x96\x4c\x2c\x55\xd3\x24\x95\x62\xdf\x10\x25\xb3\x8c\xd8\x9f\xed\x08\x3b\xc7\x32\x69\x6c\xbd\xc1\xcc\x73\xd0\x2b\x99\x2d\x35\xef\xb7\x50\x86\xda\x68\x2d\x1e\x45\x56\xc9\x78\x8d\x40\x39\x87\x53\xbc\xf2\xdd\xa5\xcc\x30\x95\x73\xe1\x4a\x9d\xf0\x9c\x55\xa8\xe7\x3d\x6f\xc9\x84\x25\xb7\x81\xd8\x64\x96\x8b\x99\x26\x5e\xfb\xae\x00\x35\x9a\xee\x25\x10\xc0\x5b\x97\x8d\xcd\xac\x6a\x42\x01\xd2\x77\xf5\x3e\x0c\xf3\xec\x90\xde\x51\x9e\xd1\x61\x86\xf1\x03\xe1\xaf\x5e\xbc\x0e\xee\x25\x9e\xa2\xcc\x32\xa7\xaa\x8e\xdf\x5d\x9d\x11\xa3\xe8\x68\xc4\x13\xfb\x53\x0a\x51\x29\xb8\xe1\xa5\x5b\x58\x25\x81\xaf\x95\xed\xa6\x6c\x38\x91\x72\x69\x4b\xff\xda\x31\x7c\x8f\xcf\xc6\x66\x12\xbf\x12\x24\xc3\xfe\x2e\x48\x41\x52\x96\xdb\x0d\x0d\xc1\x49\xc5\x47\x33\x6f\x1d\x6d\x42\xb8\x47\xd8\x60\x3c\x40\x66\x4e\xc9\xd9\x25\x29\x78\xc1\x32\x7b\xac\x74\x64\x98\x22\x45\xa9\x27\x50\x26\x01\x00\xef\x47\x03\xec\x56\xe3\xfa\x4a\x2c\x5d\xaf\xac\xf7\x23\x8f\xd1\x3d\x50\xbd\x9d\xdb\x69\x01\x8f\x8d\xad\x81\x55\xac\xde\x75\xcd\xff\x74\x86\xd0\x40\x56\x52\x37\x6e\x41\x90\xcb\x1e\x14\x92\xd9\x03\xb7\xe3\x44\x66\x69\x5c\xab\x08\xe9\x8c\x3d\x1a\x17\x2f\xeb\xdc\x6f\x21\x0c\xe5\xa6\xf2\xd1\x79\xbf\x1c\x74\x86\x09\x05\x28\xf0\xe5\x0e\x05\xfd\x71\xea\x41\x22\x15\x93\xf6\x3f\xf9\x11\xa2\x43\xdf\xa1\xd8\xfd\xd0\x94\x8b\x54\x4e\x97\x02\xba\x8e\xa6\xf8\xac\x95\x29\x8d\xe2\x49\x75\x5d\x70\x3d\x70\x9d\x15\x4b\x4a\x05\x42\x68\x4e\xa1\x54\x17\x15\x20\xc3\xc1\xc8\x81\x63\x8f\xde\xe7\xad\x18\x49\x4b\x28\x2a\xae\x79\x0a\x27\x81\x52\x06\x3e\x4e\xa6\x94\x9b\x90\x82\x20\xd8\x47\xe3\x7f\x30\xd2\xa2\xb9\x18\x90\xef\x1d\x11\xa7\x81\x0f\x7a\x52\xd5\xab\x5d\x0a\x2b\x5a\x5b\x92\xe5\x64\x19\x2a\xdc\x92\x89\x0c\x92\x0f\x10\x3a\xea\xdf\x60\xa7\x5f\x43\xf3\x57\xc5\xd9\xac\x89\xae\x69\x48\x0c\x63\x45\x53\x86\xc0\xc5\x0a\x10\xab\x80\xb8\xfc\xb4\x5b\x59\xd4\xda\x58\xd3\xfa\x41\x9a\x58\xf9\x90\x4e\x26\x2c\x2d\x57\xf4\xd6\x6a\x67\x68\xf3\xef\xea\x60\xaf\x3c\xf7\xc2\x0e\x47\xc9\x0a\x7a\x37\xdb\xf3\x76\xa7\xa7\x0d\x9d\x69\x38\xc3\xe8\x6c\xb9\xc1\x53\x75\x84\x71\xef\xf3\xc9\x9a\x14\xa2\xd6\xc9\x6d\x08\x86\x4e\xd9\x6d\x38\x04\x4f\x3b\x51\x52\x10\xf6\xd1\x12\x08\x1d\xe2\xeb\x47\x56\xdf\x42\x3f\x0b\x39\xc0\xea\x5e\x3d\x28\x01\xd6\x23\x29\x85\xe2\x69\xb9\x14\x66\xd2\xc3\xff\x60\x9c\x0b\x7e\x3f\x65\xec\xf6\xd0\xbd\x6f\x68\x71\x68\xea\x2d\xf5\x31\x6e\x7b\x28\xbc\x20\x9f\x93\xcf\xc8\x67\xe4\xcb\xbd\x48\xca\xbf\xa6\xa6\x54\x76\x3a\x6a\xc8\x8b\xcf\x4f\x5e\xbc\xd8\x0a\xa0\xec\x29\xfc\xb7\x14\x5d\x00\x75\xe3\x86\x78\x82\x7d\x79\xfa\xe6\xb4\xe6\xb4\x81\x93\xfd\x59\x3a\x61\x25\x86\x2b\x10\x82\x42\x31\x0c\x1d\xf4\xdb\xbd\x28\x2d\x4e\x1e\x7d\xcd\x54\xc6\xc5\x5e\x3d\x5e\xf5\xfd\xcd\xd9\x86\xfb\xd4\x86\x9a\x72\x0e\xc9\x57\xdc\xc7\x55\x37\x23\x91\x22\xe5\xc0\x38\xd6\x2a\x1b\xef\x2a\x4b\x3d\x86\xa8\x00\x49\xb3\xfc\xac\xc6\xc1\x06\xe4\x8d\x34\xae\x68\xc7\x6b\xa6\xb5\x15\x8f\x2c\xc2\xbc\x63\x54\x4b\x11\xe9\x9c\x76\x12\xa9\xf8\x98\x0b\x9a\xb9\x4d\xc5\x99\x43\x3d\x17\x2c\xe9\x9a\xa7\xe7\x7c\x8c\x75\x93\x5c\x07\x9a\xb0\x6e\xa7\x73\xfb\x62\x19\xa5\x29\x15\x1b\x90\x53\x31\x03\x51\x63\xc4\x2c\x76\x31\x38\x21\x25\xd3\x32\x01\xc6\x97\x65\xe0\xf5\xad\x26\xd9\xaa\x72\x59\x83\xda\xde\x99\x7f\x89\x37\x7f\x69\x2b\xf4\x52\x9e\x61\xf8\x97\xc5\x26\xaa\x0b\x96\x04\x55\xc4\xb5\x61\xa9\x00\x0c\x2a\xf4\xe9\xd5\x25\x79\xe7\xba\x10\x0c\x48\xbf\xdf\x47\x5f\x97\x36\xaa\x4c\x8c\x47\x41\x28\x55\x69\x67\x45\x89\x13\x36\x49\xb1\x98\x8f\xdd\x06\x09\x75\x54\x58\x86\xf1\xac\x64\x80\x80\x1f\x44\xa0\x20\xe4\xa5\xbd\x9b\x98\xc1\xdb\x43\x85\xf6\xa5\x94\xd7\x78\x42\xf8\xc2\xff\x81\x8d\x1e\x1d\x35\x91\x42\x0e\xad\x86\xe4\x24\x11\xc0\x8d\x91\x94\xfb\xba\xbe\xa7\x81\x1f\xfc\xad\x90\x53\xb1\x68\x09\xf0\x4e\xab\xe3\x91\x0f\x7b\xa7\x5e\xec\xfe\xb0\xd7\x23\x1f\xf6\xae\x94\x1c\x03\xed\x12\x63\xfb\x85\xc5\xac\x0f\x7b\xe7\x0c\xb8\x5a\xfa\x61\xcf\x4f\xfd\x87\x82\x9a\x64\xf2\x9a\xa9\x31\xfb\x96\xcd\xfe\x0a\x13\xd6\x7e\xf2\x4a\xf3\x5f\x73\xfb\x4c\xf8\x2d\xe3\xda\x58\x75\xfe\xaf\x39\x2d\x6a\x5f\xbe\xa6\x45\x6d\xa2\xb3\x0a\x01\x7f\xf8\x31\x67\x86\xde\x1d\x0f\xaa\xa3\xfe\xc7\x3f\xb5\x14\x27\x1f\xf6\xaa\x3d\xf5\x64\xce\x21\x18\x60\xf6\x61\x8f\xd4\x56\x70\xf2\x61\x0f\xd6\xe0\xbf\xf7\x8b\x3e\xf9\xb0\x67\xdf\x66\xbf\x56\xd2\xc8\x61\x39\x3a\xf9\xb0\x37\x9c\x19\xa6\x7b\xc7\x3d\xc5\x8a\x9e\x25\x4d\x7f\xad\xde\xf0\x61\xef\x1f\xe4\x83\xf0\x8b\x46\x17\xba\xa3\xe9\xff\x5a\xdc\x13\x65\x2d\x0f\x5f\xc7\xbf\xfb\x24\xa3\xda\xdc\x28\x0a\x99\x7f\x52\xdc\x2c\x2f\x0e\xd8\x27\x39\x12\x83\xa5\xbf\x2b\x20\x10\x4b\x7f\x46\x2c\x59\xfa\xf3\x12\x83\x43\x1b\xb9\x60\x7e\x0f\x2d\x85\xa9\xf9\x81\x9e\x75\xd8\x5f\x5c\x15\xce\x49\x44\x67\xac\x52\xe8\x9e\xb6\x17\xd5\x6a\x4b\xf6\xfe\x3b\xe2\x07\xce\x64\x38\x37\x17\xd3\x59\xa5\x8b\x05\xae\x5a\x8a\x94\xa9\x0c\xf4\xb0\x6a\x56\xb4\x26\xa4\xe8\x44\xf7\xb9\x3d\x42\x1a\x72\x6b\x2f\x58\x0f\xbd\xe7\x65\xa8\x17\x04\xeb\x0a\x33\x5a\xc2\x82\x04\xc1\x4d\x03\x72\x02\x04\xb7\x82\xea\xbb\x46\x02\x5c\xc3\x88\x7d\xfc\x26\xa8\x10\x2b\x6a\x47\x3a\xe4\x68\x09\x78\xf7\x34\x4a\x34\x10\x65\x41\x42\x94\x45\xf8\x0d\x2d\xde\x68\xa1\x46\x7a\x5b\x15\x4e\xae\xce\xc1\x87\xcf\x22\x97\x01\x5b\x56\x61\x66\x6e\x5b\xf7\xdc\x7c\x4e\x3f\xbe\x62\x62\x6c\x26\x27\xe4\x8b\xcf\xff\xf4\xe5\x9f\x97\x3c\x88\x44\x93\xa5\xdf\x30\xc1\xd6\x49\xa7\x35\x30\xcc\x0f\x8c\xdd\xe9\x76\x9f\x03\x4b\x99\x52\x6a\xe8\x60\x5c\x3d\x13\xea\x69\x55\x18\x34\xa5\xae\xc4\x1e\xf0\xd2\xb2\xb0\x70\xb1\x5c\x00\xdd\x33\x09\xeb\x11\x3e\x5a\x3c\x19\xd7\x51\xdf\xb0\xe3\xcf\x7b\x64\xe8\x40\x3c\x4f\xd6\x7f\xf8\xf8\xe3\x60\xc1\x92\xb9\x26\xff\xde\x6b\xac\x87\x6b\xb0\x87\xc9\x11\x20\x8e\x2f\x34\x89\x6c\xd2\xfb\x4f\xe6\xd9\x24\x0b\xeb\x5d\x77\x70\xeb\x82\x90\xdb\x95\x9d\xcd\xb9\xe0\x79\x99\x9f\x90\x17\x4b\x0d\x14\x96\xa4\xb5\x3c\x4d\x7c\xb8\x92\x12\xa8\x25\x5d\x63\x45\x73\x2b\x0f\x25\x71\xfd\xe6\x08\xb5\x31\x36\x0b\x06\x46\xe9\xf3\x08\xc5\x7d\xed\xe8\x50\x84\xec\x57\x28\x04\x29\xe0\xce\xce\x3f\x9f\xc4\x04\x6a\x56\x30\xbc\x0d\x68\xf3\xb5\xaa\x02\xda\xae\x9c\x0b\x04\x42\x31\x18\x15\x5c\x8c\xb5\x7b\x25\x77\xee\x35\xe4\xc6\x71\x74\xb0\x1f\xa3\xd0\x75\xc5\x53\x30\x35\x53\x32\x2e\xa9\xa2\xc2\x30\x6c\xe9\x89\x56\x0a\xf4\xe5\x54\x24\xcf\x8a\x95\x39\xcb\xce\xa8\xf6\xe1\xd0\xee\xaa\x22\xb1\xca\xab\xe0\x6b\xb8\xb1\xdb\xbb\xaa\xc7\x2f\x3e\x5f\x79\xe4\xe1\xb9\xe5\xaa\x68\xe8\x25\xf6\xc3\x69\xff\xbf\x69\xff\xe7\x1f\x0f\xdc\x3f\x5e\xf4\xff\xfd\xef\xbd\x93\x1f\x3f\x8b\xfe\xfc\x71\x79\x0b\xb0\xc5\x92\xfe\x12\xf4\x71\x4c\xa4\xea\x1d\x88\x27\xda\x03\x0e\x23\x47\xe4\x46\x95\xac\x47\x5e\xd2\x4c\xb3\x1e\x79\x2f\x80\x35\xdc\x13\x68\x4c\x94\x2b\x33\x6e\xfb\x64\xcf\xbe\x75\x55\x43\xb6\x3e\xd9\x83\x25\xad\x7e\xc6\x2d\x77\x95\x85\xa4\x1d\x90\xbc\x77\x26\xa2\x34\x22\xc2\x33\xa0\x78\x56\x64\x1d\x38\xf1\x17\x0c\x60\xe1\x77\x94\xbb\x5f\x53\x31\x23\x15\x59\x43\x61\xb5\x89\xe9\x58\x30\x86\x26\x4a\x6a\x5d\x6b\xdc\x78\xcb\xc8\x69\x65\x48\xb6\xc4\x72\xc8\x12\x0a\x82\xba\x1a\x72\xa3\x28\xfa\xc9\xbd\x6c\x59\x39\xe1\x46\x65\x46\x0e\x34\x63\x64\x20\x64\xca\xe6\xa9\xeb\xa1\x73\x78\x0f\x79\xc6\xcd\x0c\x6d\xeb\x21\x11\xc4\xea\x07\x79\x21\x95\xa1\xde\x28\xa8\xd8\x98\x7d\x24\xdc\x90\xdc\xca\x9c\x0c\xea\x33\x1c\xa4\x42\x1f\x1f\x7f\xfe\xc5\x75\x39\xc4\x0c\xed\x97\xb9\x39\x3a\xfc\xea\xe0\xa7\x92\x66\x10\xc7\xf3\x86\xe6\xec\x65\x6e\x0e\xb7\xc7\x16\x8f\xbf\x6c\x71\x8b\x0e\x7e\xc0\xbb\xf2\xe3\xc1\x0f\x7d\xf7\xaf\xcf\xfc\x57\x87\x5f\x1d\x7c\x18\xac\xfc\xfd\xf0\xb3\x23\x68\xc4\x17\xae\xdc\x8f\x3f\xf4\xab\xeb\x37\xf8\xf1\xb3\xc3\xaf\xa2\xdf\x0e\x17\x5d\xc6\x5a\x9f\x3a\xab\x0d\xf4\x73\x5a\xf4\x6f\xd9\x6c\xc9\xe5\x5c\x2a\x8e\xce\x4f\x84\x10\xcb\xe9\x7c\x25\x68\x4c\x3d\x7f\x4d\x8b\x77\x3e\x53\xf2\x01\x82\x56\xc4\x32\xc3\x78\xbf\xb2\x43\x6f\xe0\xa8\xb3\x7c\x07\xad\xaf\xab\xc4\xe9\x16\xd8\xd2\x4e\x7e\x5c\x15\xe1\xbc\xf6\x25\x62\x5d\xd4\xeb\xda\x19\xfc\xfd\x5e\xd3\x22\x7c\xed\x3c\x25\x5f\xaa\x69\xd5\x8d\xb8\x97\xe7\x28\xfa\x62\xc8\x9f\x15\xe7\xd0\x31\xe0\x82\x85\x2f\xcf\x43\xfa\x19\x17\x49\x56\xa6\x56\x52\x78\xff\xfe\xf2\xdc\x2a\xf7\x5f\x3b\x72\x33\x65\xce\xfb\xf8\xf6\xcd\xab\xff\x02\x4b\x01\x3c\xd1\x0b\xd5\x5a\xa0\x04\x30\xa7\xe8\x37\x73\x0c\x98\x7c\xcd\xd0\x8d\x03\x6f\x4e\x68\x11\x8c\x2b\x40\xee\x44\x4a\x26\x2c\x2b\xac\x00\x71\xcb\x48\x55\xbc\xd5\x4e\x5c\x35\xa1\xf7\x31\xab\x63\x66\x30\x55\x6d\x55\x58\xea\x4a\xa0\x25\x52\x08\x2c\x29\x71\x6d\xa5\xc0\x07\xb8\x1f\x16\x91\xdf\x3a\x99\x15\xde\xb1\xc1\x65\x70\x31\x48\x1b\xa3\x85\x5d\xc3\x19\xee\xf4\xc1\x6f\xd2\xdc\x7e\x37\x7a\xa3\x73\x73\x9f\xf3\x31\xd3\x0b\xd7\xbc\xc8\x49\x8f\x4f\x7b\x0d\x3a\xc5\xbf\xa2\x56\xc8\x18\xa7\x82\xfe\xf7\xd9\xa2\xb8\x13\x88\x97\x4f\x64\x5e\x94\xc6\x99\xc1\xfc\x30\x0a\xfe\x7f\xe7\x38\xea\x1c\xfa\x05\xf0\x47\x87\x4e\xcb\x1d\xbd\x6a\x8e\x08\xfd\x64\xc0\x5b\xdc\xd8\x9b\x73\x1b\x8d\x64\x29\xc2\xd6\x9c\x8b\xd8\x39\x8e\xc0\x9d\xb4\xd9\xba\x11\x02\x67\xa0\xda\xb7\x5b\x77\x3c\x62\xde\x9e\x51\x79\xb3\xbc\xb9\x60\xfd\xf9\x74\x5d\x78\x1b\x64\x45\x00\x41\xbc\xe1\xbb\x35\x31\x0f\x73\x59\x9e\x75\xeb\x4c\xcd\x7a\xed\x4e\x28\x84\x2c\x4e\xa8\x26\x43\xc6\x04\xb8\xfc\xd0\xfa\xcc\x7c\x55\x6a\x56\x39\xff\xcb\xa2\x6f\x64\x3f\x5d\x4c\x1f\xee\xbd\xd7\x15\xc6\x91\x7a\xf7\xa2\xce\xb6\x90\xe9\x64\xb6\x08\x06\xae\xf6\x34\xd7\x95\x28\xda\xf9\x10\x97\xeb\xbe\xcd\xee\x13\x1a\x15\xfe\x48\x95\x9d\x5f\xd2\xd4\xf2\xa1\xd8\x78\x66\x24\xc4\xd8\xd5\x0d\xcb\xdd\xd7\x88\xc7\x7c\x8d\xcd\x1d\x36\xe0\x1f\x6b\x23\x96\x30\xe9\xe2\xf4\xe1\x29\xb7\x95\xee\x37\x7e\x09\x58\x98\x93\xe5\xf5\x9f\xd6\x4e\xe0\xda\x63\xac\x0a\x91\xe8\x32\x47\x57\x79\x0c\xe9\x4e\xad\x69\xb4\x0b\xa2\xaf\x7f\x57\x0e\x83\x2e\x56\xcd\xee\xd4\x6c\xf2\x3f\xff\xfa\xdd\xff\x0b\x00\x00\xff\xff\xbe\xfc\xb3\x57\x4c\x97\x02\x00")
 
 func operatorsCoreosCom_catalogsourcesYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -105,7 +105,7 @@ func operatorsCoreosCom_catalogsourcesYaml() (*asset, error) {
 	return a, nil
 }
 
-var _operatorsCoreosCom_clusterserviceversionsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xfd\x7b\x73\xe4\xb6\x95\x30\x8c\xff\x9f\x4f\x81\x92\xbd\x8f\xa4\x75\x77\x6b\x26\xc9\xe6\xb7\x3b\xbf\xbc\xeb\xd2\x4a\xb2\xa3\xd7\x33\x1a\x95\x24\xdb\x4f\xca\xf1\x3a\x68\xf2\x74\x37\x56\x24\xc0\x00\x60\x4b\x9d\xc7\xcf\x77\x7f\x0b\x07\x00\x09\xf6\x45\xea\x26\x39\xa3\x8b\x81\x54\xc5\xa3\x26\x09\x82\x07\x07\xe7\x7e\xa1\x05\xfb\x01\xa4\x62\x82\xbf\x23\xb4\x60\x70\xaf\x81\x9b\xbf\xd4\xe8\xf6\xdf\xd5\x88\x89\xa3\xf9\xdb\xdf\xdd\x32\x9e\xbe\x23\x27\xa5\xd2\x22\xbf\x02\x25\x4a\x99\xc0\x29\x4c\x18\x67\x9a\x09\xfe\xbb\x1c\x34\x4d\xa9\xa6\xef\x7e\x47\x08\xe5\x5c\x68\x6a\x7e\x56\xe6\x4f\x42\x12\xc1\xb5\x14\x59\x06\x72\x38\x05\x3e\xba\x2d\xc7\x30\x2e\x59\x96\x82\xc4\xc9\xfd\xab\xe7\x6f\x46\x7f\x1a\xfd\xfe\x77\x84\x24\x12\xf0\xf1\x1b\x96\x83\xd2\x34\x2f\xde\x11\x5e\x66\xd9\xef\x08\xe1\x34\x87\x77\x24\xc9\x4a\xa5\x41\x2a\x90\x73\x96\x80\x7b\x5e\x8d\x44\x01\x92\x6a\x21\xd5\x28\x11\x12\x84\xf9\x4f\xfe\x3b\x55\x40\x62\x56\x31\x95\xa2\x2c\xde\x91\xb5\xf7\xd8\x79\xfd\x62\xa9\x86\xa9\x90\xcc\xff\x4d\xc8\x90\x88\x2c\xc7\x7f\x3b\x20\xd8\xd7\x5f\xdb\xd7\x3b\xc8\xe1\xf5\x8c\x29\xfd\xdd\xe6\x7b\xde\x33\xa5\xf1\xbe\x22\x2b\x25\xcd\x36\x7d\x08\xde\xa2\x66\x42\xea\x8b\x7a\x59\x66\x19\x89\x9a\x87\xff\x76\x37\x32\x3e\x2d\x33\x2a\x37\xcc\xf6\x3b\x42\x54\x22\x0a\x78\x47\x70\xb2\x82\x26\x90\xfe\x8e\x10\xff\x2e\x3b\xf9\x90\xd0\x34\xc5\x8d\xa4\xd9\xa5\x64\x5c\x83\x3c\x11\x59\x99\xf3\xea\xe5\xe6\x9e\x14\x54\x22\x59\xa1\x71\xb3\x6e\x66\x80\x50\x23\x62\x42\xf4\x0c\xc8\xc9\xf5\x0f\xd5\xad\x84\xfc\x8f\x12\xfc\x92\xea\xd9\x3b\x32\x32\x1b\x30\x4a\x99\x2a\x32\xba\x30\x4b\x08\xee\xb2\xbb\x79\x6a\xaf\x05\xbf\xeb\x85\x59\xaf\xd2\x92\xf1\xe9\x43\xef\x77\x1f\xb1\xdd\x12\xe6\xc1\x3e\x85\xaf\xff\x61\xe5\xf7\x6d\x5f\xef\x3f\x9f\x9a\x37\x13\x3d\xa3\x9a\xe8\x19\x53\x44\x70\x20\x12\x8a\x8c\x26\xa0\x1e\x58\xd0\x9a\x5b\xec\x8a\xae\x56\x2f\x6c\x58\x52\x38\xa5\xa6\xba\x54\xa3\x62\x46\xd5\x2a\x88\x2f\x97\x7e\x5d\x33\x9d\xbd\x71\xfe\x96\x66\xc5\x8c\xbe\x75\x3f\xaa\x64\x06\x39\xad\x71\x40\x14\xc0\x8f\x2f\xcf\x7f\xf8\xc3\xf5\xd2\x05\xd2\x84\xce\x5a\xec\x27\x4c\x19\x50\x21\x05\x21\x9e\x84\xe0\xde\x2d\x0a\x20\x7f\x5f\xfb\xcc\x75\x01\xc9\xdf\x47\x2b\x2b\x17\xe3\xff\x81\x44\x07\x3f\x4b\xf8\x47\xc9\x24\xa4\xe1\x8a\x0c\x80\x3c\x59\x5a\xfa\xd9\xc0\x3f\xf8\xa9\x90\x86\x2c\xe8\xe0\xc8\xdb\x11\xd0\xc5\xc6\xef\x4b\x5f\xbb\x6f\x40\xe2\xbe\x31\x35\x24\x11\x14\xe2\xa3\xc3\x38\x48\x1d\x1c\x2d\x9e\x32\x65\x90\x43\x82\x02\x6e\x89\x24\xa2\x10\x77\xdf\x34\x22\x06\x00\x20\x95\x21\x00\x65\x96\x1a\xda\x39\x07\xa9\x89\x84\x44\x4c\x39\xfb\x67\x35\x9b\x22\x5a\xe0\x6b\x32\xaa\x41\x69\x82\xa7\x96\xd3\x8c\xcc\x69\x56\xc2\x80\x50\x9e\x92\x9c\x2e\x88\x04\x33\x2f\x29\x79\x30\x03\xde\xa2\x46\xe4\x83\x90\x40\x18\x9f\x88\x77\x64\xa6\x75\xa1\xde\x1d\x1d\x4d\x99\xf6\x54\x3f\x11\x79\x5e\x72\xa6\x17\x47\x48\xc0\xd9\xb8\x34\x84\xf3\x28\x85\x39\x64\x47\x8a\x4d\x87\x54\x26\x33\xa6\x21\xd1\xa5\x84\x23\x5a\xb0\x21\x2e\x96\x23\xe5\x1f\xe5\xe9\x17\xd2\x6d\xb2\xda\x5f\x02\xdf\x5a\x74\x26\x9e\xc0\x3e\x08\x6b\x43\x5e\x2d\x26\xd9\xc7\xed\xb7\xd4\x20\x35\x3f\x19\xa8\x5c\x9d\x5d\xdf\x10\xbf\x00\x77\x2e\x11\xc2\xf5\xad\xaa\x06\xb6\x01\x14\xe3\x13\x90\xf6\xce\x89\x14\x39\xce\x02\x3c\x2d\x04\xe3\x1a\xff\x48\x32\x06\x5c\x13\x55\x8e\x73\xa6\x15\xe2\x1c\x28\x6d\xf6\x61\x44\x4e\x90\xe9\x91\x31\x90\xb2\x48\xa9\x86\x74\x44\xce\x39\x39\xa1\x39\x64\x27\x54\xc1\x27\x07\xb5\x81\xa8\x1a\x1a\xf0\x6d\x0f\xec\x90\x67\xaf\x3e\xb0\x72\xc6\x08\xf1\xbc\x74\xe3\xee\x6c\x3c\xc3\x24\x85\x24\xa3\xd2\x0a\x05\x44\x43\x96\x91\x8f\xef\x3f\x90\x99\xb8\x33\x58\xcc\xb8\xd2\x34\xcb\xf0\x14\x38\xfe\x6c\xc9\x69\x42\x39\xc9\x29\xa7\x53\x20\xb4\x28\x14\x99\x08\x49\x28\x99\xb2\x39\x70\x7f\xba\x46\xdb\x2e\x7e\x13\x91\x20\x96\xb8\xaf\x65\x50\xfe\xaa\x5b\xe0\xd2\x95\x4d\x64\xc3\x8c\x15\x19\xe8\x01\xa8\x1d\xd7\xf7\x22\x66\x73\x52\x72\xa5\x65\x89\x9b\x9d\x92\x5b\x58\x38\x24\xcf\x69\x41\x94\x16\xe6\xc7\x3b\xa6\x67\x84\x86\x08\x4e\x35\x62\xf1\x18\x88\x02\x4d\xc6\x0b\x62\xc4\x38\x24\x08\x5a\x88\x0c\xa9\x05\x3e\x8b\x84\x41\x82\x96\x0c\xe6\x40\xa8\x1c\x33\x2d\xa9\x5c\x54\xd8\xb0\x0c\xd0\x47\x80\x8a\x1f\x1b\x08\x0f\x9b\x41\x42\x1e\xc2\x45\x62\xc9\xad\x93\x5d\xd2\x4a\xb0\xdc\x02\x7a\x97\xe7\x0e\xdf\x6a\x71\x54\x39\x7c\x03\x45\x0c\x5e\x39\xf9\xa0\x92\x6b\xf1\x4d\x0e\xb1\x52\x22\x64\x85\x19\x06\x6c\x21\x12\x8e\xc1\x90\x13\x49\xb9\xb9\xb0\x16\xb9\x5b\x40\xeb\x21\xb4\x31\x43\xdc\xf1\x75\x38\x1a\xce\x4d\xa5\x6c\x08\x4c\xe1\x60\x1a\xf2\x0d\x33\x3f\x08\xbb\xea\x67\xb3\xc0\x39\x4b\xc1\x00\x51\x53\x66\x51\xc7\x9c\x56\x3a\x16\xa5\xb6\xb0\x73\xb7\xa4\x64\xce\x28\xa1\xd3\xa9\x84\x29\x22\xf0\xc6\xd7\x3e\x02\x13\x3b\x36\x1f\xd0\x7a\x0c\xad\x24\xff\xe0\x1d\x86\x0c\x3e\x78\x03\x5f\x77\xcc\xc3\x1b\x56\x85\xc5\xe6\x78\x6c\x0f\xed\xa0\x89\x81\x89\x07\xad\x90\x0f\xde\xbc\xcd\xde\xda\xf1\xc8\x0e\xdb\xd1\xdc\xe7\xa5\x85\xb8\xab\x63\x73\x3e\x6a\xd2\x6c\xc8\x01\xde\x58\x13\xdf\x31\x90\x02\xe4\x44\xc8\xdc\x1c\x14\x4e\x28\x49\xac\xfc\x56\x11\x1e\x24\x8d\x3c\x79\x08\x9c\x64\xdb\xfd\xb7\x63\x1b\x2c\xb0\x63\x48\x0a\xaa\x67\x8f\xdc\xb6\xdd\x56\xd9\x11\x02\xed\xd1\x9b\x1f\xa1\x66\x2b\x73\xd7\x1c\xa6\xf7\xb9\x0d\x18\x7a\x9f\x14\x79\xce\x36\xb3\x36\x50\xed\x8a\xde\x7d\x00\xa5\x0c\xcb\x46\x29\x4d\xd2\x3b\x02\x3c\x11\x86\x58\xfc\xbf\xd7\x1f\x2f\xec\xb4\x23\x72\xae\x09\xcb\x8b\x0c\x72\x23\x88\x91\x0f\x54\xaa\x19\xcd\x40\x22\x77\xfa\x9e\xe7\x8d\xbf\x1d\x26\x96\x0a\x52\x43\x8b\x52\xc8\xe8\xc2\x4e\x96\x42\x22\x52\x43\xa3\x85\x24\x85\x11\x70\xf3\xa2\xd4\x40\xa8\xbd\x8a\xef\x65\x7c\xba\x8e\x48\x77\x02\x0d\x31\x92\x48\x4e\xf5\x3b\x32\x5e\xe8\xc7\x50\x9f\x90\xfb\x61\xba\x2d\x0d\x08\x17\xf3\x38\x25\xb0\x63\x2b\x7a\x10\x4e\xfc\xe8\x57\x1a\x21\x94\x32\x0e\xf2\x52\x48\xbd\x0d\xd1\x32\xca\xc7\x14\xe4\x83\x77\x7a\x90\x31\xae\xff\xf0\xfb\x07\xee\x4c\xa1\xc8\xc4\xc2\xe0\xc5\xe3\x67\x65\xcb\xef\xd9\xfa\x5c\x6f\x3b\xdf\xb6\x67\x79\xcb\xf9\xac\x71\xaa\x8f\x99\xd6\x29\x50\xad\x26\xe2\x7d\x7d\x5b\xa5\x04\x3e\x19\xf3\xbb\x3c\xf7\xd6\x86\x2b\x98\x80\x04\x9e\x38\xda\xf4\x5d\x39\x06\xc9\x41\x83\x0a\x04\xe9\x45\xe1\x28\x8d\x91\x05\x97\xd9\xdd\xd3\x70\xb9\x47\xe4\x19\x7f\xdb\x23\x52\x8d\xbf\xed\x31\xd9\xc6\x8e\x5d\xd8\xe6\xe3\x48\x67\xc7\x4e\x34\xf6\x71\x04\x6c\x31\xe9\x7c\xbd\x39\xa7\xc3\xbc\x46\x27\x7e\x06\x12\xde\x75\x63\x19\x0d\xf9\x6e\xc2\x20\x4b\x09\x33\xc2\x9b\x59\x2c\x19\x67\x22\xb9\x75\x76\xcb\xab\x53\xa2\x84\x15\xf7\x8c\x84\x6f\x18\x6d\x22\xb8\x2a\x73\x20\xec\x31\x0c\x8e\x22\x5d\x14\xe9\xa2\x48\xf7\x52\x44\x3a\xeb\x1f\x78\x0e\x94\x6a\x69\x21\x1b\x69\x15\xde\x17\xa9\xd5\x43\x23\x52\x2b\x1c\x91\x5a\x3d\x32\x5e\x1c\xb5\xda\x4a\x4e\x7b\x74\xae\xc7\x0e\x72\x34\xa6\x46\x63\x6a\x34\xa6\xba\x11\x79\x99\x1b\x91\x97\x45\x5e\x16\x8d\xa9\x0f\x4d\x19\x8d\xa9\x3b\x4e\x14\x8d\xa9\xd1\x98\x1a\x8d\xa9\xd1\x98\xfa\xd8\xc7\x44\x91\x2e\x8a\x74\x51\xa4\xdb\x76\x31\xd1\x98\x1a\x8d\xa9\x0f\x8d\x48\xad\x82\x11\xa9\xd5\x03\xe3\x75\x53\xab\xee\xc6\xd4\x24\x03\xca\xd7\x2b\x55\x4b\xf1\xdf\x78\x1f\x8a\x46\x6c\xc2\x5c\x1e\x84\x7b\x9a\x8c\x61\x46\xe7\x4c\x94\x92\xdc\xcd\x80\xfb\x94\x1d\x32\x05\xad\x0c\x16\x80\x86\x75\x82\xf9\x23\xb4\xe6\x61\xfa\x32\x24\xc0\xe9\x38\x5b\x3b\xf1\x63\xa4\xc4\x3d\xf9\xb0\xf1\x78\x2c\x84\xf9\xba\x55\x88\xa1\xaa\xe3\x35\x9d\x5d\xe2\x99\xf7\x36\xe5\xd8\xad\x0f\x6a\x3e\xb9\x3a\xed\x2b\x94\x99\xfc\x8d\x93\xf3\x6a\x56\x82\x96\x69\x4c\x94\x30\x3c\xc4\xfc\xfa\xf1\x8e\x43\x8a\x49\x6e\x03\xc2\xb4\xb9\xc1\x1c\x7a\x96\x30\x9d\x2d\xaa\x17\x8f\xf6\x76\xdf\xc4\x67\x14\x12\x7d\x72\x75\xba\xbd\xf9\xde\x6f\xc0\xe7\xb0\xd4\x47\x3b\x7c\xb4\xc3\x57\x23\x8a\x41\x2d\x27\x8d\x62\xd0\x03\xe3\x75\x8b\x41\xcf\xdd\x6e\x1d\xad\xcd\x24\x5a\x9b\x1f\xbe\x2d\x5a\x9b\xa3\xb5\x39\xda\x6f\x36\x8c\x28\xb8\xe0\x88\x82\xcb\x23\xe3\xc5\x09\x2e\xd1\xda\x1c\xa9\x55\xa4\x56\x91\x5a\xbd\x0c\x6a\xf5\x12\x43\x77\xa3\xd1\x2f\x1a\xfd\xa2\xd1\x2f\x72\xa3\xc8\x8d\x1e\x19\x2f\x8e\x1b\x45\xa3\xdf\xae\x13\x45\xa3\xdf\xda\x11\x8d\x7e\x8f\x8c\x68\xf4\x8b\x46\xbf\x0d\x23\x0a\x2e\x2d\x27\x8d\x82\xcb\x03\xe3\x75\x0b\x2e\xd1\xe8\x17\xa9\x55\xa4\x56\x91\x5a\xbd\x0c\x6a\xd5\xdd\xe8\xf7\xc8\x49\x7a\xf8\xd9\x87\x4f\xca\x83\xcf\xb2\xe4\xa1\x17\x6e\x82\xe8\x03\x10\x7c\x94\x70\x3d\x46\xae\x86\x64\x4c\x15\xfc\xe9\x8f\x2b\x75\xcb\xc3\x5b\x72\x48\x19\x35\xaf\x5a\x7b\xc7\xe3\x24\xac\x7e\xc5\xe6\x3d\xdb\x62\xef\xab\x65\xb4\x9c\xc5\x15\x56\x7e\x34\x28\xd6\x6c\x6d\x7a\x6e\x6f\xbe\xd6\x92\x6a\x98\x2e\x82\x42\xde\x68\x93\xad\x39\x0f\xdf\x50\x80\xbe\x52\x1a\xef\x66\x20\x01\x1f\xf2\xa5\xa7\x95\x9f\x94\xa9\x2a\x7a\x39\x6d\x51\xdc\xf7\xb1\x70\x64\xff\x9e\x35\x97\x1f\xdb\xb4\x75\xd5\xb7\xd7\x02\xcb\x03\xe8\xd4\x5a\xaf\x4f\xab\x14\xe0\x65\x88\x15\x54\x1a\x0a\xe9\xad\xdc\xc8\xb4\x83\xbb\x97\xe0\xbd\x89\x28\x6e\xc1\xa9\x1f\xe7\xd0\xc3\x20\x53\x79\x93\x65\x7d\x1b\xc6\xec\x7a\x60\x5c\x82\xcc\x99\x52\x9b\x02\xae\x9b\x4b\x7f\x8c\x6c\x6e\x41\x2e\x37\xc0\xdf\x7f\x51\xb0\x9c\x4a\x7c\xc2\x1d\x90\x63\x9a\x10\x59\x66\x46\x98\xe2\x29\x71\xe5\xaf\x09\x4d\x12\x51\x72\x4d\x38\x40\x6a\x2d\x1b\xeb\x70\x75\x0b\x62\xbb\x85\xfc\xb4\xad\xf4\x34\xb4\xeb\x7c\xf4\x2e\xf7\x0d\xc7\xf6\x13\xd6\x16\x54\x0f\xc7\xf6\xd2\x16\xbe\xfe\x71\xae\xb5\x0b\x2b\xdc\x9a\x11\x36\xf6\xf7\x52\x64\x2c\x59\x5c\x95\x19\x90\x99\xc8\x52\x85\x65\xfd\x0d\x77\xaf\x1c\x0e\xa1\x88\x5c\xe0\xdd\xb8\xfa\x01\x19\x97\x9a\xa4\x02\x14\xe1\x42\xfb\xc2\x00\x8d\xc7\xad\x8b\xe9\x6e\x66\x5b\x3b\x98\x87\x08\x2d\x8a\x0c\x53\x29\x84\x11\x5a\xee\x66\x2c\x99\xd9\x7e\x35\x05\x4d\x60\xdd\x6d\xdb\x4b\x2f\x5b\x89\xd7\x64\x27\x11\x9b\x78\x9b\xd5\xf8\x31\x54\x21\x3b\xca\xda\xc4\x96\x88\xff\x56\x8a\xb2\xd8\xf2\xf6\x55\xcb\xa2\x7d\xda\x50\x79\xbd\xd4\xc0\xc6\x5f\x74\x2e\x23\xbb\x37\xf6\xb6\xca\x24\x3a\x22\xe4\x7c\x42\xf2\x32\xd3\xac\xc8\xf0\x11\x5b\x6d\x40\x11\x2a\xa1\xe6\x1b\x03\x42\xf9\xc2\x7b\xa0\x5c\x9b\x08\x48\x09\x9d\x9a\x19\x35\xf6\x87\xf1\x25\xe9\x79\x99\x83\x39\xcd\x69\xfd\x12\x54\xa7\xf8\xa2\x9e\x9d\xdc\xb1\x2c\x33\xf2\x2c\xcd\x32\x71\xb7\x9e\x2d\xad\x1b\xbb\x09\x85\x64\x37\xc1\x90\xec\x2e\x02\x13\xc2\x05\xf7\xa6\xdd\xef\xaf\xde\xb7\xdb\xc4\x8b\xe6\x1c\xae\x17\x08\x68\x03\xd2\x82\x4a\xcd\x68\x46\x4a\x99\x29\xbb\x8f\xd4\x28\x01\xd2\x37\x53\x99\x51\xf4\x0c\x26\xa0\x6c\xd7\x0e\xf2\xaf\x76\xe7\x1c\x60\xed\xf9\x14\x3c\x5b\x10\x6a\x77\x7e\x52\x66\xd9\x80\x4c\x18\xa7\x86\xec\x42\xe1\x33\x61\x8c\xfe\x44\xae\x19\x4f\xc0\x7c\xd3\xb0\x12\x2c\x70\x45\x66\x46\x73\xbe\xab\x43\x9a\x0e\x5c\x5b\x11\xab\x2d\x2b\xf7\x0a\x73\x60\x13\x3a\xce\x00\xfb\x5a\x38\x91\xe5\x4a\x64\x68\xde\x76\x86\xef\xd4\xf6\x22\xa1\xe1\xe5\xff\x62\x1c\x95\x14\x72\x85\x8c\xc3\x28\x3b\xc0\xf4\xcc\xe8\x3e\x45\x91\x2d\x0c\xa1\x30\xb8\x53\x23\xd4\x81\x2a\x93\x99\xf9\xa4\xbd\x42\xa4\x6a\xcf\x90\x91\x3d\x05\x89\x04\xad\xf6\x0e\xcd\x5f\xcb\xdf\x80\xdf\x17\x3e\x77\x44\x0b\xb6\x77\x38\x20\x08\x20\x6c\x74\x22\xf4\xec\xe5\xe2\xa1\xff\xd6\x46\x7f\xad\xc7\x46\x53\x6b\x0d\x67\x70\x5d\x3b\x44\x61\x9b\x60\x18\x1a\xad\x01\xf3\xa4\x0c\x52\x22\x1a\xf8\xf6\x50\xab\xc4\x9a\x90\x63\x4e\x20\x2f\xf4\x02\xb1\x38\x07\xca\xdd\xdd\x30\x07\xb9\xd0\x33\xa3\xad\x32\xf5\xf2\x0f\xff\x96\x8e\xa5\x7a\xac\x05\xb8\x3b\xf0\x1e\xb8\x35\x92\xdb\xce\x4a\xcb\xc0\xdd\xff\xd7\xfd\x50\xea\x35\xe2\x53\x4d\xcd\x5f\x2c\x28\x91\xbd\xb6\x02\xe3\x0f\xe6\xc9\x26\x08\xed\x4f\x96\x5a\x56\xf4\xe3\xfd\x7b\xdb\x45\xc9\xc1\xea\x3b\xc6\x53\x2b\xa2\x1e\x6b\xdb\x9e\x08\xae\xc0\x2c\x38\xb1\x99\x89\xbe\xc6\x51\x6a\x09\xa4\xdb\x89\xb5\xe0\xc7\xb5\xbf\x44\xd0\xaf\x0a\xb6\xdb\x0a\xa3\x8f\x4c\x1f\x68\x3e\xcf\x41\x59\xc1\x7e\x4d\x0d\xf9\xc7\x50\xb0\x81\x75\x46\x19\x14\xc8\xe8\x18\x32\xdb\x8c\xc9\x5c\xad\x97\x4f\x8e\xdf\x7f\xa8\xfa\x96\x49\xa0\x8f\x58\xba\x3e\x81\x8a\xb2\x85\x4b\x75\xa5\xfb\xdb\xea\xd8\x5e\x2a\x45\x50\xec\x66\x26\x26\xd7\xa0\xed\x01\xcc\x69\x61\xce\x9f\x9d\x63\xad\x95\xf3\x3d\x42\xfa\xf1\xc3\xb2\x93\x34\xbf\x7d\xb7\xa6\x75\x2f\xd9\xea\xa8\x6c\xe7\x0b\xde\xe5\xec\x3d\x60\xfb\xa8\x47\x03\xcc\x4b\x08\xed\x24\x7e\x27\xa3\x27\x55\xe7\x3d\x8b\xc1\xca\xa6\x4c\xdb\x04\x75\xe9\x7f\xaf\xa7\xe8\x79\x0b\x76\x51\xa7\x8c\x46\x9d\x41\xa2\xc5\xc3\x05\xe1\xfc\xcd\x1a\xf2\x22\x7b\xec\xe4\x91\x9d\x55\xaf\x9c\xf1\x2b\xa0\xe9\xe2\x1a\x12\xc1\xd3\x2d\x09\x6c\x63\x3f\x3e\x30\xce\xf2\x32\x27\xbc\xcc\xc7\x80\x20\x56\x76\x2e\x24\x24\x56\xad\xa5\x84\xc3\x5d\xb6\x70\xc4\x23\x25\x85\x48\x3d\x3d\x19\x1b\x35\x8c\xa6\x0b\xec\x7c\x86\xa5\x53\xf9\xc2\x4c\xc2\x74\xcd\x7d\x24\x49\x24\x55\x46\x60\x1a\xe0\xa4\x4c\x1b\x5e\x36\x06\xf4\x3a\xb1\x14\xcc\x1e\xd3\x39\x65\x99\x11\xba\x47\xe4\x14\x26\xb4\xcc\xb0\x81\x1f\x79\x43\x0e\xcc\xcb\xbc\xa6\xb5\xee\x01\x23\x08\x2b\x61\x74\x74\xe5\xb2\xdf\x71\x41\x87\x3b\xd8\xd1\xb7\xa9\xec\xe7\xc7\xb6\x15\xfe\xfc\x28\x68\xa9\xb6\x55\xd0\x1b\x1b\x73\xce\x53\x73\x1e\x42\x19\x35\x20\xe9\x4c\xb9\x99\xb7\x63\xd9\x0f\x57\x45\x58\xb3\x6a\x29\xa6\x12\x94\x3a\x05\x9a\x66\x8c\x43\x7b\xfc\xba\x99\x01\xc9\xe9\x3d\xe2\x98\x66\x39\x18\x49\x24\xc4\x30\x1a\x7e\x95\x16\x24\xa7\xb7\x50\xbd\x9e\x8c\x61\x82\x0d\x1a\xf1\x83\x83\xdd\xb7\xf8\x33\xa1\x2c\x83\x74\x84\xef\x08\x66\xa9\xfb\x1a\x5b\xc4\x31\x7f\x33\x5e\x82\x79\xaa\x90\x02\xd5\x4c\xfb\x68\xc8\xe3\x91\x87\x52\x73\xb3\xa5\xc3\xbe\x97\xdf\xe5\x12\x28\xce\xee\x13\x6b\xfe\x93\x40\x15\xde\x66\x71\x53\x95\x72\x62\x94\x4a\xaf\x8b\x06\x0b\x72\x4d\x60\xc9\x85\xd0\xae\x25\x60\xf5\x81\xf8\xb4\x6b\x51\x09\x4a\xb3\x1c\x0f\x58\x5a\x4a\xdf\x30\x13\x61\x46\xd7\x6f\x7d\xe3\xa8\xfc\xe9\xcd\x9b\x2d\xe5\xb7\x4f\x8f\xf4\x12\x50\x87\x6e\x83\x2f\x17\x15\x1d\xf2\xe4\xdf\x28\xc7\x66\x8f\x99\x13\x90\xb1\xf3\x27\x48\xf4\x20\x32\xa5\x19\x9f\x96\x4c\xcd\xc8\x18\xf4\x1d\x00\x27\x70\x6f\x6b\x5f\x90\x7f\x82\x14\xb8\xa9\x06\xbc\xb5\xf3\xa0\x01\xb4\xb7\xcf\x07\x62\x73\xa6\x98\xe0\x7f\x61\x4a\x0b\xb9\x78\xcf\x72\xf6\x48\x51\x52\x3f\x56\xfb\x1f\x57\x10\x14\x59\x8a\x5d\x8b\x59\x42\xaf\xc1\x7e\xb0\x04\xb4\x6d\x6a\x61\x15\x57\x62\xce\xc9\x98\x26\xb7\x9f\x0c\xc0\x6f\x9e\x0b\x84\x3d\xbb\x6e\x01\x55\x94\xf7\xaa\x09\x90\x6c\x59\xa4\x3c\xbb\xb7\xf0\x69\x40\xf9\x6e\x26\x14\xe0\x0d\xd6\xfc\x88\x8f\x79\x77\x01\x53\x15\xc1\x30\xa7\x5b\x70\x50\x84\x4e\x26\xcd\x3b\xea\xc3\x8e\x92\x67\x5e\x2a\x4d\x72\xaa\x93\x99\x35\x72\x89\xb4\x12\x27\xf6\x95\x13\xfb\x77\x81\xf2\xd6\xe6\xe5\xdd\x0d\xc1\xc4\xae\xf3\xec\xde\xe8\x96\x8f\xfa\x79\x9a\xa3\x01\xf2\xe5\x69\x9a\xba\x71\xd6\xdc\x10\x27\xb7\xe5\xb6\x79\xf0\x0d\x9a\x86\xeb\x5f\x70\x17\x8e\x2f\x4e\xb7\x37\xd2\xb4\x51\x70\x77\x56\x71\x97\x8d\xe0\x0f\x7c\x94\x37\xa6\xba\x2b\x4d\x4b\xb8\x6d\x1a\x3d\x20\x94\xdc\xc2\xc2\xf6\x97\x5e\x69\xd8\x2b\x21\x73\x92\x04\x60\xdf\x5a\x73\x93\x6b\x36\xbd\xc3\x7a\x77\xc6\x1e\x3b\x76\x73\x52\xf8\x31\x34\x0b\xdd\xf1\x09\xff\xd1\x3b\x3c\xb6\x3b\x82\xdb\x71\x0b\x8b\xdd\x1e\x58\xda\x6e\xb3\x0b\x4e\xf7\xb1\xfb\x6e\x7e\xa8\x04\xbd\x6a\xab\x77\xf3\x1e\x85\x63\x67\xe3\x95\x1f\x1e\x88\x9d\x3e\xaf\x42\xbf\xd0\xca\x64\xbe\x71\x5f\x59\x64\x34\x67\x7a\xc6\x0a\x64\x44\xde\x4d\xe0\xdb\x9f\xff\x40\x33\x96\x56\x53\xd8\xf3\x7b\xce\x07\x46\x7c\x32\xff\x41\xa2\x6b\xc5\xb5\x53\x01\xea\x42\x68\xfc\xe5\xb3\x01\xc8\x2e\xb3\x13\x78\xec\x14\xce\x3e\x8d\x54\x06\x15\xaf\xa0\x73\xba\x1a\xf9\x9a\x5f\x15\x28\x99\x22\xe7\x9c\x08\xe9\xe1\x80\xbd\xec\xed\x44\x76\x0a\xe4\x13\x63\xeb\xfa\x40\xcb\xf5\xda\x39\x1c\xf8\x84\x6c\x40\xef\x81\xe9\xdc\x54\x28\x1f\xd8\x2b\xb6\x57\x7e\x86\xd2\xae\x13\x55\xa9\x77\x7f\xb3\x84\xe4\x20\xa7\xe8\x8b\x49\xb6\xf6\x45\x34\x37\x65\x37\xba\x6b\xc7\xce\xd4\x37\x7c\xe1\x4e\x58\x80\xac\xc9\x9a\x80\xba\x30\x37\x3b\x43\xc3\xe4\xf4\x7f\x0c\x05\xc7\x3d\xf8\xbf\xa4\xa0\x4c\xaa\x11\x39\x26\x8a\xf1\x69\x06\x8d\x6b\x4e\xc3\x08\xa7\x31\x33\x30\x45\x0c\xa9\x9d\xd3\xcc\xe9\x52\x94\x13\xb0\x36\x2b\x33\xfb\x32\x4b\x1d\x38\x49\xc5\x50\x9e\xca\x05\xb6\x77\x0b\x8b\xbd\xc1\x0a\xd2\xec\x9d\xf3\x3d\xcb\x5b\x56\xd0\xa4\x62\x44\xe8\x3d\xdb\xc3\x6b\x7b\x7d\x72\xe1\x1d\x19\x4e\x5b\x3b\x5a\xf3\xa5\x5b\x63\x84\x8f\xfa\x68\x29\xac\x37\xb4\x44\x17\xeb\xa4\x05\x29\x15\x58\x69\x1d\x4f\x19\x01\x2f\x67\xa2\x54\x89\x8a\x29\x87\x3b\x94\x1e\x9f\x8d\xe0\x67\x34\x09\xc6\xa7\xdf\x17\x29\xd5\x5b\x85\x9b\xda\xd1\x80\xc8\xfe\x95\x9d\x84\x94\x38\x8b\xc1\xad\x09\x9b\x92\x82\x4a\x9a\xab\x11\xb9\x74\x75\x0f\x11\xd3\xd8\x24\xb4\x25\x3a\xd8\xdd\x2c\x0a\x20\xff\x0f\xb9\x0a\xd7\x32\x22\xc3\xe1\x90\xdc\x7c\x3c\xfd\xf8\x8e\xd8\x5f\xac\x94\xad\x05\x99\x08\x54\x82\x44\x29\xcd\xab\xe6\xc0\x51\xf1\x37\xf2\xbd\xe0\xf0\x71\x62\x4e\x08\xd5\x30\x07\x49\xee\xcc\x56\x25\x2c\x85\xca\x7a\x35\xda\xff\xb4\x78\xdc\x4e\x32\xc9\xe9\xfd\x75\x29\xa7\x3b\x6c\x00\x59\xd9\x84\xd0\x64\x53\x2b\x93\x88\x7a\x61\xde\xae\x4a\x66\x90\x96\x19\xa4\x84\x8e\xc5\x1c\x1a\x26\xdb\xe6\x63\xc8\xd2\x4b\xf0\x0f\x1a\x9e\x37\x56\x22\x2b\x75\xa5\xac\x1e\xc0\xfd\x3b\xf2\x6f\xe8\xf4\xa6\xa4\x00\x99\x00\xd7\x74\x0a\xcb\x66\x00\x7b\xdf\xdb\x37\xff\x72\xe8\xf8\x91\x99\xd1\x59\x4f\xde\x18\x8c\xf8\x40\xef\xbf\xe7\xb5\x69\x90\x29\xf2\x66\x44\x8e\x97\x5e\x86\xcf\x65\x49\x99\xa1\xad\x05\x1d\xf9\xc1\x2b\xc7\x0b\x22\x45\x89\xae\x7c\x52\x16\x4d\x6d\xf6\xf7\xff\xf6\x2f\x46\xe9\xa3\x79\x91\xc1\x3b\x5f\x2e\xd5\xaa\xcd\x46\x86\xd1\x82\xfc\xe1\xcd\xbf\x58\xea\x69\xce\x67\xad\x15\xd6\x30\xa3\x06\x60\x65\x41\x58\x6e\x83\x34\x21\x5b\xd4\x75\x57\x65\x13\xfd\x95\xa6\x52\xab\x01\x41\x7f\x7f\x25\x1c\x6a\xa1\x69\xb6\xa4\xe5\xa3\x16\x0e\x77\x16\x48\xa9\x40\x98\x00\x1a\xaa\xc8\xdb\x3f\xbc\xf9\x97\x55\x73\xca\x47\x9e\x00\x3e\x89\x4f\x60\x00\xc6\xd8\x28\xf7\xb7\x2c\xcb\x20\x1d\x3c\xba\xfc\x49\x29\xf5\x0c\xe4\x80\x00\x57\xde\x58\x65\xd6\xb7\xb4\x36\x9c\x5d\x96\x9c\xa3\x8c\x60\xad\xc3\x68\xd1\x0a\x2c\x5c\xee\x63\x0d\x23\xd4\x24\x17\x4a\xaf\x5f\xf2\xf6\xc7\xcd\x0c\xca\x17\x1f\x27\xbb\x8a\x03\xc3\x16\x66\x88\xd5\xa7\x5b\x88\x94\xf7\xc3\xdb\x2a\x87\x72\xc8\xb8\x1e\x0a\x39\xb4\xd3\xbc\x23\x5a\x96\x8f\x7b\x0d\xea\x91\x37\x4e\xc0\x67\x20\x03\x65\x70\xde\x56\x76\xf5\x93\x9c\xfc\xf6\xe7\x39\x15\x77\x7c\x33\xe5\x40\xc2\xe9\x68\x46\xcb\x53\xdf\xb4\xb8\x2d\x1d\x1b\xf3\x76\x73\xf7\xff\x6f\x15\xbb\x77\x20\x07\xee\xec\x56\xa7\xdd\xc8\x55\xe8\xf1\x18\x6c\xf1\xf6\xea\xd8\x5a\xce\x67\x6d\x4e\xe6\x06\xfb\x9a\x35\x94\x6b\xe5\x84\xaf\xa1\x40\x76\x1d\xb5\x43\x46\x63\x44\x81\x39\xe7\x6a\xe3\x41\xcf\x80\x2a\xbd\x0e\x14\xf1\xa0\x3f\x3e\x1e\x0e\xed\x5f\x1e\x4d\xa1\xd3\x48\x48\x08\xf2\xda\xc6\x78\x62\x11\x65\xef\x0a\xac\x87\xcf\x86\xa2\x35\x84\xa8\xbd\xea\x48\x98\xfd\x6b\xca\x57\x9f\x2a\xa0\xc6\x1b\x39\xdb\x88\xd6\xee\xd1\x20\xe4\xd7\x99\x4e\x1d\xf1\xaa\x3c\x8a\xd6\xa5\xf9\x6c\xa4\xe8\x1c\x34\x7d\x38\xfd\x63\x79\x34\x89\xf6\xb5\xa6\x3c\xa5\x32\x75\xab\xdc\xdf\x57\xd5\x94\x23\xf2\x01\x7d\x69\x7c\x22\xde\x91\x99\xd6\x85\x7a\x77\x74\x34\x65\x7a\x74\xfb\xef\x6a\xc4\xc4\x51\x22\xf2\xbc\xe4\x4c\x2f\x8e\xd0\x81\xc6\xc6\xa5\x16\x52\x1d\xa5\x30\x87\xec\x48\xb1\xe9\x90\xca\x64\xc6\x34\x24\xba\x94\x70\x44\x0b\x36\xac\x65\x66\x35\xca\xd3\x2f\xfc\x8b\x3e\xb1\x60\xdc\x38\x43\x68\x5d\x92\x73\x18\x96\xfc\x96\x8b\x3b\x3e\x44\x4d\x56\xed\x74\x9a\xb6\x8b\x62\xf0\x63\x09\xde\xbb\x04\x2e\x14\x22\xfd\xe4\x9b\x60\x3e\x66\x48\x79\x3a\xb4\x4e\xc7\x4f\xbc\x17\x6d\x6c\xbb\xc3\x3a\x30\x60\x9b\x58\x74\x3b\xda\x69\x43\x34\xd1\x6c\x0e\xad\x9c\xd8\x7e\x34\xb6\xfb\xa3\x0f\x25\x4d\x4b\x69\x77\x3c\xf0\x66\x7b\xdf\x4c\x4e\x17\x28\xeb\xe0\xbb\x89\xb0\xac\x9c\x8b\x14\x9c\xe5\x73\x8e\xaa\xfd\xb5\x61\xe6\x37\x46\x14\x76\x3e\x6e\xb4\xfb\x2e\x94\x86\xdc\x12\x27\xfb\x7c\xb6\x20\x5a\x2e\xac\x63\x5c\xde\x1a\xe5\xd3\x79\xae\x8d\xc4\x7f\x8b\xf7\x29\x25\x12\x86\xa2\x4f\x0d\x57\x2f\x77\x79\x1b\x1e\x25\x85\x50\x0c\xdf\xed\x78\xde\x6e\x96\xb9\xf6\xec\x32\x70\xd3\xfd\xe9\x8f\xbb\x6c\xdd\x04\x1b\x2c\xec\x68\x65\x6f\x46\x50\x4c\xc2\xd8\x7f\xb7\x3d\xfb\xca\x2b\xae\x46\x2c\x49\x04\x57\x5a\x52\xb6\x39\xbb\x69\xfd\x68\xe9\x0a\x69\xef\x6f\x20\x88\x41\xc7\xad\x80\x42\x56\x63\xb0\x3c\x53\x44\xb4\xf4\xa0\x0e\x01\x63\x93\x9f\x7c\x2c\xa1\x21\x5c\x2d\x4d\xab\x2d\x60\x44\x3a\xc1\xc9\x3e\x0d\x13\x90\x12\xd2\x53\x94\x3e\xaf\xab\xef\x3a\x9f\x72\x51\xfd\x7c\x76\x0f\x49\xb9\x6d\x8e\xf8\xea\x58\xb1\xe5\x79\x83\x88\x0b\x3b\xb1\x8b\x30\x47\xd7\x5f\x70\xf2\x87\x40\xb0\x3b\x41\x44\x51\xcd\xd4\xc4\x66\x92\x55\x1b\x01\x81\xe3\xb3\x42\xe1\xca\x3d\x8c\x2c\xce\x26\x45\x30\x8d\xe4\x26\x99\x09\xa1\xcc\x29\xc7\xfd\xc4\x79\xe7\x4c\x58\x9f\x1f\xa6\xb5\x48\x92\x1b\x1a\xe3\xd3\x5b\xea\xe9\xad\xa1\xb6\x7e\x8c\x29\xab\x82\x57\x10\xf4\x5e\x2a\x33\x0d\x1a\x1e\xcd\x1f\x53\x94\x9a\x94\x26\xaa\xcc\xcd\xa4\x77\xc0\xa6\x33\xad\x06\x84\x8d\x60\x84\x58\x03\x34\x99\x05\xd3\xe6\x00\xba\xd1\x1f\x25\x44\xb5\xd0\x4a\x7c\x50\xe5\x3b\xb8\x04\x9d\x41\xc5\x63\x96\xf7\x72\x2d\xb8\x06\x04\x74\x32\x3a\x1c\x90\x3a\x85\xdc\xac\x71\xbc\x20\x4c\x83\xa1\xd9\xa8\x8b\x48\x51\x4e\xed\x97\x80\x8f\xe9\xc4\x75\x55\xc9\x20\xe8\x45\x4d\x51\x67\xdc\xb3\x1f\xb7\x67\xf6\x0d\x57\x5e\xe6\x46\x5f\xac\x88\x3a\x9a\xd5\x7d\x4b\x1d\x21\x25\xa8\x42\x58\x6d\x73\xd9\xe0\xfe\xff\xaf\x1e\x3a\x50\x87\x35\x30\x67\x6c\x3a\xf3\xb0\xa4\x8e\x11\x34\xf7\x60\xf7\xb3\x47\x3a\xf9\x52\xec\x68\xe9\x51\xb1\xa3\xe9\xdb\xf6\x99\x14\x35\x56\x05\xfb\xaf\x41\xe6\x15\x14\x11\x45\x90\x64\x38\x3b\xb7\x6f\x65\xe3\x70\x8c\xbc\x21\x07\x88\x64\x4c\xef\x2b\x44\xf8\xa1\x28\x0e\x47\xe4\x98\xf0\xb2\x3a\x73\x0f\xbd\x80\x8b\x6a\x7e\x37\x91\x79\xa9\x12\xf5\x5c\x2d\xbf\xb8\x13\xb9\xb3\xa3\x9d\xa7\x3c\x1c\x43\x07\x01\x78\xbc\x60\xe2\x43\x93\x58\x58\xb7\x9c\xa0\x1b\xe9\xf6\x73\xf8\xaf\x68\x3f\xc7\x4a\x80\x05\x1e\xd7\x3a\x8a\x02\x64\x3e\x08\xa5\xa7\xea\x40\x36\x4f\xb1\x85\x45\x5b\xac\x20\xfd\x60\x06\xe9\x09\xae\xa4\x53\x84\xce\xfa\xb1\x1c\xc6\xe2\xf3\xab\x1a\xd0\x6e\x10\xf9\xf1\x02\xaf\xee\x18\xbc\xb4\x79\x74\xa5\x74\xf5\xe8\x44\xf3\xea\xf1\x20\xe2\x3d\xbf\xc0\x9e\xf5\xa3\x27\xb4\xb5\xa3\x3b\x69\xab\xc7\xee\xa1\x41\x9b\xe6\x69\x11\x30\xb4\x7e\xf4\x75\x36\xed\x68\x11\x5c\xb4\x7e\xac\x88\xa8\x9f\x26\xd6\x68\xfd\x68\x6d\x24\x5d\x3f\xda\xc6\x25\xad\x1f\x4b\x49\x8c\x9f\x28\x48\x69\xd0\x8c\x50\x22\xdf\x6a\x7b\x8e\xdf\x77\xe2\x27\xf5\xe8\x19\xc4\xed\x22\x9b\xd6\x8f\x65\x01\xf0\x85\x44\x39\xad\x99\xea\x5b\x6d\xa6\x79\xbf\xf1\x61\x9b\xbd\xee\xe3\x74\x9c\x42\x31\x70\xa9\x33\xde\xce\x8c\x11\xd5\x85\x04\x2c\x38\x80\x61\x5f\xde\x0e\xf3\x79\x02\xab\xd6\x8f\xfe\x18\xa7\x1d\x3d\xb1\x4f\x3b\x7a\x43\x6e\x14\x78\xbe\xb1\x76\xe1\x27\x94\x75\xac\x65\x3a\xca\x3a\x51\xd6\xd9\x61\x44\x59\x67\xdb\x11\x65\x9d\x4d\x23\xca\x3a\x6b\x46\x94\x75\xa2\xac\xd3\x69\x3c\x3f\x59\xc7\x5a\xaa\x7a\x33\x98\xfd\x68\x0d\xae\xcb\x16\x32\x94\xa6\x7c\x48\x4f\xd3\x54\x66\x78\xff\xb5\x23\xb1\x37\x68\x5e\x73\x91\xea\x92\xf2\x29\x90\xb7\xc3\xb7\x6f\xb6\x4c\x07\x5c\x3f\xba\x04\xed\x84\x63\xd7\xd4\xc1\xe5\xb1\xc9\x23\xf1\xc9\xbc\x4b\xee\xa4\x56\x0e\x8f\x86\x84\xb9\xc1\x41\x54\xd5\xbb\xca\x41\x13\xaa\x1b\x06\x71\x96\x43\xe5\x10\x6d\xa4\x20\xd7\x31\xbd\x82\x3b\x7f\x87\xd9\xd4\x51\xbb\x15\x24\x40\x6d\x1c\xfb\x18\xaa\x55\x88\x1c\x6c\x82\xa9\x3f\xf4\x66\x09\xe0\x61\x45\x0e\x60\x34\x1d\x91\xd4\x26\x6b\x53\xee\x62\xc6\x0e\x07\xa1\x7b\x3c\x37\xc4\x55\xe2\x7f\xcc\xb2\x9d\x7f\x1c\xe6\xc0\x75\x49\xb3\x6c\x41\x60\xce\x12\x5d\x7d\x1f\x06\x04\x32\x6d\x9d\x9d\x5d\x5c\x29\x1d\xc4\xc3\xae\x22\xe1\x70\xe5\x6c\xed\xe6\xaf\xf6\xa3\xbb\xec\xb6\xb2\x8e\xf6\xf4\x66\x49\x2e\xb1\x10\x1a\x6d\x54\xab\xb4\x79\x9b\xf5\x57\xe2\x3f\x11\xc1\x3f\x5e\xb5\x75\x8f\x91\x9e\x78\x42\x67\x3e\xb0\xac\x40\x95\x59\x66\xd0\xdb\x7a\xcc\x56\x41\xb0\xc6\x93\xb5\x26\xdb\xc6\xba\x59\xf3\x20\xeb\x06\xef\xb9\x11\x85\xc8\xc4\x74\x11\xee\xa0\xed\xd5\x12\x94\xb7\xa1\x44\x95\x63\x27\x02\x9a\x43\x74\xb1\xb4\xe5\xd1\x17\xb2\x71\x44\x5f\xc8\xca\x88\xf6\x81\xe5\x11\xed\x03\x3b\x8c\x68\x1f\x58\x33\xa2\x7d\x60\x75\x44\xfb\x40\xb4\x0f\x74\x19\xaf\xdf\x3e\x40\xa2\x2f\x64\xd3\x88\xb2\x4e\x3d\xa2\xac\xb3\xfd\x88\xb2\xce\xea\x88\xb2\x4e\x94\x75\xa2\xac\x13\x65\x9d\xb6\xa3\x03\x72\x17\x22\xed\x3d\x45\xa6\x10\xe9\x03\x19\x32\xd6\x5e\x9d\x88\x61\x26\x92\xaa\xb2\x88\x79\xc4\x79\x3e\x14\xcd\xad\x09\x7d\x40\xfe\x29\x38\xd8\xf4\x04\x5b\xb2\x36\x07\x22\xb0\x3d\x44\x21\xd2\x03\x75\xd8\x22\xf0\x3c\x66\xd8\xc4\x0c\x9b\xdf\x40\x86\xcd\x8c\x2a\x57\xf8\x08\x49\xeb\xe6\x84\x9b\xe0\xf8\xdf\x80\xcc\x7f\xb3\xf9\x36\x06\xe1\x1c\xc2\x60\xf7\xb8\x1a\x29\x2c\xec\x52\xe7\xdb\x85\xf4\xb2\x09\x31\xa7\x97\xd9\xe6\x3b\x69\x0a\x29\x29\x40\x0e\x2d\x92\x09\x32\x61\xae\xfe\xd7\x12\xfe\x3a\x08\xbf\xf0\xbc\x99\x26\x24\x5e\x74\xf2\x4c\xf3\x53\x7a\xf3\x4d\x85\x2e\xba\x06\x57\x7c\x71\xa9\x34\xfd\x68\xa5\x43\xa2\x9d\x3b\xed\xbb\x4e\x7a\x69\x5f\x4a\x24\x2a\x79\xd7\x3b\x95\x39\xde\x3c\xd6\x16\xa7\xfd\x47\x09\x72\x41\xc4\x1c\x64\xad\x18\x55\x7d\x7b\x06\x55\x93\x99\x84\xba\x02\xc8\xfd\x18\x78\x7a\x31\x45\xf4\xa9\xa9\xf7\xed\x35\x24\xcf\xac\xfa\xf1\xe6\xd1\xaf\xe2\xd0\xa3\xda\xf0\xd2\x6a\x29\x6f\x1e\xbd\x9a\xdf\x48\xcf\x26\x38\xd2\xa3\x19\x8e\xf4\x6b\x8a\x23\xbd\x9b\xe3\x48\x9f\x26\x39\xf2\xd9\x2b\x40\x6f\x1e\x3d\x9b\x8f\x48\xef\x56\x3a\xf2\x02\xeb\x49\x6f\x1e\x9f\x00\xdc\x7d\x5a\xec\x48\xac\x4e\xdd\x79\xf4\x6d\x50\x23\x7d\x1b\xd5\x48\xdf\x78\xd8\xaa\x0a\xf6\xe6\x11\xeb\x63\x7f\x02\x39\xad\x37\x21\xa2\x6b\x4d\xed\xc7\x16\xda\x03\x4e\x56\x5d\x7d\x3f\x97\x02\x64\xb9\x74\xdd\x4a\xd6\xbc\x3b\xe8\xd5\x85\xa1\x9a\x61\xcb\x53\x1f\xb7\x8a\x18\x8d\xbf\xa7\xde\xe0\x55\xf2\xa0\x78\x5c\x30\xd9\x4a\xeb\x98\xda\x74\x56\x35\x8f\x31\x4a\x41\xdd\x74\x2a\x78\x18\xef\x1d\xd9\x70\xd2\x5a\x9a\xe0\xe9\x72\x80\x69\xfd\x04\xea\x17\xb6\xd1\xed\x9e\xb7\x63\xef\xab\xfa\x8e\xbd\x51\xd8\x13\xd7\xcd\x78\xf0\x7f\xfe\xef\x61\xa3\x7a\x4b\x3d\xa1\xa3\xca\xd5\xd9\x19\x83\xa6\xc3\x0c\xe6\x90\xe1\x3a\x7c\xc3\xe5\x99\x40\x8b\xb1\x2d\x7b\x1a\x18\xa4\x2e\x96\x77\x94\x4c\x80\xea\x52\x62\x05\x51\xe0\x74\x9c\x75\x3f\x2b\x51\xc1\x8c\x0a\xe6\x76\x23\x2a\x98\x1b\x47\x54\x30\x3b\x8c\xa8\x60\x6e\x37\xa2\x82\xb9\x79\x44\x05\x33\x2a\x98\x2d\x46\x54\x30\xa3\x82\xd9\x76\xfc\x86\x15\xcc\x7e\x03\xa7\x43\x75\xcf\xc5\xa1\xa0\xfc\xa8\xa9\x66\x49\x1d\x54\xed\xef\xb2\xff\xea\x57\xcd\x0c\x55\xc8\xf5\x4a\x66\xa8\x88\xae\x28\xda\xa3\x47\x34\xca\x4a\xe7\x5c\x79\xf2\x41\x65\xf3\xb5\xc5\x86\xf7\x86\x88\x81\xd3\xb9\x57\x4c\xbc\xf1\xa1\x6b\x75\x6b\xf7\x2a\xae\x2d\x25\x07\xde\xdb\x8f\xad\x5a\xb8\xd0\xcd\x8b\x5c\xb3\x61\x7d\x47\xe5\xff\xc7\xb0\x9d\x46\xc5\x80\x86\x93\xba\x8a\x92\xab\x22\xb0\x6a\xe4\x31\xd4\x11\x64\x63\x0d\xd8\x1a\x77\xc2\xb8\x8d\xa5\xf4\x6d\x85\x04\xf7\x61\x59\x96\x9c\x22\x01\xf4\x68\x6e\x25\x5f\x5c\x0f\x8a\xbf\x35\xec\x82\x38\x22\x8a\x67\x8c\x72\x97\x6e\x2b\xb8\xef\x7b\x6f\x7b\xd9\xd7\xe2\x72\xd5\xad\xa5\x7a\xfb\x88\x9c\x21\xd2\x87\x13\x33\x85\xf0\xa1\xb6\xc3\x4a\x3f\x26\x8a\xe7\x55\x1a\xe2\x6e\xe7\xd2\x10\x4b\x31\x29\xb1\x32\x44\xac\x0c\xd1\xa9\x32\x04\x5e\xb4\x87\xbb\xf7\x12\x11\xe4\x47\xd7\x80\x49\x02\x82\x2a\x2f\x33\xcd\x8a\x3a\xc6\x5b\xd9\x57\x65\x56\x91\x98\xb8\x58\xd3\x26\xbe\x9b\xb7\xd1\x64\xb6\x8c\xf7\x38\x1f\xc6\x84\x2b\x24\x27\x2e\x9e\x13\xdb\x25\x61\x4d\x03\xaf\x75\xd8\xa0\x55\xf6\xf2\x63\x11\x4f\x91\x60\xab\x5a\x69\xb6\xdd\xbc\x0c\x9d\xcf\x0c\x4a\x18\x8a\xfd\x00\x83\x08\x5b\x66\x60\x5c\x2c\x9b\x03\xaf\xb9\xc4\x81\x3a\x3c\xf4\xc2\x50\xaf\xdc\xeb\x93\x70\x9f\x3f\x07\x5c\xe2\x3f\xb7\xe1\x3f\xf8\x41\x15\x07\xaa\xc1\x57\xf3\x9f\x97\x1d\x74\xd9\x3d\x7e\xae\x0f\x83\x5c\x6f\x71\x73\x4f\x1e\x33\xf7\x5b\xaa\xae\xf1\x2c\x5d\x18\xcf\x4e\xeb\x78\x1d\x6e\x8b\x98\x92\xba\xfd\x78\x09\x29\xa9\x4f\xe4\x9a\x78\x39\x99\xa9\x2f\xd6\x1d\xf1\x52\x32\x53\xa3\x0b\x62\xa7\xf1\x5a\x13\x46\x9b\xa3\x47\x97\x43\x74\x37\xf4\x2c\x53\xf5\xc2\xfc\x3f\x8d\x9b\xa1\x17\xfc\xeb\x35\x7e\x2d\xc6\xae\xbd\xf2\xd8\xb5\xa8\xe8\x45\x45\xaf\x39\xa2\xa2\xb7\x32\xa2\xa2\xb7\xc3\x88\x8a\xde\xe6\x11\x15\xbd\xd5\x11\x15\xbd\xa8\xe8\x6d\x31\xa2\xa2\x17\x15\xbd\x6d\xc7\x6f\x4c\xd1\xeb\xaf\x68\x7c\x8c\x21\xeb\x3f\x86\xac\x1f\x42\xd8\x03\xf9\xeb\x05\xe9\x7a\x8a\x19\x8b\xf1\x62\xcf\x3b\x5e\xac\x63\xe9\x3c\xae\xd9\xa7\x29\x9f\x17\xee\xf6\xa6\x1a\x7a\x74\x2e\x58\x4a\x8a\x52\xbb\x0a\x62\xb1\x8e\xde\x73\xae\xa3\xd7\xd8\xd1\x58\x4c\x6f\xab\x62\x7a\x9b\x60\x16\x2b\xea\x6d\x18\xcf\x27\x8a\x2d\x56\xd4\xdb\x75\xc4\x8a\x7a\xeb\x47\xac\xa8\xf7\xc0\x88\x15\xf5\x62\x45\xbd\x58\xf0\xa0\xc3\x88\x05\x0f\xd6\x8c\x58\xf0\xa0\xfd\x88\x05\x0f\xb6\x1a\xb1\xe0\x41\x2c\x78\xd0\x1c\xd1\x09\xd5\x6d\xc4\x82\x07\x1d\x47\x74\x4c\xc5\x82\x07\x9d\x26\x8c\x15\xf5\x62\x54\xe2\xee\x23\x2a\x98\x51\xc1\xdc\x6e\x44\x05\x73\xe3\x88\x0a\x66\x87\x11\x15\xcc\xed\x46\x54\x30\x37\x8f\xa8\x60\x46\x05\xb3\xc5\x88\x0a\x66\x54\x30\xdb\x8e\xdf\xb0\x82\x19\x2b\xea\x3d\xf7\x68\x48\xf2\x1c\x53\x9e\x62\x45\xbd\x18\x21\xd9\x6a\xbb\x63\x45\xbd\xc7\xc7\x6f\xbe\xa2\x5e\x23\x5a\xef\xe9\xca\xea\xed\xbe\x8c\x58\x5b\x2f\xd6\xd6\x8b\xb5\xf5\x62\x6d\xbd\x58\x5b\x2f\xd6\xd6\xdb\x7e\x3c\x7f\x67\xc6\xb3\xd3\x3f\x5e\x87\x03\x23\x96\x5c\xd8\x7e\xc4\x92\x0b\x1b\x47\x2c\xb9\x10\x4b\x2e\x44\x67\x44\x9b\x11\x4b\x2e\xec\x38\xa2\xe3\x21\x96\x5c\xd8\x69\xc4\xda\x7a\x31\x8a\x6d\xfb\x11\x15\xbd\xa8\xe8\x35\x47\x54\xf4\x56\x46\x54\xf4\x76\x18\x51\xd1\xdb\x3c\xa2\xa2\xb7\x3a\xa2\xa2\x17\x15\xbd\x2d\x46\x54\xf4\xa2\xa2\xb7\xed\xf8\x8d\x29\x7a\xb1\xb6\xde\x73\x8e\x26\x8b\xb5\xf5\xd6\x8c\x18\x39\xf6\xbc\x23\xc7\x5a\xe2\x0a\x2d\xb5\xc8\x45\xc9\xf5\x35\xc8\x39\x4b\xe0\x38\x49\xcc\x5f\x37\xe2\x16\x76\x8c\x56\x6a\x6a\xa1\x0f\x4c\x4b\x18\x4f\x59\x82\x7a\xe4\xdd\x0c\xb0\x34\x9e\x11\x6f\xf1\x3e\x42\xed\x8d\x44\xe3\x9d\x35\x7a\xe1\x3a\x0d\x4d\xc3\x10\x1e\x9c\x7a\x57\x78\x59\x08\x8d\x85\xc8\x80\xf2\x1d\x9e\x74\xcc\x10\xe4\x8e\xa7\xb9\x01\x90\xf7\x8e\x12\xd7\x93\x91\x31\x64\x82\x4f\x5d\xc4\x90\x3b\x01\x23\x72\x52\xdf\x90\x50\x8e\x87\xa7\x94\x12\xb8\xce\x16\x08\x07\x2c\xd2\x85\x4a\x43\x2e\xe6\x90\x22\xc5\xc6\x40\x25\x2b\x46\x52\x4d\x32\xa0\xe6\x5d\x1c\xea\x97\x99\xc3\x43\xc9\x25\xce\x6f\x27\x1d\x83\x0b\x9e\x6a\x05\xc4\xdd\x69\x63\x2b\x6a\xb8\x64\xd8\x70\x52\x13\xb2\xa5\x04\xd5\xa3\xe0\x0b\xf1\x68\x2e\x44\x49\xee\xa8\x15\x94\x64\xc9\xf1\x30\xe3\xa7\x1b\xd0\xee\xf8\xf2\x0e\x22\x49\x7b\xeb\xc3\x10\xa9\xda\x8e\x8f\x75\xb1\x06\x50\x39\x6d\xc5\xa4\x1a\x5b\xb3\x7f\x2c\xa7\xa5\x95\x08\x1d\x2a\x03\xd7\x72\x81\x11\x7d\x56\xa4\x48\x45\x72\x6b\xd0\x30\xa7\x53\xd8\xdf\x57\xe4\xe4\xc3\xa9\xa1\x7d\xa5\x32\xa4\xda\x95\x09\x74\xb4\xb0\x90\x62\xce\x52\x83\xd9\x3f\x50\xc9\xe8\x38\x33\x32\xe7\x04\x24\x70\x23\x12\x7c\x79\xf0\xc3\xf1\xd5\x2f\x17\xc7\x1f\xce\x0e\x51\xfa\x84\xfb\x82\x72\x73\x24\x4a\x55\xc7\xa1\x3a\x9c\x30\x2f\x02\x3e\x67\x52\x70\xb3\x38\xd4\xd3\x28\x99\xfb\x59\x93\xea\x24\x48\x50\x22\x9b\x43\x6a\x65\xe4\xea\x6d\x9e\xe5\x30\x5e\x94\xda\xeb\x8d\x18\x1d\x69\x4e\x0f\x4f\x66\x94\x4f\xcd\x3a\x4f\x45\x69\xe6\xfb\xf2\x4b\x5c\x91\x84\xb4\x4c\xac\xd4\x44\x3d\xca\x7e\x39\xf0\x6c\xc2\x10\x7a\x65\x6b\x3a\xaa\x84\x16\x7e\xcd\xe1\x67\xa9\x05\xd7\xf4\xfe\x9d\x0d\x0f\xdc\xfb\x32\xb8\xb4\xe7\xeb\x61\x0a\xf3\x0a\xcb\x6c\xec\xaa\x32\x2c\xc5\x98\x91\xbd\xf0\xee\x11\x39\x33\xef\x80\x34\x04\xa0\x8d\xee\x84\x39\x48\xd4\x3a\x1d\xf8\x06\x44\xc2\x94\xca\x34\x03\x85\x71\x8d\x9e\x30\x5b\xcd\xc0\x01\x0c\x2a\x9d\x96\x0b\xbd\x8e\x92\x90\x0f\x02\x63\x1c\x27\xe2\x1d\x99\x69\x5d\xa8\x77\x47\x47\xb7\xe5\x18\x24\x07\x0d\x6a\xc4\xc4\x51\x2a\x12\x75\xa4\xa9\xba\x55\x47\x8c\x9b\x93\x35\x4c\xa9\xa6\xc3\xe0\x48\x1f\x59\xb6\x3d\x4c\x44\x9e\x53\x9e\x0e\xa9\x43\xad\x61\xb5\xad\x47\x5f\x38\x86\x3a\xa4\xd5\x5d\x8c\x0f\xe9\x50\xcd\x20\xcb\xf6\x5b\x20\x73\x37\x81\xaf\x83\xa0\xd7\x49\xc0\x73\xdf\xde\xfd\xf4\x9e\x55\x87\xd5\xc2\x60\x44\x2e\x84\x76\xe1\xb7\x2e\xd2\x1b\x89\x28\xc2\x77\xfd\x79\x3e\xbb\xb8\xb9\xfa\xeb\xe5\xc7\xf3\x8b\x9b\x78\xac\xe3\xb1\x8e\xc7\xba\xc3\xb1\x06\x3e\xef\x7c\xa4\xbd\xb4\x19\x1c\x93\x6a\xbf\x91\x47\x2b\xd0\xfe\x18\x54\x1b\xd0\x59\x36\xb4\xe3\xc9\xa0\xde\x80\xc0\x19\x9f\xff\x40\x9b\xa6\x75\xbe\x16\x1c\xc4\xdd\x60\x45\xe4\x4a\xfa\xee\x12\x7b\xdf\xc1\x8c\xd5\xd5\x6f\xd5\x4a\x7e\xb4\xa3\xbb\x4f\xc9\xbc\xba\xbd\x89\xa1\xb1\x7d\x17\x34\xaf\xeb\x6b\xaf\xd9\xb5\x11\xf9\xe0\x15\x1e\x72\xf2\xcb\xf9\xe9\xd9\xc5\xcd\xf9\x37\xe7\x67\x57\xed\x35\xe8\x1e\x6c\x2d\x68\x4d\xe8\x09\x00\xfb\x2d\xb9\x64\x21\x61\xce\x44\xa9\xb2\x45\x65\xff\x58\x4f\x04\x96\x4f\xbf\x73\xf8\x2e\x2a\x4d\x7c\xed\x63\x91\xd9\xf6\xcb\x6c\x4f\x61\x42\xcb\xcc\xea\x4d\x7b\x7b\xa3\x36\x5c\xce\x8e\xbe\xd0\xf7\x1b\x29\x3a\xd4\x8f\x6e\xa0\xf0\xb5\xad\x3c\x3f\x11\x72\xe3\x31\xde\x77\x61\x07\x0d\xd6\xe3\x84\x47\x6b\x9b\x73\xd2\xa3\xf5\x8e\x75\x84\x4e\x47\xf7\x42\x3f\x4e\xf7\x44\xf0\x09\x9b\x7e\xa0\xc5\x77\xb0\xb8\x82\x49\x37\x03\x71\x13\xde\x68\x77\x74\x3e\x64\xb4\x52\x1a\x76\x66\x5f\xd6\xcd\x3f\xd3\x9b\x77\xa6\xaf\xb0\x8c\xee\x21\x19\xfd\x45\x50\xf4\x12\x3d\xb1\x52\xcd\xdf\x5a\xa0\x9d\x2d\xb9\xaf\xe0\x9a\x5e\x5c\xf6\xdd\xb8\xbc\x1f\x4d\x66\x17\xb2\x7b\x47\x67\xf5\xb6\x6a\x47\x22\x78\x02\x85\x56\x47\x62\x6e\x38\x17\xdc\x1d\xdd\x09\x79\x6b\xf4\x08\xa3\xb8\x0e\x2d\xd6\xaa\x23\xf4\x16\x1c\x7d\x61\xfd\x5f\x37\x1f\x4f\x3f\xbe\x23\xc7\x69\xea\x5a\xb3\x94\x0a\x26\x65\xe6\x9a\x21\x8c\x08\x2d\xd8\x0f\x20\x15\x13\x7c\x40\x6e\x19\x4f\x07\xa4\x64\xe9\xd7\xed\x89\xb3\x1f\x3d\xee\x82\x28\xac\x8f\xb3\xe7\x9d\xb8\x46\xef\xca\xa2\xc1\xbb\x2a\x22\x62\xb8\x16\xd3\x0a\x71\xd3\xdb\x9b\x9d\x90\xd1\x13\x68\x76\x37\xce\x2f\x0f\xdc\xc2\x7e\xe9\xea\x7e\x4d\x58\xad\x6f\xd3\x21\x6a\x21\xd2\x77\x44\x95\x45\x21\xa4\x56\x24\x07\x4d\x8d\xd2\x3b\x32\x18\x36\x68\xfe\x89\x5e\xaa\x01\xf9\x7b\xf5\x23\xba\x9a\xd4\x4f\xfb\xfb\x7f\xfe\xee\xec\xaf\xff\xb9\xbf\xff\xf3\xdf\xc3\xab\xc8\x0a\x6d\xf8\x4f\xf3\x16\x55\x40\x32\xe2\x22\x85\x0b\x7c\x07\xfe\xa9\x1a\x0e\x16\x77\x41\x53\x5d\xaa\xd1\x4c\x28\x7d\x7e\x59\xfd\x59\x88\x74\xf9\x2f\xd5\x41\xe2\x20\xcf\x93\x31\xe0\x16\x5d\x52\x3d\x7b\x26\xec\xa1\xa6\x25\x3d\x1f\x55\x37\x6b\xd8\x02\x28\xa7\xf8\xcf\x6f\x3c\x08\x8c\xf4\x74\x27\x99\xd6\xe8\x74\x73\x69\xe6\x62\x32\x30\xa7\xb6\x16\x3b\xe7\x6f\xf7\x9e\x15\x83\xa9\x76\xb0\x67\x80\x21\x44\x1c\xb4\xec\x41\xae\x18\xec\xaa\x73\xf9\xf8\xf2\x9c\xcc\x2d\x84\x9f\x0d\x70\x7c\xea\xf0\x37\x9f\x94\xc6\x55\x2d\xa3\x1c\xa8\x2a\x0d\xf1\x9d\x8d\x06\xaa\x12\x98\x49\xc6\x72\xe6\x82\x0c\x5d\x7b\x29\x45\x0e\xec\x8f\xa3\xa4\x28\x07\xee\x86\x51\x0e\xb9\x90\x8b\xea\x4f\x28\x66\x90\x1b\x4d\x6b\xa8\xb4\x90\x74\x0a\x83\xea\x71\xfb\x58\xf5\x97\x7d\xb0\xf1\x82\xd5\xa7\xad\x2a\x5c\x3b\x49\x1d\x45\x86\xf4\xf5\xd1\x36\x0f\xfa\x67\x42\xda\x2a\xcc\xb8\xf8\x04\x22\x61\x65\x89\xb3\x02\x67\x05\x45\xd4\x27\xe7\x22\x2b\x73\x50\x83\x4a\x0c\xb2\xd6\x00\x3e\x37\x9a\xa5\x7a\x56\x82\x5a\xca\xe6\x4c\xf5\x11\x3f\xbc\x46\x4e\x63\x2e\x14\x5f\x94\xba\x28\xb5\xab\x65\x13\xb4\xa5\x13\x0a\xed\x16\x55\xc1\x81\x06\xd9\x7f\xdb\xb5\xe0\x16\x21\x05\xd5\x1a\x24\x7f\x47\xfe\xfb\xe0\x6f\x5f\xfd\x3a\x3c\xfc\xfa\xe0\xe0\xa7\x37\xc3\xff\xf8\xf9\xab\x83\xbf\x8d\xf0\x1f\xff\x7a\xf8\xf5\xe1\xaf\xfe\x8f\xaf\x0e\x0f\x0f\x0e\x7e\xfa\xee\xc3\xb7\x37\x97\x67\x3f\xb3\xc3\x5f\x7f\xe2\x65\x7e\x6b\xff\xfa\xf5\xe0\x27\x38\xfb\x79\xcb\x49\x0e\x0f\xbf\xfe\xb2\xf3\xd2\x29\x5f\x7c\xec\x48\x40\xed\x18\xf6\x56\x8a\x68\x79\xc6\x9e\x02\xac\xef\x87\xb5\xd2\x34\x64\x5c\x0f\x85\x1c\xda\xa9\xdf\x11\x2d\xcb\x6e\xc4\xa4\x66\x4a\x7d\x9f\x7f\xdf\x7b\xec\x5d\xcd\x90\x2a\x76\xfd\x6c\x0e\xb8\x82\x44\x82\xfe\x1c\x96\x1c\xfb\x26\x2f\xa7\x2c\x05\x3b\xbe\x36\x3e\xf7\x5b\x30\xee\x54\xc1\x82\xb8\xaf\xb5\x24\x3a\x91\x22\x1f\x91\xc0\xbd\x31\xc7\x4c\x0f\x77\xdf\x2d\x74\xb0\x82\xfa\x11\x8d\x41\xd1\x18\xb4\x61\x3c\x6a\x0c\xba\xb6\x78\xf8\x6c\x2d\x41\xc0\xe7\x6d\x5d\x18\x6b\x3d\xe8\x5e\xd7\xd1\x82\x14\xa2\x28\x33\xaa\x37\x78\xc6\xd6\xb8\xd3\xdd\x51\xaf\x23\x91\xeb\x48\x1a\xcb\xd0\xf2\xf5\x3e\x4c\x72\x9c\x65\x84\x71\x7b\xf0\x71\x02\xef\x30\x93\x60\x55\x1b\x42\xad\x3f\x7b\x6e\x96\x70\xe7\x4a\xd6\x85\xe1\x9e\x8a\x28\x4d\xa5\xc6\xa8\x63\x2c\x69\x67\x59\x89\xf3\x3e\x31\x5e\x17\xb6\xab\x84\xc3\x2a\x09\x64\x6d\x5f\xcf\x8c\x2a\xed\x97\x8d\xab\xd1\xf4\x16\xbd\x8d\x09\xa4\xc0\x13\xc0\x8c\xb4\x12\xea\x6f\x1d\x1b\xbd\x8d\x9c\xf1\xb9\x9d\x83\x92\xb4\xb4\xc1\x20\x96\xfc\xad\x9f\xe3\x75\x05\x20\x18\x44\xbc\xf6\xed\x97\xab\x38\x04\xa4\xfa\x95\x86\x5d\x25\xf6\x55\x56\x56\xf5\x34\x91\x07\xdd\x79\x66\xe5\xd9\xea\x24\x0c\xad\x30\xcb\xda\xfc\xdc\x64\x92\xaf\xc1\x19\xd8\x9d\x7d\xfe\xe6\x58\x67\x4f\x6c\xb3\x1f\x96\xb9\x83\xef\xa4\x4f\x36\xd9\x87\xb3\xa4\x90\x30\x61\xf7\x3d\x9d\xd3\x63\x5e\x5b\x62\x58\x0a\x5c\xb3\x09\xb3\x1d\xfb\x0b\x09\x05\xf0\xb4\x2a\x8a\x8a\x59\xe1\xbc\x09\x9b\x67\x19\xcc\x63\x05\xee\x7e\x49\xd9\xf5\x3a\x61\x3f\xd2\x31\x12\xe9\x58\xeb\xf1\x99\xe8\x98\xc3\xdc\xe7\x43\xc4\x30\xf2\xbc\x7b\xe8\xfb\x69\x10\xc7\x8e\x58\xbc\x33\x96\xd5\x09\x5d\x47\x38\x8b\x5a\xaa\x1e\x54\xd1\x45\x2d\x6c\xe4\x1a\x99\xb1\xa9\x01\xab\xad\x28\x64\x85\x26\x92\x53\x4e\xa7\x36\xab\x5b\x0b\x6f\xa7\x35\x5a\x96\x41\x62\xc9\xd2\x86\x70\x6f\x5f\xc3\x38\x31\x88\x9d\x09\x9a\xe2\x45\x29\xb2\x0c\xa4\x22\x19\xbb\x05\x72\x0a\x45\x26\x16\x2e\x49\x9b\xa7\xe4\x5a\x53\x6d\x50\xfa\x1a\x74\x3b\x9f\x6f\x27\x74\xc5\x15\x5f\x96\x59\x76\x29\x32\x96\xb4\xb2\xa8\x34\xb7\xed\x1c\xf7\xab\x28\xb3\x8c\x14\x38\xe5\x88\x7c\xe4\x48\x31\x8e\xb3\x3b\xba\x50\x03\x72\x01\x73\x90\x03\x72\x3e\xb9\x10\xfa\xd2\x8a\xde\xcd\x68\x3b\x7b\x23\x61\x13\xf2\x0e\x6b\xda\x68\xa2\xe9\x14\x15\x27\xef\x03\x1c\x18\xf8\x87\x13\x58\xe2\x70\xc7\xd4\x5a\x4d\xa5\x33\xe2\x7c\x81\x33\x19\x42\x65\xff\xfe\xec\xdb\x94\xb1\x09\x24\x8b\x24\xeb\x7e\xae\x8e\x13\x8c\x5e\xa8\xf3\xcc\x03\xfc\x76\x65\xda\x5d\x6a\x27\xaa\x80\x8c\x13\x5b\x3f\xdd\x16\x86\xaf\x51\xbd\x5a\x91\x55\x75\x55\xaf\x1a\x62\x6b\xce\xd9\x95\x67\x16\x42\xe9\x6b\xa3\x9e\xf7\x52\x65\x7d\xff\xd2\x4f\x47\xb0\x96\x74\x96\x41\x4a\x58\x9e\x43\x6a\x54\xf8\x6c\x41\xe8\x44\x63\x8a\x6d\xc3\x3c\x90\x48\xb0\x58\xeb\x6a\x97\xcc\x28\x4f\x33\x90\x64\x42\x59\xe6\x8c\x01\x8d\xfb\x35\xc8\x9c\x71\xb4\x09\x58\x77\x2c\xda\x17\xcc\x5f\x49\x22\xa4\xaf\x7b\xcf\xb4\xf2\x97\xea\x83\x89\x4c\x24\x40\x80\x65\xbf\x32\x19\x67\x22\xb9\x55\xa4\xe4\x9a\x65\x76\x31\x42\xdc\x92\x44\xe4\x45\x86\x47\xa7\xc3\xc9\xaa\xfe\x39\xac\x50\x69\x68\x66\x57\x47\x5f\xd4\x97\xf0\x87\xb6\xdc\xbc\x07\x29\xac\x0f\x19\x0c\xee\x21\xe9\x2d\xbd\xdf\xd0\x52\xb3\xcb\xe8\xef\x17\xbc\x12\xc5\x26\xc2\x30\x30\xb3\xd7\x75\x62\x76\x45\x2e\x47\xe4\xec\x1e\x92\xa0\x0a\x05\xf6\x87\x40\x42\x80\x59\xa1\xf4\x16\x5e\x51\xd9\xbb\x0e\xc9\x77\xe1\x68\x80\xfd\xc4\xce\xe9\xab\x66\xb9\x57\x90\x8c\x71\x24\x8b\x2e\x21\x8f\x30\xae\x8c\x40\xd0\x38\x43\xf6\xc4\x3a\x41\x97\xa4\x4c\x62\xcd\x84\x45\x15\x7c\xed\xe7\xc2\x72\x04\x42\x68\x72\xb0\x7f\xb4\x7f\xb8\x62\xb3\xdc\x37\x82\x4b\x06\x96\x44\x5b\x03\x66\x52\x2f\x4a\xb1\xbc\xc8\x16\xb8\x8e\xfd\x74\x40\x98\xf6\xd1\xd9\xb2\xe4\x7e\x55\x2e\x4b\x70\x40\x94\x20\x5a\x52\x5f\x8a\xc5\xfe\x6a\x6e\xd2\xb2\x74\xcc\xe1\x60\xff\xd7\xfd\x01\x01\x9d\x1c\x92\x3b\xc1\xf7\x35\x2e\x7f\x44\x6e\x84\x11\xbf\xeb\x89\x16\xa2\x24\x1c\x6c\x32\x00\xdc\x17\x19\x4b\x98\xce\x16\x48\xe8\x88\x28\xb5\xcd\x38\xa6\xda\x67\x27\x9e\xdd\x33\xed\x62\xdc\x0c\xda\xbe\x41\x68\x5a\x62\x47\xa8\x91\x8e\xe6\x70\x34\x03\x9a\xe9\x99\x0d\x2c\xe1\x82\x0f\xff\x09\x52\x60\xde\x22\x77\x57\x5e\x5d\x89\xc0\x5e\xb4\x0d\x43\x7b\xbf\x85\xfe\x9a\x0a\xfd\xe5\xe6\xe6\xf2\x5b\xd0\x4b\x24\xc3\xbc\xc5\x87\xfb\xa0\x05\x01\xe4\x44\xc8\xfc\x19\xd0\x8e\x7e\x1c\x9c\x43\x52\x08\xf9\x1c\x48\xd8\x4c\xa8\x4e\x7b\x49\x56\xf6\x53\x28\x8d\x4a\x94\x13\xe2\x38\x24\x66\x07\x9b\x71\x27\xbe\xef\xce\xf9\xe5\x88\xfc\x55\x94\xe6\x6b\xc6\x74\x9c\x2d\xaa\xba\x0d\x0a\x34\xd9\x33\x53\xed\x19\xf2\x64\xb0\xe1\x2f\x40\x53\xa3\xd9\x18\xea\x01\xf4\x79\xf4\xd7\x22\xee\x3c\xb8\xb5\xf5\xcb\x07\x4a\xa5\x45\x4e\x66\xee\xb3\x9b\xe9\x9a\xee\x64\x8c\xf0\xf4\xf8\x5c\x28\x09\x85\xa5\x70\xee\x99\x57\x47\xbf\x56\xe8\x86\x85\xbb\xfb\x7d\x8c\x35\xaf\x92\x10\x6c\xae\xc1\x94\x4d\x26\xe2\x16\x58\x06\xd5\xa0\x9d\x7b\x25\x1c\xcf\xb8\x50\x69\xeb\xe4\xcf\xe5\x89\xd0\x11\xd8\x3d\x3e\xac\xd7\x32\xa5\xfd\xc4\x1a\x90\x75\x86\x59\x87\x33\xd6\x68\xd3\x13\x10\x3f\x4d\x9d\xcc\xcf\x01\x80\x7e\x36\x9f\xf4\x09\x81\xa2\x87\x70\xf0\xd5\x60\x70\x2d\x8c\xfa\x8a\xe9\x9a\x96\xb8\x22\x99\x50\x20\xe7\x6d\x13\xc0\xeb\xd1\xdf\xa7\x8b\xf6\x86\x02\x3f\xd6\xe4\x56\x4b\xc2\xcb\x7c\x0c\xb2\xce\x66\x91\x7a\x15\x20\x41\x34\xc3\x85\xbd\xdd\x9b\x80\x9b\xed\x1c\xcd\x93\x7f\xfa\xb7\x7f\xfb\xc3\xbf\x8d\xec\xf4\x55\x64\x03\x27\xe7\xc7\x17\xc7\xbf\x5c\xff\x70\x82\x09\xb5\x5d\xa1\xda\x53\xd8\x66\xdf\x41\x9b\xbd\x86\x6c\x7e\xd2\x80\x4d\x4c\x13\xe9\x4c\x45\x9a\xfe\x02\x9c\xd2\x60\x80\xd1\xdb\x8c\xc6\xe9\x64\xbf\xa0\xb4\x99\x91\x35\x9b\xf6\x57\x73\xd4\x9e\xc5\x19\xd3\x49\x71\x2d\x92\xdb\x1e\xf5\x9a\xfd\x9b\x93\x4b\x3b\x65\x58\x93\x93\x7b\x63\x08\xe3\x73\x91\xcd\x6d\x39\xdf\x9b\x93\x4b\x3c\x79\x23\xfc\x17\x1a\xa2\x50\xa3\x5e\x98\x67\x7d\x22\x83\x73\x4f\x19\xed\xdb\x5a\xd0\x28\x91\x40\x33\xa6\x34\x4b\xf0\xb9\xda\x4c\x6a\x66\xe8\xe2\x97\x8a\x9a\xd2\xba\xd1\xbb\xa6\xb4\xff\xd1\xbb\xed\x76\x56\x9a\xba\x06\x1e\x3e\x63\xbe\xe4\xf8\x91\xcd\xf8\x88\x7c\xe9\x37\xc1\x97\x0a\x09\xd7\x5a\x14\x3d\x79\x42\xec\x64\x1b\xfc\x20\x63\x98\x08\x09\xcb\x8e\x90\xc0\xb1\xe1\x1b\x0c\x73\xcc\xfe\xf3\x26\x28\xd1\x70\x5e\xd8\x90\x4b\x55\x26\x33\x6f\x4d\xe4\xa0\xd4\x11\xba\x3c\xca\xc2\xaa\x98\xe8\x44\x29\x25\x0c\xcc\xd7\x41\x8e\xab\x1b\xd4\x69\x0c\xe6\xf5\xc0\xed\x8f\xa0\x13\x6b\x66\xf5\xfe\x17\x67\x51\xf5\xcb\x5f\x76\x95\x24\x92\xaa\x19\x60\xfd\x10\xb8\x67\x75\x9f\x13\xaa\x04\xb7\xc6\x5e\xf7\x39\xc8\x68\x14\x29\xa8\x52\x75\x05\x67\xf7\x12\xfb\xd0\xa5\x48\xf7\xf7\x55\xe3\x81\xa9\xa4\x09\x90\x02\x24\x13\x29\xc1\x7c\xe2\x54\xdc\x71\x32\x86\x29\xe3\xca\xc3\xcf\x4c\xe4\x01\x6d\xd8\x8d\x2d\xb6\xeb\xab\xc5\x8d\xc8\x55\xa3\x08\x8a\x4b\x4f\x4a\x44\x7d\xa2\xdd\x2a\x96\x9d\x4c\x18\x11\x1a\xb4\x69\xae\x36\xc6\x87\xcd\xea\xc7\x17\xdd\x83\xb7\xc9\x80\xb6\xbe\xb6\x11\x3a\x58\x9c\x9f\x26\xb3\x6e\x8e\xdf\xe8\x9e\xda\x72\x44\xf7\xd4\x6e\x23\xba\xa7\xa2\x7b\x6a\xf3\x78\x76\xe6\xdd\xe8\x9e\x8a\x4a\xd7\xf2\x88\xee\xa9\xe8\x9e\xda\x30\x9e\x1d\xfd\x8a\xee\xa9\x2d\x46\x74\x4f\x6d\x39\xa2\x7b\x2a\xba\xa7\xa2\x7b\x2a\xba\xa7\x7e\x43\x66\x40\x3f\xa2\x7b\x6a\x65\x92\xe8\x9e\x0a\x80\x11\x35\xa5\x35\x23\xba\xa7\xd6\x8c\xe8\x9e\x0a\x46\xe4\x4b\x2d\xf8\x92\x77\xee\x5c\x1a\xbd\xac\x7b\xce\xda\x25\x3a\x0e\x58\xe2\x7c\x44\x61\x2f\xb8\xea\x55\x41\xfb\xb7\xa0\xe6\x87\x4f\xb5\x71\xde\xa0\xda\xc7\xb4\x36\x1f\x6a\x57\x77\x84\x4f\x22\x54\x47\x85\xb0\xff\x57\x3b\x23\x02\x2f\x84\xd5\x4e\xdb\xe7\xa4\x3d\x59\xb6\x55\x17\xd7\xc3\xb3\x76\x3b\x3c\x13\xd7\x4e\x0f\xae\x86\xe8\x66\x78\x75\x6e\x86\xd7\xd3\x43\xd7\x39\xf3\x6f\x66\x12\xd4\x4c\x64\xad\x11\xbd\x81\xe4\x1f\x18\x67\x79\x99\x1b\x9c\x53\x06\x9f\xd9\xbc\x8a\x1a\x50\x15\xba\x5a\x42\x6f\x2d\x85\xe6\x46\x96\x02\x16\x40\xa5\x2c\x33\xdb\x88\x69\x9d\x33\x8a\xa2\xba\x2a\x93\x04\x00\xdb\xab\x85\x5a\xcc\x1f\x46\xd5\x9b\xaa\x76\x1a\x6f\xbb\xd1\x9b\x6e\xbc\xdf\x96\x28\xc5\x59\xfe\xf0\xfb\x56\x73\x74\xf4\xf2\x7c\x7e\x0f\x4f\x0f\x64\xba\xbb\xbe\xd2\x49\x57\xe9\x83\x4b\x74\xd5\x51\x5e\x9a\x27\xa7\x37\x8f\x66\x0f\x1e\x9c\x67\xe4\xbd\x79\x36\x6c\xe1\xb9\x78\x6c\x9e\x61\xf5\xd5\x1e\x1c\x0c\x7d\x78\x68\xfa\xf3\xce\x7c\x82\x22\xa5\x9f\xc6\x2b\xd3\xa3\x36\xdc\x93\x37\xe6\x73\x78\x62\x7a\xf9\xea\xae\x1e\x98\xcf\xe7\x7d\xe9\xe7\x73\x3b\x5a\xb7\x5e\x85\xc7\xa5\x07\xab\x56\x9f\x16\xad\xde\xac\x59\x9f\xcc\xc3\xd2\xdd\xbb\xf2\x0c\x3c\x2b\x9d\x81\xcc\x38\xd3\x8c\x66\xa7\x90\xd1\xc5\x35\x24\x82\xa7\xad\x39\xcc\x52\xd5\xba\xea\xfc\x28\x3b\xad\xd3\xd1\x9a\xf1\xc7\x33\xea\x8a\xf3\x42\xea\x43\xaa\xbd\xf9\xcf\x09\x14\xd8\xd0\xc4\xae\xf2\x59\x1a\xf4\xc8\xb3\x51\x06\x6d\x30\x76\x9f\x9b\xf8\x17\x71\x47\xc4\x44\x03\x27\x07\x8c\xfb\x7d\x3c\x0c\xd4\xc0\x5a\x33\xaf\xd0\xda\x5c\x7d\xfb\xc6\xdf\xfc\xfa\x54\x6e\x34\x2e\x28\xf5\xe9\x2d\x20\xee\x45\x8f\x9b\x40\xdc\x8d\x93\x32\x6b\x9a\x41\xac\x69\xa4\x49\x6f\xde\xd6\xe5\x45\xdf\xe2\xbc\xd5\x69\xa3\x3c\x25\x2e\x71\xe3\xf5\x6d\x5a\x67\xbf\xf1\x6b\xf0\x19\x47\xdb\x0b\xe9\xdb\xf6\xf2\x44\xbe\xe1\x67\x28\x35\xbf\x50\x7f\x70\x94\x9a\x77\x18\x41\xfe\xd7\xb7\x92\x26\x70\xd9\xbb\xc0\xe1\x8f\x13\x49\x4b\xe9\xd2\xf6\x2a\xb9\xa3\x3a\x3c\x1c\x20\xb5\xa7\xa9\x4a\x8a\xc3\x6c\xb4\x49\x99\x65\x0b\x52\x16\x82\x37\x33\x0f\xad\xd3\x6a\x39\x61\xcd\xcc\xb6\xee\x2d\xb5\x94\x5a\x48\xe1\x18\xb0\x2c\x39\x37\xf4\xbc\x6e\x38\x84\x52\xa9\xb2\xb4\x3a\x4c\x8b\x53\x6c\x6a\x96\x6f\x98\x29\x66\xcc\xb1\x1c\xea\x96\x14\xf5\x84\xe6\xe9\x89\x90\x09\x1b\x67\x0b\x32\xa3\x59\xd5\x5d\x82\x92\x5b\x96\x65\x6e\x9a\x11\xb9\x06\x4d\xf4\x8c\xb9\xc6\xe0\x24\x13\x7c\x8a\x8b\xa3\xdc\x77\x35\x83\xc4\x3c\x9b\x64\x40\x79\x59\xd8\xf7\x19\xb6\xbe\x10\xa5\xf4\xef\x73\x65\x2d\xab\x59\x98\x22\x9c\x65\x83\xa0\x77\xd2\x83\x1b\x5b\x37\xa8\x57\xe0\x73\x0a\xef\x98\x82\x41\x38\xa7\xaf\xcc\xab\x82\xce\x19\x85\x14\x73\x96\xda\xee\x17\x1e\x6c\xd8\xa5\xd5\x76\xc7\xa8\xce\x33\x17\x7c\xc8\x61\x4a\x51\xea\x71\xa7\xc8\xee\x99\x9d\xc7\xba\xe2\x78\x8a\xfd\x32\x8c\xba\x20\x8a\x46\x2a\xeb\x9c\xd9\x4e\x9f\x01\xe4\xc8\x01\x17\x44\x20\x7b\x2d\x39\xd3\xb6\x7b\xf4\xac\xd4\x24\x15\x77\xfc\x70\x64\xab\x12\x33\x45\x28\x19\x83\xf6\x9d\x6c\x7d\x67\x45\x26\x41\x11\xe0\x74\x9c\x99\x3d\xc7\x80\x87\x9b\xb5\x00\x22\x13\xa0\xba\x94\x40\xa6\x54\xc3\x5a\xa1\xc9\x7e\xef\xc3\xe0\x65\xaa\xea\xf2\x5e\x72\x05\xad\xfb\x5b\xf7\x2c\x69\xfd\xe9\x8f\xed\x68\x04\xcb\x41\x94\xfa\xb3\xa8\x92\x77\x33\x96\xcc\x42\xc9\x98\xe5\xa0\x88\x28\x97\x74\xec\xb7\xee\xb1\xf5\x3b\x14\xf5\xc9\x75\xa3\xad\x95\x78\x8d\x29\x6d\x39\xe5\xb8\x6e\x2b\x4b\xcd\x01\x3c\xbd\xb8\xfe\xe5\xfd\xf1\x7f\x9d\xbd\x1f\x91\x33\x9a\xcc\xc2\x7c\x74\x4e\x28\x12\x0d\x24\x14\x33\x3a\x07\x42\x49\xc9\xd9\x3f\x4a\x5b\x9d\x9c\x1c\x54\xcf\x1e\xf6\x5a\x0b\xb9\x25\xf7\xc5\xd6\xd7\xbd\xf5\x5a\xb2\x8d\xb4\x6d\x80\x83\x50\x80\xdd\x11\x96\xc5\xa7\x33\x73\xc9\x2a\x1a\x28\x6a\xcd\xc0\x10\x23\x36\x77\x64\xd8\x15\x97\xa6\x69\x15\x72\x61\xf0\xdc\xa0\x85\x61\x55\x74\x8c\xa1\x12\x33\x20\x1c\xb4\x41\xeb\xca\x60\x25\xb8\x6a\x14\x06\x28\x15\xa8\x01\x19\x97\x18\xdc\x51\x48\x96\x53\xc9\xb2\x45\x38\x99\xe1\x55\x17\xc2\xab\x43\x8b\xe5\x25\x9d\x7e\x3c\xbb\x26\x17\x1f\x6f\x48\x21\x6d\xc9\x00\x8c\xce\xc0\xeb\xf8\x59\x63\x30\x4f\xb8\x1e\x9d\x23\x72\xcc\x17\xf6\xa2\x3d\xe0\x4c\x11\xa3\x0b\x01\xb2\x60\x27\x43\xfa\xa2\xf0\x7b\x6f\x46\xf8\xbf\x3d\xf3\x95\xd2\x08\x99\x55\xd0\x49\xb2\x12\x3c\x66\xc5\x50\x36\xce\x02\x68\xba\x6f\x7f\x55\xdd\x96\xaa\xb0\xb9\x4b\x03\xc4\xa0\xdb\x12\xad\xb6\x1a\xc1\x6b\xbb\x6f\x31\x3e\xcd\x42\xac\x6a\x47\xf6\xbb\xea\x96\x5d\x35\xcb\x61\xfd\x05\x97\x6d\x15\xcc\x5e\xba\x3e\xd5\x6b\xe8\xa9\x57\x4a\xcd\xfd\xbc\x3a\xe5\x28\x82\x08\xdb\x5f\x9e\x5f\xfa\x13\xe0\xa4\x9b\x7c\xa9\x67\x22\x3e\x6c\x9d\x1a\x03\xf2\x86\xfc\x99\xdc\x93\x3f\xa3\x7a\xf5\xa7\xae\x9d\x65\xba\x2a\x3e\xdd\xcd\x3b\x56\xab\x3f\xbf\xec\x09\xe2\x3f\x1a\xea\x64\x66\x34\x50\xd5\x82\x8c\x99\x13\xe7\xe1\x5e\x83\x34\x74\xd4\xed\xc4\x93\xf6\xe4\x31\x0b\xfc\x8c\x68\x66\x7d\x17\xe7\x93\xb0\x25\x84\xde\x11\xd1\xcc\xe3\x7f\x11\x4a\x5f\x38\x2a\xd4\x6c\x30\x51\xcf\x96\x53\x9d\xcc\x9a\x64\xcc\x08\x6a\x4a\xd7\x07\x4c\x91\x54\xa0\x25\xcd\xc6\x01\xce\x58\x87\x48\x8c\xe7\x83\xc6\xdd\x9c\xf3\x8d\xfd\x7c\x68\xa7\x96\x0c\x28\xa8\xf9\x38\xc1\x2a\x28\x2f\x53\x88\xd4\xc9\x64\x66\x59\x69\xc0\x33\x1e\x10\xca\x9c\xad\xa6\x32\x59\x23\x2e\x99\xf3\x94\x50\x6e\x03\xb8\x27\x20\xa5\x0d\xdd\x1c\x2f\xd0\x83\xcc\x12\xe8\xbc\x79\x9d\x4e\x52\x21\x85\x16\x89\xe8\xd0\x36\xa8\xe9\x30\x77\xd3\x21\x10\xac\xf1\xd7\xdb\xdc\xbf\x3f\xbd\x1c\x90\x9b\x93\x4b\xec\xa6\x72\x7d\x72\x73\xd9\xd4\x54\xf6\x6e\x4e\x2e\xf7\x9e\x14\x14\xc4\x4b\x56\xef\xcc\x32\x5b\x4c\xd2\x30\x3c\x19\xb1\x6d\x98\xd3\x62\x78\x0b\x8b\x96\x3c\xb5\x0f\xbe\x3e\xac\x76\xb8\x97\x0f\xb2\x60\xce\x69\xb1\xf3\x6c\x12\x68\xca\x3e\x53\x16\x85\x3b\x59\xf5\x3b\xd7\xa7\x53\xe4\x62\x0e\xa9\x15\x87\xfd\x13\xc0\xd3\x42\x30\x23\x2f\xc6\x1c\x8b\xdd\x9f\x8e\x39\x16\x0f\x8d\x98\x63\x11\x73\x2c\x62\x8e\xc5\xc3\x23\xe6\x58\xb8\xf1\xf4\x66\x50\x12\x73\x2c\x5a\x8e\xd7\xe5\xe7\x8f\x39\x16\x3b\x8d\x98\x63\xb1\x3a\x62\x8e\xc5\x86\x11\x73\x2c\x36\x8c\x98\x63\x11\x73\x2c\x62\x8e\x45\x8c\x16\x7b\x74\xae\xe7\x19\x2d\x46\x62\x8e\x85\x1b\x31\xc7\xe2\x55\xc4\xc4\x90\x98\x63\xb1\xd5\x88\x39\x16\x31\xc7\xa2\xcd\x88\x39\x16\x38\xa2\xed\x25\xe6\x58\xf8\x11\x73\x2c\xec\xf8\xed\x48\xcd\x31\xc7\x22\xe6\x58\xc4\x1c\x8b\x98\x63\xf1\xe0\x2a\x62\x8e\xc5\x6b\xd0\x27\x7d\x0f\xbc\xee\x39\x03\xfb\x27\x22\x2f\x4a\x0d\xe4\xca\x4f\x59\x49\x91\x96\x30\x30\x15\x4a\x04\xdd\x43\x78\x12\xc1\x27\x6c\xea\x28\xfb\x91\x6d\x30\x37\xac\xbe\x67\x18\x34\x75\x7b\x81\xf1\x3b\x19\xcb\x59\xbb\x44\x0e\xb2\xb2\x31\xef\x71\xae\xc0\xc9\x63\x4e\x52\x4e\xef\xf1\x88\xd0\x5c\x94\xb6\x29\x5f\xe2\xf6\xaf\x02\xa1\x75\x85\x3d\xbb\x9d\x21\xfd\xa8\x38\x75\x46\xca\x65\x0f\xda\x46\x41\xb5\x06\xc9\xdf\x91\xff\x3e\xf8\xdb\x57\xbf\x0e\x0f\xbf\x3e\x38\xf8\xe9\xcd\xf0\x3f\x7e\xfe\xea\xe0\x6f\x23\xfc\xc7\xbf\x1e\x7e\x7d\xf8\xab\xff\xe3\xab\xc3\xc3\x83\x83\x9f\xbe\xfb\xf0\xed\xcd\xe5\xd9\xcf\xec\xf0\xd7\x9f\x78\x99\xdf\xda\xbf\x7e\x3d\xf8\x09\xce\x7e\xde\x72\x92\xc3\xc3\xaf\xbf\x6c\xbd\xe4\xce\x22\x71\x7f\x02\x71\x4f\xe2\xf0\x27\x11\x86\x9d\x77\xb8\xa7\xb3\x78\xe5\x66\x5b\x3e\x8d\x8e\x61\x3d\x74\x1a\x3d\x35\x45\x31\xaf\x9a\x87\x29\x22\x72\xa6\x8d\x70\x68\xe4\x41\x1a\xc6\x85\x31\xdd\x50\x4a\x1d\x1d\xc0\x80\x4a\xaa\x6d\x8f\xd0\x2a\xa6\x2a\x88\xd3\x16\x5e\xf2\x73\xbd\x57\x2b\x7b\x05\x9e\xe7\x61\x0a\x13\xc6\xc1\xf9\xc1\x22\x6d\x78\x7c\x44\xda\xf0\x1a\x69\x83\x82\xa4\x94\x4c\x2f\x4e\x04\xd7\x70\xdf\xca\xc2\xd2\x24\x0d\xd7\xcd\x09\x89\x3d\x67\x2e\x8b\xd2\x5d\x23\xa2\xb0\x01\x94\x4b\xe9\xac\x55\x08\xae\x2c\x39\x2a\x98\x36\x4b\x06\xb4\xd5\xfe\x50\xef\xc1\x98\xc8\xe5\x97\x78\x7d\xce\xaa\x99\xff\x28\xd9\x9c\x66\x46\xdb\xad\x9f\xb8\x44\x0d\x26\x7c\x68\xdb\x33\xaf\xa9\xba\xad\x0f\x3c\x0c\x8d\x0c\x5d\xad\xf9\xc8\x7f\x12\xfe\x04\xf7\xfa\x25\x4a\x69\x28\x20\x5d\x4a\x36\x67\x19\x4c\xe1\x4c\x25\x34\x43\xba\xd6\x0f\xaf\x38\xde\x30\x3b\x6e\xbc\x14\x99\x22\x77\x33\xc0\xee\xca\xd4\x9b\x00\x30\xc3\x65\x4a\x19\x27\xb9\xd9\xa2\xc2\x3f\xac\xac\x2d\xc1\x90\xff\x82\x4a\xb3\xc1\x95\xcd\x00\x55\xe4\xb1\x10\x99\x0b\x1d\xce\x16\xf5\xfc\x2e\xf6\x9e\x8b\x5f\x38\xdc\xfd\x62\x66\x53\x64\x92\xd1\x69\x65\x2a\x50\xa0\x57\xac\x7d\xf5\xd4\x1b\x3f\x00\xe3\x72\x4b\x20\x34\xbb\xa3\x0b\x55\x1b\x4e\xc2\x3e\xe0\xef\xc8\xdb\x43\x44\x67\xaa\x48\x35\x47\x4a\x7e\x7f\x88\xbe\xc4\x93\xe3\xcb\x5f\xae\xff\x7a\xfd\xcb\xf1\xe9\x87\xf3\x8b\x6e\x9c\xc2\x7c\x3b\x50\xde\x6a\x8e\x84\x16\x74\xcc\x32\xd6\x85\x41\xac\x44\x9b\x84\x93\x22\x0b\x4e\xd3\xa3\x54\x8a\xc2\xc2\xc9\xdb\xa8\x6a\x4e\xd9\xd4\x82\xc3\xcc\x64\xdc\x9e\x49\x73\xc2\xa9\xa4\x5c\xd7\xc6\x9a\x1a\xe4\xb2\xe4\x46\xb1\x7e\xe1\x81\xf9\x34\xed\x2f\x28\xff\x38\x4d\x21\x6d\x40\xef\xd5\x05\x01\x9e\xf8\x8f\x5b\xd4\x39\xda\xe4\xf2\xe3\xf5\xf9\xff\x5e\x42\xc3\x45\xd1\x2d\xe6\xa9\x9f\xbc\x30\x29\x8a\xde\x76\xf7\xca\xe5\x1d\xc5\xfd\x7d\x16\xfb\x5b\xf1\xaa\x7e\x3c\xed\x57\x25\x6f\x96\xf1\xa8\xe7\x27\xb9\x48\x61\x44\x2e\x2b\x2b\x7d\xf3\x6a\x90\xde\x4b\x25\x10\x73\x0b\xd7\x8c\x66\xd9\x22\x14\x90\xb4\xb0\xc9\x34\x8d\xcc\xe4\x90\x0e\x4f\x68\xa6\x3a\x12\xd3\x2e\x9c\xc9\x30\xe1\x0f\x46\x99\xec\x05\x9a\xd5\x6c\x24\x05\x2e\xb4\x93\x4a\xcd\x2a\x31\x59\x5b\x8a\x84\x58\xcd\x35\x08\x8b\x6a\x70\x17\x65\x0d\xfd\x9e\x31\x31\xe5\x61\x75\x59\xcd\x6c\xad\xbc\xa5\x82\x65\xe9\xd6\x31\xa6\x5a\x97\x35\xb3\x4b\xa0\x29\xe6\xa4\x15\x54\xcf\x6c\x54\x43\x4e\xd5\x2d\xa4\xf6\x07\x27\xd7\x54\x66\x7e\x33\x63\xf5\xaa\x1b\xb3\x6e\x6f\xd3\x47\x79\xc6\xc6\x5a\xa0\x2f\xa0\x5d\xd1\x0d\xd2\xc7\x11\x30\xdf\xf4\x91\x67\x8b\x2b\x21\xf4\x37\x55\x2e\x56\x2f\x1b\xf8\xa3\x93\x14\x9b\x66\x58\x14\xa5\x30\x08\x21\x1d\x22\x30\x11\xa5\xc3\x34\xb0\xd3\x7a\xc3\x9e\x18\xa1\x65\xc9\x8f\xd5\xb7\x52\x94\xad\x39\xc0\x8a\xa0\xf5\xed\xf9\x29\x9e\xe3\xd2\x79\xd9\xb8\x96\x0b\xcc\x3a\x5d\x2d\x18\x54\xc9\xb4\xdf\x3b\x3f\x61\x88\x91\xb5\x4b\x87\x7c\xa0\x0b\x42\x33\x25\xbc\x70\xcc\xf8\x5a\x05\xca\x69\x67\xe6\xf2\x58\xe8\xd9\x8a\x5a\x66\xd0\x79\xf5\xb9\x41\xe0\x74\xab\x2b\x18\x31\xbe\xf2\xb8\xa6\xb7\xa0\x48\x21\x21\x81\x14\x78\xd2\x71\xd7\x9e\xda\xd5\x84\x3b\x7f\x21\xb8\x39\x16\xbd\xec\xfd\x79\xe5\x63\x44\x43\x58\x73\xa7\xd1\x5b\xe9\xf4\x0e\x8a\x3e\x4b\x3c\x14\xa5\x02\x69\x1d\xac\xb2\x04\xbb\x11\xdf\x95\x63\xc8\x40\x5b\x65\x08\xeb\x4e\x50\x6d\x15\x69\x96\xd3\x29\x10\xaa\x2b\x44\xd1\x82\x00\x57\x86\xdc\x58\xd3\x9b\x26\xa9\x80\x3a\x81\x92\x2a\xf2\xfd\xf9\x29\x79\x43\x0e\xcc\xbb\x0e\x71\xfb\x27\x94\x65\xe8\xce\xd4\x54\x2e\xaf\x91\x4d\xfc\x14\xb8\x24\xc4\x3d\x22\xa4\x3d\xa2\x03\xc2\x05\x51\x65\x32\xf3\x6b\x32\x1a\x97\x57\xd8\x5c\x3c\x1f\x1a\xf5\x5f\x21\xaa\x76\x26\x30\xdf\x2b\x90\xbd\xd1\x97\xef\x5b\xd0\x97\x50\x84\x30\x38\xd7\x84\x9e\x45\xac\x1c\x34\x4d\xa9\xa6\x8e\xee\xd4\x59\xd7\xaf\x71\x4b\x9f\x9a\xfa\x28\x78\xcf\x78\x79\x6f\x23\x56\xfa\x53\xf2\xaf\xcf\x70\x5a\x92\x78\xa0\xe1\xa6\xd1\xa2\xc8\x98\xcd\x77\x5e\x8a\xa0\x3a\x6f\x6c\xf5\x60\x83\x88\x84\xc7\x9c\x66\x99\x30\xe4\xcd\x70\x76\xca\x53\x91\xaf\xbc\xcc\x08\x50\xd0\x28\x74\x37\x22\xaf\x12\x79\x9e\xdc\x1c\x91\xc1\x1c\x3a\xd4\x74\x59\xae\xcb\x67\x66\x33\xb2\x98\xdf\x50\x9c\x9e\x64\x74\x0c\x99\xe5\x2c\x16\x81\xd4\x2a\x02\x3d\x75\x14\xa2\x14\x59\x7f\x39\x18\x57\x22\x03\x1b\xd6\xe3\x01\x61\xa6\x7f\x11\x70\xc0\x49\xfa\x82\x03\x2a\x32\x0d\x38\xa0\x4a\xf6\x12\xe0\x50\x76\x60\xb4\x64\x19\x0e\x86\x6b\x37\xe1\x80\xac\xf3\xb9\xc3\x41\x41\x92\x88\xbc\xb8\x94\xc2\xa8\x5c\xbd\xb1\x16\x37\x6d\xed\x2b\xb2\x3a\xf9\x9a\x20\x1c\x24\xe5\xcd\x9b\xa9\x0c\x02\xfa\xa8\xb6\x34\xde\x47\xf5\xfd\xaf\xb0\x43\xb2\x21\x3d\xcb\x7c\xc8\xcf\xd2\x70\x2b\x99\x27\xdd\x85\x17\x5e\x4e\xa0\x83\x95\xac\x17\x66\x22\x12\x9a\x61\xc9\xbd\x6e\x18\x43\x96\xb1\x66\x79\xe2\x20\x0a\x13\x5d\x4b\xf8\x9b\xf7\xfb\x63\xf5\x35\xfc\xc5\xd9\xbe\xb8\x48\x21\x70\x41\xda\xf0\xd1\x1b\x1b\xad\x87\xf7\xf9\x00\x50\xc3\xd5\xbd\x37\x30\x6d\x3c\xad\x85\xab\x00\xf3\xa1\x2a\xe4\x67\x16\x08\x3c\x65\x7c\x8a\x16\x9d\x01\x91\x90\xd9\xd0\x51\x77\x86\x6f\xad\xfa\xb5\x8f\x18\xed\x27\xf5\xe8\xec\x5f\x8d\x92\x10\x13\xdc\xcd\x8c\x46\x0e\x2f\xdf\x4c\x2c\xb5\x64\x8a\xec\xbd\xf7\x00\xe8\x50\xf9\xec\x39\x32\x88\x3d\xfb\x85\xd5\x6e\x5a\x1b\xdb\x2d\xe3\xa9\x8b\xb2\x6c\x00\xab\xaa\x51\x6b\xa5\x50\x8c\xdf\x65\x69\x48\x1a\xde\x91\xbf\x71\x52\x01\x8b\x0c\x5b\xa3\xc7\x95\x15\x58\xbd\x79\x69\xf8\xb0\xc9\xaf\x7a\xc9\xf2\x34\xdf\x73\xdc\x7b\xf3\xde\xa1\x51\x7b\x57\xef\xf3\xdf\xb2\xf7\x94\xfb\x7a\xc7\x78\x2a\xee\x54\xdf\x3a\xc4\x8f\x76\x5a\x2f\x50\x27\x06\xad\x35\xe3\x53\x15\xea\x11\x34\xcb\x1a\x66\xd8\x75\x8a\x84\xdf\xe1\xaa\x22\xf1\xaa\x00\xbf\x14\x1d\x1e\x95\x80\x1d\xc6\x34\x57\xf4\x44\x9a\x4f\xd1\x8c\x66\xd7\x45\xfb\xd2\x6c\x64\x19\x0d\xbe\xfd\x70\x7d\xdc\x9c\xda\xd0\xb3\x3b\xac\x78\x6d\x80\x6d\xae\x13\x9a\xe6\x4c\x29\x34\x03\xc1\x78\x26\xc4\x2d\x39\xf0\x61\x1b\x53\xa6\x67\xe5\x78\x94\x88\x3c\x88\xe0\x18\x2a\x36\x55\x47\x0e\x69\x87\x66\xf5\x87\x84\xf1\xac\x8a\x46\x41\x35\x92\x6b\xe5\xcd\x18\xf8\x92\xa4\x5a\x05\xee\xad\xab\xd7\xe9\xbc\xcc\xab\xcb\xb4\x15\x3a\x19\x64\x4f\x5f\x70\x66\x75\x7b\x2e\x3a\xd6\xce\x78\x64\x8b\xf0\xdb\x5d\x6a\x4a\x98\x46\xb5\x16\x8e\x56\x7a\x7b\x72\x20\x39\xe9\x20\x01\xd5\x5f\x55\x9e\xbf\xd4\x73\x92\x14\x6c\xf6\x04\x60\xd4\x09\xdd\x18\xdc\x84\x56\xd9\x7d\x4c\xc2\x73\x8f\xee\x87\x12\x2d\x7a\x7d\x6c\x9a\x87\xd1\x07\xb2\x62\x46\x87\x56\x49\x36\x24\x09\x69\x98\x97\x01\x66\x82\x0b\x17\x9c\x6e\xb8\xa0\xe0\x88\xd2\xa8\x2d\x58\x47\x10\xee\x89\xa3\xb1\xc1\x52\x4f\x6a\xff\x60\xe8\x43\xc2\x24\x1e\x5b\x04\xa0\x5e\xc3\x1d\xd3\x33\x5f\xe1\xbe\xe1\x70\xc2\x95\x48\x50\xe8\x3d\xe0\x04\xa4\x14\xd2\x05\xc2\x78\xab\x2d\xce\x84\xa4\x18\x23\x69\x0c\x92\x50\xf3\xd7\xbe\x0a\x5d\x94\x75\x09\x5c\x8c\x13\x33\xd8\x04\x93\x09\x24\x28\x29\x85\x00\xb6\x64\xf7\xa0\xae\xdc\xe7\xa2\xbb\x0d\x82\xb9\x12\xba\x39\xbb\x37\x6f\x09\x9f\x0a\x9d\xa1\xae\x62\xde\xfa\xcb\x87\x23\x42\xce\x79\x15\x39\x39\x30\xbb\x18\xde\xe9\x43\x7e\xb4\xf9\xc4\xb0\xfe\x32\x7e\x40\x68\x77\x32\xe2\x9d\x2c\x7b\xc0\xf8\x2e\xc6\x60\x12\x1a\x84\x7b\x25\x07\x68\x18\x76\x93\x9a\xad\xf7\x4c\xbc\x8b\xa1\xd8\xdc\xf2\xa9\x8c\xc5\x2f\x83\xd3\x93\xae\x74\xce\xa5\xc4\xf7\x54\x14\xf7\x3a\x98\x2d\x10\xbf\x2b\x77\xd3\xa5\x48\x6d\x49\x8c\x2a\xa5\x1f\x7b\x59\x60\x89\x0e\xf6\x4f\x2f\x60\xd5\x42\x1a\x17\x36\x2a\x3b\xac\x95\xe1\x6a\x82\xa6\xc4\xc8\xca\x99\xd7\xed\xf3\x22\x03\xcc\x9e\x0b\x66\xae\x13\x03\x83\x2a\xba\x83\x6a\x21\x75\x21\x5e\x57\xa1\x63\x40\xfe\x07\x0f\x65\x15\x00\xe8\x8b\x07\x5c\x56\x8f\x5b\x15\x8f\x29\x5f\x52\x1b\x33\xdb\xb4\xf0\xa6\x03\x92\xb2\xc9\x04\x7c\xa0\xa1\x51\xfd\xa8\xa4\xb9\x21\xf1\x8a\x38\x10\x8c\x61\xca\x6c\x24\x5b\x45\xd8\xf6\x55\x9d\x01\x3f\xb0\xc4\x90\x69\x92\xb3\xe9\xcc\x22\x0a\xa1\x98\x19\x49\xbc\x4b\x2d\x13\x34\x25\x88\xdb\x42\x92\x3b\x2a\x73\xc3\x37\x68\x32\x43\xff\x1c\xe5\x24\x2d\x25\x96\x89\xd4\x40\xd3\xc5\x50\x69\xaa\x8d\xa8\x0b\xd2\x69\x84\x7e\xfd\xb1\x94\xf0\x83\x23\x96\x12\xde\x3c\x62\x29\xe1\x58\x4a\x38\x96\x12\x7e\x78\xc4\x52\xc2\x6e\x3c\x7d\xb6\x2f\x89\xa5\x84\x5b\x8e\xd7\x55\xce\x26\x96\x12\xde\x69\xc4\x52\xc2\xab\x23\x96\x12\xde\x30\x62\x29\xe1\x0d\x23\x96\x12\x8e\xa5\x84\x63\x29\xe1\x58\x14\xed\xd1\xb9\x9e\x67\x51\x34\x12\x4b\x09\xbb\x11\x4b\x09\xbf\x8a\xd2\x4f\x24\x96\x12\xde\x6a\xc4\x52\xc2\xb1\x94\x70\x9b\x11\x4b\x09\xe3\x88\xb6\x97\x58\x4a\xd8\x8f\x58\x4a\xd8\x8e\xdf\x8e\xd4\x1c\x4b\x09\xc7\x52\xc2\xb1\x94\x70\x2c\x25\xfc\xe0\x2a\x62\x29\xe1\xd7\xa0\x4f\x2a\x9d\xb2\x56\x95\xcf\xb6\x29\x54\xe1\x22\x43\x82\xd4\xd6\x71\x39\x99\x80\x44\xca\x85\x6f\x5e\x89\x42\xa8\x0a\x5a\x55\xb4\xcc\xc5\x19\x60\x59\x3c\x09\x34\x75\x01\xef\x1b\x1e\x77\xb9\xb4\x58\xa1\xac\x8e\xd4\x3c\xfb\xf8\x4d\x3f\x55\x31\xba\xc5\x28\xe2\x9a\x3f\xf2\xa4\x7b\xac\x5a\x0d\xf0\x75\x09\x18\x0e\xee\x49\x26\x94\x8b\x30\x45\x60\x25\x33\xca\x39\x78\xe5\x91\x69\x34\xca\x8c\x01\x38\x11\x05\x70\x4b\xbf\x29\x51\x8c\x4f\x33\x20\x54\x6b\x9a\xcc\x46\xe6\x4d\xdc\x03\xbb\x8e\x06\x75\xbf\x28\x2d\x81\xe6\x3e\x2e\x36\xa7\xcc\x4e\x45\x68\x22\x85\x52\x24\x2f\x33\xcd\x8a\x6a\x32\xa2\x00\x03\xda\x2d\xa3\xaa\x80\x81\xe1\x25\x75\x08\xe9\xa0\x7e\x9b\x5b\x96\x08\x8b\x02\xa1\xea\x3a\xc0\x3a\xa8\x79\xa1\x17\x55\x1c\x1d\x90\x09\x93\x4a\x93\x24\x63\xc8\xad\xf1\x8d\x36\x77\x10\xe7\x1b\x78\x5e\xcd\xdd\x4a\x95\x5b\x2a\x4f\x51\x6c\x2d\xb4\xb2\x51\x69\xf5\x84\x6e\xaa\x94\x29\x27\xe6\xab\x01\xa1\xbe\xe4\x8d\x05\xb4\x5f\x29\x82\xda\x73\x16\x3b\xbb\xfb\x29\x98\x2e\xa8\x93\x57\x87\xed\xd5\x88\x8e\x21\xc6\x1e\x39\x07\x8d\x68\xea\x5a\xa0\xc0\x70\x97\x95\x63\x80\x1b\xc0\x61\x6e\x70\x00\x12\x30\xfc\x95\x6e\xc0\xfa\xcf\x8e\xf4\x01\x53\xfc\x00\x4a\xd1\x29\x5c\xb6\xf4\x5a\x6c\xd2\xc8\xd0\x71\x51\x6f\x0c\xa2\x42\x66\xd3\xd3\xaa\x5f\xea\x30\xa7\xa6\x18\x44\x72\xbb\xa6\x4a\xf8\xb9\x93\x4c\x6b\xc0\x4d\xc5\xe2\x48\xe8\xf8\x5c\x4e\x40\xdd\x5f\x0a\x96\xfa\xe0\x27\xa9\x1f\x36\x44\x9d\xa7\x36\x74\x69\x0c\x64\x2c\x19\x4c\xc8\x84\x61\x3c\x14\x46\x28\x0d\x6c\xb9\x0f\x6a\x2d\x0a\x4a\x19\x7d\x57\x70\x2f\xcb\xfa\x75\x8d\xc8\x8f\x6e\x61\x5a\x96\x3c\xa1\x41\x11\x40\x4c\xd1\x62\x13\x32\xc5\x08\x27\x27\x2d\xfe\xf1\xcd\x7f\xfc\x89\x8c\x17\x86\xa5\xa1\x64\xa5\x85\xa6\x59\xf5\x91\x19\xf0\xa9\x81\x95\x3d\x9e\xcd\x24\xa3\x0a\x02\x58\xc5\xdc\x2e\xfc\xed\xef\x6f\xc7\x4d\x1e\x7b\x94\xc2\xfc\x28\x80\xdf\x30\x13\xd3\x75\x75\xe1\xdb\x87\x4c\xb6\x54\x89\xd6\xa0\x99\xc8\x58\xb2\xe8\x8c\x68\xbe\xee\x0c\x99\x89\x3b\x2b\xeb\xaf\xc1\x9e\x3a\x06\xb2\x10\x45\x99\x59\x0b\xf6\x37\x55\x7a\x5e\xa9\x60\x35\x07\x67\xed\xb9\x40\x9b\xab\x9b\x62\xb9\x5e\xac\x0d\x6c\xf3\xaf\x14\x2e\xb6\xdb\x59\x05\xab\xf2\x33\xa8\x08\x7d\x43\xb3\x6c\x4c\x93\xdb\x1b\xf1\x5e\x4c\xd5\x47\x7e\x26\xa5\x90\xcd\xb5\x64\xd4\x50\xcb\x59\xc9\x6f\x6d\xe5\xea\x2a\x45\x58\x4c\x8d\x68\x55\x94\xda\x07\x12\xaf\xfb\x60\x9b\x70\xea\x89\xb0\x57\x83\xea\x59\xe0\x9e\xd5\xba\x8e\x4b\x95\xb0\x18\x19\xce\xaf\x42\x64\xfb\xfd\x9b\x3f\xfe\xbb\x45\x5d\x22\x24\xf9\xf7\x37\x18\xfc\xa8\x06\xf6\x10\x23\x6d\x33\x8c\x22\xa7\x59\x66\xd4\x86\x10\x29\x0d\xa0\xd7\x21\xe1\x67\xc7\x41\xdd\x1d\xdd\xb6\x16\xa5\x6e\x6e\xfe\x8a\x72\x14\xd3\x0a\xb2\xc9\xc0\x66\x05\x54\x6a\xcd\x3e\x32\x86\x7d\x47\x7d\x30\x35\xe3\x19\x08\x40\x73\x91\x95\x39\x9c\xc2\x9c\xf5\xd1\xbc\xa2\x31\x9b\x57\xf5\x33\xa6\x30\x01\x63\x9c\x89\xe4\x96\xa4\xee\x62\x10\xc6\xb2\x5c\x42\xb5\x3d\x14\xda\x06\xf4\x74\x08\xe4\xd9\xf8\xfd\x8d\x10\x9e\x9c\x16\x45\x15\xa3\x2f\xe9\x5d\x03\x18\x78\x26\x31\xdf\xb7\x63\x3d\x85\xce\x66\xe6\xae\x46\xe6\xa1\xfb\x22\x43\x37\x5b\x4f\xd1\x3a\x84\xa5\xbb\x8d\xba\x5e\x7d\x7b\xc3\x64\x03\x21\xea\x09\xfd\x69\x28\xf0\xdf\x36\x3c\x7b\x25\x2b\xa9\x4a\x6c\xa9\x10\xc3\x0a\x00\x06\x7d\x90\x24\xb7\x37\xb8\xf6\x60\xdd\xec\x16\xbf\xd4\x80\x0b\xaf\xac\xca\x39\xd5\x4e\x20\xf4\xe6\x6b\x4a\x0a\x90\x8a\x29\xc3\x97\x7f\xc0\x03\x75\x92\x51\x96\x07\x26\xc0\xa7\x01\x82\x3d\xdc\x58\xf9\xb2\x3b\xa5\xbc\x14\xa9\x9b\x10\x49\xa1\xad\xfa\xb9\x46\xac\x6d\x4a\xb5\x3d\x32\xd4\xa7\x26\x95\x3f\xd4\xd0\x6c\x52\x4a\xf3\x4b\x45\x2a\xed\x5d\xaf\x89\x40\xe2\xf7\xbd\x54\xfa\x58\x2d\xbe\x27\x32\x80\x84\xd1\x6d\x6e\x93\x12\x36\x94\x47\x7b\x50\x02\x91\xde\xe9\x81\x23\x62\x5d\xea\xe6\x4c\xb8\x47\xc9\xfe\xbb\xfd\x27\x25\x92\x16\x44\x52\x14\x74\xda\xa9\x87\xc1\x12\xa4\x96\xa7\x0d\x13\xbd\x8d\x1a\x84\xd7\xab\xb2\x43\x78\x17\xa4\x75\x21\x0a\x2c\x33\x62\xbd\xa3\x1e\xc0\x4e\x41\xb0\xf9\x90\x77\x74\x41\xa8\x14\x25\x4f\x9d\x7d\xa9\x32\xf0\x7d\x58\x7a\xf1\x85\xe0\xe0\x0d\xe7\xcb\x79\xe2\x68\xd1\x67\x9c\xbc\x1d\xbd\x7d\xf3\x5a\x38\x15\x7e\xe1\x12\xa7\xba\xa8\x38\x95\xa5\x4f\x4f\xfa\xad\xbe\xda\x71\x4f\xdf\xfb\xc1\x99\x58\xea\x62\xc6\xcc\x17\x6b\xc5\x9f\xee\x24\xd3\x10\xf4\x36\x3a\x40\xc5\xc5\xe8\x87\x41\x56\xf4\x61\x8f\x35\xbc\xfb\x49\x43\x57\xe5\xf8\x13\xd2\x2d\x47\xa0\xf0\xb8\xad\xb3\x70\xa9\x07\x48\x58\x08\xa8\xbd\x3d\x72\x60\xef\xdc\xb7\x99\x81\x87\x4f\x8a\x5a\x0e\x68\x67\xf7\x45\x87\x1a\x73\x0d\xc0\x9d\xdd\x17\x14\x6d\x70\x45\x8f\x10\xfc\x2f\x98\xd1\x39\x60\x46\x24\xcb\xa8\xcc\xd0\xe7\x78\x6d\xd7\x4e\xc6\xa5\x26\xc0\xe7\x4c\x0a\x8e\x01\x3e\x73\x2a\x19\x56\xa5\x90\x80\x99\xd5\x46\x17\xfd\xf2\xe0\x87\xe3\x2b\x0c\x68\x38\x74\x29\xe1\x6e\x95\xa5\xf2\xe5\x23\xc2\x95\x04\xd3\x3d\xba\x7d\x7e\x1d\x06\x86\x48\x73\xfd\xba\xcc\x7b\xf2\x52\x97\xb6\x20\xfe\x7d\x92\x95\x8a\xcd\x9f\x8a\x92\xb8\x54\xd5\x53\xd6\x6a\x9f\x97\xd2\x66\x6b\x40\xad\x64\xc0\xa2\x69\x1d\x59\xcb\x23\x15\x58\xf7\x55\x55\xb3\x2a\xf4\x81\x3b\xd3\x93\xcb\x65\xb7\xb1\x78\xbe\x64\xd9\x8a\x08\x81\x75\x1b\x9e\xd6\x08\x95\x72\x75\x82\x2b\xdc\x0d\xac\xcd\xe8\xe6\x46\x52\xe0\xe9\xc5\x75\x58\x04\xc0\xaa\x4b\x22\x1d\x91\xcb\xfa\xc7\xba\x52\x04\xd6\x2f\xaa\x94\x48\x90\xd3\xba\x26\xee\x14\x38\x48\x14\x12\xcc\x94\x8d\x76\x72\x64\x4c\x95\x75\xf2\x9c\x5e\x5c\x5b\x9b\xed\x6e\x30\x6b\x2d\x66\xb7\x97\x50\x0d\xc7\xb7\x39\x11\x2d\x84\xdb\x66\xb7\x9a\xca\x60\x65\x00\x83\x4a\xa9\x9d\x98\x9c\x5f\x12\x9a\xa6\x12\xdd\x3e\x4e\xf4\x09\x4a\xbd\x55\xbe\x05\xac\xca\x40\x15\x84\x6b\x0a\xc0\x8d\x24\xae\x06\x2c\x39\x2d\x8b\x8c\x59\x37\x42\xf8\x40\x5d\x4d\x02\xdb\xab\xec\x8e\xb4\x5d\xd4\xbc\xd6\x4a\x5e\x07\x2a\x24\xda\x56\x75\x7b\x60\xf7\x24\x28\x91\xcd\xeb\x82\x9a\x4b\xbb\xe6\x4e\x04\x9a\xc4\xab\x5d\xf3\x45\xdc\xb6\xda\x31\xe0\x5a\x9a\xa3\xb9\xbc\x5b\xd8\xbd\x37\x2b\xf1\x34\x55\x13\xb2\x39\xa0\x7f\xdc\xd5\xaf\x73\x65\x94\xea\x12\x9f\xd6\x37\x6c\xab\xac\x02\x95\x9e\xa2\xe1\xaa\x5a\x9e\x44\xf2\x54\x88\xb0\x6c\xec\x38\xbd\xb8\xb6\x94\xd0\x7e\x7c\xd5\x95\x6f\xdd\x2e\xd5\x54\xad\x35\x06\x3e\x59\x95\x8f\x2e\x9a\xc7\x52\x5b\x25\xd7\xa6\xb4\x53\x20\x4b\x07\xf1\xaf\x53\xe6\x5e\x87\xb7\x2b\xa0\x32\x99\xb5\x81\xff\x03\x84\xc0\x4e\x4a\x52\x61\x23\x01\x26\x42\xa2\x4a\x3c\x44\xf2\x9e\x09\x71\x5b\x16\xdb\x50\x74\x37\x8d\xed\x95\xb3\x15\x81\x68\x3c\xf1\x9b\xa2\xe9\x29\x57\x6d\xfc\xbd\x4d\xd9\x07\xb4\x95\x78\x70\xa2\x3a\x1b\x43\x2c\xeb\x4d\x27\x59\xa9\x34\xc8\x6f\x98\x54\x7a\xcf\x17\x5c\x45\x0c\xb6\x36\x91\xfd\xf0\x86\x1f\x99\x9e\xb9\xd2\x69\xfb\x83\xe6\x25\xf3\xb7\x9b\x78\xdf\xe8\xb4\xfb\x17\x82\xc3\xfe\x68\x59\xec\xaa\x48\x79\x45\xd6\x36\xf2\x14\xb7\x74\x05\x99\x8d\x17\xc5\x0b\x01\xae\xdc\xb8\xb2\x71\xe6\x0d\x9e\xfe\x29\xd0\x84\x62\x89\x26\xbc\x7b\x56\x97\x79\xb3\x05\x58\x6c\x9d\x3a\xe1\x04\xbd\x45\x08\xa2\xa0\x26\x8b\x16\x9b\x3f\xbb\x8d\x3c\xb7\x33\x06\xd8\xf2\x7f\xd7\x20\xe7\x2c\x81\xf7\x8c\xdf\xee\x88\x7e\xcd\xe8\x92\xb3\x95\xd9\x1a\x05\x79\xad\x8f\x96\x71\x1b\x7c\x67\x58\x0c\x1d\x8b\x52\xa3\xec\x86\x0e\xc7\x5a\x71\x64\xfc\x7f\xec\x5e\xa0\xbd\xbd\xb0\x15\xb3\xd6\xe9\x88\x6a\x60\x8d\x3e\x5e\x09\x54\x0b\xae\x29\xd6\xf6\x3b\x15\xc9\x2d\x48\x92\x99\x65\x8c\x48\x1d\xf8\xd2\xa8\x26\x27\x4b\xd8\x31\xea\xa2\xad\xa5\x03\x8a\x19\xe4\x20\x69\x56\x17\x55\xec\x00\xea\xf7\x8e\x70\x56\xb3\x86\x31\x29\xb6\xba\x90\x2b\x83\x66\xce\xe1\xd9\xba\xbb\x72\xba\xf0\x95\x26\x19\xc7\x70\x83\x7b\xa6\xd0\xac\x5f\x88\x34\xcc\x62\x2b\x15\xc8\x61\x95\x63\xe8\xf2\x78\x54\x15\x88\x93\xc2\xb8\x9c\x4e\x19\x9f\x3a\xea\x8c\x34\xbd\xae\x35\x56\x6b\x3a\x18\xe9\x9d\x48\xb0\x05\x1f\x51\x7a\xb0\xf1\x65\x2c\xbc\x3f\x17\xa9\xbd\x7d\xbc\xb0\xda\xa0\xdf\xd9\x3a\x40\xfa\x9c\x13\x21\x5d\x9d\x05\x9a\xa6\xb8\xf6\xd5\x2f\x74\x2d\xbd\xc3\xaf\x1a\x54\x71\x1c\x36\xb2\xbb\x7a\x2a\x00\x8b\x2a\xc7\xbe\x47\xf7\x63\x35\x36\x99\xb2\x05\xbe\x82\xf2\x9a\x5e\x35\x58\xae\xad\x79\xb6\xba\xfb\x3e\x3e\xba\xcd\x39\xdf\x9d\xbd\xb4\x62\x2d\x4d\x6e\xcd\xd7\x7c\x85\x0d\x13\x5f\xf2\x8e\x3a\xcc\xa2\xd8\x53\x54\x43\x5e\x08\x49\x25\xb3\xe4\x6e\x19\xcf\x0c\xbf\x58\x83\x60\x73\xdb\xab\x71\x0d\x8e\xad\xc5\x65\xa4\xb6\x5c\x54\x2d\xe4\x0d\x5f\x50\xc9\x0c\xd2\x12\xa3\xd4\xa7\x25\xc5\x56\xb0\x86\x5a\x38\xa3\xfa\xc2\x85\xff\x59\xa4\xab\x02\x0b\xab\x74\x84\x05\x06\xe3\x60\xb5\x3d\xf3\x0b\x62\xab\x0d\x41\xb4\xad\x34\xb1\x2f\x5f\x18\x8d\xb8\x01\x09\x13\x8a\x6d\xfe\xa8\x3f\x54\x70\x9f\x80\x21\x6b\x5a\xd5\x8b\x75\xf1\x29\x58\x4b\xd4\x63\xba\x83\x21\xcc\x59\x82\x6f\xd8\x78\x84\xdd\x17\x58\x60\x8f\x17\x75\x6b\xe3\x0d\x87\xe7\xc6\x7c\x5b\x95\x2c\x84\x4f\xf9\x14\x81\xad\x0e\xc5\x32\x04\x9b\x8a\x90\x7f\x0f\x11\x3c\x71\xd3\x07\xf9\x04\xdc\x1e\xa1\x2a\x33\xc0\x75\x21\xf4\xd1\x25\x0f\x1c\x12\x5b\x77\x76\x47\xf4\xed\xa0\x67\xb4\x77\x22\xb6\x72\xfe\x75\x51\x69\xa8\x9c\x76\x57\xff\xf6\x8f\xe5\xb4\xcc\x6d\x59\x60\xb1\x54\x99\xd5\xf5\xb3\xb4\xec\x14\x2d\x76\x86\x19\x9f\x7c\x38\x0d\x93\x33\xc2\xa8\x73\x9f\xda\x62\x84\xbc\x8e\x96\xdc\x65\x53\xae\x39\x68\xb5\x7d\xb8\xe6\x1a\x4e\x3f\x75\xb6\xca\xea\x6d\x1e\x2d\x19\x2f\x8c\x9c\x81\xd2\x51\x6d\xad\xe4\xc9\x8c\xf2\x29\x1a\xf8\x45\x69\xe6\xfb\xf2\x4b\x5c\x91\x84\xb4\x4c\x5c\x49\x79\x1f\xd9\xfd\xa5\xb7\x6b\xba\x4a\x47\xd8\x57\x4a\x25\xb4\xf0\x6b\x0e\x3f\xcb\x0a\x21\xef\x08\x1b\xc1\x88\xec\x7d\x19\x5c\xda\xb3\x6f\x2f\xa4\x30\xaf\x70\x41\xe1\xb8\xaa\x8c\x69\x3c\xde\x7b\xe1\xdd\x23\x72\x66\xde\x81\xbe\x9e\x0a\x80\x41\xdc\xf2\xb8\x06\xdf\x80\x48\x98\x52\x99\x66\x98\x4b\x38\xa9\xc4\x2d\x9b\x71\xe4\x00\x86\xa4\x17\x23\x05\xb9\xd0\xeb\xec\xae\x3b\xf5\xbb\xb7\x42\xda\x30\xa5\x9a\x0e\xb1\x0c\xbf\x25\x62\x47\xd6\x70\x30\x74\x85\x10\x87\xd4\xa1\x56\xd0\x11\xff\x0b\x97\x33\x36\xa4\xd5\x5d\x8c\x0f\xe9\x10\x4b\x12\xb6\x8f\x82\x7d\x82\x80\x89\x4e\x3a\x7c\x87\x7a\x98\xcb\x82\x77\x55\x46\x19\x61\x30\x22\x17\x42\xd7\x65\x73\xab\xd8\x0c\x57\xf2\x71\xdd\x79\x3e\xbb\xb8\xb9\xfa\xeb\xe5\xc7\xf3\x8b\x9b\x78\xac\xe3\xb1\x8e\xc7\xba\xc3\xb1\x06\x3e\xef\x7c\xa4\x2b\x05\x6f\x9d\xce\xbb\x54\x8a\x2f\x48\x19\x7f\x45\xd1\x67\x67\x7c\xfe\x03\x95\x75\x2f\x77\x94\x1f\xd7\xba\x89\x7d\xb3\x77\x24\x71\x27\x2f\x3e\xfc\xec\x09\x83\xc7\x7a\x0c\xca\xb9\x08\x2a\x1d\xac\xdb\xb5\xb0\x01\xd6\xc9\x2f\xe7\xa7\x67\x17\x37\xe7\xdf\x9c\x9f\x5d\x3d\x69\x34\x45\xc7\x52\x78\x4d\xa6\xdc\x92\x4b\x16\x12\xe6\x4c\x94\x2a\x5b\x54\x8d\xa6\xd6\x13\x81\xd5\x80\x3c\x9e\x12\xca\x17\xde\x9e\xb6\xfe\xb1\xc8\x6c\xfb\x65\xb6\xcd\xe0\x92\x0e\x85\x4b\xfa\x42\xdf\x6f\xa4\x68\xdd\x48\x7f\xd9\xb6\x6f\xed\x13\xde\xa6\xbf\x0e\x9f\xf6\x5d\x8d\x83\x06\xeb\x71\xc2\x63\x5d\x50\xc1\x08\xa3\x79\xa1\x3b\x94\x09\xef\xa5\xf8\x69\x3f\x75\x42\x6d\x20\xc6\x07\x5a\x7c\x07\x8b\x2b\xe8\x58\x1f\x65\xc9\x97\x92\x41\x62\x18\x1d\xb9\x85\x85\x75\xb1\x9e\xf8\x97\x75\xa9\xe3\xf2\x2c\x6b\xc7\xde\x42\x97\xba\xbe\x7d\x16\x7d\xbd\x85\x0e\x91\x99\x7e\xac\x94\x3f\x35\x5b\x88\x72\x9a\xd9\xd3\x6e\xbb\x47\xfa\x2d\xf8\xfa\x09\x8a\xdc\xee\x87\xec\xde\xd1\x59\xbd\x73\xf9\x08\x31\x37\x9c\x0b\xee\x8e\x5c\x54\xda\xd0\x28\xae\x43\x8b\xb5\xea\x08\x43\x6f\x8e\xbe\xc0\xff\xb8\xa2\x60\xc7\x69\xea\xa2\xa3\x4b\x05\x93\x32\xb3\xb6\x7a\x35\x22\xb4\x60\x3f\x80\x54\x68\x52\xbd\x65\x3c\x1d\x90\x92\xa5\x5f\x77\xa9\x2a\x65\x47\x8f\xbb\x20\xbc\x47\xaa\xdf\x9d\xb8\x76\x0e\xc7\x90\x77\x55\x44\x84\xd8\xd4\x47\xc4\x4d\x6f\x03\x76\x42\x46\x4f\xa0\xe9\xda\x89\x8a\xd8\x2d\xec\x97\xae\xee\xd7\x84\xd5\x3a\x73\xaa\x0a\x5c\xe9\x3b\x5f\x68\x4e\x55\xed\xa3\x46\x06\xc3\x06\xcd\x3f\x55\x41\x13\x18\x90\xbf\x57\x3f\x62\xc3\x65\xf5\xd3\xfe\xfe\x9f\xbf\x3b\xfb\xeb\x7f\xee\xef\xff\xfc\xf7\xf0\x2a\xb2\x42\xd4\x9a\x97\x6e\x41\x1b\x3c\x17\x29\x5c\xe0\x3b\xf0\x4f\x27\xae\x1d\x27\x89\x28\xb9\x76\x17\x30\x6d\x79\x34\x13\x4a\x9f\x5f\x56\x7f\x16\x22\x5d\xfe\x4b\x75\x2a\x95\xf6\x2c\x19\x03\x6e\x51\x87\xf4\x1b\x3b\xfa\x63\x0f\x35\x2d\xe9\xf9\xa8\xba\x59\x3d\x36\x62\xc9\x5d\xeb\x89\xf9\xc6\x83\x00\x5b\x5c\xfa\xfa\x08\x1c\x93\xca\x8d\x64\xda\xac\x9b\xb7\x37\x7f\xdb\xa9\x99\xaf\x1d\x3d\x92\xb6\x6a\x07\x7b\x06\x18\x42\xc4\xb7\x52\xc2\x83\x5c\x31\x58\xaf\xa5\xd4\xee\xe6\xe3\xcb\x73\x32\xb7\x10\x7e\x36\xc0\xf1\x4e\xb4\x6f\x3e\x29\x8d\xab\xbd\xa0\x4b\xc9\xab\xef\xac\xbf\xda\x5f\x77\x85\x04\x54\x55\xdb\x0b\x8c\x62\x73\x60\x7f\x1c\x25\x45\x39\x70\x37\x8c\x72\xc8\x85\x5c\x54\x7f\x56\x2e\xc2\xa1\xd2\x42\xd2\x29\x26\x9e\xd8\xc7\xed\x63\xd5\x5f\xf6\xc1\xc6\x0b\x56\x9f\xb6\xaa\x70\x52\x4a\x23\x34\x64\x8b\xba\xf4\xe7\xeb\xa3\x6d\x1e\xf4\xcf\x84\xb4\x55\x98\xd1\xb5\xef\xa3\x1d\x4d\x84\xac\x83\x04\x50\xe0\xac\xa0\x88\xfa\xa4\x4b\xac\x1d\x54\x62\x90\xb5\x06\xf0\xb9\xd1\x2c\x5b\x97\x06\xab\x47\x8f\xd4\x2c\x65\x73\xa6\x44\x87\xf4\x9a\x6a\xa2\xcd\x39\x03\xae\xb6\x87\x8d\x8c\xaa\xcc\x66\xf7\x05\x56\x43\xaa\xce\xeb\x12\xd9\x7f\xdb\xa5\xd3\xb7\x1d\x05\xd5\x1a\x24\x7f\x47\xfe\xfb\xe0\x6f\x5f\xfd\x3a\x3c\xfc\xfa\xe0\xe0\xa7\x37\xc3\xff\xf8\xf9\xab\x83\xbf\x8d\xf0\x1f\xff\x7a\xf8\xf5\xe1\xaf\xfe\x8f\xaf\x0e\x0f\x0f\x0e\x7e\xfa\xee\xc3\xb7\x37\x97\x67\x3f\xb3\xc3\x5f\x7f\xe2\x65\x7e\x6b\xff\xfa\xf5\xe0\x27\x38\xfb\x79\xcb\x49\x0e\x0f\xbf\xfe\xb2\xf3\xd2\x7b\x28\x4e\x6a\x47\x9f\x25\x4a\x9b\x33\xf6\x82\x7e\x9f\xb0\xc8\xbf\x1d\x1e\xbd\xfa\x3e\xff\x3e\x3c\xfa\x5d\xcd\x90\x2a\x76\xfd\x6c\x0e\xb8\x82\x44\x82\xfe\x1c\x96\x1c\xfb\xa6\x20\x50\x66\x5f\x91\x4a\xb5\x78\x6d\x7c\xee\xb7\x60\xdc\xf1\x62\xbb\xdd\xd7\x5a\x12\x9d\x48\x91\xfb\xb4\x77\x74\x6f\x60\x9b\x6b\x7f\xdf\x2d\x74\x6a\x96\x68\x47\x34\x06\x45\x63\xd0\x86\xf1\xa8\x31\xe8\xda\xe2\xe1\xb3\xb5\x04\x01\x9f\xb7\x75\x61\xac\xf5\xa0\x7b\x5d\x27\xac\x11\xb7\x9d\x43\x6d\xe4\x8f\xba\xaa\x3c\x71\x75\x24\x8d\x65\x68\xf9\x7a\x1f\x26\x76\xb1\x67\xdc\x1e\x7c\x9c\xa0\xce\x2b\x71\x5d\x0d\x6c\x09\x43\x98\x9b\x25\x54\x35\xb0\x1b\xd5\x2e\x31\xba\x14\x63\x5e\x7f\xb4\x21\xa8\xb7\x36\x2a\xd5\x28\x69\x8c\xd7\x75\x42\x2b\xe1\xb0\x2e\x2e\x4d\x95\x12\x89\x8d\xa6\xad\xb2\x1c\xb0\x74\x9d\x5b\x36\xae\x06\xfb\x4c\x07\xfd\xc8\x6d\xe1\xe9\xfa\x5b\xc7\x0b\xac\x87\xc9\xe7\xbe\xf8\x76\xea\x73\x66\x70\x25\xeb\xe7\x78\x5d\x01\x08\x06\x11\x9d\x13\x2c\x88\x43\x40\xaa\x5f\x69\xd8\x14\x43\x31\xc4\xa4\xb6\xb2\xb6\x6b\xf3\xd7\x99\x8b\x77\xe7\x99\x95\x67\xab\x93\x30\xb4\xc2\x2c\x6b\xf3\x73\x93\x49\xbe\x06\x67\x60\x77\xf6\xf9\x9b\x63\x9d\x3d\xb1\xcd\x7e\x58\xe6\x0e\xbe\x93\x3e\xd9\x64\x1f\xce\x92\x42\xc2\x84\xdd\xf7\x74\x4e\x8f\x79\x6d\x89\x61\x29\x70\xcd\x26\xcc\xe6\xd0\x14\x12\x0a\xe0\x36\x79\x81\x26\x33\xa4\xfd\x8e\x53\xd6\xce\xe9\xe7\x18\xcc\x63\x05\xee\x7e\x49\xd9\xf5\x3a\x61\x3f\xd2\x31\x12\xe9\x58\xeb\xf1\x99\xe8\x98\xc3\xdc\xe7\x43\xc4\x30\xf2\xbc\x7b\xe8\xfb\x69\x10\xc7\x8e\x58\xbc\x33\x96\xd5\x79\x4e\x47\x38\x4b\x2b\xeb\x73\x27\x64\xc0\xd7\x5e\x96\x59\xd6\x53\xf5\xed\xfd\x73\x84\x46\x51\x66\x99\x4b\x3a\x1e\x91\x8f\x1c\xcf\xe3\x31\x76\x79\x18\x90\x0b\x98\x83\x1c\x90\xf3\xc9\x85\xd0\x97\x56\xb0\x6d\xc6\xb2\xd9\x1b\x09\x9b\x90\x77\x46\x65\x52\x9a\x68\x5b\x69\x3f\xa8\x0b\x24\x64\x63\x82\xba\xe4\x58\x87\x18\xf4\xcd\xdb\xf2\x85\xcf\x68\x1b\x3e\xd1\x36\x55\xad\x4c\x7a\xd0\x4d\x7d\xcb\x3a\x17\x1d\x87\x11\x91\xce\x35\xb2\x2e\xa7\xf7\x05\x96\xd9\x28\x84\xd2\xd7\x46\x85\xed\xa7\xcd\xcd\xa5\x9f\x0e\x3b\x47\xd0\x2c\x83\xb4\xd1\xe7\xc8\xf6\xe7\xa0\x4d\x15\x1a\xb3\x8d\xab\x76\x11\x40\x66\x94\xa7\x19\x48\x2c\xf9\xae\x96\xeb\x5a\xb1\xba\xc7\x41\xd5\x95\xc2\xa7\x85\xd2\x24\x11\x32\x75\xcd\x6a\x5d\xf2\x26\x2e\xa6\x3a\x5e\x48\x68\x73\xca\xe9\xd4\x76\x29\x5c\x29\x1c\x8c\xe5\xa4\x55\xd0\xda\x62\x26\xc4\x2d\x49\x44\x5e\x64\x78\x00\x3a\x9c\x8f\xba\xb3\x4e\x85\xa2\x43\xec\xa6\x78\x14\x34\xdd\xc1\x1f\x9e\xb0\x3b\x62\x1f\x72\x0a\xdc\x43\xd2\x5b\x57\x3e\x43\x11\xcd\x2e\xa3\x4f\x5c\xf0\x4a\x5c\x99\x08\x73\x18\xcd\x5e\xd7\xf5\x08\x2a\xa2\x37\x22\x67\xf7\x90\x04\x9d\x2d\xcd\x13\xae\xb5\xa5\x16\x68\x0f\xe9\xde\xb1\xb8\xb3\x29\xbf\x2f\xf3\x79\x87\x04\xb5\x70\x2c\x55\x9f\xc3\x39\x7d\xb1\x6d\xf7\x0a\xec\x5b\x60\x13\xa4\x31\x69\xcd\xd7\xdf\x6e\x9c\x21\x7b\x62\x57\x4a\xd6\x55\x01\xca\x7e\x2e\x4c\xd4\x16\x42\x93\x83\xfd\xa3\xfd\xc3\x15\xbb\xde\x52\xc5\xe6\x9b\xe0\x49\x86\x25\x0a\x0b\xac\xf7\x07\xc9\x7e\x3a\x20\x4c\x7b\x1a\x6d\x2b\x25\xe0\xaa\x5c\x26\xdd\x80\x28\x41\xb4\xa4\x29\x73\x9a\x13\xfe\x6a\x6e\xd2\xb2\x74\x65\x12\x0e\xf6\x7f\xdd\x1f\x10\xd0\xc9\x21\xb9\x13\x7c\x5f\xe3\xf2\xb1\xa6\x48\xa9\x82\x89\x16\xa2\xc4\xd6\x7d\x16\x04\x55\x81\x10\x43\xe8\x88\x28\x6d\x9f\x9f\x19\xd5\x3e\x83\xef\xec\x9e\x69\xdf\xdb\x42\x4c\xc8\x1b\xdb\x66\x08\xa8\xb3\x2c\x66\x6c\x0e\x47\x33\xa0\x99\x9e\xd9\xe0\x0b\x2e\xf8\xd0\x76\x8a\x33\x14\xc8\x5d\xe9\xea\x87\xe8\x66\xa6\x0b\x47\x07\x93\xdd\xea\x82\x3a\x4a\xe4\x86\xf6\x7e\xdb\xbe\x17\x2e\x59\x69\x13\x7d\x73\x73\xf9\x6d\xa3\x19\x2e\x12\x7f\xad\x0b\x1f\x12\x13\x14\xdb\x78\x06\xb4\xa3\x1f\x27\x60\xa7\x4e\xb6\xa4\x47\x12\xd6\xb5\xa3\x2d\x59\x6d\xfb\xbd\x5b\x2b\x5b\xf2\x57\x51\x62\x0b\x3e\x3a\xce\x16\xe4\x8e\x72\xed\xd3\xf7\xf6\xcc\x54\x7b\x86\x3c\x19\x6c\xf8\x0b\xd0\x14\xa4\x42\xea\x01\xb4\x75\x51\x31\x3f\x7a\x73\x4e\x05\x6b\xeb\x97\x0f\x94\x4a\x8b\x9c\xcc\xdc\x67\x37\x53\x1a\xdd\xc9\x18\xe1\xe9\xf1\xf9\x42\x12\x0a\x4b\xe1\xdc\x33\xaf\x8e\x7e\xad\xd0\x0d\x0b\xf7\x46\xf1\xfd\x24\x04\x5b\xd8\xa2\x85\x71\x0b\x2c\xdb\x5c\xb1\x27\x5a\xda\x43\x50\x01\xe9\x31\xb0\x80\x74\x4b\x90\x5c\x9e\x08\x9d\x65\xdd\x63\xa8\x7a\x8b\x55\x20\xbd\xf9\xe3\xc9\x3a\xe3\xa5\xc3\x19\x1b\x39\xdb\x13\x10\x7b\xf5\x82\x93\xee\x29\x98\xe1\x78\x18\x00\xfd\x6c\x3e\xe9\x13\x02\x45\x0f\x21\xd3\xab\x01\xd3\x2b\x2d\xc6\x91\x4c\xd8\x4a\x55\xcf\x86\xcb\x74\xed\xb7\x4e\xd6\xe7\x1f\x4b\xc2\xab\xf6\xb8\xfa\x45\xf4\x5c\x27\xfd\x85\x36\xf6\x1d\xd8\xd8\x6b\x58\xe3\x27\x0d\x6a\xc4\x54\x8a\xce\x54\xa4\x69\x53\xc7\x29\x0d\x06\x18\xbd\xcd\x68\x9c\x4e\xf6\x73\x46\x21\xdf\xc2\xa3\x69\x45\x35\x47\xed\x59\x9c\x31\x9d\x14\xd7\x22\xb9\xed\x51\xaf\xd9\xbf\x39\xb9\xb4\x53\x06\xaa\x0d\xe5\xde\x18\xc2\xf8\x5c\x64\x73\x5b\xe9\xef\xe6\xe4\x12\x4f\xde\x08\xff\x85\x86\x28\xd4\xa8\x17\xe6\x59\x1f\xec\xef\x5c\x38\x46\xfb\xb6\x16\x34\x4a\x24\xd0\x8c\x29\xcd\x12\x7c\xae\xb2\x6d\xe1\x0c\x5d\x7c\x37\x51\x53\x5a\x37\x7a\xd7\x94\x82\x6e\xb3\xbb\x2a\x4d\x5d\x83\xf3\x9e\x31\x5f\x72\xfc\x48\x56\xcd\xd4\x22\x5f\xea\x69\xbe\xe7\xcb\x97\x0a\x09\xd7\x5a\x14\x3d\x79\x42\xec\x64\x1b\xfc\x20\x63\x98\x08\x09\xcb\x8e\x90\xc0\xb1\x91\x96\xe0\x0a\x71\x1e\x5f\x9e\x57\x26\x28\xd1\x70\x5e\xd8\xb0\x44\x5f\x7d\x33\x63\x73\xe0\xa0\xd4\x11\xba\x3c\xca\xc2\xaa\x98\xbe\x6f\xee\xc0\x7c\x1d\xe4\x85\xad\x5f\x59\x85\xfa\xbb\xae\xbd\xf8\x23\x68\x5b\x78\xb2\xf2\xbf\x38\x8b\xaa\x5f\xfe\xb2\xab\x24\x91\x54\xcd\x6c\x47\x5b\xb8\x67\xda\x75\x65\x96\x40\x95\xe0\xd6\xd8\x1b\x34\xd7\x65\x8a\x14\x54\xa9\xba\x0c\xb8\x7b\x89\x7d\xe8\xd2\x96\x0e\x0e\x1f\x98\x4a\x9a\x00\x29\x40\x32\x91\x12\xcc\xb9\x4d\xc5\x1d\x27\x63\x98\x32\xae\x3c\xfc\xcc\x44\x1e\xd0\x86\xdd\x00\xda\x86\x7d\x45\xb5\x11\xb9\x6a\x14\x0a\x71\x29\x3c\x89\xa8\x4f\xb4\x5b\xc5\xb2\x93\x09\xa3\x26\x11\xbc\xb6\xad\x4c\xb5\x31\x61\xa7\x9d\x47\x16\xdd\x83\xb7\xc9\x36\x83\xf2\xd7\x36\x42\x07\x0b\x9e\xd2\x64\xd6\xcd\x7d\x1b\xdd\x53\x5b\x8e\xe8\x9e\xda\x6d\x44\xf7\x54\x74\x4f\x6d\x1e\xcf\xce\xbc\x1b\xdd\x53\x51\xe9\x5a\x1e\xd1\x3d\x15\xdd\x53\x1b\xc6\xb3\xa3\x5f\xd1\x3d\xb5\xc5\x88\xee\xa9\x2d\x47\x74\x4f\x45\xf7\x54\x74\x4f\x45\xf7\xd4\x6f\xc8\x0c\xe8\x47\x74\x4f\xad\x4c\x12\xdd\x53\x01\x30\xa2\xa6\xb4\x66\x44\xf7\xd4\x9a\x11\xdd\x53\xc1\x88\x7c\xa9\x05\x5f\xf2\xce\x9d\x4b\xa3\x97\x75\x6f\x23\x8c\xda\x1d\xd6\xf3\x7b\xa5\x69\x4d\x5d\x6c\xfc\xcf\xda\xbe\xff\x4c\x7c\x28\x3d\xd8\xf4\xa3\x3d\xff\xd5\xd9\xf3\xfb\xb1\x85\xf5\x60\x07\xeb\x4c\xca\x9d\xd7\xfc\x66\x26\x41\xcd\x44\xd6\x1a\xd1\x1b\x48\xfe\x81\x71\x96\x97\xb9\xc1\x39\x65\xf0\x99\xcd\x2b\xf7\xbc\xaa\x5b\x32\xa3\xd7\xde\x9a\xe4\xcc\x8d\x2c\x05\xac\xc6\x49\x59\x66\xb6\x11\xf3\x27\x67\x14\x65\x62\x55\x26\x09\x00\xf6\xfa\x0a\xd5\x85\x3f\x8c\xaa\x37\x55\xbd\x1d\xde\x76\xa3\x37\xdd\x98\xac\xad\x97\x89\xb3\xfc\xe1\xf7\xad\xe6\xe8\xe8\x4e\xf9\xfc\xae\x94\x1e\xc8\x74\x77\xc5\xa0\x93\x52\xd0\x07\x97\xe8\xaa\x0c\xbc\x34\x97\x49\x6f\xae\xc3\x1e\x5c\x25\xcf\xc8\x4d\xf2\x6c\xd8\xc2\x73\x71\x8d\x3c\xc3\x52\xa0\x3d\x58\xf2\xfb\x70\x85\xf4\xe7\x06\xf9\x04\x15\x33\x3f\x8d\xfb\xa3\x47\xb5\xb3\x27\xb7\xc7\xe7\x70\x79\xf4\xf2\xd5\x5d\x5d\x1d\x9f\xcf\xcd\xd1\xcf\xe7\x76\x34\x23\xbd\x0a\xd7\x46\x0f\xe6\xa3\x3e\x4d\x47\xbd\x99\x8d\x3e\x99\x2b\xa3\xbb\x1b\xe3\x19\xb8\x30\x3a\x03\x99\x71\xa6\x19\xcd\x4e\x21\xa3\x8b\x6b\x48\x04\x4f\x5b\x73\x98\xa5\x12\x6a\xd5\xf9\x51\x76\x5a\xa7\xa3\x35\x03\x7d\x67\xd4\x55\x8a\x85\xd4\xc7\x2e\x7b\x93\x9e\x13\x28\xd0\x1a\x67\x57\xd9\xa6\x0e\xd3\x9d\x90\xb7\x99\xa0\xa9\x3a\x2a\x84\xfd\xbf\x3a\x8c\x37\x88\xdf\xb5\xef\xea\x16\xc0\xfb\xd4\xca\xa0\x8d\x7a\xee\x73\x13\xff\x22\xee\x88\x98\x68\xe0\xe4\x80\x71\xbf\x8f\x87\x81\x1a\x58\x6b\xe6\x15\x5a\x9b\xab\x6f\xdf\xf8\x9b\x5f\x9f\xca\x8d\xc6\x05\xa5\x3e\xbd\x05\xc4\xbd\xe8\x71\x13\x88\xbb\x71\x52\x66\x4d\x33\x88\x35\x8d\x34\xe9\xcd\xdb\xba\xd6\xe5\x5b\x9c\xb7\x3a\x6d\x94\xa7\xc4\x65\x48\xbc\xbe\x4d\xeb\xec\xa0\x7d\x0d\xce\xd9\x68\x7b\x21\x7d\xdb\x5e\x9e\xc8\x09\xfb\x0c\xa5\xe6\x17\xea\x78\x8d\x52\xf3\x0e\x23\x48\xb4\xfa\x56\xd2\x04\x2e\x7b\x17\x38\xfc\x71\x22\x69\x29\x5d\x7e\x5c\x25\x77\x54\x87\x87\x03\xa4\xf6\x34\x55\xd9\x67\x98\xf6\x35\x29\xb3\x6c\x41\xca\x42\xf0\x66\x8a\x9f\x75\x5a\x2d\x67\x86\x99\xd9\xd6\xbd\xa5\x96\x52\x0b\x29\x1c\x03\x96\x25\xe7\x86\x9e\xd7\xdd\x6f\x50\x2a\x55\x96\x56\x87\xf9\x67\x8a\x4d\xcd\xf2\x0d\x33\xc5\xd4\x34\x96\x43\xdd\x1f\xa1\x9e\xd0\x3c\x3d\x11\x32\x61\xe3\x6c\x41\x66\x34\xab\x5a\x1d\x50\x72\xcb\xb2\xcc\x4d\x33\x22\xd7\xa0\x89\x9e\x31\xd7\xa5\x9a\x64\x82\x4f\x71\x71\x94\xfb\x16\x5b\x90\x98\x67\x93\x0c\x28\x2f\x0b\xfb\x3e\xc3\xd6\x17\xa2\x94\xfe\x7d\xae\x7e\x64\x35\x0b\x53\x84\xb3\x6c\x10\x34\xf2\x79\x70\x63\xeb\x6e\xe9\x0a\x7c\xf2\xde\x1d\x53\x30\x08\xe7\x14\x73\x90\x92\xa5\xce\x69\x60\x7f\x2b\xa4\x98\xb3\xd4\xb6\x62\xf0\x60\xc3\x96\xa1\xb6\x55\x43\x75\x9e\xb9\xe0\x43\x0e\x53\x8a\x52\x8f\x3b\x45\x76\xcf\xec\x3c\xd6\x15\xc7\x53\x6c\xde\x60\xd4\x05\x51\x34\x72\x46\xe7\xcc\xb6\x9d\x0c\x20\x47\x0e\xb8\x20\x02\xd9\x6b\xc9\x99\xb6\xad\x8c\x67\xa5\x26\xa9\xb8\xe3\x87\x66\x72\xa6\x0c\x1c\x28\x19\x83\xf6\x6d\x55\x7d\x9b\x3f\x26\x41\x11\xe0\x74\x9c\x99\x3d\xc7\x98\x80\x9b\xb5\x00\x22\x13\xa0\xba\x94\x40\xa6\x54\xc3\x5a\xa1\xc9\x7e\xef\xc3\xe0\x65\xaa\x6a\x39\x5e\x72\x05\xad\x9b\x2d\xf7\x2c\x69\xfd\xe9\x8f\xed\x68\x04\xcb\x41\x94\xfa\xb3\xa8\x92\xb6\x1d\x7f\x20\x19\xb3\x1c\x14\x11\xe5\x92\x8e\xfd\xd6\x3d\xb6\x7e\x87\xa2\x3e\xb9\x6e\xb4\xb5\x12\xaf\x31\xa5\xb9\x6e\x80\xab\xf1\x33\x41\xb7\x53\x6a\x8e\xe2\xe9\xc5\xf5\x2f\xef\x8f\xff\xeb\xec\xbd\x3b\x9f\x3c\x64\xfa\x25\x67\xff\x28\x81\xd0\x5c\x18\xb9\x3a\x0b\xc3\x70\x06\x68\x1e\x08\x7e\xc0\x93\xdc\x6f\xc0\x4e\x4b\x86\x8c\xad\x99\xbb\x87\x25\x61\x83\xe7\x4f\x1f\x95\xf4\xd4\x2d\x6b\xaa\x96\x9b\xe6\x83\xc3\x96\x35\x94\x70\xd0\xe6\xe4\x59\x89\xd2\xb6\x30\x62\x7c\x9a\x85\xc2\x64\x3b\x72\xd5\x55\x27\xea\xaa\x11\x0d\xeb\x2f\xb8\x6c\xab\x18\xf5\xd2\x3a\xa7\x5e\x43\x4f\x0d\x27\x6a\xaa\xed\xd5\x00\xdb\x11\xd4\xab\x01\x56\xf4\x38\xbf\x24\x34\x4d\x25\x8a\x29\x78\xea\xf3\xa5\xc6\x73\xf8\xb0\x35\xc6\x0f\xc8\x1b\xf2\x67\x72\x4f\xfe\x8c\x6a\xc1\x9f\xba\xb6\xe7\xe8\x2a\xb0\x77\x37\x4b\x58\x6d\xf4\xfc\xb2\x27\x88\xff\x38\xa3\x1a\x67\x34\x50\xd5\x82\x8c\x99\x13\x43\xe1\x5e\x83\x34\x62\x91\xdb\x89\x27\x6d\x6c\x62\x16\xf8\x19\xd1\xcc\xda\xdc\xcf\x27\x61\xe5\x7f\xbd\x23\xa2\x99\xc7\x8d\x7e\x7f\xe1\xa8\x50\xb3\x8f\x40\x3d\x5b\x4e\x75\x32\x6b\x92\x31\x23\x60\xa8\x06\x73\x4a\x05\x92\x71\x1b\xbf\x36\x63\x1d\x22\x08\x9e\x0f\x1a\x77\x73\x2a\x37\xf6\xf3\xa1\x9d\x5a\x52\xfc\x91\xcf\x3b\xc1\x20\xa8\x3f\x52\x88\x74\x44\xce\x68\x32\xc3\x65\xa5\x01\xcf\x30\x1a\x08\x4e\x36\xa3\x73\xb3\xf1\xee\x59\xdb\x77\x03\xa5\x95\xca\xd4\x8a\xb8\x64\xce\x53\x42\xb9\xed\x7c\x37\x01\x29\x6d\xc8\xe1\x78\x81\x9e\x4f\x96\x40\xe7\xcd\xeb\x74\x92\x0a\x29\xb4\x48\x44\x87\xde\x2b\xcb\xd1\xcf\x38\x1d\x02\xc1\x1a\x2d\xbd\xad\xf8\xfb\xd3\xcb\x01\xb9\x39\xb9\xc4\xa6\x19\xd7\x27\x37\x97\x4d\x09\x7b\xef\xe6\xe4\xb2\x43\x0f\xff\x5e\x6c\x1e\xce\xcc\xf6\xce\x2c\x73\xe7\x49\x24\xd0\x94\xc5\x38\xf2\xed\x47\x8c\x23\xdf\x3c\x62\x1c\x79\x8c\x23\x8f\x71\xe4\x0f\x8f\x18\x47\xee\xc6\xd3\x9b\x7a\x48\x8c\x23\x6f\x39\x5e\x97\x2f\x33\xc6\x91\xef\x34\x62\x1c\xf9\xea\x88\x71\xe4\x1b\x46\x8c\x23\xdf\x30\x62\x1c\x79\x8c\x23\x8f\x71\xe4\x31\x22\xe6\xd1\xb9\x9e\x67\x44\x0c\x89\x71\xe4\x6e\xc4\x38\xf2\x57\xe1\xf7\x27\x31\x8e\x7c\xab\x11\xe3\xc8\x63\x1c\x79\x9b\x11\xe3\xc8\x71\x44\xdb\x4b\x8c\x23\xf7\x23\xc6\x91\xdb\xf1\xdb\x91\x9a\x63\x1c\x79\x8c\x23\x8f\x71\xe4\x31\x8e\xfc\xc1\x55\xc4\x38\xf2\xd7\xa0\x4f\xfa\x86\x5a\xdd\x83\xa0\xaf\xfc\x4c\xdb\x87\xd5\x90\xb3\x35\xbf\xa2\x59\x45\x15\x66\x12\x59\x4f\x99\x49\xa0\xe9\x02\xa7\x4c\xd0\x2d\x53\x0b\x59\x2f\x30\x3a\x27\x63\x39\x6b\x17\x77\x4e\x56\x0e\xcd\x7b\x9c\x2b\x70\xe1\x18\xb0\xe4\xf4\x1e\x0f\x00\xcd\x45\x69\xfb\x77\x25\x22\x2f\x4a\xdd\x84\x29\x6e\x4f\x9b\xd6\x5b\x13\x36\x75\x1c\xf5\xc8\x76\x09\x1b\x56\xd3\x0e\x83\xce\x5c\x4f\xa8\xc0\xd0\xd4\x87\x9f\x5c\xf6\xa0\x4b\x14\x54\x6b\x90\xfc\x1d\xf9\xef\x83\xbf\x7d\xf5\xeb\xf0\xf0\xeb\x83\x83\x9f\xde\x0c\xff\xe3\xe7\xaf\x0e\xfe\x36\xc2\x7f\xfc\xeb\xe1\xd7\x87\xbf\xfa\x3f\xbe\x3a\x3c\x3c\x38\xf8\xe9\xbb\x0f\xdf\xde\x5c\x9e\xfd\xcc\x0e\x7f\xfd\x89\x97\xf9\xad\xfd\xeb\xd7\x83\x9f\xe0\xec\xe7\x2d\x27\x39\x3c\xfc\xfa\xcb\xd6\x4b\xee\x2c\xf0\xf6\x27\xee\xf6\x24\xec\x7e\x12\x51\xd7\xf9\x7e\x7b\x3a\x8b\x57\x6e\xb6\xe5\xd3\xe8\xd8\xd1\x43\xa7\xd1\x6b\xdc\x28\xc4\x55\xf3\x30\x45\x44\xce\xb4\x76\x54\x94\x86\x51\x5f\x4c\x37\x54\x4e\x47\x07\xb0\xdb\x21\xd5\xb6\x9d\x60\x15\x31\x15\x44\xec\x0a\x2f\xd7\xb9\x36\x8d\x95\x35\x02\xcf\xf3\x30\x85\x09\xe3\xe0\xbc\x5c\x91\x36\x3c\x3e\x22\x6d\x78\x8d\xb4\x41\x41\x52\x4a\xa6\x17\x27\x82\x6b\xb8\x6f\x65\x3f\xd9\x64\x40\xba\x6e\x4e\x4d\xec\x89\xb3\x94\xc2\xbf\x96\x88\xc2\x06\x4a\x6e\x4c\xcd\xab\x82\x6e\x65\xc9\x51\xa5\xb4\x39\x14\xa0\xad\xbe\x87\x9a\x0e\x46\x41\x2e\xbf\xce\x6b\x70\x76\xea\x7f\x94\x6c\x4e\x33\xa3\xdf\xd6\x4f\x5c\xa2\xce\x12\x3e\xd4\xca\x88\xf5\xc4\x32\x16\x8a\x37\x97\x92\xcd\x59\x06\x53\x38\x53\x09\xcd\x90\x2a\xf5\x43\xe9\x8f\x37\xcc\x8e\x5b\x24\x45\xa6\xc8\xdd\x0c\xb0\x8d\x2a\xf5\xea\x39\x66\x2a\x4c\x29\xe3\x24\x37\x44\xb5\xf0\x0f\x2b\xab\xe7\x1b\xe2\x6d\xa4\x5e\xae\x6b\x7d\x1e\xd5\xd7\xb1\x10\x99\x0b\xeb\xcd\x16\xf5\xfc\xae\xad\x2d\x17\xbf\x70\xb8\xfb\xc5\xcc\xa6\xc8\x24\xa3\xd3\x4a\x8d\x57\xa0\x57\x2c\x71\xf5\xd4\x1b\x3f\x00\x63\x66\x4b\x20\x34\xbb\xa3\x0b\x55\x1b\x35\xc2\x86\xbf\xef\xc8\xdb\x43\x44\x3c\xaa\x48\x35\x47\x4a\x7e\x7f\x88\x7e\xbe\x93\xe3\xcb\x5f\xae\xff\x7a\xfd\xcb\xf1\xe9\x87\xf3\x8b\x6e\x74\xde\x7c\x3b\x50\xde\x6a\x8e\x84\x16\x74\xcc\x32\xd6\x85\xbc\xaf\x44\x82\x84\x93\x22\x03\x4d\xd3\xa3\x54\x8a\xc2\xc2\xc9\xdb\x8f\x42\x1d\xe7\x74\xc9\x2c\xec\x78\xb6\xdd\x9e\x49\x73\xc2\xa9\xa4\x5c\xd7\x86\x94\x1a\xe4\xb2\xe4\x46\xe9\x7d\xe1\x41\xf3\x34\xed\x2f\x60\xfe\x38\x4d\x21\x6d\x40\xef\xd5\x05\xe8\x9d\xf8\x8f\x5b\xd4\xb9\xb6\xe4\xf2\xe3\xf5\xf9\xff\x5e\x42\xc3\x45\xd1\x2d\x1e\xa9\x9f\xfc\x1e\xd9\xbe\x03\x39\x59\x35\x26\xe4\x62\x1e\xf7\xf7\xb9\xec\x6f\xc5\xab\xfa\xf1\x82\x5f\x95\x3c\x64\x27\x3c\x98\x9f\xe4\x22\x85\x11\xb9\xac\x2c\xe8\xcd\xab\x61\x0d\x01\x09\xc4\xdc\xc2\x35\xc3\x56\xe7\x81\x28\xa3\x85\x4d\x74\x69\x64\x98\x86\x74\x78\x42\x33\xd5\x91\x98\x76\xe1\x4c\x86\x09\x7f\x30\xaa\x60\x2f\xd0\xac\x66\x23\x29\x70\xa1\x9d\x24\x69\x56\x89\x49\xb7\x52\x24\xc4\xea\x9d\x41\xc8\x52\x83\xbb\xb8\x06\xf8\x9e\x31\x31\xe5\x61\x75\x59\xcd\x6c\x2d\xb0\xa5\x02\xb5\x9e\x31\xd5\x9a\xa8\x99\x5d\x02\x4d\x31\x5f\xac\xa0\x7a\x66\x23\x0e\x72\xaa\x6e\x21\xb5\x3f\x38\xb9\xa6\x32\xc1\xdb\x66\xf8\xee\x55\x37\x66\xdd\xde\xde\x8e\xf2\x8c\x8d\x83\x40\x3b\x3d\xb4\x8e\x4e\xef\x7c\x04\xcc\x37\x7d\xe4\xd9\xe2\x4a\x08\xfd\x4d\x95\x27\xd5\xcb\x06\xfe\xe8\x24\x45\x74\xb3\x34\x43\xa6\x30\x40\x20\x1d\x22\x30\x11\xa5\xc3\x14\xad\xd3\x7a\xc3\x9e\x18\xa1\x65\xc9\x8f\xd5\xb7\x52\x94\xad\x39\xc0\x8a\xa0\xf5\xed\xf9\x29\x9e\xe3\xd2\x79\xc0\xb8\x96\x8b\x42\x30\x6b\x3e\xd9\x20\xd3\x7e\xef\x7c\x78\x21\x46\xd6\xee\x16\xf2\x81\x2e\x08\xcd\x94\xf0\xc2\x31\xe3\xeb\x54\x1d\xe2\xf4\x28\x73\x79\x2c\xf4\x6c\x45\x81\x32\xe8\xbc\xfa\xdc\x20\x70\x88\xd5\x75\x53\x18\x5f\x79\x5c\xd3\x5b\x50\xa4\x90\x90\x40\x0a\x3c\xe9\xb8\x6b\x4f\xed\x06\xc2\x9d\xbf\x10\xdc\x1c\x8b\x5e\xf6\xfe\xbc\xf2\xff\xa1\x19\xab\xb9\xd3\xe8\x49\x74\x7a\x07\x45\x7f\x22\x1e\x8a\x52\x81\xb4\xce\x4f\x59\x82\xdd\x88\xef\xca\x31\x64\xa0\xad\x32\x84\xf5\x03\xa8\xb6\x2a\x2f\xcb\xe9\x14\x08\xd5\x15\xa2\x68\x41\x80\x2b\x43\x6e\xac\xe1\x4c\x93\x54\x40\x9d\xdc\x48\x15\xf9\xfe\xfc\x94\xbc\x21\x07\xe6\x5d\x87\xb8\xfd\x13\xca\x32\x74\x35\x6a\x2a\x97\xd7\xc8\x26\x7e\x0a\x5c\x12\xe2\x1e\x11\xd2\x1e\xd1\x01\xe1\x82\xa8\x32\x99\xf9\x35\x19\x8d\xcb\x2b\x6c\x2e\xd6\x0e\x4d\xf2\xaf\x10\x55\x3b\x13\x98\xef\x15\xc8\xde\xe8\xcb\xf7\x2d\xe8\x4b\x28\x42\x18\x9c\x6b\x42\xcf\x22\x56\x0e\x9a\xa6\x54\x53\x47\x77\xea\x8c\xe8\xd7\xb8\xa5\x4f\x4d\x7d\x14\xbc\x67\xbc\xbc\xb7\xb6\xb5\xfe\x94\xfc\xeb\x33\x9c\x16\x51\x00\x81\x86\x9b\x46\x8b\x22\x63\xb5\xe7\x31\x88\x6e\x3a\x6f\x6c\xf5\x60\x83\x88\x84\xc7\xdc\x3b\x30\x0d\x67\xa7\x3c\x15\xf9\xca\xcb\xd0\x5b\x4a\x93\x59\xf8\x82\x57\x89\x3c\x4f\x6e\x8e\xc8\x60\x0e\x1d\x6a\x73\x2c\x21\xce\x7b\x33\x9b\x91\xc5\xfc\x86\xe2\xf4\x24\xa3\x63\xc8\x2c\x67\xb1\x08\xa4\x56\x11\xe8\xa9\x23\x04\xa5\xc8\xfa\xcb\x8f\xb8\x12\x19\xd8\x90\x1b\x0f\x08\x33\xfd\x8b\x80\x03\x4e\xd2\x17\x1c\x50\x91\x69\xc0\x01\x55\xb2\x97\x00\x87\xb2\x03\xa3\x25\xcb\x70\x30\x5c\xbb\x09\x07\x64\x9d\xcf\x1d\x0e\x0a\x92\x44\xe4\xc5\xa5\x14\x46\xe5\xea\x8d\xb5\xb8\x69\x6b\xff\x8e\xd5\xc9\xd1\xe0\x1b\x6a\x7f\xce\x9b\xd3\xbc\x99\xca\x20\xd8\x8e\x6a\x4b\xe3\x7d\xc4\xdd\xff\x0a\x58\x0e\x92\x9e\x65\x3e\xe4\x67\x69\x38\x80\xcc\x93\xee\xc2\x0b\x4f\xf5\xef\x60\x25\xeb\x85\x99\x88\x84\x66\x58\x3a\xad\x1b\xc6\x90\x65\xac\x59\x9e\x38\x88\x90\x44\xd7\x12\xfe\xe6\xbd\xf6\x58\x45\x0b\x7f\x71\xb6\x2f\x2e\x52\x08\x9c\x85\x36\xb4\xf3\xc6\x46\xd2\xe1\x7d\x3e\x38\xd3\x70\x75\xe7\xbc\x87\xb4\xf1\xb4\x16\xae\x3a\xcb\x87\xaa\x20\x9b\x59\x20\xf0\x94\xf1\x29\x5a\x74\x06\x44\x42\x66\xc3\x3a\xdd\x19\xbe\xb5\xea\xd7\x3e\x62\xb4\x9f\xd4\xa3\xb3\x7f\x35\x4a\x42\x4c\x70\x37\x33\x1a\x39\xbc\x7c\x33\xb1\xd4\x92\x29\xb2\xf7\xde\x03\xa0\x43\x05\xab\xe7\xc8\x20\xf6\xec\x17\x56\xbb\x69\x6d\x6c\xb7\x8c\xa7\x2e\x02\xb2\x01\x2c\xaf\x24\x3a\x29\x14\x63\x6b\x59\x1a\x92\x86\x77\xe4\x6f\x9c\x54\xc0\x22\xc3\xd6\xe8\x71\x65\x05\x56\x6f\x5e\x1a\x3e\x6c\xf2\xab\x5e\xb2\x3c\xcd\xf7\x1c\xf7\xde\xbc\x77\x68\xd4\xde\xd5\xfb\xfc\xb7\xec\x3d\xe5\xbe\xde\x31\x9e\x8a\x3b\xd5\xb7\x0e\xf1\xa3\x9d\xd6\x0b\xd4\x89\x41\x6b\xcd\xf8\x54\x85\x7a\x44\xb3\x4e\xee\x7a\x45\xc2\xef\xf0\x44\x0a\x9b\x86\xb7\x2a\xc0\x2f\x45\x6e\x47\x25\x60\x87\x31\xcd\x15\x3d\x91\xe6\x53\x34\xa3\xd9\x75\xd1\xbe\x6c\x1a\x59\x46\x83\x6f\x3f\x5c\x1f\x37\xa7\x36\xf4\xec\x6e\x06\xd2\xf2\x5e\x73\x9d\xd0\x34\x67\x4a\xa1\x19\x08\xc6\x33\x21\x6e\xc9\x81\x0f\xb4\x9a\x32\x3d\x2b\xc7\xa3\x44\xe4\x41\xcc\xd5\x50\xb1\xa9\x3a\x72\x48\x3b\x34\xab\x3f\x24\x8c\x67\x55\x04\x09\xaa\x91\x5c\x2b\x6f\xc6\xc0\x97\x24\xd5\x2a\x70\x6f\x5d\xdd\x45\xe7\x65\x5e\x5d\xa6\xad\xb4\xc8\x20\x7b\xfa\x62\x30\xab\xdb\x73\xd1\xb1\xae\xc5\x23\x5b\x84\xdf\xee\xd2\x46\xc2\x14\xa7\xb5\x70\xb4\xd2\xdb\x93\x03\xc9\x49\x07\x09\xa8\xfe\x2a\xe6\xfc\xa5\x9e\x93\xa4\x60\x33\x1b\x00\xa3\x4e\xe8\xc6\x30\x24\xb4\xca\xee\x63\x82\x9c\x7b\x74\x3f\x94\x68\xd1\xeb\x63\x53\x30\x8c\x3e\x90\x15\x33\x3a\xb4\x4a\xb2\x21\x49\x48\xc3\xbc\x0c\x30\x13\x5c\x48\x8b\xa2\x86\x0b\x0a\x8e\x28\x8d\xda\x82\x75\x04\xe1\x9e\x38\x1a\x1b\x2c\xf5\xa4\xf6\x0f\x86\x3e\x24\x4c\xb0\xb1\x09\xfa\xf5\x1a\xee\x98\x9e\x61\x11\xb9\xd9\x92\xc3\x09\x57\x22\x41\xa1\xf7\x80\x13\x90\x52\x48\x17\x08\xe3\xad\xb6\x38\x13\x92\x62\x8c\xa4\x31\x48\x42\xcd\x5f\xfb\x2a\x74\x51\xd6\xa5\x4c\x31\xb6\xcb\x60\x13\x4c\x26\x90\xa0\xa4\x14\x02\xd8\x92\xdd\x83\xba\xaa\x9e\x0f\x9d\xd7\xc2\x97\x42\xcd\xd9\xbd\x79\x4b\xf8\xd4\x52\x41\x75\x2e\xf8\x70\xfd\xe5\xc3\x11\x21\xe7\xbc\x8a\x7b\x1c\x98\x5d\x0c\xef\xf4\x21\x3f\xda\x7c\x62\x58\x47\x17\x3f\x20\xb4\x3b\x19\xf1\x4e\x96\x3d\x60\x7c\x17\x63\x30\x09\x0d\xc2\xbd\x92\x03\x34\x0c\xbb\x49\xcd\xd6\x7b\x26\xde\xc5\x50\x6c\x6e\xf9\x54\xc6\xe2\x97\xc1\xe9\x49\x57\x3a\xe7\xd2\xd5\x63\xed\xd7\xed\x46\xac\xfd\xba\x79\xc4\xda\xaf\xb1\xf6\x6b\xac\xfd\xfa\xf0\x88\xb5\x5f\xdd\x78\xfa\xf4\x4c\x12\x6b\xbf\xb6\x1c\xaf\xab\xfe\x48\xac\xfd\xba\xd3\x88\xb5\x5f\x57\x47\xac\xfd\xba\x61\xc4\xda\xaf\x1b\x46\xac\xfd\x1a\x6b\xbf\xc6\xda\xaf\xb1\x8a\xd5\xa3\x73\x3d\xcf\x2a\x56\x24\xd6\x7e\x75\x23\xd6\x7e\x7d\x15\xb5\x7a\x48\xac\xfd\xba\xd5\x88\xb5\x5f\x63\xed\xd7\x36\x23\xd6\x7e\xc5\x11\x6d\x2f\xb1\xf6\xab\x1f\xb1\xf6\xab\x1d\xbf\x1d\xa9\x39\xd6\x7e\x8d\xb5\x5f\x63\xed\xd7\x58\xfb\xf5\xc1\x55\xc4\xda\xaf\xaf\x41\x9f\x54\x3a\x65\xad\xca\x61\x6d\x53\xbd\xc0\x45\x86\x04\xf9\x8e\xe3\x72\x32\x01\x89\x94\x0b\xdf\xbc\x12\x85\x50\x55\x39\xaa\x68\x99\x8b\x33\xc0\xaa\x66\x12\x68\xea\xa2\xa0\x37\x3c\xee\x12\x2c\xb1\x6c\x55\x1d\xbe\x77\xf6\xf1\x9b\x7e\x4a\x25\x74\x0b\x5c\xc3\x35\x7f\xe4\x49\xf7\x00\xa6\x1a\xe0\xeb\xa2\xf2\x1d\xdc\x93\x4c\x28\x17\x76\x88\xc0\x4a\x66\x94\x73\xf0\xca\x23\xd3\x68\x94\x19\x03\x70\x22\x0a\xe0\x96\x7e\x53\xa2\x18\x9f\x66\x40\xa8\xd6\x34\x99\x8d\xcc\x9b\xb8\x07\x76\x1d\x22\xe8\x7e\x51\x5a\x02\xcd\x7d\xb0\x64\x4e\x99\x9d\x8a\xd0\x44\x0a\xa5\x48\x5e\x66\x9a\x15\xd5\x64\x44\x01\x46\x39\x5b\x46\x55\x01\x03\xc3\x4b\xea\xb8\xc2\x41\xfd\x36\xb7\x2c\x11\x56\x8a\x41\xd5\x75\x80\xa5\x2d\xf3\x42\x2f\x88\xf9\xe4\xcc\x95\xbb\x93\x4a\x93\x24\x63\xc8\xad\xf1\x8d\x36\xa1\x0c\xe7\x1b\x78\x5e\xcd\xdd\x4a\x95\x5b\x2a\x4f\x51\x6c\x2d\xb4\x22\x18\x86\x57\x4f\xe8\xa6\x4a\x99\x72\x62\xbe\x1a\x10\xea\xeb\xa0\x58\x40\xfb\x95\x22\xa8\x3d\x67\xb1\xb3\xbb\x9f\x82\xe9\x82\xe2\x69\x06\x37\xad\x35\xac\x46\x74\x8c\x3b\xf5\xc8\x39\x68\x84\xd8\xd6\x02\x05\x86\xbb\xac\x1c\x03\xdc\x00\x0e\x73\x83\x03\x90\x80\xe1\xaf\x74\x03\xd6\x7f\x76\xa4\xd7\x54\x4e\x41\x57\x41\xb9\x6d\x63\x35\x9b\x05\x22\x82\x2a\x87\xa1\x1e\x52\x43\x0c\x81\x73\x29\x52\x8c\xb8\x77\x55\x24\x0c\xce\xac\x29\xa3\x68\x17\xe8\x0a\xe0\xac\xbb\xc1\xcb\x45\x36\xd4\xa9\x7a\xa9\x2a\x68\x02\x8a\x1c\x9c\x5f\x9e\x0c\xc8\xe5\xf9\xa9\x8b\x67\x12\x93\x75\x69\x7c\x8e\x84\x59\x04\xdc\x54\xd0\x91\x29\xff\x8e\xbb\x19\xd5\xb8\x9d\xc1\x8b\x50\x12\x9d\x51\xe9\x02\x15\x7d\xe5\x6b\x72\x21\x34\xac\x2b\x94\xe1\xa9\x01\x8a\x5f\xce\x06\xe1\x30\xcd\x8a\x33\xed\x09\x60\x4b\xb5\x25\x90\x8f\x3e\x80\x52\x74\x0a\x97\x2d\x1d\x58\x9b\x94\x73\xf4\x61\xd5\x67\x14\xa9\x42\x66\xd3\xd7\xaa\x5f\xea\x88\xb7\xa6\x44\x4c\x72\xbb\xa6\x6a\xbf\xef\x24\xd3\x1a\xf0\x7c\x63\xf1\x24\xf4\x81\x2f\x27\xa8\xee\x2f\xc5\xcd\x7d\xf0\x93\xd4\x0f\x1b\xfe\xce\x53\x1b\xc5\x36\x06\x32\x96\x0c\x26\x64\xc2\x30\x34\x0e\x83\xd5\x06\xb6\x1c\x08\xb5\xc6\x25\xa5\x40\xe2\x7a\x9c\x5a\xe3\xd7\x35\x22\x3f\xba\x85\x69\x59\x72\x5b\x01\xdd\x49\xdc\x98\xc2\xc5\x26\x64\x8a\xc1\x6e\x4e\x71\xf8\xe3\x9b\xff\xf8\x13\x19\x2f\x8c\x74\x83\xa8\xad\x85\xa6\x59\xf5\x91\x19\xf0\xa9\x81\x95\xa5\xd4\xcd\x24\xa4\x0a\x02\x58\xa3\xdc\x2e\xfc\xed\xef\x6f\xc7\x4d\x71\xeb\x28\x85\xf9\x51\x00\xbf\x61\x26\xa6\x23\x72\x42\xb9\xc1\x75\xa3\x46\x14\x29\x5a\xf2\xdb\xd7\x0d\xed\x0f\xcd\x44\xc6\x92\x45\x77\xb2\xe3\x74\x13\x32\x13\x77\x56\xed\x5b\x83\x3d\x75\x38\x6c\x21\x8a\x32\xb3\xce\x8c\x6f\xaa\xf4\xbd\x52\xc1\x6a\x8e\xce\xda\x73\x81\xe6\x77\x37\xc5\xd2\xd1\x76\x31\x8e\xfe\x95\xc2\xc5\x7e\x3b\x03\x71\x55\x9e\x06\x75\xe2\x6f\x68\x96\x8d\x69\x72\x7b\x23\xde\x8b\xa9\xfa\xc8\xcf\xa4\x14\xb2\xb9\x96\x8c\x1a\xc6\x39\x2b\xf9\xad\xad\x4b\x5d\xa5\x10\x8b\xa9\x91\xb2\x8b\x52\xfb\x4a\xa3\xeb\x3e\xd8\x26\xa4\x7a\x7e\xec\x35\xe2\x7a\x16\xb8\x67\xb5\xda\xeb\x52\x29\x2c\x46\x86\xf3\xab\x10\xd9\x7e\xff\xe6\x8f\xff\x6e\x51\x97\x08\x49\xfe\xfd\x0d\xc6\xc1\xaa\x81\x3d\xc4\x48\x17\x8d\xcc\x90\xd3\x2c\x33\xe4\x35\x44\x4a\x03\xe8\x75\x48\xf8\xd9\x71\x50\x77\x47\xb7\xad\xa5\xea\x9b\x9b\xbf\x22\x4b\x60\x5a\x41\x36\x19\xd8\xac\x81\x4a\xc3\xdd\x47\x19\x61\xdf\x51\x1f\x4c\xdd\x78\x06\xb2\xf0\x5c\x64\x65\x0e\xa7\x30\x67\x7d\x34\x9e\x68\xcc\xe6\xad\x3e\x19\x53\x98\xa0\x31\xce\x44\x72\x4b\x52\x77\x31\x88\x68\x5a\x2e\xb1\xda\x1e\x0a\x6d\x63\xbb\x3a\xc4\x74\x6d\xfc\xfe\x46\x34\x57\x4e\x8b\x82\xf1\xa9\x4d\x4e\x92\xf4\xae\x01\x0c\x3c\x93\x98\x0f\xdc\xb1\xde\x42\x67\x8f\x43\x57\x7f\xc3\xd0\x7d\x91\xa1\x9b\xad\xa7\x68\x1d\xcd\xd4\xdd\x5d\x51\xaf\xbe\xbd\x8d\xba\x81\x10\xf5\x84\xfe\x34\x14\xf8\x6f\x1b\xa9\xbf\x22\x2e\x57\xe2\x63\x85\x18\x56\x00\x30\xe8\x83\x24\xb9\xbd\xed\xbd\x07\x43\x77\xb7\x50\xb6\x06\x5c\x78\xe5\x60\xc8\xa9\x76\x02\xa1\xd7\x20\x28\x29\x40\x2a\xa6\x0c\x5f\xfe\x01\x0f\xd4\x49\x46\x59\x1e\x58\x83\x9f\x06\x08\xf6\x70\x63\x65\xcc\xee\x94\xf2\x52\xa4\x6e\x42\x24\x85\xb6\x2a\xe8\x1a\xb1\xb6\x29\xd5\xf6\xc8\x50\x9f\x9a\x54\xfe\x50\x43\xb3\x49\x29\xcd\x2f\x15\xa9\xb4\x77\xbd\x26\x02\x89\xdf\xf7\x52\xe9\x63\xb5\xf8\x9e\xc8\x00\x12\x46\xb7\xb9\x4d\x4a\xd8\x50\x1e\xed\x41\x09\x44\x7a\xa7\x07\x8e\x88\x8d\xae\x30\x67\xc2\x3d\x4a\xf6\xdf\xed\x3f\x29\x91\xb4\x20\x92\xa2\xa0\xd3\x4e\x3d\x0e\x96\x20\xb5\x3c\x6d\x98\x08\x6e\xd4\x20\xbc\x5e\x95\x25\xc2\xbb\x20\xad\x0b\x55\x60\x19\x12\xeb\x28\xf7\x00\x76\x0a\x02\xf6\xa0\x21\x77\x74\x41\xa8\x14\x25\x4f\x9d\xa9\xb1\xb2\xf5\x7e\x58\x7a\xf1\x85\xe0\xe0\x7d\x28\xcb\x79\xe4\xe8\xdc\x61\x9c\xbc\x1d\xbd\x7d\xf3\x5a\x38\x15\x7e\xe1\x12\xa7\xba\xa8\x38\x95\xa5\x4f\x4f\xfa\xad\xbe\x1a\x72\x4f\xdf\xfb\xc1\x99\x58\xea\x62\xc7\xcc\x17\x73\xc5\x9f\xee\x24\xd3\x10\x74\x2e\x3a\x40\xc5\xc5\xe8\x87\x41\xd6\xf4\x61\x8f\x35\xbe\xfb\x49\x53\x57\xe5\xf8\x13\xd2\x2d\x47\xa0\xf0\xb8\xad\xb3\x70\xa9\x07\x48\x58\x08\xa8\xbd\x3d\x72\x60\xef\xdc\xb7\x49\xa2\x87\x4f\x8a\x5a\x0e\x68\x67\xf7\x45\x87\x1a\x74\x0d\xc0\x9d\xdd\x17\x14\x6d\x70\x45\x8f\x10\xfc\x2f\x98\xd1\x39\x60\x72\x2c\xcb\xa8\xcc\xd0\xfd\x7c\x6d\xd7\x4e\xc6\xa5\x26\xc0\xe7\x4c\x0a\x8e\xb1\x5e\x73\x2a\x19\x56\xad\x90\x30\x01\x09\xdc\xe8\xa2\x5f\x1e\xfc\x70\x7c\x85\xb1\x2d\x87\xb6\x96\xbd\x5f\x65\xa9\x7c\x79\x89\x70\x25\xc1\x74\x8f\x6e\x9f\x5f\x87\x81\x21\xd2\x5c\xbf\x2e\xf3\x9e\xbc\xd4\xa5\x2d\x98\x7f\x9f\x64\xa5\x62\xf3\xa7\xa2\x24\x2e\x6b\xf9\x94\xb5\xda\xe7\xa5\x0c\xea\x1a\x50\x2b\xc9\xd0\xb5\x0d\xfe\x91\x0a\xad\xfb\xaa\xaa\x69\x15\x86\x43\x38\xd3\x13\xc9\xd9\x74\xa6\x5d\x58\xa6\x2f\x69\xb6\x22\x42\x60\x5d\x87\xa7\x35\x42\x19\xb6\x7b\x9c\x31\xaa\x76\x15\xb9\x56\x32\x0e\xdd\x2c\x18\x44\xc1\x5d\x21\x2a\x9a\x55\xb6\x15\xf3\x22\x6b\x70\x3c\xbf\x74\xde\x29\x0f\x37\xc6\xff\xc7\x06\xad\x54\xda\x85\x0d\x42\xb1\x8f\x58\xab\xe1\x24\xac\x19\xe0\x83\x35\x90\xf8\x63\x95\x15\xb4\x6a\x71\xc1\x87\xb3\xa0\x20\x49\x21\xd2\x1d\xb3\xf1\xda\x2a\x1e\xad\x54\x8e\xf5\x10\x24\x33\x91\xa5\xbe\x2f\xa7\x35\xc9\x8c\x41\xdf\x01\x70\x72\x7e\x89\xf0\x33\x9f\x88\xde\x9e\x0d\x50\xb4\xde\x01\x2c\x3d\x12\x68\xa4\x0d\x78\xee\x8a\x60\x1d\xb4\x92\x2e\x22\x7d\xf5\xa5\x9d\xcf\xfc\x5f\x2a\x98\x79\x8f\x18\x1d\x8b\x39\x20\x48\xd3\x54\x82\xea\x50\xba\xe3\x09\xf4\xd4\x4e\xa4\x94\xb5\xea\xbb\xd0\xf4\x6f\x54\x60\xf3\x16\x22\x14\xdf\xf1\xa8\x22\xe2\x7d\x66\x0a\x76\x7e\x79\xd2\x81\x7a\xed\x7f\xef\xdc\x1b\x66\xaa\xfd\x7d\x45\x58\x91\xd4\xfe\xd4\x11\xa9\xbd\x86\x41\x46\x83\x95\x18\x77\x73\x59\xb5\x15\x13\x03\xa2\xd6\x91\x48\x13\x6e\xa7\x31\x64\xc5\x65\x33\x57\x5e\x62\xa6\xac\x9b\xb8\x01\x0d\xe5\x9f\x08\x01\xe2\x03\x11\x2c\x91\x77\x61\x19\x83\x2a\xc0\x77\x89\x30\xa1\x05\xdd\x87\xf6\x05\x54\x7c\x05\x98\x9f\x0d\x96\x97\xe7\xa7\x7d\xa2\x4b\xc1\xd2\x67\x87\x2e\xbb\xeb\x97\xcd\xdc\xb5\x66\xc9\x07\x37\xa1\x3f\xec\x97\x22\xdd\x20\x26\xd5\x8c\x06\xef\x0f\xbb\x0b\x6a\x41\x28\xb1\x66\xc2\xa5\xbe\xb1\x2d\x80\xb2\x33\x95\x40\x51\xeb\xb2\xcc\xb2\x6b\x48\x24\xec\x6a\x1e\x6d\xee\xff\xf9\xd2\x5c\x9b\x44\x9e\x40\x7e\xc7\x3a\x02\xee\x66\x5e\x17\x78\xab\xb0\x26\xcc\x12\x2c\xca\x0c\xe3\x4c\x29\x5f\x78\x80\xe3\xea\x55\xe0\x8b\x62\xca\xc7\xac\xd8\x10\xa9\xc6\x2e\x28\xa8\x5e\x56\x75\x0b\xa1\x4a\x59\x8f\x29\xe3\x29\x9b\xb3\xb4\xa4\x19\xbe\x08\xa5\xd0\xb0\xa7\x6f\xc5\x21\x73\x5f\xb0\x90\x7c\x23\x24\x81\x7b\x6a\x6e\x1b\x54\x42\x2c\x55\x88\x0e\xa9\x48\x6e\x41\x0e\xac\x28\x76\x8a\x7f\x9c\xa0\xc4\x6b\x4b\xf2\xfa\x75\x18\x5d\xc2\x95\xe9\x6b\xd3\x26\xd8\xf7\x01\xb6\x70\xf8\xc2\x7e\xee\x82\xf1\xe9\x10\x7f\x31\x1f\xe2\xde\x34\x14\x7c\x48\x87\x06\x0d\x5f\x88\xe0\x87\x35\x78\x3f\xa2\x64\x75\xe5\xf1\xc5\xab\x08\x46\x91\x13\xe5\x74\x86\xc0\x92\x39\xf5\x65\xb1\x32\xd0\x58\xf1\xc8\xf9\x75\x6d\x69\x0a\xf7\x6c\xea\xc4\xb4\xb0\x02\x54\x13\xd7\x5e\x88\xf0\xd7\xd6\x42\xb6\x14\x29\x1c\x90\x2d\x07\x23\xbd\x33\x06\x8a\x39\xc8\x39\x83\xbb\x23\xc7\x3a\x87\x77\x4c\xcf\x86\x16\x22\xea\x08\x01\x7b\xf4\x85\x15\x2f\x6d\xe2\xd6\x71\x9a\x3a\xb3\x65\xa9\x60\x52\x66\xae\x5f\xee\x88\xd0\x82\xfd\x00\x52\x61\x5d\xc5\x5b\xc6\xd3\x01\x29\x59\xfa\xf5\x67\x0c\x7c\x61\x9c\xd5\x21\x76\x9d\xa8\xe0\x7b\x47\xe5\x5c\xbe\x30\xfb\x67\xdd\xd2\xd6\x05\x07\x8d\x21\x13\x7c\x1a\x64\x3b\xa3\x78\x71\xce\x99\x5e\x69\xcd\x67\xab\x96\xa1\x8a\x2c\x64\x8a\x81\x8c\x4c\xc8\x86\x3d\xd8\xcc\x87\xb5\x9c\x82\x70\x48\x43\x22\x59\x63\x3e\x0c\x67\x51\x15\x33\x22\x36\x20\xc2\x27\x46\xfa\x0a\x99\xbe\x46\x94\x2d\x59\x36\xa3\x3c\xc5\x3f\x93\x44\xc8\xd4\xad\x97\xe9\x2a\xf6\xd2\x06\x05\xd9\x48\x14\x64\x6b\xd8\x5d\x9d\x2f\xbf\x19\x35\x50\x99\x37\x02\xf5\xbc\xd8\x53\x72\xf6\x8f\x12\x08\xcd\x85\x21\xec\xcb\x85\x9c\x97\x20\x92\xd3\x05\xf2\x56\x5c\xea\xfb\x2a\xd3\xcf\x26\x13\xaa\x01\xb9\x02\x9a\xb2\x20\x21\x7a\x40\xde\x37\x33\xa4\x07\x66\x2d\xd7\x36\x75\xd3\xfd\x64\x57\xef\x9b\xab\x5f\x59\x27\x51\xee\xe3\x8a\x56\x3f\xc6\xec\x8a\xa6\xb7\xc0\xad\x52\x6e\x40\x83\x7e\xb0\x52\xe2\x1e\x24\x33\x48\x4b\xe4\x52\xe3\x05\x99\x30\x5b\xdd\x1d\x45\x05\x36\x9d\x81\xd2\x5e\xb8\x3c\xc2\x58\x9d\xba\x4d\x8d\x5f\x00\xa2\x6f\x10\x69\x5b\x9b\xb1\x72\x8a\xa5\x4b\x85\xeb\x4c\xef\x92\x49\xac\xce\xa6\xca\xdc\x9f\xe5\x65\x48\xab\x91\xef\x69\x6f\x56\x1e\x54\xcd\x66\x4b\xc0\x45\x27\x9d\xb3\xc3\x91\x09\x55\x33\xac\x29\xbf\xbc\x05\x89\x35\xc9\x24\xa5\x34\x04\xc3\x96\x99\xa5\xd8\x44\x16\x3b\x16\x62\xbf\xd1\x75\x86\x9b\x8e\xc9\x03\x66\xb1\xed\xfb\xde\x3f\x1d\x13\x3b\xae\x82\xc1\x0d\xe0\x93\x25\x4a\x60\x77\xd2\x30\x2c\x5f\x5b\xca\xb7\x21\xc7\xcd\x30\x54\xe1\xf3\xb1\xa4\xf6\xfe\xd1\x56\x7e\xcd\x2e\x1c\x90\xca\x69\x77\xcb\xc7\xfe\xb1\x9c\x96\xf6\xa0\x3b\x2a\x5c\x17\xa5\x75\xad\x3c\x51\x6a\xb3\x32\xa6\x51\x67\x4e\x3e\x9c\x86\x29\x48\x61\x6e\x85\x4f\xe0\x1a\x91\x1f\xba\x1a\xa9\x97\xad\xd4\x86\x9a\xd7\xa6\xef\xa4\x3a\x59\x86\x62\x64\x73\xaf\x5f\x54\x6f\xf3\x72\x28\xe3\x45\xa9\x1d\x1b\xac\x35\x4e\x9e\xcc\x28\x9f\xa2\x92\x29\x4a\x33\xdf\x97\x5f\xe2\x8a\x24\xa4\x65\xe2\xaa\xe9\x7b\x94\xfd\xd2\x9b\x6c\x5d\x3d\x2f\xa4\x55\x2a\xa1\x85\x5f\x73\xf8\x59\x6a\xc1\x35\xbd\x7f\x47\xd8\x08\x46\x64\xef\xcb\xe0\xd2\x9e\x7d\x7b\x21\x85\x79\x85\x4b\x7d\xc0\x55\x65\x4c\x63\xf8\xf6\x5e\x78\xf7\x88\x9c\x99\x77\xa0\x1b\xab\x02\x60\x10\x9d\x3f\xae\xc1\x37\x20\x12\xa6\x54\xa6\x99\x33\xb7\xdc\x05\x29\x1d\x15\xc0\xe0\x9e\x29\xad\x2c\x0f\xd2\x1d\x28\x93\xa6\xea\xd6\xd0\x21\x73\xb2\x86\x29\xd5\x74\x18\x1c\xe9\x23\xab\xb7\x0d\x5d\xb9\xcf\x21\x75\xa8\x55\x93\xac\xa3\x2f\x5c\x66\xe4\x90\x56\x77\x31\x23\x91\x63\xe1\xcd\xf6\x72\xce\x4b\xb3\xb1\x75\xa8\xfa\xda\x3c\xbd\x67\x75\x05\x69\x84\x01\x46\xf1\xd7\xf2\x52\x45\x44\x5d\x61\xd3\x75\xe7\xf9\xec\xe2\xe6\xea\xaf\x97\x1f\xcf\x2f\x6e\xe2\xb1\x8e\xc7\x3a\x1e\xeb\x0e\xc7\x1a\xf8\xbc\xf3\x91\xf6\x7a\xd3\x3a\x97\xef\x72\xc1\xc9\x20\x35\xe8\x15\x05\xd6\x9d\xf1\xf9\x0f\x54\xd6\x6d\xec\x9d\xbf\x6a\x8d\x07\xdc\xf7\xb9\x47\x12\x77\xf2\xe2\x23\xeb\x9e\x30\x2e\xae\xc7\x78\xa3\xd0\xa4\xb2\x6e\xd7\xc2\xde\x5f\x27\xbf\x9c\x9f\x9e\x5d\xdc\x9c\x7f\x73\x7e\x76\xf5\xa4\x81\x22\x1d\x0b\x3e\x36\x99\x72\x4b\x2e\x59\x48\x98\x33\x51\xaa\x6c\x51\xf5\xd8\x5a\x4f\x04\x56\x63\x0d\x79\x8a\xb6\x0e\x05\x12\xa3\xae\xd7\x3e\x16\x99\x6d\xbf\xcc\xb6\x19\x37\xd3\xa1\x3c\x4f\x5f\xe8\xfb\x8d\x14\x79\x4f\x28\x7c\x6d\xad\x30\xde\x19\xbe\x0e\x9f\xf6\x5d\x25\x8f\x06\xeb\x71\xc2\x63\x5d\x36\xc4\x08\xa3\x79\xa1\x3b\x14\xc3\xef\xa5\xc4\x6f\x3f\xd5\x70\x6d\xac\xce\x07\x5a\x7c\x07\x8b\x2b\xe8\x58\x05\xa8\x09\x6f\xc8\x20\x31\x8c\x8e\xdc\xc2\xc2\x06\x66\x9e\xf8\x97\x75\xa9\x56\xf4\xff\xb1\xf7\x3f\xdc\x8d\xdb\x48\xde\x28\xfc\x55\x70\x3c\x7b\x5e\xdb\x89\x24\x77\x27\x99\x99\x6c\x3f\x79\x93\xe3\xd8\xee\x8c\x9f\x74\xbb\xbd\xb6\x3b\xb9\x73\xd3\xd9\x19\x88\x84\x24\x8c\x49\x80\x01\x40\xb9\x35\x9b\xfd\xee\xf7\xa0\x0a\x00\x41\x49\xb6\x65\x92\x6e\xc9\x8e\x38\xe7\x4c\xc7\x12\x05\x82\x85\x42\xa1\xfe\xfe\x6a\x23\x11\x92\xaf\x59\x1b\xf4\xea\x2e\xa1\x8d\xaf\x59\x8b\xa4\x53\x7f\x2d\x80\xfc\xda\x25\x04\x3d\xcd\xae\x69\xbb\xd5\x23\xdd\xc2\x1a\x3f\x02\x94\xf3\xf3\x8d\xa0\xd4\xaf\x0e\x57\xc1\x07\x82\x3b\x5e\x09\x8c\xc9\xcf\x6a\x67\x57\x10\x22\x04\xab\x3a\x81\x37\x7d\xd0\xc1\x29\x19\x1d\x91\xa6\x6d\x13\x2e\x82\x4b\xd8\xad\x5c\xdd\xad\x04\x2b\xe6\xf8\x07\x9c\xb9\xf4\x95\x87\x32\xd0\xa1\x73\xd6\xc0\x72\x58\xaf\xfe\x27\x84\x44\x7b\xe4\x9f\xe1\x43\xe8\x35\xad\x7f\xd9\xdd\xfd\xe6\xc7\x93\xbf\x7f\xbb\xbb\xfb\xeb\x3f\xe3\x6f\xe1\x28\xc4\x40\x79\xfd\x16\xc0\x75\x12\x32\x65\x67\xf0\x0c\xf8\xd3\xa9\x6b\x87\x18\x3c\x71\x5f\x40\x45\xf6\x00\xb3\x96\xc2\x9f\x85\x4c\xe7\xff\xd2\xad\x00\x01\x37\xf2\x60\x80\x25\x6a\x51\x59\x84\x57\x77\xc7\x43\x25\x4b\x3a\xde\xaa\x6e\x54\xcf\x8d\x00\x2c\x8d\x90\x64\xaf\x3d\x09\xa0\xbb\xa7\x87\x7e\x10\x50\x2f\x6f\x35\xd3\x3a\x3a\xe4\xce\xf4\x65\xab\x3e\xc6\x78\x75\x28\xda\xc2\x0a\x76\x4c\x30\xa0\x88\x6f\x18\x06\x1b\x39\x1c\xb0\x21\x61\x26\x74\x9f\x3b\x3c\x3f\x25\x53\xa4\xf0\xc6\x10\xc7\x07\x36\x5f\x3f\xaa\x8c\x0b\xe1\xd3\xf9\xba\xdc\x57\x98\x80\xe3\xbf\x77\x18\x09\x3a\x20\xd8\x31\x6b\xd8\xec\xe1\x87\x83\xa4\x28\x7b\xee\x86\x41\xce\x72\xa9\x66\xe1\xcf\x00\x36\xd3\xd7\x46\x2a\x3a\x86\x9a\x1a\xfc\x39\xfe\x2c\xfc\x85\x3f\xac\x3d\x60\xf1\xd7\x68\x0a\x57\x51\xd4\x00\x70\xfb\xfc\x64\x9b\x27\xfd\x86\x88\xb6\xa4\x2d\x8c\x52\xfd\xaa\x33\x64\xf0\xc4\xa1\xc2\x19\xa8\x08\xf6\xa4\xab\x19\xee\x55\xf9\x70\xe0\x0d\x10\x53\x6b\x59\x36\x06\xc0\xab\xae\x0e\xa5\x59\xca\xa7\x5c\xcb\x16\x95\x43\x61\xa0\xdb\x73\x27\x1d\x6c\x09\xe6\x6f\x05\xb7\xd9\xc7\x02\x30\xbf\xc2\x7e\x9d\x13\xfb\x2f\xdb\x34\x39\xc7\xab\xa0\xc6\x30\x25\x5e\x91\xff\xde\xfb\xf0\xf9\xef\xfd\xfd\xef\xf6\xf6\x7e\x79\xd1\xff\xcf\x5f\x3f\xdf\xfb\x30\x80\xff\xf8\x6c\xff\xbb\xfd\xdf\xfd\x1f\x9f\xef\xef\xef\xed\xfd\xf2\xe3\xdb\x1f\xae\xce\x4f\x7e\xe5\xfb\xbf\xff\x22\xca\xfc\x1a\xff\xfa\x7d\xef\x17\x76\xf2\xeb\x8a\x83\xec\xef\x7f\xf7\x1f\xad\xa7\xde\x01\x04\x2f\x5e\x5d\x02\xf1\xd6\x47\xec\x84\xfd\x1e\xb1\x95\x05\x5e\x9e\xbd\xba\xde\xff\x17\x5e\x6a\x46\xf9\x3c\xfe\xb8\xde\x98\x0d\x8e\x09\xa1\x9f\xc2\x93\x83\x4f\xaa\xd7\xda\x04\xd3\xe2\xb9\x9d\x73\x7f\x04\xe7\x8e\x57\xdb\x71\x5d\x2b\x4d\x74\xa4\x64\xee\x2b\xfa\x21\xbc\x81\xb5\x67\xee\xbe\x6b\xd6\xaa\x25\x28\x5e\x5b\x67\xd0\xd6\x19\x74\xcb\x75\xaf\x33\x08\xcb\x11\x36\xd7\x13\xc4\xc4\xb4\x69\x08\x63\x69\x04\xdd\xdb\x3a\x31\xfc\xdd\x6a\x01\xb5\x81\xdf\xea\x3a\x44\xe2\xaa\x4c\x1a\x3c\xd0\xf2\xe5\x31\x4c\x68\xe0\xcf\x05\x6e\x7c\x18\x20\x80\x7e\x32\xd7\xbb\xc3\xd5\x5f\x4e\xed\x14\x02\xd2\x7b\x0d\xbb\x13\xb2\x8a\xb9\x18\x3b\x24\x0b\x3c\x4a\x5c\xf4\x89\x8b\x0a\x0d\x37\x28\x87\x15\x84\x3a\xd5\x5a\x26\xd0\xf8\x08\x71\xf2\x02\x2a\x9f\x9b\x36\xcc\xc6\xd0\x6b\x16\xb7\x62\x47\x78\xf5\xea\x5d\x87\x33\x40\x7d\x15\x53\x0f\x31\x9f\x96\x98\x0c\x82\xe2\x6f\xf9\x18\xcf\x2b\x01\xc1\x32\xa2\x0b\x82\x45\x79\x08\x20\xf5\x83\x85\x4d\x21\x15\x43\x8e\x2a\x2f\x6b\xb3\x66\x96\xad\x4f\xf1\xf6\x67\x66\x88\x6c\xb5\x52\x86\x16\x0e\xcb\xca\xfd\x5c\x3f\x24\x9f\x43\x30\xb0\xfd\xf1\xf9\x87\x3b\x3a\x3b\x3a\x36\xbb\x39\x32\x1f\x10\x3b\xe9\xf2\x98\xec\x22\x58\x52\x28\x36\xe2\x1f\x3b\xda\xa7\x87\x51\x65\x22\x4f\x99\x30\x7c\xc4\xb1\x5f\x6f\xa1\x58\xc1\x04\xf6\xcc\xa7\xc9\x04\x64\xbf\x3b\x29\xab\xe0\xf4\x26\x26\xf3\xa0\xc2\xdd\xad\x28\xbb\x5c\xa6\xec\x6f\xe5\x18\xd9\xca\xb1\xc6\xd7\x27\x92\x63\x8e\x73\x37\x47\x88\x41\xe6\x79\xfb\xd4\xf7\xe3\x28\x8f\x1d\xb8\xb8\x7d\xe5\xf0\x1c\x1a\x5c\x90\x8b\x46\x62\xe6\x1a\x96\xaf\x29\x92\xb1\x29\xcb\x9c\xd2\x44\x72\x2a\xe8\x18\xdb\xf0\x19\x19\x40\x7f\xa4\x0a\x2d\x8e\xe6\x11\x7d\x40\x89\xf7\x95\x5d\xf0\xa5\x92\x59\xc6\x94\x26\x19\xbf\x66\xe4\x98\x15\x99\x9c\xe5\x2e\xf1\x35\x25\x97\x86\x1a\xcb\xd2\x97\xcc\x34\x8b\xf9\xb6\x43\x03\xf1\xc5\xec\x1d\x41\x9f\x63\x75\x3c\xd4\x96\x93\xc2\x15\x4e\xbe\x13\x20\x31\x0e\xa1\xdb\x4a\x8f\x9c\xb1\x29\x53\x3d\x72\x3a\x3a\x93\xe6\x1c\x55\xef\x7a\xb6\x1d\xde\x48\xf8\x88\xbc\xb2\x46\x9d\x36\xc4\x60\xc7\x8b\xa8\xce\x5d\xaa\xda\x00\x15\xde\x5b\x17\x65\x79\x8b\x25\xe7\x30\x52\x28\x38\x6f\x14\xc6\x68\xb5\x4c\xa1\xa5\x50\xeb\x05\x3a\xc4\x32\xd2\x0a\xc9\x37\xe2\x6f\x84\x67\xf0\x08\x66\x60\x02\x72\x41\x14\xd3\x85\x14\x9a\xd5\xe1\x19\xab\x16\x94\x60\xea\xea\x4e\x2d\xc4\xc6\x27\x67\xdb\x33\xb3\x90\xda\x40\xe5\x6c\x37\x8d\xaa\xce\xfd\x70\x50\x88\x4c\xb3\x8c\xa5\xb5\x4e\x65\xd8\x61\x87\xd6\xdd\x03\x09\x34\x67\xf0\x0d\x5f\x98\xab\x4f\xae\x95\x36\xd7\xee\x0f\x5d\xef\x7c\x5f\x19\xdf\x3f\xf9\xb6\x82\xe6\x6a\x63\xc2\x21\x12\x31\xc0\x02\xde\x33\xa0\x80\xeb\xa8\x39\xcd\x44\xca\x6b\x92\xc8\xbc\xc8\x60\xeb\xb4\xd8\x59\x55\x6f\xac\xc0\x4a\x7d\xe8\x87\x7a\x10\xb5\xcd\x82\x0f\xd6\xd8\xdf\xb4\x0b\x1d\x8c\x7d\x64\x49\x67\x7d\x35\xad\x2c\xb5\xab\x0c\xf1\x7e\x29\x82\x2a\x36\x92\xf6\x00\x83\xe2\xec\x80\x3f\x18\x81\xed\x9c\x7c\x64\x49\xd4\x9b\x16\x10\xb0\x12\x8f\x27\x61\x37\x7a\xfb\x9e\xe3\xad\xc3\x14\x5d\x85\x06\x5a\x14\xdf\xc5\xd7\x1c\x68\x20\x8c\xe9\x31\xd2\xdd\x23\xa0\xdd\x04\xd8\x4f\x58\x90\x17\x83\x6e\x04\x1e\xc6\x1d\xbb\x80\x34\x18\x92\xaf\xfd\x58\xd0\xd5\x47\x4a\x43\xf6\x76\x0f\x76\xf7\x17\x7c\x96\x73\x40\xdb\x57\xd1\x2f\x39\x20\x4b\x16\x00\xd3\xc8\x92\xdd\xb4\x47\xb8\xf1\xd9\xd9\xd8\x27\x08\x66\xe5\xaa\x04\x7b\x44\x4b\x62\x14\x4d\xb9\xd3\x7e\xe0\x53\x7b\x93\x51\xa5\x3b\x1c\xf6\x76\x7f\xdf\x75\x6d\x8a\x6e\xa4\xd8\x35\x30\xfd\x01\xb9\x42\x94\x9a\x30\xd0\x4c\x96\xd0\x7c\x13\x49\x50\x64\x3c\xe1\x26\x9b\x81\xa0\x23\xb2\xc4\x4e\x5d\xf6\x98\x71\xd5\x89\x27\x1f\xb9\xf1\x2d\x49\xe4\x88\xbc\xc0\x46\x61\x8c\x3a\xaf\x69\xc6\xa7\xec\x60\xc2\x68\x66\x26\x98\x58\x22\xa4\xe8\x63\xaf\x47\x2b\x81\xdc\x37\x6d\x63\x2c\xed\x5c\x90\xf1\xd5\xc2\x1d\xb9\x38\xa1\x96\xd6\x86\x95\xbd\x3f\x34\xef\x66\x4d\x16\xc0\xc2\xae\xae\xce\x7f\xa8\xb5\xb3\x06\xe1\x6f\x4c\xe1\xd3\x7d\xa2\xa6\xef\x1b\x20\x3b\xba\x09\x70\xb6\xea\x45\x4d\x3a\x14\x61\x6d\x7b\x52\x93\xe5\xe0\x6f\xab\x37\xa3\x26\x7f\x97\x25\x40\x87\xd0\x61\x36\x0b\xb8\x0d\x9a\x19\xb2\x63\x87\xda\xb1\xe2\xc9\x72\xc3\xdf\x18\x4d\x11\x56\x43\x1b\x46\x1b\x69\x7c\xf1\xd5\x59\xe0\x2d\x9a\x5b\xb7\xe7\x40\xa9\x8d\xcc\xc9\xc4\xbd\x76\xbd\x5c\xd3\xed\x8c\x01\xec\x1e\x5f\x0b\xa5\x58\x81\x12\xce\xfd\xe6\xd9\xc9\xaf\x05\xb9\x81\x74\xaf\xf5\x4c\x48\x62\xb2\xc5\x9d\x75\xb8\x40\x62\x21\x4a\x4d\x47\xb2\xb4\x83\x84\x09\xd2\x61\xd2\x04\x69\x57\xfc\x39\x3f\x10\x04\x02\xdb\xe7\x87\x75\x96\x87\x41\x3a\xcb\x35\x20\xcb\x1c\xb3\x8e\x67\xd0\x69\xd3\x11\x11\x3b\x8d\xf0\x93\xf6\xe5\xa5\xf1\x75\x37\x01\xba\x59\x7c\xd2\x25\x05\x8a\x0e\xd2\xc1\x17\x93\xc1\x11\x74\x0a\xca\x35\x51\xb8\x82\x98\xd0\x4c\x4d\x9b\x16\x80\x57\x57\x77\xaf\x2e\x9b\x3b\x0a\xfc\xb5\xa4\xb6\x5a\x11\x11\x1a\x5c\x7b\x50\xd5\x45\x82\x44\xd9\x0c\xae\x1f\xb6\x77\x01\xfb\xe3\x88\x8a\x31\x23\x2f\xed\x2f\xff\xf2\xe7\x3f\x7f\xf9\xe7\x01\x0e\x1f\x32\x1b\x04\x39\x3d\x3c\x3b\xfc\xc7\xe5\x4f\x47\x50\x50\xdb\x96\xaa\x1d\xa5\x6d\x76\x9d\xb4\xd9\x69\xca\xe6\xa3\x26\x6c\x42\x99\x48\x6b\x29\x52\x8f\x17\xc0\x90\x31\xba\xa8\xd3\xfd\x22\x54\x3e\xab\x6b\xd6\xfd\xaf\x76\xab\x6d\xc4\x1e\x33\x49\x71\x29\x93\xeb\x0e\xed\x9a\xdd\xab\xa3\x73\x1c\x32\x32\x6d\xa8\xf0\xce\x10\x2e\xa6\x32\x9b\x02\xfa\x2a\xb9\x3a\x3a\x87\x9d\x37\x80\xff\x02\x47\x14\x58\xd4\x33\x66\xaa\x42\x06\x17\x9e\x0a\x18\xaa\x50\xa4\x41\x33\xae\x0d\x4f\xe0\x77\x95\x9b\xd4\x8e\xd0\x26\x2e\xb5\xb5\x94\x96\x5d\x9d\x5b\x4a\x51\x93\xe0\x87\x1a\x4d\x6d\x13\x0f\x37\xf8\x5c\x72\xe7\x91\xaa\x75\xd1\xde\x9e\x4b\x1d\x8c\xb7\xb9\xe7\x52\xa1\xd8\xa5\x91\x8d\xba\x05\x90\xc5\x48\x08\x0e\x76\x4b\x1c\x64\xc8\x46\x52\xb1\xf9\x40\x48\x14\xd8\x48\x4b\xd8\x84\x54\x40\xf5\x9f\x77\x41\xc9\x5a\xf0\x02\x53\x2e\x7d\x87\xec\xcc\x61\xa2\x1e\xe8\x18\x08\xd5\xb7\x3b\xee\xd9\xb7\x63\x39\xcc\xae\x57\x95\x31\xb8\x66\xcb\xf0\x21\x33\x09\xba\x59\x7d\xfc\xc5\x79\x54\xfd\xf4\xe7\x43\x25\x89\xa2\x7a\x82\x8d\x88\xd9\x47\x6e\x02\xe4\x2a\xd5\x52\xa0\xb3\x37\xea\x89\xcc\x75\x84\xc9\x1d\x05\x79\xf0\x47\xe7\x32\x9d\xef\x39\x3e\x56\x34\x61\xa4\x60\x8a\xcb\x94\x40\x3d\x71\x2a\x6f\x04\x19\xb2\x31\x17\xda\xd3\x0f\xc0\xd9\x1d\xa1\xed\x71\xc3\xc0\x37\xec\xd1\xe2\x06\xe4\xa2\x06\x82\xe2\xca\x93\x12\x59\xed\x68\x37\x8b\xf9\x20\x13\x64\x84\x02\x79\xb1\x1b\x50\x58\x98\xb8\x41\xd2\x3d\x93\xee\x20\xda\x84\x3d\xbc\xfc\x77\xb7\x52\x87\x6b\x4b\xf5\x64\xd2\x2e\xf0\xbb\x0d\x4f\xad\x78\x6d\xc3\x53\x0f\xbb\xb6\xe1\xa9\x6d\x78\xea\xf6\x6b\xe3\xdc\xbb\xdb\xf0\xd4\xd6\xe8\x9a\xbf\xb6\xe1\xa9\x6d\x78\xea\x96\x6b\xe3\xe4\xd7\x36\x3c\xb5\xc2\xb5\x0d\x4f\xad\x78\x6d\xc3\x53\xdb\xf0\xd4\x36\x3c\xb5\x0d\x4f\xfd\x81\xdc\x80\xfe\xda\x86\xa7\x16\x06\xd9\x86\xa7\x22\x62\x6c\x2d\xa5\x25\xd7\x36\x3c\xb5\xe4\xda\x86\xa7\xa2\x6b\x7b\x2e\x35\x38\x97\x7c\x70\xe7\xdc\xda\x65\xed\x6b\xd6\xce\x21\x70\xc0\x13\x17\x23\x92\xa3\x5a\x9d\x13\x3e\x6a\x50\x35\xa0\x88\x30\x3f\x7c\xa9\x8d\x8b\x06\x55\x31\xa6\xa5\xf5\x50\x2d\xdb\xc3\x15\x32\xad\x82\x11\x51\x14\x02\xad\xd3\xe6\x35\x69\x6b\xab\xb6\x6a\x13\x7a\xd8\xe8\xb0\xc3\x86\x84\x76\x3a\x08\x35\x6c\xc3\x0c\xcf\x2e\xcc\xd0\x8d\x8b\xae\x03\xf7\x5c\xeb\x13\xc6\x05\xf3\xaf\x26\x8a\xe9\x89\xcc\x1a\x33\x7a\x8d\xc9\xdf\x72\xc1\xf3\x32\x87\xbe\xb1\x96\x9f\xf9\x34\x64\x0d\x84\xe6\xd8\x4e\xd0\xa3\xa7\x30\x6a\x30\xeb\x1b\xcb\x42\x59\xe7\x84\x82\xaa\xae\xcb\x24\x61\x2c\x8d\x5a\xde\x83\x62\xf6\xe5\x20\x3c\x29\xb4\xd3\x78\xd9\x4e\xde\xb4\x3b\xfb\x11\xa2\x14\x46\xf9\xf2\x8b\x46\x63\xb4\x8c\xf2\x7c\xfa\x08\x4f\x07\x62\xba\xbd\xbd\xd2\xca\x56\xe9\xe2\x94\x68\x6b\xa3\x3c\xb5\x48\x4e\x67\x11\xcd\x0e\x22\x38\x1b\x14\xbd\xd9\x98\x63\x61\x53\x22\x36\x1b\x88\xbe\xda\x41\x80\xa1\x8b\x08\x4d\x77\xd1\x99\x47\x00\x29\x7d\x9c\xa8\x4c\x87\xd6\x70\x47\xd1\x98\x4f\x11\x89\xe9\xe4\xad\xdb\x46\x60\x3e\x5d\xf4\xa5\x9b\xd7\x6d\xe9\xdd\x7a\x16\x11\x97\x0e\xbc\x5a\x5d\x7a\xb4\x3a\xf3\x66\x3d\x5a\x84\xa5\x7d\x74\x65\x03\x22\x2b\xad\x89\xcc\x05\x37\x9c\x66\xc7\x2c\xa3\xb3\x4b\x96\x48\x91\x36\x3e\x61\xe6\x50\xeb\xc2\xfe\xd1\x38\xac\xb3\xd1\xea\xf9\xc7\x13\xea\xc0\x79\x59\xea\x53\xaa\xbd\xfb\xcf\x29\x14\xd0\xd0\x04\x67\xb9\x91\x0e\x3d\xb2\x31\xc6\x20\x26\x63\x77\xb9\x88\x7f\x93\x37\x44\x8e\x0c\x13\x64\x8f\x0b\xbf\x8e\xfb\x91\x19\x58\x59\xe6\x81\xad\xed\xb7\x2f\x5f\xf8\x9b\x9f\x9f\xc9\x0d\xce\x05\xad\x1f\xdf\x03\xe2\x1e\x74\xbf\x0b\xc4\xdd\x38\x2a\xb3\xba\x1b\x04\x5d\x23\x75\x79\xf3\xb2\x82\x17\x7d\x09\xe3\x86\xdd\x46\x45\x4a\x5c\xe1\xc6\xf3\x5b\xb4\xd6\x71\xe3\xe7\x10\x33\xde\xfa\x5e\x48\xd7\xbe\x97\x35\xc5\x86\x37\x50\x6b\x7e\xa2\xf1\xe0\xad\xd6\xfc\x80\x2b\xaa\xff\xfa\x41\xd1\x84\x9d\x77\xae\x70\xf8\xed\x44\xd2\x52\xb9\xb2\xbd\xa0\x77\x84\xcd\x23\x18\x4b\x71\x37\x85\xa2\x38\xa8\x46\x1b\x95\x59\x36\x23\x65\x21\x45\xbd\xf2\x10\x83\x56\xf3\x05\x6b\x76\xb4\x65\x4f\xa9\xb4\xd4\x42\x49\x77\x00\xab\x52\x08\x2b\xcf\xab\x86\x43\xa0\x95\x6a\x94\xd5\x71\x59\x9c\xe6\x63\x3b\x7d\x7b\x98\x42\xc5\x1c\xcf\x59\xd5\x92\xa2\x1a\xd0\xfe\x7a\x24\x55\xc2\x87\xd9\x8c\x4c\x68\x16\xba\x4b\x50\x72\xcd\xb3\xcc\x0d\x33\x20\x97\xcc\x10\x33\xe1\xae\x31\x38\xc9\xa4\x18\xc3\xe4\xa8\xf0\x5d\xcd\x58\x62\x7f\x9b\x64\x8c\x8a\xb2\xc0\xe7\xd9\x63\x7d\x26\x4b\xe5\x9f\xe7\x60\x2d\xc3\x28\x5c\x13\xc1\xb3\x5e\xd4\x3b\xe9\xce\x85\xad\x1a\xd4\x6b\xe6\x6b\x0a\x6f\xb8\x66\xbd\x78\x4c\x8f\xcc\xab\xa3\xce\x19\x85\x92\x53\x9e\x62\xf7\x0b\x4f\x36\xe8\xd2\x8a\xdd\x31\xc2\x7e\x16\x52\xf4\x05\x1b\x53\xd0\x7a\xdc\x2e\xc2\x35\xc3\x71\x30\x14\x27\x52\xe8\x97\x61\xcd\x05\x59\xd4\x4a\x59\xa7\x1c\x3b\x7d\x46\x94\x23\x7b\x42\x12\x09\xc7\x6b\x29\xb8\xc1\xee\xd1\x93\xd2\x90\x54\xde\x88\xfd\x01\xa2\x12\x73\x4d\x28\x19\x32\xe3\x3b\xd9\xfa\xce\x8a\x5c\x31\x4d\x98\xa0\xc3\xcc\xae\x39\x24\x3c\x5c\x2d\x25\x10\x19\x31\x6a\x4a\xc5\xc8\x98\x1a\xb6\x54\x69\xc2\xf7\xbd\x9b\xbc\x5c\x87\x2e\xef\xa5\xd0\xac\x71\x7f\xeb\x8e\x35\xad\xbf\x7c\xd5\x4c\x46\xf0\x9c\xc9\xd2\x7c\x12\x53\xf2\x66\xc2\x93\x49\xac\x19\xf3\x9c\x69\x22\xcb\x39\x1b\xfb\xa5\xfb\xd9\xf2\x15\xda\xda\x93\xcb\xae\xa6\x5e\xe2\x25\xae\xb4\xf9\x92\xe3\xaa\xad\x2c\xb5\x1b\xf0\xf8\xec\xf2\x1f\x6f\x0e\xbf\x3f\x79\x33\x20\x27\x34\x99\xc4\xf5\xe8\x82\x50\x10\x1a\x20\x28\x26\x74\xca\x08\x25\xa5\xe0\xbf\x95\x88\x4e\x4e\xf6\xc2\x6f\xf7\x3b\xc5\x42\x6e\x78\xfa\x42\xeb\xeb\xce\x7a\x2d\x61\x23\x6d\x4c\x70\x90\x9a\x41\x77\x84\x79\xf5\xe9\xc4\x7e\x85\x86\x06\xa8\x5a\x13\x66\x85\x11\x9f\x3a\x31\xec\xc0\xa5\x69\x1a\x52\x2e\x2c\x9f\x5b\xb6\xb0\x47\x15\x1d\x42\xaa\xc4\x84\x11\xc1\x8c\x65\xeb\xe0\xb0\x92\x42\xd7\x80\x01\x4a\xcd\x74\x8f\x0c\x4b\x48\xee\x28\x14\xcf\xa9\xe2\xd9\x2c\x1e\xcc\x9e\x55\x67\xd2\x9b\x43\xb3\xf9\x29\x1d\xbf\x3b\xb9\x24\x67\xef\xae\x48\xa1\x10\x32\x00\xb2\x33\xe0\x7b\x78\xad\x21\xb3\xbf\x70\x3d\x3a\x07\xe4\x50\xcc\xf0\x4b\xdc\xe0\x5c\x13\x6b\x0b\x31\x38\x82\x9d\x0e\xe9\x41\xe1\x77\x5e\x0c\xe0\x7f\x3b\xf6\x2d\x95\x55\x32\x43\xd2\x49\xb2\x90\x3c\x86\x6a\x28\x1f\x66\x11\x35\xdd\xbb\x3f\xab\x6e\x4b\x21\x6d\xee\xdc\x12\x31\xea\xb6\x44\xc3\x52\x03\x79\xb1\xfb\x16\x17\xe3\x2c\xe6\xaa\x66\x62\xbf\xad\x6d\xd9\xd6\xb2\xec\x57\x6f\x70\xde\xd4\xc0\xec\xa4\xeb\x53\x35\x87\x8e\x7a\xa5\x54\xa7\x9f\x37\xa7\x9c\x44\x90\x71\xfb\xcb\xd3\x73\xbf\x03\x9c\x76\x93\xcf\xf5\x4c\x84\x1f\x63\x50\xa3\x47\x5e\x90\x6f\xc8\x47\xf2\x0d\x98\x57\x7f\x69\xdb\x59\xa6\xad\xe1\xd3\xde\xbd\x83\x56\xfd\xe9\x79\x47\x14\xff\xd9\x4a\x27\x3b\xa2\xa5\xaa\x91\x64\xc8\x9d\x3a\xcf\x3e\x1a\xa6\xac\x1c\x75\x2b\xb1\xd6\x9e\x3c\x76\x82\x9f\x90\xcd\x30\x76\x71\x3a\x8a\x5b\x42\x98\x07\x32\x9a\xfd\xf9\xdf\xa4\x36\x67\x4e\x0a\xd5\x1b\x4c\x54\xa3\xe5\xd4\x24\x93\xba\x18\xb3\x8a\x9a\x36\xd5\x06\xd3\x24\x95\xe0\x49\xc3\x3c\xc0\x09\x6f\x91\x89\xb1\x39\x6c\xdc\x2e\x38\x5f\x5b\xcf\xbb\x56\x6a\xce\x81\x02\x96\x8f\x53\xac\x22\x78\x99\x42\xa6\x4e\x27\xb3\xd3\x4a\xa3\x33\xe3\x0e\xa5\xcc\xf9\x6a\x82\xcb\x1a\x78\xc9\xee\xa7\x84\x0a\x4c\xe0\x1e\x31\xa5\x30\x75\x73\x38\x83\x08\x32\x4f\x58\xeb\xc5\x6b\xb5\x93\x0a\x25\x8d\x4c\x64\x8b\xb6\x41\xf5\x80\xb9\x1b\x0e\x88\x80\xce\x5f\xef\x73\x7f\x7f\x7c\xde\x23\x57\x47\xe7\xd0\x4d\xe5\xf2\xe8\xea\xbc\x6e\xa9\xec\x5c\x1d\x9d\xef\xac\x95\x14\xc4\x6b\x56\xaf\xec\x34\x1b\x0c\x52\x73\x3c\x59\xb5\xad\x9f\xd3\xa2\x7f\xcd\x66\x0d\xcf\xd4\x2e\xce\xf5\x7e\x58\xe1\x4e\x5e\x08\xc9\x9c\xd3\xe2\xc1\xa3\x29\x46\x53\xfe\x89\xaa\x28\xdc\xce\xaa\x9e\xb9\xbc\x9c\x22\x97\x53\x96\xa2\x3a\xec\x7f\xc1\x44\x5a\x48\x6e\xf5\xc5\x6d\x8d\xc5\xc3\x7f\xbd\xad\xb1\xb8\xeb\xda\xd6\x58\x6c\x6b\x2c\xb6\x35\x16\x77\x5f\xdb\x1a\x0b\x77\xad\xdf\x0d\x4a\xb6\x35\x16\x0d\xaf\xe7\x15\xe7\xdf\xd6\x58\x3c\xe8\xda\xd6\x58\x2c\x5e\xdb\x1a\x8b\x5b\xae\x6d\x8d\xc5\x2d\xd7\xb6\xc6\x62\x5b\x63\xb1\xad\xb1\xd8\x66\x8b\xdd\x3b\xd6\x66\x66\x8b\x91\x6d\x8d\x85\xbb\xb6\x35\x16\xcf\x22\x27\x86\x6c\x6b\x2c\x56\xba\xb6\x35\x16\xdb\x1a\x8b\x26\xd7\xb6\xc6\x02\xae\xad\xef\x65\x5b\x63\xe1\xaf\x6d\x8d\x05\x5e\x7f\x1c\xad\x79\x5b\x63\xb1\xad\xb1\xd8\xd6\x58\x6c\x6b\x2c\xee\x9c\xc5\xb6\xc6\xe2\x39\xd8\x93\xbe\x07\x5e\xfb\x9a\x81\xdd\x23\x99\x17\xa5\x61\xe4\xc2\x0f\x19\xb4\x48\x14\x0c\x5c\xc7\x1a\x41\xfb\x14\x9e\x44\x8a\x11\x1f\x3b\xc9\x7e\x80\x0d\xe6\xfa\xe1\x7d\xfa\x51\x53\xb7\x27\x98\xbf\x93\xf1\x9c\x37\x2b\xe4\x20\x0b\x0b\xf3\x06\xc6\x8a\x82\x3c\x76\x27\xe5\xf4\x23\x6c\x11\x9a\xcb\x12\x9b\xf2\x25\x6e\xfd\x02\x09\x31\x14\xb6\x71\x2b\x43\xba\x31\x71\xaa\x8a\x94\xf3\x0e\xac\x8d\x82\x1a\xc3\x94\x78\x45\xfe\x7b\xef\xc3\xe7\xbf\xf7\xf7\xbf\xdb\xdb\xfb\xe5\x45\xff\x3f\x7f\xfd\x7c\xef\xc3\x00\xfe\xe3\xb3\xfd\xef\xf6\x7f\xf7\x7f\x7c\xbe\xbf\xbf\xb7\xf7\xcb\x8f\x6f\x7f\xb8\x3a\x3f\xf9\x95\xef\xff\xfe\x8b\x28\xf3\x6b\xfc\xeb\xf7\xbd\x5f\xd8\xc9\xaf\x2b\x0e\xb2\xbf\xff\xdd\x7f\x34\x9e\x72\x6b\x95\xb8\x3b\x85\xb8\x23\x75\xf8\x51\x94\x61\x17\x1d\xee\x68\x2f\x5e\xb8\xd1\xe6\x77\xa3\x3b\xb0\xee\xda\x8d\x5e\x9a\x82\x9a\x17\xc6\xe1\x9a\xc8\x9c\x1b\xab\x1c\x5a\x7d\x90\xc6\x79\x61\xdc\xd4\x8c\x52\x27\x07\x20\xa1\x92\x1a\xec\x11\x1a\x72\xaa\xa2\x3c\x6d\xe9\x35\x3f\xd7\x7b\x35\xf8\x2b\x60\x3f\xf7\x53\x36\xe2\x82\xb9\x38\xd8\x56\x36\xdc\x7f\x6d\x65\xc3\x73\x94\x0d\x9a\x25\xa5\xe2\x66\x76\x24\x85\x61\x1f\x1b\x79\x58\xea\xa2\xe1\xb2\x3e\x20\xc1\x7d\xe6\xaa\x28\xdd\x77\x44\x16\x98\x40\x39\x57\xce\x1a\x52\x70\x55\x29\xc0\xc0\xc4\x2a\x19\x66\xd0\xfa\x03\xbb\x07\x72\x22\xe7\x1f\xe2\xed\x39\x34\x33\x7f\x2b\xf9\x94\x66\xd6\xda\xad\x7e\x71\x0e\x16\x4c\xfc\xa3\x55\xf7\xbc\xa1\xfa\xba\xda\xf0\xac\x6f\x75\xe8\x30\xe7\x03\xff\x4a\xf0\x11\xfb\x68\x9e\xa2\x96\x06\x0a\xd2\xb9\xe2\x53\x9e\xb1\x31\x3b\xd1\x09\xcd\x40\xae\x75\x73\x56\x1c\xde\x32\x3a\x2c\xbc\x92\x99\x26\x37\x13\x06\xdd\x95\xa9\x77\x01\x40\x85\xcb\x98\x72\x41\x72\xbb\x44\x85\xff\xb1\x46\x5f\x82\x15\xff\x05\x55\x76\x81\x83\xcf\x00\x4c\xe4\xa1\x94\x99\x4b\x1d\xce\x66\xd5\xf8\x2e\xf7\x5e\xc8\x7f\x08\x76\xf3\x0f\x3b\x9a\x26\xa3\x8c\x8e\x83\xab\x40\x33\xb3\xe0\xed\xab\x86\xbe\xf5\x05\x20\x2f\xb7\x64\x84\x66\x37\x74\xa6\x2b\xc7\x49\xdc\x07\xfc\x15\x79\xb9\x0f\xec\x4c\x35\x09\x63\xa4\xe4\x8b\x7d\x88\x25\x1e\x1d\x9e\xff\xe3\xf2\xef\x97\xff\x38\x3c\x7e\x7b\x7a\xd6\xee\xa4\xb0\xef\xce\xa8\x68\x34\x46\x42\x0b\x3a\xe4\x19\x6f\x73\x40\x2c\x64\x9b\xc4\x83\xc2\x11\x9c\xa6\x07\xa9\x92\x05\xd2\xc9\xfb\xa8\xaa\x93\xb2\x6e\x05\xc7\x95\xc9\xb0\x3c\xa3\xfa\x80\x63\x45\x85\xa9\x9c\x35\x15\xc9\x55\x29\xac\x61\xfd\xc4\x13\xf3\x69\xda\x5d\x52\xfe\x61\x9a\xb2\xb4\x46\xbd\x67\x97\x04\x78\xe4\x5f\x6e\x56\xd5\x68\x93\xf3\x77\x97\xa7\xff\xcf\x1c\x1b\xce\x8a\x76\x39\x4f\xdd\xd4\x85\x29\x59\x74\xb6\xba\x17\xae\xee\x68\xbb\xbe\x1b\xb1\xbe\xe1\xac\xea\x26\xd2\x7e\x51\x8a\x3a\x8c\x47\x35\x3e\xc9\x65\xca\x06\xe4\x3c\x78\xe9\xeb\xdf\x46\xe5\xbd\x54\x31\x62\x6f\x11\x86\xd3\x2c\x9b\xc5\x0a\x92\x91\x58\x4c\x53\xab\x4c\x8e\xe5\xf0\x88\x66\xba\xa5\x30\x6d\x73\x32\xd9\x43\xf8\xad\x35\x26\x3b\xa1\x66\x18\x8d\xa4\x4c\x48\xe3\xb4\x52\x3b\x4b\x28\xd6\x56\x32\x21\x68\xb9\x46\x69\x51\xb5\xd3\x45\xa3\xa3\xdf\x1f\x4c\x5c\x7b\x5a\x9d\x87\x91\xd1\xcb\x5b\x6a\x36\xaf\xdd\xba\x83\xa9\xb2\x65\xed\xe8\x8a\xd1\x14\x6a\xd2\x0a\x6a\x26\x98\xd5\x90\x53\x7d\xcd\x52\xfc\xc0\xe9\x35\xc1\xcd\x6f\x47\x0c\x8f\xba\xb2\xf3\xf6\x3e\x7d\xd0\x67\x30\xd7\x02\x62\x01\xcd\x40\x37\x48\x17\x5b\xc0\xbe\xd3\x3b\x91\xcd\x2e\xa4\x34\xaf\x43\x2d\x56\x27\x0b\xf8\xb3\xd3\x14\xeb\x6e\x58\x50\xa5\x20\x09\x21\xed\x03\x31\x81\xa5\xe3\x32\xb0\xe3\x6a\xc1\xd6\xcc\xd0\xaa\x14\x87\xfa\x07\x25\xcb\xc6\x27\xc0\x82\xa2\xf5\xc3\xe9\x31\xec\xe3\xd2\x45\xd9\x84\x51\x33\xa8\x3a\x5d\x04\x0c\x0a\x3a\xed\x7b\x17\x27\x8c\x39\xb2\x0a\xe9\x90\xb7\x74\x46\x68\xa6\xa5\x57\x8e\xb9\x58\x6a\x40\x39\xeb\xcc\x7e\x3d\x94\x66\xb2\x60\x96\x59\x76\x5e\xfc\x5d\x2f\x0a\xba\x55\x08\x46\x5c\x2c\xfc\xdc\xd0\x6b\xa6\x49\xa1\x58\xc2\x52\x26\x92\x96\xab\xb6\xee\x50\x13\xac\xfc\x99\x14\x76\x5b\x74\xb2\xf6\xa7\x21\xc6\x08\x8e\xb0\xfa\x4a\x43\xb4\xd2\xd9\x1d\x14\x62\x96\xb0\x29\x4a\xcd\x14\x06\x58\x55\xc9\x70\x21\x7e\x2c\x87\x2c\x63\x06\x8d\x21\xc0\x9d\xa0\x06\x0d\x69\x9e\xd3\x31\x23\xd4\x04\x46\x31\x92\x30\xa1\xad\xb8\x41\xd7\x9b\x21\xa9\x64\x55\x01\x25\xd5\xe4\xfd\xe9\x31\x79\x41\xf6\xec\xb3\xf6\x61\xf9\x47\x94\x67\x10\xce\x34\x54\xcd\xcf\x91\x8f\xfc\x10\x30\x25\xe0\x3d\x22\x15\x6e\xd1\x1e\x11\x92\xe8\x32\x99\xf8\x39\x59\x8b\xcb\x1b\x6c\x2e\x9f\x0f\x9c\xfa\xcf\x90\x55\x5b\x0b\x98\xf7\x9a\xa9\xce\xe4\xcb\xfb\x06\xf2\x25\x56\x21\x2c\xcf\xd5\xa9\x87\x8c\x95\x33\x43\x53\x6a\xa8\x93\x3b\x55\xd5\xf5\x73\x5c\xd2\x75\x4b\x1f\xcd\xde\x70\x51\x7e\xc4\x8c\x95\xee\x8c\xfc\xcb\x13\x18\x96\x24\x9e\x68\xb0\x68\xb4\x28\x32\x8e\xf5\xce\x73\x19\x54\xa7\xb5\xa5\xee\xdd\xa2\x22\xc1\x36\xa7\x59\x26\xad\x78\xb3\x27\x3b\x15\xa9\xcc\x17\x1e\x66\x15\x28\x56\x03\xba\x1b\x90\x67\xc9\x3c\x6b\x77\x47\x64\x6c\xca\x5a\x60\xba\xcc\xe3\xf2\xd9\xd1\xac\x2e\xe6\x17\x14\x86\x27\x19\x1d\xb2\x0c\x4f\x16\x64\x20\xbd\xc8\x40\xeb\xce\x42\x54\x32\xeb\xae\x06\xe3\x42\x66\x0c\xd3\x7a\x3c\x21\xec\xf0\x4f\x82\x0e\x30\x48\x57\x74\x00\x43\xa6\x46\x07\x30\xc9\x9e\x02\x1d\xca\x16\x07\x2d\x99\xa7\x83\x3d\xb5\xeb\x74\x80\xa3\x73\xd3\xe9\xa0\x59\x92\xc8\xbc\x38\x57\xd2\x9a\x5c\x9d\x1d\x2d\x6e\xd8\x2a\x56\x84\x36\xf9\x92\x24\x1c\x10\xe5\xf5\x9b\xa9\x8a\x12\xfa\xa8\x41\x19\xef\xb3\xfa\xfe\x7f\x71\x87\x64\x2b\x7a\xe6\xcf\x21\x3f\x4a\x2d\xac\x64\x7f\xe9\xbe\x78\xe2\x70\x02\x2d\xbc\x64\x9d\x1c\x26\x32\xa1\x19\x40\xee\xb5\xe3\x18\x32\xcf\x35\xf3\x03\x47\x59\x98\x10\x5a\x82\xcf\x7c\xdc\x1f\xd0\xd7\xe0\x13\xe7\xfb\x12\x32\x65\x51\x08\x12\xd3\x47\xaf\x30\x5b\x0f\xee\xf3\x09\xa0\xf6\x54\xf7\xd1\xc0\xb4\xf6\x6b\x23\x1d\x02\xcc\xdb\x00\xe4\x67\x27\xc8\x44\xca\xc5\x18\x3c\x3a\x3d\xa2\x58\x86\xa9\xa3\x6e\x0f\x5f\xa3\xf9\xb5\x0b\x1c\xed\x07\xf5\xec\xec\x1f\x0d\x9a\x10\x97\xc2\x8d\x0c\x4e\x0e\xaf\xdf\x8c\x50\x5a\x72\x4d\x76\xde\x78\x02\xb4\x40\x3e\xdb\xc4\x03\x62\x07\xdf\x30\xac\x26\xfa\xd8\xae\xb9\x48\x5d\x96\x65\x8d\x58\x01\xa3\x16\xb5\x50\xc8\xdf\xe5\x69\x2c\x1a\x5e\x91\x0f\x82\x04\x62\x91\x7e\x63\xf6\xb8\x40\x85\xd5\xbb\x97\xfa\x77\xbb\xfc\xc2\x43\xe6\x87\x79\x2f\x60\xed\xed\x73\xfb\xd6\xec\x5d\xbc\xcf\xbf\xcb\xce\x3a\xd7\xf5\x86\x8b\x54\xde\xe8\xae\x6d\x88\x9f\x71\x58\xaf\x50\x27\x96\xad\x0d\x17\x63\x1d\xdb\x11\x34\xcb\x6a\x6e\xd8\x65\x86\x84\x5f\xe1\x80\x48\xbc\xa8\xc0\xcf\x65\x87\x6f\x8d\x80\x07\x5c\xe3\x5c\xd3\x23\x65\x5f\xc5\x70\x9a\x5d\x16\xcd\xa1\xd9\xc8\x3c\x1b\xfc\xf0\xf6\xf2\xb0\x3e\xb4\x95\x67\x37\x80\x78\x6d\x89\x6d\xbf\x27\x34\xcd\xb9\xd6\xe0\x06\x62\xc3\x89\x94\xd7\x64\xcf\xa7\x6d\x8c\xb9\x99\x94\xc3\x41\x22\xf3\x28\x83\xa3\xaf\xf9\x58\x1f\x38\xa6\xed\xdb\xd9\xef\x13\x2e\xb2\x90\x8d\x02\x66\xa4\x30\xda\xbb\x31\xe0\x21\x49\x98\x05\xac\xad\xc3\xeb\x74\x51\xe6\xc5\x69\x22\x42\x27\x67\xd9\xfa\x01\x67\x16\x97\xe7\xac\x25\x76\xc6\x3d\x4b\x04\xef\xee\x4a\x53\xe2\x32\xaa\xa5\x74\x44\xed\x6d\xed\x44\x72\xda\x41\xc2\x74\x77\xa8\x3c\x7f\xab\xc6\x24\x29\xc3\xea\x09\x06\x59\x27\xf4\xd6\xe4\x26\xf0\xca\xee\x42\x11\x9e\xfb\xe9\x6e\xac\xd1\x42\xd4\x07\xcb\x3c\xac\x3d\x90\x15\x13\xda\x47\x23\xd9\x8a\x24\x90\x61\x5e\x07\x98\x48\x21\x5d\x72\xba\x3d\x05\xa5\x00\x96\x06\x6b\x01\x03\x41\xb0\x26\x4e\xc6\x46\x53\x3d\xaa\xe2\x83\x71\x0c\x09\x8a\x78\x10\x04\xa0\x9a\xc3\x0d\x37\x13\x8f\x70\x5f\x0b\x38\xc1\x4c\x14\xd3\x10\x3d\x10\x84\x29\x25\x95\x4b\x84\xf1\x5e\x5b\x18\x09\x44\x31\x64\xd2\x58\x26\xa1\xf6\xaf\x5d\x1d\x87\x28\x2b\x08\x5c\xc8\x13\xb3\xdc\xc4\x46\x23\x96\x80\xa6\x14\x13\x18\xc5\xee\x5e\x85\xdc\xe7\xb2\xbb\x2d\x83\x39\x08\xdd\x9c\x7f\xb4\x4f\x89\x7f\x15\x07\x43\x1d\x62\xde\xf2\xaf\xf7\x07\x84\x9c\x8a\x90\x39\xd9\xb3\xab\x18\xdf\xe9\x53\x7e\x8c\x7d\xc5\x18\x7f\x19\x5e\x20\xf6\x3b\x59\xf5\x4e\x95\x1d\x70\x7c\x1b\x67\x30\x89\x1d\xc2\x9d\x8a\x03\x70\x0c\xbb\x41\xed\xd2\xfb\x43\xbc\x8d\xa3\xd8\xde\xf2\x58\xce\xe2\xa7\x71\xd2\x93\xb6\x72\xce\x95\xc4\x77\x04\x8a\x7b\x19\x8d\x16\xa9\xdf\x21\xdc\x74\x2e\x53\x84\xc4\x08\x25\xfd\xd0\xcb\x02\x20\x3a\xf8\xbf\xbd\x82\x55\x29\x69\x42\x62\x56\x76\x8c\x95\xe1\x30\x41\x53\x62\x75\xe5\xcc\xdb\xf6\x79\x91\x31\xa8\x9e\x8b\x46\xae\x0a\x03\x23\x14\xdd\x5e\x98\x48\x05\xc4\xeb\x10\x3a\x7a\xe4\x5f\xb0\x29\x43\x02\xa0\x07\x0f\x38\x0f\x3f\x47\x13\x8f\x6b\x0f\xa9\x0d\x95\x6d\x46\x7a\xd7\x01\x49\xf9\x68\xc4\x7c\xa2\xa1\x35\xfd\xa8\xa2\xb9\x15\xf1\x9a\x38\x12\x0c\xd9\x98\x63\x26\x5b\x10\x6c\xbb\xba\xaa\x80\xef\xa1\x30\xe4\x86\xe4\x7c\x3c\x41\x46\x21\x14\x2a\x23\x89\x0f\xa9\x65\x92\xa6\x04\x78\x5b\x2a\x72\x43\x55\x6e\xcf\x0d\x9a\x4c\x20\x3e\x47\x05\x49\x4b\x05\x30\x91\x86\xd1\x74\xd6\xd7\x86\x1a\xab\xea\x32\xe5\x2c\x42\x3f\xff\x2d\x94\xf0\x9d\xd7\x16\x4a\xf8\xf6\x6b\x0b\x25\xbc\x85\x12\xde\x42\x09\xdf\x7d\x6d\xa1\x84\xdd\xb5\xfe\x6a\x5f\xb2\x85\x12\x6e\x78\x3d\x2f\x38\x9b\x2d\x94\xf0\x83\xae\x2d\x94\xf0\xe2\xb5\x85\x12\xbe\xe5\xda\x42\x09\xdf\x72\x6d\xa1\x84\xb7\x50\xc2\x5b\x28\xe1\x2d\x28\xda\xbd\x63\x6d\x26\x28\x1a\xd9\x42\x09\xbb\x6b\x0b\x25\xfc\x2c\xa0\x9f\xc8\x16\x4a\x78\xa5\x6b\x0b\x25\xbc\x85\x12\x6e\x72\x6d\xa1\x84\xe1\xda\xfa\x5e\xb6\x50\xc2\xfe\xda\x42\x09\xe3\xf5\xc7\xd1\x9a\xb7\x50\xc2\x5b\x28\xe1\x2d\x94\xf0\x16\x4a\xf8\xce\x59\x6c\xa1\x84\x9f\x83\x3d\xa9\x4d\xca\x1b\x21\x9f\xad\x02\x54\xe1\x32\x43\xa2\xd2\xd6\x61\x39\x1a\x31\x05\x92\x0b\x9e\xbc\x90\x85\x10\x00\xad\x82\x2c\x73\x79\x06\x00\x8b\xa7\x18\x4d\x5d\xc2\xfb\x2d\x3f\x77\xb5\xb4\x80\x50\x56\x65\x6a\x9e\xbc\x7b\xdd\x0d\x2a\x46\xbb\x1c\x45\x98\xf3\x3b\x91\xb4\xcf\x55\xab\x08\xbe\xac\x00\xc3\xd1\x3d\xc9\xa4\x76\x19\xa6\x40\xac\x64\x42\x85\x60\xde\x78\xe4\x06\x9c\x32\x43\xc6\x04\x91\x05\x13\x28\xbf\x29\xd1\x5c\x8c\x33\x46\xa8\x31\x34\x99\x0c\xec\x93\x84\x27\x76\x95\x0d\xea\x3e\xd1\x46\x31\x9a\xfb\xbc\xd8\x9c\x72\x1c\x8a\xd0\x44\x49\xad\x49\x5e\x66\x86\x17\x61\x30\xa2\x19\x24\xb4\xe3\x41\x15\x88\x01\xe9\x25\x55\x0a\x69\xaf\x7a\x9a\x9b\x96\x8c\x41\x81\xc0\x74\xed\x01\x0e\x6a\x5e\x98\x59\xc8\xa3\x63\x64\xc4\x95\x36\x24\xc9\x38\x9c\xd6\xf0\x44\xac\x1d\x84\xf1\x7a\xfe\xac\x16\x6e\xa6\xda\x4d\x55\xa4\xa0\xb6\x16\x46\x63\x56\x5a\x35\xa0\x1b\x2a\xe5\xda\xa9\xf9\xba\x47\xa8\x87\xbc\x41\x42\xfb\x99\x02\xa9\xfd\xc9\x82\xa3\xbb\x8f\xa2\xe1\x22\x9c\xbc\x2a\x6d\xaf\x62\x74\x48\x31\xf6\xcc\xd9\xab\x65\x53\x57\x0a\x05\xa4\xbb\x2c\x6c\x03\x58\x00\xc1\xa6\x96\x07\x58\xc2\xec\xf9\x4a\x6f\xe1\xfa\x4f\xce\xf4\xd1\xa1\xf8\x96\x69\x4d\xc7\xec\xbc\x61\xd4\xe2\x36\x8b\x0c\x02\x17\xd5\xc2\x00\x2b\x64\x58\x9e\x16\x3e\xa9\xd2\x9c\xea\x6a\x10\xc9\x71\x4e\x41\xf9\xb9\x51\xdc\x18\x06\x8b\x0a\xe0\x48\x10\xf8\x9c\x2f\x40\xdd\x9d\x4b\x96\x7a\xeb\x07\xa9\x7e\x6c\x85\xba\x48\x31\x75\x69\xc8\xc8\x50\x71\x36\x22\x23\x0e\xf9\x50\x90\xa1\xd4\x43\xb8\x0f\x8a\x1e\x05\xad\xad\xbd\x2b\x85\xd7\x65\xfd\xbc\x06\xe4\x67\x37\x31\xa3\x4a\x91\xd0\x08\x04\x10\x4a\xb4\xf8\x88\x8c\x21\xc3\xc9\x69\x8b\x5f\xbd\xf8\xcf\xbf\x90\xe1\xcc\x1e\x69\xa0\x59\x19\x69\x68\x16\x5e\x32\x63\x62\x6c\x69\x85\xdb\xb3\x5e\x64\x14\x28\x00\x28\xe6\x38\xf1\x97\x5f\x5c\x0f\xeb\x67\xec\x41\xca\xa6\x07\x11\xfd\xfa\x99\x1c\x2f\xc3\x85\x6f\x9e\x32\xd9\xd0\x24\x5a\xc2\x66\x32\xe3\xc9\xac\x35\xa3\x79\xdc\x19\x32\x91\x37\xa8\xeb\x2f\xe1\x9e\x2a\x07\xb2\x90\x45\x99\xa1\x07\xfb\x75\x28\xcf\x2b\x35\x5b\xac\xc1\x59\xba\x2f\xc0\xe7\xea\x86\x98\xc7\x8b\xc5\xc4\x36\xff\x48\xe9\x72\xbb\x9d\x57\x30\xc0\xcf\x80\x21\xf4\x9a\x66\xd9\x90\x26\xd7\x57\xf2\x8d\x1c\xeb\x77\xe2\x44\x29\xa9\xea\x73\xc9\xa8\x95\x96\x93\x52\x5c\x23\x72\x75\x28\x11\x96\x63\xab\x5a\x15\xa5\xf1\x89\xc4\xcb\x5e\x18\x0b\x4e\xbd\x10\xf6\x66\x50\x35\x0a\xfb\xc8\x2b\x5b\xc7\x95\x4a\x20\x47\xc6\xe3\xeb\x98\xd9\xbe\x78\xf1\xd5\xd7\xc8\xba\x44\x2a\xf2\xf5\x0b\x48\x7e\xd4\x3d\xdc\xc4\x20\xdb\xec\x41\x91\xd3\x2c\xb3\x66\x43\xcc\x94\x96\xd0\xcb\x98\xf0\x93\xf3\xa0\x69\xcf\x6e\x2b\xab\x52\x57\x57\x7f\x07\x3d\x8a\x1b\xcd\xb2\x51\x0f\xab\x02\x82\x59\xb3\x0b\x07\xc3\xae\x93\x3e\x50\x9a\xb1\x01\x0a\xd0\x54\x66\x65\xce\x8e\xd9\x94\x77\xd1\xbc\xa2\x36\x9a\x37\xf5\x33\xae\xa1\x00\x63\x98\xc9\xe4\x9a\xa4\xee\xcb\x28\x8d\x65\x1e\x42\xb5\x39\x15\x9a\x26\xf4\xb4\x48\xe4\xb9\xf5\xfd\x6b\x29\x3c\x39\x2d\x8a\x90\xa3\xaf\xe8\x4d\x8d\x18\xb0\x27\xa1\xde\xb7\x25\x9e\x42\x6b\x37\x73\x5b\x27\x73\xdf\xbd\x91\x95\x9b\x8d\x87\x68\x9c\xc2\xd2\xde\x47\x5d\xcd\xbe\xb9\x63\xb2\xc6\x10\xd5\x80\x7e\x37\x14\xf0\xdf\x98\x9e\xbd\x50\x95\x14\x0a\x5b\x02\x63\xa0\x02\x60\xd9\x07\x44\x72\x73\x87\x6b\x07\xde\xcd\x76\xf9\x4b\x35\xba\x88\xe0\x55\xce\xa9\x71\x0a\xa1\x77\x5f\x53\x52\x30\xa5\xb9\xb6\xe7\xf2\x4f\xb0\xa1\x8e\x32\xca\xf3\xc8\x05\xb8\x1e\x22\xe0\xe6\x06\xe4\xcb\xf6\x92\xf2\x5c\xa6\x6e\x40\x10\x85\x88\xfa\xb9\x44\xad\xad\x6b\xb5\x1d\x1e\xa8\xeb\x16\x95\x3f\x55\xd4\xac\x4b\x4a\xfb\x49\x10\x95\x78\xd7\x73\x12\x90\xf0\x7e\x4f\x55\x3e\x86\xc9\x77\x24\x06\x40\x30\xba\xc5\xad\x4b\xc2\x9a\xf1\x88\x1b\x25\x52\xe9\x9d\x1d\x38\x20\x18\x52\xb7\x7b\xc2\xfd\x94\xec\xbe\xda\x5d\xab\x90\x44\x12\x29\x59\xd0\x71\xab\x1e\x06\x73\x94\x9a\x1f\x36\x2e\xf4\xb6\x66\x10\x7c\x1f\x60\x87\xe0\x2e\x96\x56\x40\x14\x00\x33\x82\xd1\x51\x4f\x60\x67\x20\x60\x3d\xe4\x0d\x9d\x11\xaa\x64\x29\x52\xe7\x5f\x0a\x0e\xbe\xb7\x73\x0f\x3e\x93\x82\x79\xc7\xf9\x7c\x9d\x38\x78\xf4\xb9\x20\x2f\x07\x2f\x5f\x3c\x97\x93\x0a\xde\x70\xee\xa4\x3a\x0b\x27\x15\xca\xa7\xb5\xbe\xab\x47\x3b\xee\xe8\x7d\xdf\x3a\x17\x4b\x05\x66\xcc\x3d\x58\x2b\x7c\x74\xa3\xb8\x61\x51\x6f\xa3\x3d\x30\x5c\xac\x7d\x18\x55\x45\xef\x77\x88\xe1\xdd\x4d\x19\xba\x2e\x87\x8f\x28\xb7\x9c\x80\x82\xed\xb6\xcc\xc3\xa5\xef\x10\x61\x31\xa1\x76\x76\xc8\x1e\xde\xb9\x8b\x95\x81\xfb\x6b\x65\x2d\x47\xb4\x93\x8f\x45\x0b\x8c\xb9\x1a\xe1\x4e\x3e\x16\x14\x7c\x70\x45\x87\x14\xfc\x9e\x4d\xe8\x94\x41\x45\x24\xcf\xa8\xca\x20\xe6\x78\x89\x73\x27\xc3\xd2\x10\x26\xa6\x5c\x49\x01\x09\x3e\x53\xaa\x38\xa0\x52\x28\x06\x95\xd5\xd6\x16\xfd\x8f\xbd\x9f\x0e\x2f\x20\xa1\x61\xdf\x95\x84\xbb\x59\x96\xda\xc3\x47\xc4\x33\x89\x86\xbb\x77\xf9\xfc\x3c\x2c\x0d\x41\xe6\xfa\x79\xd9\xe7\xe4\xa5\x29\x11\x10\xff\x63\x92\x95\x9a\x4f\xd7\x25\x49\x5c\xa9\xea\x31\x6f\xb4\xce\x73\x65\xb3\x15\xa1\x16\x2a\x60\xc1\xb5\x0e\x47\xcb\x3d\x08\xac\xbb\x3a\x60\x56\xc5\x31\x70\xe7\x7a\x72\xb5\xec\x98\x8b\xe7\x21\xcb\x16\x54\x08\xc0\x6d\x58\xaf\x13\x4a\xc8\x94\x3d\x1c\xf5\xa2\x9e\xde\xe3\x86\xc0\x98\x79\x54\x0e\xa8\x93\x09\x4b\x4b\x80\x57\xe1\x1a\xc1\x01\xad\xf9\x40\x2b\x18\x2b\x01\xfd\x19\x4e\x47\xa1\x36\x58\xf4\xc1\x39\x88\x34\xf7\xbf\x57\xbe\x92\xd8\x7f\xa0\xe7\x46\x04\xa3\xd4\x8e\xd5\x23\x54\xeb\x32\xc7\x2d\x81\xf0\xdb\x23\x6e\x74\xe8\xad\xe7\xb5\x63\xbb\x31\x1e\x58\x9d\xd5\x82\xbe\x97\x2c\x03\xe6\x6a\x41\xe3\xdd\xb3\x68\x1c\x24\xb4\xf6\x7f\x39\x86\x73\x09\x13\x10\x6d\x0b\x49\xa1\x12\xbc\xa4\x23\x0e\xed\x2b\xa8\xa3\xf7\xe5\x92\x5f\xa2\xea\x80\x77\x00\x3c\x03\x1d\xb2\x4c\xcf\x0f\x34\xac\x16\xc5\xc1\xfa\x39\xc2\xb7\xec\x0e\x48\xb5\xe6\x63\x01\x7d\xc3\xec\x68\x0f\xec\x10\xd6\xd8\x66\xea\xa2\xfb\x5f\x63\xa9\x56\xcb\xc2\xca\x69\xd1\x77\x56\xaf\x91\x39\x4f\x1e\x30\x92\x9c\x32\x35\x61\xf4\x81\x06\xdf\x5c\x5c\xcc\x8d\x51\xb5\x8e\xd1\xae\xca\xd1\xed\x1b\xff\x10\xbb\xbf\x64\x02\xd9\xf6\xe8\xa7\xf7\xd9\x4f\x14\x58\x04\x1b\x53\x8e\xf9\x94\x09\x0f\xfc\x77\x94\xd1\xd0\x7c\xcc\x43\x25\x39\xf0\xc1\xd2\xc8\x10\xf9\xb0\xe6\x54\x85\x5e\x06\x81\x52\xe7\x74\x8d\xc7\x89\x6e\x71\xad\xcb\x32\x0f\xd6\xbf\xca\x9d\x10\x7c\xc0\xd6\x1f\xbd\xea\x95\x72\x6f\xa0\xd5\xe0\x71\x48\x02\x21\xb2\xd0\x15\x14\x03\x11\xf7\x3f\xc2\xc5\xb5\x2d\x2f\x2e\x1b\xc6\xed\xb9\x80\xe3\x54\x11\x36\xb3\xca\xeb\x8c\x40\x73\x89\xd3\x51\xfd\x49\xbc\x06\x7d\x09\xd9\xd8\xb0\x87\xab\x43\xe5\x5c\xa6\x97\x05\x4b\x7a\x24\x2c\x65\xdc\xb9\xcd\x39\x6d\x30\xb5\x25\xc2\x6f\xc4\xe3\x48\x29\xa6\x0b\x89\x08\x9c\xf1\x63\xe3\x06\xa1\xdc\xd4\x22\xf6\xd8\x88\x00\x2c\xb4\x0a\x2b\xe1\xdf\x4c\xc9\xa5\x82\x60\xcc\xcd\xe0\xfa\x6b\x90\x02\x4c\x4c\xa8\x48\x50\x00\x1f\x5c\xb3\x42\x1f\x68\x3e\xc6\x4d\xff\x97\xaf\xbf\x06\x09\xe0\x49\x72\x70\x71\x72\x78\xfc\xf6\x64\x90\xa7\x4b\x8c\x38\x8f\xf5\x05\x61\xb1\x1f\xc3\x46\x22\xd3\x97\x83\x97\x5f\x63\xdc\x9e\x6b\x04\x20\x89\xe0\xbf\xb0\x26\x6d\x11\xfb\xeb\x5c\xa6\x81\x6e\x2e\x6f\xeb\x81\xd1\xc8\xb5\xca\xa0\x27\xd3\x77\xb4\x61\x46\x6d\xdb\x2c\xda\x56\x99\xb3\x1d\x66\xcb\x16\x8a\x59\xf5\x86\x4b\xd1\x24\xce\x5c\xb7\xef\xe6\x86\xf2\xce\x7b\xf7\x97\x15\xc4\xfe\x69\x62\x6c\x65\xb3\x46\x79\x9d\xc9\x1b\xc8\x0d\xe1\x52\x71\x33\x1b\x00\x54\x8f\x1c\x91\x33\x36\x65\xaa\xe7\x47\x7d\x63\x6f\x3a\x0f\xf7\xc4\x06\xc4\xb2\x3b\xa2\xae\x38\xb7\x6d\xd4\x1e\x19\xc7\x69\x08\x67\x52\x9c\x87\xd9\x85\x61\xdc\xce\xeb\x43\xc6\xe4\xa7\x50\xce\x3c\x19\x5a\xac\x03\xa2\x1f\xbb\x17\x70\x8d\x92\x7f\xa2\x8a\xcb\x52\x13\xf4\x89\xc7\x98\x83\x18\x47\x0f\x24\x02\xd5\xcc\x79\xb9\xc2\x20\x21\x3d\xde\x3b\xba\x02\x7d\x0e\xc3\x79\x73\xb4\xfc\x48\xe3\xc6\x2e\xfa\xd4\x3f\x4a\xf9\x7c\x9f\x05\xb8\x42\x3c\xcc\x96\x9e\x5f\xfe\x44\xd6\xf1\x44\x61\x18\x3f\x0f\x38\x1b\xac\xc2\x8f\xa3\x4c\xf8\xd8\x67\x95\xc1\xfb\xa3\xee\x1e\x7d\x1a\x98\xad\xc1\x92\x36\xd9\xf1\x4d\x93\x1b\x8b\xf9\xf7\x6b\xc1\x16\x75\x50\xb7\x18\x17\xce\xa7\x55\x57\x5b\x70\x07\xf9\x04\x0e\xc1\x7e\xa2\xb8\xe1\x09\xcd\x76\xe0\x08\xf3\x5f\x59\xdb\xdb\x30\x15\x7f\xab\x18\x31\x37\x12\x9f\x42\x33\x72\xcd\x66\x37\x52\xa5\x5e\xbf\xf0\x4f\xac\xd6\x42\x1b\xff\x48\xce\x9c\x2c\x40\x48\x2e\x95\x33\x45\x86\xcc\xbb\x11\xe6\x6e\x9e\x0d\xc8\xa1\x98\x39\x1f\xac\x88\x2b\x2d\xbc\x1a\x31\x9c\xa1\x8e\x83\x5a\x60\x8d\x49\xdc\x79\xe8\x9f\x46\xb1\x06\xe6\x36\x13\xdb\x2a\x90\x61\x17\x78\xed\xc5\xdb\xd8\x52\xb9\x54\x6f\xd8\x1d\x0a\x13\xd5\xa5\xff\xfa\x93\x48\x0b\xab\x9f\x71\xc1\xb4\xfe\xc1\x2e\x65\x1b\x75\xbb\xce\x1d\x14\xd4\x2a\x37\x36\xc8\xc9\x2a\xaf\x8a\xd9\x2d\x45\x7d\xcf\x77\x4b\xa1\x70\xe7\x80\x1c\xc2\x07\x90\x18\x68\x35\x47\x28\x25\xb0\x83\x59\x8b\x77\xae\xb7\x21\xde\x71\x78\x76\xec\x13\xb8\x50\xe9\xd0\x75\xcc\x46\x54\xf9\xeb\x33\x01\x4d\xd5\xa5\x11\xb1\xdf\x4a\x0a\xad\xaa\x76\xae\x54\xc9\x76\x9a\xa9\x7a\x88\x06\x7a\xf0\xe7\xaf\x5f\x80\xb6\x17\x9e\x07\x62\xff\x81\x59\xd1\x4d\x03\x85\x8d\x42\x84\xf3\xd1\xd1\x8b\x98\x1f\x3c\xc1\xbd\xdd\xe4\x3c\x77\x50\xe0\x04\xcb\x14\x68\xde\xc8\xbe\x6c\x14\x10\x6c\x1e\x0a\xec\x57\xd3\xbd\x7a\x78\x27\x84\x36\x51\xbc\xda\x73\xbb\x70\xf5\x55\xa3\xe1\xaa\x60\xa3\xe5\xea\x39\x51\xd0\x1e\xf1\x76\xdd\xc7\x90\x13\x04\x82\x0b\x5c\x23\x20\x1e\x67\x45\x8b\xfc\xa7\x06\xf2\x06\xd2\x7d\x5b\xab\x8a\xbb\x17\x38\x10\x29\x2a\xdd\x70\x21\x8d\x33\xb8\xb9\x41\xef\x70\x5a\xe1\x21\xe4\xc4\xf7\xc8\x3b\xf1\x1a\x93\x04\x7b\xa8\x28\xd6\xea\xb9\xf1\xa6\x4e\x6b\x25\x0e\xfe\xe4\xde\xbd\x8f\x53\x6e\x22\x14\x1e\x4e\xee\xc8\xf2\x6d\x79\xfa\xef\x5e\xcc\x8d\x55\x63\xbd\x9a\x65\xef\x4e\x48\x5e\x75\x50\x70\xe2\x93\x8c\x95\x2c\x0b\xef\x4e\xae\xb7\x43\xa8\x20\x84\xd1\x0b\x8a\xbd\xba\x84\xac\x0f\x1d\x9c\x37\xc0\xc0\xac\x42\x21\x4f\x49\x82\xe6\xbd\x3f\x75\x31\x15\x1c\x3d\x20\xaa\x14\xf5\x56\x83\x91\x43\x76\x27\x63\x63\x9a\xcc\x76\xea\xcf\x59\xe6\xfe\xe6\x90\xa1\xcc\x73\x84\x5f\xc4\xe7\x55\x89\x9d\x90\xff\x09\xfa\x03\xee\x35\x50\x0d\x42\x3f\x58\x7f\xe4\xfb\xca\x84\x09\x15\x29\xb8\x79\x1a\x7b\x16\xfe\xfc\xf5\x9f\xfb\x6e\xb4\x3e\x4e\x65\xbe\xc6\xcb\xd5\x6a\x2d\x75\x27\x7c\xd5\xc8\x15\xf0\x60\xfe\x0b\xde\xee\x4e\x55\xcf\xda\x12\x5b\x7d\x8a\xeb\x02\xd8\x01\xfd\x21\xe1\xf4\x0f\x4f\xbf\x4b\x3b\x5b\x3e\x48\xe8\x41\x1f\x86\xf8\x24\xe4\xaa\xe3\x4b\xb7\xd9\xad\xf3\x50\xd5\x13\x99\xa5\xb0\xaf\x9c\x93\xc9\x3f\x8a\x50\x63\x14\x1f\x96\xd0\x2d\x47\xa4\x00\x75\x5a\xaf\x33\x71\x5d\x39\x06\xa4\x2a\x34\x88\x8d\x67\x60\xfc\x01\x21\x97\xcc\xb5\x54\x8e\xe6\x01\x62\xd9\x93\x12\x0c\x29\xe0\x45\x68\xde\x87\x36\xdb\x27\x72\x48\x35\x3f\xbd\x47\x4d\xbb\xf6\xd6\x3b\xd9\x1c\x06\xa3\x46\x97\x85\x43\x3d\xa0\x19\xca\xc3\x85\x1e\x5f\x73\x07\x19\xa4\x60\x81\x44\xbc\x94\x79\x88\xd9\x5a\x5a\x68\x04\xe9\x03\x5e\xf6\xfd\x5c\x8d\x84\xfa\xa6\xb1\x73\xc5\xde\xd8\x21\x26\xbc\x40\x13\x9c\x9a\xf0\x73\x08\x7c\xd8\xaf\xe3\x72\x55\xe8\x90\xf3\x12\x6d\x60\x79\x03\x4e\xf0\x1f\x4e\x8f\xc3\x1e\xb1\x77\xbd\xbe\x04\x82\x90\x2f\x06\xae\x5d\x97\x19\xf3\x94\x0c\x31\xd8\x65\xc5\xeb\x9e\x60\x37\x98\x3e\xe7\xfc\xc4\x41\x0d\x9f\xfa\xb4\x32\x1c\x2d\x3c\xdc\x0d\xb9\x4f\xbe\x74\x9d\x97\x98\xf2\x46\xfc\x90\xbb\xf4\x9a\x77\x17\xbb\xde\x3b\x7f\xd3\x57\x37\xfd\x7e\xbf\x6f\xe7\xea\x85\xfa\x92\x9e\xb6\x76\xbf\xe7\x32\xe5\xa3\xd9\x1c\x25\x2c\x9b\x57\x8f\x00\x8e\xa4\x62\xe6\x66\xd7\xa0\xd5\x4e\x3b\x3f\x5e\x9b\x0a\x54\xc7\x9c\x47\xb0\xde\x4d\xcb\x3c\xea\x72\x63\xc9\x90\xce\x2a\xd6\x64\xc8\x26\x74\xca\x25\x14\xa6\x02\x8f\x41\xc2\xe0\x2d\x74\xf5\x3e\x1f\xb7\xe8\x0e\xeb\x08\x6d\x72\xf6\xb1\x90\x88\xa4\x08\x09\xb9\xd0\x24\x62\x3e\x98\x02\x6e\x6e\xbb\x29\x20\xaf\xa0\xc6\xf4\x4e\x7d\x40\x34\x11\x4f\x04\x32\xa4\x76\xc8\x30\x9d\xbd\xb9\x65\xde\x1f\x90\x53\xc7\x19\x60\xfe\x09\xe9\x5a\x4d\x10\x29\x08\x2b\x26\x2c\x67\x8a\x66\xf5\x07\xb9\xc2\xa4\x57\x56\x5a\x2a\xcb\x64\x18\xb0\xc8\x69\x81\xc2\x12\x64\x5f\xca\x95\xef\x35\xe5\x44\x9c\xe5\xd7\x9d\x77\xd0\xf1\xf9\x2d\xd7\xa0\xa9\x38\xd7\x07\xea\x95\x3b\xcb\xce\x23\xff\x5d\xc8\xf2\x7a\x78\xe5\x50\x0b\x87\x70\x9b\xfe\xe4\x6b\xea\x4c\xbe\xee\xde\xae\x2e\x8e\x4c\x4d\x9b\xf4\xd8\xf5\xc9\x8e\x76\x7d\xc9\xb7\x1d\xc9\x57\xe9\x48\xbe\x6e\x16\x6d\xca\x8f\xcd\x92\xfe\x5a\xb4\x20\xdf\xc8\xe6\xe3\xeb\x5e\xbd\x27\x2e\x60\xda\xb6\x1e\x7f\x78\xd3\xf1\x55\x9a\x05\x3e\x7a\xd7\xf1\x67\xc4\x35\x0d\x2b\x36\xda\x15\x3c\xb4\xe8\x39\xbe\xd6\x6e\xe3\x2d\x13\x7f\x9b\x77\x18\x5f\x6b\x6f\xf1\x96\x6f\xdd\xbc\x5d\xec\x5a\x3b\x89\xb7\x7c\xeb\xe6\xdd\xc3\xd7\xda\x37\xbc\xc5\x5b\xb7\xed\x15\xfe\xa0\x2e\xe1\x6c\xce\x79\x12\x3c\xca\x9f\x56\x0c\xb6\x29\x5a\x6b\xd8\xac\xbb\xa5\xe4\xed\xa4\x41\xf7\xb6\x35\x77\x97\xad\xb9\x37\x43\xbe\x6e\x1b\x71\xb7\x6b\xc4\xdd\x46\x72\x46\xee\x62\xf0\xcf\xb4\xd5\xa4\x0f\x03\x32\x04\x38\x9f\x6b\xdd\xb6\x2b\xf4\x28\x67\x54\xb9\xae\x66\x73\x8a\x6f\x0f\x56\xc7\xe5\x78\x2e\x1c\x2e\x98\x07\x94\x53\x35\x23\x3f\x9c\x1e\xa3\xfe\x5b\x53\xc3\x85\xf4\x8f\x0e\x9c\x92\x3a\x80\x20\x2a\x66\xed\xd5\xd6\x66\x35\xd6\x8d\x2b\xac\xdb\x62\xd7\xb5\xb2\xb1\x66\x3a\x31\x59\x5b\x96\xb8\xc4\x51\x20\x46\x44\x68\xe0\x0f\x41\x73\xa6\x0b\x9a\x58\xe9\xe7\xee\x80\x40\x6d\x54\x3f\x31\xb0\x96\x8b\x0b\x82\x96\xa2\xc2\x77\xf6\xf7\xef\xcd\x1f\xc8\x7e\x4b\xee\xbb\x62\x22\xef\x95\xc9\x68\x29\x92\xc9\x13\x59\xf1\x25\xc4\x0b\x3e\x72\x4a\xae\x99\x12\x2c\xab\x7a\x86\xfa\x1a\x13\xd6\xa4\xf6\xbd\x65\xe9\x7c\xbb\xc2\xf9\x16\x45\xef\xcd\x5b\x00\xb5\x2d\x97\x6f\x53\x84\xbc\xa4\x2f\xca\x08\xcf\x26\x3b\xa5\x99\x6b\x97\xd5\x70\xf0\xd6\x95\xa1\xad\x9a\xfa\xd4\x51\x20\x10\xc2\x76\x23\xde\xcd\x35\xea\xef\xce\x4d\xe4\x5b\x52\x87\x82\x3c\x1f\xc1\x7e\xa8\xab\xc8\x2b\x2c\x8b\x30\x18\xbb\x7a\xc1\x0d\x53\xc7\x0c\x7e\x1a\x6e\xde\xb5\xf8\x77\xc6\xb9\xa6\x47\xca\x4e\xdc\x70\x9a\x5d\x16\x4d\xdb\xf3\xd6\xd6\xfd\x87\xb7\x97\x87\xf5\x41\xad\xf6\x7d\x03\x49\xb5\x96\xa8\xf6\xfb\x28\x35\xfc\x86\x0d\x27\x52\x5e\x93\xbd\x28\xe5\x66\x52\x0e\x07\x89\xcc\xa3\xfc\xae\xbe\xe6\x63\x7d\xe0\xf8\xb3\x6f\xe7\xbd\x4f\xb8\x00\x30\xb6\x45\x10\x3b\xff\x90\x24\xcc\x02\xd6\xd0\xe5\x26\xb9\x63\x70\x71\x9a\x20\x64\x30\x15\x62\x1d\xf6\xc1\xe2\x62\x34\xef\x16\x7f\xcf\x82\xf8\x6a\xda\x18\x73\xe8\x56\xaa\xa1\x39\xbf\x16\x92\x4c\xaa\xb6\xff\x1d\xd0\xe1\x6f\xd5\x68\x31\x76\x08\x1f\xd5\x60\x4d\x2b\x3b\x23\x04\xca\x76\xa1\xbd\x82\xfb\xe9\x6e\xec\x74\xae\x97\xa3\xd0\xac\x98\x84\xc2\x31\x11\xc7\xce\x87\x2c\xae\x12\x8b\x0a\x36\xe6\x0b\xc5\x9c\xc0\x8c\xa6\x7a\x54\xf9\x4f\x7c\x16\xd9\x28\xa3\x63\x80\x67\x9f\xab\xba\x00\xe9\x08\x2d\x88\xad\xed\x10\xdd\xec\x4b\xeb\x3c\xf4\x30\x20\x1f\x6a\x4c\xe8\xf6\x81\x34\x97\x9e\x0f\xf1\xff\x43\x3b\x6f\xdf\x4b\xbd\x96\xeb\x0d\x56\x7e\x28\x2e\xd4\x96\x77\x30\x72\x6f\x6d\xf6\x98\xc0\x28\x3a\xf7\xaa\x9e\xcc\x90\x1d\x83\x02\x1f\x7e\x4f\x49\xce\x3f\xda\xa7\xc4\xbf\x8a\xb3\xca\x5d\x2f\xe4\xe5\x5f\xef\x5b\x5b\xa6\x32\x7c\x7a\x76\x15\xe3\x3b\x23\x58\x60\x01\x5f\x9c\x61\x5a\x38\xbe\x40\x1c\x26\x70\x08\xbf\x6d\xf8\xbb\x39\x2e\x47\x08\xd3\x75\xb4\xd5\x21\x5c\xe7\x86\xb3\x0b\xed\xcf\xdf\x36\xe1\x3b\x7b\x4b\x17\x21\xbc\xc5\x43\x76\xf3\xcf\x66\xd2\x26\x7f\x50\x4d\x79\xc2\x0e\x93\x44\x96\xa2\x55\xfa\xe0\x31\xb3\xaf\x40\x0d\x4b\x2f\x6b\x63\xa2\xbf\x39\x85\x6f\xb1\x62\x9a\x66\x9c\x62\x4d\x7d\xfd\x4e\x2c\xa6\xaa\xc6\x01\x7f\xf5\xdc\x0c\x1d\xcb\x60\xbb\xdd\x4f\x93\x90\xba\xf0\xfc\x76\x49\x96\x8b\x6f\xb3\x78\xc2\xcd\x51\xd0\xb9\xaa\x17\xd2\x9d\x57\xcb\x35\x37\x54\x5f\x57\x48\x03\x0c\xca\x4d\xc2\x66\x8a\x3e\x77\x2f\xda\xa7\xf8\xd4\x46\xe8\x03\x0d\xa8\x6b\xac\xdc\xb3\x2f\x7f\xa8\x5f\xff\xd7\xf1\x59\xbb\x94\xdf\x00\xb2\x8e\x75\x0c\x13\x37\x74\xd0\xb5\xe3\xf2\xf1\xb8\x0c\xcd\x3e\xb9\x47\x14\x75\xf8\xb1\xae\x77\x48\xc6\x28\xfa\x34\xc8\x5e\x94\x91\xbd\x3f\xb0\x32\xbd\x0a\xf5\xa2\xa8\x77\xad\x3e\x72\x46\x85\x8e\x4a\x0d\x19\x0c\xed\xd3\x19\xc3\x7c\xf0\x20\x74\xab\xed\xcc\xff\x3d\xef\xb3\xac\xdf\x81\xad\xff\x49\x69\xb4\xfd\x1c\x1f\xee\x05\xe6\x0a\x8f\x57\x6c\xcc\xb5\x51\x33\xdf\x84\x64\x14\x4d\xc2\x79\x65\xc2\x2d\xd7\x6c\x46\xfe\xf6\xe3\xc9\xdf\xff\xf1\xe6\xdd\xd1\xe1\x9b\x7f\xbc\x3d\x3c\xfa\xdb\xe9\xd9\xc9\x87\x0f\x97\x7f\xbf\xbc\x3a\x79\xfb\xe1\xc3\x51\xa9\x14\x13\xc6\x95\x5d\x5e\x32\xf3\xe1\x83\xe3\x54\xfd\xe1\xc3\x55\x52\xf0\xe2\xc3\x87\x73\xef\xc4\x40\x78\xe1\xff\x3a\x3e\x03\xf9\x89\xd5\x3f\x21\xe5\x06\xce\x56\x24\x3a\xcc\x7b\x42\x75\x95\x5e\x57\xab\xab\x68\x00\x49\xd5\xf4\xb8\xd3\x13\xaa\x98\x3b\x9a\xcf\xbc\x27\xab\xd5\x66\xb7\x03\x56\x7d\x01\xbc\x87\x34\x78\xc9\xc8\x90\x99\x1b\xe6\xca\xd5\xe6\x8f\xb9\x38\x8b\xf7\x67\x6c\x8f\x83\xc9\xfa\xf6\x28\x5a\x82\x3d\x8e\xca\x99\x24\x53\xce\x6e\x10\x1b\x01\xdb\xbc\x54\x00\xf8\x50\xbe\x8a\x25\x8c\xf3\xe1\x2e\xa7\x24\x15\x32\x0d\x60\xff\x73\x6e\xdd\x05\x97\x6e\xad\x5c\x02\x91\x4b\x58\x4a\xce\x4f\x8f\xc9\xcb\x01\x2a\x39\xa7\xc7\x08\xa4\xb4\x8c\xac\x24\x71\x68\x3f\xf6\x40\xc5\xd3\x77\x49\xba\x78\xc5\x00\x4d\x84\x51\x03\x0e\x28\x87\xa9\xcc\xe9\x43\xdb\x7a\xdc\x53\x78\x80\x4d\x97\x7e\x2b\x69\x86\x3a\xc0\xb9\x4c\x17\x25\xd3\xce\x37\xfe\xa3\x6f\x07\xdf\x84\x79\x7c\x3b\xf8\x06\xda\x39\x79\xb2\x7d\x3b\xd0\xd3\x64\xf0\x8d\x2b\x84\x25\xee\xa6\xa5\xd9\xa1\x0b\x55\x2d\x4e\x9f\xc5\xdf\xc0\xb3\x29\xe8\xbb\x9f\xa4\x4e\xa1\xc3\xbe\x58\x1d\x77\xc3\x42\x2d\x10\x6a\x6c\x13\xc5\xa8\x6b\xd7\x9e\xb2\x8c\x99\xa8\xbf\xfd\xda\xdb\x31\xdd\xde\x9f\xca\x87\xaa\x6a\xdd\xbb\x62\xe7\x52\xd0\x97\xfe\xf0\x4d\xbe\x62\x83\xe1\xcb\xaa\x01\x6b\x83\x0d\xd0\xb2\x22\xff\x41\x61\x1b\x23\x33\x86\xeb\xd3\x66\xa7\x2c\x2d\x88\xda\xd5\xf1\xe8\x4d\x08\xb1\x8e\xaa\xe3\x2b\x0f\xc5\x65\x39\xe2\x2a\xcc\x1f\x2c\x0d\x6c\x5e\x83\xb1\x49\xfc\x06\xca\x94\x66\xc4\x9e\x5a\x06\x3d\x19\x71\x25\xa0\x51\xd0\x66\xe7\x9b\x6b\x36\xeb\x21\x70\x03\x2a\x21\xdf\x46\x98\x82\xa1\xf4\x55\x16\x76\x40\xa9\xc8\x37\xfe\xbf\xbe\x7d\xa8\xb5\xd6\xc2\x8f\xda\xc6\x8b\x8a\x2f\xd5\x3a\x74\x75\x82\xf5\x0f\x75\x24\x07\xa4\xac\x2b\x8d\x30\x12\xc9\x35\x20\x27\x50\xdf\x88\x1a\xa9\xc3\x55\xcb\xb2\xda\xcd\xda\xf7\x48\xaa\xa1\x00\x80\xff\x25\xaa\x8b\x38\x93\x97\xae\xa4\x0e\x90\x59\x46\x4c\x55\x9f\x80\x80\x39\x93\x27\x1f\x59\x52\x3e\x14\x2d\x05\xaf\x56\xbe\xbf\x6b\xd6\xbe\x6b\xc5\x8f\x2c\xa0\xd6\x20\x6d\xac\x16\x1e\xb2\xe2\xab\xdd\x19\xa5\x66\xdd\x4d\xdb\x6b\x36\xd3\x01\x0c\xec\x1a\x47\x77\xd5\xab\x81\x7f\xfd\x41\x76\xf2\x91\x6b\xa3\xff\x8f\x6f\x99\x91\x0f\xab\x66\x25\x14\x73\xa4\xaa\xd1\xfd\x92\x08\xe8\xa5\x81\x8f\xf9\xd4\x04\xf7\x2f\xd0\x9a\xea\xef\x3c\x25\x22\xa8\x37\x6a\xdf\x69\x57\xbb\xec\x1e\x29\xa0\x44\x29\xc6\x0c\xab\xf2\x52\xf0\xc7\xc8\x9f\x48\x43\xa0\xcb\x89\x55\xf2\xea\xc7\x8c\xfb\xc8\xdd\xc4\x01\x3d\x82\x4f\x69\xc6\xd0\xae\xbf\xe1\x59\x9a\x50\x85\x21\x72\x07\x1c\xa3\x1d\x94\xa3\x43\x4c\xb0\x67\x9c\x93\x64\xd5\x2a\x6b\x17\x8b\xa3\xca\xf0\xa4\xcc\xa8\x22\x76\x3f\x8e\xa5\x7a\x20\xbe\x0c\x5e\xed\x5a\xb7\x04\x16\x6d\xd1\xd1\xb0\x2e\xdf\xe7\x47\x9c\x07\xe4\x73\xda\x8b\x35\x99\xa0\xb6\xa3\xbe\x51\xf6\xea\x50\x90\x72\xe4\x65\x53\x10\x14\x31\xa4\x9b\xa9\x39\xc7\xf9\x18\xfc\xdf\xfb\xd1\xe1\x11\x76\xe6\x80\x7c\x1f\xca\x7c\x7b\xa4\xf2\x19\x43\x31\xa1\x7b\xa6\xdb\x36\x6e\xb9\xaa\x4d\x3d\x92\x0a\xfa\xf0\xec\xa5\x12\x7e\xc3\xa6\x3c\x31\xfb\x03\xf2\xff\x5a\x4d\x11\x9c\xc8\x5e\x9d\x74\xdb\x2c\x14\x50\x56\xc0\x72\x2f\xc8\x1e\xfc\x2c\x56\x25\xf7\x7d\xa0\xc8\x75\x1e\x78\x62\xd9\x28\x2d\x42\xd4\x4b\xc2\xd3\x35\x31\x8a\x9a\xe2\x1c\x6b\x84\x93\x5f\x06\x09\x19\x64\x22\xd7\x6e\x97\xd6\x3c\xb7\x21\xce\xe2\x45\x68\x60\x9c\x7f\x81\x8f\x9e\x28\x36\x86\xfd\x87\xbb\xe7\x13\xee\x3e\x23\x0b\x99\xc9\xf1\xec\xb2\x50\x8c\xa6\x47\x52\x68\xa3\x40\x34\xb4\xc1\xf1\xba\x6d\xcc\xa8\xf5\xc3\x44\xde\x10\xea\xea\x90\xe5\x08\x41\xd5\x64\x39\x9e\x20\xd8\x2d\xfc\xd0\xb7\x49\xf3\x53\x74\x46\xa7\x1e\x90\xcb\x00\x66\x0b\x0c\x1e\xb0\x71\x61\x14\x70\x78\xdc\xd0\x99\xdb\x4c\x74\x08\xdd\x72\xab\x84\x20\x3f\x19\x0c\xfd\xdc\xfa\xfe\x20\x95\x0f\xcf\x8e\x1f\x8a\x20\xbc\x46\x85\xf6\x96\x57\x89\x7a\xe7\x43\x0b\xb5\x40\xdf\xa0\x91\x02\xdd\x68\x2e\x9d\xa6\x8a\x98\xa3\x9e\x32\x9f\x50\x37\x6d\x03\xb2\x93\xd3\x8f\x97\xd7\xec\xa6\xc1\x2f\xfd\x8b\xfe\xc8\x1e\x9e\xcb\xd5\x07\x7b\xf4\xbd\xd0\xd4\x70\x3d\x02\xa8\xf1\x4f\xa8\x8f\x43\xca\x7d\x33\x44\x64\xbc\xea\x95\x2b\xf1\x68\xa1\x5b\xb2\xc7\xd5\xab\x31\x8b\xcb\xbf\xab\xec\x20\x3c\x00\xb1\x04\x20\x80\x29\xdb\x1d\x94\xb8\x06\x03\x46\x56\x71\x68\x8c\x54\x84\x5e\xc3\x7e\xd7\x9a\x09\xe3\x6a\x0e\x42\x75\x6e\xef\x37\x17\x8c\x8d\x53\xd9\xda\xe6\x85\x01\x79\x4e\x3e\x5a\xcd\x43\x37\xcb\x34\xc2\xab\xde\x1f\x64\x6e\x50\x0c\x8f\xf9\x04\xca\xb9\x65\xa8\xe1\x74\x83\xd9\x1b\x7f\xd2\x54\xce\x55\x57\xbb\x0e\x43\xa4\x5d\x97\x21\xb2\x24\xcf\xf8\xd6\xd7\x9f\x03\xfb\x8e\xab\xd5\x9c\x53\x48\xf7\x50\x81\x47\x07\x36\x15\xd5\xc1\xee\x1a\xbf\x66\x41\x9b\xb3\x46\x91\xbd\x09\x7f\xd7\x16\xca\xbf\x45\x97\x22\xd2\x41\xa7\x22\x02\xb2\xec\xba\x81\x04\x8c\x7f\xef\x89\xd5\x78\x90\xf6\x3d\x8b\x48\x73\x83\xba\xba\x6a\x0c\x75\x5d\x99\xd6\xc8\x59\x35\xd3\xba\x12\x76\x95\x61\xdd\xea\xd9\x1d\x34\xf2\x20\x2d\x6d\xdc\xea\xaa\x11\x42\x3e\xc0\xda\xa5\x10\x60\x92\x23\xbf\x3b\x96\xda\xbc\xa7\xa2\x47\xce\xa4\xb1\xff\x44\xe6\xef\xb1\x64\xfa\x4c\x1a\xf8\x64\x23\x48\x89\xaf\xd0\x21\x21\x9d\x71\x86\xb8\x5e\x20\x37\x5d\x88\xd6\x9e\x78\x9e\x60\x4b\x0c\x8b\x53\x41\xa4\xf2\x14\x0b\xd6\x85\x76\x43\xc4\x61\x05\x07\x8e\x74\xab\x71\x62\xc7\x89\xe9\x7c\xc7\x70\x6e\x28\xc8\xfe\xc2\x6f\x00\x24\xb3\xc8\x20\x41\x3f\x2d\x15\x62\x95\x5a\x5d\xd3\xb0\x31\x4f\x48\xce\xd4\x18\x1a\x1f\x36\xc9\xab\x8f\xaf\xf6\xe7\x0a\x5e\x2d\x4f\x97\x78\x32\x2d\x78\x09\x8e\x6c\x50\xb1\x3a\x54\x01\x70\x3c\x3c\xd6\x72\x0a\x96\xd4\xff\x04\x1f\xf4\xff\x92\x82\x72\x05\xd8\xa6\x2e\x76\x1c\x7f\xe7\xa2\x2f\xf1\x30\x76\x84\x05\xdf\x12\x15\x84\x61\x21\x90\x1d\x7d\x5e\xf1\xe8\x91\x9b\x89\xd4\x78\x18\x06\xf7\xc7\xce\x35\x9b\xed\xf4\x16\x58\x6f\xe7\x54\xec\x54\x81\xe1\x1a\xb3\x85\x43\x18\x32\x08\x77\xe0\xbb\x9d\xc7\xd3\x55\x5a\x1d\xb6\x5d\x80\xcc\xcf\x4f\xa8\x21\x5f\x39\x9b\xa7\x7d\x2f\xf7\xb7\x38\x50\x64\x9f\x63\x4c\x70\xac\x58\xd4\xc7\x1d\x14\xf5\x1c\xe3\x9c\xa5\x60\x53\x66\x17\x2b\xe5\xda\x01\xb9\xf9\x14\x83\x7f\x2e\x98\x44\xff\xff\x63\x79\x26\x8d\xb7\xda\xff\xe9\xdd\x5e\xc8\x7f\x1f\x79\x5e\xe6\x88\x98\x64\xac\xa5\x90\xf2\x91\x07\x7c\xf5\x99\x0d\x75\x7b\xa1\x6e\xb6\x3a\x3e\x36\x54\x8d\x21\xc3\xd1\xd9\x0b\x9e\xcd\xc6\x99\x1c\xd2\x8c\xe4\x5c\xd8\xc7\x0c\xc8\x6b\xa9\x08\xfb\x48\xf3\x22\x63\x58\x4e\x46\xbe\xec\xff\x5b\x0a\x46\x5c\x34\xbc\x47\x3c\x2d\x5c\x92\x84\x91\xe4\x25\x72\x6d\x05\xfc\x1e\x52\x1d\x6a\x06\x58\x70\x5b\x68\xf2\xf2\xe0\xe5\xc1\x8b\x57\xe4\x77\x62\x87\x7e\xe9\xfe\xfd\xc2\xfd\xfb\x25\xf9\x9d\xfc\x4e\x08\x39\x27\xa4\xf6\x2f\x81\x7f\xfb\x84\x8f\xe2\x39\xbc\xb4\xd3\x4c\x64\xee\x5e\x18\x3c\xb9\xa1\xea\x33\xf4\x8f\x31\xd2\x0d\x0d\x45\x3f\x89\xcc\x19\xcc\xe1\xe5\xff\xf1\xf7\x40\xc0\xd5\x60\x8b\x1f\x98\xd4\x1e\x4c\x69\x9f\xdc\x80\x6b\x2a\xa7\xd7\x68\x96\x1d\x26\xa6\xa4\x99\x7d\xf8\xde\x17\xfd\x17\xfb\x44\x8a\xfa\xed\x53\x2e\xa1\x35\xba\x9b\xe1\xde\xcb\xfd\xc1\xc2\x94\xbf\x58\x32\xe5\xb9\x6e\x37\xae\xe6\xce\x0e\x7a\x3b\xd7\x78\x86\x39\x14\xb3\x1b\x3a\x0b\x6c\xe3\xcd\xd2\x31\x9f\x06\x6c\xf4\x08\x87\x02\x62\x76\xc0\x05\xdc\x23\x03\xe1\xa0\x33\xc2\xcd\x80\x9c\x9a\xdd\x5d\xdf\x5b\xc9\x6a\xcc\x1e\xc4\xfd\x38\x86\x0b\x04\xc2\xc3\xa2\xbf\x98\xcb\xe9\x6d\xd1\x58\xbf\x13\xe7\xe8\x83\x50\xd8\xdd\xd3\x2b\xff\x46\x07\x4e\xf5\x30\x96\xdf\xc1\x56\xf4\xcb\x11\xd6\xc9\x62\xa7\xa3\x01\xb4\xb1\x72\xb4\x77\x09\x23\xa8\x3a\xbb\xdd\xc3\x75\xb0\x9e\x38\xe4\xdf\x27\x34\x8b\x83\x75\x89\x04\x80\x36\xc5\x7c\xa3\xa4\x38\xbd\x28\xf8\xa5\xc8\xcf\xd5\x9d\x98\x56\x04\xf1\x57\x1c\xe8\x5b\x4c\x67\xdf\x19\x96\xc9\x35\x33\xfe\xdc\x51\x90\x11\x51\x94\x86\x0c\x69\x46\x85\xd5\x60\x16\xfc\x10\x46\xe2\x60\xf8\x4b\x60\x98\x25\xfc\xf2\xa9\xe3\x23\x0b\xbb\xa3\xbd\xd0\xff\x79\x7e\xc8\x28\x22\xeb\x1c\x85\x29\xa3\x99\x4f\xa5\x00\x7c\xf4\x00\x58\x25\x76\x77\xab\x7d\x05\x6b\x83\xc2\xaf\x72\xb0\x5a\xb9\x50\x93\xfb\x64\xcf\xe7\x3e\x12\xc3\xb2\x0c\xb9\xa7\xea\x4b\x66\x37\x59\xdc\xe8\x8c\xc3\x08\x75\x19\xb0\xf4\x87\xf5\xee\x68\x18\xd4\xb7\x92\x5d\xcc\x42\xb5\x7c\x8f\x10\x68\x0d\x38\xe6\x53\x2b\x94\x56\x12\x1a\x28\x18\x27\x2c\x2b\x88\x62\x69\x99\xe0\xe0\x84\xe8\x6b\x76\x63\x75\xaa\xea\x4d\x5d\x4b\x21\xcf\xb2\x3b\x35\xa2\xee\x20\x46\xb4\xa8\x8b\x44\x3e\x02\x86\xf4\x1d\x37\xd9\x94\xa9\x19\x29\xa4\xd6\xdc\xae\x03\xec\x25\xc8\x86\x03\xbd\x2b\x20\xeb\x40\x26\x16\x4c\xcb\x8b\xe1\x1d\x27\x76\x77\xac\xa0\xd6\xb2\xb6\x3d\x3e\xcd\x51\xf7\xa5\x3d\x66\xee\x3e\xea\xce\xe1\x7f\x8b\x47\xde\xe9\x88\x2c\xe1\xc1\x30\x97\x1a\xf3\x3c\xe4\x14\xfc\x02\x0e\xab\x2f\xf7\xa3\xc3\xf0\xcb\x83\x2f\x0e\x5e\xee\xd9\xb9\x7e\xb1\x6f\x67\x5d\x3b\xe6\x5e\x86\x63\x2e\xfc\xd2\xcd\x88\xe9\xda\x41\x67\x0d\x30\x6c\xa0\x2b\x55\xea\x22\x3c\x3e\x8b\xce\xce\x48\x1b\x17\x6f\xe3\xb9\x97\x2f\x3d\xe0\xbb\x8a\x59\x6f\x24\xec\x1c\x38\x6f\xb9\x21\x9f\xe5\x52\xb1\xcf\xa2\xfb\x6f\x3d\xa0\x9a\x9f\x3b\x6d\xfb\xa9\x65\x5c\x1b\x68\xaa\x76\xcd\x66\x0f\xd6\x74\xdb\xb8\xd7\xdb\x3a\xd7\x17\xdf\x02\x09\x92\xd3\xe2\x01\xe3\xb8\xbe\xed\x6d\x52\x78\xdf\x38\xc7\x6c\x68\x01\x0f\x8e\x47\xd4\x8a\x5c\x5f\x53\xac\x95\x0a\xf9\xb4\x43\x96\x49\xc4\x39\x75\xa9\x03\x0f\x48\xd5\x0f\xb0\xf0\xda\x48\x45\xc7\xec\xc0\x3d\xf6\xa9\xb4\x83\x70\x5d\xe0\x6b\x5e\x26\x2c\x67\x74\x20\xa9\x3e\xa5\xd9\xc7\x1f\x40\x0a\xd0\x04\xb2\x01\x81\x90\x35\x38\x87\x28\xcf\xf0\x89\x84\xb2\x1a\x54\xbf\xb7\x71\x9c\xd2\x1b\x7d\x92\x51\x6d\x78\xf2\x7d\x26\x93\xeb\x4b\x23\x55\x07\xda\xc5\xe1\xcf\x97\x0b\xa3\xd6\xd6\x54\x90\xc3\x9f\x2f\xc9\x31\xd7\xd7\x15\xba\x3e\xa2\x6a\xd6\x13\xf0\x68\x00\xc6\x71\xb5\x18\x24\xa7\xd6\xfe\x63\xde\xc6\x13\x01\xd7\xb7\xbb\xbd\xf2\x27\x7a\xa3\x19\x4e\x7f\x68\xa7\x6f\xbf\x66\xcd\x45\xf0\xda\x80\x14\xf0\x75\x4e\x8f\xd7\x10\xf8\x1a\xe9\xa6\x6d\x47\xc8\x02\x33\xbd\xe6\x19\x73\x1d\xc0\x00\x12\xa8\x8e\xf1\x0c\x5c\x33\x93\x25\xb9\xa1\xe8\xb3\x02\x99\x3a\x20\x57\xbc\x78\x45\x4e\x22\xbc\x56\x2c\x48\xa8\x0f\x65\xf5\x8d\x80\x1f\xe2\xb2\x04\x80\xcb\xd0\x75\x65\x45\xb0\xcb\x8a\x21\x27\xa8\x4c\xe9\x57\x64\x87\x7d\x34\x5f\xed\xf4\xc8\xce\xc7\x91\xb6\xff\x08\x33\x02\x74\x65\xd7\xa3\xc1\x2a\x75\x62\xc4\x54\x65\xc0\xe0\x0f\x16\x4b\x07\xbb\x67\x52\x72\xf5\xee\xf8\xdd\x2b\x50\xe0\x53\x49\x6e\x98\xef\x62\xe6\x0b\x61\x9d\x34\x8c\xc8\x00\x15\x1d\x89\xcc\x0b\x25\x73\x1e\xa5\xab\xc2\x26\x6b\xc2\xf3\xa4\x0b\x7f\x29\x24\xa5\xc1\xf2\x77\xc2\x41\x90\xed\xeb\x87\x9c\x03\x86\xbf\x8d\x7f\x4e\x47\x44\xa2\x53\xaa\x9e\x23\xcf\x75\xb8\xc9\x72\x8c\x1b\x05\xdb\x71\x55\x3c\x62\xd5\x6f\xf7\xd5\x41\xca\xa6\x07\x3a\xa5\x2f\x7b\xf0\x18\x64\x00\x07\x7e\x1f\xe6\x44\x35\xd9\x79\xb9\x33\x20\x97\xbe\xaf\x79\x2f\x9e\x63\x75\x9f\xb5\x06\xfc\x80\xe0\x56\x7d\xb1\x43\xf6\x30\x49\x1d\x74\x8a\x8c\xf9\x92\xe5\x80\xb1\x01\x3e\xfc\xfd\x46\x2a\x24\xe9\xc0\x7d\x41\x5a\xbb\x30\x88\x6b\x19\xf6\x4e\x64\x8d\x43\x7b\x73\x55\x55\x6e\x0d\x76\x0c\x74\xdf\x32\x12\x2a\x08\x98\xeb\x07\x8b\xa2\xe2\xc2\x3d\xb1\x22\x24\x17\x4e\x3b\x79\x6b\x17\x1f\xbb\xc0\xc3\x00\x77\x32\xcb\x0e\x54\x1f\xed\x6c\xcc\x99\x44\x3a\xa8\xe6\x26\xe1\x68\xe9\x66\x3d\xde\x0b\xfe\x5b\xc9\xc8\xe9\x71\xe8\xd9\xc8\x94\xe6\xda\x58\xc9\x95\xd6\x74\x04\x8e\x8a\xc3\xde\x61\x4e\xff\x2d\x05\x39\xf9\xfe\xd2\x4d\x65\x7f\x03\x09\xdc\x50\x00\xd2\x7f\x97\x8a\x59\xd5\xa8\xb5\x1e\x76\xe8\x47\x9a\xd7\xbd\xec\xe7\xe4\x98\x1a\x8a\x2a\x18\x4a\x33\x59\x55\x98\xc2\x4e\x18\x42\xe6\x8f\x2f\x1f\x6e\xa8\x45\x93\xf5\xab\x41\x96\x83\xce\x9a\x63\x4a\xd9\x9f\xbf\xbf\x38\x5d\x83\x12\x95\xc0\x29\x3c\x7e\x2b\xd3\x8e\x34\x29\x80\xf7\x38\xc2\x51\x49\x6e\x87\x25\x67\x52\xb0\x1e\x08\x3b\x62\xa5\x9d\xfb\xcf\x9f\x15\x37\x0f\xad\x98\xac\xae\xd6\xc7\xbf\x5f\xb1\x4e\xde\xda\x1e\xfe\x67\x51\x65\x3c\xc0\x38\x80\x54\x71\x8a\xc0\x30\x93\x43\xe2\xa4\xc1\x3a\xdf\xf8\xfd\xc5\x69\x67\x2f\xfc\xfe\xe2\x74\x73\x5f\xb6\x43\xe3\x60\xde\x36\xa8\xf4\xb7\x0a\x7b\x75\x5e\xe9\x5f\x5d\xe3\x1f\x74\xa5\xeb\xaf\x8b\xd2\xd7\x5c\x34\xce\x0a\xab\x8b\x8e\x13\x5f\x1f\xe9\x22\x35\x50\x92\x9d\xbe\x22\x79\x99\x19\x28\x7f\x03\xc6\xb2\x9c\xa6\xed\xe9\xed\x59\x8c\x38\x28\x08\x42\x8e\x19\x86\x17\xd2\x57\x3e\x21\x21\xfc\x62\xf9\x0f\xde\x52\x41\xc7\xf6\x76\x38\x0f\x49\x8e\x7f\x46\x1c\xbd\x87\x0e\x74\x11\xbe\xa2\x53\xca\x33\x3a\xe4\x19\x37\xd0\xfe\x7f\x7f\xe0\x15\x31\x8d\xb5\xb0\x76\xca\x6b\x13\x6a\x9d\xaa\xb0\x71\x7d\x10\x28\x98\x64\xcf\x8e\x7f\x70\x63\x05\xf7\xfe\xa0\xd2\x5e\x01\x8d\x0c\x12\xe5\x51\xc5\xad\xa9\xb6\x1e\xe5\x61\x4e\xb3\x6d\xc7\xae\x4d\xd5\x4a\x58\xe6\xd7\x0d\x21\xa0\x17\xd5\x1e\x3b\xd2\x52\xb5\x07\xbe\x70\xa0\x13\xcf\x5c\xf3\xc1\x0e\x52\x2d\x74\x1f\xd8\x32\x0d\x7f\xdf\x56\xfb\xd9\xee\x97\xfb\xaf\x6a\x81\x3b\xa1\x52\x0c\x22\x84\x43\xcf\xa5\x49\xe3\x0e\xba\x74\xa2\xda\x83\x0b\x81\x76\x65\xf7\x4d\x93\x22\x0a\xbc\x5a\x4b\xd7\xc0\xa9\x9d\x10\x02\x61\x57\x1a\x6f\x9c\x96\xef\x93\xb0\x62\x32\x6a\x5f\x03\x79\xc4\x8a\xc9\xeb\xcb\x7a\x28\xc5\x7e\x46\x5e\x5f\x2e\x91\x7b\x98\x2b\x63\xdf\x5b\x63\x80\x65\x57\x93\x8c\x8f\x98\xe1\x8d\x88\xb0\x66\xc9\x97\x4b\xc1\x8d\x54\x7a\x1d\x35\x1f\xee\xd1\xdd\xe8\x5d\x17\x9e\x10\xe4\xad\x1b\x17\x13\x3e\x13\x99\x65\x2c\x31\xae\xe7\x21\x2c\xab\x7f\xf0\x32\x47\x88\x4b\x05\xd0\xbe\xc7\xaf\x73\x7a\x1c\x20\xab\x1d\x5c\x9c\x1c\x1e\xbf\x3d\x19\xe4\xe9\x9f\x26\xf2\xa6\x6f\x64\xbf\xd4\xac\xcf\x4d\x3b\x5d\x69\x8d\x45\x21\x1d\x38\xa0\xcd\xa4\x9b\x05\xac\x10\x89\xde\xeb\x0a\x33\xcc\x07\x7e\x95\x94\x66\x11\x35\x6c\x54\x66\x19\xae\xa9\x51\x8c\xf5\x62\x77\xe2\x03\x31\xd5\xaa\x6b\xb3\xf4\xd7\xdd\xe5\x7d\x7d\xbb\x3f\x9a\x37\x65\x33\xb4\x3f\xe5\x9b\xaa\xc6\xe4\x0e\xda\x5f\x86\x91\x7d\x42\x9f\x65\x7c\xbb\x12\xd7\x6c\x46\x20\xbb\x7f\x24\x15\x20\x6d\xd6\xb9\x90\x99\x04\xc8\x75\x00\x2d\x15\x9d\xae\xb0\x21\xa4\x6e\xa3\x45\xc0\x8b\x5c\xb0\xd1\xe3\x10\xfa\x82\x8d\xb0\x80\xc2\xe7\x38\x3b\xeb\x82\x96\x66\x82\x99\x90\x88\x7c\x84\xe4\x5c\x4a\x79\x57\x91\xb1\x21\xa4\x6e\x95\x4b\xdf\x45\xbd\x57\x1b\xe0\x7d\xb2\xb0\x5e\xb1\x9b\xd0\x2d\x92\x79\x70\x5c\x41\x4e\xad\x6d\xc9\x6e\x0e\x6e\xa4\xba\xe6\x62\xdc\xbf\xe1\x66\xd2\x47\x4a\xe9\x03\xc0\x61\x3b\xf8\x13\xfc\xe3\xa2\xb5\x87\x69\xea\x32\xcb\x4a\xcd\x46\x65\x86\x39\x5f\x7a\x40\x68\xc1\x7f\x62\x4a\x43\x0a\xe3\x35\x17\x69\x8f\x94\x3c\xfd\xae\xe9\x8a\x91\x2e\x36\x48\xf3\x2e\x62\x77\x9e\x8b\xca\x8b\x1f\x45\x53\xa9\x11\x85\xd7\x92\xa8\xc6\xfa\x34\xcd\xb9\xd8\x14\xce\x6f\xaa\xda\x73\x91\x36\xa3\x60\x9d\x7a\x47\x30\x4e\x5d\xb7\xc7\xb1\x7d\xcc\x38\x64\xd1\x50\xef\xcb\xc0\x2e\x55\x2e\x9f\xa6\x9e\x4d\xb3\x92\x40\xc9\x67\xfa\xb7\xac\x8f\x4f\xe9\x17\x69\x45\xd7\x6d\x6a\xcc\x43\xae\xc7\x4c\x8d\xe9\xd6\xfd\xfd\x09\x12\x5e\x1e\x95\xc7\xc8\x56\xed\x5d\x03\xad\xdb\x6b\xba\x8f\xa0\x7f\x01\x0e\xbc\xf6\xc5\xc9\xa0\x5e\xa1\xec\xf1\xbe\x2d\xec\xcc\x17\x80\x87\x7d\x99\x51\x22\x85\x70\x98\x74\xef\x0a\x26\x2e\x0d\x4d\xae\x5b\xc6\x45\xb7\x3a\xd3\x1f\x4c\x67\xea\x36\x57\xc6\xa7\x41\xa7\x81\x47\xb1\x8a\xca\xa5\x94\x55\x59\xd2\xb8\xb1\x9f\xa0\xd4\x45\x84\xf5\xb7\xb4\x68\xef\x01\xf5\x23\xcd\x29\x4a\xe1\x63\xe7\xf4\x84\xaa\x9a\x42\x16\x65\x86\x90\x6b\x5c\x3b\x3a\x7e\x7a\xc5\xa6\xed\x06\x77\xfa\x72\x77\x39\x23\x95\x0c\xcd\x65\xca\xc8\x90\x9b\x4a\x3a\x6a\x66\xb0\x76\xd7\x01\xd1\x48\x41\x12\x07\x36\x07\x5a\x87\xd5\x30\xdc\x84\x22\x8d\x44\x10\x99\x18\x5f\xf3\x17\xca\x7c\x5f\xbc\x78\xf1\x02\x8b\x2e\xff\xfa\xd7\xbf\x12\xa9\xa0\xe3\x43\xc2\xf3\xc5\x1b\xe1\xae\x3f\xbf\x7c\x39\x20\x7f\x3f\x7c\xfb\x06\x72\xff\x0b\xa3\x11\x07\x1c\x47\xb6\x37\xd4\x7e\xac\x7b\xe4\xff\x5e\xbe\x3b\xf3\x6a\xa3\x9e\xfb\x16\x4c\xed\xf0\x7a\x75\xf4\xc5\x17\x7f\xf9\xea\xab\x01\x39\xe6\x0a\x6a\x9f\x38\x0b\xad\xb9\x82\xb7\x84\x2a\x86\x45\xa2\x00\x11\xe8\xf5\x2a\x1e\x40\xf4\x1d\x7e\x02\xf6\x1e\xc4\x7a\x46\xcb\x81\x19\x4f\x0c\x96\x59\xa1\x20\x0b\x5d\x85\x01\xb8\xd1\x41\xa1\xba\x64\x5d\x98\x5c\x8f\x64\xfc\x9a\x91\x91\x86\x96\x9c\x55\x31\xbd\x6b\x77\xe3\x4a\x4a\x70\xb0\x6a\xad\x34\x33\x4f\x3c\xf7\xb3\x95\x2f\x78\x1e\xc0\xb8\xd6\x70\x0d\x4a\x3d\xaf\xd9\xac\x8f\x1c\x56\x50\x1e\x0a\x46\x20\x39\xae\xd6\x63\x21\x78\x6d\xd2\x48\xae\x78\x8c\xc5\x42\xc9\x7f\xe1\xe2\x43\x0d\x69\x24\x89\xa1\x12\x15\x5b\xd4\x02\x58\x82\x88\x1a\x76\xf8\x3a\x58\xd7\xd4\xcb\x7f\xec\x90\x42\x17\xe1\x96\x33\xae\xed\x23\xae\xd9\x4c\xdf\xf5\xe4\xaa\x57\x8c\xe5\x4f\x8d\x9c\x52\x8a\x85\x5f\x3b\xe4\x7d\x27\x19\x5d\x93\x05\x87\x78\x53\x8d\x81\xe5\xff\xae\x10\xda\xdd\xeb\xa9\x14\x08\x51\x4b\x57\xd6\xcc\x94\x8e\x34\x90\x77\x6e\x9f\x0d\x0d\x00\xe0\x0d\x73\xaa\xae\x99\xef\xcc\x4b\xb3\x01\x39\xb7\x93\x0c\x90\x23\xa1\x31\x32\xd8\xad\x74\x06\x8f\x75\x4a\x1a\x3c\x64\x77\x30\xd8\xc5\x8d\x27\x15\xd1\x86\x2a\xb7\x8b\xec\xe7\xcf\x03\xc5\xea\x2d\x2d\x34\xa2\xaa\x58\xad\x14\x10\x87\x24\x20\xb5\x9a\x49\xd5\x17\x10\x69\xbd\x45\x9e\x22\x7d\x20\x4c\xe3\x01\x36\x11\x75\xea\xca\xc9\x06\x23\xfd\xf6\xde\x08\x2c\xa4\xbc\x85\x52\x81\x57\x2b\xd5\xc2\xc1\xec\x66\xec\x49\xe9\x12\xcb\xdb\x6a\x38\x49\x19\x69\x6b\x73\xcd\x3c\x9f\xaa\xca\x80\x57\x17\x8a\x03\x5e\xed\xd5\x07\xbc\xda\x04\x74\xf1\x5a\xd8\xa1\xe1\xa4\xc2\xc3\x68\x54\x91\x1e\x40\xcf\x8b\x70\xc4\x1b\x89\x1d\x42\x7c\xa7\x1b\x41\xe8\x50\xcb\xac\x34\xf8\xd3\xea\xcb\xf8\x98\x83\x41\x3d\xf8\x12\x9c\x6d\xe1\xb6\xe8\xd0\x83\xe3\x1e\xcf\x89\x36\xe7\x1f\x5e\xad\xc5\x44\x67\x6d\x90\x9f\xb7\x57\xa1\x35\x9d\xbd\xee\xd4\x4d\xae\x93\x2b\x86\xba\x99\x30\x97\x85\x10\xe9\x75\x56\x78\x5a\x91\x00\x4a\xa3\x57\xd1\xb0\xed\x78\xba\x16\x2f\x61\xa2\x79\x7b\xb7\xc0\xe5\x29\xd9\x0b\xed\x46\x43\x3a\xdb\xa9\x30\x4c\x8d\x68\xc2\xf6\x63\x77\x01\x2b\x26\x2c\x67\x8a\x66\x21\x43\xd9\xd7\x29\x4f\xa8\x48\x33\x57\xbc\xcf\x14\x6c\x5c\xf6\xd1\x30\x25\x68\x06\x8f\x48\x15\x9f\x32\xa5\xc9\xde\xf7\xcc\xda\x12\xd8\xa6\x74\xff\x09\xa6\x91\xe2\x8b\xac\xc3\x99\x01\x0f\xee\x26\x01\x14\x86\x5a\xd6\x29\xb1\x5a\x2a\x8f\x59\x64\x97\x55\xc7\x6e\xa0\x81\xdd\x10\x70\x62\x82\xd0\x85\x8e\x40\x18\x8d\xf4\x0d\xf0\x00\xb8\x38\x31\x38\x30\xd5\xae\x21\x1e\x20\xc2\x38\x79\xee\xa0\x42\xd6\x56\x0a\xf0\x49\x8a\x2e\xee\x2a\x99\x18\x39\x03\x52\x4e\x79\xea\xd5\x20\xc8\x66\xa8\x50\xb7\x0a\xaa\xa3\x4a\x7e\xaa\xb5\x74\xfd\x3e\xa3\x35\x42\x73\x14\x94\xa5\x3a\xa6\xb4\x8f\x14\xc7\xf1\x2e\x09\xc8\xac\x8d\x1a\x5a\x90\x4e\x0e\x44\x99\xb2\xf3\x72\x98\x71\x3d\xb9\xec\x34\xb4\x71\xb6\x64\x60\x4c\x0c\x5c\x48\x2e\xb9\x35\xdc\xa1\x99\xd0\xdc\xf5\x1f\x43\x35\x8b\x5b\x2d\x5b\xc2\x32\xf8\x5f\xc7\xbb\x43\x42\xa1\x38\x74\x35\xf3\x5f\x45\xf3\x70\xc8\x1d\xd8\x4e\x27\x65\xef\x45\x51\xfb\x3c\xa1\x59\xa6\xe7\x1b\x49\xfb\x83\x0c\x35\x53\x8f\xe6\x81\x5c\xc1\x2d\xc3\xf8\xd9\x43\xd6\x0c\x4a\xb1\x80\x6c\xba\xf4\xc5\x34\xc9\x25\x56\xfc\x0b\x22\x85\xbf\x09\xba\x02\xf9\x1f\x04\x0a\x21\xde\x18\x32\xdd\x1a\x31\x25\xb7\x31\x9d\xa7\x17\xd3\xe9\x34\x2a\x7c\x19\x5a\x34\x50\x18\xb8\x0f\x85\x4d\xbe\xd1\x2c\x0d\x85\xff\x95\xe1\x38\xb8\x2f\x7c\xbc\xb6\x08\x2e\xce\xef\xd0\x38\x58\xd0\x6e\xfc\xb6\x3f\xcd\x0d\x0a\xaa\x98\xb5\xbc\x41\x30\xf5\x9d\x6d\x9d\x44\x3b\xc9\x99\xc4\x61\x7b\x2f\x8a\xb3\xea\x4c\x87\xe3\x1c\x3f\xdc\xd5\x24\x95\x49\x69\x6d\xae\x8a\xec\x55\xc2\x44\x3b\xb4\xf7\xe7\x05\x3f\x9b\xca\x1b\x71\x43\x55\x7a\x78\xde\xa8\x6a\xb5\xae\x9c\x55\x63\xc5\xaa\xb7\x7f\x04\xb1\x9f\xd3\xa1\x6f\xf8\x1f\xc0\x9f\xb6\x81\xbb\xf9\x21\xee\xf3\xae\xb9\x36\xe0\xab\xc5\xe9\xc8\x36\xf4\xb7\x0d\xfd\x3d\x9b\xd0\x9f\x1d\xa9\xde\x29\xa5\x26\x5e\x9c\x43\xd6\x52\xfc\x59\xc4\x90\x22\x91\x8a\xa7\xe7\x7c\x3d\xec\x9c\xce\x8f\x9b\xb7\xe2\xba\xc8\x4e\xf0\x32\x17\xd4\xb1\xe7\x10\x6f\xda\x80\x78\x11\xd0\xb2\x85\x31\x88\xd7\x6d\xa5\x62\x88\xd4\x8a\x81\xe7\x28\x82\x5d\xc8\xf4\x15\x02\xa7\x42\xe7\x74\xec\xd9\xd1\x73\xb8\xcd\x3d\xe7\xbb\x10\x51\xaf\x70\x6c\xcd\xec\xd5\x9f\x4e\x62\x02\x2d\x19\x80\x74\xc4\x04\x04\x18\x01\xa8\x73\xde\x86\x1b\x48\x67\x1c\x61\xaf\xca\xd0\x69\x3b\xd2\xbc\x02\x8d\xa3\x7a\x46\xd0\xc9\x84\xe5\xd8\x3f\xfc\xb5\x27\x81\x95\x8d\xd6\x78\x30\x0c\x11\xd2\x98\xca\x35\x91\xa3\x5e\x0d\x42\x61\x67\xfa\x72\xa7\x5d\x8c\x81\x74\x17\x8e\x24\x7e\x1f\x9d\xb7\x8e\xed\x90\x79\x82\x9d\xd7\x42\x3a\x76\x0f\x81\xce\x93\x61\xeb\xe2\xb9\x2c\x0b\x38\x3f\x90\xc2\x1b\x43\x9c\x4d\x89\xd5\xf6\x42\xd4\xe0\x09\x28\x7f\xdb\x58\xed\x73\x8c\xd5\x46\x07\xa3\x17\x74\x8e\xb0\x71\xfc\x36\x0e\x09\xf8\x20\xee\x90\x79\xa3\xc6\xd9\x30\x3e\x82\xeb\xc3\xb7\x52\xd5\x53\x93\x76\x07\x83\xdd\x5d\x1f\xd4\x75\x7c\x5f\x9a\x51\xff\x6b\xc2\x44\x22\x53\x64\x16\x3b\xbe\xd2\x06\xd4\xbd\xca\xcb\x16\xcf\x25\xf7\xcf\x8a\xd3\x9b\x60\xec\x2e\x96\xba\xb5\x6c\xf1\x68\x7c\xaf\x1f\x41\x89\xa9\x54\x97\x80\xf9\xe7\x48\x14\x30\x9d\x9d\x0e\xe3\xbf\xd7\x24\xe3\x39\x77\xfd\xc3\xec\x46\x67\xda\x68\xb2\x87\x1f\x0e\x92\xa2\xec\xb9\x1b\x06\x39\xcb\xa5\x9a\xf5\xc2\x4d\xf6\xcb\xda\xaf\xdc\x1d\xfb\xd8\x87\xa2\x54\x8a\x09\x93\xcd\x9e\xb3\x06\xe4\x89\xb8\x21\x0a\x50\x58\xe3\x36\x48\x1e\xd5\x35\x57\x33\x17\x22\xbe\xe0\x2d\x8f\x30\xf6\x03\x58\xab\xee\x85\x90\x04\x7c\xca\xc4\x94\x4c\xa9\x7a\x20\x7a\xfa\xb2\xab\x43\x9d\x27\xe5\x53\xae\xdb\x36\xf7\x23\xb7\x3b\xa1\xa1\x75\x57\x69\x8a\xd2\x38\x89\xee\x77\xa0\x47\xda\x0e\x3b\x6f\x4e\x39\x7c\xb9\xd3\x7a\x4a\x05\x35\x86\x29\xf1\x8a\xfc\xf7\xde\x87\xcf\x7f\xef\xef\x7f\xb7\xb7\xf7\xcb\x8b\xfe\x7f\xfe\xfa\xf9\xde\x87\x01\xfc\xc7\x67\xfb\xdf\xed\xff\xee\xff\xf8\x7c\x7f\x7f\x6f\xef\x97\x1f\xdf\xfe\x70\x75\x7e\xf2\x2b\xdf\xff\xfd\x17\x51\xe6\xd7\xf8\xd7\xef\x7b\xbf\xb0\x93\x5f\x57\x1c\x64\x7f\xff\xbb\xff\x68\x3d\x75\x2a\x66\xef\x5a\x8a\x42\xbc\xfa\x1d\x1e\xc9\xf5\x11\x3b\x61\xbf\xb9\xd6\x0a\x5c\x98\xbe\x54\x7d\x1c\xfa\x15\x31\xaa\x6c\x27\x4c\xaa\xe3\xa5\xeb\xfd\x5f\xa9\x01\x15\xe4\xbc\x57\xea\xd7\xbc\xc1\x21\xe2\x79\xcc\x3b\x28\x0c\x3e\x71\x23\xd5\x2b\x5e\x0c\xcb\x0b\xa9\xa8\x9a\x91\xd4\x79\x33\x67\x4b\x00\x7f\x22\xc4\x9f\xd6\x68\xba\xf0\x46\x29\x57\x6b\xa8\x0d\x6e\x0d\xe0\xc3\x52\x5e\xe6\xdd\x38\xe1\x7f\x06\xe4\x79\x87\x5a\xef\x13\x88\xf0\x01\x3e\x7c\x31\xa4\xc9\x35\xda\x4b\x61\x6d\x50\x4b\x8c\x41\xa4\x77\x5c\xde\x43\xce\xa8\x08\x6e\x7c\xc8\x64\x91\x29\xb3\x0b\xe7\x6f\xc6\xb1\x6b\x2e\x77\x0c\xa7\xbb\x2c\xc1\xaa\x0d\x93\x54\xe4\x2d\xa8\x3b\x6b\x5d\x6b\xd2\x09\x6c\x07\xff\x37\x7b\x63\x75\xbc\x8e\xe0\xe2\x25\x18\x93\x0e\x23\x6b\x04\x8d\xa4\xaa\xf4\xaf\x9a\xda\x00\xeb\x16\xf6\x9c\x0f\xce\xda\xd5\xb3\x73\x42\xc5\x13\xbc\xce\x99\xc6\x5c\x14\x9e\x40\xa3\x23\x30\x3c\x81\xfa\x61\xc5\xae\xa2\x86\x88\xa5\xb6\x4f\x92\xa2\x7e\x4f\xf5\x20\xec\x03\x35\x44\x16\x70\xed\x0d\xe7\xcc\x65\xfb\xcd\xa5\xa7\x4b\xe4\xac\x80\x72\x62\x6f\x5b\xea\x12\x2c\x10\xf7\x14\xa7\x47\xcb\x11\x64\x4b\x44\x0d\x69\x7c\xcf\x95\x05\xbe\x14\x3c\xab\x33\xa6\x6f\xb4\x10\x5e\xbc\x14\x2e\x5b\x70\x81\xcb\x96\x33\x59\xa9\x99\xea\x8f\x4b\x9e\x76\xc7\x5e\x4f\x4e\xa7\x68\xa9\x49\x74\xa5\x3f\x74\xa2\x35\x74\xae\x2b\x84\x7c\xcc\xd6\x67\xe5\xce\x49\x48\xed\xac\x1d\x96\x71\x63\x88\x7a\x9a\x27\x0d\x0d\xbf\xbc\x30\xf0\xb9\x04\x57\xc1\x4f\xe4\x0e\xd1\x64\x96\x38\x50\x25\x5e\xeb\x4d\x83\xc3\xe2\x9e\x80\x8a\xa8\xbe\xfd\x3f\xef\x4f\xf2\x21\xd4\x21\x1b\x61\x16\x13\xfe\x06\xdc\x00\xae\x8e\x2b\x65\x19\x33\x50\x96\xc5\x44\xd5\xf1\x4e\x13\xc5\x72\x39\xb5\xdb\xec\x83\x20\xef\xb5\x0b\x86\xf3\xd1\x2b\x42\xf7\x6b\x85\xc1\xae\xcd\xae\x60\x2c\xc5\xe2\xae\xa8\x71\x9e\x2a\x85\xee\x91\xe1\xbe\x4f\x56\xd5\xd8\xdb\x51\x81\xc7\xcc\xb5\xaf\x02\x27\x95\x62\x96\x00\x00\x0f\xa5\x64\x4e\xb4\xa0\x85\x9e\x48\x03\xfe\x10\x5a\xd0\x84\x9b\x99\x25\xb7\x51\x34\xb9\x86\x16\xd1\x8a\xb9\x27\xf6\x48\xb2\xef\xb2\xd6\x63\x0a\xd6\x4b\xce\xcc\x44\xc9\x72\x3c\x81\x1a\x28\xbc\x2b\xc9\xa8\xf6\x04\x58\xfa\x7b\x67\xa3\x6b\x92\xce\x04\xcd\x79\x12\x5a\x67\x28\x39\xe5\x9a\x4b\x17\xc9\xc2\x71\xed\x1e\x23\xe7\xa1\xc7\x00\x06\xc8\x8e\x32\xca\x73\xb2\xa7\x19\x23\x81\x31\xf0\x9b\x4b\x54\x16\xd1\x59\xa8\x98\xfd\x79\x1c\x3d\x73\x28\x8a\x0e\x2a\xc0\x7e\x52\xc9\xe0\x90\x90\x80\x4a\x00\x6c\xee\x74\xf9\xa3\xf7\xc3\xd2\x2d\x9f\x99\x54\x90\xd0\xe6\xbb\xdf\x30\x91\xca\x28\xf5\xe5\xf0\xfc\x54\xc7\x86\xac\xeb\x19\x88\x23\xc1\x17\x99\x14\xe3\x18\x64\xae\xe2\x52\x2b\xf0\x05\xf4\x7f\x9c\xf2\xb4\xa4\x19\x8a\x7a\x37\x99\xa3\xcb\x53\xfc\x39\x1f\x4f\x4c\xff\x86\x81\x9b\x13\x4f\xc4\x2a\x35\xda\x3f\x94\x2f\xa4\xd4\x72\x0d\x47\x83\x71\xee\x34\x74\x19\x43\x87\x45\x3a\x03\x84\x5a\x97\xbc\x59\xcb\xba\xf1\x48\xed\x38\x44\xa0\x7b\x44\x74\x98\xde\x61\xe8\x06\x68\xb5\x21\xf0\x03\x5b\x2a\x03\xd7\x2e\xce\x0d\x5a\x1b\x56\x7d\x25\xc2\xc7\x26\xea\x2e\x0a\x9a\xef\x07\x81\x1e\x5d\x08\x16\x0f\xa3\xdc\xed\xaa\x6b\xa3\x43\x9a\x86\xa2\x4e\xb7\x0d\x7f\x60\x82\x29\x9e\xcc\xb1\x4e\xf8\xe9\x98\x1a\xd8\x7c\x4c\xd8\x9f\xa5\x83\x26\xa6\xf2\x9a\xf5\xe2\x69\xc5\x8c\x57\x2c\x2f\x32\x6a\xba\xc9\x54\xd9\xf9\x39\xf2\xa6\x47\xb1\x68\xbb\xfb\xa9\x48\xfb\x34\xb3\x7c\x7f\xfe\xd3\x91\xab\x87\xc3\xfd\x5c\xcb\x86\xbb\xaa\x3a\x7f\xa2\x3a\x82\x7a\xd9\xd2\x6d\x0c\x20\x6a\x43\x96\x82\xf8\x73\x4f\x06\x97\xc7\x8d\xc0\x56\xb0\xf6\x8f\xf3\x9f\x8e\x7a\x84\x0f\xd8\xc0\xff\x15\x6e\xf5\xf2\xd7\xc8\x31\xd6\x4b\x84\x3a\x1c\xd8\x35\x30\x95\xd8\x97\x1c\xff\xf6\x9f\xdf\xd8\x49\xda\x6f\xbf\xed\x7f\x13\x75\x0e\xfa\xf6\x9f\x96\x8f\x94\xbd\xa1\xfe\x69\x9c\xae\x0e\x92\xd6\xfe\xf5\xcf\x73\x99\x5e\x16\x2c\x19\xe0\x6b\xe9\x7f\xba\x3e\xea\x4c\x18\xab\xcc\x9f\x4b\x48\x54\xe3\x29\xee\x25\x78\xb6\x62\xff\xf2\xf1\x06\xd7\x80\xd4\x49\xac\x84\x1a\x26\xe0\xc8\xf1\x75\xc9\x42\x1a\xfc\x39\xb6\x2e\x85\xf9\xef\x8d\xe2\x6e\xa2\x46\x4a\x10\x26\x28\xb0\x0e\x05\x61\x1f\xb9\x06\x14\x1a\x7c\x57\x20\x07\x75\xb9\xf0\xfe\x14\xb5\xc3\x5a\x0a\x07\xd4\x21\x68\x67\x6a\xe7\xf6\x99\x90\xe6\xb3\xb0\xfc\x3e\xcf\x11\x8e\x4a\x49\xe8\x54\x02\xd2\x05\x1c\x22\x82\x94\x02\x1c\xe5\x55\x37\xc0\xe1\x8c\xe4\x5c\x1b\x7a\xcd\x06\xe4\xd2\x9e\x92\x71\xc2\x02\x52\x4f\x10\xe8\xe7\xc2\x52\x52\x0a\xc3\x33\xf8\xb6\x1a\xc7\x4e\x39\x3e\x3d\x4f\x47\x44\x97\x09\xb4\xbc\x55\xac\xef\xcf\x63\x77\xd7\x82\x24\xab\xde\xa5\x17\x16\x7b\x42\xd1\x40\x2b\x52\xf8\x29\x36\xd0\x15\x8e\xbd\x16\xb2\xb3\xed\x3c\xa5\x48\xaa\x33\x18\x88\x09\x5d\x94\xed\xb1\x9b\xf9\x7c\x22\xb4\x15\x5d\xfc\x41\xb0\x84\x69\x4d\xd5\x0c\x1b\x8c\xf2\xd0\x07\xd1\x25\xce\x82\x50\xca\xa9\x28\x61\x00\xc5\xb0\x5b\x6d\x99\x00\x75\x28\x19\x2a\x79\xcd\x44\xa8\x48\x08\x02\x2f\xa4\x65\x57\x49\xa8\x90\x0e\x20\x49\x32\xa1\x62\xcc\xaa\xa2\xf3\x9c\xa6\x40\xfb\x1f\x83\x6e\xe7\xdf\xc7\x52\x80\x8e\xac\x8a\xc4\x0d\x90\x62\x68\x0f\xc2\x10\x45\xf9\x20\x88\x77\xc3\xf4\xaa\x30\x87\x7d\x25\x9e\x35\x92\x89\xa4\x1b\xbf\x7a\x7b\x8f\x7a\x1f\xf4\x97\x35\xa6\x80\xe7\xcc\xd0\x94\x1a\xda\x59\x1a\xf8\x5b\x1a\x1a\x69\xba\x1c\x11\x60\x87\x28\x77\xc4\x9d\xe4\x5e\x79\x95\x05\x8f\x61\x08\x40\x1a\x4c\xfc\xea\x63\x0f\x7a\xcb\xd7\x2e\x86\x89\xd9\xdd\xa0\x1a\xba\xf6\xea\x30\xbc\x1f\x0d\x45\x16\x4b\x49\x5a\x82\xa2\x59\x89\xb4\x36\x31\xf6\x4e\x42\x30\x76\xa1\x3b\xa3\xf2\x55\x95\x4a\x90\xd4\x53\xbd\x97\xaa\x81\x78\xd6\x31\x61\x38\xb6\x4a\xf7\xb8\x11\x8e\xf8\xa5\xc0\xad\x3a\xb7\x0c\xb0\x4e\x63\x66\x74\x95\xa4\x89\xa7\x89\x15\x91\xee\x2c\x77\x6e\x0b\x38\x6a\xdc\xd2\x38\xcb\x7f\xb9\x3e\x8a\x0b\xa7\xa5\x3b\x2d\xec\xf9\xb5\xf6\x95\xe9\x2e\x16\x85\x1d\x65\xdf\xca\xb4\x7d\x50\x6b\xae\x35\x6a\x35\x70\x55\xb3\x82\xf5\x4b\x1a\xdc\x4a\xf8\x64\x08\xf1\xeb\x1a\xaa\x06\x1e\x01\x13\x3a\x6d\xee\x9d\xad\xf4\xdf\x7e\x68\x7b\x06\x8f\xeb\xc3\xe3\xfa\x2f\xdb\xfa\xc1\xdb\x27\x41\xfa\xab\x65\x32\x64\x7d\x42\x1d\x04\x3e\xac\x68\xbd\xec\x24\x2e\x31\xdf\x9b\x32\x9c\xbc\x2e\xc5\x23\xa4\xd5\xb8\xc2\x5c\xc6\xad\xbc\x7c\x45\x3e\xab\xe9\x5a\x4e\xa7\x0d\x96\x37\x56\x41\xed\x79\x53\x7c\xe0\x96\xdc\x43\x7c\xd5\x6f\xdf\x9f\x1b\x0c\x94\xbc\xe5\x56\xa9\xaf\xb6\x0a\x8a\xb7\x55\x92\xa1\xa7\x7d\x28\x76\xb5\x6c\xac\x64\x96\xf9\x46\xe8\x68\x8a\xcf\x25\x49\x41\xdf\x1e\x0c\xbb\xf4\x82\xcb\x23\x68\xfa\x82\xdd\x04\x95\x8e\x6a\x44\x2a\xf5\x41\x7f\x70\xcb\xf8\xcc\xb5\x65\xe3\x85\x8a\xb0\x43\x31\xc3\xa9\x1f\x87\xc5\xba\xcd\x00\xeb\xf9\x14\x25\x4b\x78\x98\x0b\xcd\x6e\xe8\x4c\xc3\xfe\xaa\x2c\xc2\xf0\x7c\x87\xdb\x5e\x0d\x7c\xc1\x46\x2d\x9a\xb3\xc7\x57\x67\x69\x01\xdd\x25\x06\x00\x2a\x0b\x17\xcd\xb3\x7d\xab\x61\x1a\xf4\xb3\x9e\xbf\xba\xcb\x2f\x80\x14\x4b\xc8\xaf\xea\x22\x50\x5b\x6f\x3a\x74\x7e\x0a\x03\x7b\x9b\x6d\x0c\x7f\xf8\xb3\x3c\x44\x1c\x87\xcc\xee\xb7\x0a\x4b\x0a\x78\x37\xfe\xed\x92\x04\xb4\x8a\xe9\x7f\x84\xc6\x44\x2e\xb4\xe3\x0b\x8b\xed\x51\x70\x78\x7e\x8a\x4f\x1c\x40\xeb\x59\x2a\x66\x4e\xcb\x32\x13\xae\xd2\x7e\x41\x95\x99\xa1\x73\xa4\x57\x7b\x5a\x28\xaa\xec\x80\x1c\x9d\xc6\x98\xdb\x74\x2e\x8b\xaf\xda\x1a\x01\xf9\xdc\xfa\xf8\xa0\xdc\xad\x2b\xb3\x69\x14\x69\x5b\xe2\xe9\xaf\x7a\x1d\x71\x84\x46\xe6\xbd\x16\x4f\x82\x22\x69\x2c\x88\xbb\x3d\x91\xe7\x32\x61\xf0\x60\x05\x7d\xd9\xf9\x96\x64\x5c\x72\x16\xf4\x33\x30\xf4\xed\xb4\x7a\x84\x8f\xec\x91\x26\x45\xdf\xd5\xb7\x07\xd7\xbb\xd3\xf1\x7c\xca\x28\x1a\xed\x76\xb3\xa2\x43\x35\x7e\x56\x3c\x40\xd8\xdd\x64\x4f\x48\x81\x3b\x1e\xef\xdd\xc7\x8c\xd9\x5b\x3c\xc6\x70\xcb\x80\xfc\x3c\x61\x22\x3e\xee\x62\x5f\x7b\x2f\x1c\xbb\x5c\xa4\x76\xb9\xe1\x2c\x04\xdb\x5f\x97\x49\xc2\x58\xf0\x16\xc5\xbd\xd7\x2b\x89\xe4\xa6\x9c\x53\x93\x4c\x98\x26\x5a\x02\xf8\xa8\x36\x34\xcb\x2a\x2f\x8d\x23\x97\x04\xcd\xc1\x3b\xe8\x23\x85\xa2\x56\x16\xee\x1c\x56\x45\x46\x9d\x57\x64\x54\x8a\x04\x73\xb2\xb8\x99\xf9\x19\xc4\x27\x3c\xfc\x0c\x4c\x53\x8d\xce\x1b\x3e\x42\x6f\x70\x64\x62\x06\x62\x82\x48\x9d\xa1\x10\xad\x9f\xf5\x0e\x76\xcf\xca\xcf\x21\x4d\xae\x6f\xa8\x4a\x35\x54\xbc\x53\xc3\xb1\xa9\x60\xaf\x36\xec\x5e\x34\x07\xfb\xf4\x9a\x6e\xb0\x1f\x0c\x59\x68\x28\x2d\xe7\x1e\x43\x68\x69\x64\x4e\x0d\x4f\xc0\x45\xc3\x47\x91\x6f\x3f\x0f\x7d\x1e\x42\x9c\x16\x65\x39\x9c\x0e\xee\x35\xc0\x5a\x53\x58\xa1\x61\x6e\x24\xe1\xb9\xd5\xb9\x28\x34\x4c\x1e\x85\xfa\x76\x1f\x88\xb8\x6b\xa6\x56\xb1\xfc\x19\xc2\x40\xd1\x5d\xe8\xfc\xb1\x66\xb9\x86\xe1\x43\x9c\x21\x38\xd8\x5d\x21\x77\x6f\x4e\x25\x22\xfe\x57\x96\xab\xed\x6c\x23\x66\xed\xd9\x05\xba\x61\x56\xd7\xd2\x77\xb2\xac\x1e\x2c\x9b\x13\x1f\x0b\xac\xfa\xe5\xda\x3b\x0c\x5c\x1a\xf7\x5e\xaa\x64\x51\x38\xd7\x5f\xbe\xbf\x38\x27\x88\xee\xa9\x29\xd3\x10\xd9\xf6\xa9\xe1\x96\x14\x63\x26\x98\xa2\x06\xe2\x03\x0e\xad\x10\x76\xef\xfc\x43\x20\x6b\x98\x44\x60\xe6\x7b\x87\x59\x31\xa1\xfb\xe4\xbd\x6b\x9a\x1f\xf8\x37\xe4\x9a\xaf\xa4\x91\xa2\x33\xd1\x47\x05\xb6\xaa\xe4\x5d\xc3\x6c\x55\xc9\xad\x2a\xd9\xe0\xda\xaa\x92\xf3\xd7\x56\x95\x8c\xaf\x90\xce\xdc\xad\x1a\x79\x11\xea\x13\xa2\xec\x92\x38\x5f\xab\x2a\x60\x78\x7c\x2f\x5f\x78\xd6\x06\x9d\x30\x5d\xca\x62\x4c\x5d\xeb\x9c\xa7\x77\xdf\x60\x4a\x1c\x7e\x38\x74\x4b\xe5\xb3\xf4\xaa\x0c\x41\xab\x25\x96\x86\x45\x4b\xea\x94\x87\x07\xaf\x61\x0d\xf5\xe5\x00\x3b\x5b\xf7\xc3\xb0\xfd\x2a\x29\xaf\x71\x23\xc0\xf8\xea\x70\x35\x49\xe7\xf0\x24\xf1\xf5\xe4\x72\xf8\xea\x57\x67\xb5\x01\xe4\x51\xea\x03\x48\xf7\x35\x02\xe4\xf1\xeb\x04\x48\xa8\xdb\xea\x7e\xdf\x5f\xf8\x3a\xb2\xb9\x9d\xef\x44\xf7\x5d\x3b\xbf\x86\x53\x16\xc6\xe1\x9a\xc8\x9c\x1b\xc3\x7c\x5e\x45\xd8\xc9\xe0\x0d\x8f\xeb\x68\x9c\xcc\x01\xb3\x1b\x93\x27\xd8\xc7\xd0\x69\x29\xd2\xe7\x40\x2b\xbb\xe1\x1a\x8c\x08\x2a\xac\x09\x88\x70\xb1\x20\x3b\xfa\x2e\xef\xd6\x9b\xb5\x5b\x39\xd4\x7e\xdc\xad\x1c\x8a\xaf\xad\x1c\x22\xd0\xb2\x2a\x83\xa2\x8d\x4e\x95\xc7\x43\x4c\xb8\x20\xbf\x95\x4c\xcd\x88\x9c\xb2\x28\xaf\x13\x9a\x52\x69\x9e\xba\xcc\x48\xe7\xb7\x6b\x6b\x75\x6d\xa8\x5e\x07\x7e\xc5\x93\x8f\x56\x7f\x06\x6c\x81\xce\x25\xfd\xfc\x03\xea\x10\x41\xb8\x0a\x7e\x89\xbd\x68\xb7\x32\x56\x0f\x1c\x06\x78\xf5\x09\xf8\xe2\x0e\xcf\x8e\xbb\x34\x81\xbb\x88\xa4\x93\xee\xa2\xe9\xe4\x36\x46\x5d\x46\x22\x24\x65\xf8\x06\x0e\xb3\x90\xf1\x10\x7c\x70\xe4\x9a\xcd\x7a\x2e\xb1\xc8\x75\x21\xf4\x37\x63\x8e\x5e\xbd\x55\x4a\x3b\x08\xbe\xfa\xd5\xf1\xa9\xd3\xa5\xcf\x0c\xaf\xb6\xad\x31\xea\x63\x79\xe2\x76\x73\x10\x76\x7c\xb0\x76\xd0\x42\x23\xbe\x6a\x4c\xea\x5a\xda\x40\xd2\x3b\x70\x2b\x80\xf2\xfb\x52\xa5\xc0\xa0\x50\x9e\x05\x12\xb6\x1b\xf6\x22\x5d\xbb\x6d\xf0\xf2\xcb\xf8\x48\xc4\x0a\x5b\xb0\x56\x13\x73\xcd\x66\xbb\xda\xa1\x54\x48\xa1\x27\xbc\xf0\xbd\x14\x41\x4e\xba\x5d\x49\x7e\x82\x54\x30\x3f\x04\x4a\xc4\x53\xd1\x23\x67\xd2\xd8\x7f\x4e\x20\xb7\x15\x43\x10\x92\xe9\x33\x69\xe0\x93\x8d\x26\x37\xbe\xda\x23\x11\xdb\xc5\x2f\x38\x44\x1f\x30\x8b\x1b\xea\x44\x7d\xc6\x23\x10\xd5\x25\xb7\x84\x85\xe1\x9a\x9c\x0a\x22\x95\xa7\xaa\xf1\x2d\xa3\xb4\x1b\xc2\x7b\x75\xa3\x60\xd1\x92\x31\xdc\x62\x48\x55\x5b\x8b\x3b\x86\x0b\x71\x27\xee\xbf\x01\xaf\x2f\x04\xea\x42\x9a\x26\xb4\x2d\xa2\x86\x8d\x79\x42\x72\xa6\xc6\x80\x68\x92\x4c\xba\x5e\xe2\xae\xce\x45\xbc\x3a\x3c\x1d\xf1\xea\x94\x0f\x41\x45\x79\x03\x09\xb8\x8f\xa3\xfe\xe0\xd8\x78\x5c\xe7\xb4\xb0\x2c\xf8\x3f\xf6\x54\x06\x2e\xf8\x5f\x68\x8b\xa6\x07\xe4\x90\x68\x2e\xc6\x19\xab\x7d\xe7\x02\x07\xf1\x30\x76\x04\x6b\xb3\xfe\x56\xf2\x29\xcd\x18\x26\xcc\x53\x11\x7a\x99\xc8\xd1\x82\xd2\xd5\x73\xbd\xd1\xac\x5c\x0e\x21\xea\x9d\x6b\x36\xdb\xe9\x2d\xb0\xed\xce\xa9\xd8\xa9\xc0\x91\x6a\x8c\x1a\x94\x0b\x88\x5e\xee\xc0\x77\x3b\x9f\x46\x4f\x7b\x02\xa6\x6b\x67\x3c\xe9\xdc\xcc\x47\x19\xd5\xba\x0b\x9c\x96\xdb\xb1\xc7\x2f\xa3\x27\x55\x75\xd7\xae\xe8\x22\xc1\x74\xe8\xee\x7c\xe4\x50\x63\xd8\x55\x0a\x6c\x07\x74\x9e\xba\x86\xce\x6d\x81\xdc\xe6\xcf\x9c\x30\x6c\x28\x43\xbd\x89\x51\x0a\xaa\x6c\x95\x5b\x28\xfe\x13\xc4\xc3\xe5\x28\xee\x03\xc1\x35\xb8\x9f\xb8\x2f\x4c\x15\xd2\x10\x2e\x92\xac\x4c\xb1\x03\x06\xfc\x14\x9c\x57\xdd\x18\xaa\x9d\x91\xb7\x73\x06\xfe\x29\x0c\xeb\x75\x4e\x9f\x59\xb3\x50\xfb\x33\x9f\x02\x01\x69\x27\x21\x9b\x00\xa9\xbd\x4e\x6a\x8d\x1a\x55\x39\xd4\x5b\x85\x1c\xd5\xf5\xc8\xd7\x7c\xa8\x18\x39\x9a\x50\x21\x58\x16\xe1\xb0\x38\x47\x27\x35\x86\x26\x13\x4c\x7f\xa6\xc4\xee\xe3\x8c\x99\x5d\x8d\x2d\xea\x73\x9a\x4c\xb8\x08\xe0\x05\x22\xe0\x11\x55\xa5\x54\x6b\x68\xae\xd3\xd6\x10\xea\xb0\x2f\xcb\xee\xed\x8d\x59\x2a\x50\xef\xd1\xdc\x3d\x15\xba\xbd\xdb\xe5\x40\x6b\x3c\x71\xa1\x4b\x08\xdc\x7b\x77\x6b\x97\x3c\xb8\xa7\xb9\x18\x31\xa5\x70\x4d\x86\xcc\xfd\x80\xf0\x5a\xdf\xd5\x81\xeb\xf7\x30\x91\x37\x24\x95\xe4\x06\x3a\x90\x4e\xad\x6a\x00\xf9\x37\xda\x2b\x15\xd1\x4c\x21\x23\x2e\x91\x79\xa1\x64\xce\xb5\xaf\xf1\x73\x0c\xb1\x36\xd8\x91\xac\x6c\x8c\xd3\x7a\x1b\xb8\xe6\xeb\x23\x62\xa8\x1a\x33\x63\x07\x27\xa2\xcc\x87\xac\x25\xac\xca\xba\x21\xbc\x3b\xed\x94\x11\x51\xea\x9e\x06\x18\xe4\xc2\x3d\x17\x01\x4f\x20\x19\x6f\x24\x95\x4b\x29\x0c\x5f\x3a\x9c\x76\xcb\x72\x3f\xb9\x73\xb1\x14\x46\xb7\x84\x4d\x6f\xd3\x40\x03\x97\xff\xe7\x9f\xcf\xba\xc1\x3d\x5f\xca\x5b\x37\x52\x65\xe9\x0d\x4f\x31\x51\x43\x93\x3d\xfb\xb8\xfd\x76\xef\xbc\x46\xe0\xf3\xd6\x1b\xf9\xe6\x86\xa7\x8f\x41\x6e\x9f\x19\x6c\xc9\x4d\x80\xde\xae\x57\x3f\x87\xbe\x70\xf0\xd8\x7d\x72\xc2\xb1\x8e\xdc\xfe\x85\x88\xa2\xf9\x90\x8b\x0a\x09\x21\x30\x04\x9c\x7c\x56\x2e\x78\x8b\x5c\x33\x83\x15\xc0\x50\x44\x2b\xcd\x84\x68\x9e\x97\x99\xa1\x82\xc9\x52\x67\xb3\x96\x6c\xfc\x54\x97\x74\x94\xb1\x8f\xb8\x9b\xdb\xeb\x2f\x61\xa8\xba\x1e\x33\x46\xb4\x07\xbf\xc2\x0b\x8a\x4c\x95\xdc\x9c\x1e\x04\xa5\x26\x94\xb1\xb3\x8f\x2c\x71\x75\x4e\x45\x56\x8e\x79\xa3\x92\xd6\x6d\x53\xc0\x46\xbf\x5e\xad\x29\x60\xd5\xf2\xac\xd4\xac\x42\xfa\x6a\xd7\x74\xfb\x69\xf4\xf0\x7b\x54\x55\xf1\x6a\x79\xa3\xbe\x94\x15\x4c\xa4\x80\x1c\x1e\xed\x38\x9c\xee\xda\xa8\xed\x10\xbb\xbb\x3e\x17\x4e\x3e\x1a\x45\xad\x90\xcf\x01\x4e\xc6\xc1\x82\xf3\x11\xa1\xa2\xad\xc0\x7e\x2e\xbd\xa5\xc8\x56\x6f\x7c\xf0\xa5\x3b\xed\x2f\x19\x11\xac\xd6\x5f\xb2\xe3\xee\x92\x78\xfa\xb9\x8d\xae\xeb\x65\x51\x4b\xba\x40\xba\xa7\xc4\xf5\x4b\x6d\xbb\x41\xea\x25\x4d\xe2\xe6\x66\xb5\xc6\x3d\xb9\x6d\x0d\xf9\xb4\x5a\x43\x8e\x00\x69\xa8\x3d\x8c\xef\x6b\x1c\x67\xce\x77\xe6\x3e\x74\x3a\xe7\x2a\xbe\x32\xb7\xa3\xa2\xe3\x15\x7a\xbe\xb8\x81\x5c\xdd\x3e\xd1\x76\x35\xaa\x24\xfd\x52\x88\x66\x42\x7b\xdd\x1d\xf4\xa8\xa1\x9a\x99\x36\x1e\xdd\xc5\x82\x06\xaf\x0f\xe2\xd8\xd8\x78\x12\x0a\x0d\x3d\xdc\x0e\xe9\x7f\xeb\x34\x47\x51\xbb\xd3\xea\x8c\x9e\xd0\x1e\xe9\x97\x85\xd4\x2d\x1c\x23\xb5\xcb\x9b\x50\xd3\xb2\x97\x7a\x8b\x53\xd6\xcd\xf6\xfd\xfb\xd3\xe3\x4e\x68\x66\x07\x9a\xa3\xd9\x20\xa0\xe9\x95\x82\xff\x56\xc6\x36\x30\x20\x0f\x06\x2a\xb9\xfb\xd7\x41\x8a\x71\xc2\x2a\x67\xfc\x31\xd7\xd7\xed\x81\xb8\x7f\x38\x3a\xa9\x0f\x59\xdf\xcc\x3f\x1c\x9d\x10\xf7\xe9\x4a\x3e\xf0\x87\x38\xc1\xdb\xe2\x39\x8f\x13\x56\x85\xc7\x52\xae\xaf\xd7\x00\xe2\xdd\xd6\x3c\x2d\xd2\xb3\x66\xd5\x82\x9b\xec\xcf\xf7\xd8\x9f\x11\x40\xed\x4c\x96\xe4\xc6\xa1\xd2\x39\x03\xee\x8a\x17\xaf\xc8\x89\xd0\xa5\x62\x55\x96\xd3\xbc\x2d\x67\x75\xa8\x95\xcd\x39\x00\xfe\xd3\xaf\x3a\xf3\xff\x77\xcd\x9f\xcf\x25\xa0\x50\x50\x65\xc0\x06\xeb\x08\xc7\x1c\xda\x94\xba\x21\x3d\x11\xee\x61\x9e\xd3\x91\xaf\x53\xe8\x39\x54\xaa\x00\xf6\xed\x6f\xb2\xec\x12\xc1\x54\xc6\x0c\xf2\x3a\x00\xd0\x92\x83\x94\x4d\x0f\x74\x4a\x5f\xf6\xe0\x31\x1e\xcc\xc8\xd4\xe6\x44\x35\xd9\x79\xb9\x33\x20\x97\x3c\xe7\x19\x55\xd9\xac\xd6\x71\xab\xba\xcf\x1e\xa6\x7e\x40\x48\x02\x79\xb1\x43\xf6\xa4\x82\x91\x13\x2a\x48\xc6\x7c\x25\xbf\xdb\xbe\x33\x34\x1f\xf6\x37\x43\x16\x92\x8d\x89\xc6\xa0\x58\xec\x86\xbd\xde\xe3\x71\x5e\x03\x3b\x3d\xae\xce\x33\x2e\xec\x21\x37\x20\xef\xdd\xe9\xe4\x8e\x7d\x64\x01\xd8\xb5\xfe\x8e\xcd\x5a\xa2\x8d\xf1\x59\xb4\xf3\x44\x2c\x3a\x3a\x36\x8d\xd0\x4d\xbd\x1d\x63\x6e\x2e\x58\x21\x3b\x50\xd1\x70\xa0\x39\xcf\x3e\x37\xf6\x03\xa9\x39\x74\x49\xa1\x86\x50\x14\x44\x49\x99\x51\x6b\x91\xa1\x5f\x7f\x40\x8e\x4f\xce\x2f\x4e\x8e\x0e\xaf\x4e\x8e\x5f\x11\x3f\x12\x8f\x75\xfa\x01\xb9\x8a\xf1\x8a\xa3\x92\x2f\x07\x0a\x1b\x9e\xd5\x73\x82\x95\x8a\xaa\xc5\x03\xe0\x37\x52\x41\x4e\x05\x37\x55\xe7\x2a\x4c\xa2\xcf\xa4\x70\x69\xf1\xf6\xd7\x2e\xae\x30\xe6\x98\xbc\x29\xdc\x60\xf6\xeb\xfa\x68\xb0\x43\xb1\xcf\x4b\x98\x4a\x23\xef\xc6\x9a\x75\xbb\x6a\x79\xd6\x61\x65\xfa\x26\x2d\x9d\x6c\xf2\x2b\x0c\xc8\x56\x5d\x79\xf0\x44\x0d\xcd\x06\x3d\xfe\xaa\x54\xb5\x5e\x80\x83\xc1\xee\x80\xd8\xb3\x7a\x77\xb0\xeb\x55\xb9\x6c\xa1\x61\x65\x18\x34\x86\xb9\xae\xf3\xf7\x80\x90\x77\xbe\x8c\x10\x70\x8b\x96\xf7\xbe\x44\xb0\xbe\xa8\xd3\xe1\xdc\x2e\xf1\x2d\x51\xcb\x61\xfc\x50\x87\x8b\x3d\xe6\x53\x26\xf0\xc5\xd6\x27\x98\xfd\x54\x3b\x59\xb5\x8b\xea\xcd\xdf\x5f\xbc\x59\xdf\x4b\xa1\x64\xe9\xe4\x95\x8e\x64\x9e\x23\x62\xf3\x24\x20\x8d\x54\x60\x21\x41\xea\xad\xc5\x38\x47\x9c\xea\x51\xa3\x0d\x3b\x27\xf1\xfd\x50\x73\xc6\x78\xf8\xd8\xd5\xf5\x8a\xca\x1e\x7a\x78\x9b\x2c\x07\x94\xae\x3d\xf4\xa6\x3b\x3e\x0f\xc2\x7b\x1c\x5c\x9c\x1c\x1e\xbf\x3d\x19\xe4\xe9\x13\x14\xbe\x4c\xa4\x85\xe4\xc2\xe8\xa6\x86\x79\xb3\x76\xdb\x6d\xc5\x76\x98\x76\x37\xba\xd9\x89\x1f\x2e\x4e\xf4\xf4\xcf\x88\x90\xef\x53\x66\x28\xcf\x74\xc4\x61\x46\x16\x32\x93\xe3\xe5\x5d\xb7\x1e\xc0\x3a\x7f\x42\xec\xd4\x3e\xed\x5b\x9e\x5c\x9f\xc5\xda\xbc\x55\x6f\x9d\xa2\xbe\x35\x2f\xf4\xd2\x08\xd4\x0a\x96\x20\x74\xd4\x7d\x06\x04\xfb\x84\x26\xc2\x02\x15\xd1\x27\x03\x22\xce\x37\x26\xa8\x90\xfe\xa3\x06\xde\xab\xda\x0e\xeb\x21\x7e\x53\xb3\xc1\x4a\xf3\xa6\x9d\xe2\xeb\x54\xff\x9b\x1b\xa9\x7e\x88\x14\x8a\xf5\x03\xa0\x32\x74\x90\x96\x2a\xd2\xc1\xe2\x33\xc5\x3b\x71\xbd\xcb\x17\xef\xca\x66\xf3\xce\xdc\x4a\x4b\x0f\x3e\x74\xc4\xab\xcb\xb2\x59\xd5\x2e\xc3\xb9\xb4\xe8\x18\x81\x92\x95\x8b\x94\x15\x8a\x4f\x79\xc6\xc6\xd0\x70\x87\x8b\xb1\x6f\x3d\x1e\xe1\xed\x43\xfb\x4b\xb6\x30\x2f\xbb\xd8\xda\xc4\x0d\xe0\x80\xb3\xce\xde\x5d\x41\x13\x27\x48\x85\x69\x6d\x4c\xda\x07\x42\xb3\xeb\x7e\xbf\x0f\xfe\xbb\xbd\x7f\x59\xab\x26\xcd\xf6\xc9\xcf\xcc\x3d\x47\x42\xa3\x29\x05\x9d\xd4\x27\x32\x74\xfa\x81\xb9\x56\x94\x05\x86\xc6\xe4\x38\x77\xd7\x81\xbd\xd3\xaa\xcf\x78\x9c\xd7\xee\xe7\x0c\xc0\x9c\xab\x98\xff\x53\xb4\x80\xd6\x74\x88\x76\x2c\xed\x7d\x9c\x68\xd9\x1e\x09\x71\xfd\xc2\x9d\x0b\x94\xe8\x59\x9e\x71\x71\x5d\xa1\x87\x8f\xa4\xe5\x63\xac\xeb\xe5\xe2\xda\xef\x1a\xc5\x68\x76\xfb\x89\xd1\x84\x47\xd7\x76\x5a\x98\xce\x42\x09\x57\xb3\x02\xf3\xd8\x82\xf0\x72\x49\x56\xb1\xa8\xdf\xd9\x79\xd2\x14\xe3\x3a\xd1\xbc\xbd\x78\x3f\xbd\x3c\xba\x3c\xad\xc9\x76\x41\xf0\xb3\x4f\x19\xb0\xbb\xed\x70\x85\x97\x7c\xd2\x16\x04\xff\xad\x59\x86\x53\x9f\x64\x65\xd3\x5f\x62\x12\xf5\xb9\x54\x86\x66\x6b\x10\x9c\xc9\x84\x16\x87\xa5\x99\x1c\x73\x9d\xc8\x29\xeb\xc8\x0d\x71\x33\xc1\x0e\x64\xbe\xe1\x02\xf7\x4c\x8a\xcf\x20\x47\x7f\x3b\x3c\x27\xb4\xb4\x5c\x67\x5c\x73\x99\xb5\xa5\xa7\x79\x0a\x5c\x62\xc9\xef\x23\xbe\xbf\x7b\xc2\x46\xbd\xfd\x36\x28\xfc\xe8\x41\x61\x90\x8b\xcf\x25\x10\xcc\x05\x37\x9c\x1a\xa9\x3a\x8b\xd6\x1d\x95\xda\xc8\xdc\x6d\x91\x53\x3f\x3c\xe4\x38\x81\xaa\x55\x7b\x62\xbd\x1b\x2b\x18\x8a\x40\xde\x53\x61\xcd\x3a\x9a\xb0\xb9\x3a\x93\x1e\xf4\x6f\xc1\xb1\x79\xb8\xe7\x1b\x57\x6d\x04\xc0\xe4\xd9\xb7\xaf\x6a\xbd\x0d\x17\x5a\xde\x7a\xa7\x63\xd5\x46\x75\x6d\xde\x62\xfe\x5b\x37\xf2\xc9\x39\xf7\x91\x2e\xff\x55\xd2\x0c\xe9\x79\xb6\x4e\x4f\x78\x7d\x1d\x3b\x79\x4d\xcf\x53\x7e\xdd\xcf\x82\xf7\xab\xd4\x88\xab\x8e\x77\x18\x45\x85\xb6\xcc\x50\xf7\x2f\xec\xba\x14\x83\x5d\xb2\x67\x92\x62\x7f\x6d\x94\xe9\xaa\x9a\x13\x5f\xd6\xad\xfd\x9b\x50\xc5\xd9\xee\xbd\xd6\x9e\x37\x00\x7b\xb8\x1b\xe7\x69\x8d\x40\xa8\x92\x91\x37\x5c\x1b\xdf\xc6\x15\x3e\xe0\xda\xf5\xbd\x02\xed\xfb\x9c\x48\x45\x78\xf1\x0f\x9a\xa6\xea\x15\x9e\xf5\xce\x3a\x84\xff\xd6\x01\xa1\x9c\x8a\x90\xb1\xb2\x67\x66\x85\x6b\xaf\x70\x75\x74\x4e\xb0\x3b\xf4\xd7\x7f\x79\x01\x9a\xf8\x97\x5f\xfc\xe5\x45\x4b\x56\x7b\xaa\xd5\x71\xa4\x6b\x2f\x64\xe7\x79\x0a\xcf\xa4\x86\x02\x14\x50\xac\x9e\x80\xd3\xcd\x49\x41\xe4\x7b\xcb\x84\xe1\xcc\xed\x52\x4d\xdd\xd6\x1b\xfc\x81\xea\x0d\x48\x28\x18\x47\x39\xfa\x58\xf2\x19\x45\xf3\xf9\x53\x11\xcd\x0d\xa9\xd9\x94\x73\xeb\x1c\x8b\xd2\x6d\x77\x57\xc7\xb9\x1c\x50\x4f\x79\x7c\x76\xf9\x8f\x37\x87\xdf\x9f\xbc\x81\xf7\x74\xd9\xf0\x96\x15\x9d\x59\xd2\x24\x77\x7b\x75\xd6\x6e\xee\x29\x6a\x4a\xce\x2e\x22\xf6\x67\xaf\x2f\xe7\x5c\x71\xf6\x93\x07\x86\xe9\xdb\xda\x96\x62\xd4\x82\x7a\x4f\x2d\x48\x00\xad\xac\x99\x5a\x4f\x71\x77\xc7\x11\x86\x08\x40\xbd\xe6\xd4\xb0\x3c\x84\xef\xd8\xda\xef\xd0\x90\x37\xc8\xc6\xa9\x71\x77\x07\x93\x2d\xc5\x90\x8a\x9d\x87\x91\x3f\x29\xb5\xdb\xa9\x87\xaa\x2b\xe4\x81\xdd\x4b\x18\xcb\x27\x3c\x58\x11\x86\x79\xd4\xca\x9e\xa8\xf6\x2c\x65\x3a\x34\xbd\x7d\x06\xdc\x5a\x2c\xeb\xf6\xd6\xfe\x74\x58\xda\x44\xce\xb5\x3a\xc6\x20\x4d\x2d\x44\x5f\xab\x5e\xbe\xad\x6b\xa2\xcf\x65\xa4\xce\x55\xa5\x0b\x9a\x74\xda\x8b\xa7\xfa\x08\x3f\x01\xa0\xb7\xa7\x78\xc0\xc0\xc4\xd7\x54\x66\x15\x9e\xdd\xcd\x76\x3c\xf2\xc3\xcd\x83\x81\x3c\x88\x4b\x7c\x97\xe8\x42\x7a\xb0\x97\x18\x35\x64\x23\x59\x88\x6c\xdc\x39\xf4\x73\x43\x07\xc2\x3a\x9d\x07\xc5\x44\x1a\x29\x3a\x2e\x21\x3d\x5f\x32\x68\x5d\x9e\xe1\x1d\x47\x55\xfb\xf5\x8a\x2f\xb0\xc2\x26\x84\xa6\xad\xc1\xe1\x4f\x6c\x29\x7c\x90\xba\x1e\xa2\x7e\x7a\x02\xa8\x48\x4f\x8f\xd7\x20\x7b\x9e\x3e\x0c\xcf\x43\x83\x73\x6b\x4b\x2f\x4d\x3b\xaa\x4b\x3f\x3d\x76\xb6\x80\xaf\x3d\xd7\x6e\xf3\x90\xdb\x77\xcf\x5a\xf4\x24\xa9\xcc\x8d\x54\x5d\xc1\x97\x9d\xd7\x86\x9b\xcb\x57\x74\xdf\x2d\xe0\x49\x3c\x4f\x59\x81\x6f\xf9\xe4\xe5\xc5\x25\x24\x72\xcd\x75\x94\x9c\x97\x10\xa1\x52\xf7\x11\x84\xc8\xd3\x11\x1e\x9d\x6a\x25\x8f\x0b\x1b\xb5\x36\x93\xd6\xef\x8a\x4e\x68\xf4\x93\x1b\xcc\xb9\x36\x2d\x7f\x54\xe2\x96\x06\x61\xe4\x1e\xba\x16\xf1\xaa\xa4\x15\x3f\xcd\xa4\x48\xfd\x40\x31\x2c\xd7\xd8\xca\x2f\xcb\xec\x7a\x4a\x11\x37\x01\x74\xe0\x52\x3d\x82\x7d\xf4\x72\x5a\xb8\x7e\xe3\xa9\xbc\x11\x37\x54\xa5\xe4\xf0\xfc\xf4\xd3\x0b\xd1\xd6\xc5\x8f\xb8\x0b\xda\x60\xd2\xd7\xa8\x08\x28\xf4\x43\x6e\x34\x66\xb3\x43\x3e\xba\x89\x7d\x48\xf6\x00\x0a\x19\x22\x56\x84\x59\x71\xe5\x66\x11\xe9\x48\x82\xc8\xc4\x50\xd7\xd9\x3d\x74\xbd\x7f\xf1\xe2\x05\x86\x14\x5e\xfc\xf5\xaf\x7f\x25\xd0\x75\x31\x65\x09\xcf\x17\x6f\x84\xbb\xfe\xfc\xf2\xe5\x80\xfc\xfd\xf0\xed\x1b\x42\x13\xb0\xc0\x10\x52\x15\x47\x86\xb5\x8b\x7f\xac\x7b\xe4\xff\x5e\xbe\x3b\xab\xda\xbd\xd7\xbf\x05\xd6\xc8\xfd\xeb\x0d\xc8\x71\x94\x7e\x1e\xbb\xfc\xa9\x99\x40\x4a\xbe\x90\x86\xd0\xd1\x08\x98\x13\x45\x32\xd7\x5e\x5c\x78\x5c\x34\x3e\x9e\xf8\x6e\xdd\x96\xad\x32\xc8\x8b\xe7\x76\x8a\x10\x62\xf1\x50\x82\x98\xe6\x0f\x63\x85\xd3\x01\xa6\xd2\x23\x19\xbf\x66\x64\xa4\xa1\x67\x77\xd5\x44\x43\x31\x6d\xed\xa7\x84\x0a\x3b\x3a\x0e\x16\xa6\x6e\x27\xf1\xb4\x73\x17\x5a\x76\x77\xae\x31\xac\x6f\x0c\xe7\xeb\x92\x50\x9e\x58\xb2\x3f\xd5\x5c\x82\xba\xbe\x18\xde\x07\xb9\xc8\x41\xf1\x05\xb1\x49\x68\x26\xc5\x38\x66\xba\x4a\x8f\xf0\x09\x88\xb3\x82\x35\x25\x46\x47\xdd\x54\xba\xe9\x4d\x86\x92\xfb\x2d\x6d\xd9\xdf\xbf\x1e\x5a\x8d\xa0\x10\xe9\x50\x96\xc6\xa7\xbc\xe1\x93\x00\x02\x0b\x30\x12\x91\xe0\xad\x1e\xdc\x59\x63\x9a\xee\x5a\xbd\x75\xd4\x67\xa9\x7e\x10\xd7\x94\xcd\x1e\x61\x34\x99\x90\x6b\x36\xeb\xa3\x88\x2f\x28\xa0\x1f\x00\x9d\x8f\x2d\x75\xb1\xbf\x50\x3d\xa3\x20\x61\xa9\xb5\x03\xdd\x22\xf8\xcc\xc4\x8a\xeb\x03\x7a\x82\x37\x95\xb4\xd3\xa8\x5d\xdf\x22\x11\x39\x0e\x7d\xa3\xc2\x44\x0a\xe3\x9a\x20\x86\x46\x45\x90\x69\x39\x57\x61\x6f\x25\x0a\x4b\xed\xcf\xf4\x5d\x4f\xae\xd2\x31\xed\x91\xe1\x94\x89\x52\x2c\xfc\x1a\x90\xc0\x21\xef\x55\x33\x87\xe7\x43\x7d\x03\xbc\x28\xa5\x73\xc2\x13\xa8\xaa\xb1\xb7\xbb\x7b\x3d\x95\x02\x21\x6a\x08\x00\x9a\x99\xd2\x91\x06\x72\x69\xed\xb3\x99\xd6\x84\xc3\x1b\xe6\x54\x5d\x33\x0f\x66\x4b\xb3\x01\x39\xb7\x93\x0c\x38\xe5\xd8\x37\x6e\x8a\x45\x10\x56\xa6\xc4\xd0\x06\xf6\x21\xbb\x83\xc1\x2e\x9e\x85\x4b\x80\x0e\x5a\xf3\x4b\x97\x2d\xc3\x3a\x6b\x15\x56\xd7\x83\x68\xa1\xb1\x75\x9a\xb5\x0e\xa0\x3d\xa1\x04\xdc\x11\x33\xf1\xda\x02\x6d\x09\x3e\x1d\x5f\x1d\xf7\xac\xea\xb6\xed\x65\x77\x4d\x2f\x5b\x84\xc0\xeb\x57\xd7\xcd\x2e\x3b\x6c\x75\x59\x4f\x38\x76\xf2\xa7\x3a\x41\xba\xea\xbb\xd7\x79\x63\xc5\xbc\x83\xb6\x56\xfe\xba\x0d\xb9\x38\x5f\xc5\xba\x00\x45\xdb\xca\xf2\x27\x65\x4e\x9c\x8e\x40\x86\x2e\x47\x6b\x89\xac\xb4\x70\xa4\x58\x0a\xac\xdf\x8e\xe8\xa2\x57\x3c\xe9\xc8\xb0\x98\xbf\xda\x1b\x1a\xf3\x57\x9b\x64\x96\xf9\x6b\x61\x9f\x87\x33\xb5\x88\x4a\x69\x61\x89\x8c\x84\x1e\x8c\x26\x08\x83\x01\x79\xeb\xce\x5c\x64\x6e\x3a\xd4\x32\x2b\x4d\x80\x55\x58\x72\x20\xc3\xa0\xbe\x63\x23\xc2\x0d\xf9\xdb\xa2\xe3\x19\x14\x13\x3c\xb3\xba\x39\xa9\xf1\xea\x50\xd8\xb4\x4d\x46\xc5\xeb\x0f\x96\x92\x8a\x57\x87\xab\xe0\xf5\xc2\x8e\x57\xe2\xd2\x61\x4a\xfa\x3a\xc0\x9a\xf6\x0a\x69\xa9\x46\xa3\x6a\xec\x15\x51\x6c\x78\xd8\x14\x6d\xb9\xba\xda\x3b\x5e\xdd\xeb\x38\x6f\xe0\xe1\xf9\xe9\xa3\x5b\x99\xd1\xb3\xb6\x76\xe6\x4a\xd7\x12\x87\x2f\x00\x11\x78\x27\xd0\x71\x45\x51\x17\x60\xb3\xf2\xf7\x0f\x60\xae\x2c\xbc\xf8\x6b\x7b\xee\x44\x41\xa9\xb9\x96\x0f\xe8\xc1\xad\x4e\xa8\xa8\x4d\x84\x4f\x97\x01\x69\xf6\xfc\x4d\x9b\x0d\x35\x48\x80\xfa\x2d\xea\x5e\xe6\xaf\xf9\xc4\x52\x47\x44\x72\x09\xbd\xf6\xd1\x7b\x12\xb9\x61\x0a\x99\xbe\xc2\xa6\xcd\x54\x08\x69\xb0\xc7\x7c\x0f\x9b\xf5\xeb\x1e\xba\x57\xac\x92\x19\x25\x5a\xa9\x28\x84\xd9\xb1\x5a\xd9\x19\xf3\x90\xce\x19\x88\x00\x13\x01\xed\xce\xbb\xe1\x24\xf2\x08\xdc\x64\xaf\x4a\x2b\xe9\xb2\xaf\x7a\x3d\xdc\x88\xe3\x7b\x26\xd2\xc9\x84\xe5\x14\x1b\x5c\x78\x02\x59\x79\x7d\xa3\xb8\x31\x0c\xf1\xaf\x99\xca\x35\x91\xa3\x9e\x37\x91\x10\xf5\x64\xfa\x72\xa7\xbb\xfe\xf4\x8f\x60\x2b\x13\xbf\x43\x9b\xc2\x57\xdd\x76\xd5\x7d\xff\x35\x3b\xc2\xee\x4e\x30\x98\x33\xe8\xb8\x23\xe6\x9c\x90\x56\x89\x98\x22\xfd\x37\x9a\x74\x9b\xe7\x66\xe8\x05\x65\x74\xeb\x66\xd8\xba\x19\xba\x18\xf1\xd1\xdc\x0c\xd1\xc1\xed\x85\xa9\x5b\x80\xd8\xf5\x10\xe3\xbf\x7b\xff\x43\x85\xeb\x10\x61\x19\x5b\x96\xf7\x9e\x07\xa9\xea\xfe\xff\xdd\xc1\x60\x77\xd7\xfb\x23\xdc\xfe\x28\xcd\xa8\xff\x35\x61\x22\x91\x29\x32\x95\x1d\x5f\x69\x03\x4a\x6d\x65\x80\xc7\x73\xc9\xfd\xb3\xe2\x18\x02\x8c\xdd\x2d\x4b\x74\x28\xa1\x7c\xce\xc8\xeb\x47\x55\xc1\x2a\xc5\x2b\xc0\x57\x39\x02\x06\x94\x3f\xa7\x81\x55\x39\x2c\x19\xcf\xb9\xc3\xd5\xb3\xe2\x82\x69\xa3\xc9\x1e\x7e\x38\x48\x8a\xb2\xe7\x6e\x18\xe4\x2c\x97\x6a\xd6\x0b\x37\xd9\x2f\x6b\xbf\x72\x77\xec\x83\xd6\x96\x94\x4a\x31\x61\xb2\xd9\x1f\x57\x7f\xf3\x24\xde\x60\xf5\x2d\x70\x45\x9b\x12\x8b\x65\xd7\x5c\xd9\x45\x00\xb6\x07\x47\x5d\xa0\x36\x9c\x43\xae\xd8\xa1\x17\xdc\x47\xf0\x29\x13\x53\x32\xa5\xaa\x71\xb1\xc3\xb2\xeb\x51\x34\xb6\x94\x4f\xb9\x96\x8d\xcb\xc5\x96\x0e\xb9\xe8\xfd\xe2\xae\x11\x80\x2c\x4d\x51\x1a\x77\xba\xf8\xbd\xed\xa1\xe6\xc2\x9e\x9e\x53\x7c\x5f\xee\x74\x38\xb9\x82\x1a\xc3\x94\x78\x45\xfe\x7b\xef\xc3\xe7\xbf\xf7\xf7\xbf\xdb\xdb\xfb\xe5\x45\xff\x3f\x7f\xfd\x7c\xef\xc3\x00\xfe\xe3\xb3\xfd\xef\xf6\x7f\xf7\x7f\x7c\xbe\xbf\xbf\xb7\xf7\xcb\x8f\x6f\x7f\xb8\x3a\x3f\xf9\x95\xef\xff\xfe\x8b\x28\xf3\x6b\xfc\xeb\xf7\xbd\x5f\xd8\xc9\xaf\x2b\x0e\xb2\xbf\xff\xdd\x7f\x74\xf8\x12\x54\xcc\xde\x75\x26\x82\xf1\xea\x3f\x8a\x1a\x51\x1f\xbb\x63\xd6\x25\xe4\x63\xbf\x72\x5e\xf7\xb9\x30\x7d\xa9\xfa\xf8\x90\x57\xc4\xa8\xb2\x2b\xd1\x55\x1d\x7f\x8f\x27\x63\x2a\x25\xa6\x42\x6e\xf4\x86\xcd\x06\x0a\x11\xcc\x1c\x7d\x74\x6f\xb0\x6b\x97\xba\x75\x04\xaf\x72\x3d\x4a\xc2\x91\x43\x86\xf9\x83\x67\x1b\x5d\xba\x8e\xbc\xdb\x54\xa3\x85\x6b\x9b\x6a\xb4\x78\x6d\x53\x8d\x1e\x78\x6d\x53\x8d\x36\xd0\x07\xb8\x4d\x35\xda\xfa\x00\x9f\x88\x0f\x70\x9b\x6a\xb4\xea\xb5\x4d\x35\x6a\x7c\x3d\xcd\x54\x23\xa7\xc0\x57\x79\x46\x1b\x9b\x66\xe4\x1a\xfc\x1f\x26\x89\x2c\x85\xb9\x92\xd7\xac\x65\x54\x76\x25\x03\x73\xe1\x99\x9b\x69\x6d\x76\xa5\x52\x76\xa0\x02\x76\xa7\xfc\xd1\x32\xe5\xd6\xcc\xec\x78\x1b\x1c\xba\x61\xbd\x9d\x69\x8f\x45\x91\xb2\x34\x3c\xcf\x0b\x2b\x63\xd7\x7b\x40\x0e\x89\x62\x09\x2f\xb8\x15\xed\x00\xa6\x03\x9f\xe3\x3e\x09\xfd\x80\xb9\xd1\x2c\x1b\xb9\x9e\xa8\xa2\xaa\x19\x56\x91\x09\xe9\xce\x8a\xa5\x8f\x41\xad\x40\xfa\x36\x96\x44\x4f\x64\x99\xa5\x44\xb1\x7f\x79\x75\xc2\xcd\xe6\x2a\x1e\x21\x76\x84\xc2\xab\x54\x8f\x75\x83\xd3\x82\x3b\xd4\xad\x4d\x12\x70\xec\x63\xc1\x15\x6c\xb6\x4b\x96\x48\x91\x76\xed\xde\x38\x99\x1f\xdf\xaf\xb5\x8b\xe6\xb0\x94\xa4\x25\xde\x00\x85\x90\x34\xe3\x29\x37\xb3\x90\x85\x81\xdb\xde\x2a\xa2\xd8\x85\xd6\x31\x82\xae\x16\x82\xd0\xa2\x50\x92\x26\x13\xa6\xa3\xb7\x41\xb5\xd2\x81\x4d\x84\xfa\xca\xac\x1c\x73\x81\x9a\x25\xfc\xc6\xaa\x21\xd9\x8c\x28\x69\x7c\x42\xd9\x2d\x0f\xbc\x8a\x06\x83\x9f\xa3\x2e\x61\xd4\x0c\xb2\xce\x64\x3c\x04\xce\x8a\x8f\xe2\x3f\x34\x91\x59\xea\x71\x4b\xbf\x7e\x61\x55\xf9\xc4\x71\xb1\x95\xf6\x80\x2a\x69\x24\xc9\xac\x5a\x64\x4f\x80\xdb\x7f\xfc\xc5\x57\x64\x22\x4b\xa5\x07\x31\x84\xc0\x4b\xf8\x0c\x9d\x14\xde\x14\x30\x24\x63\x54\x1b\xf2\xf2\x05\xc9\xb9\x28\xed\x59\xde\x11\xe3\x75\xa5\xbd\x46\x7a\xeb\x5f\xbe\x6a\x39\x5a\x37\x1a\xeb\x62\x06\x8b\xe3\xd6\x02\xfb\xb3\x39\xc5\xd5\xed\x71\x04\xc5\xc0\x1e\x8d\x73\x6a\xac\x3b\x92\xe2\x55\x14\x46\xae\x79\xe7\xff\x56\xca\xe1\xcc\xb4\x87\x81\xf9\x2f\x1c\xa7\x8e\xff\xe2\x3f\x5c\x05\x4b\xb5\x82\x52\x6d\x30\x95\xb5\x77\x8b\x1e\x73\x6d\x1a\xf5\x8a\xae\x70\x63\x1a\xfc\xb8\xed\x61\x3e\xb6\x16\x6f\x27\x45\xeb\x60\x3b\x7b\x5b\xcd\x3b\x95\x93\x84\x69\x10\x45\x1e\x3e\x0d\xfc\xb3\xf8\xd4\x86\x0f\xdd\x2c\xc4\x96\x3b\x11\x59\x3c\xf3\x77\xd0\x19\xb3\x15\xb1\xda\xe8\xf6\x9e\xb1\x3b\xa2\x16\x0e\x56\x97\x11\x9a\x8b\x31\x36\xb2\xcc\xcb\xcc\xf0\x22\xab\x28\x17\x7e\xe0\x0e\xe0\xd8\xe1\x4f\x23\x0f\x33\x45\xe0\x28\x84\x06\x87\xe0\xc8\x5e\x18\x8b\x09\x83\xfd\x18\x95\x3d\xc7\x0b\xaa\x68\x20\x7f\x22\xf3\x9c\xea\x7d\x17\x3b\xa0\x90\xbb\x82\x92\xdd\x1e\xc3\x8a\x66\xe1\xf5\xe3\x5c\x81\x75\x31\xae\x61\x82\x8a\xc6\x41\xbb\xba\xc3\x05\x86\x22\xf2\x26\xa4\xc7\x63\xff\xf4\x39\x8e\x75\x0a\xf1\xf7\x34\xb9\x66\x22\x25\xef\xb5\x27\x5c\x3a\x13\x34\x77\xe0\xea\x85\x92\xd8\xb7\x9b\xa5\x73\xbf\xd7\x3d\xe7\x48\x44\x94\x11\x0f\x02\x85\xfa\xd6\xba\xa8\x58\xea\x8e\xd0\x75\xdf\x6b\xab\x7c\xdd\x2d\xef\x34\x3a\x69\x15\x9f\x26\xcc\xeb\x8e\x76\x02\xeb\x7a\xf9\x69\x63\xc4\x37\xb2\x1c\x87\xc9\x35\xcd\xc4\x5d\x08\x47\x7a\x08\x3e\x02\x8e\x3a\xcd\xac\x88\x9b\x05\x78\x9d\x39\x06\x1b\xce\xd6\xd7\xb2\x5f\x0d\xdb\x03\x34\xed\x5e\x7c\x7f\x5c\x17\x66\x17\x34\x95\x9a\x7c\x9f\xc9\xe4\x9a\x1c\x33\x30\x1a\x1e\xb3\xdd\xbb\x1a\xa6\x4f\xbb\x4d\x63\x4e\xc7\xcd\xf2\x3c\xfa\x24\x97\x82\x1b\xa9\x9a\xc8\xe3\x0d\x02\xdb\xdb\xb6\xda\x5b\x0e\x22\xae\x86\xe9\xb3\x69\xb4\x67\x99\xbc\xa3\x0e\xbb\x13\x46\x14\x88\x18\x18\xd4\x77\xff\x68\x28\x30\xfe\x34\x91\x37\x7d\x23\xfb\xa5\x66\x7d\xde\x38\x4f\xa9\x35\x7d\xae\xd9\x0c\x92\xbe\x3a\xa1\xd0\x8f\x38\x58\xcd\x44\x37\x12\x3c\xe7\xf0\xb9\x55\xe4\x2e\xbe\x3f\xb6\xa7\xf7\x20\x36\x4b\x0e\x98\x49\x0e\x12\x56\x4c\x0e\xdc\x74\x9e\x3c\x59\xbd\x7c\xec\x86\xae\x87\x24\x91\x59\xe6\x70\xbb\xe4\x88\x1c\xb1\x62\x12\x1e\xb1\x19\xb4\x7a\xca\xcd\xd2\x0a\x29\xbb\x69\xac\x14\x89\x08\x3b\xa6\x93\x10\x11\xa3\xab\xe1\xc3\x7a\x41\x6f\x22\x6b\x7f\xc2\x9e\x24\x4d\x7a\xcb\x6d\x04\x79\x37\xa7\x47\xdd\xee\xa5\x1f\x0e\xfc\x3f\x51\xb8\xb9\xde\x92\xce\xe7\x8b\xd6\x44\xf4\xe9\x08\x2d\xcc\x94\xa5\x44\x4e\x99\x52\x3c\x65\x9a\x04\x19\x1d\x3b\x96\x78\xb6\x19\x94\xdf\x76\xc7\x7b\x5a\xf9\x01\x9b\xe3\x53\x88\x84\xb7\x1d\x73\x51\x78\xd3\x34\xe7\x62\x33\xb8\xbc\x21\xbd\x74\x42\x33\x76\xfa\xae\xb5\xe9\x7d\x89\xe3\xd4\xad\x6f\xff\x61\x04\xb2\x7f\x0f\xf0\xfc\x8f\x81\x67\x89\x90\x69\xb3\x68\xd8\x9a\x6d\xe8\x31\x35\xec\xa6\xa1\xe2\xd3\xaf\x44\x7d\xd3\xdf\x83\xe5\xf5\xb4\x6d\xf0\x35\x35\xc8\x88\xf6\x35\xa2\xde\xaf\x4b\x9d\x72\x1c\xd4\x8d\x6b\xd9\x93\x62\xae\xbf\x98\xdf\x9a\x87\xe7\xa7\xe4\x07\x7c\xde\xfa\x3a\x7e\x28\x69\xd0\x92\x39\x96\x39\xe5\x1d\x35\x62\x8f\x1a\x3a\xc5\x2f\x7c\x1e\x1e\x46\xf0\x69\x71\x17\xfa\x11\x1f\x97\x8a\xa5\xc4\x79\x3f\xb6\x6d\x0c\x36\xb8\x8d\x41\xb7\x4a\x71\xa5\x13\x47\x1e\x73\x5f\x19\x53\xe9\xc1\x9e\x8b\x40\x1d\x08\x29\x48\x44\x33\xa1\x39\x64\x1d\x44\x89\x71\xa0\x2c\x43\xfe\x77\x28\x83\x41\xc5\xb9\x47\xde\xc8\x31\x17\x5e\x3a\x49\x97\xec\x32\xa2\x3c\x6b\x47\xce\xad\xa6\xfb\x07\xd3\x74\xb5\xce\x4e\x04\x1d\x66\xcd\x33\x19\xeb\x07\x6f\x46\x21\x4f\x8a\xc1\x98\x07\x29\xd7\xf6\x5f\x72\x79\xf9\x06\x62\xb3\xa5\xf0\x96\x21\x44\x1d\xdd\xb1\x11\xea\x8b\x51\xb8\xac\x4f\x1e\xa0\xcc\xee\xac\x4f\xc5\xa9\x48\xed\xeb\x32\x5d\x4b\x00\x76\x4f\xc1\x2e\x20\xa1\x7a\x0d\xb3\x0f\x87\x8c\x5c\x4d\x78\x72\x7d\x1e\x85\x60\xa5\xb2\x9f\x89\xe8\xa3\x9a\xa2\x31\xff\xdd\xba\x0e\x1c\xf7\x5a\xe7\x5d\xb9\xbd\xae\xa2\x13\xf7\xd2\x91\xcc\x0e\x4e\xa8\xd6\x32\xe1\x55\xcc\x1f\x9c\xc2\xd5\x91\x9c\xc2\x91\xbc\x3e\x32\x80\x96\xf8\x28\xfa\x87\x67\x1c\xa7\xb4\x52\x1d\xeb\x1b\x5c\x78\x6a\xad\xed\xd5\x91\x95\x3b\xeb\xae\x79\x55\xeb\xa7\xe9\xad\xbe\xb9\xf0\xb3\xaf\x07\x75\x8c\xe2\xf5\x79\xd7\xc0\x79\x91\x55\x42\x5f\x4d\xd7\xdf\x63\x2d\xc4\x6a\x5e\xac\xbd\xcc\x0b\x37\x97\x7b\x83\x9f\xb9\x80\x34\x08\x95\x42\x16\x65\x86\x59\xab\xed\xdb\x8a\xfa\x68\x1e\x3e\x67\x0d\x01\xea\x4d\x6b\x46\xf4\xd0\x72\xbe\xe7\xd1\x97\x28\x32\x08\x5e\xfc\xe5\xab\xaf\x9e\x7a\xa7\xa2\x76\x8e\xb3\x75\xb7\x2a\x6a\x15\xea\xda\xa2\x14\x6c\x51\x0a\xee\xba\xd6\x1e\x89\xfd\xf4\x38\x04\x9d\x14\x89\x75\x51\x20\xd6\x16\x69\xa0\x65\x71\x59\x37\x85\x65\xad\xb1\x04\x1e\x15\x41\xa0\xa3\x1a\xab\xf6\x68\x01\x5b\x8c\x80\x3f\x06\x46\x40\x77\xb5\x55\x5d\xe1\x01\xb4\xaf\xa9\x7a\xfe\xb5\xff\xad\xc5\x44\xdb\x0a\xf3\x87\xd7\x95\x77\xd5\xbf\xa2\x2b\x3f\x7b\x67\x8e\x81\x9a\x57\xd7\xd9\xbb\x9e\x33\x30\xf3\xba\x42\x7c\x37\xd2\x0a\x8d\x35\x5a\xbb\xa4\xb5\xb3\x00\xa7\x22\x1b\x9d\xc1\x75\xae\xc1\x91\xde\x5d\xce\x85\xd8\xc3\xc7\x9b\x1f\x59\xdf\x86\x98\xb7\x6d\xd4\xb7\xf1\xc7\x70\xdd\x12\x7f\xd4\x35\x8c\x57\xef\x11\x04\x49\x08\x2a\x98\x1c\xc6\x7d\x54\xaa\xfd\x7f\x78\x7e\x4a\x12\xc5\x00\xd2\x80\x66\x7a\x40\x96\x68\x68\x3e\x52\xe3\x34\x3a\xaf\x99\x51\x63\x58\x5e\x98\xb6\x0c\xb7\x0d\x3f\xfe\xc1\xc2\x8f\x1d\xc7\x0c\x7e\x0a\xc3\x79\x6f\xd1\xa4\xcc\xa9\xe8\x5b\x69\x01\x81\xc8\x5a\x3e\xc7\xdc\xc1\x37\x20\xbe\x06\x0e\xa9\x49\x15\x43\x70\xf3\x52\xf0\xdf\x4a\x56\xf9\x17\x82\x7a\xb1\x01\xa1\x16\x98\x47\xc7\xb4\x43\xd5\x69\x4e\x8a\x24\x72\xa1\x94\xc9\x11\x24\xd0\xd1\x0b\x8c\x48\xff\xaa\xf9\xca\xcc\x84\xa1\x9a\x76\x0e\xe0\x00\xd5\x5d\x75\xfb\x0e\x0d\x3c\x9a\x65\xf2\x06\x9f\x1d\x2b\x1e\x76\xfd\xec\x5c\x1c\x1e\xc7\x90\x91\x9c\x2b\x25\x95\x0b\xf1\xc4\xd3\xc1\xb4\x1c\x6b\x27\x32\x85\x06\x97\x72\x59\x15\x97\xcc\xc4\xac\x62\x24\xa1\x02\x0b\x17\xed\x7f\xfb\xa4\x64\xec\x7f\xe6\xe4\xdd\x90\x4d\xe8\x94\xcb\x52\xe1\xaf\x8d\x24\x3b\xee\x2b\x38\x72\x67\xb2\x0c\x6e\xee\x12\xea\x94\xc2\xdb\xe9\x25\x74\x3a\xab\xbe\x04\x03\x35\x95\xde\x7f\xd8\x67\x1f\xb9\x36\x8b\xef\xe2\x49\xe4\x1b\x24\xac\x83\xf3\xa6\xba\xb0\x07\xec\x4f\x8d\x6b\x4e\xeb\xfc\x16\x8f\x56\x57\x49\xa7\x97\xf0\xd5\x7d\x0a\xa9\x43\x6a\xc1\x52\x71\x5f\x14\xf6\xf4\xd2\x3d\xf1\x2d\x1b\x76\x66\xda\x6a\xc4\x4f\x45\x23\x0e\x09\x12\x19\x4f\x66\xa7\xc7\xdd\xe8\x7c\x21\x31\xc2\x0e\x4a\xbe\xa7\x9a\xa5\xe4\x2d\x15\x74\x8c\xce\x91\xbd\xcb\xf3\xef\xdf\xee\x5b\x26\x01\xe7\xcb\xe9\xf1\xd2\xec\x89\xcb\x78\x66\x67\xeb\x2a\xdf\x26\xf3\x34\xea\x4c\x2b\x78\x20\x95\xd6\x56\xc0\x4e\xc2\xc9\xde\xa6\x65\xd7\x22\xb8\x11\xa6\x43\x78\xa4\x32\x3d\x2f\x5e\xa7\x79\x7a\xfd\x98\xaf\x1b\xf9\xaa\xef\x7a\xa7\xd5\x02\x4d\x2b\x04\x93\xe6\xd6\x5e\x51\xc3\xc6\xb3\x63\x56\x64\x72\x66\x97\xfb\x3c\x72\x9d\xe3\xad\x43\x3c\xea\xd5\x90\x26\x44\x95\x19\xc3\xee\x35\xf3\x10\x61\x82\xb1\xb4\x92\x53\x5c\x68\x43\x01\x20\x0c\xc7\xbf\x73\x46\x2b\x1f\x30\xab\x1e\x25\x7d\x9c\xe7\xbd\x77\xd5\xe1\x14\xed\x86\xba\xf3\x27\xab\x1f\x26\xf0\xf8\xfb\x39\xf4\x21\xc1\xc3\x95\xc3\x84\x75\x06\x87\x3d\x7d\x51\x66\xf6\xe8\xc8\xd2\xb9\x26\xa2\xa0\x5b\xb9\x35\x46\x64\x06\x90\x00\x76\xf6\x3d\x32\x2c\xad\xe2\xc5\x74\xcd\xbf\xbc\x08\x4b\x79\x33\xc1\xb8\xb1\xfd\x11\xa1\x45\x91\x71\xcc\xeb\x95\xca\x05\x7f\x23\x6f\xe3\xe2\x6d\xab\x08\x92\x07\xea\x1f\x0f\xd3\x37\xfa\x64\xca\xd4\x70\x15\x4c\x85\x87\xaa\x12\xb4\xe0\x10\x3b\x59\x59\xf3\xa8\x83\x42\x9e\x9f\xe2\xaf\xbd\xa5\x16\x9b\x66\xfe\x4b\x5c\x41\xb7\x36\x1e\x50\xd0\x75\xa5\x41\x6b\x23\xa0\x02\x1d\x9e\x9f\x22\x0c\x95\x03\x06\xaa\x5c\x16\x56\xb7\xa7\x98\x1c\x58\xa1\x11\xd2\xb1\x1d\xd1\x10\x29\xc2\x43\x99\x28\x73\x86\x60\x42\x55\x3b\x2b\x6b\xf0\x89\x59\x35\x7a\xe5\xf1\xb0\xf6\xc9\xea\xea\xc4\xc3\xc3\xe8\x0f\x0c\x9b\x3f\xf8\xe4\x11\x52\x5c\xb8\xd7\x7c\x7f\xf1\xa6\xd9\x22\x9e\xd5\xc7\x70\xe0\x31\x0c\x70\xf2\x0a\xaa\x0c\xa7\x19\x29\x55\xe6\xc3\x70\x98\xf4\xee\xd2\xd2\x26\x74\x1a\x01\xec\x0c\x08\xf9\x0c\x57\xce\x11\x16\xf7\x27\xb6\x77\xc5\x95\x1f\x95\x59\xd6\x23\x23\x2e\xa8\x15\xbb\xac\x20\x71\x38\xe8\x92\x8b\xc4\x9a\x5f\xd6\xd6\x77\xfd\x5a\x60\x46\xde\x28\x0b\x9b\x14\xa2\x8c\x10\x2d\x65\x59\x0a\xa0\x8b\xf0\x08\xbb\x61\x13\x70\x11\x58\xab\xf1\x28\x2b\xb5\x61\xea\x42\xda\xc3\x20\xca\x6b\x01\x38\x0a\x1a\x7f\xfd\x3d\x17\x29\xa4\x30\x5d\xc0\xc1\x91\x50\x41\x18\x07\xe7\x8b\x1d\x12\xe2\xd4\x96\x77\x2a\x86\xda\xd3\x65\x32\xb1\xaf\xb4\x53\xc8\x54\xef\x58\x31\xb2\x83\x2e\x3a\xbd\xb3\x6f\xff\x9a\x7f\x07\x4c\x53\x89\x7e\x77\x40\x0b\xbe\xb3\xdf\x23\x40\x20\x08\x9c\x49\x33\x79\xba\x7c\xe8\xdf\x15\x6c\xe2\x46\x5c\x78\x11\x8f\x00\x3c\x28\xaa\xee\x5f\x37\x13\x6e\x58\x68\xbe\x8d\x9e\x9d\x80\xaf\x32\x2f\xac\x09\x39\x14\x84\xe5\x85\x01\x6f\x31\xc9\x19\xf5\x21\x64\x36\x65\x6a\x66\x6d\x72\x00\xa2\x78\xf2\x9b\x3f\xf0\x63\x2b\x82\xcf\x75\x36\xaf\x98\x1c\x76\xd8\x02\x71\x77\x3f\xdb\xad\xd9\xf9\x59\x16\x49\xf3\x27\x4b\x4a\x38\x5e\x1b\x91\xf1\x27\xfb\xcb\x3a\x09\xf1\x23\x94\x96\x41\x7e\xbc\x79\xe3\x02\x19\x48\xab\x1f\xb9\x48\x51\x45\x3d\x34\x46\xf1\x61\x69\xd8\x05\xb3\x13\x4e\x30\xe5\xc1\x77\xe1\x73\xd9\xd1\x6e\x25\x96\x92\x1f\xe6\xfe\x14\x49\xbf\xa8\xd8\xae\xaa\x8c\xde\x31\xbc\xd7\xe5\x6f\x1b\xea\xce\x01\x9c\x41\xf0\x56\xa6\xcb\x37\xd5\x5c\x65\x48\x75\xb3\x53\x55\xa2\xce\x96\x7e\x2c\xa7\xc4\xce\x8a\xa5\x9a\xfe\xdd\xcb\x71\x07\xe9\x6f\x9b\x49\xe5\x1b\x00\x09\x1a\x7d\x73\x35\x2b\x98\x43\xda\x26\xa3\x8c\x8e\x2b\x36\x02\x79\x88\xea\xd3\xd1\xe5\x4f\xfe\x15\x34\xe1\xcb\x15\xd9\x7b\x35\xdd\xfb\x74\xdb\x7e\x45\xa5\x5b\xef\xb0\x0f\x59\xfa\xe5\xfd\x0a\x6e\x18\xfc\x76\x6e\x5a\x25\xec\x67\xee\x74\xa9\xdd\x46\xff\x2b\x87\xf0\x45\x23\x4e\xf0\x00\x62\xde\xdc\x84\x4c\x24\xd0\x50\x2e\x7f\xaa\xb1\xc9\x3d\xf3\xbd\x85\x69\xaf\xd9\xec\x46\xaa\xe5\x68\xe0\x8d\xf9\xeb\xce\x27\x62\x77\xfe\x7b\x37\xc8\x5b\x5a\xd8\xd7\xae\xf2\x3c\x51\xe0\xb9\xa8\x23\x5a\x05\x98\xa1\xe5\xb3\xe2\xa4\x1a\x53\xc1\xff\x8d\xc9\xb1\x89\xdd\xc7\x52\xd9\x3f\xf7\x30\x72\x81\x16\x7d\xc6\x12\xb3\xef\xf8\x6f\xa9\xdc\xbb\x87\x41\x69\x9a\x72\xd4\x2b\xce\xef\xe1\xa5\xbb\x89\xc0\xc5\xf5\x63\xd0\xfc\x8e\x8d\x75\x3f\xef\xdf\x1d\xfa\x5c\x41\x36\x97\xea\x8e\xec\xa6\x3b\x7f\x9f\x53\xee\x3a\xba\x6e\x1c\x55\x58\x4e\x79\xd3\xd7\xc2\xab\x05\x5d\x73\x6a\x4a\xc5\xcd\xd2\x03\xe9\xee\x1f\x72\xf1\x63\x39\x64\x2e\xda\xfb\xe0\x9f\x0b\x48\xde\x3b\x3c\x3f\xed\x76\x39\x16\xe1\xa5\xdd\x04\xad\x46\x43\x4a\x41\xf3\x21\x1f\x97\xb2\xd4\xd9\x2c\x76\x57\x52\x08\x56\x5b\x73\x1f\xfd\x35\x62\xd7\x10\x2a\xa4\x98\xe5\xee\x56\x91\x64\x65\xca\x6a\x23\x42\x4c\x6f\x2a\x79\x4a\x68\x69\x64\x4e\x0d\x4f\x48\x22\xf1\xbb\xfa\x48\xa5\x66\x84\xde\xf2\xdb\xa4\xd4\x46\xe6\x24\xa7\x4a\x4f\x68\x96\xdd\xb6\xc6\x1d\x9c\x6a\x77\x01\x68\xf7\xe1\xfd\x6f\xfd\x72\x8a\xb3\x6e\xc8\xdf\xf7\xe0\x85\xaf\xc0\xdf\x76\x72\xad\x06\x98\xde\xce\xa5\x2b\x8c\xe1\x4a\xe2\x97\xc2\xf5\xdc\xb3\x30\xf7\x51\xe7\xae\x9d\x7b\xef\x7b\xdd\x21\x0d\xef\xfc\x2d\xa4\xce\xb2\xf4\x34\xa7\xe3\x15\x14\xc9\x37\xd6\x6e\xa0\x62\xe6\x7f\x86\x28\x92\xba\x47\xa4\x72\x39\x20\xa1\x27\xb7\xfb\x2a\x00\x90\x2a\xf2\x0e\xc2\x6c\x52\xb9\x64\x6a\xc7\xa5\x90\x5a\xcf\xd4\x48\xaa\xdc\xea\x75\x5c\x91\x51\x29\xd0\xb4\x70\xb9\xd7\x60\xac\x38\x2f\x0e\xcd\xb4\x0c\x3b\x10\xe2\x76\xc2\x4f\x82\x50\x4d\x6e\x58\x96\x0d\xc8\x61\x96\x39\x78\xcb\x08\x1a\xa1\x2a\x79\xae\x32\x04\x86\x33\x92\xf2\x31\xd3\x86\xec\x5d\xfe\xed\x70\x1f\x4e\x6d\xf0\x70\xcc\x88\xa1\xbe\x4e\xac\xee\xb9\x81\xf3\x3f\x2d\x41\x4f\x48\xa8\xa1\x99\x1c\x63\x90\x1c\x3c\xb8\x22\x25\x45\x46\x67\x00\x52\x5f\x50\x05\x89\xa2\x09\x7a\x6f\x88\x2a\x05\xc0\xf3\x7e\xd2\x13\xe7\x7e\x51\x70\x17\x82\x6e\x1f\x78\xb2\xe1\x56\xbf\x07\xb5\xf4\x71\x8f\x32\xc5\x8a\x8c\xde\xe2\x6f\xb8\xa3\xec\xd7\xaa\xb9\x60\xc2\x4a\xc1\xc2\x18\x03\x72\x89\xbc\x93\x53\x93\x60\x08\xf3\x9f\x39\x33\x34\xa5\x86\x0e\xac\x2d\xf8\xcf\x7a\x61\x9a\xcc\x52\x3b\xd0\xed\x0b\x7d\xcb\x9c\x51\x5f\x5c\xde\x8c\xbd\xbe\x0b\xad\x52\x1b\x6e\x07\xfd\xdc\xef\xc7\x3b\x1d\x1c\x2d\xe5\x13\xbc\xfe\xc9\x47\x6b\x8a\xdd\x19\x5d\xab\xcd\x75\xfe\x47\x75\xff\x43\x56\x7f\x13\xc7\xad\x39\x03\x5c\xc4\x2b\xd7\xcf\xc7\x7f\x02\xce\xd5\xc3\xb3\xe3\xdb\x1d\x61\xf7\xbb\x0c\xee\x71\x11\xd4\x43\x06\x77\x4c\xcf\xbb\x9e\xdd\x37\xf5\xb8\x81\x2f\x4e\x81\xfa\x3d\x2c\xf5\xa0\x1e\x3c\xc5\xdf\x8c\x0b\x56\xaf\x3c\xc4\xdf\xdd\xee\x1f\x59\x29\x70\xb3\x4a\xb8\xe6\xbe\x42\xaf\x7e\x98\xec\xad\x37\xad\x16\xbd\xb9\xb7\x18\xab\x46\x70\x57\xed\x08\xc5\x95\x40\x79\x28\xd9\xf0\xce\xd3\x40\xec\x55\xa3\x5d\x2b\xfa\x77\xfc\xab\x3e\x60\xa2\x61\x29\x6b\x69\x44\xd7\x6c\xb6\xab\x5d\x2d\x8a\x14\x7a\xc2\x0b\xac\x16\x74\x01\x0a\xb7\xba\xe4\x27\x9a\xf1\x34\x0c\x81\x5c\x7d\x2a\x7a\xe4\x4c\x1a\xfb\xcf\xc9\x47\xae\x0d\x9a\x9f\xc7\x92\xe9\x33\x69\xe0\x93\x4e\x5e\x15\xa7\xf0\x80\x17\x75\x06\x30\xfa\xb8\x61\x5f\x45\x66\xb2\x7f\xa1\x53\x27\xf6\x3c\x51\xb8\x26\xa7\xc2\x6a\x04\xee\x8d\x42\x15\xad\x76\x43\xf8\x42\x11\x21\x45\x1f\xbc\xdf\x4b\xc7\x70\x84\x90\xaa\x46\x87\x3b\x86\x73\x43\x61\x3a\x1f\x7c\xc3\xb5\x17\xe2\xe1\xcc\xa6\xde\xed\xc6\x13\x92\x33\x35\x86\x78\x4e\x72\x4f\x3c\x63\x55\x57\xe4\x4a\x0e\xc8\x7b\xd7\x0a\x44\xe6\x9b\x5b\x1d\x17\x0b\x8b\x14\xdd\x8f\x62\x29\x47\x6f\xc6\xff\x58\xe9\x03\x94\xfa\x5f\x28\xa5\xd6\x03\x72\xe8\x9b\xa5\xc4\xdf\xb9\xb8\x56\x3c\x8c\x1d\x81\x6b\x62\x45\xc9\x94\x66\x0c\x91\xe3\xa9\x08\x55\x50\x72\xb4\x20\xd8\x7b\xae\xa4\xda\xee\xd9\xa0\x32\xed\x5c\xb3\xd9\x4e\x6f\x61\x69\x77\x4e\xc5\x4e\x55\x02\x57\x5b\xcc\x20\x44\x41\xdb\xda\x81\xef\x76\x9a\x9f\x05\x77\x0a\xcb\xd5\xdd\x2b\xf7\xae\x9b\xbe\xe6\xcb\x03\xd3\x4b\x95\x8d\x3d\xbd\x6f\x49\x08\xc1\x60\x45\x72\xa9\xc0\x9d\x69\x3f\x8d\x81\x34\xac\xaa\x7a\xcd\x8b\xa2\xc2\x1d\x29\x8b\xb1\xa2\x29\x23\x63\x45\x8b\xc9\x43\xd5\x12\xd4\x6d\x96\x0d\xff\x64\x14\xdd\x5b\x88\x7f\x87\x45\x57\x23\xbf\x37\x40\xbc\xe1\x0d\x9b\xe5\x46\xd1\xa2\x60\x8a\x50\x25\x4b\x70\xda\xe5\x53\xa6\x06\xfe\x16\x4c\xb9\x08\x7e\xe6\x44\x2a\xc5\x12\xe3\x4d\x74\x97\x15\x8c\x05\xab\x22\x85\x6a\xd4\x07\xab\x7d\x37\x6c\x38\x91\xf2\x1a\xaa\xe6\x80\x1d\x1f\xd1\x0b\xf2\x33\x3e\xeb\xb8\xfa\xcc\x1b\xb4\x9a\xa4\xcc\x50\x9e\x41\xae\xc9\xbb\x37\x6f\x5d\x36\x8a\xd7\x26\xfc\x2c\x97\x27\x76\x74\x60\x86\xd0\xd4\x65\x49\x5d\xb0\x29\x67\x37\x8e\xfe\xb7\xe5\x91\xf4\xc9\x98\x09\x48\x9e\xb8\x23\xc9\xa8\x4f\x34\x4f\xd9\x09\x14\xe3\xde\x3e\x50\x0b\xf7\xfd\x2d\x73\xbe\x4f\x84\xdc\x7d\x8e\xdc\x7b\x86\xac\x70\xd6\x07\x23\xfc\x5c\xaa\x3b\x80\x7f\x56\xab\x0d\x5e\xad\xee\xd7\x65\xa7\xbf\x22\x5f\x7d\xf5\xe5\xad\x37\xe5\xf4\x23\xcf\xcb\xfc\x15\xf9\xcb\x9f\xff\xfc\xe5\x9f\x6f\xbf\x8d\x0b\xbc\xed\xe5\xed\xef\xe7\xf6\xfc\xd1\xc5\xf1\x06\xd0\x3b\x0d\xd9\x7e\x77\x87\x06\x57\x18\x6a\x44\x79\x56\x2a\x97\x92\xba\xa2\xa1\xf2\x3a\xfe\x0d\x84\x75\xaa\x62\x0a\xea\x47\xf4\xc9\x68\x2e\x49\x6d\xc4\x05\xd3\xd0\x1a\xa5\x14\x8a\x25\x72\x2c\xf8\xbf\x59\xea\x3b\xa3\x40\xe2\x09\x00\xac\x7b\x16\x27\x4c\xa4\xd8\x93\xd2\x9e\xbc\x13\x2a\xd2\xec\xae\x84\x84\x15\xde\x34\xde\xc1\xad\x48\x06\xe7\xdf\x83\x08\xf6\xb6\xfa\xc5\x1c\xb9\xa0\xb3\xa6\x0b\x82\xe1\xb9\x8a\x64\x6b\xf5\xa6\x28\x18\x2f\xef\x30\xef\x97\xcc\x71\xc1\xfa\x44\xc3\x19\x3e\xfb\xad\x64\x6a\x06\x85\x23\x95\x79\x11\x25\xaa\x5d\x55\xb8\x02\xfe\x35\x9c\x5e\x87\x48\x2e\x73\x16\x79\xa5\x4a\x55\xe9\x28\x73\xcf\x86\xdf\x30\x0c\xe2\xfb\x70\x16\x39\x24\xa2\xcc\xb2\xdb\x6e\x15\xf2\xae\xc0\x57\x4c\xbb\x7b\x0c\xda\xd5\x2c\xcd\x55\x9d\x13\x4b\x28\xfd\x49\x5d\x14\xf1\x8b\x77\x64\x50\x6c\xb6\xd3\x22\x7e\xe1\x95\x72\x4e\x57\xcf\x37\x5d\x0d\xaf\x66\x05\x67\x06\x5e\x0f\x49\x48\x5d\x11\x65\xe6\x31\xdd\x1b\x78\x3d\x28\x7f\x68\x35\x57\xc7\x92\xa9\x6f\x9c\xc3\xa3\xc1\xcb\xaf\xe2\xfc\x58\xf2\xea\x5b\x17\xc8\x02\xc1\x57\xcd\xc9\x7a\x40\x3e\xd6\x8a\x2b\xb9\x82\x6b\x04\xaf\xad\x83\xe4\x41\x27\xd1\x0a\x82\xf9\x61\xce\x92\x95\x57\x55\x31\x2e\xa6\x12\x51\x9a\x1f\xa4\xc3\x5d\x2c\xfc\x70\x4e\x95\xbb\x01\xc9\xea\x74\xb9\xa0\xfc\xc6\x2a\xad\x35\x68\x49\xa9\xef\x77\xb9\xdf\xfd\x06\x77\xd7\xa6\x74\x62\x83\xd4\xdf\xbc\xcc\xd8\xcf\xdc\x4c\xde\x79\x34\x76\xc7\xd5\xa6\x2c\x32\x78\xd9\xe8\x0b\xcb\x42\x17\x95\x66\x78\x8a\x1d\xbc\x58\x22\xf3\x9c\x89\x14\x53\x99\x72\x7a\xcd\x48\xd5\x08\xd2\xea\x78\xa0\x06\xc3\x70\xec\x63\x41\x45\xa5\x27\x4e\xad\x2c\xbf\x8b\xa3\x56\xe4\xa7\x55\xcf\xda\x95\x8b\x3e\xee\x2e\xf6\x88\xaa\x35\x6a\x45\x1d\x64\xc8\x32\x09\x4e\x1c\xcc\x57\xc5\x5c\x6b\x77\x2b\x88\x64\xf7\xa9\x3b\xf5\x1c\xf2\x23\x13\xe3\x0a\x67\x4a\x67\xd0\xa4\xd5\x49\x60\x29\xd8\x80\x5c\x38\x15\x66\x35\xad\x68\x15\x71\xba\xa2\x28\x5d\xf9\x40\xac\xb0\x19\x1e\x4c\x59\xff\xbb\x98\xb6\x53\xff\xd9\x2a\xd4\xf5\x37\x3f\x67\xfa\x86\x4e\x09\x0f\x23\x6f\x7d\x4b\x57\xa7\x42\xa0\xed\x9c\xf0\x4a\xb0\x05\x30\xb8\xea\xfa\xe4\xe8\xe2\xe4\xf0\xea\xa4\x47\xde\x9f\x1f\xc3\xbf\xc7\x27\x6f\x4e\xec\xbf\x47\xef\xce\xce\x4e\x8e\xae\xac\x1e\xf1\x19\xe2\xc0\x5b\x33\xce\x52\xd7\x9e\x47\xb2\x2e\x2d\xa8\x98\x91\x51\x69\xac\x38\xa8\x1e\x56\x9b\x05\x45\x1f\x00\x4d\x53\x6b\x32\x3e\xb9\x35\x5c\x4e\xf0\x79\xb7\x49\xdc\xec\x02\xa1\xf3\x5d\x31\xd7\xfd\x6a\xd2\xca\x4c\xb2\x72\x55\x44\x6d\xca\x3b\x0d\xcb\x21\x3e\x08\xf2\x5a\x2a\xe2\xfa\x7c\xbd\x22\xbb\x85\x4c\xf5\xae\x2b\x3a\xb1\xff\x3d\xc0\x8f\x0e\x32\x39\xde\x0d\xb5\x28\x8c\x64\x72\x4c\x74\x39\x0c\x35\x42\x70\x9a\xc2\xdd\x9f\xf9\xdb\x6a\xa5\x15\xbd\x50\x28\x14\xfd\x2a\x0c\x5e\xfb\x4d\x7c\x43\x3c\xee\x01\x34\xf9\xaa\xdd\x69\x3f\x98\x1f\xf0\xb3\x83\xe5\x33\xf0\x8a\x13\x57\x73\xbf\xf8\x20\x2c\xbb\xde\xf0\x2c\x4d\xa8\x4a\x17\x78\x16\x0e\x37\x5c\x72\xa0\x1e\xe2\xe6\x62\x8f\xe4\x6a\x70\x07\x9e\x21\xa7\x4c\x65\xb4\xc0\x3c\x75\x00\x2e\x86\x04\x28\x78\xc8\x31\x2b\x18\xd4\x69\xf9\xae\xdd\x4c\x24\x99\x04\x9c\x0e\x3c\x19\x7b\xf5\x57\xc7\x84\x28\x0f\x4a\xe8\x8a\x7d\xaa\x1d\xb2\xb3\xb1\x62\x0e\x92\x9d\x1f\xc4\xbd\x98\x1e\x7d\x2b\xd8\x4b\xa8\x1e\x41\xa3\x31\x68\xbe\x8c\xec\xb8\x2a\xb8\x9d\x1e\xd9\x09\x78\x26\xa9\xd3\x92\x77\x3e\xdb\xa9\x6e\x88\xeb\xa8\x40\x49\x76\x81\xa9\x3e\x3c\x27\xae\xb6\x84\x05\xf6\xe1\xb3\xf0\xe8\x0a\x93\xc6\x1e\x6d\xce\x89\x05\x73\xa8\x0f\x34\xa8\x4d\x64\xe1\xa9\x55\x09\xe0\xbd\x4f\xb4\xd3\x8f\x7e\x6e\xa0\x5c\x1e\x4b\x09\x1d\x71\x54\x54\x71\x33\x20\x97\x35\xe6\x09\xe1\xbf\x18\x34\x87\x2b\x52\x50\x65\x4d\x11\x7f\x67\xbd\x5f\xd8\x67\xf7\x76\x0b\x5b\x81\x09\xa2\xf8\xca\x8a\x5a\xfb\x65\xf8\xc5\x51\x46\xb5\x5e\xe2\x79\x05\x41\x60\x07\x26\x0c\x47\x26\xd4\x07\x9f\x00\x84\x7a\x42\xa7\x77\xc0\x25\xac\x30\x69\x43\xd5\x98\x99\xbb\x23\x23\x54\xcc\xde\xdd\x09\x93\xd6\x5f\x19\x58\xb5\xbf\xda\x6e\xfa\xd8\xaf\x40\xb9\xfa\x5c\x98\xbe\x54\x7d\xfc\xc9\x2b\x62\x54\x79\x5b\x8c\xcb\xf0\x9c\xc9\xd2\x5c\xb2\x44\x8a\xe5\x75\x15\xee\xbe\xce\x42\x3d\x0f\x28\x36\x71\xd1\xc6\x43\xaf\x46\xf8\x8a\x93\xd8\xc9\x5e\xe9\x18\x3e\xc2\x58\x07\x69\x79\xf7\xe6\x6d\x9b\xc5\x26\x50\x66\x7d\xf7\x4a\xfe\xe4\xc4\xbe\x18\x87\x99\xba\x99\xdf\xf9\xb3\xb7\xa5\x79\xf8\x8f\xfe\x3f\xe6\xae\x2c\xb7\x6d\x1e\x08\xbf\xe7\x14\x7c\xfb\xff\x02\x8d\x7b\x86\xc2\x79\xeb\x82\xc2\x4e\x0f\xc0\x48\x74\x2c\xd4\x96\x0c\x52\x8a\xbb\xa0\x77\x2f\x38\x33\xa4\x4c\x99\x9b\x22\xd9\xb1\x1e\x6d\x72\xb8\x88\x43\xcd\xfa\xcd\xd2\x7a\xae\xe2\xad\x69\x33\xe2\xc0\x1c\xc1\xf5\xab\x96\xb7\xdd\xd9\x69\x70\xde\x0d\x5d\x96\x6b\x4c\x6c\x23\x99\x7e\x0d\xfd\x4e\x8d\x7c\xe7\xf8\x04\x88\x73\x0b\xed\x4c\xc8\xe4\x82\x51\x47\xcd\x9f\xad\xe4\x15\x2a\x90\xbc\x68\x3b\xc8\x9d\xe6\x2d\x85\x57\x12\xbc\xce\x9d\x6f\x19\x5e\x95\x31\xa6\x26\x16\x42\xb6\xea\x33\x57\xed\xf7\x43\xc9\x03\x39\x54\x83\xb0\x49\xd5\x02\xc3\xa0\x60\x7d\xac\x45\xa9\x6f\x78\xda\x02\xa4\xc7\x8e\xfa\xea\xed\x90\xe2\x58\x4f\x7e\xcf\x40\xba\xfb\xbd\x1e\xca\x3f\xeb\x55\xa3\xf7\xe4\xa3\xf7\x02\x72\x03\x46\x52\xb3\xd5\x9f\x13\x09\xd4\x58\x2d\x7e\xfa\x34\xee\xe9\x33\xde\x09\x5e\xfb\x83\xf6\x07\x27\x0a\xda\x8d\x3f\x43\x34\x00\x3b\x6e\x2b\x2d\xb2\x62\xae\x99\x62\x46\x84\x2a\xc5\x4e\x04\x52\xce\x26\x06\xb4\xd2\x08\x0f\x34\x40\x56\xb0\xd5\x37\xb7\x8f\x35\xe8\x93\x10\x4e\x29\x1c\xbd\xb0\x4c\xd2\x83\xd5\x9a\x86\xab\x02\xf1\xe5\x69\xd7\x14\x3f\x10\x64\x0c\xf0\x06\xaa\xdf\x42\x9a\xe8\xf7\xca\x56\xf5\xa2\xca\x53\xcf\xa6\x2a\xa6\xd9\x37\x53\x7e\x08\xa8\x68\xda\x7a\x03\x2d\xfd\x46\xf6\x96\xc5\xae\xa6\x1c\xbe\xeb\x04\xd0\x1a\x45\x05\xb2\x06\x1c\xcf\xc1\xb9\xce\x82\x81\x36\x80\x81\x48\x2a\x23\xdf\x53\x8e\xcd\x87\x4f\xe1\x7c\x94\x59\x83\x62\x63\x59\x31\xd8\x02\xb6\xaf\x2e\xa2\x50\x38\xd1\xfc\x99\x5c\xcb\x57\x22\x4f\x86\xe5\x0b\xe9\x76\xca\x39\xd4\x66\x0d\x71\x9c\xdd\xdb\x17\x4c\x46\xe8\x9f\x31\x3e\xbc\x5c\xf0\xd5\x51\x5e\xa6\x7a\x0c\x48\xa6\x0b\x6e\x62\xd5\x0e\x4a\x35\x45\x4f\xfc\xa6\x91\x41\x05\x66\xbe\xc9\xc7\xb3\xaa\x92\x84\xb4\xf4\x19\x8e\x5d\x3b\x4f\x21\xd2\xb7\x97\xed\xf2\x9e\x71\xb6\xad\x54\xdb\x48\x72\xad\x41\xf1\x30\xc9\xa1\x42\xa9\x3f\x06\x6c\x9e\x68\xb8\xa5\x9d\x02\xe3\x87\x83\xe0\xb6\xd8\x10\x7d\x9b\xa0\x5a\x90\x14\x45\x23\x4b\xef\xc4\x8c\x76\xef\x95\xa5\xbc\xc3\xcf\x90\x21\xba\xe3\xaa\x7d\xb4\x73\xd0\x02\x42\xe6\x6d\xec\x8a\x3f\xb4\xc4\x7e\x35\x06\x6e\xa6\xa9\xfb\x3f\x1b\xc6\x6b\xb4\x6a\x4c\x93\xc1\xd3\x42\x46\xbf\x36\x94\xe6\x5e\xb5\xae\xa3\x95\xdc\x4e\x96\x78\x9d\x99\xef\x85\x52\xd1\x74\xa7\x41\x90\x06\xe0\x04\x33\x8b\x13\x4c\xdd\xcd\xc7\x1e\x05\x04\x0c\xc7\x34\xa8\x60\xbf\xc2\x47\x8d\x81\x98\x80\x06\x05\xcb\x56\x93\x5e\xd9\x61\xcb\x55\xee\x62\x2c\x17\xd9\x40\xe3\x6c\x76\xc8\x9c\x8d\x14\x5c\xc5\x12\x36\x07\x7b\xfb\x24\x2b\xb1\x61\x4b\xbe\x17\xbb\x25\x57\x73\x6e\x2e\xdc\x00\x0b\x26\x16\xcf\x0b\xf6\xdf\xea\xc4\xdb\xfa\xb5\x69\xbf\xc4\x0a\x36\x24\x30\x0a\x72\x38\xfa\xa2\xbc\x3c\x59\x49\x48\x73\xee\x44\x9e\x9d\x3c\xc3\x08\x87\xde\x04\x6f\xc6\xb3\x8e\x43\xfc\xe8\x72\x62\x27\xc1\xe2\x57\xbc\x96\x23\x13\x29\x95\x21\x2e\xbc\x65\xfe\x4b\x2c\xc9\x92\x58\x7b\x4d\x26\x67\xab\x7b\x74\x34\x57\x30\xfb\x9f\x06\xd8\x61\x65\xfe\x4a\x69\x15\x6c\x4e\xb1\xe5\x6d\x53\xfe\xe9\x05\x06\xff\x8f\xc8\xe5\xf7\xf1\xaf\xf0\x54\x38\x81\x12\x1c\x2d\xfa\x04\x5c\x33\x00\xe3\xc1\x8c\x4a\xb6\x0e\xb2\x01\xd0\xb9\xd8\x50\x95\x33\x6a\xe3\x9c\x8f\xff\xa1\x8e\x9c\x78\xc1\xaa\x8d\x90\xf0\x21\x58\x2d\x94\x66\x8a\x77\x91\xe1\x33\x15\xaa\x3c\x65\x2a\xad\xe8\x26\x95\x58\x96\x7e\xb5\xa6\x51\xec\x05\xe3\x93\xab\xb3\x65\xe8\xc4\x23\x94\xb5\xb4\xc6\x33\x82\x58\x52\xfc\x1b\x49\xcf\x6f\xc0\x1d\x3e\x03\xe4\x61\xdd\x65\x05\x97\x34\x3a\x86\x0b\x7d\x03\x17\x80\x3e\x8d\x77\x37\x5d\x4f\xae\xfd\x76\x35\xbc\x03\x21\xdc\xd0\x3d\xe1\x73\x2d\xab\xeb\xaa\xf9\xf6\x3c\x89\xe3\x91\x4d\xef\x06\x40\x49\x92\x07\xe8\xb2\x70\x09\xf8\xa4\x4e\xdd\xdb\x9f\xb7\x1c\x58\xa8\xe8\x19\xbb\x10\x3e\x8c\x12\xf2\x45\x94\x8e\xa7\x8e\xb0\xe5\xdd\xdf\x4e\xfc\xb6\x3d\x7d\xda\x76\xf6\xe7\xef\xdd\xbf\x00\x00\x00\xff\xff\x12\xac\x7d\xb1\x7e\x88\x07\x00")
+var _operatorsCoreosCom_clusterserviceversionsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\xfd\x77\xe4\xb6\x91\x28\xfa\x7b\xfe\x0a\x1c\xc5\x7b\x25\xad\xbb\x5b\x33\x4e\x36\x6f\x33\x37\xef\xfa\x68\x25\xd9\xd1\xf3\x8c\xa6\xcf\x48\xb6\x6f\x8e\xe3\x75\xd0\x64\x75\x37\x56\x24\xc0\x00\x60\x4b\xbd\xd7\xf7\x7f\x7f\x07\x55\x00\x3f\xfa\x43\xea\x26\x39\x23\x8d\x4c\xe4\x9c\x78\xd4\x24\x8b\x60\xa1\x50\xa8\xef\xe2\x99\xf8\x01\xb4\x11\x4a\xbe\x61\x3c\x13\x70\x6f\x41\xba\xbf\xcc\xe8\xf6\xdf\xcd\x48\xa8\x93\xc5\xeb\xdf\xdd\x0a\x19\xbf\x61\x67\xb9\xb1\x2a\xfd\x00\x46\xe5\x3a\x82\x73\x98\x0a\x29\xac\x50\xf2\x77\x29\x58\x1e\x73\xcb\xdf\xfc\x8e\x31\x2e\xa5\xb2\xdc\xfd\x6c\xdc\x9f\x8c\x45\x4a\x5a\xad\x92\x04\xf4\x70\x06\x72\x74\x9b\x4f\x60\x92\x8b\x24\x06\x8d\xc0\xc3\xab\x17\xaf\x46\x7f\x1a\x7d\xf5\x3b\xc6\x22\x0d\xf8\xf8\x8d\x48\xc1\x58\x9e\x66\x6f\x98\xcc\x93\xe4\x77\x8c\x49\x9e\xc2\x1b\x16\x25\xb9\xb1\xa0\x0d\xe8\x85\x88\xc0\x3f\x6f\x46\x2a\x03\xcd\xad\xd2\x66\x14\x29\x0d\xca\xfd\x27\xfd\x9d\xc9\x20\x72\xb3\x98\x69\x95\x67\x6f\xd8\xc6\x7b\x08\x6e\x98\x2c\xb7\x30\x53\x5a\x84\xbf\x19\x1b\x32\x95\xa4\xf8\x6f\x8f\x04\x7a\xfd\x35\xbd\xde\x63\x0e\xaf\x27\xc2\xd8\xef\xb6\xdf\xf3\x56\x18\x8b\xf7\x65\x49\xae\x79\xb2\xed\x43\xf0\x16\x33\x57\xda\x5e\x95\xd3\x72\xd3\x88\xcc\xa2\xfa\x6f\x7f\xa3\x90\xb3\x3c\xe1\x7a\x0b\xb4\xdf\x31\x66\x22\x95\xc1\x1b\x86\xc0\x32\x1e\x41\xfc\x3b\xc6\xc2\xbb\x08\xf8\x90\xf1\x38\xc6\x85\xe4\xc9\x58\x0b\x69\x41\x9f\xa9\x24\x4f\x65\xf1\x72\x77\x4f\x0c\x26\xd2\x22\xb3\xb8\x58\x37\x73\x40\xac\x31\x35\x65\x76\x0e\xec\xec\xfa\x87\xe2\x56\xc6\xfe\xcb\x28\x39\xe6\x76\xfe\x86\x8d\xdc\x02\x8c\x62\x61\xb2\x84\x2f\xdd\x14\x2a\x77\xd1\x6a\x9e\xd3\xb5\xca\xef\x76\xe9\xe6\x6b\xac\x16\x72\xf6\xd0\xfb\xfd\x47\xec\x36\x85\x45\x65\x9d\xaa\xaf\xff\x61\xed\xf7\x5d\x5f\x1f\x3e\x9f\xbb\x37\x33\x3b\xe7\x96\xd9\xb9\x30\x4c\x49\x60\x1a\xb2\x84\x47\x60\x1e\x98\xd0\x86\x5b\x68\x46\x1f\xd6\x2f\x6c\x99\x52\x15\xa4\xe5\x36\x37\xa3\x6c\xce\xcd\x3a\x8a\xc7\x2b\xbf\x6e\x00\x47\x37\x2e\x5e\xf3\x24\x9b\xf3\xd7\xfe\x47\x13\xcd\x21\xe5\x25\x0d\xa8\x0c\xe4\xe9\xf8\xf2\x87\x3f\x5c\xaf\x5c\x60\x75\xec\x6c\xa4\x7e\x26\x8c\x43\x15\x72\x10\x16\x58\x08\xae\xdd\x32\x03\xf6\x8f\x8d\xcf\x5c\x67\x10\xfd\x63\xb4\x36\x73\x35\xf9\x2f\x88\x6c\xe5\x67\x0d\xff\xcc\x85\x86\xb8\x3a\x23\x87\xa0\xc0\x96\x56\x7e\x76\xf8\xaf\xfc\x94\x69\xc7\x16\x6c\x65\xcb\xd3\xa8\xf0\xc5\xda\xef\x2b\x5f\x7b\xe8\x50\xe2\xbf\x31\x76\x2c\x11\x0c\xd2\xa3\xa7\x38\x88\x3d\x1e\x89\x4e\x85\x71\xc4\xa1\xc1\x80\x24\x26\x89\x24\x24\xfd\x37\x8d\x98\x43\x00\x68\xe3\x18\x40\x9e\xc4\x8e\x77\x2e\x40\x5b\xa6\x21\x52\x33\x29\xfe\xbb\x80\x66\x98\x55\xf8\x9a\x84\x5b\x30\x96\xe1\xae\x95\x3c\x61\x0b\x9e\xe4\x30\x60\x5c\xc6\x2c\xe5\x4b\xa6\xc1\xc1\x65\xb9\xac\x40\xc0\x5b\xcc\x88\xbd\x53\x1a\x98\x90\x53\xf5\x86\xcd\xad\xcd\xcc\x9b\x93\x93\x99\xb0\x81\xeb\x47\x2a\x4d\x73\x29\xec\xf2\x04\x19\xb8\x98\xe4\x8e\x71\x9e\xc4\xb0\x80\xe4\xc4\x88\xd9\x90\xeb\x68\x2e\x2c\x44\x36\xd7\x70\xc2\x33\x31\xc4\xc9\x4a\xe4\xfc\xa3\x34\xfe\xbd\xf6\x8b\x6c\x0e\x57\xd0\xb7\x91\x9c\x59\x60\xb0\x0f\xe2\xda\xb1\x57\xa2\x24\x7a\x9c\xbe\xa5\x44\xa9\xfb\xc9\x61\xe5\xc3\xc5\xf5\x0d\x0b\x13\xf0\xfb\x12\x31\x5c\xde\x6a\x4a\x64\x3b\x44\x09\x39\x05\x4d\x77\x4e\xb5\x4a\x11\x0a\xc8\x38\x53\x42\x5a\xfc\x23\x4a\x04\x48\xcb\x4c\x3e\x49\x85\x35\x48\x73\x60\xac\x5b\x87\x11\x3b\xc3\x43\x8f\x4d\x80\xe5\x59\xcc\x2d\xc4\x23\x76\x29\xd9\x19\x4f\x21\x39\xe3\x06\x3e\x3a\xaa\x1d\x46\xcd\xd0\xa1\x6f\x77\x64\x57\xcf\xec\xf5\x07\xd6\xf6\x18\x63\xe1\x2c\xdd\xba\x3a\x5b\xf7\x30\x8b\x21\x4a\xb8\x26\xa1\x80\x59\x48\x12\xf6\xfe\xed\x3b\x36\x57\x77\x8e\x8a\x85\x34\x96\x27\x09\xee\x02\x7f\x3e\x13\x3b\x8d\xb8\x64\x29\x97\x7c\x06\x8c\x67\x99\x61\x53\xa5\x19\x67\x33\xb1\x00\x19\x76\xd7\x68\xd7\xc9\x6f\x63\x12\x8c\x98\xfb\xc6\x03\x2a\x5c\xf5\x13\x5c\xb9\xb2\x8d\x6d\xb8\xb1\x26\x03\x3d\x80\xb5\xd3\xf2\x5e\xa4\x6c\xc9\x72\x69\xac\xce\x71\xb1\x63\x76\x0b\x4b\x4f\xe4\x29\xcf\x98\xb1\xca\xfd\x78\x27\xec\x9c\xf1\x2a\x81\x73\x8b\x54\x3c\x01\x66\xc0\xb2\xc9\x92\x39\x31\x0e\x19\x82\x55\x2a\x41\x6e\x81\xcf\x22\x63\xd0\x60\xb5\x80\x05\x30\xae\x27\xc2\x6a\xae\x97\x05\x35\xac\x22\xf4\x11\xa4\xe2\xc7\x56\x84\x87\xed\x28\x61\x0f\xd1\x22\x23\x76\xeb\x65\x97\xb8\x10\x2c\x77\xc0\xde\xf8\xd2\xd3\x5b\x29\x8e\x1a\x4f\x6f\x60\x98\xa3\x2b\x2f\x1f\x14\x72\x2d\xbe\xc9\x13\x56\xcc\x94\x2e\x28\xc3\xa1\xad\x4a\x84\x13\x70\xec\x44\x73\xe9\x2e\x6c\x24\xee\x06\xd8\x7a\x88\x6c\xdc\x50\x77\x72\x13\x8d\x56\x61\x73\xad\x6b\x02\x53\x75\x08\x0b\xe9\x16\xc8\x0f\xe2\xae\xf8\xd9\x4d\x70\x21\x62\x70\x48\xb4\x5c\x10\xe9\xb8\xdd\xca\x27\x2a\xb7\x84\x3b\x7f\x4b\xcc\x16\x82\x33\x3e\x9b\x69\x98\x21\x01\x6f\x7d\xed\x23\x38\xa1\xb1\x7d\x83\x96\x63\x48\x92\xfc\x83\x77\x38\x36\xf8\xe0\x0d\x72\xd3\x36\xaf\xde\xb0\x2e\x2c\xd6\xc7\x63\x6b\x48\x83\x47\x0e\x27\x01\xb5\x4a\x3f\x78\xf3\x2e\x6b\x4b\xe3\x91\x15\xa6\x51\x5f\xe7\x95\x89\xf8\xab\x13\xb7\x3f\x4a\xd6\xec\xd8\x01\xde\x58\x32\xdf\x09\xb0\x0c\xf4\x54\xe9\xd4\x6d\x14\xc9\x38\x8b\x48\x7e\x2b\x18\x0f\xb2\x46\x19\x3d\x84\x4e\xb6\xeb\xfa\xd3\xd8\x85\x0a\x68\x0c\x59\xc6\xed\xfc\x91\xdb\x76\x5b\x2a\x1a\x55\xa4\x3d\x7a\xf3\x23\xdc\x6c\x0d\x76\x79\xc2\x74\x0e\xdb\xa1\xa1\x73\xa0\x78\xe6\xec\x02\xb5\x46\x6a\x1f\xf8\xdd\x3b\x30\xc6\x1d\xd9\x28\xa5\x69\x7e\xc7\x40\x46\xca\x31\x8b\xff\xef\xfa\xfd\x15\x81\x1d\xb1\x4b\xcb\x44\x9a\x25\x90\x3a\x41\x8c\xbd\xe3\xda\xcc\x79\x02\x1a\x4f\xa7\xef\x65\x5a\xfb\xdb\x53\x62\x6e\x20\x76\xbc\x28\x86\x84\x2f\x09\x58\x0c\x91\x8a\x1d\x8f\x56\x9a\x65\x4e\xc0\x4d\xb3\xdc\x02\xe3\x74\x15\xdf\x2b\xe4\x6c\x13\x93\x6e\x85\x1a\xe6\x24\x91\x94\xdb\x37\x6c\xb2\xb4\x8f\x91\x3e\x63\xf7\xc3\x78\x57\x1e\x50\x9d\xcc\xe3\x9c\x80\xc6\x4e\xfc\xa0\x0a\xf8\xd1\xaf\x74\x42\x28\x17\x12\xf4\x58\x69\xbb\x0b\xd3\x72\xca\xc7\x0c\xf4\x83\x77\x06\x94\x09\x69\xff\xf0\xd5\x03\x77\xc6\x90\x25\x6a\xe9\xe8\xe2\xf1\xbd\xb2\xe3\xf7\xec\xbc\xaf\x77\x85\xb7\xeb\x5e\xde\x11\x1e\x19\xa7\xba\x80\xb4\x49\x81\x6a\x04\x48\x76\xf5\x6d\x85\x12\xf8\x64\x87\xdf\xf8\x32\x58\x1b\x3e\xc0\x14\x34\xc8\xc8\xf3\xa6\xef\xf2\x09\x68\x09\x16\x4c\x45\x90\x5e\x66\x9e\xd3\x38\x59\x70\xf5\xb8\x7b\x9a\x53\xee\x11\x79\x26\xdc\xf6\x88\x54\x13\x6e\x7b\x4c\xb6\xa1\xb1\xcf\xb1\xf9\x38\xd1\xd1\xd8\x8b\xc7\x3e\x4e\x80\x0d\x80\x2e\x36\x9b\x73\x5a\xc0\x75\x3a\xf1\x33\x90\xf0\xae\x6b\xd3\xa8\xc9\x77\x53\x01\x49\xcc\x84\x13\xde\xdc\x64\xd9\x24\x51\xd1\xad\xb7\x5b\x7e\x38\x67\x46\x91\xb8\xe7\x24\x7c\x77\xd0\x46\x4a\x9a\x3c\x05\x26\x1e\xa3\xe0\x5e\xa4\xeb\x45\xba\x5e\xa4\xfb\x5c\x44\x3a\xf2\x0f\x3c\x07\x4e\xb5\x32\x91\xad\xbc\x0a\xef\xeb\xb9\xd5\x43\xa3\xe7\x56\x38\x7a\x6e\xf5\xc8\xf8\xec\xb8\xd5\x4e\x72\xda\xa3\xb0\x1e\xdb\xc8\xbd\x31\xb5\x37\xa6\xf6\xc6\x54\x3f\xfa\xb3\xcc\x8f\xfe\x2c\xeb\xcf\xb2\xde\x98\xfa\x10\xc8\xde\x98\xba\x27\xa0\xde\x98\xda\x1b\x53\x7b\x63\x6a\x6f\x4c\x7d\xec\x63\x7a\x91\xae\x17\xe9\x7a\x91\x6e\xd7\xc9\xf4\xc6\xd4\xde\x98\xfa\xd0\xe8\xb9\x55\x65\xf4\xdc\xea\x81\xf1\xb2\xb9\x55\x7b\x63\x6a\x04\xda\x5e\x1a\x93\x83\x7e\x34\x1c\xf7\xac\xb8\x95\xa9\x05\x68\x8d\xb6\x4f\x8c\xf3\x9e\x03\xc3\xf0\x5e\x39\x43\x78\x62\x2a\x22\xee\xb4\x85\x32\x7b\xe7\xd0\xb0\x3b\x98\xcc\x95\xba\x35\x48\x29\xa5\x45\xd5\x30\xae\x1d\x09\x9a\x1c\xe2\x11\x3b\x87\x29\xcf\x13\x5b\x64\x40\x08\x7a\x5f\xa4\xe4\x54\xcc\x30\x66\x5a\x48\x1f\xb1\x8f\x21\xbb\x87\xc6\xb1\xb8\x33\xbc\xfc\x11\x02\x76\x43\xa0\xf5\x36\x04\xd7\xf0\x73\x11\xa2\xb2\x8b\xd9\x52\xb2\x48\xf1\x3b\x7d\xcc\x36\x22\xdf\x81\xf3\xee\xc2\x22\x23\x4e\xd8\x78\xc7\x1f\xd4\x94\xeb\x2b\x7b\x5a\x3c\xe3\x53\x79\xca\x3f\x65\x65\x09\x65\xc8\x33\xa3\xc3\x65\xae\x92\x98\x3e\x71\x7c\xf1\xae\x60\x1e\x67\xa7\x6c\x92\xcb\x38\x21\x9b\x27\xf0\x38\x90\x81\x81\x48\x83\x1d\x30\x93\x47\x73\xc6\xe9\x41\x95\x81\x34\x73\x31\xb5\x43\x1f\x1f\x3e\x8c\xf8\x28\xd2\xb6\x9c\xc1\x43\x3c\x61\xc7\xc3\x6a\xb7\x83\x6a\xc8\x6e\xe1\xa1\x5d\xbc\xeb\xf9\x74\x0b\xcb\xbd\x0e\xfa\x9b\x39\x60\x22\x80\x55\xcc\x40\x02\x91\x7d\x8c\x0b\xee\xcc\x01\x77\x51\x0d\xeb\x29\x38\x57\x95\xb4\x3f\x4d\x46\x00\xbb\x31\xc1\xe5\xb6\x30\x09\x8c\x84\x3a\x89\x55\x64\x4e\x22\x25\x23\xc8\xac\x39\x71\xcc\x61\x21\xe0\xee\xe4\x4e\xe9\x5b\x21\x67\xc3\x3b\x61\xe7\x43\x5a\x25\x73\x82\x34\x74\xf2\x7b\xfc\x0f\xbb\x79\x7f\xfe\xfe\x0d\x3b\x8d\x63\xa6\xec\x1c\xb4\x3b\x39\xa6\x79\x42\xe2\x8d\x19\x55\xb2\xb4\x06\xa8\x3c\x0f\x58\x2e\xe2\xaf\x57\xb3\x60\x1a\xe3\x47\x65\x94\xda\xb0\xb7\x06\x29\xa6\x4b\x76\x37\x07\x9c\x32\xee\x8e\x62\xbb\x28\xcd\x84\x35\xb8\x9e\x69\x6e\x30\x6b\x88\xf2\xc6\x1e\x33\x4c\xd0\xa4\x27\x4a\x25\xc0\xb7\xdb\x1c\x22\xfe\xdd\xc3\xd4\xb5\xb2\xad\xbf\x83\xa5\xdb\xd0\xd6\x93\x58\x6d\x23\xee\xb4\x87\xeb\x1c\x99\xf6\xa6\x93\x09\x0e\x0d\x13\x33\x49\xf9\x2a\x73\x90\xab\x1c\xc4\xc0\x83\x54\xbc\xd3\x0a\xd1\x2c\x1d\x45\x96\x59\x34\x3b\x7f\xfa\xf5\x86\x87\x7d\x06\x4e\x99\xbf\x83\xe9\x34\x4a\x96\xe7\x97\x63\x6c\x74\xee\x84\x04\x50\xb7\x98\x1b\x8e\xb6\x35\x5e\xe6\x1f\x1f\x4d\xc0\xf2\x51\xc1\xd4\xdc\xde\xf0\x4f\x0f\xdd\xd3\x43\x7a\x6c\xe8\xa0\x77\x80\x1f\x7c\xe5\xe9\xc3\xe9\x48\x5b\xb0\xb3\xfa\x28\x1e\xc2\x3c\x8e\x49\x70\xab\x22\x84\x18\xf5\xd2\xdd\xd0\x01\x2b\xde\x3d\x9f\x68\x67\x5c\xe0\x0d\xbb\x9c\xcf\x37\xcb\xac\x60\x6e\xfe\x28\xae\x51\xf7\xb7\x20\x41\x63\x82\xdf\x83\x27\xf4\x83\xcb\x02\x32\x4f\xb7\x7f\xcf\xb0\x7c\xc7\x03\xf7\x04\x79\x61\x5d\x56\x73\xbc\x61\xb3\x01\x7c\x25\x57\x0f\xef\x43\x33\x96\x98\x0a\x2f\x86\xf8\xa7\xd9\x04\xe6\x7c\x21\x54\xae\x69\xe7\xfa\xd3\x9d\xcd\xc0\x1a\x27\xb1\xc3\xe6\xc9\x3d\xb2\xbe\x0f\x1f\xb1\x43\x06\x92\x4f\x92\x8d\x80\x1f\x15\xc1\xe8\xc9\x87\x1d\xfd\xdb\xf8\x26\x99\xa5\x83\x55\x7a\x9f\xdc\xb3\x83\x6d\xf5\x10\x36\x27\xa0\x9d\x7d\x38\xef\x2a\xed\x8c\xfd\x5d\xb2\xcb\x02\x2a\xc3\x28\x02\x4c\x6a\x0d\xb2\xd8\xfb\x3b\x09\x31\x16\x24\x18\x30\x61\xdd\x0d\x4e\x41\x13\x91\xb0\xc9\xb2\x78\xf1\xe8\xa0\x7b\x69\xf8\x13\xa6\xaf\x9d\x7d\x38\xdf\x3d\xd4\x22\x2c\xc0\x56\xd0\x1d\x46\x55\xf4\x31\x13\x7d\xcc\x44\x31\x7a\x93\x55\x43\xa0\xbd\xc9\xea\x81\xf1\xb2\x4d\x56\xcf\x3d\xc6\xa0\x8f\x0c\x60\x7d\x64\xc0\xc3\xb7\xf5\x91\x01\x7d\x64\x40\xef\x6b\xdb\x32\x7a\xc1\x05\x47\x2f\xb8\x3c\x32\x3e\x3b\xc1\xa5\x8f\x0c\xe8\xb9\x55\xcf\xad\x7a\x6e\xf5\x79\x70\xab\xcf\x31\xcd\xaa\x37\xfa\xf5\x46\xbf\xde\xe8\xd7\x9f\x46\xfd\x69\xf4\xc8\xf8\xec\x4e\xa3\xde\xe8\xb7\x2f\xa0\xde\xe8\xb7\x71\xf4\x46\xbf\x47\x46\x6f\xf4\xeb\x8d\x7e\x5b\x46\x2f\xb8\x34\x04\xda\x0b\x2e\x0f\x8c\x97\x2d\xb8\xf4\x46\xbf\x9e\x5b\xf5\xdc\xaa\xe7\x56\x9f\x07\xb7\x6a\x6f\xf4\x7b\x64\x27\x3d\xfc\xec\xc3\x3b\xe5\xc1\x67\xe7\xc0\x13\x3b\x3f\x9b\x43\x74\xfb\x78\x6c\xe6\x5f\x2b\x37\xbb\x6f\x16\xd3\x65\x48\x26\xa1\x28\x4b\x61\x3c\xc0\x25\xe3\x53\x0b\x9a\x09\xcb\xf2\x6c\xa6\x79\x0c\x95\x0e\x20\x67\xd7\x3f\xb8\x0b\xa1\x59\xcf\xc8\x47\x5d\x2e\x59\xac\xe4\xa1\x65\x19\x37\x06\x9b\x31\x50\xd8\xa5\x70\x2c\x97\xc7\x89\x90\x30\xc0\xc7\x3d\x40\x36\x45\xdb\xa4\xa3\x77\xca\x9a\x40\x68\xb1\xcf\x98\x29\x8b\x74\x50\x88\xb5\x06\xea\xf2\xd0\x20\x57\xe9\xb1\x10\xdb\x08\xf1\xb1\xe1\xe2\x63\x9c\x34\xda\x8a\xf5\x35\xcc\x7b\x9c\x53\x4b\x8a\xdc\x58\x0c\x81\x45\x44\x4d\x95\xae\xaf\x81\x55\x6e\xef\xfb\x65\x78\x38\xa2\xba\x2b\xfb\x71\x85\x2e\x7c\x1f\x19\x21\x67\x09\xd0\x07\x86\x5e\x3c\x7e\x7e\x87\x81\x44\x46\xec\xe2\x9e\x47\x36\x59\x62\x73\x27\x1f\xf2\x9f\x69\xe5\x4e\xd6\x90\xc2\xf1\x60\x3a\xc3\x4e\x47\x64\xca\xef\x2b\x01\xef\x6c\x7b\x5d\x97\x54\xc8\xdd\x6e\xdc\xcd\x72\xfd\xa0\x72\xbb\xdb\x11\x1b\xd5\x02\xa1\x1f\xe6\x2d\x75\x62\xa9\x3d\x88\x64\x02\x86\x82\xce\xd7\xcd\xbe\x73\x6e\x4a\xe9\x25\x52\x92\xd2\x04\xa8\x19\x8a\xa3\x2b\xea\x0c\x83\x66\x06\xb7\xd5\x7c\x4f\xac\x1d\x18\xdd\x0e\xb2\xcb\xae\x92\xcb\xb0\x9c\xd8\xcd\x32\x7b\xec\xfc\x78\xbc\x02\x1b\xdb\xd5\xfe\x30\xdc\xd5\x92\xb2\x9b\x99\x62\x77\xd1\xaa\xf6\xbd\xfb\xc9\x61\xec\xac\xfa\x6c\xc8\x42\xb2\x95\x1c\x8c\x72\x95\x7d\x60\x7b\xf8\xc8\x43\x53\x34\x3d\x2b\xee\x79\x64\xad\xd9\x7e\xa2\xc0\x0e\x85\x84\xf6\x84\xb8\x9b\xdd\x65\x4f\x80\x98\x6b\xb9\x27\xda\x8b\x5e\x80\x05\x9a\xeb\x3b\x6d\x3d\xc7\x76\x25\xb7\xf3\x7f\x16\x99\x5d\x8e\xa7\xfb\x3c\xdb\x21\x76\x1a\x8c\x4b\xb3\x64\xa7\xcb\xa1\x77\x62\x2e\x7b\x02\x25\x0a\xda\x13\x7b\xa4\x66\xad\x90\x27\x1e\x03\x73\xbe\x58\xc1\xdd\x8d\xce\x1f\x4c\x89\xda\x7b\xc6\x3b\x9b\xd9\x76\x84\x39\xb7\xf6\x11\x22\xaf\x1f\x9f\x37\x37\xe3\x15\x2e\xfd\xed\xc5\x4d\x68\x86\xe6\xbe\x98\x17\x59\x61\x5b\xd2\x82\x35\xd8\x5c\x4b\xc7\xc9\xbf\xba\xbf\x2f\xb9\x79\xfc\x08\x9e\x3e\x02\xa7\xce\x94\x7e\x4c\x61\x1d\x86\xaf\xe9\x88\x59\xee\xa6\xcd\xd5\x50\x3e\xe6\xee\x78\x53\x01\xc7\x75\xfa\x3a\x38\x39\xe8\x94\xbc\xb2\x47\x8b\xd4\xad\xcf\x4f\x69\x5b\x66\x8d\x52\x8a\xe3\x8e\x53\xda\xa5\xd4\x1d\xdb\xa3\xdc\x1d\x2b\x53\x1e\xf7\xdd\xd3\x81\x66\x4d\x2d\xb5\xb3\x9a\xe4\xb8\x99\x9c\x3b\xc3\xfe\xe3\x87\xc3\x1a\x0b\x67\x22\x06\x69\x2b\xd9\x7b\x24\xd5\x56\xe7\xb9\xbb\x0c\xf4\xe8\x04\x83\x54\x5c\x9c\xd9\x7b\xcc\xf6\xfd\xea\xb3\x35\x1e\x52\xd5\x09\x0e\xcd\x86\x9b\x49\xf0\x7b\xb6\x12\x9f\x7d\x4c\xd0\xdb\x9d\x3f\x7c\x7e\x07\xd2\x43\xa9\xb5\x5b\xe6\x7b\xb3\x49\xc0\xeb\x60\x52\x41\xf7\xde\x49\x4d\x3d\xf7\x37\xa3\x21\x40\xdd\xb1\x44\xf9\xd6\xa1\xa4\xea\x22\xea\x1c\xc2\x50\x73\x25\x0b\x01\x72\x06\xb8\xdb\xa0\xb6\x4f\x20\x52\x29\x30\xbe\xe0\x22\xe1\x93\xd5\x94\xf8\x7f\x4b\x1b\x65\x0b\x8b\xe8\x21\x03\xcb\x36\x85\xf8\x01\x65\xf8\x51\xd2\x7f\x8c\xe4\x87\x6c\xc2\x0d\xfc\xe9\x8f\x6b\x3d\x75\xab\xb7\xa4\x10\x0b\xbe\x75\x4b\x3c\xbe\x15\xca\x57\x6c\x27\xab\x1d\xe8\xb3\x98\x46\x43\x28\xbe\xe9\xe7\xa3\x86\x26\xc7\x88\xe3\x4b\xba\xf9\xda\x6a\x6e\x61\xb6\xac\x34\x99\x45\xa2\x29\x2d\xed\x72\x4b\x73\xe4\x42\xc7\xbd\x9b\x83\x06\x4a\x3f\xf7\x6d\x51\x4d\x00\x2a\x4c\x91\xad\xfd\x31\x6c\x43\xe1\x3d\x0d\xac\x43\x9b\x3a\xc3\x6e\x44\x56\x40\xd0\x39\x45\xeb\x9d\x17\x5b\x68\x15\x63\x19\xd7\x06\xe2\x22\xaa\x0f\x9d\x14\x95\xbb\x57\xf0\xdd\xa2\x5c\xce\xe3\x5c\x7e\x58\xdd\xe9\x5b\xee\xda\xa9\xe8\x0e\x2d\xfc\x18\x74\x2a\x8c\x79\xac\x16\xc3\x6e\x66\xe2\x1d\xcc\xc3\x5b\xf0\x1f\xbe\xa8\x32\x9d\xc2\x5d\x44\x8a\xf6\x84\x47\x4c\xe7\x09\x90\xdd\xb2\x28\xf5\x10\x45\x2a\x97\x96\x49\x80\x98\x22\x39\x36\xd1\xea\xa7\x3e\x81\x71\x9e\xbb\x8a\xf2\xa7\xf4\x09\x1b\x9b\xfd\x56\xc7\xee\xa7\x36\xbe\x7e\x57\x95\x6c\x37\xd3\xff\xce\x86\xff\x15\x59\x3c\x11\xd1\xf2\x43\x9e\x80\x2f\xda\x22\x24\xc9\xce\x45\x80\x65\xd5\x25\x98\xe1\xdd\x38\xfb\x01\x9b\xe4\x96\xc5\x0a\x0c\x93\xca\x86\xa2\xd5\xb5\xc7\x29\xa4\xf6\x6e\x4e\x06\x01\xf7\x10\xe3\x59\x96\xa0\xf0\xa9\x98\xd2\xec\x6e\x2e\xa2\x79\xad\xfe\xd3\xda\x6d\xbb\x7b\x6b\x76\x72\x27\xb2\xbd\x5c\x8a\x2c\x18\xbf\x26\x8f\x91\x0a\xdb\xd3\xb7\xc8\xa8\x7d\xf1\xb7\x5a\xe5\xd9\x8e\xb7\xaf\x47\x52\xd1\xd3\x9b\x34\x90\x70\xd1\x87\xc8\xd2\xda\x98\x9a\x39\xcc\x8c\x18\xbb\x9c\xb2\x34\x4f\xac\xc8\x12\x7c\x84\x0c\x58\xe4\x52\x28\xce\x8d\x01\xe3\x72\x19\x22\x6e\xbd\x46\x09\x31\xe3\x33\x07\xd1\x06\xf3\x36\xf5\x3e\xcf\x53\x2a\x43\x52\xbe\x04\xdd\xc7\x72\x59\x42\x67\x77\x22\x49\xd8\x04\x18\x4f\x12\x75\xb7\xbd\x2a\xca\xea\xd8\xcf\x09\xc6\xf6\x73\x84\xb1\xfd\x5d\x7e\x8c\x49\x25\x83\x15\xfa\xfb\x0f\x6f\x9b\x2d\xe2\x55\x1d\x86\xf7\x2f\x00\x6a\xc7\x19\xd7\x56\xf0\x84\xe5\x3a\xf1\xbe\x11\xce\x72\x03\x3a\x34\xfa\x47\x71\x93\x47\x11\x18\xea\x28\xcf\xfe\xd5\xd7\xdb\x21\xc4\xd2\xfe\x54\x32\x59\x86\xaa\x68\xd3\x3c\x49\x06\x6c\x2a\x24\x77\x6c\x17\x8a\x2a\x6c\x19\xb7\x73\x76\x2d\x64\x04\xee\x9b\x86\x85\x60\x81\x33\x72\x10\xdd\xfe\x2e\x36\x69\x3c\xf0\x2d\xef\x29\x3a\xc0\xf8\x57\xb8\x0d\x1b\x39\x41\x16\xed\x7b\x5e\x64\xf9\xa0\x12\x0c\xe7\xf3\x81\x7e\x31\x79\xc9\x78\xf5\xf2\x7f\x08\x89\x4e\x59\xf6\x01\x0f\x8e\x88\x4b\x06\x02\x4b\x60\x39\x90\x58\xbf\xcc\xd1\x4e\x49\x50\x47\xa1\x38\xd2\x41\xa6\x62\x73\xe0\xd8\xc8\x01\x15\x40\x32\x07\xc7\xee\xaf\xd5\x6f\xc0\xef\xab\x3e\x77\xc2\x33\x71\x70\x3c\x60\x88\x20\x6c\xc2\xaf\xec\xfc\xf3\xa5\xc3\xf0\xad\x68\xa2\x6d\x44\x85\x1f\xaa\x10\x7c\x3d\xab\x50\xc5\xcc\xf1\x68\x0b\x58\x17\xc6\x11\x25\x15\x58\x43\x6a\xdc\xc4\xac\x19\x3b\x95\x0c\xd2\xcc\x2e\x91\x8a\x53\xe0\xd2\xdf\x0d\x0b\xd0\x4b\x3b\x17\x72\x86\x2f\xf8\xdc\x37\xff\x8e\x81\xb4\xe5\xd8\x88\x70\xbf\xe1\x03\x72\x4b\x22\xc7\x1d\xb6\x86\xdc\xc3\x7f\x3d\xac\x4a\xbd\x4e\x7c\xda\xc3\x72\x5e\xfd\xd2\xe7\x84\x4a\x3c\x5e\x1b\xa1\xf1\x07\xf7\x64\x1d\x85\xf4\x13\x71\xcb\x82\x7f\xbc\x7d\x8b\xc4\x1a\xb0\xfe\x9d\x90\xb1\xaf\x17\x6a\xad\x16\x93\xdc\xc2\x07\x70\x13\x8e\xa8\x12\x53\xe8\xbf\xe1\xab\x82\xfa\x95\xd8\x88\x7e\x9c\xfb\xe7\x88\xfa\x75\xc1\xb6\x23\xff\x40\x45\xf3\x79\x0e\xca\xca\x75\x06\x51\x5d\xfe\x71\x1c\x6c\x40\xc1\xb7\x8e\x04\x12\x3e\x81\xa4\x0c\x34\x28\xa7\xcf\x4e\xdf\xbe\x0b\x47\x6d\xa4\x81\x3f\x12\xd9\xf3\x11\x54\x94\x9d\x5c\xb8\xee\x43\x3a\xd2\x49\x10\x15\x7b\x5b\xc1\x2d\x6d\xc0\x94\x67\x6e\xff\x11\x8c\x8d\x51\x5d\x6f\x11\xd3\xbb\x1a\xed\x76\x94\xe6\xf7\xaf\xfc\x57\x7d\xc9\xd3\xf8\x60\x1f\xb2\x7d\x94\x63\xc5\xf4\x58\x23\x68\x2f\xf1\x7b\x19\x3d\x22\x6d\xcb\x4b\xe1\x31\x18\x2a\x11\x47\x05\xf9\x74\xf8\xbd\x04\xd1\xf1\x12\xec\xa3\x4e\x0d\x7d\x31\x5a\xf5\xb8\x07\x07\xcd\xe4\x90\x66\xc9\x63\x3b\x8f\xed\xad\x7a\xa5\x42\x7e\x00\x1e\x2f\xaf\x21\x52\x32\xde\x91\xc1\xd6\xd6\xe3\x9d\x90\x22\xcd\x53\x26\xf3\x74\x02\x88\x62\x43\xb0\x90\x91\x90\x5a\xcb\x99\x84\xbb\x64\xe9\x99\x47\xcc\x32\x15\x07\x7e\x32\x71\x6a\x18\x8f\x97\xe8\x96\xc0\xb6\x7e\x72\x19\x82\x84\x8a\xee\x4f\x2c\xd2\xdc\x38\x81\x69\x80\x40\x85\xf5\xc1\x4f\x91\x92\x46\xc4\xe0\xd6\x78\x8b\xf5\xf8\x15\x3b\x72\x2f\x0b\x9a\xd6\xa6\x07\xb0\xba\xa8\x72\x3a\xba\xf1\xd5\xfe\x70\x42\xc7\x3b\xa1\x62\x3f\x57\x1c\xdb\xd3\x1d\xc7\xd0\xe9\x99\x9b\x5d\x15\xf4\xda\xc2\x5c\xca\xd8\x57\x09\x2f\x64\xd4\x0a\x4b\x17\xc6\x43\xde\xed\xc8\xb6\x0f\x56\x81\xdc\x30\x6b\xad\x66\x1a\x8c\x09\x8e\x82\xe6\xf4\x75\x33\x07\x96\xf2\x7b\xa4\x31\x2b\x52\x74\x25\x56\x29\x8c\x57\xbf\xca\x2a\x96\xf2\x5b\x28\x5e\xcf\x26\x30\xc5\xda\xca\xf8\xc1\x95\xd5\x27\xfa\x99\x72\x91\x40\x3c\xc2\x77\x54\xa0\x38\xba\xd3\x2a\x49\x40\x13\xe1\xb8\xbf\x85\xcc\xc9\xa3\xa1\x15\xaa\x99\xf4\x68\x3d\xfc\x50\xc6\xeb\x8e\x36\xce\xc6\x2b\xa8\xb8\xb8\x8f\xc8\xfc\xa7\x81\x1b\xbc\x8d\x68\xd3\xe4\x7a\x8a\x81\x8d\x5e\x17\xad\x4c\xc8\xbb\xe6\xd8\x95\xb2\xbe\x32\x78\xf1\x81\xf8\x34\x6a\x6e\xc0\xc0\x58\x91\xe2\x06\x8b\x73\xc7\x7c\x29\x20\xc8\xe1\x8c\x6f\x5e\xfa\xda\x56\xf9\xd3\xab\x57\x3b\xca\x6f\x1f\x9f\xe8\x35\xa0\x0e\xdd\x84\x5e\xae\x0a\x3e\x14\xd8\xbf\x53\x8e\xdd\x1a\x0b\x2f\x20\x67\xca\x4d\x1d\x03\x28\x63\x61\xac\x90\xb3\x5c\x98\x39\x9b\x80\xbd\x03\x90\x0c\xee\xa9\xd6\x27\xfb\x6f\xd0\x0a\x17\xd5\xa1\xb7\x74\x1e\xd4\x90\xf6\xfa\xf9\x60\x6c\x21\x8c\x50\xf2\xaf\xc2\x58\xa5\x97\x6f\x45\x2a\x76\x88\x45\x60\x9b\x76\x5b\xc9\xc9\x55\x12\xb3\x0f\xb4\x14\xd7\x40\x1f\xac\x01\x6d\x9b\x56\x91\xe2\xca\xdc\x3e\x99\xf0\xe8\xf6\xa3\x21\xf8\xd5\x73\xc1\x70\x38\xae\x1b\x60\x15\xe5\xbd\x02\x00\xb2\x2d\x22\xca\x8b\x7b\xc2\x4f\x0d\xcb\x77\x73\x65\x00\x6f\x20\xf3\x23\x3e\x16\xdc\x05\xc2\x14\x0c\x03\x3d\xff\x12\x0c\xe3\xd3\x69\xfd\x8e\x72\xb3\xa3\xe4\x89\xde\xec\x94\xdb\x88\xfc\xfe\xee\x40\x0c\xe2\xc4\xa1\xf1\x62\xff\x3e\x58\xde\xd9\xbc\xbc\xbf\x21\x98\xd1\x3c\x2f\xee\x9d\x6e\xf9\xa8\x9f\xa7\x3e\x6a\x28\x5f\x05\x53\xd7\x8d\x93\xfa\x82\x78\xb9\x0d\xd9\x38\x9d\x06\xd5\x5f\x70\x15\x4e\xaf\xce\x77\x37\xd2\x34\x51\x70\xf7\x56\x71\x57\x8d\xe0\x0f\x7c\x54\x30\xa6\xfa\x2b\x75\x4b\x38\x6a\x28\x66\xc0\x38\xbb\x85\xe5\x80\x4e\xb1\x4a\x41\x65\xbc\x59\x43\xe2\x25\x09\xaa\x73\xef\x6e\xa2\xe7\x76\x47\x49\x03\xea\xa1\xb1\x9f\x93\x22\x8c\xc7\xba\x4d\x6c\x7a\x22\x7c\xf4\x1e\x8f\xed\x4f\xe0\x34\x76\x68\x62\xb1\x3a\x6a\xcb\x7d\x5b\x36\x1e\xa0\x75\xc7\x0e\x17\x41\xd0\x2b\x96\x7a\x3f\xef\x51\x75\xec\x6d\xbc\x0a\x23\x20\xb1\xd5\xe7\x15\xe4\x57\xb5\x32\xb9\x6f\x3c\x34\x44\x8c\x6e\x4f\xcf\x45\x16\x82\x26\x71\x57\x7b\x8a\x64\x3f\xf0\x44\xc4\x05\x08\xda\xbf\x97\x72\xe0\xc4\x27\xf7\x1f\x64\xba\x24\xae\x9d\x2b\x30\x57\xca\xe2\x2f\x9f\x0c\x41\x34\xcd\x56\xe8\x21\x10\xa1\xdf\x82\xe3\x32\xa8\x78\xe1\x5c\x0a\x34\xf8\x1a\xe7\xd5\xa4\x9d\x4b\xc9\x94\x0e\x78\x70\x17\x3d\x20\x02\x11\xb2\x31\xa4\x92\x43\xb4\x5c\x6f\x84\xe1\xd1\xa7\x74\x0d\x7b\x0f\x80\xf3\xa0\x50\x3e\xa0\x2b\xa8\x63\xf9\x34\x1e\x2f\xaa\xf2\xe0\xfe\x16\x11\x4b\x41\xcf\xd0\x17\x13\xed\xec\x8b\xa8\x2f\xca\x7e\x7c\x97\xc6\xde\xdc\xb7\xfa\xc2\xbd\xa8\x00\x8f\x26\x32\x01\xb5\x39\xdc\x08\x42\xcd\xe4\xf4\x7f\x1c\x07\xc7\x35\xf8\xbf\x2c\xe3\x42\x9b\x11\x3b\x0d\x39\x3a\xd5\x6b\x5e\xc3\xa8\x82\x49\xa9\xaf\x88\x63\xb5\x0b\x9e\x78\x5d\x8a\x4b\x06\x64\xb3\x72\xd0\x57\x8f\xd4\x81\x97\x54\x1c\xe7\x29\x5c\x60\x07\xb7\xb0\x3c\x18\xac\x11\xcd\xc1\xa5\x3c\x18\x14\x39\x5c\x35\x32\x29\x0e\x22\xf4\x9e\x1d\xe0\xb5\x83\x2e\x4f\xe1\x3d\x0f\x9c\xa6\x76\xb4\xfa\x4b\x77\xa6\x88\x10\xf5\xd1\x50\x58\xaf\x69\x89\x3e\xd6\xc9\x2a\x96\x1b\x20\x69\x1d\x77\x19\x83\x20\x67\xa2\x54\x89\x8a\xa9\x84\x3b\x94\x1e\x9f\x8d\xe0\xe7\x34\x09\x21\x67\xdf\x67\x31\xb7\x3b\xa5\xd7\xd2\xa8\xf7\x57\xfa\x40\x40\x58\x8e\x50\x7c\xa3\x30\x96\x71\xcd\x53\x33\x62\x63\xdf\xe7\x01\x29\x4d\x4c\xab\xb6\x44\x8f\x3b\x8c\xae\xfc\x7f\xd9\x87\xea\x5c\x46\x6c\x38\x1c\xfa\x4e\x4a\xf4\x0b\x49\xd9\x56\xb1\xa9\x42\x25\x48\xe5\xd8\x41\x6d\x01\x12\x15\x7f\x27\xdf\x2b\x09\xef\xa7\x6e\x87\x70\x0b\x0b\xd0\xec\xce\x2d\x55\x24\x62\x28\xac\x57\xa3\xc7\xda\x2c\x95\xa3\x11\x1d\x37\x93\x4c\x52\x7e\x7f\x9d\xeb\xd9\x1e\x0b\xc0\xd6\x16\xa1\x6a\xb2\x29\x95\x49\x24\xbd\x6a\x9d\x32\x13\xcd\x21\xce\x13\x88\x19\x9f\xa8\x05\xd4\x4c\xb6\xf5\xc7\xf0\x48\xcf\x21\x3c\xe8\xce\xbc\x89\x51\x49\x6e\x0b\x65\xf5\x08\xee\xdf\xb0\x7f\x43\xa7\x37\x67\x19\xe8\x08\xa4\xe5\x33\x58\x35\x03\xd0\x7d\xaf\x5f\xfd\xcb\xb1\x3f\x8f\x1c\x44\x6f\x3d\x79\xe5\x28\xe2\x1d\xbf\xff\x5e\x96\xa6\x41\x61\xd8\xab\x11\x3b\x5d\x79\x19\x3e\x97\x44\x79\x82\xb6\x16\x74\xe4\x57\x5e\x39\x59\x32\xad\x72\x74\xe5\xb3\x3c\xab\x6b\xb3\x5f\xfd\xdb\xbf\x60\x3a\x64\x9a\x25\xf0\x26\xc4\x6f\x93\xda\xec\x64\x18\xab\xd8\x1f\x5e\xfd\xcb\xa0\x88\x95\x2d\xb5\xc2\x12\x67\xdc\x21\x2c\xcf\x98\x48\x29\x48\x13\x92\x65\x19\x08\xae\xeb\xe4\x6f\x2c\xd7\xd6\xf8\x26\x4a\x85\x70\x68\x95\xe5\xc9\x8a\x96\x8f\x5a\x38\xdc\x11\x92\x62\x85\x38\x01\x34\x54\xb1\xd7\x7f\x78\xf5\x2f\xeb\xe6\x94\xf7\x32\x02\x7c\x12\x9f\xc0\x00\x8c\x89\x53\xee\x6f\x45\x92\x40\x3c\x78\x74\xfa\xd3\x5c\xdb\x39\xe8\x01\x03\x69\x82\xb1\xca\xcd\x6f\x65\x6e\x08\x5d\xe7\x52\xa2\x8c\x40\xd6\x61\xb4\x68\x55\x2c\x5c\xfe\x63\xdd\x41\x68\x59\xaa\x8c\xdd\x3c\xe5\xdd\xb7\x9b\x1b\x5c\x2e\xdf\x4f\xf7\x15\x07\x86\x0d\xcc\x10\xeb\x4f\x37\x10\x29\xef\x87\x65\xbf\xb8\xa1\x90\x76\xa8\xf4\x90\xc0\xbc\x61\x56\xe7\x8f\x7b\x0d\xca\x91\xd6\x76\xc0\x27\x60\x03\x79\x65\xbf\xad\xad\xea\x47\xd9\xf9\xcd\xf7\x73\xac\xee\xe4\x76\xce\x81\x8c\xd3\xf3\x8c\x86\xbb\xbe\x6e\x71\x5b\xd9\x36\xee\xed\xee\xee\xff\x67\x9d\xba\xf7\x60\x07\x7e\xef\x16\xbb\x9d\xb2\xe6\x79\xbc\x1c\xec\xf0\xf6\x62\xdb\xd2\xc9\x47\x36\x27\x77\x03\xbd\x66\x03\xe7\x5a\xdb\xe1\x1b\x38\x10\xcd\xa3\x74\xc8\x50\xda\xbb\xdb\xe7\x66\xeb\x46\x4f\x80\x1b\xbb\x09\x15\xfd\x46\x7f\x7c\xec\x96\x77\x12\xc6\xc6\xfc\x93\xaa\x8d\xf1\x8c\x08\xe5\xe0\x03\x90\x87\x8f\x42\xd1\x6a\x42\xd4\x41\xb1\x25\xdc\xfa\xd5\xe5\xab\x8f\x15\x50\x13\x8c\x9c\x4d\x44\x6b\xff\x68\x25\xe4\xd7\x9b\x4e\x3d\xf3\x2a\x3c\x8a\xe4\xd2\x7c\x36\x52\x74\x0a\x96\x3f\x9c\xfe\xb1\x3a\xea\x4c\xfb\xda\x72\x19\x73\x1d\xfb\x59\x1e\x1e\x9a\x02\xe4\xc6\x3e\xa5\x33\x61\x47\xb7\xff\x8e\x4d\x4a\x23\x95\xa6\xb9\x14\x76\x79\x82\x0e\x34\x31\xc9\xad\xd2\xe6\x24\x86\x05\x24\x27\x46\xcc\x86\x5c\x47\x73\x61\x21\xb2\xb9\x86\x13\x9e\x89\x61\x29\x33\x9b\x51\x1a\xff\x3e\xbc\xe8\x23\x0b\xc6\xb5\x3d\x84\xd6\x25\xbd\x80\x61\x2e\x6f\xa5\xba\x93\x43\x6a\x8d\xba\xd7\x6e\xda\x2d\x8a\x21\x8c\x15\x7c\xef\x13\xb8\x90\xa9\xf8\xa3\x2f\x82\xfb\x98\x21\x97\xf1\x90\x9c\x8e\x1f\x79\x2d\x9a\xd8\x76\x87\x65\x60\xc0\x2e\xb1\xe8\x34\x9a\x69\x43\x3c\xb2\x62\x01\x8d\x9c\xd8\x61\xac\xa4\x72\xfa\x50\xd2\x38\xd7\x3c\x54\x66\x08\xde\xec\xe0\x9b\x49\xf9\x12\x65\x1d\x7c\x77\x68\x9f\x2a\x55\x0c\xde\xf2\x49\xa9\x75\xd7\xee\x30\xbf\x71\xa2\xb0\xf7\x71\xa3\xdd\x77\x69\x2c\xa4\xc4\x9c\xe8\xf9\x64\xc9\xac\x5e\x92\x63\x5c\xdf\x3a\xe5\xd3\x7b\xae\x9d\xc4\x7f\x8b\xf7\x19\xa3\x22\x81\xa2\x4f\x89\xd7\x20\x77\x05\x1b\x1e\x67\x99\x32\x02\xdf\xed\xcf\xbc\xfd\x2c\x73\xcd\x8f\xcb\x8a\x9b\xee\x4f\x7f\xdc\x67\xe9\xa6\xd8\x50\x72\x4f\x2b\x7b\x3d\x82\x62\x5a\x8d\xfd\xf7\xcb\x73\x68\x82\xe2\x8a\x2d\x6b\x95\x34\x56\x73\xb1\x3d\xbb\x69\xf3\x68\xe8\x0a\x69\xee\x6f\x60\x48\x41\xa7\x8d\x90\xc2\xd6\x63\xb0\xc2\xa1\x88\x64\x19\x50\x5d\x45\x0c\x25\x3f\x85\x58\x42\xc7\xb8\x1a\x9a\x56\x1b\xe0\x88\xb5\xc2\x13\x3d\x0d\x53\xd0\x1a\xe2\x73\x94\x3e\xaf\x8b\xef\xba\xa4\xca\x1d\xf4\xf3\xc5\x3d\x44\xf9\xae\x35\xf1\xd6\xc7\x9a\x2d\x2f\x18\x44\x7c\xd8\x09\x4d\x02\xdb\x96\xfb\x0b\x5e\xfe\x50\x88\x76\x2f\x88\x18\x6e\x85\xf1\xf5\xb9\x8a\x85\x80\x8a\xe3\xb3\x20\xe1\xc2\x3d\x8c\x47\x1c\x25\x45\x08\x8b\xec\x26\x9a\x2b\x65\xdc\x2e\xc7\xf5\x44\xb8\x0b\xa1\xc8\xe7\x87\x69\x2d\x9a\xa5\x8e\xc7\x84\xf4\x96\x12\x3c\x19\x6a\xcb\xc7\x84\x21\x15\xbc\xc0\x60\xf0\x52\x39\x30\x65\xea\x39\x4a\x4d\xc6\x32\x93\xa7\x0e\xe8\x1d\x88\xd9\xdc\x9a\x01\x13\x23\x18\x21\xd5\x00\x8f\xe6\x15\xb0\x29\x80\xad\xf5\x83\xad\x92\x5a\xd5\x4a\x7c\x54\xe4\x3b\xf8\x04\x9d\x41\x71\xc6\xac\xae\xe5\x46\x74\x0d\x18\xd8\x68\x74\x3c\x60\x65\xc9\x3c\x37\xc7\xc9\x92\x09\x0b\x8e\x67\xa3\x2e\xa2\x55\x3e\xa3\x2f\x81\x10\xd3\x89\xf3\x2a\x92\x41\xd0\x8b\x1a\xa3\xce\x78\x40\x1f\x77\x50\xf4\x7d\xce\x53\xa7\x2f\x16\x4c\x1d\xcd\xea\xa1\x08\x81\xd2\x1a\x4c\xa6\x48\xdb\x5c\x35\xb8\xff\xcf\xe2\xa1\x23\x73\x5c\x22\x73\x2e\x66\xf3\x80\x4b\xee\x0f\x82\xfa\x1a\xec\xbf\xf7\x58\x2b\x5f\x0a\x8d\x86\x1e\x15\x1a\x75\xdf\x76\xc8\xa4\x28\xa9\xaa\xb2\xfe\x16\x74\x5a\x60\x11\x49\x84\x3a\xe1\x13\x86\x42\xeb\x5e\x4f\x63\xec\x15\x3b\x42\x22\x13\xf6\xd0\x20\xc1\x0f\x55\x76\x3c\x62\xa7\x4c\xe6\xc5\x9e\x7b\xe8\x05\x52\x15\xf0\x3d\x20\xf7\x52\xa3\x4a\x58\x0d\xbf\xb8\x15\xbb\xa3\xd1\xcc\x53\x5e\x1d\x43\x8f\x01\x78\xbc\x41\xc4\x43\x40\x08\xd7\x0d\x01\xb4\x63\xdd\x01\x46\xf8\x8a\xe6\x30\xd6\x02\x2c\x70\xbb\x96\x51\x14\xa0\xd3\x41\x55\x7a\x2a\x36\x64\x7d\x17\x13\x2e\x9a\x52\x05\xeb\x86\x32\x58\x47\x78\x65\xad\x22\x74\x36\x8f\xd5\x30\x96\x90\x5f\x55\xc3\x76\x8d\xc9\x4f\x96\x78\x75\xcf\xe0\xa5\xed\xa3\x2d\xa7\x2b\x47\x2b\x9e\x57\x8e\x07\x09\xef\xf9\x05\xf6\x6c\x1e\x1d\x91\x2d\x8d\xf6\xac\xad\x1c\xfb\x87\x06\x6d\x83\xd3\x20\x60\x68\xf3\xe8\x6a\x6f\xd2\x68\x10\x5c\xb4\x79\xac\x89\xa8\x1f\x27\xd6\x68\xf3\x68\x6c\x24\xdd\x3c\x9a\xc6\x25\x6d\x1e\x2b\x49\x8c\x1f\x29\x48\x69\x50\x8f\x50\x62\xdf\x5a\xda\xc7\x6f\x5b\x9d\x27\xe5\xe8\x18\xc5\xcd\x22\x9b\x36\x8f\x55\x01\xf0\x33\x89\x72\xda\x00\xea\x5b\xeb\xc0\xbc\xdd\xfa\x30\x65\xaf\x87\x38\x1d\xaf\x50\x0c\x7c\xea\x4c\xb0\x33\x63\x44\x75\xa6\x01\x0b\x0e\x60\xd8\x57\xb0\xc3\x7c\x9a\xc0\xaa\xcd\xa3\xbb\x83\x93\x46\x47\xc7\x27\x8d\xce\x88\x1b\x05\x9e\x6f\xc8\x2e\xfc\x84\xb2\x0e\x59\xa6\x7b\x59\xa7\x97\x75\xf6\x18\xbd\xac\xb3\xeb\xe8\x65\x9d\x6d\xa3\x97\x75\x36\x8c\x5e\xd6\xe9\x65\x9d\x56\xe3\xf9\xc9\x3a\x64\xa9\xea\xcc\x60\xf6\x23\x19\x5c\x57\x2d\x64\x28\x4d\x85\x90\x9e\xba\xa9\xcc\x9d\xfd\xd7\x9e\xc5\xde\xa0\x79\x2d\x14\xae\xe7\x72\x06\xec\xf5\xf0\xf5\xab\x1d\xd3\x01\x37\x8f\x36\x41\x3b\xd5\xb1\x6f\xea\xe0\xea\xd8\xe6\x91\xf8\x68\xde\x25\xbf\x53\x0b\x87\x47\x4d\xc2\xdc\xe2\x20\x2a\xea\x5d\xa5\x60\x19\xb7\x35\x83\xb8\x48\xa1\x70\x88\xd6\x52\x90\xcb\x98\x5e\x25\xbd\xbf\xc3\x2d\xea\xa8\xd9\x0c\x22\xe0\x14\xc7\x3e\x81\x62\x16\x2a\x05\x4a\x30\x0d\x9b\xde\x4d\x01\x02\xae\xd8\x11\x8c\x66\x23\x16\x53\xb2\x36\x97\x3e\x66\xec\x78\x50\x75\x8f\xa7\x8e\xb9\x6a\xfc\x8f\x9b\xb6\xf7\x8f\xc3\x02\xa4\xcd\x79\x92\x2c\x19\x2c\x44\x64\x8b\xef\xc3\x80\x40\x61\xc9\xd9\xd9\xc6\x95\xd2\x42\x3c\x6c\x2b\x12\x0e\xd7\xf6\xd6\x7e\xfe\xea\x30\xda\xcb\x6e\x6b\xf3\x68\xce\x6f\x56\xe4\x12\xc2\xd0\x68\xab\x5a\x65\xdd\xdb\xc8\x5f\x89\xff\x44\x02\x7f\xff\xa1\xa9\x7b\x8c\x75\x74\x26\xb4\x3e\x07\x56\x15\xa8\x3c\x49\x1c\x79\x93\xc7\x6c\x1d\x05\x1b\x3c\x59\x1b\xb2\x6d\xc8\xcd\x9a\x56\xb2\x6e\xf0\x9e\x1b\x95\xa9\x44\xcd\x96\xd5\x15\xa4\x12\xe2\x95\xf2\x36\x9c\x99\x7c\xe2\x45\x40\xb7\x89\xae\x56\x96\xbc\xf7\x85\x6c\x1d\xbd\x2f\x64\x6d\xf4\xf6\x81\xd5\xd1\xdb\x07\xf6\x18\xbd\x7d\x60\xc3\xe8\xed\x03\xeb\xa3\xb7\x0f\xf4\xf6\x81\x36\xe3\xe5\xdb\x07\x58\xef\x0b\xd9\x36\x7a\x59\xa7\x1c\xbd\xac\xb3\xfb\xe8\x65\x9d\xf5\xd1\xcb\x3a\xbd\xac\xd3\xcb\x3a\xbd\xac\xd3\x74\xb4\x20\xee\x4c\xc5\x9d\xa7\xc8\x64\x2a\x7e\x20\x43\x86\xec\xd5\x91\x1a\x26\x2a\x2a\x2a\x8b\xb8\x47\xbc\xe7\xc3\xf0\x94\x4c\xe8\x03\xf6\xdf\x4a\x02\xa5\x27\x50\xc9\xda\x14\x98\xc2\xf6\x10\x99\x8a\x8f\xcc\x71\x83\xc0\xf3\x3e\xc3\xa6\xcf\xb0\xf9\x0d\x64\xd8\xcc\xb9\xf1\x85\x8f\x90\xb5\x6e\x4f\xb8\xa9\x6c\xff\x1b\xd0\xe9\x6f\x36\xdf\xc6\x11\x9c\x27\x18\xec\x1e\x57\x12\x05\xe1\x2e\xf6\xbe\x5d\x88\xc7\x75\x8c\x79\xbd\x8c\x9a\xef\xc4\x31\xc4\x2c\x03\x3d\x24\x22\x53\x6c\x2a\x7c\xfd\xaf\x15\xfa\xf5\x18\xfe\xcc\xf3\x66\xea\x98\xf8\xac\x93\x67\xea\x9f\xd2\x99\x6f\xaa\xea\xa2\xab\x9d\x8a\x9f\x5d\x2a\x4d\x37\x5a\xe9\x90\x59\xef\x4e\xfb\xae\x95\x5e\xda\x95\x12\x89\x4a\xde\xf5\x5e\x65\x8e\xb7\x8f\x8d\xc5\x69\xff\x99\x83\x5e\x32\xb5\x00\x5d\x2a\x46\x45\xdf\x9e\x41\xd1\x64\x26\xe2\xbe\x00\x72\x37\x06\x9e\x4e\x4c\x11\x5d\x6a\xea\x5d\x7b\x0d\xd9\x33\xab\x7e\xbc\x7d\x74\xab\x38\x74\xa8\x36\x7c\x6e\xb5\x94\xb7\x8f\x4e\xcd\x6f\xac\x63\x13\x1c\xeb\xd0\x0c\xc7\xba\x35\xc5\xb1\xce\xcd\x71\xac\x4b\x93\x1c\xfb\xe4\x15\xa0\xb7\x8f\x8e\xcd\x47\xac\x73\x2b\x1d\xfb\x0c\xeb\x49\x6f\x1f\x1f\x01\xdd\x5d\x5a\xec\x58\x5f\x9d\xba\xf5\xe8\xda\xa0\xc6\xba\x36\xaa\xb1\xae\xe9\xb0\x51\x15\xec\xed\xa3\xaf\x8f\xfd\x11\xe4\xb4\xce\x84\x88\xb6\x35\xb5\x1f\x9b\x68\x07\x34\x59\x74\xf5\xfd\x54\x0a\x10\x9d\xd2\x65\x2b\x59\xf7\xee\x4a\xaf\x2e\x0c\xd5\xac\xb6\x3c\x0d\x71\xab\x48\xd1\xf8\x7b\x1c\x0c\x5e\xb9\xac\x14\x8f\xab\x00\x5b\x6b\x1d\x53\x9a\xce\x8a\xe6\x31\x4e\x29\x28\x9b\x4e\x55\x1e\xc6\x7b\x47\x14\x4e\x5a\x4a\x13\x32\x5e\x0d\x30\x2d\x9f\x40\xfd\x82\x1a\xdd\x1e\x04\x3b\xf6\xa1\x29\xef\x38\x18\x55\x7b\xe2\x7a\x88\x47\xff\xe7\xff\x1e\xd7\xaa\xb7\x94\x00\x3d\x57\x2e\xf6\xce\x04\x2c\x1f\x26\xb0\x80\x04\xe7\x11\x1a\x2e\xcf\x15\x5a\x8c\xa9\xec\x69\xc5\x20\x75\xb5\xba\xa2\x6c\x0a\xdc\xe6\x1a\x2b\x88\x82\xe4\x93\xa4\xfd\x5e\xe9\x15\xcc\x5e\xc1\xdc\x6d\xf4\x0a\xe6\xd6\xd1\x2b\x98\x2d\x46\xaf\x60\xee\x36\x7a\x05\x73\xfb\xe8\x15\xcc\x5e\xc1\x6c\x30\x7a\x05\xb3\x57\x30\x9b\x8e\xdf\xb0\x82\xd9\x6d\xe0\x74\x55\xdd\xf3\x71\x28\x28\x3f\x5a\x6e\x45\x54\x06\x55\x87\xbb\xe8\x5f\xdd\xaa\x99\x55\x15\x72\xb3\x92\x59\x55\x44\xd7\x14\xed\xd1\x23\x1a\x65\xa1\x73\xae\x3d\xf9\xa0\xb2\xf9\xd2\x62\xc3\x3b\x23\xc4\x8a\xd3\xb9\x53\x4a\xbc\x09\xa1\x6b\x65\x6b\xf7\x22\xae\x2d\x66\x47\xc1\xdb\x8f\xad\x5a\xa4\xb2\xf5\x8b\xd2\x8a\x61\x79\x47\xe1\xff\xc7\xb0\x9d\x5a\xc5\x80\x9a\x93\xba\x88\x92\x2b\x22\xb0\x4a\xe2\x71\xdc\x11\x74\x6d\x0e\xd8\x1a\x77\x2a\x24\xc5\x52\x86\xb6\x42\x4a\x86\xb0\x2c\x62\xa7\xc8\x00\x03\x99\x93\xe4\x8b\xf3\x41\xf1\xb7\xc4\x5d\x25\x8e\x88\xe3\x1e\xe3\xd2\xa7\xdb\x2a\x19\xfa\xde\x53\x2f\xfb\x52\x5c\x2e\xba\xb5\x14\x6f\x1f\xb1\x0b\x24\xfa\x2a\x60\x61\x10\x3f\x9c\x3a\xac\x74\x63\xa2\x78\x5e\xa5\x21\xee\xf6\x2e\x0d\xb1\x12\x93\xd2\x57\x86\xe8\x2b\x43\xb4\xaa\x0c\x81\x17\x69\x73\x77\x5e\x22\x82\xfd\xe8\x1b\x30\x69\x40\x54\xa5\x79\x62\x45\x56\xc6\x78\x1b\x7a\x55\x42\x8a\xc4\xd4\xc7\x9a\xd6\xe9\xdd\xbd\x8d\x47\xf3\x55\xba\x47\x78\x18\x13\x6e\x90\x9d\xf8\x78\x4e\x6c\x97\x84\x35\x0d\x82\xd6\x41\x41\xab\xe2\xf3\x8f\x45\x3c\x47\x86\x6d\x4a\xa5\x99\xba\x79\x39\x3e\x9f\x38\x92\x70\x1c\xfb\x81\x03\xa2\xda\x32\x03\xe3\x62\xc5\x02\x64\x79\x4a\x1c\x99\xe3\xe3\x20\x0c\x75\x7a\x7a\x7d\x94\xd3\xe7\x2f\x95\x53\xe2\x7f\xed\x72\xfe\xe0\x07\x15\x27\x50\x89\xbe\xf2\xfc\xf9\xbc\x83\x2e\xdb\xc7\xcf\x75\x61\x90\xeb\x2c\x6e\xee\xc9\x63\xe6\x7e\x4b\xd5\x35\x9e\xa5\x0b\xe3\xd9\x69\x1d\x2f\xc3\x6d\xd1\xa7\xa4\xee\x3e\x3e\x87\x94\xd4\x27\x72\x4d\x7c\x3e\x99\xa9\x9f\xad\x3b\xe2\x73\xc9\x4c\xed\x5d\x10\x7b\x8d\x97\x9a\x30\x5a\x1f\x1d\xba\x1c\x7a\x77\x43\xc7\x32\x55\x27\x87\xff\xc7\x71\x33\x74\x42\x7f\x9d\xc6\xaf\xf5\xb1\x6b\x2f\x3c\x76\xad\x57\xf4\x7a\x45\xaf\x3e\x7a\x45\x6f\x6d\xf4\x8a\xde\x1e\xa3\x57\xf4\xb6\x8f\x5e\xd1\x5b\x1f\xbd\xa2\xd7\x2b\x7a\x3b\x8c\x5e\xd1\xeb\x15\xbd\x5d\xc7\x6f\x4c\xd1\xeb\xae\x68\x7c\x1f\x43\xd6\x7d\x0c\x59\x37\x8c\xb0\x03\xf6\xd7\x09\xd1\x75\x14\x33\xd6\xc7\x8b\x3d\xef\x78\xb1\x96\xa5\xf3\xa4\x15\x1f\xa7\x7c\x5e\x75\xb5\xb7\xd5\xd0\xe3\x0b\x25\x62\x96\xe5\xd6\x57\x10\xeb\xeb\xe8\x3d\xe7\x3a\x7a\xb5\x15\xed\x8b\xe9\xed\x54\x4c\x6f\x1b\xce\xfa\x8a\x7a\x5b\xc6\xf3\x89\x62\xeb\x2b\xea\xed\x3b\xfa\x8a\x7a\x9b\x47\x5f\x51\xef\x81\xd1\x57\xd4\xeb\x2b\xea\xf5\x05\x0f\x5a\x8c\xbe\xe0\xc1\x86\xd1\x17\x3c\x68\x3e\xfa\x82\x07\x3b\x8d\xbe\xe0\x41\x5f\xf0\xa0\x3e\x7a\x27\x54\xbb\xd1\x17\x3c\x68\x39\x7a\xc7\x54\x5f\xf0\xa0\x15\xc0\xbe\xa2\x5e\x1f\x95\xb8\xff\xe8\x15\xcc\x5e\xc1\xdc\x6d\xf4\x0a\xe6\xd6\xd1\x2b\x98\x2d\x46\xaf\x60\xee\x36\x7a\x05\x73\xfb\xe8\x15\xcc\x5e\xc1\x6c\x30\x7a\x05\xb3\x57\x30\x9b\x8e\xdf\xb0\x82\xd9\x57\xd4\x7b\xee\xd1\x90\xec\x39\xa6\x3c\xf5\x15\xf5\xfa\x08\xc9\x46\xcb\xdd\x57\xd4\x7b\x7c\xfc\xe6\x2b\xea\xd5\xa2\xf5\x9e\xae\xac\xde\xfe\xd3\xe8\x6b\xeb\xf5\xb5\xf5\xfa\xda\x7a\x7d\x6d\xbd\xbe\xb6\x5e\x5f\x5b\x6f\xf7\xf1\xfc\x9d\x19\xcf\x4e\xff\x78\x19\x0e\x8c\xbe\xe4\xc2\xee\xa3\x2f\xb9\xb0\x75\xf4\x25\x17\xfa\x92\x0b\xbd\x33\xa2\xc9\xe8\x4b\x2e\xec\x39\x7a\xc7\x43\x5f\x72\x61\xaf\xd1\xd7\xd6\xeb\xa3\xd8\x76\x1f\xbd\xa2\xd7\x2b\x7a\xf5\xd1\x2b\x7a\x6b\xa3\x57\xf4\xf6\x18\xbd\xa2\xb7\x7d\xf4\x8a\xde\xfa\xe8\x15\xbd\x5e\xd1\xdb\x61\xf4\x8a\x5e\xaf\xe8\xed\x3a\x7e\x63\x8a\x5e\x5f\x5b\xef\x39\x47\x93\xf5\xb5\xf5\x36\x8c\x3e\x72\xec\x79\x47\x8e\x35\xa4\x15\x9e\x5b\x95\xaa\x5c\xda\x6b\xd0\x0b\x11\xc1\x69\x14\xb9\xbf\x6e\xd4\x2d\xec\x19\xad\x54\xd7\x42\x1f\x00\xcb\x84\x8c\x45\x84\x7a\xe4\xdd\x1c\xb0\x34\x9e\x13\x6f\xf1\x3e\xc6\xe9\x46\x66\xf1\xce\x92\xbc\x70\x9e\x8e\xa7\x61\x08\x0f\x82\xde\x17\x5f\x84\xa1\x89\x52\x09\x70\xb9\xc7\x93\xfe\x30\x04\xbd\xe7\x6e\xae\x21\xe4\xad\xe7\xc4\x25\x30\x36\x81\x44\xc9\x99\x8f\x18\xf2\x3b\x60\xc4\xce\xca\x1b\x22\x2e\x71\xf3\xe4\x5a\x83\xb4\xc9\x12\xf1\x80\x45\xba\x50\x69\x48\xd5\x02\x62\xe4\xd8\x18\xa8\x44\x62\x24\xb7\x2c\x01\xee\xde\x25\xa1\x7c\x99\xdb\x3c\x9c\x8d\x11\x3e\x01\x9d\x80\x0f\x9e\x6a\x84\xc4\xfd\x79\x63\x23\x6e\xb8\x62\xd8\xf0\x52\x13\x1e\x4b\x11\xaa\x47\x95\x2f\xc4\xad\xb9\x54\x39\xbb\xe3\x24\x28\xe9\x5c\xe2\x66\xc6\x4f\x77\xa8\xdd\xf3\xe5\x2d\x44\x92\xe6\xd6\x87\x21\x72\xb5\x3d\x1f\x6b\x63\x0d\xe0\x7a\xd6\xe8\x90\xaa\x2d\xcd\xe1\xa9\x9e\xe5\x24\x11\x7a\x52\x06\x69\xf5\x12\x23\xfa\x48\xa4\x88\x55\x74\xeb\xc8\x30\xe5\x33\x38\x3c\x34\xec\xec\xdd\xb9\xe3\x7d\xb9\x71\xac\xda\x97\x09\xf4\xbc\x30\xd3\x6a\x21\x62\x47\xd9\x3f\x70\x2d\xf8\x24\x71\x32\xe7\x14\x34\x48\x27\x12\x7c\x71\xf4\xc3\xe9\x87\x5f\xae\x4e\xdf\x5d\x1c\xa3\xf4\x09\xf7\x19\x97\x6e\x4b\xe4\xa6\x8c\x43\xf5\x34\xe1\x5e\x04\x72\x21\xb4\x92\x6e\x72\xa8\xa7\x71\xb6\x08\x50\xa3\x62\x27\x68\x30\x2a\x59\x40\x4c\x32\x72\xf1\xb6\x70\xe4\x08\x99\xe5\x36\xe8\x8d\x18\x1d\xe9\x76\x8f\x8c\xe6\x5c\xce\xdc\x3c\xcf\x55\xee\xe0\x7d\xf1\x05\xce\x48\x43\x9c\x47\x24\x35\xf1\x40\xb2\x5f\x0c\xc2\x31\xe1\x18\xbd\xa1\x9a\x8e\x26\xe2\x59\x98\x73\xf5\xb3\xcc\x52\x5a\x7e\xff\x86\xc2\x03\x0f\xbe\xa8\x5c\x3a\x08\xf5\x30\x95\x7b\x05\x1d\x36\x34\xab\x04\x4b\x31\x26\xec\xa0\x7a\xf7\x88\x5d\xb8\x77\x40\x5c\x45\x20\x45\x77\xc2\x02\x34\x6a\x9d\x1e\x7d\x03\xa6\x61\xc6\x75\x9c\x80\xc1\xb8\xc6\xc0\x98\x49\x33\xf0\x08\x83\x42\xa7\x95\xca\x6e\xe2\x24\xec\x9d\xc2\x18\xc7\xa9\x7a\xc3\xe6\xd6\x66\xe6\xcd\xc9\xc9\x6d\x3e\x01\x2d\xc1\x82\x19\x09\x75\x12\xab\xc8\x9c\x58\x6e\x6e\xcd\x89\x90\x6e\x67\x0d\x63\x6e\xf9\xb0\xb2\xa5\x4f\xe8\xd8\x1e\x46\x2a\x4d\xb9\x8c\x87\xdc\x93\xd6\xb0\x58\xd6\x93\xdf\xfb\x03\x75\xc8\x8b\xbb\x84\x1c\xf2\xa1\x99\x43\x92\x1c\x36\x20\xe6\x76\x02\x5f\x0b\x41\xaf\x95\x80\xe7\xbf\xbd\xfd\xee\xbd\x28\x36\x2b\xe1\x60\xc4\xae\x94\xf5\xe1\xb7\x3e\xd2\x1b\x99\x28\xe2\x77\xf3\x7e\xbe\xb8\xba\xf9\xf0\xb7\xf1\xfb\xcb\xab\x9b\x7e\x5b\xf7\xdb\xba\xdf\xd6\x2d\xb6\x35\xc8\x45\xeb\x2d\x1d\xa4\xcd\xca\x36\x29\xd6\x1b\xcf\x68\x03\x36\x6c\x83\x62\x01\x5a\xcb\x86\x34\x9e\x0c\xeb\x35\x0c\x5c\xc8\xc5\x0f\xbc\x6e\x5a\x97\x1b\xd1\xc1\xfc\x0d\x24\x22\x17\xd2\x77\x9b\xd8\xfb\x16\x66\xac\xb6\x7e\xab\x46\xf2\x23\x8d\xf6\x3e\x25\xf7\xea\xe6\x26\x86\xda\xf2\x5d\xf1\xb4\xac\xaf\xbd\x61\xd5\x46\xec\x5d\x50\x78\xd8\xd9\x2f\x97\xe7\x17\x57\x37\x97\xdf\x5c\x5e\x7c\x68\xae\x41\x77\x60\x6b\x41\x6b\x42\x47\x08\x38\x6c\x78\x4a\x66\x1a\x16\x42\xe5\x26\x59\x16\xf6\x8f\xcd\x4c\x60\x75\xf7\x7b\x87\xef\xb2\xd0\xc4\x37\x3e\xd6\x1f\xb6\xdd\x1e\xb6\xe7\x30\xe5\x79\x42\x7a\xd3\xc1\xc1\xa8\xc9\x29\x47\xa3\x2b\xf2\xfd\x46\xab\x16\xf5\xa3\x6b\x24\x7c\x4d\x95\xe7\xa7\x4a\x6f\xdd\xc6\x87\x3e\xec\xa0\x76\xf4\x78\xe1\x91\x6c\x73\x5e\x7a\x24\xef\x58\x4b\xec\xb4\x74\x2f\x74\xe3\x74\x8f\x94\x9c\x8a\xd9\x3b\x9e\x7d\x07\xcb\x0f\x30\x6d\x67\x20\xae\xe3\x1b\xed\x8e\xde\x87\x8c\x56\x4a\x77\x9c\xd1\xcb\xda\xf9\x67\x3a\xf3\xce\x74\x15\x96\xd1\x3e\x24\xa3\xbb\x08\x8a\x4e\xa2\x27\xd6\xaa\xf9\x93\x05\xda\xdb\x92\xbb\x0a\xae\xe9\xc4\x65\xdf\xee\x94\x0f\xa3\x7e\xd8\x55\x8f\x7b\xcf\x67\xed\xae\x6a\x47\xa4\x64\x04\x99\x35\x27\x6a\xe1\x4e\x2e\xb8\x3b\xb9\x53\xfa\xd6\xe9\x11\x4e\x71\x1d\x12\xd5\x9a\x13\xf4\x16\x9c\xfc\x9e\xfc\x5f\x37\xef\xcf\xdf\xbf\x61\xa7\x71\xec\x5b\xb3\xe4\x06\xa6\x79\xe2\x9b\x21\x8c\x18\xcf\xc4\x0f\xa0\x8d\x50\x72\xc0\x6e\x85\x8c\x07\x2c\x17\xf1\xd7\xcd\x99\x73\x18\x1d\xae\x82\xca\xc8\xc7\xd9\xf1\x4a\x5c\xa3\x77\x65\x59\x3b\xbb\x0a\x26\xe2\x4e\x2d\x61\x0d\xd2\x66\xb0\x37\x7b\x21\xa3\x23\xd4\xec\x6f\x9c\x5f\x1d\xb8\x84\xdd\xf2\xd5\xc3\x92\xb1\x92\x6f\xd3\x13\x6a\xa6\xe2\x37\xcc\xe4\x59\xa6\xb4\x35\x2c\x05\xcb\x9d\xd2\x3b\x72\x14\x36\xa8\xff\x89\x5e\xaa\x01\xfb\x47\xf1\x23\xba\x9a\xcc\x4f\x87\x87\x7f\xf9\xee\xe2\x6f\xff\xeb\xf0\xf0\xe7\x7f\x54\xaf\xe2\x51\x48\xe1\x3f\xf5\x5b\x4c\x06\xd1\x48\xaa\x18\xae\xf0\x1d\xf8\xa7\xa9\x39\x58\xfc\x05\xcb\x6d\x6e\x46\x73\x65\xec\xe5\xb8\xf8\x33\x53\xf1\xea\x5f\xa6\x85\xc4\xc1\x9e\xe7\xc1\x80\x4b\x34\xe6\x76\xfe\x4c\x8e\x87\x92\x97\x74\xbc\x55\x3d\xd4\x6a\x0b\xa0\x94\xe3\x3f\xbf\x09\x28\x70\xd2\xd3\x9d\x16\xd6\xa2\xd3\xcd\xa7\x99\xab\xe9\xc0\xed\xda\x52\xec\x5c\xbc\x3e\x78\x56\x07\x4c\xb1\x82\x1d\x23\x0c\x31\xe2\xb1\x45\x1b\xb9\x38\x60\xd7\x9d\xcb\xa7\xe3\x4b\xb6\x20\x0c\x3f\x1b\xe4\x84\xd4\xe1\x6f\x3e\x2a\x8f\x2b\x5a\x46\x79\x54\x15\x1a\xe2\x1b\x8a\x06\x2a\x12\x98\x59\x22\x52\xe1\x83\x0c\x7d\x7b\x29\xc3\x8e\xe8\xc7\x51\x94\xe5\x03\x7f\xc3\x28\x85\x54\xe9\x65\xf1\x27\x64\x73\x48\x9d\xa6\x35\x34\x56\x69\x3e\x83\x41\xf1\x38\x3d\x56\xfc\x45\x0f\xd6\x5e\xb0\xfe\x34\xa9\xc2\xa5\x93\xd4\x73\x64\x88\x5f\x1e\x6f\x0b\xa8\x7f\x26\xac\xad\xa0\x8c\xab\x8f\x20\x12\x16\x96\x38\x12\x38\x0b\x2c\xa2\x3e\xb9\x50\x49\x9e\x82\x19\x14\x62\x10\x59\x03\xe4\xc2\x69\x96\xe6\x59\x09\x6a\xb1\x58\x08\xd3\x45\xfc\xf0\x06\x39\x4d\xf8\x50\x7c\x95\xdb\x2c\xb7\xbe\x96\x4d\xa5\x2d\x9d\x32\x68\xb7\x28\x0a\x0e\xd4\xd8\xfe\xeb\xb6\x05\xb7\x18\xcb\xb8\xb5\xa0\xe5\x1b\xf6\x9f\x47\x7f\xff\xf2\xd7\xe1\xf1\xd7\x47\x47\x3f\xbd\x1a\xfe\xf9\xe7\x2f\x8f\xfe\x3e\xc2\x7f\xfc\xeb\xf1\xd7\xc7\xbf\x86\x3f\xbe\x3c\x3e\x3e\x3a\xfa\xe9\xbb\x77\xdf\xde\x8c\x2f\x7e\x16\xc7\xbf\xfe\x24\xf3\xf4\x96\xfe\xfa\xf5\xe8\x27\xb8\xf8\x79\x47\x20\xc7\xc7\x5f\x7f\xd1\x7a\xea\x5c\x2e\xdf\xb7\x64\xa0\x34\x86\x9d\x95\x22\x5a\x85\xd8\x51\x80\xf5\xfd\xb0\x54\x9a\x86\x42\xda\xa1\xd2\x43\x02\xfd\x86\x59\x9d\xb7\x63\x26\xe5\xa1\xd4\xf5\xfe\x0f\xbd\xc7\xde\x94\x07\x52\x71\x5c\x3f\x9b\x0d\x6e\x20\xd2\x60\x3f\x85\x25\x87\xde\x14\xe4\x94\x95\x60\xc7\x97\x76\xce\xfd\x16\x8c\x3b\x45\xb0\x20\xae\x6b\x29\x89\x4e\xb5\x4a\x47\xac\xe2\xde\x58\x60\xa6\x87\xbf\xef\x16\x5a\x58\x41\xc3\xe8\x8d\x41\xbd\x31\x68\xcb\x78\xd4\x18\x74\x4d\x74\xf8\x6c\x2d\x41\x20\x17\x4d\x5d\x18\x1b\x3d\xe8\x41\xd7\xb1\x8a\x65\x2a\xcb\x13\x6e\xb7\x78\xc6\x36\xb8\xd3\xfd\x56\x2f\x23\x91\xcb\x48\x1a\x3a\xd0\xd2\xcd\x3e\x4c\x76\x9a\x24\x4c\x48\xda\xf8\x08\x20\x38\xcc\x34\x90\x6a\xc3\x38\xf9\xb3\x17\x6e\x0a\x77\xbe\x64\x5d\x35\xdc\xd3\x30\x63\xb9\xb6\x18\x75\x8c\x25\xed\xe8\x28\xf1\xde\x27\x21\xcb\xc2\x76\x85\x70\x58\x24\x81\x6c\xec\xeb\x99\x70\x63\xc3\xb4\x71\x36\x96\xdf\xa2\xb7\x31\x82\x18\x64\x04\x98\x91\x96\x43\xf9\xad\x13\xa7\xb7\xb1\x0b\xb9\x20\x18\x9c\xc5\x39\x05\x83\x10\xfb\xdb\x0c\xe3\x65\x05\x20\x38\x42\xbc\x0e\xed\x97\x8b\x38\x04\xe4\xfa\x85\x86\x5d\x24\xf6\x15\x56\x56\xf3\x34\x91\x07\xed\xcf\xcc\xc2\xb3\xd5\x4a\x18\x5a\x3b\x2c\x4b\xf3\x73\xfd\x90\x7c\x09\xce\xc0\xf6\xc7\xe7\x6f\xee\xe8\xec\xe8\xd8\xec\xe6\xc8\xdc\xc3\x77\xd2\xe5\x31\xd9\x85\xb3\x24\xd3\x30\x15\xf7\x1d\xed\xd3\x53\x59\x5a\x62\x44\x0c\xd2\x8a\xa9\xa0\x8e\xfd\x99\x86\x0c\x64\x5c\x14\x45\xc5\xac\x70\x59\xc7\xcd\xb3\x0c\xe6\x21\x81\xbb\x5b\x56\x76\xbd\x49\xd8\xef\xf9\x18\xeb\xf9\x58\xe3\xf1\x89\xf8\x98\xa7\xdc\xe7\xc3\xc4\x30\xf2\xbc\x7d\xe8\xfb\x79\x25\x8e\x1d\xa9\x78\x6f\x2a\x2b\x13\xba\x4e\x10\x8a\x59\xa9\x1e\x54\xf0\x45\xab\x28\x72\x8d\xcd\xc5\xcc\xa1\x95\x2a\x0a\x91\xd0\xc4\x52\x2e\xf9\x8c\xb2\xba\xad\x0a\x76\x5a\xa7\x65\x39\x22\xd6\x22\xae\x09\xf7\xf4\x1a\x21\x99\x23\xec\x44\xf1\x18\x2f\x6a\x95\x24\xa0\x0d\x4b\xc4\x2d\xb0\x73\xc8\x12\xb5\xf4\x49\xda\x32\x66\xd7\x96\x5b\x47\xd2\xd7\x60\x9b\xf9\x7c\x5b\x91\x2b\xce\x78\x9c\x27\xc9\x58\x25\x22\x6a\x64\x51\xa9\x2f\xdb\x25\xae\x57\x96\x27\x09\xcb\x10\xe4\x88\xbd\x97\xc8\x31\x4e\x93\x3b\xbe\x34\x03\x76\x05\x0b\xd0\x03\x76\x39\xbd\x52\x76\x4c\xa2\x77\x3d\xda\x8e\x6e\x64\x62\xca\xde\x60\x4d\x1b\xcb\x2c\x9f\xa1\xe2\x14\x7c\x80\x03\x87\xff\x2a\x00\x62\x0e\x77\xc2\x6c\xd4\x54\x5a\x13\xce\xef\x11\x92\x63\x54\xf4\xf7\x27\x5f\xa6\x44\x4c\x21\x5a\x46\x49\xfb\x7d\x75\x1a\x61\xf4\x42\x99\x67\x5e\xa1\x6f\x5f\xa6\xdd\xa7\x76\xa2\x0a\x28\x24\xa3\xfa\xe9\x54\x18\xbe\x24\xf5\x62\x46\xa4\xea\x9a\x4e\x35\xc4\xc6\x27\x67\xdb\x33\x33\x53\xc6\x5e\x3b\xf5\xbc\x93\x2a\xeb\x87\xe3\x00\x8e\x61\x2d\xe9\x24\x81\x98\x89\x34\x85\xd8\xa9\xf0\xc9\x92\xf1\xa9\xc5\x14\xdb\x9a\x79\x20\xd2\x40\x54\xeb\x6b\x97\xcc\xb9\x8c\x13\xd0\x6c\xca\x45\xe2\x8d\x01\xb5\xfb\x2d\xe8\x54\x48\xb4\x09\x90\x3b\x16\xed\x0b\xee\xaf\x28\x52\x3a\xd4\xbd\x17\xd6\x84\x4b\xe5\xc6\xc4\x43\xa4\x42\x00\xab\x7e\x65\x36\x49\x54\x74\x6b\x58\x2e\xad\x48\x68\x32\x4a\xdd\xb2\x48\xa5\x59\x82\x5b\xa7\xc5\xce\x2a\xfe\x39\x2c\x48\x69\xe8\xa0\x9b\x93\xdf\x97\x97\xf0\x87\xa6\xa7\x79\x07\x52\x58\x17\x32\x18\xdc\x43\xd4\x59\x7a\xbf\xe3\xa5\x6e\x95\xd1\xdf\xaf\x64\x21\x8a\x4d\x95\x3b\xc0\xdc\x5a\x97\x89\xd9\x05\xbb\x1c\xb1\x8b\x7b\x88\x2a\x55\x28\xb0\x3f\x04\x32\x02\xcc\x0a\xe5\xb7\xf0\x82\xca\xde\xb5\x48\xbe\xab\x8e\x1a\xda\xcf\x08\x66\xa8\x9a\xe5\x5f\xc1\x12\x21\x91\x2d\xfa\x84\x3c\x26\xa4\x71\x02\x41\x6d\x0f\xd1\x8e\xf5\x82\x2e\x8b\x85\xc6\x9a\x09\xcb\x22\xf8\x3a\xc0\xc2\x72\x04\x4a\x59\x76\x74\x78\x72\x78\xbc\x66\xb3\x3c\x74\x82\x4b\x02\xc4\xa2\xc9\x80\x19\x95\x93\x32\x22\xcd\x92\x25\xce\xe3\x30\x1e\x30\x61\x43\x74\xb6\xce\x65\x98\x95\xcf\x12\x1c\x30\xa3\x98\xd5\x3c\x94\x62\xa1\x5f\xdd\x4d\x56\xe7\xfe\x70\x38\x3a\xfc\xf5\x70\xc0\xc0\x46\xc7\xec\x4e\xc9\x43\x8b\xd3\x1f\xb1\x1b\xe5\xc4\xef\x12\xd0\x52\xe5\x4c\x02\x25\x03\xc0\x7d\x96\x88\x48\xd8\x64\x89\x8c\x8e\xa9\xdc\x52\xc6\x31\xb7\x21\x3b\xf1\xe2\x5e\x58\x1f\xe3\xe6\xc8\xf6\x15\x62\x93\x98\x1d\xe3\x4e\x3a\x5a\xc0\xc9\x1c\x78\x62\xe7\x14\x58\x22\x95\x1c\xfe\x37\x68\x85\x79\x8b\xd2\x5f\x79\x71\x25\x02\x3b\xd1\x36\x1c\xef\xfd\x16\xba\x6b\x2a\xf4\xd7\x9b\x9b\xf1\xb7\x60\x57\x58\x86\x7b\x4b\x08\xf7\x41\x0b\x02\xe8\xa9\xd2\xe9\x33\xe0\x1d\xdd\x38\x38\x87\x2c\x53\xfa\x39\xb0\xb0\xb9\x32\xad\xd6\x92\xad\xad\xa7\x32\x16\x95\x28\x2f\xc4\x49\x88\xdc\x0a\xd6\xe3\x4e\x42\xdf\x9d\xcb\xf1\x88\xfd\x4d\xe5\xee\x6b\x26\x7c\x92\x2c\x8b\xba\x0d\x06\x2c\x3b\x70\xa0\x0e\x1c\x7b\x72\xd4\xf0\x57\xe0\xb1\xd3\x6c\x1c\xf7\x00\xfe\x3c\xfa\x6b\x31\xbf\x1f\xfc\xdc\xba\x3d\x07\x72\x63\x55\xca\xe6\xfe\xb3\xeb\xe9\x9a\x7e\x67\x8c\x70\xf7\x84\x5c\x28\x0d\x19\x71\x38\xff\xcc\x8b\xe3\x5f\x6b\x7c\x83\xf0\xee\x7f\x9f\x60\xcd\xab\xa8\x8a\x36\xdf\x60\x8a\x92\x89\x24\x21\xcb\x91\x1a\x34\x73\xaf\x54\xc7\x33\x2e\x54\xda\x38\xf9\x73\x15\x10\x3a\x02\xdb\xc7\x87\x75\x5a\xa6\xb4\x9b\x58\x03\xb6\xc9\x30\xeb\x69\x86\x8c\x36\x1d\x21\xf1\xe3\xd4\xc9\xfc\x14\x08\xe8\x66\xf1\x59\x97\x18\xc8\x3a\x08\x07\x5f\x0f\x06\xb7\xca\xa9\xaf\x98\xae\x49\xcc\x15\xd9\x84\x01\xbd\x68\x9a\x00\x5e\x8e\xee\x3e\x5d\x35\x37\x14\x84\xb1\x21\xb7\x5a\x33\x99\xa7\x13\xd0\x65\x36\x8b\xb6\xeb\x08\xa9\x44\x33\x5c\xd1\xed\xc1\x04\x5c\x6f\xe7\xe8\x9e\xfc\xd3\xbf\xfd\xdb\x1f\xfe\x6d\x44\xe0\x8b\xc8\x06\xc9\x2e\x4f\xaf\x4e\x7f\xb9\xfe\xe1\x0c\x13\x6a\xdb\x62\xb5\xa3\xb0\xcd\xae\x83\x36\x3b\x0d\xd9\xfc\xa8\x01\x9b\x98\x26\xd2\x9a\x8b\xd4\xfd\x05\x08\xd2\x51\x80\xd3\xdb\x9c\xc6\xe9\x65\xbf\x4a\x69\x33\x27\x6b\xd6\xed\xaf\x6e\xab\x3d\x8b\x3d\x66\xa3\xec\x5a\x45\xb7\x1d\xea\x35\x87\x37\x67\x63\x02\x59\xad\xc9\x29\x83\x31\x44\xc8\x85\x4a\x16\x54\xce\xf7\xe6\x6c\x8c\x3b\x6f\x84\xff\x42\x43\x14\x6a\xd4\x4b\xf7\x6c\x48\x64\xf0\xee\x29\xa7\x7d\x93\x05\x8d\x33\x0d\x3c\x11\xc6\x8a\x08\x9f\x2b\xcd\xa4\x0e\x42\x1b\xbf\x54\xaf\x29\x6d\x1a\x9d\x6b\x4a\x87\xef\x83\xdb\x6e\x6f\xa5\xa9\x6d\xe0\xe1\x33\x3e\x97\xfc\x79\x44\x19\x1f\xfd\xb9\xf4\x9b\x38\x97\x32\x0d\xd7\x56\x65\x1d\x79\x42\x08\xd8\x16\x3f\xc8\x04\xa6\x4a\xc3\xaa\x23\xa4\xe2\xd8\x08\x0d\x86\x25\x66\xff\x05\x13\x94\xaa\x39\x2f\x28\xe4\xd2\xe4\xd1\x3c\x58\x13\x25\x18\x73\x82\x2e\x8f\x3c\x23\x15\x13\x9d\x28\xb9\x86\x81\xfb\x3a\x48\x71\x76\x83\x32\x8d\xc1\xbd\x1e\x24\xfd\x08\x36\x22\x33\x6b\xf0\xbf\x78\x8b\x6a\x98\xfe\xaa\xab\x24\xd2\xdc\xcc\x01\xeb\x87\xc0\xbd\x28\xfb\x9c\x70\xa3\x24\x19\x7b\xfd\xe7\xe0\x41\x63\x58\xc6\x8d\x29\x2b\x38\xfb\x97\xd0\x43\x63\x15\x1f\x1e\x9a\xda\x03\x33\xcd\x23\x60\x19\x68\xa1\x62\x86\xf9\xc4\xb1\xba\x93\x6c\x02\x33\x21\x4d\xc0\x9f\x03\x14\x10\xed\x8e\x1b\x2a\xb6\x1b\xaa\xc5\x8d\xd8\x87\x5a\x11\x14\x9f\x9e\x14\xa9\x72\x47\xfb\x59\xac\x3a\x99\x30\x22\xb4\xd2\xa6\xb9\x58\x98\x10\x36\x6b\x1f\x9f\x74\x07\xde\x26\x87\xda\xf2\xda\x56\xec\x60\x71\x7e\x1e\xcd\xdb\x39\x7e\x7b\xf7\xd4\x8e\xa3\x77\x4f\xed\x37\x7a\xf7\x54\xef\x9e\xda\x3e\x9e\x9d\x79\xb7\x77\x4f\xf5\x4a\xd7\xea\xe8\xdd\x53\xbd\x7b\x6a\xcb\x78\x76\xfc\xab\x77\x4f\xed\x30\x7a\xf7\xd4\x8e\xa3\x77\x4f\xf5\xee\xa9\xde\x3d\xd5\xbb\xa7\x7e\x43\x66\xc0\x30\x7a\xf7\xd4\x1a\x90\xde\x3d\x55\x41\x46\xaf\x29\x6d\x18\xbd\x7b\x6a\xc3\xe8\xdd\x53\x95\xd1\x9f\x4b\x0d\xce\xa5\xe0\xdc\x19\x3b\xbd\xac\x7d\xce\xda\x18\x1d\x07\x22\xf2\x3e\xa2\x6a\x2f\xb8\xe2\x55\x95\xf6\x6f\x95\x9a\x1f\x21\xd5\xc6\x7b\x83\x4a\x1f\xd3\xc6\x7c\xa8\x7d\xdd\x11\x21\x89\xd0\x9c\x64\x8a\xfe\xaf\x74\x46\x54\xbc\x10\xa4\x9d\x36\xcf\x49\x7b\xb2\x6c\xab\x36\xae\x87\x67\xed\x76\x78\x26\xae\x9d\x0e\x5c\x0d\xbd\x9b\xe1\xc5\xb9\x19\x5e\x4e\x0f\x5d\xef\xcc\xbf\x99\x6b\x30\x73\x95\x34\x26\xf4\x1a\x91\xbf\x13\x52\xa4\x79\xea\x68\xce\x38\x7a\x16\x8b\x22\x6a\xc0\x14\xe4\x4a\x8c\x9e\x2c\x85\xee\x46\x11\x03\x16\x40\xe5\x22\x71\xcb\x88\x69\x9d\x73\x8e\xa2\xba\xc9\xa3\x08\x00\xdb\xab\x55\xb5\x98\x3f\x8c\x8a\x37\x15\xed\x34\x5e\xb7\xe3\x37\xed\xce\x7e\x2a\x51\x8a\x50\xfe\xf0\x55\x23\x18\x2d\xbd\x3c\x9f\xde\xc3\xd3\x01\x9b\x6e\xaf\xaf\xb4\xd2\x55\xba\x38\x25\xda\xea\x28\x9f\x9b\x27\xa7\x33\x8f\x66\x07\x1e\x9c\x67\xe4\xbd\x79\x36\xc7\xc2\x73\xf1\xd8\x3c\xc3\xea\xab\x1d\x38\x18\xba\xf0\xd0\x74\xe7\x9d\xf9\x08\x45\x4a\x3f\x8e\x57\xa6\x43\x6d\xb8\x23\x6f\xcc\xa7\xf0\xc4\x74\xf2\xd5\x6d\x3d\x30\x9f\xce\xfb\xd2\xcd\xe7\xb6\xb4\x6e\xbd\x08\x8f\x4b\x07\x56\xad\x2e\x2d\x5a\x9d\x59\xb3\x3e\x9a\x87\xa5\xbd\x77\xe5\x19\x78\x56\x5a\x23\x59\x48\x61\x05\x4f\xce\x21\xe1\xcb\x6b\x88\x94\x8c\x1b\x9f\x30\x2b\x55\xeb\x8a\xfd\x63\x08\xac\xd7\xd1\xea\xf1\xc7\x73\xee\x8b\xf3\x42\x1c\x42\xaa\x83\xf9\xcf\x0b\x14\xd8\xd0\x84\x66\xf9\x2c\x0d\x7a\xec\xd9\x28\x83\x14\x8c\xdd\xe5\x22\xfe\x55\xdd\x31\x35\xb5\x20\xd9\x91\x90\x61\x1d\x8f\x2b\x6a\x60\xa9\x99\x17\x64\xed\xae\xbe\x7e\x15\x6e\x7e\x79\x2a\x37\x1a\x17\x8c\xf9\xf8\x16\x10\xff\xa2\xc7\x4d\x20\xfe\xc6\x69\x9e\xd4\xcd\x20\x64\x1a\xa9\xf3\x9b\xd7\x65\x79\xd1\xd7\x08\xb7\xd8\x6d\x5c\xc6\xcc\x27\x6e\xbc\xbc\x45\x6b\xed\x37\x7e\x09\x3e\xe3\xde\xf6\xc2\xba\xb6\xbd\x3c\x91\x6f\xf8\x19\x4a\xcd\x9f\xa9\x3f\xb8\x97\x9a\xf7\x18\x95\xfc\xaf\x6f\x35\x8f\x60\xdc\xb9\xc0\x11\xb6\x13\x8b\x73\xed\xd3\xf6\x0a\xb9\xa3\xd8\x3c\x12\x20\xa6\xdd\x54\x24\xc5\x61\x36\xda\x34\x4f\x92\x25\xcb\x33\x25\xeb\x99\x87\xe4\xb4\x5a\x4d\x58\x73\xd0\x36\xbd\xa5\x94\x52\x33\xad\xfc\x01\xac\x73\x29\x1d\x3f\x2f\x1b\x0e\xa1\x54\x6a\x88\x57\x57\xd3\xe2\x8c\x98\xb9\xe9\xbb\xc3\x14\x33\xe6\x44\x0a\x65\x4b\x8a\x12\xa0\x7b\x7a\xaa\x74\x24\x26\xc9\x92\xcd\x79\x52\x74\x97\xe0\xec\x56\x24\x89\x07\x33\x62\xd7\x60\x99\x9d\x0b\xdf\x18\x9c\x25\x4a\xce\x70\x72\x5c\x86\xae\x66\x10\xb9\x67\xa3\x04\xb8\xcc\x33\x7a\x9f\x3b\xd6\x97\x2a\xd7\xe1\x7d\xbe\xac\x65\x01\x45\x18\x26\x45\x32\xa8\xf4\x4e\x7a\x70\x61\xcb\x06\xf5\x06\x42\x4e\xe1\x9d\x30\x30\xa8\xc2\x0c\x95\x79\x4d\xa5\x73\x46\xa6\xd5\x42\xc4\xd4\xfd\x22\xa0\x0d\xbb\xb4\x52\x77\x8c\x62\x3f\x4b\x25\x87\x12\x66\x1c\xa5\x1e\xbf\x8b\x68\xcd\x08\x0e\xb9\xe2\x64\x8c\xfd\x32\x9c\xba\xa0\xb2\x5a\x2a\xeb\x42\x50\xa7\xcf\x0a\xe6\xd8\x91\x54\x4c\xe1\xf1\x9a\x4b\x61\xa9\x7b\xf4\x3c\xb7\x2c\x56\x77\xf2\x78\x44\x55\x89\x85\x61\x9c\x4d\xc0\x86\x4e\xb6\xa1\xb3\xa2\xd0\x60\x18\x48\x3e\x49\xdc\x9a\x63\xc0\xc3\xcd\x46\x04\xb1\x29\x70\x9b\x6b\x60\x33\x6e\x61\xa3\xd0\x44\xdf\xfb\x30\x7a\x85\x29\xba\xbc\xe7\xd2\x40\xe3\xfe\xd6\x1d\x4b\x5a\x7f\xfa\x63\x33\x1e\x21\x52\x50\xb9\xfd\x24\xaa\xe4\xdd\x5c\x44\xf3\xaa\x64\x2c\x52\x30\x4c\xe5\x2b\x3a\xf6\x6b\xff\xd8\xe6\x15\xea\xf5\xc9\x4d\xa3\xa9\x95\x78\x83\x29\x6d\x35\xe5\xb8\x6c\x2b\xcb\xdd\x06\x3c\xbf\xba\xfe\xe5\xed\xe9\x7f\x5c\xbc\x1d\xb1\x0b\x1e\xcd\xab\xf9\xe8\x92\x71\x64\x1a\xc8\x28\xe6\x7c\x01\x8c\xb3\x5c\x8a\x7f\xe6\x54\x9d\x9c\x1d\x15\xcf\x1e\x77\x5a\x0b\xb9\xe1\xe9\x8b\xad\xaf\x3b\xeb\xb5\x44\x8d\xb4\x29\xc0\x41\x19\xc0\xee\x08\xab\xe2\xd3\x85\xbb\x44\x8a\x06\x8a\x5a\x73\x70\xcc\x48\x2c\x3c\x1b\xf6\xc5\xa5\x79\x5c\x84\x5c\x38\x3a\x77\x64\xe1\x8e\x2a\x3e\xc1\x50\x89\x39\x30\x09\xd6\x91\x75\x61\xb0\x52\xd2\xd4\x0a\x03\xe4\x06\xcc\x80\x4d\x72\x0c\xee\xc8\xb4\x48\xb9\x16\xc9\xb2\x0a\xcc\x9d\x55\x57\x2a\xa8\x43\xcb\xd5\x29\x9d\xbf\xbf\xb8\x66\x57\xef\x6f\x58\xa6\xa9\x64\x00\x46\x67\xe0\x75\xfc\xac\x09\xb8\x27\x7c\x8f\xce\x11\x3b\x95\x4b\xba\x48\x1b\x5c\x18\xe6\x74\x21\xc0\x23\xd8\xcb\x90\xa1\x28\xfc\xc1\xab\x11\xfe\xef\xc0\x7d\xa5\x76\x42\x66\x11\x74\x12\xad\x05\x8f\x91\x18\x2a\x26\x49\x05\x9b\xfe\xdb\x5f\x54\xb7\xa5\x22\x6c\x6e\xec\x90\x58\xe9\xb6\xc4\x8b\xa5\x46\xf4\x52\xf7\x2d\x21\x67\x49\x95\xaa\x9a\xb1\xfd\xb6\xba\x65\x5b\xcd\x72\x58\x7e\xc1\xb8\xa9\x82\xd9\x49\xd7\xa7\x72\x0e\x1d\xf5\x4a\x29\x4f\xbf\xa0\x4e\x79\x8e\xa0\xaa\xed\x2f\x2f\xc7\x61\x07\x78\xe9\x26\x5d\xe9\x99\x88\x0f\x93\x53\x63\xc0\x5e\xb1\xbf\xb0\x7b\xf6\x17\x54\xaf\xfe\xd4\xb6\xb3\x4c\x5b\xc5\xa7\xbd\x79\x87\xb4\xfa\xcb\x71\x47\x18\xff\xd1\x71\x27\x07\xd1\x61\xd5\x2a\x36\x11\x5e\x9c\x87\x7b\x0b\xda\xf1\x51\xbf\x12\x4f\xda\x93\xc7\x4d\xf0\x13\x92\x19\xf9\x2e\x2e\xa7\xd5\x96\x10\x76\x4f\x42\x73\x8f\xff\x55\x19\x7b\xe5\xb9\x50\xbd\xc1\x44\x09\x2d\xe5\x36\x9a\xd7\xd9\x98\x13\xd4\x8c\x2d\x37\x98\x61\xb1\x42\x4b\x1a\xc5\x01\xce\x45\x8b\x48\x8c\xe7\x43\xc6\xed\x9c\xf3\xb5\xf5\x7c\x68\xa5\x56\x0c\x28\xa8\xf9\x78\xc1\xaa\x52\x5e\x26\x53\xb1\x97\xc9\xdc\xb4\xe2\xca\x99\xf1\x80\x50\xe6\x6d\x35\x85\xc9\x1a\x69\xc9\xed\xa7\x88\x4b\x0a\xe0\x9e\x82\xd6\x14\xba\x39\x59\xa2\x07\x59\x44\xd0\x7a\xf1\x5a\xed\xa4\x4c\x2b\xab\x22\xd5\xa2\x6d\x50\xdd\x61\xee\xc1\x21\x12\xc8\xf8\x1b\x6c\xee\xdf\x9f\x8f\x07\xec\xe6\x6c\x8c\xdd\x54\xae\xcf\x6e\xc6\x75\x4d\xe5\xe0\xe6\x6c\x7c\xf0\xa4\xa8\x60\x41\xb2\x7a\xe3\xa6\xd9\x00\x48\xcd\xf0\xe4\xc4\xb6\x61\xca\xb3\xe1\x2d\x2c\x1b\x9e\xa9\x5d\x9c\xeb\xc3\x62\x85\x3b\xf9\x20\x42\x73\xca\xb3\xbd\xa1\x69\xe0\xb1\xf8\x44\x59\x14\x7e\x67\x95\xef\xdc\x9c\x4e\x91\xaa\x05\xc4\x24\x0e\x87\x27\x40\xc6\x99\x12\x4e\x5e\xec\x73\x2c\xf6\x7f\xba\xcf\xb1\x78\x68\xf4\x39\x16\x7d\x8e\x45\x9f\x63\xf1\xf0\xe8\x73\x2c\xfc\x78\x7a\x33\x28\xeb\x73\x2c\x1a\x8e\x97\xe5\xe7\xef\x73\x2c\xf6\x1a\x7d\x8e\xc5\xfa\xe8\x73\x2c\xb6\x8c\x3e\xc7\x62\xcb\xe8\x73\x2c\xfa\x1c\x8b\x3e\xc7\xa2\x8f\x16\x7b\x14\xd6\xf3\x8c\x16\x63\x7d\x8e\x85\x1f\x7d\x8e\xc5\x8b\x88\x89\x61\x7d\x8e\xc5\x4e\xa3\xcf\xb1\xe8\x73\x2c\x9a\x8c\x3e\xc7\x02\x47\x6f\x7b\xe9\x73\x2c\xc2\xe8\x73\x2c\x68\xfc\x76\xa4\xe6\x3e\xc7\xa2\xcf\xb1\xe8\x73\x2c\xfa\x1c\x8b\x07\x67\xd1\xe7\x58\xbc\x04\x7d\x32\xf4\xc0\x6b\x9f\x33\x70\x78\xa6\xd2\x2c\xb7\xc0\x3e\x04\x90\x85\x14\x49\x8c\x41\x98\xaa\x44\xd0\x3e\x84\x27\x52\x72\x2a\x66\x9e\xb3\x9f\x50\x83\xb9\x61\xf1\x3d\xc3\x4a\x53\xb7\xcf\x30\x7e\x27\x11\xa9\x68\x96\xc8\xc1\xd6\x16\xe6\x2d\xc2\xaa\x38\x79\xdc\x4e\x4a\xf9\x3d\x6e\x11\x9e\xaa\x9c\x9a\xf2\x45\x7e\xfd\x0a\x14\x92\x2b\xec\xd9\xad\x0c\xeb\x46\xc5\x29\x33\x52\xc6\x1d\x68\x1b\x19\xb7\x16\xb4\x7c\xc3\xfe\xf3\xe8\xef\x5f\xfe\x3a\x3c\xfe\xfa\xe8\xe8\xa7\x57\xc3\x3f\xff\xfc\xe5\xd1\xdf\x47\xf8\x8f\x7f\x3d\xfe\xfa\xf8\xd7\xf0\xc7\x97\xc7\xc7\x47\x47\x3f\x7d\xf7\xee\xdb\x9b\xf1\xc5\xcf\xe2\xf8\xd7\x9f\x64\x9e\xde\xd2\x5f\xbf\x1e\xfd\x04\x17\x3f\xef\x08\xe4\xf8\xf8\xeb\x2f\x1a\x4f\xb9\xb5\x48\xdc\x9d\x40\xdc\x91\x38\xfc\x51\x84\x61\xef\x1d\xee\x68\x2f\x7e\xf0\xd0\x56\x77\xa3\x3f\xb0\x1e\xda\x8d\x81\x9b\xa2\x98\x57\xc0\x11\x86\xa9\x54\x58\x27\x1c\x3a\x79\x90\x57\xe3\xc2\x84\xad\x29\xa5\x9e\x0f\x60\x40\x25\xb7\xd4\x23\xb4\x88\xa9\xaa\xc4\x69\xab\x20\xf9\xf9\xde\xab\x85\xbd\x02\xf7\xf3\x30\x86\xa9\x90\xe0\xfd\x60\x3d\x6f\x78\x7c\xf4\xbc\xe1\x25\xf2\x06\x03\x51\xae\x85\x5d\x9e\x29\x69\xe1\xbe\x91\x85\xa5\xce\x1a\xae\xeb\x00\x19\xed\x33\x9f\x45\xe9\xaf\x31\x95\x51\x00\xe5\x4a\x3a\x6b\x11\x82\xab\x73\x89\x0a\x26\x65\xc9\x80\x25\xed\x0f\xf5\x1e\x8c\x89\x5c\x7d\x49\xd0\xe7\x48\xcd\xfc\x67\x2e\x16\x3c\x71\xda\x6e\xf9\xc4\x18\x35\x98\xea\x43\xbb\xee\x79\xcb\xcd\x6d\xb9\xe1\x61\xe8\x64\xe8\x62\xce\x27\xe1\x93\xf0\x27\xb8\xb7\x9f\xa3\x94\x86\x02\xd2\x58\x8b\x85\x48\x60\x06\x17\x26\xe2\x09\xf2\xb5\x6e\xce\x8a\xd3\x2d\xd0\x71\xe1\xb5\x4a\x0c\xbb\x9b\x03\x76\x57\xe6\xc1\x04\x80\x19\x2e\x33\x2e\x24\x4b\xdd\x12\x65\xe1\x61\x43\xb6\x04\xc7\xfe\x33\xae\xdd\x02\x17\x36\x03\x54\x91\x27\x4a\x25\x3e\x74\x38\x59\x96\xf0\x7d\xec\xbd\x54\xbf\x48\xb8\xfb\xc5\x41\x33\x6c\x9a\xf0\x59\x61\x2a\x30\x60\xd7\xac\x7d\x25\xe8\xad\x1f\x80\x71\xb9\x39\x30\x9e\xdc\xf1\xa5\x29\x0d\x27\xd5\x3e\xe0\x6f\xd8\xeb\x63\x24\x67\x6e\x58\x01\x23\x66\x5f\x1d\xa3\x2f\xf1\xec\x74\xfc\xcb\xf5\xdf\xae\x7f\x39\x3d\x7f\x77\x79\xd5\xee\xa4\x70\xdf\x0e\x5c\x36\x82\x11\xf1\x8c\x4f\x44\x22\xda\x1c\x10\x6b\xd1\x26\x55\xa0\x78\x04\xc7\xf1\x49\xac\x55\x46\x78\x0a\x36\xaa\xf2\xa4\xac\x6b\xc1\xd5\xcc\x64\x5c\x9e\x69\x1d\xe0\x4c\x73\x69\x4b\x63\x4d\x89\x72\x9d\x4b\xa7\x58\x7f\xe6\x81\xf9\x3c\xee\x2e\x28\xff\x34\x8e\x21\xae\x61\xef\xc5\x05\x01\x9e\x85\x8f\x5b\x96\x39\xda\x6c\xfc\xfe\xfa\xf2\x7f\xaf\x90\xe1\x32\x6b\x17\xf3\xd4\x4d\x5e\x98\x56\x59\x67\xab\xfb\xc1\xe7\x1d\xf5\xeb\xfb\x2c\xd6\xb7\x38\xab\xba\xf1\xb4\x7f\xc8\x65\xbd\x8c\x47\x09\x9f\xa5\x2a\x86\x11\x1b\x17\x56\xfa\xfa\xd5\x4a\x7a\x2f\xd7\xc0\xdc\x2d\xd2\x0a\x9e\x24\xcb\xaa\x80\x64\x15\x25\xd3\xd4\x32\x93\xab\x7c\x78\xca\x13\xd3\x92\x99\xb6\x39\x99\xdc\x21\xfc\xce\x29\x93\x9d\x60\xb3\x80\xc6\x62\x90\xca\x7a\xa9\xd4\xcd\x12\x93\xb5\xb5\x8a\x18\x69\xae\x95\xb0\xa8\xda\xe9\x62\xc8\xd0\x1f\x0e\x26\x61\x02\xae\xc6\x05\x64\xb2\xf2\xe6\x06\x56\xa5\x5b\x7f\x30\x95\xba\xac\x83\xae\x81\xc7\x98\x93\x96\x71\x3b\xa7\xa8\x86\x94\x9b\x5b\x88\xe9\x07\x2f\xd7\x14\x66\x7e\x07\xb1\x78\xd5\x8d\x9b\x77\xb0\xe9\xa3\x3c\x43\xb1\x16\xe8\x0b\x68\x56\x74\x83\x75\xb1\x05\xdc\x37\xbd\x97\xc9\xf2\x83\x52\xf6\x9b\x22\x17\xab\x93\x05\xfc\xd1\x4b\x8a\x75\x33\x2c\x8a\x52\x18\x84\x10\x0f\x11\x99\x48\xd2\xd5\x34\xb0\xf3\x72\xc1\x9e\x98\xa0\x75\x2e\x4f\xcd\xb7\x5a\xe5\x8d\x4f\x80\x35\x41\xeb\xdb\xcb\x73\xdc\xc7\xb9\xf7\xb2\x49\xab\x97\x98\x75\xba\x5e\x30\xa8\x90\x69\xbf\xf7\x7e\xc2\x2a\x45\x96\x2e\x1d\xf6\x8e\x2f\x19\x4f\x8c\x0a\xc2\xb1\x90\x1b\x15\x28\xaf\x9d\xb9\xcb\x13\x65\xe7\x6b\x6a\x99\x23\xe7\xf5\xe7\x06\x15\xa7\x5b\x59\xc1\x48\xc8\xb5\xc7\x2d\xbf\x05\xc3\x32\x0d\x11\xc4\x20\xa3\x96\xab\xf6\xd4\xae\x26\x5c\xf9\x2b\x25\xdd\xb6\xe8\x64\xed\x2f\x0b\x1f\x23\x1a\xc2\xea\x2b\x8d\xde\x4a\xaf\x77\x70\xf4\x59\xe2\xa6\xc8\x0d\x68\x72\xb0\xea\x1c\x68\x21\xbe\xcb\x27\x90\x80\x25\x65\x08\xeb\x4e\x70\x4b\x8a\xb4\x48\xf9\x0c\x18\xb7\x05\xa1\x58\xc5\x40\x1a\xc7\x6e\xc8\xf4\x66\x59\xac\xa0\x4c\xa0\xe4\x86\x7d\x7f\x79\xce\x5e\xb1\x23\xf7\xae\x63\x5c\xfe\x29\x17\x09\xba\x33\x2d\xd7\xab\x73\x14\xd3\x00\x02\xa7\x84\xb4\xc7\x94\xa6\x2d\x3a\x60\x52\x31\x93\x47\xf3\x30\x27\xa7\x71\x05\x85\xcd\xc7\xf3\xa1\x51\xff\x05\x92\x6a\x6b\x06\xf3\xbd\x01\xdd\x19\x7f\xf9\xbe\x01\x7f\xa9\x8a\x10\x8e\xe6\xea\xd8\x23\xc2\x4a\xc1\xf2\x98\x5b\xee\xf9\x4e\x99\x75\xfd\x12\x97\xf4\xa9\xb9\x8f\x81\xb7\x42\xe6\xf7\x14\xb1\xd2\x9d\x92\x7f\x7d\x81\x60\x59\x14\x90\x86\x8b\xc6\xb3\x2c\x11\x94\xef\xbc\x12\x41\x75\x59\x5b\xea\xc1\x16\x11\x09\xb7\x39\x4f\x12\xe5\xd8\x9b\x3b\xd9\xb9\x8c\x55\xba\xf6\x32\x27\x40\x41\xad\xd0\xdd\x88\xbd\x48\xe2\x79\x72\x73\x44\x02\x0b\x68\x51\xd3\x65\xb5\x2e\x9f\x83\xe6\x64\xb1\xb0\xa0\x08\x9e\x25\x7c\x02\x09\x9d\x2c\x44\x40\x66\x9d\x80\x9e\x3a\x0a\x51\xab\xa4\xbb\x1c\x8c\x0f\x2a\x01\x0a\xeb\x09\x88\x70\xe0\x3f\x0b\x3c\x20\x90\xae\xf0\x80\x8a\x4c\x0d\x0f\xa8\x92\x7d\x0e\x78\xc8\x5b\x1c\xb4\x6c\x15\x0f\xee\xd4\xae\xe3\x01\x8f\xce\xe7\x8e\x07\x03\x51\xa4\xd2\x6c\xac\x95\x53\xb9\x3a\x3b\x5a\x3c\xd8\xd2\x57\x44\x3a\xf9\x86\x20\x1c\x64\xe5\xf5\x9b\xb9\xae\x04\xf4\x71\x4b\x3c\x3e\x44\xf5\xfd\x8f\x6a\x87\x64\xc7\x7a\x56\xcf\xa1\x00\xa5\xe6\x56\x72\x4f\xfa\x0b\x9f\x79\x39\x81\x16\x56\xb2\x4e\x0e\x13\x15\xf1\x04\x4b\xee\xb5\xa3\x18\xb6\x4a\x35\xab\x80\x2b\x51\x98\xe8\x5a\xc2\xdf\x82\xdf\x1f\xab\xaf\xe1\x2f\xde\xf6\x25\x55\x0c\x15\x17\x24\x85\x8f\xde\x50\xb4\x1e\xde\x17\x02\x40\xdd\xa9\x1e\xbc\x81\x71\xed\x69\xab\x7c\x05\x98\x77\x45\x21\x3f\x37\x41\x90\xb1\x90\x33\xb4\xe8\x0c\x98\x86\x84\x42\x47\xfd\x1e\xbe\x25\xf5\xeb\x10\x29\x3a\x00\x0d\xe4\x1c\x5e\x8d\x92\x90\x50\xd2\x43\x46\x23\x47\x90\x6f\xa6\xc4\x2d\x85\x61\x07\x6f\x03\x02\x5a\x54\x3e\x7b\x8e\x07\xc4\x01\x7d\x61\xb1\x9a\x64\x63\xbb\x15\x32\xf6\x51\x96\x35\x64\x15\x35\x6a\x49\x0a\xc5\xf8\x5d\x11\x57\x59\xc3\x1b\xf6\x77\xc9\x0a\x64\xb1\x61\x63\xf2\xf8\x40\x02\x6b\x30\x2f\x0d\x1f\x36\xf9\x15\x2f\x59\x05\xf3\xbd\xc4\xb5\x77\xef\x1d\x3a\xb5\x77\xfd\xbe\xf0\x2d\x07\x4f\xb9\xae\x77\x42\xc6\xea\xce\x74\xad\x43\xfc\x48\x60\x83\x40\x1d\x39\xb2\xb6\x42\xce\x4c\x55\x8f\xe0\x49\x52\x33\xc3\x6e\x52\x24\xc2\x0a\x17\x15\x89\xd7\x05\xf8\x95\xe8\xf0\x5e\x09\xd8\x63\xcc\x52\xc3\xcf\xb4\xfb\x14\x2b\x78\x72\x9d\x35\x2f\xcd\xc6\x56\xc9\xe0\xdb\x77\xd7\xa7\x75\xd0\x8e\x9f\xdd\x61\xc5\x6b\x87\x6c\x77\x9d\xf1\x38\x15\xc6\xa0\x19\x08\x26\x73\xa5\x6e\xd9\x51\x08\xdb\x98\x09\x3b\xcf\x27\xa3\x48\xa5\x95\x08\x8e\xa1\x11\x33\x73\xe2\x89\x76\xe8\x66\x7f\xcc\x84\x4c\x8a\x68\x14\x54\x23\xa5\x35\xc1\x8c\x81\x2f\x89\x8a\x59\xe0\xda\xfa\x7a\x9d\xde\xcb\xbc\x3e\x4d\xaa\xd0\x29\x20\x79\xfa\x82\x33\xeb\xcb\x73\xd5\xb2\x76\xc6\x23\x4b\x84\xdf\xee\x53\x53\xaa\x69\x54\x1b\xf1\x48\xd2\xdb\x93\x23\xc9\x4b\x07\x11\x98\xee\xaa\xf2\xfc\xb5\x84\xc9\x62\xa0\xec\x09\xc0\xa8\x13\xbe\x35\xb8\x09\xad\xb2\x87\x98\x84\xe7\x1f\x3d\xac\x4a\xb4\xe8\xf5\xa1\x34\x0f\xa7\x0f\x24\xd9\x9c\x0f\x49\x49\x76\x2c\x09\x79\x58\x90\x01\xe6\x4a\x2a\x1f\x9c\xee\x4e\x41\x25\x91\xa4\x51\x5b\x20\x47\x10\xae\x89\xe7\xb1\x95\xa9\x9e\x95\xfe\xc1\xaa\x0f\x09\x93\x78\xa8\x08\x40\x39\x87\x3b\x61\xe7\xa1\xc2\x7d\xcd\xe1\x84\x33\xd1\x60\xd0\x7b\x20\x19\x68\xad\xb4\x0f\x84\x09\x56\x5b\x84\x84\xac\x18\x23\x69\x1c\x91\x70\xf7\xd7\xa1\xa9\xba\x28\xcb\x12\xb8\x18\x27\xe6\xa8\x09\xa6\x53\x88\x50\x52\xaa\x22\x98\xd8\xee\x51\x59\xb9\xcf\x47\x77\x3b\x02\xf3\x25\x74\x53\x71\xef\xde\x52\x7d\xaa\xea\x0c\xf5\x15\xf3\x36\x5f\x3e\x1e\x31\x76\x29\x8b\xc8\xc9\x81\x5b\xc5\xea\x9d\x21\xe4\xc7\xba\x4f\xac\xd6\x5f\xc6\x0f\xa8\xda\x9d\x9c\x78\xa7\xf3\x0e\x28\xbe\x8d\x31\x98\x55\x0d\xc2\x9d\xb2\x03\x34\x0c\x7b\xa0\x6e\xe9\xc3\x21\xde\xc6\x50\xec\x6e\xf9\x58\xc6\xe2\xcf\xe3\xa4\x67\x6d\xf9\x9c\x4f\x89\xef\xa8\x28\xee\x75\x05\x5a\x45\xfc\x2e\xdc\x4d\x63\x15\x53\x49\x8c\x22\xa5\x1f\x7b\x59\x60\x89\x0e\xf1\xdf\x41\xc0\x2a\x85\x34\xa9\x28\x2a\xbb\x5a\x2b\xc3\xd7\x04\x8d\x99\x93\x95\x93\xa0\xdb\xa7\x59\x02\x98\x3d\x57\x81\x5c\x26\x06\x56\xaa\xe8\x0e\x8a\x89\x94\x85\x78\x7d\x85\x8e\x01\xfb\x2f\xdc\x94\x45\x00\x60\x28\x1e\x30\x2e\x1e\x27\x15\x4f\x98\x50\x52\x1b\x33\xdb\xac\x0a\xa6\x03\x16\x8b\xe9\x14\x42\xa0\xa1\x53\xfd\xb8\xe6\xa9\x63\xf1\x86\x79\x14\x4c\x60\x26\x28\x92\xad\x60\x6c\x87\xa6\xcc\x80\x1f\x10\x33\x14\x96\xa5\x62\x36\x27\x42\x61\x1c\x33\x23\x59\x70\xa9\x25\x8a\xc7\x0c\x69\x5b\x69\x76\xc7\x75\xea\xce\x0d\x1e\xcd\xd1\x3f\xc7\x25\x8b\x73\x8d\x65\x22\x2d\xf0\x78\x39\x34\x96\x5b\x27\xea\x82\xf6\x1a\x61\x98\x7f\x5f\x4a\xf8\xc1\xd1\x97\x12\xde\x3e\xfa\x52\xc2\x7d\x29\xe1\xbe\x94\xf0\xc3\xa3\x2f\x25\xec\xc7\xd3\x67\xfb\xb2\xbe\x94\x70\xc3\xf1\xb2\xca\xd9\xf4\xa5\x84\xf7\x1a\x7d\x29\xe1\xf5\xd1\x97\x12\xde\x32\xfa\x52\xc2\x5b\x46\x5f\x4a\xb8\x2f\x25\xdc\x97\x12\xee\x8b\xa2\x3d\x0a\xeb\x79\x16\x45\x63\x7d\x29\x61\x3f\xfa\x52\xc2\x2f\xa2\xf4\x13\xeb\x4b\x09\xef\x34\xfa\x52\xc2\x7d\x29\xe1\x26\xa3\x2f\x25\x8c\xa3\xb7\xbd\xf4\xa5\x84\xc3\xe8\x4b\x09\xd3\xf8\xed\x48\xcd\x7d\x29\xe1\xbe\x94\x70\x5f\x4a\xb8\x2f\x25\xfc\xe0\x2c\xfa\x52\xc2\x2f\x41\x9f\x34\x36\x16\x8d\x2a\x9f\xed\x52\xa8\xc2\x47\x86\x54\x52\x5b\x27\xf9\x74\x0a\x1a\x39\x17\xbe\x79\x2d\x0a\xa1\x28\x68\x55\xf0\x32\x1f\x67\x80\x65\xf1\x34\xf0\xd8\x07\xbc\x6f\x79\xdc\xe7\xd2\x62\x85\xb2\x32\x52\xf3\xe2\xfd\x37\xdd\x54\xc5\x68\x17\xa3\x88\x73\x7e\x2f\xa3\xf6\xb1\x6a\x25\xc2\x37\x25\x60\x78\xbc\x47\x89\x32\x3e\xc2\x14\x91\x15\xcd\xb9\x94\x10\x94\x47\x61\xd1\x28\x33\x01\x90\x4c\x65\x20\x89\x7f\x73\x66\x84\x9c\x25\xc0\xb8\xb5\x3c\x9a\x8f\xdc\x9b\x64\x40\x76\x19\x0d\xea\x7f\x31\x56\x03\x4f\x43\x5c\x6c\xca\x05\x81\x62\x3c\xd2\xca\x18\x96\xe6\x89\x15\x59\x01\x8c\x19\xc0\x80\x76\x3a\xa8\x0a\x64\x60\x78\x49\x19\x42\x3a\x28\xdf\xe6\xa7\xa5\xaa\x45\x81\x50\x75\x1d\x60\x1d\xd4\x34\xb3\xcb\x22\x8e\x0e\xd8\x54\x68\x63\x59\x94\x08\x3c\xad\xf1\x8d\x94\x3b\x88\xf0\x06\xe1\xac\x96\x7e\xa6\xc6\x4f\x55\xc6\x28\xb6\x66\xd6\x50\x54\x5a\x09\xd0\x83\x8a\x85\xf1\x62\xbe\x19\x30\x1e\x4a\xde\x10\xa2\xc3\x4c\x11\xd5\xe1\x64\x21\xe8\xfe\xa7\x0a\xb8\x4a\x9d\xbc\x32\x6c\xaf\x24\x74\x0c\x31\x0e\xc4\x39\xa8\x45\x53\x97\x02\x05\x86\xbb\xac\x6d\x03\x5c\x00\x09\x0b\x47\x03\x10\x81\x3b\x5f\xf9\x16\xaa\xff\xe4\x44\x5f\x39\x14\xdf\x81\x31\x7c\x06\xe3\x86\x5e\x8b\x6d\x1a\x19\x3a\x2e\xca\x85\x41\x52\x48\x28\x3d\xad\xf8\xa5\x0c\x73\xaa\x8b\x41\x2c\xa5\x39\x15\xc2\xcf\x9d\x16\xd6\x02\x2e\x2a\x16\x47\x42\xc7\xe7\x6a\x02\xea\xe1\x4a\xb0\xd4\xbb\x00\xa4\x7c\xd8\x31\x75\x19\x53\xe8\xd2\x04\xd8\x44\x0b\x98\xb2\xa9\xc0\x78\x28\x8c\x50\x1a\x50\xb9\x0f\x4e\x16\x05\x63\x9c\xbe\xab\x64\x90\x65\xc3\xbc\x46\xec\x47\x3f\x31\xab\x73\x19\xf1\x4a\x11\x40\x4c\xd1\x12\x53\x36\xc3\x08\x27\x2f\x2d\xfe\xf1\xd5\x9f\xff\xc4\x26\x4b\x77\xa4\xa1\x64\x65\x95\xe5\x49\xf1\x91\x09\xc8\x99\xc3\x15\x6d\xcf\x7a\x92\x51\x81\x01\xac\x62\x4e\x13\x7f\xfd\xd5\xed\xa4\x7e\xc6\x9e\xc4\xb0\x38\xa9\xe0\x6f\x98\xa8\xd9\xa6\xba\xf0\xcd\x43\x26\x1b\xaa\x44\x1b\xc8\x4c\x25\x22\x5a\xb6\x26\xb4\x50\x77\x86\xcd\xd5\x1d\xc9\xfa\x1b\xa8\xa7\x8c\x81\xcc\x54\x96\x27\x64\xc1\xfe\xa6\x48\xcf\xcb\x0d\xac\xe7\xe0\x6c\xdc\x17\x68\x73\xf5\x20\x56\xeb\xc5\x52\x60\x5b\x78\xa5\xf2\xb1\xdd\xde\x2a\x58\x94\x9f\x41\x45\xe8\x1b\x9e\x24\x13\x1e\xdd\xde\xa8\xb7\x6a\x66\xde\xcb\x0b\xad\x95\xae\xcf\x25\xe1\x8e\x5b\xce\x73\x79\x4b\x95\xab\x8b\x14\x61\x35\x73\xa2\x55\x96\xdb\x10\x48\xbc\xe9\x83\x29\xe1\x34\x30\xe1\xa0\x06\x95\x50\xe0\x5e\x94\xba\x8e\x4f\x95\x20\x8a\xac\xc2\x37\x55\x62\xfb\xea\xd5\x1f\xff\x9d\x48\x97\x29\xcd\xfe\xfd\x15\x06\x3f\x9a\x01\x6d\x62\xe4\x6d\xee\xa0\x48\x79\x92\x38\xb5\xa1\x4a\x94\x0e\xd1\x9b\x88\xf0\x93\xd3\xa0\x6d\x4f\x6e\x3b\x8b\x52\x37\x37\x7f\x43\x39\x4a\x58\x03\xc9\x74\x40\x59\x01\x85\x5a\x73\x88\x07\xc3\xa1\xe7\x3e\x98\x9a\xf1\x0c\x04\xa0\x85\x4a\xf2\x14\xce\x61\x21\xba\x68\x5e\x51\x83\x16\x54\xfd\x44\x18\x4c\xc0\x98\x24\x2a\xba\x65\xb1\xbf\x58\x09\x63\x59\x2d\xa1\xda\x1c\x0b\x4d\x03\x7a\x5a\x04\xf2\x6c\xfd\xfe\x5a\x08\x4f\xca\xb3\xac\x88\xd1\xd7\xfc\xae\x86\x0c\xdc\x93\x98\xef\xdb\xb2\x9e\x42\x6b\x33\x73\x5b\x23\xf3\xd0\x7f\x91\xe3\x9b\x8d\x41\x34\x0e\x61\x69\x6f\xa3\x2e\x67\xdf\xdc\x30\x59\x23\x88\x12\x60\xd8\x0d\x19\xfe\x9b\xc2\xb3\xd7\xb2\x92\x8a\xc4\x96\x82\x30\x48\x00\x70\xe4\x83\x2c\xb9\xb9\xc1\xb5\x03\xeb\x66\xbb\xf8\xa5\x1a\x5e\x64\x61\x55\x4e\xb9\xf5\x02\x61\x30\x5f\x73\x96\x81\x36\xc2\xb8\x73\xf9\x07\xdc\x50\x67\x09\x17\x69\xc5\x04\xf8\x34\x48\xa0\xcd\x8d\x95\x2f\xdb\x73\xca\xb1\x8a\x3d\x40\x64\x85\x54\xf5\x73\x83\x58\x5b\x97\x6a\x3b\x3c\x50\x9f\x9a\x55\xfe\x50\x62\xb3\xce\x29\xdd\x2f\x05\xab\xa4\xbb\x5e\x12\x83\xc4\xef\xfb\x5c\xf9\x63\x31\xf9\x8e\xd8\x00\x32\x46\xbf\xb8\x75\x4e\x58\x53\x1e\x69\xa3\x54\x44\x7a\xaf\x07\x8e\x18\xb9\xd4\xdd\x9e\xf0\x8f\xb2\xc3\x37\x87\x4f\xca\x24\x09\x45\x5a\x65\x7c\xd6\xaa\x87\xc1\x0a\xa6\x56\xc1\x56\x13\xbd\x9d\x1a\x84\xd7\x8b\xb2\x43\x78\x17\xc4\x65\x21\x0a\x2c\x33\x42\xde\xd1\x80\x60\xaf\x20\x50\x3e\xe4\x1d\x5f\x32\xae\x55\x2e\x63\x6f\x5f\x2a\x0c\x7c\xef\x56\x5e\x7c\xa5\x24\x04\xc3\xf9\x6a\x9e\x38\x5a\xf4\x85\x64\xaf\x47\xaf\x5f\xbd\x94\x93\x0a\xbf\x70\xe5\xa4\xba\x2a\x4e\x2a\xe2\x4f\x4f\xfa\xad\xa1\xda\x71\x47\xdf\xfb\xce\x9b\x58\xca\x62\xc6\x22\x14\x6b\xc5\x9f\xee\xb4\xb0\x50\xe9\x6d\x74\x84\x8a\x8b\xd3\x0f\x2b\x59\xd1\xc7\x1d\xd6\xf0\xee\x26\x0d\xdd\xe4\x93\x8f\xc8\xb7\x3c\x83\xc2\xed\xb6\xc9\xc2\x65\x1e\x60\x61\x55\x44\x1d\x1c\xb0\x23\xba\xf3\x90\x32\x03\x8f\x9f\x94\xb4\x3c\xd2\x2e\xee\xb3\x16\x35\xe6\x6a\x88\xbb\xb8\xcf\x38\xda\xe0\xb2\x0e\x31\xf8\x1f\x30\xe7\x0b\xc0\x8c\x48\x91\x70\x9d\xa0\xcf\xf1\x9a\xe6\xce\x26\xb9\x65\x20\x17\x42\x2b\x89\x01\x3e\x0b\xae\x05\x56\xa5\xd0\x80\x99\xd5\x4e\x17\xfd\xe2\xe8\x87\xd3\x0f\x18\xd0\x70\xec\x53\xc2\xfd\x2c\x73\x13\xca\x47\x54\x67\x52\x01\xf7\xe8\xf2\x85\x79\x38\x1c\x22\xcf\x0d\xf3\x72\xef\x49\x73\x9b\x53\x41\xfc\xfb\x28\xc9\x8d\x58\x3c\x15\x27\xf1\xa9\xaa\xe7\xa2\xd1\x3a\xaf\xa4\xcd\x96\x88\x5a\xcb\x80\x45\xd3\x3a\x1e\x2d\x8f\x54\x60\x3d\x34\x45\xcd\xaa\xaa\x0f\xdc\x9b\x9e\x7c\x2e\x3b\xc5\xe2\x85\x92\x65\x6b\x22\x04\xd6\x6d\x78\x5a\x23\x54\x2c\xcd\x19\xce\x70\x3f\xb4\xd6\xa3\x9b\x6b\x49\x81\xe7\x57\xd7\xd5\x22\x00\xa4\x2e\xa9\x78\xc4\xc6\xe5\x8f\x65\xa5\x08\xac\x5f\x54\x28\x91\xa0\x67\x65\x4d\xdc\x19\x48\xd0\x28\x24\x38\x90\xb5\x76\x72\x6c\xc2\x0d\x39\x79\xce\xaf\xae\xc9\x66\xbb\x1f\xce\x1a\x8b\xd9\xcd\x25\x54\x77\xe2\x53\x4e\x44\x03\xe1\xb6\xde\xad\xa6\x30\x58\x39\xc4\xa0\x52\x4a\x80\xd9\xe5\x98\xf1\x38\xd6\xe8\xf6\xf1\xa2\x4f\xa5\xd4\x5b\xe1\x5b\xc0\xaa\x0c\xdc\x40\x75\x4e\x15\x74\x23\x8b\x2b\x11\xcb\xce\xf3\x2c\x11\xe4\x46\xa8\x3e\x50\x56\x93\xc0\xf6\x2a\xfb\x13\x6d\x1b\x35\xaf\xb1\x92\xd7\x82\x0b\xa9\xa6\x55\xdd\x1e\x58\x3d\x0d\x46\x25\x8b\xb2\xa0\xe6\xca\xaa\xf9\x1d\x81\x26\xf1\x62\xd5\x42\x11\xb7\x9d\x56\x0c\xa4\xd5\x6e\x6b\xae\xae\x16\x76\xef\x4d\x72\xdc\x4d\x05\x40\xb1\x00\xf4\x8f\xfb\xfa\x75\xbe\x8c\x52\x59\xe2\x93\x7c\xc3\x54\x65\x15\xb8\x0e\x1c\x0d\x67\xd5\x70\x27\xb2\xa7\x22\x84\x55\x63\xc7\xf9\xd5\x35\x71\x42\xfa\xf8\xa2\x2b\xdf\xa6\x55\x2a\xb9\x5a\x63\x0a\x7c\xb2\x2a\x1f\x6d\x34\x8f\x95\xb6\x4a\xbe\x4d\x69\xab\x40\x96\x16\xe2\x5f\xab\xcc\xbd\x16\x6f\x37\xc0\x75\x34\x6f\x82\xff\x07\x18\x01\x01\x65\xb1\xa2\x48\x80\xa9\xd2\xa8\x12\x0f\x91\xbd\x27\x4a\xdd\xe6\xd9\x2e\x1c\xdd\x83\xa1\x5e\x39\x3b\x31\x88\xda\x13\xbf\x29\x9e\x1e\x4b\xd3\xc4\xdf\x5b\x97\x7d\xc0\x92\xc4\x83\x80\xca\x6c\x0c\xb5\xaa\x37\x9d\x25\xb9\xb1\xa0\xbf\x11\xda\xd8\x83\x50\x70\x15\x29\x98\x6c\x22\x87\xd5\x1b\x7e\x14\x76\xee\x4b\xa7\x1d\x0e\xea\x97\xdc\xdf\x1e\xf0\xa1\xd3\x69\x0f\xaf\x94\x84\xc3\xd1\xaa\xd8\x55\xb0\xf2\x82\xad\x6d\x3d\x53\xfc\xd4\x0d\x24\x14\x2f\x8a\x17\x2a\xb4\x72\xe3\xcb\xc6\xb9\x37\x04\xfe\x67\xc0\x32\x8e\x25\x9a\xf0\xee\x79\x59\xe6\x8d\x0a\xb0\x50\x9d\x3a\xe5\x05\xbd\x65\x15\x45\x95\x9a\x2c\x56\x6d\xff\xec\x26\xf2\xdc\xde\x14\x40\xe5\xff\xae\x41\x2f\x44\x04\x6f\x85\xbc\xdd\x93\xfc\xea\xd1\x25\x17\x6b\xd0\x6a\x05\x79\xc9\x47\x2b\x24\x05\xdf\xb9\x23\x86\x4f\x54\x6e\x51\x76\x43\x87\x63\xa9\x38\x0a\xf9\x5f\xb4\x16\x68\x6f\xcf\xa8\x62\xd6\x26\x1d\xd1\x0c\xc8\xe8\x13\x94\x40\xb3\x94\x96\x63\x6d\xbf\x73\x15\xdd\x82\x66\x89\x9b\xc6\x88\x95\x81\x2f\xb5\x6a\x72\x3a\x87\x3d\xa3\x2e\x9a\x5a\x3a\x20\x9b\x43\x0a\x9a\x27\x65\x51\xc5\x16\xa8\x7e\xeb\x19\x67\x01\xb5\x1a\x93\x42\xd5\x85\x7c\x19\x34\xb7\x0f\x2f\x36\xdd\x95\xf2\x65\xa8\x34\x29\x24\x86\x1b\xdc\x0b\x83\x66\xfd\x4c\xc5\xd5\x2c\xb6\xdc\x80\x1e\x16\x39\x86\x3e\x8f\xc7\x14\x81\x38\x31\x4c\xf2\xd9\x4c\xc8\x99\xe7\xce\xc8\xd3\xcb\x5a\x63\xa5\xa6\x83\x91\xde\x91\x06\x2a\xf8\x88\xd2\x03\xc5\x97\x89\xea\xfd\xa9\x8a\xe9\xf6\xc9\x92\xb4\xc1\xb0\xb2\x65\x80\xf4\xa5\x64\x4a\xfb\x3a\x0b\x3c\x8e\x71\xee\xeb\x5f\xe8\x5b\x7a\x57\xbf\x6a\x50\xc4\x71\x50\x64\x77\xf1\x54\x05\x2d\x26\x9f\x84\x1e\xdd\x8f\xd5\xd8\x14\x86\x0a\x7c\x55\xca\x6b\x06\xd5\x60\xb5\xb6\xe6\xc5\xfa\xea\x87\xf8\xe8\x26\xfb\x7c\xff\xe3\xa5\xd1\xd1\x52\x3f\xad\xe5\x86\xaf\xa0\x30\xf1\x15\xef\xa8\xa7\x2c\x8e\x3d\x45\x2d\xa4\x99\xd2\x5c\x0b\x62\x77\xab\x74\xe6\xce\x8b\x0d\x04\xb6\xa0\x5e\x8d\x1b\x68\x6c\x23\x2d\x23\xb7\x95\xaa\x68\x21\xef\xce\x05\x13\xcd\x21\xce\x31\x4a\x7d\x96\x73\x6c\x05\xeb\xb8\x85\x37\xaa\x2f\x7d\xf8\x1f\x11\x5d\x11\x58\x58\xa4\x23\x2c\x31\x18\x07\xab\xed\xb9\x5f\x90\x5a\x29\x04\x91\x5a\x69\x62\x5f\xbe\x6a\x34\xe2\x16\x22\x8c\x38\xb6\xf9\xe3\x61\x53\xc1\x7d\x04\x8e\xad\x59\x53\x4e\xd6\xc7\xa7\x60\x2d\xd1\x40\xe9\x1e\x87\xb0\x10\x11\xbe\x61\xeb\x16\xf6\x5f\x40\xc8\x9e\x2c\xcb\xd6\xc6\x5b\x36\xcf\x8d\xfb\xb6\x22\x59\x08\x9f\x0a\x29\x02\x3b\x6d\x8a\x55\x0c\xd6\x15\xa1\xf0\x1e\xa6\x64\xe4\xc1\x57\xf2\x09\x24\x6d\xa1\x22\x33\xc0\x77\x21\x0c\xd1\x25\x0f\x6c\x12\xaa\x3b\xbb\x27\xf9\xb6\xd0\x33\x9a\x3b\x11\x1b\x39\xff\xda\xa8\x34\x5c\xcf\xda\xab\x7f\x87\xa7\x7a\x96\xa7\x54\x16\x58\xad\x54\x66\xf5\xfd\x2c\xe9\x38\x45\x8b\x9d\x3b\x8c\xcf\xde\x9d\x57\x93\x33\xaa\x51\xe7\x21\xb5\xc5\x09\x79\x2d\x2d\xb9\xab\xa6\x5c\xb7\xd1\x4a\xfb\x70\x79\x6a\x78\xfd\xd4\xdb\x2a\x8b\xb7\x05\xb2\x14\x32\x73\x72\x06\x4a\x47\xa5\xb5\x52\x46\x73\x2e\x67\x68\xe0\x57\xb9\x83\xf7\xc5\x17\x38\x23\x0d\x71\x1e\xf9\x92\xf2\x21\xb2\xfb\x8b\x60\xd7\xf4\x95\x8e\xb0\xaf\x94\x89\x78\x16\xe6\x5c\xfd\x2c\x12\x42\xde\x30\x31\x82\x11\x3b\xf8\xa2\x72\xe9\x80\xde\x9e\x69\xe5\x5e\xe1\x83\xc2\x71\x56\x89\xb0\xb8\xbd\x0f\xaa\x77\x8f\xd8\x85\x7b\x07\xfa\x7a\x0a\x04\x56\xe2\x96\x27\x25\xfa\x06\x4c\xc3\x8c\xeb\x38\xc1\x5c\xc2\x69\x21\x6e\x51\xc6\x91\x47\x18\xb2\x5e\x8c\x14\x94\xca\x6e\xb2\xbb\xee\xd5\xef\x9e\x84\xb4\x61\xcc\x2d\x1f\x62\x19\x7e\x62\x62\x27\x64\x38\x18\xfa\x42\x88\x43\xee\x49\xab\xd2\x11\xff\xf7\x3e\x67\x6c\xc8\x8b\xbb\x84\x1c\xf2\x21\x96\x24\x6c\x1e\x05\xfb\x04\x01\x13\xad\x74\xf8\x16\xf5\x30\x57\x05\xef\xa2\x8c\x32\xe2\x60\xc4\xae\x94\x2d\xcb\xe6\x16\xb1\x19\xbe\xe4\xe3\xa6\xfd\x7c\x71\x75\xf3\xe1\x6f\xe3\xf7\x97\x57\x37\xfd\xb6\xee\xb7\x75\xbf\xad\x5b\x6c\x6b\x90\x8b\xd6\x5b\xba\x50\xf0\x36\xe9\xbc\x2b\xa5\xf8\x2a\x29\xe3\x2f\x28\xfa\xec\x42\x2e\x7e\xe0\xba\xec\xe5\x8e\xf2\xe3\x46\x37\x71\x68\xf6\x8e\x2c\xee\xec\xb3\x0f\x3f\x7b\xc2\xe0\xb1\x0e\x83\x72\xae\x2a\x95\x0e\x36\xad\x5a\xb5\x01\xd6\xd9\x2f\x97\xe7\x17\x57\x37\x97\xdf\x5c\x5e\x7c\x78\xd2\x68\x8a\x96\xa5\xf0\xea\x87\x72\xc3\x53\x32\xd3\xb0\x10\x2a\x37\xc9\xb2\x68\x34\xb5\x99\x09\xac\x07\xe4\xc9\x98\x71\xb9\x0c\xf6\xb4\xcd\x8f\xf5\x87\x6d\xb7\x87\x6d\x3d\xb8\xa4\x45\xe1\x92\xae\xc8\xf7\x1b\xad\x1a\x37\xd2\x5f\xb5\xed\x93\x7d\x22\xd8\xf4\x37\xd1\xd3\xa1\xaf\x71\x50\x3b\x7a\xbc\xf0\x58\x16\x54\x70\xc2\x68\x9a\xd9\x16\x65\xc2\x3b\x29\x7e\xda\x4d\x9d\x50\x0a\xc4\x78\xc7\xb3\xef\x60\xf9\x01\x5a\xd6\x47\x59\xf1\xa5\x24\x10\xb9\x83\x8e\xdd\xc2\x92\x5c\xac\x67\xe1\x65\x6d\xea\xb8\x3c\xcb\xda\xb1\xb7\xd0\xa6\xae\x6f\x97\x45\x5f\x6f\xa1\x45\x64\x66\x18\x6b\xe5\x4f\xdd\x12\xa2\x9c\xe6\xd6\xb4\xdd\xea\xb1\x6e\x0b\xbe\x7e\x84\x22\xb7\x87\xd5\xe3\xde\xf3\x59\xbb\x77\xf9\x08\xb5\x70\x27\x17\xdc\x9d\xf8\xa8\xb4\xa1\x53\x5c\x87\x44\xb5\xe6\x04\x43\x6f\x4e\x7e\x8f\xff\xf1\x45\xc1\x4e\xe3\xd8\x47\x47\xe7\x06\xa6\x79\x42\xb6\x7a\x33\x62\x3c\x13\x3f\x80\x36\x68\x52\xbd\x15\x32\x1e\xb0\x5c\xc4\x5f\xb7\xa9\x2a\x45\xa3\xc3\x55\x50\xc1\x23\xd5\xed\x4a\x5c\x7b\x87\x63\xf5\xec\x2a\x98\x08\xa3\xd4\x47\xa4\xcd\x60\x03\xf6\x42\x46\x47\xa8\x69\xdb\x89\x8a\xd1\x12\x76\xcb\x57\x0f\x4b\xc6\x4a\xce\x9c\xa2\x02\x57\xfc\x26\x14\x9a\x33\x45\xfb\xa8\x91\xa3\xb0\x41\xfd\x4f\x93\xf1\x08\x06\xec\x1f\xc5\x8f\xd8\x70\xd9\xfc\x74\x78\xf8\x97\xef\x2e\xfe\xf6\xbf\x0e\x0f\x7f\xfe\x47\xf5\x2a\x1e\x85\xa8\x35\xaf\xdc\x82\x36\x78\xa9\x62\xb8\xc2\x77\xe0\x9f\x5e\x5c\x3b\x8d\x22\x95\x4b\xeb\x2f\x60\xda\xf2\x68\xae\x8c\xbd\x1c\x17\x7f\x66\x2a\x5e\xfd\xcb\xb4\x2a\x95\xf6\x2c\x0f\x06\x5c\xa2\x16\xe9\x37\x34\xba\x3b\x1e\x4a\x5e\xd2\xf1\x56\xf5\x50\x03\x35\x62\xc9\x5d\xf2\xc4\x7c\x13\x50\x80\x2d\x2e\x43\x7d\x04\x89\x49\xe5\x4e\x32\xad\xd7\xcd\x3b\x58\xbc\x6e\xd5\xcc\x97\x46\x87\xac\xad\x58\xc1\x8e\x11\x86\x18\x09\xad\x94\x70\x23\x17\x07\x6c\xd0\x52\x4a\x77\xf3\xe9\xf8\x92\x2d\x08\xc3\xcf\x06\x39\xc1\x89\xf6\xcd\x47\xe5\x71\xa5\x17\x74\x25\x79\xf5\x0d\xf9\xab\xc3\x75\x5f\x48\xc0\x14\xb5\xbd\xc0\x29\x36\x47\xf4\xe3\x28\xca\xf2\x81\xbf\x61\x94\x42\xaa\xf4\xb2\xf8\xb3\x70\x11\x0e\x8d\x55\x9a\xcf\x30\xf1\x84\x1e\xa7\xc7\x8a\xbf\xe8\xc1\xda\x0b\xd6\x9f\x26\x55\x38\xca\xb5\x13\x1a\x92\x65\x59\xfa\xf3\xe5\xf1\xb6\x80\xfa\x67\xc2\xda\x0a\xca\x68\xdb\xf7\x91\x46\x9d\x20\xcb\x20\x01\x14\x38\x0b\x2c\xa2\x3e\xe9\x13\x6b\x07\x85\x18\x44\xd6\x00\xb9\x70\x9a\x65\xe3\xd2\x60\xe5\xe8\x90\x9b\xc5\x62\x21\x8c\x6a\x91\x5e\x53\x00\xda\x9e\x33\xe0\x6b\x7b\x50\x64\x54\x61\x36\xbb\xcf\xb0\x1a\x52\xb1\x5f\x57\xd8\xfe\xeb\x36\x9d\xbe\x69\x64\xdc\x5a\xd0\xf2\x0d\xfb\xcf\xa3\xbf\x7f\xf9\xeb\xf0\xf8\xeb\xa3\xa3\x9f\x5e\x0d\xff\xfc\xf3\x97\x47\x7f\x1f\xe1\x3f\xfe\xf5\xf8\xeb\xe3\x5f\xc3\x1f\x5f\x1e\x1f\x1f\x1d\xfd\xf4\xdd\xbb\x6f\x6f\xc6\x17\x3f\x8b\xe3\x5f\x7f\x92\x79\x7a\x4b\x7f\xfd\x7a\xf4\x13\x5c\xfc\xbc\x23\x90\xe3\xe3\xaf\xbf\x68\x3d\xf5\x0e\x8a\x93\xd2\xe8\xb2\x44\x69\x1d\x62\x27\xe4\xf7\x11\x8b\xfc\xd3\x08\xe4\xd5\xf5\xfe\x0f\xe1\xd1\x6f\xca\x03\xa9\x38\xae\x9f\xcd\x06\x37\x10\x69\xb0\x9f\xc2\x92\x43\x6f\xaa\x04\xca\x1c\x1a\x56\xa8\x16\x2f\xed\x9c\xfb\x2d\x18\x77\x82\xd8\x4e\xeb\x5a\x4a\xa2\x53\xad\xd2\x90\xf6\x8e\xee\x0d\x6c\x73\x1d\xee\xbb\x85\x56\xcd\x12\x69\xf4\xc6\xa0\xde\x18\xb4\x65\x3c\x6a\x0c\xba\x26\x3a\x7c\xb6\x96\x20\x90\x8b\xa6\x2e\x8c\x8d\x1e\xf4\xa0\xeb\x54\x6b\xc4\xed\xe6\x50\x1b\x85\xad\x6e\x0a\x4f\x5c\x19\x49\x43\x07\x5a\xba\xd9\x87\x89\x5d\xec\x85\xa4\x8d\x8f\x00\xca\xbc\x12\xdf\xd5\x80\x4a\x18\xc2\xc2\x4d\xa1\xa8\x81\x5d\xab\x76\x89\xd1\xa5\x18\xf3\xfa\x23\x85\xa0\xde\x52\x54\xaa\x53\xd2\x84\x2c\xeb\x84\x16\xc2\x61\x59\x5c\x9a\x1b\xa3\x22\x8a\xa6\x2d\xb2\x1c\xb0\x74\x9d\x9f\x36\xce\x06\xfb\x4c\x57\xfa\x91\x53\xe1\xe9\xf2\x5b\x27\x4b\xac\x87\x29\x17\xa1\xf8\x76\x1c\x72\x66\x70\x26\x9b\x61\xbc\xac\x00\x04\x47\x88\xde\x09\x56\x89\x43\x40\xae\x5f\x68\xd8\x1c\x43\x31\xd4\xb4\xb4\xb2\x36\x6b\xf3\xd7\xfa\x14\x6f\x7f\x66\x16\x9e\xad\x56\xc2\xd0\xda\x61\x59\x9a\x9f\xeb\x87\xe4\x4b\x70\x06\xb6\x3f\x3e\x7f\x73\x47\x67\x47\xc7\x66\x37\x47\xe6\x1e\xbe\x93\x2e\x8f\xc9\x2e\x9c\x25\x99\x86\xa9\xb8\xef\x68\x9f\x9e\xca\xd2\x12\x23\x62\x90\x56\x4c\x05\xe5\xd0\x64\x1a\x32\x90\x94\xbc\xc0\xa3\x39\xf2\x7e\x7f\x52\x96\xce\xe9\xe7\x18\xcc\x43\x02\x77\xb7\xac\xec\x7a\x93\xb0\xdf\xf3\x31\xd6\xf3\xb1\xc6\xe3\x13\xf1\x31\x4f\xb9\xcf\x87\x89\x61\xe4\x79\xfb\xd0\xf7\xf3\x4a\x1c\x3b\x52\xf1\xde\x54\x56\xe6\x39\x9d\x20\x94\x46\xd6\xe7\x56\xc4\x80\xaf\x1d\xe7\x49\xd2\x51\xf5\xed\xc3\x4b\xc4\x46\x96\x27\x89\x4f\x3a\x1e\xb1\xf7\x12\xf7\xe3\x29\x76\x79\x18\xb0\x2b\x58\x80\x1e\xb0\xcb\xe9\x95\xb2\x63\x12\x6c\xeb\xb1\x6c\x74\x23\x13\x53\xf6\xc6\xa9\x4c\xc6\x32\x4b\x95\xf6\x2b\x75\x81\x94\xae\x01\x28\x4b\x8e\xb5\x88\x41\xdf\xbe\x2c\xbf\x0f\x19\x6d\xc3\x27\x5a\xa6\xa2\x95\x49\x07\xba\x69\x68\x59\xe7\xa3\xe3\x30\x22\xd2\xbb\x46\x36\xe5\xf4\x7e\x86\x65\x36\x32\x65\xec\xb5\x53\x61\xbb\x69\x73\x33\x0e\xe0\xb0\x73\x04\x4f\x12\x88\x6b\x7d\x8e\xa8\x3f\x07\xaf\xab\xd0\x98\x6d\x5c\xb4\x8b\x00\x36\xe7\x32\x4e\x40\x63\xc9\x77\xb3\x5a\xd7\x4a\x94\x3d\x0e\x8a\xae\x14\x21\x2d\x94\x47\x91\xd2\xb1\x6f\x56\xeb\x93\x37\x71\x32\xc5\xf6\x42\x46\x9b\x72\xc9\x67\xd4\xa5\x70\xad\x70\x30\x96\x93\x36\x95\xd6\x16\x73\xa5\x6e\x59\xa4\xd2\x2c\xc1\x0d\xd0\x62\x7f\x94\x9d\x75\x0a\x12\x1d\x62\x37\xc5\x93\x4a\xd3\x1d\xfc\xe1\x09\xbb\x23\x76\x21\xa7\xc0\x3d\x44\x9d\x75\xe5\x73\x1c\xd1\xad\x32\xfa\xc4\x95\x2c\xc4\x95\xa9\x72\x9b\xd1\xad\x75\x59\x8f\xa0\x60\x7a\x23\x76\x71\x0f\x51\xa5\xb3\xa5\x7b\xc2\xb7\xb6\xb4\x0a\xed\x21\xed\x3b\x16\xb7\x36\xe5\x77\x65\x3e\x6f\x91\xa0\x56\x1d\x2b\xd5\xe7\x10\x66\x28\xb6\xed\x5f\x81\x7d\x0b\x28\x41\x1a\x93\xd6\x42\xfd\xed\xda\x1e\xa2\x1d\xbb\x56\xb2\xae\x08\x50\x0e\xb0\x30\x51\x5b\x29\xcb\x8e\x0e\x4f\x0e\x8f\xd7\xec\x7a\x2b\x15\x9b\x6f\x2a\x4f\x0a\x2c\x51\x98\x61\xbd\x3f\x88\x0e\xe3\x01\x13\x36\xf0\x68\xaa\x94\x80\xb3\xf2\x99\x74\x03\x66\x14\xb3\x9a\xc7\xc2\x6b\x4e\xf8\xab\xbb\xc9\xea\xdc\x97\x49\x38\x3a\xfc\xf5\x70\xc0\xc0\x46\xc7\xec\x4e\xc9\x43\x8b\xd3\xc7\x9a\x22\xb9\xa9\x00\x5a\xaa\x1c\x5b\xf7\x11\x0a\x8a\x02\x21\x8e\xd1\x31\x95\x53\x9f\x9f\x39\xb7\x21\x83\xef\xe2\x5e\xd8\xd0\xdb\x42\x4d\xd9\x2b\x6a\x33\x04\xdc\x5b\x16\x13\xb1\x80\x93\x39\xf0\xc4\xce\x29\xf8\x42\x2a\x39\xa4\x4e\x71\x8e\x03\xf9\x2b\x6d\xfd\x10\xed\xcc\x74\xd5\xd1\xc2\x64\xb7\x3e\xa1\x96\x12\xb9\xe3\xbd\xdf\x36\xef\x85\xcb\xd6\xda\x44\xdf\xdc\x8c\xbf\xad\x35\xc3\x45\xe6\x6f\x6d\x16\x42\x62\x2a\xc5\x36\x9e\x01\xef\xe8\xc6\x09\xd8\xaa\x93\x2d\xeb\x90\x85\xb5\xed\x68\xcb\xd6\xdb\x7e\xef\xd7\xca\x96\xfd\x4d\xe5\xd8\x82\x8f\x4f\x92\x25\xbb\xe3\xd2\x86\xf4\xbd\x03\x07\xea\xc0\xb1\x27\x47\x0d\x7f\x05\x1e\x83\x36\xc8\x3d\x80\x37\x2e\x2a\x16\x46\x67\xce\xa9\xca\xdc\xba\x3d\x07\x72\x63\x55\xca\xe6\xfe\xb3\xeb\x29\x8d\x7e\x67\x8c\x70\xf7\x84\x7c\x21\x0d\x19\x71\x38\xff\xcc\x8b\xe3\x5f\x6b\x7c\x83\xf0\x5e\x2b\xbe\x1f\x55\xd1\x56\x6d\xd1\x22\x24\x21\x8b\x9a\x2b\x76\xc4\x4b\x3b\x08\x2a\x60\x1d\x06\x16\xb0\x76\x09\x92\xab\x80\xd0\x59\xd6\x3e\x86\xaa\xb3\x58\x05\xd6\x99\x3f\x9e\x6d\x32\x5e\x7a\x9a\xa1\xc8\xd9\x8e\x90\xd8\xa9\x17\x9c\xb5\x4f\xc1\xac\x8e\x87\x11\xd0\xcd\xe2\xb3\x2e\x31\x90\x75\x10\x32\xbd\x1e\x30\xbd\xd6\x62\x1c\xd9\x04\x55\xaa\x7a\x36\xa7\x4c\xdb\x7e\xeb\x6c\x73\xfe\xb1\x66\xb2\x68\x8f\x6b\x3f\x8b\x9e\xeb\xac\xbb\xd0\xc6\xae\x03\x1b\x3b\x0d\x6b\xfc\xa8\x41\x8d\x98\x4a\xd1\x9a\x8b\xd4\x6d\xea\x08\xd2\x51\x80\xd3\xdb\x9c\xc6\xe9\x65\x3f\x6f\x14\x0a\x2d\x3c\xea\x56\x54\xb7\xd5\x9e\xc5\x1e\xb3\x51\x76\xad\xa2\xdb\x0e\xf5\x9a\xc3\x9b\xb3\x31\x81\xac\xa8\x36\x5c\x06\x63\x88\x90\x0b\x95\x2c\xa8\xd2\xdf\xcd\xd9\x18\x77\xde\x08\xff\x85\x86\x28\xd4\xa8\x97\xee\xd9\x10\xec\xef\x5d\x38\x4e\xfb\x26\x0b\x1a\x67\x1a\x78\x22\x8c\x15\x11\x3e\x57\xd8\xb6\x10\x42\x1b\xdf\x4d\xaf\x29\x6d\x1a\x9d\x6b\x4a\x95\x6e\xb3\xfb\x2a\x4d\x6d\x83\xf3\x9e\xf1\xb9\xe4\xcf\x23\x5d\x34\x53\xeb\xcf\xa5\x8e\xe0\x3d\xdf\x73\x29\xd3\x70\x6d\x55\xd6\x91\x27\x84\x80\x6d\xf1\x83\x4c\x60\xaa\x34\xac\x3a\x42\x2a\x8e\x8d\x38\x07\x5f\x88\xf3\x74\x7c\x59\x98\xa0\x54\xcd\x79\x41\x61\x89\xa1\xfa\x66\x22\x16\x20\xc1\x98\x13\x74\x79\xe4\x19\xa9\x98\xa1\x6f\xee\xc0\x7d\x1d\xa4\x19\xd5\xaf\x2c\x42\xfd\x7d\xd7\x5e\xfc\x11\x2c\x15\x9e\x2c\xfc\x2f\xde\xa2\x1a\xa6\xbf\xea\x2a\x89\x34\x37\x73\xea\x68\x0b\xf7\xc2\xfa\xae\xcc\x1a\xb8\x51\x92\x8c\xbd\x95\xe6\xba\xc2\xb0\x8c\x1b\x53\x96\x01\xf7\x2f\xa1\x87\xc6\x54\x3a\xb8\xfa\xc0\x4c\xf3\x08\x58\x06\x5a\xa8\x98\x61\xce\x6d\xac\xee\x24\x9b\xc0\x4c\x48\x13\xf0\xe7\x00\x05\x44\xbb\xe3\x06\xd0\x36\x1c\x2a\xaa\x8d\xd8\x87\x5a\xa1\x10\x9f\xc2\x13\xa9\x72\x47\xfb\x59\xac\x3a\x99\x30\x6a\x12\xd1\x4b\x6d\x65\x8a\x85\xa9\x76\xda\x79\x64\xd2\x1d\x78\x9b\xa8\x19\x54\xb8\xb6\x15\x3b\x58\xf0\x94\x47\xf3\x76\xee\xdb\xde\x3d\xb5\xe3\xe8\xdd\x53\xfb\x8d\xde\x3d\xd5\xbb\xa7\xb6\x8f\x67\x67\xde\xed\xdd\x53\xbd\xd2\xb5\x3a\x7a\xf7\x54\xef\x9e\xda\x32\x9e\x1d\xff\xea\xdd\x53\x3b\x8c\xde\x3d\xb5\xe3\xe8\xdd\x53\xbd\x7b\xaa\x77\x4f\xf5\xee\xa9\xdf\x90\x19\x30\x8c\xde\x3d\xb5\x06\xa4\x77\x4f\x55\x90\xd1\x6b\x4a\x1b\x46\xef\x9e\xda\x30\x7a\xf7\x54\x65\xf4\xe7\x52\x83\x73\x29\x38\x77\xc6\x4e\x2f\x6b\xdf\x46\x18\xb5\x3b\xac\xe7\xf7\x42\xd3\x9a\xda\xd8\xf8\x9f\xb5\x7d\xff\x99\xf8\x50\x3a\xb0\xe9\xf7\xf6\xfc\x17\x67\xcf\xef\xc6\x16\xd6\x81\x1d\xac\x35\x2b\xf7\x5e\xf3\x9b\xb9\x06\x33\x57\x49\x63\x42\xaf\x11\xf9\x3b\x21\x45\x9a\xa7\x8e\xe6\x8c\xa3\x67\xb1\x28\xdc\xf3\xa6\x6c\xc9\x8c\x5e\x7b\x32\xc9\xb9\x1b\x45\x0c\x58\x8d\x93\x8b\xc4\x2d\x23\xe6\x4f\xce\x39\xca\xc4\x26\x8f\x22\x00\xec\xf5\x55\x55\x17\xfe\x30\x2a\xde\x54\xf4\x76\x78\xdd\x8e\xdf\xb4\x3b\x64\xa9\x5e\x26\x42\xf9\xc3\x57\x8d\x60\xb4\x74\xa7\x7c\x7a\x57\x4a\x07\x6c\xba\xbd\x62\xd0\x4a\x29\xe8\xe2\x94\x68\xab\x0c\x7c\x6e\x2e\x93\xce\x5c\x87\x1d\xb8\x4a\x9e\x91\x9b\xe4\xd9\x1c\x0b\xcf\xc5\x35\xf2\x0c\x4b\x81\x76\x60\xc9\xef\xc2\x15\xd2\x9d\x1b\xe4\x23\x54\xcc\xfc\x38\xee\x8f\x0e\xd5\xce\x8e\xdc\x1e\x9f\xc2\xe5\xd1\xc9\x57\xb7\x75\x75\x7c\x3a\x37\x47\x37\x9f\xdb\xd2\x8c\xf4\x22\x5c\x1b\x1d\x98\x8f\xba\x34\x1d\x75\x66\x36\xfa\x68\xae\x8c\xf6\x6e\x8c\x67\xe0\xc2\x68\x8d\x64\x21\x85\x15\x3c\x39\x87\x84\x2f\xaf\x21\x52\x32\x6e\x7c\xc2\xac\x94\x50\x2b\xf6\x8f\x21\xb0\x5e\x47\xab\x07\xfa\xce\xb9\xaf\x14\x0b\x71\x88\x5d\x0e\x26\x3d\x2f\x50\xa0\x35\x8e\x66\xd9\xa4\x0e\xd3\x9d\xd2\xb7\x89\xe2\xb1\x39\xc9\x14\xfd\x5f\x19\xc6\x5b\x89\xdf\xa5\x77\xb5\x0b\xe0\x7d\x6a\x65\x90\xa2\x9e\xbb\x5c\xc4\xbf\xaa\x3b\xa6\xa6\x16\x24\x3b\x12\x32\xac\xe3\x71\x45\x0d\x2c\x35\xf3\x82\xac\xdd\xd5\xd7\xaf\xc2\xcd\x2f\x4f\xe5\x46\xe3\x82\x31\x1f\xdf\x02\xe2\x5f\xf4\xb8\x09\xc4\xdf\x38\xcd\x93\xba\x19\x84\x4c\x23\x75\x7e\xf3\xba\xac\x75\xf9\x1a\xe1\x16\xbb\x8d\xcb\x98\xf9\x0c\x89\x97\xb7\x68\xad\x1d\xb4\x2f\xc1\x39\xdb\xdb\x5e\x58\xd7\xb6\x97\x27\x72\xc2\x3e\x43\xa9\xf9\x33\x75\xbc\xf6\x52\xf3\x1e\xa3\x92\x68\xf5\xad\xe6\x11\x8c\x3b\x17\x38\xc2\x76\x62\x71\xae\x7d\x7e\x5c\x21\x77\x14\x9b\x47\x02\xc4\xb4\x9b\x8a\xec\x33\x4c\xfb\x9a\xe6\x49\xb2\x64\x79\xa6\x64\x3d\xc5\x8f\x9c\x56\xab\x99\x61\x0e\xda\xa6\xb7\x94\x52\x6a\xa6\x95\x3f\x80\x75\x2e\xa5\xe3\xe7\x65\xf7\x1b\x94\x4a\x0d\xf1\xea\x6a\xfe\x99\x11\x33\x37\x7d\x77\x98\x62\x6a\x9a\x48\xa1\xec\x8f\x50\x02\x74\x4f\x4f\x95\x8e\xc4\x24\x59\xb2\x39\x4f\x8a\x56\x07\x9c\xdd\x8a\x24\xf1\x60\x46\xec\x1a\x2c\xb3\x73\xe1\xbb\x54\xb3\x44\xc9\x19\x4e\x8e\xcb\xd0\x62\x0b\x22\xf7\x6c\x94\x00\x97\x79\x46\xef\x73\xc7\xfa\x52\xe5\x3a\xbc\xcf\xd7\x8f\x2c\xa0\x08\xc3\xa4\x48\x06\x95\x46\x3e\x0f\x2e\x6c\xd9\x2d\xdd\x40\x48\xde\xbb\x13\x06\x06\x55\x98\x6a\x01\x5a\x8b\xd8\x3b\x0d\xe8\xb7\x4c\xab\x85\x88\xa9\x15\x43\x40\x1b\xb6\x0c\xa5\x56\x0d\xc5\x7e\x96\x4a\x0e\x25\xcc\x38\x4a\x3d\x7e\x17\xd1\x9a\x11\x1c\x72\xc5\xc9\x18\x9b\x37\x38\x75\x41\x65\xb5\x9c\xd1\x85\xa0\xb6\x93\x15\xcc\xb1\x23\xa9\x98\xc2\xe3\x35\x97\xc2\x52\x2b\xe3\x79\x6e\x59\xac\xee\xe4\xb1\x03\x2e\x8c\xc3\x03\x67\x13\xb0\xa1\xad\x6a\x68\xf3\x27\x34\x18\x06\x92\x4f\x12\xb7\xe6\x18\x13\x70\xb3\x11\x41\x6c\x0a\xdc\xe6\x1a\xd8\x8c\x5b\xd8\x28\x34\xd1\xf7\x3e\x8c\x5e\x61\x8a\x96\xe3\xb9\x34\xd0\xb8\xd9\x72\xc7\x92\xd6\x9f\xfe\xd8\x8c\x47\x88\x14\x54\x6e\x3f\x89\x2a\x49\xed\xf8\x2b\x92\xb1\x48\xc1\x30\x95\xaf\xe8\xd8\xaf\xfd\x63\x9b\x57\xa8\xd7\x27\x37\x8d\xa6\x56\xe2\x0d\xa6\x34\xdf\x0d\x70\x3d\x7e\xa6\xd2\xed\x94\xbb\xad\x78\x7e\x75\xfd\xcb\xdb\xd3\xff\xb8\x78\xeb\xf7\xa7\xac\x1e\xfa\xb9\x14\xff\xcc\x81\xf1\x54\x39\xb9\x3a\xa9\x86\xe1\x0c\xd0\x3c\x50\xf9\x01\x77\x72\xb7\x01\x3b\x0d\x0f\x64\x6c\xcd\xdc\x3e\x2c\x09\x1b\x3c\x7f\xfc\xa8\xa4\xa7\x6e\x59\x53\xb4\xdc\x74\x1f\x5c\x6d\x59\xc3\x99\x04\xeb\x76\x1e\x49\x94\xd4\xc2\x48\xc8\x59\x52\x15\x26\x9b\xb1\xab\xb6\x3a\x51\x5b\x8d\x68\x58\x7e\xc1\xb8\xa9\x62\xd4\x49\xeb\x9c\x72\x0e\x1d\x35\x9c\x28\xb9\x76\x50\x03\xa8\x23\x68\x50\x03\x48\xf4\xb8\x1c\x33\x1e\xc7\x1a\xc5\x14\xdc\xf5\xe9\x4a\xe3\x39\x7c\x98\x8c\xf1\x03\xf6\x8a\xfd\x85\xdd\xb3\xbf\xa0\x5a\xf0\xa7\xb6\xed\x39\xda\x0a\xec\xed\xcd\x12\xa4\x8d\x5e\x8e\x3b\xc2\xf8\x8f\x73\x6e\x11\xa2\xc3\xaa\x55\x6c\x22\xbc\x18\x0a\xf7\x16\xb4\x13\x8b\xfc\x4a\x3c\x69\x63\x13\x37\xc1\x4f\x48\x66\x64\x73\xbf\x9c\x56\x2b\xff\xdb\x3d\x09\xcd\x3d\xee\xf4\xfb\x2b\xcf\x85\xea\x7d\x04\x4a\x68\x29\xb7\xd1\xbc\xce\xc6\x9c\x80\x61\x6a\x87\x53\xac\x90\x8d\x53\xfc\xda\x5c\xb4\x88\x20\x78\x3e\x64\xdc\xce\xa9\x5c\x5b\xcf\x87\x56\x6a\x45\xf1\xc7\x73\xde\x0b\x06\x95\xfa\x23\x99\x8a\x47\xec\x82\x47\x73\x9c\x56\x5c\x39\x33\x9c\x06\x82\xc0\xe6\x7c\xe1\x16\xde\x3f\x4b\x7d\x37\x50\x5a\x29\x4c\xad\x48\x4b\x6e\x3f\x45\x5c\x52\xe7\xbb\x29\x68\x4d\x21\x87\x93\x25\x7a\x3e\x45\x04\xad\x17\xaf\xd5\x4e\xca\xb4\xb2\x2a\x52\x2d\x7a\xaf\xac\x46\x3f\x23\x38\x44\x02\x19\x2d\x83\xad\xf8\xfb\xf3\xf1\x80\xdd\x9c\x8d\xb1\x69\xc6\xf5\xd9\xcd\xb8\x2e\x61\x1f\xdc\x9c\x8d\x5b\xf4\xf0\xef\xc4\xe6\xe1\xcd\x6c\x6f\xdc\x34\xf7\x06\xa2\x81\xc7\xa2\x8f\x23\xdf\x7d\xf4\x71\xe4\xdb\x47\x1f\x47\xde\xc7\x91\xf7\x71\xe4\x0f\x8f\x3e\x8e\xdc\x8f\xa7\x37\xf5\xb0\x3e\x8e\xbc\xe1\x78\x59\xbe\xcc\x3e\x8e\x7c\xaf\xd1\xc7\x91\xaf\x8f\x3e\x8e\x7c\xcb\xe8\xe3\xc8\xb7\x8c\x3e\x8e\xbc\x8f\x23\xef\xe3\xc8\xfb\x88\x98\x47\x61\x3d\xcf\x88\x18\xd6\xc7\x91\xfb\xd1\xc7\x91\xbf\x08\xbf\x3f\xeb\xe3\xc8\x77\x1a\x7d\x1c\x79\x1f\x47\xde\x64\xf4\x71\xe4\x38\x7a\xdb\x4b\x1f\x47\x1e\x46\x1f\x47\x4e\xe3\xb7\x23\x35\xf7\x71\xe4\x7d\x1c\x79\x1f\x47\xde\xc7\x91\x3f\x38\x8b\x3e\x8e\xfc\x25\xe8\x93\xa1\xa1\x56\xfb\x20\xe8\x0f\x01\xd2\xee\x61\x35\xec\x62\xc3\xaf\x68\x56\x31\x99\x03\xa2\x4b\x90\x89\x06\x1e\x2f\x11\x64\x84\x6e\x99\x52\xc8\xfa\x0c\xa3\x73\x12\x91\x8a\x66\x71\xe7\x6c\x6d\xd3\xbc\x45\x58\x15\x17\x8e\x43\x4b\xca\xef\x71\x03\xf0\x54\xe5\xd4\xbf\x2b\x52\x69\x96\xdb\x3a\x4e\x71\x79\x9a\xb4\xde\x9a\x8a\x99\x3f\x51\x4f\xa8\x4b\xd8\xb0\x00\x3b\xac\x74\xe6\x7a\x42\x05\x86\xc7\x21\xfc\x64\xdc\x81\x2e\x91\x71\x6b\x41\xcb\x37\xec\x3f\x8f\xfe\xfe\xe5\xaf\xc3\xe3\xaf\x8f\x8e\x7e\x7a\x35\xfc\xf3\xcf\x5f\x1e\xfd\x7d\x84\xff\xf8\xd7\xe3\xaf\x8f\x7f\x0d\x7f\x7c\x79\x7c\x7c\x74\xf4\xd3\x77\xef\xbe\xbd\x19\x5f\xfc\x2c\x8e\x7f\xfd\x49\xe6\xe9\x2d\xfd\xf5\xeb\xd1\x4f\x70\xf1\xf3\x8e\x40\x8e\x8f\xbf\xfe\xa2\xf1\x94\x5b\x0b\xbc\xdd\x89\xbb\x1d\x09\xbb\x1f\x45\xd4\xf5\xbe\xdf\x8e\xf6\xe2\x07\x0f\x6d\x75\x37\xfa\xe3\xe8\xa1\xdd\x18\x34\x6e\x14\xe2\x0a\x38\xc2\x30\x95\x0a\x6b\x3d\x17\xe5\xd5\xa8\x2f\x61\x6b\x2a\xa7\xe7\x03\xd8\xed\x90\x5b\x6a\x27\x58\x44\x4c\x55\x22\x76\x55\x90\xeb\x7c\x9b\xc6\xc2\x1a\x81\xfb\x79\x18\xc3\x54\x48\xf0\x5e\xae\x9e\x37\x3c\x3e\x7a\xde\xf0\x12\x79\x83\x81\x28\xd7\xc2\x2e\xcf\x94\xb4\x70\xdf\xc8\x7e\xb2\xcd\x80\x74\x5d\x07\xcd\x68\xc7\x11\xa7\x08\xaf\x65\x2a\xa3\x40\xc9\xad\xa9\x79\x45\xd0\xad\xce\x25\xaa\x94\x94\x43\x01\x96\xf4\x3d\xd4\x74\x30\x0a\x72\xf5\x75\x41\x83\x23\xd0\xff\xcc\xc5\x82\x27\x4e\xbf\x2d\x9f\x18\xa3\xce\x52\x7d\xa8\x91\x11\xeb\x89\x65\x2c\x14\x6f\xc6\x5a\x2c\x44\x02\x33\xb8\x30\x11\x4f\x90\x2b\x75\xc3\xe9\x4f\xb7\x40\xc7\x25\xd2\x2a\x31\xec\x6e\x0e\xd8\x46\x95\x07\xf5\x1c\x33\x15\x66\x5c\x48\x96\x3a\xa6\x9a\x85\x87\x0d\xe9\xf9\x8e\x79\x3b\xa9\x57\xda\x52\x9f\x47\xf5\x75\xa2\x54\xe2\xc3\x7a\x93\x65\x09\xdf\xb7\xb5\x95\xea\x17\x09\x77\xbf\x38\x68\x86\x4d\x13\x3e\x2b\xd4\x78\x03\x76\xcd\x12\x57\x82\xde\xfa\x01\x18\x33\x9b\x03\xe3\xc9\x1d\x5f\x9a\xd2\xa8\x51\x6d\xf8\xfb\x86\xbd\x3e\x46\xc2\xe3\x86\x15\x30\x62\xf6\xd5\x31\xfa\xf9\xce\x4e\xc7\xbf\x5c\xff\xed\xfa\x97\xd3\xf3\x77\x97\x57\xed\xf8\xbc\xfb\x76\xe0\xb2\x11\x8c\x88\x67\x7c\x22\x12\xd1\x86\xbd\xaf\x45\x82\x54\x81\xe2\x01\x1a\xc7\x27\xb1\x56\x19\xe1\x29\xd8\x8f\xaa\x3a\xce\xf9\x8a\x59\xd8\x9f\xd9\xb4\x3c\xd3\x3a\xc0\x99\xe6\xd2\x96\x86\x94\x12\xe5\x3a\x97\x4e\xe9\xfd\xcc\x83\xe6\x79\xdc\x5d\xc0\xfc\x69\x1c\x43\x5c\xc3\xde\x8b\x0b\xd0\x3b\x0b\x1f\xb7\x2c\x73\x6d\xd9\xf8\xfd\xf5\xe5\xff\x5e\x21\xc3\x65\xd6\x2e\x1e\xa9\x9b\xfc\x1e\xdd\xbc\x03\x39\x5b\x37\x26\xa4\x6a\xd1\xaf\xef\x73\x59\xdf\xe2\xac\xea\xc6\x0b\xfe\x21\x97\xd5\xe3\x44\x56\xe0\xb3\x54\xc5\x30\x62\xe3\xc2\x82\x5e\xbf\x5a\xad\x21\xa0\x81\xb9\x5b\xa4\x15\xd8\xea\xbc\x22\xca\x58\x45\x89\x2e\xb5\x0c\xd3\x2a\x1f\x9e\xf2\xc4\xb4\x64\xa6\x6d\x4e\x26\x77\x08\xbf\x73\xaa\x60\x27\xd8\x2c\xa0\xb1\x18\xa4\xb2\x5e\x92\x74\xb3\xc4\xa4\x5b\xad\x22\x46\x7a\x67\x25\x64\xa9\x76\xba\xf8\x06\xf8\xe1\x60\x12\x26\xe0\x6a\x5c\x40\x26\x0b\x6c\x6e\xc0\x6c\x3e\x98\x4a\x4d\xd4\x41\xd7\xc0\x63\xcc\x17\xcb\xb8\x9d\x53\xc4\x41\xca\xcd\x2d\xc4\xf4\x83\x97\x6b\x0a\x13\x3c\x35\xc3\xf7\xaf\xba\x71\xf3\x0e\xf6\x76\x94\x67\x28\x0e\x02\xed\xf4\xd0\x38\x3a\xbd\xf5\x16\x70\xdf\xf4\x5e\x26\xcb\x0f\x4a\xd9\x6f\x8a\x3c\xa9\x4e\x16\xf0\x47\x2f\x29\xa2\x9b\xa5\x1e\x32\x85\x01\x02\xf1\x10\x91\x89\x24\x5d\x4d\xd1\x3a\x2f\x17\xec\x89\x09\x5a\xe7\xf2\xd4\x7c\xab\x55\xde\xf8\x04\x58\x13\xb4\xbe\xbd\x3c\xc7\x7d\x9c\x7b\x0f\x98\xb4\x7a\x99\x29\x41\xe6\x93\x2d\x32\xed\xf7\xde\x87\x57\xa5\xc8\xd2\xdd\xc2\xde\xf1\x25\xe3\x89\x51\x41\x38\x16\x72\x93\xaa\xc3\xbc\x1e\xe5\x2e\x4f\x94\x9d\xaf\x29\x50\x8e\x9c\xd7\x9f\x1b\x54\x1c\x62\x65\xdd\x14\x21\xd7\x1e\xb7\xfc\x16\x0c\xcb\x34\x44\x10\x83\x8c\x5a\xae\xda\x53\xbb\x81\x70\xe5\xaf\x94\x74\xdb\xa2\x93\xb5\xbf\x2c\xfc\x7f\x68\xc6\xaa\xaf\x34\x7a\x12\xbd\xde\xc1\xd1\x9f\x88\x9b\x22\x37\xa0\xc9\xf9\xa9\x73\xa0\x85\xf8\x2e\x9f\x40\x02\x96\x94\x21\xac\x1f\xc0\x2d\xa9\xbc\x22\xe5\x33\x60\xdc\x16\x84\x62\x15\x03\x69\x1c\xbb\x21\xc3\x99\x65\xb1\x82\x32\xb9\x91\x1b\xf6\xfd\xe5\x39\x7b\xc5\x8e\xdc\xbb\x8e\x71\xf9\xa7\x5c\x24\xe8\x6a\xb4\x5c\xaf\xce\x51\x4c\x03\x08\x9c\x12\xd2\x1e\x53\x9a\xb6\xe8\x80\x49\xc5\x4c\x1e\xcd\xc3\x9c\x9c\xc6\x15\x14\x36\x1f\x6b\x87\x26\xf9\x17\x48\xaa\xad\x19\xcc\xf7\x06\x74\x67\xfc\xe5\xfb\x06\xfc\xa5\x2a\x42\x38\x9a\xab\x63\x8f\x08\x2b\x05\xcb\x63\x6e\xb9\xe7\x3b\x65\x46\xf4\x4b\x5c\xd2\xa7\xe6\x3e\x06\xde\x0a\x99\xdf\x93\x6d\xad\x3b\x25\xff\xfa\x02\xc1\x22\x09\x20\xd2\x70\xd1\x78\x96\x25\xa2\xf4\x3c\x56\xa2\x9b\x2e\x6b\x4b\x3d\xd8\x22\x22\xe1\x36\x0f\x0e\x4c\x77\xb2\x73\x19\xab\x74\xed\x65\xe8\x2d\xe5\xd1\xbc\xfa\x82\x17\x49\x3c\x4f\x6e\x8e\x48\x60\x01\x2d\x6a\x73\xac\x10\xce\x5b\x07\xcd\xc9\x62\x61\x41\x11\x3c\x4b\xf8\x04\x12\x3a\x59\x88\x80\xcc\x3a\x01\x3d\x75\x84\xa0\x56\x49\x77\xf9\x11\x1f\x54\x02\x14\x72\x13\x10\xe1\xc0\x7f\x16\x78\x40\x20\x5d\xe1\x01\x15\x99\x1a\x1e\x50\x25\xfb\x1c\xf0\x90\xb7\x38\x68\xd9\x2a\x1e\xdc\xa9\x5d\xc7\x03\x1e\x9d\xcf\x1d\x0f\x06\xa2\x48\xa5\xd9\x58\x2b\xa7\x72\x75\x76\xb4\x78\xb0\xa5\x7f\x87\x74\x72\x34\xf8\x56\xb5\x3f\xef\xcd\xa9\xdf\xcc\x75\x25\xd8\x8e\x5b\xe2\xf1\x21\xe2\xee\x7f\x54\x8e\x1c\x64\x3d\xab\xe7\x50\x80\x52\x73\x00\xb9\x27\xfd\x85\xcf\x3c\xd5\xbf\x85\x95\xac\x93\xc3\x44\x45\x3c\xc1\xd2\x69\xed\x28\x86\xad\x52\xcd\x2a\xe0\x4a\x84\x24\xba\x96\xf0\xb7\xe0\xb5\xc7\x2a\x5a\xf8\x8b\xb7\x7d\x49\x15\x43\xc5\x59\x48\xa1\x9d\x37\x14\x49\x87\xf7\x85\xe0\x4c\x77\xaa\x7b\xe7\x3d\xc4\xb5\xa7\xad\xf2\xd5\x59\xde\x15\x05\xd9\xdc\x04\x41\xc6\x42\xce\xd0\xa2\x33\x60\x1a\x12\x0a\xeb\xf4\x7b\xf8\x96\xd4\xaf\x43\xa4\xe8\x00\x34\x90\x73\x78\x35\x4a\x42\x42\x49\x0f\x19\x8d\x1c\x41\xbe\x99\x12\xb7\x14\x86\x1d\xbc\x0d\x08\x68\x51\xc1\xea\x39\x1e\x10\x07\xf4\x85\xc5\x6a\x92\x8d\xed\x56\xc8\xd8\x47\x40\xd6\x90\x15\x94\x44\x2f\x85\x62\x6c\xad\x88\xab\xac\xe1\x0d\xfb\xbb\x64\x05\xb2\xd8\xb0\x31\x79\x7c\x20\x81\x35\x98\x97\x86\x0f\x9b\xfc\x8a\x97\xac\x82\xf9\x5e\xe2\xda\xbb\xf7\x0e\x9d\xda\xbb\x7e\x5f\xf8\x96\x83\xa7\x5c\xd7\x3b\x21\x63\x75\x67\xba\xd6\x21\x7e\x24\xb0\x41\xa0\x8e\x1c\x59\x5b\x21\x67\xa6\xaa\x47\xd4\xeb\xe4\x6e\x56\x24\xc2\x0a\x4f\xb5\xa2\x34\xbc\x75\x01\x7e\x25\x72\xbb\x57\x02\xf6\x18\xb3\xd4\xf0\x33\xed\x3e\xc5\x0a\x9e\x5c\x67\xcd\xcb\xa6\xb1\x55\x32\xf8\xf6\xdd\xf5\x69\x1d\xb4\xe3\x67\x77\x73\xd0\x74\xf6\xba\xeb\x8c\xc7\xa9\x30\x06\xcd\x40\x30\x99\x2b\x75\xcb\x8e\x42\xa0\xd5\x4c\xd8\x79\x3e\x19\x45\x2a\xad\xc4\x5c\x0d\x8d\x98\x99\x13\x4f\xb4\x43\x37\xfb\x63\x26\x64\x52\x44\x90\xa0\x1a\x29\xad\x09\x66\x0c\x7c\x49\x54\xcc\x02\xd7\xd6\xd7\x5d\xf4\x5e\xe6\xf5\x69\x52\xa5\x45\x01\xc9\xd3\x17\x83\x59\x5f\x9e\xab\x96\x75\x2d\x1e\x59\x22\xfc\x76\x9f\x36\x52\x4d\x71\xda\x88\x47\x92\xde\x9e\x1c\x49\x5e\x3a\x88\xc0\x74\x57\x31\xe7\xaf\x25\x4c\x16\x03\x65\x36\x00\x46\x9d\xf0\xad\x61\x48\x68\x95\x3d\xc4\x04\x39\xff\xe8\x61\x55\xa2\x45\xaf\x0f\xa5\x60\x38\x7d\x20\xc9\xe6\x7c\x48\x4a\xb2\x63\x49\xc8\xc3\x82\x0c\x30\x57\x52\x69\x22\x51\x77\x0a\x2a\x89\x24\x8d\xda\x02\x39\x82\x70\x4d\x3c\x8f\xad\x4c\xf5\xac\xf4\x0f\x56\x7d\x48\x98\x60\x43\x09\xfa\xe5\x1c\xee\x84\x9d\x63\x11\xb9\xf9\x8a\xc3\x09\x67\xa2\xc1\xa0\xf7\x40\x32\xd0\x5a\x69\x1f\x08\x13\xac\xb6\x08\x09\x59\x31\x46\xd2\x38\x22\xe1\xee\xaf\x43\x53\x75\x51\x96\xa5\x4c\x31\xb6\xcb\x51\x13\x4c\xa7\x10\xa1\xa4\x54\x45\x30\xb1\xdd\xa3\xb2\xaa\x5e\x08\x9d\xb7\x2a\x94\x42\x4d\xc5\xbd\x7b\x4b\xf5\xa9\x95\x82\xea\x52\xc9\xe1\xe6\xcb\xc7\x23\xc6\x2e\x65\x11\xf7\x38\x70\xab\x58\xbd\x33\x84\xfc\x58\xf7\x89\xd5\x3a\xba\xf8\x01\x55\xbb\x93\x13\xef\x74\xde\x01\xc5\xb7\x31\x06\xb3\xaa\x41\xb8\x53\x76\x80\x86\x61\x0f\xd4\x2d\x7d\x38\xc4\xdb\x18\x8a\xdd\x2d\x1f\xcb\x58\xfc\x79\x9c\xf4\xac\x2d\x9f\xf3\xe9\xea\x7d\xed\xd7\xdd\x46\x5f\xfb\x75\xfb\xe8\x6b\xbf\xf6\xb5\x5f\xfb\xda\xaf\x0f\x8f\xbe\xf6\xab\x1f\x4f\x9f\x9e\xc9\xfa\xda\xaf\x0d\xc7\xcb\xaa\x3f\xd2\xd7\x7e\xdd\x6b\xf4\xb5\x5f\xd7\x47\x5f\xfb\x75\xcb\xe8\x6b\xbf\x6e\x19\x7d\xed\xd7\xbe\xf6\x6b\x5f\xfb\xb5\xaf\x62\xf5\x28\xac\xe7\x59\xc5\x8a\xf5\xb5\x5f\xfd\xe8\x6b\xbf\xbe\x88\x5a\x3d\xac\xaf\xfd\xba\xd3\xe8\x6b\xbf\xf6\xb5\x5f\x9b\x8c\xbe\xf6\x2b\x8e\xde\xf6\xd2\xd7\x7e\x0d\xa3\xaf\xfd\x4a\xe3\xb7\x23\x35\xf7\xb5\x5f\xfb\xda\xaf\x7d\xed\xd7\xbe\xf6\xeb\x83\xb3\xe8\x6b\xbf\xbe\x04\x7d\xd2\xd8\x58\x34\x2a\x87\xb5\x4b\xf5\x02\x1f\x19\x52\xc9\x77\x9c\xe4\xd3\x29\x68\xe4\x5c\xf8\xe6\xb5\x28\x84\xa2\xca\x51\xc1\xcb\x7c\x9c\x01\x56\x35\xd3\xc0\x63\x1f\x05\xbd\xe5\x71\x9f\x60\x89\x65\xab\xca\xf0\xbd\x8b\xf7\xdf\x74\x53\x2a\xa1\x5d\xe0\x1a\xce\xf9\xbd\x8c\xda\x07\x30\x95\x08\xdf\x14\x95\xef\xf1\x1e\x25\xca\xf8\xb0\x43\x44\x56\x34\xe7\x52\x42\x50\x1e\x85\x45\xa3\xcc\x04\x40\x32\x95\x81\x24\xfe\xcd\x99\x11\x72\x96\x00\xe3\xd6\xf2\x68\x3e\x72\x6f\x92\x01\xd9\x65\x88\xa0\xff\xc5\x58\x0d\x3c\x0d\xc1\x92\x29\x17\x04\x8a\xf1\x48\x2b\x63\x58\x9a\x27\x56\x64\x05\x30\x66\x00\xa3\x9c\xe9\xa0\x2a\x90\x81\xe1\x25\x65\x5c\xe1\xa0\x7c\x9b\x9f\x96\xaa\x56\x8a\x41\xd5\x75\x80\xa5\x2d\xd3\xcc\x2e\x99\xfb\xe4\xc4\x97\xbb\xd3\xc6\xb2\x28\x11\x78\x5a\xe3\x1b\x29\xa1\x0c\xe1\x0d\xc2\x59\x2d\xfd\x4c\x8d\x9f\xaa\x8c\x51\x6c\xcd\xac\x61\x18\x86\x57\x02\xf4\xa0\x62\x61\xbc\x98\x6f\x06\x8c\x87\x3a\x28\x84\xe8\x30\x53\x44\x75\x38\x59\x08\xba\xff\xa9\x02\xae\x52\x3c\xcd\xd1\x26\x59\xc3\x4a\x42\xc7\xb8\xd3\x40\x9c\x83\x5a\x88\x6d\x29\x50\x60\xb8\xcb\xda\x36\xc0\x05\x90\xb0\x70\x34\x00\x11\xb8\xf3\x95\x6f\xa1\xfa\x4f\x4e\xf4\x96\xeb\x19\xd8\x22\x28\xb7\x69\xac\x66\xbd\x40\x44\xa5\xca\x61\x55\x0f\x29\x31\x86\xc8\x19\xab\x18\x23\xee\x7d\x15\x09\x47\x33\x1b\xca\x28\xd2\x04\x7d\x01\x9c\x4d\x37\x04\xb9\x88\x42\x9d\x8a\x97\x9a\x8c\x47\x60\xd8\xd1\xe5\xf8\x6c\xc0\xc6\x97\xe7\x3e\x9e\x49\x4d\x37\xa5\xf1\x79\x16\x46\x04\xb8\xad\xa0\xa3\x30\xe1\x1d\x77\x73\x6e\x71\x39\x2b\x2f\x42\x49\x74\xce\xb5\x0f\x54\x0c\x95\xaf\xd9\x95\xb2\xb0\xa9\x50\x46\xe0\x06\x28\x7e\x79\x1b\x84\xa7\x34\x12\x67\x9a\x33\xc0\x86\x6a\x4b\x45\x3e\x7a\x07\xc6\xf0\x19\x8c\x1b\x3a\xb0\xb6\x29\xe7\xe8\xc3\x2a\xf7\x28\x72\x85\x84\xd2\xd7\x8a\x5f\xca\x88\xb7\xba\x44\xcc\x52\x9a\x53\xb1\xde\x77\x5a\x58\x0b\xb8\xbf\xb1\x78\x12\xfa\xc0\x57\x13\x54\x0f\x57\xe2\xe6\xde\x05\x20\xe5\xc3\xee\x7c\x97\x31\x45\xb1\x4d\x80\x4d\xb4\x80\x29\x9b\x0a\x0c\x8d\xc3\x60\xb5\x01\x95\x03\xe1\x64\x5c\x32\x06\x34\xce\xc7\xab\x35\x61\x5e\x23\xf6\xa3\x9f\x98\xd5\xb9\xa4\x0a\xe8\x5e\xe2\xc6\x14\x2e\x31\x65\x33\x0c\x76\xf3\x8a\xc3\x1f\x5f\xfd\xf9\x4f\x6c\xb2\x74\xd2\x0d\x92\xb6\x55\x96\x27\xc5\x47\x26\x20\x67\x0e\x57\xc4\xa9\xeb\x49\x48\x05\x06\xb0\x46\x39\x4d\xfc\xf5\x57\xb7\x93\xba\xb8\x75\x12\xc3\xe2\xa4\x82\xbf\x61\xa2\x66\x23\x76\xc6\xa5\xa3\x75\xa7\x46\x64\x31\x5a\xf2\x9b\xd7\x0d\xed\x8e\xcc\x54\x22\xa2\x65\x7b\xb6\xe3\x75\x13\x36\x57\x77\xa4\xf6\x6d\xa0\x9e\x32\x1c\x36\x53\x59\x9e\x90\x33\xe3\x9b\x22\x7d\x2f\x37\xb0\x9e\xa3\xb3\x71\x5f\xa0\xf9\xdd\x83\x58\xd9\xda\x3e\xc6\x31\xbc\x52\xf9\xd8\x6f\x6f\x20\x2e\xca\xd3\xa0\x4e\xfc\x0d\x4f\x92\x09\x8f\x6e\x6f\xd4\x5b\x35\x33\xef\xe5\x85\xd6\x4a\xd7\xe7\x92\x70\x77\x70\xce\x73\x79\x4b\x75\xa9\x8b\x14\x62\x35\x73\x52\x76\x96\xdb\x50\x69\x74\xd3\x07\x53\x42\x6a\x38\x8f\x83\x46\x5c\x42\x81\x7b\x51\xaa\xbd\x3e\x95\x82\x28\xb2\x0a\xdf\x54\x89\xed\xab\x57\x7f\xfc\x77\x22\x5d\xa6\x34\xfb\xf7\x57\x18\x07\x6b\x06\xb4\x89\x91\x2f\x3a\x99\x21\xe5\x49\xe2\xd8\x6b\x95\x28\x1d\xa2\x37\x11\xe1\x27\xa7\x41\xdb\x9e\xdc\x76\x96\xaa\x6f\x6e\xfe\x86\x47\x82\xb0\x06\x92\xe9\x80\xb2\x06\x0a\x0d\xf7\x10\x65\x84\x43\xcf\x7d\x30\x75\xe3\x19\xc8\xc2\x0b\x95\xe4\x29\x9c\xc3\x42\x74\xd1\x78\xa2\x06\x2d\x58\x7d\x12\x61\x30\x41\x63\x92\xa8\xe8\x96\xc5\xfe\x62\x25\xa2\x69\xb5\xc4\x6a\x73\x2c\x34\x8d\xed\x6a\x11\xd3\xb5\xf5\xfb\x6b\xd1\x5c\x29\xcf\x32\x21\x67\x94\x9c\xa4\xf9\x5d\x0d\x19\xb8\x27\x31\x1f\xb8\x65\xbd\x85\xd6\x1e\x87\xb6\xfe\x86\xa1\xff\x22\xc7\x37\x1b\x83\x68\x1c\xcd\xd4\xde\x5d\x51\xce\xbe\xb9\x8d\xba\x46\x10\x25\xc0\xb0\x1b\x32\xfc\x37\x45\xea\xaf\x89\xcb\x85\xf8\x58\x10\x06\x09\x00\x8e\x7c\x90\x25\x37\xb7\xbd\x77\x60\xe8\x6e\x17\xca\x56\xc3\x8b\x2c\x1c\x0c\x29\xb7\x5e\x20\x0c\x1a\x04\x67\x19\x68\x23\x8c\x3b\x97\x7f\xc0\x0d\x75\x96\x70\x91\x56\xac\xc1\x4f\x83\x04\xda\xdc\x58\x19\xb3\x3d\xa7\x1c\xab\xd8\x03\x44\x56\x48\x55\x41\x37\x88\xb5\x75\xa9\xb6\xc3\x03\xf5\xa9\x59\xe5\x0f\x25\x36\xeb\x9c\xd2\xfd\x52\xb0\x4a\xba\xeb\x25\x31\x48\xfc\xbe\xcf\x95\x3f\x16\x93\xef\x88\x0d\x20\x63\xf4\x8b\x5b\xe7\x84\x35\xe5\x91\x36\x4a\x45\xa4\xf7\x7a\xe0\x88\x51\x74\x85\xdb\x13\xfe\x51\x76\xf8\xe6\xf0\x49\x99\x24\xa1\x48\xab\x8c\xcf\x5a\xf5\x38\x58\xc1\xd4\x2a\xd8\x6a\x22\xb8\x53\x83\xf0\x7a\x51\x96\x08\xef\x82\xb8\x2c\x54\x81\x65\x48\xc8\x51\x1e\x10\xec\x15\x04\xec\x41\xc3\xee\xf8\x92\x71\xad\x72\x19\x7b\x53\x63\x61\xeb\x7d\xb7\xf2\xe2\x2b\x25\x21\xf8\x50\x56\xf3\xc8\xd1\xb9\x23\x24\x7b\x3d\x7a\xfd\xea\xa5\x9c\x54\xf8\x85\x2b\x27\xd5\x55\x71\x52\x11\x7f\x7a\xd2\x6f\x0d\xd5\x90\x3b\xfa\xde\x77\xde\xc4\x52\x16\x3b\x16\xa1\x98\x2b\xfe\x74\xa7\x85\x85\x4a\xe7\xa2\x23\x54\x5c\x9c\x7e\x58\xc9\x9a\x3e\xee\xb0\xc6\x77\x37\x69\xea\x26\x9f\x7c\x44\xbe\xe5\x19\x14\x6e\xb7\x4d\x16\x2e\xf3\x00\x0b\xab\x22\xea\xe0\x80\x1d\xd1\x9d\x87\x94\x24\x7a\xfc\xa4\xa4\xe5\x91\x76\x71\x9f\xb5\xa8\x41\x57\x43\xdc\xc5\x7d\xc6\xd1\x06\x97\x75\x88\xc1\xff\x80\x39\x5f\x00\x26\xc7\x8a\x84\xeb\x04\xdd\xcf\xd7\x34\x77\x36\xc9\x2d\x03\xb9\x10\x5a\x49\x8c\xf5\x5a\x70\x2d\xb0\x6a\x85\x86\x29\x68\x90\x4e\x17\xfd\xe2\xe8\x87\xd3\x0f\x18\xdb\x72\x4c\xb5\xec\xc3\x2c\x73\x13\xca\x4b\x54\x67\x52\x01\xf7\xe8\xf2\x85\x79\x38\x1c\x22\xcf\x0d\xf3\x72\xef\x49\x73\x9b\x53\xc1\xfc\xfb\x28\xc9\x8d\x58\x3c\x15\x27\xf1\x59\xcb\xe7\xa2\xd1\x3a\xaf\x64\x50\x97\x88\x5a\x4b\x86\x2e\x6d\xf0\x8f\x54\x68\x3d\x34\x45\x4d\xab\x6a\x38\x84\x37\x3d\xb1\x54\xcc\xe6\xd6\x87\x65\x86\x92\x66\x6b\x22\x04\xd6\x75\x78\x5a\x23\x94\x3b\x76\x4f\x13\xc1\xcd\xbe\x22\xd7\x5a\xc6\xa1\x87\x82\x41\x14\xd2\x17\xa2\xe2\x49\x61\x5b\x71\x2f\x22\x83\xe3\xe5\xd8\x7b\xa7\x02\xde\x84\xfc\x2f\x0a\x5a\x29\xb4\x0b\x0a\x42\xa1\x47\xc8\x6a\x38\xad\xd6\x0c\x08\xc1\x1a\xc8\xfc\xb1\xca\x0a\x5a\xb5\xa4\x92\xc3\x79\xa5\x20\x49\xa6\xe2\x3d\xb3\xf1\x9a\x2a\x1e\x8d\x54\x8e\xcd\x18\x64\x73\x95\xc4\xa1\x2f\x27\x99\x64\x26\x60\xef\x00\x24\xbb\x1c\x23\xfe\xdc\x27\xa2\xb7\x67\x0b\x16\xc9\x3b\x80\xa5\x47\x2a\x1a\x69\x0d\x9f\xfb\x12\x58\x0b\xad\xa4\x8d\x48\x5f\x7c\x69\xeb\x3d\xff\xd7\x02\x67\xc1\x23\xc6\x27\x6a\x01\x88\xd2\x38\xd6\x60\x5a\x94\xee\x78\x02\x3d\xb5\x15\x2b\x15\x8d\xfa\x2e\xd4\xfd\x1b\x05\xda\x82\x85\x08\xc5\x77\xdc\xaa\x48\x78\x9f\x98\x83\x5d\x8e\xcf\x5a\x70\xaf\xc3\xef\xbd\x7b\xc3\x81\x3a\x3c\x34\x4c\x64\x51\xe9\x4f\x1d\xb1\xd2\x6b\x58\xc9\x68\x20\x89\x71\x3f\x97\x55\x53\x31\xb1\xc2\xd4\x5a\x32\x69\x26\x09\x8c\x63\x2b\x3e\x9b\xb9\xf0\x12\x0b\x43\x6e\xe2\x1a\x36\x4c\x78\xa2\x8a\x90\x10\x88\x40\x4c\xde\x87\x65\x0c\x8a\x00\xdf\x15\xc6\x84\x16\xf4\x10\xda\x57\xe1\xe2\x6b\xc8\xfc\x64\xb8\x1c\x5f\x9e\x77\x49\x2e\x99\x88\x9f\x1d\xb9\xec\xaf\x5f\xd6\x73\xd7\xea\x25\x1f\x3c\xc0\xb0\xd9\xc7\x2a\xde\x22\x26\x95\x07\x0d\xde\x5f\xed\x2e\x68\x15\xe3\x8c\xcc\x84\x2b\x7d\x63\x1b\x20\x65\x6f\x2e\x81\xa2\xd6\x38\x4f\x92\x6b\x88\x34\xec\x6b\x1e\xad\xaf\xff\xe5\x0a\xac\x6d\x22\x4f\x45\x7e\xc7\x3a\x02\xfe\x66\x59\x16\x78\x2b\xa8\xa6\x9a\x25\x98\xe5\x09\xc6\x99\x72\xb9\x0c\x08\xc7\xd9\x9b\x8a\x2f\x4a\x98\x10\xb3\x42\x21\x52\xb5\x55\x30\x50\xbc\xac\xe8\x16\xc2\x8d\x21\x8f\xe9\xff\xcf\xde\xff\x78\x37\x6e\x1b\xfd\xc2\xf8\xbf\x82\xe3\xf6\x7c\x6d\x37\x92\xbc\x9b\xfe\xca\xb3\x37\xdf\xe6\x38\xb6\x37\xf5\xcd\xae\xd7\x8f\xed\xdd\xbc\x7d\x93\x3c\x2d\x44\x42\x12\x6a\x12\x60\x00\x50\x5e\xf5\xe6\xfe\xef\xef\xc1\x0c\x00\x82\x94\xec\xb5\x49\x7a\x25\x3b\x62\xcf\x69\xd6\x12\x05\x82\x83\xc1\x60\x7e\x7e\x86\x8b\x94\xcf\x79\x5a\xd2\x0c\x1e\x04\x5a\x68\xdc\xd3\x37\x9c\x90\xb9\x07\x2c\x24\xaf\xa5\x22\xec\x23\xb5\xb7\x0d\x82\x12\x4b\x35\xb0\x43\x2a\x93\x6b\xa6\x06\xa8\x8a\x1d\xc3\x1f\x47\xa0\xf1\x22\x24\xaf\x9f\x87\xb5\x25\x1c\x4c\x5f\x9b\x36\xc1\xbe\x0f\x30\xd2\xe1\x77\xf8\xba\x0b\x2e\xa6\x43\xf8\xc4\xbe\x88\x7b\xd2\x50\x8a\x21\x1d\x5a\x36\x7c\x22\x8a\x1f\x60\xf0\xbe\x03\xcd\xea\xc2\xf3\x8b\x37\x11\xac\x21\x27\xcb\xe9\x0c\x88\xa5\x72\xea\x61\xb1\x32\x66\x00\xf1\xc8\xc5\x75\x11\x9a\xc2\xfd\x36\x75\x6a\x5a\x8c\x00\x55\xe7\xb5\x27\xa2\xfc\xb5\xf5\x90\x35\x32\x85\x23\xb1\xe5\x68\x64\x1e\xcc\x81\x72\xce\xd4\x9c\xb3\x9b\x03\x77\x74\x0e\x6f\xb8\x99\x0d\x91\x22\xfa\x00\x08\x7b\xf0\x3b\x54\x2f\xb1\x70\xeb\x30\x4d\x9d\xdb\xb2\xd4\x6c\x52\x66\xae\x5f\xee\x88\xd0\x82\x7f\x60\x4a\x03\xae\xe2\x35\x17\xe9\x80\x94\x3c\xfd\xe6\x33\x26\xbe\x70\xc1\xab\x14\xbb\x4e\x52\xf0\x8d\x93\x72\xae\x5e\x98\xff\xa7\x6a\x69\xeb\x92\x83\xc6\x2c\x93\x62\x1a\x55\x3b\x83\x7a\x71\x2a\xb8\x59\x6a\xcd\x87\xa8\x65\x60\x22\x4b\x95\x42\x22\x23\x97\xaa\xe6\x0f\xb6\xe3\x01\x96\x53\x94\x0e\x69\x45\x24\xaf\x8d\x07\xe9\x2c\x3a\x1c\x46\x04\x13\x22\x7c\x61\xa4\x47\xc8\xf4\x18\x51\x08\x59\x36\xa3\x22\x85\x3f\x93\x44\xaa\xd4\xcd\x97\x9b\x90\x7b\x89\x49\x41\x98\x89\x02\xc7\x1a\x74\x57\x17\xcd\x27\x83\x05\xaa\xf2\x5a\xa2\x9e\x57\x7b\x4a\xc1\x7f\x29\x19\xa1\xb9\xb4\x82\xbd\x09\xe4\xdc\xa0\x48\x4e\x17\x70\xb6\xc2\x54\xdf\x84\x4a\x3f\x2c\x26\xd4\x03\x72\xc1\x68\xca\xa3\x82\xe8\x01\x79\x53\xaf\x90\x1e\xd8\xb9\x5c\x62\xe9\xa6\xfb\x08\x67\xef\x9b\xab\x5f\x60\x90\x28\xf7\x79\x45\xcb\x2f\x63\x57\xc5\xd0\x6b\x26\xd0\x28\xb7\xa4\x81\x38\x58\xa9\x60\x0d\x92\x19\x4b\x4b\x38\xa5\xc6\x0b\x32\xe1\x88\xee\x0e\xaa\x02\x9f\xce\x98\x36\x5e\xb9\x3c\x80\x5c\x9d\xaa\x4d\x8d\x9f\x00\xb0\x6f\x94\x69\x5b\xb9\xb1\x72\x0a\xd0\xa5\xd2\x75\xa6\x77\xc5\x24\x68\xb3\xe9\x32\xf7\x7b\xb9\x49\x69\x3d\xf2\x3d\xed\xed\xcc\x23\xd4\x6c\xde\x20\x2e\x04\xe9\x9c\x1f\x8e\x4c\xa8\x9e\x01\xa6\x7c\x73\x09\x12\x74\xc9\x24\xa5\xb2\x02\x03\x61\x66\x29\x34\x91\x85\x8e\x85\xd0\x6f\x74\x95\xe3\xa6\x63\xf1\x80\x9d\x6c\xfb\xbe\xf7\xeb\x3b\xc4\x0e\x43\x32\xb8\x25\x7c\xd2\x90\x04\xb8\x92\xf6\xc0\xf2\xd8\x52\xbe\x0d\x39\x2c\x86\x95\x0a\x9f\xef\x48\x6a\x1f\x1f\x6d\x15\xd7\xec\x72\x02\x52\x35\xed\xee\xf9\xd8\x3d\x54\xd3\x12\x37\xba\x93\xc2\x15\x28\xad\x6b\xe5\x09\x5a\x1b\xea\x98\xd6\x9c\x39\x7a\x7b\x1c\x97\x20\xc5\xb5\x15\xbe\x80\x6b\x44\x3e\x74\x75\x52\x37\xbd\xd4\x56\x9a\x57\xae\xef\x24\xec\x2c\x2b\x31\xb2\xb9\xb7\x2f\xc2\xd3\xbc\x1e\xca\x45\x51\x1a\x77\x0c\x56\x16\xa7\x48\x66\x54\x4c\xc1\xc8\x94\xa5\x1d\xef\xf7\xbf\x87\x19\x29\x96\x96\x89\x43\xd3\xf7\x2c\xfb\x7b\xef\xb2\x75\x78\x5e\x20\xab\x74\x42\x0b\x3f\xe7\xf8\xb5\xf4\x42\x18\xfa\xf1\x15\xe1\x23\x36\x22\x3b\xbf\x8f\xbe\xda\xc1\xa7\x17\x4a\xda\x47\xb8\xd2\x07\x98\x55\xc6\x0d\xa4\x6f\xef\xc4\x77\x8f\xc8\x89\x7d\x06\x84\xb1\x02\x01\xa3\xec\xfc\x71\x45\xbe\x01\x51\x6c\x4a\x55\x9a\x39\x77\xcb\x4d\x54\xd2\x11\x08\xc6\x3e\x72\x6d\x34\x9e\x41\xa6\x83\x64\x32\x54\x5f\x5b\x39\x64\x77\xd6\x30\xa5\x86\x0e\xa3\x2d\x7d\x80\x76\xdb\xd0\xc1\x7d\x0e\xa9\x63\xad\x4a\x64\x1d\xfc\xce\x55\x46\x0e\x69\xb8\x8b\x5b\x8d\x1c\x80\x37\xdb\xeb\x39\x4f\xcd\xc7\xd6\x01\xf5\xb5\xbe\x7b\x4f\x2a\x04\x69\xa0\x01\x64\xf1\x57\xfa\x52\x10\xa2\x0e\xd8\x74\xd5\x7e\x3e\x39\xbb\xba\xf8\xc7\xf9\xbb\xd3\xb3\xab\xed\xb6\xde\x6e\xeb\xed\xb6\xee\xb0\xad\x99\x98\x77\xde\xd2\xde\x6e\x5a\x15\xf2\x6d\x02\x4e\x46\xa5\x41\xcf\x28\xb1\xee\x44\xcc\x3f\x50\x55\xb5\xb1\x77\xf1\xaa\x15\x11\x70\xdf\xe7\x1e\x44\xdc\xd1\x93\xcf\xac\x5b\x63\x5e\x5c\x8f\xf9\x46\xb1\x4b\x65\xd5\xaa\xc5\xbd\xbf\x8e\xfe\x79\x7a\x7c\x72\x76\x75\xfa\xfa\xf4\xe4\x62\xad\x89\x22\x1d\x01\x1f\xeb\x87\x72\xcb\x53\xb2\x50\x6c\xce\x65\xa9\xb3\x45\xe8\xb1\xb5\x5a\x08\x2c\xe7\x1a\x8a\x14\x7c\x1d\x9a\x29\xc8\xba\x5e\xf9\xb3\xed\x61\xdb\xef\x61\x5b\xcf\x9b\xe9\x00\xcf\xd3\x17\xfb\xbe\x56\x32\xef\x89\x85\x2f\xd1\x0b\xe3\x83\xe1\xab\xf8\x69\xd7\x21\x79\xd4\x8e\x1e\xa7\x3c\x56\xb0\x21\x56\x19\xcd\x0b\xd3\x01\x0c\xbf\x17\x88\xdf\x7e\xd0\x70\x31\x57\xe7\x2d\x2d\xbe\x67\x8b\x0b\xd6\x11\x05\xa8\x4e\x6f\x96\xb1\xc4\x1e\x74\xe4\x9a\x2d\x30\x31\xf3\xc8\x3f\xac\x0b\x5a\xd1\x46\x22\x24\x5f\xb3\x2e\xe8\xd5\x7d\x42\x1b\x5f\xb3\x0e\x49\xa7\xfe\x5a\x02\xf9\xb5\x4b\x08\x7a\x9a\x5d\xd3\x6e\xab\x47\xfa\x85\x35\x7e\x04\x28\xe7\xe7\x1b\x41\xa9\x5f\x3d\xae\x82\x0f\x04\xf7\xbc\x12\x18\x93\x5f\xd4\xce\xae\x20\x44\x08\x56\x75\x02\x6f\xfa\xa0\x83\x53\x32\x7a\x22\x4d\xd7\x26\x5c\x04\x97\xb0\x5f\xb9\xba\x5b\x09\x56\xcc\xf1\x0f\x38\x73\xe9\x2b\x0f\x65\xa0\x43\xe7\xac\x91\xe5\xb0\x41\xfd\x4f\x08\x89\x0e\xc8\xbf\xc2\x87\xd0\x6b\x5a\xff\xb8\xbb\xfb\xf5\xf7\x27\xff\xf8\xdb\xee\xee\xcf\xff\x8a\xbf\x85\xa3\x10\x03\xe5\xf5\x5b\x00\xd7\x49\xc8\x94\x9d\xc1\x33\xe0\x4f\xa7\xae\x1d\x62\xf0\xc4\x7d\x01\x15\xd9\x23\xcc\x5a\x0a\x7f\x16\x32\x6d\xfe\xa5\x3b\x01\x02\x6e\xe4\xc1\x00\x4b\xd4\xa1\xb2\x08\xaf\xfe\x8e\x87\x4a\x96\xf4\xbc\x55\xdd\xa8\x9e\x1b\x01\x58\x1a\x21\xc9\x5e\x7b\x12\x40\x77\x4f\x0f\xfd\x20\xa0\x5e\xde\x6a\xa6\x75\x74\xc8\x9d\xf9\xcb\x4e\x7d\x8c\xf1\xea\x51\xb4\x85\x15\xec\x99\x60\x40\x11\xdf\x30\x0c\x36\x72\x38\x60\x43\xc2\x4c\xe8\x3e\x77\x78\x7e\x4a\xe6\x48\xe1\x8d\x21\x8e\x0f\x6c\xbe\x7e\x54\x19\x17\xc2\xa7\xcd\xba\xdc\x57\x98\x80\xe3\xbf\x77\x18\x09\x3a\x20\xd8\x31\x6b\xd8\xec\xe1\x87\xa3\xa4\x28\x07\xee\x86\x51\xce\x72\xa9\x16\xe1\xcf\x00\x36\x33\xd4\x46\x2a\x3a\x85\x9a\x1a\xfc\x39\xfe\x2c\xfc\x85\x3f\xac\x3d\x60\xf9\xd7\x68\x0a\x57\x51\xd4\x00\x70\xfb\xfc\x64\x9b\x27\xfd\x86\x88\xb6\xa4\x2b\x8c\x52\xfd\xaa\x33\x64\xf0\xc4\xa1\xc2\x19\xa8\x08\xf6\xa4\xab\x19\x1e\x54\xf9\x70\xe0\x0d\x10\x73\x6b\x59\xb6\x06\xc0\xab\xae\x1e\xa5\x59\xca\xe7\x5c\xcb\x0e\x95\x43\x61\xa0\xdb\x73\x27\x1d\x6c\x09\xe6\x6f\x05\xb7\xd9\xc7\x02\x30\xbf\xc2\x7e\x6d\x88\xfd\x97\x5d\x9a\x9c\xe3\x55\x50\x63\x98\x12\xaf\xc8\xff\xec\xfd\xf4\xc5\xaf\xc3\xfd\x6f\xf6\xf6\x7e\x7c\x31\xfc\xaf\x9f\xbf\xd8\xfb\x69\x04\xff\xf8\xc3\xfe\x37\xfb\xbf\xfa\x3f\xbe\xd8\xdf\xdf\xdb\xfb\xf1\xfb\xb7\xdf\x5d\x9d\x9f\xfc\xcc\xf7\x7f\xfd\x51\x94\xf9\x35\xfe\xf5\xeb\xde\x8f\xec\xe4\xe7\x7b\x0e\xb2\xbf\xff\xcd\xef\x3b\x4f\xbd\x07\x08\x5e\xbc\xfa\x04\xe2\xad\x8f\xd8\x0b\xfb\x3d\x62\x2b\x0b\xbc\x3c\x7b\xf5\xbd\xff\x2f\xbc\xd4\x8c\xf2\x79\xfc\x71\xbd\x31\x1b\x1c\x13\x42\x3f\x87\x27\x07\x9f\x54\xaf\xb5\x09\xa6\xc5\x73\x3b\xe7\x7e\x0b\xce\x1d\xaf\xb6\xe3\xba\x56\x9a\xe8\x44\xc9\xdc\x57\xf4\x43\x78\x03\x6b\xcf\xdc\x7d\xd7\xac\x53\x4b\x50\xbc\xb6\xce\xa0\xad\x33\xe8\x96\xeb\x93\xce\x20\x2c\x47\xd8\x5c\x4f\x10\x13\xf3\xb6\x21\x8c\x95\x11\x74\x6f\xeb\xc4\xf0\x77\xf7\x0b\xa8\x8d\xfc\x56\xd7\x21\x12\x57\x65\xd2\xe0\x81\x96\xaf\x8e\x61\x42\x03\x7f\x2e\x70\xe3\xc3\x00\x01\xf4\x93\xb9\xde\x1d\xae\xfe\x72\x6e\xa7\x10\x90\xde\x6b\xd8\x9d\x90\x55\xcc\xc5\xd4\x21\x59\xe0\x51\xe2\xa2\x4f\x5c\x54\x68\xb8\x41\x39\xac\x20\xd4\xa9\xd6\x32\x81\xc6\x47\x88\x93\x17\x50\xf9\xdc\xb4\x61\x36\x86\x5e\xb3\xb8\x15\x3b\xc2\xab\x57\xef\x3a\x5e\x00\xea\xab\x98\x7b\x88\xf9\xb4\xc4\x64\x10\x14\x7f\xab\xc7\x78\x5e\x09\x08\x96\x11\x5d\x10\x2c\xca\x43\x00\xa9\x1f\x2c\x6c\x0a\xa9\x18\x72\x52\x79\x59\xdb\x35\xb3\xec\x7c\x8a\x77\x3f\x33\x43\x64\xab\x93\x32\xb4\x74\x58\x56\xee\xe7\xfa\x21\xf9\x1c\x82\x81\xdd\x8f\xcf\xdf\xdc\xd1\xd9\xd3\xb1\xd9\xcf\x91\xf9\x80\xd8\x49\x9f\xc7\x64\x1f\xc1\x92\x42\xb1\x09\xff\xd8\xd3\x3e\x3d\x8c\x2a\x13\x79\xca\x84\xe1\x13\x8e\xfd\x7a\x0b\xc5\x0a\x26\xb0\x67\x3e\x4d\x66\x20\xfb\xdd\x49\x59\x05\xa7\x37\x31\x99\x07\x15\xee\x7e\x45\xd9\xe5\x2a\x65\x7f\x2b\xc7\xc8\x56\x8e\xb5\xbe\x3e\x93\x1c\x73\x9c\xbb\x39\x42\x0c\x32\xcf\xbb\xa7\xbe\x1f\x47\x79\xec\xc0\xc5\xdd\x2b\x87\x1b\x68\x70\x41\x2e\x1a\x89\x99\x6b\x58\xbe\xa6\x48\xc6\xe6\x2c\x73\x4a\x13\xc9\xa9\xa0\x53\x6c\xc3\x67\x64\x00\xfd\x91\x2a\xb4\x38\x6a\x22\xfa\x80\x12\xef\x2b\xbb\xe0\x4b\x25\xb3\x8c\x29\x4d\x32\x7e\xcd\xc8\x31\x2b\x32\xb9\xc8\x5d\xe2\x6b\x4a\x2e\x0d\x35\x96\xa5\x2f\x99\x69\x17\xf3\xed\x86\x06\xe2\x8b\xd9\x7b\x82\x3e\xc7\xea\x78\xa8\x2d\x27\x85\x2b\x9c\x7c\x27\x40\x62\x1c\x42\xb7\x95\x01\x39\x63\x73\xa6\x06\xe4\x74\x72\x26\xcd\x39\xaa\xde\xf5\x6c\x3b\xbc\x91\xf0\x09\x79\x65\x8d\x3a\x6d\x88\xc1\x8e\x17\x51\x9d\xbb\x54\xb5\x01\x2a\xbc\xb7\x3e\xca\xf2\x96\x4b\xce\x61\xa4\x50\x70\xde\x2a\x8c\xd1\x69\x99\x42\x4b\xa1\xce\x0b\x74\x88\x65\xa4\x15\x92\x6f\xc4\xdf\x08\xcf\xe0\x11\xcc\xc0\x04\xe4\x82\x28\xa6\x0b\x29\x34\xab\xc3\x33\x56\x2d\x28\xc1\xd4\xd5\xbd\x5a\x88\xad\x4f\xce\xae\x67\x66\x21\xb5\x81\xca\xd9\x7e\x1a\x55\x9d\xfb\xe1\xa0\x10\x99\x66\x19\x4b\x6b\x9d\xca\xb0\xc3\x0e\xad\xbb\x07\x12\x68\xce\xe0\x1b\xbe\x30\x57\x9f\x5c\x2b\x6d\xae\xdd\x1f\xba\xde\xf9\xbe\x32\xbe\x7f\xf2\x6d\x05\xcd\xd5\xc6\x84\x43\x24\x62\x80\x25\xbc\x67\x40\x01\xd7\x51\x73\x9a\x99\x94\xd7\x24\x91\x79\x91\xc1\xd6\xe9\xb0\xb3\xaa\xde\x58\x81\x95\x86\xd0\x0f\xf5\x20\x6a\x9b\x05\x1f\xac\xb1\xbf\x69\x1f\x3a\x18\xfb\xc8\x92\xde\xfa\x6a\x5a\x59\x6a\x57\x19\xe2\xfd\x52\x04\x55\x6c\x22\xed\x01\x06\xc5\xd9\x01\x7f\x30\x02\xdb\x39\xf9\xc8\x92\xa8\x37\x2d\x20\x60\x25\x1e\x4f\xc2\x6e\xf4\xee\x3d\xc7\x3b\x87\x29\xfa\x0a\x0d\x74\x28\xbe\x8b\xaf\x06\x68\x20\x8c\xe9\x31\xd2\xdd\x23\xa0\xdd\x04\xd8\x4f\x58\x90\x17\x83\x6e\x04\x1e\xc6\x1d\xbb\x84\x34\x18\x92\xaf\xfd\x58\xd0\xd5\x47\x4a\x43\xf6\x76\x0f\x76\xf7\x97\x7c\x96\x0d\xa0\xed\xab\xe8\x97\x1c\x90\x25\x0b\x80\x69\x64\xc9\x6e\x3a\x20\xdc\xf8\xec\x6c\xec\x13\x04\xb3\x72\x55\x82\x03\xa2\x25\x31\x8a\xa6\xdc\x69\x3f\xf0\xa9\xbd\xc9\xa8\xd2\x1d\x0e\x7b\xbb\xbf\xee\xba\x36\x45\x37\x52\xec\x1a\x98\xfe\x88\x5c\x21\x4a\x4d\x18\x68\x21\x4b\x68\xbe\x89\x24\x28\x32\x9e\x70\x93\x2d\x40\xd0\x11\x59\x62\xa7\x2e\x7b\xcc\xb8\xea\xc4\x93\x8f\xdc\xf8\x96\x24\x72\x42\x5e\x60\xa3\x30\x46\x9d\xd7\x34\xe3\x73\x76\x30\x63\x34\x33\x33\x4c\x2c\x11\x52\x0c\xb1\xd7\xa3\x95\x40\xee\x9b\xae\x31\x96\x6e\x2e\xc8\xf8\xea\xe0\x8e\x5c\x9e\x50\x47\x6b\xc3\xca\xde\xef\xda\x77\xb3\x26\x4b\x60\x61\x57\x57\xe7\xdf\xd5\xda\x59\x83\xf0\x37\xa6\xf0\xe9\x3e\x51\xd3\xf7\x0d\x90\x1d\xfd\x04\x38\x3b\xf5\xa2\x26\x3d\x8a\xb0\xae\x3d\xa9\xc9\x6a\xf0\xb7\xfb\x37\xa3\x26\xff\x90\x25\x40\x87\xd0\x71\xb6\x08\xb8\x0d\x9a\x19\xb2\x63\x87\xda\xb1\xe2\xc9\x72\xc3\xdf\x19\x4d\x11\x56\x43\x1b\x46\x5b\x69\x7c\xf1\xd5\x5b\xe0\x2d\x9a\x5b\xbf\xe7\x40\xa9\x8d\xcc\xc9\xcc\xbd\x76\xbd\x5c\xd3\xed\x8c\x11\xec\x1e\x5f\x0b\xa5\x58\x81\x12\xce\xfd\xe6\xd9\xc9\xaf\x25\xb9\x81\x74\xaf\xf5\x4c\x48\x62\xb2\xc5\x9d\x75\xb8\x40\x62\x21\x4a\x4d\x4f\xb2\xb4\x87\x84\x09\xd2\x63\xd2\x04\xe9\x56\xfc\xd9\x1c\x08\x02\x81\xdd\xf3\xc3\x7a\xcb\xc3\x20\xbd\xe5\x1a\x90\x55\x8e\x59\xc7\x33\xe8\xb4\xe9\x89\x88\xbd\x46\xf8\x49\xf7\xf2\xd2\xf8\xba\x9b\x00\xfd\x2c\x3e\xe9\x93\x02\x45\x0f\xe9\xe0\xcb\xc9\xe0\x08\x3a\x05\xe5\x9a\x28\x5c\x41\x4c\x68\xa6\xe6\x6d\x0b\xc0\xab\xab\xbf\x57\x97\xed\x1d\x05\xfe\x5a\x51\x5b\xad\x88\x08\x0d\xae\x3d\xa8\xea\x32\x41\xa2\x6c\x06\xd7\x0f\xdb\xbb\x80\xfd\x71\x44\xc5\x94\x91\x97\xf6\x97\x7f\xf9\xf3\x9f\xff\xf8\xe7\x11\x0e\x1f\x32\x1b\x04\x39\x3d\x3c\x3b\xfc\xe7\xe5\x87\x23\x28\xa8\xed\x4a\xd5\x9e\xd2\x36\xfb\x4e\xda\xec\x35\x65\xf3\x51\x13\x36\xa1\x4c\xa4\xb3\x14\xa9\xc7\x0b\x60\xc8\x18\x5d\xd4\xe9\x7e\x11\x2a\x9f\xd5\x35\xeb\xfe\x57\xbb\xd5\x36\x62\x8f\x99\xa4\xb8\x94\xc9\x75\x8f\x76\xcd\xee\xd5\xd1\x39\x0e\x19\x99\x36\x54\x78\x67\x08\x17\x73\x99\xcd\x01\x7d\x95\x5c\x1d\x9d\xc3\xce\x1b\xc1\xbf\xc0\x11\x05\x16\xf5\x82\x99\xaa\x90\xc1\x85\xa7\x02\x86\x2a\x14\x69\xd0\x8c\x6b\xc3\x13\xf8\x5d\xe5\x26\xb5\x23\x74\x89\x4b\x6d\x2d\xa5\x55\x57\xef\x96\x52\xd4\x24\xf8\xa1\x46\x53\xd7\xc4\xc3\x0d\x3e\x97\xdc\x79\xa4\x6a\x5d\xb4\xb7\xe7\x52\x0f\xe3\x6d\xee\xb9\x54\x28\x76\x69\x64\xab\x6e\x01\x64\x39\x12\x82\x83\xdd\x12\x07\x19\xb3\x89\x54\xac\x19\x08\x89\x02\x1b\x69\x09\x9b\x90\x0a\xa8\xfe\xf3\x2e\x28\x59\x0b\x5e\x60\xca\xa5\xef\x90\x9d\x39\x4c\xd4\x03\x1d\x03\xa1\xfa\x76\xc7\x03\xfb\x76\x2c\x87\xd9\x0d\xaa\x32\x06\xd7\x6c\x19\x3e\x64\x26\x41\x37\xab\x8f\xbf\x38\x8f\xaa\x9f\x7e\x33\x54\x92\x28\xaa\x67\xd8\x88\x98\x7d\xe4\x26\x40\xae\x52\x2d\x05\x3a\x7b\xa3\x9e\xc8\x5c\x47\x98\xdc\x51\x90\x07\x7f\x74\x2e\xd3\x66\xcf\xf1\xa9\xa2\x09\x23\x05\x53\x5c\xa6\x04\xea\x89\x53\x79\x23\xc8\x98\x4d\xb9\xd0\x9e\x7e\x00\xce\xee\x08\x6d\x8f\x1b\x06\xbe\x61\x8f\x16\x37\x22\x17\x35\x10\x14\x57\x9e\x94\xc8\x6a\x47\xbb\x59\x34\x83\x4c\x90\x11\x0a\xe4\xc5\x6e\x40\x61\x61\xe2\x06\x49\x9f\x98\x74\x0f\xd1\x26\xec\xe1\xe5\xbf\xbb\x95\x3a\x5c\x5b\xaa\x27\xb3\x6e\x81\xdf\x6d\x78\xea\x9e\xd7\x36\x3c\xf5\xb0\x6b\x1b\x9e\xda\x86\xa7\x6e\xbf\x36\xce\xbd\xbb\x0d\x4f\x6d\x8d\xae\xe6\xb5\x0d\x4f\x6d\xc3\x53\xb7\x5c\x1b\x27\xbf\xb6\xe1\xa9\x7b\x5c\xdb\xf0\xd4\x3d\xaf\x6d\x78\x6a\x1b\x9e\xda\x86\xa7\xb6\xe1\xa9\xdf\x90\x1b\xd0\x5f\xdb\xf0\xd4\xd2\x20\xdb\xf0\x54\x44\x8c\xad\xa5\xb4\xe2\xda\x86\xa7\x56\x5c\xdb\xf0\x54\x74\x6d\xcf\xa5\x16\xe7\x92\x0f\xee\x9c\x5b\xbb\xac\x7b\xcd\xda\x39\x04\x0e\x78\xe2\x62\x44\x72\x52\xab\x73\xc2\x47\x8d\xaa\x06\x14\x11\xe6\x87\x2f\xb5\x71\xd1\xa0\x2a\xc6\xb4\xb2\x1e\xaa\x63\x7b\xb8\x42\xa6\x55\x30\x22\x8a\x42\xa0\x75\xda\xbe\x26\x6d\x6d\xd5\x56\x5d\x42\x0f\x1b\x1d\x76\xd8\x90\xd0\x4e\x0f\xa1\x86\x6d\x98\xe1\xd9\x85\x19\xfa\x71\xd1\xf5\xe0\x9e\xeb\x7c\xc2\xb8\x60\xfe\xd5\x4c\x31\x3d\x93\x59\x6b\x46\xaf\x31\xf9\x5b\x2e\x78\x5e\xe6\xd0\x37\xd6\xf2\x33\x9f\x87\xac\x81\xd0\x1c\xdb\x09\x7a\xf4\x14\x46\x0d\x66\x7d\x63\x59\x28\xeb\x9c\x51\x50\xd5\x75\x99\x24\x8c\xa5\x51\xcb\x7b\x50\xcc\xfe\x38\x0a\x4f\x0a\xed\x34\x5e\x76\x93\x37\xdd\xce\x7e\x84\x28\x85\x51\xfe\xf8\x65\xab\x31\x3a\x46\x79\x3e\x7f\x84\xa7\x07\x31\xdd\xdd\x5e\xe9\x64\xab\xf4\x71\x4a\x74\xb5\x51\x9e\x5a\x24\xa7\xb7\x88\x66\x0f\x11\x9c\x0d\x8a\xde\x6c\xcc\xb1\xb0\x29\x11\x9b\x0d\x44\x5f\xed\x21\xc0\xd0\x47\x84\xa6\xbf\xe8\xcc\x23\x80\x94\x3e\x4e\x54\xa6\x47\x6b\xb8\xa7\x68\xcc\xe7\x88\xc4\xf4\xf2\xd6\x5d\x23\x30\x9f\x2f\xfa\xd2\xcf\xeb\x76\xf4\x6e\x3d\x8b\x88\x4b\x0f\x5e\xad\x3e\x3d\x5a\xbd\x79\xb3\x1e\x2d\xc2\xd2\x3d\xba\xb2\x01\x91\x95\xce\x44\xe6\x82\x1b\x4e\xb3\x63\x96\xd1\xc5\x25\x4b\xa4\x48\x5b\x9f\x30\x0d\xd4\xba\xb0\x7f\x34\x0e\xeb\x6c\xb4\x7a\xfe\xf1\x8c\x3a\x70\x5e\x96\xfa\x94\x6a\xef\xfe\x73\x0a\x05\x34\x34\xc1\x59\x6e\xa4\x43\x8f\x6c\x8c\x31\x88\xc9\xd8\x7d\x2e\xe2\xdf\xe5\x0d\x91\x13\xc3\x04\xd9\xe3\xc2\xaf\xe3\x7e\x64\x06\x56\x96\x79\x60\x6b\xfb\xed\xcb\x17\xfe\xe6\xe7\x67\x72\x83\x73\x41\xeb\xc7\xf7\x80\xb8\x07\x7d\xda\x05\xe2\x6e\x9c\x94\x59\xdd\x0d\x82\xae\x91\xba\xbc\x79\x59\xc1\x8b\xbe\x84\x71\xc3\x6e\xa3\x22\x25\xae\x70\xe3\xf9\x2d\x5a\xe7\xb8\xf1\x73\x88\x19\x6f\x7d\x2f\xa4\x6f\xdf\xcb\x9a\x62\xc3\x1b\xa8\x35\x3f\xd1\x78\xf0\x56\x6b\x7e\xc0\x15\xd5\x7f\x7d\xa7\x68\xc2\xce\x7b\x57\x38\xfc\x76\x22\x69\xa9\x5c\xd9\x5e\xd0\x3b\xc2\xe6\x11\x8c\xa5\xb8\x9b\x42\x51\x1c\x54\xa3\x4d\xca\x2c\x5b\x90\xb2\x90\xa2\x5e\x79\x88\x41\xab\x66\xc1\x9a\x1d\x6d\xd5\x53\x2a\x2d\xb5\x50\xd2\x1d\xc0\xaa\x14\xc2\xca\xf3\xaa\xe1\x10\x68\xa5\x1a\x65\x75\x5c\x16\xa7\xf9\xd4\x4e\xdf\x1e\xa6\x50\x31\xc7\x73\x56\xb5\xa4\xa8\x06\xb4\xbf\x9e\x48\x95\xf0\x71\xb6\x20\x33\x9a\x85\xee\x12\x94\x5c\xf3\x2c\x73\xc3\x8c\xc8\x25\x33\xc4\xcc\xb8\x6b\x0c\x4e\x32\x29\xa6\x30\x39\x2a\x7c\x57\x33\x96\xd8\xdf\x26\x19\xa3\xa2\x2c\xf0\x79\xf6\x58\x5f\xc8\x52\xf9\xe7\x39\x58\xcb\x30\x0a\xd7\x44\xf0\x6c\x10\xf5\x4e\xba\x73\x61\xab\x06\xf5\x9a\xf9\x9a\xc2\x1b\xae\xd9\x20\x1e\xd3\x23\xf3\xea\xa8\x73\x46\xa1\xe4\x9c\xa7\xd8\xfd\xc2\x93\x0d\xba\xb4\x62\x77\x8c\xb0\x9f\x85\x14\x43\xc1\xa6\x14\xb4\x1e\xb7\x8b\x70\xcd\x70\x1c\x0c\xc5\x89\x14\xfa\x65\x58\x73\x41\x16\xb5\x52\xd6\x39\xc7\x4e\x9f\x11\xe5\xc8\x9e\x90\x44\xc2\xf1\x5a\x0a\x6e\xb0\x7b\xf4\xac\x34\x24\x95\x37\x62\x7f\x84\xa8\xc4\x5c\x13\x4a\xc6\xcc\xf8\x4e\xb6\xbe\xb3\x22\x57\x4c\x13\x26\xe8\x38\xb3\x6b\x0e\x09\x0f\x57\x2b\x09\x44\x26\x8c\x9a\x52\x31\x32\xa5\x86\xad\x54\x9a\xf0\x7d\xef\x26\x2f\xd7\xa1\xcb\x7b\x29\x34\x6b\xdd\xdf\xba\x67\x4d\xeb\x2f\x7f\x6a\x27\x23\x78\xce\x64\x69\x3e\x8b\x29\x79\x33\xe3\xc9\x2c\xd6\x8c\x79\xce\x34\x91\x65\xc3\xc6\x7e\xe9\x7e\xb6\x7a\x85\xb6\xf6\xe4\xaa\xab\xad\x97\x78\x85\x2b\xad\x59\x72\x5c\xb5\x95\xa5\x76\x03\x1e\x9f\x5d\xfe\xf3\xcd\xe1\xb7\x27\x6f\x46\xe4\x84\x26\xb3\xb8\x1e\x5d\x10\x0a\x42\x03\x04\xc5\x8c\xce\x19\xa1\xa4\x14\xfc\x97\x12\xd1\xc9\xc9\x5e\xf8\xed\x7e\xaf\x58\xc8\x2d\x4f\x5f\x68\x7d\xdd\x5b\xaf\x25\x6c\xa4\x8d\x09\x0e\x52\x33\xe8\x8e\xd0\x54\x9f\x4e\xec\x57\x68\x68\x80\xaa\x35\x63\x56\x18\xf1\xb9\x13\xc3\x0e\x5c\x9a\xa6\x21\xe5\xc2\xf2\xb9\x65\x0b\x7b\x54\xd1\x31\xa4\x4a\xcc\x18\x11\xcc\x58\xb6\x0e\x0e\x2b\x29\x74\x0d\x18\xa0\xd4\x4c\x0f\xc8\xb8\x84\xe4\x8e\x42\xf1\x9c\x2a\x9e\x2d\xe2\xc1\xec\x59\x75\x26\xbd\x39\xb4\x68\x4e\xe9\xf8\xdd\xc9\x25\x39\x7b\x77\x45\x0a\x85\x90\x01\x90\x9d\x01\xdf\xc3\x6b\x8d\x99\xfd\x85\xeb\xd1\x39\x22\x87\x62\x81\x5f\xe2\x06\xe7\x9a\x58\x5b\x88\xc1\x11\xec\x74\x48\x0f\x0a\xbf\xf3\x62\x04\xff\xdb\xb1\x6f\xa9\xac\x92\x19\x92\x4e\x92\xa5\xe4\x31\x54\x43\xf9\x38\x8b\xa8\xe9\xde\xfd\x59\x75\x5b\x0a\x69\x73\xe7\x96\x88\x51\xb7\x25\x1a\x96\x1a\xc8\x8b\xdd\xb7\xb8\x98\x66\x31\x57\xb5\x13\xfb\x5d\x6d\xcb\xae\x96\xe5\xb0\x7a\x83\xf3\xb6\x06\x66\x2f\x5d\x9f\xaa\x39\xf4\xd4\x2b\xa5\x3a\xfd\xbc\x39\xe5\x24\x82\x8c\xdb\x5f\x9e\x9e\xfb\x1d\xe0\xb4\x9b\xbc\xd1\x33\x11\x7e\x8c\x41\x8d\x01\x79\x41\xbe\x26\x1f\xc9\xd7\x60\x5e\xfd\xa5\x6b\x67\x99\xae\x86\x4f\x77\xf7\x0e\x5a\xf5\xa7\xe7\x3d\x51\xfc\x07\x2b\x9d\xec\x88\x96\xaa\x46\x92\x31\x77\xea\x3c\xfb\x68\x98\xb2\x72\xd4\xad\xc4\x5a\x7b\xf2\xd8\x09\x7e\x46\x36\xc3\xd8\xc5\xe9\x24\x6e\x09\x61\x1e\xc8\x68\xf6\xe7\x7f\x97\xda\x9c\x39\x29\x54\x6f\x30\x51\x8d\x96\x53\x93\xcc\xea\x62\xcc\x2a\x6a\xda\x54\x1b\x4c\x93\x54\x82\x27\x0d\xf3\x00\x67\xbc\x43\x26\xc6\xe6\xb0\x71\xb7\xe0\x7c\x6d\x3d\xef\x5a\xa9\x86\x03\x05\x2c\x1f\xa7\x58\x45\xf0\x32\x85\x4c\x9d\x4e\x66\xa7\x95\x46\x67\xc6\x1d\x4a\x99\xf3\xd5\x04\x97\x35\xf0\x92\xdd\x4f\x09\x15\x98\xc0\x3d\x61\x4a\x61\xea\xe6\x78\x01\x11\x64\x9e\xb0\xce\x8b\xd7\x69\x27\x15\x4a\x1a\x99\xc8\x0e\x6d\x83\xea\x01\x73\x37\x1c\x10\x01\x9d\xbf\xde\xe7\xfe\xfe\xf8\x7c\x40\xae\x8e\xce\xa1\x9b\xca\xe5\xd1\xd5\x79\xdd\x52\xd9\xb9\x3a\x3a\xdf\x59\x2b\x29\x88\xd7\xac\x5e\xd9\x69\xb6\x18\xa4\xe6\x78\xb2\x6a\xdb\x30\xa7\xc5\xf0\x9a\x2d\x5a\x9e\xa9\x7d\x9c\xeb\xc3\xb0\xc2\xbd\xbc\x10\x92\x39\xa7\xc5\x83\x47\x53\x8c\xa6\xfc\x33\x55\x51\xb8\x9d\x55\x3d\x73\x75\x39\x45\x2e\xe7\x2c\x45\x75\xd8\xff\x82\x89\xb4\x90\xdc\xea\x8b\xdb\x1a\x8b\x87\xff\x7a\x5b\x63\x71\xd7\xb5\xad\xb1\xd8\xd6\x58\x6c\x6b\x2c\xee\xbe\xb6\x35\x16\xee\x5a\xbf\x1b\x94\x6c\x6b\x2c\x5a\x5e\xcf\x2b\xce\xbf\xad\xb1\x78\xd0\xb5\xad\xb1\x58\xbe\xb6\x35\x16\xb7\x5c\xdb\x1a\x8b\x5b\xae\x6d\x8d\xc5\xb6\xc6\x62\x5b\x63\xb1\xcd\x16\xfb\xe4\x58\x9b\x99\x2d\x46\xb6\x35\x16\xee\xda\xd6\x58\x3c\x8b\x9c\x18\xb2\xad\xb1\xb8\xd7\xb5\xad\xb1\xd8\xd6\x58\xb4\xb9\xb6\x35\x16\x70\x6d\x7d\x2f\xdb\x1a\x0b\x7f\x6d\x6b\x2c\xf0\xfa\xed\x68\xcd\xdb\x1a\x8b\x6d\x8d\xc5\xb6\xc6\x62\x5b\x63\x71\xe7\x2c\xb6\x35\x16\xcf\xc1\x9e\xf4\x3d\xf0\xba\xd7\x0c\xec\x1e\xc9\xbc\x28\x0d\x23\x17\x7e\xc8\xa0\x45\xa2\x60\xe0\x3a\xd6\x08\xba\xa7\xf0\x24\x52\x4c\xf8\xd4\x49\xf6\x03\x6c\x30\x37\x0c\xef\x33\x8c\x9a\xba\x3d\xc1\xfc\x9d\x8c\xe7\xbc\x5d\x21\x07\x59\x5a\x98\x37\x30\x56\x14\xe4\xb1\x3b\x29\xa7\x1f\x61\x8b\xd0\x5c\x96\xd8\x94\x2f\x71\xeb\x17\x48\x88\xa1\xb0\x8d\x5b\x19\xd2\x8f\x89\x53\x55\xa4\x9c\xf7\x60\x6d\x14\xd4\x18\xa6\xc4\x2b\xf2\x3f\x7b\x3f\x7d\xf1\xeb\x70\xff\x9b\xbd\xbd\x1f\x5f\x0c\xff\xeb\xe7\x2f\xf6\x7e\x1a\xc1\x3f\xfe\xb0\xff\xcd\xfe\xaf\xfe\x8f\x2f\xf6\xf7\xf7\xf6\x7e\xfc\xfe\xed\x77\x57\xe7\x27\x3f\xf3\xfd\x5f\x7f\x14\x65\x7e\x8d\x7f\xfd\xba\xf7\x23\x3b\xf9\xf9\x9e\x83\xec\xef\x7f\xf3\xfb\xd6\x53\xee\xac\x12\xf7\xa7\x10\xf7\xa4\x0e\x3f\x8a\x32\xec\xa2\xc3\x3d\xed\xc5\x0b\x37\x5a\x73\x37\xba\x03\xeb\xae\xdd\xe8\xa5\x29\xa8\x79\x61\x1c\xae\x89\xcc\xb9\xb1\xca\xa1\xd5\x07\x69\x9c\x17\xc6\x4d\xcd\x28\x75\x72\x00\x12\x2a\xa9\xc1\x1e\xa1\x21\xa7\x2a\xca\xd3\x96\x5e\xf3\x73\xbd\x57\x83\xbf\x02\xf6\xf3\x30\x65\x13\x2e\x98\x8b\x83\x6d\x65\xc3\xa7\xaf\xad\x6c\x78\x8e\xb2\x41\xb3\xa4\x54\xdc\x2c\x8e\xa4\x30\xec\x63\x2b\x0f\x4b\x5d\x34\x5c\xd6\x07\x24\xb8\xcf\x5c\x15\xa5\xfb\x8e\xc8\x02\x13\x28\x1b\xe5\xac\x21\x05\x57\x95\x02\x0c\x4c\xac\x92\x61\x06\xad\x3f\xb0\x7b\x20\x27\xb2\xf9\x10\x6f\xcf\xa1\x99\xf9\x4b\xc9\xe7\x34\xb3\xd6\x6e\xf5\x8b\x73\xb0\x60\xe2\x1f\xdd\x77\xcf\x1b\xaa\xaf\xab\x0d\xcf\x86\x56\x87\x0e\x73\x3e\xf0\xaf\x04\x1f\xb1\x8f\xe6\x29\x6a\x69\xa0\x20\x9d\x2b\x3e\xe7\x19\x9b\xb2\x13\x9d\xd0\x0c\xe4\x5a\x3f\x67\xc5\xe1\x2d\xa3\xc3\xc2\x2b\x99\x69\x72\x33\x63\xd0\x5d\x99\x7a\x17\x00\x54\xb8\x4c\x29\x17\x24\xb7\x4b\x54\xf8\x1f\x6b\xf4\x25\x58\xf1\x5f\x50\x65\x17\x38\xf8\x0c\xc0\x44\x1e\x4b\x99\xb9\xd4\xe1\x6c\x51\x8d\xef\x72\xef\x85\xfc\xa7\x60\x37\xff\xb4\xa3\x69\x32\xc9\xe8\x34\xb8\x0a\x34\x33\x4b\xde\xbe\x6a\xe8\x5b\x5f\x00\xf2\x72\x4b\x46\x68\x76\x43\x17\xba\x72\x9c\xc4\x7d\xc0\x5f\x91\x97\xfb\xc0\xce\x54\x93\x30\x46\x4a\xbe\xdc\x87\x58\xe2\xd1\xe1\xf9\x3f\x2f\xff\x71\xf9\xcf\xc3\xe3\xb7\xa7\x67\xdd\x4e\x0a\xfb\xee\x8c\x8a\x56\x63\x24\xb4\xa0\x63\x9e\xf1\x2e\x07\xc4\x52\xb6\x49\x3c\x28\x1c\xc1\x69\x7a\x90\x2a\x59\x20\x9d\xbc\x8f\xaa\x3a\x29\xeb\x56\x70\x5c\x99\x0c\xcb\x33\xa9\x0f\x38\x55\x54\x98\xca\x59\x53\x91\x5c\x95\xc2\x1a\xd6\x4f\x3c\x31\x9f\xa6\xfd\x25\xe5\x1f\xa6\x29\x4b\x6b\xd4\x7b\x76\x49\x80\x47\xfe\xe5\x16\x55\x8d\x36\x39\x7f\x77\x79\xfa\xff\x34\xd8\x70\x51\x74\xcb\x79\xea\xa7\x2e\x4c\xc9\xa2\xb7\xd5\xbd\x70\x75\x47\xdb\xf5\xdd\x88\xf5\x0d\x67\x55\x3f\x91\xf6\x8b\x52\xd4\x61\x3c\xaa\xf1\x49\x2e\x53\x36\x22\xe7\xc1\x4b\x5f\xff\x36\x2a\xef\xa5\x8a\x11\x7b\x8b\x30\x9c\x66\xd9\x22\x56\x90\x8c\xc4\x62\x9a\x5a\x65\x72\x2c\x87\x27\x34\xd3\x1d\x85\x69\x97\x93\xc9\x1e\xc2\x6f\xad\x31\xd9\x0b\x35\xc3\x68\x24\x65\x42\x1a\xa7\x95\xda\x59\x42\xb1\xb6\x92\x09\x41\xcb\x35\x4a\x8b\xaa\x9d\x2e\x1a\x1d\xfd\xfe\x60\xe2\xda\xd3\xea\x3c\x8c\x8c\x5e\xde\x52\xb3\xa6\x76\xeb\x0e\xa6\xca\x96\xb5\xa3\x2b\x46\x53\xa8\x49\x2b\xa8\x99\x61\x56\x43\x4e\xf5\x35\x4b\xf1\x03\xa7\xd7\x04\x37\xbf\x1d\x31\x3c\xea\xca\xce\xdb\xfb\xf4\x41\x9f\xc1\x5c\x0b\x88\x05\xb4\x03\xdd\x20\x7d\x6c\x01\xfb\x4e\xef\x44\xb6\xb8\x90\xd2\xbc\x0e\xb5\x58\xbd\x2c\xe0\x0f\x4e\x53\xac\xbb\x61\x41\x95\x82\x24\x84\x74\x08\xc4\x04\x96\x8e\xcb\xc0\x8e\xab\x05\x5b\x33\x43\xab\x52\x1c\xea\xef\x94\x2c\x5b\x9f\x00\x4b\x8a\xd6\x77\xa7\xc7\xb0\x8f\x4b\x17\x65\x13\x46\x2d\xa0\xea\x74\x19\x30\x28\xe8\xb4\xef\x5d\x9c\x30\xe6\xc8\x2a\xa4\x43\xde\xd2\x05\xa1\x99\x96\x5e\x39\xe6\x62\xa5\x01\xe5\xac\x33\xfb\xf5\x58\x9a\xd9\x92\x59\x66\xd9\x79\xf9\x77\x83\x28\xe8\x56\x21\x18\x71\xb1\xf4\x73\x43\xaf\x99\x26\x85\x62\x09\x4b\x99\x48\x3a\xae\xda\xba\x43\x4d\xb0\xf2\x67\x52\xd8\x6d\xd1\xcb\xda\x9f\x86\x18\x23\x38\xc2\xea\x2b\x0d\xd1\x4a\x67\x77\x50\x88\x59\xc2\xa6\x28\x35\x53\x18\x60\x55\x25\xc3\x85\xf8\xbe\x1c\xb3\x8c\x19\x34\x86\x00\x77\x82\x1a\x34\xa4\x79\x4e\xa7\x8c\x50\x13\x18\xc5\x48\xc2\x84\xb6\xe2\x06\x5d\x6f\x86\xa4\x92\x55\x05\x94\x54\x93\xf7\xa7\xc7\xe4\x05\xd9\xb3\xcf\xda\x87\xe5\x9f\x50\x9e\x41\x38\xd3\x50\xd5\x9c\x23\x9f\xf8\x21\x60\x4a\xc0\x7b\x44\x2a\xdc\xa2\x03\x22\x24\xd1\x65\x32\xf3\x73\xb2\x16\x97\x37\xd8\x5c\x3e\x1f\x38\xf5\x9f\x21\xab\x76\x16\x30\xef\x35\x53\xbd\xc9\x97\xf7\x2d\xe4\x4b\xac\x42\x58\x9e\xab\x53\x0f\x19\x2b\x67\x86\xa6\xd4\x50\x27\x77\xaa\xaa\xeb\xe7\xb8\xa4\xeb\x96\x3e\x9a\xbd\xe1\xa2\xfc\x88\x19\x2b\xfd\x19\xf9\x97\x27\x30\x2c\x49\x3c\xd1\x60\xd1\x68\x51\x64\x1c\xeb\x9d\x1b\x19\x54\xa7\xb5\xa5\x1e\xdc\xa2\x22\xc1\x36\xa7\x59\x26\xad\x78\xb3\x27\x3b\x15\xa9\xcc\x97\x1e\x66\x15\x28\x56\x03\xba\x1b\x91\x67\xc9\x3c\x6b\x77\x47\x64\x6c\xce\x3a\x60\xba\x34\x71\xf9\xec\x68\x56\x17\xf3\x0b\x0a\xc3\x93\x8c\x8e\x59\x86\x27\x0b\x32\x90\x5e\x66\xa0\x75\x67\x21\x2a\x99\xf5\x57\x83\x71\x21\x33\x86\x69\x3d\x9e\x10\x76\xf8\x27\x41\x07\x18\xa4\x2f\x3a\x80\x21\x53\xa3\x03\x98\x64\x4f\x81\x0e\x65\x87\x83\x96\x34\xe9\x60\x4f\xed\x3a\x1d\xe0\xe8\xdc\x74\x3a\x68\x96\x24\x32\x2f\xce\x95\xb4\x26\x57\x6f\x47\x8b\x1b\xb6\x8a\x15\xa1\x4d\xbe\x22\x09\x07\x44\x79\xfd\x66\xaa\xa2\x84\x3e\x6a\x50\xc6\xfb\xac\xbe\xff\x5f\xdc\x21\xd9\x8a\x9e\xe6\x39\xe4\x47\xa9\x85\x95\xec\x2f\xdd\x17\x4f\x1c\x4e\xa0\x83\x97\xac\x97\xc3\x44\x26\x34\x03\xc8\xbd\x6e\x1c\x43\x9a\x5c\xd3\x1c\x38\xca\xc2\x84\xd0\x12\x7c\xe6\xe3\xfe\x80\xbe\x06\x9f\x38\xdf\x97\x90\x29\x8b\x42\x90\x98\x3e\x7a\x85\xd9\x7a\x70\x9f\x4f\x00\xb5\xa7\xba\x8f\x06\xa6\xb5\x5f\x1b\xe9\x10\x60\xde\x06\x20\x3f\x3b\x41\x26\x52\x2e\xa6\xe0\xd1\x19\x10\xc5\x32\x4c\x1d\x75\x7b\xf8\x1a\xcd\xaf\x5d\xe0\x68\x3f\xa8\x67\x67\xff\x68\xd0\x84\xb8\x14\x6e\x64\x70\x72\x78\xfd\x66\x82\xd2\x92\x6b\xb2\xf3\xc6\x13\xa0\x03\xf2\xd9\x26\x1e\x10\x3b\xf8\x86\x61\x35\xd1\xc7\x76\xcd\x45\xea\xb2\x2c\x6b\xc4\x0a\x18\xb5\xa8\x85\x42\xfe\x2e\x4f\x63\xd1\xf0\x8a\xfc\x24\x48\x20\x16\x19\xb6\x66\x8f\x0b\x54\x58\xbd\x7b\x69\x78\xb7\xcb\x2f\x3c\xa4\x39\xcc\x7b\x01\x6b\x6f\x9f\x3b\xb4\x66\xef\xf2\x7d\xfe\x5d\x76\xd6\xb9\xae\x37\x5c\xa4\xf2\x46\xf7\x6d\x43\xfc\x80\xc3\x7a\x85\x3a\xb1\x6c\x6d\xb8\x98\xea\xd8\x8e\xa0\x59\x56\x73\xc3\xae\x32\x24\xfc\x0a\x07\x44\xe2\x65\x05\xbe\x91\x1d\xbe\x35\x02\x1e\x70\x4d\x73\x4d\x8f\x94\x7d\x15\xc3\x69\x76\x59\xb4\x87\x66\x23\x4d\x36\xf8\xee\xed\xe5\x61\x7d\x68\x2b\xcf\x6e\x00\xf1\xda\x12\xdb\x7e\x4f\x68\x9a\x73\xad\xc1\x0d\xc4\xc6\x33\x29\xaf\xc9\x9e\x4f\xdb\x98\x72\x33\x2b\xc7\xa3\x44\xe6\x51\x06\xc7\x50\xf3\xa9\x3e\x70\x4c\x3b\xb4\xb3\xdf\x27\x5c\x64\x21\x1b\x05\xcc\x48\x61\xb4\x77\x63\xc0\x43\x92\x30\x0b\x58\x5b\x87\xd7\xe9\xa2\xcc\xcb\xd3\x44\x84\x4e\xce\xb2\xf5\x03\xce\x2c\x2f\xcf\x59\x47\xec\x8c\x4f\x2c\x11\xbc\xbb\x2b\x4d\x89\xcb\xa8\x56\xd2\x11\xb5\xb7\xb5\x13\xc9\x69\x07\x09\xd3\xfd\xa1\xf2\xfc\xbd\x1a\x93\xa4\x0c\xab\x27\x18\x64\x9d\xd0\x5b\x93\x9b\xc0\x2b\xbb\x0b\x45\x78\xee\xa7\xbb\xb1\x46\x0b\x51\x1f\x2c\xf3\xb0\xf6\x40\x56\xcc\xe8\x10\x8d\x64\x2b\x92\x40\x86\x79\x1d\x60\x26\x85\x74\xc9\xe9\xf6\x14\x94\x02\x58\x1a\xac\x05\x0c\x04\xc1\x9a\x38\x19\x1b\x4d\xf5\xa8\x8a\x0f\xc6\x31\x24\x28\xe2\x41\x10\x80\x6a\x0e\x37\xdc\xcc\x3c\xc2\x7d\x2d\xe0\x04\x33\x51\x4c\x43\xf4\x40\x10\xa6\x94\x54\x2e\x11\xc6\x7b\x6d\x61\x24\x10\xc5\x90\x49\x63\x99\x84\xda\xbf\x76\x75\x1c\xa2\xac\x20\x70\x21\x4f\xcc\x72\x13\x9b\x4c\x58\x02\x9a\x52\x4c\x60\x14\xbb\x7b\x15\x72\x9f\xcb\xee\xb6\x0c\xe6\x20\x74\x73\xfe\xd1\x3e\x25\xfe\x55\x1c\x0c\x75\x88\x79\xab\xbf\xde\x1f\x11\x72\x2a\x42\xe6\xe4\xc0\xae\x62\x7c\xa7\x4f\xf9\x31\xf6\x15\x63\xfc\x65\x78\x81\xd8\xef\x64\xd5\x3b\x55\xf6\xc0\xf1\x5d\x9c\xc1\x24\x76\x08\xf7\x2a\x0e\xc0\x31\xec\x06\xb5\x4b\xef\x0f\xf1\x2e\x8e\x62\x7b\xcb\x63\x39\x8b\x9f\xc6\x49\x4f\xba\xca\x39\x57\x12\xdf\x13\x28\xee\x65\x34\x5a\xa4\x7e\x87\x70\xd3\xb9\x4c\x11\x12\x23\x94\xf4\x43\x2f\x0b\x80\xe8\xe0\xff\xf1\x0a\x56\xa5\xa4\x09\x89\x59\xd9\x31\x56\x86\xc3\x04\x4d\x89\xd5\x95\x33\x6f\xdb\xe7\x45\xc6\xa0\x7a\x2e\x1a\xb9\x2a\x0c\x8c\x50\x74\x07\x61\x22\x15\x10\xaf\x43\xe8\x18\x90\x7f\xc3\xa6\x0c\x09\x80\x1e\x3c\xe0\x3c\xfc\x1c\x4d\x3c\xae\x3d\xa4\x36\x54\xb6\x19\xe9\x5d\x07\x24\xe5\x93\x09\xf3\x89\x86\xd6\xf4\xa3\x8a\xe6\x56\xc4\x6b\xe2\x48\x30\x66\x53\x8e\x99\x6c\x41\xb0\xed\xea\xaa\x02\x7e\x80\xc2\x90\x1b\x92\xf3\xe9\x0c\x19\x85\x50\xa8\x8c\x24\x3e\xa4\x96\x49\x9a\x12\xe0\x6d\xa9\xc8\x0d\x55\xb9\x3d\x37\x68\x32\x83\xf8\x1c\x15\x24\x2d\x15\xc0\x44\x1a\x46\xd3\xc5\x50\x1b\x6a\xac\xaa\xcb\x94\xb3\x08\xfd\xfc\xb7\x50\xc2\x77\x5e\x5b\x28\xe1\xdb\xaf\x2d\x94\xf0\x16\x4a\x78\x0b\x25\x7c\xf7\xb5\x85\x12\x76\xd7\xfa\xab\x7d\xc9\x16\x4a\xb8\xe5\xf5\xbc\xe0\x6c\xb6\x50\xc2\x0f\xba\xb6\x50\xc2\xcb\xd7\x16\x4a\xf8\x96\x6b\x0b\x25\x7c\xcb\xb5\x85\x12\xde\x42\x09\x6f\xa1\x84\xb7\xa0\x68\x9f\x1c\x6b\x33\x41\xd1\xc8\x16\x4a\xd8\x5d\x5b\x28\xe1\x67\x01\xfd\x44\xb6\x50\xc2\xf7\xba\xb6\x50\xc2\x5b\x28\xe1\x36\xd7\x16\x4a\x18\xae\xad\xef\x65\x0b\x25\xec\xaf\x2d\x94\x30\x5e\xbf\x1d\xad\x79\x0b\x25\xbc\x85\x12\xde\x42\x09\x6f\xa1\x84\xef\x9c\xc5\x16\x4a\xf8\x39\xd8\x93\xda\xa4\xbc\x15\xf2\xd9\x7d\x80\x2a\x5c\x66\x48\x54\xda\x3a\x2e\x27\x13\xa6\x40\x72\xc1\x93\x97\xb2\x10\x02\xa0\x55\x90\x65\x2e\xcf\x00\x60\xf1\x14\xa3\xa9\x4b\x78\xbf\xe5\xe7\xae\x96\x16\x10\xca\xaa\x4c\xcd\x93\x77\xaf\xfb\x41\xc5\xe8\x96\xa3\x08\x73\x7e\x27\x92\xee\xb9\x6a\x15\xc1\x57\x15\x60\x38\xba\x27\x99\xd4\x2e\xc3\x14\x88\x95\xcc\xa8\x10\xcc\x1b\x8f\xdc\x80\x53\x66\xcc\x98\x20\xb2\x60\x02\xe5\x37\x25\x9a\x8b\x69\xc6\x08\x35\x86\x26\xb3\x91\x7d\x92\xf0\xc4\xae\xb2\x41\xdd\x27\xda\x28\x46\x73\x9f\x17\x9b\x53\x8e\x43\x11\x9a\x28\xa9\x35\xc9\xcb\xcc\xf0\x22\x0c\x46\x34\x83\x84\x76\x3c\xa8\x02\x31\x20\xbd\xa4\x4a\x21\x1d\x54\x4f\x73\xd3\x92\x31\x28\x10\x98\xae\x03\xc0\x41\xcd\x0b\xb3\x08\x79\x74\x8c\x4c\xb8\xd2\x86\x24\x19\x87\xd3\x1a\x9e\x88\xb5\x83\x30\xde\xc0\x9f\xd5\xc2\xcd\x54\xbb\xa9\x8a\x14\xd4\xd6\xc2\x68\xcc\x4a\xab\x06\x74\x43\xa5\x5c\x3b\x35\x5f\x0f\x08\xf5\x90\x37\x48\x68\x3f\x53\x20\xb5\x3f\x59\x70\x74\xf7\x51\x34\x5c\x84\x93\x57\xa5\xed\x55\x8c\x0e\x29\xc6\x9e\x39\x07\xb5\x6c\xea\x4a\xa1\x80\x74\x97\xa5\x6d\x00\x0b\x20\xd8\xdc\xf2\x00\x4b\x98\x3d\x5f\xe9\x2d\x5c\xff\xd9\x99\x3e\x3a\x14\xdf\x32\xad\xe9\x94\x9d\xb7\x8c\x5a\xdc\x66\x91\x41\xe0\xa2\x5a\x18\x60\x85\x0c\xcb\xd3\xc2\x27\x55\x9a\x53\x5d\x0d\x22\x39\xce\x29\x28\x3f\x37\x8a\x1b\xc3\x60\x51\x01\x1c\x09\x02\x9f\xcd\x02\xd4\xdd\x46\xb2\xd4\x5b\x3f\x48\xf5\x63\x2b\xd4\x45\x8a\xa9\x4b\x63\x46\xc6\x8a\xb3\x09\x99\x70\xc8\x87\x82\x0c\xa5\x01\xc2\x7d\x50\xf4\x28\x68\x6d\xed\x5d\x29\xbc\x2e\xeb\xe7\x35\x22\x3f\xb8\x89\x19\x55\x8a\x84\x46\x20\x80\x50\xa2\xc5\x27\x64\x0a\x19\x4e\x4e\x5b\xfc\xd3\x8b\xff\xfa\x0b\x19\x2f\xec\x91\x06\x9a\x95\x91\x86\x66\xe1\x25\x33\x26\xa6\x96\x56\xb8\x3d\xeb\x45\x46\x81\x02\x80\x62\x8e\x13\x7f\xf9\xe5\xf5\xb8\x7e\xc6\x1e\xa4\x6c\x7e\x10\xd1\x6f\x98\xc9\xe9\x2a\x5c\xf8\xf6\x29\x93\x2d\x4d\xa2\x15\x6c\x26\x33\x9e\x2c\x3a\x33\x9a\xc7\x9d\x21\x33\x79\x83\xba\xfe\x0a\xee\xa9\x72\x20\x0b\x59\x94\x19\x7a\xb0\x5f\x87\xf2\xbc\x52\xb3\xe5\x1a\x9c\x95\xfb\x02\x7c\xae\x6e\x88\x26\x5e\x2c\x26\xb6\xf9\x47\x4a\x97\xdb\xed\xbc\x82\x01\x7e\x06\x0c\xa1\xd7\x34\xcb\xc6\x34\xb9\xbe\x92\x6f\xe4\x54\xbf\x13\x27\x4a\x49\x55\x9f\x4b\x46\xad\xb4\x9c\x95\xe2\x1a\x91\xab\x43\x89\xb0\x9c\x5a\xd5\xaa\x28\x8d\x4f\x24\x5e\xf5\xc2\x58\x70\xea\x85\xb0\x37\x83\xaa\x51\xd8\x47\x5e\xd9\x3a\xae\x54\x02\x39\x32\x1e\x5f\xc7\xcc\xf6\xe5\x8b\x3f\x7d\x85\xac\x4b\xa4\x22\x5f\xbd\x80\xe4\x47\x3d\xc0\x4d\x0c\xb2\xcd\x1e\x14\x39\xcd\x32\x6b\x36\xc4\x4c\x69\x09\xbd\x8a\x09\x3f\x3b\x0f\x9a\xee\xec\x76\x6f\x55\xea\xea\xea\x1f\xa0\x47\x71\xa3\x59\x36\x19\x60\x55\x40\x30\x6b\x76\xe1\x60\xd8\x75\xd2\x07\x4a\x33\x36\x40\x01\x9a\xcb\xac\xcc\xd9\x31\x9b\xf3\x3e\x9a\x57\xd4\x46\xf3\xa6\x7e\xc6\x35\x14\x60\x8c\x33\x99\x5c\x93\xd4\x7d\x19\xa5\xb1\x34\x21\x54\xdb\x53\xa1\x6d\x42\x4f\x87\x44\x9e\x5b\xdf\xbf\x96\xc2\x93\xd3\xa2\x08\x39\xfa\x8a\xde\xd4\x88\x01\x7b\x12\xea\x7d\x3b\xe2\x29\x74\x76\x33\x77\x75\x32\x0f\xdd\x1b\x59\xb9\xd9\x7a\x88\xd6\x29\x2c\xdd\x7d\xd4\xd5\xec\xdb\x3b\x26\x6b\x0c\x51\x0d\xe8\x77\x43\x01\xff\xc6\xf4\xec\xa5\xaa\xa4\x50\xd8\x12\x18\x03\x15\x00\xcb\x3e\x20\x92\xdb\x3b\x5c\x7b\xf0\x6e\x76\xcb\x5f\xaa\xd1\x45\x04\xaf\x72\x4e\x8d\x53\x08\xbd\xfb\x9a\x92\x82\x29\xcd\xb5\x3d\x97\x3f\xc0\x86\x3a\xca\x28\xcf\x23\x17\xe0\x7a\x88\x80\x9b\x1b\x90\x2f\xbb\x4b\xca\x73\x99\xba\x01\x41\x14\x22\xea\xe7\x0a\xb5\xb6\xae\xd5\xf6\x78\xa0\xae\x5b\x54\x7e\xa8\xa8\x59\x97\x94\xf6\x93\x20\x2a\xf1\xae\xe7\x24\x20\xe1\xfd\x9e\xaa\x7c\x0c\x93\xef\x49\x0c\x80\x60\x74\x8b\x5b\x97\x84\x35\xe3\x11\x37\x4a\xa4\xd2\x3b\x3b\x70\x44\x30\xa4\x6e\xf7\x84\xfb\x29\xd9\x7d\xb5\xbb\x56\x21\x89\x24\x52\xb2\xa0\xd3\x4e\x3d\x0c\x1a\x94\x6a\x0e\x1b\x17\x7a\x5b\x33\x08\xbe\x0f\xb0\x43\x70\x17\x4b\x2b\x20\x0a\x80\x19\xc1\xe8\xa8\x27\xb0\x33\x10\xb0\x1e\xf2\x86\x2e\x08\x55\xb2\x14\xa9\xf3\x2f\x05\x07\xdf\xdb\xc6\x83\xcf\xa4\x60\xde\x71\xde\xac\x13\x07\x8f\x3e\x17\xe4\xe5\xe8\xe5\x8b\xe7\x72\x52\xc1\x1b\x36\x4e\xaa\xb3\x70\x52\xa1\x7c\x5a\xeb\xbb\x7a\xb4\xe3\x9e\xde\xf7\xad\x73\xb1\x54\x60\xc6\xdc\x83\xb5\xc2\x47\x37\x8a\x1b\x16\xf5\x36\xda\x03\xc3\xc5\xda\x87\x51\x55\xf4\x7e\x8f\x18\xde\xfd\x94\xa1\xeb\x72\xfc\x88\x72\xcb\x09\x28\xd8\x6e\xab\x3c\x5c\xfa\x0e\x11\x16\x13\x6a\x67\x87\xec\xe1\x9d\xbb\x58\x19\xb8\xbf\x56\xd6\x72\x44\x3b\xf9\x58\x74\xc0\x98\xab\x11\xee\xe4\x63\x41\xc1\x07\x57\xf4\x48\xc1\x6f\xd9\x8c\xce\x19\x54\x44\xf2\x8c\xaa\x0c\x62\x8e\x97\x38\x77\x32\x2e\x0d\x61\x62\xce\x95\x14\x90\xe0\x33\xa7\x8a\x03\x2a\x85\x62\x50\x59\x6d\x6d\xd1\xdf\xef\x7d\x38\xbc\x80\x84\x86\x7d\x57\x12\xee\x66\x59\x6a\x0f\x1f\x11\xcf\x24\x1a\xee\x93\xcb\xe7\xe7\x61\x69\x08\x32\xd7\xcf\xcb\x3e\x27\x2f\x4d\x89\x80\xf8\x1f\x93\xac\xd4\x7c\xbe\x2e\x49\xe2\x4a\x55\x8f\x79\xab\x75\x6e\x94\xcd\x56\x84\x5a\xaa\x80\x05\xd7\x3a\x1c\x2d\x9f\x40\x60\xdd\xd5\x01\xb3\x2a\x8e\x81\x3b\xd7\x93\xab\x65\xc7\x5c\x3c\x0f\x59\xb6\xa4\x42\x00\x6e\xc3\x7a\x9d\x50\x42\xa6\xec\xe1\xa8\x17\xf5\xf4\x1e\x37\x04\xc6\xcc\xa3\x72\x40\x9d\xcc\x58\x5a\x02\xbc\x0a\xd7\x08\x0e\x68\xcd\x07\x5a\xc1\x58\x09\xe8\xcf\x70\x3a\x09\xb5\xc1\x62\x08\xce\x41\xa4\xb9\xff\xbd\xf2\x95\xc4\xfe\x03\xdd\x18\x11\x8c\x52\x3b\xd6\x80\x50\xad\xcb\x1c\xb7\x04\xc2\x6f\x4f\xb8\xd1\xa1\xb7\x9e\xd7\x8e\xed\xc6\x78\x60\x75\x56\x07\xfa\x5e\xb2\x0c\x98\xab\x03\x8d\x77\xcf\xa2\x71\x90\xd0\xda\xff\xe5\x18\xce\x25\x4c\x40\xb4\x2d\x24\x85\x4a\xf0\x92\x4e\x38\xb4\xaf\xa0\x8e\xde\x97\x2b\x7e\x89\xaa\x03\xde\x01\xf0\x0c\x74\xcc\x32\xdd\x1c\x68\x5c\x2d\x8a\x83\xf5\x73\x84\xef\xd8\x1d\x90\x6a\xcd\xa7\x02\xfa\x86\xd9\xd1\x1e\xd8\x21\xac\xb5\xcd\xd4\x47\xf7\xbf\xd6\x52\xad\x96\x85\x95\xd3\x62\xe8\xac\x5e\x23\x73\x9e\x3c\x60\x24\x39\x67\x6a\xc6\xe8\x03\x0d\xbe\x46\x5c\xcc\x8d\x51\xb5\x8e\xd1\xae\xca\xd1\xed\x1b\xff\x10\xbb\xbf\x64\x02\xd9\xf6\xe8\xa7\xf7\xd9\x4f\x14\x58\x04\x1b\x53\x4e\xf9\x9c\x09\x0f\xfc\x77\x94\xd1\xd0\x7c\xcc\x43\x25\x39\xf0\xc1\xd2\xc8\x10\xf9\xb0\xe6\x54\x85\x5e\x06\x81\x52\xe7\x74\x8d\xc7\x89\x6e\x71\xad\xcb\x32\x0f\xd6\x7f\x9f\x3b\x21\xf8\x80\xad\x3f\x06\xd5\x2b\xe5\xde\x40\xab\xc1\xe3\x90\x04\x42\x64\xa1\x2b\x28\x06\x22\x3e\xfd\x08\x17\xd7\xb6\xbc\xb8\x6a\x18\xb7\xe7\x02\x8e\x53\x45\xd8\xcc\x2a\xaf\x0b\x02\xcd\x25\x4e\x27\xf5\x27\xf1\x1a\xf4\x25\x64\x63\xc3\x1e\xae\x0e\x95\x73\x99\x5e\x16\x2c\x19\x90\xb0\x94\x71\xe7\x36\xe7\xb4\xc1\xd4\x96\x08\xbf\x11\x8f\x23\xa5\x98\x2e\x24\x22\x70\xc6\x8f\x8d\x1b\x84\x72\x53\x8b\xd8\x63\x23\x02\xb0\xd0\x2a\xac\x84\xff\x30\x25\x57\x0a\x82\x29\x37\xa3\xeb\xaf\x40\x0a\x30\x31\xa3\x22\x41\x01\x7c\x70\xcd\x0a\x7d\xa0\xf9\x14\x37\xfd\x5f\xbe\xfa\x0a\x24\x80\x27\xc9\xc1\xc5\xc9\xe1\xf1\xdb\x93\x51\x9e\xae\x30\xe2\x3c\xd6\x17\x84\xc5\xbe\x0f\x1b\x89\xcc\x5f\x8e\x5e\x7e\x85\x71\x7b\xae\x11\x80\x24\x82\xff\xc2\x9a\xb4\x65\xec\xaf\x73\x99\x06\xba\xb9\xbc\xad\x07\x46\x23\xd7\x2a\x83\x9e\x4c\xdf\xd1\x96\x19\xb5\x5d\xb3\x68\x3b\x65\xce\xf6\x98\x2d\x5b\x28\x66\xd5\x1b\x2e\x45\x9b\x38\x73\xdd\xbe\x6b\x0c\xe5\x9d\xf7\xee\x2f\x2b\x88\xfd\xd3\xc4\xd4\xca\x66\x8d\xf2\x3a\x93\x37\x90\x1b\xc2\xa5\xe2\x66\x31\x02\xa8\x1e\x39\x21\x67\x6c\xce\xd4\xc0\x8f\xfa\xc6\xde\x74\x1e\xee\x89\x0d\x88\x55\x77\x44\x5d\x71\x6e\xdb\xa8\x03\x32\x8d\xd3\x10\xce\xa4\x38\x0f\xb3\x0b\xc3\xb8\x9d\x37\x84\x8c\xc9\xcf\xa1\x9c\x79\x32\x74\x58\x07\x44\x3f\x76\x2f\xe0\x1a\x25\x7f\xa0\x8a\xcb\x52\x13\xf4\x89\xc7\x98\x83\x18\x47\x0f\x24\x02\xd5\xcc\x79\xb9\xc2\x20\x21\x3d\xde\x3b\xba\x02\x7d\x0e\xc3\x79\x73\xb4\xfa\x48\xe3\xc6\x2e\xfa\xdc\x3f\x4a\xf9\x7c\x9f\x25\xb8\x42\x3c\xcc\x56\x9e\x5f\xfe\x44\xd6\xf1\x44\x61\x18\x3f\x0f\x38\x1b\xac\xc2\x8f\xa3\xcc\xf8\xd4\x67\x95\xc1\xfb\xa3\xee\x1e\x7d\x1a\x98\xad\xc5\x92\xb6\xd9\xf1\x6d\x93\x1b\x8b\xe6\xfb\x75\x60\x8b\x3a\xa8\x5b\x8c\x0b\xe7\xd3\xaa\xab\x2d\xb8\x83\x7c\x02\x87\xe0\x30\x51\xdc\xf0\x84\x66\x3b\x70\x84\xf9\xaf\xac\xed\x6d\x98\x8a\xbf\x55\x8c\x98\x1b\x89\x4f\xa1\x19\xb9\x66\x8b\x1b\xa9\x52\xaf\x5f\xf8\x27\x56\x6b\xa1\x8d\x7f\x24\x67\x4e\x16\x20\x24\x97\xca\x99\x22\x63\xe6\xdd\x08\x8d\x9b\x17\x23\x72\x28\x16\xce\x07\x2b\xe2\x4a\x0b\xaf\x46\x8c\x17\xa8\xe3\xa0\x16\x58\x63\x12\x77\x1e\xfa\xa7\x51\xac\x81\xb9\xcd\xc4\xb6\x0a\x64\xd8\x05\x5e\x7b\xf1\x36\xb6\x54\x2e\xd5\x1b\x76\x87\xc2\x44\x75\xe9\xbf\xfe\x2c\xd2\xc2\xea\x67\x5c\x30\xad\xbf\xb3\x4b\xd9\x45\xdd\xae\x73\x07\x05\xb5\xca\x8d\x0d\x72\xb2\xca\xab\x62\x76\x4b\x51\xdf\xf3\xdd\x52\x28\xdc\x39\x22\x87\xf0\x01\x24\x06\x5a\xcd\x11\x4a\x09\xec\x60\xd6\xe2\x6d\xf4\x36\xc4\x3b\x0e\xcf\x8e\x7d\x02\x17\x2a\x1d\xba\x8e\xd9\x88\x2a\x7f\x7d\x26\xa0\xa9\xba\x34\x22\xf6\x4b\x49\xa1\x55\xd5\xce\x95\x2a\xd9\x4e\x3b\x55\x0f\xd1\x40\x0f\xfe\xfc\xd5\x0b\xd0\xf6\xc2\xf3\x40\xec\x3f\x30\x2b\xba\x6d\xa0\xb0\x55\x88\xb0\x19\x1d\xbd\x88\xf9\xc1\x13\xdc\xdb\x4d\xce\x73\x07\x05\x4e\xb0\x4c\x81\xe6\xad\xec\xcb\x56\x01\xc1\xf6\xa1\xc0\x61\x35\xdd\xab\x87\x77\x42\xe8\x12\xc5\xab\x3d\xb7\x0f\x57\x5f\x35\x1a\xae\x0a\x36\x5a\xae\x9e\x13\x05\xed\x11\x6f\xd7\x7d\x0c\x39\x41\x20\xb8\xc0\x35\x02\xe2\x71\x51\x74\xc8\x7f\x6a\x21\x6f\x20\xdd\xb7\xb3\xaa\xb8\x7b\x81\x03\x91\xa2\xd2\x0d\x97\xd2\x38\x83\x9b\x1b\xf4\x0e\xa7\x15\x1e\x42\x4e\xfc\x80\xbc\x13\xaf\x31\x49\x70\x80\x8a\x62\xad\x9e\x1b\x6f\xea\xb5\x56\xe2\xe0\x77\xee\xdd\x87\x38\xe5\x36\x42\xe1\xe1\xe4\x8e\x2c\xdf\x8e\xa7\xff\xee\x45\x63\xac\x1a\xeb\xd5\x2c\x7b\x77\x42\xf2\xaa\x83\x82\x13\x9f\x64\xaa\x64\x59\x78\x77\x72\xbd\x1d\x42\x05\x21\x8c\x5e\x50\xec\xd5\x25\x64\x7d\xe8\xe0\xbc\x01\x06\x66\x15\x0a\x79\x4a\x12\x34\xef\xfd\xa9\x8b\xa9\xe0\xe8\x01\x51\xa5\xa8\xb7\x1a\x8c\x1c\xb2\x3b\x19\x9b\xd2\x64\xb1\x53\x7f\xce\x2a\xf7\x37\x87\x0c\x65\x9e\x23\xfc\x22\x3e\xaf\x4a\xec\x84\xfc\x4f\xd0\x1f\x70\xaf\x81\x6a\x10\xfa\xc1\xfa\x23\xdf\x57\x26\xcc\xa8\x48\xc1\xcd\xd3\xda\xb3\xf0\xe7\xaf\xfe\x3c\x74\xa3\x0d\x71\x2a\xcd\x1a\x2f\x57\xab\xb5\xd2\x9d\xf0\xa7\x56\xae\x80\x07\xf3\x5f\xf0\x76\xf7\xaa\x7a\xd6\x96\xd8\xea\x53\x5c\x17\xc0\x0e\xe8\x0f\x09\xa7\x7f\x78\xfa\x5d\xda\xd9\xea\x41\x42\x0f\xfa\x30\xc4\x67\x21\x57\x1d\x5f\xba\xcb\x6e\x6d\x42\x55\xcf\x64\x96\xc2\xbe\x72\x4e\x26\xff\x28\x42\x8d\x51\x7c\x5c\x42\xb7\x1c\x91\x02\xd4\x69\xbd\xce\xc4\x75\xe5\x18\x91\xaa\xd0\x20\x36\x9e\x81\xf1\x47\x84\x5c\x32\xd7\x52\x39\x9a\x07\x88\x65\x4f\x4a\x30\xa4\x80\x17\xa1\x79\x1f\xda\x6c\x9f\xc9\x21\xd5\xfe\xf4\x9e\xb4\xed\xda\x5b\xef\x64\x73\x18\x8c\x1a\x5d\x16\x0e\xf5\x80\x66\x28\x0f\x97\x7a\x7c\x35\x0e\x32\x48\xc1\x02\x89\x78\x29\xf3\x10\xb3\xb5\xb4\xd0\x08\xd2\x07\xbc\xec\xfb\xb9\x1a\x09\xf5\x4d\x53\xe7\x8a\xbd\xb1\x43\xcc\x78\x81\x26\x38\x35\xe1\xe7\x10\xf8\xb0\x5f\xc7\xe5\xaa\xd0\x21\xe7\x25\xda\xc0\xf2\x06\x9c\xe0\xdf\x9d\x1e\x87\x3d\x62\xef\x7a\x7d\x09\x04\x21\x5f\x8e\x5c\xbb\x2e\x33\xe5\x29\x19\x63\xb0\xcb\x8a\xd7\x3d\xc1\x6e\x30\x7d\xce\xf9\x89\x83\x1a\x3e\xf7\x69\x65\x38\x5a\x78\xb8\x1b\x72\x9f\xfc\xd1\x75\x5e\x62\xca\x1b\xf1\x63\xee\xd2\x6b\xde\x5d\xec\x7a\xef\xfc\xcd\x50\xdd\x0c\x87\xc3\xa1\x9d\xab\x17\xea\x2b\x7a\xda\xda\xfd\x9e\xcb\x94\x4f\x16\x0d\x4a\x58\x36\xaf\x1e\x01\x1c\x49\xc5\xc2\xcd\xae\x45\xab\x9d\x6e\x7e\xbc\x2e\x15\xa8\x8e\x39\x8f\x60\xbd\xdb\x96\x79\xd4\xe5\xc6\x8a\x21\x9d\x55\xac\xc9\x98\xcd\xe8\x9c\x4b\x28\x4c\x05\x1e\x83\x84\xc1\x5b\xe8\xea\x7d\x3e\x6e\xd1\x1d\xd6\x11\xda\xe4\xec\x63\x21\x11\x49\x11\x12\x72\xa1\x49\x44\x33\x98\x02\x6e\x6e\xbb\x29\x20\xaf\xa0\xc6\xf4\x4e\x7d\x40\x34\x11\x4f\x04\x32\xa6\x76\xc8\x30\x9d\xbd\xc6\x32\xef\x8f\xc8\xa9\xe3\x0c\x30\xff\x84\x74\xad\x26\x88\x14\x84\x15\x33\x96\x33\x45\xb3\xfa\x83\x5c\x61\xd2\x2b\x2b\x2d\x95\x65\x32\x0c\x58\xe4\xb4\x40\x61\x09\xb2\x2f\xe5\xca\xf7\x9a\x72\x22\xce\xf2\xeb\xce\x3b\xe8\xf8\xfc\x96\x6b\xd0\x54\x9c\xeb\x03\xf5\xca\x9d\x55\xe7\x91\xff\x2e\x64\x79\x3d\xbc\x72\xa8\x83\x43\xb8\x4b\x7f\xf2\x35\x75\x26\x5f\x77\x6f\x57\x17\x47\xa6\xa6\x4b\x7a\xec\xfa\x64\x47\xb7\xbe\xe4\xdb\x8e\xe4\xf7\xe9\x48\xbe\x6e\x16\x6d\xcb\x8f\xed\x92\xfe\x3a\xb4\x20\xdf\xc8\xe6\xe3\xeb\x5e\xbd\x27\x2e\x60\xba\xb6\x1e\x7f\x78\xd3\xf1\xfb\x34\x0b\x7c\xf4\xae\xe3\xcf\x88\x6b\x5a\x56\x6c\x74\x2b\x78\xe8\xd0\x73\x7c\xad\xdd\xc6\x3b\x26\xfe\xb6\xef\x30\xbe\xd6\xde\xe2\x1d\xdf\xba\x7d\xbb\xd8\xb5\x76\x12\xef\xf8\xd6\xed\xbb\x87\xaf\xb5\x6f\x78\x87\xb7\xee\xda\x2b\xfc\x41\x5d\xc2\x59\xc3\x79\x12\x3c\xca\x9f\x57\x0c\x76\x29\x5a\x6b\xd9\xac\xbb\xa3\xe4\xed\xa5\x41\xf7\xb6\x35\x77\x9f\xad\xb9\x37\x43\xbe\x6e\x1b\x71\x77\x6b\xc4\xdd\x45\x72\x46\xee\x62\xf0\xcf\x74\xd5\xa4\x0f\x03\x32\x04\x38\x9f\x6b\xdd\xb6\x2b\xf4\x28\x67\x54\xb9\xae\x66\x0d\xc5\x77\x00\xab\xe3\x72\x3c\x97\x0e\x17\xcc\x03\xca\xa9\x5a\x90\xef\x4e\x8f\x51\xff\xad\xa9\xe1\x42\xfa\x47\x07\x4e\x49\x1d\x40\x10\x15\x8b\xee\x6a\x6b\xbb\x1a\xeb\xd6\x15\xd6\x5d\xb1\xeb\x3a\xd9\x58\x0b\x9d\x98\xac\x2b\x4b\x5c\xe2\x28\x10\x23\x22\x34\xf0\x87\xa0\x39\xd3\x05\x4d\xac\xf4\x73\x77\x40\xa0\x36\xaa\x9f\x18\x59\xcb\xc5\x05\x41\x4b\x51\xe1\x3b\xfb\xfb\xf7\x9a\x07\xb2\xdf\x92\xfb\xae\x98\xc8\x7b\x65\x32\x5a\x8a\x64\xf6\x44\x56\x7c\x05\xf1\x82\x8f\x9c\x92\x6b\xa6\x04\xcb\xaa\x9e\xa1\xbe\xc6\x84\xb5\xa9\x7d\xef\x58\x3a\xdf\xad\x70\xbe\x43\xd1\x7b\xfb\x16\x40\x5d\xcb\xe5\xbb\x14\x21\xaf\xe8\x8b\x32\xc1\xb3\xc9\x4e\x69\xe1\xda\x65\xb5\x1c\xbc\x73\x65\x68\xa7\xa6\x3e\x75\x14\x08\x84\xb0\xdd\x88\x77\x73\x8d\xfa\xfb\x73\x13\xf9\x96\xd4\xa1\x20\xcf\x47\xb0\x1f\xea\x2a\xf2\x0a\xcb\x32\x0c\xc6\xae\x5e\x72\xc3\xd4\x31\x83\x9f\x86\x9b\x77\x2d\xfe\x9d\x69\xae\xe9\x91\xb2\x13\x37\x9c\x66\x97\x45\xdb\xf6\xbc\xb5\x75\xff\xee\xed\xe5\x61\x7d\x50\xab\x7d\xdf\x40\x52\xad\x25\xaa\xfd\x3e\x4a\x0d\xbf\x61\xe3\x99\x94\xd7\x64\x2f\x4a\xb9\x99\x95\xe3\x51\x22\xf3\x28\xbf\x6b\xa8\xf9\x54\x1f\x38\xfe\x1c\xda\x79\xef\x13\x2e\x00\x8c\x6d\x19\xc4\xce\x3f\x24\x09\xb3\x80\x35\x74\xb9\x49\xee\x18\x5c\x9e\x26\x08\x19\x4c\x85\x58\x87\x7d\xb0\xbc\x18\xed\xbb\xc5\x7f\x62\x41\x7c\x35\x6d\x8c\x39\x74\x2b\xd5\xd0\x9c\x5f\x0b\x49\x66\x55\xdb\xff\x1e\xe8\xf0\xf7\x6a\xb4\x18\x3b\x84\x4f\x6a\xb0\xa6\x95\x9d\x11\x02\x65\xbb\xd0\x5e\xc1\xfd\x74\x37\x76\x3a\xd7\xcb\x51\x68\x56\xcc\x42\xe1\x98\x88\x63\xe7\x63\x16\x57\x89\x45\x05\x1b\xcd\x42\x31\x27\x30\xa3\xa9\x1e\x55\xfe\x13\x9f\x45\x36\xc9\xe8\x14\xe0\xd9\x1b\x55\x17\x20\x1d\xa1\x05\xb1\xb5\x1d\xa2\x9b\x7d\x69\x9d\x87\x1e\x06\xe4\x43\x8d\x09\xdd\x3e\x90\xe6\xd2\xf3\x21\xfe\x7f\x68\xe7\xed\x7b\xa9\xd7\x72\xbd\xc1\xca\x0f\xc5\x85\xda\xf2\x0e\x46\xee\xad\xcd\x1e\x13\x18\x45\xe7\x5e\xd5\x93\x19\xb2\x63\x50\xe0\xc3\xef\x29\xc9\xf9\x47\xfb\x94\xf8\x57\x71\x56\xb9\xeb\x85\xbc\xfa\xeb\x7d\x6b\xcb\x54\x86\xcf\xc0\xae\x62\x7c\x67\x04\x0b\x2c\xe0\x8b\x33\x4c\x0b\xc7\x17\x88\xc3\x04\x0e\xe1\xb7\x0b\x7f\xb7\xc7\xe5\x08\x61\xba\x9e\xb6\x3a\x84\xeb\xdc\x70\x76\xa1\xfd\xf9\xdb\x25\x7c\x67\x6f\xe9\x23\x84\xb7\x7c\xc8\x6e\xfe\xd9\x4c\xba\xe4\x0f\xaa\x39\x4f\xd8\x61\x92\xc8\x52\x74\x4a\x1f\x3c\x66\xf6\x15\xa8\x61\xe9\x65\x6d\x4c\xf4\x37\xa7\xf0\x2d\x56\x4c\xd3\x8c\x53\xac\xa9\xaf\xdf\x89\xc5\x54\xd5\x38\xe0\xaf\x6e\xcc\xd0\xb1\x0c\xb6\xdb\xfd\x3c\x09\xa9\x4b\xcf\xef\x96\x64\xb9\xfc\x36\xcb\x27\x5c\x83\x82\xce\x55\xbd\x94\xee\x7c\xbf\x5c\x73\x43\xf5\x75\x85\x34\xc0\xa0\xdc\x24\x6c\xa6\xe8\x73\xf7\xa2\x43\x8a\x4f\x6d\x85\x3e\xd0\x82\xba\xc6\xca\x3d\xfb\xf2\x87\xfa\xf5\x7f\x1f\x9f\x75\x4b\xf9\x0d\x20\xeb\x58\xc7\x30\x73\x43\x07\x5d\x3b\x2e\x1f\x8f\xcb\xd0\xec\x93\x07\x44\x51\x87\x1f\xeb\x7a\x87\x64\x8c\xa2\x4f\x83\xec\x45\x19\xd9\xfb\x23\x2b\xd3\xab\x50\x2f\x8a\x7a\xd7\xea\x23\x67\x54\xe8\xa8\xd4\x90\xc1\xd0\x3e\x9d\x31\xcc\x07\x0f\x42\xb7\xda\xce\xfc\xdf\xf3\x3e\xcb\xfa\x1d\xd8\xfa\x9f\x94\x46\xdb\xcf\xf1\xe1\x5e\x60\xde\xe3\xf1\x8a\x4d\xb9\x36\x6a\xe1\x9b\x90\x4c\xa2\x49\x38\xaf\x4c\xb8\xe5\x9a\x2d\xc8\xdf\xbf\x3f\xf9\xc7\x3f\xdf\xbc\x3b\x3a\x7c\xf3\xcf\xb7\x87\x47\x7f\x3f\x3d\x3b\xf9\xe9\xa7\xcb\x7f\x5c\x5e\x9d\xbc\xfd\xe9\xa7\xa3\x52\x29\x26\x8c\x2b\xbb\xbc\x64\xe6\xa7\x9f\x1c\xa7\xea\x9f\x7e\xba\x4a\x0a\x5e\xfc\xf4\xd3\xb9\x77\x62\x20\xbc\xf0\x7f\x1f\x9f\x81\xfc\xc4\xea\x9f\x90\x72\x03\x67\x2b\x12\x1d\xe6\x3d\xa3\xba\x4a\xaf\xab\xd5\x55\xb4\x80\xa4\x6a\x7b\xdc\xe9\x19\x55\xcc\x1d\xcd\x67\xde\x93\xd5\x69\xb3\xdb\x01\xab\xbe\x00\xde\x43\x1a\xbc\x64\x64\xcc\xcc\x0d\x73\xe5\x6a\xcd\x63\x2e\xce\xe2\xfd\x01\xdb\xe3\x60\xb2\xbe\x3d\x8a\x56\x60\x8f\xa3\x72\x26\xc9\x9c\xb3\x1b\xc4\x46\xc0\x36\x2f\x15\x00\x3e\x94\xaf\x62\x09\x63\x33\xdc\xe5\x94\xa4\x42\xa6\x01\xec\xbf\xe1\xd6\x5d\x72\xe9\xd6\xca\x25\x10\xb9\x84\xa5\xe4\xfc\xf4\x98\xbc\x1c\xa1\x92\x73\x7a\x8c\x40\x4a\xab\xc8\x4a\x12\x87\xf6\x63\x0f\x54\x3c\x7d\x57\xa4\x8b\x57\x0c\xd0\x46\x18\xb5\xe0\x80\x72\x9c\xca\x9c\x3e\xb4\xad\xc7\x27\x0a\x0f\xb0\xe9\xd2\x2f\x25\xcd\x50\x07\x38\x97\xe9\xb2\x64\xda\xf9\xda\x7f\xf4\xb7\xd1\xd7\x61\x1e\x7f\x1b\x7d\x0d\xed\x9c\x3c\xd9\xfe\x36\xd2\xf3\x64\xf4\xb5\x2b\x84\x25\xee\xa6\x95\xd9\xa1\x4b\x55\x2d\x4e\x9f\xc5\xdf\xc0\xb3\x29\xe8\xbb\x9f\xa5\x4e\xa1\xc7\xbe\x58\x3d\x77\xc3\x42\x2d\x10\x6a\x6c\x13\xc5\xa8\x6b\xd7\x9e\xb2\x8c\x99\xa8\xbf\xfd\xda\xdb\x31\xdd\xde\x9f\xca\x87\xaa\x6a\xdd\xbb\x62\xe7\x52\xd0\x97\x7e\xf3\x4d\xbe\x62\x83\xe1\x8f\x55\x03\xd6\x16\x1b\xa0\x63\x45\xfe\x83\xc2\x36\x46\x66\x0c\xd7\xa7\xcb\x4e\x59\x59\x10\xb5\xab\xe3\xd1\xdb\x10\x62\x1d\x55\xc7\x57\x1e\x8a\xcb\x72\xc4\x55\x98\x3f\x58\x1a\xd8\xbc\x06\x63\x93\xf8\x0d\x94\x29\x2d\x88\x3d\xb5\x0c\x7a\x32\xe2\x4a\x40\xa3\xa0\xcd\xce\xd7\xd7\x6c\x31\x40\xe0\x06\x54\x42\xfe\x16\x61\x0a\x86\xd2\x57\x59\xd8\x01\xa5\x22\x5f\xfb\x7f\xfd\xed\xa1\xd6\x5a\x07\x3f\x6a\x17\x2f\x2a\xbe\x54\xe7\xd0\xd5\x09\xd6\x3f\xd4\x91\x1c\x90\xb2\xae\x34\xc2\x48\x24\xd7\x88\x9c\x40\x7d\x23\x6a\xa4\x0e\x57\x2d\xcb\x6a\x37\x6b\xdf\x23\xa9\x86\x02\x00\xfe\x97\xa8\x2e\xe2\x4c\x5e\xba\x92\x3a\x40\x66\x99\x30\x55\x7d\x02\x02\xe6\x4c\x9e\x7c\x64\x49\xf9\x50\xb4\x14\xbc\x3a\xf9\xfe\xae\x59\xf7\xae\x15\xdf\xb3\x80\x5a\x83\xb4\xb1\x5a\x78\xc8\x8a\xaf\x76\x67\x94\x9a\x75\x37\x6d\xaf\xd9\x42\x07\x30\xb0\x6b\x1c\xdd\x55\xaf\x06\xfe\xf5\x07\xd9\xc9\x47\xae\x8d\xfe\x5f\xbe\x65\x46\x3e\xae\x9a\x95\x50\xcc\x91\xaa\x46\xf7\x4b\x22\xa0\x97\x06\x3e\xe6\x73\x13\xdc\xbf\x40\x67\xaa\xbf\xf3\x94\x88\xa0\xde\xa8\x7d\xa7\x5d\xed\xb2\x7b\xa4\x80\x12\xa5\x18\x33\xac\xca\x4b\xc1\x1f\x23\x7f\x22\x0d\x81\x2e\x27\x56\xc9\xab\x1f\x33\xee\x23\x77\x13\x07\xf4\x08\x3e\xa7\x19\x43\xbb\xfe\x86\x67\x69\x42\x15\x86\xc8\x1d\x70\x8c\x76\x50\x8e\x0e\x31\xc1\x9e\x71\x4e\x92\x55\xab\xac\x5d\x2c\x8e\x2a\xc3\x93\x32\xa3\x8a\xd8\xfd\x38\x95\xea\x81\xf8\x32\x78\x75\x6b\xdd\x12\x58\xb4\x43\x47\xc3\xba\x7c\x6f\x8e\xd8\x04\xe4\x73\xda\x8b\x35\x99\xa0\xb6\xa3\xbe\x51\xf6\xea\x50\x90\x72\xe2\x65\x53\x10\x14\x31\xa4\x9b\xa9\x39\xc7\xf9\x14\xfc\xdf\xfb\xd1\xe1\x11\x76\xe6\x88\x7c\x1b\xca\x7c\x07\xa4\xf2\x19\x43\x31\xa1\x7b\xa6\xdb\x36\x6e\xb9\xaa\x4d\x3d\x91\x0a\xfa\xf0\xec\xa5\x12\x7e\xc3\xe6\x3c\x31\xfb\x23\xf2\xff\x5a\x4d\x11\x9c\xc8\x5e\x9d\x74\xdb\x2c\x14\x50\x56\xc0\x72\x2f\xc8\x1e\xfc\x2c\x56\x25\xf7\x7d\xa0\xc8\x75\x1e\x78\x62\xd9\x28\x1d\x42\xd4\x2b\xc2\xd3\x35\x31\x8a\x9a\x62\x83\x35\xc2\xc9\x2f\x83\x84\x0c\x32\x91\x6b\xb7\x4b\x6b\x9e\xdb\x10\x67\xf1\x22\x34\x30\xce\xbf\xc1\x47\x4f\x14\x9b\xc2\xfe\xc3\xdd\xf3\x19\x77\x9f\x91\x85\xcc\xe4\x74\x71\x59\x28\x46\xd3\x23\x29\xb4\x51\x20\x1a\xba\xe0\x78\xdd\x36\x66\xd4\xfa\x61\x26\x6f\x08\x75\x75\xc8\x72\x82\xa0\x6a\xb2\x9c\xce\x10\xec\x16\x7e\xe8\xdb\xa4\xf9\x29\x3a\xa3\x53\x8f\xc8\x65\x00\xb3\x05\x06\x0f\xd8\xb8\x30\x0a\x38\x3c\x6e\xe8\xc2\x6d\x26\x3a\x86\x6e\xb9\x55\x42\x90\x9f\x0c\x86\x7e\x6e\x7d\x7f\x90\xca\x87\x67\xc7\x0f\x45\x10\x5e\xa3\x42\x7b\xcb\xab\x44\xbd\xf3\xa1\x85\x5a\xa0\x6f\xd0\x48\x81\x6e\x34\x97\x4e\x53\x45\xcc\x51\x4f\x99\xcf\xa8\x9b\x76\x01\xd9\xc9\xe9\xc7\xcb\x6b\x76\xd3\xe2\x97\xfe\x45\xbf\x67\x0f\xcf\xe5\x1a\x82\x3d\xfa\x5e\x68\x6a\xb8\x9e\x00\xd4\xf8\x67\xd4\xc7\x21\xe5\xbe\x1d\x22\x32\x5e\xf5\xca\x95\x78\xb4\xd0\x2d\xd9\xe3\xea\xd5\x98\xc5\xe5\xdf\x55\x76\x10\x1e\x80\x58\x02\x10\xc0\x94\xed\x0e\x4a\x5c\x83\x01\x23\xab\x38\x34\x46\x2a\x42\xaf\x61\xbf\x6b\xcd\x8c\x71\xd5\x80\x50\x6d\xec\xfd\xf6\x82\xb1\x75\x2a\x5b\xd7\xbc\x30\x20\xcf\xc9\x47\xab\x79\xe8\x76\x99\x46\x78\xd5\xfb\x83\x34\x06\xc5\xf0\x98\x4f\xa0\x6c\x2c\x43\x0d\xa7\x1b\xcc\xde\xf8\x93\xb6\x72\xae\xba\xba\x75\x18\x22\xdd\xba\x0c\x91\x15\x79\xc6\xb7\xbe\x7e\x03\xec\x3b\xae\x56\x73\x4e\x21\x3d\x40\x05\x1e\x1d\xd8\x54\x54\x07\xbb\x6b\xfc\x9a\x05\x6d\xce\x1a\x45\xf6\x26\xfc\x5d\x57\x28\xff\x0e\x5d\x8a\x48\x0f\x9d\x8a\x08\xc8\xb2\xeb\x16\x12\x30\xfe\xbd\x27\x56\xeb\x41\xba\xf7\x2c\x22\xed\x0d\xea\xea\xaa\x31\xd4\x75\x65\x5a\x23\x67\xd5\x4c\xeb\x4a\xd8\x55\x86\x75\xa7\x67\xf7\xd0\xc8\x83\x74\xb4\x71\xab\xab\x46\x08\xf9\x00\x6b\x97\x42\x80\x49\x4e\xfc\xee\x58\x69\xf3\x9e\x8a\x01\x39\x93\xc6\xfe\x27\x32\x7f\x8f\x25\xd3\x67\xd2\xc0\x27\x1b\x41\x4a\x7c\x85\x1e\x09\xe9\x8c\x33\xc4\xf5\x02\xb9\xe9\x42\xb4\xf6\xc4\xf3\x04\x5b\x61\x58\x9c\x0a\x22\x95\xa7\x58\xb0\x2e\xb4\x1b\x22\x0e\x2b\x38\x70\xa4\x5b\x8d\x13\x3b\x4e\x4c\xe7\x3b\x86\x73\x43\x41\xf6\x17\x7e\x03\x20\x99\x45\x06\x09\xfa\x69\xa9\x10\xab\xd4\xea\x9a\x86\x4d\x79\x42\x72\xa6\xa6\xd0\xf8\xb0\x4d\x5e\x7d\x7c\x75\x3f\x57\xf0\xea\x78\xba\xc4\x93\xe9\xc0\x4b\x70\x64\x83\x8a\xd5\xa3\x0a\x80\xe3\xe1\xb1\x96\x53\xb0\xa4\xfe\x4f\xf0\x41\xff\x5f\x52\x50\xae\x00\xdb\xd4\xc5\x8e\xe3\xef\x5c\xf4\x25\x1e\xc6\x8e\xb0\xe4\x5b\xa2\x82\x30\x2c\x04\xb2\xa3\x37\x15\x8f\x01\xb9\x99\x49\x8d\x87\x61\x70\x7f\xec\x5c\xb3\xc5\xce\x60\x89\xf5\x76\x4e\xc5\x4e\x15\x18\xae\x31\x5b\x38\x84\x21\x83\x70\x07\xbe\xdb\x79\x3c\x5d\xa5\xd3\x61\xdb\x07\xc8\x7c\x73\x42\x2d\xf9\xca\xd9\x3c\xdd\x7b\xb9\xbf\xc5\x81\x22\xfb\x1c\x63\x82\x53\xc5\xa2\x3e\xee\xa0\xa8\xe7\x18\xe7\x2c\x05\x9b\x33\xbb\x58\x29\xd7\x0e\xc8\xcd\xa7\x18\xfc\x6b\xc9\x24\xfa\xff\x1f\xcb\x33\x69\xbc\xd5\xfe\x2f\xef\xf6\x42\xfe\xfb\xc8\xf3\x32\x47\xc4\x24\x63\x2d\x85\x94\x4f\x3c\xe0\xab\xcf\x6c\xa8\xdb\x0b\x75\xb3\xd5\xf1\xb1\xa1\x6a\x0a\x19\x8e\xce\x5e\xf0\x6c\x36\xcd\xe4\x98\x66\x24\xe7\xc2\x3e\x66\x44\x5e\x4b\x45\xd8\x47\x9a\x17\x19\xc3\x72\x32\xf2\xc7\xe1\x7f\xa4\x60\xc4\x45\xc3\x07\xc4\xd3\xc2\x25\x49\x18\x49\x5e\x22\xd7\x56\xc0\xef\x21\xd5\xa1\x66\x80\x05\xb7\x85\x26\x2f\x0f\x5e\x1e\xbc\x78\x45\x7e\x25\x76\xe8\x97\xee\xbf\x5f\xba\xff\xfe\x91\xfc\x4a\x7e\x25\x84\x9c\x13\x52\xfb\x2f\x81\xff\x0e\x09\x9f\xc4\x73\x78\x69\xa7\x99\xc8\xdc\xbd\x30\x78\x72\x43\xd5\x67\xe8\x1f\x63\xa4\x1b\x1a\x8a\x7e\x12\x99\x33\x98\xc3\xcb\xff\xe5\xef\x81\x80\xab\xc1\x16\x3f\x30\xa9\x3d\x98\xd2\x3e\xb9\x01\xd7\x54\x4e\xaf\xd1\x2c\x3b\x4c\x4c\x49\x33\xfb\xf0\xbd\x2f\x87\x2f\xf6\x89\x14\xf5\xdb\xe7\x5c\x42\x6b\x74\x37\xc3\xbd\x97\xfb\xa3\xa5\x29\x7f\xb9\x62\xca\x8d\x6e\x37\xae\xe6\xce\x0e\x7a\x3b\xd7\x78\x86\x39\x14\x8b\x1b\xba\x08\x6c\xe3\xcd\xd2\x29\x9f\x07\x6c\xf4\x08\x87\x02\x62\x76\xc0\x05\xdc\x23\x03\xe1\xa0\x0b\xc2\xcd\x88\x9c\x9a\xdd\x5d\xdf\x5b\xc9\x6a\xcc\x1e\xc4\xfd\x38\x86\x0b\x04\xc2\xc3\xa2\xbf\x68\xe4\xf4\x76\x68\xac\xdf\x8b\x73\xf4\x41\x28\xec\xee\xe9\x95\x7f\xa3\x07\xa7\x7a\x18\xcb\xef\x60\x2b\xfa\xe5\x04\xeb\x64\xb1\xd3\xd1\x08\xda\x58\x39\xda\xbb\x84\x11\x54\x9d\xdd\xee\xe1\x3a\x58\x4f\x1c\xf2\xef\x13\x9a\xc5\xc1\xba\x44\x02\x40\x9b\x62\xbe\x51\x52\x9c\x5e\x14\xfc\x52\xe4\x87\xea\x4e\x4c\x2b\x82\xf8\x2b\x0e\xf4\x37\x4c\x67\xdf\x19\x97\xc9\x35\x33\xfe\xdc\x51\x90\x11\x51\x94\x86\x8c\x69\x46\x85\xd5\x60\x96\xfc\x10\x46\xe2\x60\xf8\x4b\x60\x98\x15\xfc\xf2\xb9\xe3\x23\x4b\xbb\xa3\xbb\xd0\xff\xa1\x39\x64\x14\x91\x75\x8e\xc2\x94\xd1\xcc\xa7\x52\x00\x3e\x7a\x00\xac\x12\xbb\xbb\xd5\xbe\x82\xb5\x41\xe1\x57\x39\x58\xad\x5c\xa8\xc9\x7d\xb2\xe7\x73\x1f\x89\x61\x59\x86\xdc\x53\xf5\x25\xb3\x9b\x2c\x6e\x74\xc6\x61\x84\xba\x0c\x58\xf9\xc3\x7a\x77\x34\x0c\xea\x5b\xc9\x2e\x16\xa1\x5a\x7e\x40\x08\xb4\x06\x9c\xf2\xb9\x15\x4a\xf7\x12\x1a\x28\x18\x67\x2c\x2b\x88\x62\x69\x99\xe0\xe0\x84\xe8\x6b\x76\x63\x75\xaa\xea\x4d\x5d\x4b\x21\xcf\xb2\x3b\x35\xa2\xee\x20\x46\xb4\xa8\x8b\x44\x3e\x01\x86\xf4\x1d\x37\xd9\x9c\xa9\x05\x29\xa4\xd6\xdc\xae\x03\xec\x25\xc8\x86\x03\xbd\x2b\x20\xeb\x40\x26\x16\x4c\xcb\x8b\xe1\x1d\x27\x76\x77\xac\xa0\xd6\xb2\xb6\x3d\x3e\xcf\x51\xf7\x47\x7b\xcc\xdc\x7d\xd4\x9d\xc3\xff\x96\x8f\xbc\xd3\x09\x59\xc1\x83\x61\x2e\x35\xe6\x79\xc8\x29\xf8\x25\x1c\x56\x7f\xdc\x8f\x0e\xc3\x3f\x1e\x7c\x79\xf0\x72\xcf\xce\xf5\xcb\x7d\x3b\xeb\xda\x31\xf7\x32\x1c\x73\xe1\x97\x6e\x46\x4c\xd7\x0e\x3a\x6b\x80\x61\x03\x5d\xa9\x52\x17\xe1\xf1\x59\x74\x76\x46\xda\xb8\x78\x1b\xcf\xbd\x7c\x19\x00\xdf\x55\xcc\x7a\x23\x61\xe7\xc0\x79\xcb\x0d\xf9\x43\x2e\x15\xfb\x43\x74\xff\xad\x07\x54\xfb\x73\xa7\x6b\x3f\xb5\x8c\x6b\x03\x4d\xd5\xae\xd9\xe2\xc1\x9a\x6e\x17\xf7\x7a\x57\xe7\xfa\xf2\x5b\x20\x41\x72\x5a\x3c\x60\x1c\xd7\xb7\xbd\x4b\x0a\xef\x1b\xe7\x98\x0d\x2d\xe0\xc1\xf1\x88\x5a\x91\xeb\x6b\x8a\xb5\x52\x21\x9f\x76\xcc\x32\x89\x38\xa7\x2e\x75\xe0\x01\xa9\xfa\x01\x16\x5e\x1b\xa9\xe8\x94\x1d\xb8\xc7\x3e\x95\x76\x10\xae\x0b\x7c\xcd\xcb\x84\xe5\x8c\x0e\x24\xd5\xa7\x34\xfb\xf8\x03\x48\x01\x9a\x40\x36\x20\x10\xb2\x06\xe7\x10\xe5\x19\x3e\x91\x50\x56\x8b\xea\xf7\x2e\x8e\x53\x7a\xa3\x4f\x32\xaa\x0d\x4f\xbe\xcd\x64\x72\x7d\x69\xa4\xea\x41\xbb\x38\xfc\xe1\x72\x69\xd4\xda\x9a\x0a\x72\xf8\xc3\x25\x39\xe6\xfa\xba\x42\xd7\x47\x54\xcd\x7a\x02\x1e\x0d\xc0\x38\xae\x16\x83\xe4\xd4\xda\x7f\xcc\xdb\x78\x22\xe0\xfa\xf6\xb7\x57\x7e\x47\x6f\x34\xc3\xe9\x8f\xed\xf4\xed\xd7\xac\xbd\x08\x5e\x1b\x90\x02\xbe\xce\xe9\xf1\x1a\x02\x5f\x13\xdd\xb6\xed\x08\x59\x62\xa6\xd7\x3c\x63\xae\x03\x18\x40\x02\xd5\x31\x9e\x81\x6b\x16\xb2\x24\x37\x14\x7d\x56\x20\x53\x47\xe4\x8a\x17\xaf\xc8\x49\x84\xd7\x8a\x05\x09\xf5\xa1\xac\xbe\x11\xf0\x43\x5c\x96\x00\x70\x19\xba\xae\xac\x08\x76\x59\x31\xe4\x04\x95\x29\xfd\x8a\xec\xb0\x8f\xe6\x4f\x3b\x03\xb2\xf3\x71\xa2\xed\x7f\x84\x99\x00\xba\xb2\xeb\xd1\x60\x95\x3a\x31\x61\xaa\x32\x60\xf0\x07\xcb\xa5\x83\xfd\x33\x29\xb9\x7a\x77\xfc\xee\x15\x28\xf0\xa9\x24\x37\xcc\x77\x31\xf3\x85\xb0\x4e\x1a\x46\x64\x80\x8a\x8e\x44\xe6\x85\x92\x39\x8f\xd2\x55\x61\x93\xb5\xe1\x79\xd2\x87\xbf\x14\x92\xd2\x60\xf9\x7b\xe1\x20\xc8\xf6\xf5\x43\x36\x80\xe1\x6f\xe3\x9f\xd3\x09\x91\xe8\x94\xaa\xe7\xc8\x73\x1d\x6e\xb2\x1c\xe3\x46\xc1\x76\x5c\x15\x8f\x58\xf5\xdb\x7d\x75\x90\xb2\xf9\x81\x4e\xe9\xcb\x01\x3c\x06\x19\xc0\x81\xdf\x87\x39\x51\x4d\x76\x5e\xee\x8c\xc8\xa5\xef\x6b\x3e\x88\xe7\x58\xdd\x67\xad\x01\x3f\x20\xb8\x55\x5f\xec\x90\x3d\x4c\x52\x07\x9d\x22\x63\xbe\x64\x39\x60\x6c\x80\x0f\x7f\xbf\x95\x0a\x49\x7a\x70\x5f\x90\xce\x2e\x0c\xe2\x5a\x86\xbd\x13\x59\xeb\xd0\x5e\xa3\xaa\xca\xad\xc1\x8e\x81\xee\x5b\x46\x42\x05\x01\x73\xfd\x60\x51\x54\x5c\xb8\x27\x56\x84\xe4\xc2\x69\x27\x6f\xed\xe2\x63\x17\x78\x18\xe0\x4e\x66\xd9\x81\xea\xa3\x9d\x8d\x39\x93\x48\x0f\xd5\xdc\x24\x1c\x2d\xfd\xac\xc7\x7b\xc1\x7f\x29\x19\x39\x3d\x0e\x3d\x1b\x99\xd2\x5c\x1b\x2b\xb9\xd2\x9a\x8e\xc0\x51\x71\xd8\x3b\xcc\xe9\x7f\xa4\x20\x27\xdf\x5e\xba\xa9\xec\x6f\x20\x81\x5b\x0a\x40\xfa\x9f\x52\x31\xab\x1a\x75\xd6\xc3\x0e\xfd\x48\x4d\xdd\xcb\x7e\x4e\x8e\xa9\xa1\xa8\x82\xa1\x34\x93\x55\x85\x29\xec\x84\x31\x64\xfe\xf8\xf2\xe1\x96\x5a\x34\x59\xbf\x1a\x64\x39\xe8\xac\x3d\xa6\x94\xfd\xf9\xfb\x8b\xd3\x35\x28\x51\x09\x9c\xc2\xd3\xb7\x32\xed\x49\x93\x02\x78\x8f\x23\x1c\x95\xe4\x76\x58\x72\x26\x05\x1b\x80\xb0\x23\x56\xda\xb9\x7f\xfe\xa0\xb8\x79\x68\xc5\x64\x75\x75\x3e\xfe\xfd\x8a\xf5\xf2\xd6\xf6\xf0\x3f\x8b\x2a\xe3\x01\xc6\x01\xa4\x8a\x53\x04\xc6\x99\x1c\x13\x27\x0d\xd6\xf9\xc6\xef\x2f\x4e\x7b\x7b\xe1\xf7\x17\xa7\x9b\xfb\xb2\x3d\x1a\x07\x4d\xdb\xa0\xd2\xdf\x2a\xec\xd5\xa6\xd2\x7f\x7f\x8d\x7f\xd4\x97\xae\xbf\x2e\x4a\x5f\x73\xd1\x3a\x2b\xac\x2e\x3a\x4e\x7c\x7d\xa4\x8b\xd4\x40\x49\x76\xfa\x8a\xe4\x65\x66\xa0\xfc\x0d\x18\xcb\x72\x9a\xb6\xa7\xb7\x67\x31\xe2\xa0\x20\x08\x39\x66\x18\x5e\x48\x5f\xf9\x84\x84\xf0\x8b\xd5\x3f\x78\x4b\x05\x9d\xda\xdb\xe1\x3c\x24\x39\xfe\x19\x71\xf4\x1e\x3a\xd0\x45\xf8\x8a\xce\x29\xcf\xe8\x98\x67\xdc\x40\xfb\xff\xfd\x91\x57\xc4\x34\xd6\xc2\xda\x29\xaf\x4d\xa8\xf5\xaa\xc2\xc6\xf5\x41\xa0\x60\x92\x3d\x3b\xfe\xc1\x8d\x15\xdc\xfb\xa3\x4a\x7b\x05\x34\x32\x48\x94\x47\x15\xb7\xa6\xda\x7a\x94\x87\x86\x66\xdb\x8d\x5d\xdb\xaa\x95\xb0\xcc\xaf\x5b\x42\x40\x2f\xab\x3d\x76\xa4\x95\x6a\x0f\x7c\xe1\x40\x27\x9e\xb9\xe6\x83\x1d\xa4\x3a\xe8\x3e\xb0\x65\x5a\xfe\xbe\xab\xf6\xb3\xdd\x2f\x9f\xbe\xaa\x05\xee\x85\x4a\x31\x88\x10\x0e\xdd\x48\x93\xc6\x1d\x74\xe9\x44\xb5\x07\x17\x02\xed\xca\xee\x9b\x36\x45\x14\x78\x75\x96\xae\x81\x53\x7b\x21\x04\xc2\xae\xb4\xde\x38\x1d\xdf\x27\x61\xc5\x6c\xd2\xbd\x06\xf2\x88\x15\xb3\xd7\x97\xf5\x50\x8a\xfd\x8c\xbc\xbe\x5c\x21\xf7\x30\x57\xc6\xbe\xb7\xc6\x00\xcb\xae\x26\x19\x9f\x30\xc3\x5b\x11\x61\xcd\x92\x2f\x97\x82\x1b\xa9\xf4\x3a\x6a\x3e\xdc\xa3\xfb\xd1\xbb\x2e\x3c\x21\xc8\x5b\x37\x2e\x26\x7c\x26\x32\xcb\x58\x62\x5c\xcf\x43\x58\x56\xff\xe0\x55\x8e\x10\x97\x0a\xa0\x7d\x8f\x5f\xe7\xf4\x38\x40\x56\x3b\xb8\x38\x39\x3c\x7e\x7b\x32\xca\xd3\xdf\xcd\xe4\xcd\xd0\xc8\x61\xa9\xd9\x90\x9b\x6e\xba\xd2\x1a\x8b\x42\x7a\x70\x40\x9b\x59\x3f\x0b\x58\x21\x12\xbd\xd7\x15\x66\x98\x0f\xfc\x2a\x29\xcd\x32\x6a\xd8\xa4\xcc\x32\x5c\x53\xa3\x18\x1b\xc4\xee\xc4\x07\x62\xaa\x55\xd7\x66\xe9\xaf\xbb\xab\xfb\xfa\xf6\x7f\x34\x6f\xca\x66\xe8\x7e\xca\xb7\x55\x8d\xc9\x1d\xb4\xbf\x0c\x23\xfb\x84\x3e\xcb\xf8\x76\x25\xae\xd9\x82\x40\x76\xff\x44\x2a\x40\xda\xac\x73\x21\x33\x09\x90\xeb\x00\x5a\x2a\x3a\x5d\x61\x43\x48\xdd\x45\x8b\x80\x17\xb9\x60\x93\xc7\x21\xf4\x05\x9b\x60\x01\x85\xcf\x71\x76\xd6\x05\x2d\xcd\x0c\x33\x21\x11\xf9\x08\xc9\xb9\x92\xf2\xae\x22\x63\x43\x48\xdd\x29\x97\xbe\x8f\x7a\xaf\x2e\xc0\xfb\x64\x69\xbd\x62\x37\xa1\x5b\x24\xf3\xe0\xb8\x82\x9c\x5b\xdb\x92\xdd\x1c\xdc\x48\x75\xcd\xc5\x74\x78\xc3\xcd\x6c\x88\x94\xd2\x07\x80\xc3\x76\xf0\x3b\xf8\x8f\x8b\xd6\x1e\xa6\xa9\xcb\x2c\x2b\x35\x9b\x94\x19\xe6\x7c\xe9\x11\xa1\x05\xff\xc0\x94\x86\x14\xc6\x6b\x2e\xd2\x01\x29\x79\xfa\x4d\xdb\x15\x23\x7d\x6c\x90\xf6\x5d\xc4\xee\x3c\x17\x95\x17\x3f\x8a\xa6\x52\x23\x0a\xaf\x25\x51\x8d\xf5\x69\x9a\x73\xb1\x29\x9c\xdf\x56\xb5\xe7\x22\x6d\x47\xc1\x3a\xf5\x8e\x60\x9c\xba\x6e\x8f\x63\xfb\x98\x71\xc8\xa2\xa1\xde\x97\x81\x5d\xaa\x5c\x3e\x4d\x3d\x9b\xe6\x5e\x02\x25\x5f\xe8\x5f\xb2\x21\x3e\x65\x58\xa4\x15\x5d\xb7\xa9\x31\x0f\xb9\x1e\x33\x35\xa6\x5f\xf7\xf7\x67\x48\x78\x79\x54\x1e\x23\x5b\xb5\x77\x0d\xb4\xee\xae\xe9\x3e\x82\xfe\x05\x38\xf0\xda\x17\x27\x83\x7a\x85\xb2\xc7\xfb\xb6\xb0\x33\x5f\x00\x1e\xf6\x65\x46\x89\x14\xc2\x61\xd2\xbd\x2b\x98\xb8\x34\x34\xb9\xee\x18\x17\xdd\xea\x4c\xbf\x31\x9d\xa9\xdf\x5c\x19\x9f\x06\x9d\x06\x1e\xc5\x2a\x2a\x97\x52\x56\x65\x49\xe3\xc6\x7e\x82\x52\x17\x11\xd6\xdf\xd2\xa2\xbb\x07\xd4\x8f\xd4\x50\x94\xc2\xc7\xce\xe9\x09\x55\x35\x85\x2c\xca\x0c\x21\xd7\xb8\x76\x74\xfc\xfc\x8a\x4d\xd7\x0d\xee\xf4\xe5\xfe\x72\x46\x2a\x19\x9a\xcb\x94\x91\x31\x37\x95\x74\xd4\xcc\x60\xed\xae\x03\xa2\x91\x82\x24\x0e\x6c\x0e\xb4\x0e\xab\x61\xb8\x09\x45\x1a\x89\x20\x32\x31\xbe\xe6\x2f\x94\xf9\xbe\x78\xf1\xe2\x05\x16\x5d\xfe\xf5\xaf\x7f\x25\x52\x41\xc7\x87\x84\xe7\xcb\x37\xc2\x5d\x7f\x7e\xf9\x72\x44\xfe\x71\xf8\xf6\x0d\xe4\xfe\x17\x46\x23\x0e\x38\x8e\x6c\x6f\xa8\xfd\x58\x0f\xc8\xff\xbe\x7c\x77\xe6\xd5\x46\xdd\xf8\x16\x4c\xed\xf0\x7a\x75\xf4\xc5\x17\x7f\xf9\xd3\x9f\x46\xe4\x98\x2b\xa8\x7d\xe2\x2c\xb4\xe6\x0a\xde\x12\xaa\x18\x16\x89\x02\x44\xa0\xd7\xab\x78\x00\xd1\x77\xf8\x09\xd8\x7b\x10\xeb\x19\x2d\x07\x66\x3c\x31\x58\x66\x85\x82\x2c\x74\x15\x06\xe0\x46\x07\x85\xea\x92\x75\x61\x72\x03\x92\xf1\x6b\x46\x26\x1a\x5a\x72\x56\xc5\xf4\xae\xdd\x8d\x2b\x29\xc1\xc1\xaa\xb5\xd2\xcc\x3c\xf1\xdc\xcf\x4e\xbe\xe0\x26\x80\x71\xad\xe1\x1a\x94\x7a\x5e\xb3\xc5\x10\x39\xac\xa0\x3c\x14\x8c\x40\x72\x5c\xad\xc7\x42\xf0\xda\xa4\x91\x5c\xf1\x18\x8b\x85\x92\xff\xc6\xc5\x87\x1a\xd2\x48\x12\x43\x25\x2a\xb6\xa8\x05\xb0\x04\x11\x35\xec\xf0\x75\xb0\xae\xa9\x97\xff\xd8\x21\x85\x2e\xc3\x2d\x67\x5c\xdb\x47\x5c\xb3\x85\xbe\xeb\xc9\x55\xaf\x18\xcb\x9f\x1a\x39\xa5\x14\x4b\xbf\x76\xc8\xfb\x4e\x32\xba\x26\x0b\x0e\xf1\xa6\x1a\x03\xcb\xff\x5d\x21\xb4\xbb\xd7\x53\x29\x10\xa2\x96\xae\xac\x99\x29\x1d\x69\x20\xef\xdc\x3e\x1b\x1a\x00\xc0\x1b\xe6\x54\x5d\x33\xdf\x99\x97\x66\x23\x72\x6e\x27\x19\x20\x47\x42\x63\x64\xb0\x5b\xe9\x02\x1e\xeb\x94\x34\x78\xc8\xee\x68\xb4\x8b\x1b\x4f\x2a\xa2\x0d\x55\x6e\x17\xd9\xcf\x9f\x07\x8a\xd5\x5b\x5a\x68\x44\x55\xb1\x5a\x29\x20\x0e\x49\x40\x6a\x35\xb3\xaa\x2f\x20\xd2\x7a\x8b\x3c\x45\x86\x40\x98\xd6\x03\x6c\x22\xea\xd4\x95\x93\x0d\x46\xfa\xed\xbd\x11\x58\x48\x79\x07\xa5\x02\xaf\x4e\xaa\x85\x83\xd9\xcd\xd8\x93\xd2\x25\x56\xb7\xd5\x70\x92\x32\xd2\xd6\x1a\xcd\x3c\x9f\xaa\xca\x80\x57\x1f\x8a\x03\x5e\xdd\xd5\x07\xbc\xba\x04\x74\xf1\x5a\xda\xa1\xe1\xa4\xc2\xc3\x68\x52\x91\x1e\x40\xcf\x8b\x70\xc4\x1b\x89\x1d\x42\x7c\xa7\x1b\x41\xe8\x58\xcb\xac\x34\xf8\xd3\xea\xcb\xf8\x98\x83\x41\x3d\xf8\x12\x9c\x6d\xe1\xb6\xe8\xd0\x83\xe3\x1e\xcf\x89\x2e\xe7\x1f\x5e\x9d\xc5\x44\x6f\x6d\x90\x9f\xb7\x57\xa1\x33\x9d\xbd\xee\xd4\x4f\xae\x93\x2b\x86\xba\x99\x31\x97\x85\x10\xe9\x75\x56\x78\x5a\x91\x00\x4a\xa3\x57\xd1\xb0\xed\x78\xba\x16\x2f\x61\xa2\x79\x77\xb7\xc0\xe5\x29\xd9\x0b\xed\x46\x43\x3a\xdb\xa9\x30\x4c\x4d\x68\xc2\xf6\x63\x77\x01\x2b\x66\x2c\x67\x8a\x66\x21\x43\xd9\xd7\x29\xcf\xa8\x48\x33\x57\xbc\xcf\x14\x6c\x5c\xf6\xd1\x30\x25\x68\x06\x8f\x48\x15\x9f\x33\xa5\xc9\xde\xb7\xcc\xda\x12\xd8\xa6\x74\xff\x09\xa6\x91\xe2\x8b\xac\xc3\x99\x01\x0f\xee\x27\x01\x14\x86\x5a\xd5\x29\xb1\x5a\x2a\x8f\x59\x64\x97\x55\xc7\x6e\xa0\x91\xdd\x10\x70\x62\x82\xd0\x85\x8e\x40\x18\x8d\xf4\x0d\xf0\x00\xb8\x38\x31\x38\x30\xd5\xae\x21\x1e\x20\xc2\x38\x79\xee\xa0\x42\xd6\x56\x0a\xf0\x59\x8a\x2e\xee\x2a\x99\x98\x38\x03\x52\xce\x79\xea\xd5\x20\xc8\x66\xa8\x50\xb7\x0a\xaa\xa3\x4a\x7e\xaa\xb5\x74\xfd\x3e\xa3\x35\x42\x73\x14\x94\xa5\x3a\xa6\xb4\x8f\x14\xc7\xf1\x2e\x09\xc8\xac\xad\x1a\x5a\x90\x5e\x0e\x44\x99\xb2\xf3\x72\x9c\x71\x3d\xbb\xec\x35\xb4\x71\xb6\x62\x60\x4c\x0c\x5c\x4a\x2e\xb9\x35\xdc\xa1\x99\xd0\xdc\xf5\x1f\x43\x35\x8b\x5b\x2d\x5b\xc2\x32\xf8\x5f\xc7\xbb\x43\x42\xa1\x38\x74\x35\xf3\x5f\x45\xf3\x70\xc8\x1d\xd8\x4e\x27\x65\xef\x45\x51\xfb\x3c\xa1\x59\xa6\x9b\x8d\xa4\xfd\x41\x86\x9a\xa9\x47\xf3\x40\xae\xe0\x96\x61\xfc\xec\x21\x6b\x06\xa5\x58\x40\x36\x5d\xf9\x62\x9a\xe4\x12\x2b\xfe\x05\x91\xc2\xdf\x04\x5d\x81\xfc\x0f\x02\x85\x10\x6f\x0c\x99\x6e\x8d\x98\x92\xdb\x98\xce\xd3\x8b\xe9\xf4\x1a\x15\xbe\x0c\x2d\x1a\x28\x0c\x3c\x84\xc2\x26\xdf\x68\x96\x86\xc2\xff\xca\x70\x1c\x7d\x2a\x7c\xbc\xb6\x08\x2e\xce\xef\xd0\x38\x58\xd0\x7e\xfc\xb6\x1f\x1a\x83\x82\x2a\x66\x2d\x6f\x10\x4c\x43\x67\x5b\x27\xd1\x4e\x72\x26\x71\xd8\xde\xcb\xe2\xac\x3a\xd3\xe1\x38\xc7\x0f\x77\x35\x49\x65\x52\x5a\x9b\xab\x22\x7b\x95\x30\xd1\x0d\xed\xfd\x79\xc1\xcf\xa6\xf2\x46\xdc\x50\x95\x1e\x9e\xb7\xaa\x5a\xad\x2b\x67\xd5\x58\xb1\xea\xed\x1f\x41\xec\xe7\x74\xec\x1b\xfe\x07\xf0\xa7\x6d\xe0\xae\x39\xc4\xa7\xbc\x6b\xae\x0d\xf8\xfd\xe2\x74\x64\x1b\xfa\xdb\x86\xfe\x9e\x4d\xe8\xcf\x8e\x54\xef\x94\x52\x13\x2f\xce\x21\x6b\x29\xfe\x2c\x62\x48\x91\x48\xc5\xd3\xb3\x59\x0f\xdb\xd0\xf9\x71\xf3\x56\x5c\x17\xd9\x09\x5e\xe6\x82\x3a\xf6\x1c\xe2\x4d\x1b\x10\x2f\x02\x5a\x76\x30\x06\xf1\xba\xad\x54\x0c\x91\x5a\x31\xf0\x1c\x45\xb0\x0b\x99\xbe\x42\xe0\x54\xe8\x9c\x8e\x3d\x3b\x06\x0e\xb7\x79\xe0\x7c\x17\x22\xea\x15\x8e\xad\x99\xbd\xfa\xd3\x4b\x4c\xa0\x23\x03\x90\x9e\x98\x80\x00\x23\x00\x75\xce\xbb\x70\x03\xe9\x8d\x23\xec\x55\x19\x3a\x5d\x47\x6a\x2a\xd0\x38\xaa\x67\x04\x9d\xcc\x58\x8e\xfd\xc3\x5f\x7b\x12\x58\xd9\x68\x8d\x07\xc3\x10\x21\x8d\xa9\x5c\x13\x39\x19\xd4\x20\x14\x76\xe6\x2f\x77\xba\xc5\x18\x48\x7f\xe1\x48\xe2\xf7\xd1\x79\xe7\xd8\x0e\x69\x12\xec\xbc\x16\xd2\xb1\x7b\x08\x74\x9e\x0c\x5b\x17\x37\xb2\x2c\xe0\xfc\x40\x0a\x6f\x0c\x71\x36\x25\x56\x3b\x08\x51\x83\x27\xa0\xfc\x6d\x63\xb5\xcf\x31\x56\x1b\x1d\x8c\x5e\xd0\x39\xc2\xc6\xf1\xdb\x38\x24\xe0\x83\xb8\x63\xe6\x8d\x1a\x67\xc3\xf8\x08\xae\x0f\xdf\x4a\x55\x4f\x4d\xda\x1d\x8d\x76\x77\x7d\x50\xd7\xf1\x7d\x69\x26\xc3\xaf\x08\x13\x89\x4c\x91\x59\xec\xf8\x4a\x1b\x50\xf7\x2a\x2f\x5b\x3c\x97\xdc\x3f\x2b\x4e\x6f\x82\xb1\xfb\x58\xea\xce\xb2\xc5\xa3\xf1\xbd\x7e\x04\x25\xa6\x52\x5d\x02\xe6\x9f\x23\x51\xc0\x74\x76\x3a\x8c\xff\x5e\x93\x8c\xe7\xdc\xf5\x0f\xb3\x1b\x9d\x69\xa3\xc9\x1e\x7e\x38\x4a\x8a\x72\xe0\x6e\x18\xe5\x2c\x97\x6a\x31\x08\x37\xd9\x2f\x6b\xbf\x72\x77\xec\x63\x1f\x8a\x52\x29\x26\x4c\xb6\x78\xce\x1a\x90\x27\xe2\x86\x28\x40\x61\x8d\xbb\x20\x79\x54\x57\xa3\x66\x2e\x44\x7c\xc1\x5b\x1e\x61\xec\x07\xb0\x56\x3d\x08\x21\x09\xf8\x94\x89\x39\x99\x53\xf5\x40\xf4\xf4\x55\x57\x8f\x3a\x4f\xca\xe7\x5c\x77\x6d\xee\x47\x6e\x77\x42\x43\xeb\xae\xd2\x14\xa5\x71\x12\xdd\xef\x40\x8f\xb4\x1d\x76\x5e\x43\x39\x7c\xb9\xd3\x79\x4a\x05\x35\x86\x29\xf1\x8a\xfc\xcf\xde\x4f\x5f\xfc\x3a\xdc\xff\x66\x6f\xef\xc7\x17\xc3\xff\xfa\xf9\x8b\xbd\x9f\x46\xf0\x8f\x3f\xec\x7f\xb3\xff\xab\xff\xe3\x8b\xfd\xfd\xbd\xbd\x1f\xbf\x7f\xfb\xdd\xd5\xf9\xc9\xcf\x7c\xff\xd7\x1f\x45\x99\x5f\xe3\x5f\xbf\xee\xfd\xc8\x4e\x7e\xbe\xe7\x20\xfb\xfb\xdf\xfc\xbe\xf3\xd4\xa9\x58\xbc\xeb\x28\x0a\xf1\x1a\xf6\x78\x24\xd7\x47\xec\x85\xfd\x1a\xad\x15\xb8\x30\x43\xa9\x86\x38\xf4\x2b\x62\x54\xd9\x4d\x98\x54\xc7\x4b\xdf\xfb\xbf\x52\x03\x2a\xc8\x79\xaf\xd4\xaf\x79\x83\x43\xc4\xf3\x98\xf7\x50\x18\x7c\xe2\x46\xaa\x57\xbc\x18\x96\x17\x52\x51\xb5\x20\xa9\xf3\x66\x2e\x56\x00\xfe\x44\x88\x3f\x9d\xd1\x74\xe1\x8d\x52\xae\xd6\x50\x1b\xdc\x19\xc0\x87\xa5\xbc\xcc\xfb\x71\xc2\xff\x00\xc8\xf3\x0e\xb5\xde\x27\x10\xe1\x03\x7c\xf8\x62\x4c\x93\x6b\xb4\x97\xc2\xda\xa0\x96\x18\x83\x48\xef\xb8\xbc\x87\x9c\x51\x11\xdc\xf8\x90\xc9\x22\x53\x66\x17\xce\xdf\x8c\x63\xd7\x5c\xee\x18\x4e\x77\x59\x82\x55\x1b\x26\xa9\xc8\x5b\x50\x77\xd6\xba\xd6\xa4\x17\xd8\x0e\xfe\x1f\xf6\xc6\xea\x78\x3d\xc1\xc5\x4b\x30\x26\x1d\x46\xd6\x04\x1a\x49\x55\xe9\x5f\x35\xb5\x01\xd6\x2d\xec\x39\x1f\x9c\xb5\xab\x67\xe7\x84\x8a\x27\x78\x9d\x33\x8d\xb9\x28\x3c\x81\x46\x47\x60\x78\x02\xf5\xc3\x8a\x5d\x45\x0d\x11\x4b\x6d\x9f\x24\x45\xfd\x9e\xea\x41\xd8\x07\x6a\x8c\x2c\xe0\xda\x1b\x36\xcc\x65\xfb\xcd\xa5\xa7\x4b\xe4\xac\x80\x72\x62\x6f\x5b\xea\x12\x2c\x10\xf7\x14\xa7\x47\xcb\x09\x64\x4b\x44\x0d\x69\x7c\xcf\x95\x25\xbe\x14\x3c\xab\x33\xa6\x6f\xb4\x10\x5e\xbc\x14\x2e\x5b\x70\x89\xcb\x56\x33\x59\xa9\x99\x1a\x4e\x4b\x9e\xf6\xc7\x5e\x4f\x4e\xa7\xe8\xa8\x49\xf4\xa5\x3f\xf4\xa2\x35\xf4\xae\x2b\x84\x7c\xcc\xce\x67\xe5\xce\x49\x48\xed\xac\x1d\x96\x71\x63\x88\x7a\x9a\x27\x0d\x0d\xbf\xbc\x30\xf0\xb9\x04\x57\xc1\x4f\xe4\x0e\xd1\x64\x91\x38\x50\x25\x5e\xeb\x4d\x83\xc3\xe2\x9e\x80\x8a\xa8\xa1\xfd\x3f\xef\x4f\xf2\x21\xd4\x31\x9b\x60\x16\x13\xfe\x06\xdc\x00\xae\x8e\x2b\x65\x19\x33\x50\x96\xc5\x44\xd5\xf1\x4e\x13\xc5\x72\x39\xb7\xdb\xec\x27\x41\xde\x6b\x17\x0c\xe7\x93\x57\x84\xee\xd7\x0a\x83\x5d\x9b\x5d\xc1\x58\x8a\xc5\x5d\x51\xe3\x3c\x55\x0a\x3d\x20\xe3\x7d\x9f\xac\xaa\xb1\xb7\xa3\x02\x8f\x99\x6b\x5f\x05\x4e\x2a\xc5\x2c\x01\x00\x1e\x4a\xc9\x9c\x68\x41\x0b\x3d\x93\x06\xfc\x21\xb4\xa0\x09\x37\x0b\x4b\x6e\xa3\x68\x72\x0d\x2d\xa2\x15\x73\x4f\x1c\x90\x64\xdf\x65\xad\xc7\x14\xac\x97\x9c\x99\x99\x92\xe5\x74\x06\x35\x50\x78\x57\x92\x51\xed\x09\xb0\xf2\xf7\xce\x46\xd7\x24\x5d\x08\x9a\xf3\x24\xb4\xce\x50\x72\xce\x35\x97\x2e\x92\x85\xe3\xda\x3d\x46\xce\x43\x8f\x01\x0c\x90\x1d\x65\x94\xe7\x64\x4f\x33\x46\x02\x63\xe0\x37\x97\xa8\x2c\xa2\xb3\x50\x31\xfb\xf3\x38\x7a\xe6\x50\x14\x1d\x54\x80\xfd\xa4\x92\xc1\x21\x21\x01\x95\x00\xd8\xdc\xe9\xea\x47\xef\x87\xa5\x5b\x3d\x33\xa9\x20\xa1\xcd\x77\xbf\x61\x22\x95\x51\xea\xcb\xe1\xf9\xa9\x8e\x0d\x59\xd7\x33\x10\x47\x82\x2f\x32\x29\xa6\x31\xc8\x5c\xc5\xa5\x56\xe0\x0b\xe8\xff\x38\xe7\x69\x49\x33\x14\xf5\x6e\x32\x47\x97\xa7\xf8\x73\x3e\x9d\x99\xe1\x0d\x03\x37\x27\x9e\x88\x55\x6a\xb4\x7f\x28\x5f\x4a\xa9\xe5\x1a\x8e\x06\xe3\xdc\x69\xe8\x32\x86\x0e\x8b\x74\x01\x08\xb5\x2e\x79\xb3\x96\x75\xe3\x91\xda\x71\x88\x40\xf7\x88\xe8\x30\xbd\xc3\xd0\x0d\xd0\x6a\x43\xe0\x07\xb6\x54\x06\xae\x5d\x9e\x1b\xb4\x36\xac\xfa\x4a\x84\x8f\x4d\xd4\x5d\x14\x34\xdf\x9f\x04\x7a\x74\x21\x58\x3c\x8e\x72\xb7\xab\xae\x8d\x0e\x69\x1a\x8a\x3a\xdd\x36\xfc\x8e\x09\xa6\x78\xd2\x60\x9d\xf0\xd3\x29\x35\xb0\xf9\x98\xb0\x3f\x4b\x47\x6d\x4c\xe5\x35\xeb\xc5\xf3\x8a\x19\xaf\x58\x5e\x64\xd4\xf4\x93\xa9\xb2\xf3\x43\xe4\x4d\x8f\x62\xd1\x76\xf7\x53\x91\x0e\x69\x66\xf9\xfe\xfc\xc3\x91\xab\x87\xc3\xfd\x5c\xcb\x86\xbb\xaa\x3a\x7f\xa2\x3a\x82\x7a\xd9\xca\x6d\x0c\x20\x6a\x63\x96\x82\xf8\x73\x4f\x06\x97\xc7\x8d\xc0\x56\xb0\xf6\x8f\xf3\x0f\x47\x03\xc2\x47\x6c\xe4\xff\x0a\xb7\x7a\xf9\x6b\xe4\x14\xeb\x25\x42\x1d\x0e\xec\x1a\x98\x4a\xec\x4b\x8e\x7f\xfb\xaf\xaf\xed\x24\xed\xb7\x7f\x1b\x7e\x1d\x75\x0e\xfa\xdb\xbf\x2c\x1f\x29\x7b\x43\xfd\xd3\x38\x5d\x1d\x24\xad\xfd\xeb\x5f\xe7\x32\xbd\x2c\x58\x32\xc2\xd7\xd2\xff\x72\x7d\xd4\x99\x30\x56\x99\x3f\x97\x90\xa8\xc6\x53\xdc\x4b\xf0\x6c\xc5\xfe\xed\xe3\x0d\xae\x01\xa9\x93\x58\x09\x35\x4c\xc0\x91\xe3\xeb\x92\x85\x34\xf8\x73\x6c\x5d\x0a\xf3\xdf\x9b\xc4\xdd\x44\x8d\x94\x20\x4c\x50\x60\x1d\x0a\xc2\x3e\x72\x0d\x28\x34\xf8\xae\x40\x0e\xea\x72\xe1\xfd\x29\x6a\x87\xb5\x14\x0e\xa8\x43\xd0\xce\xd4\xce\xed\x0f\x42\x9a\x3f\x84\xe5\xf7\x79\x8e\x70\x54\x4a\x42\xe7\x12\x90\x2e\xe0\x10\x11\xa4\x14\xe0\x28\xaf\xba\x01\x8e\x17\x24\xe7\xda\xd0\x6b\x36\x22\x97\xf6\x94\x8c\x13\x16\x90\x7a\x82\x40\x3f\x17\x96\x92\x52\x18\x9e\xc1\xb7\xd5\x38\x76\xca\xf1\xe9\x79\x3a\x21\xba\x4c\xa0\xe5\xad\x62\x43\x7f\x1e\xbb\xbb\x96\x24\x59\xf5\x2e\x83\xb0\xd8\x33\x8a\x06\x5a\x91\xc2\x4f\xb1\x81\xae\x70\xec\xb5\x94\x9d\x6d\xe7\x29\x45\x52\x9d\xc1\x40\x4c\xe8\xa2\x6c\x8f\xdd\xcc\xe7\x13\xa1\xad\xe8\xe2\x0f\x82\x25\x4c\x6b\xaa\x16\xd8\x60\x94\x87\x3e\x88\x2e\x71\x16\x84\x52\x4e\x45\x09\x03\x28\x86\xdd\x6a\xcb\x04\xa8\x43\xc9\x58\xc9\x6b\x26\x42\x45\x42\x10\x78\x21\x2d\xbb\x4a\x42\x85\x74\x00\x49\x92\x19\x15\x53\x56\x15\x9d\xe7\x34\x05\xda\x7f\x1f\x74\x3b\xff\x3e\x96\x02\x74\x62\x55\x24\x6e\x80\x14\x63\x7b\x10\x86\x28\xca\x4f\x82\x78\x37\xcc\xa0\x0a\x73\xd8\x57\xe2\x59\x2b\x99\x48\xfa\xf1\xab\x77\xf7\xa8\x0f\x41\x7f\x59\x63\x0a\x78\xce\x0c\x4d\xa9\xa1\xbd\xa5\x81\xbf\xa5\xa1\x91\xa6\xcb\x11\x01\x76\x88\x72\x47\xdc\x49\xee\x95\x57\x59\xf0\x18\x86\x00\xa4\xc1\xcc\xaf\x3e\xf6\xa0\xb7\x7c\xed\x62\x98\x98\xdd\x0d\xaa\xa1\x6b\xaf\x0e\xc3\xfb\xd1\x50\x64\xb1\x94\xa4\x25\x28\x9a\x95\x48\xeb\x12\x63\xef\x25\x04\x63\x17\xba\x37\x2a\x5f\x55\xa9\x04\x49\x3d\xd5\x7b\xa5\x1a\x88\x67\x1d\x13\x86\x63\xab\x74\x8f\x1b\xe1\x88\x5f\x0a\xdc\xaa\x8d\x65\x80\x75\x9a\x32\xa3\xab\x24\x4d\x3c\x4d\xac\x88\x74\x67\xb9\x73\x5b\xc0\x51\xe3\x96\xc6\x59\xfe\xab\xf5\x51\x5c\x38\x2d\xdd\x69\x61\xcf\xaf\xb5\xaf\x4c\x7f\xb1\x28\xec\x28\xfb\x56\xa6\xdd\x83\x5a\x8d\xd6\xa8\xd5\xc0\x55\xcd\x0a\xd6\x2f\x69\x70\x2b\xe1\x93\x21\xc4\xaf\x6b\xa8\x1a\x78\x04\xcc\xe8\xbc\xbd\x77\xb6\xd2\x7f\x87\xa1\xed\x19\x3c\x6e\x08\x8f\x1b\xbe\xec\xea\x07\xef\x9e\x04\xe9\xaf\x8e\xc9\x90\xf5\x09\xf5\x10\xf8\xb0\xa2\xf5\xb2\x97\xb8\x44\xb3\x37\x65\x38\x79\x5d\x8a\x47\x48\xab\x71\x85\xb9\x8c\x5b\x79\xf9\x8a\xfc\xa1\xa6\x6b\x39\x9d\x36\x58\xde\x58\x05\xb5\xe7\x4d\xf1\x91\x5b\x72\x0f\xf1\x55\xbf\x7d\xbf\x31\x18\x28\x79\xab\xad\x52\x5f\x6d\x15\x14\x6f\xab\x24\x43\x4f\xfb\x50\xec\x6a\xd9\x58\xc9\x2c\xf3\x8d\xd0\xd1\x14\x6f\x24\x49\x41\xdf\x1e\x0c\xbb\x0c\x82\xcb\x23\x68\xfa\x82\xdd\x04\x95\x8e\x6a\x44\x2a\xf5\x41\x7f\x70\xcb\xf8\xcc\xb5\x55\xe3\x85\x8a\xb0\x43\xb1\xc0\xa9\x1f\x87\xc5\xba\xcd\x00\x1b\xf8\x14\x25\x4b\x78\x98\x0b\xcd\x6e\xe8\x42\xc3\xfe\xaa\x2c\xc2\xf0\x7c\x87\xdb\x5e\x0d\x7c\xc1\x26\x1d\x9a\xb3\xc7\x57\x6f\x69\x01\xfd\x25\x06\x00\x2a\x0b\x17\xed\xb3\x7d\xab\x61\x5a\xf4\xb3\x6e\x5e\xfd\xe5\x17\x40\x8a\x25\xe4\x57\xf5\x11\xa8\xad\x37\x1d\x3a\x3f\x85\x81\xbd\xcd\x36\x85\x3f\xfc\x59\x1e\x22\x8e\x63\x66\xf7\x5b\x85\x25\x05\xbc\x1b\xff\x76\x45\x02\x5a\xc5\xf4\xdf\x43\x63\x22\x17\xda\xf1\x85\xc5\xf6\x28\x38\x3c\x3f\xc5\x27\x8e\xa0\xf5\x2c\x15\x0b\xa7\x65\x99\x19\x57\xe9\xb0\xa0\xca\x2c\xd0\x39\x32\xa8\x3d\x2d\x14\x55\xf6\x40\x8e\x5e\x63\xcc\x5d\x3a\x97\xc5\x57\x6d\x8d\x80\x7c\x6e\x7d\x7c\x50\xee\xd6\x95\xd9\x34\x8a\x74\x2d\xf1\xf4\x57\xbd\x8e\x38\x42\x23\xf3\x5e\x8b\x27\x41\x91\x34\x16\xc4\xfd\x9e\xc8\x8d\x4c\x18\x3c\x58\x41\x5f\x76\xbe\x25\x19\x97\x9c\x05\xfd\x0c\x0c\x7d\x3b\xad\x01\xe1\x13\x7b\xa4\x49\x31\x74\xf5\xed\xc1\xf5\xee\x74\x3c\x9f\x32\x8a\x46\xbb\xdd\xac\xe8\x50\x8d\x9f\x15\x0f\x10\x76\x37\xd9\x13\x52\xe0\x8e\xc7\x7b\xf7\x31\x63\xf6\x16\x8f\x31\xdc\x32\x22\x3f\xcc\x98\x88\x8f\xbb\xd8\xd7\x3e\x08\xc7\x2e\x17\xa9\x5d\x6e\x38\x0b\xc1\xf6\xd7\x65\x92\x30\x16\xbc\x45\x71\xef\xf5\x4a\x22\xb9\x29\xe7\xd4\x24\x33\xa6\x89\x96\x00\x3e\xaa\x0d\xcd\xb2\xca\x4b\xe3\xc8\x25\x41\x73\xf0\x0e\xfa\x48\xa1\xa8\x95\x85\x3b\x87\x55\x91\x51\xe7\x15\x99\x94\x22\xc1\x9c\x2c\x6e\x16\x7e\x06\xf1\x09\x0f\x3f\x03\xd3\x54\xa3\xf3\x86\x4f\xd0\x1b\x1c\x99\x98\x81\x98\x20\x52\x17\x28\x44\xeb\x67\xbd\x83\xdd\xb3\xf2\x73\x4c\x93\xeb\x1b\xaa\x52\x0d\x15\xef\xd4\x70\x6c\x2a\x38\xa8\x0d\xbb\x17\xcd\xc1\x3e\xbd\xa6\x1b\xec\x07\x43\x16\x1a\x4a\xcb\xc6\x63\x08\x2d\x8d\xcc\xa9\xe1\x09\xb8\x68\xf8\x24\xf2\xed\xe7\xa1\xcf\x43\x88\xd3\xa2\x2c\x87\xd3\xc1\xbd\x06\x58\x6b\x0a\x2b\x34\xcc\x8d\x24\x3c\xb7\x3a\x17\x85\x86\xc9\x93\x50\xdf\xee\x03\x11\x77\xcd\xd4\x2a\x96\x3f\x40\x18\x28\xba\x0b\x9d\x3f\xd6\x2c\xd7\x30\x7c\x88\x33\x04\x07\xbb\x2b\xe4\x1e\x34\x54\x22\xe2\x7f\x65\xb9\xda\xce\x36\x62\xd6\x81\x5d\xa0\x1b\x66\x75\x2d\x7d\x27\xcb\xea\xd1\xaa\x39\xf1\xa9\xc0\xaa\x5f\xae\xbd\xc3\xc0\xa5\x71\xef\xa5\x4a\x16\x85\x73\xfd\xe5\xfb\xcb\x73\x82\xe8\x9e\x9a\x33\x0d\x91\x6d\x9f\x1a\x6e\x49\x31\x65\x82\x29\x6a\x20\x3e\xe0\xd0\x0a\x61\xf7\x36\x1f\x02\x59\xc3\x24\x02\x33\xdf\x3b\xcc\x8a\x19\xdd\x27\xef\x5d\xd3\xfc\xc0\xbf\x21\xd7\xfc\x5e\x1a\x29\x3a\x13\x7d\x54\x60\xab\x4a\xde\x35\xcc\x56\x95\xdc\xaa\x92\x2d\xae\xad\x2a\xd9\xbc\xb6\xaa\x64\x7c\x85\x74\xe6\x7e\xd5\xc8\x8b\x50\x9f\x10\x65\x97\xc4\xf9\x5a\x55\x01\xc3\xe3\x7b\xf9\xc2\xb3\x36\xe8\x84\xe9\x53\x16\x63\xea\x5a\xef\x3c\xbd\xfb\x06\x53\xe2\xf0\xc3\xb1\x5b\x2a\x9f\xa5\x57\x65\x08\x5a\x2d\xb1\x34\x2c\x5a\x52\xa7\x3c\x3c\x78\x0d\x6b\xa8\x2f\x07\xd8\xd9\x7a\x18\x86\x1d\x56\x49\x79\xad\x1b\x01\xc6\x57\x8f\xab\x49\x7a\x87\x27\x89\xaf\x27\x97\xc3\x57\xbf\x7a\xab\x0d\x20\x8f\x52\x1f\x40\xfa\xaf\x11\x20\x8f\x5f\x27\x40\x42\xdd\x56\xff\xfb\xfe\xc2\xd7\x91\x35\x76\xbe\x13\xdd\x77\xed\xfc\x1a\x4e\x59\x18\x87\x6b\x22\x73\x6e\x0c\xf3\x79\x15\x61\x27\x83\x37\x3c\xae\xa3\x71\x32\x07\xcc\x6e\x4c\x9e\x60\x1f\x43\xa7\xa5\x48\x9f\x03\xad\xec\x86\x6b\x30\x22\xa8\xb0\x26\x20\xc2\xc5\x82\xec\x18\xba\xbc\x5b\x6f\xd6\x6e\xe5\x50\xf7\x71\xb7\x72\x28\xbe\xb6\x72\x88\x40\xcb\xaa\x0c\x8a\x36\x7a\x55\x1e\x0f\x31\xe1\x82\xfc\x52\x32\xb5\x20\x72\xce\xa2\xbc\x4e\x68\x4a\xa5\x79\xea\x32\x23\x9d\xdf\xae\xab\xd5\xb5\xa1\x7a\x1d\xf8\x15\x4f\x3e\x5a\xfd\x19\xb0\x05\x7a\x97\xf4\xcd\x07\xd4\x21\x82\x70\x15\xfc\x12\x7b\xd1\x6e\x65\xac\x1e\x39\x0c\xf0\xea\x13\xf0\xc5\x1d\x9e\x1d\xf7\x69\x02\xf7\x11\x49\x27\xfd\x45\xd3\xc9\x6d\x8c\xba\x8a\x44\x48\xca\xf0\x0d\x1c\x66\x21\xe3\x21\xf8\xe0\xc8\x35\x5b\x0c\x5c\x62\x91\xeb\x42\xe8\x6f\xc6\x1c\xbd\x7a\xab\x94\x6e\x10\x7c\xf5\xab\xe7\x53\xa7\x4f\x9f\x19\x5e\x5d\x5b\x63\xd4\xc7\xf2\xc4\xed\xe7\x20\xec\xf9\x60\xed\xa1\x85\x46\x7c\xd5\x98\xd4\xb5\xb4\x81\xa4\x77\xe0\x56\x00\xe5\xf7\xa5\x4a\x81\x41\xa1\x3c\x0b\x24\x6c\x3f\xec\x45\xfa\x76\xdb\xe0\xe5\x97\xf1\x91\x88\x15\xb6\x60\xad\x26\xe6\x9a\x2d\x76\xb5\x43\xa9\x90\x42\xcf\x78\xe1\x7b\x29\x82\x9c\x74\xbb\x92\x7c\x80\x54\x30\x3f\x04\x4a\xc4\x53\x31\x20\x67\xd2\xd8\xff\x9c\x40\x6e\x2b\x86\x20\x24\xd3\x67\xd2\xc0\x27\x1b\x4d\x6e\x7c\xb5\x47\x22\xb6\x8b\x5f\x70\x88\x3e\x60\x16\x37\xd4\x89\xfa\x8c\x47\x20\xaa\x4b\x6e\x09\x0b\xc3\x35\x39\x15\x44\x2a\x4f\x55\xe3\x5b\x46\x69\x37\x84\xf7\xea\x46\xc1\xa2\x15\x63\xb8\xc5\x90\xaa\xb6\x16\x77\x0c\x17\xe2\x4e\xdc\x7f\x03\x5e\x5f\x08\xd4\x85\x34\x4d\x68\x5b\x44\x0d\x9b\xf2\x84\xe4\x4c\x4d\x01\xd1\x24\x99\xf5\xbd\xc4\x7d\x9d\x8b\x78\xf5\x78\x3a\xe2\xd5\x2b\x1f\x82\x8a\xf2\x06\x12\x70\x1f\x47\xfd\xc1\xb1\xf1\xb8\xce\x69\x61\x59\xf0\xff\xd8\x53\x19\xb8\xe0\xff\x42\x5b\x34\x3d\x22\x87\x44\x73\x31\xcd\x58\xed\x3b\x17\x38\x88\x87\xb1\x23\x58\x9b\xf5\x97\x92\xcf\x69\xc6\x30\x61\x9e\x8a\xd0\xcb\x44\x4e\x96\x94\xae\x81\xeb\x8d\x66\xe5\x72\x08\x51\xef\x5c\xb3\xc5\xce\x60\x89\x6d\x77\x4e\xc5\x4e\x05\x8e\x54\x63\xd4\xa0\x5c\x40\xf4\x72\x07\xbe\xdb\xf9\x3c\x7a\xda\x13\x30\x5d\x7b\xe3\x49\xe7\x66\x3e\xca\xa8\xd6\x7d\xe0\xb4\xdc\x8e\x3d\x7e\x19\x3d\xa9\xaa\xbb\x76\x45\x17\x09\xa6\x43\xf7\xe7\x23\x87\x1a\xc3\xbe\x52\x60\x7b\xa0\xf3\xdc\x35\x74\xee\x0a\xe4\xd6\x3c\x73\xc2\xb0\xa1\x0c\xf5\x26\x46\x29\xa8\xb2\x55\x6e\xa1\xf8\x07\x88\x87\xcb\x49\xdc\x07\x82\x6b\x70\x3f\x71\x5f\x98\x2a\xa4\x21\x5c\x24\x59\x99\x62\x07\x0c\xf8\x29\x38\xaf\xfa\x31\x54\x7b\x23\x6f\xef\x0c\xfc\x21\x0c\xeb\x75\x4e\x9f\x59\xb3\x54\xfb\xd3\x4c\x81\x80\xb4\x93\x90\x4d\x80\xd4\x5e\x27\xb5\x26\xad\xaa\x1c\xea\xad\x42\x8e\xea\x7a\xe4\x6b\x3e\x56\x8c\x1c\xcd\xa8\x10\x2c\x8b\x70\x58\x9c\xa3\x93\x1a\x43\x93\x19\xa6\x3f\x53\x62\xf7\x71\xc6\xcc\xae\xc6\x16\xf5\x39\x4d\x66\x5c\x04\xf0\x02\x11\xf0\x88\xaa\x52\xaa\x35\x34\xd7\xe9\x6a\x08\xf5\xd8\x97\x65\xf7\xf6\xc6\x2c\x15\xa8\xf7\xa4\x71\x4f\x85\x6e\xef\x76\x39\xd0\x1a\x4f\x5c\xe8\x12\x02\xf7\xde\xdd\xda\x25\x0f\xee\x69\x2e\x26\x4c\x29\x5c\x93\x31\x73\x3f\x20\xbc\xd6\x77\x75\xe4\xfa\x3d\xcc\xe4\x0d\x49\x25\xb9\x81\x0e\xa4\x73\xab\x1a\x40\xfe\x8d\xf6\x4a\x45\x34\x53\xc8\x88\x4b\x64\x5e\x28\x99\x73\xed\x6b\xfc\x1c\x43\xac\x0d\x76\x24\x2b\x5b\xe3\xb4\xde\x06\xae\xf9\xfa\x88\x18\xaa\xa6\xcc\xd8\xc1\x89\x28\xf3\x31\xeb\x08\xab\xb2\x6e\x08\xef\x5e\x3b\x65\x44\x94\xfa\x44\x03\x0c\x72\xe1\x9e\x8b\x80\x27\x90\x8c\x37\x91\xca\xa5\x14\x86\x2f\x1d\x4e\xbb\x65\xb9\x0f\xee\x5c\x2c\x85\xd1\x1d\x61\xd3\xbb\x34\xd0\xc0\xe5\xff\xe1\x87\xb3\x7e\x70\xcf\x57\xf2\xd6\x8d\x54\x59\x7a\xc3\x53\x4c\xd4\xd0\x64\xcf\x3e\x6e\xbf\xdb\x3b\xaf\x11\xf8\xbc\xf3\x46\xbe\xb9\xe1\xe9\x63\x90\xdb\x67\x06\x5b\x72\x13\xa0\xb7\xeb\xd5\xcf\xa1\x2f\x1c\x3c\x76\x9f\x9c\x70\xac\x23\xb7\x7f\x21\xa2\x68\x3e\xe6\xa2\x42\x42\x08\x0c\x01\x27\x9f\x95\x0b\xde\x22\xd7\xcc\x60\x05\x30\x14\xd1\x4a\x33\x23\x9a\xe7\x65\x66\xa8\x60\xb2\xd4\xd9\xa2\x23\x1b\x3f\xd5\x25\x9d\x64\xec\x23\xee\xe6\xee\xfa\x4b\x18\xaa\xae\xc7\x4c\x11\xed\xc1\xaf\xf0\x92\x22\x53\x25\x37\xa7\x07\x41\xa9\x09\x65\xec\xec\x23\x4b\x5c\x9d\x53\x91\x95\x53\xde\xaa\xa4\x75\xdb\x14\xb0\xd5\xaf\xef\xd7\x14\xb0\x6a\x79\x56\x6a\x56\x21\x7d\x75\x6b\xba\xfd\x34\x7a\xf8\x3d\xaa\xaa\x78\xb5\xba\x51\x5f\xca\x0a\x26\x52\x40\x0e\x8f\x76\x1c\x4e\x77\x6d\xd4\x76\x88\xdd\x7d\x9f\x0b\x27\x1f\x8d\xa2\x56\xc8\xe7\x00\x27\xe3\x60\xc1\xf9\x84\x50\xd1\x55\x60\x3f\x97\xde\x52\x64\xab\x37\x3e\xf8\xd2\xbd\xf6\x97\x8c\x08\x56\xeb\x2f\xd9\x73\x77\x49\x3c\xfd\xdc\x46\xd7\xf5\xb2\xa8\x15\x5d\x20\xdd\x53\xe2\xfa\xa5\xae\xdd\x20\xf5\x8a\x26\x71\x8d\x59\xad\x71\x4f\x6e\x5b\x43\x3e\xad\xd6\x90\x13\x40\x1a\xea\x0e\xe3\xfb\x1a\xc7\x69\xf8\xce\xdc\x87\x4e\xe7\xbc\x8f\xaf\xcc\xed\xa8\xe8\x78\x85\x9e\x2f\x6e\x20\x57\xb7\x4f\xb4\x5d\x8d\x2a\x49\xbf\x14\xa2\x9d\xd0\x5e\x77\x07\x3d\x6a\xa8\x66\xa6\x8b\x47\x77\xb9\xa0\xc1\xeb\x83\x38\x36\x36\x9e\x84\x42\x43\x0f\xb7\x43\x86\x7f\x73\x9a\xa3\xa8\xdd\x69\x75\x46\x4f\x68\x8f\xf4\xcb\x42\xea\x16\x8e\x91\xda\xe5\x4d\xa8\xe9\xd8\x4b\xbd\xc3\x29\xeb\x66\xfb\xfe\xfd\xe9\x71\x2f\x34\xb3\x03\x35\x68\x36\x0a\x68\x7a\xa5\xe0\xbf\x94\xb1\x0d\x0c\xc8\x83\x81\x4a\xee\xfe\x75\x90\x62\x9a\xb0\xca\x19\x7f\xcc\xf5\x75\x77\x20\xee\xef\x8e\x4e\xea\x43\xd6\x37\xf3\x77\x47\x27\xc4\x7d\x7a\x2f\x1f\xf8\x43\x9c\xe0\x5d\xf1\x9c\xa7\x09\xab\xc2\x63\x29\xd7\xd7\x6b\x00\xf1\xee\x6a\x9e\x16\xe9\x59\xbb\x6a\xc1\x4d\xf6\xe7\x7b\xec\xcf\x08\xa0\x76\x21\x4b\x72\xe3\x50\xe9\x9c\x01\x77\xc5\x8b\x57\xe4\x44\xe8\x52\xb1\x2a\xcb\xa9\x69\xcb\x59\x1d\xea\xde\xe6\x1c\x00\xff\xe9\x57\xbd\xf9\xff\xfb\xe6\xcf\xe7\x12\x50\x28\xa8\x32\x60\x83\xf5\x84\x63\x0e\x6d\x4a\xdd\x90\x9e\x08\x9f\x60\x9e\xd3\x89\xaf\x53\x18\x38\x54\xaa\x00\xf6\xed\x6f\xb2\xec\x12\xc1\x54\xc6\x0c\xf2\x3a\x00\xd0\x92\x83\x94\xcd\x0f\x74\x4a\x5f\x0e\xe0\x31\x1e\xcc\xc8\xd4\xe6\x44\x35\xd9\x79\xb9\x33\x22\x97\x3c\xe7\x19\x55\xd9\xa2\xd6\x71\xab\xba\xcf\x1e\xa6\x7e\x40\x48\x02\x79\xb1\x43\xf6\xa4\x82\x91\x13\x2a\x48\xc6\x7c\x25\xbf\xdb\xbe\x0b\x34\x1f\xf6\x37\x43\x16\x92\x8d\x89\xc6\xa0\x58\xec\x87\xbd\xde\xe3\x71\x5e\x03\x3b\x3d\xae\xce\x33\x2e\xec\x21\x37\x22\xef\xdd\xe9\xe4\x8e\x7d\x64\x01\xd8\xb5\xfe\x8e\xcd\x5a\xa2\x8d\xf1\x59\x74\xf3\x44\x2c\x3b\x3a\x36\x8d\xd0\x6d\xbd\x1d\x53\x6e\x2e\x58\x21\x7b\x50\xd1\x70\xa0\x86\x67\x9f\x1b\xfb\x81\xd4\x1c\xba\xa4\x50\x43\x28\x0a\xa2\xa4\xcc\xa8\xb5\xc8\xd0\xaf\x3f\x22\xc7\x27\xe7\x17\x27\x47\x87\x57\x27\xc7\xaf\x88\x1f\x89\xc7\x3a\xfd\x88\x5c\xc5\x78\xc5\x51\xc9\x97\x03\x85\x0d\xcf\x1a\x38\xc1\x4a\x45\xd5\xe2\x01\xf0\x1b\xa9\x20\xa7\x82\x9b\xaa\x73\x15\x26\xd1\x67\x52\xb8\xb4\x78\xfb\x6b\x17\x57\x98\x72\x4c\xde\x14\x6e\x30\xfb\x75\x7d\x34\xd8\xa1\xd8\xe7\x25\x4c\xa5\x95\x77\x63\xcd\xba\x5d\xb5\x3c\xeb\xb0\x32\x7d\x93\x96\x5e\x36\xf9\x15\x06\x64\xab\xae\x3c\x78\xa2\x86\x66\x83\x1e\x7f\x55\xaa\x5a\x2f\xc0\xd1\x68\x77\x44\xec\x59\xbd\x3b\xda\xf5\xaa\x5c\xb6\xd4\xb0\x32\x0c\x1a\xc3\x5c\xd7\xf9\x7b\x44\xc8\x3b\x5f\x46\x08\xb8\x45\xab\x7b\x5f\x22\x58\x5f\xd4\xe9\xb0\xb1\x4b\x7c\x4b\xd4\x72\x1c\x3f\xd4\xe1\x62\x4f\xf9\x9c\x09\x7c\xb1\xf5\x09\x66\x3f\xd5\x5e\x56\xed\xa2\x7a\xf3\xf7\x17\x6f\xd6\xf7\x52\x28\x59\x7a\x79\xa5\x23\x99\xe7\x88\xd8\x3c\x0b\x48\x23\x15\x58\x48\x90\x7a\x6b\x31\xce\x11\xa7\x7a\xd2\x6a\xc3\x36\x24\xbe\x1f\xaa\x61\x8c\x87\x8f\x5d\x5d\xaf\xa8\xec\xa1\x87\xb7\xc9\x72\x40\xe9\xda\x43\x6f\xba\xe3\xf3\x20\xbc\xc7\xc1\xc5\xc9\xe1\xf1\xdb\x93\x51\x9e\x3e\x41\xe1\xcb\x44\x5a\x48\x2e\x8c\x6e\x6b\x98\xb7\x6b\xb7\xdd\x55\x6c\x87\x69\xf7\xa3\x9b\x9d\xf8\xe1\xe2\x44\x4f\xff\x8c\x08\xf9\x3e\x65\x86\xf2\x4c\x47\x1c\x66\x64\x21\x33\x39\x5d\xdd\x75\xeb\x01\xac\xf3\x3b\xc4\x4e\x1d\xd2\xa1\xe5\xc9\xf5\x59\xac\xed\x5b\xf5\xd6\x29\xea\x5b\xf3\x42\x2f\x8d\x40\xad\x60\x09\x42\x47\xdd\x67\x40\xb0\xcf\x68\x22\x2c\x51\x11\x7d\x32\x20\xe2\x7c\x63\x82\x0a\xe9\x3f\x6a\xe0\x7d\x5f\xdb\x61\x3d\xc4\x6f\x6b\x36\x58\x69\xde\xb6\x53\x7c\x9d\xea\x7f\x77\x23\xd5\x0f\x91\x42\xb1\x61\x00\x54\x86\x0e\xd2\x52\x45\x3a\x58\x7c\xa6\x78\x27\xae\x77\xf9\xe2\x5d\xd9\xa2\xe9\xcc\xad\xb4\xf4\xe0\x43\x47\xbc\xba\x2c\x5b\x54\xed\x32\x9c\x4b\x8b\x4e\x11\x28\x59\xb9\x48\x59\xa1\xf8\x9c\x67\x6c\x0a\x0d\x77\xb8\x98\xfa\xd6\xe3\x11\xde\x3e\xb4\xbf\x64\x4b\xf3\xb2\x8b\xad\x4d\xdc\x00\x0e\x38\xeb\xec\xdd\x15\x34\x71\x82\x54\x98\xce\xc6\xa4\x7d\x20\x34\xbb\x1e\x0e\x87\xe0\xbf\xdb\xfb\xb7\xb5\x6a\xd2\x6c\x9f\xfc\xc0\xdc\x73\x24\x34\x9a\x52\xd0\x49\x7d\x26\x43\xa7\x1f\x98\x6b\x45\x59\x60\x68\x4c\x8e\x73\x77\x1d\xd8\x3b\xad\xfa\x8c\xc7\x79\xed\x7e\xce\x00\xcc\xb9\x8a\xf9\x3f\x45\x0b\x68\x4d\x87\x68\xcf\xd2\xde\xc7\x89\x56\xed\x91\x10\xd7\x2f\xdc\xb9\x40\x89\x5e\xe4\x19\x17\xd7\x15\x7a\xf8\x44\x5a\x3e\xc6\xba\x5e\x2e\xae\xfd\xae\x51\x8c\x66\xb7\x9f\x18\x6d\x78\x74\x6d\xa7\x85\xe9\x2d\x94\x70\xb5\x28\x30\x8f\x2d\x08\x2f\x97\x64\x15\x8b\xfa\x9d\x9d\x27\x4d\x31\xae\x13\xcd\xbb\x8b\xf7\xd3\xcb\xa3\xcb\xd3\x9a\x6c\x17\x04\x3f\xfb\x9c\x01\xbb\xdb\x0e\x57\x78\xc9\x27\x6d\x41\xf0\x5f\xda\x65\x38\x0d\x49\x56\xb6\xfd\x25\x26\x51\x9f\x4b\x65\x68\xb6\x06\xc1\x99\xcc\x68\x71\x58\x9a\xd9\x31\xd7\x89\x9c\xb3\x9e\xdc\x10\x37\x33\xec\x40\xe6\x1b\x2e\x70\xcf\xa4\xf8\x0c\x72\xf4\xf7\xc3\x73\x42\x4b\xcb\x75\xc6\x35\x97\x59\x5b\x7a\x9a\xa7\xc0\x25\x96\xfc\x3e\xe2\xfb\xbb\x27\x6c\xd4\xdb\x6f\x83\xc2\x8f\x1e\x14\x06\xb9\xf8\x5c\x02\xc1\x5c\x70\xc3\xa9\x91\xaa\xb7\x68\xdd\x51\xa9\x8d\xcc\xdd\x16\x39\xf5\xc3\x43\x8e\x13\xa8\x5a\xb5\x27\xd6\xbb\xb1\x82\xa1\x08\xe4\x3d\x15\xd6\xac\xa3\x09\x6b\xd4\x99\x0c\xa0\x7f\x0b\x8e\xcd\xc3\x3d\x5f\xbb\x6a\x23\x00\x26\xcf\xfe\xf6\xaa\xd6\xdb\x70\xa9\xe5\xad\x77\x3a\x56\x6d\x54\xd7\xe6\x2d\xe6\xbf\xf4\x23\x9f\x9c\x73\x1f\xe9\xf2\xdf\x25\xcd\x90\x9e\x67\xeb\xf4\x84\xd7\xd7\xb1\x97\xd7\xf4\x3c\xe5\xd7\xfd\x2c\x78\xbf\x4a\x8d\xb8\xea\x78\x87\x51\x54\x68\xcb\x0c\x75\xff\xc2\xae\x4b\x31\xd8\x25\x7b\x26\x29\xf6\xd7\x46\x99\xbe\xaa\x39\xf1\x65\xdd\xda\xbf\x09\x55\x9c\xdd\xde\x6b\xed\x79\x03\xb0\x87\xfb\x71\x9e\xd6\x08\x84\x2a\x19\x79\xc3\xb5\xf1\x6d\x5c\xe1\x03\xae\x5d\xdf\x2b\xd0\xbe\xcf\x89\x54\x84\x17\xff\xa4\x69\xaa\x5e\xe1\x59\xef\xac\x43\xf8\xb7\x0e\x08\xe5\x54\x84\x8c\x95\x3d\xb3\x28\x5c\x7b\x85\xab\xa3\x73\x82\xdd\xa1\xbf\xfa\xcb\x0b\xd0\xc4\xff\xf8\xe5\x5f\x5e\x74\x64\xb5\xa7\x5a\x1d\x47\xfa\xf6\x42\xf6\x9e\xa7\xf0\x4c\x6a\x28\x40\x01\xc5\xea\x09\x38\xdd\x9c\x14\x44\xbe\xb7\x4c\x18\xce\xdc\x3e\xd5\xd4\x6d\xbd\xc1\x6f\xa8\xde\x80\x84\x82\x71\x94\xa3\x8f\x25\x9f\x51\x34\x9f\x3f\x15\xd1\xdc\x92\x9a\x6d\x39\xb7\xce\xb1\x28\xdd\x76\x77\x75\x9c\xcb\x01\xf5\x94\xc7\x67\x97\xff\x7c\x73\xf8\xed\xc9\x1b\x78\x4f\x97\x0d\x6f\x59\xd1\x99\x25\x6d\x72\xb7\xef\xcf\xda\xed\x3d\x45\x6d\xc9\xd9\x47\xc4\xfe\xec\xf5\x65\xc3\x15\x67\x3f\x79\x60\x98\xbe\xab\x6d\x29\x26\x1d\xa8\xf7\xd4\x82\x04\xd0\xca\x9a\xa9\xf5\x14\x77\xf7\x1c\x61\x88\x00\xd4\x6b\x4e\x0d\xcb\x43\xf8\x8e\x9d\xfd\x0e\x2d\x79\x83\x6c\x9c\x1a\x77\x77\x30\xd9\x52\x0c\xa9\xd8\x7b\x18\xf9\xb3\x52\xbb\x9b\x7a\xa8\xfa\x42\x1e\xd8\xbd\x84\xb1\x7c\xc2\x83\x15\x61\x98\x47\xad\xec\x89\x6a\xcf\x52\xa6\x43\xd3\xdb\x67\xc0\xad\xc5\xaa\x6e\x6f\xdd\x4f\x87\x95\x4d\xe4\x5c\xab\x63\x0c\xd2\xd4\x42\xf4\xb5\xea\xe5\xdb\xba\x26\xfa\x5c\x46\xea\x5c\x55\xba\xa0\x49\xaf\xbd\x78\xaa\x8f\xf0\x13\x00\x7a\x7b\x8a\x07\x0c\x4c\x7c\x4d\x65\x56\xe1\xd9\xfd\x6c\xc7\x23\x3f\x5c\x13\x0c\xe4\x41\x5c\xe2\xbb\x44\x17\xd2\x83\xbd\xc4\xa8\x21\x1b\xc9\x42\x64\xe3\xce\xa1\x1f\x5a\x3a\x10\xd6\xe9\x3c\x28\x66\xd2\x48\xd1\x73\x09\xe9\xf9\x8a\x41\xeb\xf2\x0c\xef\x38\xaa\xda\xaf\x57\x7c\x81\x15\x36\x21\x34\x6d\x0d\x0e\x7f\x62\x4b\xe1\x83\xd4\xf5\x10\xf5\xd3\x13\x40\x45\x7a\x7a\xbc\x06\xd9\xf3\xf4\x61\x78\x1e\x1a\x9c\x5b\x5b\x7a\x69\xda\x53\x5d\xfa\xe9\xb1\xb3\x05\x7c\xed\xb9\x76\x9b\x87\xdc\xbe\x7b\xd6\xa2\x27\x49\x65\x6e\xa4\xea\x0b\xbe\xec\xbc\x36\x5c\x23\x5f\xd1\x7d\xb7\x84\x27\xf1\x3c\x65\x05\xbe\xe5\x93\x97\x17\x97\x90\xc8\xd5\xe8\x28\xd9\x94\x10\xa1\x52\xf7\x11\x84\xc8\xd3\x11\x1e\xbd\x6a\x25\x8f\x0b\x1b\xb5\x36\x93\xd6\xef\x8a\x5e\x68\xf4\xc1\x0d\xe6\x5c\x9b\x96\x3f\x2a\x71\x4b\x83\x30\x72\x0f\x5d\x8b\x78\x55\xd2\x8a\x9f\x76\x52\xa4\x7e\xa0\x18\x96\x6b\x6c\xe5\x97\x65\x76\x3d\xa5\x88\x9b\x00\x3a\x70\xa9\x01\xc1\x3e\x7a\x39\x2d\x5c\xbf\xf1\x54\xde\x88\x1b\xaa\x52\x72\x78\x7e\xfa\xf9\x85\x68\xe7\xe2\x47\xdc\x05\x5d\x30\xe9\x6b\x54\x04\x14\xfa\x31\x37\x1a\xb3\xd9\x21\x1f\xdd\xc4\x3e\x24\x7b\x00\x85\x0c\x11\x2b\xc2\xac\xb8\x72\xb3\x88\x74\x24\x41\x64\x62\xa8\xeb\xec\x1e\xba\xde\xbf\x78\xf1\x02\x43\x0a\x2f\xfe\xfa\xd7\xbf\x12\xe8\xba\x98\xb2\x84\xe7\xcb\x37\xc2\x5d\x7f\x7e\xf9\x72\x44\xfe\x71\xf8\xf6\x0d\xa1\x09\x58\x60\x08\xa9\x8a\x23\xc3\xda\xc5\x3f\xd6\x03\xf2\xbf\x2f\xdf\x9d\x55\xed\xde\xeb\xdf\x02\x6b\xe4\xfe\xf5\x46\xe4\x38\x4a\x3f\x8f\x5d\xfe\xd4\xcc\x20\x25\x5f\x48\x43\xe8\x64\x02\xcc\x89\x22\x99\x6b\x2f\x2e\x3c\x2e\x1a\x9f\xce\x7c\xb7\x6e\xcb\x56\x19\xe4\xc5\x73\x3b\x45\x08\xb1\x78\x28\x41\x4c\xf3\x87\xb1\xc2\xe9\x00\x53\x19\x90\x8c\x5f\x33\x32\xd1\xd0\xb3\xbb\x6a\xa2\xa1\x98\xb6\xf6\x53\x42\x85\x1d\x1d\x07\x0b\x53\xb7\x93\x78\xda\xb9\x0b\x1d\xbb\x3b\xd7\x18\xd6\x37\x86\xf3\x75\x49\x28\x4f\x2c\xd9\x9f\x6a\x2e\x41\x5d\x5f\x0c\xef\x83\x5c\xe4\xa0\xf8\x82\xd8\x24\x34\x93\x62\x1a\x33\x5d\xa5\x47\xf8\x04\xc4\x45\xc1\xda\x12\xa3\xa7\x6e\x2a\xfd\xf4\x26\x43\xc9\xfd\x96\x76\xec\xef\x5f\x0f\xad\x46\x50\x88\x74\x2c\x4b\xe3\x53\xde\xf0\x49\x00\x81\x05\x18\x89\x48\xf0\x4e\x0f\xee\xad\x31\x4d\x7f\xad\xde\x7a\xea\xb3\x54\x3f\x88\x6b\xca\xe6\x80\x30\x9a\xcc\xc8\x35\x5b\x0c\x51\xc4\x17\x14\xd0\x0f\x80\xce\xc7\x96\xba\xd8\x5f\xa8\x9e\x51\x90\xb0\xd4\xda\x81\x6e\x11\x7c\x66\x62\xc5\xf5\x01\x3d\xc1\x9b\x4a\xda\x69\xd4\xae\x6f\x91\x88\x1c\x87\xbe\x51\x61\x22\x85\x71\x4d\x10\x43\xa3\x22\xc8\xb4\x6c\x54\xd8\x5b\x89\xc2\x52\xfb\x33\x7d\xd7\x93\xab\x74\x4c\x7b\x64\x38\x65\xa2\x14\x4b\xbf\x06\x24\x70\xc8\x7b\xd5\xcc\xe1\xf9\x50\xdf\x00\x2f\x4a\xe9\x9c\xf1\x04\xaa\x6a\xec\xed\xee\x5e\x4f\xa5\x40\x88\x1a\x02\x80\x66\xa6\x74\xa4\x81\x5c\x5a\xfb\x6c\xa6\x35\xe1\xf0\x86\x39\x55\xd7\xcc\x83\xd9\xd2\x6c\x44\xce\xed\x24\x03\x4e\x39\xf6\x8d\x9b\x63\x11\x84\x95\x29\x31\xb4\x81\x7d\xc8\xee\x68\xb4\x8b\x67\xe1\x0a\xa0\x83\xce\xfc\xd2\x67\xcb\xb0\xde\x5a\x85\xd5\xf5\x20\x5a\x68\x6c\x9d\x66\xad\x03\x68\x4f\x28\x01\x77\xc4\xcc\xbc\xb6\x40\x3b\x82\x4f\xc7\x57\xcf\x3d\xab\xfa\x6d\x7b\xd9\x5f\xd3\xcb\x0e\x21\xf0\xfa\xd5\x77\xb3\xcb\x1e\x5b\x5d\xd6\x13\x8e\x9d\xfc\xa9\x4e\x90\xbe\xfa\xee\xf5\xde\x58\x31\xef\xa1\xad\x95\xbf\x6e\x43\x2e\xce\xef\x63\x5d\x80\xa2\x6d\x65\xf9\x93\x32\x27\x4e\x27\x20\x43\x57\xa3\xb5\x44\x56\x5a\x38\x52\x2c\x05\xd6\x6f\x47\xf4\xd1\x2b\x9e\xf4\x64\x58\x34\xaf\xee\x86\x46\xf3\xea\x92\xcc\xd2\xbc\x96\xf6\x79\x38\x53\x8b\xa8\x94\x16\x96\xc8\x48\xe8\xc1\x68\x82\x30\x18\x91\xb7\xee\xcc\x45\xe6\xa6\x63\x2d\xb3\xd2\x04\x58\x85\x15\x07\x32\x0c\xea\x3b\x36\x22\xdc\x90\xbf\x2d\x3a\x9e\x41\x31\xc1\x33\xab\x9f\x93\x1a\xaf\x1e\x85\x4d\xd7\x64\x54\xbc\x7e\x63\x29\xa9\x78\xf5\xb8\x0a\x5e\x2f\xec\x79\x25\x2e\x1d\xa6\xa4\xaf\x03\xac\x69\xaf\x90\x96\x6a\x34\xaa\xc6\x5e\x11\xc5\x86\x87\x6d\xd1\x96\xab\xab\xbb\xe3\xd5\xbd\x8e\xf3\x06\x1e\x9e\x9f\x3e\xba\x95\x19\x3d\x6b\x6b\x67\xde\xeb\x5a\xe1\xf0\x05\x20\x02\xef\x04\x3a\xae\x28\xea\x02\x6c\x56\xfe\xfe\x06\xcc\x95\xa5\x17\x7f\x6d\xcf\x9d\x28\x28\xd5\x68\xf9\x80\x1e\xdc\xea\x84\x8a\xda\x44\xf8\x74\x19\x90\x66\xcf\xdf\xb4\xd9\x50\x83\x04\xa8\xdf\xa1\xee\xa5\x79\x35\x13\x4b\x1d\x11\xc9\x25\xf4\xda\x47\xef\x49\xe4\x86\x29\x64\xfa\x0a\x9b\x36\x53\x21\xa4\xc1\x1e\xf3\x03\x6c\xd6\xaf\x07\xe8\x5e\xb1\x4a\x66\x94\x68\xa5\xa2\x10\x66\xcf\x6a\x65\x6f\xcc\x43\x7a\x67\x20\x02\x4c\x04\xb4\x3b\xef\x87\x93\xc8\x23\x70\x93\xbd\x2a\xad\xa4\xcf\xbe\xea\xf5\x70\x23\x8e\xef\x99\x48\x27\x33\x96\x53\x6c\x70\xe1\x09\x64\xe5\xf5\x8d\xe2\xc6\x30\xc4\xbf\x66\x2a\xd7\x44\x4e\x06\xde\x44\x42\xd4\x93\xf9\xcb\x9d\xfe\xfa\xd3\x3f\x82\xad\x4c\xfc\x0e\x6d\x0b\x5f\x75\xdb\x55\xf7\xfd\xd7\xec\x08\xbb\x3b\xc1\x60\xce\xa0\xe3\x8e\x68\x38\x21\xad\x12\x31\x47\xfa\x6f\x34\xe9\x36\xcf\xcd\x30\x08\xca\xe8\xd6\xcd\xb0\x75\x33\xf4\x31\xe2\xa3\xb9\x19\xa2\x83\xdb\x0b\x53\xb7\x00\xb1\xeb\x21\xc6\x7f\xf7\xfe\x87\x0a\xd7\x21\xc2\x32\xb6\x2c\xef\x3d\x0f\x52\xd5\xfd\xff\xbb\xa3\xd1\xee\xae\xf7\x47\xb8\xfd\x51\x9a\xc9\xf0\x2b\xc2\x44\x22\x53\x64\x2a\x3b\xbe\xd2\x06\x94\xda\xca\x00\x8f\xe7\x92\xfb\x67\xc5\x31\x04\x18\xbb\x5f\x96\xe8\x51\x42\xf9\x9c\x91\xd7\x8f\xaa\x82\x55\x8a\x57\x80\xaf\x72\x04\x0c\x28\x7f\x4e\x03\xab\x72\x58\x32\x9e\x73\x87\xab\x67\xc5\x05\xd3\x46\x93\x3d\xfc\x70\x94\x14\xe5\xc0\xdd\x30\xca\x59\x2e\xd5\x62\x10\x6e\xb2\x5f\xd6\x7e\xe5\xee\xd8\x07\xad\x2d\x29\x95\x62\xc2\x64\x8b\xdf\xae\xfe\xe6\x49\xbc\xc1\xea\x5b\xe0\x8a\x2e\x25\x16\xab\xae\x46\xd9\x45\x00\xb6\x07\x47\x5d\xa0\x36\x9c\x43\xae\xd8\x61\x10\xdc\x47\xf0\x29\x13\x73\x32\xa7\xaa\x75\xb1\xc3\xaa\xeb\x51\x34\xb6\x94\xcf\xb9\x96\xad\xcb\xc5\x56\x0e\xb9\xec\xfd\xe2\xae\x11\x80\x2c\x4d\x51\x1a\x77\xba\xf8\xbd\xed\xa1\xe6\xc2\x9e\x6e\x28\xbe\x2f\x77\x7a\x9c\x5c\x41\x8d\x61\x4a\xbc\x22\xff\xb3\xf7\xd3\x17\xbf\x0e\xf7\xbf\xd9\xdb\xfb\xf1\xc5\xf0\xbf\x7e\xfe\x62\xef\xa7\x11\xfc\xe3\x0f\xfb\xdf\xec\xff\xea\xff\xf8\x62\x7f\x7f\x6f\xef\xc7\xef\xdf\x7e\x77\x75\x7e\xf2\x33\xdf\xff\xf5\x47\x51\xe6\xd7\xf8\xd7\xaf\x7b\x3f\xb2\x93\x9f\xef\x39\xc8\xfe\xfe\x37\xbf\xef\xf1\x25\xa8\x58\xbc\xeb\x4d\x04\xe3\x35\x7c\x14\x35\xa2\x3e\x76\xcf\xac\x4b\xc8\xc7\x61\xe5\xbc\x1e\x72\x61\x86\x52\x0d\xf1\x21\xaf\x88\x51\x65\x5f\xa2\xab\x3a\xfe\x1e\x4f\xc6\x54\x4a\x4c\x85\xdc\xe8\x0d\x9b\x0d\x14\x22\x98\x39\xfa\xe8\xde\x60\xd7\x2e\x75\xeb\x08\xbe\xcf\xf5\x28\x09\x47\x0e\x19\xe6\x37\x9e\x6d\x74\xe9\x3a\xf2\x6e\x53\x8d\x96\xae\x6d\xaa\xd1\xf2\xb5\x4d\x35\x7a\xe0\xb5\x4d\x35\xda\x40\x1f\xe0\x36\xd5\x68\xeb\x03\x7c\x22\x3e\xc0\x6d\xaa\xd1\x7d\xaf\x6d\xaa\x51\xeb\xeb\x69\xa6\x1a\x39\x05\xbe\xca\x33\xda\xd8\x34\x23\xd7\xe0\xff\x30\x49\x64\x29\xcc\x95\xbc\x66\x1d\xa3\xb2\xf7\x32\x30\x97\x9e\xb9\x99\xd6\x66\x5f\x2a\x65\x0f\x2a\x60\x7f\xca\x1f\x2d\x53\x6e\xcd\xcc\x9e\xb7\xc1\xa1\x1b\xd6\xdb\x99\xf6\x58\x14\x29\x4b\xc3\xf3\xbc\xb0\x32\x76\xbd\x47\xe4\x90\x28\x96\xf0\x82\x5b\xd1\x0e\x60\x3a\xf0\x39\xee\x93\xd0\x0f\x98\x1b\xcd\xb2\x89\xeb\x89\x2a\xaa\x9a\x61\x15\x99\x90\xee\xac\x58\xf9\x18\xd4\x0a\xa4\x6f\x63\x49\xf4\x4c\x96\x59\x4a\x14\xfb\xb7\x57\x27\xdc\x6c\xae\xe2\x11\x62\x47\x28\xbc\x4a\xf5\x58\x37\x38\x2d\xb8\x43\xdd\xda\x24\x01\xc7\x3e\x16\x5c\xc1\x66\xbb\x64\x89\x14\x69\xdf\xee\x8d\x93\xe6\xf8\x7e\xad\x5d\x34\x87\xa5\x24\x2d\xf1\x06\x28\x84\xa4\x19\x4f\xb9\x59\x84\x2c\x0c\xdc\xf6\x56\x11\xc5\x2e\xb4\x8e\x11\x74\xb5\x10\x84\x16\x85\x92\x34\x99\x31\x1d\xbd\x0d\xaa\x95\x0e\x6c\x22\xd4\x57\x66\xe5\x94\x0b\xd4\x2c\xe1\x37\x56\x0d\xc9\x16\x44\x49\xe3\x13\xca\x6e\x79\xe0\x55\x34\x18\xfc\x1c\x75\x09\xa3\x16\x90\x75\x26\xe3\x21\x70\x56\x7c\x12\xff\xa1\x89\xcc\x52\x8f\x5b\xfa\xd5\x0b\xab\xca\x27\x8e\x8b\xad\xb4\x07\x54\x49\x23\x49\x66\xd5\x22\x7b\x02\xdc\xfe\xe3\x2f\xff\x44\x66\xb2\x54\x7a\x14\x43\x08\xbc\x84\xcf\xd0\x49\xe1\x4d\x01\x43\x32\x46\xb5\x21\x2f\x5f\x90\x9c\x8b\xd2\x9e\xe5\x3d\x31\x5e\x5f\xda\x6b\xa4\xb7\xfe\xe5\x4f\x1d\x47\xeb\x47\x63\x5d\xce\x60\x71\xdc\x5a\x60\x7f\x36\xa7\xb8\xba\x3d\x8e\xa0\x18\xd8\xa3\xb1\xa1\xc6\xba\x23\x29\x5e\x45\x61\xe4\x9a\x77\xfe\x2f\xa5\x1c\x2f\x4c\x77\x18\x98\xff\xc6\x71\xea\xf8\x2f\xfe\xc3\xfb\x60\xa9\x56\x50\xaa\x2d\xa6\xb2\xf6\x6e\xd1\x53\xae\x4d\xab\x5e\xd1\x15\x6e\x4c\x8b\x1f\x77\x3d\xcc\xa7\xd6\xe2\xed\xa5\x68\x1d\x6c\x67\x6f\xab\x79\xa7\x72\x92\x30\x0d\xa2\xc8\xc3\xa7\x81\x7f\x16\x9f\xda\xf2\xa1\x9b\x85\xd8\x72\x27\x22\x8b\x67\xfe\x1e\x3a\x63\x76\x22\x56\x17\xdd\xde\x33\x76\x4f\xd4\xc2\xc1\xea\x32\x42\x73\x31\xc5\x46\x96\x79\x99\x19\x5e\x64\x15\xe5\xc2\x0f\xdc\x01\x1c\x3b\xfc\x69\xe4\x61\xa6\x08\x1c\x85\xd0\xe0\x10\x1c\xd9\x0b\x63\x31\x61\xb0\x1f\xa3\xb2\xe7\x78\x41\x15\x0d\xe4\x4f\x64\x9e\x53\xbd\xef\x62\x07\x14\x72\x57\x50\xb2\xdb\x63\x58\xd1\x2c\xbc\x7e\x9c\x2b\xb0\x2e\xc6\x35\x4c\x50\xd1\x3a\x68\x57\x77\xb8\xc0\x50\x44\xde\x84\xf4\x78\xec\x9f\xde\xe0\x58\xa7\x10\x7f\x4b\x93\x6b\x26\x52\xf2\x5e\x7b\xc2\xa5\x0b\x41\x73\x07\xae\x5e\x28\x89\x7d\xbb\x59\xda\xf8\xbd\x1e\x38\x47\x22\xa2\x8c\x78\x10\x28\xd4\xb7\xd6\x45\xc5\x52\xf7\x84\xae\xfb\x5e\x5b\xe5\xeb\x6e\x79\xa7\xd1\x49\xab\xf8\x3c\x61\x5e\x77\xb4\x13\x58\xd7\xcb\xcf\x5b\x23\xbe\x91\xd5\x38\x4c\xae\x69\x26\xee\x42\x38\xd2\x43\xf0\x11\x70\xd4\x69\x66\x45\xdc\x22\xc0\xeb\x34\x18\x6c\xbc\x58\x5f\xcb\x7e\x35\xee\x0e\xd0\xb4\x7b\xf1\xed\x71\x5d\x98\x5d\xd0\x54\x6a\xf2\x6d\x26\x93\x6b\x72\xcc\xc0\x68\x78\xcc\x76\xef\x6a\x9c\x3e\xed\x36\x8d\x39\x9d\xb6\xcb\xf3\x18\x92\x5c\x0a\x6e\xa4\x6a\x23\x8f\x37\x08\x6c\x6f\xdb\x6a\x6f\x35\x88\xb8\x1a\xa7\xcf\xa6\xd1\x9e\x65\xf2\x9e\x3a\xec\xce\x18\x51\x20\x62\x60\x50\xdf\xfd\xa3\xa5\xc0\xf8\xdd\x4c\xde\x0c\x8d\x1c\x96\x9a\x0d\x79\xeb\x3c\xa5\xce\xf4\xb9\x66\x0b\x48\xfa\xea\x85\x42\xdf\xe3\x60\x35\x13\xdd\x48\xf0\x9c\xc3\xe7\x56\x91\xbb\xf8\xf6\xd8\x9e\xde\xa3\xd8\x2c\x39\x60\x26\x39\x48\x58\x31\x3b\x70\xd3\x79\xf2\x64\xf5\xf2\xb1\x1f\xba\x1e\x92\x44\x66\x99\xc3\xed\x92\x13\x72\xc4\x8a\x59\x78\xc4\x66\xd0\xea\x29\x37\x4b\x2b\xa4\xec\xa7\xb1\x52\x24\x22\xec\x98\x4e\x42\x44\x8c\xae\xc6\x0f\xeb\x05\xbd\x89\xac\xfd\x19\x7b\x92\xb4\xe9\x2d\xb7\x11\xe4\xdd\x9c\x1e\x75\xbb\x97\x7e\x38\xf0\xff\x44\xe1\xe6\x7a\x4b\x3a\x9f\x2f\x5a\x13\xd1\xa7\x13\xb4\x30\x53\x96\x12\x39\x67\x4a\xf1\x94\x69\x12\x64\x74\xec\x58\xe2\xd9\x66\x50\x7e\xdb\x1d\xef\x69\xe5\x07\x6c\x8e\x4f\x21\x12\xde\x76\xcc\x65\xe1\x4d\xd3\x9c\x8b\xcd\xe0\xf2\x96\xf4\xd2\x09\xcd\xd8\xe9\xbb\xce\xa6\xf7\x25\x8e\x53\xb7\xbe\xfd\x87\x11\xc8\xfe\x27\x80\xe7\xbf\x0f\x3c\x4b\x84\x4c\xdb\x45\xc3\xd6\x6c\x43\x4f\xa9\x61\x37\x2d\x15\x9f\x61\x25\xea\xdb\xfe\x1e\x2c\xaf\xa7\x6d\x83\xaf\xa9\x41\x46\xb4\xaf\x11\xf5\x7e\x5d\xea\x94\xe3\xa0\x7e\x5c\xcb\x9e\x14\x8d\xfe\x62\x7e\x6b\x1e\x9e\x9f\x92\xef\xf0\x79\xeb\xeb\xf8\xa1\xa4\x41\x4b\xe6\x58\xe6\x94\xf7\xd4\x88\x3d\x6a\xe8\x14\xbf\xf0\x79\x78\x18\xc1\xa7\xc5\x5d\xe8\x27\x7c\x5a\x2a\x96\x12\xe7\xfd\xd8\xb6\x31\xd8\xe0\x36\x06\xfd\x2a\xc5\x95\x4e\x1c\x79\xcc\x7d\x65\x4c\xa5\x07\x7b\x2e\x02\x75\x20\xa4\x20\x11\xcd\x84\xe6\x90\x75\x10\x25\xc6\x81\xb2\x0c\xf9\xdf\xa1\x0c\x06\x15\xe7\x01\x79\x23\xa7\x5c\x78\xe9\x24\x5d\xb2\xcb\x84\xf2\xac\x1b\x39\xb7\x9a\xee\x6f\x4c\xd3\xd5\x3a\x3b\x11\x74\x9c\xb5\xcf\x64\xac\x1f\xbc\x19\x85\x3c\x29\x06\x63\x1e\xa4\x5c\xdb\xff\x92\xcb\xcb\x37\x10\x9b\x2d\x85\xb7\x0c\x21\xea\xe8\x8e\x8d\x50\x5f\x8c\xc2\x65\x7d\xf2\x00\x65\x76\x6f\x7d\x2a\x4e\x45\x6a\x5f\x97\xe9\x5a\x02\xb0\x7b\x0a\x76\x01\x09\xd5\x6b\x98\x7d\x38\x66\xe4\x6a\xc6\x93\xeb\xf3\x28\x04\x2b\x95\xfd\x4c\x44\x1f\xd5\x14\x8d\xe6\x77\xeb\x3a\x70\xdc\x6b\x9d\xf7\xe5\xf6\xba\x8a\x4e\xdc\x4b\x47\x32\x3b\x38\xa1\x5a\xcb\x84\x57\x31\x7f\x70\x0a\x57\x47\x72\x0a\x47\xf2\xfa\xc8\x00\x5a\xe2\xa3\xe8\x1f\x9e\x71\x9c\xd2\x4a\x75\xac\x6f\x70\xe1\xa9\xb5\xb6\x57\x47\x56\xee\xad\xbb\xe6\x55\xad\x9f\xa6\xb7\xfa\x1a\xe1\x67\x5f\x0f\xea\x18\xc5\xeb\xf3\xae\x81\xf3\x32\xab\x84\xbe\x9a\xae\xbf\xc7\x5a\x88\xd5\xbe\x58\x7b\x95\x17\xae\x91\x7b\x83\x9f\xb9\x80\x34\x08\x95\x42\x16\x65\x86\x59\xab\xdd\xdb\x8a\xfa\x68\x1e\x3e\x67\x0d\x01\xea\x4d\x6b\x46\xf4\xd0\x72\xbe\xe7\xd1\x97\x28\x32\x08\x5e\xfc\xe5\x4f\x7f\x7a\xea\x9d\x8a\xba\x39\xce\xd6\xdd\xaa\xa8\x53\xa8\x6b\x8b\x52\xb0\x45\x29\xb8\xeb\x5a\x7b\x24\xf6\xf3\xe3\x10\xf4\x52\x24\xd6\x47\x81\x58\x57\xa4\x81\x8e\xc5\x65\xfd\x14\x96\x75\xc6\x12\x78\x54\x04\x81\x9e\x6a\xac\xba\xa3\x05\x6c\x31\x02\x7e\x1b\x18\x01\xfd\xd5\x56\xf5\x85\x07\xd0\xbd\xa6\xea\xf9\xd7\xfe\x77\x16\x13\x5d\x2b\xcc\x1f\x5e\x57\xde\x57\xff\x8a\xbe\xfc\xec\xbd\x39\x06\x6a\x5e\x5d\x67\xef\x7a\xce\xc0\xcc\xeb\x0a\xf1\xdd\x48\x2b\x34\xd6\x68\xed\x92\xce\xce\x02\x9c\x8a\x6c\x75\x06\xd7\xb9\x06\x47\x7a\x77\xd9\x08\xb1\x87\x8f\x37\x3f\xb2\xbe\x0d\x31\x6f\xdb\xa8\x6f\xe3\x8f\xe1\xba\x25\xfe\xa8\x6b\x18\xaf\xde\x23\x08\x92\x10\x54\x30\x39\x8e\xfb\xa8\x54\xfb\xff\xf0\xfc\x94\x24\x8a\x01\xa4\x01\xcd\xf4\x88\xac\xd0\xd0\x7c\xa4\xc6\x69\x74\x5e\x33\xa3\xc6\xb0\xbc\x30\x5d\x19\x6e\x1b\x7e\xfc\x8d\x85\x1f\x7b\x8e\x19\x7c\x08\xc3\x79\x6f\xd1\xac\xcc\xa9\x18\x5a\x69\x01\x81\xc8\x5a\x3e\x47\xe3\xe0\x1b\x11\x5f\x03\x87\xd4\xa4\x8a\x21\xb8\x79\x29\xf8\x2f\x25\xab\xfc\x0b\x41\xbd\xd8\x80\x50\x0b\xcc\xa3\x67\xda\xa1\xea\xd4\x90\x22\x89\x5c\x2a\x65\x72\x04\x09\x74\xf4\x02\x23\xd2\xbf\x6a\xbe\x32\x33\x63\xa8\xa6\x9d\x03\x38\x40\x75\x57\xdd\xbe\x43\x03\x8f\x66\x99\xbc\xc1\x67\xc7\x8a\x87\x5d\x3f\x3b\x17\x87\xc7\x31\x66\x24\xe7\x4a\x49\xe5\x42\x3c\xf1\x74\x30\x2d\xc7\xda\x89\x4c\xa1\xc1\xa5\x5c\x56\xc5\x25\x33\x31\xab\x18\x49\xa8\xc0\xc2\x45\xfb\x6f\x9f\x94\x8c\xfd\xcf\x9c\xbc\x1b\xb3\x19\x9d\x73\x59\x2a\xfc\xb5\x91\x64\xc7\x7d\x05\x47\xee\x42\x96\xc1\xcd\x5d\x42\x9d\x52\x78\x3b\xbd\x82\x4e\x67\xd5\x97\x60\xa0\xa6\xd2\xfb\x0f\x87\xec\x23\xd7\x66\xf9\x5d\x3c\x89\x7c\x83\x84\x75\x70\xde\x5c\x17\xf6\x80\xfd\xd0\xba\xe6\xb4\xce\x6f\xf1\x68\x75\x95\x74\x7e\x09\x5f\x7d\x4a\x21\x75\x48\x2d\x58\x2a\xee\x8b\xc2\x9e\x5e\xba\x27\xbe\x65\xcb\xce\x4c\x5b\x8d\xf8\xa9\x68\xc4\x21\x41\x22\xe3\xc9\xe2\xf4\xb8\x1f\x9d\x2f\x24\x46\xd8\x41\xc9\xb7\x54\xb3\x94\xbc\xa5\x82\x4e\xd1\x39\xb2\x77\x79\xfe\xed\xdb\x7d\xcb\x24\xe0\x7c\x39\x3d\x5e\x99\x3d\x71\x19\xcf\xec\x6c\x5d\xe5\xdb\xa4\x49\xa3\xde\xb4\x82\x07\x52\x69\x6d\x05\xec\x24\x9c\xec\x5d\x5a\x76\x2d\x83\x1b\x61\x3a\x84\x47\x2a\xd3\x4d\xf1\x3a\xcf\xd3\xeb\xc7\x7c\xdd\xc8\x57\x7d\xd7\x3b\xdd\x2f\xd0\x74\x8f\x60\x52\x63\xed\x15\x35\x6c\xba\x38\x66\x45\x26\x17\x76\xb9\xcf\x23\xd7\x39\xde\x3a\xc6\xa3\x5e\x8d\x69\x42\x54\x99\x31\xec\x5e\xd3\x84\x08\x13\x8c\xa5\x95\x9c\xe2\x42\x1b\x0a\x00\x61\x38\xfe\x9d\x33\xba\xf7\x01\x73\xdf\xa3\x64\x88\xf3\xfc\xe4\x5d\x75\x38\x45\xbb\xa1\xee\xfc\xc9\xfd\x0f\x13\x78\xfc\xa7\x39\xf4\x21\xc1\xc3\x7b\x87\x09\xeb\x0c\x0e\x7b\xfa\xa2\xcc\xec\xd1\x91\xa5\x8d\x26\xa2\xa0\x5b\xb9\x35\x46\x64\x06\x90\x00\x76\xf6\x03\x32\x2e\xad\xe2\xc5\x74\xcd\xbf\xbc\x0c\x4b\x79\x33\xc3\xb8\xb1\xfd\x11\xa1\x45\x91\x71\xcc\xeb\x95\xca\x05\x7f\x23\x6f\xe3\xf2\x6d\xf7\x11\x24\x0f\xd4\x3f\x1e\xa6\x6f\x0c\xc9\x9c\xa9\xf1\x7d\x30\x15\x1e\xaa\x4a\xd0\x82\x43\xec\xe4\xde\x9a\x47\x1d\x14\xf2\xfc\x14\x7f\xed\x2d\xb5\xd8\x34\xf3\x5f\xe2\x0a\xba\xb5\xf1\x80\x82\xae\x2b\x0d\x5a\x1b\x01\x15\xe8\xf0\xfc\x14\x61\xa8\x1c\x30\x50\xe5\xb2\xb0\xba\x3d\xc5\xe4\xc0\x0a\x8d\x90\x4e\xed\x88\x86\x48\x11\x1e\xca\x44\x99\x33\x04\x13\xaa\xda\x59\x59\x83\x4f\x2c\xaa\xd1\x2b\x8f\x87\xb5\x4f\xee\xaf\x4e\x3c\x3c\x8c\xfe\xc0\xb0\xf9\x83\x4f\x1e\x21\xc5\x85\x7b\xcd\xf7\x17\x6f\xda\x2d\xe2\x59\x7d\x0c\x07\x1e\xc3\x00\x27\xaf\xa0\xca\x70\x9a\x91\x52\x65\x3e\x0c\x87\x49\xef\x2e\x2d\x6d\x46\xe7\x11\xc0\xce\x88\x90\x3f\xe0\xca\x39\xc2\xe2\xfe\xc4\xf6\xae\xb8\xf2\x93\x32\xcb\x06\x64\xc2\x05\xb5\x62\x97\x15\x24\x0e\x07\x5d\x72\x91\x58\xf3\xcb\xda\xfa\xae\x5f\x0b\xcc\xc8\x1b\x65\x61\x93\x42\x94\x11\xa2\xa5\x2c\x4b\x01\x74\x11\x1e\x61\x37\x6c\x02\x2e\x02\x6b\x35\x1e\x65\xa5\x36\x4c\x5d\x48\x7b\x18\x44\x79\x2d\x00\x47\x41\xe3\xaf\xbf\xe5\x22\x85\x14\xa6\x0b\x38\x38\x12\x2a\x08\xe3\xe0\x7c\xb1\x43\x42\x9c\xda\xf2\x4e\xc5\x50\x7b\xba\x4c\x66\xf6\x95\x76\x0a\x99\xea\x1d\x2b\x46\x76\xd0\x45\xa7\x77\xf6\xed\x5f\xcd\x77\xc0\x34\x95\xe8\x77\x07\xb4\xe0\x3b\xfb\x03\x02\x04\x82\xc0\x99\x34\xb3\xa7\xcb\x87\xfe\x5d\xc1\x26\x6e\xc5\x85\x17\xf1\x08\xc0\x83\xa2\xea\xfe\x75\x33\xe3\x86\x85\xe6\xdb\xe8\xd9\x09\xf8\x2a\x4d\x61\x4d\xc8\xa1\x20\x2c\x2f\x0c\x78\x8b\x49\xce\xa8\x0f\x21\xb3\x39\x53\x0b\x6b\x93\x03\x10\xc5\x93\xdf\xfc\x81\x1f\x3b\x11\xbc\xd1\xd9\xbc\x62\x72\xd8\x61\x4b\xc4\xdd\xfd\xc3\x6e\xcd\xce\xcf\xb2\x48\x9a\x3f\x59\x52\xc2\xf1\xda\x8a\x8c\x1f\xec\x2f\xeb\x24\xc4\x8f\x50\x5a\x06\xf9\xf1\xe6\x8d\x0b\x64\x20\xad\xbe\xe7\x22\x45\x15\xf5\xd0\x18\xc5\xc7\xa5\x61\x17\xcc\x4e\x38\xc1\x94\x07\xdf\x85\xcf\x65\x47\xbb\x95\x58\x49\x7e\x98\xfb\x53\x24\xfd\xb2\x62\x7b\x5f\x65\xf4\x8e\xe1\xbd\x2e\x7f\xdb\x50\x77\x0e\xe0\x0c\x82\xb7\x32\x5d\xbd\xa9\x1a\x95\x21\xd5\xcd\x4e\x55\x89\x3a\x5b\xfa\xb1\x9c\x12\xbb\x28\x56\x6a\xfa\x77\x2f\xc7\x1d\xa4\xbf\x6d\x26\x95\x6f\x00\x24\x68\xf4\xcd\xd5\xa2\x60\x0e\x69\x9b\x4c\x32\x3a\xad\xd8\x08\xe4\x21\xaa\x4f\x47\x97\x1f\xfc\x2b\x68\xc2\x57\x2b\xb2\x9f\xd4\x74\x3f\xa5\xdb\x0e\x2b\x2a\xdd\x7a\x87\x7d\xc8\xca\x2f\x3f\xad\xe0\x86\xc1\x6f\xe7\xa6\xfb\x84\xfd\xcc\x9d\x2e\xb5\xdb\xe8\x7f\xe5\x10\xbe\x68\xc4\x09\x1e\x40\xcc\x9b\x9b\x90\x89\x04\x1a\xca\xe5\x87\x1a\x9b\x7c\x62\xbe\xb7\x30\xed\x35\x5b\xdc\x48\xb5\x1a\x0d\xbc\x35\x7f\xdd\xf9\x44\xec\xce\xff\xc9\x0d\xf2\x96\x16\xf6\xb5\xab\x3c\x4f\x14\x78\x2e\xea\x88\x56\x01\x66\x68\xf9\xac\x38\xa9\xa6\x54\xf0\xff\x60\x72\x6c\x62\xf7\xb1\x54\xf6\xcf\x3d\x8c\x5c\xa0\x45\x9f\xb1\xc4\xec\x3b\xfe\x5b\x29\xf7\x3e\xc1\xa0\x34\x4d\x39\xea\x15\xe7\x9f\xe0\xa5\xbb\x89\xc0\xc5\xf5\x63\xd0\xfc\x8e\x8d\xf5\x69\xde\xbf\x3b\xf4\x79\x0f\xd9\x5c\xaa\x3b\xb2\x9b\xee\xfc\x7d\x4e\xb9\xeb\xe8\xba\x71\x54\x61\x39\xe5\x6d\x5f\x0b\xaf\x0e\x74\xcd\xa9\x29\x15\x37\x2b\x0f\xa4\xbb\x7f\xc8\xc5\xf7\xe5\x98\xb9\x68\xef\x83\x7f\x2e\x20\x79\xef\xf0\xfc\xb4\xdf\xe5\x58\x86\x97\x76\x13\xb4\x1a\x0d\x29\x05\xcd\xc7\x7c\x5a\xca\x52\x67\x8b\xd8\x5d\x49\x21\x58\x6d\xcd\x7d\xf4\xd7\x88\x5d\x43\xa8\x90\x62\x91\xbb\x5b\x45\x92\x95\x29\xab\x8d\x08\x31\xbd\xb9\xe4\x29\xa1\xa5\x91\x39\x35\x3c\x21\x89\xc4\xef\xea\x23\x95\x9a\x11\x7a\xcb\x6f\x93\x52\x1b\x99\x93\x9c\x2a\x3d\xa3\x59\x76\xdb\x1a\xf7\x70\xaa\xdd\x05\xa0\x3d\x84\xf7\xbf\xf5\xcb\x39\xce\xba\x25\x7f\x7f\x02\x2f\xfc\x1e\xfc\x6d\x27\xd7\x69\x80\xf9\xed\x5c\x7a\x8f\x31\x5c\x49\xfc\x4a\xb8\x9e\x4f\x2c\xcc\xa7\xa8\x73\xd7\xce\xfd\xe4\x7b\xdd\x21\x0d\xef\xfc\x2d\xa4\xce\xb2\xf4\x34\xa7\xd3\x7b\x28\x92\x6f\xac\xdd\x40\xc5\xc2\xff\x0c\x51\x24\xf5\x80\x48\xe5\x72\x40\x42\x4f\x6e\xf7\x55\x00\x20\x55\xe4\x1d\x84\xd9\xa4\x72\xc9\xd4\x8e\x4b\x21\xb5\x9e\xa9\x89\x54\xb9\xd5\xeb\xb8\x22\x93\x52\xa0\x69\xe1\x72\xaf\xc1\x58\x71\x5e\x1c\x9a\x69\x19\x76\x20\xc4\xed\x84\x9f\x04\xa1\x9a\xdc\xb0\x2c\x1b\x91\xc3\x2c\x73\xf0\x96\x11\x34\x42\x55\xf2\x5c\x65\x08\x8c\x17\x24\xe5\x53\xa6\x0d\xd9\xbb\xfc\xfb\xe1\x3e\x9c\xda\xe0\xe1\x58\x10\x43\x7d\x9d\x58\xdd\x73\x03\xe7\x7f\x5a\x82\x9e\x90\x50\x43\x33\x39\xc5\x20\x39\x78\x70\x45\x4a\x8a\x8c\x2e\x00\xa4\xbe\xa0\x0a\x12\x45\x13\xf4\xde\x10\x55\x0a\x80\xe7\xfd\xac\x27\xce\xa7\x45\xc1\x5d\x08\xba\x43\xe0\xc9\x96\x5b\xfd\x13\xa8\xa5\x8f\x7b\x94\x29\x56\x64\xf4\x16\x7f\xc3\x1d\x65\xbf\x56\xcd\x05\x13\x56\x0a\x16\xc6\x18\x91\x4b\xe4\x9d\x9c\x9a\x04\x43\x98\xff\xca\x99\xa1\x29\x35\x74\x64\x6d\xc1\x7f\xd5\x0b\xd3\x64\x96\xda\x81\x6e\x5f\xe8\x5b\xe6\x8c\xfa\xe2\xea\x66\xec\xf5\x5d\x68\x95\xda\x70\x3b\xe8\xe7\x7e\x3f\xde\xe9\xe0\xe8\x28\x9f\xe0\xf5\x4f\x3e\x5a\x53\xec\xce\xe8\x5a\x6d\xae\xcd\x1f\xd5\xfd\x0f\x59\xfd\x4d\x1c\xb7\xe6\x0c\x70\x11\xaf\x5c\x3f\x1f\xff\x09\x38\x57\x0f\xcf\x8e\x6f\x77\x84\x7d\xda\x65\xf0\x09\x17\x41\x3d\x64\x70\xc7\xf4\xbc\xeb\xd9\x7d\x53\x8f\x1b\xf8\xe2\x14\xa8\xdf\xc3\x52\x0f\xea\xc1\x53\xfc\xcd\xb8\x60\xf5\xca\x43\xfc\xdd\xed\xfe\x91\x7b\x05\x6e\xee\x13\xae\xf9\x54\xa1\xd7\x30\x4c\xf6\xd6\x9b\xee\x17\xbd\xf9\x64\x31\x56\x8d\xe0\xae\xda\x11\x8a\x2b\x81\xf2\x50\xb2\xe1\x9d\xa7\x81\xd8\xf7\x8d\x76\xdd\xd3\xbf\xe3\x5f\xf5\x01\x13\x0d\x4b\x59\x4b\x23\xba\x66\x8b\x5d\xed\x6a\x51\xa4\xd0\x33\x5e\x60\xb5\xa0\x0b\x50\xb8\xd5\x25\x1f\x68\xc6\xd3\x30\x04\x72\xf5\xa9\x18\x90\x33\x69\xec\x7f\x4e\x3e\x72\x6d\xd0\xfc\x3c\x96\x4c\x9f\x49\x03\x9f\xf4\xf2\xaa\x38\x85\x07\xbc\xa8\x33\x80\xd1\xc7\x0d\xfb\x2a\x32\x93\xfd\x0b\x9d\x3a\xb1\xe7\x89\xc2\x35\x39\x15\x56\x23\x70\x6f\x14\xaa\x68\xb5\x1b\xc2\x17\x8a\x08\x29\x86\xe0\xfd\x5e\x39\x86\x23\x84\x54\x35\x3a\xdc\x31\x9c\x1b\x0a\xd3\xf9\xe0\x1b\xae\xbd\x10\x0f\x67\x36\xf5\x6e\x37\x9e\x90\x9c\xa9\x29\xc4\x73\x92\x4f\xc4\x33\xee\xeb\x8a\xbc\x97\x03\xf2\x93\x6b\x05\x22\xf3\xcd\xad\x8e\x8b\xa5\x45\x8a\xee\x47\xb1\x94\xa3\x37\xe3\xff\x58\xe9\x03\x94\xfa\xbf\x50\x4a\xad\x47\xe4\xd0\x37\x4b\x89\xbf\x73\x71\xad\x78\x18\x3b\x02\xd7\xc4\x8a\x92\x39\xcd\x18\x22\xc7\x53\x11\xaa\xa0\xe4\x64\x49\xb0\x0f\x5c\x49\xb5\xdd\xb3\x41\x65\xda\xb9\x66\x8b\x9d\xc1\xd2\xd2\xee\x9c\x8a\x9d\xaa\x04\xae\xb6\x98\x41\x88\x82\xb6\xb5\x03\xdf\xed\xb4\x3f\x0b\xee\x14\x96\xf7\x77\xaf\x7c\x72\xdd\xf4\x35\x5f\x1d\x98\x5e\xa9\x6c\xec\xe9\x7d\x4b\x42\x08\x06\x2b\x92\x4b\x05\xee\x4c\xfb\x69\x0c\xa4\x61\x55\xd5\x6b\x5e\x14\x15\xee\x48\x59\x4c\x15\x4d\x19\x99\x2a\x5a\xcc\x1e\xaa\x96\xa0\x6e\xb3\x6a\xf8\x27\xa3\xe8\xde\x42\xfc\x3b\x2c\xba\x1a\xf9\xbd\x01\xe2\x0d\x6f\xd8\x2c\x37\x8a\x16\x05\x53\x84\x2a\x59\x82\xd3\x2e\x9f\x33\x35\xf2\xb7\x60\xca\x45\xf0\x33\x27\x52\x29\x96\x18\x6f\xa2\xbb\xac\x60\x2c\x58\x15\x29\x54\xa3\x3e\x58\xed\xbb\x61\xe3\x99\x94\xd7\x50\x35\x07\xec\xf8\x88\x5e\x90\x1f\xf0\x59\xc7\xd5\x67\xde\xa0\xd5\x24\x65\x86\xf2\x0c\x72\x4d\xde\xbd\x79\xeb\xb2\x51\xbc\x36\xe1\x67\xb9\x3a\xb1\xa3\x07\x33\x84\xa6\x2e\x4b\xea\xe2\xff\x63\xef\xdd\xbb\x1b\xc7\xad\x7c\xd1\xff\xcf\xa7\xc0\x72\xb2\x96\xed\x1e\x49\xae\x3a\xd3\x79\xdc\x3a\x59\x3d\xcb\x6d\xbb\x7a\x7c\xba\xca\xe5\xb1\x5c\xd5\x6b\x6e\x32\x37\x81\x48\x48\x42\x4c\x02\x6c\x02\x94\x4b\x99\xcc\x77\xbf\x0b\x7b\x03\x20\xa8\x27\x49\x49\x7e\x74\x93\x7f\x24\x5d\x32\x09\x02\xe0\xc6\x7e\xef\xdf\x66\x33\xce\x1e\xed\xfe\xaf\xcb\x23\xe9\x93\x09\x13\x90\x3c\xb1\x21\xc9\xa8\x4f\x14\x8f\xd9\x15\x14\xe3\xae\x1f\x68\x07\xf7\xfd\x9a\x39\x6f\x63\x21\x9b\xe5\xc8\x56\x19\x52\x43\xd6\x7b\x23\xfc\x56\xe6\x1b\x80\x7f\xea\xd5\x06\xd7\xab\xfb\xb5\xd9\xe9\xef\xc8\xb7\xdf\xfe\xeb\xda\x9b\x52\xfa\x95\xa7\x45\xfa\x8e\xfc\xfe\x77\xbf\xfb\xd7\xdf\xad\xbf\x8d\x0b\xbc\xed\xed\xfa\xf5\xd9\x33\x7f\x71\x77\xf9\x02\xf6\x3b\xf6\xd9\x7e\x9b\x43\x83\x35\x86\x1a\x53\x9e\x14\xb9\x4d\x49\xad\x69\xa8\xbc\x0f\x9f\x81\xb0\x4e\x59\x4c\x41\xdd\x88\x2e\x19\xcd\x26\xa9\x8d\xb9\x60\x0a\x5a\xa3\x14\x22\x67\x91\x9c\x08\xfe\x0f\x16\xbb\xce\x28\x90\x78\x02\x00\xeb\x8e\xc4\x09\x13\x31\xf6\xa4\x34\x92\x77\x4a\x45\x9c\x6c\x4a\x48\xa8\xb1\xd2\xf0\x04\xef\xb4\x65\x20\xff\x1a\x6d\xd8\xc7\xf2\x89\x85\xed\x82\xce\x9a\x36\x08\x86\x72\x15\xb7\x6d\xa7\x95\x22\x63\x1c\x6e\x30\xef\x57\xcc\x71\xc9\xfa\x44\xc3\x19\x7e\xfb\xb9\x60\xf9\x1c\x0a\x47\x4a\xf3\x22\x48\x54\xbb\x2f\x71\x05\xdc\x32\xac\x5e\x87\x48\x2e\x0b\x16\x79\xa9\x4a\x95\xe9\x28\x0b\xef\x86\x67\x18\x06\xf1\x5d\x38\x8b\x9c\x13\x51\x24\xc9\xba\x5b\x85\xdc\x14\xf8\x0a\xf7\x6e\x8b\x41\x5b\xcf\xd2\xac\xeb\x9c\x58\xb1\xd3\x4f\xea\xa2\x08\x17\xbe\x27\x83\xe2\x65\x3b\x2d\xc2\x05\xd7\xca\x39\xad\x9f\x6f\x5a\x0f\xaf\xa6\x86\x33\x03\xaf\x26\x09\xa9\x35\x51\x66\x0e\xe9\xde\xc0\xab\x51\xfe\x50\x3d\x57\xc7\x8a\xa9\xbf\x38\x87\x47\x8b\xc5\xd7\x71\x7e\xac\x58\x7a\xe7\x02\x59\xda\xf0\xba\x39\x59\x0d\xf2\xb1\x6a\x7e\xc9\x1a\xae\x11\xbc\x3a\x07\x49\x23\x49\x54\x83\x31\x37\x73\x96\xd4\xfe\xaa\x39\xe3\x62\x26\x11\xa5\xb9\x91\x0e\x77\xb7\xf4\xe0\x82\x2a\xf7\x08\x9c\xd5\xea\x72\x5e\xf9\x0d\x55\x5a\x63\xd0\x92\x42\x6d\x77\xb9\x6f\x5e\xc1\xe6\xda\x94\xbd\xd8\x20\xd5\x95\x17\x09\xfb\x89\xeb\xe9\x27\x87\xc6\x6e\xa9\x5a\x17\x59\x02\x8b\x0d\xfe\x60\x48\xe8\xae\xd4\x0c\xaf\xb1\x83\x17\x8b\x64\x9a\x32\x11\x63\x2a\x53\x4a\x1f\x18\x29\x1b\x41\x1a\x1d\x0f\xd4\x60\x18\x8e\x7d\xcd\xa8\x28\xf5\xc4\x99\xe1\xe5\x9b\x28\xaa\x26\x3d\xd5\x95\xb5\xb5\x8b\x3e\x36\x17\x7b\x04\xd5\x1a\x95\xa2\x0e\x32\x62\x89\x04\x27\x0e\xe6\xab\x62\xae\xb5\xbd\x15\x58\xb2\xfd\xd5\x4a\x3d\x8b\xfc\xc8\xc4\xa4\xc4\x99\x52\x09\x34\x69\xb5\x1c\x58\x0a\x36\x20\x77\x56\x85\xa9\xa7\x15\xd5\x61\xa7\x35\x59\x69\x6d\x81\x58\x62\x33\x34\xde\x59\xf7\x5c\xb8\xb7\x33\xf7\x5b\x9d\xdd\x75\x37\xff\x92\xf7\xd7\x77\x4a\x68\xb6\xbd\xd5\x23\x5d\x4a\x05\xbf\xb7\x0b\xcc\x2b\xc2\x16\xc0\xe0\xaa\xeb\x93\x8b\xbb\xab\xf3\xfb\xab\x1e\xf9\x7c\x7b\x09\xff\x7f\x79\xf5\xe1\xca\xfc\xff\xc5\xa7\x9b\x9b\xab\x8b\x7b\xa3\x47\x7c\x83\x38\xf0\xc6\x8c\x33\xbb\x6b\xe4\x91\xac\x72\x0b\x2a\xe6\x64\x5c\x68\xc3\x0e\xca\x97\x55\x66\x41\xd1\x07\x40\xe3\xd8\x98\x8c\xaf\xee\x1b\xae\xde\xf0\x45\xb7\x49\xd8\xec\x02\xa1\xf3\x6d\x31\xd7\x76\x35\xa9\x36\x91\xd4\xae\x8a\xa8\x4c\xf9\xa8\x65\x39\xc4\x5f\x04\x79\x2f\x73\x62\xfb\x7c\xbd\x23\xc7\x99\x8c\xd5\xb1\x2d\x3a\x31\xff\x3d\xc0\x9f\xce\x12\x39\x39\xf6\xb5\x28\x8c\x24\x72\x42\x54\x31\xf2\x35\x42\x20\x4d\xe1\xee\x6f\xdc\x6d\x95\xd2\x8a\x9e\x2f\x14\x0a\x9e\xf2\x83\x57\x9e\x09\x6f\x08\xc7\x3d\x83\x26\x5f\x95\x3b\xcd\x0f\x8b\x03\x7e\x73\xb6\x7a\x06\x4e\x71\xe2\xf9\xc2\x13\x7f\x11\x86\x5c\x1f\x79\x12\x47\x34\x8f\x97\x68\x16\x84\x1b\x7e\x72\xd8\x3d\xc4\xcd\xc5\x1e\xc9\xe5\xe0\x16\x3c\x43\xce\x58\x9e\xd0\x0c\xf3\xd4\x01\xb8\x18\x12\xa0\xe0\x25\x97\x2c\x63\x50\xa7\xe5\xba\x76\x33\x11\x25\x12\x70\x3a\x50\x32\xf6\xaa\x4b\xc7\x84\x28\x07\x4a\x68\x8b\x7d\xca\x13\x72\xf4\x62\xd9\x1c\x24\x3b\x37\xa2\x5e\x4c\x8f\x5e\x0b\xf6\xe2\xab\x47\xd0\x68\xf4\x9a\x2f\x23\x47\xb6\x0a\xee\xa8\x47\x8e\x3c\x9e\x49\x6c\xb5\xe4\xa3\x6f\x8e\xca\x1b\xc2\x3a\x2a\x50\x92\x6d\x60\xaa\x0f\xef\x09\xab\x2d\xe1\x03\xbb\xf0\x99\x7f\x75\x89\x49\x63\x44\x9b\x75\x62\xc1\x1c\xaa\x03\x0d\x2a\x13\x59\x7a\x6b\x59\x02\xb8\xf5\x8d\x66\xfa\xc1\xe3\x1a\xca\xe5\xb1\x94\xd0\x6e\x4e\x1e\x54\xdc\x0c\xc8\xb0\x42\x3c\x3e\xfc\x17\x82\xe6\xf0\x9c\x64\x34\x37\xa6\x88\xbb\xb3\xda\x2f\xec\x9b\xad\xdd\xc2\x6a\x10\x41\x10\x5f\xa9\xa9\xb5\x0f\xfd\x13\x17\x09\x55\x6a\x85\xe7\x15\x18\x81\x19\x98\x30\x1c\x99\x50\x17\x7c\x02\x10\xea\x29\x9d\x6d\x80\x4b\xa8\x31\x69\x4d\xf3\x09\xd3\x9b\x23\x23\x54\xcc\x3f\x6d\x84\x49\xeb\xd7\x06\x56\xed\xd7\x3b\x4d\x5f\xfb\x25\x28\x57\x9f\x0b\xdd\x97\x79\x1f\x1f\x79\x47\x74\x5e\xac\x8b\x71\x69\x9e\x32\x59\xe8\x21\x8b\xa4\x58\x5d\x57\x61\xef\xdb\x5b\xa8\xa7\x41\xb1\x89\x8d\x36\x9e\x3b\x35\xc2\x55\x9c\x84\x4e\xf6\x52\xc7\x70\x11\xc6\x2a\x48\xcb\xa7\x0f\x1f\x77\xf9\xd8\x04\xca\xac\x37\x7f\xc9\x2f\x96\xed\x8b\x89\x9f\xa9\x9d\xf9\xc6\xc7\x3e\x16\xba\xf9\x43\x17\x3e\x72\xb5\xf9\x6e\xbb\x19\x9b\x81\x39\xd6\xae\x5f\x69\xaa\x8b\x25\x6a\xa8\x7c\x1b\xcb\x2c\x87\x58\xd8\x66\x75\xfa\x21\x3c\x17\x3a\xf9\x96\xf1\x09\x10\xe7\x16\xee\x73\x29\x93\x03\x62\x1f\x34\xe7\x53\xe7\x94\xa3\x01\x49\x23\x5d\x40\xed\x34\xd5\x36\xbd\xd2\xc2\xeb\xfc\xaf\x55\xcb\x58\x69\x32\x6e\x32\x13\x23\x96\x6b\xf5\x81\x2a\xfd\x39\x8b\xe9\x9a\x1a\xaa\x85\xb4\x49\xa5\xe1\xc0\xa0\x62\xfd\x28\x58\x6c\x38\xbc\xdd\x02\x1c\x8f\x3c\x1a\xd6\x5b\xe0\x88\x4d\x23\xf9\xe5\x01\x32\x8f\xf7\xcd\xab\x56\xcf\xfa\x4e\x9a\x3d\x39\x5f\xc9\x80\xaa\x09\x23\xdb\x66\x6b\xc4\x49\x0e\xa3\x11\xc1\xbe\xae\xb2\xb8\x77\x9f\x71\xc2\xa8\x58\x9d\xb4\xbf\x40\x51\x70\x5f\x73\x1a\xb2\x2f\x20\x8f\x53\x6e\x54\x56\xac\x35\x53\xc4\xa9\x50\x31\x4b\xd8\x9a\x92\xb3\x1d\x13\x5a\xed\x1b\x2e\xed\x0b\x6a\x25\x5b\xdd\x56\x9f\xf1\x0e\x7d\xab\x84\xdb\x12\x8e\x52\x59\xb6\xda\x83\xb7\x9a\x16\x57\x05\xea\xcb\x28\x91\xd1\x03\x82\x8c\x01\xde\x00\xff\x07\xcb\x5d\xf6\x3b\xf7\x5d\xbd\x6c\xe7\xa9\x89\xeb\x8a\xe9\xf6\xcd\xb5\x1f\x82\x51\xcc\xd8\x66\x03\xfd\xf8\x32\x2f\x3d\x8b\x85\xb0\x35\x7c\x4f\x93\x40\xeb\x0c\x15\xa8\x1a\xa8\x44\x0e\x96\x6d\x16\x4c\xb4\x01\x0c\x44\x6b\x32\xd2\xd4\xd6\xd8\x9c\xfd\xb8\xbe\x1e\x65\xaf\x49\xb1\x9b\xaa\x62\xf0\x0e\xd8\x3e\x11\x6d\x84\xc2\xd9\x58\x3f\x53\xd7\xf3\xb5\xa5\x4e\x86\xd4\x57\xd2\xfd\x94\xeb\x8c\xb6\xd7\x14\xc7\xbd\x47\xfb\xd6\x16\x23\x94\x57\x93\x18\x5e\x5d\xf0\xd5\x46\x51\x26\xd1\x04\x24\xb3\x0a\x6e\xe2\xcd\x0e\x5b\x6a\x8a\x91\xf8\xb1\xcc\xd7\x1a\x30\xfb\x9b\xfc\xe6\xaa\xaa\xad\x03\x19\xed\x73\x7d\xee\xda\x72\x09\x91\xe1\x5e\xfe\x91\x1e\xa1\x64\xca\x95\x96\xb9\x0d\xad\x41\xf3\xb0\x9c\x42\x87\xd2\xd5\x39\x60\xfb\xc9\x86\xbb\xf0\x53\x20\x34\xcb\x18\xf5\xcd\x86\xac\x6c\x82\x6e\x41\x39\x8b\x64\x1e\xaf\x9c\x98\xb3\xee\x57\xea\x52\x2b\x5f\xbf\x87\x0a\xd1\x84\x2a\x7d\xef\xe7\x60\x14\x84\x9a\xdc\xb8\xaa\xfe\xd8\x25\x96\xab\x71\x70\x33\x52\x94\x7f\x94\x84\x0a\xf4\x6a\xec\xa6\x83\x6f\x57\x32\xca\xb5\xa1\x36\xd7\x6a\x5d\x8f\x5e\x73\x0b\x96\xf8\x34\x33\x4f\x99\x52\x1b\xcb\x9d\x16\x92\x34\x00\x27\x98\x78\x9c\x60\xfb\xb8\x13\xf6\xa8\x20\x60\x3a\xa6\x43\x05\x9b\xaf\x27\x35\x02\x6a\x02\x3a\x14\xfc\xb1\xda\xe9\x93\x65\x53\xaa\xea\x2e\xc6\x9f\x22\x9f\x68\x5c\xfb\x38\xd4\x9c\x4d\xce\xa8\xda\x54\xb0\xb9\xb0\xb7\xa3\x9c\xb3\x31\xb9\xa0\x29\x4b\x2e\xa8\xda\xe7\xe6\x02\x07\x18\x10\x36\x98\x0c\xc8\xf1\x5d\x10\x6d\xbd\x91\xfa\xe3\xa6\x86\x0d\x1b\x17\x39\x65\x34\xd1\xd3\x8b\x29\x8b\x56\x57\xe9\x57\x96\xf7\xef\xc1\xcd\x3e\x46\x50\xe8\x48\x96\x68\x66\x38\x1e\x89\xf0\x9e\xbc\x10\x84\x8e\xb5\x6d\xdb\x61\x54\x43\x9f\x0f\x60\x0f\xf7\x01\x14\x6b\xaa\xd4\x7a\x91\xbe\x80\x18\x69\x6e\xf5\x5e\x01\x0e\x4d\x37\x13\x37\xf9\x31\xcf\x95\xb6\xc3\x0d\xc8\x27\x11\x41\x4b\x33\xf4\xd6\xda\x5b\x68\xce\xc4\xb1\xc6\x65\x4e\x36\xb4\x62\xad\x93\x7b\x59\xeb\xb0\x63\x66\x5d\xbd\xd2\x8c\x3b\xbc\xd7\x7d\x19\xc8\x9e\xc2\xb9\xca\x31\x3a\x8d\x61\x15\x4f\xa3\x8e\x07\xa4\x83\xf3\x72\xbb\x5e\xe6\x0a\x52\x97\xfe\x10\xd2\xd0\x53\xa8\xde\xf8\xbe\xcd\x35\x69\x1b\xd5\xbf\x7a\x8a\x9f\x7d\x4d\x1d\x4d\x67\x7b\xa7\x85\xad\x3c\x9f\xd4\xd7\xbf\xb6\xeb\xa2\x5b\xd0\x80\xb0\xed\xe0\x05\x74\xe8\xdd\xca\x44\xae\x2b\xb7\x3b\x32\x70\xed\x7d\xd1\x52\x77\x43\x62\x27\x43\xa0\xd5\x32\xcd\xd9\x11\xf4\x22\x2c\x69\xaf\xcc\x1c\x1e\x16\x23\xff\xc6\x63\xd7\x3b\xd8\xa7\xb4\xb8\x5a\x91\x2c\x97\x5f\xe7\x03\x72\x25\x66\x64\x46\x73\x6e\x84\x21\xba\xda\x6d\x7f\xc4\x91\xc5\x49\x97\x22\x99\xf7\x0c\x71\x22\xec\x25\xcf\x5d\x68\x20\xa5\x73\x12\xd1\x3c\x9f\x57\x5a\x49\x1c\x4c\x65\x2c\x71\x5d\x37\xed\x5d\x78\x92\xaa\x9b\x16\xa4\x54\xae\x3e\xf4\x7b\x28\xa5\xd8\xa1\x66\x9b\x8e\xa1\x08\xa5\x6e\x0e\xce\xb9\xbd\xdd\x97\x4a\xfb\xae\x48\x8a\xa8\x68\xca\xe2\x02\x4a\x64\x22\x29\x0c\x81\xf0\x8d\xdd\x4f\xf6\x98\x0b\x2c\x64\xcc\xce\xb7\xae\x64\x69\x35\x97\x1e\xb9\x55\x00\x3c\x95\x5b\x5c\xb0\x12\x84\xeb\x05\xa0\x7f\x5c\xe8\x9e\x92\xab\xea\x9b\xad\x19\x40\x1a\xe4\x2c\xbe\x84\x64\xbe\xa1\x9f\xdb\xf5\x44\x48\xff\xf3\xd5\x57\x16\x15\x9b\x7c\x5a\x6b\xb6\xe0\x1e\xa2\x48\x30\x24\xcb\xd1\xbb\x88\x2f\x84\x46\x80\xf6\x0f\x10\xa6\x35\x3f\x40\x5b\x26\x5b\x53\x46\x35\x57\x63\x9b\x60\xe5\x36\x8e\x05\x39\xd5\x15\xd0\x85\x12\x11\x13\x83\xc5\x5c\xe3\x39\x9e\x4a\x09\xf0\x28\xb0\xff\x30\xee\x8c\x4b\x4c\x39\x0e\x6b\xe5\x1c\x80\x6a\x39\x3c\xe6\xbf\x95\x8f\x71\x45\x52\xa9\x74\xb9\x5b\x5e\x55\x12\xac\x04\x22\x9f\x40\x73\x03\xa5\x89\x2a\x52\x33\xe8\x23\xe3\x93\xa9\x56\x3d\xc2\x07\x6c\x00\x5f\x19\xd8\x5e\x39\x6c\xca\x98\x45\xb2\x73\x4e\xaa\x80\x34\xc2\xe4\xbb\x13\xef\x00\xb4\x59\x03\x3d\x7f\x6e\x17\xbf\xdb\xca\xed\xea\x11\xa6\xa3\xc1\x69\x8f\x44\x32\xcd\x0a\xcd\x00\xa4\x2b\x35\x5b\xc7\xb5\xc3\xaa\xd7\xd3\x5c\x16\x13\x5c\x89\x4d\x3b\x54\x3e\x88\x8a\x09\x86\x90\xcf\x11\x83\xbf\xf1\x08\x17\x77\x44\x5c\x5b\xda\x22\x75\x48\x6a\xb0\x3e\x97\xb6\x8f\xcc\x2c\xcf\x99\xca\x24\x7a\x2a\x17\xf3\x18\xff\x8f\x7f\xe8\x44\x9d\x96\x9b\x39\xe5\x93\xa9\xdb\x4b\x9a\xa3\x91\x59\xfd\x06\x75\x33\x27\x0e\x89\xdf\xec\x0b\x1c\x4a\xca\x08\xbe\xa1\x66\x79\xba\xaa\xd6\x01\x57\xc9\x2d\xf2\xbf\xa5\x13\xf2\x86\x9c\x00\xa1\x70\x7d\xac\x80\x68\xfb\x32\x3b\xf5\x65\x11\xdb\x5f\x50\x56\x48\xb8\x81\x14\xe2\x9b\xf8\xb1\x6a\xfb\x77\x0e\x06\xe6\x9c\x79\x04\x95\x9a\x0f\xe0\xde\xd4\xb8\xb9\x29\xfc\x73\x39\x93\x7a\xf7\x2f\x99\x85\x40\xe6\x65\xf1\x03\xcb\xd3\xde\xca\xf6\x04\x55\xea\xc7\xf5\x34\xc3\xac\x6c\xd4\xda\xa3\x4d\x47\x8d\x66\xc5\x2e\xd5\x6b\xb1\x5a\xc4\x21\xd5\x56\x76\xa7\xc2\xcc\x8c\x0e\x26\x63\x76\xac\x2c\x9a\x5e\x93\x66\x05\xcd\x41\x3c\x49\xbb\x16\xce\x1b\x3f\xf6\xcb\xa8\x81\x59\xb5\x33\x2d\xba\xc0\xb4\xed\xff\xd2\xa6\xbb\x73\xed\xda\x99\xea\xd5\xbe\x4b\x4c\xab\xee\xcd\x4b\x2a\xcc\xfe\xca\x6b\xaa\x57\xa3\x48\x40\xf5\x6a\x52\x7a\x53\xbd\x16\x1c\x0a\x07\xaa\xbf\xe9\x55\x8b\x6f\xc8\x0f\x1a\xe9\xff\x43\x8b\x86\xd7\x3b\x6c\x53\xfd\x22\x9d\xea\xb5\x28\xe4\x5f\x49\xa1\xce\x8a\xa1\x7e\x80\xa6\xba\x1f\xd6\x3e\x8c\x38\xf6\xce\xba\xb4\x8a\x5f\xcf\xc6\x4f\x5d\xb7\x00\x2e\x34\xcb\xb3\x9c\x41\xeb\x01\xc8\x66\xb5\xa9\x3f\x07\xac\x0d\xaa\x5e\xed\x18\x3f\x5e\xad\x3b\xf8\xb7\x22\x3c\x10\xa6\xef\xa1\x67\xe1\x53\xc9\x51\xdb\x21\xb1\x93\xa3\x9d\x1c\xdd\x78\x75\x72\x74\xe9\xea\xe4\x68\xad\xab\x93\xa3\x9d\x1c\x7d\x42\x39\x8a\x56\x72\x2b\xc3\xfc\x27\x74\xa6\x2c\x5a\xe2\x20\x95\x5d\xcf\xe8\xaa\x49\x6e\x64\x92\x03\xd3\xb8\x07\x33\xde\xa6\x6d\xe4\x54\x4c\x18\x79\xdb\x7f\xfb\xe6\x4d\x33\x83\x7d\x7b\x16\x77\x78\xd5\x03\xc4\xc1\x6b\x9d\xd7\x6f\x2f\xde\x5a\x4b\xf5\xb4\xf4\xc2\x07\xda\xc6\x1a\x87\xab\xef\x50\x94\x32\x4d\xa8\xae\x38\xa7\x78\xca\x7a\xce\xb1\x8d\x47\xc0\x96\xa4\x38\xcf\x6f\x4c\xa4\xb0\xfe\x43\xf3\x11\x06\xed\x66\x10\x31\xaa\x98\xeb\xa6\x6b\x67\x21\x53\xf3\x56\x2e\xb4\x3b\x40\x66\x0a\xcc\xed\x0b\x39\x81\x90\x7b\x5c\xd8\xe6\xb9\x36\xcd\xe3\x14\x67\x6b\x1b\x7c\xa6\x86\x51\xe5\xf0\x7f\x66\xda\x3a\x87\x6e\x26\x6c\xc6\x84\x2e\x68\x92\xcc\x09\x9b\xf1\x48\xfb\xf5\x41\xa0\x8a\x6b\x74\xf6\xd7\x75\x4d\xd6\x54\x33\x9a\xa8\x16\xfd\x25\x7a\xde\x9e\xd6\xd5\x4c\x2f\x58\x1a\xbf\xde\x39\x5d\x90\x97\xb6\x16\x69\xad\xaa\xab\xcd\xc8\xe8\x87\x87\xff\x04\x42\xfb\x74\x57\xb7\x6b\x51\x53\x3e\xd7\x88\xb7\x2d\x2a\xb5\x45\x92\x18\x52\x41\x4f\xf0\xf2\x32\x56\x61\xd8\x2c\x17\xd8\x63\x08\x20\x0d\xe1\x73\xcc\x3d\xf7\x32\x93\x89\x9c\xcc\xc3\x1d\xc7\x6a\x07\x9e\x66\xce\x4d\x4f\x89\x2a\x46\x56\xbd\x30\x04\x79\xb3\xf0\x89\x3a\x7f\x63\xe7\x6f\xec\xec\xa4\xda\x57\x67\x27\x2d\x5d\x9d\x9d\x54\xeb\xea\xec\xa4\xce\x4e\xea\xfc\x8d\xeb\x56\xd6\xc9\xd1\x75\x3b\xd3\xc9\xd1\xea\xd5\xc9\xd1\xa5\xab\x93\xa3\x35\xae\x4e\x8e\x76\x72\xb4\x36\xe1\x65\x32\xde\x29\xad\x33\x93\xf1\x86\xac\x4e\xf4\x31\x45\xb2\x9f\xc8\x08\x0a\xbd\xcc\x86\x9b\x47\x5c\x51\x18\x4d\xd1\xed\xd5\x23\xff\x90\x82\x61\x8a\x9e\xf9\x4a\xe0\xbc\xc2\xee\x57\x99\x8c\x4f\xd4\xe9\x96\xc4\xad\x2e\x2b\xb4\xcb\x0a\x7d\x25\x59\xa1\x53\x8a\xd0\x50\x96\x4d\xad\x4f\x12\x0d\x8e\xe6\x3d\xcb\xd3\x57\x9d\x23\x6a\x88\xc6\x7e\x74\x48\xd9\x2f\x3f\x2c\xae\x3f\xb6\x31\x0b\x16\xdf\x56\x57\x6d\xf5\xec\x12\x1d\x8d\x64\x2c\xef\x23\xa1\x48\x32\xe6\xb6\xf6\x61\x81\x06\xed\x2e\xbd\x80\x5c\xcf\xea\x6a\x9e\x3d\xe1\xb3\x3a\x9d\x56\xbe\xde\xd0\x6d\x5d\xe1\xfe\x2f\x26\xfd\xb3\xb9\x55\xd0\x27\xda\xba\x98\x7f\xac\x6d\x17\xb4\x51\xec\x41\x19\xdf\x0e\x5a\xbf\x7c\xad\xc4\x23\xdf\x08\x59\xdf\x2b\xcb\x57\xa9\x42\x49\xd2\xdc\x58\x6d\x6c\x92\xb5\xb5\x76\x76\xf1\x86\x93\x17\x00\x3e\xbf\x7c\xb5\x57\xfa\x5a\xaa\x7c\xaf\x01\xb2\x7e\xf9\x6a\x6d\xfa\x93\x1d\xcc\x7f\xd2\xd2\x05\x40\xda\xbb\x01\xc8\x4e\xae\x00\xd2\xd6\x1d\x40\x9e\x04\x30\x7f\xf9\xda\xc1\xec\x25\x3b\x79\x08\xc8\x2b\x81\xd9\x5f\xbe\x76\xdc\xb2\xb6\xde\x02\xd2\x81\xf3\x37\xba\x76\x31\xe6\xc9\x2e\x06\x3d\xd9\x85\x46\x6a\x83\xfc\x2f\x5f\x1d\xec\xff\x8e\x3a\x40\x2b\xe1\xd6\xa6\x35\xc0\xba\x09\x34\xa4\x17\x0f\xb7\x74\x08\x45\x15\xa5\x0c\x86\x59\xdc\x7b\x4a\x88\x56\x4c\x15\x09\x11\x96\x5d\xee\x0b\x50\x1b\xfc\x1e\x3b\xc3\xba\x10\x01\x40\x4a\x30\x18\x0a\xb1\xe5\x24\x2d\x4b\x02\x52\x30\xe5\x4a\xe1\x2d\x39\x06\x0f\xc3\xbd\x03\x4c\x67\x29\xa5\xa1\x88\x17\x13\x5c\xca\x27\x40\xaf\x44\xa4\xd9\x23\xe7\xdf\x3a\x56\xe5\x1d\x47\x41\x0b\x28\x3f\xe2\xc9\x7f\xff\xcf\x69\xa5\x2a\xb2\x1c\x70\xb1\x73\xe4\x88\x69\xda\x4f\xd8\x8c\x25\x30\x0f\xd7\x4b\x72\x2a\xc1\x0b\x45\x1e\xa7\x4c\x90\xc0\x68\xbe\x59\xfc\x7a\x64\xcc\x28\x40\x8f\x9b\x43\x26\xe8\x68\x63\x8f\xb1\x75\x24\xd4\x19\x02\x9d\x21\xd0\x19\x02\x0b\xcf\x75\x86\xc0\xf6\xab\x33\x04\x1a\x5f\x9d\x21\xd0\x19\x02\xf5\x5e\xde\x19\x02\x9d\x21\xd0\x64\x02\x6d\x0d\x81\xf6\x49\x55\xa1\x5a\x1e\xf4\xcf\x55\x9a\x6a\x1e\x95\x09\x57\x1e\xa6\x15\xfe\x6b\xbf\xe6\x40\xa8\xea\xaf\x36\x06\x42\x83\x61\xc9\xf8\x19\x6c\xd1\xfc\xbd\x6d\xb0\xf4\xe4\x46\xa3\xe0\x25\xe6\x8d\xb5\x22\x92\x20\x70\xd2\x9a\x4a\xee\x5d\x5a\x40\xd9\x46\xdf\xe7\x0c\xc4\xe4\xc4\x45\x98\x4e\xcd\x57\x10\x52\x57\xff\x28\x34\xef\x97\x77\xf8\x98\x13\x84\x58\x2b\x15\x4f\x95\xe0\x8b\xcf\x40\xf0\xd1\xf2\xf2\xc3\x1a\xae\xc2\xf2\xca\x1c\xb8\xb2\xfd\x9d\x21\x87\x24\x2f\x84\xb0\x9d\x68\x6c\x08\x1d\xd9\x10\x30\x8e\x12\x98\xd0\x68\x55\x30\x1f\x50\xad\xca\x7d\x0a\x62\xbe\xd4\x22\x98\xd9\x92\x09\xdf\x1d\xdf\xfc\xe2\x22\xdf\x8e\x3a\x61\x45\xdc\xbf\x7d\x40\xae\x80\x20\xc3\x81\xb9\x82\xfd\x71\xed\x6e\x1a\x13\xd9\xd3\x94\xa7\x3d\x36\x2e\x4f\x5b\x88\x5b\x76\xd5\x69\xbf\xb2\xea\x34\xf8\x23\x1e\xa8\xbd\x97\xa9\x91\x9f\xa6\x0c\xa8\xc9\xf6\x07\x4a\x8b\x44\xf3\xac\xcc\x27\x53\xb6\xf9\x1a\x2a\xa4\x63\x9b\x8b\x53\xa5\x4f\x87\xe6\xb8\x40\xa7\x30\x1e\xe4\x9f\x29\x38\xc2\x36\xdf\x05\xba\x41\x42\x3d\x97\xd3\x5e\x31\xa9\x87\xbf\x8c\x5c\x8d\x4b\xdb\xc8\xde\x1b\x41\xc0\x78\x4e\x0c\x7f\x4c\xe6\xb6\xc1\xdd\x06\xc6\x8a\xb6\xd4\x8c\x39\xb9\x3c\xe1\x33\x26\x4a\xee\x7a\xa2\x4e\x4f\x9d\x80\xdf\x2b\xd7\x3f\x08\xd7\xfe\x53\xc0\x5d\xbf\xab\xc3\xb7\x61\x41\x9e\x73\x97\xdb\x57\xf2\xed\xe7\x4f\x4a\x69\x96\xeb\xd0\xd4\x59\xd1\x2a\xc7\xe1\x49\xf3\x1b\x5e\x5b\x85\xdf\xb3\xbb\x31\x9f\xab\x72\xe1\x15\xb8\x2e\xbb\xd2\x85\x55\xd7\xee\xa5\x0b\x4f\xe0\x9e\x7c\xfe\x0a\x86\x57\xe1\x92\x7c\xee\x0a\x86\xce\x0d\xb9\xf6\x7a\xc9\x85\x05\xd5\xab\xa5\xdb\xb1\x73\x39\xee\x20\xaf\x1b\x0b\xa5\xdd\x5d\x8d\x8d\x69\xa3\x75\xae\x41\x97\x67\xf0\x0b\xca\x33\xe8\x14\xf2\x4e\x21\xef\x14\xf2\xed\x57\xa7\x90\xaf\xbb\x3a\x85\xbc\xd6\xd5\x29\xe4\x9d\x42\x8e\x57\xa7\x90\x77\x0a\xf9\xaa\xab\x79\xcc\xbf\x8b\xf7\xef\x31\xde\xdf\x9c\x81\x34\x64\x1b\x8d\x09\xa2\x45\x7c\xbf\x8b\xed\xbf\x9c\xd8\x7e\x03\x18\x08\xa1\xf9\xee\x50\x10\xe1\x17\x5b\x87\x07\x41\x67\x92\xc7\x24\x2b\xb4\xad\xae\xef\x30\x21\xf6\x89\x09\x51\xf9\x02\x1d\x30\x44\x2d\x60\x88\x75\x7b\xd6\xa1\x43\x74\xe8\x10\x1d\x3a\xc4\x96\xab\x43\x87\x58\x71\x75\xe8\x10\x2b\xae\xae\x28\xcc\x5d\x5d\x51\x58\xad\xab\x2b\x0a\xab\x7b\x75\x45\x61\x75\xaf\xd7\xe7\xf0\x25\x5d\x51\xd8\x6b\x70\xfe\x92\xae\x28\xac\x73\x08\x37\xfe\x5c\xaf\xb6\x28\xac\x43\x87\xf8\x95\x67\x6d\x90\xce\x10\xe8\x0c\x81\xce\x10\x58\xf7\x5c\x67\x08\x6c\xbf\x3a\x43\xa0\xf1\xd5\x19\x02\x9d\x21\x50\xef\xe5\x9d\x21\xd0\x19\x02\x4d\x26\xd0\xa1\x43\xbc\xb6\x6c\x11\xd2\xa1\x43\x74\x19\x24\x1d\x3a\x44\x87\x0e\x51\x45\x87\xa8\x64\x33\x3c\x1f\x44\x44\xf3\x69\x74\x38\x11\x1d\x4e\x44\x87\x13\xd1\xe1\x44\xac\xbf\x3a\x9c\x88\xea\xf5\xb2\x1c\x9a\x5d\x59\xda\x96\xad\xe9\xca\xd2\xaa\x57\x57\x96\xb6\xee\x7a\x7d\xce\xc9\xae\x2c\xed\xc5\x3a\x24\xbb\xb2\xb4\xce\xf9\xb8\xfe\x13\xbd\xaa\xb2\xb4\x0e\x27\xe2\x57\x9c\x71\xd0\x29\xe4\x9d\x42\xde\x29\xe4\xdb\xaf\x4e\x21\x5f\x77\x75\x0a\x79\xad\xab\x53\xc8\x3b\x85\x1c\xaf\x4e\x21\xef\x14\xf2\x55\x57\x87\x13\xd1\xe1\x44\x54\x1f\xe8\x70\x22\x5e\x73\x94\xbf\xc6\xf7\xa6\x42\x48\x8d\xaa\xcd\xfa\x2f\x5c\x35\x04\xca\x27\x82\x12\x6d\x7b\x2e\xcd\xb7\xd6\x2c\xcd\x8c\x3a\xbf\x7e\x96\xb5\x38\x62\x53\xfe\x57\x63\xb1\x96\xcd\xb3\xbc\xee\x5a\x2f\xfc\x03\xe6\xd9\x31\x9f\x80\x89\x2b\x62\x3e\xe3\x71\x41\x93\x60\x40\xf7\x51\x63\x96\x25\x72\x6e\xa4\xc5\xb6\xe5\x6f\x3e\xe7\x5b\x4f\xf6\xea\x79\x5e\xc0\x2c\x9d\xae\xee\xe6\x0c\x5f\x0b\xe8\xd1\x49\x62\x3f\x71\xfc\xb5\xce\xac\x6b\x0b\xb2\x7a\xb6\x54\x1f\xce\xe5\x86\x5b\xea\xda\x3b\x4c\xcc\xb6\xf1\xa6\xca\x5e\x5d\x89\x19\xe0\x58\x18\x55\xcf\x48\x0a\x4b\xb9\x7e\x47\x8e\x95\x19\x92\xe7\x52\x80\xd2\x31\xa3\x39\xa7\xa3\xc4\x30\x0f\xd4\x1c\x51\xd1\xb5\x3f\xfa\xb3\x0c\xf8\x26\x34\xdd\x40\xf4\x78\xd5\xe7\xf1\x35\x79\xfb\xe2\xda\xbe\xd0\xaa\x01\x23\x56\xae\x86\xd8\x1b\x0c\xb7\xa4\x25\xf9\xd4\xcd\x40\xa8\xa9\xcc\x34\xb1\xaa\xb7\xd2\x03\x5e\xcd\xac\x60\x33\x64\x3d\xc1\x55\xd9\xc6\x1b\xf3\x35\x1d\x1a\xca\x8a\xdd\x1b\x90\x8f\xd6\xc2\xa0\xe4\xe2\xaf\xd7\x97\x57\x37\xf7\xd7\xef\xaf\xaf\xee\xea\xf1\xe5\x86\x52\x18\x64\x4f\x8b\x45\x1c\x7f\x71\xdf\x1a\x30\x32\x98\x30\x5a\xcc\x6f\x4f\xbe\x9c\xdf\xfd\xf5\xe6\xfc\xe3\xd5\x29\x30\x6f\xf6\x35\xa3\xc2\xf0\xef\x42\x39\x69\x9a\xe5\x6c\xc6\x65\xa1\x92\xb9\x97\x8c\x2b\xcf\x83\x13\xb4\x25\x2b\x41\xd7\xce\x9c\x28\x96\xcf\x78\xb4\x7a\xeb\xd0\x3a\xa4\x25\x21\x46\x20\x81\xcc\x5e\xe6\x4c\xc9\x64\xc6\x62\xd4\xdc\xfd\xa4\xdd\x7b\xb8\xc8\x0a\xed\x2c\x4d\xc8\x59\x1a\x19\x3d\x30\x9a\x52\x31\x61\xf1\x80\x5c\xca\xc2\x8c\xf7\xdb\xdf\xc2\xc2\x72\x16\x17\x11\xca\x25\xcf\xf4\x7e\xdb\x73\x42\xd8\x88\x51\x85\x48\x34\x2a\xa2\x99\x5b\x7a\xb8\x3b\x6a\x2e\x34\xfd\xfa\x0e\x93\x76\x8e\x7e\x1b\xfc\xe9\xc8\xa1\xf8\x48\xf3\x0a\x3c\xfe\x38\xab\x04\x00\x64\x12\x72\x14\xde\x3d\x20\x57\xe6\x1d\x2c\x0e\xbf\x03\xe6\x5c\xb1\x19\xcb\xc1\x4e\xb5\x5f\xc1\xb0\x98\x09\xcd\xe3\x84\x29\xe0\x2c\x8f\x53\x06\xc8\x48\x68\xaf\xd8\x0d\x63\xde\x0a\x16\x52\x0f\xc8\x25\x1b\xd3\x22\xd1\x80\x1e\x74\x74\x34\x38\x3e\x18\x09\xbe\xcf\x65\x4d\xf4\x90\x0a\x19\x0e\x11\xaf\x67\x2c\xf3\xb5\xc7\xe9\xd8\x3a\x09\x07\xe4\xc2\x13\x43\xa1\x0c\x73\xb6\xfe\x15\xa7\xfe\xa0\x3d\xdf\x60\x85\x0d\x8c\xae\xe6\xee\x35\x94\xae\x1f\x69\xf6\x23\x9b\xdf\xb1\x71\x7d\x35\xbc\xba\x3f\xa0\x05\x5a\x4f\x13\x4a\xe3\x0b\x37\x70\x7d\x2b\xb3\x95\x8d\xd9\xc6\xe9\xd9\xcc\xe1\xd9\xce\x67\xd9\xd8\x5f\xb9\x84\x3b\x84\x7a\xb9\xd5\xb0\xdb\xb8\x90\x1b\x3b\xd8\xea\x4b\x9a\x95\x93\x3e\x0e\x45\x8e\xe5\x13\x7a\x40\x3e\x4a\xd0\x37\xc7\xf2\x1d\x99\x6a\x9d\xa9\x77\x67\x67\x0f\xc5\x88\xe5\x82\x69\xa6\x06\x5c\x9e\xc5\x32\x52\x67\x91\x14\x11\xcb\xb4\x3a\x93\x33\xc3\x79\xd9\xe3\xd9\xa3\xcc\x1f\xb8\x98\xf4\x8d\x91\xd2\x47\xaa\x50\x67\x60\x07\x9d\xfd\x06\xad\xee\xfb\x4f\x97\x9f\xde\x91\xf3\x38\xb6\xe0\x6b\x85\x62\xe3\x22\xb1\xd0\x4b\x03\x42\x33\xfe\x85\xe5\x8a\x4b\xd1\x23\x0f\x5c\xc4\x3d\x52\xf0\xf8\xdf\xea\x31\x97\x1d\x77\x52\x66\xa8\xf5\xef\xb0\x9b\x43\xb0\xfd\xe6\x15\xfe\xe9\x0f\x95\xe1\x9c\x5c\x2b\xa0\x11\xe7\x28\xb4\x82\xae\xc5\xf2\x46\x52\x26\x8c\x8a\xba\x59\xc5\x66\x7b\xdb\xf3\x8a\xe3\x92\x59\xa0\x67\xc3\x12\x4c\x26\xe3\x77\x44\x15\x59\x26\x73\x6d\x34\x5b\x4d\x63\xaa\xe9\xc0\x7c\xe9\x5e\xf5\x9f\x60\x07\xf7\xc8\xdf\xfc\x8f\x60\xcc\xaa\x3f\x1f\x1f\xff\xe9\xc7\xab\xff\xfc\xee\xf8\xf8\xbf\xfe\x16\xfe\x35\xb0\x0e\xab\xb7\x18\xeb\x7a\x60\x2c\xdb\x1b\x78\x07\xfc\xd3\x8a\xfd\xf3\x28\x92\x85\xd0\xf6\x0f\x9a\xea\x42\x0d\xa6\x52\xe9\xeb\x5b\xff\xcf\x4c\xc6\x8b\xff\x52\x35\x25\x17\x79\x5a\x66\x07\xdb\x7c\x4b\xf5\xf4\xc0\x2c\xaf\x3c\x6f\x3b\x90\xbd\x1d\x21\x04\xd1\x4b\x29\xfc\xe7\x7b\xb7\x0c\x23\x49\x1f\x73\xae\x35\x13\xa0\x57\x41\x22\xb2\x1c\xf7\xcc\x09\x28\xd5\x88\xd9\xdb\xa3\x27\x61\x9a\x7e\x77\x77\x58\x34\xac\xca\xae\x18\x0f\x85\x67\xfc\xcb\xae\xa0\xf3\xdb\x6b\x32\xc3\x5d\x3a\xf8\x02\x5d\xb2\xea\xfb\xbd\x9d\x79\x0f\x7e\x68\x97\xeb\x35\xef\x77\xe8\xb7\xf6\xe9\xb1\x24\xe1\x29\xb7\xe1\x26\x0b\x94\xa8\xc8\x09\xfe\x38\x88\xb2\xa2\x67\x6f\x18\xa4\x2c\x95\xf9\xdc\xff\x93\x65\x53\x96\x1a\x0d\xb6\xaf\xb4\xcc\xe9\x84\xf5\xfc\xe3\xf8\x98\xff\x17\x3e\x58\x79\xc1\xf2\xd3\x68\x62\x44\x45\x6e\x84\x59\x32\x77\x1c\x8a\xc5\x2f\xf3\xac\xbb\xed\x3b\xf0\x51\xf7\x5f\xed\x66\x47\x95\xc1\x5b\xed\xa8\x7c\xf8\x55\x83\xae\x3d\x93\x49\x91\x32\xd5\xf3\xe2\x14\xad\x1d\x31\x33\x5a\xb7\x7a\x12\x41\x1e\xf3\x19\x57\x4d\xa3\xb2\x2b\xe4\x38\xb7\x89\x01\xb2\xd0\xc6\x02\xc4\xca\x9c\x00\xac\x54\x2a\xb0\xad\x7c\x6a\x78\x85\x95\xbd\x6d\x52\x76\x47\x48\x46\xb5\x66\xb9\x78\x47\xfe\xbf\x93\xbf\xfc\xcb\x3f\xfb\xa7\xff\x76\x72\xf2\xe7\x37\xfd\xff\xe7\xbf\xfe\xe5\xe4\x2f\x03\xf8\x8f\x6f\x4e\xff\xed\xf4\x9f\xee\x1f\xff\x72\x7a\x7a\x72\xf2\xe7\x1f\x3f\xfe\x70\x7f\x7b\xf5\x5f\xfc\xf4\x9f\x7f\x16\x45\xfa\x80\xff\xfa\xe7\xc9\x9f\xd9\xd5\x7f\xd5\x1c\xe4\xf4\xf4\xdf\x7e\xdb\x68\x9a\x54\xcc\x3f\x35\x60\x28\x78\xf5\x5b\x15\x42\x2d\x3e\xdd\x22\xf4\xfc\xb5\x5f\x2a\xad\x7d\x2e\x74\x5f\xe6\x7d\x1c\xe6\x1d\xd1\x79\x51\xff\xc0\x95\x4c\x75\x97\x73\xe3\xd0\x2c\xdf\x95\x0c\xd5\x8b\x8c\x83\x1f\x0c\xc5\xa2\x9c\xe9\x7d\x5b\x8c\x38\xaa\x93\x77\x0b\xe1\xa7\x97\xc8\x6b\x5f\x8b\x11\xe9\x43\x35\xb0\xbf\xa5\x66\x31\xce\x65\x3a\x20\x81\x1b\x70\x06\xf9\x1f\xf6\xbe\x07\x56\xd3\x4b\xe1\xae\xce\xe8\xfc\x65\x1a\x9d\x43\xa4\x87\x27\xb7\x38\xeb\x10\xc6\x5a\x8f\x77\x54\x37\x1a\x50\x7b\xaf\xbd\x70\x6e\x34\xa7\x3b\xaf\xce\xda\x94\x1d\x3b\x3b\x04\xed\x2e\x95\xdd\x76\xd3\xae\xc5\xe6\x9a\xb0\x1e\xd4\xa1\x1b\x17\x5c\x1e\x7f\x40\x5d\x3d\xf6\x90\xfb\x98\x16\xf3\x95\xa7\x45\x4a\x68\x6a\x6c\x69\xb3\xc4\xe5\x75\xbb\x60\x70\xd3\x03\x5b\x89\x0a\x9e\xa5\x54\xd0\x09\xeb\xfb\x61\xfb\x65\x4c\xf3\xac\xce\xd1\x6b\x28\x34\xda\x67\xb5\xbc\x70\xd5\xac\x91\x4a\xd6\x4e\x15\x6b\xa1\x82\xed\xac\x7a\x39\xeb\xae\x39\x5d\xdf\x39\xc3\x73\x81\xb2\xb9\xd8\x4a\xd9\xb9\xc7\x1b\xbf\x1e\x13\x3f\x0e\x57\x44\xa6\x5c\x6b\x6b\xe1\xd0\xf2\xc4\xf7\x08\xd7\x15\xb5\xdf\x9e\x29\xc0\xe0\xc7\xb6\x05\xec\x6b\x96\xf0\x88\x83\x19\xea\xdc\x01\x3d\x14\x43\x8f\x1c\xeb\xd3\xa9\x20\x3c\xcd\x30\xd7\x0c\xce\x46\xdf\xc5\xbf\x6c\x74\xa2\x3b\x67\xdd\x39\x5b\x73\xed\x74\xce\xd0\x56\xff\x68\xce\x43\x33\x09\xf9\x25\x78\x70\x39\x17\x27\x38\x1e\x65\xe6\x42\x8a\x37\xbf\xac\xb4\x85\x60\x1d\x01\xb7\xa0\x38\x59\xc8\xc7\x32\x46\x0e\xde\x05\x89\x57\x90\xb5\x50\x5b\xe0\x1f\x34\x6b\x01\xe6\x58\xd3\x43\x7c\x90\x1c\x07\x3f\x81\x16\xc1\x59\x70\x9e\xda\x0d\x5d\x88\xe5\x6b\x1b\x2b\x87\xd8\x33\xee\x7c\x99\xc4\x07\xef\x34\xfc\x19\x2d\x20\x4c\xdd\x83\x47\xc9\xf1\xbb\xe3\x83\xa4\x41\xe0\x32\x73\x99\xd1\x09\xad\x07\xa3\xb2\xb4\xda\xc5\x21\x48\xcc\x34\xcb\x53\xc0\xbb\x98\xca\x47\x77\x36\xcc\x41\xca\xec\x5d\xcc\x02\x86\x40\xdb\x15\xa9\xc0\x04\xac\x26\x3c\x80\x67\x0a\x14\xfe\x47\x3a\x27\x34\x97\x85\x88\x2d\xac\x88\xd9\x16\xc5\x74\x8f\x7c\x5c\x78\xf1\x8d\x14\x10\xd3\x2e\x94\xd9\xc3\xe5\x4a\x2c\x63\xc8\xbf\x1d\xbc\xad\x09\x70\xd3\x70\x23\x5b\xe6\xc4\xc0\x2c\xc1\x7a\x81\x24\x47\x58\xb7\xb3\x1a\xdc\xd9\x3c\xc8\x7c\x73\x46\xe3\x4f\x22\xa9\x69\xcd\x57\xe6\xfc\x11\xc9\x14\x86\xe8\x83\x8b\xdc\x68\x04\x79\x01\x7e\x6d\x1a\xf7\x1f\x73\xae\x59\xa0\x04\x9c\x8c\x69\xa2\xa0\x1f\x52\x21\xbc\x96\x70\x5a\xcd\xb1\x80\x5b\x9a\x2c\xb4\x7e\x84\x50\x15\xa3\x3d\x9d\x63\x7b\x60\x81\x74\xcb\x63\x1c\xa6\xb8\xad\x3f\xd2\xd5\x84\x12\x72\x82\x77\x1e\x2b\x92\x4b\xa9\xb7\x74\xe7\xaa\xae\xbc\xf6\x27\xb6\x0b\xbf\xfa\x9a\xd5\xf4\x16\x57\x93\xde\x5c\x0e\x53\xb6\xc7\x5d\xf8\x9e\x4d\xe9\x8c\x29\xa2\x78\xca\x13\x9a\x27\x90\x4f\x30\xc4\x79\x42\x6f\xad\x95\x59\x75\xcd\x32\xad\xd6\xa4\x1c\x6e\xfd\x04\x6e\x1e\x66\xbf\x80\x07\xb9\x79\x21\x82\x91\x83\x3d\xfa\x1a\x25\x85\xe2\xb3\xfd\x9d\xca\x64\x4b\xc5\x48\xe5\xab\xd8\x9a\x8e\xcd\x19\xc2\xfe\xae\xc4\x9c\xc7\x39\x14\x55\x8d\xe6\x4b\xf7\xc1\x28\x0f\x2c\xdb\x9a\x53\xfb\xf4\x29\xc5\x9b\xb9\xe9\x5a\x1f\x4b\x99\x70\xeb\x9c\xb6\x17\xc3\x2f\xc7\x8a\x70\xa1\x34\x4d\x12\x57\x92\xb4\xc1\x8d\x58\x67\x6e\x32\xae\x51\x5a\x5d\x9d\x63\xf0\x88\x73\xbb\xa8\xd0\xa9\x2c\xe3\xb2\xde\xef\xe5\x7d\x8d\x2c\xe7\x32\xe7\x7a\x7e\x91\x50\xa5\x36\xc7\xd7\xaa\xfc\x73\xf1\xb9\x85\xb5\xdb\x3f\x93\xc8\xfc\x3d\xc8\xba\xd8\x50\x9e\x5a\x63\xb6\x5a\x26\x2c\x6f\x94\x7b\x7f\x5f\x3e\xb1\xf2\x64\x1d\xab\x70\xd0\xa7\xcc\x40\xbf\x77\x47\xd6\xa8\x09\xe5\x34\xa1\x74\x47\x6b\x0a\xfd\xde\xcc\x3c\xf1\x2f\x46\xbb\x17\x73\x62\xb8\x9f\xb6\x2d\x00\x83\x9e\x78\x3a\x07\x08\xb6\x3f\xf9\x6a\xb1\x1e\x1b\x8f\x59\xa4\xbf\x0b\x78\xa7\x2f\x00\xf1\x15\x5e\x7f\x72\xff\xf5\xdd\x1e\x72\xd8\x6b\x27\x9f\xc3\xc4\x9a\xe5\x9f\xc3\x23\x50\x45\x10\xf9\xa2\x67\xdc\x09\x1c\xcd\xec\x13\x2c\xcf\x15\x82\x60\x69\x11\xaa\x5d\x00\x1f\x17\xdc\xac\xac\xae\x19\x38\x35\xac\x5f\xb0\xac\x70\x63\xe4\x46\x5a\x54\x42\xd6\x23\xb7\x20\xa4\xca\x5f\x40\x82\xdc\x48\xc4\x27\xac\x69\x08\xd6\x90\xec\x35\xe2\x2f\x95\x6d\xf9\xb1\x2c\x31\xc6\xf5\x55\x4a\x8c\x4b\xaa\xae\x54\x88\x6d\xda\x9f\x07\x36\x2f\x4b\x51\x6d\x01\x33\x24\x90\xf6\x4a\x9a\x71\xde\x78\xac\x2c\xfd\x3f\x16\x52\x4c\xa6\x23\x2e\xf0\x65\x38\xb4\xfb\x20\x30\xba\xdb\x56\x11\xc3\x3f\xe1\x35\xfb\xda\xb4\xba\x95\xcc\x95\x9d\xfb\xd4\xa0\x6e\xd9\x57\x3e\xae\xae\x58\x0e\xca\x94\xaf\x7e\x2e\x68\x52\x55\x45\xec\x4f\xf6\xa6\xa5\x4a\xcd\x47\x9e\xc4\x11\xcd\x6d\xfe\x01\x9c\x5b\xa2\x24\x7e\x43\x84\xc6\x8b\xa8\xf0\x1c\xa0\xfc\x52\xd8\x1e\x92\x64\x34\xd7\x3c\x2a\x12\x9a\x13\x73\x2e\x26\x32\xdf\x1a\x44\xab\xbd\xaf\x25\xf9\x0c\x59\x24\x45\xdc\xcc\xcd\x72\xbf\xf8\x74\xb8\xd3\xc0\x79\x59\xce\x2d\xf2\x1f\x4f\xd9\x22\xc1\x9e\xd8\xae\x9f\x96\xd6\xe4\xd8\x9d\x73\x7f\xe8\x2a\x8e\xc8\x8a\x35\xc8\x11\x42\xf4\x34\x60\x9c\xfe\x84\x0c\xc8\xf7\x73\xe7\xf5\x04\x0f\xa8\xcd\x92\x06\x93\xd3\xbe\xd3\x92\xaf\xdd\xf2\xf2\x70\x8d\x65\x0e\xc9\xe7\x27\xb1\xc4\xcc\xea\x19\x8f\x8c\x66\xf9\xff\xb2\x5c\x62\x95\x23\x9b\x20\x90\xa3\x25\x77\x97\x70\xaf\xa1\xb7\x2b\x14\xcb\xbd\x21\x27\x08\xad\xc9\xd3\x94\xc5\x9c\x6a\x96\xcc\x4f\x9d\xfa\x86\xe0\x9c\xf5\x3e\x60\x3d\xef\x5c\x80\xc8\xfa\xfb\x6f\x37\xfb\xd3\xea\x54\x4c\x54\x1d\x50\x2e\xcb\xbc\xdc\x21\x4c\x3c\x5f\xf8\x94\x5e\x4a\xc9\x0d\x05\xea\x41\x41\x7a\x60\x59\x38\xd6\xe3\x3f\xf4\xdf\x0d\x3d\x50\x92\xb3\x09\xd0\x3c\x52\xf1\x1e\x28\xde\x66\xfe\xd4\xd4\x28\xd0\x70\x5f\xad\x4d\x54\x2a\x9e\xec\x7d\xf5\x1d\x87\xfb\xd7\x31\xac\x03\xb0\xc2\xe6\xcc\x1c\x62\x67\xc7\x81\x5b\x10\xb5\x10\x8a\xad\x8f\x47\x8c\xd0\x28\x62\x4a\x61\xc1\xaf\x51\x3a\x4a\xf7\x4d\x99\x2e\xf1\xfa\xaa\xde\xe8\xa3\xba\x4a\xa8\xd2\x3c\xfa\x3e\x91\xd1\xc3\x50\xcb\xbc\x19\xc9\x1f\x9f\xff\x34\x5c\x1a\x61\xa1\x72\xec\xfc\xa7\x21\xb9\xe4\xea\x21\x48\x94\xb1\xe1\x94\x50\xa7\xa3\xe4\xa1\x18\xb1\x84\xe9\xe3\x63\x85\x4e\xb2\x94\x1a\xdd\x8c\x39\xff\x98\xf0\x59\x5a\x25\x6d\x35\x8e\xa6\xd8\x9c\xc2\x33\x4b\x88\xbf\xa1\x8f\x8a\xe1\xf4\x47\x66\xfa\xe6\xcf\x6c\x5b\x1c\xa5\x81\x1b\xb8\xbe\x13\xb8\x6f\xa9\xef\xfa\x72\x8f\xc1\xe4\xb1\xba\x37\x73\x6d\x1c\x74\x7b\xcf\x13\x66\x71\x91\xcd\x62\xdd\x71\xb5\xe7\x03\xbe\xde\x5c\x16\xe4\x91\xa2\xc8\x06\x77\xc7\x80\xdc\xf3\xec\x1d\xb9\x12\xaa\xc8\x59\xa9\x72\x8d\x17\x86\xe2\xaa\x4c\xe0\x74\x5c\x1e\xbe\x36\x72\x40\xc3\x24\x2c\xd3\x27\x57\x5f\x69\x9a\x25\x4c\xbd\x23\x47\xec\xab\xfe\xf6\xa8\x47\x8e\xbe\x8e\x95\xf9\x3f\xa1\xc7\xea\x68\x40\xae\x53\x1f\x8d\xe3\xc2\x36\x70\x46\x84\x68\x7c\x80\xf0\x71\xe8\x82\x3b\x08\xb1\xd8\x6c\x94\xa9\x7c\x24\xb1\x24\x8f\x58\xf8\x66\x54\x19\x96\xe7\x46\x1f\xb2\xdc\x21\xd8\x06\x70\x24\x45\x32\xcd\x72\x99\xf2\xc0\x12\x01\x62\xaf\x1f\xc3\xab\xe9\x16\x03\x7d\xa8\xae\xa7\xbb\x4a\x05\x60\x8c\xb9\xc7\x49\xd5\x1b\xb6\x8e\x06\xae\xc7\x2e\xba\xda\xb3\x1e\x0a\x50\x2c\x40\x28\xda\x9b\xcc\x57\xb7\xa3\x00\xeb\x0f\xbe\xf3\x7b\x9f\x72\x4a\xce\x62\x36\x3b\x53\x31\x7d\xdb\x83\xd7\x28\x9b\x16\xa3\x2b\x73\xa2\x8a\x1c\xbd\x3d\x1a\x90\xa1\x73\xaf\xf5\xc2\x39\x96\xf7\x19\x55\xd2\x0d\x08\x18\x1e\x6f\x8e\xc8\x89\xcc\x61\x64\xa3\x4d\x26\x8c\xce\x98\xb5\xd1\xe1\x74\xcd\x51\xd0\x9e\xd6\x4a\x31\x6e\x1a\x1c\x6c\x02\x0a\xdf\xcc\x71\xbd\x90\xf2\x6d\xf7\xec\x48\xe7\x05\x83\xae\xf2\x63\x69\xb8\xaf\xe1\xa7\x8a\xe1\xf1\xbc\xb3\xa3\x97\x0b\xe7\xa2\x1a\x10\xd4\xd2\x0e\xb0\xf1\xe3\x1e\x81\x43\xfb\xe8\x59\xf8\x31\x69\xec\x24\x77\xac\xb6\xf9\x9e\x7e\x16\xfc\xe7\x82\x91\xeb\x4b\xef\xbe\x61\xb9\xe2\x4a\x9b\x13\x1f\x57\x64\x1c\x47\xc1\x77\x72\x9e\xd2\x7f\x48\x41\xae\xbe\x1f\xda\xd7\x9e\x3e\xf3\x26\xd5\x60\x1c\xf4\x1f\x45\xce\x8c\xc8\x6e\xa4\x0b\x9c\xbb\xa7\x16\xe5\xbf\xf9\x9d\x5c\x52\x4d\x51\x0d\xb0\x29\x1b\xa2\xe4\xfd\x86\x22\x47\x5c\xc4\xf6\x4f\x81\x7c\x7f\x1e\x51\x6c\xbe\xe4\x4d\x9d\x18\x2b\xde\xfa\xf9\xee\x7a\x8f\x42\x3b\x02\x49\x30\xf9\x28\xe3\x16\x92\xfb\xdf\xcd\x76\x5e\xe0\x08\x24\x35\x43\x90\x1b\x29\x58\x0f\x0e\x3a\x31\x27\xdd\xfe\xe7\x4f\x39\xd7\xac\x01\x7b\xab\x29\x6e\xdc\xce\x35\x9e\xf9\x7d\x10\x08\x04\xde\x62\xc8\x05\x4e\x94\x15\x3c\xa3\x44\x8e\x88\x3d\x09\x87\x98\xf5\xe7\xbb\xeb\x56\x93\xfe\x7c\x77\xfd\xf4\x13\x6e\xa9\xd8\x2d\xea\x75\xa5\xdc\x2e\x53\x90\x17\x15\xb6\xfa\xda\xda\x60\x5f\x7a\xda\xbe\x77\xeb\x81\x8b\x5a\x69\x18\xd5\xa3\x74\xf5\x35\x43\x60\x1d\xeb\xbb\x18\x4e\x29\xa4\xfb\xfb\xe6\x1e\xf0\x81\xcd\x17\x57\x46\x0a\xb8\x4f\x6d\x2c\x45\xe0\x62\xe4\x92\xa1\x6f\x36\x7e\xe7\xa0\x03\xfc\x13\xab\x1f\xf8\x08\xc9\x5d\xf1\x3b\xe4\xbf\x04\x73\xbd\xe2\x80\xb2\x4e\x30\xfa\x2c\xfc\x9f\xe8\x8c\xf2\x84\x8e\x78\xc2\x01\xb1\x55\x9f\x0e\x2a\x09\x6c\x0a\xa6\xbc\xf7\x43\xde\x5a\x25\x59\x8a\x80\x93\x13\x33\xd6\x19\x44\xd0\x4f\x07\xa5\x36\x02\xf8\x4a\xe0\x2c\x42\x95\xa5\xa2\xaa\x28\xa6\x81\xf2\x16\x34\x95\xfa\x64\x53\x47\x4d\x80\x4f\x60\xce\x4b\x73\x11\x68\x9e\x5a\x29\x02\xe1\x0f\x43\x0b\x6b\xf1\xf2\xa5\x20\x96\x21\xd4\x94\x83\x40\x6a\x35\xee\x6d\x22\x09\x7f\x0d\x74\x46\x7c\x35\x4f\x2b\xb1\xe9\x80\xeb\x00\x3e\xd3\x96\x97\x54\x70\x73\x91\xf2\x86\x96\xd5\xd8\xaa\x6a\x94\xb4\x86\xde\xea\xf5\x6f\x69\xc4\x1d\x3c\x25\x34\x5e\x0c\x70\x58\x52\x8b\xe0\x1a\xcc\x29\x62\xd9\x74\xdc\xcc\x55\x7f\xc1\xb2\xe9\xfb\x61\xd5\x45\x68\x7e\x23\xef\x87\x2b\xce\x2d\x6c\x38\xac\x5a\xa1\xe3\xf0\x58\x91\x84\x8f\x99\xe6\x5b\x17\x72\xa0\x93\x9b\x4a\xc1\xb5\xcc\xd5\x1e\xcf\xa2\x1b\xb2\x5d\x06\x37\x16\xca\x7d\xb4\x63\x20\xd2\x67\x24\x93\x84\x45\x0e\x53\x0c\xb6\xd7\xbd\x64\x95\x81\xc4\xac\x97\x60\xf0\xf0\x47\x30\x91\xac\x31\x74\x86\x9f\xf7\xec\xee\xea\xfc\xf2\xe3\xd5\x20\x8d\x7f\x33\x95\x8f\x7d\x2d\xfb\x85\x62\x7d\x5e\xab\x12\xaf\x19\x60\x62\x03\xb0\xc4\x86\x8e\x9a\x7a\x39\x5b\xd5\xcd\xfd\xe4\x2a\x8e\xc8\x67\x85\xf1\x14\x70\x26\xb9\x8c\x35\x29\x75\x8f\xe4\xd4\x56\x12\x51\xeb\x8b\x2a\x92\x04\xf7\x5b\xe7\x8c\xf5\x42\x33\xbe\x41\x0e\xf9\xa1\x75\x85\x60\x69\x87\x65\xe7\xcf\x41\x6c\xcd\x24\x43\x1d\x35\x64\xd3\xfe\x0d\xfd\x28\x2e\x38\x05\x39\x6e\x5a\x42\x40\x1b\x30\xa7\xc6\x32\x37\x14\x94\x57\xa9\x81\xe9\x08\x96\x7c\x56\x28\x96\x0f\xac\x7c\x79\x86\xed\xaa\x2b\x79\x60\x82\x35\x4b\x62\x37\x6f\xd6\x1d\x1b\x23\xca\xb3\x83\x0f\xb3\xda\x18\x2d\xf4\x94\x09\x6d\xf4\x7a\xc3\xb8\xec\x96\xac\xdc\x3d\x0b\x1b\xfd\x0c\xdb\x55\xbb\xbc\xa3\x69\x4d\x6c\x93\x4a\xd1\x5f\x70\x8d\x68\x23\xa2\x34\x47\x67\x47\xbe\x9e\xbb\x63\x9b\xd3\x58\x42\xbe\x37\x82\x35\x54\xc8\x8d\xc6\x29\x17\x2f\xf4\x70\x46\x5c\xc4\xdb\x77\x61\x01\x97\x02\x9e\xa9\xea\x60\x38\x8e\xf3\xef\xfb\xe8\x21\x75\x36\x13\x34\x1c\x75\x71\xc4\x6a\x14\xb1\xd6\x41\x4c\xe7\xea\xe7\xa4\x8f\x6f\xe9\x67\x71\xb9\x37\x5d\x48\xf0\xb0\xae\xa3\x27\x08\xf4\xed\xed\x5b\x93\x5f\xa7\x0a\xb4\x87\xfd\x6a\xa6\xf5\xec\x28\xc7\xa1\x31\xb0\x72\x5d\x36\xb0\x80\x1e\xce\xa7\xb3\x8d\xa1\x61\x30\xcd\x69\xca\x34\xcb\xb1\x8a\xc6\xd6\xe5\x08\x9b\x3e\xf9\x29\x63\x62\xa8\x69\xf4\xd0\xc0\x4f\xde\xc9\xde\x17\x24\x7b\xdb\xc7\xf9\x5c\x2a\x50\xec\xe9\x82\xc7\x46\xef\xb3\x21\xe8\x32\x53\x08\x0f\xc4\x0b\xe2\x38\x1e\x4f\xb4\x99\xc7\xc3\xe3\x1a\x56\x05\xae\xff\xd9\x3a\x39\x20\x0f\x2d\x93\x59\x91\x60\x26\x20\x77\xd5\x2f\xfb\x13\x90\x4d\x0e\x86\xd5\x7f\xda\xc5\xca\x4a\x5e\x91\xca\x98\x91\x11\xd7\x25\x17\x50\x4c\x93\x8c\xe5\x29\xb7\xdd\xb9\xa4\x20\x91\xcd\x59\x04\x29\x67\x24\x9a\x7d\x79\x20\x01\x05\x91\x91\xa6\x36\xb9\x97\x8c\x98\x7e\x64\x4c\x90\x37\x6f\xde\xbc\x01\x1d\xe5\xcd\x1f\xfe\xf0\x07\x02\x35\xe7\x31\x8b\x78\xba\x7c\x23\xdc\xf5\xbb\xb7\x6f\x07\xe4\x3f\xcf\x3f\x7e\x80\x9c\xb3\x4c\x2b\x32\x92\x7a\x6a\x47\x36\x37\x54\x1e\x56\x3d\xf2\x7f\x87\x9f\x6e\x9c\x5a\xa1\x16\xfe\x0a\xa6\x89\x5f\x5e\x35\x11\xf7\xcd\xef\xbf\xfd\x76\x40\x2e\x79\x0e\x65\x18\x1c\xd2\x33\x7d\xd5\x53\xe6\x2a\x81\xa0\x61\x00\x64\x9a\x06\x6d\xea\xac\x20\xb1\x55\x87\x29\x74\xe5\x1f\xe1\x79\x90\x62\x9c\xf0\x48\x63\x67\x03\x64\x00\x08\x8b\x62\xfb\x19\x50\x9b\x9d\x6e\x93\x62\x60\x72\x3d\x92\xf0\x07\x46\xc6\xea\x87\x5c\x16\x59\xd9\x5d\x25\x67\xca\x68\xb7\x11\x15\x90\xf2\x0a\x83\x95\xdf\x4a\x31\xfd\x02\xf2\x33\xda\x35\x48\xbf\xae\x28\x2c\x3d\x6c\xfd\xfe\xc0\xe6\x7d\xa4\x88\x8c\x72\x9f\x58\x08\x81\xf3\x0a\x50\xa7\xb7\x4a\xe3\xe0\xdc\xba\xd4\xda\x2c\x97\x7f\xc7\x8f\xe5\x41\xe9\x9d\xbe\xac\xac\x8e\x66\xdb\x51\x80\xdf\x98\x57\xdb\xa5\x19\xd9\x68\x5b\xb1\x05\x49\xde\xd7\xe3\xb0\x32\x40\xdb\x56\xf6\x2c\x86\xb4\xf5\x4d\x6f\x2e\x31\x13\x0d\x3d\x29\xfc\xb2\x85\x58\x7a\xda\xc2\x35\x5b\xce\x63\xc1\xbd\x6d\xb6\x7d\x39\x06\x66\x23\xdb\xfc\xe4\x00\x7a\xbe\x02\xcc\x5a\x49\x01\x52\x4c\x17\x76\x6b\x20\x1f\xcb\xbc\x9b\x29\x65\xd3\x9c\x53\x9a\x3f\x18\x93\xc1\xf2\x81\x01\x14\x40\x96\x1d\xf5\x7d\x17\x7a\xb0\x2f\xe8\xbc\x52\xa0\x6c\x5e\x72\x3c\x18\x1c\xe3\x41\x91\x39\x51\x9a\xe6\x96\xea\xcd\xef\xf5\xa3\x04\x07\x70\x3c\x56\xcb\x57\x69\x86\xfd\x73\xc0\xd1\x63\x31\x8d\x69\xa5\xcc\x91\xda\xfd\x3a\x10\x28\x76\x53\x70\xaf\x26\xc0\x5e\x7d\x58\x48\xad\x9b\x9b\x23\x80\x35\x42\xff\x5a\x07\x1f\x6d\x8f\x45\x53\x14\xee\x46\xe0\x53\x69\x4d\x01\xb8\x62\xa6\x4d\xc5\xa0\xad\x2c\xa8\xb4\x2f\x78\xf9\x72\xef\x7a\x8c\x45\x0d\x55\x2e\x66\xb9\x44\xa0\x45\x94\x2d\x01\xca\xa2\xfa\x17\x2e\xde\x42\xa2\x69\x0a\xca\xd8\x4c\xd4\xe1\x55\x37\x50\x81\xd7\xd2\xa9\xf0\x5c\x35\xab\x40\xee\x26\xe8\x52\x00\x4d\xd3\x1d\x9d\x01\xf9\x68\xb9\x2e\x12\x19\x1d\x29\x99\x14\x1a\x1f\x2d\xff\x18\xb2\x64\x18\xd4\x75\x7a\x03\x3e\xec\x6f\x0b\x18\x74\xd0\x6c\xa1\x2e\xaf\xc6\xab\xd1\xd1\xac\x6b\xb1\xfd\x42\xad\xb5\x46\x7b\xd5\x04\x39\xaf\x15\x48\xbb\x6a\x81\x99\x57\xdf\x6b\x11\x29\xde\xcc\xdc\x1a\x5e\x93\x93\x12\x79\xd7\x85\xe7\xaf\x85\x66\xf9\x98\x46\xec\x34\x34\xc3\x3c\x22\xb2\xcf\x18\x72\xf5\x13\x53\x2a\xe2\x04\xd5\xf2\x88\xe5\x70\x08\xd8\x57\xcd\x72\x41\x13\x78\x45\x9c\xf3\x19\xcb\x15\x39\xf9\x9e\x19\x1d\x12\x9b\x2b\x6f\x05\x5f\x38\x54\x52\x25\x4c\x66\x9f\xc6\x1f\x0c\xd8\x3c\x39\x04\x1e\x73\x1a\xae\x08\x8e\x5b\xb9\x65\xb8\xc3\xb8\xbd\x2a\x34\x73\x07\x86\xb8\x80\x6b\x03\x23\x99\xcb\x22\xb7\x9e\x77\xd7\x9a\x24\x92\xb9\x31\xa8\x70\x60\xaa\x48\xce\x26\x46\xdb\xcd\xcb\x16\xde\x51\x52\x98\x1f\xf6\x9e\xe6\xb6\xf7\xa4\xc0\x4d\x29\x7d\x63\xab\x84\xcb\x19\x8f\x9d\x38\xc5\x6e\x87\xbe\xd7\x4a\x46\x55\x50\xad\x43\x95\x92\x11\x07\x0b\x3a\xd8\x67\x54\xe9\x41\xe8\x7a\x18\x9d\x4a\x6e\x79\xe8\x53\x96\x50\xb5\x5b\x0b\x14\xb5\x19\xa3\x96\x31\xbb\x2d\x46\x09\x57\xd3\x61\x6b\xd7\xe3\xcd\x8a\x41\x30\xd1\x61\x29\x88\xb8\xd6\x1d\xa9\x98\x50\x1c\x84\xa3\x61\xf5\x46\x34\x73\xa3\x71\x49\xd8\x4a\xf7\x74\x48\xa5\x12\x0a\x49\x12\x66\xab\x32\xcd\x9f\x82\x79\xd8\x4a\x37\xac\xcc\x8e\xd9\x67\x91\x55\x7e\x8f\x68\x92\x2c\xb5\x70\x77\x8c\x18\xb5\x14\x57\xfd\x86\x5f\x96\x9b\x8f\xee\x51\x82\x17\x00\xf6\xd6\x2e\x4c\x91\x54\x62\x45\x90\x20\x52\xb8\x9b\xa0\xc0\xdc\x3d\x10\x00\x9e\x00\x6c\x12\x10\x4e\xfd\xcf\xdc\xf9\x5c\x5f\x90\xcf\xb5\x75\xb4\x63\x18\x34\x7b\x2d\xa1\x96\xaa\xcd\x07\x1d\x93\x75\xcc\x78\x4b\x58\x64\xef\x91\x09\x7c\xef\xb9\xd6\x39\x1f\x15\xba\x1e\x19\xad\x28\x3e\x2d\x07\x00\x91\x6e\xac\x17\x38\xd0\x7d\x6b\x9f\x44\x01\xa5\x5a\xb3\xc2\x1f\x8b\x65\x36\x50\xca\x24\x10\x47\xf8\xe3\xb1\x22\xb1\x8c\x0a\x8f\x42\x09\x5b\x57\x06\xe4\xea\xb7\xc5\x7f\x32\xc4\xc8\xda\x54\x16\xcb\x47\xf1\x48\xf3\xf8\xfc\x76\x6b\xc6\x7e\x55\xf0\x97\xcf\x85\xea\x95\x1b\x0e\xda\x7a\xd0\x91\x2c\x74\x89\x1c\xf4\xeb\x70\x7a\x6b\x69\xf8\x45\x4d\x1f\x37\xe9\xdc\xe6\x9d\xdb\x7c\xd5\xf5\x24\x6e\x73\xf3\x14\x6a\x55\xae\x11\x78\xe5\xf8\x3a\xac\x36\x9e\xd4\x4f\x21\x3e\xb4\xff\x35\x60\x3b\xc8\xfd\x17\xeb\x03\x16\x74\x3d\x3c\x30\xe5\x97\x0e\xf4\x43\xc7\x97\x40\xac\xbf\x14\x5f\xed\x01\xfd\xaf\xbb\x36\x3a\x2b\x53\x8f\x37\xb5\x3c\x03\x3d\x23\xe8\x51\xd6\xb3\x10\x75\x3d\x6b\xc3\x89\xa0\xf9\x37\x9c\xed\x17\xde\x81\xa8\xeb\x36\x56\xeb\xea\xba\x8d\x2d\x5f\xcf\x11\x47\xe8\x79\xcf\xdc\x2b\x50\x04\xba\x38\xc2\xca\xeb\x89\xe3\x08\x01\x63\x77\x0c\xc2\x6e\x44\x18\x5b\x08\x5d\x7b\x2e\xc0\x30\x62\x4e\x21\x1d\x94\xa0\xd3\x86\xf4\x5c\x68\x41\xe6\xd5\x10\xef\xf1\x60\x70\x7c\xec\x02\x0e\x96\x4e\x0b\x3d\xee\xff\x91\x30\x11\xc9\x18\x3f\xae\x19\x3f\x57\x1a\x54\x84\xd2\xea\x0f\xe7\x92\xba\x77\x85\x61\x62\x18\xbb\xe9\xa7\xf9\xc5\x76\x0f\x0c\x7b\x04\x56\x5b\x02\xe2\x1d\x5d\x1f\xc0\x55\x57\xd7\x07\xb0\xf6\x12\xbb\x3e\x80\x6b\xae\xae\x0f\xe0\x0e\xe7\xe6\x19\xfa\x00\x82\x37\xfe\x92\x37\x2c\xa8\xb8\xb2\x4f\x55\x33\x3c\x35\x4b\x33\x99\xd3\x7c\x4e\x62\xeb\xd1\x98\xaf\x28\x68\x0d\x2a\x5a\x77\x46\xaf\x81\xd9\xc7\x3c\xdf\x63\x4d\x45\xa3\x42\x56\x16\xf3\x22\x6d\xee\x38\xfb\x09\x10\xcd\x2c\x1a\x9a\x0b\xc4\xe2\x60\x1e\x9f\x91\x46\x0f\xa8\xd7\xfa\xbd\x44\xed\x20\x04\x4a\x3a\x5a\x00\xd5\x04\xd7\x1b\x44\x22\x65\xcc\xcc\x46\xbb\x9b\x71\xec\x8a\x9b\x0c\xc3\x30\x36\x73\xe1\xc4\xde\x78\x6a\x74\x96\x8f\x20\x22\x9f\xec\xdb\x90\xc6\xa5\x79\xfc\x1f\x0c\xda\x29\xb5\x80\x21\x93\xa0\xb8\xfb\x46\x4f\x89\x8c\x82\x50\x78\x45\x4c\xc1\xde\x7b\x3a\x77\x41\x02\xf3\x05\xcc\xfb\x51\xe1\x00\xef\x51\xa2\x30\x96\xc8\x23\x00\xdc\x07\x25\x1f\x7b\x11\xbb\x5d\xbf\x0f\xda\xa7\x15\xca\xbc\x09\x70\x7c\x83\x7b\xca\x17\x3d\x3a\x74\x4e\x1d\x74\xa6\xaa\x9a\x26\xe6\x2f\x43\xb7\x07\x81\x71\x07\xe5\x1a\x4e\x8f\x57\x05\x68\x8f\xf6\x2d\x56\x7f\x92\x63\x88\x94\x95\x7d\x9e\x3c\x3e\xe5\x12\x6d\x09\x9e\x54\x89\xcb\x81\xf0\xf9\x85\x17\xc2\x66\x3f\x2c\x51\xca\x6a\x42\x29\x14\xcb\xfb\x93\x82\xc7\xed\x48\xe4\x45\xcb\xbf\x06\x52\xaf\x8d\xac\x6b\x2c\xe1\x76\x92\x6b\x3e\x47\xa4\x91\x4c\x38\xba\xf2\xa9\x25\x15\xa1\x10\x82\xfc\x55\xd3\x4c\xa8\xcb\x5e\xf0\x07\xd0\xc5\xa0\xee\xbd\x1d\x6c\x85\x45\x34\x8f\x6c\xc1\x31\x5f\xe8\xdb\x60\x86\x45\x3a\x84\x4c\xdc\xbe\xf9\x1f\x67\x2f\xbb\x70\xc1\x88\x8d\x31\x6a\x8c\xcf\x80\xd9\x64\xf3\x87\x63\x96\x30\x0d\xe9\xc0\xcc\x43\xaf\x62\x38\x3a\x95\x33\x43\xda\x7f\x11\xe4\xb3\xc3\x40\xe6\xe3\x77\x84\x9e\x56\x8a\x36\x14\x5a\x2a\x82\xb1\x18\x93\x8a\x93\xf2\x3d\x79\x21\x54\x8f\x8c\x4e\x5d\xb2\x0c\x9c\x3f\x61\x74\xc4\xc4\x83\xd7\x82\x11\x9e\x33\xb3\x01\x50\x3a\x9d\xcb\x94\x28\x41\x33\x35\x95\xd0\xa4\x33\xa2\x19\x8d\xb8\x9e\x9b\xed\xd6\x39\x8d\x1e\xcc\x5d\x10\x13\x80\x37\xf6\x48\x74\x6a\x33\xd0\xc2\x1d\xac\xa6\x3a\xeb\x69\x2e\x8b\xc9\x14\xf2\x76\xf1\x2e\x68\x1a\x60\x37\x60\xe5\xf3\xd6\x1e\x52\x24\x9e\x0b\x9a\xf2\xc8\xc3\x20\xe6\x72\xc6\x15\x97\xd6\x83\x8c\xe3\x1a\xfa\x27\xb7\x1e\xbb\x0e\x1d\xd3\x17\x09\xe5\x29\x39\x51\x8c\x11\x4f\x18\xf8\x97\x21\x2a\x36\xe8\x0c\xc9\x99\x79\x3c\xf4\x5a\x4b\xdf\xf7\x49\x58\x44\x87\x92\xef\xf9\x60\x19\x0a\x4f\x38\x78\xf1\xea\x57\x9f\xfa\x4f\xb7\x7a\x66\x32\x87\x04\x02\x87\x46\xca\x44\x2c\x83\x90\xe9\xf9\xed\xb5\x0a\x8d\x15\xa4\x33\x8b\xcf\x07\x7f\x48\xa4\x98\x84\x40\x08\x25\x95\x1a\x26\x2b\x00\x4c\x7f\xc6\xe3\x82\x26\xc8\x5e\xed\x64\x2e\x86\xd7\xf8\x38\x9f\x4c\x75\xff\x91\x81\x1b\x07\xa5\x50\x99\x9a\xe5\x5e\xca\x97\x52\x89\xb8\x02\x76\xac\xad\xfb\x01\x5d\x62\x66\x6a\x8f\x74\x0e\x28\x3b\x36\xe1\xa5\x12\xad\x75\x28\x68\x38\x84\xdf\xf7\x60\xd3\x61\x7a\xe7\x1e\x10\xdd\x68\x11\xe0\xe7\x32\xbb\x0c\x54\xbb\x3c\x37\xb3\xf1\x01\x5e\xa1\xff\x59\x97\x60\xcc\xa8\xe1\xfd\x45\xa0\xc7\x0a\x02\x2d\xa3\x20\x77\x0c\x86\x40\x7f\x39\x22\x4f\x41\x31\x81\x3d\x86\x3f\x30\xc1\x72\x1e\x2d\x90\x8e\x7f\x74\x42\x35\x1c\x3e\x26\xcc\x63\xf1\x60\x9b\x29\x75\x20\xfd\x6f\x56\x12\xd4\xbd\x6d\x0c\xd3\x58\x27\x39\xfa\x29\xf0\xee\x05\x31\x1b\x73\x5a\xa9\x88\xfb\x34\x31\x74\x7a\xfb\xe5\xc2\xe6\x7f\xe3\xf9\xab\x64\x2e\xb8\x4e\x17\x5c\xf8\xa6\x42\x46\x77\x59\x79\xec\x00\x4c\x60\xc4\x00\x57\xdb\x23\xa8\x1b\x13\xf6\xd1\x98\xeb\x96\x54\x6e\xbf\x5c\xf4\x08\x1f\xb0\x81\xfb\x97\xbf\xd5\xf1\x4b\x2d\x27\x98\x23\xe9\x73\x60\x81\xca\x61\x2a\xa1\xaf\x2c\x7c\xf6\x6f\x7f\x32\x93\x34\x7f\xfd\xae\xff\xa7\x00\xb5\xf5\xbb\xbf\x99\xef\x9e\x9b\x1b\xaa\xbf\x86\x69\x75\xbe\xe7\xd9\xdf\x6e\x65\x6c\xac\xef\x81\xc5\x09\xff\x1b\x06\xdd\x08\x13\xda\x28\xad\xb7\x32\xc6\xce\xd7\x48\xfb\xf0\xee\x9c\xfd\xdd\xf9\x3f\x61\x9b\xbc\xb7\x29\xa2\x9a\x09\x10\x11\xae\x7e\x45\x48\x6d\x1b\x67\x63\x43\x4f\x33\xff\x13\xf0\x4f\x60\x01\x5e\x8f\x68\x29\xe1\xf0\x23\x83\x39\x17\x84\x7d\xe5\x0a\x2a\x61\x71\xad\xb0\x1d\xd4\xe6\xec\x39\xa9\x67\x86\x35\x3b\x1c\x87\xbd\x84\x60\x6e\xdf\x08\xa9\xbf\xf1\x9f\xdf\xe5\xa4\x80\x68\x93\x84\xce\x24\x77\xed\x99\xcc\xb9\x14\xe0\x08\x2c\xd1\xc5\x47\x73\x92\x72\xa5\xe9\x03\x1b\x90\xa1\x91\x6a\x61\x60\x0f\x77\x4f\x10\xc0\xf5\x64\x31\x29\x84\xe6\x09\xfc\xb5\x1c\xc7\x4c\x39\x94\x76\xd7\x63\xa2\x8a\xc8\x88\x8a\x2c\x67\x7d\x27\x3f\xed\x5d\x4b\x9c\xa7\x5c\x4b\xcf\x7f\xec\x29\x45\x43\x24\x8b\xe1\x51\xd8\x0f\x2a\x2c\x79\x2d\x65\xaf\x99\x79\x4a\x11\x95\x32\x13\x36\x53\x0d\xc8\x0d\x88\xc9\xc4\xc5\xba\xd1\x26\xb2\xfe\x55\xc1\x22\xa6\x14\xcd\xe7\x3d\x68\x7b\xc5\x3d\xae\xba\x4d\x54\x02\x26\x92\x52\x81\x4d\xa7\x72\x16\x49\xa1\x74\x5e\x44\xb0\x3b\x94\x8c\x72\xf9\xc0\x84\xcf\x9c\xf4\x0c\xca\xa7\xad\x95\x09\x43\x10\xae\x93\x24\x9a\x52\x31\x09\x3a\x0e\xa4\x34\x86\xbd\xff\xd1\xeb\x58\x6e\x3d\x66\x07\xe8\xd8\xa8\x34\x5c\xc3\x56\x8c\x8c\xe0\xf2\x5e\xe2\xbf\x08\xe2\x4c\xfc\x5e\xe9\xc6\x35\x4b\xe2\xc9\x56\x1e\xd6\x90\x8f\x91\xc6\xde\xc6\x3e\xe8\x0c\x07\x48\x7d\x4b\x99\xa6\x31\xd5\xb4\x55\xfa\xdb\x47\xea\x01\xf2\x6d\x2c\x15\x1b\x9d\x94\x31\x56\x2b\x01\x9d\xd2\x27\x33\x1e\x96\x8d\xc1\xa9\x9c\xba\xaf\x00\x15\xeb\xda\xd0\x97\x8d\x6d\x60\x56\x1b\x36\x1b\xb0\x5d\x6a\xcc\xf0\x6e\x34\x64\x1d\x2c\x26\x71\x01\x0a\x5a\xc9\x5a\xea\xc6\xcf\x1a\xbb\x89\xcd\x47\x68\xb5\x53\xf7\x65\xa8\x2f\xaa\xa6\xb8\xad\x54\x81\x50\x6e\x30\xa1\x79\xce\xc2\x5a\x3d\xbb\x81\x85\x40\xb2\x5f\xd8\x4a\xd8\xeb\x09\xd3\xaa\x4c\xc6\x41\xce\x6c\xd8\x8d\x6f\xa2\x76\xef\x74\x00\xb7\xbd\xd6\xd2\x5c\xad\x8b\xe1\xe6\x2b\x69\x39\xaf\x91\x05\x07\xdb\xdd\x76\xbe\x71\xec\xd8\xf0\x51\xc6\xcd\x1c\xea\x0b\xed\x0d\xca\x41\xca\x7c\x56\xcc\x4f\x56\xe0\x76\xc0\xb7\x40\xb8\x4d\x55\x2a\x0f\x91\xfd\x4d\xe9\xac\xbd\xc7\xac\xd4\xd5\xfa\x1e\xfa\x19\x5e\xd7\x87\xd7\xf5\xdf\x36\xf1\x2f\x36\x4b\x76\x71\x57\x83\xa4\x97\xea\x8b\x1a\x3a\x76\x0d\xab\x19\x36\xf6\xc5\x2e\xe2\xd5\x7b\x89\x60\x43\xa1\x3e\xfc\x6c\x8b\x44\x18\x37\xfc\xe3\x1d\xf9\xa6\xa2\x03\x58\x5d\xcb\x5b\x70\x98\xbd\x7c\xe2\x4c\xba\x81\xfd\x1c\xae\x8c\xbf\x7a\xfb\xe9\xc2\x60\xa0\x7c\xac\xb6\x6e\x5c\x96\xb4\x57\x08\x8d\xf2\x96\x83\x5f\xcf\x15\x6d\x18\x12\xcb\x65\x92\xb0\x1c\x96\x60\x4d\xba\x85\x84\x00\xc0\x83\x45\x57\x73\xcf\x9b\xce\x5e\x03\x15\xec\xd1\xab\x1a\x54\x21\x22\x8e\x0b\xd4\xb1\xb0\x69\xf3\xaa\xf1\x7c\x26\xf7\xb9\x98\xe3\xd4\x2f\xfd\x87\x59\xa7\xc8\xf7\xc2\x66\x43\x30\x17\x9a\x3c\xd2\xb9\x02\xda\x2f\x2d\x0b\xff\x7e\x8b\x51\x57\x0e\x7c\xc7\xc6\xf8\x74\x83\x40\x5e\xcb\x50\x5e\xbb\x60\x1e\x54\xa4\x72\x51\x2f\xe3\xaa\x7c\xa4\x56\x97\xe6\xf2\x6a\x17\xff\x83\x34\x1c\xc8\x07\x68\x1a\xc0\xa9\x02\xd7\xde\x5e\xc3\x20\x4e\x7f\x9f\xc0\x3f\x9c\x2c\xf2\x91\x8d\x11\x33\x34\x5e\xd6\x9f\x03\xbd\x84\xcf\xae\x48\x8e\x28\x09\xed\x47\x00\xb7\xb5\xee\x6c\xdf\x2b\x3a\x67\x90\xe2\x02\x6f\x1c\x40\x0b\x08\x2a\xe6\x56\xd2\xeb\x29\xcf\xe3\x7e\x46\x73\x3d\x47\xc3\xb6\x57\x79\x9b\x2f\x40\x68\xb8\xf4\xd6\xb1\xa7\xba\x48\xd2\x6b\xf7\x19\xb6\xc0\x75\x88\xb2\xc1\x84\xb5\xbb\xfb\x54\xab\x6a\x52\xe6\xb0\x72\x55\x37\x01\x92\x80\xb3\x24\x9f\x75\x55\x71\xc8\x5c\xda\x4b\x94\x85\x88\x31\x0a\x86\xb0\x11\xb0\x0c\xd3\xc2\xbd\xec\x07\x03\xca\x4c\xa1\x67\xec\x56\x4a\x84\x14\x7d\x5b\x1b\xe5\x5d\x90\x56\x7f\x70\xa9\x41\xb6\xc9\x94\x98\x5b\xc7\x52\xf8\xae\x70\x00\x7f\x52\xc8\x89\x90\x02\x4f\x0f\xde\x7b\x8a\x99\x51\x6b\x3c\x67\x70\x8b\x6d\xf2\x58\xed\x0d\x17\x9c\x56\x27\x36\xb8\x88\xcd\x27\x03\x5e\x0e\x36\x95\x2a\xa2\x88\x31\x6f\x85\x87\x3d\x81\xca\xd3\x6d\xa7\xec\x9a\x9b\x29\x09\x00\x39\xd0\x88\xb6\xb4\x7e\xed\x76\x49\x90\x7c\xce\x51\x19\x08\xc4\x4a\x39\x92\x75\x04\x40\x0b\x55\xcc\xe2\x29\x44\x84\xf9\x07\x5c\xcf\xdd\x0c\x42\x09\x05\x8f\x81\xa9\xa1\xd0\x28\xe6\x63\xf4\x8a\x05\x26\x83\xdf\x4c\x60\x4f\x73\x64\x48\x55\x59\x65\x61\x2f\x0c\x2f\x1a\xd1\xe8\xe1\x91\xe6\x31\x34\x74\xcc\xa8\xe6\x08\xb6\xde\xab\x0c\x7b\x12\xcc\xc1\xbc\xbd\x22\xdb\x4e\xbd\x61\x02\x4d\x57\xe4\xc2\x6b\x08\x2d\xb4\x4c\xa9\xe6\x11\x98\xbe\x7c\x1c\xf8\x38\x53\x8f\x23\xb9\xd0\x24\x1e\x38\xad\x5d\x06\x68\xee\x39\x66\xa6\xea\x47\x49\x78\x6a\x74\x06\x0a\x0d\x49\xc6\xbe\xae\xca\x39\x64\x37\xcd\xd4\x28\x46\x3f\x81\x3b\x3c\xb8\x0b\x8d\x6a\x63\x66\x29\x18\xde\xfb\x5b\xbd\xa3\xd1\x16\x24\xf5\x16\x44\x3a\x71\x4f\x19\xaa\x36\xb3\x0d\x88\xb5\x67\x3e\xd0\x23\x33\xba\x82\xda\x48\xb2\x6a\xb0\x6a\x4e\xd8\xcf\x50\x91\x98\xab\x85\x36\xa5\x27\x71\x2e\xb3\xcc\xba\x54\xd2\xd3\xe5\x39\x41\x94\x23\x9f\x31\x15\x74\xe1\x44\xaf\xfa\x84\x09\xdf\x54\xd6\xa2\x85\xc0\xe9\x5d\x7c\x09\x64\x9b\x91\x00\x6c\xee\xe4\x3c\xc9\xa6\xf4\x94\x7c\xb6\xcd\x9c\x3c\xfd\xfa\x9c\xc2\x5a\x1a\x15\x3a\x69\x9c\x77\xb4\x53\x85\x3a\x55\xa8\x53\x85\x3a\x55\x68\xe7\x55\xf9\x54\xb6\xf6\x6a\xd0\x9d\xcf\xe9\x5c\xe8\x58\xeb\x72\x1d\xca\xa4\xcf\xc3\x7b\x40\xfc\xbb\x9e\x80\x43\xb6\xe5\x43\x98\xba\xb1\x13\x1d\x1d\x7f\xc0\xf4\x0f\xfc\x71\x64\xb7\xd6\x65\xa4\x94\xd9\x30\x46\x2b\x29\x34\x0b\x3e\x81\x15\x56\x8d\xf7\xbc\x52\x69\x7b\x86\x1d\x66\xfa\x7e\xd8\x7e\x99\x80\x52\x0b\x24\x3e\xbc\x5a\xee\x3e\xd9\xa9\x9c\x34\xbc\x5e\x74\x1e\xca\xc2\x82\xdb\xe4\x62\x92\x9d\xf3\x31\xc9\x6e\x39\x99\x64\xbf\x79\x99\xc4\xe7\x7e\xef\x76\x86\xee\x5c\xde\xf9\xc2\x29\xb2\x6c\x6b\xd3\x29\xaa\x60\x1b\xf8\x71\xb8\x72\x1d\x10\x6d\xac\xd1\x9f\x0a\xf0\xc4\x85\xb9\xc2\xf6\xfc\x82\xc9\x84\x01\x45\xf6\xd5\xa3\x18\x07\x7a\x41\xd0\xb3\x5b\x42\x12\x42\x9a\x21\x7c\x11\x9c\xc3\xbe\xcd\xd7\x72\x26\x49\x77\xa6\xdd\xd5\x9d\xe9\x26\x23\x3c\xf7\x99\xc6\x7c\xe8\x66\xe9\xfb\x55\xad\x1c\x03\x89\xe4\xe7\x82\xe5\x73\x22\x67\x2c\xc8\xf3\x01\xd0\x66\xc5\x63\x9b\x29\x63\xfd\x17\x4d\x34\xe1\x27\xd6\x0f\xc0\x47\x72\xf5\xd5\xe8\x52\x50\x0f\xb7\x13\x97\x5b\x1c\xac\x5a\x1e\x8d\xbb\xe6\xb6\xdf\xb1\x35\xc3\x5f\xd4\xc0\xe2\xb1\x95\xbf\x80\x0f\xe1\xfc\xe6\xb2\xad\x19\xd1\x34\xba\x44\xda\x45\x98\xc8\x3a\xe2\x58\xb5\x4c\xdc\x0e\xff\x97\x6a\x77\x2c\x6f\xff\x93\x07\x36\xef\xd9\x20\x75\xd9\x8c\x1f\x6e\xc6\xbc\x8b\x2a\x4c\x6a\x7d\xf8\x8c\xea\xb5\x03\xd7\x6c\x6b\xaf\xe3\xd5\x04\x52\xb3\xfa\x9c\xdb\x8c\xe6\x0c\x7a\x07\xe6\xde\x10\x7a\x33\xbc\x2a\x84\x61\x21\x64\x21\xd9\x0f\x28\x04\x80\x05\x5d\x5a\xb4\x27\x0a\x48\x05\x07\x4e\xd2\xfc\x93\x92\x5d\xcc\x4d\xbc\xdc\x16\xef\x61\xc1\x9e\x74\x2b\xf9\xbc\x0f\x6c\x7e\xac\x6c\x45\xa2\x14\x6a\xca\x33\x87\x8b\x0f\x3c\xc2\x52\x33\xf9\x02\xa1\x7c\x37\x04\x72\x83\x6b\xd1\x23\x37\x52\x9b\xff\xbb\x82\x3c\x1f\x74\x1b\x4a\xa6\x6e\xa4\x86\x5f\x9e\x65\xcb\x70\xca\x7b\xd8\x30\xeb\x37\xe4\xe0\xf5\xc3\xac\x34\xa8\xef\x70\x99\x23\xb0\x31\x36\x28\xea\x37\x97\x2b\x72\x2d\x88\xcc\xdd\xce\x68\x07\x95\xac\xec\x10\xce\xb3\x13\x38\x69\x57\x8c\x61\x37\x54\xe6\x95\xfd\xdc\x30\x9c\xf7\xf7\x72\xf7\x17\xf0\xfc\x80\x83\xdc\xa7\xbb\x00\xd4\x2f\xd5\x6c\xc2\x23\x92\xb2\x7c\x02\x15\xa8\xd1\x74\x97\xcf\xd4\x86\xaf\xe3\xd5\x92\xbb\x87\x2f\x6f\x41\x23\x20\x12\x3f\x40\xe2\xd1\xee\xa2\x15\xc7\x41\x31\x92\xd2\xcc\x90\xc7\x7f\x1b\x69\x01\x5f\xe8\x7f\x00\xaa\x5b\x0d\xc8\xb9\xeb\xf1\x1a\xfe\xcd\x3a\xf6\xc2\x61\xcc\x08\xc6\x16\xf8\xb9\xe0\x33\x9a\x30\x4c\xce\xa3\xc2\x63\x96\xca\xf1\x92\x40\xef\x59\xbc\x6e\xc3\xbb\x7c\xd8\xe6\xe8\x81\xcd\x8f\x7a\x4b\x24\x75\x74\x2d\x8e\xca\xc2\xf0\x0a\x11\x79\xa1\x07\x1e\xfd\x23\xf8\xdb\xd1\xfe\x75\x80\x67\x34\x09\x5a\xd1\x8b\x75\x35\x5d\x24\x54\xa9\xa6\x35\xb4\xeb\x31\xdf\x86\xc1\xa8\x65\x8d\x92\x4d\xbe\x8c\x30\x95\x6b\x7f\x3e\x31\xa8\x0d\x68\x93\x0e\xd4\x70\xaf\x66\xb6\x69\x4c\x13\x90\x88\x45\x7e\xeb\x87\xf0\x25\x20\x8f\x61\x65\x5d\x19\x21\x5d\xb3\x6b\x5f\x20\x06\x23\xc7\x21\x6e\x25\x57\x60\x36\x73\x57\x14\x22\xa4\x26\x5c\x44\x49\x11\x23\xea\x26\x3c\x0a\x46\x77\x73\xa3\xa0\xd5\x16\xed\x44\x48\x5f\xfc\x10\x4e\x77\x71\x11\xd9\xa5\x5c\xdc\xc5\xd0\x19\x84\x2b\x7d\x14\x0a\x77\xec\x10\x2b\x1e\x6f\xcd\x76\xac\x42\x8c\x5e\x54\xf5\x91\xf7\x7c\x94\x33\x72\x31\xa5\x42\xb0\x24\xa8\x9b\xb5\x8e\x12\xdf\xd8\x0b\x94\x14\xdb\xce\xeb\xb8\xda\xcf\xcb\xf1\x38\xe1\x6b\xb6\x0f\xd2\x2f\xf9\xf5\xb7\xd6\xda\x5b\x57\x76\x8b\x31\x39\x95\x8f\x24\x96\xe4\x11\xba\x35\xcc\x8c\xc8\x82\x58\xa9\x72\xc2\x2e\x98\x29\x64\x2f\x44\x32\xcd\x72\x99\x72\xe5\xf2\xdc\xed\x07\xdc\x7b\x89\x69\x52\xd4\xc2\x21\x5a\x07\x5a\xf3\xfe\x82\x68\x9a\x4f\x98\x36\x03\x11\x51\xa4\x23\xd6\xa0\x0c\xf6\x50\x30\x69\x2f\xbf\x77\xd8\xfe\xdb\x7f\xe1\x67\xf8\xe9\xa7\x9b\x16\xcd\x86\x57\x7d\xcf\x47\x99\x27\xf1\x23\x8f\x31\x20\xa7\xc8\x89\x19\xfa\xf4\xf5\x74\x06\x7e\x7c\xe4\xf1\xae\x5b\xe1\x32\x93\xcc\x56\x10\xd8\x0b\xdb\xcf\x8a\x03\x3e\x38\xbc\xe2\x94\x5c\x71\xac\x0f\x32\xff\x42\x24\x9c\x74\xc4\x45\x59\x91\xe6\x3f\x0c\x70\x5f\x73\x4e\x9c\x65\xa2\x98\xc6\xca\x0e\x28\x8e\x90\x7a\x4a\x14\x4f\x8b\x44\x53\xc1\x64\xa1\x92\x79\x03\x32\x79\xce\xed\x1e\x27\xec\x2b\x52\x77\x33\xf9\xe6\x1f\xab\xca\xb9\x09\x56\xc4\xb9\xdd\x5f\x12\x74\x65\xe2\x53\x7c\xe6\x85\x9e\x2f\x1d\x62\x5f\x59\x64\x73\x78\xb3\xa4\x98\xf0\xad\xe5\x0b\xbf\x5a\xe0\xf6\x12\x0e\xbb\x50\xac\x44\x02\xa8\xdf\xd4\xe6\x79\x71\xd6\x0f\x2a\xe6\xef\x57\x83\xa9\xc7\x2c\x63\x22\x06\x24\xb6\x80\x7a\x71\xba\x7b\xdf\x31\x8b\x6c\xb6\x0b\x0f\xbb\xfa\xaa\x73\x6a\x18\x52\x0a\x25\xa8\x16\x2a\x8d\x8f\x09\x15\x4d\x98\xcb\x4b\xc2\x31\x26\xbf\x52\xf9\xfe\x04\x6d\xba\x77\x43\xd8\x47\x6e\x6b\x0f\x83\xaa\xa6\xe8\xae\x40\xc2\xb7\x6f\x09\x73\x69\x77\x45\xc4\x57\x2b\x00\xbf\x17\x66\xd5\xb5\x24\x7d\x75\xf0\xf8\x63\xa8\xea\x6d\x06\xdb\xf4\x1e\x9f\x59\xb0\x9f\xed\x8f\x8b\x8d\xb0\x37\xd8\xcb\x96\x8a\x03\xb6\x0f\x18\xab\x76\x20\x5b\x77\x44\x94\xd9\xd1\x32\x51\xad\x10\x62\x3b\x13\x3b\x14\xca\x39\xd5\x54\x31\x5d\xd7\x93\xb2\x9c\x84\xe7\x74\x03\x1c\x07\x81\xf6\x21\x31\xdc\x95\xb6\x92\xfe\x77\x56\x8b\x10\x95\x3b\x8d\xfe\xe0\x36\xc6\x21\x3b\x31\x1f\x62\xc6\x31\x62\xf3\x39\x22\xaa\x1b\xf4\x11\xaa\x29\x11\xec\x2c\x3e\x7f\x6e\xd1\xb6\xd6\x3c\xb4\xb0\xee\x81\x47\x72\x28\x04\xff\xb9\x08\xf5\x7e\x40\xbd\xf0\x2b\xb5\xf7\xef\x73\x39\x93\x88\x95\x0e\xaa\x4b\xae\x1e\x9a\x01\x96\xfd\x70\x71\x55\x7d\xbc\x7a\x08\x7e\xb8\xb8\x22\xf6\xd7\x5a\xfe\xa3\x26\x0e\xa4\x5d\x71\xb4\x26\x11\x2b\xdd\xb4\x31\x57\x0f\xcf\xd4\x40\x3e\x8b\x6f\xb6\x67\x5e\x3f\x87\x8f\xcb\x61\xb8\x04\x40\x43\x73\x59\x90\x47\x8b\x56\x60\x15\xe3\x7b\x9e\xbd\x23\x57\x42\x15\x39\x2b\xa3\xb6\x8b\x3a\xb2\x91\xbb\x2f\xa9\xd1\xfc\x4e\x74\xf2\xd2\x9d\x6c\x19\xcd\x35\xe8\xc5\x2d\x70\xdc\xa0\x3d\x82\x7d\xdc\x2d\x64\x0b\x01\x5c\x8f\x5d\xbe\x5d\xcf\x56\x5d\x7b\xb0\x33\x77\x93\xf9\xe4\x01\x04\x49\xf8\x91\xdf\x7b\x30\x20\x72\x16\xb3\xd9\x99\x8a\xe9\xdb\x1e\xbc\xc6\x15\x04\xeb\xca\x9c\xa8\x22\x47\x6f\x8f\x06\x64\xc8\x53\x9e\xd0\x3c\x99\x57\xd0\x9d\xcb\xfb\x8c\x80\x70\x03\x42\xd0\xed\xcd\x11\x39\x91\x39\x8c\x1c\x51\x41\x12\xe6\xaa\x89\xec\xd1\x9a\xa3\xda\x78\xfa\xf4\x7c\x85\x1c\xdc\x43\x89\x2c\xa6\x39\x39\x7c\x46\x71\x54\x01\x9e\xb9\x2c\x79\x39\x17\x86\xc1\x0f\xc8\xe7\x55\xed\xd7\xe1\xa4\xb8\x3b\x9e\x6f\x4b\x0f\x6e\xe3\xed\x66\xb9\x2d\x1b\x86\xcf\xb9\x59\x75\xac\xc3\x09\xd7\x77\x2c\x93\x0d\xd5\x04\x7c\x68\xc1\xf3\xc6\xb5\xf9\x41\x2a\x0e\x88\xa6\x54\x43\xc7\xe3\x5c\xf3\xa8\x48\xa8\xd1\xa6\xd1\xef\x36\x20\x97\x57\xb7\x77\x57\x17\xe7\xf7\x57\x97\xef\x88\x1b\x89\x87\xfa\xdd\x80\xdc\x87\x18\x4e\x41\xca\xaf\x05\xca\xf1\xef\xea\x59\x86\x44\x45\x09\x0d\x09\x38\x1c\x54\x90\x6b\xc1\x75\x89\xb0\x8c\x49\x68\x89\x14\x36\xad\xcc\x3c\x6d\xfd\x7e\x13\x8e\x09\x20\xc2\x0e\x66\xfe\x5c\x1d\x0d\x4e\x0a\x62\xb2\xfa\xa9\x6c\xb5\x06\x0f\xa4\x5f\x94\x5b\xbc\x4f\xcd\xdf\x81\xa7\x36\x3e\x30\xf7\xe8\xf0\x2f\x91\x6c\x51\x22\x78\xa0\x77\x87\x49\xb3\xa2\x5d\x37\x31\xb2\xe6\x78\x70\xec\xd4\x89\x64\x09\xdc\xdf\x0f\x1a\x42\x70\x55\xe9\x6c\x40\xc8\x27\x97\xce\x0d\xb5\xbf\xab\xfb\x04\x20\x60\x43\x80\x32\xbf\x40\xad\xae\x05\x44\x31\x0a\x5f\x6a\x31\xbb\x26\x7c\xc6\x04\x2e\x6c\xff\x8c\xca\x4d\xa1\xf1\xce\xdf\x95\xb3\xff\x7c\xf7\x61\xff\x13\xc3\xd3\xd7\x78\x5a\x17\x32\x4d\x11\xd5\x69\xea\x2b\xf8\xca\x22\x3c\xcf\x05\xf6\x6a\xf4\x20\x46\xd5\x78\x2b\xa1\x2f\x70\x31\xf7\xd8\x82\x91\xe3\x7f\xb6\xb5\x0a\xa2\xd4\x6f\x9b\xc3\x34\x5b\x40\x34\xe5\xa0\x4c\x2c\x5b\x3f\xf3\x73\x3e\xbb\xbb\x3a\xbf\xfc\x78\x35\x48\xe3\x67\x62\x28\x4c\xc4\x99\xe4\x42\xab\x3a\xc6\xcd\xf6\x16\x37\x4d\xd8\x8e\x7f\x75\x73\x39\x7d\xe5\x1e\x0d\x93\x36\xdc\x78\x01\xaa\x5c\xcc\x34\xe5\x89\x0a\xbe\xaa\x96\x99\x4c\xe4\x64\x35\x72\x73\x83\xcf\xf5\x1b\xc4\x7f\xe9\xd3\xbe\xa1\x83\xfd\x6b\xfd\xf5\x5a\x75\x54\x77\xc5\xb5\xe6\x00\x6c\x48\xbf\x62\xaf\x4d\x43\x47\x8d\x17\xbc\xe8\x03\xa9\x6d\x4b\x3b\x81\xf6\x25\x1c\x6f\x07\xbe\x57\xa2\xd9\x05\x4d\x73\xea\xea\x73\x87\xdf\xc0\x3a\xaa\x9c\xe1\x50\x75\xba\x24\x55\x77\xee\xdf\xed\x53\x55\x26\x98\xe5\xac\xef\x01\x96\xa0\x93\x8b\xcc\x03\x79\x1c\xf2\x44\xe7\xdc\x71\xae\x20\xbc\x2b\x99\x2f\x3a\x79\x4a\xcd\xc9\xfb\xc7\xb0\xfe\x3f\x49\xe6\x25\xac\xa3\x35\xb1\xe9\x04\x81\x93\x72\xeb\x3d\xce\x72\x3e\xe3\x09\x9b\x00\x90\x2b\x17\x93\xa0\x47\xa7\x43\x0a\x80\x16\x00\x6c\x69\x5e\xe6\x83\x29\x1d\x82\x79\x03\x75\xdc\x7c\xba\x07\x70\x60\x08\x5b\xee\xac\xa4\x9b\x17\x42\xd3\x99\x7e\xbf\x0f\xfe\x84\x93\xbf\x1b\x4d\x33\x4e\x4e\xc9\x4f\xcc\xbe\x47\x02\x80\x71\x0e\x1d\x88\xa6\xd2\x23\xc8\xc2\x5c\xcb\x9d\x05\xa2\xc4\x60\xbf\xbd\xeb\xcc\xdc\x69\x54\x29\x14\x47\x95\xfb\xa1\x75\x2b\x42\x31\x62\xec\xe9\xb9\xb4\xd2\x3d\x0b\x86\x1d\xb8\x9f\xf3\xc5\xae\xa2\x55\x1f\x23\xca\x2c\x9f\xa4\x44\xcd\xd3\x84\x8b\x87\x12\xd5\x6b\x2c\x0d\x3d\x61\xfd\x02\x17\x0f\x8e\x7a\x73\x46\x93\xf5\x1c\xb4\x0d\xad\xec\x9d\x7b\xea\x56\x6e\xc2\xfb\x79\x86\xf1\x7b\xcf\x08\x6c\x60\x3a\x64\x7d\x47\x47\x2f\x72\xd5\x5c\x45\x8a\x37\x63\x77\xd7\xc3\x8b\x61\xa5\x17\xad\xb1\x14\xe1\xb7\xa7\x74\x6c\xaf\x13\x18\xb0\xa0\x67\xd7\x08\xf9\xcf\xdb\x23\xca\x7d\x92\x14\x75\xee\xc2\x04\xa6\x5b\x99\x6b\x9a\xec\x91\x41\x44\x53\x9a\x9d\x17\x7a\x7a\xc9\x55\x24\x67\xac\x85\xf9\xf4\x38\x45\x64\x66\x07\xf8\xc7\x1d\x11\xe0\x78\xe4\xe2\xdf\xcf\x6f\x09\x2d\xcc\x57\xd5\x16\x28\x74\xef\x61\x79\xb7\x8a\x21\x96\x1d\xec\x69\x0d\x76\xb4\x27\x59\x41\x17\x9c\xd8\x6b\x70\x02\xce\xff\x4b\x0f\x48\x70\xc1\x35\xa7\x5a\xd6\xee\x7f\x56\xf5\x0f\x14\x4a\xcb\xd4\x92\xea\xb5\x1b\x0a\xe2\xc7\x20\x9e\x2b\xa3\x57\x3b\x34\x80\xa2\x0e\x5b\x74\x2d\x8c\x5a\x4d\x23\xb6\x90\xf3\xd8\x03\x2c\x4e\x1c\x9b\xfb\x7b\xfe\x64\xb3\x52\x01\xa4\x2b\xf9\xee\x5d\x05\x3f\x7d\xa9\x0d\x86\x73\x5a\x94\xad\x15\xf6\xee\xf5\xe1\x3f\x37\x3f\xeb\xd6\xd1\x86\x6b\xfb\x8f\x82\x26\xb8\x27\x37\x87\xf0\x4a\x55\xf7\xb8\xf1\x54\xdd\xb7\x75\xfb\x7f\xe3\xad\xf1\x42\x21\xd6\x17\xde\xa1\x73\x2a\x94\xf9\x28\x55\x3b\xeb\xd8\x86\x9c\x8e\xc9\x89\x8e\xb2\xd3\xbd\xaf\xae\x4d\xd6\x3a\x4e\xd8\x7e\x83\x0f\x3e\x5b\xbd\xfe\xdc\x0e\x16\x0b\x02\x9a\x6e\xee\x40\xa9\x2c\x08\xc5\x33\xf9\xc0\x95\x76\x6d\x12\xe0\x07\xae\x2c\x7e\x2f\x68\x49\xb7\xc6\x1c\xe3\xd9\x5f\x69\x1c\xe7\xef\x50\xde\xb8\x96\xce\x39\xe8\x4a\x0e\x35\x8b\x0a\x1f\x35\x3c\xd1\xf3\xcc\xc2\xec\xdd\x5f\xdc\x12\xec\x96\xf2\xc7\xdf\x63\xdb\xd8\x7f\xfd\xdf\xbf\x7f\xd3\xe0\xf3\x3e\x77\x52\x78\x6b\xef\xc4\xde\x63\x4a\x2f\x28\x3f\x10\x94\x0c\xcc\x0c\x04\xce\x69\x4f\x36\xd2\x95\xf9\xc8\x9e\x9f\xb7\x55\x45\xba\x5c\xba\x17\x92\x4b\x47\x7c\xa1\x08\xf2\x8b\x7d\xf0\x1c\x64\x37\xb7\x2f\x91\xdd\xd4\xd8\x91\x3a\x14\x54\xa5\x1c\x3c\xd9\xc6\x7e\x0c\xe2\x64\x90\x87\x7e\x79\x33\xfc\xeb\x87\xf3\xef\xaf\x3e\xc0\x5c\x6d\xe6\x98\x21\x09\x2e\x5a\xe7\x48\xd5\x27\xb1\x7a\x96\x66\x9d\x2d\x69\x1a\x85\xb9\x79\x3f\x5c\x30\xc7\xcd\x2f\x0d\x43\x2f\xbb\xea\xdd\x62\x5c\x73\x07\x9e\xc3\x71\x06\x2d\x47\x58\xbe\xdf\x82\x90\x1d\x3c\x6c\x01\xb8\x56\xc5\xc8\x32\xdf\x0d\xe7\xb9\xb3\x1d\x54\xe3\x7b\x90\x17\x12\x5c\x30\xab\xc6\x9d\xd8\x7b\x58\xe1\x60\x3b\x56\x5f\x2d\xc8\xdb\x54\x0d\x1d\x0f\xe1\x39\x17\x88\x32\xc7\x17\x73\x8e\x72\xc3\xd5\x0d\x3f\x67\xca\x37\x45\x78\xc1\x54\x93\xad\x42\x49\x6e\xc6\xdd\x56\x02\x2d\x57\x3a\x8d\x55\xc2\x2e\x95\xaa\x8a\x75\xc8\xe2\x2e\x57\x81\x5a\x13\x56\x65\x34\xda\x2b\xde\x67\xf9\x13\xfe\x02\x45\xed\xcf\xc5\x20\xe1\xe5\x7b\x4e\xa9\xf5\x63\x36\x27\xed\x0b\xf7\xe8\x62\x51\x5c\xa3\xaf\xe5\x3a\x72\x64\xd2\x15\x1f\x86\xd5\x73\xcf\xfe\x29\xc9\x93\xf1\xd6\x9f\x5a\x1a\x43\x87\x30\x84\xb2\xa9\xd4\x52\xec\x90\x3e\x7f\xbb\x62\x80\xea\xf9\xc6\x3b\x2e\xca\x96\x33\x41\x6f\x48\xc8\xb0\xf4\xe1\x06\xa3\x04\x3a\x49\x22\x85\x0b\x3c\x54\xc3\x0e\xcf\xa4\xb1\xc4\xd7\x97\x7b\x3c\x8b\xaf\xab\xb4\xb3\xa9\x43\x78\xef\x69\x21\x71\x8b\x1a\x95\xeb\x4b\xab\xb3\xb9\x3a\x14\x65\x09\x91\xac\xa7\xc4\xbd\xca\x51\x99\xeb\x47\x99\xb7\x29\xf3\xbe\xad\x3c\xba\x90\xa3\x60\xff\xb6\x54\x93\xf5\x72\xcf\x0e\xce\xf4\x45\x9c\x9f\x21\x04\x7e\x17\xd0\xc5\x17\x4f\x8c\xcf\xf8\x3f\xc0\xa1\x7a\xfe\xc3\xd4\x5a\x6a\x1d\xb6\xfc\x77\xef\x6a\xbc\xa3\xba\xc6\xeb\xfc\x62\x1f\xb4\xee\x08\xf3\x9d\x4a\x16\x42\xfd\xe1\xb4\x2f\xd8\x2b\xcb\xc8\x25\x76\x94\x6c\xc4\x2d\xae\x35\x4b\xb1\x21\x35\x4d\x12\xb3\xaf\x52\x84\x00\xd3\xb6\xd0\xb7\x47\x10\xa3\x39\xa5\x99\xeb\xee\x2d\x1f\xc5\x23\xcd\x63\x72\x7e\x7b\xbd\x3f\xc6\xd0\x28\x01\x1b\x29\xaa\x2e\xce\x57\xb5\xf5\xa7\x8c\x19\x19\x71\xad\xca\xe6\x7b\x4c\x87\xf6\xa6\x61\x82\x3e\xba\x65\x8e\xaf\x39\xaa\xf6\x8d\x81\xbc\x14\x44\x46\x9a\xda\x0e\x2d\xbe\x7b\xcd\x9b\x37\x6f\xd0\x7d\xf6\xe6\x0f\x7f\xf8\x03\x36\x3c\x8a\x59\xc4\xd3\xe5\x1b\xe1\xae\xdf\xbd\x7d\x3b\x20\xff\x79\xfe\xf1\x03\xb4\x69\xcc\xb4\x42\x68\x12\x1c\x19\x5b\xc9\x07\x0f\xab\x1e\xf9\xbf\xc3\x4f\x37\x65\xdb\x96\xea\x5f\x6d\x17\x6e\xbb\xbc\x01\xb9\x0c\xd2\x9e\x42\xd7\x18\xd5\x53\xdb\x67\x48\x13\x3a\x1e\x63\x2b\xd2\x91\xeb\x8a\x8b\xc7\xcc\xd5\x94\x43\x2b\x71\xec\x00\x62\xc8\x20\x81\x7c\x2c\x63\xaa\xa3\x3b\xd1\xc1\x1c\x60\x7a\x19\x8c\xe5\x39\x23\x4c\xa5\x87\xcd\xe8\xc7\x0a\xfa\x80\x94\xc0\x7f\x39\x53\x46\x2f\xb5\x6d\x10\x71\x30\x3f\x75\x33\x89\xe7\x8f\x15\x35\xe8\x4a\x51\x21\x30\x07\x6c\x5c\x76\xb7\xff\x3b\x06\x46\xb7\xa7\x0c\x1f\x30\x76\x53\xd5\x13\xfc\x9c\xf0\xcb\x59\xe8\x00\xcf\x46\x08\x4d\x24\xf4\xd7\xf2\x1f\xba\x94\x5b\x41\xaf\xfc\x3a\x0b\x6a\x81\xba\xd8\x1c\x97\x17\x39\xd4\x47\xda\xa0\xaf\x4e\xd5\xd5\x1e\x40\x2d\xd0\x91\x2c\xb4\x0b\x69\xe3\xa8\xd8\x80\x12\x3b\xa5\x37\xc2\x8e\x6c\x05\x38\xd9\x0e\x96\xb8\x05\x8e\x69\x55\x18\x54\x94\x87\x1e\x61\x34\x9a\x92\x07\x36\xef\x23\xdb\xca\x28\x54\xf7\xf8\x7e\x66\x16\xe7\xb3\x1a\xd5\x89\x58\x6c\x74\x64\xbb\x69\x2e\x53\xa0\xa4\x2a\x5f\x1d\xe4\x54\x50\x65\x35\x24\x8b\x1f\x2a\x02\x47\x81\x03\xb2\x0e\x7a\x08\x7b\xc0\x50\x6c\x29\x5e\xad\x5c\x31\xa7\x8e\xc5\xe6\x31\xb5\xe9\xcd\x65\x7a\x84\x61\x83\x56\xa0\x15\x62\xe9\x69\xdb\x42\xdb\xaa\x7b\xf0\x42\xea\xc0\x9a\x83\x14\x0b\x68\xb2\x67\x5b\x29\xd9\x7b\xdd\x2e\xf9\x8d\xa8\x54\xd6\x28\xa6\x0b\xbb\x35\xd8\xc1\xab\x10\x09\x53\x8a\x70\x58\x61\x4a\xf3\x07\xe6\xc0\x63\x68\x32\x20\xb7\x66\x92\x1e\xc3\x0a\xf1\x91\x67\x98\x40\x67\xce\x6c\x58\x32\x64\x5e\x72\x3c\x18\x1c\x23\x7f\x5f\x51\x40\xd4\x88\x36\xda\xc2\xea\xb6\x82\xd3\x5d\x68\xcd\x9d\x29\x84\x08\x36\xda\x1e\xc0\x61\x4b\xa8\x8d\xd3\x53\x27\xc5\x68\x03\xe0\xa4\xe5\x45\xb5\xc2\x7f\x6d\x0f\x6d\xde\x0e\xd8\xbc\x66\xa8\xa3\x7a\xed\x02\x68\xde\x12\xce\x7c\xa9\x57\xb8\xfd\x60\xf6\xe8\xb5\xc1\x74\xde\x09\x78\x3b\x6d\x08\xfd\xea\xae\x75\x28\x3e\x69\x1d\x6d\xd1\x76\xd4\x4b\xd8\xab\x52\x0f\xaf\xc7\xab\x3a\xc0\xb9\x62\xc2\x52\xc3\xf6\xec\xd4\xec\xc0\xf3\xeb\x85\x4d\xfb\xc0\x90\x16\x8a\xe2\xe2\xd5\x4c\x71\x5c\xbc\xea\x06\x0f\x17\xaf\xa5\xb3\xe5\xf9\x7f\x16\xa4\xee\xc3\x96\x6a\x09\xb8\xdd\xda\x1f\xc0\x01\xf9\x68\xe5\x03\x12\x23\x1d\x29\x99\x14\xda\x97\x35\xad\x10\x1e\x30\xa8\x43\xf9\xc6\x92\x53\x77\x5b\x20\x4a\x40\x88\x22\x6f\x6e\x2e\x55\xf0\x6a\x79\xc0\x9b\x77\xbe\xfb\x05\xa7\xa1\xec\xb4\x93\x4e\xbf\xd8\x61\x37\x87\x16\x9f\xc2\xe5\x54\x57\x34\x1e\x48\x45\xd1\x0a\xd5\x29\xa7\xbc\xd8\x3e\x51\x2d\xd6\x57\xd7\x71\x63\xa7\x69\xbd\x13\xe7\xb7\xd7\x7b\xb5\x06\x82\x71\x7f\x15\xf6\x00\x38\x87\x2a\x9d\x7a\x2e\xcb\x1d\xb0\x0e\x66\xc3\x7b\x5e\x89\x5a\xb9\x34\xf9\xf7\x86\x6f\x06\x0e\xdd\x05\xb8\x3d\xf4\x00\x95\x1c\x36\x80\xe8\x73\xa1\x48\x38\xc9\xaf\x43\x05\x7d\x62\x65\x12\x76\xa6\x61\x63\x73\x77\x2d\x37\xc8\x83\x45\x93\x21\xf4\xc2\x41\x8b\x31\x30\x3d\x33\x19\xbf\xb3\x2d\xa0\x85\x90\xd8\x93\x4e\xf5\xb0\x99\x8e\xea\xa1\x49\x69\x94\x8b\x20\x98\x9c\x07\x6e\xf8\x1d\xd4\x89\x96\x4d\x92\x76\x6b\x93\x64\x3e\x26\xac\xff\xb6\xf9\x17\x25\x3b\xf7\x3c\x22\x81\xd4\x6a\xdb\x33\xa5\xea\x32\xc7\xb1\x7c\xaf\xf6\x68\xca\x52\x8a\xc0\x7f\x6e\x91\x86\x07\x3d\xe6\x5c\x6b\x86\x98\x4c\x2c\x4f\x15\x91\xe3\x5e\xa5\x97\xe1\xd1\xec\xed\x51\xbb\xfe\x31\x3b\x37\xfa\x21\xe5\xf7\xd8\xcb\x96\xdc\x56\x74\x3b\x43\xe9\x60\x74\x24\x80\xe0\x29\x16\x9c\x18\x46\x18\xcd\x70\x0f\x9f\x65\xf9\xcf\x6b\x6e\xf5\xbc\x72\xd1\x99\x5b\x9d\xb9\xe5\xae\xbd\x98\x5b\x81\xe0\x71\x4c\xc8\x6e\x58\x68\x82\x85\xd8\x60\xce\x0e\x2b\x6b\xa3\x02\x5c\x1f\x43\xa2\xce\x02\x93\x79\xd5\x67\x67\xcc\xa8\x63\x67\x97\x59\x7a\x2e\xf4\xb8\xff\x47\xc2\x44\x24\x63\x24\x02\x33\x7e\xae\x34\x28\x42\xa5\x11\x13\xce\x25\x75\xef\x0a\xfd\x7e\x30\x76\xfb\x4f\xd8\x92\x2b\xb8\x18\xe2\xfb\xbd\xa9\x01\xa5\xf0\xf7\x25\xc7\x76\x13\x3c\x52\x81\xd5\x02\xca\xf8\x25\xf6\xb8\x06\x52\x77\xcd\x7a\xc9\x09\xfe\x38\x88\xb2\xa2\x67\x6f\x18\xa4\x2c\x95\xf9\xbc\xe7\x6f\x32\x7f\xac\x3c\x65\xef\x38\x05\xcd\x21\x2a\x72\x63\x38\x26\xf3\xd7\xad\x43\xb8\x6d\x7a\x06\x15\xc2\x7f\xb1\xa6\x3d\x88\xc2\x6b\x21\xcd\xd0\x03\x97\x81\x93\xa0\xec\xcc\x34\xf6\x00\x8b\xaa\xe7\xcd\x5e\xf8\x95\x89\x19\x99\xd1\xbc\x51\x93\xf6\xf0\xda\x59\x6b\x88\xf9\x8c\xab\xb6\x2d\x15\x57\x5a\xe3\xdc\x82\xb5\xc9\x42\x67\x85\xb6\x5c\xd4\x9d\x13\x57\x6a\xef\xcf\xc7\x82\x02\xf5\xf6\xa8\xe5\x44\x5e\x51\x9b\x63\xbc\x5a\x37\x3b\xc6\x6b\xf7\x96\xc7\xd5\x71\x76\x20\xa1\x3d\xb7\x34\x77\x97\x23\x91\xfd\x9c\xcd\x52\x90\x96\x68\x11\x4e\xa1\x7d\xc2\xc3\x87\xd9\x2c\x7b\xf5\x08\x59\xe8\xfc\x5f\x83\x33\x68\x4f\xc1\x61\x5b\xf9\xf8\x2b\x8f\x0c\x0f\x6d\x37\x85\x2e\x2c\xdc\x80\x04\xbb\xb0\x30\xe9\xc2\xc2\xaf\xd4\x4f\xd1\x85\x85\x3b\x3f\xc5\xf2\xd5\x85\x85\xab\x57\x17\x16\xfe\x65\x86\x85\xad\xd2\x57\xc6\x84\x9f\x3c\x24\x6c\x9b\x06\x9d\x47\x91\x2c\x84\xbe\x97\x0f\xac\x41\xf4\xa2\x96\x21\xb0\x34\xfe\xd3\x5a\x05\x6d\xd4\x90\x86\xaa\x44\x3b\x25\x82\x16\x31\x37\xaa\xff\x0e\x24\x75\x6e\x87\x70\x7a\xbe\x61\xd7\x22\x66\xb1\x1f\xdb\x1d\x5e\x6d\xf6\x7d\x40\xce\x49\xce\x22\x9e\x71\xdb\xab\x9e\xe2\xef\x48\x73\xbe\x47\x03\xd7\x8a\x25\x63\x8b\x8f\x2f\xc2\x96\x43\xa5\x0a\x6f\xf9\xdf\xca\xd7\xa0\x64\x92\x0e\x4a\xdd\x75\x61\xca\xd9\xdf\x9d\x48\xb3\xb3\xb9\x0f\x47\x08\x1d\x2e\xb0\x94\x4a\xa7\x23\x78\x59\xc6\x6d\x75\xf7\x53\x1c\x78\xf6\x35\xe3\x39\x10\xf3\x90\x45\x52\xd4\xeb\xe1\xba\xe6\x33\x5d\x2d\x8e\xe5\xbe\x97\xf5\xa2\xb2\x98\xc4\x45\xee\x3b\xb5\xce\x68\xc2\x63\xae\xe7\x3e\xf2\x67\xfb\x7a\x51\x3c\x43\xfe\x63\xaa\x72\x33\x09\xcd\xb2\x5c\xd2\x68\xca\x54\x30\x73\x54\x4f\x6c\xd1\x9a\xcf\xb9\xc7\x4e\x74\xa0\xa1\xc0\x33\x46\x3c\x26\x73\x92\x4b\xed\x82\xfa\x6b\x5e\x78\x1f\x0c\x06\x8f\xa3\x8c\xd3\xf9\x1c\x22\xff\x32\x1c\x02\x67\xc5\xc7\xe1\x3f\x14\x91\x49\xec\x70\x56\xfe\xf8\xc6\xa8\x84\x91\xa5\x44\xc3\xfd\x00\x7d\x43\x4b\x92\x18\x71\x6d\x38\xe2\xfa\x87\xff\xf7\xb7\x64\x2a\x8b\x5c\x0d\xc2\xf2\xa9\xb7\xf0\x1b\x1a\x7a\x4e\xa5\xd4\x24\x61\x54\x69\xf2\xf6\x0d\x49\xb9\x28\x8c\x7c\x6a\x41\x3c\x6d\xb4\xa0\x40\xff\xf9\xfd\xb7\x0d\x9e\x6c\xae\xf9\x2c\x47\x48\x2d\x75\x65\x88\xc5\x6c\x15\x20\x7b\xae\xb0\x08\x0f\xb1\xcd\x17\xd4\x21\xcb\x8e\xc3\x5d\x17\x5a\x1e\xe8\xb4\xfd\x5c\xc8\xd1\x5c\x37\x2b\xe1\xfc\x0f\x7c\xa6\x5a\xbb\xe9\x7e\xac\x83\xf3\x52\xc2\xbc\x6c\x79\xed\xc1\x3a\x6e\x4c\xb8\xd2\x5b\xfb\x6d\x94\xf5\x9d\x5b\x6e\x6c\x22\x78\x26\xc6\x6a\x68\x5c\xb0\x03\xb6\x86\xd3\x95\x9d\x03\x2a\x8a\x18\xf6\xdb\xbc\x2c\x3b\x3a\x09\x89\x6f\xa8\xf1\x82\x17\x00\x95\xe6\x88\x66\x0f\x28\xee\xb5\x17\x5c\x57\x2f\x73\x44\xd2\x62\xc5\xf8\x60\xf5\x7c\x28\x2e\x26\x08\xba\x9e\x16\x89\xe6\x59\x52\xae\xde\x3f\x60\x19\x7e\xe8\xa4\xa3\x81\x47\x89\x62\xc1\x33\x42\x67\x81\x43\xf3\xc4\x8f\xc5\x84\x46\xec\xf0\xdc\xc8\x8d\x8c\xe6\xd4\x6f\x21\xf4\xfb\x55\xa7\xd6\xdf\x47\x21\x46\x89\x9c\xc9\xb0\xfd\x9c\x26\x7e\xa9\x61\x2c\x6a\xdf\x04\xa4\x99\xa0\xa2\x96\x73\xbb\x6a\x3c\xc2\x63\x44\x3e\xfa\xb4\x36\xec\xdd\xb2\x40\x39\x56\x11\xfa\x9e\x46\x0f\x4c\xc4\xd8\xf0\x0a\x16\x1f\xcf\x05\x4d\x2d\x80\x58\xd0\x29\x7c\xe1\x79\xd5\xb3\x4e\x0c\xac\x2e\x74\x85\xcf\x28\xa3\xf7\xbd\x13\x85\x6a\x81\xc0\xf3\x59\x61\x6f\xee\x4d\xe7\x5f\xa1\x93\x27\xe7\xb3\x88\x39\x9d\xc1\xbc\x6c\xdf\x0b\x98\xd5\xaa\xfa\x5f\x5a\x82\x4d\xc8\xe4\x01\x45\x83\x68\xf0\xce\x77\xc0\x0a\xa3\x89\x39\xf2\x73\x5f\xde\xba\xf0\xa1\x47\xf3\xfd\xb7\xed\xc9\x47\xcd\x8a\x92\x8f\xef\xbe\xbf\xac\x1e\xee\x3b\x1a\x4b\x45\xbe\x4f\x64\xf4\x40\x2e\x19\x28\x6d\x87\x6c\x35\x93\x8f\xe2\xe7\x87\x14\x4f\xe9\x64\x7b\xec\xae\x4f\x52\x29\xb8\x96\xf9\x36\x7e\xd2\xf5\xcf\x7c\x36\x88\xea\x7c\x14\xbf\x78\x80\x6a\x43\x6c\xed\xba\x65\xe6\x70\x34\x61\x00\x87\xd0\xd8\xf2\xa0\xfd\x66\x2a\x1f\xfb\x5a\xf6\x0b\xc5\xfa\xbc\x56\x5c\xb8\xd1\x1a\x1f\xd8\x1c\x82\xe2\x8d\x57\xf9\x23\x3e\x58\x31\x37\xb4\x04\x2f\x16\xfc\x6e\x84\xfa\xdd\xf7\x97\x46\x82\x0c\x42\x55\xf1\x8c\xe9\xe8\x2c\x62\xd9\xf4\xcc\xbe\xfa\xc5\x6e\x8d\xe3\x21\xcd\xf7\xe6\x9c\x44\x32\x49\x6c\x1d\xb9\x1c\x93\x0b\x96\x4d\xfd\x70\x4f\xbf\xde\xe7\x06\x3c\xce\xa4\x6c\x0e\xf2\x1a\x1c\x23\xf3\xbc\x3d\x45\x01\x21\xe5\xa3\x66\x3d\x31\x9e\x92\x74\x5e\x78\xdf\xd0\x83\x6e\xd1\xe1\xb1\xa2\x8f\x87\xee\x51\xb0\x3d\x83\x30\x45\x15\x1a\xda\xe5\xa1\x54\x58\xd1\xf5\x18\x35\xf2\x98\xc5\x44\xce\x58\x9e\xf3\x98\x29\xe2\x79\x51\x68\xd4\xf2\xe4\xe9\x77\xaf\x43\xa9\x7e\x41\x28\xd5\xad\x6c\xa6\x80\x71\x99\xe7\x97\x19\x17\x8d\x53\x2e\x5e\x24\xeb\x52\x11\x4d\xd8\xf5\xa7\x46\x66\xc9\x10\x9f\xa9\x5a\x26\xee\xc7\x00\x0c\x6e\x0b\xb8\xda\x8f\x9e\x76\x88\x90\xf1\x76\x4f\xed\x81\xec\x8b\x09\xd5\xec\xb1\x86\xb0\xec\x97\xec\xab\xce\xbd\xa0\xbd\x3e\xbf\x2d\xf2\x4c\x60\x87\x01\xed\x23\x62\xdb\xbe\xc5\xad\xfd\x6a\xcd\xdd\x3c\x6e\x39\x0b\x58\xc2\x8e\x7c\xcf\x6f\xaf\xc9\x0f\x38\xf6\xfe\x11\x18\x73\xa9\x51\x43\xbc\x94\x29\xe5\x2d\x1a\xb9\x4c\xab\x2d\xd8\xdd\xa4\x6f\xfd\xc0\x04\x47\x0e\x3b\xd1\x8c\xf9\xa4\x30\x96\x9d\xb5\xc6\x3a\x28\xbc\x85\x65\x1e\x5e\x79\x29\x75\x97\xc0\x03\xe5\x32\x2d\x4b\x7d\xc5\x7d\x4d\x10\x21\x3e\xa4\x4a\x14\x13\x8a\x43\x44\x27\x08\xb8\xdb\x76\x84\xd8\x0b\x13\xd3\x2a\x51\xc1\xe9\x91\x0f\x72\xc2\x85\x3b\xad\xd2\x06\xfe\xc6\x94\x27\xf5\xb7\xa4\xd3\x48\x5e\x90\x46\xa2\x54\x72\x25\xe8\x28\xa9\x97\xd1\x50\x65\xfe\x09\x85\x38\x2d\x83\xe7\xcf\x62\xae\xcc\xff\x93\xe1\xf0\x03\xf8\xea\x0b\xe1\xb4\x66\xf0\x60\x5b\xb6\xe7\x6b\x21\xf0\x50\xee\xff\x1c\x21\x1f\x6a\x85\x57\x78\x2d\x62\x33\x65\xa6\x2a\x49\x35\x76\x44\x44\x6f\xf4\x59\xc4\x98\x85\x30\x62\xe4\x7e\xca\xa3\x87\xdb\xc0\x25\x2f\x73\xf3\x9b\x08\x7e\xaa\x08\xac\xc5\xbf\xed\x9b\x61\xda\xe9\xde\xb6\x31\x91\xef\x03\xae\x3f\xb4\xcb\x36\x03\x11\xaa\x94\x8c\x78\x19\x8b\x01\x07\x4d\x29\x16\x62\x10\x0b\xfb\x5f\x0a\x68\x02\x3b\xcb\x31\xf7\x01\x5d\x07\x5f\x15\xca\x2d\x2e\xdc\x8a\xf7\x3e\x7d\x24\x95\x56\x68\xee\xf7\x15\xfc\x76\xa7\xe9\x2e\x84\x14\x5c\x8e\xbb\xfd\x60\x4e\xc7\x72\xfd\x41\x97\x3e\x99\xc7\x71\xb7\x78\x8b\x7b\x5d\x70\xbd\xa2\x8f\x55\x56\xf7\x42\x7c\x11\x7f\xb3\x41\x06\x38\x64\x99\xcc\x8a\x04\x33\x41\x76\x87\xa2\x77\xde\x62\x7c\xcf\x1e\x83\x0e\x4f\x05\xaa\xda\x34\x35\xfa\x97\x81\xaf\x1a\x28\x73\x6f\x7e\xff\xed\xb7\xaf\x1d\x71\xb5\xbe\x91\x7d\x28\xc8\xd5\xda\xae\xd9\xae\x3a\xa9\xab\x4e\xaa\x92\xe4\xa1\x21\x73\xf7\x5e\x7f\xd4\x38\xd1\xb7\x69\x92\x6f\x93\x0a\xa3\x06\xc9\xc0\xcd\x13\x81\x1b\xd5\x10\xed\xad\x72\xa8\x45\x4e\x6c\xb3\x2a\xa1\xae\x36\xe8\x97\x57\x1b\xd4\x2e\x17\xb6\x4d\x1d\x50\xb3\x1c\xd8\x5f\x56\xcd\x4f\xa3\xa3\xd9\xa4\x2a\xa5\x79\x2d\x4a\x1b\x7c\xc2\x36\x3e\xb4\x76\xed\xb3\x42\x0f\x90\xb5\x41\xca\xfe\x96\xae\x2d\x26\xa2\x9a\x69\x69\x0e\xdb\x13\x59\x20\xa4\x91\x11\x86\xaf\x90\xcd\x3a\x5d\x5a\x4b\xff\xd3\x70\x21\xe4\xe2\x7f\x7e\xbe\x48\xcb\x2f\x37\x84\xd1\xb5\x98\x79\x3d\x7e\x75\x55\xc1\x7c\x71\x1e\x0a\xe0\x02\x20\xb6\xe5\x28\xc4\xab\x2c\xcf\xcd\xf9\xed\xb5\x31\xbf\xa1\xf4\x88\x26\x6a\x40\x56\x48\x75\xe7\x0d\xb5\x5a\x80\x93\xe6\x54\x6b\x96\x66\xba\xc9\x87\xef\xdc\xea\x2f\xc8\xad\xbe\x83\xef\xef\x8b\x7f\xd4\xf7\x29\x2d\x52\x2a\xfa\xe6\x94\x81\x83\xbd\x12\xa3\x5b\x60\xd2\x03\xe2\xf2\x93\x71\x47\x68\xce\x10\xac\xab\xda\xb7\x99\x06\xfd\x39\x0f\xe7\xf6\x84\xf1\x77\x58\x3f\x8a\xdb\x85\xd3\x17\xc9\xa5\x94\x57\xbb\x28\xbf\x17\xee\xa0\x05\x32\xbb\x62\x93\xeb\x29\x43\xd1\x7e\x0b\x45\x3c\xe5\x5d\x55\x5d\x1a\x95\x69\x9a\x24\xf2\x11\xdf\x1d\x0a\x3a\xf3\x0d\xcc\x5c\x6c\xad\xda\x88\x91\x94\x1b\x83\xdd\xba\x5b\xc3\xe9\x60\xb8\xd4\xe8\xe4\x2c\x47\x85\x37\xb7\x51\xb6\x21\xd3\xe1\xe7\x36\x66\xae\xc0\xc4\x70\xf3\xdf\x2e\x99\x08\x31\x91\x2d\x9f\x18\xb1\x29\x9d\x71\x59\xe4\xf8\xb4\x96\xe4\xc8\xfe\x09\x44\xc6\x5c\x16\xde\xfd\x85\xfd\x3b\xfd\xea\xd4\x8a\x7d\xba\x29\xff\x08\xc6\x40\x2c\x9d\x9f\xa2\xcf\xbe\x72\xa5\x97\xd7\xe2\xb6\xc8\x01\xef\xed\x93\x7a\x66\x2a\x33\x42\xa3\x45\x37\xbe\x2f\xe1\x93\x55\x35\x66\x36\x84\x3f\xbd\xba\x5e\x7c\x35\x50\x67\x3b\xed\xe8\x50\xda\x91\x0f\xa2\x25\x3c\x9a\xb7\xe8\x4f\x57\x06\xcf\xcc\x00\xe4\x7b\xaa\x58\x4c\x3e\x52\x41\x27\x68\xe8\x9d\x0c\x6f\xbf\xff\x78\x6a\x3e\x20\x18\x92\xd7\x97\x2b\x23\x6c\xc3\x70\x16\x37\xfb\x2e\x17\x59\x5a\x67\x2b\x69\xd5\x70\xa5\x7b\x2f\x7a\x21\x5e\xe2\xd4\x85\x05\x5e\x2e\x70\x5d\xec\x41\xba\xc0\x32\x66\x69\xbc\x73\xd7\xd1\x84\x2a\x7d\x9f\x53\xc8\xb5\x90\xe2\x9e\xaf\xde\xe9\xca\xd4\x3e\x50\xa5\x6d\x15\x33\x58\xff\x54\x17\x8a\x68\x3f\x06\x8b\xb1\x78\x41\x8a\xf2\x8f\x46\x7e\x80\xe6\xb3\x6a\x8b\xb7\x6c\xa9\xf3\xac\xc4\x54\xb3\xfe\xca\x9a\x56\xb3\x86\xcf\x99\xf9\x7b\xc3\xf9\x3f\x32\x52\xc0\x73\x71\xb0\x94\x43\xcc\x30\x65\x4a\xad\xa9\xb0\xa8\x02\x1d\xa0\x4a\x45\xbc\x4a\x65\x1f\x24\x1c\x33\x00\x0c\x97\x8a\x99\xa6\x3c\x51\x16\x7b\xe2\x71\x8a\xac\xec\x22\x29\x94\x66\xf9\x10\x2b\x1c\x1d\xa6\x36\xb7\xa5\x25\x1c\x42\xcb\x31\x7c\x9f\xc6\x9f\x20\x9b\x52\xb5\x7d\xea\x17\x08\x8a\x5a\xbe\xc7\xe9\x42\x2b\x67\xd6\x74\x0e\x39\xa3\x6a\x35\xde\xf8\xc2\xfe\x8d\x72\xce\xc6\xe4\x82\xa6\x2c\xb9\xa0\x6a\x9f\x1b\x68\xa8\x83\x0d\x08\x1b\x4c\x06\x1e\xd1\xd0\x70\x12\x75\x23\xf5\xc7\xd5\xfe\x92\x2d\x4b\xf2\x43\x0c\x81\xf0\xb6\xae\xee\xbe\x3c\x6e\x72\x8c\x21\xae\x60\x10\x9b\xfc\xc6\x15\xb9\x18\x7e\x59\x3b\x99\x75\x31\x92\x0d\x31\x91\xad\xda\xc4\x36\x1d\xa2\xbf\xb1\x64\xba\x0f\x26\xcf\xda\x3f\xda\x0f\xb8\xf6\xef\x62\x7d\xa3\xfb\xfe\xfa\x13\x8d\x7f\x9e\xad\x25\xc6\x3a\x3a\x4c\xcc\x32\x26\x0c\x73\xde\xa0\xe5\xd4\x09\x4c\x6d\x0d\x47\x2d\xb8\x39\xec\x5b\x91\x68\x9c\x29\x66\xe9\x62\x6c\x83\x03\xf6\x9e\x0a\x7d\x9c\x40\x08\x05\xeb\xce\xb8\x18\x73\xc1\x35\x23\x82\x29\x73\x28\x4e\x37\xbc\xbe\xa6\x36\x59\x4f\x93\xdc\x4c\x0b\xee\x9e\x0d\x14\xe1\x6e\xd9\xf8\x69\xdd\x4d\x9b\x3e\x30\x5e\x75\x55\xd5\x5a\xb0\x02\xb5\x95\x03\xb3\xbe\xbd\x0d\xb6\x41\xb8\xb4\x1a\x4f\xad\xe5\x46\xe1\xb5\xa0\x6c\x99\x47\xee\x80\x49\x63\x01\x72\x64\x38\x70\x44\x11\x6e\x18\x7e\x76\xb9\xb9\x25\x07\xa3\xe4\x6e\x91\x07\x12\x99\x2f\x52\xf8\xbe\x96\x55\x14\x7c\x7f\x7b\x3e\xab\xd7\x00\xa3\xc6\x78\x5b\x08\xab\xc6\x08\x9b\x89\xa9\xc6\x00\x5b\x09\xa8\xc6\x18\x9b\x7d\x71\x35\x06\xd8\x46\x75\xcf\x4f\x6f\x35\x16\xb1\x99\xc6\x6a\x0c\xb0\x95\xae\x56\x8c\x01\x68\x51\x71\x05\xec\xd9\x9a\x4f\xd5\xdf\x8a\x91\x07\xfe\x2e\xc7\xb7\xdb\x4e\xfe\xfb\x7f\xfe\xd7\xff\x1f\x00\x00\xff\xff\x3e\xeb\x07\x54\x83\xd3\x09\x00")
 
 func operatorsCoreosCom_clusterserviceversionsYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -125,7 +125,7 @@ func operatorsCoreosCom_clusterserviceversionsYaml() (*asset, error) {
 	return a, nil
 }
 
-var _operatorsCoreosCom_installplansYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5b\xdd\x6f\x1b\x37\x12\x7f\xf7\x5f\x31\x70\x1f\xd2\x02\x96\xdc\xe6\x80\xc3\x41\x6f\x39\xf7\x7a\xf0\x5d\x3f\x82\xd8\xcd\x4b\xaf\x0f\xa3\xdd\x91\x96\x35\x97\x64\xf9\x21\x47\x57\xf4\x7f\x3f\x0c\xb9\x9f\xd2\xee\x6a\xe5\x38\x69\x70\xe8\xbe\x24\xde\x1d\x92\xf3\x3d\xbf\x21\x29\x34\xe2\x2d\x59\x27\xb4\x5a\x01\x1a\x41\xef\x3c\x29\xfe\xcb\x2d\x1f\xfe\xe6\x96\x42\x5f\xef\xbe\xba\x78\x10\x2a\x5f\xc1\x4d\x70\x5e\x97\x6f\xc8\xe9\x60\x33\xfa\x9a\x36\x42\x09\x2f\xb4\xba\x28\xc9\x63\x8e\x1e\x57\x17\x00\xa8\x94\xf6\xc8\xaf\x1d\xff\x09\x90\x69\xe5\xad\x96\x92\xec\x62\x4b\x6a\xf9\x10\xd6\xb4\x0e\x42\xe6\x64\xe3\xe4\xf5\xd2\xbb\x2f\x97\x7f\x5d\xbe\xbc\x00\xc8\x2c\xc5\xe1\xf7\xa2\x24\xe7\xb1\x34\x2b\x50\x41\xca\x0b\x00\x85\x25\xad\x40\x28\xe7\x51\x4a\x23\x51\xb9\xa5\x36\x64\xd1\x6b\xeb\x96\x99\xb6\xa4\xf9\x9f\xf2\xc2\x19\xca\x78\xed\xad\xd5\xc1\xac\x60\x90\x26\xcd\x56\xb3\x88\x9e\xb6\xda\x8a\xfa\x6f\x80\x05\x68\x59\xc6\xff\x27\xd1\x6f\xd3\xa2\xaf\x25\xaa\xf8\x56\x0a\xe7\xff\x7d\xf8\xe5\x5b\xe1\x7c\xfc\x6a\x64\xb0\x28\xfb\xac\xc6\x0f\xae\xd0\xd6\x7f\xdf\x2e\xcc\x0b\x09\x93\x3e\x09\xb5\x0d\x12\x6d\x6f\xd4\x05\x80\xcb\xb4\xa1\x15\xc4\x41\x06\x33\xca\x2f\x00\x2a\xa5\x55\x93\x2c\x00\xf3\x3c\x1a\x02\xe5\x6b\x2b\x94\x27\x7b\xa3\x65\x28\x55\xb3\x08\xd3\xe4\xe4\x32\x2b\x8c\x8f\xca\xbe\x2f\x08\x36\xc2\x3a\x0f\x37\x77\x6f\x41\x28\xf0\x05\x45\x99\x40\x6f\x20\x93\xc1\x79\xb2\x77\x64\x77\x22\xa3\xca\x37\xe2\xfa\xcd\x74\x00\xbf\x38\xad\x5e\xa3\x2f\x56\xb0\x64\x75\x2f\xc7\x07\xfd\xf4\xe5\xcf\x9d\x71\xc9\x86\x37\x77\x6f\x3b\xef\xfc\x9e\x25\x74\xde\x0a\xb5\x9d\xe2\x18\x8d\xb1\x7a\x87\x12\x4a\x9d\xd3\x04\x2f\x35\xdd\xd1\xb2\xaf\x8e\x3f\x8c\xac\x3d\x3c\x65\x54\xfe\xd0\x94\xbd\x0f\x69\xca\xb5\xd6\x92\x2a\x6f\xa9\x89\x77\x5f\xa1\x34\x05\x7e\x55\xbd\x74\x59\x41\x25\xb6\x46\xd2\x86\xd4\xab\xd7\xb7\x6f\xff\x72\x77\xf0\x01\xfa\xba\xe8\xb8\x1c\xe4\x1c\x85\xe4\xa2\x01\x2b\xc7\x89\xd1\xc3\x86\x44\x70\x14\x2d\xda\x46\xc0\x11\x9b\x7a\xfd\x0b\x65\xbe\xf3\xda\xd2\xaf\x41\x58\xca\xbb\xab\xb3\x46\xea\x18\x3f\x78\xcd\xda\xe9\xbc\x32\x96\xd7\xf2\x9d\x48\x4a\x4f\x27\xc9\xf4\xde\x1f\x48\xf6\x82\xc5\x4f\x74\x3d\xc9\x2a\x87\xa7\xbc\xd2\x19\x0b\xe5\x0b\xe1\xc0\x92\xb1\xe4\x48\xf9\x56\x68\x55\xc9\xb4\x04\x76\x46\xb2\x8e\xa3\x2e\xc8\x9c\x13\xd1\x8e\xac\x07\x4b\x99\xde\x2a\xf1\xdf\x66\x36\x07\x5e\xa7\x08\x40\x4f\xce\x43\x0c\x21\x85\x12\x76\x28\x03\x5d\x01\xaa\x1c\x4a\xdc\x83\x25\x9e\x17\x82\xea\xcc\x10\x49\xdc\x12\xbe\xd3\x96\x0d\xb0\xd1\x2b\x28\xbc\x37\x6e\x75\x7d\xbd\x15\xbe\x4e\xa1\x99\x2e\xcb\xa0\x84\xdf\x5f\xc7\x6c\x28\xd6\x81\xad\x71\x9d\xd3\x8e\xe4\xb5\x13\xdb\x05\xda\xac\x10\x9e\x32\x1f\x2c\x5d\xa3\x11\x8b\xc8\xac\x8a\x69\x74\x59\xe6\x9f\xd9\x2a\xe9\xba\x17\x07\xea\x1b\xf4\x5f\xa8\xf3\xd6\xa4\xae\x39\x7f\x81\x70\xec\x26\x71\x78\x92\xa5\x55\x29\xbf\x62\xad\xbc\xf9\xc7\xdd\x3d\xd4\x0c\x24\xb5\x27\x0d\xb7\xa4\xae\x55\x36\x2b\x4a\xa8\x0d\xd9\x44\xb9\xb1\xba\x8c\xb3\x90\xca\x8d\x16\xca\xc7\x3f\x32\x29\x48\x79\x70\x61\x5d\x0a\xef\xa2\xcf\x91\xf3\x6c\x87\x25\xdc\xc4\x0a\x02\x6b\x82\x60\x72\xf4\x94\x2f\xe1\x56\xc1\x0d\x96\x24\x6f\xd0\xd1\x07\x57\x35\x6b\xd4\x2d\x58\x7d\xf3\x95\xdd\x2d\x80\xc7\x03\x8e\x62\x0c\xa0\x2e\x51\xa3\xd6\xe9\xc4\xf8\x9d\xa1\xac\x89\x86\x26\xa6\x5f\x19\x23\x45\x96\xdc\xbe\xf1\x0e\x76\xe4\x75\x93\x08\x7a\x59\x69\x92\x9d\xb1\xb0\x87\x54\x5e\x8e\xd3\x66\xff\xd3\xd1\x42\xfc\x69\x56\x19\x81\x89\x9c\x01\x31\x6f\xa4\xa5\x8f\xbf\x1c\xe8\xab\x4e\xed\xec\xd0\xec\x61\xc1\x91\x6d\x0b\x86\xd1\x52\x64\x7b\xd8\x68\xcb\xf9\xa1\xa3\xdb\x25\xdc\x7a\x28\x83\x8b\xfe\xa6\x15\xb1\x66\x2f\x5f\x05\xaf\x4b\xf4\x22\xbb\x04\x6d\xe1\xf2\x3b\x54\x01\xe5\xe5\x72\x80\x85\x51\x87\x68\x79\x1f\x52\xe9\x70\x8d\x68\x9f\x71\xd5\x8d\xcf\x85\xd6\xe2\x7e\xe0\xab\xf0\x54\x0e\x0e\x3b\xc1\xfd\x96\x14\x17\x8d\x81\x8c\xdd\x0e\xe5\x44\xb9\x25\x7b\xf4\x3d\x79\xe3\xf8\xb8\x91\x25\xd3\xb0\x06\xe9\x9c\x35\xde\x79\xf4\xe1\x48\xce\x9e\x8b\x5c\x76\x63\x2a\x92\x77\x12\x58\x55\x40\x37\xda\x96\x29\xa6\x70\xad\x43\x4a\x56\x69\x6a\xf6\x0c\xe7\xc9\xb8\x26\x54\x38\xd8\x32\x5d\x1a\x49\xbe\x5f\x7b\x97\xf0\x1f\x05\xd5\x0a\x9c\x0e\xbd\x45\x21\xe3\x54\x98\xf9\x80\x32\xce\x48\x55\x85\xde\x3b\x4f\xe5\xf2\xf2\x79\x22\x35\x43\x8f\x52\x6f\xef\x52\x36\x18\x20\x30\x05\x3a\x3a\x27\xfe\xbc\x27\x15\x38\x0b\x57\xce\xf8\x2a\xcb\x74\x50\xfe\x0d\x6d\x4e\x87\xe4\xf8\x58\xb0\xb4\x21\x4b\x2a\xab\xea\xbb\x4b\x04\x80\x89\x02\x7c\x81\x9e\x23\x39\xb8\xa4\xe6\x5c\x27\x1c\x9c\x37\x50\xa6\x56\xf8\x78\x54\x0e\x2a\xef\x94\xbc\x30\x89\x55\x86\xc5\x7c\x7d\x5b\xe3\x93\x04\x4b\xa8\x96\xce\x0f\x31\x07\xa7\xa2\x80\x9f\x8d\x20\x99\x47\xfc\x39\x87\x83\x17\xb7\x95\x42\x63\x15\xf7\x1a\x10\x8c\xa0\x8c\x7a\x70\x28\x2a\x8c\x30\xaf\x5e\x72\xc1\xb3\x54\x7d\xbb\x4a\xb5\xba\x82\x01\x2d\x5c\xf2\x28\x14\x20\xe3\x02\x91\xc3\xbf\xee\x7e\xf8\xfe\xfa\x9f\x3a\xf1\xc6\x96\x22\xe7\x92\x27\x97\xa4\xfc\x15\xb8\x90\x15\x80\x8e\x59\x63\xf7\x64\xff\xa7\x65\x89\x4a\x6c\xc8\xf9\x65\x35\x1b\x59\xf7\xd3\xcb\x9f\x97\xf0\x8d\xb6\x40\xef\x90\x83\xe7\x0a\x44\xd2\x5a\x03\x2a\x2a\xd7\x88\xa9\x9c\x85\x69\xc6\xc2\xa3\xf0\x45\x64\xc9\xe8\xbc\x62\xfa\x31\x32\xeb\xf1\x81\xf3\x77\x62\x36\x70\x2f\xf3\x40\x2b\xb8\x4c\xad\x49\xb3\xf4\x6f\x0c\xc3\x7f\xbf\x84\xcf\x1f\x0b\xb2\x04\x97\xfc\xe7\x65\x5a\xb0\xc1\x80\xfc\xae\xb6\x63\xbb\x70\x74\x48\x6f\xc5\x76\x4b\x31\xf2\x19\xd0\x30\x68\xf8\x82\x2b\x84\xd8\x80\xd2\x1d\xe2\x38\x05\xeb\xd3\x50\x26\x36\x82\xf2\x23\x46\x7e\x7a\xf9\xf3\x25\x7c\xde\x97\x0b\x84\xca\xe9\x1d\xbc\x4c\xed\x98\x70\x2c\xe3\x17\x4b\xb8\x8f\x96\xd9\x2b\x8f\xef\x78\xce\xac\xd0\x8e\x14\x68\x25\xf7\xcc\x71\x81\x3b\x02\xa7\x4b\x82\x47\x92\x72\x91\x50\x42\x0e\x8f\xb8\x67\x19\x6a\x55\xb2\x55\x11\x0c\x5a\x7f\x80\x90\xef\x7f\xf8\xfa\x87\x55\x5a\x8d\xcd\xb6\x55\xbc\x04\xa3\xaf\x8d\x60\xfc\xcb\xc0\x37\xa1\xb8\x68\x73\x66\x24\x24\x23\x71\xea\x2b\x50\x6d\xa9\x6e\x1e\x37\x81\xf1\xd4\xf2\x10\x31\xcd\xf6\xf8\x21\xb8\x3a\xec\xec\x11\xb6\x1e\x06\xda\x1f\x08\x0a\x67\x8b\x18\x7b\xc0\x59\x22\x7e\xdf\xf1\xc1\x49\x11\x1f\xc2\x9a\xac\x22\x4f\x51\xca\x5c\x67\x8e\x05\xcc\xc8\x78\x77\xad\x77\x9c\x54\xe9\xf1\xfa\x51\xdb\x07\xa1\xb6\x0b\x76\xb2\x45\xb2\xbc\xbb\x8e\xbb\x1f\xd7\x9f\xc5\x7f\xde\x4b\xa2\xd1\x52\x3d\x2c\x56\x24\xff\x18\xb2\xf1\x3a\xee\xfa\xc9\xa2\xd5\x90\xfa\x9c\x4a\xf0\xe2\x2e\x05\x7c\x76\x38\x9a\xc3\xe5\xb1\x10\x59\x51\x37\xad\x9d\x0c\x57\x62\x9e\x52\x20\xaa\xfd\x07\x77\x63\x56\x60\xb0\xbc\xf6\x7e\x51\xed\xcb\x2d\x50\xe5\xfc\x7f\x27\x9c\xe7\xf7\x4f\xd6\x58\x10\x33\x03\xf8\xc7\xdb\xaf\x3f\x8e\x73\x07\xf1\xc4\x68\x5d\x07\x95\x4b\xfa\x56\xeb\x87\x60\x06\x41\x42\x4f\xa0\xbf\x77\xa9\xeb\xfe\xa3\xea\xd2\x84\x5a\x18\xab\xb7\x96\x6b\x65\xa7\xcb\x05\x13\x64\x4a\xaf\x41\x19\xcc\x1e\x70\x4b\xd5\xa2\xb1\x8c\x70\x6f\x5c\x95\xa3\xaa\x15\x18\x87\x39\x4f\xc0\xfd\xa3\xdc\xa7\xdd\x80\x8a\xcf\x11\x36\xeb\xba\xc8\x3c\x46\x04\x5b\xf1\x7d\x9a\xdf\x93\xc0\x6c\x0a\xdb\xa6\xe7\x00\xe1\xbe\xa1\xcd\x28\xa1\xc8\xd9\xef\x37\x62\xa0\x3d\xa9\x49\x0c\xfa\x62\xf4\xa3\x25\x23\x71\x08\x44\xc3\x0c\x08\x09\x47\x7c\x8e\xd1\x1d\x58\xe3\xe6\x60\x58\x6d\x91\x3a\x61\x54\x5a\xee\x91\xc5\x37\x95\x15\x58\x24\x78\x44\x17\x33\x90\xdc\x51\x1e\x37\x60\xc6\x70\xe8\x0c\x8b\xcc\x93\x16\x66\xc1\xe6\x01\x79\x9f\x00\x9e\xbb\x8c\x4f\xa4\xa3\xf4\x9c\x04\xd2\x03\x3c\xfd\x09\xa7\xff\x84\xd3\x9f\x38\x9c\x3e\x2b\x06\xa6\xa0\xf5\x90\xfb\x7f\xaa\x00\xfb\x2c\xa1\xa7\xc0\xf6\x90\xd0\x9f\x08\xe4\x3e\x5b\xc6\x49\xf8\x3d\x26\xe8\x27\x02\xc2\xcf\x12\x76\x26\x20\x1f\x12\xf9\xff\x19\x96\x9f\xa5\xc3\x09\x88\x3e\xa4\xb7\x4f\x02\xa8\xcf\x16\x30\xd3\x2a\x9d\x82\x4f\xa0\x94\x3e\xd6\x6a\x06\x1c\xee\x03\x33\xd3\x28\x7b\xfb\xb4\x5d\x98\x7c\x0a\x4e\x8d\x41\xf2\xf4\x4c\x00\xf3\xee\x24\x27\x30\xd9\x69\xac\x9c\x9e\x45\xb5\x7d\x7d\x82\x88\xd7\x9c\x20\x99\x87\x00\x01\x24\x3a\x7f\x6f\x51\x39\x51\xdf\xe0\x98\xa6\x3f\xb0\xc8\xb7\xc8\x6d\x87\x28\x9b\x2e\x23\xd9\x07\x7c\x33\x65\x05\x68\xe3\x51\x4d\xb5\x2f\xcf\x98\x46\x69\x5f\x8c\x35\x1d\xed\x33\x33\x4a\xf8\x49\xe7\x00\x2b\xc8\xd1\xd3\x82\x39\x3a\x29\xf6\x8f\xf1\xb0\xf2\xd9\x44\x66\x0c\x6f\xac\x5e\x53\xfe\x87\x49\x55\x92\x73\xb8\x3d\x4f\x9c\x57\x50\x84\x12\x15\x58\xc2\x1c\xd7\x92\xea\x49\x18\x8d\xc5\xd3\x4a\xb5\x85\x9c\x3c\x0a\xe9\x3a\x27\x2c\xad\x7d\x9f\x4d\x58\x4b\xe8\x4e\x55\x09\x38\xbe\x62\x92\x86\xc5\x83\xc2\x9e\x3d\x5e\xb8\x68\xe4\x0f\xc1\xe9\xf0\xc9\xd5\x24\xa7\x77\xcd\x89\x54\x8f\xc9\xab\xfa\x04\xf3\xde\x06\xba\x82\x6f\x50\x3a\xba\x82\x1f\xd5\x83\xd2\x8f\xcf\xc7\x6f\x24\x3c\x4b\xaf\x7b\x13\xb9\x6a\xf8\x7c\x06\x56\xda\xee\x7e\x66\xb2\xbf\x6d\x06\xd4\x3b\x34\x55\x87\xbe\x08\x4a\xfc\x1a\xfa\x8d\x4a\x73\xc8\xf4\xf9\x61\x0b\x73\x73\xf7\x36\x3a\x47\x6a\xb7\x5d\x6a\x64\xea\xd6\xee\xe6\xee\xad\xfb\xe2\x44\x6d\x98\x94\xca\x4c\x36\xaa\x3d\x79\xb8\xa7\x3d\x68\xb5\xa4\xce\x3a\x57\x7f\xda\x6d\x19\x13\xa4\x5c\xc2\xad\x7f\xe1\x98\x07\x91\xa1\x94\x7b\xee\x5a\x44\xc9\x81\xd9\xa0\x9e\x53\x55\x6d\x9a\xf3\x19\x05\xe2\x28\xd8\x68\xb3\xa1\xcc\x8b\x1d\x75\x86\xd7\x8a\x4e\x1b\x4e\x94\x57\x72\xbc\x17\x73\xf5\x56\xce\x4c\xd6\xde\x54\xe4\xb5\xa3\x74\xed\xdf\x6a\xb5\x9a\x34\xf5\x9a\xd1\x69\x14\xc1\x46\x07\x95\x03\xfa\x68\x9e\x27\xf2\xdc\x3f\xc3\xfd\x78\x07\xfe\xd3\xf8\xe9\x79\x36\x1b\x3b\x27\xf0\x0d\xfa\x9a\x02\x5f\x6d\x92\xa3\x77\x94\x85\xce\x1d\xaf\xee\x1d\x8e\xa7\xed\x35\x9e\x76\xd9\x73\xd0\xcc\xac\xf4\x39\xb7\xfe\xce\xc5\x13\xcf\xba\xe8\xc9\x72\x3f\x2b\xd2\xa6\xab\xee\x30\xfe\x7e\x93\x8a\x6e\xdc\xea\xcc\xb0\x24\x99\xa1\xa3\xfc\xb0\x16\x27\x30\x3e\xa7\x00\xcf\x60\xf4\x54\xd1\x9d\x31\xc5\x74\x1d\x3c\xe9\xf6\xb1\x2a\x26\xaa\x75\x7d\x0b\xa2\x69\x37\x7a\xfe\xcd\xf9\x04\x21\x23\x1b\x8b\x4c\xba\x44\x87\xac\xab\xc7\x42\x3f\x39\x33\x4e\x58\xbb\xc7\xfa\x77\x35\x7e\xe3\x05\x23\xb6\x5b\x1c\x61\xbb\xaa\xfe\xb5\xd8\x8e\xf2\xde\x55\x9a\x58\x24\x4b\xdc\xc7\xab\x69\xa5\xd1\xd6\x63\x3a\xe0\x08\x2a\x27\xeb\x3c\xaa\x9c\xc7\x3e\x16\xfb\xa8\x06\xc3\x32\x17\xe8\x40\x78\x07\xa9\x2f\xf6\x95\xc1\xce\xbe\x83\x15\xef\xbb\x9c\x14\xb2\xa3\xec\xd7\x3c\xa0\x81\x08\xbd\xc5\x53\x5d\xed\x19\x66\xd2\x0a\xd3\x8c\x49\x9c\xb8\x58\xf5\xbe\x79\xf6\xce\x93\x39\xcc\xab\x1d\x21\x54\x84\xe3\x3b\x91\xa7\xcb\x48\x64\x40\xa8\xe7\x49\xaa\xa7\x0f\x70\xd2\xc1\xc4\x78\x58\x2d\x9a\x5d\x9a\x51\x82\x89\x8e\xf6\x74\x52\x6f\xd6\x7f\xcf\x44\x37\x7e\xc7\x2d\x3d\x47\xf6\xa8\x7f\x37\x31\x61\x97\xce\x2d\xdb\x78\x8f\xd3\xdb\x0a\x03\xed\x67\x59\x07\xe6\x6e\x1e\xcc\xd9\x3a\x58\xa4\x9f\x4f\x4c\x52\x3c\x08\x75\x7c\xf3\xb3\x4b\xc0\xd0\x69\x92\xa0\xbd\xf2\x37\x93\x2c\x6e\x29\x4e\xd2\x56\xc7\x49\xef\x79\x9a\x95\x7e\x3b\xf2\x71\xb6\xdf\x67\x4e\x54\x1f\xfe\x3c\xcb\x64\xa7\xf7\xc7\x67\x4e\xd4\x9a\xe6\x99\xa7\x9b\xb1\xb3\x3d\x73\xce\xdd\x9c\x2d\xe3\x67\x00\x0d\x47\x21\x5f\xf5\xe9\x13\xd5\xc4\xa0\xf5\x22\x0b\x12\x6d\x1b\xfb\x31\x3d\x1f\xfd\xe2\xe8\x6c\x9e\x9d\x47\xeb\xc7\xf0\xe3\xe1\x76\x42\xa2\xac\x39\x8d\xfb\x51\x8f\x05\xa9\xe6\xd8\x2e\xfd\x80\x0b\xd6\xb4\xe5\xb2\x67\x8c\xdc\xd7\xbf\x0e\x68\xef\x9e\x4b\xe1\x7c\xac\xfc\x6d\x15\x9f\x7b\x89\x61\x54\xed\x63\xe0\xd5\x91\xdd\x51\xbe\x02\x6f\x43\xf3\xca\x6b\xcb\x88\xa6\xf7\x2e\xac\x1b\xfe\x5a\x35\x54\x76\x84\xdf\x7e\xbf\xf8\x5f\x00\x00\x00\xff\xff\xaa\x07\xfe\xc9\xfd\x36\x00\x00")
+var _operatorsCoreosCom_installplansYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5b\x5b\x73\xdc\x36\xb2\x7e\xd7\xaf\xe8\x52\x1e\x9c\x54\x69\x46\x89\x4f\xd5\xa9\x53\x7a\xf3\x91\x37\x5b\xda\xf5\x45\x25\x39\x7e\xc9\xe6\xa1\x87\xec\x19\x22\x02\x01\x06\x00\x67\x3c\x9b\xca\x7f\xdf\x6a\x00\xe4\x90\x1c\xde\x46\x96\x1d\xd5\x56\xf4\x62\x0f\x89\x4b\xdf\xd0\xfd\x75\xa3\x89\x85\xf8\x48\xc6\x0a\xad\xae\x00\x0b\x41\x9f\x1c\x29\xfe\x65\x97\x0f\xff\x67\x97\x42\x5f\x6e\x7f\x38\x7b\x10\x2a\xbd\x82\xeb\xd2\x3a\x9d\xdf\x91\xd5\xa5\x49\xe8\x35\xad\x85\x12\x4e\x68\x75\x96\x93\xc3\x14\x1d\x5e\x9d\x01\xa0\x52\xda\x21\x3f\xb6\xfc\x13\x20\xd1\xca\x19\x2d\x25\x99\xc5\x86\xd4\xf2\xa1\x5c\xd1\xaa\x14\x32\x25\xe3\x17\xaf\xb6\xde\x7e\xbf\xfc\xdf\xe5\xcb\x33\x80\xc4\x90\x9f\xfe\x41\xe4\x64\x1d\xe6\xc5\x15\xa8\x52\xca\x33\x00\x85\x39\x5d\x81\x50\xd6\xa1\x94\x85\x44\x65\x97\xba\x20\x83\x4e\x1b\xbb\x4c\xb4\x21\xcd\xff\xe4\x67\xb6\xa0\x84\xf7\xde\x18\x5d\x16\x57\xd0\x3b\x26\xac\x56\x91\x88\x8e\x36\xda\x88\xea\x37\xc0\x02\xb4\xcc\xfd\xff\x03\xeb\x37\x61\xd3\x5b\x89\xca\x3f\x95\xc2\xba\x7f\x76\xdf\xbc\x11\xd6\xf9\xb7\x85\x2c\x0d\xca\x36\xa9\xfe\x85\xcd\xb4\x71\xef\x0e\x1b\xf3\x46\xa2\x08\xaf\x84\xda\x94\x12\x4d\x6b\xd6\x19\x80\x4d\x74\x41\x57\xe0\x27\x15\x98\x50\x7a\x06\x10\x85\x16\x17\x59\x00\xa6\xa9\x57\x04\xca\x5b\x23\x94\x23\x73\xad\x65\x99\xab\x7a\x13\x1e\x93\x92\x4d\x8c\x28\x9c\x17\xf6\x87\x8c\x60\x2d\x8c\x75\x70\x7d\xff\x11\x84\x02\x97\x91\xe7\x09\xf4\x1a\x12\x59\x5a\x47\xe6\x9e\xcc\x56\x24\x14\x6d\xc3\xef\x5f\x2f\x07\xf0\xab\xd5\xea\x16\x5d\x76\x05\x4b\x16\xf7\x72\x78\xd2\xcf\xdf\xff\xd2\x98\x17\x74\x78\x7d\xff\xb1\xf1\xcc\xed\x99\x43\xeb\x8c\x50\x9b\x31\x8a\xb1\x28\x8c\xde\xa2\x84\x5c\xa7\x34\x42\x4b\x35\xee\x68\xdb\x57\xc7\x2f\x06\xf6\xee\x5f\xd2\x0b\xbf\x6f\xc9\xd6\x8b\xb0\xe4\x4a\x6b\x49\xd1\x5a\xaa\xc1\xdb\x1f\x50\x16\x19\xfe\x10\x1f\xda\x24\xa3\x1c\x0f\x4a\xd2\x05\xa9\x57\xb7\x37\x1f\xff\xe7\xbe\xf3\x02\xda\xb2\x68\x98\x1c\xa4\x7c\x0a\xc9\x7a\x05\x46\xc3\xf1\xa7\x87\x15\x89\x60\xc9\x6b\xf4\x70\x02\x8e\xc8\xd4\xab\x5f\x29\x71\x8d\xc7\x86\x7e\x2b\x85\xa1\xb4\xb9\x3b\x4b\xa4\x3a\xe3\x9d\xc7\x2c\x9d\xc6\xa3\xc2\xf0\x5e\xae\x71\x92\xc2\x5f\xc3\xc9\xb4\x9e\x77\x38\x7b\xc1\xec\x87\x71\x2d\xce\xa2\xc1\x53\x1a\x65\xc6\x4c\xb9\x4c\x58\x30\x54\x18\xb2\xa4\xdc\x81\x69\x15\x79\x5a\x02\x1b\x23\x19\xcb\xa7\xae\x94\x29\x3b\xa2\x2d\x19\x07\x86\x12\xbd\x51\xe2\xdf\xf5\x6a\x16\x9c\x0e\x27\x00\x1d\x59\x07\xfe\x08\x29\x94\xb0\x45\x59\xd2\x05\xa0\x4a\x21\xc7\x3d\x18\xe2\x75\xa1\x54\x8d\x15\xfc\x10\xbb\x84\xb7\xda\xb0\x02\xd6\xfa\x0a\x32\xe7\x0a\x7b\x75\x79\xb9\x11\xae\x72\xa1\x89\xce\xf3\x52\x09\xb7\xbf\xf4\xde\x50\xac\x4a\xd6\xc6\x65\x4a\x5b\x92\x97\x56\x6c\x16\x68\x92\x4c\x38\x4a\x5c\x69\xe8\x12\x0b\xb1\xf0\xc4\x2a\xef\x46\x97\x79\xfa\x8d\x89\x4e\xd7\xbe\xe8\x88\xaf\xd7\x7e\xa1\xf2\x5b\xa3\xb2\x66\xff\x05\xc2\xb2\x99\xf8\xe9\x81\x97\x83\x48\xf9\x11\x4b\xe5\xee\x6f\xf7\x1f\xa0\x22\x20\x88\x3d\x48\xf8\x30\xd4\x1e\x84\xcd\x82\x12\x6a\x4d\x26\x8c\x5c\x1b\x9d\xfb\x55\x48\xa5\x85\x16\xca\xf9\x1f\x89\x14\xa4\x1c\xd8\x72\x95\x0b\x67\xbd\xcd\x91\x75\xac\x87\x25\x5c\xfb\x08\x02\x2b\x82\xb2\x48\xd1\x51\xba\x84\x1b\x05\xd7\x98\x93\xbc\x46\x4b\x5f\x5c\xd4\x2c\x51\xbb\x60\xf1\xcd\x17\x76\x33\x00\x1e\x4f\x38\x3a\x63\x00\x55\x88\x1a\xd4\x4e\xe3\x8c\xdf\x17\x94\xd4\xa7\xa1\x3e\xd3\xaf\x8a\x42\x8a\x24\x98\x7d\x6d\x1d\x6c\xc8\xab\xda\x11\xb4\xbc\xd2\x28\x39\x43\xc7\x1e\x42\x78\x39\x76\x9b\xed\x57\x47\x1b\xf1\xab\x59\x61\x04\x46\x7c\x06\x78\xbf\x11\xb6\x3e\x7e\xd3\x91\x57\xe5\xda\xd9\xa0\xd9\xc2\x4a\x4b\xe6\x10\x30\x0a\x2d\x45\xb2\x87\xb5\x36\xec\x1f\x1a\xb2\x5d\xc2\x8d\x83\xbc\xb4\xde\xde\xb4\x22\x96\xec\xf9\xab\xd2\xe9\x1c\x9d\x48\xce\x41\x1b\x38\x7f\x8b\xaa\x44\x79\xbe\xec\x21\x61\xd0\x20\x0e\xb4\xf7\x89\xb4\x3f\x46\x1c\xfe\x86\x45\x37\xbc\x16\x1a\x83\xfb\x9e\xb7\xc2\x51\xde\x3b\x6d\x82\xfa\xb5\x36\x09\x5d\x6b\xb5\x96\x22\x71\xbd\x0b\xb4\xe4\xff\x63\x6b\x38\xe4\xf8\x40\x16\xde\xbf\x79\x0b\x0e\x1f\x08\xf4\x4e\x91\xb1\x99\x28\x58\xbe\x6b\x41\x32\xe5\x21\x0a\x37\x94\xc2\x6a\x0f\xda\x65\x64\xc2\xf3\xf8\xd8\x58\xd8\x65\xa4\xc0\x3a\x2a\x2c\xa0\xf1\xc1\x5f\x0a\x4a\x61\x27\x5c\x06\xd6\x3b\x9b\x85\x15\x69\x78\xb1\x1f\x56\xce\x90\x8c\x37\xa4\x38\x28\xf6\x44\xa4\xc3\x5c\x0e\x04\x1b\x32\x47\xef\x19\xce\xae\x30\x79\x98\x94\xca\x5d\x1c\xd8\xf0\x70\x19\x3a\x40\xb8\xd6\x79\x21\xc9\x11\x1b\xd8\x8f\x28\x24\xa5\xad\xa8\xbe\x22\xbf\x07\x4b\x87\x67\xc7\xf0\x54\x18\xda\x0a\x5d\x5a\xb9\x3f\x9c\x70\xb8\xee\xb3\x15\xeb\x83\x9c\x49\x29\x65\x70\xc7\x2e\xd6\x3a\x74\xa5\xbd\x80\x5d\x26\x92\xac\x3a\xed\xec\x4e\x2a\x12\x6f\xfd\x11\x39\x5d\x8e\xa6\x35\x7f\xb6\x44\xc2\x70\x48\xc9\x91\xc9\xbd\x67\xdb\x65\xe4\xcd\x80\x39\x6d\xca\x82\xa3\xfc\xe7\xc9\xc2\x5b\x92\x70\xb0\x46\x21\x2d\x4b\x3c\x1e\x74\x5e\x67\x60\x8a\x70\x8d\x65\xfd\xbc\xa5\x87\xa0\xd5\xb6\x6d\xac\xc5\x11\x51\xc9\x7d\x43\xe8\xeb\x23\xc9\xf2\x20\x4b\xee\x02\xac\x6e\xb2\x67\xbd\x41\xeb\xd2\x79\xa2\x12\x54\x2f\x5c\x47\xfb\x27\xfb\x1d\x00\x52\x65\xde\x7f\xe4\x17\xf0\x8e\xb6\x3d\x16\x1d\xde\xbd\x57\x6c\x8b\xa5\xa1\xa3\xf7\x21\xbe\x0c\x9f\x94\x01\x52\xc2\xb4\x3a\x77\x39\x69\x7e\xb0\xd8\xd1\x20\x79\xde\x8c\x92\x7e\x78\x03\x92\x44\x48\xbc\xd6\x26\x0f\x5a\xc2\x15\x8b\x99\x9f\x86\xa5\xd9\x04\x82\x7f\xa9\x82\x1f\x1b\x57\x52\x9d\xcc\xa6\x86\x97\xf0\x2f\x05\x71\x07\x06\x38\xce\xa0\x90\x7e\x29\x4c\x5c\x89\xd2\xaf\x48\x11\x73\xef\xad\xa3\x7c\x79\xfe\x34\xb1\x37\x41\x87\x52\x6f\xee\x43\x7c\xef\x19\x50\x64\x68\xbb\xfa\x1a\x8d\xa8\xce\x91\x2a\x19\x57\x45\x9b\x7f\x95\x24\xba\x54\xee\x8e\xd6\xd3\x41\x76\x78\x2e\x18\x5a\x93\x21\x95\x44\xc4\x6e\xc3\x00\xc0\x30\x22\xf8\x3d\x61\x39\x2e\x7b\x31\xa7\x3a\x64\xb6\x69\x9d\x9c\x54\x02\x1f\xb6\xf7\x5e\xe1\x4d\xf1\x0b\xa3\xd9\x47\x3f\x9b\xb7\x37\x55\xc6\x51\xb9\x89\xc8\x9d\xeb\x23\x0e\xa6\x0f\x24\x84\xe0\xe6\x33\xca\x39\x14\xbc\xb8\x89\x02\xf5\xb8\xdc\x69\x40\x28\x04\x25\xd4\x4a\x70\xbc\xc0\x08\xd3\xf8\x90\x21\xac\xa1\xf8\xee\x22\xa0\xef\x08\xec\x0f\x09\x90\x43\xa1\x00\x19\xe9\x8b\x14\xfe\x71\xff\xfe\xdd\xe5\xdf\x75\x0c\xbc\x98\x24\x64\x43\xa0\xa0\x9c\x14\x7b\xaa\x32\xc9\x00\x2d\x93\xc6\xe6\xc9\xf6\x4f\xcb\x1c\x95\x58\x93\x75\xcb\xb8\x1a\x19\xfb\xf3\xcb\x5f\x96\x0c\x00\x80\x3e\x21\x1f\x9e\x0b\xf6\x7f\x2c\xb5\x3a\x4d\x88\xa6\xe1\xc1\x19\x33\x53\xcf\xf5\xde\xcf\x93\x54\xe8\x34\x12\xbd\xf3\xc4\x06\xdc\x10\x89\x2d\x09\xa4\x78\xa0\x2b\x38\x0f\xc5\x86\x7a\xeb\xdf\x39\xb1\xfe\xe3\x1c\xbe\xdd\x65\x64\x08\xce\xf9\xe7\x79\xd8\xb0\xce\xea\xf8\x59\xa5\xc7\xc3\xc6\xde\x20\x9d\x11\x9b\x0d\xf9\x93\xcf\x29\x0a\xa7\x01\xdf\x71\x80\x10\x6b\x50\xba\x31\xd8\x2f\xc1\xf2\x2c\x28\x11\x6b\x86\x21\x5d\x42\x7e\x7e\xf9\xcb\x39\x7c\xdb\xe6\x0b\x84\x4a\xe9\x13\xbc\x0c\x05\x16\x61\x99\xc7\xef\x38\x8e\xf0\x4a\x7b\xe5\xf0\x13\xaf\x99\x64\xda\x92\x0a\x01\xc4\x69\xc8\x70\x4b\x60\x75\x4e\xb0\x23\x29\x17\x01\xf7\xa7\xb0\xc3\x3d\xf3\x50\x89\x92\xb5\x8a\x50\xa0\x71\x9d\x9c\xf7\xc3\xfb\xd7\xef\xaf\xc2\x6e\xac\xb6\x8d\x0f\x4e\x9c\x4f\xad\x05\x67\xb4\x9c\xca\x86\xbc\xcc\xeb\x9c\x09\x29\x83\x92\xd8\xf5\x65\xa8\x36\x54\x95\x83\xd6\x25\x67\x48\xcb\x6e\x0e\x34\xdb\xe2\xfb\x12\xd0\x7e\x63\xf7\x89\x68\xf7\xa0\xfd\x89\x69\xde\x6c\x16\x7d\x55\x67\x16\x8b\xef\x1a\x36\x38\xca\xe2\x43\xb9\x22\xa3\xc8\x91\xe7\x32\xd5\x89\x65\x06\x13\x2a\x9c\xbd\xd4\x5b\x76\xaa\xb4\xbb\xdc\x69\xf3\x20\xd4\x66\xc1\x46\xb6\x08\x9a\xb7\x97\xbe\x9e\x79\xf9\x8d\xff\xe7\xb3\x38\x1a\x0c\xd5\xfd\x6c\xf9\xe1\x5f\x83\x37\xde\xc7\x5e\x3e\x9a\xb5\x2a\x49\x3e\x25\x12\xbc\xb8\x0f\x07\x3e\xe9\xce\xe6\xe3\x12\xa0\x74\x2c\x43\x35\x3c\x5c\x8e\x69\x70\x81\xa8\xf6\x5f\xdc\x8c\x59\x80\xa5\xe1\xbd\xf7\x8b\x58\x69\x5f\xa0\x4a\xf9\xff\x56\x58\xc7\xcf\x1f\x2d\xb1\x52\xcc\x3c\xc0\x3f\xdd\xbc\xfe\x3a\xc6\x5d\x8a\x47\x9e\xd6\x55\xa9\x52\x49\x6f\xb4\x7e\x28\x8b\xe9\x64\xf6\xff\x9b\xa3\xab\x8a\x42\xac\xbb\x08\xb5\x28\x8c\xde\x18\x8e\x95\x8d\xba\x15\x14\xa5\x0c\xee\xb5\x54\x05\x26\x0f\xb8\xa1\xb8\xa9\x0f\x23\xa4\x5c\x15\x8e\x62\x72\x3f\x0c\x73\x1e\x91\xc9\x0f\x52\x1f\xea\x7b\x91\xce\x01\x32\xab\xb8\xc8\x34\x7a\x04\x1b\xe9\x9e\xa6\x77\x12\x98\x8d\x61\xdb\xf0\xd7\x41\xb8\x77\xb4\x1e\x1c\x28\x52\xb6\xfb\xb5\x18\x48\x5f\x3c\x18\x46\x97\x0d\xbe\x34\x54\x48\xec\x03\xd1\x30\x03\x42\xc2\x11\x9d\x43\xe3\x3a\xda\xb8\xee\x4c\xab\x34\x52\x39\x8c\x28\xe5\xd6\x30\xff\x24\x6a\x81\x59\x82\x1d\x5a\xef\x81\xe4\x96\xd3\x51\xa3\xf3\x21\x1c\x3a\x43\x23\xf3\xb8\x85\x59\xb0\xb9\x87\xdf\x47\x80\xe7\x26\xe1\x23\xee\x28\xfc\x4d\x02\xe9\x1e\x9a\xfe\x82\xd3\x7f\xc1\xe9\x67\x0e\xa7\x4f\x3a\x03\x63\xd0\xba\xcf\xfc\x9f\x2b\xc0\x3e\x89\xe9\x31\xb0\xdd\xc7\xf4\x33\x81\xdc\x27\xf3\x38\x0a\xbf\x87\x18\x7d\x26\x20\xfc\x24\x66\x67\x02\xf2\x3e\x96\xff\x9b\x61\xf9\x49\x32\x1c\x81\xe8\x7d\x72\x7b\x16\x40\x7d\x36\x83\x89\x56\xa1\xaf\x65\x04\xa5\xb4\xb1\x56\x3d\xa1\x5b\x07\x66\xa2\x51\xb6\xea\xb4\x4d\x98\x3c\x05\xa7\x86\x20\x79\xf8\x1b\x01\xe6\xcd\x45\x26\x30\xd9\x34\x56\x0e\x7f\x8b\x58\xbe\x9e\x18\xc4\x7b\x8e\x0c\x99\x87\x00\x01\x24\x5a\xf7\xc1\xa0\xb2\xa2\xea\xc9\x1a\x1f\xdf\xd1\xc8\x1b\xe4\xb4\x43\xe4\x75\x96\x11\xf4\x03\xae\x5e\x32\x02\x5a\x7f\xfd\x11\xeb\xf2\x8c\x69\x94\xbf\x13\x1c\x47\x8c\xb3\x4f\x09\x84\xfb\xcc\x1c\xdd\x15\xa4\xe8\x68\xc1\x14\x4d\xb2\xfd\x93\x6f\x3f\x78\x32\x96\x19\xc3\x17\x46\xaf\x28\xfd\xd3\xb8\xca\xc9\x5a\xdc\x9c\xc6\xce\x2b\xc8\xca\x1c\x15\x18\xc2\x14\x57\x92\xaa\x45\x18\x8d\xf9\xfe\x03\xb5\x81\x94\x9c\xbf\x5a\x3b\xdc\xb0\x1c\xf4\xfb\x64\xcc\x1a\x42\x3b\x15\x25\xe0\xb8\x69\x2c\x4c\xf3\x57\xff\x2d\x7d\xbc\xb0\x5e\xc9\x5f\x82\xd2\xfe\x9b\xab\x51\x4a\xef\xeb\x1b\xa9\x16\x91\x17\xd5\x55\xe5\x07\x53\xd2\x05\xfc\x88\xd2\xd2\x05\xfc\xa4\x1e\x94\xde\x3d\x1d\xbd\x7e\xe0\x49\x72\xdd\x17\x9e\xaa\x9a\xce\x27\x20\xe5\x90\xdd\xcf\x74\xf6\x37\xf5\x84\xaa\x42\x13\x33\xf4\x45\xa9\xc4\x6f\x65\x3b\x51\xa9\x2f\x99\xbe\xed\xa6\x30\xd7\xf7\x1f\xbd\x71\x84\x74\x3b\x5e\xd0\x57\xa9\xdd\xf5\xfd\x47\xfb\xdd\x44\x6c\x18\xe5\xaa\x18\x4d\x54\x5b\xfc\x70\x4e\xdb\x49\xb5\xa4\x4e\x1a\xcd\x7c\x87\xb2\x4c\x51\x4a\xb9\x84\x1b\xf7\xc2\x32\x0d\x22\x41\x29\xf7\x9c\xb5\x88\x9c\x0f\x66\x8d\x7a\xa6\xa2\xda\x38\xe5\x33\x02\xc4\xd1\x61\xa3\xf5\x9a\x12\x27\xb6\xd4\x98\x5e\x09\x3a\x14\x9c\x28\x8d\x7c\x7c\x16\x71\x55\x29\x67\x26\x69\x77\x71\x78\x65\x28\x4d\xfd\x1f\xa4\x1a\x17\x0d\xb9\xa6\x37\x1a\x45\xb0\xd6\xa5\x4a\x01\x9d\x57\xcf\x23\x69\x6e\xdf\xe1\x7e\xbd\x16\x9e\x71\xfc\xf4\x34\xc5\xc6\xc6\x0d\x7c\x8d\xbe\xc6\xc0\xd7\xc1\xc9\xd1\x27\x4a\xca\x46\xd7\x66\xb3\x2b\xeb\x71\xb5\xc6\x69\x93\x3d\x05\xcd\xcc\x72\x9f\x73\xe3\xef\x5c\x3c\xf1\xa4\x9b\x4e\x86\xfb\x59\x27\x6d\x3c\xea\xf6\xe3\xef\xbb\x10\x74\x7d\xa9\x33\xc1\x9c\x64\x82\x96\xd2\x6e\x2c\x0e\x60\x7c\x4e\x00\x9e\x41\xe8\x54\xd0\x9d\xb1\xc4\x78\x1c\x9c\x34\x7b\x1f\x15\xc3\xa8\x55\xd5\x05\x51\xa7\x1b\x2d\xfb\x06\xdf\x03\x96\x90\xf1\x41\x26\xb4\xc5\x22\xcb\x6a\x97\xe9\x47\x7b\xc6\x11\x6d\xb7\x48\x7f\x5b\xe1\x37\xde\xd0\x63\xbb\xc5\x11\xb6\x8b\xf1\xef\x80\xed\x7c\x23\xd9\xa1\x95\xc6\x07\xc9\x1c\xf7\xbe\xd9\x34\x2f\xb4\x71\x18\x2e\x38\x4a\x95\x92\xb1\x0e\x55\xca\x73\x77\xd9\x3e\x34\x6b\x31\xcf\x19\x5a\xdf\x89\x16\xf2\x62\x17\x15\x76\x72\x77\x93\xef\x77\x99\x64\xb2\x21\xec\x5b\x9e\x50\x43\x84\xd6\xe6\x21\xae\xb6\x14\x33\xaa\x85\x71\xc2\x24\x8e\xb4\x12\x7e\xae\x9f\xbd\x77\x54\x74\xfd\x6a\x83\x09\xe5\xe1\xf8\x56\xa4\xa1\x19\x89\x0a\x10\xea\x69\x9c\xea\xf4\x05\x4e\xb8\x98\x18\x3e\x56\x8b\xba\x4a\x33\x38\x60\x24\xa3\x9d\x76\xea\x93\x6e\xae\xd7\xfc\xe9\x53\x21\x51\xa8\xae\x24\xc3\x2f\x2a\x2e\x80\x96\x9b\x65\xa8\xdc\xb6\xfb\x52\x11\xaa\x36\x57\x36\x2a\x06\x0b\x9f\x09\x65\xa2\xf4\x3e\xd3\x4d\x0f\x77\xe8\xf5\x08\x81\xad\xa9\xfa\x8e\x6b\xc4\xaa\x1a\x5d\xff\xbe\xaf\xdc\x99\x88\xe0\xf6\xb3\x6c\x0b\xe6\x96\x3e\xe6\x14\x3e\x16\xe1\x73\xae\xd1\x11\x0f\x42\x1d\x77\xa2\x37\x07\x30\xf0\x1b\x1d\x70\x68\x58\x9c\x39\xcc\x17\x44\x47\xc7\xc6\xcb\xb0\xcf\xbc\x8b\x0b\xdf\xb2\x7d\x9d\xcb\x83\x99\x0b\x55\x57\x57\x4f\xb2\xd8\x74\x75\x7f\xe6\x42\x07\xd5\x3c\xf1\x72\x33\xea\xf2\x33\xd7\xdc\xce\x29\x78\x3f\x01\xe4\x39\x3a\xf2\xb1\xca\x30\x12\x0b\x0b\x34\x4e\x24\xa5\x44\x73\x38\xfb\x3e\xb8\x1c\x7d\x01\x79\x32\xcd\x55\xeb\xf4\x64\xec\xbe\xad\x7a\xac\x43\x3b\x75\xcf\xb7\x6d\x31\x53\xf3\x8e\xa8\xd3\x2e\xee\xfb\xab\xc3\x65\x64\xa7\x8f\x3c\x39\xea\xab\xff\x02\x9d\xa6\x89\x49\xef\x9d\x36\xb8\xa9\x5b\xc9\x67\x75\xd0\x5c\xdf\xbd\xee\x4c\x83\x1c\x8b\x76\xb2\x4a\x98\x64\x83\x9f\xe0\x56\xdf\x4a\xf5\x48\x84\x7d\x77\xf8\x02\xc0\x6f\x50\x5f\xce\xaf\x68\xad\x4d\x28\x52\x86\xc9\xe3\xbd\xad\xa3\x2e\xbc\xf9\x01\xea\xb4\x37\x9b\x34\x6d\xd1\xe8\x8f\x9f\x25\xbf\x56\x43\x7d\xbf\xe4\x6a\x9b\xe9\xed\xf8\xef\xbb\xdc\x6e\xc9\xd0\xb7\x2f\xd7\x5f\x04\x08\x77\xc1\x28\xd1\x52\xcf\x07\x2a\xb8\x61\x50\xcf\x56\x1a\xfb\xff\x97\x55\xfd\x61\xf8\x03\x85\xf8\x09\x40\x37\x4b\x30\x8d\x4f\xb9\xe2\xba\xe8\x59\xdb\xfb\x77\x5a\x2d\x62\x73\x0e\x44\x7b\xf7\x65\xe7\xea\x82\xa7\xda\xfe\xd9\xa8\xb5\x52\xc1\x2c\x95\xd6\x32\x1b\x38\x08\xbd\x5a\x3c\x48\xab\xff\x1c\x1c\x15\xff\xfa\x57\x71\x35\xa9\xcf\x43\x78\xd6\xa1\x71\x43\x85\x83\x6e\x1d\x39\x8c\xac\x9c\xbc\xb7\x08\xff\x01\x4c\xd5\xaf\x11\xbe\xc5\x87\x15\x6d\xd8\xc4\x8a\x42\xee\xab\x0f\x3d\x0f\x9f\x11\x4a\x61\x5d\xf8\x76\xa8\x4e\xdf\xe6\x76\xaf\x0d\xea\x7f\xa8\x6a\xe1\xbf\xe5\x4a\xaf\xc0\x99\xb2\x7e\x14\x5c\x55\xfb\x59\xb9\xaa\xe9\x3b\x88\x21\x86\x40\xf8\xfd\x8f\xb3\xff\x04\x00\x00\xff\xff\xf4\x67\x44\x9e\xc8\x40\x00\x00")
 
 func operatorsCoreosCom_installplansYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -145,7 +145,7 @@ func operatorsCoreosCom_installplansYaml() (*asset, error) {
 	return a, nil
 }
 
-var _operatorsCoreosCom_olmconfigsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\xef\x6e\xdb\x46\x12\xff\xee\xa7\x18\xe8\x0e\x88\x9d\x93\xa8\x38\x39\xe4\x5a\x02\x41\x10\x28\xe7\x22\x48\x7c\x0d\x62\x5f\x0e\x38\xcb\x77\x1d\x92\x23\x6a\x1b\x72\x97\xdd\x9d\xb5\xad\x16\x7d\xf7\x62\x76\x49\x8a\x92\x45\xc7\x40\x5b\x7e\x11\xb9\x3b\x3b\x3b\x7f\x7f\x33\x23\x6c\xd4\x67\xb2\x4e\x19\x9d\x02\x36\x8a\xee\x98\xb4\x7c\xb9\xe4\xcb\x37\x2e\x51\x66\x7e\x73\x7a\xf4\x45\xe9\x22\x85\x85\x77\x6c\xea\x4f\xe4\x8c\xb7\x39\xbd\xa5\x95\xd2\x8a\x95\xd1\x47\x35\x31\x16\xc8\x98\x1e\x01\xa0\xd6\x86\x51\x96\x9d\x7c\x02\xe4\x46\xb3\x35\x55\x45\x76\x56\x92\x4e\xbe\xf8\x8c\x32\xaf\xaa\x82\x6c\x60\xde\x5d\x7d\xf3\x2c\x79\x99\x3c\x3f\x02\xc8\x2d\x85\xe3\x97\xaa\x26\xc7\x58\x37\x29\x68\x5f\x55\x47\x00\x1a\x6b\x4a\xc1\x54\x75\x6e\xf4\x4a\x95\x2e\x31\x0d\x59\x64\x63\x5d\x92\x1b\x4b\x46\x7e\xea\x23\xd7\x50\x2e\x37\x97\xd6\xf8\x26\x85\x83\x34\x91\x57\x27\x20\x32\x95\xc6\xaa\xee\x1b\x60\x26\x97\x84\xf7\xa8\xf8\xf7\x1f\xce\x17\xe1\xca\xb0\x56\x29\xc7\xef\x77\xd7\x3f\x28\xc7\x61\xaf\xa9\xbc\xc5\x6a\x28\x64\x58\x76\x4a\x97\xbe\x42\x3b\xd8\x38\x02\x70\xb9\x69\x28\x85\x45\xe5\x1d\x93\x3d\x02\x68\x8d\xd1\xca\x31\x6b\x15\xbe\x39\x6d\xc5\x72\xf9\x9a\x6a\xec\x84\x04\x51\x4d\xbf\xf9\xf8\xee\xf3\x8b\x8b\xbd\x0d\x80\x82\x5c\x6e\x55\xc3\xc1\xb4\xbd\x98\xa0\x1c\x20\xd8\xd6\x81\xf2\xd2\x18\xed\x54\x56\x11\xac\x8c\x85\x28\x98\xb7\x4a\x97\x72\x26\x19\xf0\xe3\x8d\x48\x6a\xb2\x1f\x29\xe7\xc1\xb2\xa5\x9f\xbc\xb2\x54\x0c\xaf\x16\xc1\xbb\x80\x18\x2c\x37\x56\x3c\xc1\x03\x2b\xc7\x67\x10\x7e\x3b\xeb\x7b\x3a\x3c\x11\x45\x23\x1d\x14\x12\x79\xe4\x80\xd7\xd4\x99\x8c\x8a\xd6\x3a\x60\x56\xc0\x6b\xe5\xc0\x52\x63\xc9\x91\x8e\xb1\x28\xcb\xa8\x5b\x05\x12\xb8\x20\x2b\x07\xc1\xad\x8d\xaf\x0a\x51\xfc\x86\x2c\x83\xa5\xdc\x94\x5a\xfd\xdc\x73\x73\xc0\x26\x5c\x53\x21\x93\x63\x50\x9a\xc9\x6a\xac\xe0\x06\x2b\x4f\x53\x40\x5d\x40\x8d\x1b\xb0\x24\x7c\xc1\xeb\x01\x87\x40\xe2\x12\x38\x37\x96\x40\xe9\x95\x49\x61\xcd\xdc\xb8\x74\x3e\x2f\x15\x77\xc9\x95\x9b\xba\xf6\x5a\xf1\x66\x1e\xf2\x44\x65\x5e\x62\x75\x5e\xd0\x0d\x55\x73\xa7\xca\x19\xda\x7c\xad\x98\x72\xf6\x96\xe6\xd8\xa8\x59\x10\x56\x87\x04\x4b\xea\xe2\x2f\x9d\x37\xdd\x93\x3d\xf3\x45\x97\x39\x16\x77\xee\x6c\x85\x98\x7e\xd0\xd6\x12\xdd\x31\x56\xe2\xf1\xa8\xcb\xd6\xa4\xb2\x24\x56\xf9\xf4\xcf\x8b\xcb\x6d\x38\x05\xb3\x47\x0b\x6f\x49\xdd\xd6\xd8\x62\x28\xa5\x57\x64\x23\xe5\xca\x9a\x3a\x70\x21\x5d\x34\x46\x69\x0e\x1f\x79\xa5\x48\x33\x38\x9f\xd5\x8a\x5d\x08\x30\x72\x2c\x7e\x48\x60\x11\xb0\x05\x32\x02\xdf\x14\xc8\x54\x24\xf0\x4e\xc3\x02\x6b\xaa\x16\xe8\xe8\x4f\x37\xb5\x58\xd4\xcd\xc4\x7c\x8f\x37\xf6\x10\x1a\xef\x1f\xb8\x97\x50\x00\x1d\x7c\x8d\x7a\xa7\xcf\xe6\x8b\x86\x72\xf1\x92\x98\x4d\x4e\x85\x1c\x46\x3d\x48\xf7\xce\x35\xc9\x63\x2f\x1f\x4f\x53\x79\x56\x84\x62\x9a\x03\x3b\x7b\x22\x9e\xb5\x84\x01\xfb\x51\xe9\x28\xa3\xe0\xa6\xe4\x61\x07\x33\x28\xb8\xf3\xfd\x87\xf3\x9e\xef\xbe\x98\x5f\x11\xf5\x6b\xe2\x06\xb1\x94\x93\x6b\x16\xa6\x51\x54\x2c\x2e\x3e\x8f\x90\xed\xc9\xff\x76\xff\x94\x98\xd9\x3b\x2a\x04\x0e\x5a\x96\x22\xf9\x13\x07\x93\x48\x04\x8b\x8b\xcf\x93\x4e\x91\xe0\x88\xbe\xee\x80\xd2\x8e\xb1\xaa\xa8\x00\xec\x62\x3c\x00\x7e\xc4\xff\x29\xdc\xae\xc9\x12\xe0\xee\x72\xd1\x33\x90\xbb\x8d\x96\xf4\x42\x86\x35\x3a\xc8\x88\xf4\x80\xa9\xd2\xc1\xe9\x2d\xf5\x77\x52\xf5\x22\x2d\xa3\x2d\x89\x1d\x60\x55\xc5\x72\xd7\x60\x2e\x80\xf4\x9f\x35\x69\xb0\x44\x5a\xd4\x28\xa6\xc1\x05\xb7\xaa\xaa\x04\xfe\xa4\xf6\x52\x10\x72\xa0\x98\x9b\x04\x8d\x08\xf3\xf5\x98\x90\x87\x5c\xb7\x75\x5f\x66\x4c\x45\xa8\x77\xe3\x9c\x91\xfd\x3d\x77\x8c\x44\x7a\xa0\xed\x63\x3d\x7e\xfd\xd9\xd1\x9e\x1b\x5d\xa8\x41\x1f\x73\x88\x29\x5a\x8b\x9b\x03\xbb\x8a\xa9\x1e\x8b\xc8\xa1\x86\x93\x45\x77\xc9\x36\x55\x0a\x62\x54\x55\xd4\x4f\xfc\x8e\x92\xdb\x1c\xeb\x1a\x41\xee\xad\x0d\x00\xc9\xe2\xa8\xae\xd8\xbd\xf9\xf8\x0e\xba\xbe\x2c\x81\xd9\x6c\x06\x97\xb2\xec\xd8\xfa\x9c\xc5\x6e\x52\xb8\x74\x41\x45\xe0\x5a\x28\x1b\xaa\x95\x13\xe6\x62\xc3\xa0\x46\x17\x9d\x2b\x45\x55\x01\x0d\xf2\x1a\x92\x68\xea\x64\x6b\x8a\x04\xe0\x4c\x42\xe1\x0e\xeb\xa6\xa2\x69\x30\x03\x9c\x19\xd3\x7a\x28\x5e\xf8\x4b\x50\x74\x3e\x87\x4f\x7d\x15\x08\x9c\x4d\xe6\xc8\xde\xc4\xde\x30\x14\x64\x58\x19\xf3\xc4\xed\xea\x94\x74\x87\xdf\x6b\x73\xab\x0f\x89\x10\xee\x44\x4b\x29\x2c\x27\x6f\x6e\x50\x55\x12\xc7\xcb\xc9\x14\x96\x93\x8f\xd6\x94\x96\x9c\xb4\x5b\xb2\x20\xe5\x79\x39\x79\x4b\xa5\xc5\x82\x8a\xe5\xa4\x63\xfd\xb7\x06\x39\x5f\x9f\x93\x2d\xe9\x3d\x6d\x5e\x05\x86\x3b\x5b\x17\x6c\xa5\x29\xdc\xbc\xaa\x85\xa6\xdf\x13\x04\xbb\xdc\x34\xf4\xaa\xc6\x66\x67\xf1\x1c\x9b\x1d\x46\xbd\x5b\x1d\x5c\x5d\x4b\x09\xb8\x39\x4d\xb6\xae\xfe\xe1\x47\x67\x74\xba\x9c\x6c\x75\x9a\x9a\x5a\x42\xa6\xe1\xcd\x72\x02\x3b\x12\xa4\xcb\x49\x90\xa1\x5b\xef\x84\x4e\x97\x13\xb9\x4d\x96\xad\x61\x93\xf9\x55\xba\x9c\x64\x1b\x26\x37\x3d\x9d\x5a\x6a\xa6\x92\xf1\xaf\xb6\x37\x2c\x27\x3f\xc0\x52\x77\x42\x1b\x5e\x93\x8d\x9e\x76\xf0\xeb\xe4\x60\xa0\x7e\x05\x79\xc7\x9a\xbf\xed\x33\x83\x0a\x1d\x5f\x5a\xd4\x4e\x75\x0d\xfd\x28\x69\x4d\xce\x61\x39\xbe\x6f\x09\x9d\xd1\xa3\xdb\x31\x4a\x46\xb7\x45\x97\x83\x9b\x5f\xab\x1f\x70\x40\x87\x31\xca\xbd\xdc\xbe\x7f\xb0\x03\x30\xd9\x01\x96\x85\x90\xd1\x7d\x5c\x70\x4f\x2d\x89\x2a\xed\x91\xe4\x7f\x0b\x77\x6c\x00\x75\xf0\x5b\xd2\x26\x77\xec\x5d\x33\x92\x02\xa2\x03\x2b\xaf\x0b\xb2\xd5\x46\xda\xb3\x2d\xd7\x7c\x8d\xba\x94\x5e\x09\xde\xad\x62\x61\x50\x0e\xa4\x8f\xfa\x22\x09\x36\x95\x83\x1a\xbc\xeb\x7a\xba\x20\x57\xcf\x51\x80\x25\x02\x42\xcb\x26\xb4\x85\x79\x4e\x0d\x4b\xd6\x8d\xc1\xfe\x03\x0d\xd1\xf0\x59\x19\x5b\x23\xa7\x20\xcd\xdc\x8c\xc7\xc3\xa3\x0d\x8e\x47\x1a\xbe\xa5\x8e\x0d\xec\xda\xd7\x28\xd5\x0e\x8b\x50\xb4\xfb\x3d\x5d\xa8\x1c\x43\x23\xdb\xe1\x2d\x66\xc6\x47\x04\xdc\xfa\xa1\x35\xb5\x74\xae\x19\x09\x52\x86\x1c\x6d\xd5\xfa\x9d\xca\xd7\x78\xf7\x81\x74\xc9\xeb\x14\x5e\x3c\xff\xc7\xcb\x6f\x46\x08\x23\x68\x52\xf1\x1d\x69\x29\xb5\x07\xe6\xa4\x11\x33\xdc\x3f\x38\xe8\xca\x83\x9e\x49\xd7\x9c\x26\xe5\x96\x26\xb6\x0e\x3b\x71\x79\x8b\x0e\x1c\x31\x64\x28\x2d\x90\x6f\xc4\x2e\x52\x05\x42\x0f\xa2\x73\x9a\x82\x5a\x1d\x66\xa6\x7a\x70\xaf\x36\x70\xfa\x7c\x0a\x59\x6b\xe2\xfb\xb0\x7e\x75\x77\x9d\x1c\x10\x59\x39\xf8\x76\xba\x27\x8f\x34\x44\x3e\x54\x44\x09\x1c\xb8\x55\xbc\x0e\xb3\xac\x14\xb5\x76\x5e\x3b\x50\x26\xa9\x97\xf7\x6b\x8e\x93\x62\x59\x86\x81\xfc\xf0\xd3\x85\xad\xd2\xfc\xf2\xef\xe3\xfe\x55\x5a\xd5\xbe\x4e\xe1\xd9\x08\x49\x84\xb4\x47\x7a\x33\x12\x6f\xbb\x04\x14\xe8\x2a\x2d\xd6\x35\xb2\xca\x41\x15\x32\xa7\xac\x14\xd9\x61\x68\x8b\xd2\xed\x41\xa9\xfb\x3b\x56\x7c\xe2\x5a\x1c\x1a\x04\xfb\x47\x6b\x0a\x9f\xcb\xac\x66\x56\x61\xa4\x50\x2b\x95\x0f\x01\x4a\x06\xa0\x90\x0d\x71\x04\x07\xba\x13\xa3\xf7\xc3\x6e\x9c\x87\x09\xb5\xd2\xa5\x6b\xaf\x94\x49\x4f\x00\x24\x56\xe3\xdb\x35\x85\xd2\x13\x46\xf7\xf6\x8c\x0d\x52\x39\x55\x90\x95\x1e\x19\x4a\x8f\x16\x35\x13\x15\x02\x3f\x92\x82\x2d\xed\x00\xf2\x70\x3b\xf6\x75\xd9\x18\x53\x35\x82\x95\x88\xd8\x8e\x8a\x21\x63\xff\xb8\x54\x3d\x7d\xf6\xfc\x41\x97\xf7\x74\xa3\x44\x0d\x32\x93\xd5\x29\xfc\xef\xea\xcd\xec\xbf\x38\xfb\xf9\xfa\xb8\x7d\x79\x36\xfb\xf6\xff\xd3\xf4\xfa\xe9\xe0\xf3\xfa\xe4\xf5\x5f\x47\x38\x1d\x6e\xa0\x47\xc2\xa7\x2d\x22\x5d\x13\xd9\x79\x74\x1a\x2a\x8c\x59\xc1\xa5\xf5\x34\x85\x33\xac\x1c\x4d\xe1\xdf\x3a\x94\x86\xdf\x69\x34\xd2\xbe\x1e\x97\x4e\xaa\xf2\x44\x6e\x3d\xdc\x7c\xf4\x24\x41\xa4\x87\x69\x5a\x71\x1f\x9a\x40\x1e\x67\xa4\xd0\xbe\xc5\x01\xb5\x43\x9a\xc1\xdf\x0b\x10\x10\x4f\x5a\xd6\xa4\x6d\x7f\x93\xdc\xd4\xf3\xc1\xdf\x0f\xd2\x77\x9f\xa3\xde\xc0\x16\xd6\x62\xb3\xba\x1f\xe9\x8e\x05\x9b\x30\xb7\xc6\xb9\x7e\x6c\x71\x50\xa9\x2f\x04\x7d\x47\x1b\xc1\x32\xa3\x1c\x43\xa3\x6e\x33\xc5\x16\xed\x66\x30\x97\x40\x8e\x3a\xfc\x1b\xe2\x68\xe5\x2b\x38\x76\x44\x90\x68\x53\xd0\x7d\x74\x3d\x89\x18\x8a\x99\xaa\x14\x6f\xc2\x18\x4b\x32\x87\x57\xaa\x9d\x0f\xea\xc6\x58\x46\xcd\x31\xdd\x2c\x95\x74\x07\x8a\xa1\x96\x9e\x93\xc2\xe8\x75\x5c\x68\x77\x7a\xfa\xfc\xc5\x85\xcf\x0a\x53\xa3\xd2\x67\x35\xcf\x4f\x5e\x1f\xff\xe4\xb1\x12\xe4\x29\xfe\x85\x35\x9d\xd5\x7c\xf2\xc7\x95\xc5\xd3\x97\x8f\xc8\xa2\xe3\xab\x98\x2b\xd7\xc7\x57\xb3\xf6\xed\x69\xb7\x74\xf2\xfa\x78\x99\x3c\xb8\x7f\xf2\x54\x74\x18\x64\xe0\xf5\xd5\x6c\x9b\x7e\xc9\xf5\xd3\x93\xd7\x83\xbd\x93\x2e\x19\x63\x9d\x4a\x81\xad\xef\x9a\x16\xc7\xc6\x4a\x93\xb2\xb3\xe6\xb3\xde\xbd\xdb\x20\x6c\x33\x17\x7e\xf9\xf5\xe8\xb7\x00\x00\x00\xff\xff\xc6\x78\xa2\x47\x7d\x17\x00\x00")
+var _operatorsCoreosCom_olmconfigsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x5b\x5b\x73\xdb\x46\x96\x7e\xf7\xaf\x38\xc5\xd9\x2a\x59\x59\x02\x8a\x9c\xdd\xec\x0c\xab\x52\x2e\x0d\x1d\x67\x55\xb6\x22\x95\xa9\x78\x2f\x96\x77\xa6\x01\x1c\x82\x3d\x6a\x74\x63\xba\x1b\x92\x38\x53\xf3\xdf\xb7\x4e\x5f\x00\x90\x04\x48\xc6\x8a\xf7\x65\xf1\x90\x88\x40\x5f\xce\xf5\x3b\x97\x6e\xb3\x9a\x7f\x44\x6d\xb8\x92\x33\x60\x35\xc7\x27\x8b\x92\x7e\x99\xf4\xfe\xf7\x26\xe5\xea\xec\xe1\xfc\xc5\x3d\x97\xc5\x0c\xe6\x8d\xb1\xaa\xfa\x80\x46\x35\x3a\xc7\x37\xb8\xe4\x92\x5b\xae\xe4\x8b\x0a\x2d\x2b\x98\x65\xb3\x17\x00\x4c\x4a\x65\x19\xbd\x36\xf4\x13\x20\x57\xd2\x6a\x25\x04\xea\xa4\x44\x99\xde\x37\x19\x66\x0d\x17\x05\x6a\xb7\x78\xdc\xfa\xe1\xdb\xf4\xfb\xf4\xd5\x0b\x80\x5c\xa3\x9b\x7e\xcb\x2b\x34\x96\x55\xf5\x0c\x64\x23\xc4\x0b\x00\xc9\x2a\x9c\x81\x12\x55\xae\xe4\x92\x97\x26\x55\x35\x6a\x66\x95\x36\x69\xae\x34\x2a\xfa\x5f\xf5\xc2\xd4\x98\xd3\xce\xa5\x56\x4d\x3d\x83\xc1\x31\x7e\xad\x48\x20\xb3\x58\x2a\xcd\xe3\x6f\x80\x84\x36\x71\x7f\x7b\xc6\xaf\xdf\x5f\xcd\xdd\x96\xee\x9d\xe0\xc6\xbe\xdb\x7c\xff\x9e\x1b\xeb\xbe\xd5\xa2\xd1\x4c\xf4\x89\x74\xaf\x0d\x97\x65\x23\x98\xee\x7d\x78\x01\x60\x72\x55\xe3\x0c\xe6\xa2\x31\x16\xf5\x0b\x80\x20\x8c\x40\x47\x12\x18\x7e\x38\x0f\x64\x99\x7c\x85\x15\x8b\x44\x02\xb1\x26\x2f\x6e\x2e\x3f\x7e\xb7\xd8\xfa\x00\x50\xa0\xc9\x35\xaf\xad\x13\x6d\x4b\x26\x70\x03\x0c\x74\x50\x20\xfd\x51\x2b\x69\x78\x26\x10\x96\x4a\x83\x27\xac\xd1\x5c\x96\x34\x27\xed\xad\x67\xd7\x44\xa9\xca\xfe\x82\xb9\xed\xbd\xd6\xf8\xd7\x86\x6b\x2c\xfa\x5b\x13\xe1\xd1\x20\x7a\xaf\x6b\x4d\x9a\xb0\x3d\x29\xfb\xa7\x67\x7e\x1b\xef\xb7\x78\x38\x21\x46\xfd\x38\x28\xc8\xf2\xd0\x80\x5d\x61\x14\x19\x16\x41\x3a\xa0\x96\x60\x57\xdc\x80\xc6\x5a\xa3\x41\xe9\x6d\x91\x5e\x33\x19\x18\x48\x61\x81\x9a\x26\x82\x59\xa9\x46\x14\xc4\xf8\x03\x6a\x0b\x1a\x73\x55\x4a\xfe\xb7\x76\x35\x03\x56\xb9\x6d\x04\xb3\x68\x2c\x70\x69\x51\x4b\x26\xe0\x81\x89\x06\xa7\xc0\x64\x01\x15\x5b\x83\x46\x5a\x17\x1a\xd9\x5b\xc1\x0d\x31\x29\x5c\x29\x8d\xc0\xe5\x52\xcd\x60\x65\x6d\x6d\x66\x67\x67\x25\xb7\xd1\xb9\x72\x55\x55\x8d\xe4\x76\x7d\xe6\xfc\x84\x67\x0d\xd9\xea\x59\x81\x0f\x28\xce\x0c\x2f\x13\xa6\xf3\x15\xb7\x98\xdb\x46\xe3\x19\xab\x79\xe2\x88\x95\xce\xc1\xd2\xaa\xf8\x5d\xd4\xa6\x39\xd9\x12\x9f\x57\x99\xb1\xa4\xce\x8d\x4f\xce\xa6\xf7\xca\x9a\xac\xdb\xdb\x8a\x9f\xee\x79\xe9\x44\x4a\xaf\x48\x2a\x1f\x7e\x5c\xdc\x76\xe6\xe4\xc4\xee\x25\xdc\x0d\x35\x9d\xb0\x49\x50\x5c\x2e\x51\xfb\x91\x4b\xad\x2a\xb7\x0a\xca\xa2\x56\x5c\x5a\xf7\x23\x17\x1c\xa5\x05\xd3\x64\x15\xb7\xc6\x19\x18\x1a\x4b\x7a\x48\x61\xee\xb0\x05\x32\x84\xa6\x2e\x98\xc5\x22\x85\x4b\x09\x73\x56\xa1\x98\x33\x83\x5f\x5d\xd4\x24\x51\x93\x90\xf8\x8e\x17\x76\x1f\x1a\x77\x27\xec\x38\x14\x40\x84\xaf\x51\xed\xb4\xde\xbc\xa8\x31\x27\x2d\x91\xd8\x68\x96\xf3\x61\x26\x7b\xee\x1e\x55\x93\x1e\xbb\xf9\xb8\x9b\x3a\x57\x2d\x0a\x87\xf7\x4c\xfc\xb1\x91\x85\xc0\x6b\xb7\x00\x19\xcb\xc0\xe0\x2d\xaa\x2f\xc6\xe7\x02\xd3\xe8\x98\x70\x92\x25\x4f\xcd\xdc\x90\x40\x20\x31\xc8\x2c\x5c\x4a\x63\x99\x10\x37\x82\x49\x6f\x4a\xac\xae\x05\x19\x54\x4b\x56\xf4\x55\xbf\xcc\xf5\xfb\x2b\x30\x4d\x5d\x2b\x6d\x0d\x64\x6b\x02\x0d\xd6\x08\xbb\x2d\x8a\x4e\x1c\x4c\x6b\xb6\x1e\xf8\xca\x2d\x56\x83\xfc\x6d\x71\xb8\xcd\x17\xf0\x82\x6c\x67\xc9\x91\x1c\x89\x88\x22\xd6\x82\x7f\x38\x96\x88\x8b\x0c\xc1\xac\x78\x5d\x63\x41\xac\x78\xc6\xcd\x10\x91\x7b\xb5\xe6\x9f\x61\x34\xee\x9e\xc4\x51\x31\xf8\x71\x9f\xda\xfd\x93\xfb\x28\xb5\xa0\x98\x35\xba\xc3\x96\x48\xe6\xfd\x39\xc0\x84\x50\x8f\x26\x2e\x94\x18\xff\x36\x2a\xd9\xe1\xb6\x57\x1e\x29\x32\x43\xa7\x60\x4e\x4e\x3e\x1f\x9e\xc1\x96\x4b\x12\x25\x3e\xa0\x5e\xfb\x78\x5e\xb3\x1c\xa7\x60\x9c\x19\xac\x9d\x59\x79\x60\xc6\x02\x1a\x29\xd0\x18\xc0\xa7\x5a\xf0\x9c\x5b\xb1\xf6\xe4\x60\x31\x2c\xeb\x4e\xde\x99\x52\x02\x99\x1c\x19\xe5\x93\x8c\xe3\xa4\xf1\x13\x8d\x8d\x0e\x7b\x71\x73\xe9\x27\xf7\x19\x4f\xe1\x96\x20\x90\x50\xac\xfb\xce\x0d\x60\x55\xdb\xf5\x21\x42\x07\xc0\xa7\x7b\x86\x30\x7f\x84\xcc\x08\xfe\x44\x14\x49\x75\x83\xc0\x2f\xa5\xe1\x29\xa1\xbc\x4f\x4b\xb4\x68\x12\x4a\xa0\x92\xe0\x74\x56\x55\x3c\xdf\x99\xe0\xfd\xe0\x17\x59\xb3\xfc\x9e\xf2\x40\xd5\xd8\x83\x08\xf3\xc7\xdd\x39\x91\x8d\xe0\xfb\x60\x79\x85\x51\xef\x0e\x2d\x1b\x37\x9a\xc2\x19\x0b\x7b\x4e\x41\x3d\xa0\xd6\xbc\x88\x31\x2e\x67\x96\x09\x55\xb6\x79\xe4\x89\x81\x24\xf1\x63\x13\x3f\x3d\xb1\x61\xb7\xa5\x60\x65\x0a\x8b\x26\x6b\xa9\x32\x2e\x43\xd8\x80\xae\xb0\x3c\x02\xb7\xf0\xc8\xed\xca\x6d\x12\x17\x5f\x6a\x56\xe1\xa3\xd2\xf7\x14\xaf\x86\x37\xe9\xf2\xeb\x71\x28\x1b\x51\x43\x8e\xda\x5e\x1a\xd3\xa0\x3e\x28\xcc\x79\x3b\xb4\xcd\x0a\xd1\xc0\x4a\x3d\xfa\x68\x83\xfa\x81\xe4\x43\x0b\xf2\x25\xa7\x04\xda\x39\xf0\x23\x66\x2b\xa5\xee\x3d\xd7\x17\x37\x97\x14\xf9\x79\x8e\x1e\xe1\x39\x2d\x57\x4c\xa3\x27\xda\x15\x72\x0d\xf3\xc5\xc7\xfe\x06\x14\xf2\xd5\xa3\xf4\x63\x75\x0a\x6f\xbc\xe2\x5c\x1a\x46\x80\x5e\xa2\x24\x41\x79\x85\xcd\x2f\xdc\x3e\x86\x97\xb2\x55\x56\x9f\x20\x6e\x0d\x8a\xe5\xb8\x94\x46\x91\xf4\x10\x18\x52\x89\x44\x59\xe0\x98\x43\x6d\x48\xf2\xc7\x30\xb8\xcf\x26\x91\xda\xbe\x0f\xbc\x8e\xac\x75\x10\xf4\x8f\xc1\x6e\xaa\x71\x7c\x4a\x70\xc5\xf6\xa0\xd5\xb6\x0d\x5c\xb4\x73\x7c\x32\xd8\xfb\x29\x1d\x0f\xf3\xc5\xc7\x13\xd3\x41\xaf\x8f\x6a\x2b\x25\x0a\xcf\xe2\xcd\x8f\x57\x80\x32\x57\x05\x16\xa4\xac\x10\xd5\xb9\x34\x16\x59\x11\x61\xc5\x60\xae\xd1\x4e\xc1\x34\xf9\x0a\x98\x89\xee\x20\xcd\x8a\x2f\x6d\x62\xbc\x05\x25\x39\x4b\x73\x6d\x3b\x0a\xc6\x81\xe8\x28\x91\xc1\x11\xd1\xd2\x3f\x09\xdc\xe3\x50\x56\x10\x9f\x63\x84\x4f\xcf\x3d\xae\xf7\x0f\xd8\x12\x3e\x05\x82\x7b\x5c\x93\xe1\x1b\x14\x54\xb4\x1c\x98\x7d\x44\x10\xf0\x8f\x2b\x2a\x7f\x0d\x29\x27\x3f\xf7\xa2\x80\xc6\x25\x6a\x94\x76\x30\xd9\xee\x30\x9e\xf0\xab\x50\xb9\xa1\x54\x3b\xc7\xda\x9a\x33\xc2\xbd\x07\x8e\x8f\x67\x04\x6f\x5c\x96\x09\x81\x5f\x12\x62\xf9\x99\xb3\xa1\xb3\xdf\xb9\xff\xc1\xed\xf5\x9b\x6b\x97\x31\x82\xb2\x2b\xd4\xd0\x18\x5c\x36\x02\x96\x1c\x45\x61\xd2\x5e\xc5\x38\x75\x41\x69\x0a\x0d\x2f\x5e\x6f\x67\xe4\x5f\x2c\x1f\x55\xfb\x3c\xf5\x57\xc9\x88\x72\x71\xbe\x5c\xc3\xe3\x0a\x1d\xc9\xce\x3b\x5a\x77\x51\xda\x21\x1b\xe9\xb3\x6a\x8c\xab\x60\x7c\x0d\x3b\x9c\x8c\x6d\x13\xbd\x3f\x05\x21\xe7\x7e\xb7\xdf\xba\xb6\xdc\xfa\x1d\xae\x63\x64\x24\x92\x36\x1c\xf1\x28\x1f\xde\x04\x66\xef\x9b\x29\x5c\xda\x13\x03\xbc\x94\x4a\x63\x41\x82\x90\xdb\x08\x62\x70\xaf\x15\x1f\xa5\x21\x4f\x25\x59\xe4\x45\x1b\x08\x8f\x66\x7d\x31\x30\xd9\x41\x9b\xec\x85\x55\x22\x13\x94\xec\x02\x1d\x01\x9b\xda\x48\x87\x48\x99\x03\x31\x70\x07\xcb\xc2\xf4\x34\x43\xcb\xd2\x16\xd4\xc8\x37\xc2\xec\x84\x66\x27\x7e\x5a\x42\xab\xff\x06\xf2\x71\x5b\x5e\x6c\x77\xe1\x8e\x92\xce\xf6\x54\x17\xb7\x59\x41\xba\x0f\x12\x88\x02\xf1\x40\xed\x72\xec\xdf\x00\x8a\xbb\xc2\xf2\xe6\x48\x38\x3d\x28\x0b\x37\xe0\x98\xf8\x7c\xbb\xae\x5b\x70\x1b\x4a\x3b\x7e\xf2\x29\xc7\x78\xa1\x70\x84\x5a\x50\x36\xd5\x38\x3f\x49\xb7\xc7\x9e\x31\x31\x5f\xd8\x19\xb2\x44\x66\x29\xab\x38\x98\xd3\xbd\x0d\x03\x5d\x47\x96\x71\xe9\x1d\x9c\x92\x71\xe2\x3f\xa6\x27\x2c\x13\xe8\x72\xad\xb8\xee\x57\x48\xa0\x0a\x6e\x68\x9b\xb9\xaa\x39\x16\xf3\xc5\xc7\x51\x65\x6f\xd0\xff\x66\x7b\x16\xb9\x6e\x63\xbc\x71\x86\x25\x89\xf2\x13\x03\x13\x3f\x88\x12\x94\x49\x64\xc4\xb7\x47\x84\xe8\x75\x84\xe1\x3a\xfe\xe9\xb2\x12\x26\x84\x2f\xc3\x99\x6c\xbf\xf8\xa2\xcd\x81\xa2\x65\xba\x44\xf2\x7c\x87\xf5\x3c\xef\x12\x1f\xef\x28\x24\x49\x37\xdf\xe7\x37\xd9\x1a\x18\x08\x5e\xae\xec\x23\xd2\x7f\x81\xcb\x02\x9f\xba\xa8\x30\x05\xc1\x32\xa4\x1d\x27\x4a\x54\x6d\x2f\x3b\x71\xc3\x26\x53\xa2\x03\x59\xbe\x8a\xb6\xb9\x41\xd0\x89\x09\xd4\xf4\x68\x48\xe1\x3f\x08\x73\x35\xa2\x24\x49\x14\x53\xa7\xc5\x47\x2e\x04\x68\x74\x4d\x75\xdf\x65\xe9\xc9\xc6\x4c\x9c\x50\xfc\x36\x61\xfd\xfd\x66\xbe\x2f\x14\x69\xac\x95\xb6\x57\xdc\x18\x2e\xcb\x1b\xd4\x15\xfd\xb5\x07\x80\x36\x94\xfb\x61\x64\x32\x54\xec\x1e\xcd\x66\x09\xe5\xb4\x41\x12\xc7\x27\xcc\x1b\x92\x79\x5b\x48\x45\x0c\xcb\x73\xd5\x48\x1b\x64\xc7\xf5\x96\x3a\x3d\xa5\xa1\x73\xa0\x1b\x81\x43\x73\xb9\x81\xca\x93\x43\xf6\x15\xec\x63\xa3\x5a\xf3\x41\xae\xed\x35\x2c\x95\xce\x78\x51\xa0\x9c\x82\x66\x21\x0b\x60\x12\x94\x14\xeb\x90\x3a\xb9\x5e\xa6\x27\xff\x91\x99\x6e\xc2\x97\xcb\xdc\xb8\xde\xea\x82\x17\x78\x51\xd7\x62\x34\x09\xd8\xc1\xf9\xde\x9c\x21\x09\xfb\xde\x9a\xe3\x75\xa3\x3d\xc3\x35\x18\x8b\xb5\xf1\x02\xf7\x9b\x27\x86\xaa\x59\x37\x23\xf4\x5e\x98\x0d\xb9\x9a\x0b\xa5\xd9\x3a\xa4\x71\xee\x1d\x54\x4c\xb2\x12\xb5\x77\x19\xd7\x24\xd6\x0f\xae\xc9\xa3\xe4\x52\x70\xda\xca\xad\x3d\x38\x65\xc9\xb8\xd7\x00\x11\xd1\x2b\x24\xfb\xc4\x93\x54\x73\x0f\x72\x7e\xbd\x2f\x13\x2e\xef\x56\xfc\x80\xd6\x37\x81\x0f\x22\xec\xe5\xc0\xa4\xed\xba\xaf\x64\x3a\x63\x25\x42\xae\x04\xa5\xf5\xe1\x6c\xa2\x2f\xfd\xaf\x00\xb8\x15\x7b\xba\x28\x8f\x8b\x87\x57\x6e\x28\x59\x3f\x55\xfb\x42\xc9\x12\xe6\xaa\xaa\x05\x5a\x74\xe5\xf6\x5b\xc6\x09\xb3\x36\xcd\x45\x53\x26\x59\xdb\x7e\x71\xbf\x86\x0c\xdd\xec\xcd\x13\x94\x58\xc1\xef\xb2\x1d\x8a\x4a\xae\x3b\x4c\x4b\xe1\x72\x09\x8d\x34\x94\x53\xed\x6c\xe8\xfc\x4a\xf0\x8a\x13\x00\x64\x6b\x22\x7c\x4e\x8e\xfb\xc5\x71\xba\x0a\x0b\x1c\x2b\xa6\x79\x84\x09\x62\xaf\x62\x4f\xbc\x6a\x2a\x90\x4d\x95\xa1\xde\xe1\x8d\xa4\xe3\x22\x4b\x9f\xb9\x7e\x9a\xf1\xaf\xfb\xc9\xe6\xd2\x62\xe9\xce\x08\x87\x9e\xa5\xd2\x15\xb3\x6e\xd4\x77\xaf\x46\xc6\x54\x5c\x12\x81\x33\x38\x1f\x1c\x10\xdb\x52\xc7\xa1\xf5\x75\x1c\x4d\x50\x2a\x7c\xe9\x8f\xd1\xe9\x43\xc0\x8a\x7d\xde\x47\x82\x87\x5a\x09\x9e\xaf\x43\x1c\x25\xe3\x20\x64\xa0\xd8\xc3\x65\xc1\x1f\x78\xd1\x30\xd1\x0f\x65\x7b\x65\x31\xd6\xa8\x87\xfd\xcd\xfa\x1d\x26\x7e\x8e\xfb\x0d\xba\x2d\xdf\x45\x16\xdd\x7e\x0c\xec\xa8\x25\x30\x77\xb6\x2b\xb0\xa7\xd7\x03\x3d\xd2\x03\xd9\xf0\x31\x6d\x89\xa4\xdb\x6d\x74\xd4\x71\x8d\x89\xfd\xc0\xe0\x9f\xff\x1f\xf0\xe0\x9f\x23\xbb\x04\x87\xa0\x62\x58\x70\xff\x37\x80\xd1\x67\x64\x3f\x6c\xf8\xe7\x18\xf0\x08\x5c\xef\x87\x10\xff\xb4\xe4\x3e\xb3\x80\x1b\x3a\x33\xa8\x58\x9d\xdc\xe3\x7a\x8f\x45\x1f\xf6\x8c\xb1\x93\x88\x8a\xd5\x3b\x33\x6a\xad\x9e\x06\x73\xaa\x0d\xcd\xde\xd0\xa8\xed\x30\xef\xa6\x3a\x8f\xa0\x5f\x56\x37\xae\x36\x98\x5f\x6c\xb5\xa8\x65\x38\x9e\xe2\x32\xf8\x41\x81\xb5\x50\xeb\x0a\xa5\xcf\xb9\x7a\xb5\xca\xa5\x35\x61\x55\x94\x0f\xf0\xc0\x34\xa7\x34\xff\xa5\x39\x05\xcb\xee\x5d\x2a\x95\x63\x81\x32\x47\x87\xe5\x60\x57\xca\x60\xaf\x78\x90\x8b\x15\x5f\xda\x88\xca\x9e\xe8\x29\x65\xb0\x54\xe2\x3f\x30\x2e\x68\xb5\xaf\x90\x7a\xac\xac\xad\x6f\xc6\xe4\xb8\x23\xcb\x7f\xbf\xbd\xbd\xf1\xf2\x0c\x6e\xf2\xcb\x87\xf7\x91\x09\xcf\x3c\x05\x0d\x1a\xd5\x5e\x0d\x98\x76\x52\x64\xc6\x7d\xfa\xd3\xcd\x87\xeb\xff\xfc\xaf\x2f\xce\x03\x5c\x37\xf3\xd7\x91\xbc\x38\x8e\xe6\xc5\x1e\xa2\x17\xcf\xa4\x5a\xaa\xe3\x49\xfe\x59\x6d\xd0\x9b\xab\xaa\x62\x89\xc1\x9a\xb9\x26\x44\xdb\x12\x58\x29\x63\x9d\x3b\x4d\xa1\x50\x95\xeb\x18\x90\x41\xcf\x2f\xdf\x7c\x70\xc5\x0b\x3c\xae\x78\xbe\xea\xf1\x19\xae\xd3\xc4\x4b\x1a\x86\x8a\xd0\x3e\x9f\x3f\x5f\x3f\x93\xc9\xe0\x47\xf3\x8b\xe3\x5a\x3b\x71\x74\xec\x5a\xbb\x4a\xbd\xd7\x97\x5d\x29\xd1\x9e\xf3\xdd\xfc\x78\x95\xf4\x1a\x9d\x1b\x6e\xea\xd2\x96\xd6\x15\x23\x9b\x8e\x98\xad\x83\x0b\x89\x3e\x29\xb8\x7d\xbf\x48\xdc\x65\xa1\x1c\x6b\x77\x5c\xe5\x04\xf4\x8c\x43\x9e\xc3\x69\x81\x87\xbe\x67\x9c\x11\x1d\x38\xa2\xd8\x3c\x24\x1e\x6c\x21\x0f\x4b\xb6\x2f\xca\xcd\x18\x36\xc9\x59\x38\x4a\x4d\x73\x6d\x27\xcf\x6e\x7e\x1e\x3a\xda\xd8\x49\xff\x86\xce\xb9\x7f\xc5\x19\xd3\x00\x39\xc6\x32\xdb\xec\x08\x79\xe4\x02\x8f\x1b\xdb\x5e\xe1\xf1\xbf\xbe\xf6\x25\x9e\x5c\x49\xdf\x6c\x1d\xb4\x84\xdf\xe6\x2a\xcc\x64\x1e\x37\xe9\x7a\x8d\x05\x5a\xc6\x85\xe7\x8f\xdc\x84\x99\x1a\x73\xdb\x16\x0b\x8d\xd6\xee\xde\x97\x65\x16\xdb\x3b\x7c\x17\x37\x97\x10\xaf\x9b\xa6\x90\x24\x09\xdc\xd2\x6b\x63\x75\x93\xbb\x44\x8a\x5c\x4c\x16\xe1\x30\xbf\xe0\xda\x5d\xc2\x33\xae\x3d\xcd\xa4\x67\x03\x7c\xc5\x11\x5a\x09\x35\xb3\x2b\x48\xbd\xa8\xd3\x4e\x14\x29\xc0\x5b\xa5\x01\x9f\x18\xa5\xb2\x53\x27\x06\x78\xab\x54\xd0\x90\xdf\xf0\xef\x8e\xd1\xb3\x33\xf8\xd0\x5e\x6e\x0b\x2d\x12\x83\xfa\x21\x74\xcc\x9d\xf7\x2f\x95\x3a\x31\x9b\x3c\xa5\x71\xf2\x3b\xa9\x1e\xe5\x10\x09\x6e\x4f\xa6\x71\x06\x77\x93\x8b\x18\x90\xef\x26\x53\xb8\x9b\xdc\x68\x55\x6a\x74\xbd\x28\x7a\x41\x20\x7c\x37\x79\x83\xa5\x66\x05\x16\x77\x93\xb8\xf4\x3f\xd7\xcc\xe6\xab\x2b\xd4\x25\xbe\xc3\xf5\x0f\x6e\xc1\x8d\x4f\x0b\x4b\x00\x5f\xae\x7f\xa8\x68\x4c\xfb\x8d\xf0\xfe\x76\x5d\xe3\x0f\x15\xab\x37\x5e\x5e\xb1\x7a\x63\xa1\x56\xad\x06\x3e\x7d\xae\xd0\xb2\x87\xf3\xb4\x53\xf5\x9f\xff\x62\x94\x9c\xdd\x4d\x3a\x9e\xa6\x8a\xd2\xef\xaa\xb6\xeb\xbb\x09\x6c\x50\x30\xbb\x9b\x38\x1a\xe2\xfb\x48\xf4\xec\x6e\x42\xbb\xd1\x6b\xad\xac\xca\x9a\xe5\xec\x6e\x92\xad\x2d\x9a\xe9\xf9\x54\x63\x3d\x25\x4f\xfd\xa1\xdb\xe1\x6e\xf2\x67\xb8\x93\x91\xe8\x5e\xd3\xc8\xc0\x3f\x26\xe3\x07\x03\xcf\xbb\x45\x25\x98\xb1\xb7\x9a\x49\xc3\xe3\x3d\xe5\xd1\xa1\x15\x1a\xc3\xca\xf1\xef\x1a\x99\x51\x63\x27\x7b\x49\xc0\x84\xd1\xcf\xc4\xcb\xe0\xc7\xc3\x70\xbf\xcb\xc3\x91\x55\xf3\xee\xc4\x08\x60\xf4\xc5\x5f\xb1\xf1\x49\x45\xb4\x0b\xdb\x8e\x26\x47\xd5\xaa\x72\xfe\x1f\xe0\xce\x2a\x60\xd2\xe9\x2d\x0d\xce\xed\x83\x6b\x86\x6d\x77\x15\x1a\x59\xa0\x16\x6b\x77\xda\xd6\x01\xcb\x8a\xc9\x12\x8b\x14\xa8\x08\x74\x01\x9a\x1b\x97\x79\xdc\x93\x83\x4d\x69\xa2\x84\xc6\xc4\x20\xe4\xe8\x6a\x57\x24\x60\xf1\x80\x10\x96\x71\xa7\x80\x39\x05\xeb\xb1\x34\xd8\x3f\x47\x85\xa1\x58\x51\x15\xcc\xa2\xbb\xb7\x33\xd6\x92\xf1\xc6\x71\xa4\xe0\xc3\x68\x7f\x15\x63\xd5\x54\xae\x21\xc1\x0a\x77\xea\xd1\x7e\x93\x05\xc5\x59\x62\x3a\xe2\x2d\xcb\x54\x63\x43\x11\x12\xf5\x10\x44\x1d\xee\x1f\x32\xe9\x2f\x98\x05\xb6\x9e\xc9\x7c\xc5\x9e\xde\xa3\x2c\xed\x6a\x06\xdf\xbd\xfa\xb7\xef\x7f\x3f\x32\xd0\x83\x26\x16\x3f\xb5\x05\xff\x91\x62\xd8\x9d\xd8\xbb\x6c\xec\xf8\x4c\xe3\x9d\xdb\xb4\xd7\x4d\x88\x9d\xa7\x9e\x05\x3d\xb2\xd0\xa0\x66\x06\x0b\x68\x6a\x92\xcb\x5b\xd7\x92\x32\x96\xc9\x1c\xa7\xc0\x97\xc3\x8b\xf1\x16\xdc\xc5\x1a\xce\x5f\x4d\x21\x0b\x22\xde\x85\xf5\x4f\x4f\x9f\xd3\x01\x92\xb9\x81\x3f\x4c\xb7\xe8\xe1\x06\x48\x55\x6a\xe9\x0c\xc7\xb7\xc1\x35\xfa\x30\x19\xaa\xc3\x81\x30\x89\x2d\xbd\x87\x14\x77\xa8\x19\xd0\x6b\x04\x7c\xff\x2f\xe3\xfa\x8d\x4d\x80\x6f\x47\x33\x55\x82\xb4\x23\xb5\xe9\x07\x77\x59\x02\x23\xe8\x2a\x35\xab\x2a\x66\x79\xde\x5d\xa1\xd5\x7d\xd3\xf6\x87\x29\x6e\x22\xc5\xfd\x0d\x29\x9e\x98\x80\x43\x3d\x63\xbf\xd1\xaa\x68\x72\xd4\x2e\x3a\xb7\xa7\x77\x3d\x80\x5a\xd7\xe8\xbd\xc1\xdf\xca\x00\x7c\xaa\x7d\xcd\xe2\xef\xf0\xfb\x6b\xfe\xc8\x24\x97\xa5\x09\x5b\x72\xe3\x01\xc4\x47\xe3\xfe\xb5\x8f\x38\x47\x3b\xaa\x0c\x2f\x50\x53\xf5\x03\x65\xc3\x34\x93\x16\xd1\x5d\x8d\xf3\x57\x3d\xfd\xbd\xfa\x0e\xf2\x58\x77\x9b\x3d\x7a\xa3\x77\xd5\x78\x10\xb2\x8e\xc5\xd5\xf3\xaf\x84\x6e\xb8\xea\xf9\xb7\xaf\xf6\xaa\xbc\x1d\x37\xde\x6f\x64\xd6\xa2\x96\x33\xf8\x9f\x4f\x17\xc9\x7f\xb3\xe4\x6f\x9f\x5f\x86\x3f\xbe\x4d\xfe\xf0\xa7\xe9\xec\xf3\x37\xbd\x9f\x9f\x4f\x5f\xff\xd3\xc8\x4a\xc3\x09\xf4\x88\xf9\x84\x20\x12\x93\xc8\xa8\xd1\x69\x2c\xc4\x6e\x75\x83\x53\x78\xcb\x84\xc1\x29\xfc\x22\x5d\x68\x78\xa6\xd0\xf6\x9f\xe5\x53\x54\x9e\xd0\xae\xc3\xc9\x47\x3b\xc4\x91\xb4\x7f\x4c\x20\x77\x5f\xc9\x78\x9c\x90\x6c\xb8\xe1\xd0\x43\x9a\xde\xbf\x9a\x70\x97\x93\xc8\x91\x54\x1a\xd2\xdf\x34\x57\xd5\x59\xef\x5f\x55\x50\xde\x7d\xc5\xe4\x1a\x3a\x58\xf3\xc9\xea\xb6\xa5\x1b\x4b\xd8\xc4\x72\xad\x8c\x69\xcb\x16\x03\x82\xdf\x23\xb4\x19\xad\x07\xcb\x0c\x73\xe6\x12\x75\x9d\x71\xab\x99\x5e\xf7\xea\x12\xc8\x99\x0c\xfd\x83\x65\x23\xe0\xa5\x41\x84\x54\xaa\x02\x77\xd1\xf5\xd4\x63\x28\xcb\xb8\xe0\xd6\x5d\x96\x2b\x30\x1e\xfc\xb9\xfa\xa0\xaa\x95\xb6\x4c\x5a\xef\x6e\x1a\x4b\x7c\x02\x6e\xa1\xa2\x9c\x13\x5d\xe9\xf5\xb2\x90\xe6\xfc\xfc\xd5\x77\x8b\x26\xf3\x4d\x8e\xb7\x95\x3d\x3b\x7d\xfd\xf2\xaf\x0d\x13\x84\x3c\x05\xd5\x89\x6f\x2b\x7b\xfa\xdb\x85\xc5\xf3\xef\x8f\xf0\xa2\x97\x9f\xbc\xaf\x7c\x7e\xf9\x29\x09\x7f\x7d\x13\x5f\x9d\xbe\x7e\x79\x97\xee\xfd\x7e\xfa\x0d\xf1\xd0\xf3\xc0\xcf\x9f\x92\xce\xfd\xd2\xcf\xdf\x9c\xbe\xee\x7d\x3b\x8d\xce\xe8\xe3\xd4\x0c\xac\x6e\x62\xd2\x62\xac\xd2\x94\xa4\x6c\xbc\x6b\xb2\x56\xbd\x9d\x11\x06\xcf\x85\xbf\xff\xe3\xc5\xff\x06\x00\x00\xff\xff\x07\x3b\x8e\x97\x54\x38\x00\x00")
 
 func operatorsCoreosCom_olmconfigsYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -185,7 +185,7 @@ func operatorsCoreosCom_operatorconditionsYaml() (*asset, error) {
 	return a, nil
 }
 
-var _operatorsCoreosCom_operatorgroupsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\xe9\x6f\x1b\x37\x16\xff\xee\xbf\xe2\x41\x5d\x20\x76\x56\x1a\xc5\xee\x22\xdb\x0a\x08\x02\x23\x69\x0a\x6f\x73\x18\xb1\xdb\x0f\x6b\x7b\xb7\xd4\xcc\xd3\x88\x35\x87\x9c\x92\x1c\xdb\x6a\x90\xff\x7d\xf1\x1e\x39\x87\x4e\xcb\x49\xda\xed\x2e\xa4\x2f\xf6\x0c\x8f\x79\xe7\xef\x1d\xa4\x28\xe5\x4f\x68\x9d\x34\x7a\x04\xa2\x94\x78\xe7\x51\xd3\x93\x4b\xae\xbf\x71\x89\x34\xc3\x9b\xc3\xbd\x6b\xa9\xb3\x11\xbc\xa8\x9c\x37\xc5\x7b\x74\xa6\xb2\x29\xbe\xc4\x89\xd4\xd2\x4b\xa3\xf7\x0a\xf4\x22\x13\x5e\x8c\xf6\x00\x84\xd6\xc6\x0b\x7a\xed\xe8\x11\x20\x35\xda\x5b\xa3\x14\xda\x41\x8e\x3a\xb9\xae\xc6\x38\xae\xa4\xca\xd0\xf2\xe6\xf5\xa7\x6f\x9e\x24\x4f\x93\xa3\x3d\x80\xd4\x22\x2f\x3f\x97\x05\x3a\x2f\x8a\x72\x04\xba\x52\x6a\x0f\x40\x8b\x02\x47\x60\x4a\xb4\xc2\x1b\x9b\x5b\x53\x95\x2e\xa9\x1f\x5d\x92\x1a\x8b\x86\xfe\x14\x7b\xae\xc4\x94\xbe\xce\x73\xda\x25\x73\x73\xc2\x7e\x35\x91\xc2\x63\x6e\xac\xac\x9f\x01\x06\x60\x54\xc1\xff\x07\xe6\xdf\xc5\x3d\xbe\xa7\x2d\xf9\xbd\x92\xce\xff\xb0\x3c\xf6\x5a\x3a\xcf\xe3\xa5\xaa\xac\x50\x8b\x04\xf3\x90\x9b\x1a\xeb\xdf\xb6\x9f\xe7\xcf\xe5\x61\x48\xea\xbc\x52\xc2\x2e\xac\xdb\x03\x70\xa9\x29\x71\x04\xbc\xac\x14\x29\x66\x7b\x00\x51\x7c\x71\x9b\x41\x14\xd1\xcd\x61\xdc\xd5\xa5\x53\x2c\x44\xfd\x0d\xa0\x2d\xf5\xf1\xe9\xc9\x4f\x5f\x9f\x2d\x0c\x00\x64\xe8\x52\x2b\x4b\xcf\xca\x98\x63\x08\xa4\x03\x3f\x45\xa8\xb4\xf4\x60\x26\x50\x54\xca\x4b\x8f\x5a\xe8\x74\x06\x13\x63\xe1\xdd\xeb\x37\x50\x08\x2d\x72\xcc\x3a\xa2\x86\x13\x4f\xba\x77\xde\x0a\xa9\xc3\x0e\x52\x3b\x2f\x94\x62\xf5\xd2\x4e\xcd\x64\x90\x1a\xa4\x77\x41\x23\xc4\x1b\x78\x03\x02\x48\x8d\x72\x22\x31\x03\x87\xfc\x69\x2f\x6c\x8e\xbe\x9d\xe6\x92\x0e\x07\x7e\x46\xe2\x31\xe3\x5f\x30\xf5\x9d\xd7\x16\x7f\xad\xa4\xc5\xac\xcb\x2c\x89\xaa\x36\xda\xce\xeb\xd2\x12\x45\xbe\x63\x05\xe1\xd7\x71\x91\xb9\xf7\x0b\x52\x7b\x44\xa2\x0d\xf3\x20\x23\xef\xc0\xc0\x76\x54\x12\xb1\xc1\x62\x67\x4e\xa6\xd2\x81\xc5\xd2\xa2\x43\xed\x1b\x89\x08\x1d\x19\x48\xe0\x0c\x2d\x2d\x24\x5b\xa9\x54\x46\xa2\xbc\x41\xeb\xc1\x62\x6a\x72\x2d\x7f\x6b\x76\x73\x24\x2b\xfa\x8c\x12\x1e\x9d\x07\xa9\x3d\x5a\x2d\x14\xdc\x08\x55\x61\x1f\x84\xce\xa0\x10\x33\xb0\x48\xfb\x42\xa5\x3b\x3b\xf0\x14\x97\xc0\x1b\x63\x49\x3b\x13\x33\x82\xa9\xf7\xa5\x1b\x0d\x87\xb9\xf4\x35\x00\xa4\xa6\x28\x48\xf9\xb3\x21\xfb\xb2\x1c\x57\xa4\xb3\x61\x86\x37\xa8\x86\x4e\xe6\x03\x61\xd3\xa9\xf4\x98\xfa\xca\xe2\x50\x94\x72\xc0\xc4\x6a\x06\x81\xa4\xc8\xbe\xb2\x11\x32\xdc\xa3\x05\xf1\x05\x95\x39\x6f\xa5\xce\xe7\x86\xd8\xe7\x36\xca\x9a\x3c\x8f\x2c\x53\xc4\xe5\x81\x97\x56\xa4\xf4\x8a\xa4\xf2\xfe\xbb\xb3\x73\xa8\x09\x08\x62\x0f\x12\x6e\xa7\xba\x56\xd8\x24\x28\xa9\x27\x68\xc3\xcc\x89\x35\x05\xef\x82\x3a\x2b\x8d\xd4\x9e\x1f\x52\x25\x51\x7b\x70\xd5\xb8\x20\xa3\x25\x03\x43\xe7\x49\x0f\x09\xbc\x60\xfc\x83\x31\x42\x55\x66\xc2\x63\x96\xc0\x89\x86\x17\xa2\x40\xf5\x42\x38\xfc\xdd\x45\x4d\x12\x75\x03\x12\xdf\xf6\xc2\xee\xc2\xf7\xf2\x82\x25\x87\x02\xa8\xe1\x75\xad\x76\xe6\xf0\xe3\xac\xc4\xb4\xc6\x10\x5a\xc9\x98\x21\xf4\x02\xc8\xd4\x2a\x4a\xb6\x25\x62\xbd\xbb\x32\x89\xa8\x30\xf5\xc6\x2e\x8f\x2c\x90\x7a\x16\x27\xc6\x15\x81\xcc\x39\xd2\x1e\xb9\xcd\xb8\xb3\x05\xa5\xf7\x51\xcb\x5a\x10\x3e\x9d\x7e\x77\x47\x36\xd9\x81\xf4\x7b\xa8\x5f\x5c\x14\x3c\x82\x22\x13\xa1\x89\x12\x63\x54\x8d\x28\x6a\x24\x2c\x82\xc9\x9f\x4f\x71\xee\x0d\x08\x8b\x70\xfc\xf6\x25\x66\xab\x98\x6b\x19\x14\xd6\x8a\xd9\x9a\x19\xd2\x63\xb1\x96\xf0\x05\xd2\x8f\x37\x90\x17\x1d\xbb\x1e\xf1\x53\xc1\xb1\xc4\x73\x24\x09\xa0\xd5\x07\x01\xd7\x38\x0b\xf8\x46\xb0\x19\x55\x16\x26\x5b\x64\x34\x64\x65\x5e\xe3\x8c\x27\x45\xb0\x5b\x4b\xdd\x3d\xfa\x0b\xbf\xd5\xd1\x64\xfe\x37\xa0\x4f\x6e\x1c\xaf\x89\x5d\x3b\xe9\x3e\x63\x09\xbf\x6b\x9c\x6d\x1a\x5e\x10\x38\xc9\x21\xba\x61\x90\x3c\xbd\x60\x69\xb1\x67\xd6\xc2\x16\x65\xa9\x24\x32\x9a\x6d\xdc\x7b\x2d\x9c\xcc\xff\x6a\x56\x1f\x40\x68\xa3\xca\x16\xa1\x83\xb2\x1f\xb9\xa0\x58\xb2\xf4\xa9\x2c\x63\x92\x10\x52\x83\x3a\x94\xfd\x24\x94\xec\xa4\x21\x6c\xd5\x27\xba\x0f\x6f\x8d\xa7\x3f\xdf\xdd\x49\x82\x6a\xb2\x87\x97\x06\xdd\x5b\xe3\xf9\xcd\x17\x61\x35\x90\xf0\x00\x46\xc3\x02\x36\x76\x1d\xfc\x8a\x38\xe9\xc6\x33\x4a\xa3\x26\xac\x9f\x46\x28\xd2\x51\x44\x31\xb6\xe6\x88\x33\x8c\xb0\x51\xd8\xa2\xa8\x1c\x07\x20\x6d\xf4\x00\x8b\xd2\xcf\x56\xee\x11\x05\x61\xec\x9c\x1c\x36\x6c\x17\xb7\x3a\xa7\xb8\x18\x46\x42\x06\xa3\x28\x15\x85\xac\x62\xa2\x39\x1a\x53\x2e\x2d\x53\x28\xd0\xe6\x08\x25\x21\xd4\x36\xe2\xdd\x84\x2b\xe1\x77\x0f\xba\x6c\xa9\x2b\x86\xcc\xd7\xe4\x00\x0f\x80\xd8\x30\x3f\xc0\x52\x21\x4a\x52\xd3\x07\x42\x1f\x96\xd4\x47\x28\x85\xa4\x8c\xf7\x98\xb3\x77\x85\x73\x63\x52\xb3\x4c\xbb\xdb\xd0\x0e\xd2\x01\x41\xc9\x8d\x50\x84\x77\x64\xc9\x1a\x50\x05\xf4\xa3\x24\x7b\x01\xd8\xfb\x70\x3b\x35\x2e\x80\xd9\x44\xa2\xe2\xdc\xa7\x77\x8d\xb3\x5e\x7f\x49\xb5\xbd\x13\xdd\x0b\xb8\xb8\xa4\xcc\x06\x44\x8d\x56\x33\xe8\xf1\x58\xef\xd3\x63\xc1\x46\xb0\x14\x59\xc6\xe5\xa1\x50\xa7\x5b\xa0\xd9\x46\xbd\x39\xb4\x37\x32\xc5\xe3\x34\x35\x95\xe6\xc2\x69\x8b\xb8\xbe\xb8\xa4\x06\x3f\x91\x15\x52\xcf\xd5\x16\x3c\x13\x44\x98\x0a\xb7\x53\x99\x4e\xe1\x56\x2a\xc5\x69\x9c\xc3\x8c\xd4\x93\x61\xa9\xcc\xac\x91\xf3\xbe\x3b\x08\x9a\xa5\x7c\xb2\x96\x3d\x57\x6a\xeb\x53\x83\x75\xcc\x51\xfa\x9f\x9e\x5a\x73\x23\x33\xcc\x8e\x4f\x4f\x56\x4a\x69\x9e\x39\x5e\x02\x1e\x95\x72\x5c\x7e\x51\xce\xe9\x4d\xcc\x39\x57\xa6\x30\x65\x67\xff\x4e\x91\xbe\x96\xd8\xb1\x31\x0a\xc5\xf2\x78\x48\x85\x9a\x22\xf4\x7e\x5a\xcf\x17\x16\x44\xb8\xc3\xbb\x52\xc9\x54\xfa\x1a\xbf\xdb\xdc\x8a\xeb\x19\x5e\xc4\xc0\x25\x39\x1b\x70\xe8\xfb\x6d\xae\x26\x1d\xc8\x5c\x1b\xbb\xda\x3e\x37\xe3\xc9\x06\x14\xb9\x07\x3b\xee\x06\xd7\xd5\x18\xad\x46\x8f\x6e\x40\x39\xd6\x20\x2e\xc0\x85\xf4\xd8\x0b\x5f\x2d\x7d\x62\x43\x82\xcc\xf3\x9b\x14\x39\x3c\xad\x4a\x92\xdf\x3f\x3c\x47\x5e\x9f\xaf\x0c\x40\x09\xe7\x7f\x0c\x55\xca\x03\x32\xeb\xd4\xe8\xe0\xd7\xf7\xab\xfe\x45\x33\x75\x31\xc6\xad\xb2\xd0\x76\xe3\x2f\xaa\xd4\x39\x8a\x7a\x0d\x49\x2d\x14\x66\xe8\x85\x54\x41\xe2\x46\x23\x08\x82\x06\x5f\x53\x99\x56\xd6\x72\xb5\xe7\xc9\xb3\xea\xca\xfd\xf8\xf4\x04\x1a\x6d\xc0\x60\x30\x08\x71\xd1\x79\x5b\xa5\x6c\xaf\x54\x85\xeb\x0c\x33\xde\x35\x93\x96\x4b\x6f\x47\x9b\xb7\x72\x88\x99\x57\x80\xf3\x52\xf8\x29\x24\x41\xf9\x49\x47\x14\x00\xaf\x8c\x05\xbc\x13\x45\xa9\xb0\xcf\x62\x80\x57\xc6\x44\x9b\x09\x1f\xfc\xc0\x8c\x0e\x87\xf0\xbe\x4d\x98\x38\x28\x8c\x09\xdb\x42\xbe\xc4\xdd\x05\x98\x18\x43\x92\xee\xf2\x94\xd4\x8b\x7f\xd0\xe6\x56\xaf\x22\x81\xbf\x29\x2c\x8e\xe0\xb2\x77\x7c\x23\xa4\x12\x63\x85\x97\xbd\x3e\x5c\xf6\x4e\xad\xc9\x39\x44\xe9\xfc\x32\xc6\x9c\xcb\xde\x4b\xcc\xad\xc8\x30\xbb\xec\xd5\x5b\xff\x95\xb3\x80\x37\x94\x10\xfc\x80\xb3\x67\xbc\xe1\xdc\xd0\x59\xc8\x1a\x66\xcf\x42\xd2\x50\x8f\x91\x93\x9d\xcf\x4a\x7c\x46\x11\xb3\xfb\xf2\x8d\x28\xe7\x36\xea\x58\xda\xc5\x15\xd5\xb3\x37\x87\x49\xab\xea\x9f\x7f\x71\x46\x8f\x2e\x7b\x2d\x4f\x7d\x53\x90\xc9\x94\x7e\x76\xd9\x83\x39\x0a\x46\x97\x3d\xa6\xa1\x7e\x5f\x13\x3d\xba\xec\xd1\xd7\xe8\xb5\x35\xde\x8c\xab\xc9\xe8\xb2\x37\x9e\x79\x74\xfd\xc3\xbe\xc5\xb2\x4f\x00\xf6\xac\xfd\xc2\x65\xef\x67\xb8\xd4\x35\xd1\xc6\x4f\xd1\x06\x4d\x3b\xf8\xd8\xdb\x80\x3e\x1b\x42\xea\x7d\xb5\x47\xf0\xe8\x73\x2b\xb4\x93\x75\x07\x75\xed\xd4\x02\x9d\x13\xf9\xfa\x71\x8b\xc2\xad\x0c\x0f\x61\x38\x58\xc9\xda\x61\xe2\x65\xe5\xe0\xfd\x85\xcd\x32\x0f\x5b\x16\x94\xcb\x0b\xdb\x72\xc7\x79\xf0\xf4\x82\x3d\xba\xb1\x0b\xdf\xcc\x26\x47\xb5\xa6\x60\xff\x8f\x00\xcc\x29\x19\xeb\x2d\x26\xbd\xb1\x11\x37\x46\xb8\x9d\xa2\x8e\x2d\xd1\x0c\xad\x9a\x51\xe6\xdb\xee\x9a\x4e\x85\xce\x31\x4b\x20\xa4\xdd\x82\xf1\x80\x02\xf4\x35\x39\x18\xa7\x6b\x1a\x2a\x57\x37\xa8\x98\xae\x66\x47\x02\x96\x00\x08\x71\x1b\x46\xce\x34\xc5\xd2\x93\xd7\xdd\x57\xbd\xde\x53\xa3\x4c\x8c\x2d\x84\x1f\x01\x61\xfe\xc0\xaf\x37\x8f\x68\x1c\x5b\x0a\x3e\xce\x0e\xd9\xf1\xb4\x2a\x84\x26\xeb\xc9\x88\xde\x76\x4c\x67\x32\x15\xdc\x95\xab\xf1\x56\x8c\x4d\x15\x10\xb0\xd5\x43\x14\x75\x21\x66\x24\x67\x4a\x13\xc8\x47\x23\x5b\x9f\xc9\x7c\x21\xee\x5e\xa3\xce\xfd\x74\x04\x5f\x1f\xfd\xfd\xe9\x37\x6b\x26\x06\xd0\xc4\xec\x7b\xd4\x14\x9f\x56\x34\x7d\xd7\x88\x61\x79\x61\xb7\x80\x25\x3e\x93\xba\xd3\x96\xe4\xed\x9c\xa6\x02\x6f\x2d\xe8\x56\x70\xc2\x03\x63\x41\xc9\x67\x55\x92\x5c\x28\x0a\x70\xff\x5c\xa7\xd8\x07\x39\x59\xbd\x99\x6c\xc0\x5d\xcd\xe0\xf0\xa8\x0f\xe3\x28\xe2\x65\x58\xbf\xb8\xbb\x4a\x56\x90\x2c\x1d\x7c\xdb\x5f\xa0\x87\x72\xdc\x8a\x23\x22\xa7\x97\xb7\xd2\x4f\xc1\x62\x08\x93\xb1\xf9\xbc\x22\x4c\x62\x43\xef\x7d\x8a\xa3\x60\x99\xe3\xfa\x6e\x48\x6d\xb6\x52\xfb\xa7\x7f\x5b\xaf\x5f\xa9\x65\x51\x15\x23\x78\xb2\x66\x4a\x80\xb4\x2d\xb5\x19\x26\xb7\x59\x82\x20\xe8\xca\xad\x28\x0a\x4e\xbd\x65\x86\xda\x53\xfd\x60\xbb\xa6\xed\xb9\x8e\xe2\x85\x13\x6e\x45\x75\xa4\xf8\xc8\x45\x1c\xea\x18\xfb\xa9\x35\x59\x95\xa2\xe5\xe8\x1c\x2b\x92\xb4\x0b\x50\xb3\x12\x83\x37\x84\xf3\x04\xca\x9a\x31\xf5\x4d\xe7\x3e\x34\xf7\x51\x68\xa9\x73\x17\x3f\x29\x5d\x00\x90\x10\x8d\x6f\xa7\xc8\xa1\x67\xae\x12\x64\xaa\x9c\xcc\xd0\x62\x06\x02\xf2\x4a\x58\xa1\x3d\x62\x46\xf0\x13\xaa\xc1\xd0\x4d\x6f\x21\x4f\xb4\x3d\xec\xda\x1b\x83\xab\x06\xb0\x22\x12\x63\xdf\x3b\xf4\x09\xbe\x98\xab\x1e\x3e\x39\xda\xa8\xf2\x66\xde\xfa\x5e\x9a\xf0\x1e\xad\x1e\xc1\xbf\x2e\x8e\x07\xff\x14\x83\xdf\xae\xf6\xe3\x3f\x4f\x06\xdf\xfe\xbb\x3f\xba\x7a\xdc\x79\xbc\x3a\x78\xfe\x97\x35\x3b\xad\x4e\xeb\xd7\x98\x4f\x0c\x22\x75\x12\x59\x6b\xb4\xcf\x11\xc6\x4c\xe0\xdc\x56\xd8\x87\x57\x42\x39\xec\xc3\x8f\x9a\x43\xc3\x67\x0a\x0d\x75\x55\x6c\x6e\x4b\xf6\xe8\xab\xab\x93\x8f\x66\x0a\x93\xb4\x79\x4e\x24\x77\x53\x67\x60\x3b\x21\x71\xfa\x66\x26\x5d\xa4\xe9\x9c\x95\x00\x23\x1e\xa5\xac\x49\x4c\x7f\x93\xd4\x14\xc3\xce\x59\x0a\xe5\xdd\x6f\x84\x9e\x41\x0b\x6b\x21\x59\x5d\xb4\x74\xe7\x09\x9b\x44\x6a\x8d\x73\xcd\x49\x83\x03\x25\xaf\x11\x9a\x8c\x36\x80\xe5\x18\x53\xc1\x89\xba\x1d\x4b\x6f\x85\x9d\x75\xea\x12\x48\x85\x8e\x3d\x81\x49\xa5\x60\xdf\x21\x42\xa2\x4d\x86\xcb\xe8\x7a\x10\x30\x54\x8c\xa5\x92\x7e\x16\x1a\x08\xa9\xd1\x13\x25\x63\x7d\x50\x94\xc6\x7a\xa1\x7d\xdd\x7c\xc9\xf1\x8e\x4a\x5d\xee\xfb\x84\x22\x79\x3f\xd3\xee\xf0\xf0\xe8\xeb\xb3\x6a\x9c\x99\x42\x48\xfd\xaa\xf0\xc3\x83\xe7\xfb\xbf\x56\x42\x71\xe7\x82\x6a\xea\x57\x85\x3f\xf8\x72\x61\xf1\xf0\xe9\x16\x5e\xb4\x7f\x11\x7c\xe5\x6a\xff\x62\x10\xff\x7b\x5c\xbf\x3a\x78\xbe\x7f\x99\x6c\x1c\x3f\x78\x4c\x3c\x74\x3c\xf0\xea\x62\xd0\xba\x5f\x72\xf5\xf8\xe0\x79\x67\xec\x60\xd9\x19\x3b\x55\xeb\xbd\x05\xe8\xeb\x76\x6e\xc8\x4e\x7c\x7d\xa9\xa0\xf6\xcc\xf9\xd4\x70\xb1\x24\x8d\x5e\x4c\xf1\x38\x6e\xf3\xe0\xee\xce\x36\x49\x97\xde\xbe\x9b\x32\xdf\x47\x09\x8d\xfb\xd5\x47\xe3\x4d\x04\x9a\x63\xea\xcf\xd8\x2f\x81\xa5\x0e\xdf\x7b\x9c\x3c\xb0\xc1\xf7\x1e\x27\x60\x71\x82\x16\x75\x8a\xb5\x60\xe6\xfb\x7a\xf1\xd8\xb7\x69\xfc\xfd\x0e\x67\x78\xeb\x2f\x0a\xac\x64\x81\x92\xfd\x78\x39\xa0\xb6\xc7\xc8\xc3\xda\x03\x89\x7b\x5d\x9a\xe3\xf1\xa9\xf0\xd3\xad\x28\x78\x74\x12\xc5\xc6\xdd\x7b\x3e\x4f\x29\x25\xa6\x38\x77\x17\x81\xf3\x38\x14\x59\x7c\x49\x89\x8f\xc5\x38\xd6\x0f\x19\x47\x3c\xb3\x68\xef\x2a\x50\xd2\x04\x82\x80\x58\x66\xf0\x8f\xb3\x77\x6f\x87\xdf\x9b\x98\x2b\x50\x35\xe3\x82\x6f\x71\xb7\xb9\x0f\xae\x4a\xa7\x20\x1c\x91\x46\xf5\xed\x19\xb7\x25\x0a\xa1\xe5\x04\x9d\x4f\xe2\x6e\x68\xdd\xc5\xd1\x55\x32\xdf\x0e\x91\xf1\x60\xa3\x3e\xd1\x8f\x06\xc0\xbe\x41\xcc\x34\x6b\x39\x69\x65\x92\x4a\x93\x45\xa2\x6f\x99\x58\x2f\xae\x11\x4c\x24\xb6\x42\x0e\x0a\x23\xe8\x91\x99\x74\x3e\xfd\x81\x1c\xeb\x63\x0f\xf6\x6f\xa7\x68\x11\x7a\xf4\xd8\x0b\x1f\x6c\x2e\x60\xd0\xbb\x4e\xc4\x8f\x1f\x0e\xf9\xbd\x95\x79\xce\xe9\x16\xdf\x26\xb8\x41\xed\x0f\x38\xbe\x4d\x40\x9b\xce\x64\x1d\xfb\xd4\x6d\x77\x7a\x91\x90\x8b\xa3\xab\x1e\xec\xcf\xf3\x45\x29\x28\xde\xc1\x51\xd3\x91\x2e\x4d\x76\x50\x57\xad\x33\xed\xc5\x1d\x17\x06\x53\xe3\x50\x87\xce\xbf\x37\x30\x15\x37\x08\xce\x50\xf1\x89\x4a\x0d\x42\x82\x99\xc1\x6d\x68\xd0\xd5\xa2\x0c\x87\x3a\xa5\xb0\x7e\xe1\x7a\xca\xf9\xbb\x97\xef\x46\xe1\x6b\xa4\xb6\x5c\xd7\x55\xee\x44\x6a\xa1\xe2\xe9\x43\x93\x1f\x12\x21\x55\x50\x92\x37\xb1\xb4\xad\x4f\x46\x26\x95\xaf\x2c\x26\x8b\xd7\x15\xb6\xb6\xf8\x55\x77\x45\x56\x1b\x3b\xdf\x19\x59\x74\xb4\xff\xe2\x8d\x8c\xad\x59\xd4\x6b\x4e\x3c\x96\x59\x7c\xdb\xb1\xc1\x8d\x2c\xb6\xd0\x4c\x5c\x66\x26\x75\xc4\x60\x8a\xa5\x77\x43\x73\x43\xd0\x89\xb7\xc3\x5b\x63\xaf\xa5\xce\x07\x64\x64\x83\xa0\x79\x37\xe4\x10\x33\xfc\x8a\xff\x7c\x16\x47\x1c\xa7\xb6\x67\x2b\x5c\x0c\xfb\x03\x78\xe3\xf0\x39\xfc\x64\xd6\xea\xfc\xf2\x21\x91\xe0\xd1\x59\x5d\xfc\x2d\xac\x26\x77\x09\x07\x52\xf1\xc6\x58\x07\xe1\x0a\x91\x05\x08\x14\x7a\xf6\xbb\x9b\x31\x09\x90\x6b\xfc\x74\x36\x88\x57\x3a\x07\x42\x67\x83\x26\xbf\x4e\x67\x9f\x2c\xb1\x4a\x6e\xe9\xc0\x3f\x9e\xbc\xfc\x63\x8c\xbb\x92\x0f\xf2\xd6\xd0\x45\x19\x81\xb7\x55\x9d\xdd\x39\x6f\xac\xc8\x71\xfe\x5d\x35\x6e\x8a\x8f\x96\xe1\x58\x57\xc2\x87\x8f\xfc\xaa\xbd\xc4\x29\x54\x39\x15\x47\xf5\xda\xdd\x55\xce\xdd\x55\xce\xdd\x55\xce\xdd\x55\xce\x8d\xc2\xde\x5d\xe5\xdc\x5d\xe5\xdc\x5d\xe5\xdc\x5d\xe5\xdc\x5d\xe5\xfc\x3c\x56\x77\x57\x39\x77\x57\x39\x77\x57\x39\x9b\xdf\xee\x2a\xe7\x96\xcc\xed\xae\x72\xfe\xd9\xaf\x72\xfe\x7f\x5f\xce\xdc\x1d\x8e\xfd\x6f\x1c\x8e\xed\x8e\xbb\x76\xc7\x5d\xbb\xe3\xae\xdd\x71\xd7\x27\x58\xfc\xee\xb8\x6b\x77\xdc\xb5\x3b\xee\xda\x1d\x77\xfd\x49\x8f\xbb\x26\x42\xb9\xad\xcf\xbb\xfe\x13\x00\x00\xff\xff\xf2\x1a\x66\xa5\x7c\x46\x00\x00")
+var _operatorsCoreosCom_operatorgroupsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5b\x7b\x6f\x24\x37\x8e\xff\x7f\x3e\x05\xd1\x7b\xc0\xd8\x73\xdd\xd5\xb1\x73\xc8\xed\x36\x10\x04\xc6\x4c\x26\xf0\x65\x1e\xc6\xd8\xc9\x01\x67\xfb\x6e\xd5\x55\xec\x6e\xc5\x55\x52\xad\xa4\xb2\x5d\x1b\xe4\xbb\x1f\x48\x49\xf5\xe8\xb7\x27\x33\xb9\xdc\xa0\xeb\x1f\xbb\x4b\x8f\x22\x29\x8a\xfc\x91\x94\x44\x29\x7f\x46\x63\xa5\x56\x13\x10\xa5\xc4\x47\x87\x8a\x7e\xd9\xe4\xee\xaf\x36\x91\x7a\x7c\x7f\xf2\xec\x4e\xaa\x6c\x02\x2f\x2b\xeb\x74\xf1\x01\xad\xae\x4c\x8a\xaf\x70\x26\x95\x74\x52\xab\x67\x05\x3a\x91\x09\x27\x26\xcf\x00\x84\x52\xda\x09\x7a\x6d\xe9\x27\x40\xaa\x95\x33\x3a\xcf\xd1\x8c\xe6\xa8\x92\xbb\x6a\x8a\xd3\x4a\xe6\x19\x1a\x9e\x3c\x7e\xfa\xfe\xab\xe4\x9b\xe4\xf4\x19\x40\x6a\x90\x87\x5f\xc9\x02\xad\x13\x45\x39\x01\x55\xe5\xf9\x33\x00\x25\x0a\x9c\x80\x2e\xd1\x08\xa7\xcd\xdc\xe8\xaa\xb4\x49\xfc\x69\x93\x54\x1b\xd4\xf4\xa7\x78\x66\x4b\x4c\xe9\xeb\xdc\xa7\x1d\xd2\xeb\xe3\xe7\x8b\x44\x0a\x87\x73\x6d\x64\xfc\x0d\x30\x02\x9d\x17\xfc\xbf\x67\xfe\x7d\x98\xe3\x07\x9a\x92\xdf\xe7\xd2\xba\x1f\x57\xdb\xde\x48\xeb\xb8\xbd\xcc\x2b\x23\xf2\x65\x82\xb9\xc9\x2e\xb4\x71\xef\xda\xcf\xf3\xe7\xe6\xbe\x49\xaa\x79\x95\x0b\xb3\x34\xee\x19\x80\x4d\x75\x89\x13\xe0\x61\xa5\x48\x31\x7b\x06\x10\xc4\x17\xa6\x19\x05\x11\xdd\x9f\x84\x59\x6d\xba\xc0\x42\xc4\x6f\x00\x4d\xa9\xce\x2e\xce\x7f\xfe\xfa\x72\xa9\x01\x20\x43\x9b\x1a\x59\x3a\x5e\x8c\x1e\x43\x20\x2d\xb8\x05\x42\xa5\xa4\x03\x3d\x83\xa2\xca\x9d\x74\xa8\x84\x4a\x6b\x98\x69\x03\xef\xdf\xbc\x85\x42\x28\x31\xc7\xac\x23\x6a\x38\x77\xb4\xf6\xd6\x19\x21\x95\x9f\x41\x2a\xeb\x44\x9e\xf3\xf2\xd2\x4c\x4d\x67\x90\x0a\xa4\xb3\x7e\x45\x88\x37\x70\x1a\x04\xd0\x32\xca\x99\xc4\x0c\x2c\xf2\xa7\x9d\x30\x73\x74\x6d\x37\x9b\x74\x38\x70\x35\x89\x47\x4f\x7f\xc1\xd4\x75\x5e\x1b\xfc\x47\x25\x0d\x66\x5d\x66\x49\x54\x51\x69\x3b\xaf\x4b\x43\x14\xb9\x8e\x16\xf8\xa7\xb3\x45\x7a\xef\x97\xa4\xf6\x9c\x44\xeb\xfb\x41\x46\xbb\x03\x3d\xdb\x61\x91\x88\x0d\x16\x3b\x73\xb2\x90\x16\x0c\x96\x06\x2d\x2a\xd7\x48\x44\xa8\xc0\x40\x02\x97\x68\x68\x20\xe9\x4a\x95\x67\x24\xca\x7b\x34\x0e\x0c\xa6\x7a\xae\xe4\x3f\x9b\xd9\x2c\xc9\x8a\x3e\x93\x0b\x87\xd6\x81\x54\x0e\x8d\x12\x39\xdc\x8b\xbc\xc2\x21\x08\x95\x41\x21\x6a\x30\x48\xf3\x42\xa5\x3a\x33\x70\x17\x9b\xc0\x5b\x6d\x68\x75\x66\x7a\x02\x0b\xe7\x4a\x3b\x19\x8f\xe7\xd2\x45\x03\x90\xea\xa2\xa0\xc5\xaf\xc7\xbc\x97\xe5\xb4\xa2\x35\x1b\x67\x78\x8f\xf9\xd8\xca\xf9\x48\x98\x74\x21\x1d\xa6\xae\x32\x38\x16\xa5\x1c\x31\xb1\x8a\x8d\x40\x52\x64\x7f\x31\xc1\x64\xd8\xe7\x4b\xe2\xf3\x4b\x66\x9d\x91\x6a\xde\x6b\xe2\x3d\xb7\x55\xd6\xb4\xf3\x48\x33\x45\x18\xee\x79\x69\x45\x4a\xaf\x48\x2a\x1f\xbe\xbf\xbc\x82\x48\x80\x17\xbb\x97\x70\xdb\xd5\xb6\xc2\x26\x41\x49\x35\x43\xe3\x7b\xce\x8c\x2e\x78\x16\x54\x59\xa9\xa5\x72\xfc\x23\xcd\x25\x2a\x07\xb6\x9a\x16\xa4\xb4\xa4\x60\x68\x1d\xad\x43\x02\x2f\xd9\xfe\xc1\x14\xa1\x2a\x33\xe1\x30\x4b\xe0\x5c\xc1\x4b\x51\x60\xfe\x52\x58\xfc\xec\xa2\x26\x89\xda\x11\x89\x6f\x7f\x61\x77\xcd\xf7\xea\x80\x95\x0d\x05\x10\xcd\xeb\xc6\xd5\xe9\xd9\x8f\xcb\x12\xd3\x68\x43\x68\x24\xdb\x0c\xa1\x96\x8c\x4c\x5c\xa2\x64\x5f\x22\x36\x6f\x57\x7a\xf0\x31\xcd\xab\x0c\xc9\x58\x5e\x08\x47\x3b\x62\x4d\xa7\x25\xaa\xbf\x5f\x1d\x03\x06\x0b\x7d\x8f\x4c\x7b\x6b\x75\xe0\x61\xa1\xad\x7f\x01\x85\x70\xe9\x02\x2d\x08\x55\xfb\x7d\x8d\x50\xc6\xc1\x8d\xfa\xf4\x58\x7d\x6e\xb7\xdb\xb1\x3e\xe7\xc2\x18\x51\xaf\x69\x95\x0e\x8b\xb5\x2c\x6d\x59\xe8\x8e\x60\x2e\x31\xc7\xd4\x69\xb3\xaf\x50\x62\xff\x20\x10\xbb\x2c\x11\xe9\xc0\x72\x97\x4f\xc3\xf5\xda\xf5\x86\x1d\x6b\x4e\x0f\x2f\xc7\xf7\x8f\xb4\xb3\x3b\x8e\x71\x07\x8f\xcb\x83\xbc\x5d\x21\xff\x4e\x4b\x9a\x8b\x29\xe6\x81\x3b\x16\x00\xfb\x93\xc2\x1b\x8e\xab\x05\xf6\xde\x80\x30\x08\x67\xef\x5e\x61\xb6\x8e\x39\xd8\xb9\xac\xb0\x7d\x69\x57\x48\x3f\xdb\x42\x5e\x30\x8f\xb1\xc5\x2d\x04\x7b\x64\xc7\xfe\xd8\x9b\xfe\x21\x08\xb8\xc3\xda\x7b\x09\x72\x3e\x61\xc9\x7c\x67\x83\xec\x53\x78\x31\xef\xb0\xe6\x4e\xc1\x65\x6c\xa4\x6e\xc7\xfa\xf9\x67\xbd\x4f\xee\x3f\x23\xfa\xe4\xd6\xf6\x48\xec\xc6\x4e\xbb\x94\xc5\x3f\x77\x58\x6f\x6b\x5e\x12\x38\xc9\x21\x18\x33\x2f\x79\x7a\xc1\xd2\x62\xfb\x16\x85\x2d\xca\x32\x97\xc8\x3e\x61\xeb\xdc\x5b\xf7\x6a\xfb\x44\x56\x9f\x40\x68\xb3\x94\xad\x9f\xf3\x8b\xfd\xdc\xfa\x85\x25\x4d\x5f\xc8\x32\x40\x2d\x0f\xb0\x22\x20\xf8\x59\xe4\xb2\x03\xe6\x58\xab\xcf\xd5\x10\xde\x69\x47\x7f\xbe\x7f\x94\xe4\xf0\x48\x1f\x5e\x69\xb4\xef\xb4\xe3\x37\x9f\x84\x55\x4f\xc2\x13\x18\xf5\x03\x58\xd9\x95\xdf\x57\xc4\x49\x17\x15\x10\x18\xf5\xa6\xb9\x11\x8a\xb4\xe4\x97\xb5\x89\x1c\x31\x4e\xf3\x13\xf9\x29\x8a\xca\xb2\x1b\x57\x5a\x8d\xb0\x28\x5d\xbd\x76\x8e\x20\x08\x6d\x7a\x72\xd8\x32\x5d\x98\xea\x8a\xd0\x85\x6f\xf1\x38\x30\x27\x40\x0f\x59\xc5\x44\x33\xa6\xa1\x88\x44\xa6\x50\xa0\x99\xb3\x4b\x49\x17\xfb\x88\x77\x9b\x5d\xf1\xcf\x0e\xeb\xb2\xe7\x5a\xb1\xc9\x7c\x43\x1b\xe0\x09\x26\xd6\xf7\xf7\x66\xa9\x10\x25\x2d\xd3\xaf\x64\x7d\x58\x52\xbf\x41\x29\x24\xc5\x0d\x67\x1c\x03\xe5\xd8\x6b\x93\x8a\x65\xda\x9d\x86\x66\x90\x16\xc8\x94\xdc\x8b\x9c\xec\x1d\x69\xb2\x02\xcc\xbd\xf5\xa3\x50\x65\xc9\xb0\x0f\x83\xe7\xa6\x3d\x3b\x93\x98\x33\x82\x1c\xdc\x61\x3d\x18\xae\x2c\xed\xe0\x5c\x0d\xbc\x5d\x5c\x59\xcc\xc6\x88\x6a\x95\xd7\x30\xe0\xb6\xc1\xc7\xfb\x82\xad\xc6\x52\x64\x19\x07\xd9\x22\xbf\xd8\xc3\x9a\x6d\x5d\x37\xf5\x14\x30\xd4\x43\x41\xde\xac\x7d\x1c\x0a\x1a\xd2\xda\x45\x2e\x62\xac\xd2\x99\xc5\xcf\x8d\x19\x4c\x6b\x88\x28\x23\x81\xe6\xd3\x95\x45\xb0\x0b\xcc\x73\x98\xe7\x7a\x0a\xb6\x56\x4e\x3c\x0e\x01\x93\x79\x02\x03\x87\xa2\x18\x89\xd1\x8b\xc1\x1f\x87\x9f\xec\xbe\xc0\xa9\x41\x4c\x11\x17\x1d\x20\xd1\x01\x12\x1d\x20\xd1\x01\x12\x3d\x9d\xd5\x03\x24\x3a\x40\xa2\x03\x24\x6a\x9e\x4f\x08\x89\x2c\x9a\x7b\x99\xe2\x59\x9a\xea\x4a\x71\x46\x7e\x0f\xbf\xbe\x3c\x24\x1a\x3f\x91\x15\x52\xf5\x92\xd6\xdc\x13\x84\xef\x0a\x0f\x0b\x99\x2e\xe0\x41\xe6\x39\xe7\x07\x2d\x66\xb4\x3c\x19\x96\xb9\xae\x1b\x39\x1f\xd9\x63\xbf\xb2\xd2\xb6\xb2\xe7\x12\xc0\x66\x68\xb0\x89\x39\x27\x9c\x4c\x2f\x8c\xbe\x97\x19\x66\x67\x17\xe7\xbb\x51\xdf\x25\x0f\x01\x87\x79\x6e\x39\xaf\xaf\x34\xeb\x90\x4f\x66\xae\x85\x30\x65\x67\xfe\x4e\xf5\x67\x23\xb1\x53\xad\x73\x14\xab\xed\x1e\x0a\x35\xd5\x8d\xdd\xb4\x5e\x2d\x0d\x08\xe6\x0e\x1f\xcb\x5c\xa6\x9c\x8f\x62\x85\xef\x40\x4d\x02\x9f\x3c\x88\x0d\x97\x64\x34\x60\xd1\x0d\x1b\xac\x36\xec\xc3\xde\xa8\xe9\x9c\x33\xf3\x30\x88\xf4\x57\xce\x95\x36\xeb\x35\xf8\xf3\x60\x4e\x80\xc7\xd1\x5d\x35\x45\xa3\xd0\xa1\x1d\x11\x0a\x1b\x85\x01\xb8\xba\x49\xaa\x72\x6e\x44\x86\x6f\x75\xb6\x5b\x99\x7f\x6a\xfb\x46\x2d\xce\x70\x26\xaa\xdc\xc5\x69\xa0\xa0\xb6\x80\xe9\x2f\xab\x69\x33\xd8\xeb\x07\x57\xeb\xd0\x46\x63\xb4\xac\x1c\xbd\x82\x4e\x28\xff\x90\xc6\xa3\xca\x50\xa5\x92\xdc\xd0\x7f\x4a\xb7\x80\xb7\x52\xc9\x42\xe4\x2f\x17\x42\xcd\x71\x18\xbe\xcd\x76\xbf\x03\xc0\xd8\xb4\x04\xb2\x2c\xd7\x8b\xba\x33\xc1\xc3\x02\x3d\x11\x91\xf2\x60\x78\xa8\x6b\xb2\x44\xba\x27\x9b\x63\x8e\xca\x62\xf0\xe6\xa4\xeb\x62\x36\xe3\x68\xe4\xc9\x9b\x0d\x00\x55\x55\xac\x5f\xdc\x11\xbc\xf2\x42\xdd\xd0\xda\xe3\xbe\x9f\x69\x77\xc2\x55\x2b\x2a\xb3\x25\xd7\xce\xfd\x9b\x6c\xbb\xff\xb5\x2e\xdf\xfe\xe1\xe9\xe9\xf6\xcd\x08\x75\x04\xb9\xb0\xee\x27\x5f\xf0\x78\x42\x92\x3e\xd5\xca\x5b\xf2\xdd\x9b\xfd\x65\xd3\x75\x19\xd5\xac\x53\xbb\x76\xe2\x4f\xba\x49\x7b\x14\x0d\x1a\x92\x5a\xe7\x97\xa1\x13\x32\xf7\x12\xd7\x0a\x41\x90\x33\x70\x91\xca\xb4\x32\x86\x0b\x47\x8e\x6c\x69\x2c\x02\x9e\x5d\x9c\x43\xb3\x1a\x30\x1a\x8d\x3c\x12\xb2\xce\x54\x29\x5b\x28\xa9\x1c\x29\x79\xc6\xb3\x66\xd2\x70\x15\xcf\xd2\xe4\xad\x1c\x02\xd6\xf6\x0e\xbc\x14\x6e\x01\x89\x5f\xfc\xa4\x23\x0a\x80\xd7\xda\x00\x3e\x8a\xa2\xcc\x71\xc8\x62\x80\xd7\x5a\x07\x9d\xf1\x1f\xfc\x95\x19\x1d\x8f\xe1\x43\x0b\x91\x19\x06\x4c\xc9\x9b\x79\x84\xcc\x85\x4a\x98\x69\x4d\x92\xee\xf2\x94\xc4\xc1\x3f\x2a\xfd\xa0\xd6\x91\xc0\xdf\x14\x06\x27\x70\x33\x38\xbb\x17\x32\x17\xd3\x1c\x6f\x06\x43\xb8\x19\x5c\x18\x3d\x67\x50\xa2\xe6\x37\x01\x65\xdc\x0c\x5e\x21\x6f\xe5\xec\x66\x10\xa7\xfe\x57\xc6\x7d\x6f\x09\x02\xfe\x88\xf5\xb7\x3c\x61\xaf\xe9\xd2\xe3\xc4\xfa\x5b\x0f\x13\x63\x1b\x19\xcd\xab\xba\xc4\x6f\x09\x23\x75\x5f\xbe\x15\x65\x6f\xa2\x8e\xa6\x5d\xdf\x16\xe8\xc4\xfd\x49\xd2\x2e\xf5\xdf\x7f\xb1\x5a\x4d\x6e\x06\x2d\x4f\x43\x5d\x90\xca\x94\xae\xbe\x19\x40\x8f\x82\xc9\xcd\x80\x69\x88\xef\x23\xd1\x93\x9b\x01\x7d\x8d\x5e\x1b\xed\xf4\xb4\x9a\x4d\x6e\x06\xd3\xda\xa1\x1d\x9e\x0c\x0d\x96\x43\xb2\x9a\xdf\xb6\x5f\xb8\x19\xfc\x1d\x6e\x54\x24\x5a\xbb\x05\x1a\xbf\xd2\x16\x7e\x1b\x6c\xf1\x26\x5b\x40\xd4\xae\x68\xd3\xef\xe8\x2b\x23\x94\x95\xf1\x30\xc6\xc6\xae\x05\x5a\x2b\xe6\x9b\xdb\x0d\x0a\xbb\x16\x10\xf8\x66\xaf\x25\x1b\x9b\x89\x97\xb5\x8d\xbb\x43\xd9\x55\x1e\xf6\x4c\x21\xac\x0e\x6c\x03\x5c\xeb\xc0\xd1\x0b\xde\xd1\x8d\x5e\xb8\xa6\x37\x6d\x54\xa3\x0b\xde\xff\xc1\x00\x33\x08\xe7\x75\x0b\x61\x4e\xa8\xe9\x4f\xb1\xe3\xb4\x54\x86\x26\xaf\xc9\xe7\xb5\xb3\xa6\xec\x11\xb2\x04\x7c\xa0\x25\xd8\x1e\x90\x9b\xba\xa3\x0d\xc6\x00\x5d\x41\x65\x63\xad\x9b\xe9\x6a\x66\x24\xc3\xe2\x0d\x42\x98\x86\x2d\x67\x9a\x62\xe9\x68\xd7\xed\xca\x57\xec\x88\x4a\x67\xda\x14\xc2\x4d\x80\x6c\xfe\xc8\x6d\x56\x8f\xa0\x1c\x7b\x0a\x3e\xf4\xf6\xf1\xd0\xa2\x2a\x84\x22\xed\xc9\x88\xde\xb6\x4d\x65\x32\x15\x5c\xe0\x8f\xf6\x56\x4c\x75\xe5\x2d\x60\xbb\x0e\x41\xd4\x85\xa8\x49\xce\x04\x0c\x69\x8f\x06\xb6\x7e\x27\xf3\x85\x78\x7c\x83\x6a\xee\x16\x13\xf8\xfa\xf4\xdf\xbf\xf9\xeb\x86\x8e\xde\x68\x62\xf6\x03\x2a\xf2\x4f\x6b\xce\x8f\x6c\x10\xc3\xea\xc0\x6e\xca\x82\xf8\x4c\x62\xd1\x3e\x99\xb7\x7d\x9a\x9c\x4b\xab\x41\x0f\x82\x21\x2e\x4c\x05\x85\x1b\x55\x49\x72\x21\x2f\xc0\x58\x4c\xa5\x38\x04\x39\x5b\x3f\x99\x6c\x8c\x7b\x5e\xc3\xc9\xe9\x10\xa6\x41\xc4\xab\x66\xfd\xfa\xf1\x36\x59\x43\xb2\xb4\xf0\xb7\xe1\x12\x3d\x14\xd5\x54\xec\x11\x39\xa0\x78\x20\xf0\x67\xd0\xbb\xc9\x90\x1b\x5e\xe3\x26\xb1\xa1\x77\xd7\xc2\x91\xb3\x9c\xe3\xe6\xfc\x57\x54\x5b\xa9\xdc\x37\xff\xb6\x79\x7d\x09\x90\x55\xc5\x04\xbe\xda\xd0\xc5\x9b\xb4\x3d\x57\xd3\x77\x6e\x51\x82\x20\xd3\x35\x37\xa2\x28\x38\xd8\x92\x19\x2a\x47\x11\xa3\xe9\xaa\xb6\xe3\xc8\x99\x07\xce\x38\xf9\xd8\x91\xe2\x73\x1b\xec\x50\x47\xd9\x2f\x8c\xce\xaa\x94\xa0\xac\x9e\xc5\x18\x34\xed\x1a\xa8\xba\x44\xbf\x1b\xfc\xd1\x24\x8a\x93\x7c\xde\x3d\xc6\xf1\x2a\x83\x02\x85\x92\x6a\x6e\xc3\x27\xa5\xf5\x06\xc4\x7b\xe3\x87\x05\xb2\xeb\xe9\xc5\xfe\x4c\x95\x95\x19\x1a\xcc\x40\xc0\xbc\x12\x46\x28\x87\x98\x91\xf9\xf1\xf1\xbf\x3f\x98\xd3\x9a\x3c\xd1\x1e\x87\x89\xbb\xd1\x6f\x55\x6f\xac\x88\xc4\x70\x84\xc6\x67\x86\x3e\xd9\x56\x3d\xf9\xea\x74\xeb\x92\x37\xfd\x36\x67\x4f\x7d\x64\x38\x81\xff\xbe\x3e\x1b\xfd\x97\x18\xfd\xf3\xf6\x28\xfc\xf3\xd5\xe8\x6f\xff\x33\x9c\xdc\xbe\xe8\xfc\xbc\x3d\xfe\xee\x5f\x36\xcc\xb4\x1e\xd6\x6f\x50\x9f\xe0\x44\x22\x88\x8c\x2b\x3a\x64\x0f\xa3\x67\x70\x65\x2a\x1c\xc2\x6b\x91\x5b\x1c\xc2\x4f\x8a\x5d\xc3\xef\x14\xda\xe6\x50\xc6\x3f\x23\x18\xd0\x57\xd7\x83\x8f\xa6\x0b\x93\xb4\xbd\x4f\x20\x77\x5b\x2e\x68\x3f\x21\x31\x7c\xd3\xb3\xae\xa5\xe9\x1c\xbb\x02\xb6\x78\x04\x59\x93\x00\x7f\x93\x54\x17\xe3\xce\xb1\x2c\xc2\xdd\x6f\x85\xaa\xa1\x35\x6b\x1e\xac\x2e\x6b\xba\x75\x64\x9b\x44\x6a\xb4\xb5\xcd\xa1\x25\x0b\xb9\xbc\x43\x68\x10\xad\x37\x96\x53\x4c\x05\x03\x75\x33\x95\xce\x08\x53\x77\xe2\x12\x48\x85\x0a\x59\xa0\x59\x95\xc3\x91\x45\x84\x44\xe9\x0c\x57\xad\xeb\xb1\xb7\xa1\x62\x2a\x73\xe9\x6a\x9f\x32\x4a\xb5\x9a\xe5\x32\xc4\x07\x45\xa9\x8d\x13\xca\xc5\x74\xdb\x1c\x1f\x41\xba\xa6\x26\x27\x2d\x1c\x65\xca\x9e\x9c\x9c\x7e\x7d\x59\x4d\x33\x5d\x08\xa9\x5e\x17\x6e\x7c\xfc\xdd\xd1\x3f\x2a\x91\x73\xae\xea\x9d\x28\xf0\x75\xe1\x8e\x3f\x9d\x5b\x3c\xf9\x66\x8f\x5d\x74\x74\xed\xf7\xca\xed\xd1\xf5\x28\xfc\xf7\x22\xbe\x3a\xfe\xee\xe8\x26\xd9\xda\x7e\xfc\x82\x78\xe8\xec\xc0\xdb\xeb\x51\xbb\xfd\x92\xdb\x17\xc7\xdf\x75\xda\x8e\x57\x37\x63\x27\x6a\xdd\x19\x80\xbe\x69\xfb\x7a\x74\xe2\xe2\xf9\xe4\xb8\x33\xfb\xd0\x70\x39\x24\x0d\xbb\x98\xfc\x71\x98\xe6\x23\x52\x0c\xbb\x41\x57\x93\x70\xf1\x51\xdd\x1e\x69\xb4\x77\xcb\x23\x08\x6a\x68\xe3\x86\xec\x04\x50\xa4\x8b\x95\x2a\xe5\xb0\xe7\x0b\x96\x39\x6d\x4b\x24\x0b\x71\x8f\x30\x45\x54\xe4\xf0\x7e\xf1\xde\x46\x2a\xa7\x39\x21\x73\xb5\xc0\xfa\xb9\x41\xa0\xf0\xc9\x85\xe8\xb6\x37\x95\x0d\x78\xc6\x7f\x5c\xe4\xf9\xe7\x3a\x2f\xb7\x39\x9d\xb2\x24\x9b\xa5\xbc\x8a\x3f\xaf\xbb\xab\xa4\xbb\xde\x14\x7f\x82\x08\xcd\x9f\x08\x9e\xaf\x64\x5c\xda\x1e\x44\xc3\x47\x86\x4e\xcd\xe4\x7b\xda\xdf\x97\xb1\x3f\xcb\xc8\x54\x08\x5a\xa5\x08\x78\x8f\xa6\x8e\x89\xbf\xce\xf1\xf0\xb5\xf9\x42\x58\x08\xeb\xd5\x25\xd5\xa5\x8c\xba\xd2\x3b\x2e\x30\x24\x4b\x4e\x51\xbb\xcf\xdd\x49\x67\xbb\x53\x66\xf8\xe8\xc3\x9f\x30\xfe\xe5\xe5\xcf\xde\x72\x67\xd2\x92\x59\x0e\x40\x86\x3e\xc3\x27\x14\xd0\x14\x32\x96\xb7\x5d\x9b\x2e\x24\xcd\xde\x09\x34\x37\xa5\xb1\xfd\xf3\xb4\xa8\xe7\x6d\x88\x6c\xfc\xcb\x29\xe7\x32\xc5\xd2\x71\x0b\x92\xeb\x83\x90\x8c\x0d\x67\xda\xfc\x2e\x07\xaf\x36\xd4\x39\xd6\x90\xc6\xf5\x8d\x60\xe0\xf6\xd3\xec\x9d\x34\xa8\xfd\x53\xfc\xfd\xe4\xbe\xaf\x26\xaf\xbf\x08\xd0\x80\xe4\x9e\x46\xfd\x59\x53\xf4\xfd\xb2\xd3\x07\x9c\x3d\xb1\xea\xf4\x01\x67\x60\x70\x86\x06\x55\x8a\x51\x30\xfd\x62\x53\x38\xe4\xde\x54\xa3\x3e\xc3\xc1\x92\xcd\xd7\x22\xd6\xb2\x70\x76\x71\x1e\xaf\x42\x44\x8d\x0a\x3c\x6c\xac\x92\xef\xd4\x65\x0e\x19\x2e\x84\x5b\xec\x45\xc1\xf3\xf3\x20\x36\x2e\x29\x73\x91\xbf\x94\x98\x62\xef\xe6\x05\x5b\x2b\x14\x59\x78\x49\xb1\x19\x79\x29\x6e\x1b\xfa\xa0\x28\x14\xd2\xdb\x9b\x19\x14\xd7\x81\x20\xac\x28\x33\xf8\x8f\xcb\xf7\xef\xc6\x3f\xe8\x10\xce\x88\x34\x45\xeb\xdd\x3f\x97\x40\x87\x60\xab\x74\x01\xc2\x12\x69\x64\xe0\x2f\x39\x73\x5a\x08\x25\x67\x68\x5d\x12\x66\x43\x63\xaf\x4f\x6f\x93\x7e\xc6\x56\x86\x6a\x7b\xbc\xbf\x10\x14\x80\xf7\x06\x31\xd3\x8c\xe5\xb8\x9a\x49\x2a\x75\x16\x88\x7e\x60\x62\x9d\xb8\x23\xd3\xec\x89\xad\x90\x71\xeb\x04\x06\xa4\x26\x9d\x4f\xff\x4a\x1b\xeb\xb7\x01\x1c\x3d\x90\x29\x84\x01\xfd\x1c\xf8\x0f\xda\xee\x11\xae\x4e\x50\x12\x3e\xec\x5d\xb6\x91\xf3\x39\x47\x84\x5c\x3d\xbb\x47\xe5\x8e\x19\x82\xcf\x40\xe9\x4e\x67\x15\x8a\xa7\x6d\xc9\x74\x99\x90\xeb\xd3\xdb\x01\x1c\xf5\xf9\x0a\x86\xfe\xb4\x29\x93\x96\x3a\x3b\x8e\x89\x35\x3e\x1e\xc6\xb9\x8b\x85\xb6\xa8\x7c\xcd\xc8\x69\x0f\x45\xac\x2e\x10\x1e\x30\xcf\x47\x3e\x06\xce\xe0\xc1\xd7\x10\xa2\x28\xfd\x49\x83\x52\x18\xb7\x74\x19\xe7\xea\xfd\xab\xf7\x13\xff\x35\x5a\xb6\xb9\x8a\x89\xb8\x99\x54\x22\x0f\x85\xc2\x26\x84\x25\x42\x2a\xbf\x48\x4e\x87\xec\x5b\xf4\x78\xb3\xca\x55\x06\x93\xe5\xcb\x19\x7b\x6b\xfc\xba\x9b\x31\xeb\x95\x9d\x6f\xc8\x2c\x6f\xb4\xff\xc3\xfb\x27\x7b\xb3\xb8\xcd\x3d\xf5\x59\xec\x7a\xa7\xad\x2c\xb6\xa6\x99\xb8\xcc\x74\x6a\x89\xc1\x14\x4b\x67\xc7\xfa\x9e\x4c\x27\x3e\x8c\x1f\xb4\xb9\x93\x6a\x3e\x22\x25\x1b\xf9\x95\xb7\x63\x76\x31\xe3\xbf\xf0\x9f\xdf\xc5\x11\xfb\xa9\xfd\xd9\xf2\x4e\xff\x0f\xe0\x8d\xdd\xe7\xf8\xa3\x59\x8b\x21\xf0\x53\x3c\xc1\xf3\xcb\x98\x9f\x5a\x1a\x4d\xdb\xc5\x9f\x92\x08\xf7\xe3\x3a\x16\xae\x10\x99\x37\x81\x42\xd5\x9f\x5d\x8d\x49\x80\x9c\x86\x4c\xeb\x51\xb8\xc0\x3a\x12\x2a\x1b\x35\x29\x80\xb4\xfe\x68\x89\x55\x72\xcf\x0d\xfc\xd3\xf9\xab\x3f\x46\xb9\x2b\xf9\xa4\xdd\xea\x13\xbd\x13\x86\xf9\xf1\x95\xd3\x86\xf0\x6e\xef\x5d\x35\x6d\xf2\x23\x2d\xc3\x21\xf5\x05\xbf\xfe\xc6\xaf\xda\x2b\xab\x22\x2f\x17\xe2\x34\x8e\x3d\x5c\x5c\x3d\x5c\x5c\x3d\x5c\x5c\x3d\x5c\x5c\xdd\x2a\xec\x2f\xf1\xe2\xea\xe1\x7e\xc1\xe1\x7e\xc1\xe1\x7e\xc1\xe1\x7e\xc1\xe1\x7e\xc1\xe1\x7e\x41\xff\x39\xdc\x2f\x38\xdc\x2f\x38\xdc\x2f\xf8\x7f\x7d\xbf\x80\xcb\xf5\x7f\xe4\xed\x81\x2f\xfb\xfc\xf8\x97\x5c\xbf\xff\x92\x8a\x63\x87\x72\xd7\xa1\xdc\x75\x28\x77\x1d\xca\x5d\x1f\xa1\xf1\x87\x72\xd7\xa1\xdc\x75\x28\x77\x1d\xca\x5d\x7f\xd2\x72\xd7\x4c\xe4\x76\xef\x7a\xd7\xff\x06\x00\x00\xff\xff\x02\x7d\xd1\x7b\x6a\x57\x00\x00")
 
 func operatorsCoreosCom_operatorgroupsYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -205,7 +205,7 @@ func operatorsCoreosCom_operatorgroupsYaml() (*asset, error) {
 	return a, nil
 }
 
-var _operatorsCoreosCom_operatorsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x59\x5f\x8f\xdb\xb8\x11\x7f\xdf\x4f\x31\xf0\x3d\xec\x1d\xe0\x3f\xbd\x14\x28\x0a\xbf\x2d\x76\x73\xc5\xb6\xd7\x4d\x90\xdd\xe4\xe5\x70\x0f\x63\x69\x6c\xb1\xa6\x48\x1d\x87\xb2\xd7\x0d\xf2\xdd\x8b\x21\x29\x5b\xb2\xbd\xb6\x9c\x6e\xd2\xf2\xc5\x16\x45\x0e\xe7\xef\x6f\x66\x28\xac\xd4\x27\x72\xac\xac\x99\x02\x56\x8a\x9e\x3d\x19\x79\xe2\xf1\xf2\xaf\x3c\x56\x76\xb2\xfa\xf9\x6a\xa9\x4c\x3e\x85\xdb\x9a\xbd\x2d\x3f\x10\xdb\xda\x65\x74\x47\x73\x65\x94\x57\xd6\x5c\x95\xe4\x31\x47\x8f\xd3\x2b\x00\x34\xc6\x7a\x94\x69\x96\x47\x80\xcc\x1a\xef\xac\xd6\xe4\x46\x0b\x32\xe3\x65\x3d\xa3\x59\xad\x74\x4e\x2e\x10\x6f\x8e\x5e\xfd\x69\xfc\x97\xf1\x9b\x2b\x80\xcc\x51\xd8\xfe\xa4\x4a\x62\x8f\x65\x35\x05\x53\x6b\x7d\x05\x60\xb0\xa4\x29\xd8\x8a\x1c\x7a\xeb\x78\xbc\xfb\x97\x59\x47\x56\x7e\xca\x2b\xae\x28\x93\x83\x17\xce\xd6\x55\x7b\x75\x6b\x4d\x24\xd5\xf0\x87\x9e\x16\xd6\xa9\xe6\x19\x60\x04\x56\x97\xe1\x7f\x94\xfb\x5d\xa2\x11\xa6\xb4\x62\xff\x8f\xce\xf4\xaf\x8a\x7d\x78\x55\xe9\xda\xa1\x6e\x9d\x19\x66\x59\x99\x45\xad\xd1\xed\xe6\xaf\x00\x38\xb3\x15\x4d\xe1\x56\xd7\xec\x49\x26\x92\x1e\x12\x0f\xa3\x24\xeb\xea\xe7\xc4\x12\x67\x05\x95\xd8\x30\x08\x42\xca\xdc\xbc\xbf\xff\xf4\xe7\xc7\xbd\x17\x00\x39\x71\xe6\x54\xe5\x83\x56\x1b\x1e\xc1\x51\xe5\x88\xc9\x78\x06\x84\x2c\x1e\xbb\x65\x68\xdc\xda\xee\x37\xc2\x98\x9d\xfd\x8b\x32\xdf\x9a\xae\x9c\x2c\xf6\x2d\x2d\xc5\xd1\xf2\x9e\xce\xfc\x1e\x1f\xd7\xc2\x6c\x5c\x07\xb9\x38\x0e\x31\xf8\x82\x1a\xb1\x29\x4f\x12\x82\x9d\x83\x2f\x14\xef\xf8\x0d\xbe\x20\xd3\x68\x12\x57\x63\x78\x24\x27\x1b\x81\x0b\x5b\xeb\x5c\x3c\x6c\x45\xce\x83\xa3\xcc\x2e\x8c\xfa\xf7\x96\x1a\x83\xb7\xe1\x18\x8d\x9e\xd8\x83\x32\x9e\x9c\x41\x0d\x2b\xd4\x35\x0d\x01\x4d\x0e\x25\x6e\xc0\x91\xd0\x85\xda\xb4\x28\x84\x25\x3c\x86\x7f\x5a\x47\xa0\xcc\xdc\x4e\xa1\xf0\xbe\xe2\xe9\x64\xb2\x50\xbe\x89\x8d\xcc\x96\x65\x6d\x94\xdf\x4c\x82\x9b\xab\x59\x2d\x76\x9f\xe4\xb4\x22\x3d\x61\xb5\x18\xa1\xcb\x0a\xe5\x29\xf3\xb5\xa3\x09\x56\x6a\x14\x98\x35\x21\x3e\xc6\x65\xfe\x83\x4b\xd1\xc4\xd7\x7b\xea\x8b\x76\x60\xef\x94\x59\x74\x5e\x05\x9f\x3c\xa9\x6b\x71\x4f\x50\x62\xe8\xb8\x3d\xca\xb2\x53\xa9\x4c\x89\x56\x3e\xbc\x7d\x7c\x82\x86\x81\xa8\xf6\xa8\xe1\x96\xb7\xec\x94\x2d\x8a\x52\x66\x4e\x2e\xae\x9c\x3b\x5b\x06\x2a\x64\xf2\xca\x2a\xe3\xc3\x43\xa6\x15\x19\x0f\x5c\xcf\x4a\xe5\xc5\x8a\x7f\xd4\xc4\x5e\xec\x30\x86\xdb\x00\x0d\x30\x23\xa8\xab\x1c\x3d\xe5\x63\xb8\x37\x70\x8b\x25\xe9\x5b\x64\xfa\xe6\xaa\x16\x8d\xf2\x48\xd4\xd7\x5f\xd9\x6d\x64\x3b\xdc\x70\x10\x25\x00\x0d\xfc\xbc\x68\x9d\x26\x22\x1f\x2b\xca\x3a\xa1\x90\x13\x2b\x27\xae\xeb\xd1\x93\x38\x7c\x07\x76\xfa\x1c\xed\xd1\xd7\xdc\xef\xf0\xb0\xb4\x73\xbc\x9d\xb1\x18\xba\x75\x3e\x9a\x1d\x7c\x48\xa4\x88\x41\x33\x5b\x56\xd6\x88\x63\xf4\xe5\xea\x65\xe8\x80\x90\x1c\x1a\x7a\x87\xef\xf6\x78\xbf\xdd\x2e\x4d\xf3\x33\xe2\xad\xf7\x8a\x0c\xe8\x23\x39\xa6\x28\xd0\x11\x70\xeb\xc1\xad\x0c\x71\x5b\xb1\xc5\x31\x9e\x04\x9c\x35\xce\x48\x3f\x92\xa6\xec\xd0\x3c\xe7\x24\x96\xd1\xd9\x7f\x7c\xc9\x9e\xf0\xbf\xb6\x77\xc4\xd8\x0e\x44\xe0\x8f\x9a\xdc\x06\xec\x8a\x9c\x84\x3b\x79\x31\xdc\x4e\x29\x35\x53\x2e\x18\xc8\x61\x67\x47\x2d\xd7\x27\x8c\xd9\x53\x4d\x7d\x44\x95\x51\xa2\xcf\x8a\xb7\xcf\x02\x29\xad\x1c\xd7\x43\xea\xfd\x8d\x49\x70\xc5\x41\xcc\xa8\x00\x6e\x94\x92\x8c\x56\x46\xd4\x7a\x2a\xa8\x33\x03\xe8\x08\x6e\x1e\xee\x28\x3f\xe6\x0f\x5d\x81\xd1\x39\xdc\x9c\x58\xa5\x3c\x95\x27\x85\xd8\x13\xe3\xe6\x04\xab\x09\xa7\x9b\x37\xc9\x8b\x8d\x47\x65\x38\xe5\xa0\x21\x20\x2c\x69\x13\xd3\x95\x64\xc1\x26\x28\xc3\x62\x47\x21\xb9\x05\xdb\x2e\x69\x13\x16\xa5\xdc\x75\x92\xc3\x1e\xb6\x8d\xe3\x74\x30\xec\xc6\x48\x8e\x3f\xbb\xc6\x1e\x07\xb5\xee\xe8\xe3\x54\x71\x2c\x69\x73\x6e\xc9\x9e\x31\x44\x47\x8a\x53\x55\x20\x56\x91\x89\xa0\x49\x99\xda\x1a\x02\xab\x4a\x2b\x0a\x89\xeb\x2c\xfd\x17\xb3\xc7\xe1\x68\xc4\xbf\x90\x69\x7b\xb4\x8c\x5b\xd2\xe6\x9a\xa3\x03\x48\x74\x14\xaa\x92\x58\xdf\xc2\x40\x53\xc1\x7c\x42\xad\xf2\x5d\x51\x1a\x22\xe1\xde\x0c\xe1\xc1\x7a\xf9\x79\xfb\xac\x24\x43\x8b\xdf\xdc\x59\xe2\x07\xeb\xc3\xcc\xab\x8a\x1d\x59\xb9\x50\xe8\xb8\x29\x04\x88\x89\x31\x29\x52\xb5\x4b\x1a\x1e\xc3\xfd\xbc\x83\x6a\xb2\xfa\xde\x80\x75\x8d\x74\xa1\xc8\x8c\x84\x22\x89\xb2\xe6\x50\x83\x18\x6b\x46\x54\x56\x7e\x73\x94\x46\x52\x8a\x75\x1d\x9d\x9c\x20\x97\x48\x3d\x49\x69\x14\xdf\xc4\x22\x56\x63\x46\x39\xe4\x75\x60\x3a\x14\x64\xd2\x6e\xa8\x0c\x4a\x72\x0b\x82\x4a\x10\xae\xaf\xaa\xcf\xe1\x52\x1c\x3d\xd0\xa9\x4d\xf4\x8c\xfd\x02\x04\x87\xec\x73\x21\x6c\xc7\x3d\x11\xde\x4a\xac\xc4\x74\x9f\x05\xc5\x82\xf6\xbe\x40\x85\xca\xf1\x18\x6e\x42\x7b\xa4\xa9\xf3\x4e\x99\xa0\xe7\x36\x19\xa1\xa0\x18\x04\x8a\x56\xa8\x05\x37\xc5\xd3\x0d\x90\x8e\x28\x6a\xe7\x07\xc9\x62\x08\xeb\x42\x6a\x01\x89\xef\xb9\x22\x1d\x4a\xe2\xc1\x92\x36\x83\xe1\x81\xb9\x07\xf7\x66\x10\xf1\xf5\xc0\xc0\x5b\x30\xb6\x46\x6f\x60\x10\xde\x0d\xfe\xbb\xfc\x72\x16\x74\x31\xcf\x43\x63\x8d\xfa\x7d\x4f\x24\x3c\x6b\x4b\x47\xf3\x17\x49\x74\x8c\xf7\x81\xe6\x51\x98\x56\x39\x31\x27\x47\x26\x14\x59\xf6\xc5\x1a\x62\x57\x75\x0c\x13\x8a\x52\x0e\x6b\xe5\x8b\x6e\xed\xf2\x92\x76\xce\x7b\xf8\x19\xbf\xee\x0a\xa1\xb2\xe2\x43\xc3\x76\xf4\xc1\xad\x14\x11\x23\x1b\x6e\x87\x40\xc6\xa9\xac\x68\x98\x95\x22\x37\x16\xd2\x62\xf9\x68\x86\x13\x99\xb4\x97\x41\xfb\xa5\xb3\x97\x3b\xe9\x13\x82\xde\xbc\xbf\x6f\x7a\xe8\xd8\x3a\x53\x23\xe8\x19\x00\xef\x09\xde\x3b\x1d\x5c\xc0\xd4\xed\x76\x53\x3b\x5f\xb5\xfa\xf0\x6d\x8b\x11\x5a\xc6\xc6\x83\xfa\x30\x7c\x1e\x02\x7b\xc1\xdf\x71\x76\x77\xdc\xb6\x99\xc5\x15\x2a\x8d\x33\xdd\xb4\x48\x31\xd9\xa6\x06\x69\xcb\xfc\x75\x74\x1b\x3a\x87\xe5\xbd\xcb\xae\xfe\x85\x97\x94\x55\xd1\x65\x7b\x2c\x94\xf3\xcf\x2c\xeb\x5f\x7d\x49\x27\xc3\xfe\xc9\xa1\x61\xd5\x5c\xd9\xf5\xc9\x3c\x7b\xad\x0d\x7b\xf0\xaa\xa4\xe4\x0d\x8d\x31\xfc\x96\x2c\xe5\xf1\xb6\xc1\x1a\x6a\x62\x33\xa0\xbf\xf5\x05\xbd\x08\x28\xed\x71\x41\xa5\x22\x63\x6e\x5d\x89\x7e\x0a\x39\x7a\x1a\x09\x67\xbd\xd4\xf0\x31\x5c\x6a\xbc\xaa\x0a\xd6\xc8\x62\x8d\x19\xe5\xff\x0f\x42\x96\xc4\x8c\x8b\xcb\xa5\xbb\x81\xa2\x2e\x51\xa2\x0b\xf3\x10\x47\x89\x10\x28\x93\xab\x0c\xc3\x75\x54\x4e\x1e\x95\x66\xc0\x99\xad\x63\xf4\xed\xcc\xff\xea\x16\x76\x84\x7c\x0e\x65\x8f\xc8\x11\x53\xbe\x6c\x15\xe5\x75\x4d\x75\xcd\xc1\x07\xbe\x25\xd7\xc7\xaf\x77\xce\x72\x9d\xae\x7a\xb6\x60\x9b\x18\x1e\x86\x68\xb2\x73\x78\x72\x35\x0d\xe1\x17\xd4\x4c\x43\xf8\x68\x96\xc6\xae\x5f\x9f\xf7\xb0\xf8\x62\x7d\x6f\xaa\xc0\xe1\x96\xe7\x57\x64\x2b\x14\x84\xef\xd1\x17\x17\xa4\xb5\xeb\xfb\x54\x0b\x85\x5a\x3e\x54\x11\x95\xa2\x8c\x3a\x97\xd3\xa0\x0c\x7b\xc2\x3c\x4d\x92\xf1\xca\x51\x7a\x37\x8c\x37\xa7\xa9\x83\xd9\x5d\x5e\x4b\x7d\x09\x28\x65\xa7\xca\xe1\xef\x8f\xef\x1e\x26\x7f\xb3\xa9\x64\xc5\x2c\x23\x4e\xa9\x45\xea\xcc\x21\x70\x9d\x15\x80\xdc\x5c\x17\x3e\x86\xa4\x53\xa2\x51\x73\x62\x3f\x4e\xd4\xc8\xf1\x6f\x6f\x7e\x1f\xc3\x2f\xd6\x01\x3d\x63\x59\x69\x1a\x82\x4a\x6d\x4e\x73\xc5\xdb\x2a\x8f\x82\x30\xdb\xbd\xa1\x12\x0a\x2c\x55\x36\x4f\x4c\xaf\x03\xb3\x1e\x97\x04\x36\x31\x5b\x13\x68\xb5\xa4\x29\x0c\xb8\xa2\xac\x75\xf4\x67\x83\x25\x7d\x19\xc0\x8f\xeb\x82\x1c\xc1\x40\x1e\x07\xf1\xc0\x6d\x09\x29\x73\x2d\xa7\x4c\x07\xc7\x3e\xdc\xa9\xc5\x82\x1c\xc5\x62\x9c\x56\x64\xfc\x4f\xd2\x89\xa9\x39\x18\xdb\x5a\x1c\x48\x88\x3e\x2b\xca\xd4\x5c\x51\x7e\xc0\xc8\x6f\x6f\x7e\x1f\xc0\x8f\x5d\xb9\x04\x75\xe8\x19\xde\xc4\x2e\x43\xb1\xc8\xf8\x53\x6a\xdc\x78\x63\x3c\x3e\x0b\xcd\x4c\x5a\x07\x13\x6b\x7e\x6f\xa1\xc0\x15\x01\xdb\x92\x60\x4d\x5a\x8f\xe2\xbd\x69\x0e\xeb\xd8\x92\x36\xaa\x8c\x2d\x5e\x85\xce\xef\x7d\xaf\x78\x7a\x77\xf7\x6e\x1a\x4f\x13\xb3\x2d\x8c\x1c\x61\xac\x87\xb9\x32\xa8\x53\xdf\xa1\x78\xd7\xa6\x70\x1d\x8d\xe4\x2d\x64\x05\x9a\x80\x95\x41\x1b\xf3\xda\xd7\x8e\xc6\xfb\xf7\xd7\x5f\x15\x03\xc7\x3e\x24\x9c\x72\xff\xf0\x59\x61\xbf\xc8\xfc\x1f\x5e\xda\x7f\x95\xd0\xe1\xbb\xda\x05\x42\x3f\xb4\xfc\xf4\xa4\xd0\xcb\x7a\x46\xce\x90\xa7\x20\x77\x6e\x33\x16\x91\x33\xaa\x3c\x4f\xec\x8a\xdc\x4a\xd1\x7a\xb2\xb6\x6e\xa9\xcc\x62\x24\x8e\x38\x8a\xde\xc1\x93\xf0\x2d\x72\xf2\x43\xf8\x79\x35\x19\xb9\xc2\xec\x62\x41\xc3\xa6\xef\x21\xad\x9c\xc3\x93\x57\x11\xb6\x69\xe4\x2e\xef\x9d\xae\x1f\x23\x70\x64\xfb\x34\x24\xec\xd6\x85\xca\x8a\xe6\x53\x64\x0b\x29\x4b\xcc\x23\x94\xa2\xd9\x7c\x73\xe7\x17\x95\xd6\x4e\xce\xde\x8c\xd2\x67\xf4\x11\x9a\x5c\xfe\xb3\x62\x2f\xf3\xaf\xa2\xc3\x5a\x5d\x04\x04\x1f\xef\xef\xbe\x4f\x48\xd4\xea\x2b\xa2\x3e\x7e\xc6\x9a\x82\x77\x75\x53\xd3\xb2\xb7\x4e\x2a\xd7\xce\x5c\x3d\xdb\xde\x58\xec\x84\x4f\x45\x16\x7c\xfe\x72\xf5\x9f\x00\x00\x00\xff\xff\xb3\x0d\x9b\xb8\x18\x21\x00\x00")
+var _operatorsCoreosCom_operatorsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5a\x4b\x6f\x23\xb9\x11\xbe\xfb\x57\x14\xb4\x07\xef\x02\x52\x6b\xc6\x01\x82\x40\x79\x00\x86\x3d\xbb\x70\xb2\xeb\x31\xc6\x9e\xb9\x2c\xf6\x50\xea\x2e\xa9\x19\xb1\xc9\x5e\x92\x2d\x5b\x19\xcc\x7f\x0f\x8a\x64\xbf\xf4\x6c\x4d\x3c\x49\x78\xb1\x9b\x8f\x62\x7d\xf5\x62\x15\x29\x2c\xc5\x27\x32\x56\x68\x35\x03\x2c\x05\xbd\x38\x52\xfc\x65\x93\xd5\x9f\x6c\x22\xf4\x74\xfd\xf6\x62\x25\x54\x36\x83\x9b\xca\x3a\x5d\x7c\x20\xab\x2b\x93\xd2\x2d\x2d\x84\x12\x4e\x68\x75\x51\x90\xc3\x0c\x1d\xce\x2e\x00\x50\x29\xed\x90\xbb\x2d\x7f\x02\xa4\x5a\x39\xa3\xa5\x24\x33\x59\x92\x4a\x56\xd5\x9c\xe6\x95\x90\x19\x19\x4f\xbc\xde\x7a\xfd\x26\xf9\x63\x72\x75\x01\x90\x1a\xf2\xcb\x9f\x44\x41\xd6\x61\x51\xce\x40\x55\x52\x5e\x00\x28\x2c\x68\x06\xba\x24\x83\x4e\x1b\x9b\xb4\xff\xa5\xda\x90\xe6\x3f\xc5\x85\x2d\x29\xe5\x8d\x97\x46\x57\x65\x77\x76\x67\x4e\x20\x55\xf3\x87\x8e\x96\xda\x88\xfa\x1b\x60\x02\x5a\x16\xfe\xff\x80\xfb\x7d\xa4\xe1\xbb\xa4\xb0\xee\x1f\xbd\xee\x9f\x85\x75\x7e\xa8\x94\x95\x41\xd9\xd9\xd3\xf7\x5a\xa1\x96\x95\x44\xd3\xf6\x5f\x00\xd8\x54\x97\x34\x83\x1b\x59\x59\x47\xdc\x11\xe5\x10\x79\x98\x44\xac\xeb\xb7\x91\x25\x9b\xe6\x54\x60\xcd\x20\x30\x29\x75\xfd\x70\xf7\xe9\x0f\x8f\x5b\x03\x00\x19\xd9\xd4\x88\xd2\x79\xa9\xd6\x3c\x82\xa1\xd2\x90\x25\xe5\x2c\x20\xa4\x61\xdb\x86\xa1\xa4\xb3\xdc\x6d\x98\x31\x3d\xff\x27\xa5\xae\xd3\x5d\x1a\x9e\xec\x3a\x52\x0a\xad\x63\x3d\xbd\xfe\x2d\x3e\x2e\x99\xd9\x30\x0f\x32\x36\x1c\xb2\xe0\x72\xaa\x61\x53\x16\x11\x82\x5e\x80\xcb\x85\x6d\xf9\xf5\xb6\xc0\xdd\xa8\x22\x57\x09\x3c\x92\xe1\x85\x60\x73\x5d\xc9\x8c\x2d\x6c\x4d\xc6\x81\xa1\x54\x2f\x95\xf8\x57\x43\xcd\x82\xd3\x7e\x1b\x89\x8e\xac\x03\xa1\x1c\x19\x85\x12\xd6\x28\x2b\x1a\x03\xaa\x0c\x0a\xdc\x80\x21\xa6\x0b\x95\xea\x50\xf0\x53\x6c\x02\xbf\x68\x43\x20\xd4\x42\xcf\x20\x77\xae\xb4\xb3\xe9\x74\x29\x5c\xed\x1b\xa9\x2e\x8a\x4a\x09\xb7\x99\x7a\x33\x17\xf3\x8a\xf5\x3e\xcd\x68\x4d\x72\x6a\xc5\x72\x82\x26\xcd\x85\xa3\xd4\x55\x86\xa6\x58\x8a\x89\x67\x56\x79\xff\x48\x8a\xec\x3b\x13\xbd\xc9\x5e\x6e\x89\x2f\xe8\xc1\x3a\x23\xd4\xb2\x37\xe4\x6d\xf2\xa8\xac\xd9\x3c\x41\xb0\xa2\xc3\xf2\x80\xa5\x15\x29\x77\xb1\x54\x3e\xbc\x7b\x7c\x82\x9a\x81\x20\xf6\x20\xe1\x8e\xb5\xb4\xc2\x66\x41\x09\xb5\x20\x13\x66\x2e\x8c\x2e\x3c\x15\x52\x59\xa9\x85\x72\xfe\x23\x95\x82\x94\x03\x5b\xcd\x0b\xe1\x58\x8b\xbf\x57\x64\x1d\xeb\x21\x81\x1b\x1f\x1a\x60\x4e\x50\x95\x19\x3a\xca\x12\xb8\x53\x70\x83\x05\xc9\x1b\xb4\xf4\xcd\x45\xcd\x12\xb5\x13\x16\xdf\x70\x61\x77\x23\xdb\xee\x82\x1d\x2f\x01\xa8\xc3\xcf\x41\xed\xd4\x1e\xf9\x58\x52\xda\x73\x85\x8c\xac\x30\x6c\xba\x0e\x1d\xb1\xc1\xd7\x33\x93\xe6\x3f\x0b\xcf\xc2\xe5\xba\x72\x80\x50\x62\xba\xc2\x25\x81\x56\x72\x03\xb8\x5c\x1a\x5a\xf2\x3a\xaf\x04\x5d\x94\x5a\x79\x5f\xb7\x24\x29\x75\x94\xc1\x7c\xc3\x43\xc2\x80\xc4\x39\xc9\x3f\x6f\x91\xec\xd0\x43\xaf\x03\xeb\x50\x4a\xca\x82\x96\x91\x63\x24\x4a\xbd\x0c\x1e\x53\xa9\x76\xfc\x39\x27\xe5\xf7\x6c\x02\x8d\xb0\x90\x91\x24\x56\xef\x50\x99\x1d\x8e\x2e\xdc\xd2\x1c\x95\x22\xb9\x3b\xb0\x25\xd8\x9b\x30\x8f\x19\xf0\x42\x88\x9f\x3e\xa0\x50\x03\xcf\xe9\x1a\x9d\xc7\x96\xc0\x2d\x2d\xb0\x92\xae\x09\x15\x71\xe2\x25\xc3\xf0\x23\x35\xa5\x6d\x38\x47\xed\x86\x5b\xdc\xe7\x9e\x8f\x9a\x12\x53\x3a\x89\xe0\x6e\x6b\x41\x0d\x45\x35\x1d\xfc\xa5\x3b\x92\x6e\x15\x21\xd4\x18\x9e\x73\x91\xe6\xdc\xed\x8f\x50\xee\x5c\x80\x70\x90\x69\xb2\xea\xd2\x01\xbd\x08\xeb\x76\x01\x33\xf5\x2d\x29\x7d\x2d\xd4\x07\x89\xea\xba\x2c\x8d\x5e\xe3\x69\x7d\xdd\xed\xae\xa9\x01\x63\xfd\x5d\x6a\x29\xd2\x4d\xcd\x5d\x8d\xfc\xd2\x76\x17\xdb\x3e\xa4\xeb\xca\xe9\x02\x9d\x48\xcf\x06\x01\x40\xaa\x2a\xf6\xf1\xcd\x07\x72\x43\xf7\xc0\xf8\x2f\xa8\x2a\x94\x3b\x83\x51\xa2\x27\xa5\xf1\x10\xed\xb3\xa3\xf2\xc3\xa6\x7b\x36\xb4\x10\xe2\x4f\x32\xf1\x18\x4e\x82\x3d\x3c\xdc\x84\x00\x10\x27\x74\x9c\xa8\xcb\xa0\x77\xa8\xaf\x63\x6d\xb8\x93\x3c\xf6\xe7\xef\xfa\xc8\x37\xe7\xd8\xa1\x59\x92\x6b\x38\xd8\x13\xb2\xb6\x58\x7e\xda\x5a\xe0\x63\x6c\x8f\x69\xdb\xf7\xec\x02\x15\x2e\x39\xf9\xb8\x5b\x00\x15\xa5\xdb\x8c\xf7\x8e\x03\xc3\x69\x69\x24\xf0\x94\xd3\xe6\x92\xe3\xf7\x52\x69\xd3\x8d\xce\x35\xf6\x56\x4a\x28\x0d\x61\xb6\x81\x1c\x2d\x67\x56\x75\xf8\xfe\x89\xb3\x66\x70\x39\xba\x7e\x54\xcf\x44\xc6\x21\x24\x04\x96\xc3\x12\x43\x63\x70\xb3\x67\x54\x38\x2a\xf6\xca\xe9\x84\xa8\x63\x7a\xf8\x01\xd5\x00\x17\xfa\xd4\x99\x1c\xd3\x1f\x2a\xd6\x64\xc0\x70\xcf\x18\x6c\x95\xe6\x80\x16\x46\x7f\xfb\xeb\xdb\xe4\x2a\x79\x03\x7f\xb9\x4a\xde\x24\x6f\x46\xe3\x16\x6f\x1b\x4f\x3b\xb1\x26\x32\x01\x45\x65\x1d\x9f\xce\x1b\x7f\x64\x0a\x75\x96\xe1\xf0\xa9\x5e\xed\x88\x60\x7f\x66\xe0\xa7\xf6\x72\x03\x3d\xb7\x9c\x85\x75\x92\x83\x8e\xce\xfc\xa1\xcc\xd9\x56\x7b\xee\xbf\xd2\xc9\xdb\xd0\x3b\x7d\xf8\xb6\x29\x47\xe8\x9f\x93\x6d\x52\x4b\x1b\x04\xec\xc9\xd9\xfe\x21\x76\x58\x86\x7b\xb9\xe5\xc6\x39\x25\x27\x4a\x87\x02\xb5\x4f\x70\x1e\x7d\xde\x13\x4b\xb6\xe1\x88\xb9\xf5\xd6\xef\x9f\xb2\x05\xfe\xe7\xee\x8a\x60\x79\x9e\x08\xfc\x5e\x91\xd9\x80\x66\x1b\x64\x63\x74\xac\xb8\x56\x28\x95\xa5\x8c\x03\x53\xc8\xd1\xb6\x4f\xb8\x83\xca\x1c\x28\xa6\x21\x50\xb9\x15\xe8\xd2\xfc\xdd\x0b\xe7\xfb\x9d\x02\x74\x00\xea\xed\x85\x11\xb8\xb0\x1e\x66\x10\x80\xad\x85\x12\x95\x56\x84\x92\xe2\x29\xa7\x5e\x8f\x0f\x88\xd7\xf7\xb7\xbb\x39\xe3\x2e\xe0\x43\x21\xa6\x6e\x47\x42\xcd\x5e\x18\xd7\x47\x58\xad\xa3\x48\x1c\x89\x56\xac\x1c\x0a\x65\x63\x81\x38\x06\x84\x15\x6d\x42\x66\xcc\x25\x6a\xed\x94\x7e\xb2\x21\x5f\x79\x7a\xdd\xae\x68\xe3\x27\xc5\xc2\xf2\x28\x87\x03\x74\x1b\xda\x71\x67\x68\xdb\x84\xb7\x3f\x39\x47\x77\x2f\x3a\x0e\xb5\x21\x46\x15\xda\x8a\x36\xa7\xa6\x6c\x29\x83\x65\x14\x0f\xf4\xa0\x15\xee\x68\xa2\x73\xa3\x08\x2c\x4b\x29\xc8\x57\x95\x27\xe9\x9f\x48\xf9\xba\xad\x86\x7f\x26\xd3\x7a\xef\x1d\xcb\x8a\x36\x97\x36\x18\x00\x7b\x47\x2e\x4a\xf6\xf5\x26\x0c\xd4\xd7\x0b\x9f\x50\x8a\xf6\xb4\x09\x9e\x70\xa7\xc6\x70\xaf\x1d\xff\x79\xc7\x39\xbb\xf5\x76\x73\xab\xc9\xde\x6b\xe7\x7b\x5e\x15\x76\x60\xe5\x4c\xd0\x61\x91\x77\x10\x15\x7c\x92\x51\x75\xef\x1b\x42\xfa\xb2\x5d\xb1\xdc\x29\xd0\xa6\x46\xe7\x6f\x80\x02\xa1\x40\xc2\x1f\xb1\x73\x02\xa5\xd5\xc4\x27\x3e\x7b\x69\x44\xa1\x68\xd3\x93\xc9\x11\x72\x91\xd4\x53\x2e\xea\x91\x70\xc3\x24\x31\xa5\x0c\xb2\xca\x33\xed\x6f\x4b\xd0\xd1\x52\xa4\x50\x90\x59\x72\x96\xe8\xd2\x7c\xa8\xa8\x4f\xc5\xa5\xd0\x06\x44\xa7\x2e\xd1\x13\xfa\xf3\x21\xd8\x9f\x3e\x67\x86\xed\xb0\x26\x84\xb7\x02\x4b\x56\xdd\x67\x8e\x62\x5e\x7a\x5f\xa0\x44\x61\x6c\x02\xd7\xfe\xee\x52\x52\x6f\x4c\x84\x8c\xb2\x4b\x86\x29\x08\x0b\x1c\x8a\xd6\x28\x39\x6e\xb2\xa5\x2b\x20\x19\xa2\xa8\x5e\xec\x1c\x16\x5c\xac\x72\x2e\xc0\xfe\xbd\x10\x24\xfd\x7d\xd5\x68\x45\x9b\xd1\x78\x47\xdd\xa3\x3b\x35\x0a\xf1\x75\x47\xc1\x4d\x30\xf6\xb7\x20\x23\x3f\x36\xfa\xcf\xce\x97\x93\x41\x17\xb3\xcc\xdf\x7a\xa3\x7c\x18\x18\x09\x4f\xea\xd2\xd0\xe2\x20\x89\x9e\xf2\x3e\xd0\x22\x80\xe9\xa4\x13\x0b\x32\xa4\x7c\x92\xa5\x0f\xe6\x10\x6d\xd6\x31\x6e\xaf\x84\xfc\xc5\x4f\x2f\x77\x39\x24\x9d\xd3\x16\x7e\xc2\xae\xfb\x20\x44\x9a\x7f\xa8\xd9\x0e\x36\xd8\xa0\x08\x31\xb2\xe6\x76\x0c\xa4\x8c\x48\xf3\x9a\x59\x4e\x72\x43\x22\xcd\x9a\x0f\x6a\x38\x72\x92\x0e\x52\xe8\xb0\xe3\xec\xf0\x35\xf7\x11\xa0\xd7\x0f\x77\x4d\xf1\x10\xab\xd2\x08\xf4\x44\x00\x1f\x18\xbc\x5b\x19\x9c\xc1\xd4\x4d\xb3\xa8\x7b\x5e\x75\x2e\xc9\x9b\x12\xa3\x77\x95\x38\x84\xe1\xd3\x21\x70\x50\xf8\xdb\xcf\x6e\xcb\x6d\x97\x59\x5c\xa3\x90\x38\x97\x75\x89\x14\x0e\xdb\x58\x20\x35\xcc\x5f\x06\xb3\xd9\x5b\xb8\xee\xc2\x18\x90\x76\x0d\x4f\xbc\x38\xad\x0a\x26\x3b\x60\x22\xef\x7f\x62\xda\xf0\xec\x8b\x2b\x19\xeb\x9e\x0c\x2a\x2b\xea\xf7\xb4\x21\x27\xcf\x56\x69\x63\x1d\x38\x51\xd4\x17\xcb\xb5\x32\x5c\x43\xb6\xbe\x24\xd6\x8a\x6a\xdf\xf4\xd1\x5f\xbb\x9c\x0e\x06\x94\x6e\x3b\x23\x53\xe1\xb6\xd0\xa6\x40\x37\x83\x0c\x1d\x4d\x98\xb3\x41\x62\xf8\xe8\x5f\x1c\x5e\x55\x04\xcf\x68\x59\x1b\x73\xca\xfe\x1f\x40\x16\x64\xed\x81\x7b\xc6\xa3\xe8\xae\x21\xaf\x0a\x64\xef\xc2\xcc\xfb\x51\x24\x04\x42\x65\x22\x45\xff\x56\x94\x91\x43\x21\x2d\xe0\x5c\x57\xc1\xfb\x5a\xf5\xbf\xba\x86\x0d\xa1\x3d\x15\x65\xf7\xe0\x08\x47\x3e\x2f\x65\xe1\xf5\x55\x75\x69\xbd\x0d\x7c\x4b\xae\xf7\x5f\xef\x9c\xe4\x3a\x5e\xf5\x34\xc1\x36\x32\x3c\xf6\xde\xa4\x17\xf0\x64\x2a\x1a\xc3\x8f\x28\x2d\x8d\xe1\xa3\x5a\x29\xfd\xfc\xfa\xbc\xfb\xc9\x67\xcb\x7b\x53\x7a\x0e\x1b\x9e\x5f\x91\x2d\x9f\x10\x3e\xa0\xcb\xcf\x38\xd6\x2e\xef\x62\x2e\xe4\x73\x79\x9f\x45\x94\x82\x52\xea\xbd\x1c\xfb\xfb\x3d\xc2\x2c\x76\x92\x72\xc2\x50\x1c\x1b\x87\x67\xcd\x58\xc1\xb4\x2f\xcb\x9c\x5f\x02\x72\xda\x29\x32\xf8\xfb\xe3\xfb\xfb\xe9\x4f\x3a\xa6\xac\x98\xa6\x64\xe3\xd1\xc2\x79\x66\x7b\xc5\x18\xdf\xf2\x1e\xfd\xa1\x53\xa0\x12\x0b\xb2\x2e\x89\xd4\xc8\xd8\x5f\xaf\x7e\x4b\xe0\x47\x6d\x80\x5e\xb0\x28\x25\x8d\x41\xc4\x32\xa7\x7e\x7f\xed\xa4\x47\x1e\x4c\xb3\x36\x5e\x3e\x32\x3e\x9d\x45\xa6\x9f\x3d\xb3\x0e\x57\x04\x3a\x32\x5b\x11\x48\xb1\xa2\x19\x8c\x6c\x49\x69\x67\xeb\xcf\x0a\x0b\xfa\x32\x82\xef\x9f\x73\x32\x04\x23\xfe\x1c\x85\x0d\xf7\x3e\x09\xb5\x1b\x87\x3a\xdc\x88\xe5\x92\x0c\x85\x64\x9c\xd6\xa4\xdc\x0f\x5c\x89\x89\x05\x28\xdd\x99\xec\x49\xb0\x3c\x4b\x4a\xc5\x42\x50\xb6\xc3\xc8\xaf\x57\xbf\x8d\xe0\xfb\x3e\x2e\x8e\x3a\xf4\x02\x57\xa1\xca\x10\x96\x31\xfe\x10\x0b\x37\xbb\x51\x0e\x5f\xfc\xf3\x16\x97\x0e\x2a\xe4\xfc\x4e\x43\x8e\x6b\x02\xab\x0b\x82\x67\x92\x72\x12\xee\x4d\x33\x78\x0e\x25\x69\x2d\xca\x50\xe2\x95\x68\xdc\xd6\x8f\x09\x9e\xde\xdf\xbe\x9f\x85\xdd\x58\x6d\x4b\xc5\x5b\x28\xed\x60\x21\x14\xca\x58\x77\x08\xdb\x96\x29\xb6\x0a\x4a\x72\xda\x3f\x0d\xfa\x58\xe9\xa5\xb1\xa8\x5c\x65\x28\xd9\x7e\x5c\xfe\x2a\x1f\xd8\xf7\xca\x7f\xcc\xfc\xfd\x9b\xff\x76\x92\xf9\x3f\x7c\x51\xff\x2a\xd0\xfe\x47\x2f\x67\x80\xbe\xef\xd8\xe9\x51\xd0\xab\x6a\x4e\x46\x91\x23\x8f\x3b\xd3\xa9\x65\xc8\x29\x95\xce\x4e\xf5\x9a\xcc\x5a\xd0\xf3\xf4\x59\x9b\x95\x50\xcb\x09\x1b\xe2\x24\x58\x87\x9d\xfa\x17\x93\xe9\x77\xfe\xcf\xab\x61\x3c\xf8\xd2\x75\x0c\x68\xef\x75\xeb\x5b\xa2\xf5\x8f\x49\xd3\x57\x01\x5b\x17\x72\xe7\xd7\x4e\x97\x8f\x21\x70\xa4\xdb\x34\xd8\xed\xc2\x43\x77\xfc\x9d\x50\x27\x52\x16\x98\x85\x50\x8a\x6a\xf3\xcd\x8d\x9f\x45\x5a\x19\xde\x7b\x33\x89\xbf\x71\x9b\xa0\xca\xf8\x7f\x2b\xac\xe3\xfe\x57\x91\x61\x25\xce\x0a\x04\x1f\xef\x6e\xff\x3b\x2e\x51\x89\xaf\xf0\xfa\xf0\x8c\x35\x03\x67\xaa\x3a\xa7\xb5\x4e\x1b\xce\x5c\x7b\x7d\xd5\xbc\xb9\xb1\x68\xc1\xc7\x24\x0b\x3e\x7f\xb9\xf8\x77\x00\x00\x00\xff\xff\xa4\x5f\xd4\x5b\xb5\x28\x00\x00")
 
 func operatorsCoreosCom_operatorsYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -225,7 +225,7 @@ func operatorsCoreosCom_operatorsYaml() (*asset, error) {
 	return a, nil
 }
 
-var _operatorsCoreosCom_subscriptionsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x6b\x73\xe3\xb8\x95\xe8\xf7\xf9\x15\x28\x27\x55\xb6\xb3\x92\xdc\x9d\x9d\x4d\x72\xbd\xa9\xa4\xdc\xb6\x7b\xa2\x9d\x6e\xb7\xb7\xe5\xee\xa9\xdc\x24\x37\x81\x48\x48\xc2\x98\x04\x38\x00\x28\xb7\xf2\xf8\xef\xb7\x70\x0e\x00\x82\xd4\x8b\x94\xe5\xc7\x4c\xcc\x0f\x33\x6d\x0a\x00\x81\x83\x83\xf3\xc2\x79\xd0\x82\x7f\x66\x4a\x73\x29\x4e\x09\x2d\x38\xfb\x62\x98\xb0\x7f\xe9\xc1\xed\x6f\xf4\x80\xcb\x93\xf9\xeb\xaf\x6e\xb9\x48\x4f\xc9\x79\xa9\x8d\xcc\x3f\x32\x2d\x4b\x95\xb0\x0b\x36\xe1\x82\x1b\x2e\xc5\x57\x39\x33\x34\xa5\x86\x9e\x7e\x45\x08\x15\x42\x1a\x6a\x5f\x6b\xfb\x27\x21\x89\x14\x46\xc9\x2c\x63\xaa\x3f\x65\x62\x70\x5b\x8e\xd9\xb8\xe4\x59\xca\x14\x0c\xee\x3f\x3d\x7f\x35\xf8\xd5\xe0\x97\x5f\x11\x92\x28\x06\xdd\x6f\x78\xce\xb4\xa1\x79\x71\x4a\x44\x99\x65\x5f\x11\x22\x68\xce\x4e\x89\x2e\xc7\x3a\x51\xbc\x80\x4f\x0c\x64\xc1\x14\x35\x52\xe9\x41\x22\x15\x93\xf6\x7f\xf9\x57\xba\x60\x89\xfd\xf8\x54\xc9\xb2\x38\x25\x2b\xdb\xe0\x70\x7e\x8e\xd4\xb0\xa9\x54\xdc\xff\x4d\x48\x9f\xc8\x2c\x87\x7f\xe3\xda\x47\xd1\x57\xe1\x75\xc6\xb5\xf9\x76\xe9\xa7\x77\x5c\x1b\xf8\xb9\xc8\x4a\x45\xb3\xc6\x6c\xe1\x17\x3d\x93\xca\x5c\x55\xdf\xb6\xdf\xd2\xe5\x38\xfe\xb7\x6b\xc8\xc5\xb4\xcc\xa8\xaa\x0f\xf2\x15\x21\x3a\x91\x05\x3b\x25\x30\x46\x41\x13\x96\x7e\x45\x88\x83\xa3\x1b\xb3\x4f\x68\x9a\xc2\xde\xd0\xec\x5a\x71\x61\x98\x3a\x97\x59\x99\x8b\xf0\x4d\xdb\x26\x65\x61\xd4\x53\x72\x33\x63\xa4\xa0\xc9\x2d\x9d\x32\xff\xbd\x31\x4b\x89\x91\xa1\x03\x21\xdf\x6b\x29\xae\xa9\x99\x9d\x92\x81\x05\xf1\xc0\x42\x30\xfa\x19\xf7\xe7\x1a\x07\x89\xde\x9b\x85\x9d\xae\x36\x8a\x8b\xe9\xa6\xcf\x27\xd4\xd0\x4c\x4e\x09\xe2\x17\x99\x48\x45\xcc\x8c\x11\xfb\x29\x3e\xe1\x2c\xf5\xf3\xdb\x30\x23\xec\xba\x34\xa7\x51\xf3\x75\xeb\x29\xcd\xa8\x10\x2c\x23\x72\x42\xca\x22\xa5\x86\x69\x62\x64\x05\x9f\xcd\xe0\x71\x9d\x97\x66\x73\xbe\xf4\x7e\xc5\x74\xb0\xe9\xfc\x35\xcd\x8a\x19\x7d\xed\x5e\xea\x64\xc6\x72\x5a\xed\xa1\x2c\x98\x38\xbb\x1e\x7e\xfe\xcf\x51\xe3\x07\x52\x5f\x4a\x8c\xa2\xe4\x96\xb1\x42\x57\x87\x82\x94\x85\x5d\x93\x5d\x1c\x19\x2f\x88\x51\x34\xb9\xe5\x62\x0a\x4b\x9f\xe2\x7a\xcf\x71\x63\xf4\x60\x69\xca\x72\xfc\x3d\x4b\x4c\xf4\x5a\xb1\x1f\x4a\xae\x58\x1a\x4f\xc5\x42\xd6\x93\x88\xc6\x6b\x0b\xa7\xe8\x55\xa1\xec\xb4\x4c\x74\x0e\xf1\x89\x68\x54\xed\x7d\x63\x99\x87\x16\x16\xd8\x8e\xa4\x96\x3c\xd9\xe9\xcf\x98\x3f\x1c\x2c\x75\x00\xb4\xdb\x69\x66\x5c\x13\xc5\x0a\xc5\x34\x13\x48\xb0\xec\x6b\x2a\xdc\x9a\x06\x64\xc4\x94\xed\x68\x0f\x6c\x99\xa5\x96\x8e\xcd\x99\x32\x44\xb1\x44\x4e\x05\xff\x7b\x18\x0d\x40\x64\x3f\x93\x59\xfc\x30\x04\x8e\x9b\xa0\x19\x99\xd3\xac\x64\x3d\x42\x45\x4a\x72\xba\x20\x8a\xd9\x71\x49\x29\xa2\x11\xa0\x89\x1e\x90\xf7\x52\x31\xc2\xc5\x44\x9e\x92\x99\x31\x85\x3e\x3d\x39\x99\x72\xe3\x29\x70\x22\xf3\xbc\x14\xdc\x2c\x4e\x80\x98\xf2\x71\x69\x37\xee\x24\x65\x73\x96\x9d\x68\x3e\xed\x53\x95\xcc\xb8\x61\x89\x29\x15\x3b\xa1\x05\xef\xc3\x64\x05\x92\xc8\x3c\xfd\x99\x72\x34\x5b\x1f\x36\xc0\xb7\xf2\x1c\x10\x4f\xf5\x36\xc2\xda\x12\x3f\xc2\x35\xa1\xae\x3b\xae\xa5\x02\xa9\x7d\x65\xa1\xf2\xf1\x72\x74\x43\xfc\x04\x10\xec\x08\xe1\xaa\xa9\xae\x80\x6d\x01\xc5\xc5\x84\x29\x6c\x39\x51\x32\x87\x51\x98\x48\x0b\xc9\x85\x81\x3f\x92\x8c\x33\x61\xec\x31\xcc\xb9\xd1\x80\x73\x4c\x1b\xbb\x0f\x03\x72\x0e\x0c\x88\x8c\x99\x3b\xb0\xe9\x80\x0c\x05\x39\xa7\x39\xcb\xce\xa9\x66\x0f\x0e\x6a\x0b\x51\xdd\xb7\xe0\x6b\x0f\xec\x98\x7f\x2e\x77\x58\x3a\x63\x84\x78\x06\xb7\x76\x77\xe2\x03\x3f\x2a\x58\x12\x8e\x03\x15\xe4\xac\x28\x32\x9e\x20\xc6\x9b\x19\x35\x24\xa1\xc2\xc2\x8b\x0b\x6d\x68\x96\x01\x3b\x69\x35\x8b\x75\xa7\x9d\xc0\xd1\x6e\x30\x07\xff\x7a\x89\x42\xd7\x7f\x08\x4c\xad\xd1\x62\x1d\x65\xb0\x8f\xa3\xb3\xcb\x3f\x6c\x00\x39\x41\xc9\x64\xc2\xa7\xab\xba\xad\x85\xe5\x39\x74\x01\x99\x86\x72\xa1\xdd\x10\xa5\x42\x68\x56\x9c\xca\xf2\x2e\x5a\xe3\xdb\x83\xb5\xb3\x5b\x09\xd9\x6d\x6b\xb6\x0f\x13\xf3\xd5\x3f\x34\x16\x70\x29\xe6\x78\x50\xad\xcc\x62\x89\x1c\x13\x73\xae\xa4\xc8\xed\x21\x9a\x53\xc5\xe9\x38\x73\x8c\x8d\x59\xf2\x85\x67\x0c\x97\xc8\xd4\xaa\x23\xb5\xe6\xab\xb8\x1e\xaa\x14\x5d\xac\x69\xc1\x0d\xcb\xd7\xac\x66\xd5\xb4\x3f\x53\x15\x51\x09\x8b\xbc\xab\xa6\x4e\x5c\x03\x3b\x75\x4a\xce\xc3\xc4\xd7\x7e\x66\x0b\xdc\xf1\x59\x8f\xdb\xd5\xb3\x06\xcb\xfd\xb3\x6d\x03\xf1\x01\x4e\xbf\xe1\xf7\x06\x58\xec\x09\x41\x06\xc6\x56\x42\x63\x40\xde\x97\x1a\x76\x8b\x92\xf3\xbf\x0e\x2f\x2e\xaf\x6e\x86\x6f\x87\x97\x1f\xd7\x83\x83\x6c\x3b\x28\xd5\x03\x34\xbe\xc3\x64\x0f\x3f\xfb\x3d\x52\x6c\xc2\x14\x13\x09\xd3\xe4\xe7\x47\x9f\xcf\x3e\xfe\xf5\xea\xec\xfd\xe5\x31\xa1\x8a\x11\xf6\xa5\xa0\x22\x65\x29\x29\xb5\x67\x1a\x85\x62\x73\x2e\x4b\x9d\x2d\x1c\xe5\x4a\xd7\x20\x6d\x13\x5b\x81\xdb\x52\xb1\x20\x9a\xa9\x39\x4f\x56\x83\x48\x0f\xc8\x70\x42\x68\x85\x40\x49\xc0\x70\xcb\xa8\xb2\x39\x4b\x7b\x30\x6c\x98\xb4\xff\x0e\x17\x45\x69\x3c\xc3\xbb\xe3\x59\x06\xa7\x42\xa0\xac\x94\x0e\xc8\x85\x2c\xed\x78\x3f\xff\x39\x2c\x4c\xb1\xb4\x4c\x40\x88\xb6\xc4\x80\x8b\xa9\xfd\xa9\x47\xee\x66\x3c\x99\x11\x9a\x65\xf2\x4e\x03\xa5\x60\x3a\xa1\x85\x5f\x7a\x0c\x1d\xbd\x10\x86\x7e\x39\x25\x7c\xc0\x06\xe4\xe0\xe7\xd1\x4f\x07\xf8\xf5\x42\x49\xfb\x09\x94\x93\x71\x56\x19\x37\x4c\xd1\x8c\x1c\xc4\xad\x07\xe4\xd2\x7e\x83\xa5\xf1\x3e\xc0\x08\x82\xcd\x99\xb2\xab\xf0\xbb\xd0\x23\x8a\x4d\xa9\x4a\x33\xa6\xb5\xc5\xb3\xbb\x19\x33\x33\x86\xa2\x78\x00\x18\xfb\xc2\x2d\xc3\x95\x8a\x08\x69\x06\xe4\x82\x4d\x68\x99\x01\x07\x26\x07\x07\x83\x26\xe3\xdb\x1d\xd5\xde\x2a\x99\x77\x40\xb7\x51\x5d\x73\x58\xb5\xf7\x87\x1a\x47\xae\x91\x35\xcd\x52\xc2\x27\x4e\x82\xe1\xda\x2e\x8a\xb0\xbc\x30\x8b\x36\x87\x66\x0b\x1d\x21\xad\x09\x01\x09\x3c\xe9\x3d\x2d\xbe\x65\x8b\x8f\x6c\xb2\xad\x79\x73\xfd\x2c\x63\x89\x25\x94\xe4\x96\x2d\x40\x9c\x25\xe7\x7e\xc0\xcd\x4b\xe9\xb4\x1c\xd2\x92\x3c\xfa\xa7\x6f\xa7\xb3\xb5\x5d\x7b\x20\xd9\xe7\x96\x2d\xda\x34\x23\xcb\x3a\x9d\x05\x0d\xf0\x3a\x0b\xab\xed\x50\x21\xed\x51\xd6\x3f\xdb\x29\xfa\xca\xc9\x1d\xc6\xa4\xdd\x9d\x53\xb3\x52\x60\xbd\x2d\xc7\x4c\x09\x66\x18\xc8\xac\xa9\x4c\xb4\x15\x57\x13\x56\x18\x7d\x22\xe7\x96\xf2\xb1\xbb\x93\x3b\xa9\xac\x22\xd7\xbf\xe3\x66\xd6\xc7\x5d\xd5\x27\x60\xf4\x38\xf9\x19\xfc\x8f\xdc\x7c\xb8\xf8\x70\x4a\xce\xd2\x94\x48\x38\xe2\xa5\x66\x93\x32\x23\x13\xce\xb2\x54\x0f\x22\xad\xab\x07\xfa\x40\x8f\x94\x3c\xfd\xfd\xe6\xc3\xbd\x23\xc4\x64\x81\xc6\x8a\x1d\xa0\x36\x02\xa1\x6b\x51\xa3\x53\x01\xe9\x2d\x85\xb2\x2a\x82\xdd\xf3\xdc\xb1\x45\xc7\x50\x3a\x2c\x63\x2c\x65\xc6\xa8\xd8\xd2\x03\xc0\xd6\xfd\xcc\x1e\x56\x87\x16\x46\xf0\x08\x50\xc8\xf4\x94\xe8\xb2\x28\xa4\x32\x3a\xa8\x08\x60\x73\xe9\xd5\xff\x04\x79\xb9\x47\xfe\x16\x5e\x66\x74\xcc\x32\xfd\xa7\xc3\xc3\xdf\x7e\x7b\xf9\xc7\xdf\x1d\x1e\xfe\xe5\x6f\xf1\xaf\x91\x85\xae\xde\x04\x6d\x3a\x32\x05\x21\xdc\xfd\xe9\xd8\xe8\x59\x92\xc8\x52\x18\xf7\x83\xa1\xa6\xd4\x83\x99\xd4\x66\x78\x1d\xfe\x2c\x64\xda\xfc\x4b\x6f\xe1\x04\xe4\x61\x89\x0e\x80\xf3\x9a\x9a\xd9\x9e\x49\xcf\x7a\x6b\xc4\xea\xa7\xb6\xdd\xde\x3e\xe1\x76\xd9\x19\x24\xec\x3f\xdf\xfa\xe9\x5a\x0e\x74\xa7\xb8\x31\x4c\x80\xdc\xc1\x54\x6e\x39\x71\xcf\x62\x6e\xc5\x66\xe7\xaf\x0f\x1e\x84\x78\x05\xa8\xed\xb0\x38\x98\xbd\x5b\x19\x22\x73\x20\xb4\x5e\x82\xaa\x74\xa4\xb3\xeb\xa1\xb7\xcc\xec\x7d\x21\xde\xde\xf0\xf6\xde\x67\x32\x58\x2e\xdc\xb2\x82\xa4\x79\x4a\xa4\xc8\x16\xe1\x77\x4d\x32\x0e\xd6\x08\x2b\x80\x06\x8b\xc4\x11\xbe\x1c\x24\x45\xd9\x73\x0d\x06\x39\xcb\xa5\x5a\x84\x3f\x59\x31\x63\xb9\x95\xd8\xfa\xda\x48\x45\xa7\xac\x17\xba\x63\xb7\xf0\x17\x76\xac\x7d\x60\xb9\x37\x8a\xd4\x49\xa9\x2c\xf3\xc8\x16\x9e\x82\xb0\xf4\x69\xcf\xa2\x07\xd3\x9e\x8f\x62\xd8\x8d\xab\x1d\x59\x6e\xd0\x16\x9d\xc1\xd5\xaf\x0a\x64\xc8\xb9\xcc\xca\x9c\xe9\x5e\x60\x4f\x28\xad\x8b\xb9\x95\x26\x97\xcc\x3b\xab\x9f\x8e\xa7\x2f\xe5\x73\xae\xa5\xda\x99\x0f\x72\x67\xf2\x94\xa5\xb1\x9a\xca\x44\xaa\x9c\x9a\xa0\x2e\x7e\x29\xa4\x06\x1d\xc0\xe1\x6c\x83\xa4\xbc\x3e\x68\xf5\xd9\x82\x1a\xc3\x94\x38\x25\xff\xef\xe8\xcf\xff\xf1\xcf\xfe\xf1\xef\x8f\x8e\xfe\xf4\xaa\xff\x7f\xfe\xf2\x1f\x47\x7f\x1e\xc0\x3f\x7e\x71\xfc\xfb\xe3\x7f\xfa\x3f\xfe\xe3\xf8\xf8\xe8\xe8\x4f\xdf\xbe\xff\xe6\xe6\xfa\xf2\x2f\xfc\xf8\x9f\x7f\x12\x65\x7e\x8b\x7f\xfd\xf3\xe8\x4f\xec\xf2\x2f\x2d\x07\x39\x3e\xfe\xfd\xcf\x5b\x4d\x8f\x8a\xc5\x87\x16\x07\x1e\x9f\xbe\xdb\x20\x2e\x0c\x9b\x32\xd5\xb1\x57\xeb\x6d\x25\xe4\x4b\xbf\x12\xda\xfa\x5c\x98\xbe\x54\x7d\xec\x7e\x4a\x8c\x2a\xb7\x1f\x8c\x8a\xa8\xed\x82\xe7\x1f\xfd\x69\x8d\x4c\xb1\x9e\x34\xef\x1d\x91\x35\x4b\x14\x33\xfb\xd2\x60\x70\x34\xcf\x3f\x0a\x99\x1e\x6a\x22\xd6\x98\x09\xd7\x4d\xfb\xdf\x42\xa9\xf1\x22\x05\xc2\xab\xe2\xbc\x13\x25\xf3\x01\x89\xcc\x42\x73\x9a\xf1\xd4\xb7\xbb\x65\x5b\xb4\x5c\xff\xbc\x28\x41\x3f\x2e\x25\x68\x84\xfb\xfb\xe0\x1a\x10\x13\xf3\x4d\x66\x9a\xa6\x4d\xd7\xb6\xad\x9b\xa3\xbd\x00\x65\x24\x29\x64\x51\x66\xd4\xac\x31\xdb\xad\xb0\x4d\x3b\xdc\xd7\xc1\x4c\x68\x37\x1a\xec\xc0\x8e\xca\xe5\xab\x8d\xa1\xe4\x2c\xcb\x08\x17\x78\x12\x60\x00\x6f\xcd\x53\x0c\xe5\x25\x42\xd1\xe0\x3c\xb7\x53\xb8\x9b\xb1\xa6\xa1\x91\x6b\xab\xeb\x28\xc3\xc5\x74\x40\xbe\xb3\xbf\x23\xcd\x72\xa6\x31\x2e\x48\x5e\x66\x86\x17\x19\x23\x81\xdb\xa2\x0d\x2d\x2b\x19\xa1\x5a\xcb\x84\x53\xe3\x66\xec\xee\x0f\xb5\xf1\xd3\x86\xd9\x18\x7a\x0b\xa6\xd0\x84\xa5\x4c\x24\x6c\x40\x3e\xc3\x75\x61\x58\xeb\xd8\x0a\x83\x60\xde\x87\x31\x28\x49\x4b\xbc\xda\x41\x7a\xb0\x7a\x8c\x61\x9e\x97\x06\x0c\xc5\x8f\x65\xc5\xb7\x3b\xee\x2c\x73\x91\x31\x1f\x48\x55\x10\xad\x29\xdc\x3d\xc8\x49\xa5\xba\xeb\xfb\x99\xef\xdb\x11\xde\x60\x6e\xdb\xca\xa9\x96\x28\x6e\x65\x63\xa8\x53\xda\xc7\xb6\x18\xb6\xa3\xb3\x3f\x49\x1a\xdb\x81\xbe\xb6\xa7\xad\x1d\x8c\x4b\x5d\xe9\x69\x5b\x6b\x52\xa1\xd8\x84\x7f\xe9\x80\x8f\x67\xa2\x52\x51\x78\xca\x84\xb1\x8a\x80\x02\x82\xaa\x58\xc1\x04\xe8\xe1\x8c\x26\x33\xa0\x0b\x8e\x8a\x56\x96\xe1\x87\xbc\x31\x42\x29\xa3\xfb\xf1\x1a\xad\x92\x62\x5e\xce\xd6\x4f\xfc\x6c\xb9\x5d\xdf\xff\xc1\x12\x32\x65\xa8\x5b\xac\x57\xae\x1b\xfb\x18\xf5\x70\x7e\x2e\xfe\x2f\xbc\xc0\xf3\x93\xb4\xda\x5b\xb8\x72\x2a\x24\x9c\xb5\x09\x37\x44\x5a\x89\xc0\x7e\x77\x40\x46\x2b\x7a\xe6\xd4\x24\x33\xd7\xe2\xf0\x50\x13\x34\xda\x36\x07\x1a\xa3\x89\x30\x2d\x33\x96\x12\xef\xb0\x81\x83\x76\x44\xa9\x9a\xab\xc2\x09\xd5\x9a\x4f\x45\xbf\x90\x69\xdf\x8e\x76\xb2\x0e\x21\x5a\x1c\xaa\xd8\xd5\x70\xfb\xc1\xda\x8a\x57\xc1\x38\xd1\x6e\x9b\x3e\x06\xfb\x5b\x24\x5b\x24\x32\x2f\x4a\xc3\x22\xe3\x5c\xb0\xeb\x8c\x17\xe8\x59\x14\xc9\x90\x95\x44\x74\x3f\x98\xe6\x54\xd0\x29\xeb\xbb\x8f\xf7\xc3\xc7\xfb\xe1\x5b\xf7\x01\x73\x1b\xaa\x85\x26\xc5\x4d\xe7\xb0\x0e\xbc\x77\x68\xb2\xc4\x97\x63\x67\x3a\xca\xe9\x17\x9e\x97\x39\xa1\xb9\x2c\x05\xc8\x64\xcb\xe0\x84\xcb\x6b\x96\xee\x07\x60\x2b\x00\xa5\xd7\x42\xaa\x25\xb4\x48\x67\xc4\x24\xcf\xd7\xb2\xd5\xca\xa2\xd5\xcd\x92\xd5\xc1\x82\xb5\xb3\xe5\xca\x1b\xa9\xdb\xe3\xe3\x47\x6f\x37\x6f\x60\x24\x17\x5b\x31\xd2\x1f\x70\x70\xed\x08\xe3\x70\x4d\x64\xce\x8d\x09\x2e\x59\x01\xc3\x7a\x84\x9b\x9a\xf5\xd3\x9d\x05\x3e\x41\x1a\xcb\x35\x61\x5f\xac\x36\xc5\xc1\x8a\xee\x6f\x2d\x7a\xc8\x65\xef\xb8\x06\x03\x1a\x15\x84\xe7\x45\xc6\x72\xef\x43\xda\xf7\xba\x99\x73\x32\x78\x39\x1f\x2f\xe7\x63\x55\x27\xdd\x45\x16\x89\xc5\x10\x34\x14\x8c\x59\x56\x89\x23\x16\xb3\x0b\x99\x6a\x27\x2f\x78\x1c\xb2\x67\xe1\xf2\x0b\xd7\xe0\x89\xfb\x91\x81\x65\x60\xc4\x8c\x26\x77\x33\xa9\x19\xf6\xa0\x8a\xb9\x71\x22\xd6\xe8\x2d\x21\x70\x8f\x00\x4e\xa3\x93\x49\xbd\x45\xca\x8a\x4c\x2e\x72\x90\x6c\x87\x26\x96\x67\x82\xe8\xc2\xf2\x22\xa3\x86\x05\xc1\x66\xb3\xb5\xe1\xde\x9c\x0f\xbe\x7e\xf9\xc5\x4a\x00\x51\x1c\x44\x0b\xd8\x36\x3b\xd6\x4d\x53\x0d\x48\x3b\x22\x93\xa3\xcf\xf2\x0d\x48\xf8\xd5\x1b\x80\xe6\xd9\xd5\xc5\x7a\x07\x49\xd2\xca\xbc\x42\xb6\x9b\x58\x96\x96\x71\xb6\x61\xaa\x0d\xe9\x15\x7d\x7e\xbd\x07\x2b\x7a\xa0\xf7\xd0\x78\xd5\x73\xee\x73\x21\x3a\x00\x1b\x2b\x96\x61\xe8\x83\x33\x34\xdb\x46\xce\x73\x7d\x3f\x1a\x59\x5b\xbb\x7b\x1b\x9b\x7b\x3f\x4c\x7e\x4f\x4a\x60\x2b\xa3\x7c\x6d\x33\x40\xc9\x8e\x8f\x2a\xb8\x1c\x59\x48\xa2\x7d\xde\x6d\x04\x2d\x8a\x0c\xee\xeb\x64\x5b\xdf\xac\x96\xea\x18\x2e\xbf\xe3\xa4\xc3\x96\xc7\x0e\xb7\x76\xe6\x87\x1a\x11\xc0\x9e\x8e\x19\x2f\x9c\x37\x23\x5a\xeb\x7c\xfc\xc2\x67\xb0\xa3\x56\x31\x25\xf6\x24\x0c\x45\x8f\x5c\x49\x63\xff\x77\x89\x36\x51\x8b\x37\x17\x92\xe9\x2b\x69\xe0\xcd\x5e\x97\x8d\x53\xe9\xb8\x68\xec\x04\x07\x44\xe0\x99\x04\x83\x74\x14\xd0\x80\xbe\xa2\x40\x0a\x3d\x80\xb8\x26\x43\x41\xa4\xf2\xab\x0b\x56\x5d\xed\x86\xf0\x9a\xa1\x90\xa2\x8f\x6e\x84\xab\xc6\xb8\x0c\x3e\x94\x31\x4c\x36\x0c\xe7\x86\xba\xb1\x14\x18\x7f\xc1\x10\x96\x8c\x26\x2c\x25\x69\x09\x93\x86\x70\x0c\x6a\xd8\x94\x27\x24\x67\x6a\xca\x2c\xd3\x4e\x66\x6d\x41\xbd\x8d\x2e\xe1\xd3\x82\x3a\xc5\x83\x6e\xd9\x3f\x20\xc1\xef\x80\x4b\x74\x23\xdb\xd8\x07\xc9\x5b\x4e\x0b\xbb\x75\xff\xb0\x54\x0c\xa0\xf7\x2f\x52\x50\xae\xf4\x80\x9c\x79\xd7\xdb\xf8\x37\x67\x03\x8b\x87\xb1\x23\x58\xa9\xef\x87\x92\xcf\x69\x66\xe9\x26\x0a\x78\x0c\xc5\x3b\x3b\x7a\x93\x59\xf4\x1c\x2f\xb5\xe7\x1b\xfd\x5d\xb8\x26\x07\xb7\x6c\x71\xd0\x5b\xda\xee\x83\xa1\x38\x40\xfa\xba\xb4\xc1\x81\x18\x83\x47\xc9\x01\xfc\x76\x70\x3f\xfe\xf2\x00\xc2\xdf\xd6\xbd\x34\x32\x63\x2a\x0e\xfd\xdc\xb2\x87\x37\x55\x7b\x58\x5a\x75\xbd\x1b\x8d\xf4\x38\xb7\x14\x37\x5e\x6c\xb1\x67\xab\x9a\x17\xa0\x96\x31\x34\x99\xa1\x17\xb7\x9b\x17\xc4\xd1\x2c\x88\xdd\x33\x83\x74\x1d\x10\xc3\x71\x48\xa3\xe0\xd2\xe7\xb7\x01\xdb\x7a\x0c\xe4\xa7\xdf\x45\xfe\xed\xd0\xde\xfe\x11\x30\xe4\xb7\xfe\x5f\xbf\xbb\x67\xdc\x42\x3b\xc6\x86\x53\xea\x20\x60\x5c\x42\x07\xc2\x45\x0a\x17\x4c\x6e\xa9\x00\x01\x1c\xcb\xc2\x07\x96\x35\x20\x97\x96\x50\x91\x9c\x51\xa1\xbd\x99\x0b\x6e\xa2\xaa\xc6\xda\x5d\x99\x45\x7a\x95\x33\x29\x54\x27\x83\x91\x2b\x39\x72\xb6\xaf\x1e\xb9\x06\x5b\x6a\xf5\x06\x4e\xd2\x95\xbc\xfc\xc2\x92\xd2\xac\xbd\xcb\x8a\xe1\xb6\x95\x8b\x6c\x65\xf4\x35\x80\x7c\x5b\x31\x79\x5c\x59\x8d\xc9\x57\x18\x1c\xb3\xf9\x8d\x90\xb9\x65\x8b\x8a\xd9\x38\x11\x02\x48\x7e\xaf\xc2\x12\xcf\x0a\x90\x77\xfc\xb7\x37\x65\xe5\x63\x2e\xf0\x63\x38\xb4\xdf\x0a\x18\xdd\x03\xd4\x4a\x76\x59\x86\x9f\xd9\x07\xb8\xda\xc9\x19\x35\x98\x7d\xe8\x20\x63\x04\x2a\xb9\x5a\xba\x88\x44\x8a\xcb\x1f\x4a\x9a\xd5\x83\x10\xdc\x2b\xd7\x68\x89\xaa\xdf\xf1\x2c\x4d\xa8\x72\x5e\x5e\x18\xa6\xa9\x25\xee\x1e\x05\x42\x90\x50\x11\x4e\x7b\xb5\x47\x1a\xaf\x2a\x0b\xaa\x0c\x4f\xca\x8c\x2a\x1f\x39\xde\x2a\x50\x60\x2b\x44\x2b\xa4\x19\xb1\x44\x8a\xb4\x8b\x02\x70\xd3\xec\xdb\xbc\x6b\x2d\x98\xe2\x12\xbd\x8b\x79\xce\x9a\x48\x7a\x54\xb7\x69\xcb\x89\x3f\xd5\xe1\x88\xd5\x2c\x1f\x10\x9b\xe9\x19\x1e\x9f\x0a\xa9\x58\x7a\x1c\x91\xc7\x70\x2a\x06\xe4\xcd\xc2\x9b\x59\xc0\xe4\xe2\xa2\x2b\x34\x33\x3e\x10\xc6\xa3\xac\x03\x76\x75\xa0\x26\x52\x41\x70\xca\x51\x2a\x31\x22\x63\xce\x13\x73\x3c\x20\xff\x97\x29\x09\x1b\x2f\xd8\x94\x1a\x3e\x0f\xdc\x34\x28\xae\x8a\x51\x77\x83\xff\x8a\x1c\x41\x37\xc2\xf3\x9c\xa5\x9c\x1a\x96\x2d\x8e\x51\x8f\x65\x44\x2f\xb4\x61\x79\x9b\xad\x6b\x63\x34\x40\x5f\x3b\x68\xfb\xab\xaf\x37\xb4\xec\x1a\x43\xf5\xd9\x47\xa5\x54\x90\x41\x1f\x82\xc6\x16\x06\x1e\x24\x37\x88\x9b\xb1\x0f\x82\x0b\x6c\xf6\x92\x65\xbc\xc1\xdf\x5b\x3c\xa0\x44\x31\xc8\x40\xe0\x30\xf7\x9e\x38\x8e\xde\x94\xef\x65\x29\xd6\x9b\x04\x6b\x0b\x7f\xe7\x94\xf0\xcf\x51\xc7\xb5\x51\x8a\x8f\x22\x26\x44\x33\x89\x4c\x94\x94\x80\x5d\x12\xd8\xb9\x25\x0f\xd8\xaa\xf2\x44\xd9\x3a\xc9\xbd\x46\x24\xc2\x5c\xb6\x78\xbd\xef\x25\x6e\x31\x7c\xa8\x03\x2e\x83\x83\xb8\x03\x4c\x23\x6e\xcf\x38\x72\x00\xf8\x89\x10\xac\x10\x14\xbe\xc5\x52\xef\xc5\x66\xa9\x81\xeb\x4a\x0e\x4f\x0f\xf7\x42\x7c\x71\x39\x4a\x16\x74\x0a\xe7\xa9\xc3\xaa\x9a\x5d\x49\xca\x0c\x53\x39\x04\x5c\xcf\xe4\x1d\xfe\x8e\x6c\xab\x70\xad\x58\x5a\xc5\xb6\xcf\xa4\x06\xae\x54\x0f\x62\x84\xf3\x0b\x17\xa3\x77\x74\x41\xa8\x92\xa5\x48\x9d\xd4\x14\x08\xe8\xfb\xc6\x87\xaf\xa4\x00\x4a\x51\x6a\x0b\xab\x9b\x1a\x95\x1e\x33\x43\xed\xb1\x79\x3d\x78\xfd\x6a\x2f\x00\xeb\x18\xb7\x0a\xb3\x69\x58\x0a\xfd\x5d\xb9\x3f\x33\x7b\x99\x97\x62\x34\xfd\x20\xb2\x2e\xb2\xdc\x7b\x44\x2f\xe8\xda\x07\x25\x8c\x4f\xc0\x76\xdb\xc3\x57\x77\x8a\x1b\x16\x91\xc7\xa3\x09\xcd\x34\xb3\xaa\x7b\x29\x82\x08\x7b\x5c\x17\x41\xa0\x49\x9b\x05\x6d\xf7\x07\xd1\xe5\xf8\x9e\xe7\xcc\x1d\x28\x40\xb9\xea\x98\x05\x84\x3b\xd4\x1b\x8e\x5c\x3d\xb8\x93\x1c\x61\x4b\x2b\xb1\x49\x69\x8e\xf7\xe3\x24\x82\x0b\xb4\x9a\x75\x17\x95\xc4\xc7\x0d\x17\x7b\x5c\xed\x1b\x36\xa3\x73\xa6\x89\xe6\x39\xcf\xa8\xca\x20\x56\x70\x84\xf3\x23\xe3\xd2\xac\x8e\x40\xef\x16\xdd\x1c\xcf\x24\x1a\x6e\x2b\xa8\xfd\x3c\x2c\x9c\x80\x46\xf8\x79\xd9\xef\xe4\xa5\x29\x69\x96\x2d\x08\xfb\x92\x64\xa5\xe6\xf3\xfb\x9e\x26\x17\xfd\xb0\x03\xab\x6e\x72\xe9\x42\xa6\xa3\x82\x25\x8f\xc9\xa3\xeb\x1a\x86\x25\x55\xa9\xdf\x74\xe0\xc9\xa8\xec\x83\xe6\xbe\x00\xcf\xa7\x24\x61\x5a\x7b\x9f\xca\x45\xec\xe7\x19\xd6\xf0\x63\x49\x28\x40\xef\xf4\x65\x46\xb5\xe1\xc9\x9b\x4c\x26\xb7\x23\x23\x55\xa7\x98\xfd\xb3\xef\x46\x4b\xfd\x1b\x69\x18\xce\xbe\x1b\x91\x0b\xae\x6f\xe3\xc4\x2e\x78\x69\x1a\x9b\x4b\x28\xb9\x2d\xc7\x2c\x63\xe6\xf0\x50\x23\x97\xcb\x69\x32\xe3\x82\x79\x06\x27\x42\x48\x8a\x53\xf8\x2c\x94\xbb\xde\x99\xba\xc0\xa7\x13\x87\xaf\x3f\xa3\x77\x9a\xe1\xf4\xc7\x76\xfa\xf6\x67\xd6\x26\x22\x7d\xaf\xf7\x14\x38\x99\xe1\xc5\x9e\xee\x20\x26\xfa\xc6\xce\xb1\x9b\x71\xfb\xf0\x2d\xcf\x18\xea\x38\xb0\x44\xef\x95\xe6\xce\x01\xec\xd8\x42\x96\xe4\x8e\xa2\x56\x0c\x34\x70\x40\x6e\x78\x71\x4a\x2e\x85\x2e\x15\xab\xec\x19\x93\xc6\x50\x5c\x57\x91\x65\x5e\x9d\x82\x1d\x46\x95\xc3\x52\x3a\xa7\x5d\x91\xcb\x2f\x34\x2f\x32\xa6\x4f\xc9\x01\xfb\x62\xbe\x3e\xe8\x91\x83\x2f\x13\x6d\xff\x27\xcc\x44\x1f\x0c\xc8\x30\x0f\xf7\xec\x90\xfa\x47\x31\xef\xfa\x84\x1d\x2c\x33\x8e\xf8\xec\x83\x20\x88\x73\xa3\xb3\xd2\x5a\x2a\xc9\x1d\x66\xa0\xb0\x24\x9e\x29\x25\x55\xf0\x3c\x8f\xc0\x00\xdc\x25\x91\x79\xa1\x64\xce\x23\xc3\x1e\x20\xf8\x5e\xfd\xeb\xc0\xdc\xb0\x5d\x24\x5d\xde\x7f\xcc\xe9\xe6\x3a\x93\x3a\x73\x5c\xb7\xfb\xc3\x89\xf7\x98\x40\x55\xd1\xe9\xee\xa0\x7f\xba\x46\x76\xbf\xdd\x28\x96\x5a\xc5\x3b\xfc\x36\x44\xcd\x91\x93\x94\xcd\x4f\x74\x4a\x5f\xf7\xe0\x33\xda\x79\xfb\x99\xda\x9c\xa8\x26\x07\xaf\x0f\x06\x64\xe4\xb9\x6d\x2f\x9e\x63\xd5\x6e\x22\x55\x18\x10\x8c\xe9\xaf\x0e\xc8\x91\x54\x30\x72\x42\x05\xc9\x18\x9d\x3b\x03\x32\x9e\xa9\x05\xea\xb4\xc7\xad\xa3\x1e\xdb\x06\x80\x45\x5a\xfe\x7f\xfe\x72\x4b\xeb\x76\x92\xe8\xf2\xbe\x79\xcf\xc8\x03\x2b\x82\x1e\x80\x30\x29\x2d\x8d\xb5\x54\xd3\xb2\x55\x48\xab\xe5\xc6\xae\x16\xcc\xc5\x92\xa6\x8c\x03\x6c\xdc\xd4\x03\x90\x53\x0f\x9e\x80\xea\x92\x8e\xf1\xf5\x9e\xa4\x76\x85\xe6\x27\xc1\x7f\x28\x19\x19\x5e\x84\xc8\x7a\xa6\x34\xd7\xc6\x9e\xee\xb4\xc6\xc3\x38\x32\xb6\xa3\xb3\x9c\xfe\x5d\x0a\x72\xf9\x66\xe4\x3e\x7a\xfc\xa4\xe0\xd9\x4a\x24\xe8\xdf\x4b\xc5\x2c\x3b\xee\xe2\x30\xe0\xfb\x34\x39\xbb\x7d\x4f\x2e\xa8\xa1\xc8\xe0\x9d\xcb\x95\xa8\x28\xbc\xc5\xc2\x31\x17\xa9\xfb\x29\xe2\xdc\x8f\xcd\x64\xed\xee\x5d\x6d\x92\x97\xe2\x86\x9f\x3e\x0e\xf7\xc4\x8c\x13\xa0\xf1\xd3\xf7\x32\xed\xcc\x91\xff\x60\x01\x78\x8e\xfd\x49\x6e\x07\x20\x56\x67\xef\xc1\x71\x26\xf6\x3c\xbb\x7f\x7e\x67\x35\xce\xd6\xc4\xab\x15\x1b\xf1\xd0\xea\x38\xe7\x9b\x48\x4f\x07\xda\x61\x51\x03\xce\x8d\x63\x28\xe3\x4c\x8e\x89\xc3\xf7\x7d\xcf\xf7\xd3\xc7\xe1\x0e\xd3\xfd\xf4\x71\xf8\xb8\x53\xdd\x49\x3c\x6b\x4a\x67\x15\x0f\xae\xc2\x31\x9a\x62\x57\x7b\x99\x6b\xb0\x2f\x69\x6b\x9f\x70\x5a\x95\x55\x72\x0b\x94\x0e\x2f\xbf\x14\xe8\x7c\xe6\x8c\xfc\xa3\x19\x85\x38\xe6\x10\x5d\x07\x9b\x6a\x77\x59\x5b\xca\xee\xb7\xd7\x6a\x74\x40\x9f\xc8\x05\xc3\x2b\xcb\xf4\xd4\x3b\x02\x84\x1e\xab\x3b\xbc\x07\xb7\xcb\xf4\x14\xe9\x2a\x41\x2f\xcc\x34\xc2\xa6\x23\x34\x11\x89\xf0\x13\x9d\x53\x9e\xd1\x31\xcf\xb8\x59\x58\x0e\x7d\x3c\xa8\xb9\x96\x6a\x98\xf2\x5e\x0f\xf3\x8e\xa2\xc5\x92\x81\x8a\x1c\xd9\x91\x4e\xc0\xc0\x75\x3c\xa8\xa4\x8a\x19\x53\x2e\x08\x11\x45\x8f\x9a\xc8\xa1\x99\x01\x6c\x6b\x48\x1c\x6d\x51\x65\x3b\xbb\x07\xc0\xdb\xf3\xd1\x95\xa1\xd9\x3e\x2b\x19\x1a\xfc\x30\x72\x39\xe1\x9e\x33\x4f\xc3\x78\xa9\x56\x5c\x0d\xd0\x6a\x6b\xcb\xf6\x7c\xed\xa7\x8d\x53\x24\x04\xa3\xed\xc0\x04\xed\x54\x85\x63\x82\x3e\xbe\xbe\xe6\x46\x89\x58\x36\x72\xa4\xc4\xa5\x4b\x42\xbe\x69\x71\xeb\xdb\x16\xa9\x02\xba\x24\x58\xf0\x3b\xdf\x35\xe4\x6a\x06\x6e\x15\xdb\x91\xab\xf5\x6c\x12\x56\xcc\x26\x5d\xee\xa9\xcf\x59\x31\x7b\x3b\xaa\x9b\xe7\xec\x3b\xf2\x76\xb4\xe2\x5c\x02\x90\x61\xb5\x1a\x8d\x76\x87\x9a\x64\x7c\xc2\x0c\xdf\xb2\x84\x07\x38\x99\xb9\x14\xdc\x48\xb5\x3e\x2e\x99\x74\x3a\x6d\x7e\xb8\xae\xfc\xb0\xca\xe4\xf1\xde\x8d\x80\x0e\x70\x89\xcc\x32\x96\xf8\x3c\xd6\x00\x52\xff\x89\x55\xca\x0b\x73\x3a\x7b\xc8\xf2\x8f\x8a\xca\x09\x6e\xe8\xc9\xc7\xcb\xb3\x8b\xf7\x97\x83\x3c\xfd\xd9\x4c\xde\xf5\x8d\xec\x97\x9a\xf5\x79\x8b\x54\x21\x4f\xe7\x46\x88\x4f\xd1\x2a\x73\x55\x1d\xa4\x1f\x7c\x00\x23\xf9\xa4\xd1\x6d\x00\x4c\x39\xfe\x52\x48\x4a\xd3\x23\x8a\xba\x20\x45\xea\x2c\x41\x65\x96\x21\x94\x8d\x62\xac\x17\xab\xd4\x1b\x63\x33\x3a\x2f\x68\x57\x23\x42\xb5\xa8\x87\x25\xd0\x8f\x8f\x5c\x5d\x68\xfd\x76\x21\x62\x13\xe4\x46\x61\x0c\xef\x7f\x01\x57\x4d\x46\x82\x7f\x16\xf8\xdb\x4e\xa4\xb2\x58\xa3\xea\x18\xc0\x4c\x02\x8b\x3d\x29\x35\x53\x03\xc7\x31\x1e\x1d\x50\x1d\x92\xf5\xec\x90\x23\xad\x09\xa6\x8f\x6c\x82\x0e\xc9\x3e\x67\xae\x93\xa2\x68\x69\x66\x4c\x18\x9f\x72\xdc\x01\x63\x25\xdc\x9c\x87\xf3\xa3\x03\xaa\x65\x7a\xa0\x6e\xc9\x7c\x5e\x12\xe0\x74\x41\x43\x7b\x50\xee\x45\xb7\x43\x74\x94\xa2\xa9\x04\x17\x08\xcc\xe9\x56\x43\x30\x9a\xe6\x5c\x3c\xc3\x83\x98\x70\x91\x6e\x5b\x7f\x23\x71\x1d\xf4\xa8\xcb\x51\x38\x8a\xb7\x9e\x87\x9b\x38\xea\xf5\x1a\x0c\x21\x77\x77\x72\xf5\x1b\xb9\x56\x87\x2e\x5f\xe8\x1f\xb2\x3e\x7e\xa5\x5f\xa4\x15\x54\x5e\xae\xd7\xf6\x6f\xc0\x79\x84\x4b\xb3\x3d\xed\x2f\xf9\xf7\x13\x68\xee\x0d\xa9\x2e\x32\xcc\xbd\x78\x33\x54\x4d\xd1\x3e\x68\x0b\x33\x82\x61\xf9\x15\xa7\xbb\x5a\x10\x14\x54\xd1\x9c\x19\xa6\xd0\x75\xcc\x39\xa3\x09\xe7\xd5\xff\xa1\x60\x62\x64\x68\x72\xbb\xef\x14\xa2\x2f\xfc\xf4\xe1\xf8\xe9\xae\xb7\x65\xde\x49\x26\x0d\x98\xe0\x12\x0a\x2d\xe2\x9b\x59\x2e\x1c\xb3\x79\x26\x74\x25\xe4\xf1\xea\x62\x89\x08\x79\x9c\xea\x4c\xb4\xca\xeb\x85\xc6\x07\x70\x11\x0b\x89\xe9\xc0\xf5\x1d\xa1\xb0\x1f\xa6\xd7\xfe\x10\x38\x39\x66\x97\x7b\xa7\x8a\x1e\xe4\x32\x65\x64\xcc\x4d\x75\xd2\x35\x33\xa4\x60\x2a\xe7\x2e\x00\x5a\x0a\xac\xc1\xc7\x52\xe4\x5e\x96\x53\xb9\x4f\x47\x9c\x4d\x10\x99\x18\x5f\xe4\x8a\x8c\x99\xb9\x63\x4c\x90\x57\xaf\x5e\xbd\x02\x79\xe3\xd5\xaf\x7f\xfd\x6b\x02\x19\x17\x52\x96\xf0\x7c\xb9\x21\xb4\xfa\xaf\xd7\xaf\x07\xe4\x8f\x67\xef\xdf\x81\xff\x55\x61\x34\x19\x4b\x33\x73\x23\xdb\x06\xb5\xce\xba\x47\xfe\x67\xf4\xe1\xca\x8b\x09\xba\xf1\x2b\xa8\x14\x61\x79\x75\x67\xba\x57\xbf\xfa\xfa\xeb\x01\xb9\xe0\x0a\x22\x6f\x39\xc4\x0a\x04\x77\xc1\xc2\xbb\xd0\x09\x69\x96\x63\xdd\x1d\x9b\x70\xee\xb4\x39\x9f\xce\x0c\x56\x4b\x02\x4c\xc9\x78\x62\x30\xfb\x1e\x1e\x76\xcc\x85\xa4\x5d\x28\x89\x0b\x8c\x72\x8e\x23\x30\xb9\x1e\xc9\xf8\x2d\x23\x13\xfd\x8d\x92\x65\x51\x05\x04\x2a\xa6\xad\x8c\xea\x6a\x31\xe1\x60\xd5\x5e\x69\x66\x9e\xd4\x93\xa1\xa5\xa5\xa6\x86\x74\xc3\x9a\x00\xd2\x0b\xf9\xc7\xfa\x88\x09\x05\xe5\xc1\xb9\x0e\xae\x9b\x6b\xd9\xef\x83\x16\x99\x46\xe7\xd4\xc7\x77\x14\x4a\x7e\x8f\x9b\xc4\x85\x8f\x14\x72\x32\xaf\x76\x32\x97\x0b\xcc\x04\x9b\x2d\xaf\x47\xae\x5b\xbe\xe7\xa2\xe2\xa3\x18\xa3\xe1\x24\x0e\x46\x83\xd0\x6d\xae\xed\x27\x6a\xc9\x21\x57\x7c\x39\x2e\x4f\x68\x66\x1a\x77\xb4\x14\x4b\xbd\x5d\xad\x11\x47\x69\x5c\x05\x1a\x17\xe6\x55\x8d\x81\xee\xaa\x2e\x48\x26\xaa\x6b\x54\x4b\xd8\x56\x73\x92\xd1\xcc\x94\x0e\x34\xe0\xab\x64\xbf\xcd\xb4\x76\xb1\x36\x39\x55\xb7\x56\xec\x77\xe7\x7f\x00\x9e\xc1\x3a\xc4\xf9\x60\xd0\xd5\x9c\x85\x22\x75\xb1\x67\xbd\xfd\xc8\xe1\x60\x70\x88\x07\x44\x2a\xcc\x77\x89\xd8\x6e\xdf\x3f\x51\x4c\x71\xdd\x73\x9b\x16\x51\x09\x3a\x57\xda\x83\xd6\x3c\x82\xa9\x83\x54\x9b\x2c\xb7\x9d\xc4\x97\x6e\xf9\x82\xdb\x66\x0c\xc6\x96\x45\x9b\xb2\x05\x5d\x25\xa8\x0e\x09\x86\xd7\xd7\x4d\x71\x47\xa0\x5d\xce\xe0\xce\x39\x70\x09\x7a\x45\xec\x32\xc7\xae\x4c\xce\x05\xb1\xd5\x2a\x66\x3d\x7f\xae\x36\x9c\x60\xf8\x47\x9d\x56\x39\x5a\x10\x49\x08\x55\x75\xaa\x2a\x16\xe4\x59\x33\xaf\x18\x5d\xba\x65\x63\xef\xc2\xc8\xf0\x69\x77\x49\x80\xcf\xd2\x39\x08\x34\xb3\xa8\x55\xbb\xc8\xd0\x00\x00\x72\xa3\x3f\x2c\x03\xf2\xde\xd1\x54\x44\x2e\x3a\xd6\x32\x2b\x0d\x76\xad\x7e\x8c\x09\x2e\x0c\xea\x53\x0e\x00\x95\x0d\xcd\x22\xf2\x6b\xaa\x7a\x5f\xed\x28\x31\x3e\x1d\x0e\xe3\x4b\xea\xcb\x27\x4b\x2b\x5b\x65\xec\xd6\x0f\x96\x62\x36\xd1\xbc\x8b\xaa\x34\x1a\x92\xa3\xaa\x54\x86\xbf\xe6\x1e\x0a\xc3\xd4\x84\x26\xec\x38\x56\xa1\x42\x49\x92\xe0\x59\xe3\x63\x03\x66\x54\xa4\x19\x8a\xd6\x09\x53\x80\xf2\xec\x8b\x2b\x96\x6b\x3f\x91\x2a\x0e\x45\x60\x8f\xde\x30\x2b\x0f\x32\x6a\x4a\xc5\x5a\x45\x18\xed\xd7\xad\x10\xa6\xb1\x2f\xa5\x0d\x06\xeb\xea\x52\x01\x9d\xbc\x84\x2a\xa2\x63\x55\x81\x09\xa1\x8a\x20\xd5\xb1\x5a\x3a\xb0\xa8\x04\xf4\x18\x48\xc5\x42\x96\xca\xd9\xbd\x7d\x6e\xd1\x44\x2a\xab\x08\xe1\xc0\x54\x13\xc5\xa6\x56\x5a\x55\x20\xd6\x62\x8b\xac\xb4\x2f\xf6\xea\xfc\xb5\x67\x27\xb9\x4d\x2e\x6e\x13\x27\x3e\xcb\x39\x4f\x3d\x8b\x84\xbb\xa5\xaa\xc4\x5f\x41\x75\x14\x77\x12\xa5\x63\x8f\x20\x8c\xc2\x38\x30\xd2\x10\xd1\x59\xf3\x9f\x8e\xad\xbb\x12\x12\x3d\xb4\xa8\xa5\xd0\x85\x08\xcb\x94\x5d\x97\xe3\x8c\xeb\xd9\x68\x47\x53\xe0\xd5\x8a\x21\xd0\x61\x60\xe9\xa2\x6e\xad\x79\x50\x33\xa1\x39\xb0\x3c\x4b\xc6\x2d\xb3\x85\xda\xc1\x12\x80\xe8\x7b\xc7\x98\x29\x21\x30\x22\x63\x2e\x9c\xdf\xfe\x14\xcd\xc3\x45\x68\x61\x02\x8f\x94\x7d\x12\x45\xed\x7d\x42\xb3\x4c\x37\xa3\x57\x3d\xa1\x45\x99\xc3\x47\x6d\xe1\x9e\x72\xbb\xdd\xa1\x4c\x48\x23\x15\xe4\xda\x85\x69\x92\x4b\x8c\x70\x11\x44\x0a\xdf\x08\xf2\x90\xf8\x0e\x51\x54\x1f\xc4\xee\x02\xca\xec\xb9\x8e\xe2\x8b\x0d\xf4\xe1\x6c\xa0\x3b\xde\x34\x54\x95\x94\x68\x14\x11\x5c\x2f\xf5\xec\x49\xa9\x27\xb9\x5b\xae\x24\xf6\x7a\x2b\x80\xdf\x3c\x33\x58\x9e\xbc\x73\xce\xb3\xcf\x8d\xee\xc0\xa6\xad\xde\x01\x87\xb7\xef\x34\x8b\x24\xc2\x4c\xa7\x10\x84\x23\xb0\x7c\xe4\x2b\x9e\x03\xec\x06\x5f\x1e\x6a\x92\xca\xa4\x0c\xb9\x51\x01\x68\xd5\x05\x58\x9b\x0c\x82\xa4\xeb\x71\xea\x9e\xd6\x2a\xfe\xc8\x56\xac\x4a\xe5\x9d\xb8\xa3\x2a\x3d\xbb\xde\xe2\x97\x5e\x67\xe7\x55\xaf\x58\x50\xf2\x83\x41\x25\x3c\x3a\x96\xa5\xa9\xd2\x67\xfe\xb4\x4d\xcf\x46\x5a\x8a\xd0\xd2\xd2\x4c\x5e\x8c\xd7\x2f\xc6\xeb\xe6\xf3\xe0\xc6\x6b\xdb\xa7\x9e\x0b\xb6\x76\x5c\x7d\x8a\x01\x9e\xb5\x75\xa5\x7d\x48\x2b\x68\x44\x60\x90\xba\x37\xfd\xe0\x1b\x72\x1b\x1e\x91\x6a\x6f\x23\x59\xcf\x53\x20\x60\xd5\x4f\x6f\x31\x7d\x20\x3b\x68\xfb\x5a\xbd\xf8\xac\x73\xc1\xdd\x54\xbb\x17\xa4\x86\xa8\xd8\x6e\xcf\x65\x42\xee\x39\xbd\x4b\xa4\x55\x19\x3b\x4c\xc4\xdc\xa1\x54\x27\x3e\x1d\x81\x4f\x3a\x6f\x00\xe9\x58\x48\x17\x9f\xae\xbb\x41\x76\x28\xaa\x8b\xcf\x13\x97\xd6\xc5\xa7\xb3\x89\x9b\x74\x2f\xb3\xbb\x62\xb9\x0f\x5b\x6c\x77\xc7\xa5\x3d\xbe\xf5\xbe\x57\x95\x78\x7b\xfe\x6c\xfd\xc5\x7a\xbf\xf4\x3c\xa2\xf5\x3e\x22\xdc\x9e\x18\x38\x00\xc4\x16\xfd\xd8\xdc\xe6\xcd\xfa\x63\xe6\xc5\xca\x41\x95\x81\xcc\xa2\x9c\x37\xe8\x4b\x55\xbf\x36\x3d\x1c\x0c\x0e\x0f\xbd\x99\xdf\xe1\x67\x69\x26\xfd\xdf\x10\x26\x12\x99\xe2\xa6\xda\xf1\x95\x36\xc0\xf4\x2b\xed\x3c\x9e\x4b\xee\xbf\x15\x5f\xbd\xc2\xd8\xdd\xb6\xa4\xc3\x09\xee\x5e\x3a\x7b\x15\xa4\x1f\xa3\x80\x76\x5c\x26\xbb\x5e\x15\x1b\x5b\xdc\xa7\x14\x76\x0c\xbc\x07\xe7\xaf\xad\x8b\x63\xe3\xb3\x0b\x7b\xdd\xa1\x50\x36\x3e\x8f\x5c\x2e\x1b\x9f\x9d\x38\x6a\xa7\xd2\xd9\x2b\x16\xf7\x78\x05\xb4\xf1\x79\xa6\xc5\x54\xea\x4f\xa7\x62\xda\xf8\xec\x56\x52\xbb\xde\xb7\xe3\xd6\xef\xa5\xbc\x36\x3e\xdd\x8a\x6c\xe3\xb3\xef\x52\xdb\xf8\xb4\x84\x04\xd8\xc0\x2f\x78\xa7\xe0\x81\x4b\xd7\xa7\xee\xf9\x68\x58\x5e\x48\x45\xd5\x82\xa4\xce\xd6\xb0\x58\x11\x80\x19\x45\x60\xde\x3b\x2b\x0a\xcc\x3d\xe5\x6a\x4f\xf1\x03\x1d\x82\x2f\x59\xca\xcb\xb5\x25\x8b\xd7\x81\xed\x3b\xc8\x86\xe5\x32\x69\xf9\xcb\x4d\x1c\x2a\xa4\x12\xa4\xc9\xad\xab\x91\xe3\x61\x88\x9c\x3e\x4e\xb9\x73\xd0\xc8\x7c\x0c\xc6\x30\xb8\xe9\x73\xb5\x00\x7d\x63\x1c\xbb\x66\xb8\xc2\x2b\x0f\x77\xf7\x7f\xe4\x1a\x1e\x5b\xf9\xe3\x3d\x30\xbd\x47\xda\x13\xd2\x31\xc8\x8c\xff\x9d\x41\x81\xad\xce\x29\xac\x24\x88\xdd\xa1\xf0\x57\x26\x93\xe8\x62\xb9\xc6\x7e\x00\xea\x01\xb3\xbd\x61\xde\xc2\xde\x7e\x1d\x85\x07\xb0\xe8\x64\x1a\xef\xea\x78\x02\xb9\x1b\x41\x44\x07\xd8\x05\x78\xdf\x44\x65\xf0\x4a\x6d\xbf\x04\xa9\xd5\xa3\x36\xd5\x87\xee\x7c\x0a\x49\x13\x55\x2a\xab\x2b\x16\xf6\x97\x91\x87\x40\xa4\x94\x41\x78\x82\x97\xc2\x75\x09\x32\xa0\xfb\x8a\x93\x85\xe4\x04\xee\xa3\xaa\xba\x5f\x21\x7b\xe1\x12\x56\x09\x9e\xd5\xd1\xca\xa7\x6e\x0b\x0b\x2f\x85\xf3\x22\x58\xc2\x91\xd5\x28\x52\x6a\xa6\xfa\xd3\x92\xa7\xbb\x20\xc7\x33\xe6\x6e\xad\x79\x5a\x77\x4e\xd6\x91\x7f\xdd\x83\x6b\x05\x2f\x8b\x0e\x74\xff\xe0\x32\xb8\x66\xd4\x08\x7f\x9c\x12\xae\xee\xa6\x41\xbd\x27\x40\x38\x72\xfe\xbe\xe7\x26\xe8\xad\x8e\x21\x24\x8b\xc4\x85\xc9\xf2\x5a\x3e\x47\x1c\x16\x31\x0f\xbc\x52\xfb\xf6\x3f\x5e\xbf\xf5\xc6\xfa\x31\x9b\xc8\xaa\x04\x08\xaa\x3b\xce\x97\x36\x65\x19\x83\x3a\xe9\xbe\x06\xbb\x6d\x00\xd7\xbc\xb9\x9c\x5b\x64\xfe\xb3\x20\x9f\x7c\x52\x7a\x3e\x39\x25\xf4\xb8\x16\xaa\xe0\xca\xaa\x08\xc6\x52\x74\xb0\xcd\xaa\xef\xa8\x52\xe8\x1e\x19\x1f\x7b\x67\x13\x38\x71\xc2\xca\x7c\x99\x17\x67\x51\x69\x56\xcc\x02\x00\x02\x7e\x95\xcc\x89\x16\xb4\xd0\x33\x09\xd5\xf5\x13\x5a\xd0\x84\x9b\x85\x05\xb7\x51\x34\xb9\x85\x32\x3c\x8a\xb9\x2f\xf6\x48\x72\xec\xfc\xb5\x62\x08\xd6\xdd\x7e\xcd\x4c\xc9\x72\x3a\x03\x4f\x56\x6c\x95\x64\x54\x7b\x00\xac\xec\xef\xb4\x19\x4d\xd2\x85\xa0\x39\x4f\x42\xd2\x3c\x25\xe7\x5c\x73\xe9\xac\xb9\x38\xae\xc5\x7a\x72\x1d\xf2\x9e\xa1\x91\xf8\x3c\xa3\x3c\x27\x47\x9a\x31\x12\x10\x03\x7f\x71\xd5\xda\xd1\x78\xa1\x98\xed\x1e\x5b\x90\x65\x48\xde\x2d\x5c\xc6\x81\x8a\xd2\x85\x2b\x2a\x64\x94\x70\xdc\xd2\xd5\x9f\x3e\x0e\x5b\xb7\x7a\x66\x52\xc1\xc5\xbc\xcf\x5a\xc9\x44\x2a\xa3\xeb\xc9\xb3\xeb\xa1\x8e\xd5\x0e\xc4\x33\x97\xdb\x0d\x7e\xc8\xa4\x98\xc6\x21\xfb\x15\x96\x5a\xb2\x2a\xa0\x96\xc9\x9c\xa7\x25\xcd\x90\xa0\xba\xc9\x9c\x8f\x86\xd8\x9d\x4f\x67\xa6\x7f\xc7\xc0\xec\x82\x7c\xa7\x72\x6d\xf2\x1f\xe5\x4b\x6e\x39\x5c\x03\x01\x36\xce\x6c\x80\x26\x2c\x3b\xb5\x3b\xba\x80\xfc\x2e\xce\x85\xa4\x76\x33\xea\x73\x6b\xe1\x10\x01\xee\x11\xd0\x61\x7a\x67\xa1\x36\x85\x95\x18\xc0\x2e\x65\xa1\x0c\x58\xbb\x3c\x37\x0b\xf8\x28\xd7\x5d\x78\xed\xca\x90\x51\xbb\x47\x20\xc5\xfd\x59\xa0\x85\x09\xae\x3b\xc6\x91\xef\x15\x0c\x81\x76\x6c\xcc\x70\x04\x8e\xf5\xee\x18\x7e\xc3\x04\x53\x3c\x69\xa0\x4e\xe8\x3a\xa5\x06\x0e\x1f\x13\xb6\x5b\x3a\xd8\xac\x1a\x3d\x80\x8c\x37\xaf\x50\xe9\xc6\x55\x23\xec\x28\x7d\x1c\x7c\x17\x59\xe1\xa2\x7b\x13\x7b\x4a\xa9\x48\xfb\x34\xb3\xf8\x79\xfd\xf9\xdc\xf9\x45\xe3\xb9\xab\xf9\x05\xf8\xc2\x42\x5c\x84\x4c\xd4\x56\x4a\x59\x79\xdc\x20\x00\x7e\xcc\x52\x20\x53\x71\x0d\xc6\x3b\xab\x70\x3b\x14\xb9\xfe\x7c\xde\x23\x7c\xc0\x06\xfe\xaf\xd0\xd4\xd3\x49\x23\xa7\xe8\x55\x18\x3c\x45\x01\xbb\x61\x2a\xb1\x6d\x2b\xee\xfb\xb7\xdf\xda\x49\xda\x5f\x7f\xd7\xff\x6d\x94\xdb\xf3\x77\x7f\xb3\xfb\xad\x6c\x83\xfa\xdb\xd8\x35\x2d\x24\xb2\xff\xdb\xb5\x4b\xf4\xec\xd2\x40\xff\xcd\xd5\xb7\x62\xc2\x58\xc1\xf4\x5a\xc2\xa5\x3f\x4f\x11\xe7\xe1\xdb\x8a\x7d\xef\xed\x94\x00\xa6\x60\x23\x4a\xa8\x61\x02\x58\x83\x8f\xe1\x10\xd2\x60\x77\x57\xca\xd5\xce\xff\x08\x2c\x0c\x18\x6e\xd6\x23\x46\x4a\x38\xf4\x48\x58\xce\x04\x61\xbe\xfc\x25\xae\x15\xc0\x41\x9d\xdf\x9b\xe7\x76\x76\x58\x0b\xe1\x10\x91\x6b\xe7\x01\x73\xfb\x85\x90\xe6\x17\x61\xfb\x1b\x85\xb9\xe9\x5c\x72\x9f\xd3\xdb\x9e\x47\x81\x45\x12\x43\x96\xe9\xf1\x82\xe4\x5c\x1b\x7a\xcb\x06\x64\x64\xb9\x59\x7c\xb9\x86\xd0\x13\x04\x72\x41\xb2\x94\x94\xc2\xf0\x0c\x7e\xad\xc6\xb1\x53\x8e\xb9\xdc\x70\x42\x74\x09\x15\xc3\x0b\xc5\xfa\x9e\x6f\xba\x56\x4b\x14\xa7\x5a\x4b\x2f\x6c\xf6\x8c\xa2\xb2\x51\xa4\xd0\x15\xe0\x41\x85\x43\xaf\x25\x6f\x30\x3b\x4f\x29\x92\x8a\x57\x02\x30\xf5\x80\x5c\x01\x7b\xcc\xfc\x0d\x33\xea\x3d\xce\x1e\x2a\x58\xc2\xb4\xa6\x6a\xd1\x83\x5c\xe9\x3c\xe4\xd7\x76\x0e\x40\x40\x3c\x72\x2a\x30\x53\xb9\x62\x89\x14\xda\xa8\x32\x31\x58\xba\x6e\xac\xe4\x2d\x13\xc1\xfb\x30\x10\xa6\xe0\x06\x56\xb9\xe3\xc0\xf5\x99\x24\xc9\x8c\x8a\x69\x54\xfa\x25\xa7\x29\xc0\xfe\xdb\x20\x57\xf9\xf5\x58\x08\xd0\x89\x15\x65\xb8\x01\x50\x8c\x2d\xc3\x0a\x56\xdd\x3f\x0b\xe2\x15\xf7\x5e\x65\x76\xb5\x4b\xe2\xd9\x16\xda\xd5\x89\x7e\x91\x8e\x36\xc2\x3e\x48\x09\x7b\x76\x23\xcb\x99\xa1\x29\x35\x74\x07\x57\xb2\xf7\x55\xbd\x3a\x5f\xb2\x1e\x6b\x86\x86\x7b\x4e\xc7\xed\xbc\x80\x27\x0b\x1e\x87\x4b\xc1\x49\x9c\x79\xc8\x43\xfc\xb5\xb1\x38\xe5\xee\x1d\xd0\x43\x0c\xc4\x27\x5f\x10\xcc\x0e\xef\x47\x43\x72\x51\x55\x3b\xac\xc8\x49\xbb\x5b\xad\x8e\x06\x5d\x0b\xfa\x1d\x60\x74\x53\x5d\xbd\x25\x75\x77\xb1\x95\x82\x0e\x72\x09\x26\x0c\x57\x2c\x8e\x4e\x73\xa0\x2b\x05\x22\x79\x03\x88\x00\xe5\x29\x33\xba\x72\x78\x41\x3a\x6c\x89\x8b\xe3\x77\x4e\xfd\x05\x22\xed\x00\xeb\x34\xc8\xd5\x12\x17\x82\x5d\x4b\x47\x67\x2d\xe5\x7f\x10\xb8\xee\x62\xc3\xc6\x0c\xfd\xef\x65\xda\xc5\xec\xdd\x48\x6c\x5f\x0d\x51\x79\x81\xa2\x3f\xaf\x06\x33\x02\x7e\x03\x2e\xbf\x74\x2d\xc6\x0e\x89\xdc\x8c\xce\x77\xb7\x79\x55\x92\x58\x3f\x24\x05\x86\xcf\xf5\xe1\x73\xfd\xd7\xed\x6d\x83\x5d\x1c\x4a\xfc\xd3\xda\xb1\xa4\xfe\x91\x4e\x86\x58\x4b\x52\x46\x1d\xad\xa7\xcd\x8c\xe5\x81\xda\xbb\xeb\xc8\x70\x05\xec\x42\x26\x18\xb7\x74\xe2\x94\xfc\xa2\xc6\xdf\x9d\x1c\x15\xb4\x32\xf4\xf4\x3d\xf2\x6a\xda\xc0\x6d\x82\x0f\x48\xaf\x37\x3f\x6e\x0c\x06\x82\xc5\x6a\x8d\xc5\x7b\x14\x07\x61\xcf\x0a\x66\x0a\xec\x72\x3e\x90\xc1\x22\x96\x92\x59\xc6\x14\x2c\xc1\xa9\x69\x8d\xeb\x78\xc8\x25\x8a\xc6\xe1\x5e\x50\x87\x83\x74\x29\xd8\x5d\x10\x23\xa8\xc6\xac\x2d\xfe\xea\x8c\xb9\x2a\x74\x6b\xc7\x0b\x5e\xcf\x67\x62\x81\x53\xbf\x08\xdb\xb2\x4e\x38\xef\xc5\x15\xdd\x60\x2e\x34\xbb\xa3\x0b\x0d\x18\x5f\x69\x0b\xe1\xfb\x2e\x43\x5a\x35\xf0\x47\x36\xc1\xde\xad\xaf\xd6\x76\xba\x5c\xdb\xe5\x7a\x0d\xe2\x2e\xb9\x68\xe3\xcb\x54\x75\xd8\x58\x85\xa3\xf9\xec\x72\x1f\x07\x0e\x2f\x70\x0f\xdf\xed\x72\xa5\x9e\xf2\xf4\x7a\x08\x43\x78\x69\x7c\x0a\x7f\x78\x5e\x13\x6e\x1f\xc6\xcc\x62\x75\x15\x51\x0d\x18\x12\xf7\x5d\xe1\x92\x50\xa1\xd6\xb7\x90\x16\xd5\x19\xa0\x43\xd9\x2e\xc5\xc0\xa5\x04\xbe\x38\x80\xb4\xff\x54\x2c\x1c\x0f\x37\x33\xae\xd2\x7e\x41\x95\x59\xa0\x7a\xda\xab\x7d\x2d\xb8\xe7\x77\x5a\xf8\x8e\xf7\x42\xed\x32\x0e\xaf\x85\x30\x2c\xde\x97\xde\x73\x86\xff\xb5\x70\x7d\x8c\xf5\xb4\x0f\x00\x58\xb9\x9e\xab\x28\x1e\xde\xeb\x82\x4f\xb6\x9e\x34\x26\x1f\xbb\x72\x8c\xc6\xad\x2d\x12\xfe\xb8\xf2\x93\x8c\x1d\xa8\x03\x47\x07\xe5\xc7\x4e\xa0\x67\x75\x4e\x5a\x95\xea\x8e\xcc\x86\x4e\x2a\xf0\xee\x37\xae\x50\x90\x58\x38\x63\x50\xfc\xad\x78\x80\x70\x2e\xc8\x91\x90\x02\xcf\x0a\xb6\x3d\x46\xef\xa3\x35\xd6\x2e\x68\xe2\x2a\xbc\xd5\x0b\x6c\x46\x67\xd3\xb3\x05\x2e\x52\xbb\x59\x40\xab\x41\x1f\xd2\x65\x92\x30\x16\x34\xe8\xb8\xde\x4b\x75\x96\xdd\x94\x7d\xa5\x48\x2d\x21\x95\x8b\x36\x34\xcb\x2a\xcd\xd5\x81\x4b\x02\x67\xf3\xc6\xc5\x88\xe1\xd5\x42\x73\x9c\x12\x0f\x35\xc8\xd1\x63\xa6\x14\x09\xde\xfe\x73\xb3\xf0\x33\x88\x39\x10\x74\x03\x95\x41\xa3\x42\xcb\x27\x68\xc9\x8a\x44\xff\x00\x4c\x20\x46\xae\x02\x7a\x9d\x17\xb9\xb4\x0d\x96\xf2\x8c\x69\x72\x7b\x47\x55\x0a\x95\x70\x0b\x6a\x38\x26\xe2\xee\xd5\x86\x3d\x8a\xe6\x00\x75\xe8\x63\xe4\x3b\x0e\x0a\x06\x94\xd7\x90\x8d\xcf\x10\x5a\x1a\x99\x53\xc3\x13\x50\x5b\xf9\x24\xb2\x4b\xe6\x21\x6f\x61\xa3\x6a\x1f\xd0\xd5\x50\xff\xfd\x06\xef\x7a\x14\x23\xe6\x4e\x12\x9e\x5b\x99\x80\x42\x01\x8a\x49\x88\x31\xf2\x46\xd4\x4d\x33\xb5\x82\xcf\x77\x60\xc2\x8e\x5a\xa1\x42\x6c\xd5\x25\x0d\xc3\x07\x1b\x69\x30\x0e\xba\x20\x9d\x5e\x83\x65\x13\xdf\xcb\x62\xb5\x9d\x6d\x84\xac\x3d\xbb\x41\x77\xcc\xca\x02\x7a\x23\xca\xea\xc1\xaa\x39\x61\x51\x58\x4d\x52\xae\x1b\x95\x9d\x8f\x52\x25\x8b\xc2\x99\x43\xf2\xe3\xe5\x39\xc1\xcd\x84\x9a\x33\x1d\x95\x2f\x46\x4b\xf8\x94\x89\x50\x7f\xdb\x65\xbb\x80\xd3\xdb\xfc\x08\x78\x76\x91\x28\xf9\xd9\xd1\x59\x56\xcc\xe8\x31\xf9\xe4\x0a\xf5\x04\xfc\x0d\x7e\x7b\xad\x24\x26\x34\xb0\x78\x8b\xe6\x8b\xa8\xd3\xf2\x79\x11\x75\x5e\x44\x9d\x7f\x6f\x51\x27\x38\x8c\xed\x2a\xe6\x7c\x0c\x5e\x92\x8d\xb2\xde\xde\xe3\xa0\x72\xa3\x7c\x78\xbb\x45\xf8\xd6\x03\x53\xc0\xdd\xa8\x0d\xba\x4e\xdc\x03\x73\x0e\xdf\xa1\xf3\x45\x55\xe1\xd9\x44\xfe\x20\x95\x2f\x8a\x95\x36\x4a\xc3\x22\xd0\x3b\x26\xd4\x19\xd6\xb5\xd8\xd2\x13\xac\x2a\xd2\x0f\xc3\xf6\x2b\xf7\x8f\x16\xa9\xc5\xe3\x67\x27\xa8\x93\x7b\x84\x51\xc6\xcf\x33\xf6\x00\x69\x2c\xb6\xbb\x8f\x23\xb9\xa7\x9f\x23\xb9\x8f\xaf\x23\xd9\xa7\xbf\x23\x09\x5e\xd3\xf7\x39\x31\x1f\xbd\xbf\x76\xe3\xcc\x38\xe2\xb4\xe9\xcc\xd4\xa2\xf5\xc3\x38\x5c\xfb\x8a\x75\xee\xb6\x2f\x9c\x01\xb0\x97\xc5\x5e\xb7\xee\xb4\x82\xe2\x83\x57\x7a\xec\x4b\xc8\x8d\x1b\xf1\xfa\xaa\x7c\xb3\x91\x70\xfd\x9f\x17\x98\x66\x07\x4e\x5d\xdf\xf9\x46\x79\xc5\xe2\xe5\x04\xbf\x9c\xe0\xb6\xfd\x9f\xf2\x04\xa3\x5f\x71\x17\xb7\xf7\xba\x5c\x8d\x97\x78\xe4\x87\x92\xa9\x05\x91\x73\x16\xf9\xd3\x40\x12\x60\xcd\x53\xe7\x91\xe2\x6c\x0e\xed\x65\xd9\x47\xe4\xf9\x60\xd1\xb8\xfc\x62\x25\x23\x88\x10\xbb\x07\x2d\x6b\x0e\x55\x0f\x02\x46\x68\x79\xa0\x7b\xe2\x65\xa9\x88\x1e\xb8\xec\x60\xd5\x1b\xd0\xf7\xcf\xae\x2e\x76\x53\x00\xba\xdd\xef\x90\x5d\xee\x78\x96\x16\x7f\xb6\x61\x81\x08\x88\xf0\x4b\xbd\xfe\x51\xd0\xd2\xc9\x2d\x5b\xf4\xdc\x95\xb0\xcb\x6b\xee\x1b\xa3\x67\x43\x3d\x19\x67\xdb\x24\x10\xab\x00\xb4\x03\x55\xdc\x4d\xab\xc6\xa7\x7d\xfa\xc6\x7a\x2f\x0f\x84\xae\xc4\x77\x67\xb2\xdd\x29\xcd\x63\xfc\xd4\x50\xc1\xa5\x26\x05\xc7\x39\xc0\x09\x48\x69\xe7\x9d\x8a\x03\x1a\x80\x23\x35\x50\x8b\xae\x9b\x48\x76\x57\x0d\xf1\xf1\x80\xbd\xf7\x52\x03\x9a\xd6\xbc\x62\x6f\xd9\xe2\x50\xbb\x78\x3c\x29\xf4\x8c\x17\x3e\x8b\x3a\x50\x02\x87\xb9\xe4\x33\x5c\x95\xfb\x21\xf0\xcc\x0f\x45\x8f\x5c\x49\x63\xff\x77\x09\x5e\x33\x68\xc8\x93\x4c\x5f\x49\x03\x6f\x1e\x1d\x58\x38\xdd\x7b\x83\xca\xd9\xf0\x38\x58\xe0\xd0\xbb\x0b\x62\x21\xbc\x37\x06\x80\xc4\x5d\x40\x06\xb0\x72\x4d\x86\x82\x48\xe5\x61\x62\x7c\xda\x5d\xed\x86\xf0\x36\x97\xc8\x60\xba\x62\x0c\x07\x4a\xa9\x6a\x90\xdc\x30\x5c\xb0\xbd\x72\xff\x0b\xd8\x64\xc0\x58\x1d\x5c\x48\x20\x79\x2c\x35\x6c\xca\x13\x92\x33\x35\x85\xc8\xcb\x64\xb6\xfb\x06\x75\xa7\xdb\xf8\xec\x44\xbd\xe3\x0f\x77\xc6\x0c\x60\x75\xef\xc0\x89\xe7\xbe\x0c\x13\x47\x41\x16\x91\xd3\xc2\x22\xc5\x3f\x2c\x27\x80\x7d\xf9\x17\x24\x7b\xd6\x03\x72\xe6\x2b\x70\xc6\xbf\x39\x43\x5b\x3c\x8c\x1d\xc1\xca\xf1\x3f\x94\x7c\x4e\x33\x86\xae\x6d\x54\x84\xbc\x98\x72\xb2\xc4\xa6\x7b\x2e\xe3\xb3\xa5\x52\xe1\xe2\xe4\xe0\x96\x2d\x0e\x7a\x4b\x88\x74\x30\x14\x07\x55\xf8\x73\x0d\x75\x02\x43\x03\x9b\xfa\x01\xfc\x76\xb0\x6f\xce\xfe\x44\xe2\xfc\x0e\x58\xe2\x8c\x40\xe7\x19\xd5\xba\x5b\xe4\xe8\xfa\xfc\x63\xa3\x68\xcc\x2a\x82\xc7\x39\x2c\x26\xe8\x10\xb5\x3f\x5b\x15\xf8\xd1\x77\x77\xae\xe9\x04\xa5\xb9\x2b\x1f\xd2\x3e\xf5\x41\x93\xaa\x86\x01\x42\xa0\xc4\x5d\x1c\x6b\x56\xdd\x49\xae\x81\xd7\x67\xb8\xf5\x90\x93\x38\x5f\x22\xd7\xa0\xe2\x72\x1f\x3a\x21\xa4\x21\x5c\x24\x59\x99\x62\x9e\x47\xe8\x0a\x0a\x72\x57\x91\x7e\x07\xe0\xdc\x03\x79\x3e\x87\x01\xbc\x3c\xe2\x6f\x3f\x97\x7c\x56\x9b\xd7\x54\x70\x35\x18\x6e\x7c\x10\x56\xfb\x5e\xeb\x64\x8b\x87\x60\x3d\x9d\xe5\x79\x5d\xc6\x78\xcb\xc7\x8a\x91\xf3\x19\x15\x82\x65\x51\xbc\xa8\x33\x64\x84\x12\x4e\x20\x78\xb8\xc2\x4d\x87\xf5\xca\x4d\x9e\x8e\x89\x10\x9d\xbc\xf7\xea\xb5\x3f\xee\x42\x4a\x7b\xab\x84\xed\xb2\x1a\xce\xe4\x1d\x49\x25\xb9\x83\x5c\xfe\x73\xcb\x8e\xe0\x26\x52\x7b\x46\x16\xcd\x14\x7c\x03\x12\x99\x17\x4a\xe6\x5c\x7b\x0f\x70\xb7\x71\x7b\x0d\xb0\xcc\xca\x16\x79\x73\xd6\x25\x5c\x79\x7b\x4e\x0c\x55\x53\x66\xec\x30\x44\x94\xf9\x98\xb5\x0e\xff\x7c\x88\x84\x5d\xcf\xbd\x42\xd4\x7e\x8b\x3c\x21\xe8\xbf\xfb\xee\xaa\x73\x29\xd8\x55\x3b\x78\x27\x55\x96\xde\xf1\x14\x2f\xbd\x34\x39\xb2\x03\x1f\x3f\xff\xba\xad\x77\x77\x3c\xbd\x1f\x00\xbc\x67\x8f\x05\x00\x01\x08\xb8\xca\x45\x1c\x72\x4a\xc3\x07\x8e\xc9\x25\xc7\xd8\x18\xfb\x17\x66\x6d\xc9\xc7\x5c\x54\x51\x58\x61\x33\x80\xae\xda\xf3\xe0\xb5\x09\xcd\x0c\x46\x35\x40\x60\x80\x34\x33\xa2\x79\x5e\x66\x86\x0a\x26\x4b\x9d\x2d\x5a\xa3\xc5\xd3\x00\x79\x92\xb1\x2f\x88\xc5\x5d\xf8\x55\xe8\x54\xe7\x5b\x53\x8c\xfd\xf2\x30\x5f\x62\x5c\x95\xbb\x50\x7a\x12\x98\x58\x08\x96\x61\x5f\x58\xe2\x3c\x5b\x8b\xac\x9c\xf2\x2d\xce\xfb\xff\x66\x29\xbe\xab\x24\xca\xa5\x66\x55\x64\x7b\xdb\x22\x26\x4f\x97\x91\xfb\x41\x99\xf5\xcd\xea\xb4\xdb\x29\x2b\x98\x48\x21\x23\x58\x84\xab\x38\xdd\xbd\xc2\xca\x65\xd7\xda\x9d\x42\x5d\x7e\x31\x8a\x5a\x72\x93\x43\x50\xa5\x4b\xd6\xc5\x27\x84\x8a\xf6\xa4\xe3\x79\x64\xc1\x25\xff\x76\x3c\xfa\xc1\x8b\x24\xdf\x2f\xf7\x3a\x52\x51\x87\xf6\xba\xee\xb0\xba\x22\x47\xba\xfb\x4a\xec\x59\x7a\xdf\x5c\xe9\x7a\x45\x7a\xe8\xc6\xac\x5e\x8a\x47\xfe\x28\x12\xa7\x4f\x20\x26\xb5\x4b\x3a\xa1\xb7\xd8\xa3\xa1\xd9\xba\x97\xcd\x62\xc4\x1b\x34\x59\x87\xb7\x11\x49\x87\xdc\x9d\x6e\x20\x17\x57\x43\xb4\x85\x65\xe5\xc2\x55\x0a\xb1\x8d\x58\x3d\x44\x3e\x6c\x6a\xa8\x66\xa6\x9d\x55\x63\xd9\x2d\xcd\x73\x7a\x1c\x05\x13\xb0\x83\x43\xb4\x0f\xcc\x24\xfd\xdf\x39\x99\x40\xd4\x5a\x5a\x69\xc0\x03\xc4\x67\x1c\x62\xe1\x9a\x16\xc7\x48\xed\x36\x24\xd4\xb4\xae\x16\xd3\x8a\xde\xbb\x19\x7c\xfa\xd4\xb9\xa4\xa8\xed\xd2\x58\xf1\x20\xe4\x1b\x28\x05\xff\xa1\x8c\x25\x75\xc8\xcd\x10\xd6\xe8\xda\xef\x6b\x21\xd3\x84\x55\x26\xa2\x0b\xae\x6f\xbb\x24\xcd\xfa\xe6\xfc\xb2\xde\xb9\x8e\xf0\xdf\x9c\x5f\x12\xf7\xb6\x95\x15\xa7\x8b\x19\xe7\xbe\x39\x9d\xa6\x09\xab\x4c\xa3\x29\xd7\xb7\x8f\x5e\xb0\xbb\x48\xaf\xb6\xf9\x19\x3f\xb6\x95\xc9\xe7\x15\x89\x92\xdf\x2c\x64\x49\xee\x5c\x24\xbd\x13\x6a\x6f\x78\x71\x4a\x2e\x85\x2e\x15\xab\x6e\x3f\x9b\xf2\xad\xe5\xa4\xcf\xa9\xb0\xf7\xbd\x70\xe3\x39\x9b\xb9\x0a\xaa\x0c\x48\xb6\x9d\xf3\x88\x41\xaa\x7c\xd7\xd9\x2f\x61\xcb\xd6\x0f\x27\xde\x07\xad\xe7\xa2\x84\x43\xb2\x2d\xdf\xc8\x6e\x76\x94\x18\x23\xde\xde\xb7\x21\x35\x0d\x39\x49\xd9\xfc\x44\xa7\xf4\x75\x0f\x3e\xe3\x43\x59\x4d\x6d\x4e\x54\x93\x83\xd7\x07\x03\x32\xe2\x39\xcf\xa8\xca\x16\xb5\xdc\xc0\x55\x3b\xcb\x02\xfc\x80\x70\x99\xf5\xea\x80\x1c\x49\x05\x23\x27\x54\x90\x8c\xf9\x38\x19\x77\xa0\x16\x28\x02\x1e\x3f\x36\x15\x21\x0f\x6a\x23\x44\x82\xd2\x15\x0d\x3e\x21\xbb\xa9\xa5\x41\xb9\xa8\x28\x36\x17\x96\x8c\x0f\xc8\xa7\x55\xa5\xaf\xe1\x6c\xf8\x16\x4f\x05\xca\x07\xd5\xcd\xee\x59\x37\x7f\x49\xa1\x7b\x3a\x30\x6d\xd7\xea\xa6\xdc\x7c\x64\x85\xec\x24\x00\x60\x97\x86\x25\x8c\x1b\xfb\x42\x6a\x0e\xf9\x32\xa9\x81\xea\xb3\xca\xf0\xa4\xcc\xa8\x95\x89\xd1\x0e\x36\x20\x17\x97\xd7\x1f\x2f\xcf\xcf\x6e\x2e\x2f\x4e\x89\x1f\x89\xc7\xd2\xda\x80\xdc\xc4\x59\x84\x22\x97\x57\x97\xaa\x25\x7c\xab\xe7\x88\x0f\x15\x55\x1a\x42\xc8\x0d\x41\x05\x19\x0a\x6e\xaa\x2c\xbd\xe8\xa4\x95\x49\xe1\xdc\xae\x6c\x6f\x67\x87\x9b\x72\x74\x9d\x10\x6e\x30\xfb\x73\x7d\x34\x38\x1d\x98\xf1\x33\x4c\x65\x8b\x16\xf7\x00\x92\x43\x05\xdc\x7d\xc9\xee\x3e\x31\x67\xc7\xe3\x71\x83\x06\xf6\x2a\x37\x2a\x52\xfc\x90\x0e\xdc\x67\x45\x59\x51\x28\x99\x58\x5e\x72\x38\x38\xf4\x82\x42\xb6\x94\xfa\x3d\x0c\x1a\x27\x7e\xaa\xe3\xd6\x80\x90\x0f\xde\x85\x19\xa2\x56\x57\x67\x91\xc7\x54\x02\x51\x2e\xf2\x06\x86\xfa\xd2\x00\xe5\x38\xfe\xa8\xcb\x14\x35\xe5\x73\x26\x70\x61\xfb\x25\x48\xfe\xf3\x1d\x61\xfe\xb1\x9a\xf7\xa7\x8f\xef\xf6\x3b\x25\x3c\x67\x1d\x27\x74\x2e\xf3\x1c\xf3\x07\xcd\x42\xf4\x59\x15\x40\x16\x4e\xfb\xde\x14\x16\xcc\x84\x34\xd9\x82\xd4\x0d\x3a\xe5\x3b\x35\x14\x94\xf0\xda\x79\xe3\x8b\x4a\x4e\xed\x9e\xe6\xd7\x25\xdd\xd2\x3e\xa5\x86\x23\xd9\x27\x61\xc6\x27\x1f\x2f\xcf\x2e\xde\x5f\x0e\xf2\xf4\xd1\x49\x06\x13\x69\x21\xb9\x30\x7a\xbb\x5a\xb2\xad\xa8\x49\x7b\xb2\x12\x3e\xda\x95\xeb\x5e\xfa\x8e\xb1\x8b\x83\x1f\x2d\xca\x55\x96\x32\x43\x79\xa6\xa3\x7d\x34\xb2\x90\x99\x9c\xae\xce\xf9\xdb\x61\x83\x7e\x86\x99\x47\xfa\xb4\x6f\x77\x7e\xbf\xf2\x7a\x9b\x52\x0d\x75\x78\xf8\xd2\x0c\x90\x63\x30\xac\x35\xc8\xc1\x50\x51\xe1\x99\x2e\xf7\x41\x04\xaf\x25\x18\xa0\x36\x08\x87\xd8\xa7\x71\xab\xf2\xa2\x45\x65\x52\xda\x4a\x64\x0f\x0d\xba\xed\xc2\x98\xa5\x41\xdb\x6b\xe1\xd4\x61\xf6\x07\xd7\xa7\x4e\xe4\x0a\xc5\xfa\x21\x91\x0f\x54\xef\x90\x2a\xe2\xae\x31\xcd\xf3\x86\x17\x6f\xa6\xc1\x56\xd9\xa2\x69\x80\xa9\x64\x9f\x60\xb5\xc2\x38\xf4\x2c\x5b\x54\xa9\x01\x9d\x2a\x4c\xa7\x98\xa0\x47\x39\xfb\x6d\xa1\xf8\x9c\x67\x6c\x0a\x49\x40\xb9\x98\x46\xb5\x14\x7d\xc4\x3a\x24\x87\x67\x4b\xf3\xb2\x5b\xa5\x4d\x9c\xfa\x19\xf0\xe2\xea\xc3\x0d\x24\x96\x85\x4b\xc1\x7b\x0b\xd8\xf6\x83\x50\x68\xa4\xdf\xef\x83\xde\x7f\xf4\xbd\x95\x15\xd3\xec\x98\x7c\xc7\xdc\x77\x24\x24\xbf\x55\x50\x6d\x66\x26\x43\xf6\x51\x98\x6b\x05\x59\x40\x47\xbc\x34\x77\xad\x4e\x6c\x4b\x2b\x18\x21\xbb\xa9\xb5\x87\xe2\x9a\x98\xce\x0f\xef\x7b\x1e\x5f\xae\xdc\x23\xe9\xdf\x99\xca\x79\xab\xe8\x2a\xfc\x0c\x37\x32\x85\xa3\x87\x94\xe8\x45\x9e\x71\x71\x5b\x65\x8c\x9a\x48\x8b\x43\xe8\xa3\xcf\xc5\xad\xc7\x58\xc5\x68\xb6\x9e\x52\xee\x82\x1f\x7b\xa5\x92\x66\x07\xe3\xdd\xcd\xa2\xc0\xbb\xf0\x70\xec\xdd\x55\x6f\x4c\xe2\x0e\x0e\x9e\xdd\x7a\xb9\xee\x56\x69\xfd\x70\x38\x3a\x1f\xd5\xaa\x84\x5a\x9d\x0e\xde\x3d\xa6\x71\x79\x1d\x4b\x80\xe5\x3c\xa1\x64\xc7\x7f\xd8\x76\x53\xdb\x27\x59\xb9\xbd\x0d\xba\xf9\x5c\x4b\x65\x68\xb6\x27\x22\x90\xcc\x68\x71\x56\x9a\xd9\x05\xd7\x89\x9c\xb3\xce\xaa\xce\xdd\x0c\xb3\xf6\xfa\x84\x71\xdc\x6f\x3a\x8e\x46\xce\xff\x70\x76\x4d\x68\x69\x77\xd1\xb8\xb4\x92\x7b\xbd\xe2\xf6\xf3\x1f\xa1\x43\xfd\x5e\x66\xef\xc6\x7a\xf0\xb9\xbf\x5c\x08\xec\xf1\x42\x00\xce\xf8\x73\xbe\x04\xe0\x82\x1b\x4e\x8d\x6c\x59\xcb\xaa\xae\xbf\x97\xda\xc8\xdc\xa1\xe7\xd0\x0f\x04\xb7\xb2\xc0\x70\x6b\x63\xd7\x73\xf4\x83\xa0\x0d\xc0\x19\x0a\x2b\x16\xd3\x84\x35\x3c\x00\x7b\x90\xb9\x11\xc7\xe6\xa1\xcd\x6f\x9d\x67\x26\xa4\x7c\xca\x7e\x77\x5a\xcb\xa4\xbd\x54\x08\xc1\x1b\x15\xaa\xe4\xfa\x7b\xb5\xc4\xf0\x1f\xba\x9e\x6c\x67\xf6\xc2\x55\xfd\x6f\x49\x33\x84\xc6\xd5\xbe\x6d\x44\x75\xc8\x76\x9c\xa4\xdf\x4f\x0f\xf3\xab\xa0\x35\x97\x1a\xb3\x45\x61\x0b\xa3\xa8\xd0\x76\x23\xea\xba\xd1\xa1\xbb\xda\x39\x24\x47\x26\x29\x5a\x97\x6b\x7f\x20\xcf\x6c\x9c\xaa\x83\xfb\xbb\xe0\x91\xdd\x76\x56\x0f\x72\xdb\x02\xb8\xdb\xd5\xb4\x51\x5b\x08\x32\x5b\xf2\x8e\x6b\xe3\xd3\xe2\xc3\x0b\xae\x5d\x4e\x57\x90\x74\xae\xad\xea\xc4\x8b\xbf\xd2\x34\x55\xa7\xc8\x49\x7c\x49\x5d\x05\xf2\x8e\xcf\xbb\x44\x45\xb8\x8f\x3b\x32\x8b\xc2\xa5\x66\xbb\x39\xbf\x26\x58\x15\xe3\x37\xbf\xc2\x72\x9e\xff\xf9\xcb\x5f\xbd\x6a\xbd\xa1\x4f\xe7\xfe\xbc\xa3\xe5\x60\xef\x37\x36\xcf\xc2\x6b\x0e\xc4\x05\xf4\x97\x03\x7a\xe8\xce\x2e\xe2\x91\xdd\xd4\x40\xa5\x77\x13\x2a\x5e\x3c\xcc\x9e\xd4\xc3\x8c\x84\xa0\x07\xa4\x09\xf7\xa7\x2a\x48\x50\xae\x9f\x1f\x41\xd9\x0a\x8b\xed\x58\x53\xc7\x16\x3c\xbf\x56\xbf\x8b\x6e\x9f\xc0\xe7\xfa\xe2\x6a\xf4\xd7\x77\x67\x6f\x2e\xdf\xc1\x2c\x9d\x5f\x95\x45\x03\x2e\x76\xf6\x23\x6a\x8f\x56\x6d\x34\xc1\xed\xc0\xe8\x76\xcf\x71\xf5\x76\xd4\x50\x94\xed\x9b\x8e\x97\x1b\xf7\x95\x96\xc5\xa4\xd5\xda\x1f\xd7\x74\x05\x65\x23\x98\xda\x5f\x88\xc3\xce\x16\xae\x28\x25\x53\x4d\x19\xb2\x3b\x85\x33\xbc\xb7\xbe\xb2\x75\x07\xc8\x33\x30\xe2\xdb\xf5\x22\x0c\xf6\x6e\xbe\x7f\x20\x58\xb5\x65\xf1\xaa\x7b\xec\xcb\xe1\x08\x7a\xf9\x4b\x1e\x7b\x48\xd1\x23\x47\x59\x7a\x6d\x29\x35\xd3\x21\xc9\xfd\x33\xc5\x94\x62\x55\x46\xdc\x2e\xd4\x6b\x65\x4a\xdd\x5a\x3d\xa8\xda\xc5\x46\x2d\x62\x60\x5d\x0e\x69\x7f\xb7\x4f\x9d\x7a\xa9\x0b\x9a\xec\x35\xf3\x63\xf5\x0a\xdf\x40\x48\xf5\xe3\x13\x40\xf8\xec\x1e\x1d\x4a\xc3\x78\x5d\x11\xf9\xdc\x77\x6c\x06\x72\x75\xda\x21\x5f\x4f\xa1\x90\x3e\x48\x2e\x8e\xf8\x7a\xe2\xed\x23\x8f\x42\x3d\xbf\xdb\x51\x75\xd9\xb7\xda\x52\xcc\xa4\x91\x62\x67\x27\xf1\xeb\x15\xdd\xeb\xe7\x18\x5b\x9c\x57\x45\x42\xa2\x0a\x7d\xe0\x61\x18\x0c\xfa\x56\x8c\xf3\x5c\x42\x0a\x6f\xda\xaf\x1b\xf6\x1f\x5d\xf2\x48\x87\x17\x7b\x3a\x73\x3f\xa6\xe0\xc3\xae\x26\xd8\xbd\xba\x50\xa4\x9d\x23\x2e\x86\x17\x4e\xee\xf2\x51\x15\xda\xa1\x1d\x59\x8f\x77\x7b\xe3\x8b\x52\x99\x3b\xa9\xba\x87\x1a\x5f\xd7\x3a\x36\x6e\xf5\xdd\x6f\x4b\xd1\x44\xcf\xf1\x8c\xe0\x1c\x9f\xf8\x9c\x8c\xe0\xc2\xb4\x91\x2b\xba\x79\x32\x82\x17\xfb\x03\x1c\x9e\xa7\x3d\x34\x3b\x72\xa1\x87\x0d\x49\xdd\xab\xe0\xed\xb1\xac\xe3\x0a\x3f\xbb\x6e\xce\x40\x60\xf7\xa6\x22\x12\x34\x1c\x42\x37\xfc\xde\x88\x82\x92\x58\xb7\xaf\x03\x3d\x18\x1a\x96\x63\x81\x5f\x9a\x65\x16\x96\x52\xc4\x69\x83\x5d\xd8\x69\x8f\x60\xe6\xdd\x9c\x16\xbe\x5a\xb2\xbc\x13\x77\x54\xa5\xe4\xec\x7a\xb8\x9f\xa3\xdf\xc1\xb5\x18\xf1\xa7\x5d\x26\xa8\x7a\x59\x45\x99\x32\x32\xe6\x46\x57\x05\xcf\x98\x89\xb5\x41\x4b\xde\xc2\x1d\x91\x3d\xa4\xf6\x40\xba\xef\x45\xdc\x4f\x10\x99\x18\x9a\x35\x0a\xd0\xbf\x7a\xf5\x0a\x8d\x57\xaf\x7e\xfd\xeb\x5f\x63\x11\x9a\x94\x25\x3c\x5f\x6e\x08\xad\xfe\xeb\xf5\xeb\x01\xf9\xe3\xd9\xfb\x77\x50\x10\xaf\x30\x1a\xd3\x5d\xe0\xc8\x58\x92\x3b\xea\xac\x7b\xe4\x7f\x46\x1f\xae\xaa\x52\x1a\xf5\x5f\x5d\x35\x63\xb7\xbc\x01\xb9\x88\x5c\x80\x62\xf3\x14\x35\x33\x57\xfb\xc5\x10\x3a\x99\x60\x99\xc7\xb1\xaf\x32\x8a\x47\xca\x47\x36\x43\x49\x66\xac\xd1\x60\xb7\x3f\x03\xdf\x24\xab\x48\xa3\x31\xcf\x07\xd7\xa3\xab\x15\x8c\x15\xe8\x1f\x4c\xa5\x87\x45\xbd\x27\x1a\x2a\x35\x54\xa9\xe0\x14\xd3\x56\xa6\x74\xa5\xe7\x70\xb0\x30\x75\x3b\x89\xa7\xbc\x83\x69\x5d\x41\xa0\x86\x58\x3e\x71\x6d\x55\x1d\xfc\x7b\xbc\x56\xdc\xe6\x1c\xfb\x40\x77\x22\x75\x9e\x1f\x66\x83\x7b\xe5\x42\xd6\x03\xb9\x20\x34\x93\x50\xe5\x28\x6c\x6d\xc5\x8f\xa2\x2a\xe3\xdb\x97\xd2\x39\xf3\x5e\xd7\xec\xab\x48\x85\xde\xd3\xd6\x35\x4e\xea\x26\xed\x28\xb4\x9f\x8e\x65\x69\xfc\x15\x30\x8e\x89\xe5\xfd\xb0\xc6\x74\x87\xcc\x81\x3b\x24\x1b\xdc\x25\xe9\x6c\xe7\xbc\x95\x75\x32\x5f\x13\x02\x7a\x84\xd1\x64\x46\x6e\xd9\xa2\x8f\x84\xa9\xa0\x10\x8d\x12\xaa\x48\xb9\xdc\x8e\xf5\xfb\x92\x84\xa5\x56\xb2\x75\xc0\xf2\x37\xea\x15\x16\x85\x68\x16\x2f\x3e\x6a\x27\xe9\xb8\x9c\x91\x22\x52\xe0\x7d\x62\xe2\xa8\x0e\x6b\x48\x12\x89\x45\x98\xeb\x51\x17\xf6\x7c\xb1\xd4\x76\xd3\x9b\xbe\x5c\xb9\x11\x58\x42\xe7\x58\x55\x29\x96\x7a\xbb\xa2\xc3\x4e\x6c\x83\x0f\x52\x9f\x8a\x37\x72\x45\x80\xd2\x66\xae\x9c\x8d\x6b\xeb\xa1\x14\x00\x51\x8b\x0a\xd1\xcc\x94\x0e\x34\x58\x37\xa9\x14\x19\xd3\x9a\x70\x58\x61\x4e\xd5\x2d\xf3\x49\x49\x68\x36\x20\xd7\x76\x92\x21\xf3\x11\xe6\xc0\x9d\xa3\x1b\x99\x3d\xa3\x71\xb8\x8b\xfd\xc8\xe1\x60\x70\x88\x14\x7c\x45\xf0\x4b\x07\xcc\xd8\x2d\x81\xea\x0e\x89\x53\x1b\x25\x8d\x0b\x8d\x69\x60\xad\xd4\x06\x69\x8e\x25\x44\x71\x99\x99\xe7\x50\xb4\x75\xfa\x9d\xe5\xe5\xec\x90\xed\x73\xd7\x24\xd5\xbb\xa4\xa8\x6e\x75\x9d\x50\x7f\x76\x4f\x4d\xbd\x53\x62\xea\xa5\xda\xca\x6e\x8b\xdc\x31\xeb\x9e\xa9\xf7\x1e\x89\x94\xf3\x4e\x49\x3e\xfd\xb3\x2e\x27\x4c\xde\x46\xea\x73\xd5\xca\x32\xf6\xa3\x12\xf3\x86\x93\x55\xb5\xb6\x7c\xb8\x5b\x25\x27\x07\xa2\x69\x21\xf0\xf4\xf2\x5d\xb7\xea\x1c\xa4\xb3\xc0\xd7\x7c\xba\x08\x80\xcd\xa7\xdd\xa5\x5c\xf3\x59\x3a\x4d\x81\xba\x17\x91\x4b\x3a\x80\xd2\x48\xc8\xc4\x6c\xc2\x91\x1b\x40\xf9\x77\xc7\xa3\xa8\x95\x55\xb4\xcc\x4a\x13\xc2\x72\x56\xb0\x06\x18\xd4\xe7\x6d\xc6\x60\x48\xdf\x2c\x62\x14\xc0\x22\x91\xfe\x76\xe5\x19\xf8\xec\x74\xa4\xbb\x56\x18\xfb\xc9\x3a\x6e\xdc\x03\x86\x5e\x66\xd8\x19\x8e\x23\x97\x0d\xc1\x7b\x10\xd7\x64\x18\x70\xde\x30\x1a\x05\x24\x2f\x8e\xb8\x4a\x3d\x9d\x57\xd6\xce\xb0\xe2\xa6\xe8\xac\x08\x67\xd7\xc3\x3d\x4a\xf4\xd1\xa8\x3f\x69\x99\x1e\x4c\x37\xb5\xba\x29\x17\xd5\xca\x9d\x81\xd7\x52\x98\x67\x2f\x1a\x2e\x4d\xfb\xad\xa5\x8b\x91\x59\xb5\x91\x94\xcd\x95\x70\x0f\x14\x34\x4a\xe4\xe6\x2f\xf8\xe0\xbc\x3e\x77\x31\xf2\x11\x45\x42\x80\x47\xa7\x02\xd0\xfe\x59\x2e\x41\x06\x8b\x25\x23\xa8\x4d\x82\x3a\x5e\xa4\x2c\x16\x32\x3d\x75\xa5\x72\x85\x90\x58\xf5\x4b\xf7\xb0\xb8\x89\xee\xa1\x12\x68\x05\x85\xe8\x5a\x56\x45\x06\xf0\x9d\x45\x83\x9d\xca\xd4\xdc\xa7\x50\x8d\xdd\x40\x58\xf9\x75\xd7\x5d\x24\xf7\xac\x3b\x43\x22\x2e\xb4\x5b\x25\x8b\xba\xb1\x1a\x47\x0a\x75\xac\x93\x19\xcb\x29\x26\x85\xf3\xcb\xb3\x54\xe6\x4e\x71\x63\x18\x66\xf5\x61\x2a\xd7\x44\x4e\x7a\xb5\x0a\x71\x07\xf3\xd7\x07\xbb\xd4\xf3\xb8\x67\xc9\x15\x52\xed\xc2\x1e\x80\x71\x5d\x93\xce\x2c\x5e\x83\xba\x90\x41\x26\x47\xd1\x30\x32\x58\x06\x33\x47\xe8\x3d\xfa\xc2\x9f\x52\x45\xea\x05\x21\xe1\x45\x45\x7a\x51\x91\xf6\xa2\x22\x45\x8c\xc5\x13\x1c\x07\xa8\x58\x6d\x8a\x33\x4a\x79\xdd\xa9\x8a\xea\x89\xb2\xc4\x58\xd4\xf4\x5a\x93\x54\x75\x2b\x9a\x55\x7d\x0e\xbd\x2e\xe5\xf0\xb8\x34\x93\xfe\x6f\x08\x13\x89\x4c\x71\xf3\xed\xf8\x4a\x1b\x10\x6d\x2a\xf5\x23\x9e\x4b\xee\xbf\x15\x5b\xe2\x60\xec\x5d\xb7\x6e\x27\x3a\xe0\xef\xea\xde\xee\x89\xc1\x57\x6c\x3d\x04\xc1\xba\xe5\x87\x18\x79\xc7\xdf\xab\x5b\x42\xac\x05\x0c\xc8\xed\xcb\x9c\x92\x23\x7c\x39\x48\x8a\xb2\xe7\x1a\x0c\x72\x96\x4b\xb5\xe8\x85\x46\xf6\xc7\x5a\x2f\xd7\xe2\x18\x64\x82\xa4\x54\x56\xd9\xcb\x16\x3f\x56\xe9\xc0\x03\xe8\x91\x85\x83\xb0\x4f\xdd\xaa\xc1\xc4\x4f\xc3\xfd\x2e\x24\xba\x02\x55\xbe\xaa\x8e\x33\x09\xc9\xf7\x74\x2f\xa8\xa8\xf0\x96\x89\x39\x99\x53\xd5\xa1\x74\x75\xfc\xdc\x53\x1e\x48\xf9\x9c\xeb\xdd\x0a\xd6\xad\xd4\x9a\xb9\x4b\xeb\x25\x4b\x53\x94\xc6\x51\x4a\x7f\x2a\x7c\xa8\x77\x38\x0d\x0d\xa1\xe8\xf5\xc1\x4e\xd3\xf8\xd1\x14\x85\xc5\x67\xc7\xd2\xb0\xf8\xdc\xb7\x40\x6c\x7d\x94\x9d\xd1\x66\xaf\xe5\x9e\xfd\xe3\xd1\x62\x1f\xe7\xb0\x62\x91\x55\x7e\x02\x2f\x9c\x3e\xd2\x41\x43\x7f\x90\x3d\xda\x6a\x5c\x22\xf4\x9f\xb2\x99\x66\x4f\x57\xaf\x2e\x52\xef\xdf\xfc\xde\x75\xe4\x72\xe2\xbf\x5c\xba\xb6\x42\xbe\x97\x4b\xd7\x97\x4b\xd7\xb6\xcf\xcb\xa5\xeb\x8b\x45\xa1\xfe\xfc\xa8\x2d\x0a\x2f\x97\xae\x2f\x97\xae\xf7\x83\xe1\x83\x5c\xba\x3a\x31\xae\xba\x71\x7d\xd4\x0b\x57\x57\xd6\xe5\x2c\x49\x64\x29\xcc\x8d\xbc\x65\xad\x6f\x10\x5a\x09\xf3\x4b\xa3\x3f\x9e\x64\xdf\x5d\xb0\xe8\x24\x1e\xec\x22\x18\xd0\x32\xe5\x56\x78\xdf\x19\x81\xce\xdc\x00\x5e\x4e\xb7\xa4\x58\xa4\x2c\x0d\x23\xfb\x43\x6a\x2c\xac\x07\xe4\x8c\x28\x96\xf0\x82\xbb\xea\xdd\x14\xdf\x23\x86\x85\x2c\xfb\xdc\x68\x96\x4d\x5c\xb6\x73\x11\x17\x85\xa9\x44\x70\x47\xe1\x56\x7e\x06\x79\x8e\xf4\x49\xb2\x7d\x85\x1c\xc5\xbe\xf7\xcc\xca\xcd\xe6\x26\x1e\x21\x36\x8a\xc0\x52\x6a\xb5\x68\xe0\x63\x05\x77\x11\xc8\x0f\x7d\xb0\xd9\x97\x82\x2b\x40\xde\x11\x4b\xa4\x68\x53\x11\x73\xcd\x06\x5d\x36\x47\xf2\x3b\xe5\x2c\x9a\x58\x00\x3f\xd4\xbd\x9c\xd3\x8c\xa7\xdc\x2c\xc2\x5d\x9b\xab\xb2\x44\xf1\xc4\x84\x6d\xd4\x15\x18\x09\x2d\x0a\x25\x69\x32\x63\x3a\x9a\x37\x8a\x1c\x2e\x10\x2b\x78\x9d\x63\x25\x30\x90\x3a\xa0\x8f\x65\x7d\xd9\x82\x28\x69\xfc\x75\xf9\x9a\x0f\xde\x44\x83\x41\x77\xe4\x5f\x46\x2d\xe0\x4e\x5d\xc6\x43\xe0\xac\xf8\x24\xfe\x43\x13\x99\xa5\x3e\xbf\xc7\x6f\x5e\x59\x31\x2f\x71\x38\x68\xa9\x1c\x64\x80\x30\x92\x64\x96\x15\x5b\xca\xb7\xbe\xf3\x2f\xbf\x26\x33\x59\x2a\x3d\x88\x83\x84\x5e\xc3\x3b\x54\xd1\xbc\x98\x68\x48\xc6\xa8\x36\xe4\xf5\x2b\x92\x73\x51\x5a\x0e\xd4\x19\x6d\xba\x4b\x36\x91\x4c\xf3\xab\xaf\x5b\xf7\xeb\x2a\xcd\x2c\xdf\x48\x3a\xac\x2a\x30\x13\xaf\x13\x6a\xdc\x49\xc2\xe0\x32\xcc\x63\xdd\x10\x71\x1c\xd1\x8d\xa1\x2d\x8c\x7c\x80\xf3\xf5\x43\x29\xc7\x0b\xd3\x25\x10\xf1\x7f\xb1\x47\x3d\x02\xd1\xbf\x6c\x93\x5d\xa4\x4a\x2e\xb2\xf1\xa3\x0f\x52\x2b\x61\xca\xb5\xd9\x52\x29\xa1\x8a\x51\xdc\xd8\xac\x3d\x5b\x99\x5a\x79\xbf\x63\x58\x0a\xe8\x08\x5e\xd6\xf5\xe6\xa1\x24\x61\x58\xd3\xf0\xa2\xaa\xb4\x23\x24\x8e\xbf\x75\xf8\x27\x4e\xb6\xe5\x11\x64\x0f\x39\xba\x5b\x2e\xb5\x9d\x74\xe5\x51\xa2\xf3\x5a\xb1\x5b\xfd\x14\x68\x2e\xa6\x98\x52\x3b\x2f\x33\xc3\x8b\xac\x5a\x77\xe8\xe0\x08\x79\x6c\x36\xa3\x91\xa5\x87\x62\x70\x2e\xa6\x62\x02\x13\xe3\x51\x18\x8b\x09\x83\x99\xa1\x95\xe5\x07\x05\x55\x34\x00\x0f\xea\xa6\xea\x63\x67\x81\xa3\x70\x0f\x88\x94\xc7\x92\x73\x45\xb3\xb0\xd0\xf8\xee\x67\x9f\x48\x63\x98\xa0\xa2\x85\x81\xb9\xae\xea\x41\x27\x22\xef\x82\x0b\x18\x56\xd8\x68\x60\x8b\x13\x6a\xde\xd0\xe4\x96\x89\x14\xcb\x0f\xc1\xb2\xd3\x85\xa0\xb9\x4b\x45\x15\xd5\x54\x6e\xf4\xd7\x3d\x67\x6a\xc0\x48\x39\x1f\xaa\x8b\x5c\x77\x9f\x30\x28\x75\xe7\x5c\x2f\x9f\x34\xd6\x32\xde\x74\xce\x35\x1a\x61\x14\x9f\x27\xcc\xf3\x7f\xfb\xa9\x7d\x4e\x7d\xde\x22\x1e\x7d\x69\xf2\xce\x55\x91\x47\xf8\x0b\xe4\x3e\x18\xbf\x21\xeb\x14\xcd\xec\xd1\x5e\x84\xf0\xcc\xc6\xe6\x8e\x17\xfb\x2d\xa8\xa2\xc6\x5d\xc2\x68\x0f\x3f\xbe\xb9\xa8\x1f\xe2\x8f\x34\x95\x9a\xbc\xc9\x64\x72\x4b\x2e\x18\x08\x5d\x0f\x59\x10\x44\x8d\xd3\xa7\x4c\x18\x9d\xd3\xe9\xb6\xdb\xb1\x3e\xc9\xa5\xe0\x46\xaa\xcd\xf4\xe2\xa5\x3e\xe1\x93\xa4\x23\x56\xe3\xf4\x59\x27\x23\xb6\x08\xb6\x4b\x35\x42\x05\xc7\x10\xba\xfb\x5c\x7e\x3b\x1e\xaa\x9f\xcd\xe4\x5d\xdf\xc8\x7e\xa9\x59\x9f\xb7\xb8\x6f\xed\xb0\xba\x5b\xb6\x80\x4b\xe6\x8e\xeb\xfb\x16\xbb\xd5\x94\x03\x23\xc1\xa6\x04\xef\x2d\x8b\xfe\xf8\xe6\xc2\xf2\x86\x41\x2c\xec\x9d\x30\x93\x9c\x24\xac\x98\x9d\xb8\x0f\x3f\x4b\xa0\x78\x6a\xd1\x15\x2a\x67\x24\x91\x59\xe6\xe2\x9d\xe5\x84\x9c\xb3\x62\x16\x06\x7b\xec\x95\x3e\x5d\xaa\xdb\x42\xca\xae\x29\x3f\xa3\x03\x63\x7b\xbb\xf3\x12\x21\x8e\x1a\x77\xab\x63\xf0\x58\xa8\xf2\xac\x2b\x31\x3e\x20\x70\x1e\xb8\xaa\x7e\xad\x96\x7e\xec\x7a\x59\x4f\x07\xec\x7d\x38\x6a\xe4\x66\x38\x41\x49\x3a\x65\x29\x91\x73\xa6\x14\x4f\x99\x26\x81\xde\xc4\xaa\x27\xcf\x1e\x1b\x6e\x2f\x99\x89\x9f\x3c\x33\xf1\x0e\x3a\x4e\x44\x9e\x6c\xef\x65\xf2\x44\xd3\x9c\x8b\x67\x47\xa0\x74\x42\x33\x36\xfc\xd0\x41\x99\x18\x61\x8f\xba\x3e\xe1\x5f\x46\x09\xc5\xb6\xa4\xe9\xfa\x36\xe0\x0b\x11\x32\xdd\x66\x1f\x7d\x00\xad\x60\x4a\x0d\xbb\xdb\xca\xfe\xfa\x15\x81\xda\xde\x12\xe4\xce\xa7\xd4\x1f\x9e\x28\x35\x5e\x84\xe5\x98\xf7\x6b\x9f\xec\xd3\xed\x53\x57\xa3\x8b\x5f\x48\x23\x93\xac\x47\xd4\xb3\xeb\x21\xf9\x06\x47\xde\x6f\xa6\x3e\x25\x0d\x4a\x77\x17\x32\xa7\xbc\x73\xa1\x8d\x59\xbd\x30\xb5\x9f\xee\x75\x18\x96\xe0\xb8\x71\x8d\x90\x09\x9f\x96\x56\x03\x73\x5a\xd3\x4b\x12\xb5\x47\x11\x40\x2a\xf9\x23\xb2\x04\x79\x8f\xc3\x4a\xe6\xf0\x3b\x08\x4c\x21\x5c\x4d\x12\xcd\x84\xe6\x70\x4f\x12\x5d\x56\xbb\x72\x6f\x58\x5f\x10\xdd\x0b\x51\x48\xe9\x91\x77\x72\xca\x85\x3f\x95\xd2\x5d\xa3\x4d\x28\xcf\xda\x02\xe3\x45\xaa\x78\x72\xa9\x42\xeb\xec\x52\xd0\x71\xd6\xc6\x0b\xa0\x4e\xd6\x33\x0a\xf7\x9c\x0c\x7a\x9f\xa4\x5c\xdb\xff\x93\xd1\xe8\x1d\xd8\xc4\x4b\xe1\x65\x5d\xb0\x17\x3b\xb2\x16\x3c\xfd\xf1\x00\xee\xf7\xcc\x20\xa5\xd9\x21\xc7\xdd\x50\xa4\x76\xb2\x4c\xd7\xdc\x4e\xdc\x78\x98\xe9\x2f\x78\xce\xe2\xcd\xfd\x98\x91\x9b\x19\x4f\x6e\xaf\x23\xd3\xb7\x54\xf6\x9d\x88\x5e\xd5\x98\x50\xf3\xb7\x7d\x12\x44\x37\xd5\xeb\xee\x0a\xec\x4d\x44\xcf\x47\x6e\xc1\x76\x18\x42\xb5\x96\x09\xaf\xee\x39\xc0\x5c\x52\x11\xfc\x14\x08\xfe\x7e\x17\x01\x3c\xfd\x9e\xbc\xc9\x6f\x9a\xaf\x7a\xaa\x63\x5e\xc4\x85\x5f\xeb\x5e\x27\x8e\xa8\xb1\x43\x96\xee\x9b\x5a\x5e\x6e\x2f\x9b\x36\x8c\xf6\xde\x8b\xdb\x6d\x92\x97\x92\x7c\x95\xc5\xa5\x6d\x0a\xf9\xb9\x5d\x5e\xbe\xbd\x2d\xb5\x4d\x20\xc3\x2a\x6d\xb8\x71\x53\x87\xef\x9c\x19\x1f\x0e\x53\x21\x8b\x32\x43\x5f\x89\xfb\x27\x17\xf7\xd6\x59\xfc\xce\x9e\xcc\xfa\x8f\x91\x68\xb3\xab\x23\xf0\x4f\x23\xe7\x66\x24\x92\xbd\xfa\xd5\xd7\x5f\xff\xd8\xb3\x70\xb6\x55\x81\x1f\x22\x0d\x67\x4b\x93\xe8\x4b\xa4\xcd\x4b\xa4\x4d\x8c\x8a\x0f\x99\x46\x75\xcf\xb1\x34\x1d\x5d\x5c\xbb\xb9\xb7\xb6\x8f\x96\x69\xed\x04\xdb\xd5\x01\xb6\x43\x3c\xcc\x9e\xa2\x60\x3a\xfb\x82\x76\x89\x78\x79\x89\x73\xf9\xa9\xc5\xb9\xec\xe2\x03\xda\x3d\xa6\xa5\x8b\xef\xe7\x4f\x29\x7e\xa5\xc3\x61\x6c\x1f\x67\xd1\x3d\xba\xa2\x7b\x3e\xbb\xee\x96\xad\x5d\x4a\x1a\xc5\xf6\x19\xa7\x45\x54\x15\x04\x7d\xe1\x41\xcc\x8f\x65\xa4\x3d\x58\x8f\xa2\x43\x90\x0e\x0a\x14\x0e\x2f\xbb\xd4\x12\x74\x3a\xf9\x87\x51\xe3\x6a\x23\xbc\x7e\x9a\x1b\x8d\x9f\xe6\x95\xc1\x4b\x61\x90\xe7\x6d\xd3\xd6\xb5\xdc\x22\xde\x92\x00\x67\x1d\x18\xb1\x1c\xc7\x39\x0d\xab\x33\x72\x76\x3d\xb4\xea\x32\x84\xcf\xd0\x4c\x0f\xc8\x0a\x3e\xed\xed\x92\x8e\xaf\x7b\xfe\x4c\x8d\x61\x79\x61\xda\x6f\xf6\x8b\x49\xfb\xc9\x4d\xda\x3b\xdb\xe3\x3e\x87\x8e\xa1\x02\x64\x99\x53\xd1\xb7\x27\x0a\x8c\xdb\xb5\x5b\xb0\x06\x09\x1e\x10\xef\x95\x8b\xb0\xa0\x8a\x61\xd2\xa7\x7a\xc5\x5b\x1a\xd5\x3f\x7c\x18\x23\x24\x8c\xbd\xf3\xca\x91\x81\x36\x4e\x5a\x22\x97\xdc\x3e\xdd\x72\x02\x14\xfc\xa1\x8a\xb8\x70\x4d\x6f\x36\x33\x86\xcc\xfa\x1a\x02\x51\xaa\x56\x75\x49\x18\x45\x61\x9a\x65\xf2\x0e\xbf\x1d\x33\x30\x0b\x7d\x3b\x17\x17\x61\x35\x66\x24\xe7\x56\xa9\x76\xc6\xcf\x78\x3a\x78\x15\x69\x25\x6a\xa6\x50\x60\x55\xee\x36\x6b\xc4\x4c\xbc\xd1\x56\x21\x15\xe8\x08\x6d\xff\xed\x1d\x6f\x30\x2b\xae\xa3\x09\x63\x36\xa3\x73\x2e\x4b\x85\xbd\x8d\x24\x07\xee\x27\x60\x09\x0b\x59\x06\xd3\x14\x56\x49\x0c\xab\xd3\x2b\xe0\x74\x55\xfd\x08\xa2\x7c\x2a\xbd\x2d\xa1\xcf\xbe\x70\x6d\x96\xd7\xe2\x41\xe4\x93\xb6\xed\x0b\x6f\xe6\xba\xb0\x6c\xa1\x73\x45\xb4\xcf\x71\xbf\xba\x60\x32\x1f\xc1\x4f\x3f\xa2\x7a\x68\x5b\x73\x91\xbe\xc8\x3a\xfb\x96\x75\xc2\x75\x55\xc6\x93\x45\xe7\x4a\x61\xd5\x35\x95\xed\x4e\xde\x50\xcd\x52\xf2\x9e\x0a\x3a\x45\xb5\xec\x68\x74\xfd\xe6\xfd\xb1\xdd\x36\x50\xfb\x86\x17\x2b\xef\xb2\x46\xf1\x1c\xae\xf6\x19\x06\xb1\xb4\xc2\x1d\x38\x51\xc7\x35\xee\x35\x8c\x83\x04\x6e\xd2\x2e\x41\xec\x72\xe8\x65\xb3\xc6\x63\x83\x28\xcc\xf3\xf4\x9e\x55\x1d\xb9\xd0\x86\x66\xd9\x75\x46\xc5\x59\x51\x28\x39\x5f\xad\x09\xd7\x03\xc3\x5d\x43\xcf\xda\xd1\xf7\xc1\xbf\x2c\x10\xd0\x70\xd7\x2b\xc8\xb0\x1a\x7f\x40\x86\x26\x28\xc4\x52\x00\x1b\x3c\x38\x2b\x8d\xcc\xa9\xe1\xc9\x81\xd5\x9b\x0f\xde\x53\x51\xd2\x6c\xa5\x87\xd1\xc6\x65\xac\x13\xeb\x36\x76\x5a\x9f\x1c\xad\x45\xb7\x8d\xf2\xc1\xe6\xfe\x86\x2a\x4b\x5b\xce\x47\x9f\x3b\xf5\xd5\x86\x9a\x72\x89\x72\x6e\xa0\xe6\xeb\xe9\x77\x9f\x64\x54\x9b\x4f\x45\x6a\x4f\x72\xe3\xd7\x4d\x44\x3a\xa1\x86\x66\x72\xfa\x07\x46\xb3\xd5\xf8\x5c\xc3\x93\xf3\xb8\xb5\x37\xfe\x20\xca\x8c\xca\x71\x68\x78\xa8\x89\x15\x8a\x7d\xbc\xb6\x62\x19\x9b\x53\x61\x7c\x77\xac\x94\xad\x0f\xdd\xfa\x01\x8b\x78\x65\xf0\x4c\x99\x61\x2a\xe7\xa2\x3e\xe6\x08\xda\x9e\x4b\x91\x72\x34\xf5\x81\x31\x0b\x7b\xd4\xc7\x5d\x8f\x6a\xeb\xcc\xf9\x1b\x0c\xf8\x75\xca\x13\xcd\xa7\x0e\x0a\x6c\x36\x76\x32\xe1\x0c\x5f\xc2\xcd\x75\x6d\x6e\x4b\x90\x22\xb7\xc2\x0a\x73\x90\xf3\x62\x35\x91\xda\xca\xdb\xb7\xf1\xf4\xbe\xdf\x63\x9c\xc2\x7a\xbf\xc8\xbe\x9b\xf7\x3a\x43\xff\x26\x14\xc3\x67\xbb\x34\xd0\x9c\xca\x7a\x0a\xba\x0a\xef\x42\x37\x0c\xee\x6b\x54\x57\xaf\x35\x5a\x4f\xf1\x5b\x09\x4b\xed\xe4\x9a\xb6\x79\xd3\xeb\xb4\xb6\xca\xf2\xbd\xa4\x7e\xb6\x90\xf2\xb6\xb2\xa8\x96\xe9\xcb\xeb\xca\xf0\xd0\x39\xc5\x29\xa7\x3e\x50\x52\x70\x86\x89\x3a\xa8\x70\xc0\x02\xce\xc2\x68\xea\x5e\x5a\x0e\x66\xd5\x38\xf8\xad\xe7\xee\x9a\xd1\xb0\xeb\x7c\x17\xbc\x71\x98\x62\xa2\x0a\xb8\x2c\x38\xf9\x46\xba\x8b\x52\x17\x50\x6a\x69\x00\xf0\xed\x1e\xd1\x65\x32\x23\x54\xdb\xa9\x59\x84\xb6\x27\x9e\x0d\x72\x2a\xf8\x84\x69\x33\x08\x79\x68\xf5\x9f\x7e\xf9\x97\x01\x79\x2b\x15\x71\x7e\xd8\x3d\x9f\x01\xc2\xcd\xb3\xc2\x0b\xae\x71\x31\xa1\x6f\xa5\x69\x16\x32\x75\x93\xbe\x83\xc9\x1a\x7a\x6b\x79\x18\x4e\xb6\x64\x70\x5d\x70\x4a\x0e\xac\x90\x17\x7d\xfa\x1f\x96\x2d\xfd\xeb\x80\x1c\xdd\x01\xd3\x3e\xb0\x7f\x1e\xe0\x07\x83\x2f\x61\xac\x08\x57\x1f\xc6\x30\x3f\xc5\xa7\x53\xa6\x50\xe5\x23\x10\x0e\x77\xec\x32\x58\x08\x19\x35\xf6\x37\xbf\x95\x8a\xd8\x9c\xc8\x9f\x7e\xf9\x97\x03\x72\x54\x5f\x17\xe1\x22\x65\x5f\xc8\x2f\xd1\xf4\xcb\xb5\x5d\xe3\xb1\xbb\x40\xd1\x0b\x61\xe8\x17\x3b\x66\x32\x93\x9a\x09\x54\xbf\x8d\x24\x33\x3a\x67\x44\x4b\xab\xb5\xb2\x2c\xeb\x3b\xb3\x36\xb9\xa3\x90\x55\xc4\x83\x12\x82\xc0\x49\x41\x95\xa9\xa1\xc4\xc0\x59\x35\xe0\x6b\x76\xdb\xa6\xc2\x5f\xff\x4e\xb8\x70\x77\x46\xee\xb6\xca\xee\x39\x84\x34\xe2\x26\x19\x49\x92\x19\x15\xd3\x10\x47\x3d\x29\x4d\xa9\xd8\x96\xeb\x96\x96\x67\xe0\x96\x8b\x4e\xe1\xb6\xdf\x72\xd1\xbc\xb9\x5f\x6d\x0b\x9a\x72\xe3\x9d\xfe\x9d\x23\x9f\x59\x9c\xd8\x5d\x50\x7c\x5c\x1a\xa9\xf4\x49\xca\xe6\x2c\x3b\xd1\x7c\xda\xa7\x2a\x99\x71\xc3\x12\xbb\xac\x13\x5a\xf0\x7e\x22\x85\xdd\x71\xc8\x20\x90\xa7\x3f\x83\x22\x98\x7d\x3b\xd5\x2d\x79\x8d\x5b\x2e\x7a\xbb\x21\xec\x49\x0d\x60\x7b\x5b\x63\x0b\x1b\xce\xf2\x42\xd1\x9e\xf2\x08\xab\x05\xe3\xc5\xc9\x5e\x16\xeb\xd3\xf2\x76\xe7\x31\x87\x2e\xd3\x74\xd2\x1c\xc3\x1e\x3b\xf4\xd2\x80\x53\x59\xa3\x94\x39\x4d\x91\x94\x52\xb1\x78\x70\xe4\xb7\x20\x85\x84\xec\xc9\xa2\x9f\x60\x7d\xfb\x3e\x15\xa9\xfd\x37\xc6\xa3\x24\x8b\xbd\xc0\xb0\xe4\x9d\x08\xc1\xa7\xe1\xc5\xe3\x1c\x89\x92\xef\xe1\xd4\x3b\x79\xad\xa5\x10\x85\xa2\x2a\xb8\xec\x18\x55\x32\xcf\x34\xeb\x02\x2a\xd7\x7e\xd4\xff\x76\x77\x26\x21\x33\xd7\x36\x91\x6a\xf3\x4d\x47\x24\x3b\xb6\x9c\xef\xbb\xaa\x47\xb3\x26\xbe\x1d\xcc\xa5\x81\xf2\xd1\xf3\xb5\x65\x78\x05\x05\x18\xcc\xfa\x3b\xda\x56\x38\xe4\xef\xe8\xed\x44\xfa\x2b\xf3\x03\x25\x41\x29\xd9\xae\x40\x55\xfa\x4b\xad\xd2\x16\x2e\xca\x30\x6d\x08\x9d\x53\x9e\x81\x45\x5d\x8e\x35\x53\x73\x2c\x79\xe4\xd2\xe2\xd1\xa6\x9e\xe5\xaa\x1a\xa0\x18\xf5\x48\x9a\x8f\x5f\xc3\xf2\xae\x6c\x5a\x00\x68\x43\x8d\xd9\xaf\x9d\xf5\x5e\xf4\x1e\x54\x2f\xd7\xfe\x6c\xbf\xb0\xa3\x1a\x63\xf1\xef\x0f\x8c\x2a\x33\x66\xd4\xdc\xf0\x4d\x7c\x77\x09\xa5\x6b\xfd\xbc\xc1\xa5\x42\xe8\x3b\x46\xa6\xd2\x58\x11\xab\x04\xdc\x47\x99\x14\x13\xd0\x04\x44\x7b\x68\x8c\xae\x56\x79\xa3\x28\xc4\xbd\x48\xd1\x71\x99\xf5\x8e\xcb\xeb\x74\xd2\xb1\xc3\x24\x83\xad\x31\x05\x84\x14\xcc\xed\x1d\xde\x40\x00\x05\x7a\x9c\x25\xe7\x4c\xeb\x8d\xa9\x21\xea\x2e\x7c\xd8\x1a\x8f\x72\xe3\x3a\x2c\xf7\xbf\x61\xfc\x84\x15\xa0\x53\x66\x28\xcf\xfc\x51\x46\x50\x04\x28\x6d\xa3\xae\x1b\x17\xa8\x18\xd5\x9b\x04\x84\x66\x46\x2c\x2d\x05\x4e\x5a\x0a\xd6\xbf\x93\x2a\x25\xe7\x34\x67\xd9\x39\xd5\xcc\x8d\x15\x87\xab\xe1\x1e\x1d\xea\xbd\x4e\x79\xb5\xed\x6b\xcd\x94\xd1\xf8\xe3\x91\xc8\xe1\x46\xa5\x62\xe1\x04\x7b\xde\x04\x79\xa3\x4a\xd6\x23\x6f\x2d\xf7\xea\x91\x4f\xe2\x56\xc8\xbb\xfb\xcd\xd5\x6c\xbc\xb9\xa8\xbb\x59\xb9\xcc\x2d\x90\x22\xcf\x25\x84\xa9\x19\x7c\xc2\x74\x77\x9c\x91\x23\xf8\x6b\x4c\x8d\x75\x66\x13\x9a\xfa\x19\xd9\x7f\x2e\x99\xa0\xac\xa2\xa8\xe4\x54\x31\x8d\x39\x57\x56\x26\xf4\x6b\x6b\x72\xfe\x86\x09\x17\xf1\xb6\x75\x7a\xc3\x55\xbd\xfc\x4c\x3d\x5f\x9b\x56\xbf\xb8\xfd\x76\x1f\x2b\xb2\x95\xa2\xc6\x66\x2f\xbc\x68\xa2\x6b\x8c\x4f\xeb\x66\xb8\xda\xe8\x14\x71\xbd\xa8\x2d\x0a\x25\x9b\xac\xa3\x7e\x75\xe7\xa3\xcf\xeb\x81\xbd\x96\xf7\x6d\xe3\x4f\xdb\xcd\x52\xf7\x35\x48\x6d\x3d\x33\x5b\x8d\x50\x2f\xe6\xa7\x17\xf3\xd3\x8f\xc9\xfc\xb4\x15\xe3\x37\x99\x9c\x7e\x1c\xc6\xa6\xad\x4b\xdc\x64\x60\x7a\x96\xa6\xa5\x56\x2b\xda\x68\x4e\x7a\xb6\x86\xa4\xad\x4b\x6b\x69\x3c\xfa\xf7\x31\x1b\x6d\x85\xd8\x06\x53\xd1\x33\x34\x12\xb5\x11\xc8\x58\xda\x46\x4c\x1c\x46\x8d\x63\x41\xb1\x2a\x98\x18\x86\xf3\x2e\x35\xb1\x38\xb3\xab\xb4\x68\x05\xb8\xad\x73\x3b\x74\x93\x6b\x2f\x7b\x39\x81\xd1\x95\x13\x5c\x9a\x2c\xb9\xb8\xbc\xfe\x78\x79\x7e\x76\x73\x79\xd1\x94\xef\x56\x41\x7a\x8b\x24\xb6\xd9\x06\xd1\x8f\x24\xb1\x35\x0d\x2c\x41\x5e\xf3\x93\xc5\x81\x35\x3f\x95\x25\x5f\xd5\xeb\xfe\x72\xe1\xbd\xb8\xdc\xbd\xf8\xc7\xf6\xd3\xd9\xf6\x78\xda\xd3\x09\xd8\x82\x1e\x63\x56\xee\x99\xc9\x2c\xd5\xde\xd7\x74\x78\x11\xa2\x97\xb8\x48\xb2\x32\xb5\xc2\xc5\xa7\x4f\xc3\x0b\x3d\x20\xe4\x0d\x4b\x68\xa9\xc1\x0a\x93\x4a\x71\x68\xc8\x87\xab\x77\x7f\x04\x1f\x6a\x68\xd1\x0b\xc9\x3e\x20\x83\x2c\xa7\x98\x04\xd7\x60\x16\x32\xf2\x86\xa1\xa0\x02\x5f\x4e\x68\x61\xa9\x98\xc6\x2a\x0b\x06\x64\x91\x19\xcb\x0a\x4b\x31\x6f\x19\xa9\x72\x7f\xda\x81\xab\x1a\xe6\xde\xe5\x71\xca\x0c\x46\x3a\x6d\xf2\x6a\xdc\x08\xb5\x2d\x16\xd7\x7b\xd8\x5a\x6b\xea\xa3\xd3\xc6\xef\xa8\x76\x16\xab\x95\xb3\xdd\xb2\xbf\xdb\xed\x33\xeb\x4d\x1c\x6b\x8c\x1b\x48\x9e\xe1\xaf\xa5\x39\xdb\xc9\x56\x76\x0c\x74\x22\xe1\xa6\xb5\x35\x75\xbd\x1b\xd0\xea\x9c\xf5\x4b\xb6\x0c\xd6\x04\x72\xed\xc3\xc1\x8b\x3a\x9a\x72\xbb\xb9\x40\xc1\x8b\xb4\x56\x5d\xd2\x79\xdb\xd5\xdf\x95\xe3\x50\x5f\xb4\x9a\xaf\xb3\xc8\x90\x7f\xfc\xeb\xab\xff\x1f\x00\x00\xff\xff\x19\x53\xdb\x44\x5f\xac\x01\x00")
+var _operatorsCoreosCom_subscriptionsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xfd\x6d\x73\xe3\x36\x96\x28\x8e\xbf\x9f\x4f\x81\xf2\x4c\x95\xed\xb9\x92\xba\x7b\xef\xec\xcc\xfc\x7b\xa7\xb2\xe5\xd8\x4e\xd6\x37\x69\xc7\xb7\xed\x74\x6a\xef\xce\xfe\x27\x10\x09\x49\x18\x93\x00\x03\x80\x72\x6b\x76\xf7\xbb\xff\x0a\xe7\x00\x20\x48\xc9\x36\x49\x49\x7e\xe8\x90\x2f\x92\x36\x45\x82\xc0\xc1\xc1\x79\x7e\xa0\x05\xff\xc4\x94\xe6\x52\xbc\x27\xb4\xe0\xec\xb3\x61\xc2\xfe\xa5\x27\xb7\x7f\xd6\x13\x2e\xdf\x2c\xdf\xfd\xe6\x96\x8b\xf4\x3d\x39\x2d\xb5\x91\xf9\x47\xa6\x65\xa9\x12\x76\xc6\x66\x5c\x70\xc3\xa5\xf8\x4d\xce\x0c\x4d\xa9\xa1\xef\x7f\x43\x08\x15\x42\x1a\x6a\x6f\x6b\xfb\x27\x21\x89\x14\x46\xc9\x2c\x63\x6a\x3c\x67\x62\x72\x5b\x4e\xd9\xb4\xe4\x59\xca\x14\x0c\xee\x3f\xbd\x7c\x3b\xf9\xe3\xe4\x9f\x7e\x43\x48\xa2\x18\xbc\x7e\xc3\x73\xa6\x0d\xcd\x8b\xf7\x44\x94\x59\xf6\x1b\x42\x04\xcd\xd9\x7b\xa2\xcb\xa9\x4e\x14\x2f\xe0\x13\x13\x59\x30\x45\x8d\x54\x7a\x92\x48\xc5\xa4\xfd\x5f\xfe\x1b\x5d\xb0\xc4\x7e\x7c\xae\x64\x59\xbc\x27\x1b\x9f\xc1\xe1\xfc\x1c\xa9\x61\x73\xa9\xb8\xff\x9b\x90\x31\x91\x59\x0e\xff\xc6\xb5\x5f\x47\x5f\x85\xdb\x19\xd7\xe6\xbb\xb5\x9f\xbe\xe7\xda\xc0\xcf\x45\x56\x2a\x9a\x35\x66\x0b\xbf\xe8\x85\x54\xe6\xb2\xfa\xb6\xfd\x96\x2e\xa7\xf1\xbf\xdd\x83\x5c\xcc\xcb\x8c\xaa\xfa\x20\xbf\x21\x44\x27\xb2\x60\xef\x09\x8c\x51\xd0\x84\xa5\xbf\x21\xc4\xc1\xd1\x8d\x39\x26\x34\x4d\x61\x6f\x68\x76\xa5\xb8\x30\x4c\x9d\xca\xac\xcc\x45\xf8\xa6\x7d\x26\x65\x61\xd4\xf7\xe4\x66\xc1\x48\x41\x93\x5b\x3a\x67\xfe\x7b\x53\x96\x12\x23\xc3\x0b\x84\xfc\x5d\x4b\x71\x45\xcd\xe2\x3d\x99\x58\x10\x4f\x2c\x04\xa3\x9f\x71\x7f\xae\x70\x90\xe8\xbe\x59\xd9\xe9\x6a\xa3\xb8\x98\x3f\xf4\xf9\x84\x1a\x9a\xc9\x39\x41\xfc\x22\x33\xa9\x88\x59\x30\x62\x3f\xc5\x67\x9c\xa5\x7e\x7e\x0f\xcc\x08\x5f\x5d\x9b\xd3\x75\xf3\x76\xeb\x29\x2d\xa8\x10\x2c\x23\x72\x46\xca\x22\xa5\x86\x69\x62\x64\x05\x9f\x87\xc1\xe3\x5e\x5e\x9b\xcd\xe9\xda\xfd\x0d\xd3\xc1\x47\x97\xef\x68\x56\x2c\xe8\x3b\x77\x53\x27\x0b\x96\xd3\x6a\x0f\x65\xc1\xc4\xc9\xd5\xc5\xa7\xff\x7d\xdd\xf8\x81\xd4\x97\x12\xa3\x28\xb9\x65\xac\xd0\xd5\xa1\x20\x65\x61\xd7\x64\x17\x47\xa6\x2b\x62\x14\x4d\x6e\xb9\x98\xc3\xd2\xe7\xb8\xde\x53\xdc\x18\x3d\x59\x9b\xb2\x9c\xfe\x9d\x25\x26\xba\xad\xd8\x2f\x25\x57\x2c\x8d\xa7\x62\x21\xeb\x49\x44\xe3\xb6\x85\x53\x74\xab\x50\x76\x5a\x26\x3a\x87\x78\x45\x34\xaa\x76\xbf\xb1\xcc\x43\x0b\x0b\x7c\x8e\xa4\x96\x3c\xd9\xe9\x2f\x98\x3f\x1c\x2c\x75\x00\xb4\xdb\x69\x16\x5c\x13\xc5\x0a\xc5\x34\x13\x48\xb0\xec\x6d\x2a\xdc\x9a\x26\xe4\x9a\x29\xfb\xa2\x3d\xb0\x65\x96\x5a\x3a\xb6\x64\xca\x10\xc5\x12\x39\x17\xfc\x1f\x61\x34\x00\x91\xfd\x4c\x66\xf1\xc3\x10\x38\x6e\x82\x66\x64\x49\xb3\x92\x8d\x08\x15\x29\xc9\xe9\x8a\x28\x66\xc7\x25\xa5\x88\x46\x80\x47\xf4\x84\x7c\x90\x8a\x11\x2e\x66\xf2\x3d\x59\x18\x53\xe8\xf7\x6f\xde\xcc\xb9\xf1\x14\x38\x91\x79\x5e\x0a\x6e\x56\x6f\x80\x98\xf2\x69\x69\x37\xee\x4d\xca\x96\x2c\x7b\xa3\xf9\x7c\x4c\x55\xb2\xe0\x86\x25\xa6\x54\xec\x0d\x2d\xf8\x18\x26\x2b\x90\x44\xe6\xe9\x6f\x95\xa3\xd9\xfa\xb0\x01\xbe\x8d\xe7\x80\x78\xaa\xf7\x20\xac\x2d\xf1\x23\x5c\x13\xea\x5e\xc7\xb5\x54\x20\xb5\xb7\x2c\x54\x3e\x9e\x5f\xdf\x10\x3f\x01\x04\x3b\x42\xb8\x7a\x54\x57\xc0\xb6\x80\xe2\x62\xc6\x14\x3e\x39\x53\x32\x87\x51\x98\x48\x0b\xc9\x85\x81\x3f\x92\x8c\x33\x61\xec\x31\xcc\xb9\xd1\x80\x73\x4c\x1b\xbb\x0f\x13\x72\x0a\x0c\x88\x4c\x99\x3b\xb0\xe9\x84\x5c\x08\x72\x4a\x73\x96\x9d\x52\xcd\xf6\x0e\x6a\x0b\x51\x3d\xb6\xe0\x6b\x0f\xec\x98\x7f\xae\xbf\xb0\x76\xc6\x08\xf1\x0c\xee\xde\xdd\x89\x0f\xfc\x75\xc1\x92\x70\x1c\xa8\x20\x27\x45\x91\xf1\x04\x31\xde\x2c\xa8\x21\x09\x15\x16\x5e\x5c\x68\x43\xb3\x0c\xd8\x49\xab\x59\xdc\x77\xda\x09\x1c\xed\x06\x73\xf0\xb7\xd7\x28\x74\xfd\x87\xc0\xd4\x1a\x4f\xdc\x47\x19\xec\xe5\xe8\xec\xfa\x0f\x0f\x80\x9c\xa0\x64\x32\xe3\xf3\x4d\xaf\xdd\x0b\xcb\x53\x78\x05\x64\x1a\xca\x85\x76\x43\x94\x0a\xa1\x59\x71\x2a\xcb\xbb\x68\x8d\x6f\x4f\xee\x9d\xdd\x46\xc8\x3e\xb6\x66\x7b\xd1\x19\x48\x60\xab\xcd\xbf\x36\x56\x71\xe2\x1e\xb6\x47\xd6\x9e\xa1\x42\xa6\x87\x1a\x28\x58\x5a\x66\x40\xef\xa5\xd0\x46\x51\x6e\x8f\xe3\x3d\x03\x3e\x32\xdf\x36\x73\xb6\x97\x90\x29\x3b\x79\x64\xee\x6b\xf3\x3f\x63\xc8\x72\x35\xbc\x1e\xd6\x1e\xaf\x40\x95\x19\xd3\x41\x6c\x28\x64\x7a\xdf\x3a\x5a\xae\xa5\xed\x7a\xf0\x39\x36\x63\x4a\xb1\xf4\xac\xb4\xb8\x76\x1d\x66\x75\x31\x17\x32\xdc\x3e\xff\xcc\x92\xd2\x6c\x60\x63\x0f\x2e\xdd\x0a\x22\x6e\x99\x4c\x91\x3b\x9e\x65\xee\x73\x20\x8b\xb8\x1f\xec\x7a\x81\x13\x59\xf0\x68\x3c\xd8\x9a\x1a\xae\x67\x2b\x00\x47\x00\x18\xfb\x6c\xa9\x2e\x08\x8b\x11\xc2\x5a\xce\x0f\x04\x97\xb3\x2c\x1d\x91\x69\x69\x08\x37\x40\x8d\x93\x85\x94\x9a\x11\x8a\x70\x87\x71\x97\x5c\x02\xaf\x23\x52\x30\x22\x15\xc9\x2d\x49\x05\x9e\xca\xe2\xe1\x27\x30\xf3\xea\x35\xae\x49\x2e\xb5\xa9\x60\xe5\x71\xd1\x0e\x73\xc7\xcd\x02\xfe\x98\x5b\xf9\xdf\xf2\x51\x5d\xe6\x76\xd0\x3b\xc6\xe7\x0b\xa3\x47\x84\x4f\xd8\x04\x76\x97\xd1\x64\x11\x0d\x9b\x33\x66\x34\xa1\x59\xe6\xa7\x10\xa3\x04\x12\xa8\xdc\x32\x19\x72\x14\xb8\x90\xe3\x18\xa3\x40\xc0\x9a\xbb\xb6\x11\x5c\x23\xc2\x4c\x32\x39\x1e\x91\x44\xe6\x45\x69\x18\x9c\xf0\xdc\x82\x8e\x1b\x2b\x4c\x21\xc7\x53\xb2\x9c\xe3\x4a\x58\xe6\x3e\xec\xc5\x0d\x00\x2e\xc8\x03\x56\x3c\x17\x73\x72\x80\x8b\x3b\xf0\x12\x84\x1d\x8e\xe3\x22\x60\x7d\x39\x35\xc9\xc2\x09\x31\x89\x54\x8a\xe9\x42\x0a\x78\x13\x7e\x39\xaf\xe6\xf6\x2f\xe1\xa5\x23\x7d\x5c\x01\x73\xc1\xe7\x0b\x0f\x4b\xaa\x18\xdc\xab\xef\xc1\x43\x67\xa4\x3a\x27\x54\x29\xba\x7a\xe4\x49\x6e\x58\xfe\xc8\x29\x59\x43\xed\x13\x41\x58\x5e\x98\x55\x84\x13\xd1\xee\x19\xa6\xf2\x00\x03\xd8\x60\x38\xae\x1a\xd7\xc7\x73\xcb\xc7\xb8\x71\x18\x42\xde\x92\x23\x40\x11\x6e\x0e\x35\xa0\xeb\x58\x16\xc7\x13\x72\x02\xea\x63\x8b\x0f\x08\x19\xc6\x77\x03\xd9\x8f\x6a\x59\x8d\xf5\xe8\xda\x5a\x12\x15\xbc\xee\x67\x9e\xeb\xd7\xd8\xcd\x9f\x89\x0d\xbc\x73\xd3\xe3\x08\x93\x47\x1f\x6d\x4b\xde\xfc\xd3\x7e\x0e\x6d\x9e\x6e\x6e\x35\xa2\xb4\x66\x19\x4b\x8c\xa5\xd1\x4c\xe5\x23\x42\xb5\x96\x09\xb7\x72\x5a\x85\xb4\x75\x4c\xc7\x95\x3c\x0e\x7b\xd2\x15\xfe\xa4\xf3\xfa\xed\xd5\x3c\x78\x6d\xdf\x5b\x83\x46\xc6\xb5\xb1\x94\xa1\x0e\x95\x1a\xc1\x9a\xae\xe0\xd7\x43\x4d\x32\x3a\x65\xd9\xbd\x7c\x79\xfd\x6a\x7f\x6a\xab\xab\xe5\xf9\xbd\x77\x41\xf7\x2e\xc4\x69\x09\x61\xe3\x41\xe6\xf4\x12\x14\x6a\x40\x23\x42\xc9\x2d\x5b\xa1\xb2\x64\x75\x30\xa7\x9d\xe2\xc3\x8a\x21\xbb\xb1\xc8\x71\xcb\x56\xf0\x90\xd3\x9c\x3a\x4c\xb7\x33\x72\xe0\xd5\xe5\x98\x56\xd7\xd8\x4e\xb4\xe3\x1b\x7e\xd1\x1d\x5e\xeb\x8e\xbf\x78\xdd\xb2\x07\x25\xaf\x4d\xd7\x9a\x48\x02\x38\x09\xfb\x01\x9b\x04\xfc\xcb\xef\x31\xb5\x3a\x06\x18\x0f\xba\xec\x10\x79\x4c\x62\x7f\xe8\xf2\xd0\xdb\x6a\x5d\x1f\x83\x4a\x8a\x08\x79\xa8\x11\xf9\xec\x49\x5f\x70\x30\x94\x58\x4c\x86\x83\xeb\x75\xf7\x4f\x34\xe3\x69\x64\x4f\xb1\x7c\xf6\x42\x8c\xc8\xa5\x34\xf6\x7f\xe7\x9f\xb9\xb6\xe2\xcb\x99\x64\xfa\x52\x1a\xf8\x73\x42\xbe\x35\x88\xeb\xdf\xb7\xa4\x6c\x3b\x00\x10\xce\x77\x2b\xf0\x9c\x08\xa4\x29\x76\xf9\xb1\xd6\xaf\x27\xe4\x02\xc5\x96\x70\x70\xb9\xb6\x7a\xb7\x54\x1e\x0c\x60\x87\x81\x67\xdd\x10\x79\xa9\x41\x4d\x17\x52\x8c\x41\x06\xd8\x38\x06\x42\xcf\x8e\x13\xc3\xef\x81\xe1\xee\x1f\xea\x5b\x63\x87\xf9\xfe\xde\x97\x17\x74\x09\x22\x1d\x17\xf3\x2c\x08\x6f\x23\x72\xb7\xe0\xc9\x02\xa5\x6e\x50\x92\x0d\x53\x85\x62\x96\x61\x51\x50\xa7\xed\x9d\x39\x53\x56\xd8\xe5\x7e\x3c\x34\x2d\x65\x34\x61\x29\x49\x41\xb4\x44\x33\x09\x35\x6c\xce\x13\x92\x33\x35\x67\xa4\xb0\x9c\xa4\xdf\xee\x77\x23\xec\x78\x75\x26\xef\xf1\x07\x3b\xa1\x1b\xb0\xc8\x6f\xac\xac\xfb\x44\xdc\x11\xe4\xea\x81\x3b\x0e\xdc\xb1\x71\x0d\xdc\x31\x5c\x03\x77\x7c\xe4\x1a\xb8\xe3\xc0\x1d\xf7\xce\x1d\x51\x97\xed\xa1\x3c\xff\x84\x26\x8e\xa6\xb6\x0c\x9c\xd6\x3b\x5a\xea\x6a\xb3\xe5\x37\xd7\x8e\xe0\xdc\x80\xaa\xcd\x05\x3c\xa6\xa8\x98\x33\xf2\x6e\xfc\xee\xed\xdb\x2e\x4a\xb5\xdb\xc8\x56\x6f\xcc\xa4\xca\xa9\x81\x77\xfe\xf7\x3f\x3d\xf8\xc6\x7d\xf6\xb7\x1d\x58\x4d\x1d\x8e\x07\x43\x5e\x4d\x76\xb8\xc7\xf0\x09\xd4\x49\x48\x43\x72\x66\x08\x35\x35\x53\x11\xcf\xd9\xc8\x1b\x96\x11\xe1\x9d\x9f\xc9\x5b\x60\x53\x22\x85\xb3\xe3\x59\xe0\x4f\xfa\xcd\x20\x61\x54\x33\x4b\x49\xa7\x2c\xcc\x42\xe6\xf6\xab\x5c\x18\x7f\x5c\xec\x14\x98\x87\x0a\x39\x62\x93\xf9\x84\xa4\x25\xbc\x46\x85\x73\x7c\x1d\xe3\x6c\xf5\x4a\x1b\x96\x83\x25\x57\x2a\xf8\x9f\x9d\xb6\x51\x2b\xfb\x30\x5b\x32\x61\x4a\x9a\x65\x2b\xc2\x96\x3c\x31\x61\x7d\xe0\x77\xe3\x06\x8d\xed\xed\x4c\x84\xad\x44\x87\xf6\xe2\xc2\x78\x0d\x83\xf5\x23\xef\x74\xe1\xf6\x6b\x63\xb7\x39\x93\x0d\x5e\x88\x2b\x99\xdc\x2b\xac\x1a\x3b\x2e\xda\xc0\xe1\x9f\x80\x5c\x3f\x7c\x7c\xdc\xe4\x4a\x3a\x53\xb2\x0e\xd4\xab\x29\x96\x96\x59\x66\x11\x03\xad\xb0\xeb\x0b\xd8\x60\x1d\xc5\x25\xd5\x90\x19\x0d\xef\x68\x62\x3e\xb9\x3c\xb3\x50\xb1\xcf\xdc\xc8\x42\x66\x72\xbe\x8a\x21\x0d\x2b\x03\xdb\xad\x7b\x17\xdd\x64\x28\x34\x58\xf4\xbb\x6c\x6c\xcd\x60\xf9\x1b\x2c\x7f\x83\x6e\xb3\x76\x0d\xba\x4d\xb8\x06\xdd\xe6\x91\x6b\xd0\x6d\x06\xdd\x66\xb0\xfc\x91\x81\x3b\x3e\x00\x93\x81\x3b\x92\x81\x3b\xde\xbb\xae\x81\x3b\x3e\x08\x9e\x81\x3b\x0e\xdc\x71\xd3\x55\xc8\x74\x8b\x40\xc7\x42\xa6\x0f\xc4\x39\xa2\xd5\x27\x91\xe3\x4c\x26\xd4\xb8\xc8\x6a\xfb\x8a\xb3\xf3\x69\x9a\xa3\x21\x6a\x44\xfe\x21\x05\xc3\xe0\x35\xbb\x37\x60\x4e\x92\x66\xc1\x94\x7d\xfc\x48\x1f\x3f\x18\xd8\x34\xc4\x49\x0e\x71\x92\x2f\x3e\x4e\x72\x41\x35\xee\x2b\x12\xa5\xfb\xc3\x26\xa3\x03\x79\xc3\x54\xfe\x4a\xa3\x26\x2d\xba\xb8\xed\x86\x9c\x95\x6a\x4b\x71\xe5\xa9\xf3\x17\xb0\xf4\xaa\xbe\x5e\x27\x2f\xc3\xa2\x68\x9a\xb2\x94\x14\x4c\x8d\x11\x45\x24\x99\x71\x91\x6e\x58\xab\x87\xcf\xb3\x46\x3f\xd6\xd7\xf1\x8c\x21\x90\xf5\x89\xf4\xb0\xb9\xc6\x86\xe3\x1a\x85\x7f\x11\x01\x91\x5d\xa5\xfa\x31\x31\xce\xc8\xfb\x5d\x4b\xb9\xbe\xbb\x68\x0e\x02\xb5\x37\x09\xf7\xd7\x2b\x41\x2c\xff\xa5\x64\x6a\x45\xe4\x92\xa9\x4a\x60\x0d\xd9\x51\xce\x47\xc6\x35\x49\xa8\x46\x4e\xd1\x55\xb5\xec\xa8\x46\xf5\xd3\x53\xfa\x5b\xa2\x49\x13\x2e\xcd\xa1\x50\x27\xf5\x3a\x38\xc2\x6c\xa3\x12\xbe\xc1\x0b\x50\x59\xff\x3b\xcd\xa7\xaf\xe8\xd6\x4b\x70\xdb\x88\x14\x2f\x58\x39\x27\xfd\x15\x74\xd2\x5b\x49\x27\xbd\x14\x75\xd2\x57\x59\x27\x5b\x28\xec\xa4\x9f\xd2\x4e\x9a\xa8\x60\x77\xc8\x49\x59\xfb\xd1\xdf\xc9\x36\x2a\x2a\xd9\x42\x8f\x27\xcd\xa5\x06\x34\x55\xfb\x52\xea\x01\xd7\x6b\x7a\xfd\x53\x03\xab\x9f\x4e\x4f\x9a\xa0\x72\xca\x30\x07\x85\xf6\x95\x68\xf8\x4f\xa2\x6e\x93\xad\x54\x6e\xd2\x5f\xed\x26\xfd\x31\x03\x58\xdd\xf7\xe0\x4e\xdd\x96\x61\xe2\x28\xc8\x22\x72\x5a\x58\xa4\xf8\x2f\xcb\x09\x60\x5f\xfe\x87\x14\x94\x2b\x6d\xe5\x3b\x67\x33\x89\x7f\x73\xda\x79\x3c\x8c\x1d\x81\x6b\x62\x49\xf5\x92\x66\x96\xf7\x60\x1c\x87\xd3\x8b\xec\xe8\x4d\x36\x3d\x22\x77\x0b\xab\x6d\x5a\x2a\x85\xda\x12\xd7\xe4\xe0\x96\xad\x0e\x46\x6b\x88\x74\x70\x21\x0e\x90\x47\xad\xa1\x4e\x60\x68\x52\x64\x2b\x72\x00\xbf\x1d\xec\x9a\xb3\xf7\x60\x5c\x71\xf5\x8a\xbe\x7c\xa1\x07\x96\x08\x9f\x7c\xbc\x7b\x61\x13\xb9\x08\x3a\x36\xfc\x57\x74\xc5\x60\x20\xd4\x22\x62\x2e\x21\x6a\x04\x70\x0c\xee\xa7\x5e\xf9\x2d\x85\xab\x55\x00\xba\x6b\x35\x18\x32\xa9\xf5\x90\x26\xb7\xf1\x52\x30\x0d\x82\x1d\x0b\x26\xa2\xe8\x65\x78\x76\x82\xe1\x20\x15\xb7\x13\x69\x33\x40\xa4\x7a\x03\x64\xc4\x9c\x51\xa1\xc9\x81\xb7\x3d\x1d\xea\xea\x89\x83\x49\x95\xdd\x17\x46\x3c\xfa\xaf\xff\x39\xae\x65\xf4\x55\x03\x3a\xca\x15\xb0\x79\xca\x0c\x1d\x67\x6c\xc9\x32\x98\x07\x77\x48\xba\x90\x60\x27\x22\x77\x0b\x26\x48\xa4\xde\x5e\x36\xf7\x8e\xcc\x18\x35\xa5\x62\x70\xb4\x04\x9d\x66\x5d\xb0\x77\x10\xe6\x07\x61\x7e\x10\xe6\x07\x61\xfe\x81\x6b\x10\xe6\x3b\x5c\x83\x30\x3f\x08\xf3\x0f\x7d\x78\x10\xe6\x07\x61\xfe\xf1\x8f\xf7\x13\xe6\xfb\x86\x22\xc5\xa2\xb5\xf3\xff\x61\xb5\x2b\x6a\x78\x52\x85\x29\xf9\xa7\xf0\x5f\xbb\x15\xe9\x63\x71\x7d\xb3\x40\x1f\x0b\xfd\x6b\xea\xcb\xe4\x11\xe9\x3d\xc8\xf7\x6b\x6f\x3e\x28\xd8\xbf\xac\x68\xab\x1e\xa8\x11\xb9\x2c\x7a\xe2\xc6\x8d\x77\xb6\xbb\xe2\x70\x53\x56\x79\xe2\x53\x72\xe4\x7d\x3a\xc7\x16\xf6\x42\x9a\xfa\x8f\xc2\xf0\x71\xf5\x44\xf0\xf2\x80\x03\xb3\x96\xd1\x53\x73\x7c\x04\xbf\x7e\xf0\x45\x57\xdb\x69\x29\x08\x53\xb5\x39\x70\xed\x6a\x7e\x41\x3c\x86\x2a\x85\xb0\xa3\x4a\xe1\x1d\xd4\x48\x72\xb0\x66\x9b\x43\x3c\x94\x95\x60\x3e\x20\x30\x55\x50\x8a\x3c\xaa\xd4\x60\x99\x3c\x97\x2c\x20\x85\xf3\xb9\xda\x3b\xde\xaf\xec\x71\x12\x56\xc4\xc3\xd7\x27\xe4\x1c\xd0\x30\x1e\x98\x6b\x80\x0f\xcd\x32\x79\xd7\x5d\x41\xdb\x7f\xe2\xd5\x5d\xe7\xc4\xab\x86\x87\x70\xc8\xbb\xfa\x95\xe4\x5d\xc1\x8f\x78\x84\x76\x9e\x80\x45\x7e\x5a\x30\xc0\x22\xc5\x00\x54\x79\x99\x19\x5e\x54\xd1\x58\x1a\x3f\x95\xa1\x90\x39\x73\xb1\x2d\x75\xbc\xb4\x5f\xa3\xc9\xa2\x89\x9f\x30\x1e\x44\x6f\x69\x38\xb4\x2e\x7e\x84\x66\x99\xcb\x5a\xf2\x12\x29\x06\xc9\xf0\xe7\x8e\x7d\x38\xf3\x85\x0c\xbd\x32\x03\x44\xe6\xc8\xd2\xc2\xcc\x6e\xa8\xa5\x6a\x0f\x10\x51\xd4\x89\x96\xcc\x73\xde\x39\x5f\x32\x51\x51\xd2\x23\x7d\x7c\xec\x59\xf8\x4e\x29\xfc\x5e\x28\xf4\x5f\x22\x4a\xfa\x55\x1b\x1a\x0d\x0b\x0a\x54\xba\x02\x5f\x45\xa3\x9f\x33\xc8\xa3\x4b\x24\x41\x37\x13\x43\x8f\x08\x82\x27\x8c\x1e\x78\x3d\xb9\x6b\xcf\x6c\x60\x7c\x8e\xe8\xfd\x17\x6f\x54\x1c\xc2\xf7\xab\x6b\xdb\xf0\xfd\xbd\x1b\x0e\x9f\x37\x8a\xff\x15\x18\x0b\x9f\x33\x8a\x7f\x30\x10\x3e\xb8\x29\x2f\x2d\xb8\xbe\x7e\xf5\x32\x08\x0e\xc6\xc0\xde\x5c\xb8\x23\xc3\xd9\xd6\x08\xd8\x11\x23\x7a\x7a\xf2\x07\x2f\xfe\x17\xe3\xc5\x1f\x84\xea\x96\xd7\x20\x54\xdf\x0b\x94\x41\xa8\x26\x83\x50\xfd\xd8\xf2\x06\xa1\xfa\x41\xf0\x0c\x42\xf5\x83\x9b\x32\x08\xd5\x83\x50\x4d\x5e\x9b\x50\xdd\xa7\xd4\xd8\xe0\x4d\xdf\xc6\x9b\xde\x95\x58\x74\x22\x11\x1d\xd1\xa0\xb3\xf7\x7c\xf0\x9c\xbf\x14\xcf\x79\xeb\xa2\x05\xc2\xf0\x6d\x0b\x17\xc4\x7b\x75\x5f\xf5\x02\xba\x94\x3c\x25\x45\x69\x5c\x4e\xf8\x50\xc1\x60\x17\x15\x0c\x6a\x90\x1f\xca\x18\xb4\x2a\x63\x70\x1f\xcc\x86\x5a\x06\x43\x2d\x83\x1d\xbb\xb9\x87\x5a\x06\x43\x2d\x83\xa1\x96\x81\xbf\x86\xf4\x27\x32\xa4\x3f\xb5\xba\x86\xf4\xa7\xfb\xaf\x21\xfd\xe9\xc5\x1a\x5f\xc9\x90\xfe\xf4\xb2\x0d\xb1\x64\x48\x7f\x1a\x8c\xb3\x2d\x37\xea\x15\xa6\x3f\x0d\xb5\x0c\x7e\xc5\x51\x10\x64\x10\xe6\x07\x61\x7e\x10\xe6\x07\x61\xfe\xe1\x6b\x10\xe6\x3b\x5c\x83\x30\x3f\x08\xf3\x0f\x7d\x78\x10\xe6\x07\x61\xfe\xf1\x8f\x0f\xb5\x0c\x5e\x4f\xf4\x05\x19\x6a\x19\x0c\x11\x19\x43\x2d\x83\x5f\x6f\x2d\x83\x5a\x74\xc0\xf3\x15\x34\xe8\x3e\x8d\xa1\xaa\xc1\x50\xd5\x60\xa8\x6a\x30\x54\x35\xf0\xd7\x50\xd5\x00\xaf\x97\x64\x6a\x1c\x12\xb0\xee\x05\xca\x90\x80\x45\x86\x04\xac\xc7\x96\xf7\x0a\xcc\x86\x43\x02\xd6\x0b\x34\x15\x0e\x09\x58\x83\x59\xb0\xb9\x39\xaf\x24\x01\x6b\xa8\x6a\xf0\x2b\xf5\xe7\x0f\x42\x75\xcb\x6b\x10\xaa\xef\x05\xca\x20\x54\x93\x41\xa8\x7e\x6c\x79\x83\x50\xfd\x20\x78\x06\xa1\xfa\xc1\x4d\x19\x84\xea\x41\xa8\x26\xaf\x4d\xa8\x1e\xaa\x1a\x0c\x55\x0d\x86\xaa\x06\xaf\xd0\x87\xfe\xe8\x4e\x53\x21\xa4\x41\x91\xe5\xbe\xbd\xad\x8b\xf2\xd5\xf3\x51\x7a\xb1\x3b\x87\x76\x97\x0d\xcb\x0b\x2b\x90\xdb\x15\xb2\xa5\x55\xa4\x53\x56\x64\x72\x65\x09\xe5\x7d\x73\x6e\x41\x0b\xbb\x51\xbe\x47\x97\x5d\xcd\xa9\xdd\xb2\xcf\xaa\xe7\x2d\x53\x98\xf1\x39\xa8\xa8\x22\xe5\x4b\x9e\x96\x34\x8b\x07\x0c\x7b\x5b\x4e\xb1\xea\x43\x4a\x4e\xaf\x3f\xe9\x91\x45\x00\x23\x0b\xff\xb3\x1f\x06\x80\x49\xe8\x54\x2e\xd9\xc3\xf0\x79\x88\x18\x3c\x72\xfc\xef\x59\xcb\x29\x4c\xc1\xcb\xea\xf5\x09\x59\xcc\xf5\xfc\xb9\x5a\x1c\x22\x74\x60\x9f\xf7\x23\x61\x2b\xf6\xd6\x46\x7b\x1a\xc3\xd9\xbd\xf7\x81\x76\x3a\x0e\x6d\x51\xb0\xa3\x89\xe8\x55\x30\x01\x48\x83\xda\x63\xf8\xa1\x8e\x43\x16\x12\x29\xac\x70\xc8\xad\x76\xfe\xe0\xe0\xad\xf9\x7d\x7b\xad\xcd\x52\x95\x36\xa5\x48\x36\xac\xae\x2a\x48\x02\xa4\xe9\xfe\x62\x24\x33\xa9\xfc\xc2\x1f\xa7\x38\x9d\x64\x9a\x8e\x09\xf9\xbb\x2d\x2d\xb2\x01\x24\xbb\x29\x30\x32\xd4\x16\xe9\x54\x5b\xe4\x45\x94\x15\xb9\xbf\x8e\x48\x53\xad\xd8\x5b\x21\x91\xf8\xfc\xec\x49\xe8\x6b\xb0\x71\x27\xd3\x56\x58\x12\xc7\x61\x59\x31\x3b\x36\xf7\xe2\x91\xd6\xb8\x62\x9e\x17\x19\x4f\xb8\xf1\x41\x73\x6f\xc9\x11\x20\x0d\x37\x87\x1a\x10\x78\x2c\x8b\x63\xab\xdd\x81\x6c\xfc\xf8\x07\x84\x0c\xe3\xbb\x81\xec\x47\xb5\xac\xc6\xea\x24\xd6\x76\x52\xa9\xba\xdb\xef\xc6\x6e\x45\x4c\x24\xf7\x73\xa5\xf5\x97\x5a\x56\x29\xc1\xab\x8f\xe1\xae\x9a\xd5\x16\x66\x5a\x38\x0e\x95\x2d\x76\x0f\x95\x4a\xf0\xea\x69\x6d\xed\x6b\xd0\xdc\x2e\xf7\x6e\xdd\x98\xed\x75\xd7\x1a\xb4\x6a\x44\x70\x8a\x32\xff\xa1\x46\x4d\xa1\x67\xfa\xd8\x13\xda\x8a\x1e\x44\x84\x97\x67\xae\xc7\x6b\x8b\x24\xbb\x6d\xd2\xec\xfa\x26\xda\x6d\x91\x6a\xb7\x5d\xb2\x5d\xef\x74\xbb\x0d\x22\xd2\xfe\x32\xed\xb6\x4c\x1f\xdb\x2e\xdb\x6e\xad\x7a\xd3\x9e\x4c\xfb\xa3\xba\x5d\x9f\x7c\x6b\xf0\x9c\x7c\xdf\x2b\xdf\x6e\x6b\x90\xf5\xcf\xb9\x5b\x17\x28\x5e\x89\xad\x7f\xc3\x50\xdf\x1a\x3b\xcc\xf7\xf7\xbe\xbc\xa0\x4b\x56\x69\xc3\x4e\xe0\x1c\x39\x6b\x0e\x68\x0a\x53\x17\x52\x5d\x28\x66\xd0\x8c\x44\x85\x8f\xe9\x7f\xc2\xf4\xbe\x6d\x13\xfc\xb6\x4a\xf1\xdb\x02\x19\x81\x41\x7f\x03\x45\xeb\x9e\x92\x37\x63\x99\xbc\x81\x37\x0f\xbc\x79\xe0\xcd\x8f\x5d\x03\x6f\xee\x7c\x0d\xbc\x79\xe0\xcd\xf1\xf5\xfa\x78\x73\x97\x7c\x4a\xbc\x6a\x88\xf7\x53\xe7\xac\x4a\xcb\xdb\xbc\x17\x6f\xbb\xb4\xca\x78\xd5\x5d\x92\x2b\xf1\x6a\x9f\x62\x89\xd7\x6e\x13\x2d\xf1\x1a\x5a\x47\x43\x94\xc7\xce\x73\x2b\xf7\x13\x4d\xda\xbd\xa2\x6e\x13\xd7\xf5\x9e\xa2\x56\xd7\xbe\xd3\x33\xb2\x27\xae\x68\xbc\x59\xcc\x86\xfc\xd1\x2a\x52\x03\xd9\xec\x0f\x1f\x5f\x7a\x70\x6a\x23\xca\x63\x6d\x49\x1b\x2c\xd5\x1b\x62\x80\xd0\x2d\x92\x47\xb1\x40\xf0\xcc\x8d\xf3\xe0\xc7\x3b\x00\xab\x04\x3b\xba\x8f\x1f\x02\x67\x31\x8a\x2c\x16\x69\x2f\x1b\x5b\x36\xd8\x56\x07\xdb\xea\xa0\xbf\x3d\xf6\xde\xa0\xbf\x3d\x70\x0d\xfa\x5b\xe7\x6b\xd0\xdf\x06\xfd\x2d\xbe\x5e\x9f\xfe\x46\x06\xdb\xea\x23\xd7\xc0\x9b\xbb\x5c\x03\x6f\x1e\x78\xf3\xc0\x9b\x07\xde\x3c\xf0\xe6\x0d\x1f\x6f\x85\x8c\x51\x31\xae\xde\x21\xbb\xb5\x76\x52\xf7\xb4\x0f\x0c\xe9\x0d\xfb\x6a\x1d\x18\xaf\x7c\x88\xf8\x1d\x22\x7e\x5f\x55\xc4\xef\x8b\x6b\x24\x18\x9f\xa6\xa7\x88\xff\x7d\xa1\xad\x05\x63\x30\xec\x3f\x8e\xb7\x73\xb3\x41\xff\xe6\xfe\x83\x79\xfb\xb4\x1f\xc4\xeb\xf9\x9a\x10\xe2\xf5\xc4\x85\x14\xba\xb6\x25\xc4\xab\xaf\x52\xd1\xb3\x45\x21\x5e\x4f\xdc\xa8\x10\xaf\x2d\x54\xc5\x6d\x34\xaf\x6d\xbd\x02\xe4\x85\xd5\x4c\xa9\xae\x67\xab\xa0\xff\xda\x2a\xaa\x54\xd7\x56\xe6\x0a\xb2\xa5\xc9\x82\x6c\x61\xb6\x20\xdb\x99\x2e\xc8\xd6\xe6\x0b\xb2\x8d\x09\x83\x3c\x4b\xbf\x14\xbc\xb6\x54\xcf\xc9\xd6\x56\x0d\xf2\x0a\xab\xb6\x54\xd7\x0e\xc0\xb7\x8d\x85\x83\x0c\x35\x5d\x3a\x5f\xdb\x1a\x20\xc8\xb6\x46\x08\xb2\x2d\xde\xf4\xee\xb5\x82\xd7\x50\x05\x66\x07\x72\x45\x6f\x26\xb9\x8b\xee\x2b\xf1\x44\x7a\xe0\xd0\x16\x6d\x15\xf1\x1a\x8a\x31\x7e\x31\xc5\x18\x9b\xe8\x34\x28\x20\x83\x02\x32\x28\x20\x3d\xdf\x1d\x14\x90\xee\xd7\xa0\x80\x0c\x0a\xc8\xa0\x80\x74\xbd\x06\x05\x64\x50\x40\xbe\x00\x05\x64\xbb\x40\xb7\xa1\x70\xe5\xb6\x0d\x21\xe3\x7d\x7c\xd9\xf5\x6e\xdd\x8b\x7d\x1a\x45\xe2\x35\x94\xba\x7c\x29\xa5\x2e\xb7\xc4\x83\x2d\x53\x1d\x9f\xb1\x81\x64\xbc\xe8\x21\xd3\xf1\xd5\x66\x3a\x0e\xed\x24\x6b\xd7\xd3\xc5\xc2\x0c\x0d\x26\x9f\xb7\xc1\x64\xbc\xdf\x7b\x0f\xfa\xe9\x1e\x2f\xd2\xc7\x80\xd2\x3b\x4e\xe4\xc9\x63\x44\x5e\x73\xb6\xe8\x8b\x30\xc9\x3e\x77\xc6\xca\x2b\x31\xc3\x0e\x29\x2b\x6d\xaf\xdd\xa4\xac\x3c\x91\xa9\xf5\xe5\x64\xae\xbc\x1a\xf3\xea\x4b\xc9\x5c\x19\x4c\xaa\x0f\x5e\xaf\x25\xa1\xa4\x7e\x6d\x61\x42\x1d\xcc\xa7\x5b\xca\x00\xbd\x98\xdb\x6e\xcc\xa6\xbd\xf0\x65\xab\x78\x8d\x21\x56\xe3\x0b\x8b\xd5\x18\x14\x81\x41\x11\x18\x14\x81\x8d\xef\x0d\x8a\xc0\xe3\xd7\xa0\x08\x74\xbe\x06\x45\x60\x50\x04\xda\x7d\x7c\x50\x04\x06\x45\xa0\xcb\x04\xfa\x2a\x02\xfd\x8b\x28\x0e\x31\x13\xdb\xc7\x4c\xf4\x23\x34\x3d\xc8\x4b\x2f\x24\xe9\x19\x23\x31\xc4\x47\xbc\xac\xf8\x88\x8e\xa5\x4a\x84\xe1\xbb\x29\x57\x12\xef\xde\x7d\x35\x4b\xe8\x52\xf2\x94\x14\xa5\x71\x15\x1f\x86\xba\x25\x9b\x41\xbb\x9b\xba\x25\xb5\x1d\x19\x8a\x97\xb4\x2a\x5e\x72\x1f\xcc\x86\x0a\x26\xb5\x73\x35\x54\x30\x19\x2a\x98\x0c\x15\x4c\x5a\x5d\x43\x05\x93\xc7\xae\x21\x81\x70\x48\x20\x1c\x12\x08\x87\x04\xc2\x21\x81\xf0\xb5\x18\xb6\xc9\x90\x40\xf8\xda\x8c\xdc\x64\x48\x20\x1c\x0c\xdf\x43\x02\xe1\x50\xc1\x64\x88\x8a\xb9\x07\x9d\x06\x05\x64\x50\x40\x06\x05\xa4\xe7\xbb\x83\x02\xd2\xfd\x1a\x14\x90\x41\x01\x19\x14\x90\xae\xd7\xa0\x80\x0c\x0a\xc8\x17\xa0\x80\x0c\x15\x4c\x9e\x3b\x1a\x87\x0c\x15\x4c\x86\x08\x9d\xa1\x82\xc9\x50\xc1\xa4\x7b\x7c\x8e\xaf\x1f\x52\x8b\x16\x79\xbe\x32\x26\xdd\xa7\x31\xd4\x32\x19\x6a\x99\x0c\xb5\x4c\x86\x5a\x26\x70\x0d\xb5\x4c\xee\xb9\x86\x14\xc6\xea\x1a\x52\x18\xdb\x5e\x43\x0a\x63\x9b\x6b\x48\x61\x7c\x15\x86\xd6\x21\x85\xf1\x55\x18\x57\x87\x14\xc6\xc1\x90\xda\x6d\xbb\x5e\x6d\x0a\xe3\x50\xcb\x64\x88\xda\x18\x14\x81\x41\x11\x18\x14\x81\xcd\xef\x0d\x8a\xc0\xe3\xd7\xa0\x08\x74\xbe\x06\x45\x60\x50\x04\xda\x7d\x7c\x50\x04\x06\x45\xa0\xcb\x04\x86\x5a\x26\xaf\x31\x7a\x62\xa8\x65\x32\x44\x4a\xbc\x94\x5a\x26\x54\x08\x69\x50\x54\x7a\x78\xb7\xeb\x4a\x48\xf5\x56\x54\x2a\xc0\x9d\x5b\xbb\xef\x86\xe5\x85\x55\x25\x1e\x9e\x71\x6b\x2a\xda\x87\x66\xb6\x04\x80\x63\x15\x4c\x75\x59\xff\x69\x78\xc9\xbe\x3f\xe3\x73\x50\xc7\x45\xca\x97\x3c\x2d\x69\x16\x0d\xea\x37\x3d\x65\x45\x26\x57\x96\xeb\xb4\x01\xc9\xe3\xb4\xa1\x15\x35\xd8\x3c\xe7\x53\x98\xb1\xd7\x15\xfc\xfc\x61\x37\x01\x77\x3d\x77\x0f\x8b\xc0\xbb\x6d\x57\xd0\x89\x39\xb6\xd7\xf3\xc6\x70\x9e\x1f\x79\xac\x8b\x1e\xc6\xc4\xb2\x0d\x7d\xab\xc1\xf0\x5c\x2c\xa1\x26\x8b\x15\x35\x2d\x07\x72\x18\x1f\x20\x75\xa8\xed\xb0\x5c\x49\x01\x02\xce\x92\x2a\x4e\xa7\x99\x25\x40\x28\xb9\xa2\xa0\xed\x6e\x06\x7a\x00\xb5\x7b\x68\xfe\xc8\x61\xc1\xab\x1b\xef\xe8\xc0\x33\x9a\xeb\xfc\x44\xeb\x8a\x95\xd8\xb8\x32\xe2\x1e\xb0\xd4\x97\x56\x28\xd6\x25\xf2\xa3\x83\x10\xd5\xd5\x2a\xd0\x0a\x67\xf0\xea\xae\xc1\xdb\xa1\xdb\x33\xc8\x1a\x78\x2f\xed\x8e\xfb\xea\x3f\x1b\xa0\x3a\x21\x1f\x9c\x16\x44\xc9\xe9\xdf\x2e\xce\xce\x2f\x6f\x2e\xbe\xb9\x38\xff\xd8\x9e\xfe\xf7\xe0\xfc\xc0\xeb\x7a\x2e\xe8\xf0\x93\xc7\x07\xa8\x09\xc3\x84\x95\xa6\x7e\x77\xf4\xe9\xe4\xe3\xdf\x2e\x4f\x3e\x9c\x1f\x03\x93\x60\x9f\x0b\x2a\x2c\x9f\x28\xb5\xe7\xe0\x85\x62\x4b\x2e\x4b\x9d\xad\x02\x37\xde\x78\x7e\x3c\x73\xaf\x48\x12\x9a\xaf\x56\x44\x33\xb5\xe4\xc9\x66\x30\xa2\x36\x4b\x2b\x64\x4d\x80\xdb\x59\xb8\x2a\xa6\x65\xb6\x64\x29\x6a\x15\x61\xd2\xfe\x3b\x5c\x14\xa5\xf1\x9a\x31\xc4\x93\x4d\xad\x3c\x9a\x2c\xa8\x98\xb3\x74\x42\xce\x64\x69\xc7\xfb\xdd\xef\x60\x61\x8a\xa5\x65\x82\xfc\x2f\x10\xcf\xdf\x8d\x3c\xe3\xb7\xac\x5b\x63\x15\x26\x9d\xd0\xc2\x2f\x3d\x86\x8e\x5e\x09\x43\x3f\xbf\xc7\x80\xaa\x83\xdf\x45\x3f\x1d\xf8\x0a\x56\xd2\x7e\x02\xc9\x05\xce\x2a\x83\xe2\x49\x19\x39\x88\x9f\x9e\x90\x73\xfb\x0d\x96\xc6\xfb\x80\xf1\x70\x6c\xc9\x14\xe8\xd5\x6e\x17\x2c\x49\x9a\x53\x95\x66\x4c\x03\x25\xba\x5b\x30\xa8\x12\x86\xba\x94\x03\x18\x0b\x5a\xbb\x90\x66\x42\xce\xd8\x8c\x96\x99\x81\xca\x59\x07\x07\x93\xc3\xbd\xa3\xe3\x37\x4a\x76\xa8\x8e\x53\x43\xc9\x6b\xac\x5b\x35\x93\xea\xde\x63\x76\xe8\x8c\xa2\x13\x72\x1a\x10\xa3\xd4\x96\xb0\x3b\x3b\x91\x17\xbf\xd0\x16\xd1\x71\xb5\x1d\x95\xc3\x7e\x26\x44\xe4\xde\x1f\x68\xf1\x1d\x5b\x7d\x64\xb3\x6e\xea\x41\x1d\x5e\x20\x95\x3a\x0b\x1a\x72\xfc\x53\x3f\x78\x37\xed\xb8\xb7\x6e\xdc\xd7\xe8\xdb\xdd\xe0\xdb\xdf\x5e\xdb\xcb\x56\xbb\x56\x9b\x0b\xf5\x07\xa7\x09\xf4\x35\xad\xf7\x32\x28\x76\xe3\x5a\xfe\xaa\x13\xfb\x98\x7d\x39\x3a\x63\x26\xe4\x83\x04\x19\x78\x26\xdf\x93\x85\x31\x85\x7e\xff\xe6\xcd\x6d\x39\x65\x4a\x30\xc3\xf4\x84\xcb\x37\xa9\x4c\xf4\x9b\x44\x8a\x84\x15\x46\xbf\x91\x4b\x4b\xb9\xd9\xdd\x9b\x3b\xa9\x6e\xb9\x98\x8f\xad\x62\x35\x46\xac\xd1\x6f\x40\x77\x7b\xf3\x5b\xb4\x1e\xdc\xfc\x70\xf6\xc3\x7b\x72\x92\xa6\xae\x90\x61\xa9\xd9\xac\xcc\x5c\xa9\xb2\x09\xa1\x05\xff\xc4\x94\xe6\x52\x8c\xc8\x2d\x17\xe9\x88\x94\x3c\xfd\xd7\xf6\xc4\xc9\x5f\x5b\x40\x55\x16\xa8\x9d\x6c\x09\xd9\x6b\xd0\x5d\x57\x35\x5a\x1c\x0e\xa1\xa5\xc2\xdc\x68\xc0\x1d\x6f\x24\x75\x4c\xb3\xe7\x52\xa7\x52\x66\x8c\x8a\x2e\xd1\xe4\x16\xe4\xdb\xd1\x99\xc3\x8a\xd0\xa0\xe5\xc6\x21\x52\x21\xd3\xf7\x44\x97\x45\x21\x95\xb1\x12\xb6\xa1\x29\x35\x74\x62\x31\x60\x54\xff\x13\x74\xfa\x11\xf9\x39\xdc\x04\xc5\x5c\xff\xc7\xe1\xe1\x5f\xbe\x3b\xff\xf7\xaf\x0e\x0f\xff\xf3\xe7\xf8\xd7\x48\xc3\xad\x3f\xa2\x0b\x96\x4c\xac\x96\x7e\x09\xdf\x80\x3f\x9d\x38\x71\x92\x24\xb2\x14\xc6\xfd\x60\xa8\x29\xf5\x64\x21\xb5\xb9\xb8\x0a\x7f\x16\x32\x6d\xfe\xa5\x3b\x70\x44\xf2\x3c\x84\x12\x40\x7e\x45\xcd\xe2\x89\xc8\x65\x75\x36\xb7\x3c\x1a\x6e\x94\xb8\x60\x65\x4e\xe1\x9f\xdf\xf8\x25\x59\x6e\x7d\xa7\xb8\x31\x4c\x80\x1c\x07\x41\xe9\x72\x36\xb2\xa7\xa4\x12\x5b\x96\xef\x0e\x9e\x94\xe0\x06\x88\x6f\x09\x00\x58\xa1\x5b\x3d\x1e\x9c\xc0\x40\xd6\x4d\x5f\x27\x57\x17\x64\x89\x10\x7b\xb2\xc5\xfa\x40\xe5\x6f\x76\x4a\x23\x42\x01\x52\xb7\xf4\xa0\x01\xbc\x47\xdb\x7e\x08\x8f\x26\x19\xcf\xb9\x73\xd9\xb9\x62\xa5\x9a\x1c\xe1\xcd\x49\x52\x94\x23\xf7\xc0\x24\x67\xb9\x54\xab\xf0\x27\x2b\x16\x2c\xb7\x92\xf4\x58\x1b\xa9\xe8\x9c\x8d\xc2\xeb\xf8\x5a\xf8\x0b\x5f\xac\x7d\x60\xfd\x6d\x54\x75\x92\x52\x59\xa6\x98\xad\x3c\x45\x63\xe9\xcb\xa7\x0d\x1e\x94\x4f\x44\x1a\xc2\x4e\x5e\xee\x40\x24\x09\x96\x07\x14\x70\x02\x14\x40\xfe\x5f\xca\xac\xcc\x99\x1e\x05\x36\x8d\xda\x98\x58\x5a\x4d\x40\x3f\xa9\xa0\x90\xf2\x25\xd7\x7d\xbc\xdf\x1b\xe4\x04\xee\x02\x35\x64\x69\xac\xb6\x8a\x99\x5e\x51\x51\x61\xa9\x41\x0f\x0c\xe9\x03\x35\x32\xf8\xae\x6b\xba\x27\x21\x05\x35\x86\x29\xf1\x9e\xfc\xff\x8f\xfe\xfa\xbf\xfe\x7b\x7c\xfc\xaf\x47\x47\xff\xf1\x76\xfc\xff\xfb\xcf\xff\x75\xf4\xd7\x09\xfc\xe3\xf7\xc7\xff\x7a\xfc\xdf\xfe\x8f\xff\x75\x7c\x7c\x74\xf4\x1f\xdf\x7d\xf8\xf6\xe6\xea\xfc\x3f\xf9\xf1\x7f\xff\x87\x28\xf3\x5b\xfc\xeb\xbf\x8f\xfe\x83\x9d\xff\x67\xcb\x41\x8e\x8f\xff\xf5\x77\x9d\xa7\x4a\xc5\xea\x87\x8e\x04\x08\xaf\x71\xef\x44\xbb\xe6\x08\x3d\xdd\xfd\x9f\xc7\x95\xd0\x3c\xe6\xc2\x8c\xa5\x1a\xe3\x50\xef\x89\x51\x65\xb7\xc3\x59\x11\xe5\x6d\xcf\x97\xaf\x34\xfb\xbe\x22\xc8\x81\xfd\x3c\xd9\x01\xd2\x2c\x51\xcc\xec\x43\xd3\xc5\x91\x3d\x1f\x6d\xb8\xf6\x5e\x3a\xdd\x7e\x8d\xca\x6f\x70\x85\x01\xdc\x2b\x49\x66\xa6\x64\x3e\x21\x91\xf9\x73\x09\x71\x3a\xee\xb9\x5b\xd6\xc1\x0a\xe3\xaf\x41\x59\xfe\xf5\x28\xcb\xd7\x88\x27\xcf\xa6\x29\xb7\x45\x98\x7b\xbd\x01\x49\x17\x0f\x4a\xa7\x3d\x08\x82\x40\xe7\xf9\x7d\x0c\x22\xb6\x0b\xbf\x72\x33\xc5\x62\xfe\x95\x00\xde\x7f\x09\xad\xc9\x65\x57\xd2\x85\x32\x7e\xaf\x64\xe1\xc3\xef\x51\x9f\x48\x43\xab\x0e\x0c\x6f\xfa\xcc\xf3\x32\x27\x34\x97\x25\x46\x30\xad\xc3\xc1\x3b\xeb\xbb\x1e\xf2\x9a\x27\xf6\x4d\x4e\x05\x9d\xb3\x71\x18\x76\x5c\xf9\x94\xdf\xb4\x3d\xae\x3d\x98\xd1\x76\xd1\x49\xaf\x40\x44\xec\x2c\x1a\xf6\x17\x09\x7b\x8a\x82\x3b\x11\x01\xbd\x86\xda\x0f\xf7\x3f\x7a\x05\xba\x81\xfd\x5c\x3c\x8a\xfd\x2a\xf4\x24\xb8\x98\x91\x30\x0e\xd7\x44\xe6\xdc\x18\xa7\x91\xd1\x8a\x4a\x8c\x08\x37\x35\xd5\xc4\x9d\x3b\xe8\xe7\x81\x2d\x50\xd8\xe7\x22\xe3\x09\x07\x75\xda\x9b\x38\x46\xc8\xde\xee\x38\xd6\x62\xa0\x82\xf0\xbc\xc0\xb8\x42\x38\x3f\x63\xef\x4f\x74\x1e\x9e\xe1\x2c\x0e\x67\xf1\x39\xce\x22\xda\x1e\x3e\xd8\xf3\xd2\x9d\xf3\x7e\x8a\x5e\x5e\x8f\xb3\x8a\x8e\x50\x15\x5d\x92\xe3\xc3\x2f\x37\xb4\x24\x5a\x53\x44\x5d\x28\x4e\x1c\x62\xf0\xac\x42\x86\x4f\x41\xb0\x1d\x44\x96\x74\x12\x2a\x9e\x24\xb2\x04\xe6\xdb\xc1\x92\xbe\xd7\x58\x94\x30\x99\x9e\xce\x72\x30\x2e\x3b\x60\x37\xe2\x2c\x8c\x8b\x63\x80\xb8\x00\xdc\x95\x2a\xa8\x13\xbe\x6b\x69\x3d\x6a\x6c\x18\xca\x09\xaf\x92\xc3\xf7\x87\x7b\x0d\x57\xc1\x25\x2b\x59\xd0\x39\x6d\x5f\x8a\x68\x6d\xe5\xcd\x61\x48\xca\x0c\x53\x39\xd4\x89\x59\xc8\x3b\x7f\x9e\xec\xe1\x2b\xdc\x53\xcc\x15\xdd\x81\xd6\x50\x52\x83\xfa\x5a\x0f\x4c\x01\xab\x1c\x28\x25\x77\x74\x45\xa8\x92\xa5\x48\x5d\x69\x1e\x0b\x22\xcd\xcc\x88\x7c\x68\x7c\xf8\x52\x0a\x88\x37\x28\xb5\x85\xe7\x7a\xc6\x20\xe1\x82\xbc\x9b\xbc\xeb\x50\x34\xaa\x07\x50\xb7\x88\x69\x82\x19\x83\xb6\x05\xc1\xb0\x00\x03\xaf\xd9\xf8\xf3\xbc\xd7\xb9\x2b\x46\xd3\x1f\x44\xd6\xc1\x42\x51\x9b\xff\x07\x44\x65\x18\x66\x0c\xae\x05\x2b\x81\xa8\x12\xfc\x01\x34\x1d\xdf\x29\x6e\x58\x24\x74\x1c\xcd\x68\xa6\xa1\x97\x5b\x29\x82\x54\x72\x5c\x8f\x91\x81\x47\xba\x2e\xba\x9b\x57\x56\x97\xd3\x1d\x9e\x7b\x77\xc0\x01\xbd\xab\x63\x1f\x87\x37\xde\x4f\x02\xea\xc1\x41\xe4\x08\x9f\x3c\xd4\x44\x49\x69\x5a\x74\x1d\xac\x43\xa1\xd3\xd6\x3b\x20\x9c\x7f\x2e\x3a\x58\xd6\xeb\x01\x8f\x3e\x36\xad\xd8\x21\x44\xbe\x66\x0b\xba\x64\x9a\x68\x9e\xf3\x8c\xaa\x0c\x62\x3e\xae\x71\xae\xd0\x2f\x70\x63\x44\x65\xb7\x08\xba\x7b\x42\x4f\x1f\xdd\x0e\x3f\x0f\x0b\x33\xa0\x59\x7e\x5e\x58\x35\xcc\x97\x1a\xfb\x9c\x64\xa5\xe6\xcb\xdd\x9f\xdc\xac\x45\xc6\x52\x6d\x87\x5c\x4e\xd1\xc3\xd1\xe6\xe1\xa9\xcc\x9e\xd9\x15\x24\xfd\x4d\x57\x6b\xcf\xc1\x28\xb7\xac\x68\x15\x8b\xfd\xbc\xe1\xe9\x8f\x53\xe4\x7b\x6d\x49\x55\xc0\xb6\x37\x6c\x9f\x5e\x7f\x3a\xd4\x84\x0b\x6d\x68\x96\xf9\xd4\xb9\x47\x4c\xaa\x6d\xe7\x29\xd3\x96\xe5\x05\xea\xf3\x8d\x5e\xf3\xe6\x25\x1d\x1b\xe1\x65\x5a\xe5\xae\xbe\xfc\xdd\x2a\x14\x97\x8a\x9b\xd5\x69\x46\xb5\x7e\xdc\xaf\x59\xa7\xc5\xcd\x77\x1b\xf0\x70\x3f\x93\xc4\xfe\x1e\x45\xcd\x3c\x92\x9a\xdd\x72\xe6\x46\x66\x4c\x75\xce\x03\xb9\xa9\xde\xda\x78\x32\x0f\x75\x3c\xf0\x73\x65\x3e\xdc\xf8\xe3\x6f\x45\x94\x6a\xca\x90\x72\x66\x0c\x85\x7e\x98\x76\xce\xf8\x8b\xd5\x46\xc4\x8a\x58\xaa\x6a\x5c\xbb\xd4\xa8\x7f\xa8\x51\x50\x4e\xf1\x2f\x21\xf3\x71\xc4\x66\x33\x96\x98\xaf\x22\x9a\x1c\x92\x94\x42\xb6\xe2\x5f\xfc\xbf\xbe\xda\x61\xee\x44\xa7\x64\x07\x98\x64\xf7\x7c\x07\x78\x0d\x32\x5b\x92\x50\x18\x00\x21\x83\x23\x5a\xb8\xc1\x72\x7d\xf2\x12\xa6\xc8\xa1\x08\x08\xa5\x21\xa3\x87\xb5\x93\x81\x23\x23\x8e\xb3\x95\x56\xd9\x9b\x8c\x5c\x4a\x57\x75\x94\x8d\xc8\x15\x30\xc3\xea\x0e\x70\xaa\x4b\x89\xf5\x47\x3b\x28\xb5\x2d\xa5\x89\x96\xfe\xad\x1a\x88\xbe\xab\xd2\xee\x71\xad\xb5\xb4\xfb\x0a\xfb\x6b\x59\x8f\x0f\xc1\xea\x96\xad\xaa\x34\x6c\x97\xd4\x0f\x01\xc8\xa3\x0a\x9f\xbc\x57\x03\xb3\xaa\xff\xc5\x95\x05\x94\xf9\x94\x0b\xfc\x18\x0e\xed\x37\x07\x46\xf7\x20\x16\x29\xfc\x09\x9f\xd9\x35\x00\xbb\x64\xf7\xd7\xa0\xf8\x43\x87\x5c\xfe\x90\xed\xbb\x39\x8b\x3f\x4a\xdd\x3f\xff\xa5\xa4\x59\x5d\x14\x72\xb7\xdc\x43\x6b\xd9\xc9\x77\x3c\x4b\x13\xaa\x5c\xdc\x08\x9c\x6f\xa2\x25\xee\x27\x96\xc0\x4c\xa8\x08\x94\xa2\xda\x35\x6c\xb3\x4b\x0a\xaa\x0c\x4f\xca\x8c\x2a\x62\xcf\xcb\x5c\xaa\x56\x0e\xcb\x4e\x30\xae\x50\xea\x9a\x25\x52\xa4\xdd\xcd\x4a\x37\xcd\x11\x62\xa8\x03\xe5\x66\x8a\xbb\x6a\x9f\x3c\x67\x4d\x44\x3e\x72\x5d\x95\x1d\x0e\xca\x99\xa7\x05\xe1\x60\xd6\x8c\xb3\x35\x4d\x96\x63\x19\xe1\xe3\x88\xd8\x86\x93\x33\x21\x5f\xaf\xbc\x25\x18\xac\xc2\x2e\xfa\x1e\xd4\x65\xf7\x4d\x87\xd6\x0e\xfc\xd5\xa1\x9b\x49\x05\x09\x0e\x47\xa9\xc4\x88\xfd\x25\x4f\xac\x94\xfb\xff\x98\x92\x98\xd1\xcb\xe6\x58\xbc\xd5\x1d\x03\x9f\xd4\x61\xa0\x77\x36\x24\x81\xbe\x25\x47\x58\x5a\x97\xe7\x39\x4b\x39\x35\x2c\x5b\x1d\x7b\xf1\x11\x8b\xf3\xb6\xdf\xcc\xf6\x16\xca\xa8\x42\xf3\x1f\xff\xf0\xb8\x3d\xb1\x6d\x96\x4e\xdd\xe8\xe6\xb3\x19\x2a\x88\x61\x82\x43\x63\x6b\x03\xa7\x93\x0f\x14\x71\x88\x8a\x36\x44\x5a\x8f\x27\x51\x61\xe3\xff\x6e\xf1\x83\x12\xc5\xe6\x70\x1e\x10\xbb\x77\x78\x1a\x5c\x54\x57\x07\x69\x05\x8d\x10\x9b\x25\x95\x5a\x86\x9e\x7b\xae\x9b\x11\x75\x7f\xf2\x8b\x33\x86\xd6\x48\xa3\x9d\x4f\xea\x75\x4f\x30\x91\xa2\x84\x43\xb1\x05\xfd\x94\x11\x9a\x24\x4c\x6b\x4c\x82\xb7\x02\x4d\x65\xa2\xaa\xc2\x5c\xbe\x8c\x4c\x4e\x7a\xa7\xcf\x33\xaa\x0d\x4f\xbe\xce\x64\x72\x7b\x6d\xa4\xea\x7e\x44\x0e\x4f\x7e\xba\x5e\x1b\xa5\x91\x01\x79\xf2\xd3\x35\x39\xe3\xfa\x36\x0a\x82\x72\x2e\xaa\x58\x8e\xa4\xe4\xb6\x9c\xb2\x8c\x99\xc3\x43\x8d\x06\xc2\x9c\x5a\x79\x90\x79\xdb\xa0\x08\xd1\x79\x15\xfe\x75\xf6\x50\xb9\x78\xd3\x37\x0e\x59\x7f\x4b\xef\x34\xc3\xe9\x4f\xed\xf4\xed\xcf\xac\x8d\x6f\xaa\xa3\xa9\xbc\x9b\xa1\x7c\xec\x30\xf4\xe2\x6c\x0f\xce\xfd\x99\xbe\xb1\x73\xef\xe5\xe0\xfc\x86\x67\xcc\xd5\x5b\xb7\x00\xf0\x47\xdd\x9d\x27\xd8\xd5\x95\x2c\xc9\x1d\x45\xb1\x00\x4c\x3a\x13\x72\xc3\x8b\xf7\xe4\x5c\xe8\x52\xb1\x4a\xc4\x9b\x35\x86\xe2\xba\x0a\xfa\xf5\xdc\x03\xb0\x00\x29\xa9\x25\x30\x8e\x99\x90\xf3\xcf\x34\x2f\x32\xa6\xdf\x93\x03\xf6\xd9\xfc\xe1\x60\x44\x0e\x3e\xcf\xb4\xfd\x9f\x30\x33\x7d\x30\x21\x17\x79\xf0\x7c\x72\xe1\x9a\xed\x63\xe5\x79\x7c\x81\xf0\x59\x6c\x7e\xdc\x0b\x12\xb9\x88\xa2\x85\xbc\x23\xa9\x24\x77\x98\xb4\x69\xc5\x25\xa6\x94\x95\xb9\x1c\x35\x89\xc0\x00\xc6\xb2\x44\xe6\x85\x92\x39\x8f\xb4\x22\x38\x04\xdd\xfc\xa5\x1d\xcc\x80\x20\x7b\x75\xf1\x04\xd4\x31\x02\x94\x44\x3f\x04\xa9\x5b\xff\xee\xc3\x87\x8b\x99\xf7\x6a\x8f\x9c\xc5\x05\x84\x17\x60\xb4\xee\x21\x8b\x01\x6e\x14\x60\x21\xd1\x9e\x7f\x13\x42\x93\xc9\x9b\x94\x2d\xdf\xe8\x94\xbe\x1b\xc1\x67\xb4\x0b\x6d\x32\xb5\x39\x51\x4d\x0e\xde\x1d\x4c\xc8\xb5\x37\x27\x8e\xe2\x39\x56\xcf\x59\xd1\xd5\x0f\x08\x75\x72\xde\x1e\x90\x23\xa9\x60\x64\x2b\xbd\x66\x8c\x2e\x99\xb3\x29\xc0\x89\x5b\x21\xf3\x3e\x6e\x1d\xa2\xde\xc7\x09\xdb\xb5\x11\x45\x77\x83\x7e\x23\x85\xc0\xc1\xf0\xc0\xa8\x92\x1d\x80\x35\x5e\x5a\x8a\x6d\x69\xb0\x66\x78\x74\x3f\xba\x2f\x54\x80\xe0\xa2\xee\x74\x35\xd2\x0d\xf0\xe0\x66\x1f\x80\xa1\xff\xe0\xd9\x68\x38\xe9\xe5\x3c\xf0\xa4\xb9\x1f\x7c\x7f\x14\xfc\x97\x92\x91\x8b\xb3\x60\x8a\x62\x4a\x73\x6d\x2c\x65\x48\x6b\x3c\x92\x23\xe3\x3c\x3a\xc9\xe9\x3f\xa4\x20\xe7\x5f\x5f\xbb\x4f\x1f\xbf\x00\x80\xb5\x24\x30\xf4\x1f\xa5\x62\x96\xf5\x77\x96\x2b\x4e\xfc\x9b\x4d\x59\xc2\xde\x27\x67\xd4\x50\x14\x29\x5c\x48\x8d\xa8\xf8\x85\xc5\xd4\x29\x17\xa9\xfb\x29\x92\x15\x9e\x9f\xad\xdb\x1d\xbe\x6c\xeb\xcf\xc6\xc7\x7f\xfc\x78\xb1\x07\x21\x20\x01\xae\x32\xff\x20\xd3\x9e\x92\xc0\xbf\x59\x50\x9f\xe2\x28\x24\xb7\xc3\x90\x4b\x29\xd8\x08\x88\x03\xb1\xd4\xc1\xfd\xf3\x27\xc5\x0d\xeb\x48\x22\x3b\xb0\x2f\x0f\xd1\x5e\xab\xb8\x89\x9c\xad\x40\x9b\x2c\x5a\xc1\x29\x74\x8c\x6c\x9a\xc9\x29\x71\xa7\x67\x9f\x2b\xf8\xf1\xe3\x45\xef\x05\xfc\xf8\xf1\xe2\xf9\x26\xbf\x85\x30\xd9\x94\x25\x2b\xf9\xa0\x0a\x63\x6f\x0a\x89\xed\x25\xc4\xc9\xae\x64\xc3\x7d\x41\xee\x96\x8b\xd6\x61\x33\xf5\xa3\x77\xfe\xb9\xc0\x42\x58\xce\x26\x73\xbd\xa0\x90\x62\x12\x9a\x16\xc1\xc6\x5b\x4c\xd0\x96\xbb\x78\x14\xb0\x9a\x2d\x50\x44\x72\xc6\xd0\x2e\x9d\xbe\xf7\x65\x37\xc2\x1b\x9b\x5f\xf8\x00\x81\x7c\xe9\x7b\xa4\xe7\x04\xe3\xfa\xd2\x08\xe3\x8e\xd0\xf3\x2f\xc2\x4f\x74\x49\x79\x46\xa7\x3c\xe3\x50\xed\xd9\x1c\x4f\x6a\xc1\x8a\x1a\xa6\xbc\x37\xa2\xb0\x95\x08\xb4\x16\x89\x40\x8e\xec\x78\x6f\x20\x92\xe1\x78\x52\x49\x3f\x50\x1b\x0d\x0c\x62\x28\x22\xd5\x44\x23\xcd\x0c\x60\x64\x43\x32\xea\x86\x4e\x6d\xc5\x12\xd8\x16\x7b\x9e\xfa\xb1\x59\xfb\xe6\x46\x36\x0b\x3f\x5c\xbb\x52\x31\xaf\x8b\xd3\x62\xea\x4b\x07\x5e\x0b\x28\xd9\xf2\xf9\xae\xdc\xf6\xd7\x86\x8f\x24\x64\x9d\xf5\x66\xcd\xbe\x78\x25\x94\xda\x75\xe9\x4e\xb5\xba\xdc\x88\xa1\xd7\x8e\x54\xb9\xca\x03\xc8\xcd\x2d\x5e\xb6\xef\x5f\xd5\x99\xba\x04\x4c\xe9\xb5\x30\xa0\xd6\xa4\x35\x62\x76\x9c\x5f\xc2\x8a\xc5\xac\xbb\x6b\xe3\x94\x15\x8b\x6f\xae\xeb\xa6\x52\x7b\x8f\x7c\x73\xbd\xe1\xdc\xc3\x46\x00\x14\x34\x1a\x50\x0f\x35\xc9\xf8\x8c\x19\xde\x6a\x51\x7b\x3e\xf9\xb9\x14\xdc\x48\xa5\xf7\x70\x8e\xfd\xd0\xfd\xb3\x03\x30\x19\xf4\x83\x1b\x07\x2b\x06\x27\x32\xcb\x58\xe2\xeb\x08\x02\xd8\xfd\x87\x36\x29\x7a\xcc\x59\x42\x26\xb7\x7f\x06\x55\xcf\x29\x75\x6f\x70\xeb\xdf\x7c\x3c\x3f\x39\xfb\x70\x3e\xc9\xd3\xdf\x2e\xe4\xdd\xd8\xc8\x71\xa9\xd9\x98\xb7\xce\x36\xed\x5e\x64\xb5\x63\x81\xd5\x1e\x06\xaa\xf6\x71\x7a\x75\x80\xff\xe0\x33\xe6\xc8\x8f\x1a\x7d\x55\x60\x50\xf3\x11\x8b\x52\x9a\x11\x51\xd4\x65\xc1\x51\x67\x8f\x2b\xb3\x0c\xf7\xc0\x28\xc6\x46\xb1\xb9\xa2\x63\xce\xc2\x53\xc9\x2b\xd1\x32\xf7\xcb\x2a\x9e\x0b\x19\xbb\x73\x9d\xb6\xa2\xd0\x43\xb0\xbc\x0e\x23\x79\x07\x20\xc4\x38\x1a\x09\xc1\x05\x50\x4b\x6e\x26\x95\xc5\x2c\x55\xc7\x12\x66\x12\x58\xfe\x9b\x52\x33\x35\x71\xbc\xeb\x99\x40\xd7\x85\xab\xc1\x44\x3b\xa4\x88\x3f\x0c\xb8\x8f\x6c\x86\x95\xe7\x7d\x89\x40\x27\x1d\xd2\xd2\x2c\x98\x30\x56\xff\xb0\x04\xcf\x81\x67\x23\x24\x5d\x29\xfb\x67\x02\x5d\xa7\x94\xa3\x3e\xb9\xe1\x5d\xb3\xa4\xbf\xf0\xfc\xe8\xce\x08\x6b\x8f\xd7\x0e\xf8\x82\xf2\xc7\x5b\xd1\x54\x42\x1e\x01\x16\x43\xa9\xa1\x22\x4d\x73\x2e\x5e\xf8\x21\x4e\xb8\x48\xdb\x41\xa4\x51\x03\x06\xde\xab\xcb\x7e\x38\x96\xf7\x91\x04\x2f\x2d\xf5\xba\x1e\x34\x86\xf6\xfe\xda\xba\xb7\xb6\xd5\x81\xcd\x57\xfa\x97\x6c\x8c\x5f\x19\x17\x69\x05\xa7\xc1\xf5\x5a\x5d\x0f\xbb\x5e\x77\x6b\x2e\x7b\x02\x87\xea\x4e\x71\x80\xfc\xba\xc5\xac\x1d\xc1\xae\xbb\x64\xb5\x03\xf9\x00\x1a\xbe\x6b\xdf\x5d\x08\x0b\x4f\xc0\x59\xf6\xba\x3d\x34\x82\xa7\x8a\xe6\xcc\x30\x85\x99\x5d\x2e\x57\x4c\xb8\xd0\xd9\x1f\x0a\x26\xae\x0d\x4d\x6e\x3b\xfa\x15\x06\x9e\xfe\xc2\x79\xfa\x76\xbe\x55\x1f\xd6\x95\x06\x9c\xe1\xa9\x95\x35\x5d\x48\x40\x15\xf5\x85\x07\xe7\x05\x52\xa9\x50\x97\xb8\xbb\x05\x27\xd4\x3b\xad\x33\xf2\x70\xdb\x19\x6d\x20\xee\xb0\x90\x45\x99\x61\x24\x28\xf7\x99\x58\xbb\x67\xbc\x5d\x0f\x90\x93\xb7\xfa\xfb\x24\x2b\x1a\x93\xcb\x94\x91\x29\x37\x15\xf5\xd0\xcc\x90\x82\xa9\x9c\xbb\x0e\x88\x52\x90\xc4\xc5\xb0\x02\xd7\xb4\x1c\xd2\x4d\x20\xe2\xa8\x82\xc8\xc4\x50\x17\x04\x4e\xa6\xcc\xdc\x31\x26\xc8\xdb\xb7\x6f\xdf\x82\x2c\xf4\xf6\x4f\x7f\xfa\x13\x81\xba\x0c\x29\x4b\x78\xbe\xfe\x20\x3c\xf5\xcf\xef\xde\x4d\xc8\xbf\x9f\x7c\xf8\x1e\x62\x0b\x0b\xa3\xc9\x54\x9a\x85\x1b\xd9\x3e\x50\x7b\x59\x8f\xc8\xff\xb9\xfe\xe1\xd2\x8b\x2d\xba\xf1\x2b\xa8\x4a\x61\x79\xf5\x20\xed\xb7\x7f\xfc\xc3\x1f\x26\xe4\x8c\x2b\x48\xfb\xe1\x10\xae\x1b\x32\xf2\x0a\x9f\xa5\x06\x8d\x51\x20\xf2\x38\x6a\x0d\xea\x98\x91\xcb\xa0\xcd\xf9\x7c\x01\x00\xb0\x67\x45\x8a\x59\xc6\x13\x83\x1d\x5c\x90\x50\x60\x29\x22\xd7\xb7\x85\xba\x8c\x06\x17\xcc\x04\x93\x1b\x91\x8c\xdf\x32\x32\xd3\xdf\x2a\x59\x16\x55\xb7\x29\xc5\xb4\x95\xa8\x13\x2a\x20\x04\x1a\x06\xab\xf6\x4a\x33\xf3\xc2\x62\x69\x3a\xd9\xb6\x6a\xc8\x78\x51\x13\x88\x46\x84\xd1\x04\xfa\xdf\x8c\x11\x43\x0a\xca\x43\x40\x29\x04\x33\xd4\x8a\xfa\x06\xad\x39\x8d\xce\xb5\x0f\xbd\x2e\x94\xfc\x3b\x6e\x5e\x68\xa4\xe1\xe5\x74\xed\x64\x40\xd7\x86\x07\xec\xe7\xbc\xde\x96\xd2\xf2\x58\xd7\xf2\x32\x4a\x08\xb8\x98\xc5\xd9\x25\xd0\xb5\x07\xbb\x45\xdd\xb2\x95\x7e\xe8\xcb\x55\xed\x54\x8b\x5f\x1a\x77\xba\x14\x6b\x6f\xbb\x32\xf1\x8e\x32\xb9\x06\x03\x2e\x4b\xa3\x1a\x03\xa3\xd5\x5d\xfc\x7a\xd4\x22\xa3\x56\xd0\xb9\x16\xbe\xa5\x99\x29\x1d\x68\x20\xae\xce\x7e\x9b\x69\xed\xc2\xe0\x73\xaa\x6e\xad\xaa\xe2\xe8\xc2\x04\x12\x77\x75\x08\xc1\xc7\x0c\x89\x25\x3a\x0c\x72\xba\xaa\x25\xe2\xdb\x8f\x1c\x4e\x26\x87\x78\x70\xa4\x22\xda\x50\xe5\x4e\x81\xbd\xdf\xcd\x73\xb2\x47\x83\x6a\x3d\x1d\x9b\x16\xd8\x57\x0c\x0c\x54\xae\x5e\x3a\xad\xa5\xe7\x52\x07\xbb\x3d\x17\xe6\xef\x53\x98\xaf\x6b\x51\xbe\x31\x2c\xac\xf5\x0b\xfd\x2a\xf8\x75\xae\xde\x77\x5f\xd9\x7a\x77\x7c\xfa\x74\x05\xe8\x5c\x30\x2e\xef\xc0\x44\x37\xcc\xba\x2b\x2b\x75\xd9\x2a\xb5\x16\x2c\x2f\x9f\x77\x5e\xcc\x30\x51\xa6\x4e\xf9\x1c\x65\x89\xa4\x91\xaa\x95\x49\x55\x64\xe2\x15\xb0\xc8\x18\x81\xfa\x14\x66\xed\xce\x2e\xf1\xea\xe2\xb0\xc1\x6b\xed\xc4\x04\xca\x5c\xd4\xca\x77\x67\x68\xf6\x00\x69\xd6\x1f\xab\x09\xf9\xe0\x28\x37\x22\x1d\x9d\x6a\x99\x95\x06\x5f\xad\x7e\x8c\xc9\x3a\x0c\xea\xbb\x69\x02\x2d\x0f\x8f\x45\x44\x3e\x6a\x1a\xd3\x85\xde\xe3\xd5\xf9\xd8\x76\xd1\x1c\xbf\x60\xad\xb1\x33\xdc\xba\x56\xc5\xec\xd5\x38\x42\xf7\xac\x87\xd9\xcd\xca\x92\x68\xde\x5d\xed\xbb\xbe\x20\x47\x55\x15\x6f\x1f\x0e\x71\x21\x0c\x53\x33\x9a\xb0\xe3\x58\x1d\x0c\x55\xd7\x43\x84\x97\xcf\xc3\x59\x50\x91\x66\xa8\x0e\x24\x4c\xc1\x41\x61\x9f\x0d\x53\x82\x66\xf0\x89\x54\xf1\x25\x53\x9a\x1c\x7d\xcd\xac\xac\x8a\x8d\xf4\x5b\x15\x27\xd9\x77\x40\x2d\x4c\x6c\x1f\xca\x28\x0c\xdc\x2f\x60\x07\x5e\xf5\x12\xb6\x88\x8e\x67\x05\x4a\x84\x3c\x82\x5d\xc7\x6a\xf8\xc4\x22\x20\x70\x00\x20\x42\x2b\x59\x2a\xe7\x7d\xf0\x2d\x99\x12\xa9\xac\x82\x87\x03\x53\x4d\x14\x9b\x5b\x69\x5b\xf9\xde\xae\x8c\x24\x59\x69\x6f\xec\x2d\x74\x71\x2f\x41\x9f\x0f\x85\x6c\xce\x9c\x42\x20\x97\x3c\xf5\x6c\x1a\xbb\xd0\x86\x7e\x53\x05\xd5\x51\x66\x18\xd5\x5a\x26\x1c\xb4\xfb\x08\xe6\xa8\x5e\x00\x33\x0f\xe5\xaa\x6a\x39\x09\xb1\xfd\x5c\x42\xe6\x79\xeb\xc2\xc9\xdd\x09\xbe\x4c\xd9\x55\x39\xcd\xb8\x5e\x5c\x6f\x65\x5a\xbd\xdc\x30\x10\x06\x96\xac\x39\x5f\xef\x35\xb7\x6a\x26\x34\x07\x86\x6b\x59\x86\x65\xfb\xdc\x4a\x75\x12\xc0\xea\xdf\x8e\xb1\x57\x42\xa2\x52\xc6\x5c\x36\xb1\xfd\x29\x9a\x87\xcb\xbc\xc4\xaa\x03\x29\xfb\x51\x14\xb5\xfb\x09\xcd\x32\xdd\x2c\xd6\xe5\x09\x39\x4a\x42\x3e\x1b\x13\x77\x99\x5b\x04\x08\xd5\xc6\x1b\xc5\x32\xef\x5d\x98\x26\xb9\xc4\x8c\x33\x41\xa4\xf0\x0f\x41\xf1\x04\xff\x42\x54\x34\x08\x4a\x95\x01\x12\x75\xdb\xf2\xc1\xa6\xfc\xc2\x6d\xca\x5b\x79\x7d\xae\xa3\x66\xdd\x55\x89\xb3\x7a\xf3\x57\x4f\x98\x3d\x01\x7f\xc4\x3d\xb4\x37\x0f\x0d\x7e\xff\xc4\x18\xc5\xa7\xa5\x69\x8f\x62\x1b\x92\xa6\xab\x41\x40\x54\xb0\x9a\x14\x1c\xfc\xb1\xd3\x95\x92\x08\x93\x9d\x8a\x13\x8e\xcf\x3a\xb9\xa8\x78\x1a\xb0\x33\xbc\x79\xa8\x49\x2a\x93\x32\x54\x9e\x05\x30\x56\x0e\x4b\xd4\xd8\xf6\x78\x14\xb7\xab\x12\xdb\x09\x0b\x53\x79\x27\xee\xa8\x4a\x4f\xae\x5a\x65\x79\xd4\x85\x89\xea\xdd\x58\x94\xf3\x43\x42\xcb\x22\x3a\x95\xa5\xa9\xaa\x75\xfd\x3a\x0d\xfd\x46\x5a\x5a\xd3\xd2\xae\x4f\x06\x57\xc1\xe0\x2a\x78\xe8\x7a\x52\x57\x81\x7d\x13\x25\xb6\x8c\x6b\xa8\x0f\x5e\x3b\xde\xbe\x96\x22\xcf\xba\x85\x8c\x3f\x95\xcd\x39\x22\x51\xc8\x3d\x9a\xf9\x24\x0d\x99\x12\x0f\x53\x85\x05\x91\x1c\xea\x69\x18\x88\x0b\x2f\xd1\x3e\xfd\x04\xf6\xe6\x5d\x34\x8b\xac\x42\xcd\x1f\x6a\x1b\x09\xb2\x4c\xd4\xe7\x71\xe4\xca\x4b\x8e\x9c\x6e\x29\xd2\xaa\x9b\x0f\xd0\x83\x57\xd4\x95\x6d\xe8\xd8\x38\x74\x6c\x7c\xec\x7a\x65\xbe\x95\x51\xb0\x42\xbe\x02\x61\x63\xf0\xad\x3c\x78\x3d\x93\x6f\x25\x62\x0c\x9e\x90\x38\xc0\xc4\xfe\x96\xd8\x64\xe9\x9d\x2e\x53\xe6\x85\xe0\x49\x55\xb4\xde\xa2\xa2\x77\xb7\x48\x55\x77\x9d\x1f\x4e\x26\x87\x87\xde\x09\xe3\xf0\xb6\x34\xb3\xf1\x9f\x09\x13\x89\x4c\x71\xb3\xed\xf8\x4a\x1b\x10\x3f\x2a\x2b\x45\x3c\x97\xdc\x7f\x2b\x76\xbf\xc3\xd8\x7d\xb6\xea\x8b\xef\xce\x1a\xf7\x60\xad\xb7\x5c\xc5\x27\x86\x3e\xab\x6d\xaf\xa1\xcf\x6a\xa7\x17\x87\x3e\xab\x1d\xae\xa1\xcf\xaa\xbb\xbe\x80\x3e\xab\xe0\xa1\x38\xe3\x3d\x12\x6f\xce\xdd\x9b\xf5\x88\x5d\xc3\xf2\x42\x2a\xaa\x56\x24\x75\xd6\x99\xd5\x86\x84\xeb\x28\xe3\x7a\xeb\x6a\x50\xb0\x82\x94\xab\x3d\xe4\xde\x74\x4e\xb0\x66\x29\x2f\xf3\x7e\x46\xc1\x9f\xa0\xb2\xa0\xab\x4a\xe8\x1d\xdc\x38\x60\xa8\xb7\x4a\x93\x5b\x94\xa7\x03\x6c\x51\x0a\x89\x8b\x92\x1d\x34\x8a\xe6\x82\x59\x11\x3c\xb9\x32\x65\x16\xf0\xfe\x61\x1c\xbb\x66\x02\x44\x77\x95\x8b\x1a\x39\x72\x0f\x1e\x5b\xd9\xe8\x03\xb0\xdf\x27\xdd\x2b\xd2\x2b\x0d\x94\xff\x83\x41\x2b\xb9\x9e\xe5\x00\x25\x28\x0f\xa1\xd1\x5d\x26\x93\x28\xdc\xa0\xc6\xf6\x60\x1f\xc2\x19\xf0\xce\x13\xbb\x1b\x76\x0e\x28\xd8\x80\x55\x2c\xd3\xe8\x8b\xe5\x09\x34\xfa\x00\x45\x03\x7b\xca\xfb\x1d\xb8\x89\x5a\x4c\x96\xda\x7e\x09\x6a\x79\x47\xcf\x54\x1f\xba\xf3\x95\x77\x4d\xd4\x99\xaf\xae\x1e\xd9\x5f\xae\x3d\x1c\x22\x65\x13\xd2\x79\xbc\x2e\xa1\x4b\x90\x58\xdd\x57\x9c\x9c\x26\x67\xe0\x5d\xac\xfa\xdc\x85\x1a\xb3\x6b\x78\x26\x78\x56\x47\x34\x5f\x18\x33\x2c\xbc\x14\x2e\xda\x64\x0d\x6b\x36\x23\x4d\xa9\x99\x1a\xcf\x4b\x9e\xf6\x47\x97\x17\xcf\x43\x3b\x72\xce\xbe\xfc\xb2\x17\x97\xdc\x9a\x37\x86\xf8\x9c\xce\xbc\xe4\xe0\x3c\x84\xf6\xd4\x98\x49\x5c\x88\xb3\x1e\xe6\x43\x7d\x94\x48\x38\x9c\xde\x57\x77\x13\xf4\x74\xc7\x64\x92\x55\xe2\x92\xe0\x79\xa3\x7f\x8c\x1d\x16\x71\x14\x22\xae\xc7\xf6\x3f\x5e\x9f\xf7\x2e\x93\x29\x9b\xa1\x17\x1e\xdf\x01\x35\xce\xc5\x89\xa7\x2c\x63\x06\xc2\xbe\x59\x28\xad\x8c\xee\xfd\x5c\x2e\x2d\xda\xff\x55\x90\x1f\x7d\x2d\x74\x3e\x7b\x4f\xe8\x71\x2d\x91\x47\xa3\xb6\x24\x18\x4b\x31\x78\x3c\xab\xbe\xa3\x4a\xa1\x47\x64\x7a\xec\x83\x95\xe0\x6c\x0a\x2b\x83\x66\xa1\x50\x35\x18\x09\x14\xb3\x00\x80\x74\x7e\x25\x73\xa2\x05\x2d\xf4\x42\x42\x33\xe4\x84\x16\x34\xe1\x66\x65\xc1\x6d\x14\x4d\x6e\xed\x53\xe0\x17\x81\x2f\x8e\x48\x72\xec\xa2\x04\x63\x08\xd6\x43\xda\xcd\x42\xc9\x72\xbe\x80\x98\x6c\x7c\x0a\x1a\x91\x38\x00\x6c\x7c\xdf\xe9\x64\x9a\xa4\x2b\x41\x73\x9e\x84\x52\xa5\x4a\x2e\xb9\xe6\xd2\x59\xca\x71\x5c\x7b\x26\xc8\x55\xa8\x21\x89\x06\xf8\xd3\x8c\xf2\x9c\x1c\x69\xc6\x48\x40\x0c\xfc\xe5\x1a\x85\x23\x34\xd6\x28\x66\x5f\x8f\xad\xf3\x32\xf4\xb1\x13\xae\x22\x49\x45\x13\x83\x03\x11\x99\x2c\x1c\xc6\x74\xf3\xa7\x8f\xc3\xd6\x6d\x9e\x99\x54\x10\x90\xe1\xab\x07\x33\x91\xca\xc8\xb5\x7c\x72\x75\xa1\x63\xc5\x08\xf1\xcc\xd5\xc9\x84\x1f\x32\x29\xe6\x71\xd1\x8e\x0a\x4b\x2d\x01\x16\xd0\x78\x63\xc9\xd3\x92\x66\x48\x7a\xdd\x64\x4e\xaf\x2f\xf0\x75\x3e\x5f\x98\xf1\x1d\x03\x33\x13\x72\xa8\x2a\x34\xce\x7f\x94\xaf\x85\x6c\x71\x0d\xa4\xda\x38\x73\x08\x9a\xec\xec\xd4\xee\xe8\x0a\x2a\x4e\xb9\x60\xa2\x9a\x57\xdb\x57\x16\xc4\x21\x02\xdc\x23\xa0\xc3\xf4\x4e\x42\x93\x04\x2b\x6d\x80\x1d\xce\x42\x19\xb0\x76\x7d\x6e\x16\xf0\x51\xdd\xd0\x70\xdb\x54\x85\xd7\x51\x32\xfc\xab\x40\x8b\x1a\x38\x97\xa6\x51\xec\x1e\x0c\x81\xf6\x7f\xac\xd4\x06\x49\x23\xee\x18\x7e\xcb\x04\x53\x3c\x69\xa0\x4e\x78\x75\x4e\x0d\x1c\x3e\x26\xec\x6b\xe9\xa4\x8d\xaa\xb6\x67\xb9\x71\x59\x21\xd7\x8d\x6b\x56\xd5\x4b\x7e\x39\xf8\x29\xb2\x46\x46\xbe\x2a\x7b\x7a\xa9\x48\xc7\x34\xb3\x78\x7b\xf5\xe9\xd4\xc5\xf3\xe3\x79\xac\x45\x7f\xf8\xae\x39\x5c\x84\xc6\x67\x56\xce\xd9\x78\x0c\xa1\xc8\xc5\x94\x41\x4d\xfd\xd0\x59\xc1\xaa\xcc\x77\x82\x29\x8f\x3a\x57\x9f\x4e\x47\x84\x4f\xd8\xc4\xff\x15\x1e\xf5\xf4\xd3\xc8\x39\xc6\xaf\x86\xb8\x65\xc0\x7a\x98\x4a\x6c\xcb\x8b\xdf\xfd\xf9\x2f\x76\x92\xf6\xd7\xaf\xc6\x7f\x89\x2a\x2d\x7f\xf5\xb3\xc5\x03\x65\x1f\xa8\xdf\x8d\xc3\x19\x43\x1f\xc7\x9f\xaf\x64\x6a\xb5\xfd\x89\xeb\x11\xf0\x33\x3a\x1d\x09\x13\xc6\x0a\xbb\x57\x12\x02\x39\x78\x8a\x67\x01\xbe\xad\xd8\xdf\xbd\xbd\x16\xc0\x14\x2c\x60\x09\x35\x4c\x00\xcb\xf0\x79\x4b\x42\x1a\x7c\xdd\x35\x3f\xb6\xf3\x3f\x02\xdb\x08\x26\x69\x8e\x88\x91\x12\x88\x01\x12\x9c\x13\x41\xd8\x67\xae\x21\xab\x1a\xd7\x0a\xe0\xa0\x2e\x56\xd2\x73\x41\x3b\xac\x85\x70\x1a\xf7\x38\x83\xb9\xfd\x5e\x48\xf3\xfb\xb0\xfd\x3e\xae\x07\x58\x9d\x24\x74\x29\xb9\x6f\x21\x67\xcf\xa9\x00\x43\x65\xd5\x4d\x60\xba\x22\x39\xd7\x86\xde\xb2\x09\xb9\xb6\x5c\x2e\x76\x68\x22\xf4\x04\x81\x7a\xbb\x2c\x25\xa5\x30\x3c\x83\x5f\xab\x71\xec\x94\x63\xee\x77\x31\x23\xba\x4c\x2c\xeb\x28\x14\x1b\x7b\x7e\xea\x9e\x5a\xa3\x44\xd5\x5a\x46\x61\xb3\x17\x14\x15\x98\x22\x85\x57\x01\x1e\x54\x38\xf4\x5a\x8b\x0e\xb4\xf3\x94\x22\xa9\x78\x28\x00\x53\x4f\xc8\x25\xb0\xcd\xcc\xfb\xff\x51\x97\x72\xf6\x5f\xc1\x12\xa6\x35\x55\xab\x11\xb4\xe6\xe3\xa1\x8f\x82\x0b\xfc\x02\xa2\x92\x53\x81\x8d\xf1\x14\x4b\xa4\xd0\x46\x95\x09\x40\x87\x92\xa9\x92\xb7\x4c\x84\x88\xd5\x40\xb0\x42\x58\x60\x15\x74\x05\xee\x48\x49\x92\x05\x15\xf3\xa8\x13\x49\x4e\x53\x80\xfd\x77\x41\xf6\xf2\xeb\xb1\x10\xa0\x33\x2b\xe2\x70\x03\xa0\x98\x5a\x46\x16\xac\xd8\x7f\x15\xc4\x9b\x0d\x46\x95\x99\xd9\x2e\x89\x67\xad\x68\x1a\xe9\x67\x07\xed\x6e\x01\x1d\x83\x3c\xb1\xc7\x10\xc3\x9c\x19\x9a\x52\x43\x7b\x87\x19\x7e\xa0\xa1\x51\x86\xf3\x21\x63\xc3\xa4\xca\xb7\xec\x38\xa5\x17\x0e\x65\xc1\xe3\x34\x42\x38\xad\x0b\xbf\x3b\x50\x15\xc1\x58\xbc\x73\x3e\x1a\x8c\x1e\xc4\x06\x24\xae\xf3\x95\x1d\xde\x8f\x86\x24\x85\xa5\x24\x2d\x41\x90\xab\x48\x4e\x17\x9f\x60\x2f\x93\xb6\xdd\x98\xde\x50\xbb\xa9\x5c\x99\x49\x3d\x94\x70\xa3\xd8\x84\xbc\x85\x09\xc3\x15\x8b\xf3\x38\x1d\x30\x4b\x81\x47\xa3\x01\x56\x80\xfb\x9c\x19\x5d\x05\x31\x21\xf5\xb6\x24\x29\x34\x80\xbc\xf1\x72\x83\x07\xb5\xd3\x5c\x37\xcb\x6f\xb8\x11\x5a\x3a\xea\x6c\xf9\xc5\xde\x21\xdd\xdf\x96\x8f\x1d\x5d\x3e\xc8\xb4\xbb\x13\xa0\xd1\xea\xa4\x1a\xa8\x8a\x31\xc6\xf8\x71\x0d\x66\x0d\xfc\x12\xb8\x14\x75\x2d\x4b\x15\x49\xe6\x82\x2e\xfb\x5b\xeb\x2a\x79\x6f\x1c\xca\xb8\xc3\xe7\xc6\xf0\xb9\xf1\xbb\xae\x76\xce\xee\x41\x42\xfe\xea\x18\x2c\x54\xff\x60\x0f\xc3\xb3\x25\x4d\xd7\xbd\xec\xc4\xcd\x5e\x15\x81\xb3\x38\x17\x70\x70\xbb\xbb\x44\x20\xc6\x2d\xbd\x79\x4f\x7e\x5f\x93\x25\x9c\xcc\x16\x34\x43\x8c\x32\x3f\xf2\xaa\xe2\xc4\x6d\x91\x2f\x19\x51\x7f\xfc\xb8\x31\x18\x08\x31\x9b\xb5\x26\x1f\xcd\x1e\x04\x4b\x2b\x04\x2a\xb0\x2b\xfa\x64\x1c\x8b\x76\x4a\x66\x19\x53\xb0\x04\xa7\x2a\x36\x82\x22\xa0\x2e\x33\x9a\xc1\x47\x41\x25\x0f\x92\xac\x60\x77\x41\x64\xa1\x1a\x2b\x39\x79\x27\x24\x8b\x9b\xe0\x6f\x1a\x2f\x44\xdc\x9f\x88\x15\x4e\xfd\x2c\x6c\xce\x7d\x0a\xc2\x28\x6e\x66\x06\x73\xa1\xd9\x1d\x5d\x69\x38\x0f\x95\xc6\x12\xbe\xef\xea\x34\x56\x03\x7f\x64\x33\x7c\xbb\xa3\x93\x72\x0b\x37\x65\x7f\x47\x25\x64\x31\x73\xd1\x3e\x7a\xad\x7a\xad\x75\xa7\xfb\xea\xea\xef\xdf\x84\x90\x25\x88\x8f\xe8\xe3\x88\xaa\x17\x91\xbe\xba\x80\x81\xbc\x8e\x30\x87\x3f\x3c\x2f\x0b\x1e\x99\x29\xb3\xf8\x5f\xd5\x36\x00\x5c\x8a\xdf\xdd\x10\x30\x52\x21\xe1\x77\x50\x68\xda\x99\xda\x43\xbf\x7d\xc5\x20\x04\x08\xbe\x38\x81\xd6\x30\x54\xac\x9c\xd4\x60\x16\x5c\xa5\xe3\x82\x2a\xb3\x42\x65\x7a\x54\xfb\x5a\x48\x22\xe9\xb1\xfc\xad\x7c\x68\x5d\x2a\xbf\xc7\x57\xbd\x7f\xa6\x05\x87\xef\x4c\xe7\x9c\x1e\xf7\x42\xfa\xa9\x57\xd8\x35\x65\xc5\x5f\xeb\x3d\x99\x1b\x49\x74\x2f\x62\x85\x69\x4c\x98\xb6\xe3\x48\x0d\x4f\x39\x32\x96\xb8\x69\xba\x8c\x43\xf8\x83\x3c\x01\x8a\x9c\x9d\xc6\xc8\xea\xcf\x94\x08\x29\xc6\x2e\x1f\x2e\x98\x46\x9d\x4c\xe2\x43\xaa\x5c\x73\x3b\xb1\x72\x06\xaf\xf8\x5b\xf1\x00\xe1\x34\x91\x23\x21\x05\x9e\x30\x7c\xf6\x18\x23\xca\xee\xb1\xe8\xc1\x23\xae\x51\x6d\xbd\x77\x65\x74\xa2\x3d\xdb\xe1\x22\xb5\xdb\x07\xbc\x00\x74\x3b\x5d\x26\x09\x63\xc1\x1a\x10\xf7\x16\xab\x28\x80\x9b\xb2\x6f\xb6\xa8\x25\x14\x73\x82\xa6\xdc\x95\x16\xee\xc0\x25\x81\x73\x7a\x03\x6a\xc4\x50\x6b\x69\x67\xce\x20\x01\xed\xa2\x31\xda\xa9\x14\x09\xc6\x60\x70\xb3\xf2\x33\x88\x39\x1c\xbc\x06\xaa\x8d\x46\xe5\x9c\xcf\xd0\x5a\x17\xa9\x28\x01\x98\x40\xc2\x56\x48\xb4\xea\xbc\xce\x95\x5d\xb1\xf4\x6a\x4a\x93\xdb\x3b\xaa\x52\x68\x44\x5b\x50\xc3\xb1\x69\xc2\xa8\x36\xec\x51\x34\x07\xfb\xf5\x1a\x6f\x3c\x0e\x8a\x10\x34\x68\x92\x8d\xcf\x10\x5a\x1a\x99\x53\xc3\x13\x50\xc1\xf9\x2c\xb2\xbd\xe6\xa1\xce\x6a\xf0\x6b\x21\xed\x04\x6a\xec\x96\x01\xda\x81\xc2\x08\x60\x73\x27\x09\xcf\xad\xcc\x41\xa1\x61\xd1\x2c\xe4\xcf\x79\x43\xf1\x43\x33\xb5\x82\xd5\x4f\x60\xa6\x8f\x9e\x42\xe5\xde\xaa\x75\x1a\x86\x0f\x76\xe0\x60\x00\x75\x89\x66\xa3\x86\x48\x40\xfc\x5b\x16\xab\xed\x6c\x23\x64\x1d\xd9\x0d\xba\x63\x56\xd6\xd0\x0f\xa2\xac\x9e\x6c\x9a\x13\xf6\x5b\xd5\x24\xe5\xba\xd1\x6a\xf9\x28\x55\xb2\x28\x9c\x69\x27\x3f\x5e\x9f\x13\x78\x5f\xd4\x92\xe9\xa8\x7b\x30\x5a\xfb\xe7\x4c\x84\x46\xd9\xae\x5a\x0d\x9c\xde\xe6\x47\x20\x2a\x8f\x44\xc5\x14\x8f\x4e\xb2\x62\x41\x8f\xc9\x8f\xae\x29\x5c\xc0\xdf\x10\x8b\xd9\x4a\x22\x43\x63\x91\xb7\xda\x0e\xa2\x54\xe3\x1a\x44\xa9\x41\x94\x1a\x44\xa9\xa7\x5d\x61\x08\xff\xdb\x4e\x8c\xfa\x18\xe2\x65\x1b\x1d\xb9\x7d\x7c\x47\x15\x50\xbb\x7f\xab\x4c\xf8\xd6\x13\x52\xd8\x6d\x68\x17\x86\xae\x6c\x8d\x63\x87\xdf\x63\x08\x0c\xde\x9c\x3a\x50\xfb\xa8\x9c\x2a\x22\xc8\x4a\x39\xa5\x61\xd1\x96\x38\xe6\xd7\x79\x0f\x6a\x59\xd8\x6f\xb0\xf3\xd4\x38\x0c\x3b\xae\x82\x70\x5a\x37\x6e\x88\xaf\x2d\x76\x83\x6c\x9d\x5e\x1c\x5f\x2f\x3e\x26\xa7\xb1\xf0\xbe\xb1\xad\x64\x27\xf1\xad\x64\xfb\x18\x57\xb2\xfb\x38\x57\x12\xe2\xf0\xb7\x3f\x67\x1f\x7d\x1e\x40\xe3\xa4\x39\x52\xf7\xd0\x49\xab\xd5\xcd\x08\xe3\x70\xed\x3b\xb4\x3a\x3f\x6b\x38\x39\x60\x3d\x8c\xe3\xb2\xdd\x19\x07\x35\x0d\x9d\xa9\xec\x73\xa8\x0c\x1e\xc9\x1b\x55\x3b\x7b\x23\x21\x20\x23\x2f\xb0\xdc\x16\x9c\xd5\xb1\x8b\x6b\xf3\x6a\xd0\x70\xee\x37\x5d\xc3\xb9\xef\x3b\xca\x4b\x39\xf7\x18\x7f\xde\x3d\x9d\xa2\xae\x11\xa0\x43\x95\xfc\x52\x32\xb5\x22\x72\xc9\xa2\xb8\x28\x28\x8a\xae\x79\xea\x22\x8b\x9c\x5d\xa5\xab\x14\xfe\x4c\x72\x07\xd8\x71\xce\x3f\x5b\x79\x0d\x72\x1d\xb7\xa6\x8c\xcd\x01\xeb\x29\xf5\x08\x45\xbf\x25\x9e\x14\x5a\x9a\xa4\x27\xae\xe6\x60\x75\x07\x6c\x1d\x27\x97\x67\xdb\xa8\x34\x7d\x3c\x6b\xa4\xbf\x77\x8d\xdc\x87\x38\x9b\x96\x8c\xa0\x09\xbf\xd4\x3b\xeb\x05\x9b\x05\xb9\x65\xab\x91\x73\xe4\xbb\xae\x11\xfe\x61\x8c\x59\xa9\x97\x16\xee\x56\xb2\xa5\x7e\x6d\x49\x75\xb7\xb1\x31\xe0\xd5\xb5\xf4\x6c\xfd\x5d\x0f\x9c\x7e\x84\x7e\x4b\x46\xd1\xa3\x44\x6d\x7c\xd5\x90\xc6\x95\x64\x86\xa0\x4a\xc0\x1e\x28\xb2\xe9\x43\xd3\x03\xc2\x40\x38\x3e\x50\xa0\x7e\xdb\x4d\xb6\x55\x8b\xf1\xf2\x60\xdf\xd1\xe2\x03\x8a\xd7\x62\xa8\x6f\xd9\xea\x50\xbb\xac\x54\x29\xf4\x82\x17\xbe\x77\x05\xd0\x15\x87\xf5\xe4\x13\x84\x42\xf8\x21\x90\x82\x5c\x88\x11\xb9\x94\xc6\xfe\xef\x1c\x62\xa9\xd0\x24\x2a\x99\xbe\x94\x06\xee\x3c\x2b\xf8\x70\xea\x3b\x02\x9e\xb3\x8f\x72\xb0\x6e\x62\x14\x20\xe4\xe1\xf8\x88\x1c\x00\x92\x73\x1e\x07\x40\x73\x4d\x2e\x04\x91\xca\x43\xc9\xf8\x92\xe4\xda\x0d\xe1\xad\x54\x91\x31\x7a\xc3\x18\x0e\xb8\x52\xd5\x60\xfb\xc0\x70\xc1\xae\xcd\xfd\x2f\x60\xc5\x02\x47\x40\x08\x23\x82\x32\xda\xd4\xb0\x39\x4f\x48\xce\xd4\x1c\x32\x92\x93\xc5\xb6\x5b\xd6\x97\x2f\xe0\xb5\x05\x77\x88\x27\xd1\x13\x6f\x80\xc5\x7e\x0f\x01\x5e\xbb\x61\xd7\x38\x16\xb2\xa3\x9c\x16\x16\x65\xfe\xcb\x72\x1d\xd8\xb5\xff\x81\x32\xf9\x7a\x42\x4e\x7c\x9f\xea\xf8\x37\x67\xb8\x8c\x87\xb1\x23\x58\x9d\xe4\x97\x92\x2f\x69\xc6\x30\x40\x92\x8a\x50\xeb\x57\xce\xd6\x84\x84\x91\xab\x95\x6f\xe9\x5c\x70\x59\x1d\xdc\xb2\xd5\xc1\x68\x0d\xcd\x0e\x2e\xc4\x41\x55\x4c\xa0\x86\x58\x81\x79\x82\x37\xe3\x00\x7e\x3b\xd8\x8f\x5c\xf1\x02\x54\x93\xde\x38\xe4\xcc\x66\xa7\x19\xd5\xba\x4f\x5e\xf5\xfd\xb5\x0d\xaf\xa3\x91\xab\x3c\x33\x17\x14\x9b\x60\xf8\xdc\xee\x6c\x7c\x90\xc3\xd1\x37\xe4\xaa\x07\xdc\x96\xae\x41\x54\xd7\xc2\x23\x4d\x1a\x1d\x86\x09\x69\x3b\x77\x71\xd6\x64\xe5\x3d\xbe\x07\x82\x9f\xc0\x3f\x25\x67\x71\x1d\x57\xae\x41\xbd\xe7\x3e\x91\x47\x48\x43\xb8\x48\xb2\x32\xc5\x8a\xb4\xf0\x2a\x18\x07\xfa\x29\x26\xbd\xc1\xb5\x35\x82\x7d\x0a\xc3\x78\x99\xc8\x7b\xae\xd7\x62\xa7\x9b\x2e\x46\x70\xeb\x06\x6f\x1d\x42\x6f\x9f\xab\x9f\xb5\x8a\x42\xad\x97\xe2\x3d\xad\xcb\x39\xdf\xf0\xa9\x62\xe4\x74\x41\x85\x60\x59\x94\x47\xed\x0c\x3d\xa1\x09\x20\x08\x3f\xae\xf5\xdf\x61\xbd\xf7\x9f\xa7\x8d\x22\xe4\xf7\xef\xb5\x27\xfc\x97\xd3\x7e\xef\xfe\xd2\xc7\x5d\x1b\xef\x61\xfd\xd5\x85\xbc\x23\xa9\x24\x77\xd0\x71\x65\x69\x59\x1f\xf8\x9b\xb5\x67\x9a\xd1\x4c\x21\x02\x24\x91\x79\xa1\x64\xce\xb5\xcf\x59\x70\x1b\xba\xb7\x34\xe3\xac\x6c\x5d\x27\xeb\xbe\xe2\x49\xdf\x9c\x12\x43\xd5\x9c\x19\x3b\x18\x11\x65\x3e\x65\x1d\xd3\xa2\xf7\x5d\x12\xf0\x75\xf4\x1b\xdc\x5f\xcb\x40\xdc\x9e\x9f\x7e\xba\xec\xd9\x30\x7d\xd3\x5e\xdf\x49\x95\xa5\x77\x3c\x45\x47\xa6\x26\x47\x76\xf8\xe3\xd7\xdb\xdd\xfc\xee\x8e\xa7\xbb\x00\x8f\x8f\x0c\xb3\xe0\x21\x00\x1f\xd7\xfb\x8e\x43\xdd\x7e\xf8\xcc\x31\x39\xe7\x98\x27\x66\xff\xc2\x8a\x4d\xf9\x94\x8b\x2a\x53\x31\x6c\x18\x50\x72\x7b\xae\xbc\xc6\xa4\x99\xc1\x0c\x1f\x48\x92\x91\x66\x41\x34\xcf\xcb\xcc\x50\xc1\x64\xa9\xb3\x55\x47\x34\x7a\x29\x5b\x30\xcb\xd8\x67\x3c\x0d\xdd\xf9\x67\x78\xb5\xce\x47\xe7\x98\x3d\xe9\x77\x64\x8d\x91\x56\xc1\x68\xe9\x9b\xc0\x54\x43\x5a\x19\xfb\xcc\x12\x17\x97\x5d\x64\xe5\x9c\xb7\x4a\x61\x19\x9a\x2c\x44\x9a\x40\x55\xa2\xbe\xd4\xac\xaa\x34\xd1\xad\xe9\xd5\xcb\xe8\x89\xb0\x57\xd1\xe2\x66\x73\xe3\x83\x94\x15\x4c\xa4\x50\x79\x30\xc2\x70\x9c\xee\xde\xa0\xe7\x2a\xfa\x6d\x4b\x07\xcf\x3f\x1b\x45\x2d\x51\xcb\x21\xbd\xd9\x95\x09\xe4\x33\x42\x45\x57\x02\xf5\x52\x6b\x89\x93\x41\xae\xd8\x5d\x2b\xe2\x5a\xbf\x8c\x1d\x77\xcb\x40\xea\xed\x0e\x8e\xae\x87\x61\x6f\xe8\x6a\xe1\xbe\x12\xc7\x4b\x6f\xdb\xdd\x42\x6f\x28\xca\xdf\x98\xd5\xd0\x3e\xf9\x8b\x69\x75\x31\x83\xcc\xf2\xee\x65\xc8\xbe\xc1\xf7\x1a\xb6\x00\x77\xd3\x37\x16\x6d\xa1\xfb\x3b\x0c\x8f\xd8\x07\xd4\x2c\x76\x03\xb9\xbc\x35\xa2\x2d\x74\xab\xa0\xc3\x52\x88\x76\x44\x6f\xdf\x1d\x09\xa8\xa1\x9a\x99\x2e\x16\xa3\xf5\x00\x4b\x2f\x7f\xe0\x58\xd8\x38\x03\x12\x05\x7c\x7a\x35\x19\x7f\xe5\x24\x15\x51\x7b\xd2\xca\x28\x1e\x50\xbe\x52\x19\x0b\xae\x7d\x1c\x23\xb5\xdb\x93\x50\xd3\xb1\xf7\x58\x07\xae\xe2\x66\xf3\xe3\x8f\x3d\xdb\x6f\xdb\x17\x1b\x30\x98\x84\xea\x23\xa5\xe0\xbf\x94\xb1\x4e\x02\x95\x5a\xc2\xaa\xdd\xf3\xfb\x58\xda\x3c\x61\x95\x71\xee\x8c\xeb\xdb\xee\x85\xfa\xbe\x3d\x3d\xaf\x0f\x51\x3f\x2c\xdf\x9e\x9e\x13\x77\xb7\x95\xcd\xac\x8b\xd1\x6c\xdb\x7a\x71\xf3\x84\x55\xe6\xeb\x94\xeb\xdb\x3d\x14\xf9\xeb\xaa\x4e\x14\xe9\x65\xbb\xe8\xfc\xe7\xb4\xef\xf9\xda\x44\x51\x01\xad\x95\x2c\xc9\x9d\xab\xba\xe1\x04\xf4\x1b\x5e\xbc\x27\xe7\x42\x97\x8a\x55\x5e\xf2\xa6\xac\x6e\x79\x7a\x6b\x71\x1d\xbb\xcf\xbf\xdf\x99\x3d\x70\xd7\xf8\xf3\x5a\x0c\x8c\x05\x55\x06\x64\xf2\x9e\x75\x0c\xa1\x0d\x8a\x1b\xc2\x2f\xea\x11\x64\xb8\x98\xf9\x38\xca\x91\xab\x0a\x10\x8a\xfd\xf9\x87\xec\xf6\x47\x65\x75\xe2\x0d\xff\x26\x14\xbc\x22\x6f\x52\xb6\x7c\xa3\x53\xfa\x6e\x04\x9f\xf1\xc9\xe9\xa6\x36\x27\xaa\xc9\xc1\xbb\x83\x09\xb9\xe6\x39\xcf\xa8\xca\x56\xb5\x0a\xeb\xd5\x73\x96\xb9\xf8\x01\xc1\x89\xf9\xf6\x80\x1c\x49\x05\x23\x27\x54\x90\x8c\xf9\xcc\x34\x77\xdc\x56\x28\x9e\x1e\x3f\x0f\xed\x21\x4f\x66\x9d\x45\x32\xd4\x0f\x3d\x7e\x44\x76\x56\x2b\xae\x74\x56\xd1\x7f\x2e\x2c\x53\x98\x90\x1f\x1d\x35\x77\x6c\x0f\xb7\x10\x4e\x91\x7f\xe2\x79\x41\xfc\x64\x3a\xe7\x76\x9a\xe4\xba\xa2\xfa\xdc\x80\x6b\xab\xad\xce\xb9\xf9\xc8\x0a\xd9\x43\xe4\xc0\x17\x1b\x96\x45\x6e\xec\x0d\xa9\x39\x54\x05\xa6\x06\x3a\xbb\x2b\xc3\x93\x32\xa3\x56\x82\x47\xbb\xe2\x84\x9c\x9d\x5f\x7d\x3c\x3f\x3d\xb9\x39\x3f\x7b\x4f\xfc\x48\x3c\x96\x21\x27\xe4\x26\xae\x5f\x16\x85\x7c\xbb\x22\x51\xe1\x5b\x23\x47\xb8\xa8\xa8\x4a\xa8\x42\x7d\x19\x2a\xc8\x85\xe0\xa6\xaa\x6c\x8e\x41\x84\x99\x14\x2e\x2c\xd0\xbe\xed\xec\x9a\x73\x8e\xc1\x38\xc2\x0d\x66\x7f\xae\x8f\x06\x27\x08\xeb\x1a\x87\xa9\xb4\xd2\x4e\xf7\x2c\xab\x54\xe0\xde\x87\xd6\xe1\x8b\x10\xf7\x3a\x54\x37\xe8\x10\xa9\xaa\x44\x23\x47\x09\xcd\x19\x7c\x3d\x26\xa9\x6a\xbd\x13\xa0\xdd\xb5\xe5\x55\x87\x93\x43\x2f\x9a\x64\x6b\x0d\x3a\xc2\xa0\x71\x59\xba\x3a\xfe\x4d\x08\xf9\xc1\x87\xf9\x43\x1e\xfa\xe6\x5e\x1f\x58\x7c\x24\xea\x0c\xd1\xc0\x62\xdf\xd2\xa5\x9c\xc6\x1f\x75\x75\xec\xe6\x7c\xc9\x04\x2e\x6c\x7f\x84\xcd\x4f\xa5\xd7\x2e\x7c\xac\x56\xf2\xe3\xc7\xef\xf7\x37\x49\x3c\xa9\xbd\xa6\x78\x2a\xf3\x1c\x2b\xa0\x2d\x42\x26\x69\x95\x0c\x1a\xa8\xc6\x5e\x94\x2f\xac\xeb\x36\x6b\x75\x20\x1a\x14\xd0\xbf\xda\x50\xb6\xc2\x6d\x97\xe7\x22\x2a\x79\xba\x7b\x99\x74\x57\x48\x50\xfb\xd2\x3d\x8e\x3d\xbc\x09\xf3\x7e\xf3\xf1\xfc\xe4\xec\xc3\xf9\x24\x4f\x5f\x00\x31\x62\x22\x2d\x24\x17\x46\xb7\x55\xb4\xda\xb5\xbf\xea\x4a\xb6\xc2\x34\xfa\xc9\x02\xe7\xfe\xf5\x38\xb0\xc6\x8f\x19\x55\x6a\x4c\x99\xa1\x3c\xd3\xd1\x8e\x1b\x59\xc8\x4c\xce\x37\x57\x51\xef\xb0\x95\xbf\xc5\x5a\x48\x63\x3a\xb6\x38\xb2\x3f\x0d\xa4\x7d\xab\x9e\x3a\x84\x7c\x6b\x1e\xa8\xc5\x1a\x56\x1f\x24\x7b\xe8\xa8\xf3\x0a\x00\xb0\x47\x91\x71\x0d\x2a\xa8\x03\x03\x49\xf0\x85\x2e\xab\xca\x91\x51\x43\xad\xb6\xb2\xe4\xd3\x00\xb3\xad\x18\x69\xa9\x5b\xdb\xce\x6a\x75\x28\xfe\x9b\x7b\xb3\x4e\x44\x0b\xc5\xc6\xa1\x20\x19\x74\x78\x92\x2a\xe2\xf9\x31\x4d\xf5\x46\x2a\x6f\xd2\xc2\xa7\xb2\x55\xd3\x58\x55\x49\x6d\xc1\xe6\x87\xf5\x2e\xb2\x6c\x55\x95\x53\x75\x26\x01\x3a\xc7\x42\x63\xca\x59\xce\x0b\xc5\x97\x3c\x63\x73\x28\xa8\xcc\xc5\x3c\xea\x29\xec\x2b\x63\x40\x3b\x0f\xb6\x36\x2f\xbb\x79\xda\xc4\x05\xf7\x01\x53\x2e\x7f\xb8\x81\x22\xdd\xe0\xfa\xdd\x5a\x59\xb0\x1f\x84\x66\x54\xe3\xf1\x18\xec\x1f\x47\x7f\xb7\x52\x6e\x9a\x1d\x93\x9f\x98\xfb\x8e\x84\x42\xe2\x0a\x3a\x95\x2d\x64\xa8\xe4\x0c\x73\xad\x20\x0b\x08\x8a\xc1\x15\xee\xa9\x37\xf6\x49\x2b\xae\x21\x3b\xab\x3d\x0f\x6d\xa7\xb1\x04\x2a\xfa\xe4\x5e\x82\x44\xbc\x27\xa6\xb2\x25\xb5\xf4\x76\xe7\x4d\x38\x1c\xfc\x68\x85\xa3\xab\x94\xe8\x55\x9e\x71\x71\x5b\x55\xc7\x9b\x49\x8b\x67\x98\xf7\xc2\xc5\xad\xc7\x6a\xc5\x68\x76\x3f\xc5\xed\x83\x43\x7b\xa3\xb6\xa6\xb7\xe9\xf3\x66\x55\x60\x9c\x44\x20\x16\xce\xe9\x1f\x93\xca\x83\x83\x17\x0d\x01\xae\x13\xcd\xbb\x93\xc7\x8b\xeb\xd3\xeb\x5a\x5f\x6d\xab\xd5\xc2\xbd\xa7\x34\xe8\xdf\xc7\x6c\x60\x51\x2f\x4a\x02\xe5\xbf\xb4\xf3\xd0\x8f\x49\x56\xb6\x7d\x12\x83\xce\xae\xa4\x32\x34\xdb\x03\x61\x49\x16\xb4\x38\x29\xcd\xe2\x8c\xeb\x44\x2e\x59\x4f\x35\xef\x6e\x81\x15\xd6\x7d\xc1\x4d\xee\x91\x04\xc7\x24\xa7\xff\x76\x72\x45\x68\x69\x77\xdd\xb8\x62\xbe\x7b\x0b\x7f\xf0\x2b\xba\xc6\x14\x96\x1d\xae\xc7\x8d\xf8\xa4\xab\x19\x9c\x36\x3b\x77\xda\x00\xdd\x78\x2d\x8e\x1a\x2e\xb8\xe1\xd4\xc8\x4e\x7d\x16\xeb\xf6\x8d\x52\x1b\x99\x3b\x14\xbe\xf0\xc3\x81\x4f\x1e\x58\x7f\xed\x0b\xf5\xee\x2c\xa0\x28\x00\xb8\x2e\x84\x15\xeb\x69\xc2\x1a\x71\xad\x23\xa8\x97\x8b\x63\xf3\xf0\xcc\x5f\x5c\x34\x32\x14\xc2\xcb\xbe\x7a\x5f\xeb\x95\xb0\xd6\x02\xc7\x1b\x5d\xaa\xb6\x2a\x7b\xb3\x66\xf1\x5f\xfa\xd1\x03\x67\x4c\xc4\x75\xfe\xdf\x92\x66\x08\x9f\xcb\x7d\x5a\xde\xea\x70\xef\x35\x6d\xbf\xe7\x7e\x5f\x2e\x83\xf5\xa0\xd4\x58\x67\x0f\x9f\x30\x8a\x0a\x6d\x37\xab\xae\xff\x1d\x3a\x17\xdd\x21\x39\x32\x49\x71\xbc\xb7\x95\xf6\xcd\x76\xc0\xc9\xbb\xbd\xf9\x3e\x64\x39\x74\x9b\xe7\xde\xfd\x68\x70\x06\xfa\x19\x83\x6a\x0b\x44\x11\x80\x7c\xcf\xb5\xf1\x6d\x54\xe0\x06\xd7\xae\x2e\x37\x48\x67\x57\x56\x6d\xe4\xc5\xdf\x68\x9a\xaa\xf7\xc8\xbb\x7c\x4b\x7b\x05\x32\x9a\xaf\x58\x47\x45\xf0\xc0\x1e\x99\x55\xe1\xca\x5f\xde\x9c\x5e\x11\xec\xae\xf4\xe7\x3f\x62\x1b\xec\xff\xfd\x4f\x7f\x7c\xdb\x71\xeb\x5f\x4a\xf4\x3a\xd9\xd6\xca\xb2\x73\xbf\xdc\x0b\x8d\xf9\x04\x81\x06\xa3\x3d\x81\x1a\x3b\xaa\x80\x78\x67\x91\x20\xf0\x88\x6d\xc4\x9e\x21\x3e\xf2\x05\xc7\x47\x92\x90\x90\x84\x74\x66\x57\xf4\x0a\x49\xd5\xd5\x4b\x25\x55\x2d\xa1\xd3\x16\xb3\xea\x18\x85\xd4\xc0\xea\xbf\x91\xef\x11\xf2\x15\xce\x2e\xaf\xff\xf6\xfd\xc9\xd7\xe7\xdf\xc3\xbc\x5d\xf4\x9f\x45\x15\x2e\x7a\xc7\xb6\xb5\x47\xbd\xf6\x9a\x72\x5b\xf0\xf4\xf1\x60\x5d\x7e\x73\xdd\x30\x2d\xd8\x3b\x1d\xdd\x56\xdb\xea\x02\x62\xd6\x01\x1a\xcf\x6d\x34\x84\x56\x47\x4c\xed\x27\xd9\x68\x4b\x0b\x63\x54\xf0\xae\xa6\x14\xda\x3d\xc5\x39\x6f\xad\xb7\xb5\xdc\x2b\xf2\xc2\x9c\x31\x16\x02\x08\x95\x9d\xbb\x61\xf6\x0a\xbd\x6e\xe2\x87\xea\x9b\xa9\x76\x78\x0d\xef\x7a\x87\x9e\x3d\xf2\x18\x37\xa6\x2c\x87\xb0\xbc\x81\xe9\xd0\x74\xe5\x15\x60\x53\xb1\xa9\x9a\x7a\x77\xea\xb8\xb1\x28\x7b\xad\x3b\x62\xcd\x65\x55\xcb\xce\xb9\xaf\x0b\x81\x8f\x25\xa1\x4e\x15\xd7\x05\x4d\x76\x5a\xdb\xb7\xba\x85\x77\xa0\xb0\xc3\x4b\x20\xb0\x30\x91\x3d\x85\x55\x87\xb1\xfb\xa1\xff\xa9\x7f\xbd\x99\xac\xd9\x69\x17\x7d\x57\xa0\x42\xfa\x64\xd9\x38\xab\xf3\x45\x6c\x31\x79\x72\xba\xfc\x53\x4f\x85\x6d\x9f\xca\x5a\xb1\x90\x46\x8a\x2d\x53\x2e\xae\x36\x0c\x52\xa7\x07\xf8\xc4\x69\xd5\x0e\x2b\xea\x87\x0b\x11\xb6\xc1\x55\x63\x05\x50\xcf\x91\xa4\xf0\x4e\x9b\xba\xcb\xe6\xf9\x0f\x70\x91\x5e\x9c\xed\xe1\xec\xbe\xbe\xb4\xe4\xae\xc6\xf2\xbd\x85\xef\xa4\x3d\xf3\xa0\x2e\xce\x9c\xac\xe8\x73\x9d\xb4\x43\x56\x72\x3f\xb6\xee\x85\x4f\x4b\x65\xee\xa4\xea\x5b\xde\xe0\xaa\xf6\x7a\x23\x7e\xc4\xfd\xb6\x96\x1f\xf8\x3a\xce\x1a\xce\xfa\xc5\x9d\xb7\x6b\x70\xc4\x37\x3a\x18\x34\x4f\x58\xc8\x24\xd9\xc3\x21\x7c\x39\x87\x6f\x2b\xae\xb8\xdf\xb4\xf6\xbd\xa9\x18\x1e\x2b\x7b\xad\xf9\x93\x7b\xd9\x99\x5a\xec\xfe\x55\xe4\x87\x86\xc3\xec\x3e\xb2\x17\x72\xa3\x24\x76\xe1\xed\x4c\x69\x2e\x0c\xcb\xb1\xe1\x3f\xcd\x32\x0b\x6f\x29\xe2\xa2\xf5\x2e\x99\x7d\x44\xb0\xee\x7b\x4e\x0b\xd7\x4f\x29\x95\x77\xe2\x8e\xaa\x94\x9c\x5c\x5d\xec\x9e\xa8\x74\x0e\xe6\x47\xac\xeb\x52\xa3\xaf\xde\x42\x59\xa6\x8c\x4c\xb9\xd1\x55\x53\x52\x66\x62\x9d\xd9\x12\xd4\xe0\x51\xb4\x47\xde\x1e\x6f\xf7\xd5\x88\x27\x0b\x22\x13\x43\x5d\xe7\xa9\xd0\x95\xeb\xed\xdb\xb7\x68\x42\x7c\xfb\xa7\x3f\xfd\x09\x1b\xb9\xa5\x2c\xe1\xf9\xfa\x83\xf0\xd4\x3f\xbf\x7b\x37\x21\xff\x7e\xf2\xe1\x7b\x68\x69\x5b\x18\x8d\x25\x7f\x70\x64\x80\x7d\xfc\xb2\x1e\x91\xff\x73\xfd\xc3\x65\xd5\x8e\xaa\xfe\x2b\x6c\x6d\xee\x97\x37\x21\x67\x51\x78\x5b\x6c\x12\xa4\x66\xe1\xfa\xa7\x19\x42\x67\x33\x6c\xe9\x3c\xf5\x5d\xc7\xf1\x38\xfa\x3a\x0a\x7c\xbe\xf0\xdd\x8b\x2c\x5a\x64\x10\x77\xc7\xed\x14\xc1\xa4\xea\x4b\x7f\x60\x18\x21\x8c\x15\xa8\x29\x4c\x65\x44\x32\x7e\xcb\xc8\x4c\x43\x0f\xa3\xaa\xa8\xa7\x62\xda\xca\xc7\xae\x3d\x2c\x0e\x16\xa6\x6e\x27\xf1\xb2\x7c\x6f\x1d\xbb\xe7\xd4\x10\xce\x17\x46\xf7\x71\xc5\x78\x7e\x2d\xd8\x5e\x8a\x2f\xac\x2e\x8f\x84\xf9\xe1\xae\xba\x52\x1a\x81\xec\x10\x9a\x49\xe8\x29\x18\x90\xa0\xe2\x83\x3e\x20\x64\x55\xb0\xb6\x8b\xeb\x59\x6d\xb5\x5f\x2d\x6f\xa4\x6c\x1f\x68\xc7\xfe\x61\x75\xd7\x44\x54\x8a\x84\x4e\x65\x69\x7c\xc8\x01\x8e\x8c\x4d\x7c\x8d\xf4\x00\xeb\xf4\xa1\xde\x85\x67\xfb\x97\x36\xef\x59\xe7\xb8\xce\x58\x6a\xc2\xca\x88\x30\x9a\x2c\xc8\x2d\x5b\x8d\x91\xe4\x15\x14\xb2\xcf\x42\x8f\x47\x57\xff\xb7\xee\x21\x4b\x58\x6a\xe5\x76\x07\x44\x1f\xd9\x51\x61\x5d\xc8\x5e\xf3\xa2\xb0\x76\x12\x99\xab\x2b\x2c\x22\xc3\x87\x2f\x94\x1f\xf5\x6e\x0f\x85\x84\x21\x52\xa5\x91\x41\x65\x4f\x28\x4b\xed\x6b\xfa\xa1\x2f\x57\xe1\x2c\x96\x84\x3a\xe6\x58\x8a\xb5\xb7\xa1\x72\x1b\x23\x4e\xbc\x84\x0f\x52\x5f\xf0\x3d\x0a\x89\x81\xc6\xa3\xae\x85\x9c\x7b\xd6\x43\x29\x00\xa2\x96\xe1\xa5\x99\x29\x1d\x68\xb0\xab\x61\x29\x32\xa6\x35\xe1\xb0\xc2\x9c\xaa\x5b\xe6\x8b\x31\xd1\x6c\x42\xae\xec\x24\x43\x5d\x39\xac\xab\xbe\xc4\xa0\x49\x7b\xa6\xe3\xd4\x35\xfb\x91\xc3\xc9\xe4\x10\x79\xc3\x86\x44\xb6\xce\xf8\xb1\x4d\x09\xee\xde\xa5\xb7\xeb\x7c\x9e\x16\x1a\x4b\x8b\x5b\xe9\x12\xca\xeb\x4b\xc8\xe3\x34\x0b\xcf\x0d\x69\xc7\x62\x65\xf1\xb5\x65\x8d\xe8\xed\xda\x28\xf4\x6f\xa2\xd0\xc1\x45\x54\xbf\xb6\x6d\x9e\xb0\x45\xeb\x84\x7a\x00\x95\x3b\xdf\x15\x85\xed\x5b\x27\x7e\xeb\xc2\xfe\x79\x8f\x32\xd1\xfe\xba\xaf\x52\x56\xde\x46\x3a\x75\x9d\x49\x33\xf6\xaa\xc4\xd1\x8b\xd9\xa6\x6e\x99\x3e\x11\xb6\x92\xea\x03\x09\xb6\x10\x78\x7e\x39\xb4\x4f\x6f\x2b\xd2\x53\x30\x6d\x5e\xdd\x05\xd5\xe6\xd5\xc5\x19\xdb\xbc\xd6\xce\x5d\xe0\x21\x45\x94\x0a\x02\x20\x36\x12\x7a\x02\x98\x70\x38\x27\xe4\x83\xe3\x31\x88\x9c\x74\xaa\x65\x56\x9a\x90\x56\xb7\x81\x01\xc1\xa0\xbe\x83\x00\xa6\x4f\xfb\xc7\x22\x76\x04\x8c\x18\x69\x7a\x3f\xce\x84\xd7\x16\x87\xbf\x5f\xa7\xd0\x2f\x3c\x44\x08\xaf\x2d\xa0\xea\xe5\x96\x2d\x21\x7b\xed\x6a\xb8\xf8\xb8\xfb\x9a\x34\x05\x61\x42\x46\xa3\xa8\xe6\x05\x23\xd7\x23\xaf\xe7\x5a\xbb\x18\x9e\xdc\x74\x9d\x35\xe5\xe4\xea\x62\xe7\x5a\x48\x34\xf6\xaf\x52\x0f\x01\x03\x57\xad\x1b\xd9\x59\x05\x11\x67\x60\xb7\xf4\xea\x15\x8a\xb3\x6b\x0b\xf9\xc6\xd2\xdd\xc8\x88\xdd\x28\x91\x89\x16\xac\x8a\x42\x47\x65\x35\xbd\x7b\x17\x4e\xff\xeb\x13\x7d\x9f\x49\x80\x05\x68\x75\x88\x8b\x6d\x5e\xeb\xcd\x45\x01\x08\xe4\x1a\x7a\x7d\xa1\x36\x1b\xa9\xc5\x85\x4c\xdf\xbb\x96\xfd\x42\x48\xec\xe7\xa9\x47\xd8\x2c\x4c\x8f\x50\xdd\xb5\x42\x4c\xe4\xb8\x57\x91\x4b\x62\x4b\xb1\x65\x8b\x06\x71\xdb\xb7\x88\xb3\x9b\x0c\xb0\xb8\xea\xb7\xd3\x64\x27\xbd\xde\x48\xc4\x15\xb7\xe9\x03\x55\x77\x1f\xe0\x78\x7e\x93\x75\xb2\x60\x39\xc5\x02\x9e\x7e\xc1\x96\x7e\xdd\x29\x6e\x0c\xc3\x7a\x68\x4c\xe5\x9a\xc8\xd9\xa8\xd6\x1f\xf6\x60\xf9\xee\xa0\x7f\x7f\xac\x9d\x34\x35\x23\xd5\x1e\xed\x0c\x3c\x57\x35\xb9\xd2\x9e\x06\x50\x80\x32\xa8\xd8\x2b\x1a\x46\x18\xcb\xe4\x96\x08\xcf\x67\x05\xc5\xf3\xab\x81\xa3\x20\xcc\x0c\x6a\xe0\xa0\x06\x6e\xba\x76\xa6\x06\x46\x8c\xcb\x13\x2b\x07\xc0\x58\x35\x8c\xeb\xf5\x79\xfd\xb0\xca\xd3\x8b\x6a\x67\x59\x94\xf5\x9a\xa1\x54\x75\x7b\xa4\x55\xef\x0e\xbd\xbe\xe8\xf0\xbb\x34\xb3\xf1\x9f\x09\x13\x89\x4c\x11\x29\xec\xf8\x4a\x1b\x10\xb2\x2a\x85\x2a\x9e\x4b\xee\xbf\x15\xdb\x34\x61\xec\xed\xb6\x74\x0b\x8a\xe1\x7d\xae\xdf\xec\x54\xa4\xa8\x04\x89\x90\x4e\xef\x00\x12\xaa\x76\x38\x89\xa2\xf2\xf9\x66\xd8\x67\xdc\x1e\x03\xdf\x38\x9d\x1c\xe1\xcd\x49\x52\x94\x23\xf7\xc0\x24\x67\xb9\x54\xab\x51\x78\xc8\xfe\x58\x7b\xcb\x3d\x71\x0c\x52\x48\x52\x2a\xab\xd0\x66\xab\x2f\x47\x1e\xf1\x20\x7b\x46\x71\x24\xec\x62\x9f\x7e\x6b\xf1\xd5\x08\x1b\x0d\x85\x05\xc1\xb0\x51\x75\xa4\x9b\x85\x42\xa9\x7a\x14\xd4\x73\xb8\xcb\xc4\x92\x2c\xa9\x6a\x1d\xac\xb9\xe9\xda\x89\x04\x92\xf2\x25\xd7\xdb\xb4\xa5\xdd\x68\x3d\xe0\xae\xb0\xa2\x2c\x4d\x51\x1a\x47\x7d\xfd\x59\xf2\xa5\x26\xc2\x19\x6a\x08\x66\xef\x0e\xb6\x98\xcc\x2b\x6b\x45\x8f\xd7\x56\x0d\xe9\xf1\xda\x4d\x5b\xfa\xfa\x58\x5b\xa2\xd6\x8e\x5b\xd4\xc7\x97\x47\x9d\xdd\x9d\xe1\x8a\x29\x57\x95\x54\xbc\xe0\xfc\x0c\x87\x14\x23\x89\x76\x6e\xdd\x72\xed\x39\x7e\x8d\x86\xad\x1d\x39\xd8\x5d\x66\xee\xaf\xdc\xbb\x7e\xed\x3a\xb8\x0c\xae\xf5\xf6\xd7\xe0\x5a\x6f\xf1\xe6\xe0\x5a\x77\xd7\x17\x60\x53\x19\x5c\xeb\x83\x4d\xe5\xe1\x6b\x70\xad\x6f\xbe\x06\xd7\xfa\xaf\xc7\xb5\xee\x04\xca\xca\xaf\xfe\x6c\x6e\x75\xd7\xf0\xec\x24\x49\x64\x29\xcc\x8d\xbc\x65\x1d\xbd\x36\xad\x14\x90\xb5\x6f\x3c\x8f\x36\xd2\x57\xc4\xe9\x21\xa2\xf4\x17\x4e\x68\x99\x72\xab\x76\x6c\x89\x76\x27\x6e\x18\xaf\x67\x58\xb2\x2f\x52\x96\x86\xf1\xfd\x61\x37\x76\x3f\x26\xe4\x84\x28\x96\xf0\x82\x5b\x52\x07\xc9\xcf\x70\x1f\xf1\x32\xf4\x87\xe1\x46\xb3\x6c\xe6\x7a\x70\x88\xb8\x5d\x5a\xa5\x42\x38\xda\xb9\xf1\x33\xc8\xe5\xa4\x6f\xcb\xe0\xbb\xc9\x29\xf6\x77\xcf\x1e\xdd\x6c\x6e\xe2\x11\x62\x43\x11\x2c\xa5\xd6\xa5\x0d\x3e\x56\x70\x57\x95\xe0\x29\x09\x04\xfb\x5c\x70\x05\xc8\x7e\xcd\x12\x29\xda\xf7\xc9\xae\xae\xda\x96\x9d\x37\xc7\xf3\x7b\xe7\xac\xc5\x2c\x25\x69\xa9\x42\x37\xec\x25\xcd\x78\xca\xcd\x2a\x78\x45\x5d\xef\x42\x8a\xe7\x2c\x6c\xac\xae\x00\x4b\x68\x51\x28\x49\x93\x05\xd3\xd1\xec\x51\xec\x71\xc9\x90\x21\xdf\x02\x3b\x71\x82\xe4\x03\xef\x58\x36\x9b\xad\x88\x92\xc6\x07\x48\xdc\xf3\xc1\x9b\x68\x30\x78\x1d\x79\xa5\x51\x2b\x88\xa2\x90\xf1\x10\x38\x2b\x3e\x8b\xff\xd0\x44\x66\xa9\xaf\x3b\xf4\xe7\xb7\x56\xd4\x4c\x1c\x56\x5a\x6a\x09\x55\x67\x8c\x24\x99\x65\xfb\x96\x82\xde\xff\xf2\x3f\xfd\x81\x2c\x64\xa9\xf4\x24\x4e\xb9\x7b\x07\xf7\x50\xe9\xf4\xa2\xaa\x21\x19\xa3\xda\x90\x77\x6f\x49\xce\x45\x69\x79\x5b\x4f\x44\xea\x2b\x5d\x45\x72\xd5\x1f\xff\xd0\xf1\xed\x7e\x12\xd5\xba\x07\xd9\x61\x5b\x81\xf5\xd8\x9d\x60\xe5\xce\x1c\x26\x75\x62\x0f\x84\x86\x98\xe5\x48\x78\xbc\x0b\xc2\xc8\x3d\x9f\xc4\x5f\x4a\x39\x5d\x99\xee\x69\xc3\xff\x17\xdf\xab\xe7\x0b\xfb\x9b\x6d\x6a\x1f\x55\xa5\x8f\x5a\x7c\x7a\xef\xdd\x7f\xe6\x5c\x9b\x56\xbd\x7f\xaa\xbc\xe2\x16\x0f\x77\x65\x5e\x73\xab\xc1\xf4\x4a\xf2\x02\xdd\xc7\xcb\xea\xde\x88\x96\x24\x0c\xfb\x14\x9f\x55\x1d\xea\x84\xc4\xaf\xb4\xfc\xc8\x0b\x2a\x53\xe8\x91\x6b\x07\x9d\x20\x3a\x2d\xbe\x8b\x2c\xe8\x11\xa9\xe7\xea\xf1\xe5\xfa\x99\xd2\x5c\xcc\xb1\x71\x43\x5e\x66\x86\x17\x59\x05\x89\xf0\x82\x63\x20\xb1\x01\x92\x46\x16\x32\x8a\x89\xf9\x58\x9a\x0e\x8c\xb5\x47\x61\x2c\x26\x0c\xf6\x1f\x50\x96\x0f\x15\x54\xd1\x00\x4e\xe8\xaf\xae\x8f\x9d\x2d\x93\x82\x6f\x17\x29\x9b\x65\x23\x8a\x66\x61\xb9\xb1\xaf\x6e\x5f\x88\x65\x98\xa0\xa2\xb5\x51\xbf\xae\xe0\xc2\xab\x44\xde\x85\xf0\x43\xec\x37\xd5\xc0\x28\x27\x70\x7d\x4d\x93\x5b\x26\x52\x6c\xea\x07\x80\x48\x57\x82\xe6\xae\x58\x5f\x68\xa6\xc6\xd2\xc6\xfb\x7a\xe4\x0c\x2f\x98\xc5\xea\x93\xf2\x91\xff\xef\x0b\x2a\xa5\xee\x59\xad\xea\x47\x6d\x99\xfb\xc3\xf4\x42\xa3\x91\x4a\xf1\x65\xc2\xbc\x6c\x62\x3f\xb8\xaf\xc5\x2c\x5b\x57\xb0\x58\x5b\x8e\x0b\xae\xe5\x11\xd6\x03\xcb\x09\xce\x07\xa8\xcb\x47\x33\x4b\x22\x56\x21\xbd\xba\x81\x00\xd3\xd5\xfe\x5a\x90\xa9\x69\xf7\x84\xf9\xc3\x8f\x5f\x9f\xd5\x89\xc1\x47\x9a\x4a\x4d\xbe\xce\x64\x72\x4b\xce\x18\x08\x8d\xfb\x6c\x8f\xa5\xa6\xe9\xcb\x6a\x4b\x90\xd3\x79\x3b\xbf\xe7\x98\xe4\x52\x70\x23\x55\x1b\x7a\x34\xf4\x1f\x7e\xf6\x52\xf6\x6a\x9a\xbe\x9a\x42\xf6\x16\x09\xfb\x77\x1b\x56\x70\x84\x61\x10\x5f\x4d\xb5\xe7\x81\xfc\xed\x42\xde\x8d\x8d\x1c\x97\x9a\x8d\x79\x6b\x3f\x7b\xe7\xf5\xde\xb2\x15\x04\x1d\xf4\x5a\xf1\x77\xf8\x72\x4d\x25\x32\x12\x2c\x75\x70\xdf\x0a\x0e\x1f\xbf\x3e\xb3\xdc\x68\x12\x8b\xa9\x6f\x98\x49\xde\x24\xac\x58\xbc\x71\x9f\x7f\xf1\x60\xf2\xf4\xa6\x1f\x9c\x4e\x48\x22\xb3\xcc\xd5\x3d\x90\x33\x72\xca\x8a\x45\x18\xf2\x79\xd6\xfe\x92\x8a\x9f\x17\x52\xf6\x2b\xe4\x1c\x1d\x39\x3b\x86\x3b\x71\x11\xa2\xa9\x69\xb7\x5e\x3d\xcf\x81\x5a\xaf\xa0\x27\xf3\xde\xc1\xf5\x74\x35\xe4\x0f\xaf\xfd\xeb\xa0\x2f\x47\xee\x9c\x7a\xc9\x78\x1f\x0f\x54\x23\x61\x17\x33\xd4\x10\x52\x96\x12\xb9\x64\x4a\xf1\x94\x69\x12\x68\x58\xac\x88\xf3\xec\x79\x20\x39\x54\xaf\x7f\xe1\xd5\xeb\x7b\xeb\x74\x11\xb1\xb3\x63\xac\x13\x3b\x9a\xe6\x5c\xbc\x68\x72\xa7\x13\x9a\xb1\x8b\x1f\x3a\xab\x4a\xd7\xf8\x5e\x5d\x5b\xf2\x37\xa3\x22\x8c\x8f\x14\x32\xfc\x2e\xe0\x14\x11\x32\x6d\x67\xbd\xde\xb3\xce\x33\xa7\x86\xdd\xb5\x64\xc4\xe3\x8a\xf4\xb5\x7d\x1e\x24\xe9\x97\xa5\x23\x3d\x53\x41\xd2\xe8\x9c\x60\x95\xc4\x7d\xb1\x73\xb7\xa3\xfd\x4c\x59\x7e\x69\x8d\x7a\xe2\x1e\xd5\x4f\xae\x2e\xc8\xb7\x38\xfe\xfe\x2a\xa6\x2a\x69\x50\x52\x3d\x93\x39\xe5\x3d\x1b\x51\x45\x05\xa2\xe3\x05\x5c\x85\xc1\x09\x8e\x1e\x77\xd5\x9a\xf1\x79\x69\x35\x51\xa7\x3d\x0e\x65\x29\x1f\x59\xf2\xd3\x09\x4d\x95\xcc\x14\x59\xdc\x7c\x64\x6d\x25\x27\xf9\x5d\x06\xf6\x14\x5c\xd8\x44\x33\xa1\x39\x78\xc9\xa2\xc0\x07\xd7\xce\x15\x7b\x0c\x63\x18\x2d\x0a\x56\x23\xf2\xbd\x9c\x73\xe1\x4f\xb7\x74\xce\xd5\x19\xe5\x59\x37\xf0\x0c\x92\xd0\x0b\x97\x84\xb4\xce\xce\x05\x9d\x66\xed\x23\x4d\xea\x8c\x24\xa3\xe0\x27\x67\x30\xc6\x9b\x94\x6b\xfb\x7f\x72\x7d\xfd\x3d\xf8\x36\x4a\xe1\x25\x79\xb0\xf2\x3b\xb2\x19\xf2\x69\xf0\xf0\xee\xef\xbc\x21\x0d\xeb\x5d\x47\xf4\x42\xa4\x76\xfa\x4c\xd7\x02\xa2\xdc\xa8\x58\x65\x35\x44\x97\x63\x74\xc8\x94\x91\x9b\x05\x4f\x6e\xaf\x22\x17\x86\x54\xf6\x9e\x88\x6e\xd5\x18\x61\xf3\xb7\x7d\x11\x5c\x37\xed\xab\xbe\x6a\xfe\x4d\xc4\x41\xae\x1d\x08\xec\x60\x84\x6a\x2d\x13\x5e\xf9\xb4\xc0\x08\x55\xb1\x98\x14\x58\xcc\xfe\x96\x05\x52\xc7\x4e\xf8\xa3\xdf\x58\xdf\x59\x5d\xc7\xfc\x90\x0b\xbf\xfa\xbd\x2d\x05\x51\xa9\x77\x77\x88\x9b\x5a\x3f\x08\x2f\x85\x37\xdc\x31\x3e\x3f\xc2\x6d\xa4\x97\xef\x7c\x4f\xe6\xb5\xad\x0c\x7d\x21\x5c\x7d\xd4\xbd\x2c\xbe\x7d\x32\xd1\x26\x2b\x42\xc3\x97\x8b\xf7\x9c\x83\x06\x0e\x65\x21\x8b\x32\xc3\x28\x9e\xed\xdb\x5c\x78\x6b\x3a\x7e\x67\x0f\x0e\x9b\xa7\x2e\x96\xdc\x35\x7c\xfe\xcb\xa8\x9b\x1c\x09\x90\x6f\xff\xf8\x87\x3f\xbc\xf6\x4a\xca\xdd\x0c\x05\xfb\x2e\xa5\xdc\xc9\x54\x3d\x64\xc1\x0d\x59\x70\xf1\xf5\xb4\xa5\xb0\xf7\x92\xe7\xd6\x2b\xe8\xbb\x4f\xc0\x77\xd7\x4c\xb6\x8e\xc1\xe1\xfd\x02\xc3\x3b\xe7\xaa\xed\x34\x43\xad\x67\x8c\x74\xf7\x6c\xb4\x21\x07\xed\xcb\xcc\x41\xeb\x1f\x1b\xdd\x37\xdf\xac\x7b\x4c\xf4\x97\x97\x5b\xd6\xf9\xd8\x76\xcd\x78\xea\x9e\xe7\xd4\xb7\x7e\x68\x5f\xbb\x60\xff\xb6\x80\xb1\x15\xcb\xe9\x3f\x55\x6f\x60\xdf\x52\x18\x2b\x08\x1a\x69\x0f\xe5\x13\x6a\x3f\xa4\xb3\x32\x88\x9f\x92\xdd\xbb\x04\x3b\xab\xc4\x0f\xd7\x0d\x17\x55\xb8\xfd\xfc\x9e\xa9\x2f\xdf\xc5\x33\xb4\xbd\x7a\xfd\xfe\x05\x5d\xab\x91\xe4\x2d\x2a\x40\x39\x40\x24\x90\xd3\xb8\xce\x6c\x75\xbe\x4e\xae\x2e\x48\xa2\x18\xa4\xbc\xd1\x4c\x4f\xc8\x06\x89\xc1\x5b\x7e\x9d\x84\xe1\x25\x05\x6a\x0c\xcb\x0b\xd3\x15\x21\x06\xf7\xc2\x0b\x77\x2f\x6c\x69\xd3\xfc\x14\x5e\x0f\x3d\x9f\xcb\x9c\x8a\xb1\x3d\x8d\xe0\x68\xa8\xf9\x37\x1b\x84\x7e\x42\x7c\xcc\x3a\x42\x87\x2a\x86\xc5\xf1\xea\x3d\xf4\x69\xd4\xdf\x78\xff\xa6\x5d\xf8\xce\x96\xb0\x40\x56\xde\x38\xa5\x89\x5c\x0b\x75\x76\x0b\x0c\x70\xf1\x07\x32\x92\x07\x6a\xb6\x04\xb3\x60\x28\x36\x5c\x41\xf2\x58\xf5\x54\x5d\x9e\x47\x81\x9e\x66\x99\xbc\xc3\x6f\xc7\x8c\xd3\xee\x87\x9d\x8b\xcb\x9f\x9c\x32\x92\x73\xa5\xa4\x72\x26\xe5\x78\x3a\xe8\x76\xb6\x7a\x01\x53\x28\x70\x2b\xe7\x95\xbc\x66\x26\xde\x7a\xab\x92\x0b\x4c\x1c\xb0\xff\xf6\x41\x5f\x58\x0f\xdd\xd1\x93\x29\x5b\xd0\x25\x97\xa5\xc2\xb7\x8d\x24\x07\xee\x27\x60\x39\x2b\x59\x06\x33\x1e\xf6\x3c\x0e\xab\xd3\x1b\xe0\x74\x59\xfd\x08\x0a\x49\x2a\xbd\x7d\x65\xcc\x3e\x73\x6d\xd6\xd7\xe2\x41\xe4\x0b\x60\xee\x03\x93\x96\xba\xb0\x0c\xa7\x67\x17\xd2\x4f\xf1\xdb\x75\x11\x69\x79\x0d\x3f\xbd\xea\x1e\xa4\x2d\x2b\x49\x0f\x12\xd8\x53\x49\x60\xc1\xe1\x98\xf1\x64\xd5\xb3\x17\x67\xe5\x68\xb4\x83\x90\xaf\xa9\x66\x29\xf9\x40\x05\x9d\xa3\x32\x7a\x74\x7d\xf5\xf5\x87\x63\xbb\xa9\xa0\xec\x5e\x9c\x6d\xf4\x46\x5e\xc7\x33\xb9\xdc\x57\xfa\xd1\xda\x9a\x7b\x73\xbd\x8e\xab\xde\x5b\x42\x15\x09\x9c\xab\x4b\x09\xf0\xf5\x64\xed\x66\x0f\xe7\x06\xb9\x59\xe6\xe9\xce\xba\x36\x33\xb1\xbc\x6f\x9e\xf5\xfa\x05\x62\x59\xef\x22\xc1\xc4\x92\x2b\x29\x00\xbe\x4b\xaa\xb8\x95\x2e\xb4\xb7\xde\x39\x6d\x3a\x94\x67\x9d\x90\x53\x28\xd9\x0f\x3c\xb1\x48\x1f\x22\xf8\x8f\x9b\xb3\x1f\x31\x5f\x37\xa7\xfd\x89\xaa\x1a\xf1\x16\x1b\xa7\x1e\xbb\x02\x28\x09\x05\x60\xef\x47\x93\x56\x34\xba\x0d\x65\x1e\x03\x3e\xde\xfb\x40\x3b\xfa\xfb\xb8\xd8\x5d\x03\x4b\x2c\x67\x6f\x82\x46\x4c\x71\x4f\xff\x76\x71\x76\x7e\x79\x73\xf1\xcd\xc5\xf9\xc7\x87\x4f\x4d\xcb\xb3\x02\x9a\x4c\x87\xc9\x1e\x7e\xf2\x7b\x14\xc5\x71\xfd\xee\xe8\xd3\xc9\xc7\xbf\x5d\x9e\x7c\x38\xc7\xfa\xca\xec\x73\x41\xa1\x04\x4a\x19\x92\xae\x0a\xc5\xac\x80\xa3\xb3\x95\x37\x48\xdd\x83\xb4\x4d\x6c\x05\x36\x6e\x05\x28\x5f\x04\x63\xe3\x6b\xce\xc1\x14\x10\x28\x09\x18\xae\x98\x96\xd9\xd2\x9b\x7b\xc3\xa4\xfd\x77\xb8\x28\x4a\xe3\x2d\x7f\x41\x52\x14\xc9\x82\x8a\x39\xc4\xba\xc8\xd2\x8e\xf7\xbb\xdf\xc1\xc2\x14\x4b\xcb\x04\x39\x52\x48\xbc\xfe\xdd\xc8\x27\x40\xa3\x4c\x09\x05\x88\x75\x42\x0b\xbf\xf4\x18\x3a\x7a\x25\x0c\xfd\xfc\x9e\xf0\x09\x9b\x90\x83\xdf\x45\x3f\x1d\x84\x0a\x20\xf6\x13\xb1\x3d\x32\xe3\x86\x29\x9a\x91\x83\xf8\xe9\x09\x39\xb7\xdf\x80\x42\xbf\x61\x1f\xd0\xf3\xc6\x96\x4c\xd9\x55\xf8\x5d\x18\x11\xc5\xe6\x54\xa5\x99\x0b\x07\x8d\xed\x86\x01\x60\x20\x18\x6a\x22\x95\x15\x15\xeb\x2e\xe6\x83\x83\x47\x8c\xd0\x5d\x50\xed\x1b\x25\x1f\x89\x77\xa9\x33\x13\x2c\x16\xeb\x43\x3c\x37\xed\xfd\xa1\xf6\x4e\xcd\x88\xac\x59\x9e\xc3\x67\x55\xbe\xb5\xbd\x6f\x55\xf4\x47\x82\x5e\x5b\xcb\x7a\xed\x05\xb1\xd0\x78\xf5\x3b\xb6\x6a\x65\xdc\x68\x98\x35\x7c\xcd\xf4\x5b\xb6\xc2\xd8\x98\xd0\x2d\xea\x71\xae\xd9\x49\x74\xed\x22\xb8\xb6\x73\xdd\x75\x93\x56\x5b\x7b\xde\xee\xf3\xb8\x61\x39\xe1\x76\xb2\x44\x47\x49\xa2\xbd\x21\xe5\x8b\x35\xa1\x74\x84\x58\x37\x0f\x47\xaf\x16\x69\xbd\x3c\x1c\x5d\xec\x92\xed\x3b\x2a\xdd\xd7\xe8\x60\xbd\x63\x92\x53\x91\x34\xc9\x99\xa1\x29\x35\x74\x62\x77\x6e\x54\xff\x13\x94\xf7\x11\xf9\x39\xdc\xc4\xae\x4a\xff\x71\x78\xf8\x97\xef\xce\xff\xfd\xab\xc3\xc3\xff\xfc\x39\xfe\x35\x6a\xc0\x54\x7f\xc4\xaa\x49\x13\x21\x53\x30\x44\xb8\x3f\xeb\x35\xe2\xdc\x0f\x86\x9a\x52\x4f\xac\xb6\x76\x71\x15\xfe\x2c\x64\xda\xfc\x4b\xb7\x70\x47\xee\x91\xe8\xb4\x6f\xba\xd4\x8d\xf4\x74\x6d\xa4\xf4\xcc\x2d\x93\x3a\x1e\xc5\x8e\x6d\x90\x9e\xb0\xe1\x51\x87\x85\x74\x6f\x49\xf2\xf4\xcd\x47\xdc\x9f\xac\x58\xb0\xdc\x4a\x6c\x63\xa7\x50\xd7\xdb\x92\x8c\x9a\x3d\x49\xea\x7d\x4a\xd6\xde\xde\xa6\x65\xc9\x1e\xcf\x62\xeb\x86\x23\xdd\x8e\x62\x8f\x26\x22\x4f\xdc\x2e\xa4\xe3\xe9\xeb\xd4\x02\xe4\x99\x9a\x7d\xbc\xf0\xb6\x1e\x9d\x1a\x78\xf4\x6b\xd5\xd1\xa3\x29\xc7\x0e\xda\x6f\x74\x6b\xb4\xb1\xeb\x96\x1a\x1d\x56\x8c\x5e\xd4\x5d\x69\x30\xeb\x51\x1e\x91\xbb\x66\x50\x6a\x2a\xa5\xa6\x1e\x15\x53\x71\xde\x99\x92\xf9\x84\x44\x66\x21\xa8\x50\xea\x9f\xbb\x65\x2d\x53\x3b\x07\x25\xe8\x75\x29\x41\xbb\x29\x66\xfd\xb8\x06\xc4\xc4\xf2\x21\x33\x4d\xd3\xa6\x6b\x9f\xad\x9b\xa3\xbd\x00\x65\x64\x95\xbe\xd2\xce\xda\x37\xf1\xb8\xaf\x83\x99\x30\x38\xba\x1d\x95\xcb\x37\x1b\x43\xc9\x49\x96\x11\x2e\xf0\x24\xd4\xa2\xd8\x15\x73\x4e\x29\x8a\x06\x67\xa8\x19\x75\xe7\xbd\xc5\x95\xa1\x91\x6b\x0c\x08\x84\x9c\x88\x9f\xec\xef\x48\xb3\x9c\x69\x8c\x8b\xaa\xc0\x62\xe0\xb6\x21\xa4\x7e\xa3\xeb\x26\xa3\xda\xf8\x69\xc3\x6c\x0c\xbd\x05\x53\x68\xc2\x52\x26\x12\x36\x21\x9f\x30\x90\xc4\xaf\x75\x6a\x85\x41\x30\xef\x63\xe5\x67\x92\x96\x45\x06\x59\x7c\x30\x93\xcd\x63\x5c\xe4\x79\x69\xc0\x50\xfc\x54\x56\x7c\xbb\xe3\xce\x32\x17\x19\xf3\x81\x54\x05\xd1\x9a\x82\xef\x41\xce\x2a\xd5\xfd\xfe\x4a\x6b\xad\x48\x7a\x3b\xc2\x1b\xcc\x6d\x8f\x72\xaa\x35\x8a\x5b\xd9\x18\xea\x94\xf6\xa9\x2d\x86\xed\xe8\xec\x17\x49\x63\x3b\xd0\xd7\xf6\xb4\xb5\x83\x71\xa9\x2b\x3d\x6d\x6b\x4d\x2a\x14\x9b\xf1\xcf\x1d\xf0\xf1\x44\x54\x2a\x4a\x54\x85\x1d\xf2\x0a\x58\xc1\x04\xe8\xe1\x3e\xbf\xc8\x53\xd1\xca\x32\xbc\x4f\x8f\x51\xcb\x38\xbd\xf5\xf4\xdb\x4d\x52\xcc\x70\xb6\xbe\xf0\xb3\xe5\x76\x7d\xf7\x07\x6b\xc1\x68\x66\x16\xa7\x0b\x96\xdc\xde\xbb\xeb\xb5\xd9\xfd\x5b\xf4\x82\x65\x5b\x19\x75\x6e\x36\x1c\x89\x24\xf8\x4b\xca\x92\x8c\xaa\x2a\x84\x45\x97\x53\x3b\xc8\x94\xa5\xe4\xf4\xfa\xd3\xbd\xf1\xaf\x2d\x70\xf5\x71\x5d\x64\xec\x26\x71\xcf\x03\x6d\xd0\x3c\x79\x10\x20\x6b\x40\x71\xe0\x80\x18\x07\xd8\x23\x9a\x65\xa4\xa0\x5a\x07\x97\x1b\x46\xef\x48\xe5\xa2\x70\x10\x58\x0f\x2a\x18\xed\x72\xe0\x5a\xe4\xbd\xdd\xb7\x7d\xae\x8a\x2f\xba\x5f\x61\xc1\x20\x70\x88\x30\xd7\x43\xed\xe6\x09\x85\x4c\x13\x93\xad\x88\x14\xcc\xf7\x35\x28\x94\x9c\xb2\x2a\x3f\x42\xb3\x47\x7c\x46\xad\xc9\x50\x4e\x3f\x5f\x55\x5b\x44\x1e\xce\xa2\xc9\xa1\x70\x42\xcb\x87\xdb\xaa\xb1\x8f\x84\x2f\x90\x6e\x9e\xcb\x52\x1b\x99\x7f\x6c\x6d\xa3\xa8\x23\x56\xed\x65\x40\x29\xac\x44\x61\xa5\x6a\x1c\xb9\x32\x5c\x2c\x5c\x31\x72\x6a\x4a\x28\x5b\x90\xf2\xaa\xea\x46\x55\x8d\x1b\x42\xcc\xa8\x48\xbd\x47\xe2\x39\x2d\x05\x61\x92\x37\xab\xa2\x8d\xa5\x67\xdc\xba\xa8\x7e\x8b\x2d\xf4\x0f\x76\xe8\xf9\x3c\xf6\x86\xf9\xdd\x9b\x6b\x2b\x38\xf4\x50\x73\x4f\xe3\xf7\x7d\x94\x74\x5c\xf7\xb8\xc2\x06\x27\xe4\xf8\x45\x1f\x6a\x32\x71\x88\x10\x9e\x69\x99\x9d\xd0\x51\x8b\xef\xd0\x74\x61\x6f\x16\x95\x1e\x03\x77\x88\xd5\x5e\x0b\x47\xc2\xc0\x69\xbf\x05\xf5\xd3\x5a\x0f\x0e\x01\xb9\xf3\xfa\x53\x6c\xc1\xfb\x17\xc2\xe7\x42\x7a\x93\x7b\x92\x95\xda\x30\x35\x86\x10\xef\xc8\x52\xbd\x97\xad\xea\x66\x50\xed\x38\x38\x62\x5b\x1f\x5b\x0e\x12\xb6\x3a\x3a\x03\xfb\x59\xd0\x65\x03\x9e\x37\xaa\x6c\x19\x0e\xd9\x71\xf6\xcb\x2e\x0e\xce\x0e\x63\x5b\xe1\xb7\x23\x6f\xf8\xb7\x9b\x9b\xab\x06\x47\xf8\xf6\xfc\xc6\xbb\xc0\x5c\x70\x95\x8b\xf6\xf2\xaa\x4d\x1d\xc5\x88\x62\xa6\x54\xc2\x72\x8d\x7f\xfa\xfc\xb9\xe2\x1c\x69\x0b\xd8\xed\x91\x2b\x14\x52\xb5\x09\x11\x1f\xfb\xd5\xed\x98\x18\xb7\x4f\xc5\xdd\x10\xe5\x2a\x3d\xfc\x1b\xc1\x5f\x6f\xf6\xe3\x94\xb6\xa0\xea\x33\x57\xa9\x4c\xa3\x0d\x57\x97\xe9\x75\x71\x0a\x75\x4d\x88\x76\xd3\xe9\x43\x1f\x3c\xae\x23\x8d\xa8\x55\x75\x7a\xf0\x18\xec\x7c\x67\x7a\xa8\xc7\x98\xde\x54\x85\x47\x03\x95\x43\x29\x3d\x9e\x73\x37\x99\xad\xa5\x96\x8a\xd2\x7e\x90\x21\x3a\xce\xfc\x87\xe6\xfb\x35\x9a\x14\xeb\x3e\x87\x7a\xc3\xc3\x28\xb4\xbe\x0a\x69\xd5\xb4\x11\x52\xbb\xd1\x9a\xd7\xcd\x0c\x4d\x3f\x69\xf5\x66\x93\x60\xba\xc3\x09\xa6\x8c\xa6\x19\x17\x0f\x4e\xad\x91\xea\x8b\x2f\x58\xd2\xb1\x90\x77\x24\x93\x2e\xe6\xd8\x19\x33\x2c\x48\xc1\x68\x68\x35\x7a\x3a\x33\xce\x28\x23\xd8\x9d\x3b\x96\x29\x2b\x32\xb9\xca\xc1\x7e\x3f\x65\x89\xcc\x19\xa1\x4b\xca\x33\x8c\x3b\x8f\xf7\xe2\x9f\xf3\xc7\x75\xfe\x07\x96\x87\x11\x6c\xad\x0c\x35\xdf\xc3\xa3\x10\xf5\x42\xd3\x14\xa3\xad\x9d\xa3\x98\x18\x96\x17\xe0\x4e\x92\x33\xc2\x96\x4c\xad\xa2\x15\x4c\xc2\x9b\xae\x6c\x5c\xdc\x64\x29\x7e\xd7\x8e\x7c\xcb\x0a\x33\x22\x9a\x8b\xc4\x67\xe2\xf9\x61\x2c\xb1\x02\x03\xa5\x54\x50\x26\x28\x45\x7b\x2b\x36\xd0\xb9\x17\x08\x2d\x4e\x2f\x4d\x11\x61\x68\x76\xd5\xe2\xa8\x3d\x0a\x51\x21\x53\x76\xed\x26\xda\x0a\xae\x87\x97\xd1\x1b\xce\x86\xe2\xff\xc2\x08\x76\x6f\x12\x31\xaa\xac\x62\xae\x01\x74\x92\xcc\x38\x34\x11\xa2\xf0\xdd\x09\xb9\xde\xf0\x66\x4e\x4d\xb2\x70\x4f\x40\x4b\x21\xdc\x8e\xc6\x40\x53\x8c\x91\x4b\xcb\x8c\x39\xa8\x52\xe3\x06\xed\x68\x53\xf5\x05\x0e\x21\xf4\xf1\x0d\xd5\x9a\xcf\xc5\xb8\x90\xe9\xd8\x8e\xf6\xe6\x3e\x8b\xe8\xd3\xef\x54\xa1\xb8\x54\xdc\xac\x4e\x33\xaa\xf5\x43\x71\x4d\x75\x51\xa7\xf9\x56\xe8\x91\xe2\x7e\x20\x89\xfd\x25\x8a\x2e\x7d\xc4\x38\xf9\xc0\x0c\x83\x56\xd6\x0e\x91\x3e\x86\x10\xb9\xc8\xfd\x97\xc8\xbc\x28\x0d\x8b\xe2\xe7\x42\xe8\x95\xaf\x38\x17\xb9\x79\x2b\xa7\xe5\x76\xbb\x9e\x43\x62\xd9\xd8\x7d\x7c\x1c\x3e\x3e\x0e\xdf\xda\x06\x11\xda\xf0\x44\x8c\xfa\x6b\x4d\xb2\x0f\xbf\xc7\xa8\x42\xbc\x39\x75\x12\x53\x4e\x3f\xf3\xbc\xcc\x09\x75\xdd\xba\x66\x1b\xc0\x09\xf9\x25\x3d\x5a\x29\x6d\x04\xd8\x06\x40\xe9\x7b\x21\xd5\x12\x5a\xa4\xf3\xd1\x21\x2f\x37\xf8\xac\x55\xd0\x59\xb7\x60\xb3\x0e\x41\x66\xbd\x83\xcb\x7c\x1c\x69\x7b\x7c\xfc\xe8\x43\x5b\x1b\x18\xc9\xc5\xa3\x18\xe9\x0f\x38\x64\x5f\x85\x71\xb8\x26\x32\xe7\xc6\x38\x03\x10\xad\x8e\xfd\x88\x70\x53\x0b\x50\x74\x67\x01\x3a\x0b\x63\x11\x57\xf6\x39\xe4\xff\x46\x75\x37\xaa\x46\xd6\x90\x4d\x4f\x78\x5e\x60\x41\x27\xc0\xe9\xb1\x0f\x9f\x70\x79\x40\xc3\xf9\x18\xce\xc7\xa6\x97\x74\x17\x69\x29\x16\x94\x30\x96\x67\xca\xb2\x4a\x60\xb2\x98\x6d\x59\xae\x93\x68\xaa\x5a\xcb\x13\x72\xfe\x99\x6b\x48\x77\xff\xc8\x20\x78\xe7\x9a\x19\xed\x0a\x74\xc2\x1b\xd8\x49\x35\xab\x17\x63\xf5\xc1\x4a\xa0\x76\x0a\x4b\xed\x9b\xe5\x5a\x63\x41\xf7\xc2\xc4\x12\x57\x53\xc0\x0d\xa2\xd7\x16\xc2\x6a\x1b\xce\x07\x5f\x3f\xff\x6c\x25\x00\xa8\x0d\xd8\x9a\xe6\x34\x5f\xac\x47\x8f\x35\x20\xed\x88\x0c\xe8\x28\x13\x57\x2f\xae\xba\x03\xd0\x3c\xb9\x3c\x7b\xb8\x14\xc0\x7e\x5c\x92\x27\x0f\x4c\xb5\x21\x5f\x03\x79\x73\x94\x44\x87\xf2\x85\x10\x5f\x36\x72\x19\xae\x91\xa7\x15\x1b\x93\x66\x50\xbc\x3d\xae\xe5\x8a\xef\xed\xc8\x5b\xd9\xde\xa7\xf8\x78\x58\xec\x38\x4c\x7e\x47\xbe\xc7\x56\x71\xb3\xb5\xcd\x70\xb5\x65\xab\xa3\x0a\x59\x81\xbe\x15\x66\xd8\x08\x5a\x14\x19\x98\xa9\x5a\xf4\x4d\xef\x61\x8b\xea\x38\xe9\xb0\xe5\xb5\x82\x26\xb7\x6c\x75\xa8\x5d\x55\x44\x29\xf4\x82\x17\xde\x26\x0e\xe7\xc3\x61\x01\xf9\x04\xa1\x8e\x7e\x08\x3c\x09\x17\x62\x44\x2e\xa5\xb1\xff\x3b\xc7\xb0\x45\x8b\x37\x67\x92\xe9\x4b\x69\xe0\xce\x4e\x97\x8d\x53\xe9\xb8\x68\x57\x3c\x8b\x63\xc7\x5d\x7b\x26\x21\x66\x14\x93\x9d\xfd\xe2\x2e\x66\xf5\xe8\x03\xae\xc9\x85\x20\x52\xf9\xd5\x85\xc0\x4b\xed\x86\xf0\xba\xab\x90\x62\x8c\x99\xbe\x9b\xc6\x38\x0f\x69\xce\x31\x4c\x1e\x18\xce\x0d\x85\x45\x83\xe0\x17\x1e\xa2\x47\x52\x92\x96\xd8\x3f\xdb\xce\x9e\x1a\x36\xe7\x09\xc9\x99\x9a\x43\xd1\xc9\x64\xd1\x16\xd4\xed\xca\x05\xb7\x2e\x14\xdc\x6a\xff\x80\x04\x7f\xff\xa0\x51\x86\x6c\x24\xdb\xce\xc6\x02\xe4\x2d\xa7\x85\xdd\xba\xff\xb2\x54\x0c\xa0\xf7\x3f\x50\xe4\x5a\x4f\xc8\x89\x0f\xcf\x88\x7f\x73\x06\xe1\x78\x18\x3b\x82\x95\xfa\x7e\x29\xf9\x92\x66\x0c\x7b\xda\x52\x11\xea\x75\xca\xd9\x1a\xb3\x18\x39\x5e\x6a\xcf\x37\xa6\xa4\x71\x4d\x0e\x6e\xd9\xea\x60\xb4\xb6\xdd\x07\x17\xe2\xa0\x2a\xb6\x5a\xdb\xe0\x40\x8c\x21\xe9\xeb\x00\x7e\x3b\xd8\x8e\xbf\xec\x41\xf8\x7b\x74\x2f\x8d\xcc\x5c\x93\x9b\x76\xba\xfb\x4d\xf5\x3c\x2c\xad\xca\xc0\x88\x46\x7a\x9a\x40\xe2\x1b\x2f\xb6\xd8\xb3\x55\xcd\x0b\x50\xcb\xd7\x70\x32\xd2\xcf\x0b\x1a\x74\xaf\x88\xdd\x33\xd7\x20\x01\x10\xc3\x71\x48\xa3\x20\x2e\xfb\x2f\x01\xdb\x46\x0c\xe4\xa7\xaf\xa2\x12\x14\xf0\xbc\xfd\x23\x60\xc8\x5f\xfc\xbf\xbe\xda\xb2\xb4\x48\x3b\xc6\x86\x53\xea\x20\x60\x9c\x63\x25\x61\x1e\x3a\xb9\xc0\x52\x01\x02\xcc\x15\x19\x96\xb8\xac\x09\x39\xb7\x84\x8a\xe4\x8c\x0a\xed\x0d\x71\x10\x2c\x5e\x3d\xac\x5d\x54\x7b\xa4\x57\x39\x93\x42\x75\x32\x18\xb9\x94\xd7\xce\x3a\x37\x22\x57\x10\xee\x58\xdd\x81\x93\x74\x29\xcf\x3f\xb3\xa4\x34\x8f\x58\xe6\x5b\x72\x91\x47\x19\x7d\x0d\x20\xdf\x55\x4c\x1e\x57\x56\x63\xf2\x15\x06\xc7\x6c\xfe\x41\xc8\xdc\xb2\x55\xc5\x6c\x9c\x08\x01\x24\x7f\x54\x61\x89\x67\x05\xc8\x3b\xfe\xc5\x9b\xb2\xf2\x29\x17\xf8\x31\x1c\xda\x6f\x05\x8c\xee\x01\x6a\x25\xbb\x2c\xc3\xcf\xec\x02\x5c\xed\xe4\x8c\x8d\x6e\xae\x36\x32\x46\xd4\x21\x60\x93\x74\x11\x89\x14\xe7\xbf\x94\x34\xab\x7b\x08\xdc\x2d\xf7\xd0\x1a\x55\xbf\xe3\x59\x9a\x50\xe5\x12\x31\xe1\x8c\x12\x2d\x5d\xc9\x6c\x20\x04\x09\x15\xe1\xb4\x57\x7b\xa4\x31\x9b\xa0\xa0\xca\xf0\xa4\xcc\xa8\x22\xf6\x2c\xcc\xa5\x6a\x55\xcb\xe3\x51\x88\x56\x48\x73\xcd\x12\x29\xd2\x2e\x0a\xc0\x4d\xf3\xdd\x66\x3a\x44\xc1\x14\x97\x58\x00\x80\xe7\xac\x89\xa4\x47\x75\xab\xbb\x9c\xf9\x53\x1d\x8e\x58\xcd\xf2\x81\x55\xd7\x1d\xc3\x73\x21\x36\xc7\x11\x79\x0c\xa7\x62\x42\xbe\x0e\x8d\x4d\x46\xae\x39\x02\x14\x32\x65\xc6\xd7\xaa\xf1\x28\xeb\x80\x5d\x1d\xa8\x99\x54\x50\x3f\xe6\xc8\x15\x0d\x64\x4b\x9e\x98\xe3\x09\xf9\x7f\x4c\x49\xd8\x78\xc1\xe6\x58\xac\xbb\x51\x03\xd5\xb8\xce\x2a\x54\x93\xb7\xe4\x08\x5e\x23\x3c\xcf\x59\xca\xa9\x61\xd9\xea\x38\xc4\xd3\x62\x0d\xb8\x16\x5b\xd7\xc6\x68\x10\x39\xe9\xff\xf8\x87\x07\x9e\xec\x5a\xe6\xe8\x93\x2f\x1c\x53\x41\x06\xd3\x7c\x1a\x5b\x18\x78\x90\x7c\x40\xdc\x8c\xd3\x84\xaa\x56\x5b\x9e\xcc\x84\x0d\xfe\x3b\xc4\xdf\x12\xc5\xe6\x80\xe5\x88\xb9\x5b\xe2\xf8\x32\x2a\xdd\xdb\xce\xf9\xe6\x94\xf0\xb8\xe6\xef\xbd\x85\xc4\x9e\x44\x4c\x88\x66\x12\x99\x28\x29\x56\x23\x04\x76\x6e\xc9\xc3\xa7\x5a\xd1\x50\xfa\xf8\x24\x77\x5a\x34\x0c\xe6\xf2\x48\x61\x8a\x9d\x94\x16\x0b\x1f\xea\x80\xcb\x57\x51\x3b\x90\x46\x69\x2d\xe3\xc8\x41\xdc\x63\x25\x20\xa8\xab\x9d\xe9\x13\x4d\xe3\xba\xfa\x87\xef\x1f\xa9\x90\xdf\x92\xf8\xe2\x72\x94\x2c\xe8\x9c\x3e\x1e\xc1\x51\x57\x46\x1a\xaf\x92\x94\x19\xa6\x72\x2e\x18\xba\xc2\x73\x44\x5d\xcb\xb6\x0a\xf7\x14\x4b\x21\xe7\xa4\xaa\x4c\x69\x64\xa3\xce\x18\x9c\x5f\xc8\x5d\xb8\xa3\x2b\x42\x95\x2c\x45\xea\xa4\xa6\x40\x40\x3f\x34\x3e\x7c\x29\xd1\xff\x1e\xb5\x8b\x08\x54\x7a\xca\x0c\xb5\xc7\xe6\xdd\xe4\xdd\xdb\x9d\x00\xac\x63\x69\x39\x6c\x5e\x51\xb7\x14\x5e\x86\x2e\x6b\x9f\x5a\x74\x86\x69\x39\xaf\x76\x05\xcb\xeb\xcd\x6b\x5c\x69\x56\xfb\xea\x18\x94\x30\x3e\x03\xdb\xed\x08\x6f\x41\xb9\xf2\x88\x3c\x1e\x61\x2d\x73\xe8\xfa\x13\x44\xd8\xe3\x4d\xfd\xe7\x5b\x2c\xe8\xf1\x94\x2d\x5d\x4e\xb7\x3c\x67\xee\x40\x01\xca\x55\xc7\x2c\x20\xdc\xa1\x7e\xe0\xc8\xd5\xeb\xaf\x91\x23\x7c\xd2\x4a\x6c\x52\x9a\xe3\xdd\xe4\x71\xe1\x02\xad\x66\xdd\x45\x25\xf1\xa5\xfd\x8a\x1d\xae\xf6\x6b\xb6\xa0\x4b\xa6\x89\xe6\x39\xcf\xa8\xca\xa0\x9c\xd7\x35\xce\x8f\x4c\x4b\xb3\xb9\x48\x64\xb7\x02\x84\xf1\x4c\xa2\xe1\x1e\x05\xb5\x9f\x87\x85\x13\xd0\x08\x3f\x2f\xfb\x9d\xbc\x34\x25\xcd\xb2\x15\x61\x9f\x93\xac\xd4\x7c\xb9\xed\x69\x72\x05\x4a\x7a\xb0\xea\x26\x97\x2e\x64\x7a\x5d\xb0\xe4\x29\x79\x74\x5d\xc3\xb0\xa4\x2a\xf5\x9b\x0e\x3c\x19\x95\x7d\xd0\xdc\x57\x90\x9c\x98\x24\x4c\x6b\x9f\xf6\xbc\x8a\x53\xb1\xc3\x1a\x5e\x4b\xcd\x4f\x7a\xa7\xcf\x33\xaa\x0d\x4f\xbe\xce\x64\x72\x7b\x6d\xa4\xea\x54\x56\xf3\xe4\xa7\xeb\xb5\xf7\x1b\x95\x52\x4f\x7e\xba\x26\x67\x5c\xdf\x46\x05\x3f\x7c\xe7\xcb\xc8\x5c\x42\x7d\xa1\xeb\xc3\xc3\x7a\xa9\x6b\xcf\xe0\x44\xa8\x1a\x53\xc5\x55\x6d\xdd\x52\x85\xde\x69\x86\xd3\x9f\xda\xe9\xdb\x9f\x59\x9b\xa2\x91\x3b\xf5\x53\xe0\x64\x2e\xce\x76\xe4\x83\x68\x5b\x3c\xbb\xbe\x91\xcd\xba\xd9\xf5\xa2\xf6\xb0\x63\x2b\x59\x92\x3b\x8a\x5a\xb1\xab\xa9\x7d\xc3\x8b\xf7\xe4\x5c\xe8\x52\xb1\xca\x9e\xd1\x2c\xaf\xcd\x75\x87\x0a\xdb\x34\x2f\x32\xa6\xdf\xef\xaa\xce\xf6\x3e\x10\xc4\x65\xba\x5a\x69\x2d\x95\xe4\x0e\x8b\xc4\x5a\x12\x0f\xcd\xfd\x42\x71\x88\x08\x0c\xc0\x5d\x12\x99\x17\x4a\xe6\x3c\x32\xec\x01\x82\xef\x34\x05\x16\xcc\x0d\x3d\x82\x8a\x0f\xc1\xaa\xe9\x5f\x26\x75\xe6\x78\xdf\xee\x5f\xcc\x7c\xc4\xc4\xa8\xd6\x16\x80\xeb\xf0\x90\xdd\xef\x65\xd5\x0f\x22\xde\xe1\x6f\x42\x61\x2b\xf2\x26\x65\xcb\x37\x3a\xa5\xef\x46\xf0\x19\xed\x12\x72\x4d\x6d\x4e\x54\x93\x83\x77\x07\x13\x72\xed\xb9\x6d\xad\x13\x5a\xf5\xdc\x4c\xaa\x30\x20\x18\xd3\xdf\x1e\x90\x23\xa9\x60\xe4\x84\x0a\x92\x31\x88\x2e\xc5\x86\xc9\xf6\x4c\xad\x50\xa7\x3d\x6e\x5d\x98\xac\x6d\x38\x7e\x97\x50\xfc\xf6\xad\x73\x1a\x05\xe2\x1c\xac\x0e\xac\x08\x7a\x00\xc2\x24\x34\xc5\x81\x10\x6e\x86\x07\x32\x34\xc7\x09\x0b\x6e\x74\xc7\x01\x19\x02\x06\x78\x70\x53\x0f\x40\x4e\x3d\x78\x06\xaa\x4b\x3a\x96\xc0\xf4\x24\xb5\x2b\x34\x7f\xc4\x26\x25\x17\x67\x21\x3c\x91\x29\xcd\x35\x74\x22\x4d\x6b\x3c\x8c\x23\x63\x3b\x3a\xc9\xe9\x3f\xa4\x20\xe7\x5f\xfb\x06\x28\xc7\xcf\x0a\x9e\x47\x89\x04\xfd\x47\xa9\x98\x65\xc7\x5d\x02\x06\xfc\x3b\x4d\xce\x6e\xef\x63\x4f\x58\xf8\xd5\x85\x5c\x89\x8a\xc2\x5b\x2c\x9c\x72\xdf\xd0\x22\xe6\xdc\x4f\xcd\x64\xed\xee\x5d\x3e\x9e\xa1\x8a\x0f\xfe\xf8\xf1\x62\x47\xcc\x38\x01\x1a\x3f\x6f\xd7\x28\xba\x8e\x8b\xff\x66\x01\x78\x8a\xef\x63\xab\x4c\x62\x75\xf6\x11\x1c\x67\x62\xcf\xb3\xfb\xe7\x4f\x56\xe3\x6c\x4d\xbc\x5a\xb1\x11\x0f\xad\x8e\x73\xbe\x89\xf4\x74\xa0\x1d\x16\x35\xe0\xdc\x38\x86\x32\xcd\xe4\xd4\xf7\x6b\xd8\xf5\x7c\x7f\xfc\x78\xd1\x63\xba\x3f\x7e\xbc\x78\xda\xa9\xf6\x12\xcf\xbe\x94\xae\x26\x1d\xe0\x74\xcb\x45\x8b\x34\xa1\xfa\x91\x39\xff\x5c\x60\xf0\x99\x33\xf2\x5f\x2f\x28\x94\x1a\x0c\x05\xb0\x60\x53\xed\x2e\x6b\x4b\xd9\xfd\xf6\x5a\x8d\x0e\xe8\x13\x39\x63\xe8\xb2\x4c\xdf\xfb\x40\x80\xf0\xc6\xe6\x17\xb0\x41\x48\xfa\x1e\xe9\x2a\xc1\x28\xcc\x34\xc2\xa6\x23\x34\x11\x89\xf0\x93\xcb\x53\xe1\x19\x37\x90\xea\x71\x3c\xa9\x85\x96\x6a\x98\xf2\x4e\x0f\x73\x4f\xd1\xe2\x99\xfb\xf1\xb5\x65\xf7\x00\x78\x7b\x3e\xba\x32\x34\xfb\xce\x46\x86\x06\x3f\xf8\xe4\xc6\x97\xcc\xd3\xaa\x16\xa6\x8f\x3f\x6a\xd1\xea\xd1\x27\xdb\xf3\xb5\x2f\x1b\xa7\x48\xc7\xfe\xb0\xb5\x35\xc6\xb9\xb0\xbe\x04\x66\x2d\x8c\x12\xb1\xcc\xf7\x1b\x72\x15\xcd\x91\x6f\x5a\xdc\xfa\xae\x45\x35\xcf\x2e\x35\x50\xfd\xce\x77\xad\x8a\xb4\x80\xb0\x8a\x36\x45\x3d\x5a\xce\x26\x61\xc5\x62\xd6\xc5\x4f\x7d\xca\x8a\xc5\x37\x8d\xc6\xb2\xf6\x1e\xf9\xe6\x7a\xc3\xb9\x04\x20\xc3\x6a\x35\x1a\xed\x0e\x35\xc9\xf8\x8c\x19\xfe\xc8\x12\xf6\x70\x32\x73\x29\xb8\x91\xea\xfe\xd2\x81\xa4\xd3\x69\xf3\xc3\x75\xe5\x87\x55\xb1\xdd\x0f\x6e\x04\x0c\x80\x4b\x64\x96\xb1\xc4\xb8\xf2\xef\x00\x52\xff\x89\x4d\xca\x0b\x73\x3a\xfb\xe4\xf6\xcf\xa0\xbe\x38\x45\xe5\x0d\x6e\xe8\x9b\x8f\xe7\x27\x67\x1f\xce\x27\x79\xfa\xdb\x85\xbc\x1b\x1b\x39\x2e\x35\x1b\xf3\x16\xd5\x7c\x9f\x2f\x8c\x10\xaf\x76\x85\x07\xee\xeb\x39\xff\xa3\xc6\xb0\x01\x30\xe5\x78\xa7\x90\x94\x66\x44\x14\x75\x75\xc4\xa8\xb3\x04\x95\x59\x86\x50\x36\x8a\xb1\x51\xac\x52\x3f\x98\x9b\xd1\x79\x41\x7d\x8d\x08\xd5\xa2\xf6\x4b\xa0\x9f\x1e\xb9\xba\xd0\xfa\xc7\x85\x88\x87\x20\x77\x1d\xc6\x08\x09\x8f\xae\x40\xc5\x2d\x5b\x11\x88\xb7\x9d\x49\x65\xb1\x46\xd5\x31\x80\x99\x04\x16\xfb\xa6\xd4\x4c\x4d\x1c\xc7\x78\x72\x40\x75\xa8\xa7\xdd\xa3\x8d\x41\x13\x4c\x1f\xd9\x0c\x03\x92\x7d\x5b\x2b\x27\x45\xd1\xd2\x2c\x98\x30\x56\x06\xb7\xc4\xc9\x01\x63\x23\xdc\x5c\x84\xf3\x93\x03\xaa\x65\xa5\x83\x6e\x65\x09\x86\x1a\xd5\x5d\xd0\xd0\x1e\x94\xad\xe8\x76\xc8\x8e\x52\x34\x95\x10\x02\x81\x6d\x17\x6a\x08\x46\xd3\x9c\x8b\x17\x78\x10\x13\x2e\xd2\xc7\xd6\xdf\xe8\x2d\x01\x6f\xd4\xe5\x28\x1c\xa5\x57\xf3\xd9\x56\x87\x2e\x5f\xe9\x5f\xb2\x31\x7e\x65\x5c\xa4\x15\x54\x06\xf7\xda\xee\x0d\x38\x4f\xe0\x34\xdb\xd1\xfe\x92\x5f\x9f\x40\xb3\x35\xa4\xba\xc8\x30\x5b\xf1\xe6\x42\x72\xe7\x0c\x0a\xcd\x2e\xf0\x24\x7a\xdd\xd5\x82\xa0\xa0\x8a\xe6\xcc\x30\x85\xa1\x63\x2e\x18\x4d\xb8\xa8\xfe\x1f\x0a\x26\xae\x0d\x4d\x6e\x77\xdd\xe5\x67\xe0\xa7\xfb\xe3\xa7\x7d\xbd\x65\x3e\x48\x26\x0d\x98\xe0\x0a\x6a\xad\x62\xcf\x2c\x17\x8e\xd9\xbc\x10\xba\x12\x4a\xed\x77\xb1\x44\x84\x52\xeb\x75\x26\x5a\x95\xde\x47\xe3\x03\x84\x88\x85\xde\x11\x10\xfa\x8e\x50\xd8\x0d\xd3\x6b\x7f\x08\x9c\x1c\xd3\xc7\xef\x54\xd1\x83\x5c\xa6\x8c\x4c\xb9\xa9\x4e\xba\x66\x86\x14\x4c\xe5\xdc\x25\x40\x4b\xe1\x7b\xef\x23\xf7\xb2\x9c\xca\x7d\x3a\xe2\x6c\x82\xc8\xc4\x50\x97\x5d\x42\xa6\xcc\xdc\x31\x26\xc8\xdb\xb7\x6f\xdf\x82\xbc\xf1\xf6\x4f\x7f\xfa\x13\x81\x8a\x0b\x29\x4b\x78\xbe\xfe\x20\x3c\xf5\xcf\xef\xde\x4d\xc8\xbf\x9f\x7c\xf8\x1e\xe2\xaf\x0a\xa3\xc9\x54\x9a\x85\x1b\xd9\x3e\x50\x7b\x59\x8f\xc8\xff\xb9\xfe\xe1\xd2\x8b\x09\xba\xf1\x2b\xa8\x14\x61\x79\xf5\x60\xba\xb7\x7f\xfc\xc3\x1f\x26\xe4\x8c\x2b\xc8\xbc\xe5\x90\x2b\x10\xc2\x05\x0b\x1f\x42\x27\xa4\x59\xcf\x75\x77\x6c\xc2\x85\xd3\xe6\x7c\xbe\x00\x00\xd8\x13\x20\xc5\x2c\xe3\x89\xc1\x72\x6b\x78\xd8\xb1\x5c\xb9\xab\x62\x4d\x5d\x62\x94\x0b\x1c\x81\xc9\x8d\x48\xc6\x6f\x19\x99\xe9\x6f\x95\x2c\x8b\x2a\x21\x50\x31\x6d\x65\xd4\x84\x0a\xc8\xbf\x80\xc1\xaa\xbd\xd2\xcc\x3c\x6b\x24\x43\x4b\x4b\x4d\x0d\xe9\x2e\x6a\x02\xc8\x28\xb4\x08\x18\x23\x26\x14\x94\x87\xe0\x3a\x70\x37\xd7\x1a\x54\x06\x2d\x32\x8d\xce\xa9\xcf\xef\x28\x94\xfc\x3b\x6e\x12\x17\x3e\x53\xc8\xc9\xbc\xda\xc9\x5c\x2e\x31\x53\x44\xb5\x8a\x7c\xe6\xba\xe5\x7b\x2e\x2b\x3e\xca\x31\xba\x98\xc5\xc9\x68\x90\xba\xcd\xb5\xfd\x44\xad\x7f\xcb\x86\x2f\x57\x3d\x07\x2d\x1e\x69\xdc\xd1\x52\xac\xbd\xed\xda\x01\x3b\x4a\xe3\x9a\x44\xbb\x34\xaf\x6a\x0c\x0c\x57\x75\x49\x32\x51\xeb\xf1\x5a\x4f\x85\x5a\x90\x8c\x66\xa6\x74\xa0\x81\x58\x25\xfb\x6d\xa6\xb5\xcb\xb5\xc9\xa9\xba\xb5\x62\xbf\x3b\xff\x13\x88\x0c\xae\x0a\x8e\x63\xd2\xd5\x12\x8d\xd4\x39\x5d\xd5\x22\xeb\xed\x47\x0e\x27\x93\x43\x3c\x20\x52\x61\x4b\x1a\xc4\x76\x7b\xff\x99\x72\x8a\xeb\x91\xdb\xb4\xc0\x7a\xdd\x60\x8c\x71\xdd\x77\x69\x2d\x22\x98\x3a\x48\xb5\x29\x8e\xd7\x49\x7c\xe9\x56\xfa\xb0\x6d\x53\x2f\x7c\xb2\x68\xd3\x59\xb4\xab\x04\xd5\xa1\x07\xd8\xfd\xad\x8d\xdd\x11\x68\x57\x6a\xb0\x73\x35\x44\x82\x51\x11\x7d\xe6\xd8\x95\xc9\xb9\x24\xb6\x5a\x53\xfb\x97\xcf\xd5\x2e\x66\x98\xfe\x51\xa7\x55\x8e\x16\x44\x12\x42\xd5\x40\xbe\xca\x05\x79\xd1\xcc\x2b\x46\x97\x6e\x0d\x13\xbb\x56\xc7\xed\x52\x9d\x78\xc3\x39\x08\x34\xb3\xa8\x35\xa4\xcd\xd0\x00\x00\x72\xa3\x3f\x2c\x13\xf2\xc1\xd1\x54\x44\x2e\x3a\xd5\x32\x2b\x0d\xbe\x5a\xfd\x18\x13\x5c\x18\xd4\x97\x1c\x00\x2a\x1b\x1e\x8b\xc8\xaf\xa9\x5a\xf2\xb7\xa3\xc4\x78\xed\xb7\xfc\xee\x97\xa2\x69\x3d\x77\xe7\xa7\xaa\xa9\x9e\xde\x5b\x17\xa8\x44\xf3\x2e\xaa\xd2\xf5\x05\x39\xaa\xba\xd9\x7a\x37\xf7\x85\x30\x4c\xcd\x68\xc2\x8e\x63\x15\x2a\x74\x0d\x0e\x91\x35\x3e\x37\x60\x41\x45\x9a\xa1\x68\x9d\x30\x05\x28\xcf\x3e\x1b\xa6\x04\xcd\xe0\x13\xa9\xe2\x4b\xa6\x34\x39\xfa\x9a\x59\x79\x90\x51\x53\x2a\xd6\x2a\xc3\x68\xb7\x61\x85\x30\x8d\x5d\x29\x6d\x30\x58\xd7\x90\x0a\x78\x69\x53\x11\xee\x0a\x4c\x08\x55\x04\xa9\x8e\xd5\xd2\x89\x45\x25\xa0\xc7\x40\x2a\x56\xb2\x54\xce\xee\xed\xab\x9f\x26\x52\x59\x45\x08\x07\xa6\x9a\x28\x36\xb7\xd2\xaa\x02\xb1\x16\x9f\xc0\x4e\x09\x3b\x0d\xfe\xda\x71\x90\xdc\x43\x21\x6e\x33\x27\x3e\xcb\x25\x4f\x3d\x8b\x04\xdf\x92\xe3\xd7\x5c\x63\x7d\xed\x90\x77\x12\x75\x4c\x8c\x20\x8c\xc2\x38\x30\xd2\x90\xd1\x59\x8b\x9f\x8e\xad\xbb\x12\x0a\x3d\xb4\x68\x77\xda\x85\x08\xcb\x94\x5d\x95\xd3\x8c\xeb\xc5\x75\x4f\x53\xe0\xe5\x86\x21\x30\x60\x60\xcd\x51\x77\xaf\x79\x50\x33\xa1\x39\xb0\x3c\x4b\xc6\x2d\xb3\xe5\x56\x7e\x72\xb5\x9c\x43\xe7\x8d\x0a\x33\x25\x24\x46\x64\xcc\xa5\xf3\xdb\x9f\xa2\x79\xb8\x0c\x2d\x2c\xe0\x91\xb2\x1f\x45\x51\xbb\x9f\xd0\x2c\xd3\xcd\xec\x55\x4f\x68\x51\xe6\xf0\x59\x5b\xb8\xa7\xdc\x6e\x77\xe8\xe4\xdb\x28\x05\x79\xef\xc2\x34\xc9\x25\x66\xb8\x08\xe8\xc8\x84\x0f\x41\x1d\x12\xff\x42\x94\xd5\x07\xb9\xbb\x80\x32\x3b\xae\x9e\x3e\xd8\x40\xf7\x67\x03\xed\xe9\x69\xa8\x9a\x9d\xd3\x28\x23\xb8\x56\x9a\x33\x90\x52\x4f\x72\x1f\x71\x49\xec\xd4\x2b\x80\xdf\x3c\x31\x46\xf1\x69\x69\x3a\xd7\x3c\xfb\xd4\x78\x1d\xd8\xb4\xd5\x3b\xe0\xf0\x8e\x9d\x66\x91\x44\x98\xe9\x14\x82\x70\x04\xd6\x8f\x7c\xc5\x73\x80\xdd\xe0\xcd\x43\x4d\x52\x99\x94\xa1\x36\x2a\x00\xad\x72\x80\xb5\xa9\x20\x48\xba\x1e\xa7\xee\x65\xad\xe2\x8f\x3c\x8a\x55\xa9\xbc\x13\x77\x54\xa5\x27\x57\x8f\xc4\xa5\xd7\xd9\x79\xf5\x56\x2c\x28\xf9\xc1\x88\xbd\x4f\xa7\xb2\x34\x55\xf9\xcc\x2f\xdb\xf4\x6c\xa4\xa5\x08\x2d\x2d\xcd\x64\x30\x5e\x0f\xc6\xeb\xe6\xb5\x77\xe3\xb5\x7d\xa7\x5e\x0b\xb6\x76\x5c\x7d\x89\x01\x9e\xb5\x0d\xa5\xdd\xa7\x15\x34\x22\x30\x48\xdd\x9b\x71\xf0\x0d\xb9\x0d\x8f\x48\xb5\xb7\x91\xac\xe7\x29\x10\xb0\xea\xe7\xb7\x98\xee\xc9\x0e\x0a\xab\x6b\x25\x4c\xe3\x75\x5f\x08\x2e\x56\x66\x46\xa7\x43\xe4\xbd\x28\x64\xfa\x1e\x8b\x39\x52\x21\x24\x72\x3f\x3d\x72\x95\x90\x47\x4e\xef\x12\x69\xd4\x44\x0c\x0a\x31\x7b\xd6\xd8\xd1\x5a\xd6\x1a\xf8\xa4\xf3\x06\x10\xd8\x04\x58\xdb\x23\x25\x85\xe2\xab\xeb\x6e\xd8\xab\x12\x09\xdb\xbf\xd3\x14\x6c\xf0\xfd\xd0\xaf\x2a\x59\xb0\x9c\xc2\x3f\xbf\xf1\x0b\xb0\x27\xda\x8a\x64\x86\x61\x3e\x34\x53\xb9\x26\x72\x36\xaa\xa5\xeb\x1c\x2c\xdf\xb5\x6c\x34\x86\x57\x67\x13\x37\xf1\x18\xf8\x78\x55\x97\x07\x96\x7b\x55\x33\x3f\x5a\xec\xab\xfa\x59\x3b\xe6\x50\xf9\x95\x80\x66\x21\x7c\xf6\xba\xb4\xa7\xb7\xde\x8f\x82\x8d\xec\x15\xb0\xf5\xc1\x7a\xbf\x76\x3d\xa1\xf5\x3e\x22\xdc\x9e\x18\x38\x00\xc4\x16\xfd\xd8\xdc\xe6\xcd\xfa\x53\xe6\xc5\xca\x49\x55\x81\xcc\xa2\x9c\x37\xe8\x4b\x55\x77\x9b\x1e\x4e\x26\x87\x87\xde\xcc\xef\xf0\xb3\x34\xb3\xf1\x9f\x09\x13\x89\x4c\x71\x53\xed\xf8\x4a\x1b\x60\xfa\x95\x76\x1e\xcf\x25\xf7\xdf\x8a\x5d\xaf\x30\x76\xb7\x2d\xe9\x70\x82\x7d\x36\xfc\x37\x5b\xb1\xc8\x8a\x31\x86\xec\xfa\xaa\x59\x19\x9a\x91\x1d\x87\xac\x5a\x78\x60\xf7\x1a\x40\x4b\xdf\x3a\x84\x1c\xe1\xcd\x49\x52\x94\x23\xf7\xc0\x24\x67\xb9\x54\xab\x51\x78\xc8\xfe\x58\x7b\xcb\x3d\x81\xf5\x97\x92\x52\x29\x26\xa0\x89\xc7\x4b\xe5\xaf\x1d\x9a\x27\x93\x9e\xec\x35\x40\xbd\x5d\xd6\x58\x75\x35\xa2\x8e\x83\x0f\x00\x2c\x51\x55\x5b\xa5\x59\x28\xfc\xa1\x47\xc1\x58\x07\x77\x99\x58\x92\x25\x55\xba\x2d\xcc\x49\x5f\x8e\x9a\xf2\x25\xd7\x6d\xca\xde\xdf\xb3\xb8\xca\xec\x03\xa5\xf7\x4a\x53\x94\xc6\x51\x27\x8f\xbb\xbe\x5a\x51\xc0\xd9\x86\xe0\xf0\xee\xa0\xc3\xc7\x5f\x68\x33\x95\xfa\xd5\xaa\xb5\x4a\xfd\xea\xd6\x68\x65\xf3\xbb\x1d\xb7\x7e\x8b\x26\x45\xcd\xab\x5b\xdb\x66\xbc\xee\x63\x32\x55\x71\x2c\x2f\x98\xed\xe1\x10\x80\x0d\xfc\x8c\x77\x4a\x1e\x38\x77\xef\xd4\x23\x1f\x0d\xcb\x0b\xa9\xa8\x5a\x91\xd4\xd9\x1a\x56\x1b\x12\x30\xa3\x0c\xcc\xad\xab\xa2\xc0\xdc\x53\xae\x76\x94\x3f\xd0\x21\xf9\x92\xa5\xbc\xcc\xbb\x9a\xb2\x7e\x82\x6a\x58\xae\x92\x96\x77\x6e\xe2\x50\xa1\x94\x20\x4d\x6e\x5d\x8f\x1c\x0f\x43\xe4\xf4\x71\xc9\x9d\x83\x46\xe5\x63\x30\x86\x81\xa7\xcf\x75\x2b\xf4\x0f\xe3\xd8\x35\xc3\x15\xba\x3c\x9c\xef\xff\xc8\x3d\x78\x6c\xe5\x8f\x0f\xc0\xf4\x9e\x68\x4f\x48\xc7\x24\x33\xfe\x0f\x06\x0d\xb6\x3a\x97\xb0\x92\x20\x76\x87\xc6\x5f\x99\x4c\x22\xc7\x72\x8d\xfd\x00\xd4\x03\x66\x7b\xc3\xbc\x85\xbd\xfd\x3a\x0a\x0f\x60\xd1\xc9\x34\xfa\xea\x78\x02\xb5\x1b\x41\x44\x07\xd8\x05\x78\xdf\x44\x6d\xf0\x4a\x6d\xbf\x04\xa5\xd5\xa3\x67\xaa\x0f\xdd\xf9\x12\x92\x26\xea\x54\x56\x57\x2c\xec\x2f\xd7\x1e\x02\x91\x52\x06\xe9\x09\x5e\x0a\xd7\x25\xc8\x80\xee\x2b\x4e\x16\x92\x33\xf0\x47\x55\x7d\xbf\x42\xf5\xc2\x35\xac\x12\x3c\xab\xa3\x95\x2f\xdd\x16\x16\x5e\x0a\x17\x45\xb0\x86\x23\x9b\x51\xa4\xd4\x4c\x8d\xe7\x25\x4f\xfb\x20\xc7\x0b\xe6\x6e\xad\x79\x5a\x77\x4e\xd6\x91\x7f\x6d\xc1\xb5\x42\x94\x45\x07\xba\x7f\x70\x1e\x42\x33\x6a\x84\x3f\x2e\x09\x57\x0f\xd3\xa0\x3e\x12\x20\x1c\x39\xef\xef\xb9\x09\x7a\xab\x63\x08\xc9\x2a\x71\x69\xb2\xbc\xd1\x27\xd7\x0e\x8b\x98\x07\x51\xa9\x63\xfb\x1f\xaf\xdf\x7a\x63\xfd\x94\xcd\x64\xd5\x02\x04\xd5\x1d\x17\x4b\x9b\xb2\x8c\x19\x08\x8d\x65\xa1\x14\x27\xba\x79\x73\xb9\xb4\xc8\xfc\x57\x41\x7e\xf4\x45\xe9\xf9\xec\x3d\xa1\xc7\xb5\x54\x05\xd7\x56\x45\x30\x96\x62\x80\x6d\x56\x7d\x47\x95\x42\x8f\xc8\xf4\xd8\x07\x9b\xc0\x89\x13\x56\xe6\xcb\xbc\x38\x8b\x4a\xb3\x62\x16\x00\x90\xf0\xab\x64\x4e\xb4\xa0\x85\x5e\x48\x03\x7a\x1f\x2d\x68\xc2\xcd\xca\x82\xdb\x28\x9a\xdc\x42\x1b\x1e\xc5\xdc\x17\x47\x24\x39\x76\xf1\x5a\x31\x04\xeb\x61\xbf\x66\xa1\x64\x39\x5f\x40\x24\x2b\x3e\x05\x0d\x55\x1d\x00\x36\xbe\xef\xb4\x19\x4d\xd2\x95\xa0\x39\x4f\x42\xd1\x3c\x25\x97\x5c\x73\xe9\xac\xb9\x38\xae\xc5\x7a\x72\x15\xea\x9e\xa1\x91\xf8\x34\xa3\x3c\x27\x47\x9a\x31\x12\x10\x03\x7f\xb9\x46\xb1\x05\x8d\x17\x8a\xd9\xd7\x63\x0b\xb2\x0c\xc5\xbb\x85\xab\x38\x50\x51\xba\xe0\xa2\x42\x46\x09\xc7\x2d\xdd\xfc\xe9\xe3\xb0\x75\x9b\x67\x26\x15\x38\xe6\x7d\xd5\x4a\x26\x52\x19\xb9\x27\x4f\xae\x2e\x74\xac\x76\x20\x9e\xb9\xda\x6e\xf0\x43\x26\xc5\x3c\x4e\xd9\xaf\xb0\xd4\x92\x55\x01\xbd\x4c\x96\x3c\x2d\x69\x86\x04\xd5\x4d\xe6\xf4\xfa\x02\x5f\xe7\xf3\x85\x19\xdf\x31\x30\xbb\x20\xdf\xa9\x42\x9b\xfc\x47\xf9\x5a\x58\x0e\xd7\x40\x80\x8d\x33\x1b\xa0\x09\xcb\x4e\xed\x8e\xae\xa0\xbe\x8b\x0b\x21\xa9\x79\x46\x7d\x6d\x2d\x1c\x22\xc0\x3d\x02\x3a\x4c\xef\x24\xf4\xa6\xb0\x12\x03\xd8\xa5\x2c\x94\x01\x6b\xd7\xe7\x66\x01\x1f\xd5\xba\x0b\xb7\x5d\x1b\x32\x6a\xf7\x08\xa4\xb8\xbf\x0a\xb4\x30\x81\xbb\x63\x1a\xc5\x5e\xc1\x10\x68\xc7\xf6\x9d\xb8\xab\x63\xf8\x2d\x13\x4c\xf1\xa4\x81\x3a\xe1\xd5\x39\x35\x70\xf8\x98\xb0\xaf\xa5\x93\x87\x55\xa3\x3d\xc8\x78\xcb\x0a\x95\x6e\x5c\x37\xc2\x8e\xd2\xc7\xc1\x4f\x91\x15\x2e\xf2\x9b\xd8\x53\x4a\x45\x3a\xa6\x99\xc5\xcf\xab\x4f\xa7\x2e\x2e\x1a\xcf\x5d\x2d\x2e\xc0\x37\x16\xe2\x22\x54\xa2\xb6\x52\xca\xc6\xe3\x06\x09\xf0\x53\x06\x7d\xc6\x6b\x3d\x18\xef\xac\xc2\xed\x50\xe4\xea\xd3\xe9\x88\xf0\x09\x9b\xf8\xbf\xc2\xa3\x9e\x4e\x1a\x39\xc7\xa8\xc2\x10\x29\x0a\xd8\x0d\x53\x89\x6d\x5b\xf1\xbb\x3f\xff\xc5\x4e\xd2\xfe\xfa\xd5\xf8\x2f\x51\x6d\xcf\xaf\x7e\xb6\xfb\xad\xec\x03\xf5\xbb\x71\x68\x5a\x28\x64\xff\xf3\x95\x2b\xf4\xec\xca\x40\xff\xec\xfa\x5b\x31\x61\xac\x60\x7a\x25\xc1\xe9\xcf\x53\xc4\x79\xf8\xb6\x62\x7f\xf7\x76\x4a\x00\x53\xb0\x11\x25\xd4\x30\x01\xac\xc1\xe7\x70\x08\x69\xf0\x75\xd7\xca\xd5\xce\xff\x08\x2c\x0c\x98\x6e\x36\x22\x46\x4a\x38\xf4\x48\x58\x4e\x04\x61\xbe\xfd\x25\xae\x15\xc0\x41\x5d\xdc\x9b\xe7\x76\x76\x58\x0b\xe1\x34\xee\xcf\x0e\x73\xfb\xbd\x90\xe6\xf7\x61\xfb\x1b\xad\xc3\xe9\x52\x72\x5f\xd3\xdb\x9e\x47\x81\x4d\x12\x43\x95\xe9\xe9\x8a\xe4\x5c\x1b\x7a\xcb\x26\xe4\xda\x72\xb3\xd8\xb9\x86\xd0\x13\x04\x6a\x41\xb2\x94\x94\xc2\xf0\x0c\x7e\xad\xc6\xb1\x53\x8e\xb9\xdc\xc5\x8c\xe8\x12\x7a\x9a\x17\x8a\x8d\x3d\xdf\x74\x4f\xad\x51\x9c\x6a\x2d\xa3\xb0\xd9\x0b\x8a\xca\x46\x91\xc2\xab\x00\x0f\x2a\x1c\x7a\xad\x45\x83\xd9\x79\x4a\xdf\x92\xde\xfe\x01\xc0\xd4\x13\x72\x09\xec\x31\xf3\x1e\x66\xd4\x7b\x9c\x3d\x54\xb0\x84\x69\x4d\xd5\x6a\x04\xb5\xd2\x79\xa8\xaf\xed\x02\x80\x80\x78\xe4\x54\x60\xa5\x72\xc5\x12\x29\xb4\x51\x65\x62\xb0\x75\xdd\x54\xc9\x5b\x26\x42\xf4\x61\x20\x4c\x21\x0c\xac\x0a\xc7\x01\xf7\x99\x24\xc9\x82\x8a\x79\xd4\xfa\x25\xa7\x29\xc0\xfe\xbb\x20\x57\xf9\xf5\x58\x08\xd0\x99\x15\x65\xb8\x01\x50\x4c\x2d\xc3\x0a\x56\xdd\xbf\x0a\xe2\x15\xf7\x51\x65\x76\xb5\x4b\xe2\xd9\x23\xb4\xab\x13\xfd\x22\x1d\x6d\x84\x63\x90\x12\x76\x1c\x46\x96\x33\x43\x53\x6a\x68\x8f\x50\xb2\x0f\x55\xbf\x3a\xdf\x54\x1f\x7b\x86\x06\x3f\xa7\xe3\x76\x5e\xc0\x93\x05\x8f\xd3\xa5\xe0\x24\x2e\x3c\xe4\x21\xff\xda\x58\x9c\x72\x7e\x07\x8c\x10\x03\xf1\xc9\x37\x04\xb3\xc3\xfb\xd1\x90\x5c\x54\xdd\x0e\x2b\x72\xd2\xce\xab\xd5\xd1\xa0\x6b\x41\xdf\x03\x46\x37\x95\xeb\x2d\xa9\x87\x8b\x6d\x14\x74\x90\x4b\x30\x61\xb8\x62\x71\x76\x9a\x03\x5d\x29\x10\xc9\x1b\x40\x04\x28\xcf\x99\xd1\x55\xc0\x0b\xd2\x61\x4b\x5c\x1c\xbf\x73\xea\x2f\x10\x69\x07\x58\xa7\x41\x6e\x96\xb8\x10\xec\x5a\x3a\x3a\x6b\x29\xff\x5e\xe0\xda\xc7\x86\x8d\x15\xfa\x3f\xc8\xb4\x8b\xd9\xbb\x51\xd8\xbe\x1a\xa2\x8a\x02\xc5\x78\x5e\x0d\x66\x04\xfc\x06\x38\xbf\x74\x2d\xc7\x0e\x89\xdc\x82\x2e\xfb\xdb\xbc\x2a\x49\x6c\x1c\x8a\x02\xc3\xe7\xc6\xf0\xb9\xf1\xbb\xf6\xb6\xc1\x2e\x01\x25\xfe\x6a\x1d\x58\x52\xff\x48\x27\x43\xac\x25\x29\xd7\x1d\xad\xa7\xcd\x8a\xe5\x81\xda\x3b\x77\x64\x70\x01\xbb\x94\x09\xc6\x2d\x9d\x78\x4f\x7e\x5f\xe3\xef\x4e\x8e\x0a\x5a\x19\x46\xfa\x1e\x79\x35\x6d\xe2\x36\xc1\x27\xa4\xd7\x1f\x3f\x6e\x0c\x06\x82\xc5\x66\x8d\xc5\x47\x14\x07\x61\xcf\x0a\x66\x0a\xec\x72\x3e\x91\xc1\x22\x96\x92\x59\xc6\x14\x2c\xc1\xa9\x69\x0d\x77\x3c\xd4\x12\x45\xe3\xf0\x28\xa8\xc3\x41\xba\x14\xec\x2e\x88\x11\x54\x63\xd5\x16\xef\x3a\x63\xae\x0b\xdd\xbd\xe3\x85\xa8\xe7\x13\xb1\xc2\xa9\x9f\x85\x6d\xb9\x4f\x38\x1f\xc5\x1d\xdd\x60\x2e\x34\xbb\xa3\x2b\x0d\x18\x5f\x69\x0b\xe1\xfb\xae\x42\x5a\x35\xf0\x47\x36\xc3\xb7\x5b\xbb\xd6\x7a\x39\xd7\xfa\xb8\xd7\x20\xef\x92\x8b\x36\xb1\x4c\xd5\x0b\x0f\x76\xe1\x68\x5e\x7d\xfc\x71\x10\xf0\x02\x7e\xf8\x6e\xce\x95\x7a\xc9\xd3\xab\x0b\x18\xc2\x4b\xe3\x73\xf8\xc3\xf3\x9a\xe0\x7d\x98\x32\x8b\xd5\x55\x46\x35\x60\x48\xfc\xee\x86\x90\x84\x0a\xb5\xbe\x83\xb2\xa8\xce\x00\x1d\xda\x76\x29\x06\x21\x25\xf0\xc5\x09\x94\xfd\xa7\x62\xe5\x78\xb8\x59\x70\x95\x8e\x0b\xaa\xcc\x0a\xd5\xd3\x51\xed\x6b\x21\x3c\xbf\xd3\xc2\x7b\xfa\x85\xda\x55\x1c\xbe\x17\xc2\xb0\x78\xdf\x7a\xcf\x19\xfe\xef\x85\xeb\x53\xac\xa7\x7d\x02\xc0\xc6\xf5\x5c\x46\xf9\xf0\x5e\x17\x7c\xb6\xf5\xa4\x31\xf9\xe8\xcb\x31\x1a\x5e\x5b\x24\xfc\x71\xe7\x27\x19\x07\x50\x07\x8e\x0e\xca\x8f\x9d\xc0\xc8\xea\x9c\xb4\x6a\xd5\x1d\x99\x0d\x9d\x54\xe0\xc3\x6f\x5c\xa3\x20\xb1\x72\xc6\xa0\xf8\x5b\xf1\x00\xe1\x5c\x90\x23\x21\x05\x9e\x15\x7c\xf6\x18\xa3\x8f\xee\xb1\x76\xc1\x23\xae\xc3\x5b\xbd\xc1\x66\x74\x36\x3d\x5b\xe0\x22\xb5\x9b\x05\xb4\x1a\xf4\x21\x5d\x26\x09\x63\x41\x83\x8e\xfb\xbd\x54\x67\xd9\x4d\xd9\x77\x8a\xd4\x12\x4a\xb9\x68\x43\xb3\xac\xd2\x5c\x1d\xb8\x24\x70\x36\x6f\x5c\x8c\x18\x5e\x2d\x35\xc7\x29\xf1\xd0\x83\x1c\x23\x66\x4a\x91\xa0\xf7\x9f\x9b\x95\x9f\x41\xcc\x81\xe0\x35\x50\x19\x34\x2a\xb4\x7c\x86\x96\xac\x48\xf4\x0f\xc0\x04\x62\xe4\x3a\xa0\xd7\x79\x91\x2b\xdb\x60\x29\xcf\x94\x26\xb7\x77\x54\xa5\xd0\x09\xb7\xa0\x86\x63\x21\xee\x51\x6d\xd8\xa3\x68\x0e\xd0\x87\x3e\x46\xbe\xe3\xa0\x60\x40\x7b\x0d\xd9\xf8\x0c\xa1\xa5\x91\x39\x35\x3c\x01\xb5\x95\xcf\x22\xbb\x64\x1e\xea\x16\x36\xba\xf6\x01\x5d\x0d\xfd\xdf\x6f\xd0\xd7\xa3\x18\x31\x77\x92\xf0\xdc\xca\x04\x14\x1a\x50\xcc\x42\x8e\x91\x37\xa2\x3e\x34\x53\x2b\xf8\xfc\x04\x26\xec\xe8\x29\x54\x88\xad\xba\xa4\x61\xf8\x60\x23\x0d\xc6\x41\x97\xa4\x33\x6a\xb0\x6c\xe2\xdf\xb2\x58\x6d\x67\x1b\x21\xeb\xc8\x6e\xd0\x1d\xb3\xb2\x80\x7e\x10\x65\xf5\x64\xd3\x9c\xb0\x29\xac\x26\x29\xd7\x8d\xce\xce\x47\xa9\x92\x45\xe1\xcc\x21\xf9\xf1\xfa\x9c\xc0\x33\xa1\x96\x4c\x47\xed\x8b\xd1\x12\x3e\x67\x22\xf4\xdf\x76\xd5\x2e\xe0\xf4\x36\x3f\x02\x91\x5d\x24\x2a\x7e\x76\x74\x92\x15\x0b\x7a\x4c\x7e\x74\x8d\x7a\x02\xfe\x86\xb8\xbd\x56\x12\x13\x1a\x58\xbc\x45\x73\x10\x75\x5a\x5e\x83\xa8\x33\x88\x3a\xbf\x6e\x51\x27\x04\x8c\xf5\x15\x73\x3e\x86\x28\xc9\x46\x5b\x6f\x1f\x71\x50\x85\x51\xee\xdf\x6e\x11\xbe\xb5\x67\x0a\xd8\x8f\xda\x60\xe8\xc4\x16\x98\x73\xf8\x3d\x06\x5f\x54\x1d\x9e\x4d\x14\x0f\x52\xc5\xa2\x58\x69\xa3\x34\x2c\x02\xbd\x63\x42\x9d\x61\x5d\xcb\x2d\x7d\x83\x5d\x45\xc6\x61\xd8\x71\x15\xfe\xd1\xa2\xb4\x78\x7c\xf5\x82\x3a\xd9\x22\x8d\x32\xbe\x5e\x70\x04\x48\x63\xb1\xdd\x63\x1c\xc9\x96\x71\x8e\x64\x9b\x58\x47\xb2\xcb\x78\x47\x12\xa2\xa6\xb7\x39\x31\x1f\x7d\xbc\x76\xe3\xcc\x38\xe2\xf4\xd0\x99\xa9\x65\xeb\x87\x71\xb8\xf6\x1d\xeb\x9c\xb7\x2f\x9c\x01\xb0\x97\xc5\x51\xb7\xee\xb4\x82\xe2\x83\x2e\x3d\xf6\x39\xd4\xc6\x8d\x78\x7d\xd5\xbe\xd9\x48\x70\xff\xe7\x05\x96\xd9\x81\x53\x37\x76\xb1\x51\x5e\xb1\x18\x4e\xf0\x70\x82\xdb\xbe\xff\x9c\x27\x18\xe3\x8a\xbb\x84\xbd\xd7\xe5\x6a\x74\xe2\x91\x5f\x4a\xa6\x56\x44\x2e\x59\x14\x4f\x03\x45\x80\x35\x4f\x5d\x44\x8a\xb3\x39\xb4\x97\x65\x9f\x90\xe7\x83\x45\xe3\xfc\xb3\x95\x8c\x20\x43\x6c\x0b\x5a\xd6\x1c\xaa\x9e\x04\x8c\xd0\xf2\x40\xf7\xc4\xcb\x52\x11\x3d\x71\xd5\xc1\xaa\x3b\xa0\xef\x9f\x5c\x9e\xf5\x53\x00\xba\xf9\x77\x48\x1f\x1f\xcf\xda\xe2\x4f\x1e\x58\x20\x02\x22\xfc\x52\xef\x7f\x14\xb4\x74\x72\xcb\x56\x23\xe7\x12\x76\x75\xcd\xfd\xc3\x18\xd9\x50\x2f\xc6\xd9\xb6\x08\xc4\x26\x00\xf5\xa0\x8a\xfd\xb4\x6a\xbc\xda\x97\x6f\xac\xbf\xe5\x81\xd0\x95\xf8\xf6\x26\xdb\x9d\xca\x3c\xc6\x57\x0d\x15\x5c\x69\x52\x08\x9c\x03\x9c\x80\x92\x76\x3e\xa8\x38\xa0\x01\x04\x52\x03\xb5\xe8\xba\x89\xa4\xbf\x6a\x88\x97\x07\xec\xd6\x4b\x0d\x68\x5a\x8b\x8a\xbd\x65\xab\x43\xed\xf2\xf1\xfe\x3f\xf6\xbe\xf5\x39\x6e\x1b\xdb\xf3\xfb\xfe\x15\x28\xcd\x07\xd9\xb7\x5a\x2d\xdb\x3b\x9b\x99\xca\x66\x53\x25\x4b\x72\xa2\x1d\xc7\xd6\x75\xcb\x4e\xdd\xbd\x75\x6b\x0a\x22\xa1\x6e\x8c\xd8\x00\x43\x80\x2d\xf7\x4e\xcd\xff\x7e\x0b\xe7\x1c\x80\x20\xfb\x41\xb2\x9b\x7a\x38\x91\xbe\x24\x66\x13\x20\x9e\xe7\x7d\x7e\x47\x2b\x33\x93\xb9\x47\x51\x07\x4a\x40\x27\x97\x7d\x01\x57\xb9\xef\x02\xef\xfc\x85\x1a\xb1\x0f\xda\xba\xff\x9c\x43\xd4\x0c\x1a\xf2\xb4\x30\x1f\xb4\x85\x27\x0f\xbe\x58\x38\xdc\xbd\x97\x8a\x6c\x78\x12\x2c\x70\x18\xdd\x05\xb9\x10\x3e\x1a\x03\x96\x84\x1c\x90\x61\x59\xa5\x61\x17\x8a\xe9\xc2\xaf\x89\xf5\xb0\xbb\x86\xba\xf0\x36\x97\xc8\x60\xba\xa6\x0f\x5a\x4a\x5d\xd4\x56\x72\x4b\x77\xc1\xf6\x2a\xfd\x2f\x60\x93\x01\x63\x75\x08\x21\x01\xf0\x58\x6e\xc5\x54\x26\x6c\x2e\x8a\x29\x64\x5e\x26\xb3\xdd\x37\xa8\x3f\xdd\xc6\xbf\x9d\xa8\x77\xfc\xe1\xde\x27\x03\x58\xdd\x7b\x08\xe2\xd9\x97\x61\x62\x2f\xc8\x22\xe6\x3c\x77\x87\xe2\x9f\x8e\x13\xc0\xbe\xfc\x0b\xc0\x9e\xcd\x98\x9d\xf8\x0a\x9c\xf1\x6f\x64\x68\x8b\xbb\x71\x3d\x38\x39\xfe\xb7\x52\x2e\x78\x26\x30\xb4\x8d\xab\x80\x8b\xa9\x6f\x56\xd8\xf4\x88\x10\x9f\x1d\x95\x0a\x8e\x93\x83\x5b\xb1\x3c\x18\xad\x1c\xa4\x83\x0b\x75\x50\xa5\x3f\xd7\x8e\x4e\x60\x68\x60\x53\x3f\x80\xdf\x0e\x86\xe6\xec\x8f\x24\xce\xef\x70\x4a\xc8\x08\x74\x9a\x71\x63\xfa\x65\x8e\x6e\xc6\x1f\x9b\x44\x7d\x56\x19\x3c\x14\xb0\x98\x60\x40\xd4\x70\xb6\x2a\x88\xa3\xef\x1f\x5c\xd3\x6b\x95\x16\x54\x3e\xa4\x3b\xf4\x41\x93\xaa\x86\x0e\x42\xa2\xc4\x5d\x9c\x6b\x56\xf9\x24\x37\xac\xd7\x17\xf0\x7a\xe8\x9b\x18\x2f\x51\x1a\x50\x71\xa5\x4f\x9d\x50\xda\x32\xa9\x92\xac\x4c\x11\xe7\x11\x9a\x82\x82\xdc\x57\xa4\xdf\x61\x71\xf6\x38\x3c\x5f\x42\x07\x5e\x1e\xf1\xde\xcf\x95\x98\xd5\xa6\x9b\x0a\x5c\x83\xc1\xe3\x83\x6b\x35\xf4\x5c\x6f\x5a\x22\x04\xeb\x70\x96\xa7\x75\x19\xe3\x9d\xbc\x2e\x04\x3b\x9d\x71\xa5\x44\x16\xe5\x8b\x92\x21\x23\x94\x70\x02\xc1\x83\x0a\x37\x1d\xd6\x2b\x37\x79\x3a\xa6\x42\x76\xf2\xe0\xd5\x6b\xbf\xed\x42\x4a\x83\x55\xc2\x26\x54\xc3\x99\xbe\x63\xa9\x66\x77\x80\xe5\xbf\x70\xec\x08\x3c\x91\xc6\x33\xb2\x68\xa4\x10\x1b\x90\xe8\x79\x5e\xe8\xb9\x34\x3e\x02\x9c\x36\x6e\xd0\x04\xcb\xac\xec\x80\x9b\xb3\x09\x70\xe5\xdd\x29\xb3\xbc\x98\x0a\xeb\xba\x61\xaa\x9c\x5f\x8b\xce\xe9\x9f\xf7\x01\xd8\xf5\xd4\x2b\x44\x0d\x5b\xe4\x09\x97\xfe\xd7\x5f\x3f\xf4\x2e\x05\xbb\x6e\x07\xef\x74\x91\xa5\x77\x32\x45\xa7\x97\x61\x2f\x5c\xc7\x2f\x9f\x7e\xdd\xd6\xbb\x3b\x99\xee\xb7\x00\x3e\xb2\xc7\x2d\x00\x83\x15\xa0\xca\x45\x12\x30\xa5\xe1\x03\x2f\xd9\xb9\xc4\xdc\x18\xf7\x2f\x44\x6d\x99\x5f\x4b\x55\x65\x61\x85\xcd\x00\xba\xea\xee\x83\xd7\x26\x8c\xb0\x98\xd5\x00\x89\x01\xda\xce\x98\x91\xf3\x32\xb3\x5c\x09\x5d\x9a\x6c\xd9\xf9\x58\x3c\xce\x22\xdf\x64\xe2\x2b\x9e\xe2\x3e\xfc\x2a\x34\xaa\xf3\xad\x29\xe6\x7e\xf9\x35\x5f\x61\x5c\x55\xb8\x50\x7a\x1c\x98\x58\x48\x96\x11\x5f\x45\x42\x91\xad\x79\x56\x4e\x65\x4b\xf0\xfe\x1f\x0c\xe2\xbb\x02\x51\x2e\x8d\xa8\x32\xdb\xbb\x16\x31\x79\x3c\x44\xee\x7b\x65\xd6\x57\xeb\x61\xb7\x53\x91\x0b\x95\x02\x22\x58\x74\x56\x71\xb8\x83\xae\x15\xa1\x6b\xed\x4e\xa1\xce\xbf\xda\x82\x3b\x72\x33\x87\xa4\x4a\x02\xeb\x92\x37\x8c\xab\xee\xa4\xe3\x69\xa0\xe0\xb2\x3f\x1c\x8f\xbe\xf7\x22\xc9\xfb\x61\xaf\x23\x15\xa5\x63\x6f\xea\x01\xab\x6b\x30\xd2\xe9\x2b\x71\x64\xe9\xbe\x58\xe9\x66\x0d\x3c\x74\x63\x54\xcf\xc5\x23\xbf\x09\xe0\xf4\x1b\xc8\x49\xed\x03\x27\xf4\x0e\x5b\x34\x34\x5b\x7a\xd8\x2c\x46\xbc\x45\x93\xa5\x73\x1b\x91\x74\xc0\xee\xa4\x8e\x28\xaf\x86\x19\xb7\x96\x55\x08\x57\xa9\x54\x1b\xb1\xba\x0f\x3c\x6c\x6e\xb9\x11\xb6\x9b\x55\x63\x35\x2c\xcd\x73\x7a\xec\x05\x01\xd8\x21\x20\xda\x27\x66\xb2\xa3\x1f\x49\x26\x50\xb5\x37\x9d\x34\xe0\x17\xc4\x23\x0e\x89\xe0\xa6\xc5\x3e\x52\xb7\x0d\x09\xb7\x9d\xab\xc5\x74\xa2\xf7\x34\x82\xcf\x9f\x7b\x97\x14\x75\x4d\x1a\x33\x1e\x07\xbc\x81\x52\xc9\xdf\xca\x58\x52\x07\x6c\x86\x30\x47\x7a\x7f\xa8\x89\x4c\x13\x51\x99\x88\xce\xa4\xb9\xed\x03\x9a\xf5\xd3\xe9\x79\xbd\x71\xfd\xc0\xff\x74\x7a\xce\xe8\x69\x27\x2b\x4e\x1f\x33\xce\xbe\x98\x4e\xd3\x44\x54\xa6\xd1\x54\x9a\xdb\x07\x2f\xd8\x9d\xa7\x1f\xda\xe2\x8c\x1f\xda\xca\xe4\x71\x45\x22\xf0\x9b\xa5\x2e\xd9\x1d\x65\xd2\x93\x50\x7b\x25\xf3\xef\xd9\xb9\x32\x65\x21\x2a\xef\x67\x53\xbe\x75\x9c\xf4\x29\x15\xf6\xde\xeb\x6c\x3c\x65\x33\x57\xce\x0b\x0b\x92\x6d\x6f\x1c\x31\x80\xca\xa7\xc6\x7e\x0a\x2d\x5b\x7f\x71\xe3\x63\xd0\x46\x94\x25\x1c\xc0\xb6\xfc\x4b\x6e\xb3\x23\x60\x8c\x78\x7b\xdf\x05\x68\x1a\x76\x9c\x8a\xc5\xb1\x49\xf9\xeb\x11\x7c\xc6\xa7\xb2\xda\xda\x98\xb8\x61\x07\xaf\x0f\xc6\x6c\x22\xe7\x32\xe3\x45\xb6\xac\x61\x03\x57\xef\x39\x16\xe0\x3b\x04\x67\xd6\xab\x03\xf6\x42\x17\xd0\x73\xc2\x15\xcb\x84\xcf\x93\xa1\x0b\xb5\x44\x11\xf0\xe5\x43\x53\x11\x76\xaf\x36\x42\x24\x28\x7d\x8f\xc1\x67\x64\x37\x35\x18\x94\xb3\x8a\x62\x4b\xe5\xc8\xf8\x98\x7d\x5e\x57\xfa\x1a\xee\x86\x7f\xe3\xb1\x96\xf2\x5e\x75\xb3\x3d\xeb\xe6\xaf\x28\x74\x8f\xb7\x4c\xed\x5a\xdd\x54\xda\x4f\x22\xd7\xbd\x04\x00\x6c\xd2\xb0\x84\x49\xeb\x1e\x68\x23\x01\x2f\x93\x5b\xa8\x3e\x5b\x58\x99\x94\x19\x77\x32\x31\xda\xc1\xc6\xec\xec\xfc\xf2\xd3\xf9\xe9\xc9\xd5\xf9\xd9\xf7\xcc\xf7\x24\x63\x69\x6d\xcc\xae\x62\x14\xa1\x28\xe4\x95\xa0\x5a\xc2\xb7\x46\x44\x7c\xb8\xaa\x60\x08\x01\x1b\x82\x2b\x76\xa1\xa4\xad\x50\x7a\x31\x48\x2b\xd3\x8a\xc2\xae\x5c\x6b\xb2\xc3\x4d\x25\x86\x4e\x28\xea\xcc\xfd\x5c\xef\x0d\x6e\x07\x22\x7e\x86\xa1\xb4\x68\x71\xf7\x20\x39\x54\x8b\x3b\x94\xec\xee\x81\x39\x7b\x5e\x8f\x2b\x34\xb0\x57\xd8\xa8\x48\xf1\x03\x1c\xb8\x47\x45\x59\x53\x28\x99\x39\x5e\x72\x38\x3e\xf4\x82\x42\xb6\x02\xfd\x1e\x3a\x8d\x81\x9f\xea\x67\x6b\xcc\xd8\x47\x1f\xc2\x0c\x59\xab\xeb\x51\xe4\x11\x4a\x20\xc2\x22\x6f\x9c\x50\x5f\x1a\xa0\xbc\x8e\x3f\x4a\x48\x51\x53\xb9\x10\x0a\x27\x36\x2c\x41\xf2\x9f\xef\xb9\xe6\x9f\xaa\x71\x7f\xfe\xf4\x7e\xd8\x21\xe1\x3d\xeb\x39\xa0\x53\x3d\x9f\x23\x7e\xd0\x2c\x64\x9f\x55\x09\x64\xe1\xb6\x0f\xa6\xb0\x20\x12\xd2\x4d\xcb\xa1\x6e\xd0\x29\xdf\xa8\xa1\xa0\x84\xc7\x14\x8d\xaf\x2a\x39\xb5\x3f\xcc\x2f\x81\x6e\x19\x0f\xa9\x41\x24\xfb\x38\x8c\xf8\xf8\xd3\xf9\xc9\xd9\x2f\xe7\xe3\x79\xfa\xe0\x24\x43\xa8\x34\xd7\x52\x59\xd3\xae\x96\xb4\x15\x35\xe9\x4e\x56\xc2\x47\xfb\x72\xdd\x73\xdf\x30\x0e\x71\xf0\xbd\x45\x58\x65\xa9\xb0\x5c\x66\x26\xda\x47\xab\x73\x9d\xe9\xe9\x7a\xcc\xdf\x1e\x1b\xf4\x27\x44\x1e\x39\xe2\x47\x6e\xe7\x87\x95\xd7\xbb\x94\x6a\xa8\xaf\x87\x2f\xcd\x00\x18\x83\x61\xae\x41\x0e\x86\x8a\x0a\x4f\x74\xba\xf7\x22\x78\xad\xac\x01\x6a\x83\x70\x89\x3d\x8c\x5b\x85\x8b\x16\x95\x49\xe9\x2a\x91\xdd\xf7\xd2\xb5\x0b\x63\x8e\x06\xb5\xd7\xc2\xa9\xaf\xd9\xcf\xd4\xa6\x4e\xe4\xf2\x42\x1c\x05\x20\x1f\xa8\xde\xa1\x8b\x88\xbb\xc6\x34\xcf\x1b\x5e\xbc\x99\x06\xdf\xca\x96\x4d\x03\x4c\x25\xfb\x04\xab\x15\xe6\xa1\x67\xd9\xb2\x82\x06\x24\x55\x98\x4f\x11\xa0\xa7\x20\xfb\x6d\x5e\xc8\x85\xcc\xc4\x14\x40\x40\xa5\x9a\x46\xb5\x14\x7d\xc6\x3a\x80\xc3\x8b\x95\x71\xb9\xad\x32\x36\x86\x7e\x86\x73\xf1\xe1\xe3\x15\x00\xcb\x82\x53\x70\x6f\x01\xdb\x7d\x10\x0a\x8d\x1c\x1d\x1d\x81\xde\xff\xe2\x1f\x4e\x56\x4c\xb3\x97\xec\x57\x41\xdf\xd1\x00\x7e\x5b\x40\xb5\x99\x99\x0e\xe8\xa3\x30\xd6\x6a\x65\xe1\x38\xa2\xd3\x9c\xde\x3a\x76\x6f\x3a\xc1\x08\xd9\x4d\xed\x7d\x28\xae\x89\x70\x7e\xe8\xef\x79\x78\xb9\x72\x40\xd2\xbf\x33\x95\xf3\x56\xd1\x75\xe7\x33\x78\x64\x72\xa2\x87\x9c\x99\xe5\x3c\x93\xea\xb6\x42\x8c\xba\xd1\xee\x0c\x61\x8c\xbe\x54\xb7\xfe\xc4\x16\x82\x67\x9b\x29\xe5\x2e\xe7\x63\x50\x2a\x69\x77\x30\xde\x5d\x2d\x73\xf4\x85\x87\x6b\x4f\xae\xde\x98\xc4\x1d\x1c\x3c\xb9\xf9\x4a\xd3\xaf\xd2\xfa\xe1\xc5\xe4\x74\x52\xab\x12\xea\x74\x3a\x78\xf6\x90\xc6\xe5\x4d\x2c\x01\xa6\xf3\x88\x92\x9d\xfc\xad\xcd\x53\x7b\xc4\xb2\xb2\xfd\x1d\x0c\xf3\xb9\xd4\x85\xe5\xd9\x40\x44\x20\x99\xf1\xfc\xa4\xb4\xb3\x33\x69\x12\xbd\x10\xbd\x55\x9d\xbb\x19\xa2\xf6\x7a\xc0\x38\xe9\x37\x1d\x7b\x63\xa7\x3f\x9f\x5c\x32\x5e\xba\x5d\xb4\x04\x2b\x39\xa8\x8b\xdb\x8f\x7f\x82\x01\xf5\x83\x8c\x9e\xfa\xba\xf7\xb1\x3f\x3b\x04\x06\x74\x08\xc0\x1d\x7f\xca\x4e\x00\xa9\xa4\x95\xdc\xea\x8e\xb5\xac\xea\xfa\x7b\x69\xac\x9e\xd3\xf1\xbc\xf0\x1d\x81\x57\x16\x18\x6e\xad\xef\x3a\x46\x3f\x08\xda\xb0\x38\x17\xca\x89\xc5\x3c\x11\x8d\x08\xc0\x11\x20\x37\x62\xdf\x32\xbc\xf3\x03\x45\x66\x02\xe4\x53\xf6\xe3\xf7\x35\x24\xed\x95\x42\x08\xde\xa8\x50\x81\xeb\x0f\x6a\x89\x91\xbf\xf5\xbd\xd9\x64\xf6\xc2\x59\xfd\x7b\xc9\x33\x5c\x8d\x0f\x43\xdb\x88\xea\x2b\xdb\x73\x90\x7e\x3f\xfd\x9a\x7f\x08\x5a\x73\x69\x10\x2d\x0a\xdf\xb0\x05\x57\xc6\x6d\x44\x5d\x37\x3a\x24\xd7\xce\x21\x7b\x61\x93\xbc\x73\xb9\xf6\x7b\x8a\xcc\xc6\xa1\xd2\xba\xbf\x0f\x11\xd9\x5d\x47\x75\x2f\xde\x16\x38\xbb\x7d\x4d\x1b\xb5\x89\x20\xb3\x65\xef\xa5\xb1\x1e\x16\x1f\x1e\x48\x43\x98\xae\x20\xe9\x5c\x3a\xd5\x49\xe6\x7f\xe7\x69\x5a\x7c\x8f\x9c\xc4\x97\xd4\x2d\x40\xde\xf1\xb8\x4b\x5c\x05\x7f\xdc\x0b\xbb\xcc\x09\x9a\xed\xea\xf4\x92\x61\x55\x8c\xbf\x7e\x87\xe5\x3c\xff\xe7\x9b\xef\x5e\x75\xde\xd0\xc7\x0b\x7f\xde\xd1\x72\x30\xb8\xc7\xe6\x49\x44\xcd\x81\xb8\x80\xf1\x72\x40\x0f\xe9\xee\xe2\x39\x72\x9b\x1a\xa8\xf4\x6e\x42\xc5\x73\x84\xd9\xa3\x46\x98\xb1\x90\xf4\x80\x34\x61\x7f\xaa\x82\x04\xe5\xf2\xe9\x11\x94\xd6\xb5\x68\x3f\x35\xf5\xd3\x82\xf7\xd7\xe9\x77\x91\xf7\x09\x62\xae\xcf\x3e\x4c\xfe\xfe\xfe\xe4\xed\xf9\x7b\x18\x25\xc5\x55\xb9\x63\x20\xd5\xce\x71\x44\xdd\x8f\x55\x17\x4d\xb0\x7d\x31\xfa\xf9\x39\x3e\xbc\x9b\x34\x14\x65\xf7\xa4\xa7\x73\x63\x5f\x69\x59\xdd\x74\x9a\xfb\xc3\x9a\xae\xa0\x6c\x84\x28\x86\x4b\x71\xd8\xd9\xc2\x15\x41\x32\xd5\x94\x21\xb7\x53\x38\xc2\xbd\xf5\x95\xd6\x1d\x60\x4f\xc0\x88\xef\xe6\x8b\x6b\x30\xb8\xf9\xfe\x9e\xd6\xaa\x2b\x8b\x2f\xfa\xe7\xbe\x1c\x4e\xa0\x95\x77\xf2\xb8\x4b\x8a\x11\x39\x85\xa3\xd7\x8e\x52\x0b\x13\x40\xee\x9f\xe8\x49\xc9\xd7\x21\xe2\xf6\xa1\x5e\x6b\x21\x75\x6b\xf5\xa0\x6a\x8e\x8d\x5a\xc6\xc0\x26\x0c\x69\xef\xdb\xe7\xa4\x5e\x9a\x9c\x27\x83\x22\x3f\x56\x8f\xf0\x09\xa4\x54\x3f\x3c\x01\x84\xcf\x0e\x18\x50\x1a\xfa\xeb\x7b\x90\x4f\x7d\xc3\x66\x22\x57\xaf\x1d\xf2\xf5\x14\x72\xed\x93\xe4\xe2\x8c\xaf\x47\xde\x3e\xf6\x20\xd4\xf3\xd7\x1d\x55\x97\xa1\xd5\x96\x7c\xa6\xad\x56\x3b\x07\x89\x5f\xae\x69\x5e\xbf\xc7\xf8\xc6\x69\x55\x24\x24\xaa\xd0\x07\x11\x86\xc1\xa0\xef\xc4\x38\xcf\x25\xb4\xf2\xa6\xfd\xba\x61\xff\xc1\x25\x8f\xf4\xe2\x6c\xa0\x3b\xf7\x2d\x25\x1f\xf6\x35\xc1\x0e\x1a\x42\x91\xf6\xce\xb8\xb8\x38\x23\xb9\xcb\x67\x55\x18\x3a\x76\x6c\xf3\xb9\x1b\x8c\x2f\xea\xc2\xde\xe9\xa2\x7f\xaa\xf1\x65\xad\x61\xc3\xab\x4f\xbf\xad\x64\x13\x3d\xc5\x3b\x82\x63\x7c\xe4\x7b\x32\x01\x87\x69\x03\x2b\xba\x79\x33\x42\x14\xfb\x3d\x5c\x9e\xc7\xbd\x34\x3b\x72\xa1\xfb\x4d\x49\x1d\x54\xf0\xf6\xa7\xac\xe7\x0c\xbf\x50\x33\x32\x10\xb8\xbd\xa9\x88\x04\x0f\x97\x90\xba\x1f\x8c\x28\x14\x1a\xeb\xf6\xf5\xa0\x07\x17\x56\xcc\xb1\xc0\x2f\xcf\x32\xb7\x96\x5a\xc5\xb0\xc1\x94\x76\x3a\x62\x88\xbc\x3b\xe7\xb9\xaf\x96\xac\xef\xd4\x1d\x2f\x52\x76\x72\x79\x31\xcc\xd5\xef\x11\x5a\x8c\xe7\xa7\x1b\x12\x54\xbd\xac\xa2\x4e\x05\xbb\x96\xd6\x54\x05\xcf\x84\x8d\xb5\x41\x47\xde\x82\x8f\xc8\x5d\x52\x77\x21\xe9\x7b\x11\xf7\x53\x4c\x27\x96\x67\x8d\x02\xf4\xaf\x5e\xbd\x42\xe3\xd5\xab\xbf\xfc\xe5\x2f\x58\x84\x26\x15\x89\x9c\xaf\xbe\x08\x6f\xfd\xaf\xd7\xaf\xc7\xec\x3f\x4e\x7e\x79\x0f\x05\xf1\x72\x6b\x10\xee\x02\x7b\xc6\x92\xdc\x51\x63\x33\x62\xff\x77\xf2\xf1\x43\x55\x4a\xa3\xfe\x2b\x55\x33\xa6\xe9\x8d\xd9\x59\x14\x02\x14\x9b\xa7\xb8\x9d\x51\xed\x17\xcb\xf8\xcd\x0d\x96\x79\xbc\xf6\x55\x46\xf1\x4a\xf9\xcc\x66\x28\xc9\x8c\x35\x1a\xdc\xf6\x67\x10\x9b\xe4\x14\x69\x34\xe6\xf9\xe4\x7a\x0c\xb5\x82\xbe\x02\xfd\x83\xa1\x8c\xb0\xa8\xf7\x8d\x81\x4a\x0d\x15\x14\x5c\x21\x8c\x93\x29\xa9\xf4\x1c\x76\x16\x86\xee\x06\xf1\x98\x3e\x98\xce\x15\x04\x6a\x07\xcb\x03\xd7\x56\xd5\xc1\xff\x81\x6e\xc5\xb6\xe0\xd8\x7b\xf2\x89\xd4\x79\x7e\x18\x0d\xee\x15\xa5\xac\x07\x72\xc1\x78\xa6\xa1\xca\x51\xd8\xda\x8a\x1f\x45\x55\xc6\xdb\xa7\xd2\x1b\x79\xaf\x2f\xfa\x2a\x52\xa1\x5f\x78\xe7\x1a\x27\x75\x93\x76\x94\xda\xcf\xaf\x75\x69\xbd\x0b\x18\xfb\xc4\xf2\x7e\x58\x63\xba\x07\x72\xe0\x0e\x60\x83\xbb\x80\xce\xf6\xc6\xad\xac\x93\xf9\x9a\x10\x30\x62\x82\x27\x33\x76\x2b\x96\x47\x48\x98\x72\x0e\xd9\x28\xa1\x8a\x14\x61\x3b\xd6\xfd\x25\x89\x48\x9d\x64\x4b\x8b\xe5\x3d\xea\xd5\x29\x0a\xd9\x2c\x5e\x7c\x34\x24\xe9\x10\x66\xa4\x8a\x14\x78\x0f\x4c\x1c\xd5\x61\x0d\x20\x91\x58\x84\xb9\x9e\x75\xe1\xee\x97\x48\x5d\x33\xb3\xed\xcb\x55\x18\x81\x23\x74\xc4\xaa\x4a\xb5\xd2\x9a\x8a\x0e\x93\xd8\x06\x1f\xe4\x1e\x8a\x37\x0a\x45\x80\xd2\x66\x54\xce\x86\xde\xf5\xab\x14\x16\xa2\x96\x15\x62\x84\x2d\x69\x69\xb0\x6e\x52\xa9\x32\x61\x0c\x93\x30\xc3\x39\x2f\x6e\x85\x07\x25\xe1\xd9\x98\x5d\xba\x41\x06\xe4\x23\xc4\xc0\x5d\x60\x18\x99\xbb\xa3\x71\xba\x8b\xfb\xc8\xe1\x78\x7c\x88\x14\x7c\x4d\xf2\x4b\x8f\x93\xb1\x1b\x80\xea\x0e\xc0\xa9\x8d\x92\xc6\xb9\x41\x18\x58\x27\xb5\x01\xcc\xb1\x86\x2c\x2e\x3b\xf3\x1c\x8a\x77\x86\xdf\x59\x9d\xce\x0e\x68\x9f\xbb\x82\x54\xef\x02\x51\xdd\xc9\x9d\x50\xff\xdb\x1d\x9a\x7a\x27\x60\xea\x95\xda\xca\xb4\x45\x74\xcd\xfa\x23\xf5\xee\x01\xa4\x3c\xef\x05\xf2\xe9\xff\x36\x61\xc2\xcc\xbb\x48\x7d\x54\xad\x2c\x13\xdf\x94\x98\x77\x71\xb3\xae\xd6\x96\x4f\x77\xab\xe4\xe4\x40\x34\xdd\x0a\x3c\xbe\x7c\xd7\xaf\x3a\x07\xeb\x2d\xf0\x35\xff\xfa\x08\x80\xcd\xbf\x6e\x4e\xb9\xe6\xdf\xca\x6d\x0a\xd4\x3d\x8f\x42\xd2\x61\x29\xad\x06\x24\x66\x1b\xae\xdc\x18\xca\xbf\x13\x8f\xe2\x4e\x56\x31\x3a\x2b\x6d\x48\xcb\x59\xc3\x1a\xa0\x53\x8f\xdb\x8c\xc9\x90\xfe\xb5\x88\x51\x00\x8b\x44\xfa\xdb\x97\x67\xe0\xdf\x4e\x57\xba\x6f\x85\xb1\xdf\x6d\xe0\xc6\x1e\x6b\xe8\x65\x86\x9d\xd7\x71\x42\x68\x08\x3e\x82\xb8\x26\xc3\x40\xf0\x86\x35\x28\x20\x79\x71\x84\x2a\xf5\xf4\x9e\x59\x37\xc3\x0a\x0d\x91\xac\x08\x27\x97\x17\x03\x4a\xf4\x51\xaf\xbf\x6b\x99\x1e\x4c\x37\xb5\xba\x29\x67\xd5\xcc\xc9\xc0\xeb\x28\xcc\x93\x17\x0d\x57\x86\xfd\xce\xd1\xc5\xc8\xac\xda\x00\x65\xa3\x12\xee\x81\x82\x46\x40\x6e\xde\xc1\x07\xf7\xf5\xa9\x8b\x91\x0f\x28\x12\xc2\x7a\xf4\x2a\x00\xed\xff\x56\x4b\x90\xc1\x64\xd9\x04\x6a\x93\xa0\x8e\x17\x29\x8b\xb9\x4e\xbf\xa7\x52\xb9\x4a\x69\xac\xfa\x65\x46\x58\xdc\xc4\x8c\x50\x09\x74\x82\x42\xe4\x96\x2d\x22\x03\xf8\xce\xa2\xc1\x4e\x65\x6a\xf6\x29\x54\xe3\x36\x10\x66\x7e\xd9\x77\x17\xd9\x9e\x75\x67\x58\xc4\x85\x76\xab\x64\x51\x37\x56\x63\x4f\xa1\x8e\x75\x32\x13\x73\x8e\xa0\x70\x7e\x7a\x8e\xca\xdc\x15\xd2\x5a\x81\xa8\x3e\xa2\x98\x1b\xa6\x6f\x46\xb5\x0a\x71\x07\x8b\xd7\x07\xbb\xd4\xf3\xd8\xb3\xe4\x0a\xab\x76\x61\x80\xc5\xb8\xac\x49\x67\xee\x5c\x83\xba\x90\x01\x92\xa3\x6a\x18\x19\x1c\x83\x59\xe0\xea\x3d\xf8\xc4\x1f\x53\x45\x1a\x05\x21\xe1\x59\x45\x7a\x56\x91\x06\x51\x91\x22\xc6\xe2\x09\x0e\x2d\x54\xac\x36\xc5\x88\x52\x5e\x77\xaa\xb2\x7a\x22\x94\x18\x77\x34\xbd\xd6\xa4\x8b\xba\x15\xcd\xa9\x3e\x87\x5e\x97\xa2\x73\x5c\xda\x9b\xa3\xbf\x32\xa1\x12\x9d\xe2\xe6\xbb\xfe\x0b\x63\x41\xb4\xa9\xd4\x8f\x78\x2c\x73\xff\xad\xd8\x12\x07\x7d\xef\xba\x75\x3b\xd1\x01\xef\xab\x7b\x37\x10\x83\xaf\xd8\x7a\x48\x82\xa5\xe9\x87\x1c\x79\xe2\xef\x95\x97\x10\x6b\x01\xc3\xe1\xf6\x65\x4e\xd9\x0b\x7c\x38\x4e\xf2\x72\x44\x2f\x8c\xe7\x62\xae\x8b\xe5\x28\xbc\xe4\x7e\xac\xb5\xa2\x37\x5e\x82\x4c\x90\x94\x85\x53\xf6\xb2\xe5\xb7\x2a\x1d\xf8\x05\x7a\x60\xe1\x20\xec\x53\xbf\x6a\x30\xf1\x5f\x23\xfc\x2e\x00\x5d\x81\x2a\x5f\x55\xc7\xb9\x09\xe0\x7b\x66\x14\x54\x54\x78\x2a\xd4\x82\x2d\x78\xd1\xa3\x74\x75\xfc\xb7\xa7\x3c\x90\xca\x85\x34\xbb\x15\xac\x5b\xab\x35\x4b\x82\xf5\xd2\xa5\xcd\x4b\x4b\x94\xd2\xdf\x0a\x9f\xea\x1d\x6e\x43\x43\x28\x7a\x7d\xb0\xd3\x30\xbe\x99\xa2\xb0\xf8\xb7\x63\x69\x58\xfc\xdb\xb7\x40\x6c\xbd\x97\x9d\x8f\xcd\xa0\xe5\x9e\xfd\x9f\x3f\x16\x43\xdc\xc3\x8a\x45\x56\xf8\x04\x5e\x38\x7d\xa0\x8b\x86\xf1\x20\x03\xda\x6a\x08\x08\xfd\xf7\x6c\xa6\x19\xc8\xf5\x4a\x99\x7a\x7f\x70\xbf\xeb\x84\x30\xf1\x9f\x9d\xae\x9d\x0e\xdf\xb3\xd3\xf5\xd9\xe9\xda\xf5\xef\xd9\xe9\xfa\x6c\x51\xa8\xff\x7d\xd3\x16\x85\x67\xa7\xeb\xb3\xd3\x75\xbf\x35\xbc\x17\xa7\x2b\x89\x71\x95\xc7\xf5\x41\x1d\xae\x54\xd6\xe5\x24\x49\x74\xa9\xec\x95\xbe\x15\x9d\x3d\x08\x9d\x84\xf9\x95\xde\x1f\x4e\xb2\xef\x2f\x58\xf4\x12\x0f\x76\x11\x0c\x78\x99\x4a\x27\xbc\xef\x7c\x80\x4e\xa8\x03\x2f\xa7\x3b\x52\xac\x52\x91\x86\x9e\xfd\x25\xb5\x6e\xad\xc7\xec\x84\x15\x22\x91\xb9\xa4\xea\xdd\x1c\x9f\xe3\x09\x0b\x28\xfb\xd2\x1a\x91\xdd\x10\xda\xb9\x8a\x8b\xc2\x54\x22\x38\x51\xb8\xb5\x9f\x41\x9e\xa3\x3d\x48\xb6\xaf\x90\x53\x88\x7f\x78\x66\x45\xa3\xb9\x8a\x7b\x88\x8d\x22\x30\x95\x5a\x2d\x1a\xf8\x58\x2e\x29\x03\xf9\xbe\x2f\xb6\xf8\x9a\xcb\x02\x0e\xef\x44\x24\x5a\x75\xa9\x88\xb9\x61\x83\xce\x9b\x3d\xf9\x9d\x22\x8b\x26\x16\xc0\x0f\x75\x2f\x17\x3c\x93\xa9\xb4\xcb\xe0\x6b\xa3\x2a\x4b\x1c\x6f\x4c\xd8\x46\x53\x2d\x23\xe3\x79\x5e\x68\x9e\xcc\x84\x89\xc6\x8d\x22\x07\x25\x62\x85\xa8\x73\xac\x04\x06\x52\x07\xb4\x71\xac\x2f\x5b\xb2\x42\x5b\xef\x2e\xdf\xf0\xc1\xab\xa8\x33\x68\x8e\xfc\xcb\x16\x4b\xf0\xa9\xeb\xb8\x0b\x1c\x95\xbc\x89\xff\x61\x98\xce\x52\x8f\xef\xf1\xd7\x57\x4e\xcc\x4b\xe8\x0c\x3a\x2a\x07\x08\x10\x56\xb3\xcc\xb1\x62\x47\xf9\x36\x37\x7e\xf3\x67\x36\xd3\x65\x61\xc6\x71\x92\xd0\x6b\x78\x86\x2a\x9a\x17\x13\x2d\xcb\x04\x37\x96\xbd\x7e\xc5\xe6\x52\x95\x8e\x03\xf5\x3e\x36\xfd\x25\x9b\x48\xa6\xf9\xee\xcf\x9d\xdb\xf5\x95\x66\x56\x3d\x92\x74\xaa\x72\x44\xe2\x25\xa1\x86\x6e\x12\x26\x97\x21\x8e\x75\x43\xc4\x21\xa2\x1b\xaf\xb6\xb2\xfa\x1e\xee\xd7\x6f\xa5\xbe\x5e\xda\x3e\x89\x88\xff\x8e\x2d\xea\x19\x88\xfe\x61\x17\x74\x91\x0a\x5c\x64\xeb\x47\xef\xa5\x56\xc2\x54\x1a\xdb\x52\x29\xa1\xca\x51\xdc\xfa\x5a\x77\xb6\x32\x75\xf2\x7e\xcf\xb4\x14\xd0\x11\xbc\xac\xeb\xcd\x43\x49\x22\xb0\xa6\xe1\x59\x55\x69\x47\x69\xec\xbf\xb5\xfb\x47\x06\xdb\xf2\x07\x64\x00\x8c\xee\x8e\x53\xed\x26\x5d\xf9\x23\xd1\x7b\xae\xd8\xac\x7e\x0b\x8c\x54\x53\x84\xd4\x9e\x97\x99\x95\x79\x56\xcd\x3b\x34\x20\x42\x1e\x9b\xcd\x78\x64\xe9\xe1\x98\x9c\x8b\x50\x4c\x60\x62\x7c\x11\xfa\x12\xca\x22\x32\x74\xe1\xf8\x41\xce\x0b\x1e\x16\x0f\xea\xa6\x9a\x97\x64\x81\xe3\xe0\x07\x44\xca\xe3\xc8\x79\xc1\xb3\x30\xd1\xd8\xf7\x33\xe4\xa1\xb1\x42\x71\xd5\xc1\xc0\x5c\x57\xf5\xa0\x11\xd3\x77\x21\x04\x0c\x2b\x6c\x34\x4e\x0b\x09\x35\x6f\x79\x72\x2b\x54\x8a\xe5\x87\x60\xda\xe9\x52\xf1\x39\x41\x51\x45\x35\x95\x1b\xed\xcd\x88\x4c\x0d\x98\x29\xe7\x53\x75\x91\xeb\x0e\xb9\x06\xa5\xe9\x8d\xf5\xf2\xd9\x60\x2d\xe3\x6d\xf7\xdc\xa0\x11\xa6\x90\x8b\x44\x78\xfe\xef\x3e\x35\xe4\xd0\x17\x1d\xf2\xd1\x57\x06\x4f\xa1\x8a\x32\x3a\xbf\x40\xee\x83\xf1\x1b\x50\xa7\x78\xe6\xae\xf6\x32\xa4\x67\x36\x36\xf7\x7a\x39\x6c\x41\x95\xe2\xba\x4f\x1a\xed\xe1\xa7\xb7\x67\xf5\x4b\xfc\x89\xa7\xda\xb0\xb7\x99\x4e\x6e\xd9\x99\x00\xa1\xeb\x3e\x0b\x82\x14\xd7\xe9\x63\x02\x46\xcf\xf9\xb4\xcd\x3b\x76\xc4\xe6\x5a\x49\xab\x8b\xed\xf4\xe2\xb9\x3e\xe1\xa3\xc0\x11\x17\xd7\xe9\x93\x06\x23\x76\x07\x6c\x97\x6a\x84\x05\x5c\x43\x68\xee\xb1\xfc\x76\xbc\x54\x7f\x9a\xe9\xbb\x23\xab\x8f\x4a\x23\x8e\x64\x07\x7f\x6b\x8f\xd9\xdd\x8a\x25\x38\x99\x7b\xce\xef\x6f\xd8\xac\xa6\x1c\x58\x0d\x36\x25\x78\xee\x58\xf4\xa7\xb7\x67\x8e\x37\x8c\x63\x61\xef\x58\xd8\xe4\x38\x11\xf9\xec\x98\x3e\xfc\x24\x17\xc5\x53\x8b\xbe\xab\x72\xc2\x12\x9d\x65\x94\xef\xac\x6f\xd8\xa9\xc8\x67\xa1\xb3\x87\x9e\xe9\xe3\x41\xdd\xe6\x5a\xf7\x85\xfc\x8c\x2e\x8c\x6b\x4d\xf7\x25\x3a\x38\xc5\x75\xbf\x3a\x06\x0f\x75\x54\x9e\x74\x25\xc6\x7b\x5c\x9c\x7b\xae\xaa\x5f\xab\xa5\x1f\x87\x5e\xd6\xe1\x80\x7d\x0c\x47\x8d\xdc\x5c\xdc\xa0\x24\x9d\x8a\x94\xe9\x85\x28\x0a\x99\x0a\xc3\x02\xbd\x89\x55\x4f\x99\x3d\xf4\xba\x3d\x23\x13\x3f\x3a\x32\xf1\x0e\x3a\x4e\x44\x9e\x5c\xeb\x55\xf2\xc4\xd3\xb9\x54\x4f\x8e\x40\x99\x84\x67\xe2\xe2\x63\x0f\x65\x62\x82\x2d\xea\xfa\x84\x7f\x18\x01\x8a\xb5\xc0\x74\xfd\x2d\x9c\x17\xa6\x74\xda\x66\x1f\xbd\x07\xad\x60\xca\xad\xb8\x6b\x65\x7f\x47\x15\x81\x6a\x7f\x13\xe4\xce\xc7\xd4\x1f\x1e\x09\x1a\x2f\x3a\xe5\x88\xfb\x35\x24\xfb\xa4\x7d\xea\x6b\x74\xf1\x13\x69\x20\xc9\xfa\x83\x7a\x72\x79\xc1\x7e\xc2\x9e\x87\x45\xea\x2b\xb4\x45\xe9\xee\x4c\xcf\xb9\xec\x5d\x68\x63\x56\x2f\x4c\xed\x87\x7b\x19\xba\x65\xd8\x6f\x5c\x23\xe4\x46\x4e\x4b\xa7\x81\x91\xd6\xf4\x0c\xa2\xf6\x20\x02\x48\x25\x7f\x44\x96\x20\x1f\x71\x58\xc9\x1c\x7e\x07\x81\x29\x04\xd7\x24\x33\x42\x19\x09\x7e\x92\xc8\x59\x4d\xe5\xde\xb0\xbe\x20\x86\x17\xa2\x90\x32\x62\xef\xf5\x54\x2a\x7f\x2b\x35\xb9\xd1\x6e\xb8\xcc\xba\x2e\xc6\xb3\x54\xf1\xe8\x52\x85\x31\xd9\xb9\xe2\xd7\x59\x97\x28\x80\x3a\x59\xcf\x38\xf8\x39\x05\xb4\x3e\x4e\xa5\x71\xff\x65\x93\xc9\x7b\xb0\x89\x97\xca\xcb\xba\x60\x2f\x26\xb2\x16\x22\xfd\xf1\x02\x0e\x7b\x67\x90\xd2\xec\x80\x71\x77\xa1\x52\x37\x58\x61\x6a\x61\x27\xd4\x1f\x22\xfd\x85\xc8\x59\xf4\xdc\x5f\x0b\x76\x35\x93\xc9\xed\x65\x64\xfa\xd6\x85\x7b\xa6\xa2\x47\x35\x26\xd4\xfc\x6d\x48\x82\x48\x43\xbd\xec\xaf\xc0\x5e\x45\xf4\x7c\x42\x13\x76\xdd\x30\x6e\x8c\x4e\x64\xe5\xe7\x00\x73\x49\x45\xf0\x53\x20\xf8\xc3\x4e\x02\x78\xfa\x9e\xbc\xc9\x6f\x9a\xaf\x7a\x6a\x62\x5e\x24\x95\x9f\xeb\xa0\x03\xc7\xa3\xb1\x03\x4a\xf7\x55\x0d\x97\xdb\xcb\xa6\x0d\xa3\xbd\x8f\xe2\xa6\x4d\xf2\x52\x92\xaf\xb2\xb8\xb2\x4d\x01\x9f\x9b\x70\xf9\x06\x9b\x6a\x97\x44\x86\x75\xda\x70\xc3\x53\x87\xcf\xc8\x8c\x0f\x97\x29\xd7\x79\x99\x61\xac\xc4\xfe\xe0\xe2\xde\x3a\x8b\xdf\x19\xc8\xac\xff\x10\x40\x9b\x7d\x03\x81\x7f\x1f\x98\x9b\x91\x48\xf6\xea\xbb\x3f\xff\xf9\x5b\x47\xe1\xec\xaa\x02\xdf\x07\x0c\x67\x47\x93\xe8\x73\xa6\xcd\x73\xa6\x4d\x7c\x14\xef\x13\x46\x75\xe0\x5c\x9a\x9e\x21\xae\xfd\xc2\x5b\xbb\x67\xcb\x74\x0e\x82\xed\x1b\x00\xdb\x23\x1f\x66\xa0\x2c\x98\xde\xb1\xa0\x7d\x32\x5e\x9e\xf3\x5c\x7e\x6f\x79\x2e\xbb\xc4\x80\xf6\xcf\x69\xe9\x13\xfb\xf9\x7b\xca\x5f\xe9\x71\x19\xbb\xe7\x59\xf4\xcf\xae\xe8\x8f\x67\xd7\xdf\xb2\xb5\x4b\x49\xa3\xd8\x3e\x43\x5a\x44\x55\x41\xd0\x17\x1e\x44\x7c\x2c\xab\xdd\xc5\x7a\x10\x1d\x82\xf5\x50\xa0\xb0\x7b\xdd\xa7\x96\x20\xe9\xe4\x1f\x27\x0d\xd7\x46\x78\xfc\x38\x1e\x8d\xdf\xa7\xcb\xe0\xb9\x30\xc8\xd3\xb6\x69\x9b\x1a\xb6\x88\xb7\x24\xc0\x5d\x07\x46\xac\xaf\x63\x4c\xc3\xea\x8e\x9c\x5c\x5e\x38\x75\x19\xd2\x67\x78\x66\xc6\x6c\x0d\x9f\xf6\x76\x49\xe2\xeb\x9e\x3f\x73\x6b\xc5\x3c\xb7\xdd\x37\xfb\xd9\xa4\xfd\xe8\x26\xed\x9d\xed\x71\x5f\x42\xc3\x50\x01\xb2\x9c\x73\x75\xe4\x6e\x14\x18\xb7\x6b\x5e\xb0\x06\x09\x1e\x33\x1f\x95\x8b\x6b\xc1\x0b\x81\xa0\x4f\xf5\x8a\xb7\x3c\xaa\x7f\x78\x3f\x46\x48\xe8\x7b\xe7\x99\x23\x03\x6d\xdc\xb4\x44\xaf\x84\x7d\xd2\x74\xc2\x2a\xf8\x4b\x15\x71\xe1\x9a\xde\x6c\x67\x02\x99\xf5\x25\x24\xa2\x54\x6f\xd5\x25\x61\x14\x85\x79\x96\xe9\x3b\xfc\x76\xcc\xc0\xdc\xea\xbb\xb1\x50\x86\xd5\xb5\x60\x73\xe9\x94\x6a\x32\x7e\xc6\xc3\x41\x57\xa4\x93\xa8\x45\x81\x02\x6b\x41\xde\xac\x89\xb0\xf1\x46\x3b\x85\x54\x61\x20\xb4\xfb\x7f\x1f\x78\x83\xa8\xb8\x44\x13\xae\xc5\x8c\x2f\xa4\x2e\x0b\x6c\x6d\x35\x3b\xa0\x9f\x80\x25\x2c\x75\x19\x4c\x53\x58\x25\x31\xcc\xce\xac\x59\xa7\x0f\xd5\x8f\x20\xca\xa7\xda\xdb\x12\x8e\xc4\x57\x69\xec\xea\x5c\xfc\x12\x79\xd0\xb6\xa1\xce\xcd\xc2\xe4\x8e\x2d\xf4\xae\x88\xf6\x25\x6e\x57\x17\x4c\x16\x13\xf8\xe9\x1b\xaa\x87\xd6\x8a\x45\xfa\x2c\xeb\x0c\x2d\xeb\x04\x77\x55\x26\x93\x65\xef\x4a\x61\x95\x9b\xca\x35\x67\x6f\xb9\x11\x29\xfb\x85\x2b\x3e\x45\xb5\xec\xc5\xe4\xf2\xed\x2f\x2f\xdd\xb6\x81\xda\x77\x71\xb6\xd6\x97\x35\x89\xc7\xf0\x61\xc8\x34\x88\x95\x19\xee\xc0\x89\x7a\xce\x71\xd0\x34\x0e\x16\xb8\x49\x37\x80\xd8\xd5\xd4\xcb\x66\x8d\xc7\x06\x51\x58\xcc\xd3\x3d\xab\x3a\x4a\x65\x2c\xcf\xb2\xcb\x8c\xab\x93\x3c\x2f\xf4\x62\xbd\x26\x5c\x4f\x0c\xa7\x17\x3d\x6b\xc7\xd8\x07\xff\x30\xc7\x85\x06\x5f\xaf\x62\x17\x55\xff\x63\x76\x61\x83\x42\xac\x15\xb0\xc1\x83\x93\xd2\xea\x39\xb7\x32\x39\x70\x7a\xf3\xc1\x2f\x5c\x95\x3c\x5b\x1b\x61\xb4\x75\x1a\x9b\xc4\xba\xad\x8d\x0a\x9d\x65\xd7\x3c\xb9\xc5\xa3\xd1\x3a\xed\x4f\xb5\xd7\xfd\xe4\x1b\x4f\xf5\x4d\x3c\x65\xc3\xa6\x42\x09\xcc\x71\xf3\x11\x3d\x93\xf2\x3a\x74\x8a\xa6\x71\xb5\xac\x3d\x64\x75\xd9\x86\xcd\x28\x0b\xee\xa3\x7a\xc7\x65\x56\x16\x82\x96\x78\xc4\x8c\x76\x2c\xcf\xce\xa2\x3a\xc3\x87\xf1\x27\xe3\x91\x8c\xc8\xde\x5e\xa5\xce\xcf\xf8\x82\x0a\x97\x8b\x85\x28\xfc\xbe\xc9\x35\x03\x4a\xf5\x7a\x75\xb7\xe5\x1a\x08\x55\x6e\x70\x42\x1f\xe1\x37\x37\xfc\x16\x26\xba\xf2\xfb\x66\x38\xbb\xad\x43\xc1\x66\x5b\x25\xba\xed\xed\x2d\x2f\x1c\x37\x38\x9d\x7c\xe9\xdd\xb6\xcc\xa7\x05\x4f\x37\xc6\x52\xd4\x13\xe9\xaa\x77\x59\x2a\xac\x28\xe6\x52\x35\x42\x29\xe8\xbe\x82\xab\xc3\xed\xb2\x86\x12\xeb\xf4\x91\xd4\xbd\xaa\x60\x37\xb9\xf2\x0f\xc1\xb7\xbd\xe0\x32\x73\x72\xf7\xc8\xdd\x32\x90\xa5\xdd\x9b\x8c\xb3\x64\xc6\xd5\x14\xde\x09\xc0\x9a\xc4\x08\xbd\xa9\x14\xbf\x02\x19\x38\xd2\x9a\xb8\xe6\x38\xba\xbc\x04\x64\x95\xf1\xfa\x89\xf1\x2e\xd5\xeb\x25\xfb\xf8\xfe\x17\xc7\x0e\x69\xe0\x60\x52\xce\x85\x4a\x85\x72\x27\xd8\x7d\x28\x9e\x35\x49\xc6\xf1\x71\xfe\x48\x23\xc0\x74\x62\x89\xa6\xee\x81\x4f\x23\xe9\xfe\x1b\x7e\xfd\x45\x2a\x39\xe7\xd9\x29\x2c\xd5\xa6\x0d\xfe\x55\xaa\x54\xdf\xad\x15\x6c\xd6\xed\x31\xbd\x0e\xd2\x2e\xe6\x2d\x01\xbc\x06\x57\x20\xc7\xe3\x6f\x69\x09\x86\x4f\xbc\xb7\x81\x50\xd6\xe9\x0b\x95\xd3\x43\xea\xeb\xc4\xfe\x8f\xa5\x35\x12\x57\xd2\x1d\x84\x25\xf5\x36\xaa\x37\x73\x9f\x9d\x89\x2c\x65\xa5\xb2\x32\xc3\x35\x17\x5f\x2d\xbd\xec\x76\x5d\xa1\x0d\xde\xa9\xec\xcb\xd1\xa6\xaf\xbb\x6e\xfc\xa7\x19\xb7\x40\x39\x20\x7b\x70\xe3\xf6\x6c\xf2\x91\x6d\xf1\x8a\x6d\x5e\x3e\xcc\xda\x2c\x44\x52\x16\xb0\x52\xab\x8b\xb8\x9e\x95\xb7\x4a\xc0\x6d\x92\xef\x51\x00\xd6\xd8\xf8\x82\x71\xc2\x7a\xb9\xa1\xfe\x45\xbb\x20\xec\xfb\xdf\x2c\x34\xd4\x8d\x57\x1e\xe7\x43\x1a\xc8\xa1\x83\x9a\x8a\x6e\x57\x69\x43\x8d\xe5\x4b\x03\xdb\x8a\x8e\x69\x00\xc5\x90\x16\x37\x7a\xc4\xc4\x78\x3a\x66\x07\x6f\x66\x5b\x42\x7b\x3b\x88\x3c\x7e\xca\x1d\xc7\x3c\xa1\xd7\x71\x17\x93\x42\x2b\x26\xbe\x3a\x05\xc8\x84\x58\xb7\x1b\xb9\x20\x24\x7c\xc3\x5e\x20\xd2\xc6\x08\xe0\x38\x46\x2c\xe5\xc0\x6c\xe7\x5a\xd9\xd9\x08\xff\x83\x3e\x27\x7c\x7e\x27\xc4\xed\x4b\xfa\xde\x35\xde\x22\xd2\x9a\xe3\x23\xee\x67\xfe\x8a\xbd\x61\xff\xc6\xfe\x8d\x7d\x77\x80\x00\xc2\x70\x71\x26\xdc\x96\x85\xeb\x8e\x5b\xf6\xea\xcd\xf7\xaf\x5e\xed\xb5\x38\x6e\xc5\xff\x9f\x56\x5d\x17\xe7\x8a\x5e\xf7\x62\xc6\xc5\xc9\x87\x93\x9a\xd1\x04\x76\xf0\xff\xbb\x37\x30\xa6\xa9\x5a\x4b\x77\x07\x8a\xbc\x10\xe8\xba\xf7\x53\x3c\x2f\xdd\x99\x3b\x7e\x2b\x8a\x4c\xaa\x83\x7a\xbc\xc8\xe7\xab\xd3\x1d\xe7\x46\x05\x08\x3e\x39\xca\xd8\x4a\xfa\xbe\x44\x2f\x53\xc2\xb5\x98\x3b\x66\x55\xb8\x27\x23\x66\xca\x64\xc6\xb8\x61\x07\x3f\xfe\x9f\xd7\xe3\x37\xe3\x57\xec\x87\x37\xe3\x57\xe3\x57\x07\xa3\x2a\xeb\x75\x95\xfd\x1d\x1a\x3f\x08\x94\x2d\xdd\x49\x27\xbd\x7f\xcc\x4e\xd8\x9c\x7f\x95\xf3\x72\x1e\xde\x21\xd7\x1d\xa6\xe8\x43\x08\x05\x7c\x1c\xfd\x7d\x07\xf4\xc1\x5e\xdc\xc5\x58\x6e\xcb\x95\x7b\xbc\x85\xc4\x6c\x26\x2e\x47\x2c\xe3\xc6\x7e\xce\x53\xc7\x3b\x1b\xbf\x6e\x23\x19\xd7\xa5\x4a\x33\xf1\x59\xe5\x3c\xb9\xfd\x24\x36\x20\x41\xd4\xb6\xe2\x6d\xb3\x05\xb3\x05\x4f\x6e\x3d\x7c\x10\x62\x34\xe8\x1b\xea\x99\x95\xf0\x22\x60\x3b\xdc\x58\xb1\x2a\xc7\x1e\x9a\x3a\x5f\xb8\xe1\x32\x43\x2d\x18\x5b\x82\xe4\x80\x7d\x19\x50\x03\xa4\x61\x49\x26\x78\x01\x36\x8c\x44\x34\x34\x85\x60\x18\xac\x89\x14\x7a\x9e\x67\x62\x03\xd4\x4e\x0b\x45\xdf\x4e\xcf\x8f\xbc\x6d\x7a\x7d\x72\xf8\x11\x4d\x27\x1a\xe0\x86\xf7\x1c\x0b\x85\xd5\xbc\x5a\x0f\x0c\xd3\x46\xf6\xfd\x30\x36\x32\x85\x9a\x16\x46\x2f\x7b\x0a\xa1\xca\xf9\x35\xe2\x59\xd5\xb6\x02\xae\xce\xca\x7e\xac\xd9\x40\xbf\x3d\x9b\xe8\x57\x07\x87\x75\xbb\x83\x7a\x65\x25\x3b\x4d\xf5\x5d\xb3\x55\x98\x73\x44\x10\x33\x6e\x85\xb1\xb5\x63\x34\xec\xdc\xb7\x90\xf7\xda\xc6\x77\x9a\xd2\x87\xb8\x85\x9f\x0e\xd0\x74\x6e\x49\xe2\xab\x2e\x1d\x48\xe7\xee\x4e\x82\x40\x5d\x08\xa3\xb3\x45\xf0\xcd\xc4\x77\x84\x4f\xb7\x84\x12\x77\x60\x52\x7e\x03\x1d\x01\x3a\xda\x88\x6e\x84\xea\x14\x51\xf3\x6e\x87\x75\x52\x6b\x12\xa4\xde\x85\x7f\x40\x7b\x78\xca\x2d\xcf\xf4\x74\x42\xa5\x1d\x1c\x3f\xcf\xcb\x2c\xf3\x95\xe0\x31\xca\x85\xf4\x13\xda\x57\xba\x33\x23\xf7\xf8\xe0\x87\x5b\xa9\xd2\x1f\x8f\x7f\x08\xfa\x03\xfd\xff\x8f\x07\x63\xf6\x79\xed\x72\x8a\x1b\x5d\x88\xc6\x6e\x70\xc3\x8c\xd6\xc8\x1e\x94\x87\x4b\x9b\x93\xaa\xd4\x72\x48\xb6\x9a\x55\x79\x9a\x4a\x8c\x36\xb8\xec\x60\x09\xdd\xba\x5f\x09\xae\xd4\xcf\x82\x67\xeb\x2d\x4b\xb5\xe5\x3f\x8d\xdf\xf6\x61\x18\x66\xdd\x4d\x58\x48\x71\xe7\x91\xd3\x0a\x91\x89\x05\x57\xb6\xb1\x2d\x87\xc4\xf2\x3c\x21\xf7\xa1\x47\x41\x63\xad\xf5\x39\x81\x77\x4f\xb5\xc2\xa9\x1b\x0c\x2b\xc1\x16\xf5\x7e\xef\x4f\x69\x88\xc7\x53\x5f\x0a\x12\x10\xc9\x3b\x33\xc3\x87\x10\x43\x5e\x1b\xdb\xea\x4d\xbb\x55\xa0\xbd\x5d\xeb\x72\x43\x84\xd8\x00\x3a\x46\x12\x0f\x61\x73\x86\xe2\x11\x8d\x7b\x53\xc8\xdd\x36\xa9\x02\xff\xda\xd5\x91\xe6\x50\x3a\x4a\xb1\xa7\x8d\x66\x5e\x61\xa3\x68\x57\x8c\x61\xac\xbd\xd4\x26\x87\xb6\xb8\x2d\xba\x79\x18\xba\x56\x30\xab\xf3\xdb\xaa\xde\xd6\x8a\x23\xb8\x83\xbf\xa5\xd5\x58\xdc\xb1\x90\x58\xdd\x2d\x7d\x41\xe9\x69\x05\x39\xf2\x38\xcb\xa5\x40\xc8\x4c\x27\x46\xc1\x62\x81\xd0\x2c\x78\x4a\x0f\x85\xb2\xb2\x10\xf4\xdb\x88\xa2\xbe\x31\xc4\x8a\xb2\x08\x7c\x98\x16\x47\xc8\x48\x08\xdb\x3b\xfe\x49\x53\xc8\x32\x41\x3b\x39\x1a\x00\x16\xf4\x4a\x6e\x4f\x85\x71\x07\xda\xdd\x78\x31\x9e\x73\x25\x6f\x84\xb1\xe3\x50\x11\xc6\xfc\xe7\x9b\xff\x1a\xb3\x77\x4e\xbf\xc2\x8c\xe8\x91\xc7\x62\xa4\x71\x56\xe7\x42\x1a\x9c\x4c\x68\x5b\xf9\x7c\x73\x9d\xd2\xa0\xef\x60\xb0\x96\xdf\x0a\x34\x40\x61\x88\xa2\x13\xe4\xbf\x67\x07\x26\x17\x49\xf4\xe9\x7f\x3a\x2e\xf0\xaf\x03\xf6\xe2\x0e\xcc\xe7\x07\xee\x9f\x07\xf8\xc1\x90\xd5\x17\x0b\x13\xd5\x87\x51\xf5\x28\xe4\x74\x2a\x0a\x74\xbe\x32\x00\xa6\x79\x49\x58\x92\x4a\x47\x2f\xfb\x18\xec\xca\x59\xdb\x1c\xc8\x7f\xbe\xf9\xaf\x03\xf6\xa2\x3e\x2f\x26\x55\x2a\xbe\xb2\x37\x18\x84\x25\x8d\x9b\xe3\x4b\x0a\x65\x34\x4b\x65\xf9\x57\x90\x97\x67\xda\x08\x85\xc6\x3b\xab\xd1\x7a\x6b\xf4\x5c\xb0\x3b\x91\x65\x47\x14\x60\xc6\xee\x50\xfd\xf5\x4b\x09\x22\x3b\xcb\x79\x61\x6b\x47\x62\x4c\xf1\x05\xf0\x35\xb7\x6d\x53\xe5\x03\xb1\x6f\xa4\xa2\xe8\x4d\x8a\x1b\x75\x7b\x0e\xe0\x42\xb8\x49\x56\x07\x9b\x21\x41\xf2\x94\xb6\x2c\x44\x4b\xe0\x63\xc7\x3b\xe0\xd8\x77\x9f\xe3\xff\x37\xa9\x9a\x31\xf4\xeb\xa3\x32\xa6\xd2\xfa\xf4\x7b\x4a\xa9\xb3\xcb\x63\xb7\x0b\x85\xbc\x2e\xad\x2e\xcc\x71\x2a\x16\x22\x3b\x36\x72\x7a\xc4\x8b\x64\x26\xad\x48\xdc\xb4\x8e\x79\x2e\x8f\x12\xad\xdc\x8e\x03\x96\xdf\x3c\xfd\x93\x9b\x8b\x39\x72\x43\x6d\xa9\x30\xd4\x71\xd2\xed\x21\x29\x8f\x1a\x8a\x32\xd8\x1c\x3b\x44\x53\xac\x4e\x14\x5d\x20\x0f\x30\x5b\x08\x23\x38\x1e\x64\xb2\xbe\x40\x4e\x7f\x1e\x73\x48\x35\x9f\x92\x66\x1f\xee\xda\xa1\x56\x00\xb7\xb2\x46\x29\xe7\x3c\x45\x52\xca\xd5\xf2\xde\x0f\xbf\x5b\x52\x28\x8d\x96\x2c\x8f\xa0\x0b\x9d\x1d\x71\x95\xba\xff\x47\x64\x88\x64\x39\xc8\x1a\x96\xb2\x17\x21\xf8\x7c\x71\xf6\x30\x57\xa2\x94\x03\xdc\x7a\x92\xd7\x3a\x0a\x51\x28\xaa\xa2\xc3\xb1\x28\x85\x67\x9a\x75\x01\x55\x1a\xdf\xeb\xff\xa6\xe8\xc5\xe0\xe8\x6b\x13\xa9\xb6\xc7\x1c\x46\xb2\x63\xc7\xf1\xbe\xaf\x5a\xc4\x11\x2d\xa8\xa3\x1b\x4b\x80\xcc\xde\xa2\x57\x9b\x86\x57\x50\x80\xc1\x6c\x8e\x96\xee\x74\x86\xda\x75\xd9\x24\x28\x25\xed\x0a\x54\xa5\xbf\xd4\x6a\x5e\x47\x86\x87\xe0\x63\x63\xfa\xda\x88\x62\x81\xc5\x87\x09\xa0\x9e\x37\xf5\x2c\xaa\x2f\x88\x62\xd4\x03\x69\x3e\x7e\x0e\xab\xbb\xb2\x6d\x02\xa0\x0d\x35\x46\xbf\x71\xd4\x83\xe8\x3d\xa8\x5e\x6e\xfc\xd9\x7d\x61\x47\x35\xc6\x9d\xbf\x9f\x05\x2f\xec\xb5\xe0\x76\x9b\xad\x66\xcd\x91\xae\xb5\xf3\x16\x9b\xea\x40\xdf\x09\x36\xd5\x16\xfd\xae\xee\xc8\xa1\x4c\x8a\x76\xe6\x70\xd0\xee\xfb\x44\x57\xb3\xbc\x2a\x38\x20\x50\x68\xd5\x73\x9a\xf5\x86\xab\xf3\x24\xe9\x98\x4e\x92\xc5\xb7\x11\x8c\x51\x2b\x41\x7b\x87\xb1\x80\x40\x81\x1e\x66\xca\x73\x61\xcc\x56\x90\xc6\x7a\x32\x1d\xbe\x8d\x57\xb9\x11\x98\x3a\xf7\xbf\x21\x92\x81\x13\xa0\x53\x61\xb9\xcc\xfc\x55\xc6\xa5\x08\xab\xb4\x97\x53\xa8\x10\xdc\x74\xf6\xf1\x7d\x82\x97\x71\xd0\x5a\x89\xa3\x3b\x5d\xa4\xec\x94\xcf\x45\x76\xca\x8d\xa0\xbe\x62\xe0\x18\xdc\xa3\x43\x33\xe8\x90\xd7\xbb\x3b\x36\x0c\x19\x8d\x3f\xfe\x10\xd1\xd9\xa8\x54\x2c\x1c\xe0\xc8\x07\x03\x5d\x15\xa5\x18\xb1\x77\x8e\x7b\x8d\xd8\x67\x75\xab\xf4\xdd\x7e\x63\xb5\x5b\x63\x08\xeb\xfe\x36\xc2\x50\x05\x33\x2c\x41\xb3\x36\xdc\x0f\x34\xdc\x1d\x47\x44\x04\x7f\x43\x08\x49\x9d\xd9\x84\x57\xfd\x88\xdc\xff\xae\x98\xa0\x9c\xa2\x58\xe8\x29\x38\x4c\x41\xfb\xef\x1d\x14\x41\xde\xb4\x9f\xa5\xb1\xba\x83\xd3\xe8\xa2\xf6\x3a\x2b\x44\xa2\x8b\xd4\x6c\x30\xbe\x7b\xcf\x83\x54\xd2\xcc\x36\x84\x3f\x8d\xa0\xf8\x82\xb1\x58\xee\x78\xcc\x3e\x2b\x70\xc3\x81\xbf\xb3\xde\x93\x98\x1b\x91\x2d\x84\x19\x91\x88\x87\x5f\xe6\x85\x50\x87\x96\x4d\x79\x71\xed\xae\x2c\x41\x6f\x6e\x8b\x0e\xd9\x97\x9f\xd2\xb0\x3e\xc1\x00\x22\xeb\x61\xc3\x7f\xb5\x3a\xf5\x3a\x0b\xbd\x37\xd6\xe9\xa3\xf0\x36\xdb\x14\xd1\x8b\x46\xe4\x7d\xe3\x6b\xb2\xc5\xd7\x05\xa9\xbb\x33\xbe\x11\x77\xe6\x88\x59\xbd\x23\x8f\xe6\x5b\xc2\x10\xf1\x6f\x6b\x30\x62\x88\x43\x34\xb6\xe0\x56\x4c\x43\xb9\x93\x38\x12\x71\x1f\xa2\x42\xf1\x2e\x5b\x00\x04\xd7\x8c\x4f\x14\x7e\x7c\x68\x50\x99\x43\x00\x2a\x59\x97\xaa\x08\x1a\x86\xf1\x8f\xf1\x58\x41\xb3\xdb\x9f\x14\x92\x01\xf7\x4c\x4e\x85\xd9\x82\x3c\xb2\xce\x7a\x8b\x6d\x42\x15\x20\x40\x33\x4e\xf1\x99\x27\xe5\xf8\x22\x23\x91\x1e\xe4\x04\xfa\x89\x0e\x12\xd5\x8d\x19\x72\x2a\x93\x96\xd2\xb6\x9b\x0d\xd1\xeb\xbc\x87\xab\xe6\xfe\xd3\xac\x34\x56\x14\x13\xac\x75\xe0\xf5\xf0\x3b\x6e\xc8\x01\xe7\x2e\x76\xa1\xe7\xc3\x4d\xa5\x43\xc6\xc9\xe6\x39\x7d\x88\x73\x45\x6a\x21\x74\x6b\x67\xb8\xdf\xa8\x6b\x34\xa4\xeb\x68\x6b\x8d\x6a\x1e\xcf\xc6\xed\x0c\x84\x73\xaf\x41\x76\x97\x1d\xdd\x2e\x76\x9c\xc4\x3b\x27\xe6\xae\x3b\x3d\x6b\xcf\x4a\x21\xf2\x8c\x27\x95\xc7\x72\xe5\x2e\x70\xb5\x05\x9d\xb0\xc3\x1c\x65\xbb\x1f\x7d\x3d\xff\xda\xe4\x43\x1f\x8a\x46\x02\x6b\xe8\x38\xa4\x4b\xf7\x6e\x80\x11\x87\x7f\x6c\x3a\x10\x18\xce\x24\x21\x30\x95\xce\x13\x54\x2a\xc1\xf8\x80\x7d\xc5\xda\xa2\x8f\x46\x38\xf1\xef\x6f\x3d\xc9\xd0\x2b\x8c\x1b\x9e\x49\x35\x7d\xa0\x33\x6d\x75\x57\xb9\x57\x77\x3f\xcf\x55\xf0\xd5\xda\x58\xf6\xdd\x66\x16\x9d\xe1\x9f\x30\x70\x7d\x83\xc9\x74\xd3\x39\xae\x5a\xf9\x99\x78\xdb\xca\xb4\xfa\xa5\xce\x8d\xf2\xf5\x62\xcd\xf6\x10\x97\x68\xa0\x1b\x1c\xa0\x9b\x46\xb8\xde\xf1\xb9\x2d\x66\x65\x9b\x87\xde\xcf\xee\x74\xf2\x65\x87\x48\xa8\xd6\x10\xa4\x56\xd7\xe8\xbe\x4e\xd1\xd6\x73\xde\xea\x08\x7d\x76\x81\x3e\xbb\x40\xbf\x25\x17\x68\xeb\x89\xdf\xe6\xf6\xfc\x36\x1c\x9e\xed\x11\x72\x5b\x9c\x9c\x4f\xd2\xbd\xd9\x69\x46\x5b\xc5\xf5\x27\xeb\xcc\x6c\x9d\x5a\x47\x07\xe6\x1f\xc7\x75\xd9\xba\x62\x5b\xdc\x95\x4f\xd0\x51\xd9\x45\x20\x13\x69\x17\x53\xe5\x45\xf4\x72\x6c\xac\x24\x21\x05\x52\xa2\xbd\xd0\x48\x5a\x50\x9b\xcc\xd8\x65\x70\xf9\x06\x8d\xa7\x21\x19\x50\xca\x59\x67\xd9\xab\x2d\x59\xf3\xec\xfc\xf2\xd3\xf9\xe9\xc9\xd5\xf9\x59\x53\xbe\x5b\xb7\xd2\xfb\xc6\xa4\x07\x49\x6c\xc3\x0b\x8e\x20\x6f\xf8\xc9\x9d\x81\x0d\x3f\x95\xa5\x5c\xd7\x6a\x7f\xb9\x70\x2f\x2e\xb7\x17\xff\x68\xbf\x9d\x5d\xaf\xa7\xbb\x9d\x70\x5a\x10\x3f\xc0\xc9\x3d\x33\x9d\xa5\xc6\x23\x8f\x5c\x9c\x05\x2c\x3b\xa9\x92\xac\x4c\x9d\x70\xf1\xf9\xf3\xc5\x99\x19\x33\xf6\x56\x24\xbc\x34\xe0\x09\x4c\xb5\x3a\xb4\xec\xe3\x87\xf7\xff\x01\x88\x3a\xf0\xc6\x28\x40\xbf\x43\x3d\x41\xc9\xb1\x24\xa2\xc5\x9a\x34\xec\xad\x40\x41\x05\xbe\x9c\xf0\xdc\x51\x31\xcc\xe5\x51\x16\x64\x91\x99\xc8\x72\x47\x31\x6f\x05\xab\x2a\xc1\xb9\x8e\xe1\x57\x44\x4a\x21\x00\x8c\xa9\xb0\x88\x7b\xb7\x0d\xe3\x62\xeb\xaa\xb5\x78\xfd\xf7\xf0\xf7\xd7\x5c\x18\xe4\x11\xba\xe3\x86\xbc\xa6\xbb\x24\x76\xb6\xeb\xc4\x9b\xdd\x6c\x1b\x1c\x6c\x48\x9e\xe1\x5f\x2b\x63\x76\x83\xad\x7c\x69\x18\xc8\x2c\x6d\x67\x8f\xfe\x96\x5c\x74\x91\xbe\xe5\xc9\xed\xe9\xe4\x4b\x7b\x3c\xc2\xa7\xda\xeb\x21\x9e\x7e\xad\xde\x6e\x08\x20\x36\x36\x3f\xa1\x1d\x53\x23\x5d\xe6\xc9\x2d\x54\xe1\x5e\x42\x3f\xe2\xab\x3b\xd8\x64\xdd\x44\x5b\x67\x09\x4d\xd6\xd1\x44\xca\x1f\x95\xd6\xa7\xc1\x1a\x96\xc3\x9e\x6f\x36\x22\xd8\xe6\xb7\xb7\x7a\xae\x76\x70\xd8\x6c\x4f\xe5\x9e\xf1\x42\xa4\x67\x3e\x05\x79\x03\xbd\xab\x9b\x7a\x56\x9a\x40\xf8\x07\x9e\x92\x90\x1f\x3d\x8a\x38\x9d\x54\x94\x3d\x1d\xa1\xd7\x5c\x2f\xeb\x99\xcc\x86\x59\x5e\x4c\x05\x38\x98\x1d\x93\xaa\x5e\xa5\x74\x37\xc3\xad\x34\x37\x4b\xa7\x62\xaf\x26\x67\x37\x6c\x02\xd5\xa7\x81\x0f\x57\xca\xee\xb5\x40\x30\x32\xff\x33\xcf\xb4\x9a\x42\x7e\xb0\x5c\xab\xa0\x0f\x14\x75\x52\x5f\xb1\x65\x8c\x60\xc1\x55\x94\xb9\x1e\xaf\x18\xc5\x0b\xc4\x78\x46\x70\x6c\x61\xfe\x8d\xf4\x72\x4c\x21\x37\xec\x1e\x3d\x69\xc9\xba\xd3\xbb\xf1\xed\x30\xe8\x9d\x9d\x5e\xb2\xab\x4f\x1d\xd6\xa3\x3a\x80\xe1\x64\xc0\xf3\x50\xf6\x8c\xce\xc9\xe9\xe4\xcb\x88\x08\xa4\xc5\x2a\xcd\x98\x22\x33\xfe\x81\xac\x36\x3f\x8e\x7f\x80\xda\xdb\x3f\xb6\x99\xef\xb6\x83\x1b\xb7\x82\x1a\x77\x71\x2b\xac\x5b\xf1\xae\xde\x85\xf5\xb4\x66\x8d\x65\x73\xf2\x85\x16\xc9\x27\x50\xd5\x16\x70\x2f\xf3\x6c\x87\x20\xd7\x7a\x0e\xd8\x66\x9f\x8d\xc5\x1a\x11\x34\x4c\x12\xae\xe3\xeb\xb2\xe3\x40\x81\x39\xb5\x53\x3c\xf7\x56\x93\x97\xd7\xf8\xdb\xba\x0c\xcd\x6e\x2c\xcf\x88\x62\x21\xd2\xef\x21\x94\xd1\x3f\x42\x88\x9e\xfa\xb3\xf2\xda\xeb\x91\xd1\xb9\xa2\xe0\x13\xf6\xcf\x7f\xfd\x8f\xff\x0e\x00\x00\xff\xff\x03\x31\x75\xa0\xd0\xd0\x04\x00")
 
 func operatorsCoreosCom_subscriptionsYamlBytes() ([]byte, error) {
 	return bindataRead(
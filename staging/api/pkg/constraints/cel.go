@@ -13,8 +13,31 @@ import (
 	"github.com/blang/semver/v4"
 )
 
-// PropertiesKey is the key for bundle properties map (input data for CEL evaluation)
-const PropertiesKey = "properties"
+const (
+	// PropertiesKey is the key for bundle properties map (input data for CEL evaluation)
+	PropertiesKey = "properties"
+
+	// ServerVersionKey is the key for the cluster's Kubernetes server version,
+	// e.g. "v1.22.3" (input data for CEL evaluation)
+	ServerVersionKey = "serverVersion"
+
+	// APIsKey is the key for the list of APIs served by the cluster, each a map
+	// with "group", "version" and "kind" entries (input data for CEL evaluation)
+	APIsKey = "apis"
+
+	// MaxOpenShiftVersionKey is the key for the highest OpenShift minor version the
+	// cluster may be upgraded to given the operators installed on it, or an empty
+	// string if unbounded (input data for CEL evaluation)
+	MaxOpenShiftVersionKey = "maxOpenShiftVersion"
+
+	// FactsKey is the key for the map of custom, administrator-provided
+	// cluster facts (input data for CEL evaluation)
+	FactsKey = "facts"
+)
+
+// clusterKeys are the CEL variables describing the cluster rather than the
+// bundle under evaluation.
+var clusterKeys = []string{ServerVersionKey, APIsKey, MaxOpenShiftVersionKey, FactsKey}
 
 // Cel is a struct representing CEL expression information
 type Cel struct {
@@ -26,7 +49,11 @@ type Cel struct {
 // evaluate CEL expression and an error if occurs
 func NewCelEnvironment() *CelEnvironment {
 	env, err := cel.NewEnv(cel.Declarations(
-		decls.NewVar(PropertiesKey, decls.NewListType(decls.NewMapType(decls.String, decls.Any)))),
+		decls.NewVar(PropertiesKey, decls.NewListType(decls.NewMapType(decls.String, decls.Any))),
+		decls.NewVar(ServerVersionKey, decls.String),
+		decls.NewVar(APIsKey, decls.NewListType(decls.NewMapType(decls.String, decls.String))),
+		decls.NewVar(MaxOpenShiftVersionKey, decls.String),
+		decls.NewVar(FactsKey, decls.NewMapType(decls.String, decls.String))),
 		cel.Lib(semverLib{}),
 	)
 	// If an error occurs here, it means the CEL enviroment is unable to load
@@ -48,7 +75,29 @@ type CelEnvironment struct {
 
 // CelProgram is a struct that encapsulates compiled CEL program
 type CelProgram struct {
-	program cel.Program
+	program    cel.Program
+	references map[string]struct{}
+}
+
+// ClusterOnly returns true if the compiled CEL expression refers to cluster facts
+// but not to the properties of the bundle it is evaluated against. The result of
+// such an expression is the same for every bundle.
+func (e CelProgram) ClusterOnly() bool {
+	if _, ok := e.references[PropertiesKey]; ok {
+		return false
+	}
+	return e.ReferencesCluster()
+}
+
+// ReferencesCluster returns true if the compiled CEL expression refers to any
+// cluster facts, so that they need to be gathered before evaluating it.
+func (e CelProgram) ReferencesCluster() bool {
+	for _, key := range clusterKeys {
+		if _, ok := e.references[key]; ok {
+			return true
+		}
+	}
+	return false
 }
 
 /*
@@ -127,9 +176,20 @@ func (e *CelEnvironment) Validate(rule string) (CelProgram, error) {
 		return celProg, fmt.Errorf("cel expressions must have type Bool")
 	}
 
+	checked, err := cel.AstToCheckedExpr(ast)
+	if err != nil {
+		return celProg, err
+	}
+	references := make(map[string]struct{})
+	for _, ref := range checked.GetReferenceMap() {
+		if ref.GetName() != "" && len(ref.GetOverloadId()) == 0 {
+			references[ref.GetName()] = struct{}{}
+		}
+	}
+
 	prog, err := e.env.Program(ast)
 	if err != nil {
 		return celProg, err
 	}
-	return CelProgram{program: prog}, nil
+	return CelProgram{program: prog, references: references}, nil
 }
@@ -69,3 +69,96 @@ func TestCelLibrary(t *testing.T) {
 		})
 	}
 }
+
+func TestCelClusterFacts(t *testing.T) {
+	props := []map[string]interface{}{
+		{
+			"type":  "olm.test",
+			"value": "1.0.0",
+		},
+	}
+
+	data := map[string]interface{}{
+		PropertiesKey:    props,
+		ServerVersionKey: "v1.22.3",
+		APIsKey: []map[string]string{
+			{"group": "config.openshift.io", "version": "v1", "kind": "ClusterVersion"},
+		},
+		MaxOpenShiftVersionKey: "4.10",
+		FactsKey: map[string]string{
+			"workerNodes": "3",
+		},
+	}
+
+	tests := []struct {
+		name              string
+		rule              string
+		out               bool
+		clusterOnly       bool
+		referencesCluster bool
+	}{
+		{
+			name:              "ServerVersion/True",
+			rule:              "semver_compare(serverVersion, '1.21.0') >= 0",
+			out:               true,
+			clusterOnly:       true,
+			referencesCluster: true,
+		},
+		{
+			name:              "ServerVersion/False",
+			rule:              "semver_compare(serverVersion, '1.25.0') >= 0",
+			out:               false,
+			clusterOnly:       true,
+			referencesCluster: true,
+		},
+		{
+			name:              "APIs/True",
+			rule:              "apis.exists(a, a.group == 'config.openshift.io' && a.kind == 'ClusterVersion')",
+			out:               true,
+			clusterOnly:       true,
+			referencesCluster: true,
+		},
+		{
+			name:              "MaxOpenShiftVersion/False",
+			rule:              "maxOpenShiftVersion == '' || semver_compare(maxOpenShiftVersion, '4.11') >= 0",
+			out:               false,
+			clusterOnly:       true,
+			referencesCluster: true,
+		},
+		{
+			name:              "Facts/True",
+			rule:              "'workerNodes' in facts && int(facts.workerNodes) >= 3",
+			out:               true,
+			clusterOnly:       true,
+			referencesCluster: true,
+		},
+		{
+			name:              "PropertiesAndFacts/True",
+			rule:              "properties.exists(p, p.type == 'olm.test') && int(facts.workerNodes) >= 3",
+			out:               true,
+			clusterOnly:       false,
+			referencesCluster: true,
+		},
+		{
+			name:              "Constant/True",
+			rule:              "true",
+			out:               true,
+			clusterOnly:       false,
+			referencesCluster: false,
+		},
+	}
+
+	env := NewCelEnvironment()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prog, err := env.Validate(tt.rule)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.clusterOnly, prog.ClusterOnly())
+			assert.Equal(t, tt.referencesCluster, prog.ReferencesCluster())
+
+			result, err := prog.Evaluate(data)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.out, result)
+		})
+	}
+}
@@ -1,7 +1,11 @@
 package v1
 
 import (
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
 )
 
 const (
@@ -11,18 +15,147 @@ const (
 // OLMConfigSpec is the spec for an OLMConfig resource.
 type OLMConfigSpec struct {
 	Features *Features `json:"features,omitempty"`
+
+	// InstallPlanRetention configures the garbage collection of InstallPlans.
+	// +optional
+	InstallPlanRetention *InstallPlanRetention `json:"installPlanRetention,omitempty"`
+
+	// AdditionalBundleObjectKinds are the kinds of bundle objects that InstallPlans may apply in
+	// addition to the kinds OLM supports by default.
+	// +listType=atomic
+	// +optional
+	AdditionalBundleObjectKinds []BundleObjectKind `json:"additionalBundleObjectKinds,omitempty"`
+
+	// BundleUnpackTimeout is the default time allowed for unpacking a bundle, overriding the catalog operator's
+	// --bundle-unpack-timeout flag. Subscriptions and InstallPlans override it with the
+	// operatorframework.io/bundle-unpack-timeout annotation.
+	// +optional
+	BundleUnpackTimeout *metav1.Duration `json:"bundleUnpackTimeout,omitempty"`
+
+	// CertIssuer configures how the serving certificates of webhooks and APIServices are issued, unless their CSV
+	// configures its own issuer. Defaults to OLM generating a CA and signing the certificates itself.
+	// +optional
+	CertIssuer *v1alpha1.CertIssuer `json:"certIssuer,omitempty"`
+
+	// Proxy configures the proxy and the trusted CA certificates injected into the deployments of operators. Its
+	// proxy env variable(s) take precedence over those of the OpenShift cluster Proxy, where available.
+	// +optional
+	Proxy *ProxyConfig `json:"proxy,omitempty"`
+}
+
+// ProxyConfig configures the proxy that operators use to reach services outside of the cluster. Empty fields are
+// not injected. Like the proxy env variable(s) of the cluster Proxy, those configured here are not injected into
+// the deployments of operators whose Subscription config defines any of HTTP_PROXY, HTTPS_PROXY or NO_PROXY.
+type ProxyConfig struct {
+	// HTTPProxy is the URL of the proxy for HTTP requests, injected as HTTP_PROXY.
+	// +optional
+	HTTPProxy string `json:"httpProxy,omitempty"`
+
+	// HTTPSProxy is the URL of the proxy for HTTPS requests, injected as HTTPS_PROXY.
+	// +optional
+	HTTPSProxy string `json:"httpsProxy,omitempty"`
+
+	// NoProxy is the comma-separated list of hostnames, domains and CIDRs for which the proxy should not be used,
+	// injected as NO_PROXY.
+	// +optional
+	NoProxy string `json:"noProxy,omitempty"`
+
+	// TrustedCA references a ConfigMap holding the PEM-encoded CA certificates that operators should trust, such
+	// as the one of a TLS-intercepting proxy.
+	// +optional
+	TrustedCA *TrustedCAConfigMap `json:"trustedCA,omitempty"`
+}
+
+// DefaultTrustedCAKey is the key of the trusted CA ConfigMap holding the certificates unless configured otherwise.
+const DefaultTrustedCAKey = "ca-bundle.crt"
+
+// TrustedCAConfigMap references a ConfigMap of CA certificates. The ConfigMap is looked up in the namespace of each
+// operator, so it must be distributed to the namespaces operators are installed in, e.g. by trust-manager.
+// Operators are still started when it's missing from their namespace.
+type TrustedCAConfigMap struct {
+	// Name is the name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Key is the key of the ConfigMap holding the certificates.
+	// Defaults to "ca-bundle.crt".
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
+// BundleObjectKind identifies a kind of object that may be shipped in bundles.
+type BundleObjectKind struct {
+	// Group is the API group of the kind. The core API group is empty.
+	// +optional
+	Group string `json:"group,omitempty"`
+
+	// Kind is the name of the kind.
+	Kind string `json:"kind"`
+
+	// ClusterScoped allows cluster-scoped objects of the kind to be applied.
+	// Cluster-scoped objects affect every namespace, so they are rejected unless explicitly allowed.
+	// +optional
+	ClusterScoped bool `json:"clusterScoped,omitempty"`
 }
 
 // Features contains the list of configurable OLM features.
 type Features struct {
 
 	// DisableCopiedCSVs is used to disable OLM's "Copied CSV" feature
-	// for operators installed at the cluster scope, where a cluster
-	// scoped operator is one that has been installed in an
-	// OperatorGroup that targets all namespaces.
+	// for all operators. Operators installed in an OperatorGroup that
+	// targets specific namespaces are listed instead by a lightweight
+	// index ConfigMap, labeled "olm.operator-index", in each of the
+	// OperatorGroup's target namespaces.
 	// When reenabled, OLM will recreate the "Copied CSVs" for each
-	// cluster scoped operator.
+	// operator.
 	DisableCopiedCSVs *bool `json:"disableCopiedCSVs,omitempty"`
+
+	// ServerSideApply makes InstallPlans apply the objects of their steps with
+	// server-side apply, so that fields set by other field managers are preserved.
+	// Conflicts with other field managers fail the step unless the InstallPlan
+	// forces conflicts.
+	ServerSideApply *bool `json:"serverSideApply,omitempty"`
+
+	// ReportMissingPermissions makes InstallPlans that are executed with the
+	// ServiceAccount of their OperatorGroup report every rule the ServiceAccount
+	// is missing to install the operator when they are forbidden, rather than
+	// only the request that was forbidden.
+	ReportMissingPermissions *bool `json:"reportMissingPermissions,omitempty"`
+}
+
+// DefaultInstallPlanMaxCount is the number of InstallPlans kept per namespace unless configured otherwise.
+const DefaultInstallPlanMaxCount = 5
+
+// InstallPlanRetention configures how many InstallPlans are kept per namespace, and for how long.
+type InstallPlanRetention struct {
+	InstallPlanRetentionPolicy `json:",inline"`
+
+	// Overrides replace the fields of the cluster-wide policy that they set for individual namespaces.
+	// +listType=map
+	// +listMapKey=namespace
+	// +optional
+	Overrides []NamespaceInstallPlanRetention `json:"overrides,omitempty"`
+}
+
+// InstallPlanRetentionPolicy limits the InstallPlans kept in a namespace.
+type InstallPlanRetentionPolicy struct {
+	// MaxCount is the maximum number of InstallPlans kept in a namespace.
+	// Defaults to 5.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxCount *int32 `json:"maxCount,omitempty"`
+
+	// MaxAge is how long Complete and Failed InstallPlans are kept, unless they belong to
+	// the latest generation of InstallPlans in their namespace.
+	// If unset, InstallPlans are only limited by MaxCount.
+	// +optional
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
+}
+
+// NamespaceInstallPlanRetention is the InstallPlan retention policy of a single namespace.
+type NamespaceInstallPlanRetention struct {
+	Namespace string `json:"namespace"`
+
+	InstallPlanRetentionPolicy `json:",inline"`
 }
 
 // OLMConfigStatus is the status for an OLMConfig resource.
@@ -69,3 +202,56 @@ func (config *OLMConfig) CopiedCSVsAreEnabled() bool {
 
 	return !*config.Spec.Features.DisableCopiedCSVs
 }
+
+// ServerSideApplyIsEnabled returns true if and only if the olmConfigs ServerSideApply is set and true,
+// otherwise false is returned
+func (config *OLMConfig) ServerSideApplyIsEnabled() bool {
+	if config == nil || config.Spec.Features == nil || config.Spec.Features.ServerSideApply == nil {
+		return false
+	}
+
+	return *config.Spec.Features.ServerSideApply
+}
+
+// MissingPermissionsAreReported returns true if and only if the olmConfigs ReportMissingPermissions is set and true,
+// otherwise false is returned
+func (config *OLMConfig) MissingPermissionsAreReported() bool {
+	if config == nil || config.Spec.Features == nil || config.Spec.Features.ReportMissingPermissions == nil {
+		return false
+	}
+
+	return *config.Spec.Features.ReportMissingPermissions
+}
+
+// ProxyConfig returns the proxy configuration, or nil if there's none.
+func (config *OLMConfig) ProxyConfig() *ProxyConfig {
+	if config == nil {
+		return nil
+	}
+	return config.Spec.Proxy
+}
+
+// InstallPlanRetentionFor returns the maximum number and age of InstallPlans kept in the given namespace.
+// A zero age means that InstallPlans are kept regardless of their age.
+func (config *OLMConfig) InstallPlanRetentionFor(namespace string) (maxCount int, maxAge time.Duration) {
+	maxCount = DefaultInstallPlanMaxCount
+	if config == nil || config.Spec.InstallPlanRetention == nil {
+		return
+	}
+
+	policies := []InstallPlanRetentionPolicy{config.Spec.InstallPlanRetention.InstallPlanRetentionPolicy}
+	for _, override := range config.Spec.InstallPlanRetention.Overrides {
+		if override.Namespace == namespace {
+			policies = append(policies, override.InstallPlanRetentionPolicy)
+		}
+	}
+	for _, policy := range policies {
+		if policy.MaxCount != nil && *policy.MaxCount > 0 {
+			maxCount = int(*policy.MaxCount)
+		}
+		if policy.MaxAge != nil {
+			maxAge = policy.MaxAge.Duration
+		}
+	}
+	return
+}
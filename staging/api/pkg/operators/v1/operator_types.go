@@ -5,8 +5,46 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-// OperatorSpec defines the desired state of Operator
-type OperatorSpec struct{}
+// OperatorSpec defines the desired state of Operator.
+// Operators without a package only aggregate the components selected by their label; Operators with a package
+// are installed from a catalog, and uninstalled when the Operator is deleted.
+type OperatorSpec struct {
+	// Package is the name of the package to install.
+	// +optional
+	Package string `json:"package,omitempty"`
+
+	// Channel is the channel of the package to install from. Defaults to the package's default channel.
+	// +optional
+	Channel string `json:"channel,omitempty"`
+
+	// VersionRange is a semver range, such as ">=1.2.0 <2.0.0", that the installed operator's version
+	// must stay within.
+	// +optional
+	VersionRange string `json:"versionRange,omitempty"`
+
+	// Source is the name of the CatalogSource to install the package from.
+	// +optional
+	Source string `json:"source,omitempty"`
+
+	// SourceNamespace is the namespace of the CatalogSource to install the package from.
+	// +optional
+	SourceNamespace string `json:"sourceNamespace,omitempty"`
+
+	// InstallNamespace is the namespace the operator is installed in, which is created if it doesn't exist.
+	// Defaults to the name of the package.
+	// +optional
+	InstallNamespace string `json:"installNamespace,omitempty"`
+
+	// TargetNamespaces are the namespaces the operator manages. If empty, the operator manages all namespaces.
+	// They're ignored when the install namespace already has an OperatorGroup that the Operator didn't create.
+	// +optional
+	TargetNamespaces []string `json:"targetNamespaces,omitempty"`
+
+	// InstallPlanApproval is the approval policy of the operator's InstallPlans. Defaults to Automatic.
+	// +optional
+	// +kubebuilder:validation:Enum=Automatic;Manual
+	InstallPlanApproval string `json:"installPlanApproval,omitempty"`
+}
 
 // OperatorStatus defines the observed state of an Operator and its components
 type OperatorStatus struct {
@@ -7,6 +7,8 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
 )
 
 const (
@@ -32,8 +34,22 @@ type OperatorGroupSpec struct {
 	// +optional
 	Selector *metav1.LabelSelector `json:"selector,omitempty"`
 
+	// NamePatterns select the namespaces whose name matches any of the patterns, in addition to the namespaces
+	// selected by Selector. Patterns use shell glob syntax, e.g. "team-a-*".
+	// +optional
+	NamePatterns []string `json:"namePatterns,omitempty"`
+
+	// ExcludeSelector removes the namespaces it selects from the OperatorGroup's target namespaces.
+	// +optional
+	ExcludeSelector *metav1.LabelSelector `json:"excludeSelector,omitempty"`
+
+	// ExcludeNamePatterns remove the namespaces whose name matches any of the patterns from the OperatorGroup's
+	// target namespaces.
+	// +optional
+	ExcludeNamePatterns []string `json:"excludeNamePatterns,omitempty"`
+
 	// TargetNamespaces is an explicit set of namespaces to target.
-	// If it is set, Selector is ignored.
+	// If it is set, Selector, NamePatterns and the exclusions are ignored.
 	// +optional
 	// +listType=set
 	TargetNamespaces []string `json:"targetNamespaces,omitempty"`
@@ -45,6 +61,12 @@ type OperatorGroupSpec struct {
 	// Static tells OLM not to update the OperatorGroup's providedAPIs annotation
 	// +optional
 	StaticProvidedAPIs bool `json:"staticProvidedAPIs,omitempty"`
+
+	// UpgradeMode is the default upgrade mode of the Subscriptions OLM creates in the OperatorGroup's
+	// namespace to install dependencies. With MinimalChange, upgrading an operator only upgrades its
+	// dependencies when the upgrade requires it. Subscriptions created by users are not affected.
+	// +optional
+	UpgradeMode v1alpha1.UpgradeMode `json:"upgradeMode,omitempty"`
 }
 
 // OperatorGroupStatus is the status for an OperatorGroupResource.
@@ -61,6 +83,26 @@ type OperatorGroupStatus struct {
 
 	// Conditions is an array of the OperatorGroup's conditions.
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// NamespaceStatuses report, for each target namespace, whether the OperatorGroup's operators have been
+	// projected into it. They're omitted for OperatorGroups that target all namespaces.
+	// +optional
+	NamespaceStatuses []OperatorGroupNamespaceStatus `json:"namespaceStatuses,omitempty"`
+}
+
+// OperatorGroupNamespaceStatus is the status of an OperatorGroup's target namespace.
+type OperatorGroupNamespaceStatus struct {
+	// Name of the target namespace.
+	Name string `json:"name"`
+
+	// Converged is true once every installed operator in the OperatorGroup has been copied into the
+	// namespace, or listed by its operator index when copied CSVs are disabled, and has the permissions
+	// it requires there.
+	Converged bool `json:"converged"`
+
+	// Message describes what the namespace is waiting for.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
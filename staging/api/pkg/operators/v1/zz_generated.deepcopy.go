@@ -20,11 +20,27 @@ limitations under the License.
 package v1
 
 import (
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BundleObjectKind) DeepCopyInto(out *BundleObjectKind) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BundleObjectKind.
+func (in *BundleObjectKind) DeepCopy() *BundleObjectKind {
+	if in == nil {
+		return nil
+	}
+	out := new(BundleObjectKind)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Components) DeepCopyInto(out *Components) {
 	*out = *in
@@ -83,6 +99,16 @@ func (in *Features) DeepCopyInto(out *Features) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ServerSideApply != nil {
+		in, out := &in.ServerSideApply, &out.ServerSideApply
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReportMissingPermissions != nil {
+		in, out := &in.ReportMissingPermissions, &out.ReportMissingPermissions
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Features.
@@ -95,6 +121,70 @@ func (in *Features) DeepCopy() *Features {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallPlanRetention) DeepCopyInto(out *InstallPlanRetention) {
+	*out = *in
+	in.InstallPlanRetentionPolicy.DeepCopyInto(&out.InstallPlanRetentionPolicy)
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make([]NamespaceInstallPlanRetention, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallPlanRetention.
+func (in *InstallPlanRetention) DeepCopy() *InstallPlanRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallPlanRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallPlanRetentionPolicy) DeepCopyInto(out *InstallPlanRetentionPolicy) {
+	*out = *in
+	if in.MaxCount != nil {
+		in, out := &in.MaxCount, &out.MaxCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallPlanRetentionPolicy.
+func (in *InstallPlanRetentionPolicy) DeepCopy() *InstallPlanRetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallPlanRetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceInstallPlanRetention) DeepCopyInto(out *NamespaceInstallPlanRetention) {
+	*out = *in
+	in.InstallPlanRetentionPolicy.DeepCopyInto(&out.InstallPlanRetentionPolicy)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceInstallPlanRetention.
+func (in *NamespaceInstallPlanRetention) DeepCopy() *NamespaceInstallPlanRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceInstallPlanRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OLMConfig) DeepCopyInto(out *OLMConfig) {
 	*out = *in
@@ -162,6 +252,31 @@ func (in *OLMConfigSpec) DeepCopyInto(out *OLMConfigSpec) {
 		*out = new(Features)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.InstallPlanRetention != nil {
+		in, out := &in.InstallPlanRetention, &out.InstallPlanRetention
+		*out = new(InstallPlanRetention)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdditionalBundleObjectKinds != nil {
+		in, out := &in.AdditionalBundleObjectKinds, &out.AdditionalBundleObjectKinds
+		*out = make([]BundleObjectKind, len(*in))
+		copy(*out, *in)
+	}
+	if in.BundleUnpackTimeout != nil {
+		in, out := &in.BundleUnpackTimeout, &out.BundleUnpackTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.CertIssuer != nil {
+		in, out := &in.CertIssuer, &out.CertIssuer
+		*out = new(v1alpha1.CertIssuer)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(ProxyConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OLMConfigSpec.
@@ -201,7 +316,7 @@ func (in *Operator) DeepCopyInto(out *Operator) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -395,6 +510,21 @@ func (in *OperatorGroupList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorGroupNamespaceStatus) DeepCopyInto(out *OperatorGroupNamespaceStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorGroupNamespaceStatus.
+func (in *OperatorGroupNamespaceStatus) DeepCopy() *OperatorGroupNamespaceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorGroupNamespaceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperatorGroupSpec) DeepCopyInto(out *OperatorGroupSpec) {
 	*out = *in
@@ -403,6 +533,21 @@ func (in *OperatorGroupSpec) DeepCopyInto(out *OperatorGroupSpec) {
 		*out = new(metav1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NamePatterns != nil {
+		in, out := &in.NamePatterns, &out.NamePatterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeSelector != nil {
+		in, out := &in.ExcludeSelector, &out.ExcludeSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExcludeNamePatterns != nil {
+		in, out := &in.ExcludeNamePatterns, &out.ExcludeNamePatterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.TargetNamespaces != nil {
 		in, out := &in.TargetNamespaces, &out.TargetNamespaces
 		*out = make([]string, len(*in))
@@ -444,6 +589,11 @@ func (in *OperatorGroupStatus) DeepCopyInto(out *OperatorGroupStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NamespaceStatuses != nil {
+		in, out := &in.NamespaceStatuses, &out.NamespaceStatuses
+		*out = make([]OperatorGroupNamespaceStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorGroupStatus.
@@ -491,6 +641,11 @@ func (in *OperatorList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperatorSpec) DeepCopyInto(out *OperatorSpec) {
 	*out = *in
+	if in.TargetNamespaces != nil {
+		in, out := &in.TargetNamespaces, &out.TargetNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorSpec.
@@ -523,6 +678,26 @@ func (in *OperatorStatus) DeepCopy() *OperatorStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyConfig) DeepCopyInto(out *ProxyConfig) {
+	*out = *in
+	if in.TrustedCA != nil {
+		in, out := &in.TrustedCA, &out.TrustedCA
+		*out = new(TrustedCAConfigMap)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyConfig.
+func (in *ProxyConfig) DeepCopy() *ProxyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RichReference) DeepCopyInto(out *RichReference) {
 	*out = *in
@@ -549,3 +724,18 @@ func (in *RichReference) DeepCopy() *RichReference {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustedCAConfigMap) DeepCopyInto(out *TrustedCAConfigMap) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustedCAConfigMap.
+func (in *TrustedCAConfigMap) DeepCopy() *TrustedCAConfigMap {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustedCAConfigMap)
+	in.DeepCopyInto(out)
+	return out
+}
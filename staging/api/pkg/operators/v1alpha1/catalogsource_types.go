@@ -6,6 +6,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"time"
 )
 
@@ -107,12 +108,95 @@ type GrpcPodConfig struct {
 	// default.
 	// +optional
 	PriorityClassName *string `json:"priorityClassName,omitempty"`
+
+	// Resources overrides the compute resources of the registry server container.
+	// Defaults to requesting 10m CPU and 50Mi memory, without limits.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// SecurityContext holds the pod-level security attributes of the catalog source's pod,
+	// e.g. its seccomp profile or the user it runs as.
+	// +optional
+	SecurityContext *corev1.PodSecurityContext `json:"securityContext,omitempty"`
+
+	// ContainerSecurityContext is merged into the security context of the registry server container,
+	// which defaults to a writable root filesystem. Privileged containers, privilege escalation and added
+	// capabilities are not allowed.
+	// +optional
+	ContainerSecurityContext *corev1.SecurityContext `json:"containerSecurityContext,omitempty"`
+
+	// Affinity holds the scheduling constraints of the catalog source's pod.
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+
+	// Volumes are additional volumes of the catalog source's pod, e.g. a ConfigMap holding a CA bundle.
+	// Only configMap, secret, projected, emptyDir and downwardAPI volumes are allowed.
+	// +optional
+	Volumes []corev1.Volume `json:"volumes,omitempty"`
+
+	// VolumeMounts are additional mounts of the registry server container. Each mount must refer to one of the Volumes.
+	// +optional
+	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+
+	// Replicas is the number of registry server pods serving the catalog.
+	// When set, the registry server runs as a Deployment instead of a single pod, so that the catalog
+	// stays available while its pods are evicted or rescheduled, e.g. during node drains.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// TopologySpreadConstraints describes how the replicas of the registry server are spread across the cluster.
+	// Defaults to spreading the replicas across nodes when more than one replica is requested.
+	// Only used when Replicas is set.
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+
+	// PodDisruptionBudget, if specified, limits the voluntary disruptions of the registry server replicas.
+	// Only used when Replicas is set.
+	// +optional
+	PodDisruptionBudget *CatalogPodDisruptionBudget `json:"podDisruptionBudget,omitempty"`
+}
+
+// CatalogPodDisruptionBudget configures the PodDisruptionBudget of the registry server replicas.
+// At most one of MinAvailable and MaxUnavailable may be set. When neither is set, one replica may be unavailable.
+type CatalogPodDisruptionBudget struct {
+	// MinAvailable is the number or percentage of replicas that must remain available during evictions.
+	// +optional
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+
+	// MaxUnavailable is the number or percentage of replicas that may be unavailable during evictions.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
 }
 
 // UpdateStrategy holds all the different types of catalog source update strategies
-// Currently only registry polling strategy is implemented
 type UpdateStrategy struct {
 	*RegistryPoll `json:"registryPoll,omitempty"`
+
+	// Webhook allows the catalog to be updated on demand by notifying the catalog operator,
+	// e.g. from a CI pipeline after pushing a new catalog image.
+	// +optional
+	Webhook *WebhookUpdate `json:"webhook,omitempty"`
+
+	// Windows restrict catalog updates to recurring maintenance windows.
+	// Updates that are due outside of every window wait for the next window to open.
+	// Without a polling interval, the catalog is checked for an update once each time a window opens.
+	// +optional
+	Windows []UpgradeWindow `json:"windows,omitempty"`
+
+	// ContentAware compares the content of the updated catalog with the content being served before
+	// swapping catalog pods, so that new images without content changes don't roll out new pods.
+	// +optional
+	ContentAware bool `json:"contentAware,omitempty"`
+}
+
+// WebhookUpdate configures on demand catalog updates.
+// Notifications are HTTP POST requests to the catalog operator's /catalogsources/<namespace>/<name>/update endpoint
+// with the token as a bearer token.
+type WebhookUpdate struct {
+	// SecretName is the name of a Secret in the CatalogSource's namespace whose "token" key holds the token
+	// that notifications must present. The Secret must be labeled with catalogsource.operators.coreos.com/update-webhook.
+	SecretName string `json:"secretName"`
 }
 
 type RegistryPoll struct {
@@ -151,6 +235,18 @@ type CatalogSourceStatus struct {
 	// The last time the CatalogSource image registry has been polled to ensure the image is up-to-date
 	LatestImageRegistryPoll *metav1.Time `json:"latestImageRegistryPoll,omitempty"`
 
+	// LastCheckedDigest is the image digest of the catalog found by the latest update check.
+	// +optional
+	LastCheckedDigest string `json:"lastCheckedDigest,omitempty"`
+
+	// ContentDigest is the digest of the content served by the catalog. It is only computed for content aware updates.
+	// +optional
+	ContentDigest string `json:"contentDigest,omitempty"`
+
+	// LastContentChange is the last time an update changed the content served by the catalog.
+	// +optional
+	LastContentChange *metav1.Time `json:"lastContentChange,omitempty"`
+
 	ConfigMapResource     *ConfigMapResourceReference `json:"configMapReference,omitempty"`
 	RegistryServiceStatus *RegistryServiceStatus      `json:"registryService,omitempty"`
 	GRPCConnectionState   *GRPCConnectionState        `json:"connectionState,omitempty"`
@@ -222,6 +318,10 @@ func (c *CatalogSource) Update() bool {
 	if !c.Poll() {
 		return false
 	}
+	// catalogs without a polling interval are only updated on demand or in their update windows
+	if c.Spec.UpdateStrategy.RegistryPoll == nil || c.Spec.UpdateStrategy.Interval == nil {
+		return false
+	}
 	interval := c.Spec.UpdateStrategy.Interval.Duration
 	latest := c.Status.LatestImageRegistryPoll
 	if latest == nil {
@@ -245,13 +345,14 @@ func (c *CatalogSource) Update() bool {
 	return false
 }
 
-// Poll determines whether the polling feature is enabled on the particular catalog source
+// Poll determines whether the polling feature, or any other update strategy, is enabled on the particular catalog source
 func (c *CatalogSource) Poll() bool {
 	if c.Spec.UpdateStrategy == nil {
 		return false
 	}
-	// if polling interval is zero polling will not be done
-	if c.Spec.UpdateStrategy.RegistryPoll == nil {
+	// if no update strategy is set polling will not be done
+	strategy := c.Spec.UpdateStrategy
+	if strategy.RegistryPoll == nil && strategy.Webhook == nil && len(strategy.Windows) == 0 {
 		return false
 	}
 	// if catalog source is not backed by an image polling will not be done
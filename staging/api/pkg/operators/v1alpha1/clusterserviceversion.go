@@ -206,3 +206,17 @@ func (c *ClusterServiceVersion) TrimConditionsIfLimitExceeded() {
 	firstIndex := len(c.Status.Conditions) - ConditionsLengthLimit
 	c.Status.Conditions = c.Status.Conditions[firstIndex:len(c.Status.Conditions)]
 }
+
+// Validate returns an error unless exactly one probe of the health check is set.
+func (c HealthCheck) Validate() error {
+	probes := 0
+	for _, set := range []bool{c.OperatorCondition != nil, c.HTTP != nil, c.CustomResource != nil} {
+		if set {
+			probes++
+		}
+	}
+	if probes != 1 {
+		return fmt.Errorf("health check %s must set exactly one of operatorCondition, http and customResource", c.Name)
+	}
+	return nil
+}
@@ -413,6 +413,16 @@ func TestWebhookDescGetValidatingConfigurations(t *testing.T) {
 	require.Equal(t, webhookDesc.WebhookPath, mWebhookConfig.ClientConfig.Service.Path)
 }
 
+func TestHealthCheckValidate(t *testing.T) {
+	require.NoError(t, HealthCheck{Name: "ready", OperatorCondition: &OperatorConditionHealthCheck{Type: "Ready"}}.Validate())
+	require.EqualError(t, HealthCheck{Name: "none"}.Validate(), "health check none must set exactly one of operatorCondition, http and customResource")
+	require.Error(t, HealthCheck{
+		Name:              "both",
+		OperatorCondition: &OperatorConditionHealthCheck{Type: "Ready"},
+		HTTP:              &HTTPHealthCheck{Service: "operator", Port: 8080},
+	}.Validate())
+}
+
 func helperNewConditions(count int) []ClusterServiceVersionCondition {
 	conditions := make([]ClusterServiceVersionCondition, 0)
 
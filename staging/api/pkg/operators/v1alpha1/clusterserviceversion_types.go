@@ -8,6 +8,7 @@ import (
 
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	rbac "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -313,6 +314,16 @@ type ClusterServiceVersionSpec struct {
 	// +optional
 	Cleanup CleanupSpec `json:"cleanup,omitempty"`
 
+	// CertIssuer overrides how the serving certificates of the CSV's webhooks and APIServices are issued.
+	// Defaults to the issuer configured in the cluster's OLMConfig.
+	// +optional
+	CertIssuer *CertIssuer `json:"certIssuer,omitempty"`
+
+	// HealthChecks verify the operator is healthy after it upgrades from the CSV it replaces.
+	// If they don't pass within their deadline, the upgrade fails and the replaced CSV's deployments are restored.
+	// +optional
+	HealthChecks *HealthChecks `json:"healthChecks,omitempty"`
+
 	// The name(s) of one or more CSV(s) that should be skipped in the upgrade graph.
 	// Should match the `metadata.Name` field of the CSV that should be skipped.
 	// This field is only used during catalog creation and plays no part in cluster runtime.
@@ -331,6 +342,132 @@ type CleanupSpec struct {
 	Enabled bool `json:"enabled"`
 }
 
+// CertIssuerType identifies how serving certificates are issued.
+type CertIssuerType string
+
+const (
+	// CertIssuerTypeGenerated issuers generate a CA and sign serving certificates with it.
+	CertIssuerTypeGenerated CertIssuerType = "Generated"
+	// CertIssuerTypeExternal issuers rely on an external issuer, such as cert-manager or the OpenShift service CA
+	// operator, to populate the serving certificate secrets.
+	CertIssuerTypeExternal CertIssuerType = "External"
+)
+
+// CertIssuer configures how the serving certificates of webhooks and APIServices are issued.
+// +k8s:openapi-gen=true
+type CertIssuer struct {
+	// Type of the issuer. Defaults to Generated.
+	// +kubebuilder:validation:Enum=Generated;External
+	// +optional
+	Type CertIssuerType `json:"type,omitempty"`
+
+	// External configures the External issuer.
+	// +optional
+	External *ExternalCertIssuer `json:"external,omitempty"`
+}
+
+// ExternalCertIssuer configures an issuer that populates the serving certificate secret of a webhook or APIService
+// service. The secret is named after the service, with a "-cert" suffix, and must hold tls.crt and tls.key.
+// +k8s:openapi-gen=true
+type ExternalCertIssuer struct {
+	// SecretNameAnnotation is an annotation set on the service to the name of its serving certificate secret,
+	// such as service.beta.openshift.io/serving-cert-secret-name.
+	// +optional
+	SecretNameAnnotation string `json:"secretNameAnnotation,omitempty"`
+
+	// ServiceAnnotations are added to the service as they are.
+	// +optional
+	ServiceAnnotations map[string]string `json:"serviceAnnotations,omitempty"`
+
+	// CAKey is the key of the secret that holds the PEM encoded CA bundle. Defaults to ca.crt.
+	// It's ignored when CAConfigMap is set.
+	// +optional
+	CAKey string `json:"caKey,omitempty"`
+
+	// CAConfigMap is a ConfigMap in the CSV's namespace that holds the PEM encoded CA bundle instead of the secret,
+	// such as the openshift-service-ca.crt ConfigMap.
+	// +optional
+	CAConfigMap *corev1.ConfigMapKeySelector `json:"caConfigMap,omitempty"`
+}
+
+// HealthChecks are the checks that must pass after an upgrade before the replaced CSV is removed.
+// +k8s:openapi-gen=true
+type HealthChecks struct {
+	// Checks that must all pass for the operator to be healthy.
+	Checks []HealthCheck `json:"checks"`
+
+	// Deadline is how long the checks have to pass after the new CSV's deployments become available.
+	// Defaults to 5m.
+	// +optional
+	Deadline *metav1.Duration `json:"deadline,omitempty"`
+}
+
+// HealthCheck is a single check of an operator's health. Exactly one of its probes must be set.
+// +k8s:openapi-gen=true
+// +kubebuilder:validation:MinProperties=2
+// +kubebuilder:validation:MaxProperties=2
+type HealthCheck struct {
+	// Name identifies the check in the CSV's status.
+	Name string `json:"name"`
+
+	// OperatorCondition passes when the operator's OperatorCondition has a condition with the given type and status.
+	// +optional
+	OperatorCondition *OperatorConditionHealthCheck `json:"operatorCondition,omitempty"`
+
+	// HTTP passes when a GET request to a service in the CSV's namespace returns a 2xx status code.
+	// +optional
+	HTTP *HTTPHealthCheck `json:"http,omitempty"`
+
+	// CustomResource passes when a custom resource has a status condition with the given type and status.
+	// +optional
+	CustomResource *CustomResourceHealthCheck `json:"customResource,omitempty"`
+}
+
+// OperatorConditionHealthCheck checks a condition of the operator's OperatorCondition.
+// +k8s:openapi-gen=true
+type OperatorConditionHealthCheck struct {
+	// Type of the condition.
+	Type string `json:"type"`
+
+	// Status the condition must have. Defaults to True.
+	// +optional
+	Status metav1.ConditionStatus `json:"status,omitempty"`
+}
+
+// HTTPHealthCheck checks an HTTP endpoint served by the operator.
+// +k8s:openapi-gen=true
+type HTTPHealthCheck struct {
+	// Service is the name of the service in the CSV's namespace.
+	Service string `json:"service"`
+
+	// Port of the service.
+	Port int32 `json:"port"`
+
+	// Path to request. Defaults to "/".
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
+// CustomResourceHealthCheck checks a status condition of a custom resource.
+// +k8s:openapi-gen=true
+type CustomResourceHealthCheck struct {
+	Group    string `json:"group"`
+	Version  string `json:"version"`
+	Resource string `json:"resource"`
+	Name     string `json:"name"`
+
+	// Namespace of the custom resource. Defaults to the CSV's namespace; ignored for cluster-scoped resources.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// ConditionType is the type of the condition in the resource's .status.conditions.
+	ConditionType string `json:"conditionType"`
+
+	// Status the condition must have. Defaults to True.
+	// +optional
+	Status metav1.ConditionStatus `json:"status,omitempty"`
+}
+
 // +k8s:openapi-gen=true
 type Maintainer struct {
 	Name  string `json:"name,omitempty"`
@@ -416,6 +553,7 @@ const (
 	CSVReasonInvalidWebhookDescription                   ConditionReason = "InvalidWebhookDescription"
 	CSVReasonOperatorConditionNotUpgradeable             ConditionReason = "OperatorConditionNotUpgradeable"
 	CSVReasonWaitingForCleanupToComplete                 ConditionReason = "WaitingOnCleanup"
+	CSVReasonUpgradeHealthChecksFailed                   ConditionReason = "UpgradeHealthChecksFailed"
 )
 
 // HasCaResources returns true if the CSV has owned APIServices or Webhooks.
@@ -538,6 +676,34 @@ type ClusterServiceVersionStatus struct {
 	// CleanupStatus represents information about the status of cleanup while a CSV is pending deletion
 	// +optional
 	Cleanup CleanupStatus `json:"cleanup,omitempty"`
+	// HealthChecks is the outcome of the health checks run after the CSV replaced another
+	// +optional
+	HealthChecks *HealthCheckStatus `json:"healthChecks,omitempty"`
+	// InjectedConfig is the configuration injected into each deployment of the install strategy, from the
+	// Subscription's config and the cluster proxy. Env variables are listed by name only, since their values
+	// may carry credentials.
+	// +optional
+	InjectedConfig []DeploymentConfig `json:"injectedConfig,omitempty"`
+}
+
+// HealthCheckStatus represents the outcome of the health checks of an upgraded CSV
+// +k8s:openapi-gen=true
+type HealthCheckStatus struct {
+	// Passed is the time all checks first passed. Once set, the checks aren't run again.
+	// +optional
+	Passed *metav1.Time `json:"passed,omitempty"`
+	// Results of the latest run of each check.
+	// +optional
+	Results []HealthCheckResult `json:"results,omitempty"`
+}
+
+// HealthCheckResult is the result of a single health check
+// +k8s:openapi-gen=true
+type HealthCheckResult struct {
+	Name    string `json:"name"`
+	Healthy bool   `json:"healthy"`
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // CleanupStatus represents information about the status of cleanup while a CSV is pending deletion
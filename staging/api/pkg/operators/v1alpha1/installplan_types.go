@@ -30,8 +30,37 @@ type InstallPlanSpec struct {
 	Approval                   Approval `json:"approval"`
 	Approved                   bool     `json:"approved"`
 	Generation                 int      `json:"generation,omitempty"`
+
+	// RollbackPolicy determines whether the InstallPlan is rolled back to the previously installed
+	// ClusterServiceVersions when it fails or one of the ClusterServiceVersions it installed fails.
+	// The previous installation is only recorded if a RollbackPolicy is set, so InstallPlans without
+	// one can't be rolled back.
+	// +optional
+	RollbackPolicy RollbackPolicy `json:"rollbackPolicy,omitempty"`
+
+	// Rollback requests that a Complete or Failed InstallPlan be rolled back to the previously installed
+	// ClusterServiceVersions recorded in its status, which requires a RollbackPolicy.
+	// +optional
+	Rollback bool `json:"rollback,omitempty"`
+
+	// ForceConflicts makes OLM take ownership of fields managed by other field managers
+	// when steps are applied with server-side apply.
+	// +optional
+	ForceConflicts bool `json:"forceConflicts,omitempty"`
 }
 
+// RollbackPolicy is the policy for rolling back a failed InstallPlan.
+// +kubebuilder:validation:Enum=Never;OnFailure
+type RollbackPolicy string
+
+const (
+	// RollbackPolicyNever leaves failed InstallPlans as they are, unless a rollback is requested explicitly.
+	RollbackPolicyNever RollbackPolicy = "Never"
+
+	// RollbackPolicyOnFailure rolls back failed InstallPlans automatically.
+	RollbackPolicyOnFailure RollbackPolicy = "OnFailure"
+)
+
 // InstallPlanPhase is the current status of a InstallPlan as a whole.
 type InstallPlanPhase string
 
@@ -50,6 +79,8 @@ type InstallPlanConditionType string
 const (
 	InstallPlanResolved  InstallPlanConditionType = "Resolved"
 	InstallPlanInstalled InstallPlanConditionType = "Installed"
+	// InstallPlanRolledBack indicates whether an InstallPlan has been rolled back to the previously installed ClusterServiceVersions.
+	InstallPlanRolledBack InstallPlanConditionType = "RolledBack"
 )
 
 // ConditionReason is a camelcased reason for the state transition.
@@ -60,6 +91,8 @@ const (
 	InstallPlanReasonInstallCheckFailed InstallPlanConditionReason = "InstallCheckFailed"
 	InstallPlanReasonDependencyConflict InstallPlanConditionReason = "DependenciesConflict"
 	InstallPlanReasonComponentFailed    InstallPlanConditionReason = "InstallComponentFailed"
+	InstallPlanReasonRollbackStarted    InstallPlanConditionReason = "RollbackStarted"
+	InstallPlanReasonRollbackRefused    InstallPlanConditionReason = "RollbackRefused"
 )
 
 // StepStatus is the current status of a particular resource an in
@@ -73,6 +106,7 @@ const (
 	StepStatusCreated             StepStatus = "Created"
 	StepStatusWaitingForAPI       StepStatus = "WaitingForApi"
 	StepStatusUnsupportedResource StepStatus = "UnsupportedResource"
+	StepStatusConflict            StepStatus = "Conflict"
 )
 
 // ErrInvalidInstallPlan is the error returned by functions that operate on
@@ -105,6 +139,29 @@ type InstallPlanStatus struct {
 	// plan has its current status.
 	// +optional
 	Message string `json:"message,omitempty"`
+
+	// Previous records the installation replaced by the InstallPlan, so that the InstallPlan can be rolled back.
+	// +optional
+	Previous *PreviousInstallation `json:"previous,omitempty"`
+}
+
+// PreviousInstallation records the ClusterServiceVersions replaced by an InstallPlan and where to find
+// the steps that install them again.
+type PreviousInstallation struct {
+	// Replaced maps the name of each ClusterServiceVersion installed by the InstallPlan to the name
+	// of the ClusterServiceVersion it replaced.
+	Replaced map[string]string `json:"replaced,omitempty"`
+
+	// InstallPlans maps the name of each replaced ClusterServiceVersion to the name of the InstallPlan
+	// that installed it, whose steps are applied again on rollback. Replaced ClusterServiceVersions
+	// without an InstallPlan are installed again as they are on-cluster at the time of the rollback.
+	// +optional
+	InstallPlans map[string]string `json:"installPlans,omitempty"`
+
+	// CRDStorageVersions maps the name of each CustomResourceDefinition updated by the InstallPlan
+	// to its storage version before the update.
+	// +optional
+	CRDStorageVersions map[string]string `json:"crdStorageVersions,omitempty"`
 }
 
 // InstallPlanCondition represents the overall status of the execution of
@@ -228,6 +285,10 @@ type Step struct {
 	Resolving string       `json:"resolving"`
 	Resource  StepResource `json:"resource"`
 	Status    StepStatus   `json:"status"`
+
+	// Message explains the status of the step, e.g. the field managers a Conflict is with.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // BundleLookupConditionType is a category of the overall state of a BundleLookup.
@@ -36,8 +36,60 @@ type SubscriptionSpec struct {
 	StartingCSV            string              `json:"startingCSV,omitempty"`
 	InstallPlanApproval    Approval            `json:"installPlanApproval,omitempty"`
 	Config                 *SubscriptionConfig `json:"config,omitempty"`
+
+	// UpgradeMode determines whether the installed operator is upgraded whenever an upgrade is available,
+	// or only when a change is required by the other operators in its namespace. If unset on a Subscription
+	// created by OLM to install a dependency, the UpgradeMode of the namespace's OperatorGroup is used.
+	// +optional
+	UpgradeMode UpgradeMode `json:"upgradeMode,omitempty"`
+
+	// VersionRange is a semver range, such as ">=1.2.0 <2.0.0", that the installed operator's version
+	// must stay within. A maximum version can be given as a range like "<2.0.0".
+	// +optional
+	VersionRange string `json:"versionRange,omitempty"`
+
+	// UpgradeWindows are the maintenance windows during which Automatic InstallPlans may be approved.
+	// Outside of every window, InstallPlans are held until the next window opens.
+	// If empty, Automatic InstallPlans are approved at any time.
+	// +optional
+	UpgradeWindows []UpgradeWindow `json:"upgradeWindows,omitempty"`
+
+	// RollbackPolicy is the RollbackPolicy of InstallPlans generated for the Subscription.
+	// If any Subscription in a namespace has the OnFailure policy, so do the namespace's generated InstallPlans,
+	// which otherwise have the Never policy if any Subscription does.
+	// +optional
+	RollbackPolicy RollbackPolicy `json:"rollbackPolicy,omitempty"`
+}
+
+// UpgradeWindow is a recurring maintenance window.
+type UpgradeWindow struct {
+	// Schedule is a cron expression with five fields (minute, hour, day of month, month and day of week)
+	// describing when the window opens, e.g. "0 2 * * 6" for every Saturday at 02:00.
+	Schedule string `json:"schedule"`
+
+	// Duration is how long the window stays open each time it opens, e.g. "2h".
+	Duration metav1.Duration `json:"duration"`
+
+	// TimeZone is the IANA name of the time zone the Schedule is interpreted in, e.g. "Europe/Berlin".
+	// Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
 }
 
+// UpgradeMode describes how the resolver chooses between an installed operator and its upgrades.
+// +kubebuilder:validation:Enum=Default;MinimalChange
+type UpgradeMode string
+
+const (
+	// UpgradeModeDefault upgrades installed operators to the most preferred bundle available in their channel.
+	UpgradeModeDefault UpgradeMode = "Default"
+
+	// UpgradeModeMinimalChange keeps installed operators unless changing them is required to satisfy
+	// the constraints of other operators, so that the upgrade of one operator doesn't also upgrade its
+	// dependencies unless it needs to.
+	UpgradeModeMinimalChange UpgradeMode = "MinimalChange"
+)
+
 // SubscriptionConfig contains configuration specified for a subscription.
 type SubscriptionConfig struct {
 	// Selector is the label selector for pods to be configured.
@@ -84,6 +136,87 @@ type SubscriptionConfig struct {
 	// List of VolumeMounts to set in the container.
 	// +optional
 	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+
+	// HealthChecks replace the health checks declared by the subscribed CSVs.
+	// +optional
+	HealthChecks *HealthChecks `json:"healthChecks,omitempty"`
+
+	// Affinity is the pod's scheduling constraints.
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+
+	// Annotations are added to the pod template of every deployment.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Labels are added to the pod template of every deployment. Labels already set by the pod template are
+	// kept, since the deployment's selector may depend on them.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// PriorityClassName is the priority class of the pods.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// Deployments configure individual deployments of the subscribed CSVs, on top of the configuration above.
+	// +optional
+	Deployments []DeploymentConfig `json:"deployments,omitempty"`
+}
+
+// DeploymentConfig is the configuration of a single deployment of an operator.
+type DeploymentConfig struct {
+	// Name of the deployment in the CSV's install strategy.
+	Name string `json:"name"`
+
+	// NodeSelector replaces the pod's node selector.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations are added to the pod's tolerations.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// Affinity replaces the pod's scheduling constraints.
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+
+	// Annotations are added to the pod template.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Labels are added to the pod template. Labels already set by the pod template are kept.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// PriorityClassName replaces the priority class of the pods.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// Volumes are added to the pod, replacing volumes of the same name.
+	// +optional
+	Volumes []corev1.Volume `json:"volumes,omitempty"`
+
+	// Containers configure individual containers of the deployment.
+	// +optional
+	Containers []ContainerConfig `json:"containers,omitempty"`
+}
+
+// ContainerConfig is the configuration of a single container of a deployment.
+type ContainerConfig struct {
+	// Name of the container.
+	Name string `json:"name"`
+
+	// Resources replace the compute resources of the container.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// Env is merged into the container's environment variables, replacing variables of the same name.
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+
+	// VolumeMounts are added to the container, replacing mounts of the same name.
+	// +optional
+	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
 }
 
 // SubscriptionConditionType indicates an explicit state condition about a Subscription in "abnormal-true"
@@ -105,6 +238,12 @@ const (
 
 	// SubscriptionResolutionFailed indicates that the dependency resolution in the namespace in which the subscription is created has failed
 	SubscriptionResolutionFailed SubscriptionConditionType = "ResolutionFailed"
+
+	// SubscriptionUpgradeWindowClosed indicates that a Subscription's Automatic InstallPlan is held until one of its upgrade windows opens.
+	SubscriptionUpgradeWindowClosed SubscriptionConditionType = "UpgradeWindowClosed"
+
+	// SubscriptionBundleUnpackFailed indicates that the bundles of a Subscription's InstallPlan failed to unpack and unpacking will be retried.
+	SubscriptionBundleUnpackFailed SubscriptionConditionType = "BundleUnpackFailed"
 )
 
 const (
@@ -134,6 +273,18 @@ const (
 
 	// InstallPlanFailed is a reason string for Subscriptions that transitioned due to a referenced InstallPlan failing without setting an explicit failure condition.
 	InstallPlanFailed = "InstallPlanFailed"
+
+	// OutsideUpgradeWindow is a reason string for Subscriptions whose InstallPlan is held because none of their upgrade windows are open.
+	OutsideUpgradeWindow = "OutsideUpgradeWindow"
+
+	// InvalidUpgradeWindow is a reason string for Subscriptions whose InstallPlan is held because one of their upgrade windows is invalid.
+	InvalidUpgradeWindow = "InvalidUpgradeWindow"
+
+	// BundleUnpackRetryScheduled is a reason string for Subscriptions whose failed bundle unpacking is retried after a backoff.
+	BundleUnpackRetryScheduled = "BundleUnpackRetryScheduled"
+
+	// BundleUnpackRetrying is a reason string for Subscriptions that are resolved again to retry unpacking their bundles.
+	BundleUnpackRetrying = "BundleUnpackRetrying"
 )
 
 // SubscriptionCondition represents the latest available observations of a Subscription's state.
@@ -176,6 +327,17 @@ type SubscriptionStatus struct {
 	// +optional
 	InstalledCSV string `json:"installedCSV,omitempty"`
 
+	// RolledBackCSVs are the ClusterServiceVersions whose installation was rolled back.
+	// They are excluded from resolution for the Subscription until it upgrades past the
+	// ClusterServiceVersion it was rolled back to.
+	// +optional
+	RolledBackCSVs []string `json:"rolledBackCSVs,omitempty"`
+
+	// InstallHistory records the latest InstallPlans that finished for the Subscription, oldest first.
+	// Unlike the InstallPlans themselves, the records aren't garbage collected.
+	// +optional
+	InstallHistory []InstallRecord `json:"installHistory,omitempty"`
+
 	// Install is a reference to the latest InstallPlan generated for the Subscription.
 	// DEPRECATED: InstallPlanRef
 	// +optional
@@ -202,6 +364,16 @@ type SubscriptionStatus struct {
 	// +optional
 	CatalogHealth []SubscriptionCatalogHealth `json:"catalogHealth,omitempty"`
 
+	// SharedDependencies lists the operators, installed in other namespaces by OperatorGroups targeting all namespaces,
+	// that satisfy APIs required by the Subscription's installed CSV instead of being installed alongside it.
+	// +optional
+	SharedDependencies []SharedDependency `json:"sharedDependencies,omitempty"`
+
+	// BundleUnpackRetry tracks the retries of bundle unpacking after the Subscription's InstallPlans failed to unpack its bundles.
+	// It is cleared once an InstallPlan of the Subscription completes.
+	// +optional
+	BundleUnpackRetry *BundleUnpackRetry `json:"bundleUnpackRetry,omitempty"`
+
 	// Conditions is a list of the latest available observations about a Subscription's current state.
 	// +optional
 	Conditions []SubscriptionCondition `json:"conditions,omitempty" hash:"set"`
@@ -210,6 +382,36 @@ type SubscriptionStatus struct {
 	LastUpdated metav1.Time `json:"lastUpdated"`
 }
 
+// BundleUnpackRetry records the failed attempts to unpack the bundles of a Subscription.
+type BundleUnpackRetry struct {
+	// Attempts is the number of InstallPlans that failed to unpack the Subscription's bundles.
+	Attempts int32 `json:"attempts"`
+
+	// FailedInstallPlan is the name of the latest InstallPlan that failed to unpack the Subscription's bundles.
+	FailedInstallPlan string `json:"failedInstallPlan"`
+
+	// NextRetryTime is the time at which unpacking is retried by resolving the Subscription again.
+	NextRetryTime metav1.Time `json:"nextRetryTime"`
+
+	// SourceVersions are the versions of the CatalogSources and pull secrets used by the failed attempt, by "<kind>/<namespace>/<name>".
+	// Unpacking is retried before NextRetryTime as soon as any of them changes.
+	// +optional
+	SourceVersions map[string]string `json:"sourceVersions,omitempty"`
+}
+
+// SharedDependency identifies an operator installed in another namespace whose APIs a Subscription depends on.
+type SharedDependency struct {
+	// ClusterServiceVersion is the name of the CSV providing the required APIs.
+	ClusterServiceVersion string `json:"clusterServiceVersion"`
+
+	// Namespace is the namespace the providing CSV is installed in.
+	Namespace string `json:"namespace"`
+
+	// APIs lists the required APIs provided by the CSV, formatted as <kind>.<version>.<group>.
+	// +optional
+	APIs []string `json:"apis,omitempty"`
+}
+
 // GetCondition returns the SubscriptionCondition of the given type if it exists in the SubscriptionStatus' Conditions.
 // Returns a condition of the given type with a ConditionStatus of "Unknown" if not found.
 func (s SubscriptionStatus) GetCondition(conditionType SubscriptionConditionType) SubscriptionCondition {
@@ -263,6 +465,48 @@ type InstallPlanReference struct {
 	UID        types.UID `json:"uuid"`
 }
 
+// InstallRecord describes an InstallPlan that finished for a Subscription.
+type InstallRecord struct {
+	// InstallPlan is the name of the InstallPlan.
+	InstallPlan string `json:"installPlan"`
+
+	// From is the name of the ClusterServiceVersion replaced by the installation, if any.
+	// +optional
+	From string `json:"from,omitempty"`
+
+	// To is the name of the ClusterServiceVersion installed for the Subscription.
+	To string `json:"to"`
+
+	// StartTime is the time the InstallPlan started installing.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is the time the InstallPlan finished.
+	CompletionTime metav1.Time `json:"completionTime"`
+
+	// Approval is the approval strategy of the InstallPlan.
+	Approval Approval `json:"approval"`
+
+	// Approver is the field manager that approved a Manual InstallPlan, if known.
+	// +optional
+	Approver string `json:"approver,omitempty"`
+
+	// Phase is the phase the InstallPlan finished in, either Complete or Failed.
+	Phase InstallPlanPhase `json:"phase"`
+
+	// CatalogSource is the name of the CatalogSource the ClusterServiceVersion was resolved from.
+	// +optional
+	CatalogSource string `json:"catalogSource,omitempty"`
+
+	// CatalogSourceNamespace is the namespace of the CatalogSource.
+	// +optional
+	CatalogSourceNamespace string `json:"catalogSourceNamespace,omitempty"`
+
+	// CatalogDigest is the image digest of the catalog at the time of the installation, if known.
+	// +optional
+	CatalogDigest string `json:"catalogDigest,omitempty"`
+}
+
 // SubscriptionCatalogHealth describes the health of a CatalogSource the Subscription knows about.
 type SubscriptionCatalogHealth struct {
 	// CatalogSourceRef is a reference to a CatalogSource.
@@ -167,29 +167,6 @@ func validateInstallModes(csv *v1alpha1.ClusterServiceVersion) (errs []errors.Er
 		}
 	}
 
-	// validate installModes when conversionCRDs field is present in csv.Spec.Webhookdefinitions
-	// check if WebhookDefinitions is present
-	if len(csv.Spec.WebhookDefinitions) != 0 {
-		for _, WebhookDefinition := range csv.Spec.WebhookDefinitions {
-			// check if ConversionCRDs is present
-			if len(WebhookDefinition.ConversionCRDs) != 0 {
-				supportsOnlyAllNamespaces := true
-				// check if AllNamespaces is supported and other install modes are not supported
-				for _, installMode := range csv.Spec.InstallModes {
-					if installMode.Type == "AllNamespaces" && !installMode.Supported {
-						supportsOnlyAllNamespaces = false
-					}
-					if installMode.Type != "AllNamespaces" && installMode.Supported {
-						supportsOnlyAllNamespaces = false
-					}
-				}
-				if supportsOnlyAllNamespaces == false {
-					errs = append(errs, errors.ErrInvalidCSV("only AllNamespaces InstallModeType is supported when conversionCRDs is present", csv.GetName()))
-				}
-			}
-		}
-	}
-
 	// all installModes should not be `false`
 	if !anySupported {
 		errs = append(errs, errors.ErrInvalidCSV("none of InstallModeTypes are supported", csv.GetName()))
@@ -41,13 +41,10 @@ func TestValidateCSV(t *testing.T) {
 		},
 		{
 			validatorFuncTest{
-				description: "invalid install modes when dealing with conversionCRDs",
-				wantErr:     true,
-				errors: []errors.Error{
-					errors.ErrInvalidCSV("only AllNamespaces InstallModeType is supported when conversionCRDs is present", "etcdoperator.v0.9.0"),
-				},
+				description: "valid namespaced install modes when dealing with conversionCRDs",
+				wantErr:     false,
 			},
-			filepath.Join("testdata", "incorrect.csv.with.conversion.webhook.yaml"),
+			filepath.Join("testdata", "correct.csv.with.conversion.webhook.ownnamespace.yaml"),
 		},
 		{
 			validatorFuncTest{
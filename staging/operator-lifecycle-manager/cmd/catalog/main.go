@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"path/filepath"
+	"sync/atomic"
 	"time"
 
 	configv1client "github.com/openshift/client-go/config/clientset/versioned/typed/config/v1"
@@ -15,8 +17,11 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/api/client"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/bundle"
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/operators/catalog"
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/operators/catalogtemplate"
+	resolvercache "github.com/operator-framework/operator-lifecycle-manager/pkg/controller/registry/resolver/cache"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/registry/resolver/solver"
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/lib/operatorclient"
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/lib/operatorstatus"
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/lib/server"
@@ -75,6 +80,12 @@ var (
 
 	installPlanTimeout  = flag.Duration("install-plan-retry-timeout", 1*time.Minute, "time since first attempt at which plan execution errors are considered fatal")
 	bundleUnpackTimeout = flag.Duration("bundle-unpack-timeout", 10*time.Minute, "The time limit for bundle unpacking, after which InstallPlan execution is considered to have failed. 0 is considered as having no timeout.")
+	bundleUnpackMethod  = flag.String("bundle-unpack-method", string(bundle.UnpackMethodJob), fmt.Sprintf("the default method used to unpack bundles, either %q or %q; CatalogSources can override it with the %s annotation", bundle.UnpackMethodJob, bundle.UnpackMethodDirect, bundle.BundleUnpackMethodAnnotationKey))
+	bundleCacheDir      = flag.String("bundle-cache-dir", filepath.Join(os.TempDir(), "olm-bundle-cache"), "the directory in which bundles unpacked with the Direct method are cached")
+
+	snapshotTTL                  = flag.Duration("catalog-snapshot-ttl", resolvercache.DefaultSourceTTL, "the duration for which a snapshot of a catalog's content is reused for resolution before being refreshed; catalogs served by OLM-managed pods are only listed again if their registry was replaced")
+	maxConcurrentSnapshotUpdates = flag.Int("max-concurrent-snapshot-updates", resolvercache.DefaultMaxConcurrentSnapshotUpdates, "the maximum number of catalog snapshots that may be refreshed at the same time")
+	solverBackend                = flag.String("solver-backend", solver.SearchBackendName, fmt.Sprintf("the dependency resolution backend to use, either %q or %q", solver.SearchBackendName, solver.MemoizedBackendName))
 )
 
 func init() {
@@ -107,7 +118,15 @@ func main() {
 		*catalogNamespace = catalogNamespaceEnvVarValue
 	}
 
-	listenAndServe, err := server.GetListenAndServeFunc(server.WithLogger(logger), server.WithTLS(tlsCertPath, tlsKeyPath, clientCAPath), server.WithDebug(*debug))
+	// The catalog update webhooks are served by the operator, which is only available once it has been configured.
+	catalogUpdates := &pendingHandler{}
+	serverOptions := []server.Option{server.WithLogger(logger), server.WithTLS(tlsCertPath, tlsKeyPath, clientCAPath), server.WithDebug(*debug)}
+	if *tlsCertPath != "" && *tlsKeyPath != "" {
+		serverOptions = append(serverOptions, server.WithHandler(catalog.CatalogUpdatePathPrefix, catalogUpdates))
+	} else {
+		logger.Warn("catalog update webhooks are disabled: both --tls-key and --tls-cert must be provided to serve them")
+	}
+	listenAndServe, err := server.GetListenAndServeFunc(serverOptions...)
 	if err != nil {
 		logger.Fatalf("Error setting up health/metric/pprof service: %v", err)
 	}
@@ -133,11 +152,17 @@ func main() {
 		log.Fatalf("error configuring client: %s", err.Error())
 	}
 
+	unpackMethod, err := bundle.ParseUnpackMethod(*bundleUnpackMethod)
+	if err != nil {
+		log.Fatalf("error configuring bundle unpacking: %s", err.Error())
+	}
+
 	// Create a new instance of the operator.
-	op, err := catalog.NewOperator(ctx, *kubeConfigPath, utilclock.RealClock{}, logger, *wakeupInterval, *configmapServerImage, *opmImage, *utilImage, *catalogNamespace, k8sscheme.Scheme, *installPlanTimeout, *bundleUnpackTimeout)
+	op, err := catalog.NewOperator(ctx, *kubeConfigPath, utilclock.RealClock{}, logger, *wakeupInterval, *configmapServerImage, *opmImage, *utilImage, *catalogNamespace, k8sscheme.Scheme, *installPlanTimeout, *bundleUnpackTimeout, unpackMethod, *bundleCacheDir, *snapshotTTL, *maxConcurrentSnapshotUpdates, *solverBackend)
 	if err != nil {
 		log.Fatalf("error configuring catalog operator: %s", err.Error())
 	}
+	catalogUpdates.set(op.CatalogUpdateHandler())
 
 	opCatalogTemplate, err := catalogtemplate.NewOperator(ctx, *kubeConfigPath, logger, *wakeupInterval, *catalogNamespace)
 	if err != nil {
@@ -156,3 +181,21 @@ func main() {
 
 	<-op.Done()
 }
+
+// pendingHandler responds with 503 Service Unavailable until the handler it delegates to is set.
+type pendingHandler struct {
+	handler atomic.Value
+}
+
+func (p *pendingHandler) set(handler http.Handler) {
+	p.handler.Store(handler)
+}
+
+func (p *pendingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	handler, ok := p.handler.Load().(http.Handler)
+	if !ok {
+		http.Error(w, "not ready to accept catalog updates", http.StatusServiceUnavailable)
+		return
+	}
+	handler.ServeHTTP(w, r)
+}
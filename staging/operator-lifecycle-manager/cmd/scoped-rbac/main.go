@@ -0,0 +1,227 @@
+// scoped-rbac prints the least-privilege RBAC that the ServiceAccount of an OperatorGroup needs for OLM to install
+// an operator with it, given either the operator's bundle or an InstallPlan.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/operator-framework/operator-registry/pkg/api"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/tools/clientcmd"
+	k8syaml "sigs.k8s.io/yaml"
+
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/bundle"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/registry/resolver"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/lib/scoped"
+)
+
+var (
+	kubeConfigPath = flag.String(
+		"kubeconfig", "", "absolute path to the kubeconfig file, used to discover the resources of kinds that OLM doesn't know about")
+
+	bundleDir = flag.String(
+		"bundle", "", "path to the directory of an operator bundle")
+
+	installPlanPath = flag.String(
+		"install-plan", "", "path to an InstallPlan manifest")
+
+	namespace = flag.String(
+		"namespace", "", "namespace of the OperatorGroup, defaults to the namespace of the InstallPlan")
+
+	serviceAccountName = flag.String(
+		"service-account", "", "name of the ServiceAccount of the OperatorGroup")
+)
+
+func main() {
+	flag.Parse()
+
+	if err := run(os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(out io.Writer) error {
+	if *serviceAccountName == "" {
+		return errors.New("--service-account is required")
+	}
+
+	var (
+		steps []*v1alpha1.Step
+		err   error
+	)
+	switch {
+	case *bundleDir != "" && *installPlanPath != "":
+		return errors.New("--bundle and --install-plan are mutually exclusive")
+	case *bundleDir != "":
+		if *namespace == "" {
+			return errors.New("--namespace is required with --bundle")
+		}
+		steps, err = stepsFromBundle(*bundleDir, *namespace)
+	case *installPlanPath != "":
+		steps, err = stepsFromInstallPlan(*installPlanPath)
+	default:
+		return errors.New("one of --bundle or --install-plan is required")
+	}
+	if err != nil {
+		return err
+	}
+
+	perms, err := scoped.PermissionsForSteps(*namespace, steps, manifestForStep, apiResourceFromDiscovery)
+	if err != nil {
+		return err
+	}
+
+	role, roleBinding, clusterRole, clusterRoleBinding := perms.RBACForServiceAccount(*serviceAccountName)
+	objs := []interface{}{role, roleBinding}
+	if clusterRole != nil {
+		objs = append(objs, clusterRole, clusterRoleBinding)
+	}
+	for i, obj := range objs {
+		data, err := k8syaml.Marshal(obj)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			fmt.Fprintln(out, "---")
+		}
+		if _, err := out.Write(data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// stepsFromBundle returns the steps of an InstallPlan installing the bundle in the given directory.
+func stepsFromBundle(dir, namespace string) ([]*v1alpha1.Step, error) {
+	manifestsDir := filepath.Join(dir, "manifests")
+	if _, err := os.Stat(manifestsDir); err != nil {
+		manifestsDir = dir
+	}
+	files, err := os.ReadDir(manifestsDir)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &api.Bundle{}
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+		f, err := os.Open(filepath.Join(manifestsDir, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+		objs, err := decodeManifests(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("error decoding %s: %v", file.Name(), err)
+		}
+
+		for _, obj := range objs {
+			data, err := obj.MarshalJSON()
+			if err != nil {
+				return nil, err
+			}
+			if obj.GetKind() == v1alpha1.ClusterServiceVersionKind {
+				b.CsvName = obj.GetName()
+				b.CsvJson = string(data)
+			}
+			b.Object = append(b.Object, string(data))
+		}
+	}
+	if b.CsvJson == "" {
+		return nil, fmt.Errorf("no ClusterServiceVersion found in %s", manifestsDir)
+	}
+
+	return resolver.NewStepsFromBundle(b, namespace, "", "", "")
+}
+
+func decodeManifests(r io.Reader) ([]*unstructured.Unstructured, error) {
+	var objs []*unstructured.Unstructured
+	dec := yaml.NewYAMLOrJSONDecoder(r, 30)
+	for {
+		obj := &unstructured.Unstructured{}
+		if err := dec.Decode(&obj.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				return objs, nil
+			}
+			return nil, err
+		}
+		// Skip empty documents and package manifests of bundles in the legacy format
+		if obj.GetKind() != "" {
+			objs = append(objs, obj)
+		}
+	}
+}
+
+// stepsFromInstallPlan returns the steps of the InstallPlan in the given file, and defaults the namespace to its own.
+func stepsFromInstallPlan(path string) ([]*v1alpha1.Step, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var plan v1alpha1.InstallPlan
+	if err := k8syaml.Unmarshal(data, &plan); err != nil {
+		return nil, err
+	}
+	if *namespace == "" {
+		*namespace = plan.GetNamespace()
+	}
+	if *namespace == "" {
+		return nil, errors.New("--namespace is required for InstallPlans without a namespace")
+	}
+
+	return plan.Status.Plan, nil
+}
+
+// manifestForStep returns the manifest embedded in the step. Steps of bundles that OLM unpacked reference the unpacked
+// bundle instead, which isn't available outside the cluster.
+func manifestForStep(step *v1alpha1.Step) (string, error) {
+	var ref struct {
+		Kind              string `json:"kind"`
+		Name              string `json:"name"`
+		CatalogSourceName string `json:"catalogSourceName"`
+	}
+	if err := json.Unmarshal([]byte(step.Resource.Manifest), &ref); err == nil && (ref.Kind == bundle.ConfigMapStorage || ref.Kind == bundle.CacheStorage) && ref.Name != "" && ref.CatalogSourceName != "" {
+		return "", fmt.Errorf("step %s of %s references an unpacked bundle, use --bundle instead", step.Resource.Name, step.Resolving)
+	}
+	return step.Resource.Manifest, nil
+}
+
+func apiResourceFromDiscovery(gvk schema.GroupVersionKind) (metav1.APIResource, error) {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = *kubeConfigPath
+	config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{}).ClientConfig()
+	if err != nil {
+		return metav1.APIResource{}, fmt.Errorf("a cluster is needed to discover the resource of kind %s: %v", gvk, err)
+	}
+	client, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return metav1.APIResource{}, err
+	}
+
+	resources, err := client.ServerResourcesForGroupVersion(gvk.GroupVersion().String())
+	if err != nil {
+		return metav1.APIResource{}, err
+	}
+	for _, r := range resources.APIResources {
+		if r.Kind == gvk.Kind && !strings.Contains(r.Name, "/") {
+			return r, nil
+		}
+	}
+	return metav1.APIResource{}, fmt.Errorf("no resource of kind %s found", gvk)
+}
@@ -0,0 +1,238 @@
+package bundle
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/operator-framework/operator-registry/pkg/api"
+)
+
+// DefaultCacheTTL is how long a Cache keeps bundles that aren't loaded.
+const DefaultCacheTTL = 24 * time.Hour
+
+// cachePruneInterval is the minimum interval between prunes of a Cache.
+const cachePruneInterval = time.Hour
+
+// Cache stores unpacked bundles on the local filesystem.
+// The contents of a bundle are stored once per content digest, so bundles that share objects share storage,
+// and bundles are indexed by the name of their BundleUnpackResult.
+// Bundles that haven't been stored or loaded within the TTL of the Cache are evicted when later bundles are stored.
+type Cache struct {
+	dir string
+	ttl time.Duration
+
+	// mu is held for writing while pruning, so no content is removed while bundles are stored or loaded
+	mu         sync.RWMutex
+	lastPruned time.Time
+}
+
+// cachedBundle is the index entry of a bundle, which refers to its contents by digest.
+type cachedBundle struct {
+	CsvName string   `json:"csvName"`
+	CsvJson string   `json:"csvJson,omitempty"`
+	Objects []string `json:"objects"`
+}
+
+// NewCache returns a Cache that stores bundles in the given directory, which is created if it doesn't exist,
+// and evicts them once they haven't been used for the given TTL. A TTL of zero disables eviction.
+func NewCache(dir string, ttl time.Duration) (*Cache, error) {
+	for _, d := range []string{filepath.Join(dir, "blobs", "sha256"), filepath.Join(dir, "bundles")} {
+		if err := os.MkdirAll(d, 0755); err != nil {
+			return nil, fmt.Errorf("failed to create bundle cache directory %s: %v", d, err)
+		}
+	}
+	return &Cache{dir: dir, ttl: ttl}, nil
+}
+
+// Store writes the contents of a bundle to the cache under the given name.
+func (c *Cache) Store(name string, bundle *api.Bundle) error {
+	if err := c.store(name, bundle); err != nil {
+		return err
+	}
+	c.pruneIfDue()
+	return nil
+}
+
+func (c *Cache) store(name string, bundle *api.Bundle) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	entry := cachedBundle{CsvName: bundle.GetCsvName()}
+	if bundle.GetCsvJson() != "" {
+		digest, err := c.storeBlob(bundle.GetCsvJson())
+		if err != nil {
+			return err
+		}
+		entry.CsvJson = digest
+	}
+	for _, obj := range bundle.GetObject() {
+		digest, err := c.storeBlob(obj)
+		if err != nil {
+			return err
+		}
+		entry.Objects = append(entry.Objects, digest)
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(c.bundlePath(name), data)
+}
+
+// Load reads the bundle stored under the given name. It returns nil if the bundle isn't cached.
+func (c *Cache) Load(name string) (*api.Bundle, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	data, err := ioutil.ReadFile(c.bundlePath(name))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var entry cachedBundle
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, fmt.Errorf("failed to read cached bundle %s: %v", name, err)
+	}
+
+	bundle := &api.Bundle{CsvName: entry.CsvName, Object: []string{}}
+	if entry.CsvJson != "" {
+		if bundle.CsvJson, err = c.loadBlob(entry.CsvJson); err != nil {
+			return nil, err
+		}
+	}
+	for _, digest := range entry.Objects {
+		obj, err := c.loadBlob(digest)
+		if err != nil {
+			return nil, err
+		}
+		bundle.Object = append(bundle.Object, obj)
+	}
+
+	// the modification time of an index entry is the time its bundle was last used
+	now := time.Now()
+	if err := os.Chtimes(c.bundlePath(name), now, now); err != nil {
+		return nil, err
+	}
+	return bundle, nil
+}
+
+// pruneIfDue prunes the cache if it wasn't pruned within the prune interval.
+func (c *Cache) pruneIfDue() {
+	if c.ttl <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if time.Since(c.lastPruned) < cachePruneInterval {
+		return
+	}
+	c.lastPruned = time.Now()
+	// a failed prune is retried after the interval
+	_ = c.prune(time.Now().Add(-c.ttl))
+}
+
+// prune removes the bundles last used before the given time and any content no longer referenced by a bundle.
+// The write lock must be held.
+func (c *Cache) prune(before time.Time) error {
+	entries, err := ioutil.ReadDir(filepath.Join(c.dir, "bundles"))
+	if err != nil {
+		return err
+	}
+	referenced := map[string]struct{}{}
+	for _, info := range entries {
+		path := filepath.Join(c.dir, "bundles", info.Name())
+		if info.ModTime().Before(before) {
+			if err := os.Remove(path); err != nil {
+				return err
+			}
+			continue
+		}
+
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		var entry cachedBundle
+		if err := json.Unmarshal(data, &entry); err != nil {
+			// unreadable entries and leftover temporary files can't be loaded
+			if err := os.Remove(path); err != nil {
+				return err
+			}
+			continue
+		}
+		if entry.CsvJson != "" {
+			referenced[entry.CsvJson] = struct{}{}
+		}
+		for _, digest := range entry.Objects {
+			referenced[digest] = struct{}{}
+		}
+	}
+
+	blobs, err := ioutil.ReadDir(filepath.Join(c.dir, "blobs", "sha256"))
+	if err != nil {
+		return err
+	}
+	for _, info := range blobs {
+		if _, ok := referenced[info.Name()]; ok {
+			continue
+		}
+		if err := os.Remove(c.blobPath(info.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Cache) bundlePath(name string) string {
+	return filepath.Join(c.dir, "bundles", name+".json")
+}
+
+func (c *Cache) blobPath(digest string) string {
+	return filepath.Join(c.dir, "blobs", "sha256", digest)
+}
+
+func (c *Cache) storeBlob(content string) (string, error) {
+	digest := fmt.Sprintf("%x", sha256.Sum256([]byte(content)))
+	if _, err := os.Stat(c.blobPath(digest)); err == nil {
+		return digest, nil
+	}
+	return digest, writeFileAtomic(c.blobPath(digest), []byte(content))
+}
+
+func (c *Cache) loadBlob(digest string) (string, error) {
+	content, err := ioutil.ReadFile(c.blobPath(digest))
+	if err != nil {
+		return "", fmt.Errorf("failed to read cached bundle content %s: %v", digest, err)
+	}
+	if fmt.Sprintf("%x", sha256.Sum256(content)) != digest {
+		return "", fmt.Errorf("cached bundle content %s is corrupt", digest)
+	}
+	return string(content), nil
+}
+
+// writeFileAtomic writes a file via a temporary file, so readers never see partial content.
+func writeFileAtomic(path string, data []byte) error {
+	f, err := ioutil.TempFile(filepath.Dir(path), ".tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
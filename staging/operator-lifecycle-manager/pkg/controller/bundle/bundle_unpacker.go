@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	k8slabels "k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes"
 	listersbatchv1 "k8s.io/client-go/listers/batch/v1"
@@ -47,8 +49,9 @@ const (
 type BundleUnpackResult struct {
 	*operatorsv1alpha1.BundleLookup
 
-	bundle *api.Bundle
-	name   string
+	bundle  *api.Bundle
+	name    string
+	storage string
 }
 
 func (b *BundleUnpackResult) Bundle() *api.Bundle {
@@ -59,6 +62,11 @@ func (b *BundleUnpackResult) Name() string {
 	return b.name
 }
 
+// Storage returns the kind of storage that holds the unpacked bundle, e.g. ConfigMapStorage.
+func (b *BundleUnpackResult) Storage() string {
+	return b.storage
+}
+
 // SetCondition replaces the existing BundleLookupCondition of the same type, or adds it if it was not found.
 func (b *BundleUnpackResult) SetCondition(cond operatorsv1alpha1.BundleLookupCondition) operatorsv1alpha1.BundleLookupCondition {
 	for i, existing := range b.Conditions {
@@ -82,6 +90,7 @@ func newBundleUnpackResult(lookup *operatorsv1alpha1.BundleLookup) *BundleUnpack
 	return &BundleUnpackResult{
 		BundleLookup: lookup.DeepCopy(),
 		name:         hash(lookup.Path),
+		storage:      ConfigMapStorage,
 	}
 }
 
@@ -379,6 +388,8 @@ const (
 	JobIncompleteMessage        = "unpack job not completed"
 	JobNotStartedReason         = "JobNotStarted"
 	JobNotStartedMessage        = "unpack job not yet started"
+	JobRestartedReason          = "JobRestarted"
+	JobRestartedMessage         = "unpack job of an earlier attempt failed and is being restarted"
 	NotUnpackedReason           = "BundleNotUnpacked"
 	NotUnpackedMessage          = "bundle contents have not yet been persisted to installplan status"
 )
@@ -456,10 +467,37 @@ func (c *ConfigMapUnpacker) UnpackBundle(lookup *operatorsv1alpha1.BundleLookup,
 	// Check if bundle unpack job has failed due a timeout
 	// Return a BundleJobError so we can mark the InstallPlan as Failed
 	if jobCond, isFailed := getCondition(job, batchv1.JobFailed); isFailed {
+		// A job that failed before this lookup started belongs to an earlier unpack attempt,
+		// so it's restarted rather than failing the lookup straight away
+		if staleJob(job, pendingCond) {
+			propagation := metav1.DeletePropagationBackground
+			err = c.client.BatchV1().Jobs(job.GetNamespace()).Delete(context.TODO(), job.GetName(), metav1.DeleteOptions{PropagationPolicy: &propagation})
+			if err != nil && !apierrors.IsNotFound(err) {
+				return
+			}
+			err = nil
+			if pendingCond.Reason != JobRestartedReason {
+				pendingCond.Reason = JobRestartedReason
+				pendingCond.Message = JobRestartedMessage
+				pendingCond.LastTransitionTime = &now
+				result.SetCondition(pendingCond)
+			}
+			return
+		}
+
 		// Add the BundleLookupFailed condition with the message and reason from the job failure
+		failedMessage := jobCond.Message
+		var podMsgs string
+		podMsgs, err = c.jobPodMessages(job)
+		if err != nil {
+			return
+		}
+		if podMsgs != "" {
+			failedMessage = failedMessage + ": " + podMsgs
+		}
 		failedCond.Status = corev1.ConditionTrue
 		failedCond.Reason = jobCond.Reason
-		failedCond.Message = jobCond.Message
+		failedCond.Message = failedMessage
 		failedCond.LastTransitionTime = &now
 		result.SetCondition(failedCond)
 
@@ -469,11 +507,11 @@ func (c *ConfigMapUnpacker) UnpackBundle(lookup *operatorsv1alpha1.BundleLookup,
 	if _, isComplete := getCondition(job, batchv1.JobComplete); !isComplete {
 		// In the case of an image pull failure for a non-existent image the bundle unpack job
 		// can stay pending until the ActiveDeadlineSeconds timeout ~10m
-		// To indicate why it's pending we inspect the container statuses of the
+		// To indicate why it's pending we inspect the container statuses and events of the
 		// unpack Job pods to surface that information on the bundle lookup conditions
 		pendingMessage := JobIncompleteMessage
 		var pendingContainerStatusMsgs string
-		pendingContainerStatusMsgs, err = c.pendingContainerStatusMessages(job)
+		pendingContainerStatusMsgs, err = c.jobPodMessages(job)
 		if err != nil {
 			return
 		}
@@ -517,7 +555,9 @@ func (c *ConfigMapUnpacker) UnpackBundle(lookup *operatorsv1alpha1.BundleLookup,
 	return
 }
 
-func (c *ConfigMapUnpacker) pendingContainerStatusMessages(job *batchv1.Job) (string, error) {
+// jobPodMessages describes why the pods of an unpack job haven't completed: the waiting reasons of
+// pending containers, the termination messages of failed containers and the warning events of the pods.
+func (c *ConfigMapUnpacker) jobPodMessages(job *batchv1.Job) (string, error) {
 	containerStatusMessages := []string{}
 	// List pods for unpack job
 	podLabel := map[string]string{BundleUnpackPodLabel: job.GetName()}
@@ -527,33 +567,91 @@ func (c *ConfigMapUnpacker) pendingContainerStatusMessages(job *batchv1.Job) (st
 		return "", fmt.Errorf("Failed to list pods for job(%s): %v", job.GetName(), listErr)
 	}
 
-	// Ideally there should be just 1 pod running but inspect all pods in the pending phase
-	// to see if any are stuck on an ImagePullBackOff or ErrImagePull error
 	for _, pod := range pods {
-		if pod.Status.Phase != corev1.PodPending {
-			// skip status check for non-pending pods
-			continue
+		// Ideally there should be just 1 pod running but inspect all pods in the pending phase
+		// to see if any are stuck on an ImagePullBackOff or ErrImagePull error
+		if pod.Status.Phase == corev1.PodPending {
+			for _, ic := range pod.Status.InitContainerStatuses {
+				if ic.Ready {
+					// only check non-ready containers for their waiting reasons
+					continue
+				}
+
+				msg := fmt.Sprintf("Unpack pod(%s/%s) container(%s) is pending", pod.Namespace, pod.Name, ic.Name)
+				waiting := ic.State.Waiting
+				if waiting != nil {
+					msg = fmt.Sprintf("Unpack pod(%s/%s) container(%s) is pending. Reason: %s, Message: %s",
+						pod.Namespace, pod.Name, ic.Name, waiting.Reason, waiting.Message)
+				}
+
+				// Aggregate the wait reasons for all pending containers
+				containerStatusMessages = append(containerStatusMessages, msg)
+			}
 		}
 
-		for _, ic := range pod.Status.InitContainerStatuses {
-			if ic.Ready {
-				// only check non-ready containers for their waiting reasons
+		// Containers that exited with an error usually explain why in their termination message
+		statuses := append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+		for _, cs := range statuses {
+			terminated := cs.State.Terminated
+			if terminated == nil || terminated.ExitCode == 0 {
 				continue
 			}
+			containerStatusMessages = append(containerStatusMessages, fmt.Sprintf("Unpack pod(%s/%s) container(%s) terminated. Reason: %s, Message: %s",
+				pod.Namespace, pod.Name, cs.Name, terminated.Reason, strings.TrimSpace(terminated.Message)))
+		}
 
-			msg := fmt.Sprintf("Unpack pod(%s/%s) container(%s) is pending", pod.Namespace, pod.Name, ic.Name)
-			waiting := ic.State.Waiting
-			if waiting != nil {
-				msg = fmt.Sprintf("Unpack pod(%s/%s) container(%s) is pending. Reason: %s, Message: %s",
-					pod.Namespace, pod.Name, ic.Name, waiting.Reason, waiting.Message)
-			}
+		containerStatusMessages = append(containerStatusMessages, c.podEventMessages(pod)...)
+	}
+
+	return strings.Join(containerStatusMessages, " | "), nil
+}
 
-			// Aggregate the wait reasons for all pending containers
-			containerStatusMessages = append(containerStatusMessages, msg)
+// podEventMessages returns the distinct warning events of a pod, oldest first.
+// Events are diagnostics only, so failing to list them isn't an error.
+func (c *ConfigMapUnpacker) podEventMessages(pod *corev1.Pod) []string {
+	events, err := c.client.CoreV1().Events(pod.GetNamespace()).List(context.TODO(), metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("involvedObject.name", pod.GetName()).String(),
+	})
+	if err != nil {
+		c.logger.WithError(err).Debugf("failed to list events for pod(%s/%s)", pod.GetNamespace(), pod.GetName())
+		return nil
+	}
+
+	warnings := []corev1.Event{}
+	for _, event := range events.Items {
+		if event.Type != corev1.EventTypeWarning || event.InvolvedObject.Kind != "Pod" || event.InvolvedObject.Name != pod.GetName() {
+			continue
 		}
+		warnings = append(warnings, event)
 	}
+	sort.SliceStable(warnings, func(i, j int) bool {
+		return warnings[i].LastTimestamp.Before(&warnings[j].LastTimestamp)
+	})
 
-	return strings.Join(containerStatusMessages, " | "), nil
+	var msgs []string
+	seen := map[string]struct{}{}
+	for _, event := range warnings {
+		msg := fmt.Sprintf("Unpack pod(%s/%s) event. Reason: %s, Message: %s", pod.GetNamespace(), pod.GetName(), event.Reason, event.Message)
+		if _, ok := seen[msg]; ok {
+			continue
+		}
+		seen[msg] = struct{}{}
+		msgs = append(msgs, msg)
+	}
+	return msgs
+}
+
+// staleJob returns true if a failed unpack job was started by an earlier attempt to unpack the bundle
+// rather than for the given pending lookup.
+func staleJob(job *batchv1.Job, pendingCond operatorsv1alpha1.BundleLookupCondition) bool {
+	switch pendingCond.Reason {
+	case JobNotStartedReason:
+		return true
+	case JobRestartedReason:
+		created := job.GetCreationTimestamp()
+		return pendingCond.LastTransitionTime != nil && created.Before(pendingCond.LastTransitionTime)
+	}
+	return false
 }
 
 func (c *ConfigMapUnpacker) ensureConfigmap(csRef *corev1.ObjectReference, name string) (cm *corev1.ConfigMap, err error) {
@@ -10,6 +10,7 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -1399,3 +1400,154 @@ func TestConfigMapUnpacker(t *testing.T) {
 	}
 
 }
+
+func TestConfigMapUnpackerFailedJob(t *testing.T) {
+	pathHash := hash(bundlePath)
+	start := metav1.Now()
+	failedJobCondition := batchv1.JobCondition{
+		Type:    batchv1.JobFailed,
+		Status:  corev1.ConditionTrue,
+		Reason:  "BackoffLimitExceeded",
+		Message: "Job has reached the specified backoff limit",
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns-a",
+			Name:      "unpack-pod",
+			Labels:    map[string]string{BundleUnpackPodLabel: pathHash},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodFailed,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name: "extract",
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+						ExitCode: 1,
+						Reason:   "Error",
+						Message:  "error loading manifests from directory: no such file or directory\n",
+					}},
+				},
+			},
+		},
+	}
+	event := &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Namespace: "ns-a", Name: "unpack-pod.1"},
+		InvolvedObject: corev1.ObjectReference{Kind: "Pod", Namespace: "ns-a", Name: "unpack-pod"},
+		Type:           corev1.EventTypeWarning,
+		Reason:         "FailedMount",
+		Message:        `secret "my-secret" not found`,
+	}
+
+	for _, tt := range []struct {
+		description string
+		pending     operatorsv1alpha1.BundleLookupCondition
+		expected    []operatorsv1alpha1.BundleLookupCondition
+		jobDeleted  bool
+	}{
+		{
+			description: "FreshLookup/Restarted",
+			pending: operatorsv1alpha1.BundleLookupCondition{
+				Type:    operatorsv1alpha1.BundleLookupPending,
+				Status:  corev1.ConditionTrue,
+				Reason:  JobNotStartedReason,
+				Message: JobNotStartedMessage,
+			},
+			expected: []operatorsv1alpha1.BundleLookupCondition{
+				{
+					Type:               operatorsv1alpha1.BundleLookupPending,
+					Status:             corev1.ConditionTrue,
+					Reason:             JobRestartedReason,
+					Message:            JobRestartedMessage,
+					LastTransitionTime: &start,
+				},
+			},
+			jobDeleted: true,
+		},
+		{
+			description: "StartedLookup/Failed/WithDiagnostics",
+			pending: operatorsv1alpha1.BundleLookupCondition{
+				Type:               operatorsv1alpha1.BundleLookupPending,
+				Status:             corev1.ConditionTrue,
+				Reason:             JobIncompleteReason,
+				Message:            JobIncompleteMessage,
+				LastTransitionTime: &start,
+			},
+			expected: []operatorsv1alpha1.BundleLookupCondition{
+				{
+					Type:               operatorsv1alpha1.BundleLookupPending,
+					Status:             corev1.ConditionTrue,
+					Reason:             JobIncompleteReason,
+					Message:            JobIncompleteMessage,
+					LastTransitionTime: &start,
+				},
+				{
+					Type:   BundleLookupFailed,
+					Status: corev1.ConditionTrue,
+					Reason: "BackoffLimitExceeded",
+					Message: "Job has reached the specified backoff limit: " +
+						"Unpack pod(ns-a/unpack-pod) container(extract) terminated. Reason: Error, Message: error loading manifests from directory: no such file or directory | " +
+						`Unpack pod(ns-a/unpack-pod) event. Reason: FailedMount, Message: secret "my-secret" not found`,
+					LastTransitionTime: &start,
+				},
+			},
+		},
+	} {
+		t.Run(tt.description, func(t *testing.T) {
+			client := k8sfake.NewSimpleClientset(pod, event)
+
+			period := 5 * time.Minute
+			factory := informers.NewSharedInformerFactory(client, period)
+			jobInformer := factory.Batch().V1().Jobs()
+			podLister := factory.Core().V1().Pods().Lister()
+
+			stop := make(chan struct{})
+			defer close(stop)
+
+			crClient := crfake.NewSimpleClientset(&operatorsv1alpha1.CatalogSource{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns-a", Name: "src-a"},
+			})
+			crFactory := crinformers.NewSharedInformerFactory(crClient, period)
+			csLister := crFactory.Operators().V1alpha1().CatalogSources().Lister()
+
+			unpacker, err := NewConfigmapUnpacker(
+				WithClient(client),
+				WithCatalogSourceLister(csLister),
+				WithConfigMapLister(factory.Core().V1().ConfigMaps().Lister()),
+				WithJobLister(jobInformer.Lister()),
+				WithPodLister(podLister),
+				WithRoleLister(factory.Rbac().V1().Roles().Lister()),
+				WithRoleBindingLister(factory.Rbac().V1().RoleBindings().Lister()),
+				WithOPMImage(opmImage),
+				WithUtilImage(utilImage),
+				WithNow(func() metav1.Time { return start }),
+				WithUnpackTimeout(10*time.Minute),
+			)
+			require.NoError(t, err)
+
+			job := unpacker.job(&corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "ns-a", Name: pathHash}, bundlePath, []corev1.LocalObjectReference{}, -1*time.Minute)
+			job.Status.Conditions = []batchv1.JobCondition{failedJobCondition}
+			_, err = client.BatchV1().Jobs("ns-a").Create(context.TODO(), job, metav1.CreateOptions{})
+			require.NoError(t, err)
+
+			factory.Start(stop)
+			factory.WaitForCacheSync(stop)
+			crFactory.Start(stop)
+			crFactory.WaitForCacheSync(stop)
+
+			res, err := unpacker.UnpackBundle(&operatorsv1alpha1.BundleLookup{
+				Path:             bundlePath,
+				CatalogSourceRef: &corev1.ObjectReference{Namespace: "ns-a", Name: "src-a"},
+				Conditions:       []operatorsv1alpha1.BundleLookupCondition{tt.pending},
+			}, -1*time.Minute)
+			require.NoError(t, err)
+			require.ElementsMatch(t, tt.expected, res.Conditions)
+
+			_, err = client.BatchV1().Jobs("ns-a").Get(context.TODO(), pathHash, metav1.GetOptions{})
+			if tt.jobDeleted {
+				require.True(t, apierrors.IsNotFound(err))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,411 @@
+package bundle
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/operator-framework/operator-registry/pkg/api"
+	registryclient "github.com/operator-framework/operator-registry/pkg/client"
+	"github.com/operator-framework/operator-registry/pkg/configmap"
+	"github.com/operator-framework/operator-registry/pkg/image"
+	"github.com/operator-framework/operator-registry/pkg/image/containerdregistry"
+	registrytypes "github.com/operator-framework/operator-registry/pkg/registry"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	listersoperatorsv1alpha1 "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/listers/operators/v1alpha1"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/registry"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/registry/resolver/projection"
+)
+
+const (
+	// UnpackErrorReason and UnpackErrorMessage describe a pending BundleLookup whose last unpack attempt failed.
+	UnpackErrorReason  = "UnpackError"
+	UnpackErrorMessage = "bundle could not be unpacked"
+
+	// DeadlineExceededReason describes a failed BundleLookup that wasn't unpacked within the unpack timeout.
+	DeadlineExceededReason = "DeadlineExceeded"
+)
+
+// RegistryClientProvider provides clients for the registries of CatalogSources.
+type RegistryClientProvider interface {
+	ClientsForNamespaces(namespaces ...string) map[registry.CatalogKey]registryclient.Interface
+}
+
+// RegistryFactory returns an image registry that stores images in cacheDir and reads
+// registry credentials from the docker config in configDir, if not empty.
+type RegistryFactory func(cacheDir, configDir string) (image.Registry, error)
+
+// DirectUnpacker unpacks bundles in-process, without Jobs or ConfigMaps.
+// The bundle contents are fetched from the catalog's registry if it serves them, or else pulled from the bundle image,
+// and are stored in a local Cache.
+type DirectUnpacker struct {
+	logger        *logrus.Logger
+	client        kubernetes.Interface
+	csLister      listersoperatorsv1alpha1.CatalogSourceLister
+	sources       RegistryClientProvider
+	cache         *Cache
+	newRegistry   RegistryFactory
+	now           func() metav1.Time
+	unpackTimeout time.Duration
+
+	// attempts are the unpacks in progress, or finished but not yet reported, by cache name.
+	// Bundles are unpacked in the background, so pulling images and fetching bundles doesn't block syncs.
+	mu       sync.Mutex
+	attempts map[string]*unpackAttempt
+}
+
+// unpackAttempt is the unpack of a bundle in the background.
+type unpackAttempt struct {
+	done   chan struct{}
+	bundle *api.Bundle
+	err    error
+}
+
+// errUnpacking is returned while a bundle is unpacked in the background.
+var errUnpacking = errors.New("bundle is being unpacked")
+
+var _ Unpacker = &DirectUnpacker{}
+
+func NewDirectUnpacker(logger *logrus.Logger, client kubernetes.Interface, csLister listersoperatorsv1alpha1.CatalogSourceLister, sources RegistryClientProvider, cache *Cache, now func() metav1.Time, unpackTimeout time.Duration) *DirectUnpacker {
+	return &DirectUnpacker{
+		logger:        logger,
+		client:        client,
+		csLister:      csLister,
+		sources:       sources,
+		cache:         cache,
+		newRegistry:   containerdRegistryFactory(logger),
+		now:           now,
+		unpackTimeout: unpackTimeout,
+		attempts:      map[string]*unpackAttempt{},
+	}
+}
+
+func containerdRegistryFactory(logger *logrus.Logger) RegistryFactory {
+	return func(cacheDir, configDir string) (image.Registry, error) {
+		return containerdregistry.NewRegistry(
+			containerdregistry.WithLog(logrus.NewEntry(logger)),
+			containerdregistry.WithCacheDir(cacheDir),
+			containerdregistry.WithResolverConfigDir(configDir),
+		)
+	}
+}
+
+func (c *DirectUnpacker) UnpackBundle(lookup *operatorsv1alpha1.BundleLookup, timeout time.Duration) (result *BundleUnpackResult, err error) {
+	result = newBundleUnpackResult(lookup)
+	result.storage = CacheStorage
+
+	// if bundle lookup failed condition already present, then there is nothing more to do
+	failedCond := result.GetCondition(BundleLookupFailed)
+	if failedCond.Status == corev1.ConditionTrue {
+		return result, nil
+	}
+
+	// if pending condition is not true then bundle has already been unpacked(unknown)
+	pendingCond := result.GetCondition(operatorsv1alpha1.BundleLookupPending)
+	if pendingCond.Status != corev1.ConditionTrue {
+		return result, nil
+	}
+
+	now := c.now()
+
+	if _, err = c.csLister.CatalogSources(result.CatalogSourceRef.Namespace).Get(result.CatalogSourceRef.Name); err != nil {
+		if apierrors.IsNotFound(err) && pendingCond.Reason != CatalogSourceMissingReason {
+			pendingCond.Status = corev1.ConditionTrue
+			pendingCond.Reason = CatalogSourceMissingReason
+			pendingCond.Message = CatalogSourceMissingMessage
+			pendingCond.LastTransitionTime = &now
+			result.SetCondition(pendingCond)
+			err = nil
+		}
+
+		return
+	}
+
+	// A negative timeout means the annotation was unset or malformed so the default is used
+	if timeout < time.Duration(0) {
+		timeout = c.unpackTimeout
+	}
+
+	bundle, unpackErr := c.load(lookup, timeout)
+	if errors.Is(unpackErr, errUnpacking) {
+		// the lookup is synced again until the bundle is unpacked
+		return
+	}
+	if unpackErr != nil {
+		c.logger.WithError(unpackErr).WithField("bundle", lookup.Path).Debug("failed to unpack bundle")
+
+		// Fail the lookup once attempts have failed for longer than the timeout, as the Job would have
+		if timeout != time.Duration(0) && pendingCond.Reason == UnpackErrorReason && pendingCond.LastTransitionTime != nil &&
+			now.Sub(pendingCond.LastTransitionTime.Time) > timeout {
+			failedCond.Status = corev1.ConditionTrue
+			failedCond.Reason = DeadlineExceededReason
+			failedCond.Message = fmt.Sprintf("bundle was not unpacked within %s: %v", timeout, unpackErr)
+			failedCond.LastTransitionTime = &now
+			result.SetCondition(failedCond)
+
+			return
+		}
+
+		// Failed attempts are retried, and the time of the first failure is kept to bound retries by the timeout
+		if pendingCond.Reason != UnpackErrorReason {
+			pendingCond.LastTransitionTime = &now
+		}
+		pendingCond.Status = corev1.ConditionTrue
+		pendingCond.Reason = UnpackErrorReason
+		pendingCond.Message = fmt.Sprintf("%s: %v", UnpackErrorMessage, unpackErr)
+		result.SetCondition(pendingCond)
+
+		return
+	}
+
+	result.bundle = bundle
+	if result.Bundle() == nil || len(result.Bundle().GetObject()) == 0 {
+		return
+	}
+
+	if result.BundleLookup.Properties != "" {
+		props, err := projection.PropertyListFromPropertiesAnnotation(lookup.Properties)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load bundle properties for %q: %w", lookup.Identifier, err)
+		}
+		result.bundle.Properties = props
+	}
+
+	// A successful load should remove the pending condition
+	result.RemoveCondition(operatorsv1alpha1.BundleLookupPending)
+
+	return
+}
+
+// Load returns the contents of the bundle of a BundleLookup from the cache.
+// If the bundle is missing, it's unpacked again in the background and an error is returned until it's done.
+func (c *DirectUnpacker) Load(lookup *operatorsv1alpha1.BundleLookup) (*api.Bundle, error) {
+	return c.load(lookup, c.unpackTimeout)
+}
+
+// load returns the cached bundle of a BundleLookup. If it isn't cached, the result of the last unpack of the bundle
+// is returned, or errUnpacking while a new unpack runs in the background.
+func (c *DirectUnpacker) load(lookup *operatorsv1alpha1.BundleLookup, timeout time.Duration) (*api.Bundle, error) {
+	name := hash(lookup.Path)
+	bundle, err := c.cache.Load(name)
+	if err != nil {
+		c.logger.WithError(err).WithField("bundle", lookup.Path).Warn("failed to load cached bundle, unpacking it again")
+	}
+	if bundle != nil {
+		return bundle, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if attempt, ok := c.attempts[name]; ok {
+		select {
+		case <-attempt.done:
+			delete(c.attempts, name)
+			return attempt.bundle, attempt.err
+		default:
+			return nil, errUnpacking
+		}
+	}
+
+	cs, err := c.csLister.CatalogSources(lookup.CatalogSourceRef.Namespace).Get(lookup.CatalogSourceRef.Name)
+	if err != nil {
+		return nil, err
+	}
+	attempt := &unpackAttempt{done: make(chan struct{})}
+	c.attempts[name] = attempt
+	go func() {
+		defer close(attempt.done)
+		attempt.bundle, attempt.err = c.unpack(name, cs, lookup.DeepCopy(), timeout)
+	}()
+	return nil, errUnpacking
+}
+
+// unpack fetches the bundle of a BundleLookup from its catalog or bundle image and stores it in the cache.
+func (c *DirectUnpacker) unpack(name string, cs *operatorsv1alpha1.CatalogSource, lookup *operatorsv1alpha1.BundleLookup, timeout time.Duration) (*api.Bundle, error) {
+	ctx := context.TODO()
+	if timeout > time.Duration(0) {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	bundle, err := c.bundleFromCatalog(ctx, cs, lookup)
+	if err != nil {
+		c.logger.WithError(err).WithField("bundle", lookup.Path).Debug("bundle contents not available from catalog, pulling bundle image")
+	}
+	if bundle == nil || len(bundle.GetObject()) == 0 {
+		if bundle, err = c.bundleFromImage(ctx, cs, lookup.Path); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := c.cache.Store(name, bundle); err != nil {
+		return nil, fmt.Errorf("failed to cache bundle %s: %v", lookup.Path, err)
+	}
+	return bundle, nil
+}
+
+// bundleFromCatalog gets the bundle from the registry of the CatalogSource it was resolved from.
+// Catalogs only serve the objects of bundles whose contents they include.
+func (c *DirectUnpacker) bundleFromCatalog(ctx context.Context, cs *operatorsv1alpha1.CatalogSource, lookup *operatorsv1alpha1.BundleLookup) (*api.Bundle, error) {
+	key := registry.CatalogKey{Name: cs.GetName(), Namespace: cs.GetNamespace()}
+	client, ok := c.sources.ClientsForNamespaces(cs.GetNamespace())[key]
+	if !ok {
+		return nil, fmt.Errorf("no connection to catalog %s", key)
+	}
+
+	pkgName, err := packageName(lookup.Properties)
+	if err != nil {
+		return nil, err
+	}
+	pkg, err := client.GetPackage(ctx, pkgName)
+	if err != nil {
+		return nil, err
+	}
+
+	// The bundle can be fetched from any channel that contains it, so try the default channel first
+	channels := pkg.GetChannels()
+	sort.SliceStable(channels, func(i, j int) bool {
+		return channels[i].GetName() == pkg.GetDefaultChannelName() && channels[j].GetName() != pkg.GetDefaultChannelName()
+	})
+	for _, channel := range channels {
+		bundle, err := client.GetBundle(ctx, pkgName, channel.GetName(), lookup.Identifier)
+		if err != nil || bundle.GetBundlePath() != lookup.Path {
+			continue
+		}
+		return bundle, nil
+	}
+	return nil, fmt.Errorf("bundle %s not found in package %s", lookup.Identifier, pkgName)
+}
+
+// bundleFromImage pulls and unpacks the bundle image, using the pull secrets of the CatalogSource.
+func (c *DirectUnpacker) bundleFromImage(ctx context.Context, cs *operatorsv1alpha1.CatalogSource, path string) (*api.Bundle, error) {
+	dir, err := ioutil.TempDir("", "bundle-unpack-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	var configDir string
+	if len(cs.Spec.Secrets) > 0 {
+		configDir = filepath.Join(dir, "docker")
+		if err := c.writeDockerConfig(ctx, cs, configDir); err != nil {
+			return nil, err
+		}
+	}
+
+	reg, err := c.newRegistry(filepath.Join(dir, "cache"), configDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create image registry: %v", err)
+	}
+	defer func() {
+		if err := reg.Destroy(); err != nil {
+			c.logger.WithError(err).Warn("failed to clean up image registry")
+		}
+	}()
+
+	ref := image.SimpleReference(path)
+	if err := reg.Pull(ctx, ref); err != nil {
+		return nil, fmt.Errorf("failed to pull bundle image %s: %v", path, err)
+	}
+	bundleDir := filepath.Join(dir, "bundle")
+	if err := reg.Unpack(ctx, ref, bundleDir); err != nil {
+		return nil, fmt.Errorf("failed to unpack bundle image %s: %v", path, err)
+	}
+
+	return loadManifests(filepath.Join(bundleDir, "manifests"))
+}
+
+// loadManifests loads a bundle from a manifests directory the same way the unpack Job does, but without a ConfigMap.
+func loadManifests(dir string) (*api.Bundle, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	cm := &corev1.ConfigMap{Data: map[string]string{}}
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+		content, err := ioutil.ReadFile(filepath.Join(dir, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+		cm.Data[file.Name()] = string(content)
+	}
+
+	return configmap.NewBundleLoader().Load(cm)
+}
+
+// writeDockerConfig writes the registry credentials of the pull secrets of the CatalogSource to a docker config in dir.
+func (c *DirectUnpacker) writeDockerConfig(ctx context.Context, cs *operatorsv1alpha1.CatalogSource, dir string) error {
+	auths := map[string]json.RawMessage{}
+	for _, name := range cs.Spec.Secrets {
+		secret, err := c.client.CoreV1().Secrets(cs.GetNamespace()).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get pull secret %s: %v", name, err)
+		}
+
+		var secretAuths map[string]json.RawMessage
+		switch secret.Type {
+		case corev1.SecretTypeDockerConfigJson:
+			var config struct {
+				Auths map[string]json.RawMessage `json:"auths"`
+			}
+			err = json.Unmarshal(secret.Data[corev1.DockerConfigJsonKey], &config)
+			secretAuths = config.Auths
+		case corev1.SecretTypeDockercfg:
+			err = json.Unmarshal(secret.Data[corev1.DockerConfigKey], &secretAuths)
+		default:
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read pull secret %s: %v", name, err)
+		}
+		for registry, auth := range secretAuths {
+			auths[registry] = auth
+		}
+	}
+
+	data, err := json.Marshal(map[string]interface{}{"auths": auths})
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, "config.json"), data, 0600)
+}
+
+// packageName returns the name of the package from a bundle's properties annotation.
+func packageName(properties string) (string, error) {
+	props, err := projection.PropertyListFromPropertiesAnnotation(properties)
+	if err != nil {
+		return "", err
+	}
+	for _, prop := range props {
+		if prop.GetType() != registrytypes.PackageType {
+			continue
+		}
+		var pkg registrytypes.PackageProperty
+		if err := json.Unmarshal([]byte(prop.GetValue()), &pkg); err != nil {
+			return "", err
+		}
+		return pkg.PackageName, nil
+	}
+	return "", fmt.Errorf("bundle properties do not include a package")
+}
@@ -0,0 +1,400 @@
+package bundle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/operator-framework/operator-registry/pkg/api"
+	registryclient "github.com/operator-framework/operator-registry/pkg/client"
+	"github.com/operator-framework/operator-registry/pkg/image"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	crfake "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/clientset/versioned/fake"
+	crinformers "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/informers/externalversions"
+	listersoperatorsv1alpha1 "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/listers/operators/v1alpha1"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/registry"
+)
+
+type fakeRegistryClient struct {
+	registryclient.Interface
+	pkg     *api.Package
+	bundles map[string]*api.Bundle
+}
+
+func (c *fakeRegistryClient) GetPackage(ctx context.Context, packageName string) (*api.Package, error) {
+	if c.pkg == nil || c.pkg.GetName() != packageName {
+		return nil, fmt.Errorf("package %s not found", packageName)
+	}
+	return c.pkg, nil
+}
+
+func (c *fakeRegistryClient) GetBundle(ctx context.Context, packageName, channelName, csvName string) (*api.Bundle, error) {
+	bundle, ok := c.bundles[channelName+"/"+csvName]
+	if !ok {
+		return nil, fmt.Errorf("bundle %s not found in channel %s", csvName, channelName)
+	}
+	return bundle, nil
+}
+
+type fakeRegistryClientProvider map[registry.CatalogKey]registryclient.Interface
+
+func (p fakeRegistryClientProvider) ClientsForNamespaces(namespaces ...string) map[registry.CatalogKey]registryclient.Interface {
+	return p
+}
+
+type fakeImageRegistry struct {
+	image.Registry
+	files   map[string]string
+	pullErr error
+	pulled  []string
+}
+
+func (r *fakeImageRegistry) Pull(ctx context.Context, ref image.Reference) error {
+	r.pulled = append(r.pulled, ref.String())
+	return r.pullErr
+}
+
+func (r *fakeImageRegistry) Unpack(ctx context.Context, ref image.Reference, dir string) error {
+	for name, content := range r.files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *fakeImageRegistry) Destroy() error {
+	return nil
+}
+
+func catalogSourceLister(t *testing.T, objs ...runtime.Object) listersoperatorsv1alpha1.CatalogSourceLister {
+	stop := make(chan struct{})
+	t.Cleanup(func() { close(stop) })
+
+	crFactory := crinformers.NewSharedInformerFactory(crfake.NewSimpleClientset(objs...), 5*time.Minute)
+	csLister := crFactory.Operators().V1alpha1().CatalogSources().Lister()
+	crFactory.Start(stop)
+	crFactory.WaitForCacheSync(stop)
+	return csLister
+}
+
+func TestCache(t *testing.T) {
+	cache, err := NewCache(t.TempDir(), 0)
+	require.NoError(t, err)
+
+	missing, err := cache.Load("missing")
+	require.NoError(t, err)
+	require.Nil(t, missing)
+
+	a := &api.Bundle{CsvName: "etcdoperator.v0.9.2", CsvJson: csvJson, Object: []string{csvJson, etcdBackup, etcdCluster}}
+	b := &api.Bundle{CsvName: "etcdoperator.v0.9.2", CsvJson: csvJson, Object: []string{csvJson, etcdBackup, etcdRestore}}
+	require.NoError(t, cache.Store("a", a))
+	require.NoError(t, cache.Store("b", b))
+
+	loaded, err := cache.Load("a")
+	require.NoError(t, err)
+	require.Equal(t, a, loaded)
+	loaded, err = cache.Load("b")
+	require.NoError(t, err)
+	require.Equal(t, b, loaded)
+
+	// Content shared between bundles is stored once
+	blobs, err := ioutil.ReadDir(filepath.Join(cache.dir, "blobs", "sha256"))
+	require.NoError(t, err)
+	require.Len(t, blobs, 4)
+
+	// Bundles that weren't used within the TTL are evicted with content only they reference
+	require.NoError(t, cache.Store("c", &api.Bundle{CsvName: "etcdoperator.v0.9.2", Object: []string{etcdCluster}}))
+	old := time.Now().Add(-2 * time.Hour)
+	require.NoError(t, os.Chtimes(cache.bundlePath("b"), old, old))
+	require.NoError(t, os.Chtimes(cache.bundlePath("c"), old, old))
+	_, err = cache.Load("c")
+	require.NoError(t, err)
+	require.NoError(t, cache.prune(time.Now().Add(-time.Hour)))
+	evicted, err := cache.Load("b")
+	require.NoError(t, err)
+	require.Nil(t, evicted)
+	loaded, err = cache.Load("a")
+	require.NoError(t, err)
+	require.Equal(t, a, loaded)
+	blobs, err = ioutil.ReadDir(filepath.Join(cache.dir, "blobs", "sha256"))
+	require.NoError(t, err)
+	require.Len(t, blobs, 3)
+
+	// Corrupt content is detected
+	require.NoError(t, ioutil.WriteFile(filepath.Join(cache.dir, "blobs", "sha256", blobs[0].Name()), []byte("corrupt"), 0644))
+	_, err = cache.Load("a")
+	require.Error(t, err)
+}
+
+func TestDirectUnpacker(t *testing.T) {
+	start := metav1.NewTime(time.Date(2021, 3, 6, 12, 0, 0, 0, time.UTC))
+	properties := `{"properties":[{"type":"olm.package","value":{"packageName":"etcd","version":"0.9.2"}}]}`
+	catalogKey := registry.CatalogKey{Name: "src-a", Namespace: "ns-a"}
+
+	pendingLookup := func(reason string, lastTransition *metav1.Time) *operatorsv1alpha1.BundleLookup {
+		return &operatorsv1alpha1.BundleLookup{
+			Path:             bundlePath,
+			Identifier:       "etcdoperator.v0.9.2",
+			Properties:       properties,
+			CatalogSourceRef: &corev1.ObjectReference{Namespace: catalogKey.Namespace, Name: catalogKey.Name},
+			Conditions: []operatorsv1alpha1.BundleLookupCondition{{
+				Type:               operatorsv1alpha1.BundleLookupPending,
+				Status:             corev1.ConditionTrue,
+				Reason:             reason,
+				Message:            JobNotStartedMessage,
+				LastTransitionTime: lastTransition,
+			}},
+		}
+	}
+	catalogBundle := &api.Bundle{CsvName: "etcdoperator.v0.9.2", CsvJson: csvJson, BundlePath: bundlePath, Object: []string{csvJson, etcdBackup}}
+
+	for _, tt := range []struct {
+		name      string
+		lookup    *operatorsv1alpha1.BundleLookup
+		sources   fakeRegistryClientProvider
+		registry  *fakeImageRegistry
+		now       metav1.Time
+		pulled    []string
+		objects   []string
+		condition *operatorsv1alpha1.BundleLookupCondition
+	}{
+		{
+			name:   "FromCatalog",
+			lookup: pendingLookup(JobNotStartedReason, nil),
+			sources: fakeRegistryClientProvider{catalogKey: &fakeRegistryClient{
+				pkg:     &api.Package{Name: "etcd", DefaultChannelName: "beta", Channels: []*api.Channel{{Name: "alpha"}, {Name: "beta"}}},
+				bundles: map[string]*api.Bundle{"beta/etcdoperator.v0.9.2": catalogBundle},
+			}},
+			registry: &fakeImageRegistry{},
+			now:      start,
+			objects:  []string{csvJson, etcdBackup},
+		},
+		{
+			name:   "FromImage",
+			lookup: pendingLookup(JobNotStartedReason, nil),
+			sources: fakeRegistryClientProvider{catalogKey: &fakeRegistryClient{
+				pkg:     &api.Package{Name: "etcd", DefaultChannelName: "beta", Channels: []*api.Channel{{Name: "beta"}}},
+				bundles: map[string]*api.Bundle{"beta/etcdoperator.v0.9.2": {CsvName: "etcdoperator.v0.9.2", BundlePath: bundlePath}},
+			}},
+			registry: &fakeImageRegistry{files: map[string]string{
+				"manifests/etcdoperator.clusterserviceversion.yaml": csvJson,
+				"manifests/etcdbackups.crd.yaml":                    etcdBackup,
+				"metadata/annotations.yaml":                         "annotations:\n  operators.operatorframework.io.bundle.package.v1: etcd\n",
+			}},
+			now:     start,
+			pulled:  []string{bundlePath},
+			objects: []string{csvJson, etcdBackup},
+		},
+		{
+			name:     "PullFailed",
+			lookup:   pendingLookup(JobNotStartedReason, nil),
+			sources:  fakeRegistryClientProvider{},
+			registry: &fakeImageRegistry{pullErr: fmt.Errorf("manifest unknown")},
+			now:      start,
+			pulled:   []string{bundlePath},
+			condition: &operatorsv1alpha1.BundleLookupCondition{
+				Type:               operatorsv1alpha1.BundleLookupPending,
+				Status:             corev1.ConditionTrue,
+				Reason:             UnpackErrorReason,
+				Message:            "bundle could not be unpacked: failed to pull bundle image bundle-path: manifest unknown",
+				LastTransitionTime: &start,
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			cs := &operatorsv1alpha1.CatalogSource{ObjectMeta: metav1.ObjectMeta{Name: catalogKey.Name, Namespace: catalogKey.Namespace}}
+			cache, err := NewCache(t.TempDir(), 0)
+			require.NoError(t, err)
+
+			unpacker := NewDirectUnpacker(logrus.New(), k8sfake.NewSimpleClientset(), catalogSourceLister(t, cs), tt.sources, cache, func() metav1.Time { return tt.now }, 10*time.Minute)
+			unpacker.newRegistry = func(cacheDir, configDir string) (image.Registry, error) {
+				require.Empty(t, configDir)
+				return tt.registry, nil
+			}
+
+			// the bundle is unpacked in the background
+			res, err := unpacker.UnpackBundle(tt.lookup, -1*time.Minute)
+			require.NoError(t, err)
+			require.Equal(t, tt.lookup.Conditions, res.Conditions)
+			require.Nil(t, res.Bundle())
+			waitForUnpacks(unpacker)
+
+			res, err = unpacker.UnpackBundle(tt.lookup, -1*time.Minute)
+			require.NoError(t, err)
+			require.Equal(t, CacheStorage, res.Storage())
+			require.Equal(t, tt.pulled, tt.registry.pulled)
+
+			if tt.condition != nil {
+				require.Equal(t, *tt.condition, res.GetCondition(tt.condition.Type))
+				require.Nil(t, res.Bundle())
+				return
+			}
+
+			require.Equal(t, corev1.ConditionUnknown, res.GetCondition(operatorsv1alpha1.BundleLookupPending).Status)
+			require.ElementsMatch(t, tt.objects, res.Bundle().GetObject())
+			require.Equal(t, "etcdoperator.v0.9.2", res.Bundle().GetCsvName())
+			require.Len(t, res.Bundle().GetProperties(), 1)
+
+			// The unpacked bundle is loaded from the cache from then on
+			tt.registry.pullErr = fmt.Errorf("unexpected pull")
+			unpacker.sources = fakeRegistryClientProvider{}
+			cached, err := unpacker.Load(tt.lookup)
+			require.NoError(t, err)
+			require.ElementsMatch(t, tt.objects, cached.GetObject())
+		})
+	}
+}
+
+// waitForUnpacks waits for the unpacks the DirectUnpacker runs in the background.
+func waitForUnpacks(u *DirectUnpacker) {
+	u.mu.Lock()
+	var attempts []*unpackAttempt
+	for _, attempt := range u.attempts {
+		attempts = append(attempts, attempt)
+	}
+	u.mu.Unlock()
+	for _, attempt := range attempts {
+		<-attempt.done
+	}
+}
+
+func TestDirectUnpackerDeadline(t *testing.T) {
+	start := time.Date(2021, 3, 6, 12, 0, 0, 0, time.UTC)
+	cs := &operatorsv1alpha1.CatalogSource{ObjectMeta: metav1.ObjectMeta{Name: "src-a", Namespace: "ns-a"}}
+	cache, err := NewCache(t.TempDir(), 0)
+	require.NoError(t, err)
+
+	now := metav1.NewTime(start)
+	unpacker := NewDirectUnpacker(logrus.New(), k8sfake.NewSimpleClientset(), catalogSourceLister(t, cs), fakeRegistryClientProvider{}, cache, func() metav1.Time { return now }, 10*time.Minute)
+	unpacker.newRegistry = func(cacheDir, configDir string) (image.Registry, error) {
+		return &fakeImageRegistry{pullErr: fmt.Errorf("manifest unknown")}, nil
+	}
+
+	lookup := &operatorsv1alpha1.BundleLookup{
+		Path:             bundlePath,
+		CatalogSourceRef: &corev1.ObjectReference{Namespace: "ns-a", Name: "src-a"},
+		Conditions: []operatorsv1alpha1.BundleLookupCondition{{
+			Type:   operatorsv1alpha1.BundleLookupPending,
+			Status: corev1.ConditionTrue,
+			Reason: JobNotStartedReason,
+		}},
+	}
+	// attempt fails the unpack of the lookup once and returns the updated lookup
+	attempt := func(at time.Duration) *BundleUnpackResult {
+		now = metav1.NewTime(start.Add(at))
+		res, err := unpacker.UnpackBundle(lookup, -1*time.Minute)
+		require.NoError(t, err)
+		waitForUnpacks(unpacker)
+		res, err = unpacker.UnpackBundle(res.BundleLookup, -1*time.Minute)
+		require.NoError(t, err)
+		lookup = res.BundleLookup
+		return res
+	}
+
+	// the first failure is the start of the deadline, which later failures keep
+	for _, at := range []time.Duration{0, 5 * time.Minute, 10 * time.Minute} {
+		cond := attempt(at).GetCondition(operatorsv1alpha1.BundleLookupPending)
+		require.Equal(t, UnpackErrorReason, cond.Reason)
+		require.Equal(t, start, cond.LastTransitionTime.Time.UTC())
+	}
+
+	res := attempt(11 * time.Minute)
+	failed := metav1.NewTime(start.Add(11 * time.Minute))
+	require.Equal(t, operatorsv1alpha1.BundleLookupCondition{
+		Type:               BundleLookupFailed,
+		Status:             corev1.ConditionTrue,
+		Reason:             DeadlineExceededReason,
+		Message:            "bundle was not unpacked within 10m0s: failed to pull bundle image bundle-path: manifest unknown",
+		LastTransitionTime: &failed,
+	}, res.GetCondition(BundleLookupFailed))
+}
+
+func TestDirectUnpackerPullSecrets(t *testing.T) {
+	cs := &operatorsv1alpha1.CatalogSource{
+		ObjectMeta: metav1.ObjectMeta{Name: "src-a", Namespace: "ns-a"},
+		Spec:       operatorsv1alpha1.CatalogSourceSpec{Secrets: []string{"pull-json", "pull-cfg", "opaque"}},
+	}
+	secrets := []runtime.Object{
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "pull-json", Namespace: "ns-a"},
+			Type:       corev1.SecretTypeDockerConfigJson,
+			Data:       map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":{"quay.io":{"auth":"YTpi"}}}`)},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "pull-cfg", Namespace: "ns-a"},
+			Type:       corev1.SecretTypeDockercfg,
+			Data:       map[string][]byte{corev1.DockerConfigKey: []byte(`{"registry.example.com":{"auth":"Yzpk"}}`)},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "opaque", Namespace: "ns-a"},
+			Data:       map[string][]byte{"token": []byte("secret")},
+		},
+	}
+	cache, err := NewCache(t.TempDir(), 0)
+	require.NoError(t, err)
+
+	unpacker := NewDirectUnpacker(logrus.New(), k8sfake.NewSimpleClientset(secrets...), catalogSourceLister(t, cs), fakeRegistryClientProvider{}, cache, metav1.Now, 0)
+	var config string
+	unpacker.newRegistry = func(cacheDir, configDir string) (image.Registry, error) {
+		content, err := ioutil.ReadFile(filepath.Join(configDir, "config.json"))
+		config = string(content)
+		return &fakeImageRegistry{files: map[string]string{"manifests/csv.yaml": csvJson}}, err
+	}
+
+	lookup := &operatorsv1alpha1.BundleLookup{Path: bundlePath, CatalogSourceRef: &corev1.ObjectReference{Namespace: "ns-a", Name: "src-a"}}
+	_, err = unpacker.Load(lookup)
+	require.True(t, errors.Is(err, errUnpacking))
+	waitForUnpacks(unpacker)
+	_, err = unpacker.Load(lookup)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"auths":{"quay.io":{"auth":"YTpi"},"registry.example.com":{"auth":"Yzpk"}}}`, config)
+}
+
+type fakeUnpacker string
+
+func (u fakeUnpacker) UnpackBundle(lookup *operatorsv1alpha1.BundleLookup, timeout time.Duration) (*BundleUnpackResult, error) {
+	return &BundleUnpackResult{name: string(u)}, nil
+}
+
+func TestMethodUnpacker(t *testing.T) {
+	csLister := catalogSourceLister(t,
+		&operatorsv1alpha1.CatalogSource{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "ns"}},
+		&operatorsv1alpha1.CatalogSource{ObjectMeta: metav1.ObjectMeta{Name: "direct", Namespace: "ns", Annotations: map[string]string{BundleUnpackMethodAnnotationKey: "Direct"}}},
+		&operatorsv1alpha1.CatalogSource{ObjectMeta: metav1.ObjectMeta{Name: "unknown", Namespace: "ns", Annotations: map[string]string{BundleUnpackMethodAnnotationKey: "Magic"}}},
+	)
+
+	_, err := NewMethodUnpacker(logrus.New(), csLister, UnpackMethodDirect, map[UnpackMethod]Unpacker{UnpackMethodJob: fakeUnpacker("job")})
+	require.Error(t, err)
+
+	unpacker, err := NewMethodUnpacker(logrus.New(), csLister, UnpackMethodJob, map[UnpackMethod]Unpacker{
+		UnpackMethodJob:    fakeUnpacker("job"),
+		UnpackMethodDirect: fakeUnpacker("direct"),
+	})
+	require.NoError(t, err)
+
+	for catalog, expected := range map[string]string{"default": "job", "direct": "direct", "unknown": "job", "missing": "job"} {
+		res, err := unpacker.UnpackBundle(&operatorsv1alpha1.BundleLookup{CatalogSourceRef: &corev1.ObjectReference{Namespace: "ns", Name: catalog}}, time.Minute)
+		require.NoError(t, err)
+		require.Equal(t, expected, res.Name(), catalog)
+	}
+
+	_, err = ParseUnpackMethod("Magic")
+	require.Error(t, err)
+}
@@ -0,0 +1,94 @@
+package bundle
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	listersoperatorsv1alpha1 "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/listers/operators/v1alpha1"
+)
+
+// BundleUnpackMethodAnnotationKey allows setting the method used to unpack the bundles of a CatalogSource
+// and overrides the default specified by the --bundle-unpack-method flag
+const BundleUnpackMethodAnnotationKey = "operatorframework.io/bundle-unpack-method"
+
+// UnpackMethod is a way of unpacking bundles.
+type UnpackMethod string
+
+const (
+	// UnpackMethodJob unpacks each bundle into a ConfigMap with a Job.
+	UnpackMethodJob UnpackMethod = "Job"
+
+	// UnpackMethodDirect unpacks bundles in-process from the catalog or the bundle image into a local cache.
+	UnpackMethodDirect UnpackMethod = "Direct"
+)
+
+// ParseUnpackMethod returns the UnpackMethod of the given name.
+func ParseUnpackMethod(method string) (UnpackMethod, error) {
+	switch m := UnpackMethod(method); m {
+	case UnpackMethodJob, UnpackMethodDirect:
+		return m, nil
+	default:
+		return "", fmt.Errorf("unknown bundle unpack method %q, must be either %q or %q", method, UnpackMethodJob, UnpackMethodDirect)
+	}
+}
+
+const (
+	// ConfigMapStorage is the storage of bundles unpacked into ConfigMaps.
+	ConfigMapStorage = "ConfigMap"
+
+	// CacheStorage is the storage of bundles unpacked into a local Cache.
+	CacheStorage = "BundleCache"
+)
+
+// MethodUnpacker unpacks each bundle with the method selected by its CatalogSource, or the default method.
+type MethodUnpacker struct {
+	logger        *logrus.Logger
+	csLister      listersoperatorsv1alpha1.CatalogSourceLister
+	defaultMethod UnpackMethod
+	unpackers     map[UnpackMethod]Unpacker
+}
+
+var _ Unpacker = &MethodUnpacker{}
+
+func NewMethodUnpacker(logger *logrus.Logger, csLister listersoperatorsv1alpha1.CatalogSourceLister, defaultMethod UnpackMethod, unpackers map[UnpackMethod]Unpacker) (*MethodUnpacker, error) {
+	if _, ok := unpackers[defaultMethod]; !ok {
+		return nil, fmt.Errorf("no unpacker for the default bundle unpack method %q", defaultMethod)
+	}
+	return &MethodUnpacker{
+		logger:        logger,
+		csLister:      csLister,
+		defaultMethod: defaultMethod,
+		unpackers:     unpackers,
+	}, nil
+}
+
+func (u *MethodUnpacker) UnpackBundle(lookup *operatorsv1alpha1.BundleLookup, timeout time.Duration) (*BundleUnpackResult, error) {
+	return u.unpackers[u.methodFor(lookup)].UnpackBundle(lookup, timeout)
+}
+
+// methodFor returns the unpack method selected by the CatalogSource of a BundleLookup.
+func (u *MethodUnpacker) methodFor(lookup *operatorsv1alpha1.BundleLookup) UnpackMethod {
+	if lookup.CatalogSourceRef == nil {
+		return u.defaultMethod
+	}
+	cs, err := u.csLister.CatalogSources(lookup.CatalogSourceRef.Namespace).Get(lookup.CatalogSourceRef.Name)
+	if err != nil {
+		return u.defaultMethod
+	}
+	value, ok := cs.GetAnnotations()[BundleUnpackMethodAnnotationKey]
+	if !ok {
+		return u.defaultMethod
+	}
+	method, err := ParseUnpackMethod(value)
+	if err != nil {
+		u.logger.WithError(err).WithField("catalogsource", cs.GetNamespace()+"/"+cs.GetName()).Warn("using the default bundle unpack method")
+		return u.defaultMethod
+	}
+	if _, ok := u.unpackers[method]; !ok {
+		return u.defaultMethod
+	}
+	return method
+}
@@ -0,0 +1,242 @@
+package install
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/api/wrappers"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/certs"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/lib/ownerutil"
+)
+
+// DefaultExternalCAKey is the key of the CA bundle in secrets populated by external issuers, unless configured otherwise.
+const DefaultExternalCAKey = "ca.crt"
+
+// externalCertRecheckDelay is how long to wait before checking whether an external issuer has renewed a certificate
+// that's already due for rotation.
+const externalCertRecheckDelay = 10 * time.Minute
+
+// CertIssuer provides the serving certificates of the services that front webhook and APIService deployments.
+type CertIssuer interface {
+	// PrepareService is called before the service is created, and lets the issuer request a serving certificate
+	// for it in the given secret.
+	PrepareService(service *corev1.Service, secretName string)
+
+	// Issue ensures that the secret holds a serving certificate for the hosts of the service. It returns the secret,
+	// the PEM encoded CA bundle that verifies the certificate, and the time the certificate should be rotated.
+	Issue(client wrappers.InstallStrategyDeploymentInterface, owner ownerutil.Owner, secretName string, hosts []string) (*corev1.Secret, []byte, time.Time, error)
+}
+
+// NewCertIssuer returns the CertIssuer for the given configuration. Without a configuration, OLM generates the
+// certificates itself.
+func NewCertIssuer(config *v1alpha1.CertIssuer) CertIssuer {
+	if config != nil && config.Type == v1alpha1.CertIssuerTypeExternal {
+		external := v1alpha1.ExternalCertIssuer{}
+		if config.External != nil {
+			external = *config.External
+		}
+		return &externalCertIssuer{config: external}
+	}
+	return &generatedCertIssuer{}
+}
+
+// generatedCertIssuer generates a CA, and signs the serving certificates of every service with it.
+type generatedCertIssuer struct {
+	ca       *certs.KeyPair
+	rotateAt time.Time
+}
+
+func newGeneratedCertIssuer(ca *certs.KeyPair, rotateAt time.Time) *generatedCertIssuer {
+	return &generatedCertIssuer{ca: ca, rotateAt: rotateAt}
+}
+
+func (g *generatedCertIssuer) PrepareService(service *corev1.Service, secretName string) {}
+
+func (g *generatedCertIssuer) Issue(client wrappers.InstallStrategyDeploymentInterface, owner ownerutil.Owner, secretName string, hosts []string) (*corev1.Secret, []byte, time.Time, error) {
+	logger := log.WithFields(log.Fields{})
+
+	// Create the CA the first time it's needed
+	if g.ca == nil {
+		expiration := time.Now().Add(DefaultCertValidFor)
+		ca, err := certs.GenerateCA(expiration, Organization)
+		if err != nil {
+			logger.Debug("failed to generate CA")
+			return nil, nil, time.Time{}, err
+		}
+		g.ca = ca
+		g.rotateAt = expiration.Add(-1 * DefaultCertMinFresh)
+	}
+
+	// Create signed serving cert
+	servingPair, err := certGenerator.Generate(g.rotateAt, Organization, g.ca, hosts)
+	if err != nil {
+		logger.Warnf("could not generate signed certs for hosts %v", hosts)
+		return nil, nil, time.Time{}, err
+	}
+
+	// Create Secret for serving cert
+	certPEM, privPEM, err := servingPair.ToPEM()
+	if err != nil {
+		logger.Warnf("unable to convert serving certificate and private key to PEM format for Secret %s", secretName)
+		return nil, nil, time.Time{}, err
+	}
+
+	// Add olmcahash as a label to the caPEM
+	caPEM, _, err := g.ca.ToPEM()
+	if err != nil {
+		logger.Warnf("unable to convert CA certificate to PEM format for Secret %s", secretName)
+		return nil, nil, time.Time{}, err
+	}
+	caHash := certs.PEMSHA256(caPEM)
+
+	secret := &corev1.Secret{
+		Data: map[string][]byte{
+			"tls.crt":   certPEM,
+			"tls.key":   privPEM,
+			OLMCAPEMKey: caPEM,
+		},
+		Type: corev1.SecretTypeTLS,
+	}
+	secret.SetName(secretName)
+	secret.SetNamespace(owner.GetNamespace())
+	secret.SetAnnotations(map[string]string{OLMCAHashAnnotationKey: caHash})
+	secret.SetLabels(map[string]string{OLMManagedLabelKey: OLMManagedLabelValue})
+
+	existingSecret, err := client.GetOpLister().CoreV1().SecretLister().Secrets(owner.GetNamespace()).Get(secret.GetName())
+	if err == nil {
+		// Check if the only owners are this CSV or in this CSV's replacement chain
+		if ownerutil.Adoptable(owner, existingSecret.GetOwnerReferences()) {
+			ownerutil.AddNonBlockingOwner(secret, owner)
+		}
+
+		// Attempt an update
+		// TODO: Check that the secret was not modified
+		if existingCAPEM, ok := existingSecret.Data[OLMCAPEMKey]; ok && !ShouldRotateCerts(owner.(*v1alpha1.ClusterServiceVersion)) {
+			logger.Warnf("reusing existing cert %s", secret.GetName())
+			secret = existingSecret
+			caPEM = existingCAPEM
+		} else if _, err := client.GetOpClient().UpdateSecret(secret); err != nil {
+			logger.Warnf("could not update secret %s", secret.GetName())
+			return nil, nil, time.Time{}, err
+		}
+
+	} else if k8serrors.IsNotFound(err) {
+		// Create the secret
+		ownerutil.AddNonBlockingOwner(secret, owner)
+		if _, err := client.GetOpClient().CreateSecret(secret); err != nil {
+			if !k8serrors.IsAlreadyExists(err) {
+				log.Warnf("could not create secret %s: %v", secret.GetName(), err)
+				return nil, nil, time.Time{}, err
+			}
+			// if the secret isn't in the cache but exists in the cluster, it's missing the labels for the cache filter
+			// and just needs to be updated
+			if _, err := client.GetOpClient().UpdateSecret(secret); err != nil {
+				log.Warnf("could not update secret %s: %v", secret.GetName(), err)
+				return nil, nil, time.Time{}, err
+			}
+		}
+	} else {
+		return nil, nil, time.Time{}, err
+	}
+
+	return secret, caPEM, g.rotateAt, nil
+}
+
+// externalCertIssuer relies on an external issuer to populate the serving certificate secrets. OLM only labels and
+// annotates the secrets, so that it can watch them and detect CA changes.
+type externalCertIssuer struct {
+	config v1alpha1.ExternalCertIssuer
+}
+
+func (e *externalCertIssuer) PrepareService(service *corev1.Service, secretName string) {
+	annotations := service.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	for k, v := range e.config.ServiceAnnotations {
+		annotations[k] = v
+	}
+	if e.config.SecretNameAnnotation != "" {
+		annotations[e.config.SecretNameAnnotation] = secretName
+	}
+	service.SetAnnotations(annotations)
+}
+
+func (e *externalCertIssuer) Issue(client wrappers.InstallStrategyDeploymentInterface, owner ownerutil.Owner, secretName string, hosts []string) (*corev1.Secret, []byte, time.Time, error) {
+	// The secret isn't in the cache until it's labelled
+	kubeClient := client.GetOpClient().KubernetesInterface()
+	secret, err := kubeClient.CoreV1().Secrets(owner.GetNamespace()).Get(context.TODO(), secretName, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil, nil, time.Time{}, fmt.Errorf("waiting for serving certificate secret %s to be issued", secretName)
+	}
+	if err != nil {
+		return nil, nil, time.Time{}, err
+	}
+	if len(secret.Data["tls.crt"]) == 0 || len(secret.Data["tls.key"]) == 0 {
+		return nil, nil, time.Time{}, fmt.Errorf("waiting for serving certificate secret %s to be issued", secretName)
+	}
+	cert, err := certs.PEMToCert(secret.Data["tls.crt"])
+	if err != nil {
+		return nil, nil, time.Time{}, fmt.Errorf("invalid serving certificate in secret %s: %v", secretName, err)
+	}
+
+	var caPEM []byte
+	if ref := e.config.CAConfigMap; ref != nil {
+		cm, err := kubeClient.CoreV1().ConfigMaps(owner.GetNamespace()).Get(context.TODO(), ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, nil, time.Time{}, fmt.Errorf("could not get CA bundle configmap %s: %v", ref.Name, err)
+		}
+		caPEM = []byte(cm.Data[ref.Key])
+	} else {
+		key := e.config.CAKey
+		if key == "" {
+			key = DefaultExternalCAKey
+		}
+		caPEM = secret.Data[key]
+	}
+	if len(caPEM) == 0 {
+		return nil, nil, time.Time{}, fmt.Errorf("waiting for the CA bundle of serving certificate secret %s", secretName)
+	}
+
+	// Label the secret for the cache, and record the CA hash so that CA changes are detected
+	caHash := certs.PEMSHA256(caPEM)
+	if secret.GetLabels()[OLMManagedLabelKey] != OLMManagedLabelValue || secret.GetAnnotations()[OLMCAHashAnnotationKey] != caHash {
+		secret = secret.DeepCopy()
+		labels := secret.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[OLMManagedLabelKey] = OLMManagedLabelValue
+		secret.SetLabels(labels)
+		annotations := secret.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[OLMCAHashAnnotationKey] = caHash
+		secret.SetAnnotations(annotations)
+		if secret, err = client.GetOpClient().UpdateSecret(secret); err != nil {
+			return nil, nil, time.Time{}, err
+		}
+	}
+
+	// Rotate ahead of the certificate's expiry, leaving the issuer time to renew certificates with short lifetimes
+	minFresh := DefaultCertMinFresh
+	if lifetime := cert.NotAfter.Sub(cert.NotBefore); minFresh > lifetime/3 {
+		minFresh = lifetime / 3
+	}
+	rotateAt := cert.NotAfter.Add(-1 * minFresh)
+
+	// The issuer may not have renewed a certificate that's already due, so check back later rather than reinstalling
+	// on every sync
+	if recheckAt := time.Now().Add(externalCertRecheckDelay); rotateAt.Before(recheckAt) {
+		rotateAt = recheckAt
+	}
+	return secret, caPEM, rotateAt, nil
+}
@@ -0,0 +1,163 @@
+package install
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	clientfakes "github.com/operator-framework/operator-lifecycle-manager/pkg/api/wrappers/wrappersfakes"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/certs"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/lib/operatorclient"
+)
+
+func TestExternalCertIssuer(t *testing.T) {
+	namespace := "ns"
+	owner := &v1alpha1.ClusterServiceVersion{ObjectMeta: metav1.ObjectMeta{Name: "operator.v1", Namespace: namespace}}
+	hosts := []string{"dep-service.ns", "dep-service.ns.svc"}
+
+	ca, err := certs.GenerateCA(time.Now().Add(time.Hour), Organization)
+	require.NoError(t, err)
+	caPEM, _, err := ca.ToPEM()
+	require.NoError(t, err)
+	serving, err := certs.CreateSignedServingPair(time.Now().Add(90*time.Minute), Organization, ca, hosts)
+	require.NoError(t, err)
+	certPEM, keyPEM, err := serving.ToPEM()
+	require.NoError(t, err)
+
+	issuedSecret := func(data map[string][]byte) *corev1.Secret {
+		return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "dep-service-cert", Namespace: namespace}, Data: data}
+	}
+
+	for _, tt := range []struct {
+		name        string
+		config      v1alpha1.ExternalCertIssuer
+		objs        []runtime.Object
+		expectError string
+	}{
+		{
+			name:        "SecretNotIssued",
+			expectError: "waiting for serving certificate secret dep-service-cert to be issued",
+		},
+		{
+			name:        "SecretEmpty",
+			objs:        []runtime.Object{issuedSecret(nil)},
+			expectError: "waiting for serving certificate secret dep-service-cert to be issued",
+		},
+		{
+			name:        "CAMissing",
+			objs:        []runtime.Object{issuedSecret(map[string][]byte{"tls.crt": certPEM, "tls.key": keyPEM})},
+			expectError: "waiting for the CA bundle of serving certificate secret dep-service-cert",
+		},
+		{
+			name: "CAInSecret",
+			objs: []runtime.Object{issuedSecret(map[string][]byte{"tls.crt": certPEM, "tls.key": keyPEM, "ca.crt": caPEM})},
+		},
+		{
+			name:   "CAInConfigMap",
+			config: v1alpha1.ExternalCertIssuer{CAConfigMap: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "service-ca"}, Key: "service-ca.crt"}},
+			objs: []runtime.Object{
+				issuedSecret(map[string][]byte{"tls.crt": certPEM, "tls.key": keyPEM}),
+				&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "service-ca", Namespace: namespace}, Data: map[string]string{"service-ca.crt": string(caPEM)}},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			kubeClient := k8sfake.NewSimpleClientset(tt.objs...)
+			client := &clientfakes.FakeInstallStrategyDeploymentInterface{}
+			client.GetOpClientReturns(operatorclient.NewClient(kubeClient, nil, nil))
+
+			issuer := NewCertIssuer(&v1alpha1.CertIssuer{Type: v1alpha1.CertIssuerTypeExternal, External: &tt.config})
+			secret, gotCAPEM, rotateAt, err := issuer.Issue(client, owner, "dep-service-cert", hosts)
+			if tt.expectError != "" {
+				require.EqualError(t, err, tt.expectError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, caPEM, gotCAPEM)
+
+			// the certificate is rotated a third of its lifetime before it expires
+			cert, err := certs.PEMToCert(certPEM)
+			require.NoError(t, err)
+			require.Equal(t, cert.NotAfter.Add(-cert.NotAfter.Sub(cert.NotBefore)/3), rotateAt)
+
+			// the secret is labelled for the cache and annotated with the CA hash
+			require.Equal(t, OLMManagedLabelValue, secret.GetLabels()[OLMManagedLabelKey])
+			require.Equal(t, certs.PEMSHA256(caPEM), secret.GetAnnotations()[OLMCAHashAnnotationKey])
+			stored, err := kubeClient.CoreV1().Secrets(namespace).Get(context.TODO(), "dep-service-cert", metav1.GetOptions{})
+			require.NoError(t, err)
+			require.Equal(t, secret.GetLabels(), stored.GetLabels())
+			require.Equal(t, secret.GetAnnotations(), stored.GetAnnotations())
+		})
+	}
+
+	t.Run("NotYetRenewed", func(t *testing.T) {
+		// a certificate that's already due for rotation is checked again later instead of on every sync
+		expiring, err := certs.CreateSignedServingPair(time.Now().Add(time.Minute), Organization, ca, hosts)
+		require.NoError(t, err)
+		expiringPEM, expiringKeyPEM, err := expiring.ToPEM()
+		require.NoError(t, err)
+		kubeClient := k8sfake.NewSimpleClientset(issuedSecret(map[string][]byte{"tls.crt": expiringPEM, "tls.key": expiringKeyPEM, "ca.crt": caPEM}))
+		client := &clientfakes.FakeInstallStrategyDeploymentInterface{}
+		client.GetOpClientReturns(operatorclient.NewClient(kubeClient, nil, nil))
+
+		issuer := NewCertIssuer(&v1alpha1.CertIssuer{Type: v1alpha1.CertIssuerTypeExternal, External: &v1alpha1.ExternalCertIssuer{}})
+		before := time.Now()
+		_, _, rotateAt, err := issuer.Issue(client, owner, "dep-service-cert", hosts)
+		require.NoError(t, err)
+		require.False(t, rotateAt.Before(before.Add(externalCertRecheckDelay)))
+	})
+}
+
+func TestExternalCertIssuerPrepareService(t *testing.T) {
+	issuer := NewCertIssuer(&v1alpha1.CertIssuer{
+		Type: v1alpha1.CertIssuerTypeExternal,
+		External: &v1alpha1.ExternalCertIssuer{
+			SecretNameAnnotation: "service.beta.openshift.io/serving-cert-secret-name",
+			ServiceAnnotations:   map[string]string{"example.com/issuer": "internal-ca"},
+		},
+	})
+	service := &corev1.Service{}
+	issuer.PrepareService(service, "dep-service-cert")
+	require.Equal(t, map[string]string{
+		"service.beta.openshift.io/serving-cert-secret-name": "dep-service-cert",
+		"example.com/issuer": "internal-ca",
+	}, service.GetAnnotations())
+
+	// generated certificates don't need any preparation
+	service = &corev1.Service{}
+	NewCertIssuer(nil).PrepareService(service, "dep-service-cert")
+	require.Empty(t, service.GetAnnotations())
+}
+
+func TestStrategyResolverCertIssuer(t *testing.T) {
+	external := &v1alpha1.CertIssuer{Type: v1alpha1.CertIssuerTypeExternal}
+	generated := &v1alpha1.CertIssuer{Type: v1alpha1.CertIssuerTypeGenerated}
+
+	for _, tt := range []struct {
+		name       string
+		clusterCfg *v1alpha1.CertIssuer
+		csvCfg     *v1alpha1.CertIssuer
+		expected   CertIssuer
+	}{
+		{name: "Default", expected: &generatedCertIssuer{}},
+		{name: "ClusterWide", clusterCfg: external, expected: &externalCertIssuer{}},
+		{name: "CSVOverride", clusterCfg: external, csvCfg: generated, expected: &generatedCertIssuer{}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			resolver := &StrategyResolver{CertIssuerFunc: func() *v1alpha1.CertIssuer { return tt.clusterCfg }}
+			owner := &v1alpha1.ClusterServiceVersion{
+				ObjectMeta: metav1.ObjectMeta{Name: "operator.v1", Namespace: "ns"},
+				Spec:       v1alpha1.ClusterServiceVersionSpec{CertIssuer: tt.csvCfg},
+			}
+			installer := resolver.InstallerForStrategy(v1alpha1.InstallStrategyNameDeployment, nil, nil, owner, nil, nil, nil, nil)
+			require.IsType(t, tt.expected, installer.(*StrategyDeploymentInstaller).certIssuer)
+		})
+	}
+}
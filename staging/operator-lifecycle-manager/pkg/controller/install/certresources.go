@@ -176,22 +176,16 @@ func (i *StrategyDeploymentInstaller) updateCertResourcesForDeployment(deploymen
 }
 
 func (i *StrategyDeploymentInstaller) installCertRequirements(strategy Strategy) (*v1alpha1.StrategyDetailsDeployment, error) {
-	logger := log.WithFields(log.Fields{})
-
 	// Assume the strategy is for a deployment
 	strategyDetailsDeployment, ok := strategy.(*v1alpha1.StrategyDetailsDeployment)
 	if !ok {
 		return nil, fmt.Errorf("unsupported InstallStrategy type")
 	}
 
-	// Create the CA
-	expiration := time.Now().Add(DefaultCertValidFor)
-	ca, err := certs.GenerateCA(expiration, Organization)
-	if err != nil {
-		logger.Debug("failed to generate CA")
-		return nil, err
+	issuer := i.certIssuer
+	if issuer == nil {
+		issuer = NewCertIssuer(nil)
 	}
-	rotateAt := expiration.Add(-1 * DefaultCertMinFresh)
 
 	for n, sddSpec := range strategyDetailsDeployment.DeploymentSpecs {
 		certResources := i.certResourcesForDeployment(sddSpec.Name)
@@ -202,7 +196,7 @@ func (i *StrategyDeploymentInstaller) installCertRequirements(strategy Strategy)
 		}
 
 		// Update the deployment for each certResource
-		newDepSpec, caPEM, err := i.installCertRequirementsForDeployment(sddSpec.Name, ca, rotateAt, sddSpec.Spec, getServicePorts(certResources))
+		newDepSpec, caPEM, err := i.installCertRequirementsForDeployment(sddSpec.Name, issuer, sddSpec.Spec, getServicePorts(certResources))
 		if err != nil {
 			return nil, err
 		}
@@ -223,7 +217,7 @@ func ShouldRotateCerts(csv *v1alpha1.ClusterServiceVersion) bool {
 	return false
 }
 
-func (i *StrategyDeploymentInstaller) installCertRequirementsForDeployment(deploymentName string, ca *certs.KeyPair, rotateAt time.Time, depSpec appsv1.DeploymentSpec, ports []corev1.ServicePort) (*appsv1.DeploymentSpec, []byte, error) {
+func (i *StrategyDeploymentInstaller) installCertRequirementsForDeployment(deploymentName string, issuer CertIssuer, depSpec appsv1.DeploymentSpec, ports []corev1.ServicePort) (*appsv1.DeploymentSpec, []byte, error) {
 	logger := log.WithFields(log.Fields{})
 
 	// Create a service for the deployment
@@ -236,6 +230,7 @@ func (i *StrategyDeploymentInstaller) installCertRequirementsForDeployment(deplo
 	service.SetName(ServiceName(deploymentName))
 	service.SetNamespace(i.owner.GetNamespace())
 	ownerutil.AddNonBlockingOwner(service, i.owner)
+	issuer.PrepareService(service, SecretName(service.GetName()))
 
 	existingService, err := i.strategyClient.GetOpLister().CoreV1().ServiceLister().Services(i.owner.GetNamespace()).Get(service.GetName())
 	if err == nil {
@@ -258,81 +253,19 @@ func (i *StrategyDeploymentInstaller) installCertRequirementsForDeployment(deplo
 		return nil, nil, fmt.Errorf("could not create service %s: %s", service.GetName(), err.Error())
 	}
 
-	// Create signed serving cert
+	// Issue the serving cert
 	hosts := []string{
 		fmt.Sprintf("%s.%s", service.GetName(), i.owner.GetNamespace()),
 		fmt.Sprintf("%s.%s.svc", service.GetName(), i.owner.GetNamespace()),
 	}
-	servingPair, err := certGenerator.Generate(rotateAt, Organization, ca, hosts)
-	if err != nil {
-		logger.Warnf("could not generate signed certs for hosts %v", hosts)
-		return nil, nil, err
-	}
-
-	// Create Secret for serving cert
-	certPEM, privPEM, err := servingPair.ToPEM()
-	if err != nil {
-		logger.Warnf("unable to convert serving certificate and private key to PEM format for Service %s", service.GetName())
-		return nil, nil, err
-	}
-
-	// Add olmcahash as a label to the caPEM
-	caPEM, _, err := ca.ToPEM()
+	secret, caPEM, rotateAt, err := issuer.Issue(i.strategyClient, i.owner, SecretName(service.GetName()), hosts)
 	if err != nil {
-		logger.Warnf("unable to convert CA certificate to PEM format for Service %s", service)
+		logger.Warnf("could not issue serving cert for service %s", service.GetName())
 		return nil, nil, err
 	}
 	caHash := certs.PEMSHA256(caPEM)
-
-	secret := &corev1.Secret{
-		Data: map[string][]byte{
-			"tls.crt":   certPEM,
-			"tls.key":   privPEM,
-			OLMCAPEMKey: caPEM,
-		},
-		Type: corev1.SecretTypeTLS,
-	}
-	secret.SetName(SecretName(service.GetName()))
-	secret.SetNamespace(i.owner.GetNamespace())
-	secret.SetAnnotations(map[string]string{OLMCAHashAnnotationKey: caHash})
-	secret.SetLabels(map[string]string{OLMManagedLabelKey: OLMManagedLabelValue})
-
-	existingSecret, err := i.strategyClient.GetOpLister().CoreV1().SecretLister().Secrets(i.owner.GetNamespace()).Get(secret.GetName())
-	if err == nil {
-		// Check if the only owners are this CSV or in this CSV's replacement chain
-		if ownerutil.Adoptable(i.owner, existingSecret.GetOwnerReferences()) {
-			ownerutil.AddNonBlockingOwner(secret, i.owner)
-		}
-
-		// Attempt an update
-		// TODO: Check that the secret was not modified
-		if existingCAPEM, ok := existingSecret.Data[OLMCAPEMKey]; ok && !ShouldRotateCerts(i.owner.(*v1alpha1.ClusterServiceVersion)) {
-			logger.Warnf("reusing existing cert %s", secret.GetName())
-			secret = existingSecret
-			caPEM = existingCAPEM
-			caHash = certs.PEMSHA256(caPEM)
-		} else if _, err := i.strategyClient.GetOpClient().UpdateSecret(secret); err != nil {
-			logger.Warnf("could not update secret %s", secret.GetName())
-			return nil, nil, err
-		}
-
-	} else if k8serrors.IsNotFound(err) {
-		// Create the secret
-		ownerutil.AddNonBlockingOwner(secret, i.owner)
-		if _, err := i.strategyClient.GetOpClient().CreateSecret(secret); err != nil {
-			if !k8serrors.IsAlreadyExists(err) {
-				log.Warnf("could not create secret %s: %v", secret.GetName(), err)
-				return nil, nil, err
-			}
-			// if the secret isn't in the cache but exists in the cluster, it's missing the labels for the cache filter
-			// and just needs to be updated
-			if _, err := i.strategyClient.GetOpClient().UpdateSecret(secret); err != nil {
-				log.Warnf("could not update secret %s: %v", secret.GetName(), err)
-				return nil, nil, err
-			}
-		}
-	} else {
-		return nil, nil, err
+	if i.certsRotateAt.IsZero() || rotateAt.Before(i.certsRotateAt) {
+		i.certsRotateAt = rotateAt
 	}
 
 	// create Role and RoleBinding to allow the deployment to mount the Secret
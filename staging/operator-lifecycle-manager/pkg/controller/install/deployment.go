@@ -3,6 +3,7 @@ package install
 import (
 	"fmt"
 	"hash/fnv"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	appsv1 "k8s.io/api/apps/v1"
@@ -29,6 +30,8 @@ type StrategyDeploymentInstaller struct {
 	initializers           DeploymentInitializerFuncChain
 	apiServiceDescriptions []certResource
 	webhookDescriptions    []certResource
+	certIssuer             CertIssuer
+	certsRotateAt          time.Time
 }
 
 var _ Strategy = &v1alpha1.StrategyDetailsDeployment{}
@@ -66,6 +69,10 @@ func (c DeploymentInitializerFuncChain) Apply(deployment *appsv1.Deployment) (er
 type DeploymentInitializerBuilderFunc func(owner ownerutil.Owner) DeploymentInitializerFunc
 
 func NewStrategyDeploymentInstaller(strategyClient wrappers.InstallStrategyDeploymentInterface, templateAnnotations map[string]string, owner ownerutil.Owner, previousStrategy Strategy, initializers DeploymentInitializerFuncChain, apiServiceDescriptions []v1alpha1.APIServiceDescription, webhookDescriptions []v1alpha1.WebhookDescription) StrategyInstaller {
+	return newStrategyDeploymentInstaller(strategyClient, templateAnnotations, owner, previousStrategy, initializers, apiServiceDescriptions, webhookDescriptions)
+}
+
+func newStrategyDeploymentInstaller(strategyClient wrappers.InstallStrategyDeploymentInterface, templateAnnotations map[string]string, owner ownerutil.Owner, previousStrategy Strategy, initializers DeploymentInitializerFuncChain, apiServiceDescriptions []v1alpha1.APIServiceDescription, webhookDescriptions []v1alpha1.WebhookDescription) *StrategyDeploymentInstaller {
 	apiDescs := make([]certResource, len(apiServiceDescriptions))
 	for i := range apiServiceDescriptions {
 		apiDescs[i] = &apiServiceDescriptionsWithCAPEM{apiServiceDescriptions[i], []byte{}}
@@ -219,6 +226,12 @@ func (i *StrategyDeploymentInstaller) Install(s Strategy) error {
 	return i.cleanupOrphanedDeployments(updatedStrategy.DeploymentSpecs)
 }
 
+// CertsRotateAt returns when the earliest serving certificate issued by the last install should be rotated, or the
+// zero time if none were issued.
+func (i *StrategyDeploymentInstaller) CertsRotateAt() time.Time {
+	return i.certsRotateAt
+}
+
 // CheckInstalled can return nil (installed), or errors
 // Errors can indicate: some component missing (keep installing), unable to query (check again later), or unrecoverable (failed in a way we know we can't recover from)
 func (i *StrategyDeploymentInstaller) CheckInstalled(s Strategy) (installed bool, err error) {
@@ -5,6 +5,7 @@ package install
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/operator-framework/api/pkg/operators/v1alpha1"
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/api/wrappers"
@@ -22,6 +23,12 @@ type StrategyInstaller interface {
 	CheckInstalled(strategy Strategy) (bool, error)
 }
 
+// CertRotationTracker is implemented by installers that issue serving certificates, and reports when they should be
+// rotated.
+type CertRotationTracker interface {
+	CertsRotateAt() time.Time
+}
+
 type StrategyResolverInterface interface {
 	UnmarshalStrategy(s v1alpha1.NamedInstallStrategy) (strategy Strategy, err error)
 	InstallerForStrategy(strategyName string, opClient operatorclient.ClientInterface, opLister operatorlister.OperatorLister, owner ownerutil.Owner, annotations map[string]string, apiServiceDescriptions []v1alpha1.APIServiceDescription, webhookDescriptions []v1alpha1.WebhookDescription, previousStrategy Strategy) StrategyInstaller
@@ -29,6 +36,9 @@ type StrategyResolverInterface interface {
 
 type StrategyResolver struct {
 	OverridesBuilderFunc DeploymentInitializerBuilderFunc
+
+	// CertIssuerFunc returns the cluster-wide cert issuer configuration, which CSVs may override.
+	CertIssuerFunc func() *v1alpha1.CertIssuer
 }
 
 func (r *StrategyResolver) UnmarshalStrategy(s v1alpha1.NamedInstallStrategy) (strategy Strategy, err error) {
@@ -50,13 +60,26 @@ func (r *StrategyResolver) InstallerForStrategy(strategyName string, opClient op
 			initializers = append(initializers, r.OverridesBuilderFunc(owner))
 		}
 
-		return NewStrategyDeploymentInstaller(strategyClient, annotations, owner, previousStrategy, initializers, apiServiceDescriptions, webhookDescriptions)
+		installer := newStrategyDeploymentInstaller(strategyClient, annotations, owner, previousStrategy, initializers, apiServiceDescriptions, webhookDescriptions)
+		installer.certIssuer = NewCertIssuer(r.certIssuerConfig(owner))
+		return installer
 	}
 
 	// Insurance against these functions being called incorrectly (unmarshal strategy will return a valid strategy name)
 	return &NullStrategyInstaller{}
 }
 
+// certIssuerConfig returns the cert issuer configuration of the owner CSV, falling back to the cluster-wide one.
+func (r *StrategyResolver) certIssuerConfig(owner ownerutil.Owner) *v1alpha1.CertIssuer {
+	if csv, ok := owner.(*v1alpha1.ClusterServiceVersion); ok && csv.Spec.CertIssuer != nil {
+		return csv.Spec.CertIssuer
+	}
+	if r.CertIssuerFunc != nil {
+		return r.CertIssuerFunc()
+	}
+	return nil
+}
+
 type NullStrategyInstaller struct{}
 
 var _ StrategyInstaller = &NullStrategyInstaller{}
@@ -8,9 +8,11 @@ import (
 	log "github.com/sirupsen/logrus"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/retry"
 
 	"github.com/operator-framework/api/pkg/operators/v1alpha1"
@@ -172,18 +174,64 @@ func (i *StrategyDeploymentInstaller) createOrUpdateValidatingWebhook(ogNamespac
 	return nil
 }
 
-// check if csv supports only AllNamespaces install mode
-func isSingletonOperator(csv v1alpha1.ClusterServiceVersion) bool {
-	// check if AllNamespaces is supported and other install modes are not supported
-	for _, installMode := range csv.Spec.InstallModes {
-		if installMode.Type == v1alpha1.InstallModeTypeAllNamespaces && !installMode.Supported {
-			return false
+// ConversionWebhookOwnerAnnotationKey is set on CRDs whose conversion webhook is served by a ClusterServiceVersion,
+// and holds the namespace/name of that ClusterServiceVersion.
+const ConversionWebhookOwnerAnnotationKey = "olm.conversion-webhook-owner"
+
+// ConversionWebhookOwner returns the value of the ConversionWebhookOwnerAnnotationKey annotation for the given CSV.
+func ConversionWebhookOwner(csv *v1alpha1.ClusterServiceVersion) string {
+	return csv.GetNamespace() + "/" + csv.GetName()
+}
+
+// IsConversionWebhookOwner returns true if the conversion webhook of the CRD is served by the given CSV. CRDs configured
+// before ownership was recorded are considered to be owned by any CSV converting them.
+func IsConversionWebhookOwner(csv *v1alpha1.ClusterServiceVersion, crd *apiextensionsv1.CustomResourceDefinition) bool {
+	owner, ok := crd.GetAnnotations()[ConversionWebhookOwnerAnnotationKey]
+	return !ok || owner == ConversionWebhookOwner(csv)
+}
+
+// canTakeOverConversionWebhook returns an error if the conversion webhook of the CRD is served by another CSV that
+// still needs it. A CSV takes over the webhook from the CSV it replaces, or from a CSV that is gone or no longer
+// serves conversions for the CRD.
+func (i *StrategyDeploymentInstaller) canTakeOverConversionWebhook(csv *v1alpha1.ClusterServiceVersion, crd *apiextensionsv1.CustomResourceDefinition) error {
+	owner := crd.GetAnnotations()[ConversionWebhookOwnerAnnotationKey]
+	if owner == "" || owner == ConversionWebhookOwner(csv) {
+		return nil
+	}
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(owner)
+	if err != nil {
+		log.Warnf("ignoring invalid conversion webhook owner %q on CRD %s", owner, crd.GetName())
+		return nil
+	}
+
+	current, err := i.strategyClient.GetOpLister().OperatorsV1alpha1().ClusterServiceVersionLister().ClusterServiceVersions(namespace).Get(name)
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Unable to get ClusterServiceVersion %s owning the conversion webhook of CRD %s: %v", owner, crd.GetName(), err)
+	}
+
+	// Hand off within the replacement chain
+	if namespace == csv.GetNamespace() {
+		if name == csv.Spec.Replaces || current.Status.Phase == v1alpha1.CSVPhaseReplacing || current.Status.Phase == v1alpha1.CSVPhaseDeleting {
+			return nil
+		}
+	}
+
+	for _, desc := range current.Spec.WebhookDefinitions {
+		if desc.Type != v1alpha1.ConversionWebhook {
+			continue
 		}
-		if installMode.Type != v1alpha1.InstallModeTypeAllNamespaces && installMode.Supported {
-			return false
+		for _, conversionCRD := range desc.ConversionCRDs {
+			if conversionCRD == crd.GetName() {
+				return fmt.Errorf("conversion webhook of CRD %s is owned by ClusterServiceVersion %s", crd.GetName(), owner)
+			}
 		}
 	}
-	return true
+
+	return nil
 }
 
 func (i *StrategyDeploymentInstaller) createOrUpdateConversionWebhook(caPEM []byte, desc v1alpha1.WebhookDescription) error {
@@ -193,10 +241,6 @@ func (i *StrategyDeploymentInstaller) createOrUpdateConversionWebhook(caPEM []by
 		return fmt.Errorf("ConversionWebhook owner must be a ClusterServiceVersion")
 	}
 
-	if !isSingletonOperator(*csv) {
-		return fmt.Errorf("CSVs with conversion webhooks must support only AllNamespaces")
-	}
-
 	if len(desc.ConversionCRDs) == 0 {
 		return fmt.Errorf("Conversion Webhook must have at least one CRD specified")
 	}
@@ -222,6 +266,10 @@ func (i *StrategyDeploymentInstaller) createOrUpdateConversionWebhook(caPEM []by
 				return fmt.Errorf("CSV %s does not own CRD %s", csv.GetName(), conversionCRD)
 			}
 
+			if err := i.canTakeOverConversionWebhook(csv, crd); err != nil {
+				return err
+			}
+
 			// crd.Spec.Conversion.Strategy specifies how custom resources are converted between versions.
 			// Allowed values are:
 			// 	- None: The converter only change the apiVersion and would not touch any other field in the custom resource.
@@ -264,6 +312,14 @@ func (i *StrategyDeploymentInstaller) createOrUpdateConversionWebhook(caPEM []by
 				},
 			}
 
+			// Record which CSV serves the conversion webhook, so that CSVs in other namespaces don't take it over
+			annotations := crd.GetAnnotations()
+			if annotations == nil {
+				annotations = map[string]string{}
+			}
+			annotations[ConversionWebhookOwnerAnnotationKey] = ConversionWebhookOwner(csv)
+			crd.SetAnnotations(annotations)
+
 			// update CRD conversion Specs
 			if _, err = i.strategyClient.GetOpClient().ApiextensionsInterface().ApiextensionsV1().CustomResourceDefinitions().Update(context.TODO(), crd, metav1.UpdateOptions{}); err != nil {
 				return fmt.Errorf("Error updating CRD with Conversion info: %w", err)
@@ -0,0 +1,140 @@
+package install
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	listersv1alpha1 "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/listers/operators/v1alpha1"
+	clientfakes "github.com/operator-framework/operator-lifecycle-manager/pkg/api/wrappers/wrappersfakes"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/lib/operatorclient"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/lib/operatorlister"
+)
+
+func TestCreateOrUpdateConversionWebhook(t *testing.T) {
+	crdName := "widgets.example.com"
+	desc := v1alpha1.WebhookDescription{
+		GenerateName:   "convert.widgets.example.com",
+		Type:           v1alpha1.ConversionWebhook,
+		DeploymentName: "widget-operator",
+		ContainerPort:  443,
+		ConversionCRDs: []string{crdName},
+	}
+	conversionCSV := func(namespace, name, replaces string, phase v1alpha1.ClusterServiceVersionPhase) *v1alpha1.ClusterServiceVersion {
+		return &v1alpha1.ClusterServiceVersion{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: v1alpha1.ClusterServiceVersionSpec{
+				Replaces: replaces,
+				// Namespaced install modes don't prevent serving conversions
+				InstallModes: []v1alpha1.InstallMode{
+					{Type: v1alpha1.InstallModeTypeOwnNamespace, Supported: true},
+					{Type: v1alpha1.InstallModeTypeAllNamespaces, Supported: false},
+				},
+				CustomResourceDefinitions: v1alpha1.CustomResourceDefinitions{
+					Owned: []v1alpha1.CRDDescription{{Name: crdName}},
+				},
+				WebhookDefinitions: []v1alpha1.WebhookDescription{desc},
+			},
+			Status: v1alpha1.ClusterServiceVersionStatus{Phase: phase},
+		}
+	}
+	crd := func(owner string) *apiextensionsv1.CustomResourceDefinition {
+		crd := &apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: crdName}}
+		if owner != "" {
+			crd.SetAnnotations(map[string]string{ConversionWebhookOwnerAnnotationKey: owner})
+		}
+		return crd
+	}
+
+	for _, tt := range []struct {
+		name        string
+		csv         *v1alpha1.ClusterServiceVersion
+		crd         *apiextensionsv1.CustomResourceDefinition
+		existing    []*v1alpha1.ClusterServiceVersion
+		expectError string
+	}{
+		{
+			name: "Unowned",
+			csv:  conversionCSV("ns-a", "operator.v1", "", v1alpha1.CSVPhaseInstalling),
+			crd:  crd(""),
+		},
+		{
+			name:     "OwnedByItself",
+			csv:      conversionCSV("ns-a", "operator.v1", "", v1alpha1.CSVPhaseInstalling),
+			crd:      crd("ns-a/operator.v1"),
+			existing: []*v1alpha1.ClusterServiceVersion{conversionCSV("ns-a", "operator.v1", "", v1alpha1.CSVPhaseInstalling)},
+		},
+		{
+			name:        "OwnedByAnotherNamespace",
+			csv:         conversionCSV("ns-b", "operator.v1", "", v1alpha1.CSVPhaseInstalling),
+			crd:         crd("ns-a/operator.v1"),
+			existing:    []*v1alpha1.ClusterServiceVersion{conversionCSV("ns-a", "operator.v1", "", v1alpha1.CSVPhaseSucceeded)},
+			expectError: "conversion webhook of CRD widgets.example.com is owned by ClusterServiceVersion ns-a/operator.v1",
+		},
+		{
+			name: "OwnerDeleted",
+			csv:  conversionCSV("ns-b", "operator.v1", "", v1alpha1.CSVPhaseInstalling),
+			crd:  crd("ns-a/operator.v1"),
+		},
+		{
+			name:     "OwnerNoLongerServesConversions",
+			csv:      conversionCSV("ns-b", "operator.v1", "", v1alpha1.CSVPhaseInstalling),
+			crd:      crd("ns-a/operator.v1"),
+			existing: []*v1alpha1.ClusterServiceVersion{{ObjectMeta: metav1.ObjectMeta{Name: "operator.v1", Namespace: "ns-a"}}},
+		},
+		{
+			name:     "HandOffToReplacement",
+			csv:      conversionCSV("ns-a", "operator.v2", "operator.v1", v1alpha1.CSVPhaseInstalling),
+			crd:      crd("ns-a/operator.v1"),
+			existing: []*v1alpha1.ClusterServiceVersion{conversionCSV("ns-a", "operator.v1", "", v1alpha1.CSVPhaseSucceeded)},
+		},
+		{
+			name:     "HandOffFromReplacedAncestor",
+			csv:      conversionCSV("ns-a", "operator.v3", "operator.v2", v1alpha1.CSVPhaseInstalling),
+			crd:      crd("ns-a/operator.v1"),
+			existing: []*v1alpha1.ClusterServiceVersion{conversionCSV("ns-a", "operator.v1", "", v1alpha1.CSVPhaseReplacing)},
+		},
+		{
+			name:        "SameNamespaceNotInReplacementChain",
+			csv:         conversionCSV("ns-a", "other.v1", "", v1alpha1.CSVPhaseInstalling),
+			crd:         crd("ns-a/operator.v1"),
+			existing:    []*v1alpha1.ClusterServiceVersion{conversionCSV("ns-a", "operator.v1", "", v1alpha1.CSVPhaseSucceeded)},
+			expectError: "conversion webhook of CRD widgets.example.com is owned by ClusterServiceVersion ns-a/operator.v1",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			extClient := apiextensionsfake.NewSimpleClientset(tt.crd)
+			indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			for _, csv := range tt.existing {
+				require.NoError(t, indexer.Add(csv))
+			}
+			lister := operatorlister.NewLister()
+			lister.OperatorsV1alpha1().RegisterClusterServiceVersionLister(metav1.NamespaceAll, listersv1alpha1.NewClusterServiceVersionLister(indexer))
+
+			client := &clientfakes.FakeInstallStrategyDeploymentInterface{}
+			client.GetOpClientReturns(operatorclient.NewClient(nil, extClient, nil))
+			client.GetOpListerReturns(lister)
+
+			installer := &StrategyDeploymentInstaller{strategyClient: client, owner: tt.csv}
+			err := installer.createOrUpdateConversionWebhook([]byte("ca"), desc)
+
+			updated, getErr := extClient.ApiextensionsV1().CustomResourceDefinitions().Get(context.TODO(), crdName, metav1.GetOptions{})
+			require.NoError(t, getErr)
+			if tt.expectError != "" {
+				require.EqualError(t, err, tt.expectError)
+				require.Nil(t, updated.Spec.Conversion)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, ConversionWebhookOwner(tt.csv), updated.GetAnnotations()[ConversionWebhookOwnerAnnotationKey])
+			require.NotNil(t, updated.Spec.Conversion)
+			require.Equal(t, tt.csv.GetNamespace(), updated.Spec.Conversion.Webhook.ClientConfig.Service.Namespace)
+		})
+	}
+}
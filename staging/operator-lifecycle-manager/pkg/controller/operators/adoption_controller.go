@@ -135,6 +135,12 @@ func (r *AdoptionReconciler) ReconcileSubscription(ctx context.Context, req ctrl
 
 	// Wrap with convenience decorator
 	operator, err := r.factory.NewPackageOperator(in.Spec.Package, in.GetNamespace())
+	if ref := metav1.GetControllerOf(in); ref != nil && ref.Kind == operatorGVK.Kind && ref.APIVersion == operatorGVK.GroupVersion().String() {
+		// Subscriptions installed by an Operator are components of that Operator
+		o := &operatorsv1.Operator{}
+		o.SetName(ref.Name)
+		operator, err = r.factory.NewOperator(o)
+	}
 	if err != nil {
 		log.Error(err, "Could not wrap Operator with convenience decorator")
 		return reconcile.Result{}, nil
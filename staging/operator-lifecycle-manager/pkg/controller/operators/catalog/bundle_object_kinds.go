@@ -0,0 +1,153 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	operatorsv1 "github.com/operator-framework/api/pkg/operators/v1"
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/lib/ownerutil"
+)
+
+// restrictedGroups are API groups whose objects are managed by OLM or grant control over the API server itself,
+// so they can't be shipped as bundle objects even when configured.
+var restrictedGroups = map[string]struct{}{
+	v1alpha1.GroupName:       {},
+	"apiextensions.k8s.io":   {},
+	"apiregistration.k8s.io": {},
+}
+
+// restrictedGroupKinds can't be shipped as bundle objects even when configured, since they affect workloads
+// beyond the operator's own.
+var restrictedGroupKinds = map[schema.GroupKind]struct{}{
+	{Kind: "Namespace"}: {},
+	{Kind: "Node"}:      {},
+	{Group: "admissionregistration.k8s.io", Kind: "MutatingWebhookConfiguration"}:   {},
+	{Group: "admissionregistration.k8s.io", Kind: "ValidatingWebhookConfiguration"}: {},
+}
+
+// bundleObjectKinds are the kinds of bundle objects configured in the OLMConfig, in addition to supportedKinds.
+type bundleObjectKinds map[schema.GroupKind]operatorsv1.BundleObjectKind
+
+func newBundleObjectKinds(config *operatorsv1.OLMConfig) bundleObjectKinds {
+	kinds := bundleObjectKinds{}
+	if config == nil {
+		return kinds
+	}
+	for _, k := range config.Spec.AdditionalBundleObjectKinds {
+		kinds[schema.GroupKind{Group: k.Group, Kind: k.Kind}] = k
+	}
+	return kinds
+}
+
+// hasKind returns true if a kind of the given name is configured in any group.
+func (k bundleObjectKinds) hasKind(kind string) bool {
+	for gk := range k {
+		if gk.Kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// check returns an error if objects of the given kind may not be applied.
+func (k bundleObjectKinds) check(gk schema.GroupKind, namespaced bool) error {
+	kind, ok := k[gk]
+	if !ok {
+		return fmt.Errorf("%s is not a supported bundle object kind", gk)
+	}
+	if _, ok := restrictedGroups[gk.Group]; ok {
+		return fmt.Errorf("objects of the %s API group can't be bundle objects", gk.Group)
+	}
+	if _, ok := restrictedGroupKinds[gk]; ok {
+		return fmt.Errorf("%s can't be a bundle object kind", gk)
+	}
+	if !namespaced && !kind.ClusterScoped {
+		return fmt.Errorf("%s is cluster-scoped, which must be allowed explicitly", gk)
+	}
+	return nil
+}
+
+// clusterScoped returns the configured kinds that allow cluster-scoped objects.
+func (k bundleObjectKinds) clusterScoped() []schema.GroupKind {
+	var gks []schema.GroupKind
+	for gk, kind := range k {
+		if kind.ClusterScoped {
+			gks = append(gks, gk)
+		}
+	}
+	return gks
+}
+
+// olmConfig returns the "cluster" OLMConfig, or nil if it doesn't exist or can't be retrieved.
+func (o *Operator) olmConfig(log logrus.FieldLogger) *operatorsv1.OLMConfig {
+	config, err := o.olmConfigLister.Get("cluster")
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			log.WithError(err).Warn("unable to get olmConfig, using defaults")
+		}
+		return nil
+	}
+	return config
+}
+
+// gcClusterScopedBundleObjects deletes the cluster-scoped bundle objects of configured kinds that are
+// still owned by a deleted ClusterServiceVersion. Objects of upgraded operators are owned by the newest
+// ClusterServiceVersion, so they are kept.
+func (o *Operator) gcClusterScopedBundleObjects(obj interface{}) {
+	csv, ok := obj.(*v1alpha1.ClusterServiceVersion)
+	if !ok {
+		return
+	}
+	if csv.IsCopied() {
+		return
+	}
+
+	logger := o.logger.WithFields(logrus.Fields{
+		"csv":       csv.GetName(),
+		"namespace": csv.GetNamespace(),
+	})
+	kinds := newBundleObjectKinds(o.olmConfig(logger)).clusterScoped()
+	if len(kinds) == 0 {
+		return
+	}
+
+	groups, err := o.opClient.KubernetesInterface().Discovery().ServerGroups()
+	if err != nil {
+		logger.WithError(err).Warn("unable to discover api groups for cluster-scoped bundle object gc")
+		return
+	}
+	preferred := map[string]string{}
+	for _, g := range groups.Groups {
+		preferred[g.Name] = g.PreferredVersion.Version
+	}
+
+	selector := ownerutil.CSVOwnerSelector(csv).String()
+	for _, gk := range kinds {
+		version, ok := preferred[gk.Group]
+		if !ok {
+			continue
+		}
+		r, err := o.apiresourceFromGVK(gk.WithVersion(version))
+		if err != nil || r.Namespaced {
+			continue
+		}
+		client := o.dynamicClient.Resource(gk.WithVersion(version).GroupVersion().WithResource(r.Name))
+		objs, err := client.List(context.TODO(), metav1.ListOptions{LabelSelector: selector})
+		if err != nil {
+			logger.WithError(err).WithField("kind", gk).Warn("unable to list cluster-scoped bundle objects")
+			continue
+		}
+		for _, obj := range objs.Items {
+			logger.WithField("kind", gk).WithField("name", obj.GetName()).Info("deleting cluster-scoped bundle object")
+			if err := client.Delete(context.TODO(), obj.GetName(), metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+				logger.WithError(err).WithField("name", obj.GetName()).Warn("unable to delete cluster-scoped bundle object")
+			}
+		}
+	}
+}
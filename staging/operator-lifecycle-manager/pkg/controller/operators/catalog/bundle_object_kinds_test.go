@@ -0,0 +1,135 @@
+package catalog
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	operatorsv1 "github.com/operator-framework/api/pkg/operators/v1"
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+)
+
+func TestBundleObjectKindsCheck(t *testing.T) {
+	kinds := newBundleObjectKinds(&operatorsv1.OLMConfig{
+		Spec: operatorsv1.OLMConfigSpec{
+			AdditionalBundleObjectKinds: []operatorsv1.BundleObjectKind{
+				{Group: "networking.k8s.io", Kind: "NetworkPolicy"},
+				{Group: "admissionregistration.k8s.io", Kind: "ValidatingAdmissionPolicy", ClusterScoped: true},
+				{Group: "gateway.networking.k8s.io", Kind: "GatewayClass"},
+				{Group: "admissionregistration.k8s.io", Kind: "MutatingWebhookConfiguration", ClusterScoped: true},
+				{Kind: "Namespace", ClusterScoped: true},
+				{Group: "operators.coreos.com", Kind: "CatalogSource"},
+			},
+		},
+	})
+
+	require.True(t, kinds.hasKind("NetworkPolicy"))
+	require.False(t, kinds.hasKind("Ingress"))
+
+	for _, tt := range []struct {
+		gk         schema.GroupKind
+		namespaced bool
+		allowed    bool
+	}{
+		{gk: schema.GroupKind{Group: "networking.k8s.io", Kind: "NetworkPolicy"}, namespaced: true, allowed: true},
+		{gk: schema.GroupKind{Group: "extensions", Kind: "NetworkPolicy"}, namespaced: true},
+		{gk: schema.GroupKind{Group: "admissionregistration.k8s.io", Kind: "ValidatingAdmissionPolicy"}, allowed: true},
+		{gk: schema.GroupKind{Group: "gateway.networking.k8s.io", Kind: "GatewayClass"}},
+		{gk: schema.GroupKind{Group: "admissionregistration.k8s.io", Kind: "MutatingWebhookConfiguration"}},
+		{gk: schema.GroupKind{Kind: "Namespace"}},
+		{gk: schema.GroupKind{Group: "operators.coreos.com", Kind: "CatalogSource"}, namespaced: true},
+	} {
+		t.Run(tt.gk.String(), func(t *testing.T) {
+			err := kinds.check(tt.gk, tt.namespaced)
+			if tt.allowed {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+		})
+	}
+
+	require.Equal(t, []schema.GroupKind{{Group: "admissionregistration.k8s.io", Kind: "ValidatingAdmissionPolicy"}}, newBundleObjectKinds(&operatorsv1.OLMConfig{
+		Spec: operatorsv1.OLMConfigSpec{
+			AdditionalBundleObjectKinds: []operatorsv1.BundleObjectKind{
+				{Group: "networking.k8s.io", Kind: "NetworkPolicy"},
+				{Group: "admissionregistration.k8s.io", Kind: "ValidatingAdmissionPolicy", ClusterScoped: true},
+			},
+		},
+	}).clusterScoped())
+}
+
+func TestExecutePlanAdditionalBundleObjectKinds(t *testing.T) {
+	namespace := "ns"
+
+	gatewayCRD := &apiextensionsv1beta1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "gateways.gateway.networking.k8s.io"},
+		Spec: apiextensionsv1beta1.CustomResourceDefinitionSpec{
+			Group:    "gateway.networking.k8s.io",
+			Versions: []apiextensionsv1beta1.CustomResourceDefinitionVersion{{Name: "v1beta1", Served: true, Storage: true}},
+			Names:    apiextensionsv1beta1.CustomResourceDefinitionNames{Plural: "gateways", Singular: "gateway", Kind: "Gateway"},
+			Scope:    apiextensionsv1beta1.NamespaceScoped,
+		},
+	}
+	gateway := &unstructured.Unstructured{}
+	gateway.SetAPIVersion("gateway.networking.k8s.io/v1beta1")
+	gateway.SetKind("Gateway")
+	gateway.SetName("gateway")
+
+	for _, tt := range []struct {
+		name     string
+		kinds    []operatorsv1.BundleObjectKind
+		expected v1alpha1.StepStatus
+	}{
+		{
+			name:     "NotConfigured",
+			expected: v1alpha1.StepStatusUnsupportedResource,
+		},
+		{
+			name:     "OtherGroup",
+			kinds:    []operatorsv1.BundleObjectKind{{Group: "networking.istio.io", Kind: "Gateway"}},
+			expected: v1alpha1.StepStatusUnsupportedResource,
+		},
+		{
+			name:     "Configured",
+			kinds:    []operatorsv1.BundleObjectKind{{Group: "gateway.networking.k8s.io", Kind: "Gateway"}},
+			expected: v1alpha1.StepStatusCreated,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.TODO())
+			defer cancel()
+
+			plan := withSteps(installPlan("p", namespace, v1alpha1.InstallPlanPhaseInstalling, "csv"), []*v1alpha1.Step{{
+				Resource: v1alpha1.StepResource{
+					Group:    "gateway.networking.k8s.io",
+					Version:  "v1beta1",
+					Kind:     "Gateway",
+					Name:     "gateway",
+					Manifest: toManifest(t, gateway),
+				},
+				Status: v1alpha1.StepStatusUnknown,
+			}})
+			config := &operatorsv1.OLMConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+				Spec:       operatorsv1.OLMConfigSpec{AdditionalBundleObjectKinds: tt.kinds},
+			}
+
+			op, err := NewFakeOperator(ctx, namespace, []string{namespace}, withClientObjs(plan, config), withExtObjs(gatewayCRD))
+			require.NoError(t, err)
+
+			err = op.ExecutePlan(plan)
+			require.Equal(t, tt.expected, plan.Status.Plan[0].Status)
+			if tt.expected == v1alpha1.StepStatusUnsupportedResource {
+				require.Equal(t, v1alpha1.ErrInvalidInstallPlan, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/bundle"
+)
+
+const (
+	// bundleUnpackRetryInitialBackoff is the delay before the first retry of a failed bundle unpack.
+	// The delay doubles with each failed attempt, up to bundleUnpackRetryMaxBackoff.
+	bundleUnpackRetryInitialBackoff = 30 * time.Second
+	bundleUnpackRetryMaxBackoff     = 30 * time.Minute
+
+	// bundleUnpackSourceCheckInterval is how often the sources of a failed bundle unpack are checked for changes.
+	bundleUnpackSourceCheckInterval = time.Minute
+)
+
+// bundleUnpackBackoff returns the delay before retrying after the given number of failed attempts.
+func bundleUnpackBackoff(attempts int32) time.Duration {
+	backoff := bundleUnpackRetryInitialBackoff
+	for i := int32(1); i < attempts && backoff < bundleUnpackRetryMaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > bundleUnpackRetryMaxBackoff {
+		backoff = bundleUnpackRetryMaxBackoff
+	}
+	return backoff
+}
+
+// ensureSubscriptionBundleUnpackState retries the bundle unpacking of a Subscription whose InstallPlan failed to unpack its bundles.
+// Failures are recorded in the Subscription's status, and the Subscription is resolved again into a new InstallPlan
+// after an exponential backoff, or as soon as the CatalogSources or pull secrets used by the failed attempt change.
+func (o *Operator) ensureSubscriptionBundleUnpackState(logger *logrus.Entry, sub *v1alpha1.Subscription) (*v1alpha1.Subscription, bool, error) {
+	if sub.Status.InstallPlanRef == nil {
+		return sub, false, nil
+	}
+
+	ip, err := o.lister.OperatorsV1alpha1().InstallPlanLister().InstallPlans(sub.GetNamespace()).Get(sub.Status.InstallPlanRef.Name)
+	if k8serrors.IsNotFound(err) {
+		return sub, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	retry := sub.Status.BundleUnpackRetry
+	failed := failedBundleLookups(ip)
+	if len(failed) == 0 {
+		if retry == nil || ip.Status.Phase != v1alpha1.InstallPlanPhaseComplete {
+			return sub, false, nil
+		}
+
+		// The bundles were unpacked, so the retries are over
+		out := sub.DeepCopy()
+		out.Status.BundleUnpackRetry = nil
+		out.Status.RemoveConditions(v1alpha1.SubscriptionBundleUnpackFailed)
+		return o.updateBundleUnpackState(logger, out)
+	}
+
+	now := o.now()
+	versions := o.bundleUnpackSourceVersions(logger, failed)
+	if retry == nil || retry.FailedInstallPlan != ip.GetName() {
+		out := sub.DeepCopy()
+		attempts := int32(1)
+		if retry != nil {
+			attempts = retry.Attempts + 1
+		}
+		backoff := bundleUnpackBackoff(attempts)
+		next := metav1.NewTime(now.Add(backoff))
+		out.Status.BundleUnpackRetry = &v1alpha1.BundleUnpackRetry{
+			Attempts:          attempts,
+			FailedInstallPlan: ip.GetName(),
+			NextRetryTime:     next,
+			SourceVersions:    versions,
+		}
+		out.Status.SetCondition(v1alpha1.SubscriptionCondition{
+			Type:               v1alpha1.SubscriptionBundleUnpackFailed,
+			Status:             corev1.ConditionTrue,
+			Reason:             v1alpha1.BundleUnpackRetryScheduled,
+			Message:            fmt.Sprintf("InstallPlan %s failed to unpack bundles (attempt %d), retrying at %s: %s", ip.GetName(), attempts, next.Format(time.RFC3339), bundleUnpackFailureMessage(failed)),
+			LastTransitionTime: &now,
+		})
+		o.nsResolveQueue.AddAfter(sub.GetNamespace(), backoff)
+		return o.updateBundleUnpackState(logger, out)
+	}
+
+	sourcesChanged := !reflect.DeepEqual(versions, retry.SourceVersions)
+	if now.Before(&retry.NextRetryTime) && !sourcesChanged {
+		wait := retry.NextRetryTime.Sub(now.Time)
+		if wait > bundleUnpackSourceCheckInterval {
+			wait = bundleUnpackSourceCheckInterval
+		}
+		o.nsResolveQueue.AddAfter(sub.GetNamespace(), wait)
+		return sub, false, nil
+	}
+
+	// Clear the installplan ref so the resolution happens again, like when the installplan is missing
+	out := sub.DeepCopy()
+	out.Status.RemoveConditions(v1alpha1.SubscriptionInstallPlanPending, v1alpha1.SubscriptionInstallPlanFailed)
+	out.Status.InstallPlanRef = nil
+	out.Status.Install = nil
+	out.Status.CurrentCSV = ""
+	out.Status.State = v1alpha1.SubscriptionStateNone
+	out.Status.BundleUnpackRetry.SourceVersions = versions
+
+	message := fmt.Sprintf("retrying bundle unpacking after %d failed attempts", retry.Attempts)
+	if sourcesChanged {
+		message = "retrying bundle unpacking because a catalog source or pull secret changed"
+	}
+	out.Status.SetCondition(v1alpha1.SubscriptionCondition{
+		Type:               v1alpha1.SubscriptionBundleUnpackFailed,
+		Status:             corev1.ConditionTrue,
+		Reason:             v1alpha1.BundleUnpackRetrying,
+		Message:            message,
+		LastTransitionTime: &now,
+	})
+	logger.WithField("installplan", ip.GetName()).Info(message)
+	return o.updateBundleUnpackState(logger, out)
+}
+
+func (o *Operator) updateBundleUnpackState(logger *logrus.Entry, sub *v1alpha1.Subscription) (*v1alpha1.Subscription, bool, error) {
+	sub.Status.LastUpdated = o.now()
+	updated, err := o.client.OperatorsV1alpha1().Subscriptions(sub.GetNamespace()).UpdateStatus(context.TODO(), sub, metav1.UpdateOptions{})
+	if err != nil {
+		logger.WithError(err).Info("error updating subscription bundle unpack status")
+		return nil, false, err
+	}
+	return updated, true, nil
+}
+
+// failedBundleLookups returns the bundle lookups of a failed InstallPlan that failed to unpack.
+func failedBundleLookups(ip *v1alpha1.InstallPlan) []v1alpha1.BundleLookup {
+	if ip.Status.Phase != v1alpha1.InstallPlanPhaseFailed {
+		return nil
+	}
+	var failed []v1alpha1.BundleLookup
+	for _, lookup := range ip.Status.BundleLookups {
+		if lookup.GetCondition(bundle.BundleLookupFailed).Status == corev1.ConditionTrue {
+			failed = append(failed, lookup)
+		}
+	}
+	return failed
+}
+
+// bundleUnpackFailureMessage describes why each of the failed bundle lookups failed.
+func bundleUnpackFailureMessage(failed []v1alpha1.BundleLookup) string {
+	msgs := make([]string, 0, len(failed))
+	for _, lookup := range failed {
+		cond := lookup.GetCondition(bundle.BundleLookupFailed)
+		msgs = append(msgs, fmt.Sprintf("bundle %s: %s: %s", lookup.Path, cond.Reason, cond.Message))
+	}
+	return strings.Join(msgs, " | ")
+}
+
+// bundleUnpackSourceVersions returns the versions of the CatalogSources and pull secrets used to unpack the given bundle lookups.
+// CatalogSources are versioned by generation so that status updates don't count as changes.
+// Missing sources have an empty version, so that creating them is a change too.
+func (o *Operator) bundleUnpackSourceVersions(logger *logrus.Entry, lookups []v1alpha1.BundleLookup) map[string]string {
+	versions := map[string]string{}
+	for _, lookup := range lookups {
+		ref := lookup.CatalogSourceRef
+		if ref == nil {
+			continue
+		}
+		key := fmt.Sprintf("%s/%s/%s", v1alpha1.CatalogSourceKind, ref.Namespace, ref.Name)
+		cs, err := o.lister.OperatorsV1alpha1().CatalogSourceLister().CatalogSources(ref.Namespace).Get(ref.Name)
+		if err != nil {
+			versions[key] = ""
+			continue
+		}
+		versions[key] = strconv.FormatInt(cs.GetGeneration(), 10)
+
+		for _, name := range cs.Spec.Secrets {
+			key := fmt.Sprintf("Secret/%s/%s", cs.GetNamespace(), name)
+			secret, err := o.opClient.KubernetesInterface().CoreV1().Secrets(cs.GetNamespace()).Get(context.TODO(), name, metav1.GetOptions{})
+			if err != nil {
+				if !k8serrors.IsNotFound(err) {
+					logger.WithError(err).WithField("secret", name).Debug("failed to get catalog pull secret")
+				}
+				versions[key] = ""
+				continue
+			}
+			versions[key] = secret.GetResourceVersion()
+		}
+	}
+	if len(versions) == 0 {
+		// Match the omitted field of a stored status
+		return nil
+	}
+	return versions
+}
+
+// bundleUnpackTimeoutFor returns the bundle unpack timeout annotation for an InstallPlan of the given Subscriptions,
+// which is the longest timeout they set with the same annotation. Invalid timeouts are ignored.
+func bundleUnpackTimeoutFor(subs []*v1alpha1.Subscription) (string, bool) {
+	var (
+		longest time.Duration
+		value   string
+		found   bool
+	)
+	for _, sub := range subs {
+		timeout, ok := sub.GetAnnotations()[bundle.BundleUnpackTimeoutAnnotationKey]
+		if !ok {
+			continue
+		}
+		d, err := time.ParseDuration(timeout)
+		if err != nil || d < 0 {
+			continue
+		}
+		if !found || d > longest {
+			longest, value, found = d, timeout, true
+		}
+	}
+	return value, found
+}
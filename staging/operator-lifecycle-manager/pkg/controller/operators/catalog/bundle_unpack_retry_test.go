@@ -0,0 +1,206 @@
+package catalog
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilclock "k8s.io/apimachinery/pkg/util/clock"
+
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/bundle"
+)
+
+func TestEnsureSubscriptionBundleUnpackState(t *testing.T) {
+	namespace := "ns"
+	now := time.Date(2021, 3, 6, 12, 0, 0, 0, time.UTC)
+	lookup := v1alpha1.BundleLookup{
+		Path:             "quay.io/bundle:v1",
+		CatalogSourceRef: &corev1.ObjectReference{Namespace: "olm", Name: "catalog"},
+		Conditions: []v1alpha1.BundleLookupCondition{{
+			Type:    bundle.BundleLookupFailed,
+			Status:  corev1.ConditionTrue,
+			Reason:  "BackoffLimitExceeded",
+			Message: "Job has reached the specified backoff limit",
+		}},
+	}
+	catalog := &v1alpha1.CatalogSource{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "olm", Name: "catalog", Generation: 2},
+		Spec:       v1alpha1.CatalogSourceSpec{Secrets: []string{"pull-secret"}},
+	}
+	pullSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "olm", Name: "pull-secret", ResourceVersion: "7"}}
+	versions := map[string]string{"CatalogSource/olm/catalog": "2", "Secret/olm/pull-secret": "7"}
+
+	for _, tt := range []struct {
+		name     string
+		phase    v1alpha1.InstallPlanPhase
+		elapsed  time.Duration
+		retry    *v1alpha1.BundleUnpackRetry
+		changed  bool
+		expected *v1alpha1.BundleUnpackRetry
+		reason   string
+		retried  bool
+	}{
+		{
+			name:    "FirstFailure",
+			phase:   v1alpha1.InstallPlanPhaseFailed,
+			changed: true,
+			expected: &v1alpha1.BundleUnpackRetry{
+				Attempts:          1,
+				FailedInstallPlan: "install-a",
+				NextRetryTime:     metav1.NewTime(now.Add(30 * time.Second)),
+				SourceVersions:    versions,
+			},
+			reason: v1alpha1.BundleUnpackRetryScheduled,
+		},
+		{
+			name:    "LaterFailure",
+			phase:   v1alpha1.InstallPlanPhaseFailed,
+			retry:   &v1alpha1.BundleUnpackRetry{Attempts: 3, FailedInstallPlan: "install-0"},
+			changed: true,
+			expected: &v1alpha1.BundleUnpackRetry{
+				Attempts:          4,
+				FailedInstallPlan: "install-a",
+				NextRetryTime:     metav1.NewTime(now.Add(4 * time.Minute)),
+				SourceVersions:    versions,
+			},
+			reason: v1alpha1.BundleUnpackRetryScheduled,
+		},
+		{
+			name:    "BackingOff",
+			phase:   v1alpha1.InstallPlanPhaseFailed,
+			elapsed: 10 * time.Second,
+			retry: &v1alpha1.BundleUnpackRetry{
+				Attempts:          1,
+				FailedInstallPlan: "install-a",
+				NextRetryTime:     metav1.NewTime(now.Add(30 * time.Second)),
+				SourceVersions:    versions,
+			},
+		},
+		{
+			name:    "Retried",
+			phase:   v1alpha1.InstallPlanPhaseFailed,
+			elapsed: time.Minute,
+			retry: &v1alpha1.BundleUnpackRetry{
+				Attempts:          1,
+				FailedInstallPlan: "install-a",
+				NextRetryTime:     metav1.NewTime(now.Add(30 * time.Second)),
+				SourceVersions:    versions,
+			},
+			changed: true,
+			expected: &v1alpha1.BundleUnpackRetry{
+				Attempts:          1,
+				FailedInstallPlan: "install-a",
+				NextRetryTime:     metav1.NewTime(now.Add(30 * time.Second)),
+				SourceVersions:    versions,
+			},
+			reason:  v1alpha1.BundleUnpackRetrying,
+			retried: true,
+		},
+		{
+			name:  "RetriedOnPullSecretChange",
+			phase: v1alpha1.InstallPlanPhaseFailed,
+			retry: &v1alpha1.BundleUnpackRetry{
+				Attempts:          1,
+				FailedInstallPlan: "install-a",
+				NextRetryTime:     metav1.NewTime(now.Add(30 * time.Second)),
+				SourceVersions:    map[string]string{"CatalogSource/olm/catalog": "2", "Secret/olm/pull-secret": "6"},
+			},
+			changed: true,
+			expected: &v1alpha1.BundleUnpackRetry{
+				Attempts:          1,
+				FailedInstallPlan: "install-a",
+				NextRetryTime:     metav1.NewTime(now.Add(30 * time.Second)),
+				SourceVersions:    versions,
+			},
+			reason:  v1alpha1.BundleUnpackRetrying,
+			retried: true,
+		},
+		{
+			name:    "Unpacked",
+			phase:   v1alpha1.InstallPlanPhaseComplete,
+			retry:   &v1alpha1.BundleUnpackRetry{Attempts: 1, FailedInstallPlan: "install-0"},
+			changed: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.TODO())
+			defer cancel()
+
+			sub := &v1alpha1.Subscription{
+				ObjectMeta: metav1.ObjectMeta{Name: "sub", Namespace: namespace},
+				Spec:       &v1alpha1.SubscriptionSpec{Package: "pkg"},
+				Status: v1alpha1.SubscriptionStatus{
+					CurrentCSV:        "csv-a",
+					State:             v1alpha1.SubscriptionStateUpgradePending,
+					InstallPlanRef:    &corev1.ObjectReference{Name: "install-a", Namespace: namespace},
+					BundleUnpackRetry: tt.retry,
+				},
+			}
+			plan := installPlan("install-a", namespace, tt.phase, "csv-a")
+			if tt.phase == v1alpha1.InstallPlanPhaseFailed {
+				plan.Status.BundleLookups = []v1alpha1.BundleLookup{lookup}
+			}
+
+			clock := utilclock.NewFakeClock(now)
+			op, err := NewFakeOperator(ctx, namespace, []string{namespace, "olm"},
+				withClock(clock),
+				withClientObjs(sub, plan, catalog),
+				withK8sObjs(pullSecret))
+			require.NoError(t, err)
+			clock.Step(tt.elapsed)
+
+			out, changed, err := op.ensureSubscriptionBundleUnpackState(op.logger.WithField("test", tt.name), sub)
+			require.NoError(t, err)
+			require.Equal(t, tt.changed, changed)
+			if !tt.changed {
+				require.Equal(t, sub, out)
+				return
+			}
+			require.Equal(t, tt.expected, out.Status.BundleUnpackRetry)
+
+			cond := out.Status.GetCondition(v1alpha1.SubscriptionBundleUnpackFailed)
+			if tt.reason == "" {
+				require.Equal(t, corev1.ConditionUnknown, cond.Status)
+				return
+			}
+			require.Equal(t, corev1.ConditionTrue, cond.Status)
+			require.Equal(t, tt.reason, cond.Reason)
+			if tt.reason == v1alpha1.BundleUnpackRetryScheduled {
+				require.Contains(t, cond.Message, "bundle quay.io/bundle:v1: BackoffLimitExceeded: Job has reached the specified backoff limit")
+			}
+
+			if tt.retried {
+				require.Nil(t, out.Status.InstallPlanRef)
+				require.Empty(t, out.Status.CurrentCSV)
+				require.Equal(t, v1alpha1.SubscriptionState(v1alpha1.SubscriptionStateNone), out.Status.State)
+			} else {
+				require.NotNil(t, out.Status.InstallPlanRef)
+			}
+		})
+	}
+}
+
+func TestBundleUnpackTimeoutFor(t *testing.T) {
+	sub := func(timeout string) *v1alpha1.Subscription {
+		s := &v1alpha1.Subscription{}
+		if timeout != "" {
+			s.SetAnnotations(map[string]string{bundle.BundleUnpackTimeoutAnnotationKey: timeout})
+		}
+		return s
+	}
+
+	_, ok := bundleUnpackTimeoutFor([]*v1alpha1.Subscription{sub(""), sub("invalid")})
+	require.False(t, ok)
+
+	timeout, ok := bundleUnpackTimeoutFor([]*v1alpha1.Subscription{sub("5m"), sub(""), sub("20m"), sub("10m")})
+	require.True(t, ok)
+	require.Equal(t, "20m", timeout)
+
+	require.Equal(t, 30*time.Second, bundleUnpackBackoff(1))
+	require.Equal(t, 4*time.Minute, bundleUnpackBackoff(4))
+	require.Equal(t, 30*time.Minute, bundleUnpackBackoff(100))
+}
@@ -0,0 +1,97 @@
+package catalog
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/registry/reconciler"
+)
+
+const (
+	// CatalogUpdatePathPrefix is the path prefix of the catalog update webhook.
+	// Updates are requested with POST requests to /catalogsources/<namespace>/<name>/update.
+	CatalogUpdatePathPrefix = "/catalogsources/"
+
+	// catalogUpdateTokenKey is the key of the token in the Secret of a webhook update strategy.
+	catalogUpdateTokenKey = "token"
+)
+
+// CatalogUpdateHandler returns the handler of the catalog update webhook, which requests an update check of
+// CatalogSources with a webhook update strategy. Requests must present the token of the CatalogSource's webhook
+// Secret as a bearer token. Only Secrets labeled with reconciler.CatalogSourceUpdateWebhookLabelKey are considered.
+func (o *Operator) CatalogUpdateHandler() http.Handler {
+	return http.HandlerFunc(o.handleCatalogUpdate)
+}
+
+func (o *Operator) handleCatalogUpdate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, CatalogUpdatePathPrefix), "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] != "update" {
+		http.NotFound(w, r)
+		return
+	}
+	namespace, name := parts[0], parts[1]
+	logger := o.logger.WithField("catalogsource", namespace+"/"+name)
+
+	cs, err := o.lister.OperatorsV1alpha1().CatalogSourceLister().CatalogSources(namespace).Get(name)
+	if k8serrors.IsNotFound(err) || (err == nil && (cs.Spec.UpdateStrategy == nil || cs.Spec.UpdateStrategy.Webhook == nil)) {
+		// catalogs that don't accept notifications are indistinguishable from missing ones
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		logger.WithError(err).Warn("failed to get catalogsource for update request")
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	secret, err := o.lister.CoreV1().SecretLister().Secrets(namespace).Get(cs.Spec.UpdateStrategy.Webhook.SecretName)
+	if err != nil {
+		logger.WithError(err).Warn("failed to get catalog update webhook secret")
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	token := secret.Data[catalogUpdateTokenKey]
+	presented := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if len(token) == 0 || subtle.ConstantTimeCompare(token, []byte(presented)) != 1 {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{
+				reconciler.CatalogSourceUpdateRequestedAnnotationKey: o.now().UTC().Format(time.RFC3339),
+			},
+		},
+	})
+	if err != nil {
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	if _, err := o.client.OperatorsV1alpha1().CatalogSources(namespace).Patch(context.TODO(), name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		logger.WithError(err).Warn("failed to request catalog update")
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	if err := o.catsrcQueueSet.Requeue(namespace, name); err != nil {
+		logger.WithError(err).Debug("failed to requeue catalogsource for update")
+	}
+
+	logger.Info("catalog update requested")
+	w.WriteHeader(http.StatusAccepted)
+	fmt.Fprintf(w, "update of catalogsource %s/%s requested\n", namespace, name)
+}
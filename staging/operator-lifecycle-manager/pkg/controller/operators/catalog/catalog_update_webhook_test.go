@@ -0,0 +1,145 @@
+package catalog
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilclock "k8s.io/apimachinery/pkg/util/clock"
+
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/registry/reconciler"
+)
+
+func TestCatalogUpdateHandler(t *testing.T) {
+	namespace := "ns"
+	now := time.Date(2021, 3, 6, 12, 0, 0, 0, time.UTC)
+	catalog := func(name string, webhook *v1alpha1.WebhookUpdate) *v1alpha1.CatalogSource {
+		return &v1alpha1.CatalogSource{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: v1alpha1.CatalogSourceSpec{
+				SourceType:     v1alpha1.SourceTypeGrpc,
+				Image:          "quay.io/catalog:latest",
+				UpdateStrategy: &v1alpha1.UpdateStrategy{Webhook: webhook},
+			},
+		}
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "webhook-token",
+			Namespace: namespace,
+			Labels:    map[string]string{reconciler.CatalogSourceUpdateWebhookLabelKey: ""},
+		},
+		Data: map[string][]byte{"token": []byte("s3cr3t")},
+	}
+	unlabeled := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "unlabeled-token", Namespace: namespace},
+		Data:       map[string][]byte{"token": []byte("s3cr3t")},
+	}
+
+	for _, tt := range []struct {
+		name      string
+		method    string
+		path      string
+		token     string
+		status    int
+		requested string
+	}{
+		{
+			name:      "Requested",
+			method:    http.MethodPost,
+			path:      "/catalogsources/ns/webhook/update",
+			token:     "s3cr3t",
+			status:    http.StatusAccepted,
+			requested: "webhook",
+		},
+		{
+			name:   "WrongToken",
+			method: http.MethodPost,
+			path:   "/catalogsources/ns/webhook/update",
+			token:  "guess",
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "MissingToken",
+			method: http.MethodPost,
+			path:   "/catalogsources/ns/webhook/update",
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "UnlabeledSecret",
+			method: http.MethodPost,
+			path:   "/catalogsources/ns/unlabeled/update",
+			token:  "s3cr3t",
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "WebhookNotEnabled",
+			method: http.MethodPost,
+			path:   "/catalogsources/ns/polled/update",
+			token:  "s3cr3t",
+			status: http.StatusNotFound,
+		},
+		{
+			name:   "MissingCatalog",
+			method: http.MethodPost,
+			path:   "/catalogsources/ns/missing/update",
+			token:  "s3cr3t",
+			status: http.StatusNotFound,
+		},
+		{
+			name:   "InvalidPath",
+			method: http.MethodPost,
+			path:   "/catalogsources/ns/webhook",
+			token:  "s3cr3t",
+			status: http.StatusNotFound,
+		},
+		{
+			name:   "WrongMethod",
+			method: http.MethodGet,
+			path:   "/catalogsources/ns/webhook/update",
+			token:  "s3cr3t",
+			status: http.StatusMethodNotAllowed,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.TODO())
+			defer cancel()
+
+			op, err := NewFakeOperator(ctx, namespace, []string{namespace},
+				withClock(utilclock.NewFakeClock(now)),
+				withClientObjs(
+					catalog("webhook", &v1alpha1.WebhookUpdate{SecretName: secret.GetName()}),
+					catalog("unlabeled", &v1alpha1.WebhookUpdate{SecretName: unlabeled.GetName()}),
+					catalog("polled", nil),
+				),
+				withK8sObjs(secret, unlabeled))
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			rec := httptest.NewRecorder()
+			op.CatalogUpdateHandler().ServeHTTP(rec, req)
+			require.Equal(t, tt.status, rec.Code)
+
+			for _, name := range []string{"webhook", "unlabeled", "polled"} {
+				cs, err := op.client.OperatorsV1alpha1().CatalogSources(namespace).Get(ctx, name, metav1.GetOptions{})
+				require.NoError(t, err)
+				requested, ok := cs.GetAnnotations()[reconciler.CatalogSourceUpdateRequestedAnnotationKey]
+				if name != tt.requested {
+					require.False(t, ok)
+					continue
+				}
+				require.Equal(t, now.Format(time.RFC3339), requested)
+				require.True(t, reconciler.UpdateRequested(cs))
+			}
+		})
+	}
+}
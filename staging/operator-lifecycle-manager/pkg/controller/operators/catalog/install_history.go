@@ -0,0 +1,204 @@
+package catalog
+
+import (
+	"encoding/json"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/registry/reconciler"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/lib/ownerutil"
+)
+
+const (
+	// maxInstallHistory is the number of InstallRecords kept in the status of a Subscription.
+	maxInstallHistory = 10
+
+	// catalogDigestsAnnotationKey is the InstallPlan annotation recording the image digests served by its
+	// catalogs when it was resolved, as a JSON object keyed by "<namespace>/<name>" of the CatalogSources.
+	catalogDigestsAnnotationKey = "olm.catalog-digests"
+)
+
+// recordInstallHistory adds a record of a Complete or Failed InstallPlan to the status of each Subscription
+// that references it and doesn't record it yet.
+func (o *Operator) recordInstallHistory(plan *v1alpha1.InstallPlan, logger *logrus.Entry) error {
+	var subs []*v1alpha1.Subscription
+	for _, owner := range ownerutil.GetOwnersByKind(plan, v1alpha1.SubscriptionKind) {
+		sub, err := o.lister.OperatorsV1alpha1().SubscriptionLister().Subscriptions(plan.GetNamespace()).Get(owner.Name)
+		if err != nil {
+			logger.WithError(err).WithField("sub", owner.Name).Debug("unable to get installplan owner")
+			continue
+		}
+		if sub.Status.InstallPlanRef == nil || sub.Status.InstallPlanRef.Name != plan.GetName() || hasInstallRecord(sub, plan.GetName()) {
+			continue
+		}
+
+		record, ok := o.installRecord(plan, sub.Status.CurrentCSV)
+		if !ok {
+			continue
+		}
+		sub = sub.DeepCopy()
+		sub.Status.InstallHistory = append(sub.Status.InstallHistory, record)
+		if len(sub.Status.InstallHistory) > maxInstallHistory {
+			sub.Status.InstallHistory = sub.Status.InstallHistory[len(sub.Status.InstallHistory)-maxInstallHistory:]
+		}
+		subs = append(subs, sub)
+	}
+	if len(subs) == 0 {
+		return nil
+	}
+
+	logger.WithField("subs", len(subs)).Debug("recording install history")
+	_, err := o.updateSubscriptionStatuses(subs)
+	return err
+}
+
+func hasInstallRecord(sub *v1alpha1.Subscription, plan string) bool {
+	for _, record := range sub.Status.InstallHistory {
+		if record.InstallPlan == plan {
+			return true
+		}
+	}
+	return false
+}
+
+// installRecord describes the installation of the named ClusterServiceVersion by the given InstallPlan.
+// It returns false if the InstallPlan doesn't install the ClusterServiceVersion.
+func (o *Operator) installRecord(plan *v1alpha1.InstallPlan, csvName string) (v1alpha1.InstallRecord, bool) {
+	var step *v1alpha1.Step
+	for _, s := range plan.Status.Plan {
+		if s.Resource.Kind == v1alpha1.ClusterServiceVersionKind && s.Resource.Name == csvName {
+			step = s
+			break
+		}
+	}
+	if step == nil {
+		return v1alpha1.InstallRecord{}, false
+	}
+
+	// the InstallPlan finished when its Installed condition last transitioned
+	completed := o.now()
+	if cond := plan.Status.GetCondition(v1alpha1.InstallPlanInstalled); cond.LastTransitionTime != nil {
+		completed = *cond.LastTransitionTime
+	}
+
+	record := v1alpha1.InstallRecord{
+		InstallPlan:            plan.GetName(),
+		To:                     csvName,
+		StartTime:              plan.Status.StartTime,
+		CompletionTime:         completed,
+		Approval:               plan.Spec.Approval,
+		Phase:                  plan.Status.Phase,
+		CatalogSource:          step.Resource.CatalogSource,
+		CatalogSourceNamespace: step.Resource.CatalogSourceNamespace,
+	}
+	if plan.Spec.Approval == v1alpha1.ApprovalManual {
+		record.Approver = approver(plan)
+	}
+	if plan.Status.Previous != nil {
+		record.From = plan.Status.Previous.Replaced[csvName]
+	}
+	if record.From == "" {
+		manifest, err := newManifestResolver(plan.GetNamespace(), o.lister.CoreV1().ConfigMapLister(), o.bundleLoader, o.logger).ManifestForStep(step)
+		var csv v1alpha1.ClusterServiceVersion
+		if err == nil && json.Unmarshal([]byte(manifest), &csv) == nil {
+			record.From = csv.Spec.Replaces
+		}
+	}
+	if record.CatalogSource != "" {
+		var digests map[string]string
+		if err := json.Unmarshal([]byte(plan.GetAnnotations()[catalogDigestsAnnotationKey]), &digests); err == nil {
+			record.CatalogDigest = digests[record.CatalogSourceNamespace+"/"+record.CatalogSource]
+		}
+	}
+
+	return record, true
+}
+
+// approver returns the field manager that last set the approval of the InstallPlan.
+func approver(plan *v1alpha1.InstallPlan) string {
+	var (
+		manager string
+		latest  *metav1.Time
+	)
+	for _, entry := range plan.GetManagedFields() {
+		if entry.FieldsV1 == nil {
+			continue
+		}
+		var fields struct {
+			Spec map[string]json.RawMessage `json:"f:spec"`
+		}
+		if err := json.Unmarshal(entry.FieldsV1.Raw, &fields); err != nil {
+			continue
+		}
+		if _, ok := fields.Spec["f:approved"]; !ok {
+			continue
+		}
+		if latest == nil || (entry.Time != nil && latest.Before(entry.Time)) {
+			manager, latest = entry.Manager, entry.Time
+		}
+	}
+	return manager
+}
+
+// catalogDigests returns the image digests served by the CatalogSources of the given steps and bundle lookups,
+// keyed by "<namespace>/<name>". CatalogSources whose digest isn't known are omitted.
+func (o *Operator) catalogDigests(steps []*v1alpha1.Step, bundleLookups []v1alpha1.BundleLookup) map[string]string {
+	digests := map[string]string{}
+	record := func(name, namespace string) {
+		key := namespace + "/" + name
+		if _, ok := digests[key]; ok || name == "" {
+			return
+		}
+		if digest := o.catalogDigest(name, namespace); digest != "" {
+			digests[key] = digest
+		}
+	}
+	for _, step := range steps {
+		record(step.Resource.CatalogSource, step.Resource.CatalogSourceNamespace)
+	}
+	for _, lookup := range bundleLookups {
+		if lookup.CatalogSourceRef != nil {
+			record(lookup.CatalogSourceRef.Name, lookup.CatalogSourceRef.Namespace)
+		}
+	}
+	return digests
+}
+
+// catalogDigest returns the image digest served by the ready pods of a CatalogSource, if they all serve the same one.
+func (o *Operator) catalogDigest(name, namespace string) string {
+	selector := labels.SelectorFromSet(labels.Set{reconciler.CatalogSourceLabelKey: name})
+	pods, err := o.lister.CoreV1().PodLister().Pods(namespace).List(selector)
+	if err != nil {
+		return ""
+	}
+	var digest string
+	for _, pod := range pods {
+		if !podIsReady(pod) {
+			continue
+		}
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.ImageID == "" {
+				continue
+			}
+			if digest != "" && digest != status.ImageID {
+				// a rollout is in progress, so the digest that served the resolution is unknown
+				return ""
+			}
+			digest = status.ImageID
+		}
+	}
+	return digest
+}
+
+func podIsReady(pod *corev1.Pod) bool {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodReady {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
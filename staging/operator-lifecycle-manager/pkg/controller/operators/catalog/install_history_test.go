@@ -0,0 +1,188 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilclock "k8s.io/apimachinery/pkg/util/clock"
+
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/registry/reconciler"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/lib/ownerutil"
+)
+
+func TestRecordInstallHistory(t *testing.T) {
+	namespace := "ns"
+	now := time.Date(2021, 3, 6, 12, 0, 0, 0, time.UTC)
+	start := metav1.NewTime(now.Add(-time.Minute))
+
+	newCSV := csv("csv-b", namespace, nil, nil)
+	newCSV.Spec.Replaces = "csv-a"
+	step := csvStep(t, newCSV)
+	step.Resource.CatalogSource = "catalog"
+	step.Resource.CatalogSourceNamespace = "olm"
+
+	completed := metav1.NewTime(now.Add(-10 * time.Second))
+
+	for _, tt := range []struct {
+		name     string
+		ref      string
+		history  int
+		expected []v1alpha1.InstallRecord
+	}{
+		{
+			name: "Recorded",
+			ref:  "install-b",
+			expected: []v1alpha1.InstallRecord{{
+				InstallPlan:            "install-b",
+				From:                   "csv-a",
+				To:                     "csv-b",
+				StartTime:              &start,
+				CompletionTime:         completed,
+				Approval:               v1alpha1.ApprovalManual,
+				Approver:               "kubectl-patch",
+				Phase:                  v1alpha1.InstallPlanPhaseComplete,
+				CatalogSource:          "catalog",
+				CatalogSourceNamespace: "olm",
+				CatalogDigest:          "quay.io/catalog@sha256:abc",
+			}},
+		},
+		{
+			name:    "Trimmed",
+			ref:     "install-b",
+			history: maxInstallHistory,
+		},
+		{
+			name: "NotReferenced",
+			ref:  "install-c",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.TODO())
+			defer cancel()
+
+			sub := &v1alpha1.Subscription{
+				ObjectMeta: metav1.ObjectMeta{Name: "sub", Namespace: namespace, UID: "sub-uid"},
+				Spec:       &v1alpha1.SubscriptionSpec{Package: "pkg"},
+				Status: v1alpha1.SubscriptionStatus{
+					CurrentCSV:     "csv-b",
+					InstallPlanRef: &corev1.ObjectReference{Name: tt.ref, Namespace: namespace},
+				},
+			}
+			sub.SetGroupVersionKind(v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.SubscriptionKind))
+			for i := 0; i < tt.history; i++ {
+				sub.Status.InstallHistory = append(sub.Status.InstallHistory, v1alpha1.InstallRecord{InstallPlan: fmt.Sprintf("install-%d", i)})
+			}
+
+			plan := withSteps(installPlan("install-b", namespace, v1alpha1.InstallPlanPhaseComplete, "csv-b"), []*v1alpha1.Step{step})
+			plan.Spec.Approval = v1alpha1.ApprovalManual
+			plan.Spec.Approved = true
+			plan.Status.StartTime = &start
+			plan.Status.Conditions = []v1alpha1.InstallPlanCondition{{
+				Type:               v1alpha1.InstallPlanInstalled,
+				Status:             corev1.ConditionTrue,
+				LastTransitionTime: &completed,
+			}}
+			plan.SetAnnotations(map[string]string{catalogDigestsAnnotationKey: `{"olm/catalog":"quay.io/catalog@sha256:abc"}`})
+			plan.ManagedFields = []metav1.ManagedFieldsEntry{
+				{
+					Manager:  "catalog",
+					Time:     &start,
+					FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:approval":{},"f:approved":{}}}`)},
+				},
+				{
+					Manager:  "kubectl-patch",
+					Time:     &metav1.Time{Time: now},
+					FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:approved":{}}}`)},
+				},
+				{
+					Manager:  "kubectl-label",
+					Time:     &metav1.Time{Time: now},
+					FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{}}}`)},
+				},
+			}
+			ownerutil.AddNonBlockingOwner(plan, sub)
+
+			op, err := NewFakeOperator(ctx, namespace, []string{namespace, "olm"},
+				withClock(utilclock.NewFakeClock(now)),
+				withClientObjs(sub, plan, operatorGroup("og", "", namespace, nil)))
+			require.NoError(t, err)
+
+			require.NoError(t, op.syncInstallPlans(plan))
+
+			outSub, err := op.client.OperatorsV1alpha1().Subscriptions(namespace).Get(ctx, sub.GetName(), metav1.GetOptions{})
+			require.NoError(t, err)
+			if tt.history > 0 {
+				require.Len(t, outSub.Status.InstallHistory, maxInstallHistory)
+				require.Equal(t, "install-1", outSub.Status.InstallHistory[0].InstallPlan)
+				require.Equal(t, "install-b", outSub.Status.InstallHistory[maxInstallHistory-1].InstallPlan)
+				return
+			}
+			require.Equal(t, tt.expected, outSub.Status.InstallHistory)
+		})
+	}
+}
+
+func TestCatalogDigest(t *testing.T) {
+	pod := func(name, imageID string, ready corev1.ConditionStatus) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "olm",
+				Labels:    map[string]string{reconciler.CatalogSourceLabelKey: "catalog"},
+			},
+			Status: corev1.PodStatus{
+				Phase:             corev1.PodRunning,
+				Conditions:        []corev1.PodCondition{{Type: corev1.PodReady, Status: ready}},
+				ContainerStatuses: []corev1.ContainerStatus{{ImageID: imageID}},
+			},
+		}
+	}
+
+	for _, tt := range []struct {
+		name     string
+		pods     []*corev1.Pod
+		expected string
+	}{
+		{
+			name:     "Serving",
+			pods:     []*corev1.Pod{pod("catalog-a", "quay.io/catalog@sha256:abc", corev1.ConditionTrue)},
+			expected: "quay.io/catalog@sha256:abc",
+		},
+		{
+			name: "ReplacementNotReady",
+			pods: []*corev1.Pod{
+				pod("catalog-a", "quay.io/catalog@sha256:abc", corev1.ConditionTrue),
+				pod("catalog-b", "quay.io/catalog@sha256:def", corev1.ConditionFalse),
+			},
+			expected: "quay.io/catalog@sha256:abc",
+		},
+		{
+			name: "RollingOut",
+			pods: []*corev1.Pod{
+				pod("catalog-a", "quay.io/catalog@sha256:abc", corev1.ConditionTrue),
+				pod("catalog-b", "quay.io/catalog@sha256:def", corev1.ConditionTrue),
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.TODO())
+			defer cancel()
+
+			var objs []runtime.Object
+			for _, p := range tt.pods {
+				objs = append(objs, p)
+			}
+			op, err := NewFakeOperator(ctx, "olm", []string{"olm"}, withK8sObjs(objs...))
+			require.NoError(t, err)
+
+			require.Equal(t, tt.expected, op.catalogDigest("catalog", "olm"))
+		})
+	}
+}
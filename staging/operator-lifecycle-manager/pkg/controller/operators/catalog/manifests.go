@@ -5,33 +5,43 @@ import (
 	"fmt"
 
 	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/operator-framework/operator-registry/pkg/api"
 	"github.com/operator-framework/operator-registry/pkg/configmap"
 	errorwrap "github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/client-go/listers/core/v1"
 
+	controllerbundle "github.com/operator-framework/operator-lifecycle-manager/pkg/controller/bundle"
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/registry/resolver"
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/registry/resolver/projection"
 )
 
 // ManifestResolver can dereference a manifest for a step. Steps may embed manifests directly or reference content
-// in configmaps
+// in configmaps or the bundle cache
 type ManifestResolver interface {
 	ManifestForStep(step *v1alpha1.Step) (string, error)
 }
 
-// manifestResolver caches manifest from unpacked bundles (via configmaps)
+// unpackedBundleLoader loads bundles that were unpacked into the bundle cache
+type unpackedBundleLoader interface {
+	Load(lookup *v1alpha1.BundleLookup) (*api.Bundle, error)
+}
+
+// manifestResolver caches manifest from unpacked bundles (via configmaps or the bundle cache)
 type manifestResolver struct {
 	configMapLister v1.ConfigMapLister
+	bundleLoader    unpackedBundleLoader
 	unpackedSteps   map[string][]v1alpha1.StepResource
 	namespace       string
 	logger          logrus.FieldLogger
 }
 
-func newManifestResolver(namespace string, configMapLister v1.ConfigMapLister, logger logrus.FieldLogger) *manifestResolver {
+func newManifestResolver(namespace string, configMapLister v1.ConfigMapLister, bundleLoader unpackedBundleLoader, logger logrus.FieldLogger) *manifestResolver {
 	return &manifestResolver{
 		namespace:       namespace,
 		configMapLister: configMapLister,
+		bundleLoader:    bundleLoader,
 		unpackedSteps:   map[string][]v1alpha1.StepResource{},
 		logger:          logger,
 	}
@@ -47,7 +57,7 @@ func (r *manifestResolver) ManifestForStep(step *v1alpha1.Step) (string, error)
 	}
 
 	log := r.logger.WithFields(logrus.Fields{"resolving": step.Resolving, "step": step.Resource.Name})
-	log.WithField("ref", ref).Debug("step is a reference to configmap")
+	log.WithField("ref", ref).Debug("step is a reference to an unpacked bundle")
 
 	usteps, err := r.unpackedStepsForBundle(step.Resolving, ref)
 	if err != nil {
@@ -77,14 +87,9 @@ func (r *manifestResolver) unpackedStepsForBundle(bundleName string, ref *Unpack
 	if ok {
 		return usteps, nil
 	}
-	cm, err := r.configMapLister.ConfigMaps(ref.Namespace).Get(ref.Name)
+	bundle, err := r.loadBundle(ref)
 	if err != nil {
-		return nil, errorwrap.Wrapf(err, "error finding unpacked bundle configmap for ref %v", *ref)
-	}
-	loader := configmap.NewBundleLoader()
-	bundle, err := loader.Load(cm)
-	if err != nil {
-		return nil, errorwrap.Wrapf(err, "error loading unpacked bundle configmap for ref %v", *ref)
+		return nil, err
 	}
 
 	if ref.Properties != "" {
@@ -103,6 +108,38 @@ func (r *manifestResolver) unpackedStepsForBundle(bundleName string, ref *Unpack
 	return steps, nil
 }
 
+func (r *manifestResolver) loadBundle(ref *UnpackedBundleReference) (*api.Bundle, error) {
+	if ref.Kind == controllerbundle.CacheStorage {
+		if r.bundleLoader == nil {
+			return nil, fmt.Errorf("no bundle cache to load ref %v from", *ref)
+		}
+		bundle, err := r.bundleLoader.Load(&v1alpha1.BundleLookup{
+			Path:       ref.Path,
+			Replaces:   ref.Replaces,
+			Properties: ref.Properties,
+			CatalogSourceRef: &corev1.ObjectReference{
+				Namespace: ref.CatalogSourceNamespace,
+				Name:      ref.CatalogSourceName,
+			},
+		})
+		if err != nil {
+			return nil, errorwrap.Wrapf(err, "error loading cached bundle for ref %v", *ref)
+		}
+		return bundle, nil
+	}
+
+	cm, err := r.configMapLister.ConfigMaps(ref.Namespace).Get(ref.Name)
+	if err != nil {
+		return nil, errorwrap.Wrapf(err, "error finding unpacked bundle configmap for ref %v", *ref)
+	}
+	loader := configmap.NewBundleLoader()
+	bundle, err := loader.Load(cm)
+	if err != nil {
+		return nil, errorwrap.Wrapf(err, "error loading unpacked bundle configmap for ref %v", *ref)
+	}
+	return bundle, nil
+}
+
 func refForStep(step *v1alpha1.Step, log logrus.FieldLogger) *UnpackedBundleReference {
 	log = log.WithFields(logrus.Fields{"resolving": step.Resolving, "step": step.Resource.Name})
 	var ref UnpackedBundleReference
@@ -111,7 +148,7 @@ func refForStep(step *v1alpha1.Step, log logrus.FieldLogger) *UnpackedBundleRefe
 		return nil
 	}
 	log = log.WithField("ref", ref)
-	if ref.Kind != "ConfigMap" || ref.Name == "" || ref.Namespace == "" || ref.CatalogSourceName == "" || ref.CatalogSourceNamespace == "" {
+	if (ref.Kind != controllerbundle.ConfigMapStorage && ref.Kind != controllerbundle.CacheStorage) || ref.Name == "" || ref.Namespace == "" || ref.CatalogSourceName == "" || ref.CatalogSourceNamespace == "" {
 		log.Debug("step is not a reference to an unpacked bundle (this is not an error if the step is a manifest)")
 		return nil
 	}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"path"
 	"reflect"
 	"sort"
 	"strings"
@@ -45,6 +46,7 @@ import (
 	"github.com/operator-framework/api/pkg/operators/v1alpha1"
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/clientset/versioned"
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/informers/externalversions"
+	operatorsv1listers "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/listers/operators/v1"
 	operatorsv1alpha1listers "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/listers/operators/v1alpha1"
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/bundle"
 	olmerrors "github.com/operator-framework/operator-lifecycle-manager/pkg/controller/errors"
@@ -82,8 +84,7 @@ const (
 	serviceKind            = "Service"
 	roleKind               = "Role"
 	roleBindingKind        = "RoleBinding"
-	generatedByKey         = "olm.generated-by"
-	maxInstallPlanCount    = 5
+	generatedByKey         = resolver.GeneratedByAnnotationKey
 	maxDeletesPerSweep     = 5
 	RegistryFieldManager   = "olm.registry"
 )
@@ -108,11 +109,14 @@ type Operator struct {
 	sources                  *grpc.SourceStore
 	sourcesLastUpdate        sharedtime.SharedTime
 	resolver                 resolver.StepResolver
+	sharedOperators          resolver.SharedOperatorsProvider
+	olmConfigLister          operatorsv1listers.OLMConfigLister
 	reconciler               reconciler.RegistryReconcilerFactory
 	catalogSubscriberIndexer map[string]cache.Indexer
 	clientAttenuator         *scoped.ClientAttenuator
 	serviceAccountQuerier    *scoped.UserDefinedServiceAccountQuerier
 	bundleUnpacker           bundle.Unpacker
+	bundleLoader             unpackedBundleLoader
 	installPlanTimeout       time.Duration
 	bundleUnpackTimeout      time.Duration
 	clientFactory            clients.Factory
@@ -121,7 +125,7 @@ type Operator struct {
 type CatalogSourceSyncFunc func(logger *logrus.Entry, in *v1alpha1.CatalogSource) (out *v1alpha1.CatalogSource, continueSync bool, syncError error)
 
 // NewOperator creates a new Catalog Operator.
-func NewOperator(ctx context.Context, kubeconfigPath string, clock utilclock.Clock, logger *logrus.Logger, resync time.Duration, configmapRegistryImage, opmImage, utilImage string, operatorNamespace string, scheme *runtime.Scheme, installPlanTimeout time.Duration, bundleUnpackTimeout time.Duration) (*Operator, error) {
+func NewOperator(ctx context.Context, kubeconfigPath string, clock utilclock.Clock, logger *logrus.Logger, resync time.Duration, configmapRegistryImage, opmImage, utilImage string, operatorNamespace string, scheme *runtime.Scheme, installPlanTimeout time.Duration, bundleUnpackTimeout time.Duration, bundleUnpackMethod bundle.UnpackMethod, bundleCacheDir string, snapshotTTL time.Duration, maxConcurrentSnapshotUpdates int, solverBackend string) (*Operator, error) {
 	resyncPeriod := queueinformer.ResyncWithJitter(resync, 0.2)
 	config, err := clientcmd.BuildConfigFromFlags("", kubeconfigPath)
 	if err != nil {
@@ -188,8 +192,14 @@ func NewOperator(ctx context.Context, kubeconfigPath string, clock utilclock.Clo
 	}
 	op.sources = grpc.NewSourceStore(logger, 10*time.Second, 10*time.Minute, op.syncSourceState)
 	op.reconciler = reconciler.NewRegistryReconcilerFactory(lister, opClient, configmapRegistryImage, op.now, ssaClient)
-	res := resolver.NewOperatorStepResolver(lister, crClient, opClient.KubernetesInterface(), operatorNamespace, op.sources, logger)
+	backend, err := solver.NewBackend(solverBackend)
+	if err != nil {
+		return nil, err
+	}
+	res := resolver.NewOperatorStepResolver(lister, crClient, opClient.KubernetesInterface(), operatorNamespace, op.sources, backend, logger,
+		resolvercache.WithSourceTTL(snapshotTTL), resolvercache.WithMaxConcurrentSnapshotUpdates(maxConcurrentSnapshotUpdates))
 	op.resolver = resolver.NewInstrumentedResolver(res, metrics.RegisterDependencyResolutionSuccess, metrics.RegisterDependencyResolutionFailure)
+	op.sharedOperators = resolver.NewGlobalOperatorGroupSharedOperators(lister.OperatorsV1().OperatorGroupLister(), lister.OperatorsV1alpha1().ClusterServiceVersionLister())
 
 	// Wire OLM CR sharedIndexInformers
 	crInformerFactory := externalversions.NewSharedInformerFactoryWithOptions(op.client, resyncPeriod())
@@ -229,6 +239,50 @@ func NewOperator(ctx context.Context, kubeconfigPath string, clock utilclock.Clo
 	if err := op.RegisterInformer(prunedCSVInformer); err != nil {
 		return nil, err
 	}
+	prunedCSVInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			op.requeueSharedDependents(obj)
+		},
+		UpdateFunc: func(oldObj, obj interface{}) {
+			op.requeueInstallPlansForFailedCSV(obj)
+			if sharedPhaseChanged(oldObj, obj) {
+				op.requeueSharedDependents(obj)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			op.gcClusterScopedBundleObjects(obj)
+			op.requeueSharedDependents(obj)
+		},
+	})
+
+	// Wire OperatorGroups
+	ogInformer := crInformerFactory.Operators().V1().OperatorGroups()
+	op.lister.OperatorsV1().RegisterOperatorGroupLister(metav1.NamespaceAll, ogInformer.Lister())
+	ogInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			op.requeueForSharingOperatorGroup(nil, obj)
+		},
+		UpdateFunc: op.requeueForSharingOperatorGroup,
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			op.requeueForSharingOperatorGroup(obj, nil)
+		},
+	})
+	if err := op.RegisterInformer(ogInformer.Informer()); err != nil {
+		return nil, err
+	}
+
+	// Wire OLMConfig
+	olmConfigInformer := crInformerFactory.Operators().V1().OLMConfigs()
+	op.olmConfigLister = olmConfigInformer.Lister()
+	if err := op.RegisterInformer(olmConfigInformer.Informer()); err != nil {
+		return nil, err
+	}
 
 	// TODO: Add namespace resolve sync
 
@@ -328,6 +382,16 @@ func NewOperator(ctx context.Context, kubeconfigPath string, clock utilclock.Clo
 	op.lister.RbacV1().RegisterRoleBindingLister(metav1.NamespaceAll, roleBindingInformer.Lister())
 	sharedIndexInformers = append(sharedIndexInformers, roleBindingInformer.Informer())
 
+	// Wire ClusterRoles
+	clusterRoleInformer := k8sInformerFactory.Rbac().V1().ClusterRoles()
+	op.lister.RbacV1().RegisterClusterRoleLister(clusterRoleInformer.Lister())
+	sharedIndexInformers = append(sharedIndexInformers, clusterRoleInformer.Informer())
+
+	// Wire ClusterRoleBindings
+	clusterRoleBindingInformer := k8sInformerFactory.Rbac().V1().ClusterRoleBindings()
+	op.lister.RbacV1().RegisterClusterRoleBindingLister(clusterRoleBindingInformer.Lister())
+	sharedIndexInformers = append(sharedIndexInformers, clusterRoleBindingInformer.Informer())
+
 	// Wire ServiceAccounts
 	serviceAccountInformer := k8sInformerFactory.Core().V1().ServiceAccounts()
 	op.lister.CoreV1().RegisterServiceAccountLister(metav1.NamespaceAll, serviceAccountInformer.Lister())
@@ -352,6 +416,13 @@ func NewOperator(ctx context.Context, kubeconfigPath string, clock utilclock.Clo
 	op.lister.CoreV1().RegisterPodLister(metav1.NamespaceAll, csPodInformer.Lister())
 	sharedIndexInformers = append(sharedIndexInformers, csPodInformer.Informer())
 
+	// Wire Deployments for CatalogSource
+	csDeploymentInformer := informers.NewSharedInformerFactoryWithOptions(op.opClient.KubernetesInterface(), resyncPeriod(), informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+		options.LabelSelector = csPodLabels.String()
+	})).Apps().V1().Deployments()
+	op.lister.AppsV1().RegisterDeploymentLister(metav1.NamespaceAll, csDeploymentInformer.Lister())
+	sharedIndexInformers = append(sharedIndexInformers, csDeploymentInformer.Informer())
+
 	// Wire Pods for BundleUnpack job
 	buReq, err := labels.NewRequirement(bundle.BundleUnpackPodLabel, selection.Exists, nil)
 	if err != nil {
@@ -372,6 +443,13 @@ func NewOperator(ctx context.Context, kubeconfigPath string, clock utilclock.Clo
 	op.lister.CoreV1().RegisterConfigMapLister(metav1.NamespaceAll, configMapInformer.Lister())
 	sharedIndexInformers = append(sharedIndexInformers, configMapInformer.Informer())
 
+	// Wire Secrets of catalog update webhooks
+	secretInformer := informers.NewSharedInformerFactoryWithOptions(op.opClient.KubernetesInterface(), resyncPeriod(), informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+		options.LabelSelector = reconciler.CatalogSourceUpdateWebhookLabelKey
+	})).Core().V1().Secrets()
+	op.lister.CoreV1().RegisterSecretLister(metav1.NamespaceAll, secretInformer.Lister())
+	sharedIndexInformers = append(sharedIndexInformers, secretInformer.Informer())
+
 	// Wire Jobs
 	jobInformer := k8sInformerFactory.Batch().V1().Jobs()
 	sharedIndexInformers = append(sharedIndexInformers, jobInformer.Informer())
@@ -395,7 +473,7 @@ func NewOperator(ctx context.Context, kubeconfigPath string, clock utilclock.Clo
 	}
 
 	// Setup the BundleUnpacker
-	op.bundleUnpacker, err = bundle.NewConfigmapUnpacker(
+	jobUnpacker, err := bundle.NewConfigmapUnpacker(
 		bundle.WithLogger(op.logger),
 		bundle.WithClient(op.opClient.KubernetesInterface()),
 		bundle.WithCatalogSourceLister(catsrcInformer.Lister()),
@@ -412,6 +490,19 @@ func NewOperator(ctx context.Context, kubeconfigPath string, clock utilclock.Clo
 	if err != nil {
 		return nil, err
 	}
+	bundleCache, err := bundle.NewCache(bundleCacheDir, bundle.DefaultCacheTTL)
+	if err != nil {
+		return nil, err
+	}
+	directUnpacker := bundle.NewDirectUnpacker(op.logger, op.opClient.KubernetesInterface(), catsrcInformer.Lister(), op.sources, bundleCache, op.now, op.bundleUnpackTimeout)
+	op.bundleLoader = directUnpacker
+	op.bundleUnpacker, err = bundle.NewMethodUnpacker(op.logger, catsrcInformer.Lister(), bundleUnpackMethod, map[bundle.UnpackMethod]bundle.Unpacker{
+		bundle.UnpackMethodJob:    jobUnpacker,
+		bundle.UnpackMethodDirect: directUnpacker,
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	// Register CustomResourceDefinition QueueInformer
 	crdInformer := extinf.NewSharedInformerFactory(op.opClient.ApiextensionsInterface(), resyncPeriod()).Apiextensions().V1().CustomResourceDefinitions()
@@ -593,6 +684,8 @@ func validateSourceType(logger *logrus.Entry, in *v1alpha1.CatalogSource) (out *
 	case v1alpha1.SourceTypeGrpc:
 		if out.Spec.Image == "" && out.Spec.Address == "" {
 			err = fmt.Errorf("image and address unset: at least one must be set for sourcetype: %s", sourceType)
+		} else if out.Spec.GrpcPodConfig != nil {
+			err = validateGrpcPodConfig(out.Spec.GrpcPodConfig)
 		}
 	default:
 		err = fmt.Errorf("unknown sourcetype: %s", sourceType)
@@ -613,6 +706,116 @@ func validateSourceType(logger *logrus.Entry, in *v1alpha1.CatalogSource) (out *
 	return
 }
 
+// validateGrpcPodConfig validates the pod overrides of a grpc catalog source.
+func validateGrpcPodConfig(config *v1alpha1.GrpcPodConfig) error {
+	if config.Resources != nil {
+		for name, limit := range config.Resources.Limits {
+			if request, ok := config.Resources.Requests[name]; ok && request.Cmp(limit) > 0 {
+				return fmt.Errorf("invalid grpcPodConfig: %s request %s exceeds limit %s", name, request.String(), limit.String())
+			}
+		}
+	}
+
+	if sc := config.SecurityContext; sc != nil {
+		if sc.WindowsOptions != nil && sc.WindowsOptions.HostProcess != nil && *sc.WindowsOptions.HostProcess {
+			return fmt.Errorf("invalid grpcPodConfig: host process containers are not allowed")
+		}
+		if err := validateSeccompProfile(sc.SeccompProfile); err != nil {
+			return err
+		}
+	}
+	if sc := config.ContainerSecurityContext; sc != nil {
+		if err := validateContainerSecurityContext(sc); err != nil {
+			return err
+		}
+	}
+
+	volumes := map[string]struct{}{}
+	for _, volume := range config.Volumes {
+		if volume.Name == "" {
+			return fmt.Errorf("invalid grpcPodConfig: volume name unset")
+		}
+		if _, ok := volumes[volume.Name]; ok {
+			return fmt.Errorf("invalid grpcPodConfig: duplicate volume %s", volume.Name)
+		}
+		if err := validateVolumeSource(volume.VolumeSource); err != nil {
+			return fmt.Errorf("invalid grpcPodConfig: volume %s: %v", volume.Name, err)
+		}
+		volumes[volume.Name] = struct{}{}
+	}
+	mountPaths := map[string]struct{}{}
+	for _, mount := range config.VolumeMounts {
+		if _, ok := volumes[mount.Name]; !ok {
+			return fmt.Errorf("invalid grpcPodConfig: volume mount %s doesn't refer to a volume", mount.Name)
+		}
+		if !path.IsAbs(mount.MountPath) {
+			return fmt.Errorf("invalid grpcPodConfig: mount path %q of volume mount %s must be absolute", mount.MountPath, mount.Name)
+		}
+		if _, ok := mountPaths[mount.MountPath]; ok {
+			return fmt.Errorf("invalid grpcPodConfig: duplicate mount path %s", mount.MountPath)
+		}
+		if mount.MountPropagation != nil && *mount.MountPropagation == corev1.MountPropagationBidirectional {
+			return fmt.Errorf("invalid grpcPodConfig: volume mount %s must not use bidirectional mount propagation", mount.Name)
+		}
+		mountPaths[mount.MountPath] = struct{}{}
+	}
+
+	if pdb := config.PodDisruptionBudget; pdb != nil && pdb.MinAvailable != nil && pdb.MaxUnavailable != nil {
+		return fmt.Errorf("invalid grpcPodConfig: podDisruptionBudget minAvailable and maxUnavailable are mutually exclusive")
+	}
+	return nil
+}
+
+// validateContainerSecurityContext rejects security context overrides that would grant the registry server container
+// more privileges than it has by default.
+func validateContainerSecurityContext(sc *corev1.SecurityContext) error {
+	if sc.Privileged != nil && *sc.Privileged {
+		return fmt.Errorf("invalid grpcPodConfig: privileged containers are not allowed")
+	}
+	if sc.AllowPrivilegeEscalation != nil && *sc.AllowPrivilegeEscalation {
+		return fmt.Errorf("invalid grpcPodConfig: privilege escalation is not allowed")
+	}
+	if sc.Capabilities != nil && len(sc.Capabilities.Add) > 0 {
+		return fmt.Errorf("invalid grpcPodConfig: adding capabilities is not allowed")
+	}
+	if sc.ProcMount != nil && *sc.ProcMount != corev1.DefaultProcMount {
+		return fmt.Errorf("invalid grpcPodConfig: proc mount type %s is not allowed", *sc.ProcMount)
+	}
+	if sc.WindowsOptions != nil && sc.WindowsOptions.HostProcess != nil && *sc.WindowsOptions.HostProcess {
+		return fmt.Errorf("invalid grpcPodConfig: host process containers are not allowed")
+	}
+	return validateSeccompProfile(sc.SeccompProfile)
+}
+
+// validateVolumeSource only allows volumes that expose API objects or scratch space to the registry server.
+func validateVolumeSource(source corev1.VolumeSource) error {
+	switch {
+	case source.ConfigMap != nil, source.Secret != nil, source.Projected != nil, source.EmptyDir != nil, source.DownwardAPI != nil:
+		return nil
+	default:
+		return fmt.Errorf("only configMap, secret, projected, emptyDir and downwardAPI volumes are allowed")
+	}
+}
+
+func validateSeccompProfile(profile *corev1.SeccompProfile) error {
+	if profile == nil {
+		return nil
+	}
+	switch profile.Type {
+	case corev1.SeccompProfileTypeLocalhost:
+		if profile.LocalhostProfile == nil || *profile.LocalhostProfile == "" {
+			return fmt.Errorf("invalid grpcPodConfig: localhostProfile must be set for seccomp profile type %s", profile.Type)
+		}
+	case corev1.SeccompProfileTypeRuntimeDefault, corev1.SeccompProfileTypeUnconfined:
+		if profile.LocalhostProfile != nil {
+			return fmt.Errorf("invalid grpcPodConfig: localhostProfile must only be set for seccomp profile type %s", corev1.SeccompProfileTypeLocalhost)
+		}
+	default:
+		return fmt.Errorf("invalid grpcPodConfig: unknown seccomp profile type %s", profile.Type)
+	}
+	return nil
+}
+
 func (o *Operator) syncConfigMap(logger *logrus.Entry, in *v1alpha1.CatalogSource) (out *v1alpha1.CatalogSource, continueSync bool, syncError error) {
 	out = in
 	if !(in.Spec.SourceType == v1alpha1.SourceTypeInternal || in.Spec.SourceType == v1alpha1.SourceTypeConfigmap) {
@@ -727,8 +930,8 @@ func (o *Operator) syncRegistryServer(logger *logrus.Entry, in *v1alpha1.Catalog
 
 	// requeue the catalog sync based on the polling interval, for accurate syncs of catalogs with polling enabled
 	if out.Spec.UpdateStrategy != nil {
-		logger.Debugf("requeuing registry server sync based on polling interval %s", out.Spec.UpdateStrategy.Interval.Duration.String())
 		resyncPeriod := reconciler.SyncRegistryUpdateInterval(out, time.Now())
+		logger.Debugf("requeuing registry server sync based on update strategy in %s", resyncPeriod.String())
 		o.catsrcQueueSet.RequeueAfter(out.GetNamespace(), out.GetName(), queueinformer.ResyncWithJitter(resyncPeriod, 0.1)())
 		return
 	}
@@ -925,6 +1128,14 @@ func (o *Operator) syncResolvingNamespace(obj interface{}) error {
 		}
 		subscriptionUpdated = subscriptionUpdated || changedIP
 
+		// retry unpacking bundles that failed to unpack
+		sub, changedUnpack, err := o.ensureSubscriptionBundleUnpackState(logger, sub)
+		if err != nil {
+			logger.Debugf("error ensuring bundle unpack state: %v", err)
+			return err
+		}
+		subscriptionUpdated = subscriptionUpdated || changedUnpack
+
 		// record the current state of the desired corresponding CSV in the status. no-op if we don't know the csv yet.
 		sub, changedCSV, err := o.ensureSubscriptionCSVState(logger, sub, querier)
 		if err != nil {
@@ -933,6 +1144,15 @@ func (o *Operator) syncResolvingNamespace(obj interface{}) error {
 		}
 
 		subscriptionUpdated = subscriptionUpdated || changedCSV
+
+		// record the shared operators the installed CSV depends on
+		sub, changedDeps, err := o.ensureSubscriptionSharedDependencies(logger, sub)
+		if err != nil {
+			logger.Debugf("error recording shared dependencies in status: %v", err)
+			return err
+		}
+
+		subscriptionUpdated = subscriptionUpdated || changedDeps
 		subs[i] = sub
 	}
 	if subscriptionUpdated {
@@ -991,7 +1211,20 @@ func (o *Operator) syncResolvingNamespace(obj interface{}) error {
 		return err
 	}
 
+	// installed operators are kept while their upgrade windows are closed, so resolve again once the next one opens
+	var installed []*v1alpha1.Subscription
+	for _, sub := range subs {
+		if sub.Status.InstalledCSV != "" {
+			installed = append(installed, sub)
+		}
+	}
+	now := o.now()
+	if _, next := holdForUpgradeWindows(installed, now); !next.IsZero() {
+		o.nsResolveQueue.AddAfter(namespace, next.Sub(now.Time))
+	}
+
 	// create installplan if anything updated
+	var installPlanApproval v1alpha1.Approval
 	if len(updatedSubs) > 0 {
 		logger.Debug("resolution caused subscription changes, creating installplan")
 		// Finish calculating max generation by checking the existing installplans
@@ -1007,7 +1240,7 @@ func (o *Operator) syncResolvingNamespace(obj interface{}) error {
 
 		// any subscription in the namespace with manual approval will force generated installplans to be manual
 		// TODO: this is an odd artifact of the older resolver, and will probably confuse users. approval mode could be on the operatorgroup?
-		installPlanApproval := v1alpha1.ApprovalAutomatic
+		installPlanApproval = v1alpha1.ApprovalAutomatic
 		for _, sub := range subs {
 			if sub.Spec.InstallPlanApproval == v1alpha1.ApprovalManual {
 				installPlanApproval = v1alpha1.ApprovalManual
@@ -1015,7 +1248,9 @@ func (o *Operator) syncResolvingNamespace(obj interface{}) error {
 			}
 		}
 
-		installPlanReference, err := o.ensureInstallPlan(logger, namespace, maxGeneration+1, subs, installPlanApproval, steps, bundleLookups)
+		// Automatic InstallPlans are held while the upgrade windows of the subscriptions they change are closed
+		held, _ := holdForUpgradeWindows(updatedSubs, o.now())
+		installPlanReference, err := o.ensureInstallPlan(logger, namespace, maxGeneration+1, subs, installPlanApproval, len(held) > 0, steps, bundleLookups)
 		if err != nil {
 			logger.WithError(err).Debug("error ensuring installplan")
 			return err
@@ -1044,6 +1279,14 @@ func (o *Operator) syncResolvingNamespace(obj interface{}) error {
 		newSub = true
 	}
 
+	// Explain why an Automatic InstallPlan is held outside of upgrade windows
+	if installPlanApproval == v1alpha1.ApprovalAutomatic && len(updatedSubs) > 0 {
+		held, _ := holdForUpgradeWindows(updatedSubs, o.now())
+		for sub, cond := range held {
+			sub.Status.SetCondition(cond)
+		}
+	}
+
 	// Update subscriptions with all changes so far
 	_, updateErr := o.updateSubscriptionStatuses(subs)
 	if updateErr != nil {
@@ -1126,6 +1369,7 @@ func (o *Operator) ensureSubscriptionCSVState(logger *logrus.Entry, sub *v1alpha
 			return nil, false, err
 		}
 		out.Status.State = v1alpha1.SubscriptionStateAtLatest
+		pruneRolledBackCSVs(out, sub.Status.CurrentCSV)
 		out.Status.InstalledCSV = sub.Status.CurrentCSV
 	}
 
@@ -1162,7 +1406,7 @@ func (o *Operator) setIPReference(subs []*v1alpha1.Subscription, gen int, instal
 	return subs
 }
 
-func (o *Operator) ensureInstallPlan(logger *logrus.Entry, namespace string, gen int, subs []*v1alpha1.Subscription, installPlanApproval v1alpha1.Approval, steps []*v1alpha1.Step, bundleLookups []v1alpha1.BundleLookup) (*corev1.ObjectReference, error) {
+func (o *Operator) ensureInstallPlan(logger *logrus.Entry, namespace string, gen int, subs []*v1alpha1.Subscription, installPlanApproval v1alpha1.Approval, held bool, steps []*v1alpha1.Step, bundleLookups []v1alpha1.BundleLookup) (*corev1.ObjectReference, error) {
 	if len(steps) == 0 && len(bundleLookups) == 0 {
 		return nil, nil
 	}
@@ -1180,10 +1424,12 @@ func (o *Operator) ensureInstallPlan(logger *logrus.Entry, namespace string, gen
 	}
 	logger.Warn("no installplan found with matching generation, creating new one")
 
-	return o.createInstallPlan(namespace, gen, subs, installPlanApproval, steps, bundleLookups)
+	return o.createInstallPlan(namespace, gen, subs, installPlanApproval, held, steps, bundleLookups)
 }
 
-func (o *Operator) createInstallPlan(namespace string, gen int, subs []*v1alpha1.Subscription, installPlanApproval v1alpha1.Approval, steps []*v1alpha1.Step, bundleLookups []v1alpha1.BundleLookup) (*corev1.ObjectReference, error) {
+// createInstallPlan creates an InstallPlan for the given steps. An Automatic InstallPlan that is held
+// is created unapproved, and approved once the upgrade windows of the subscriptions it changes open.
+func (o *Operator) createInstallPlan(namespace string, gen int, subs []*v1alpha1.Subscription, installPlanApproval v1alpha1.Approval, held bool, steps []*v1alpha1.Step, bundleLookups []v1alpha1.BundleLookup) (*corev1.ObjectReference, error) {
 	if len(steps) == 0 && len(bundleLookups) == 0 {
 		return nil, nil
 	}
@@ -1204,8 +1450,10 @@ func (o *Operator) createInstallPlan(namespace string, gen int, subs []*v1alpha1
 		catalogSources = append(catalogSources, s)
 	}
 
+	approved := installPlanApproval == v1alpha1.ApprovalAutomatic && !held
+
 	phase := v1alpha1.InstallPlanPhaseInstalling
-	if installPlanApproval == v1alpha1.ApprovalManual {
+	if !approved {
 		phase = v1alpha1.InstallPlanPhaseRequiresApproval
 	}
 	ip := &v1alpha1.InstallPlan{
@@ -1216,12 +1464,30 @@ func (o *Operator) createInstallPlan(namespace string, gen int, subs []*v1alpha1
 		Spec: v1alpha1.InstallPlanSpec{
 			ClusterServiceVersionNames: csvNames,
 			Approval:                   installPlanApproval,
-			Approved:                   installPlanApproval == v1alpha1.ApprovalAutomatic,
+			Approved:                   approved,
 			Generation:                 gen,
 		},
 	}
 	for _, sub := range subs {
 		ownerutil.AddNonBlockingOwner(ip, sub)
+		if sub.Spec != nil && sub.Spec.RollbackPolicy != "" && ip.Spec.RollbackPolicy != v1alpha1.RollbackPolicyOnFailure {
+			ip.Spec.RollbackPolicy = sub.Spec.RollbackPolicy
+		}
+	}
+	annotations := map[string]string{}
+	if timeout, ok := bundleUnpackTimeoutFor(subs); ok {
+		annotations[bundle.BundleUnpackTimeoutAnnotationKey] = timeout
+	}
+	// record the catalog content the InstallPlan was resolved from, since the catalogs may update before it completes
+	if digests := o.catalogDigests(steps, bundleLookups); len(digests) > 0 {
+		raw, err := json.Marshal(digests)
+		if err != nil {
+			return nil, err
+		}
+		annotations[catalogDigestsAnnotationKey] = string(raw)
+	}
+	if len(annotations) > 0 {
+		ip.SetAnnotations(annotations)
 	}
 
 	res, err := o.client.OperatorsV1alpha1().InstallPlans(namespace).Create(context.TODO(), ip, metav1.CreateOptions{})
@@ -1328,6 +1594,7 @@ type UnpackedBundleReference struct {
 	CatalogSourceNamespace string `json:"catalogSourceNamespace"`
 	Replaces               string `json:"replaces"`
 	Properties             string `json:"properties"`
+	Path                   string `json:"path,omitempty"`
 }
 
 // unpackBundles makes one walk through the bundlelookups and attempts to progress them
@@ -1335,11 +1602,15 @@ func (o *Operator) unpackBundles(plan *v1alpha1.InstallPlan) (bool, *v1alpha1.In
 	out := plan.DeepCopy()
 	unpacked := true
 
-	// The bundle timeout annotation if specified overrides the --bundle-unpack-timeout flag value
+	// The bundle timeout annotation if specified overrides the OLMConfig and --bundle-unpack-timeout flag values
 	// If the timeout cannot be parsed it's set to < 0 and subsequently ignored
 	unpackTimeout := -1 * time.Minute
 	timeoutStr, ok := plan.GetAnnotations()[bundle.BundleUnpackTimeoutAnnotationKey]
-	if ok {
+	if !ok {
+		if config := o.olmConfig(o.logger); config != nil && config.Spec.BundleUnpackTimeout != nil {
+			unpackTimeout = config.Spec.BundleUnpackTimeout.Duration
+		}
+	} else {
 		d, err := time.ParseDuration(timeoutStr)
 		if err != nil {
 			o.logger.Errorf("failed to parse unpack timeout annotation(%s: %s): %v", bundle.BundleUnpackTimeoutAnnotationKey, timeoutStr, err)
@@ -1389,9 +1660,10 @@ func (o *Operator) unpackBundles(plan *v1alpha1.InstallPlan) (bool, *v1alpha1.In
 		// step manifests are replaced with references to the configmap containing them
 		for i, s := range steps {
 			ref := UnpackedBundleReference{
-				Kind:                   "ConfigMap",
+				Kind:                   res.Storage(),
 				Namespace:              res.CatalogSourceRef.Namespace,
 				Name:                   res.Name(),
+				Path:                   res.Path,
 				CatalogSourceName:      res.CatalogSourceRef.Name,
 				CatalogSourceNamespace: res.CatalogSourceRef.Namespace,
 				Replaces:               res.Replaces,
@@ -1419,6 +1691,12 @@ func (o *Operator) unpackBundles(plan *v1alpha1.InstallPlan) (bool, *v1alpha1.In
 	return unpacked, out, nil
 }
 
+// installPlanRetention returns the maximum number and age of InstallPlans kept in the given namespace,
+// as configured by the "cluster" OLMConfig.
+func (o *Operator) installPlanRetention(log logrus.FieldLogger, namespace string) (int, time.Duration) {
+	return o.olmConfig(log).InstallPlanRetentionFor(namespace)
+}
+
 // gcInstallPlans garbage collects installplans that are too old
 // installplans are ownerrefd to all subscription inputs, so they will not otherwise
 // be GCd unless all inputs have been deleted.
@@ -1428,14 +1706,29 @@ func (o *Operator) gcInstallPlans(log logrus.FieldLogger, namespace string) {
 		log.Warn("unable to list installplans for GC")
 	}
 
-	if len(allIps) <= maxInstallPlanCount {
-		return
+	maxCount, maxAge := o.installPlanRetention(log, namespace)
+	toDelete := installPlansOverCount(allIps, maxCount)
+	if maxAge > 0 && len(toDelete) < maxDeletesPerSweep {
+		toDelete = append(toDelete, installPlansOverAge(allIps, toDelete, maxAge, o.now().Time, maxDeletesPerSweep-len(toDelete))...)
+	}
+
+	for _, i := range toDelete {
+		if err := o.client.OperatorsV1alpha1().InstallPlans(namespace).Delete(context.TODO(), i.GetName(), metav1.DeleteOptions{}); err != nil {
+			log.WithField("deleting", i.GetName()).WithError(err).Warn("error GCing old installplan - may have already been deleted")
+		}
+	}
+}
+
+// installPlansOverCount returns the installplans of the oldest generations that exceed maxCount.
+func installPlansOverCount(allIps []*v1alpha1.InstallPlan, maxCount int) []*v1alpha1.InstallPlan {
+	if len(allIps) <= maxCount {
+		return nil
 	}
 
 	// we only consider maxDeletesPerSweep more than the allowed number of installplans for delete at one time
 	ips := allIps
-	if len(ips) > maxInstallPlanCount+maxDeletesPerSweep {
-		ips = allIps[:maxInstallPlanCount+maxDeletesPerSweep]
+	if len(ips) > maxCount+maxDeletesPerSweep {
+		ips = allIps[:maxCount+maxDeletesPerSweep]
 	}
 
 	byGen := map[int][]*v1alpha1.InstallPlan{}
@@ -1459,12 +1752,12 @@ func (o *Operator) gcInstallPlans(log logrus.FieldLogger, namespace string) {
 	for _, i := range gens {
 		g := byGen[i]
 
-		if len(ips)-len(toDelete) <= maxInstallPlanCount {
+		if len(ips)-len(toDelete) <= maxCount {
 			break
 		}
 
 		// if removing all installplans at this generation doesn't dip below the max, safe to delete all of them
-		if len(ips)-len(toDelete)-len(g) >= maxInstallPlanCount {
+		if len(ips)-len(toDelete)-len(g) >= maxCount {
 			toDelete = append(toDelete, g...)
 			continue
 		}
@@ -1480,14 +1773,47 @@ func (o *Operator) gcInstallPlans(log logrus.FieldLogger, namespace string) {
 			// final fallback to lexicographic sort, in case many installplans are created with the same timestamp
 			return g[i].GetName() < g[j].GetName()
 		})
-		toDelete = append(toDelete, g[:len(ips)-len(toDelete)-maxInstallPlanCount]...)
+		toDelete = append(toDelete, g[:len(ips)-len(toDelete)-maxCount]...)
 	}
 
-	for _, i := range toDelete {
-		if err := o.client.OperatorsV1alpha1().InstallPlans(namespace).Delete(context.TODO(), i.GetName(), metav1.DeleteOptions{}); err != nil {
-			log.WithField("deleting", i.GetName()).WithError(err).Warn("error GCing old installplan - may have already been deleted")
+	return toDelete
+}
+
+// installPlansOverAge returns up to limit of the Complete and Failed installplans created more than maxAge before now,
+// oldest first, except for those of the latest generation and those already being deleted.
+func installPlansOverAge(allIps, deleting []*v1alpha1.InstallPlan, maxAge time.Duration, now time.Time, limit int) []*v1alpha1.InstallPlan {
+	latest := 0
+	for _, ip := range allIps {
+		if ip.Spec.Generation > latest {
+			latest = ip.Spec.Generation
+		}
+	}
+
+	skip := make(map[string]struct{}, len(deleting))
+	for _, ip := range deleting {
+		skip[ip.GetName()] = struct{}{}
+	}
+
+	var toDelete []*v1alpha1.InstallPlan
+	for _, ip := range allIps {
+		if _, ok := skip[ip.GetName()]; ok || ip.Spec.Generation == latest {
+			continue
+		}
+		if ip.Status.Phase != v1alpha1.InstallPlanPhaseComplete && ip.Status.Phase != v1alpha1.InstallPlanPhaseFailed {
+			continue
+		}
+		if now.Sub(ip.GetCreationTimestamp().Time) > maxAge {
+			toDelete = append(toDelete, ip)
 		}
 	}
+
+	sort.Slice(toDelete, func(i, j int) bool {
+		return toDelete[i].CreationTimestamp.Before(&toDelete[j].CreationTimestamp)
+	})
+	if len(toDelete) > limit {
+		toDelete = toDelete[:limit]
+	}
+	return toDelete
 }
 
 func (o *Operator) syncInstallPlans(obj interface{}) (syncError error) {
@@ -1511,8 +1837,15 @@ func (o *Operator) syncInstallPlans(obj interface{}) (syncError error) {
 		return
 	}
 
-	// Complete and Failed are terminal phases
+	// Complete and Failed are terminal phases, unless the InstallPlan is rolled back
 	if plan.Status.Phase == v1alpha1.InstallPlanPhaseFailed || plan.Status.Phase == v1alpha1.InstallPlanPhaseComplete {
+		if err := o.recordInstallHistory(plan, logger); err != nil {
+			syncError = fmt.Errorf("failed to record install history: %v", err)
+			return
+		}
+		if o.shouldRollback(plan) {
+			syncError = o.rollbackInstallPlan(plan, logger)
+		}
 		return
 	}
 
@@ -1616,6 +1949,22 @@ func (o *Operator) syncInstallPlans(obj interface{}) (syncError error) {
 		}
 	}
 
+	if plan.Status.Phase == v1alpha1.InstallPlanPhaseRequiresApproval && plan.Spec.Approval == v1alpha1.ApprovalAutomatic && !plan.Spec.Approved {
+		syncError = o.syncHeldInstallPlan(plan, logger)
+		return
+	}
+
+	// Record what the InstallPlan replaces before any of its steps are applied, so that it can be rolled back
+	if plan.Status.Phase == v1alpha1.InstallPlanPhaseInstalling && plan.Status.StartTime == nil && plan.Status.Previous == nil {
+		previous, err := o.previousInstallation(plan)
+		if err != nil {
+			logger.WithError(err).Warn("unable to record previous installation, rollback will be unavailable")
+		} else if previous != nil {
+			plan = plan.DeepCopy()
+			plan.Status.Previous = previous
+		}
+	}
+
 	outInstallPlan, syncError := transitionInstallPlanState(logger.Logger, o, *plan, o.now(), o.installPlanTimeout)
 
 	if syncError != nil {
@@ -1903,7 +2252,12 @@ func (o *Operator) ExecutePlan(plan *v1alpha1.InstallPlan) error {
 	}
 
 	ensurer := newStepEnsurer(kubeclient, crclient, dynamicClient)
-	r := newManifestResolver(plan.GetNamespace(), o.lister.CoreV1().ConfigMapLister(), o.logger)
+	r := newManifestResolver(plan.GetNamespace(), o.lister.CoreV1().ConfigMapLister(), o.bundleLoader, o.logger)
+	olmConfig := o.olmConfig(o.logger)
+	additionalKinds := newBundleObjectKinds(olmConfig)
+	if olmConfig.ServerSideApplyIsEnabled() {
+		ensurer = ensurer.withServerSideApply(plan.Spec.ForceConflicts)
+	}
 
 	discoveryQuerier := newDiscoveryQuerier(o.opClient.KubernetesInterface().Discovery())
 
@@ -1921,9 +2275,13 @@ func (o *Operator) ExecutePlan(plan *v1alpha1.InstallPlan) error {
 		return err
 	}
 	b := newBuilder(plan, o.lister.OperatorsV1alpha1().ClusterServiceVersionLister(), builderKubeClient, builderDynamicClient, r, o.logger)
+	if olmConfig.ServerSideApplyIsEnabled() {
+		b = b.withServerSideApply(plan.Spec.ForceConflicts)
+	}
 
 	for i, step := range plan.Status.Plan {
 		if err := func(i int, step *v1alpha1.Step) error {
+			plan.Status.Plan[i].Message = ""
 			wr.PopWarnings()
 			defer func() {
 				warnings := wr.PopWarnings()
@@ -2233,7 +2591,7 @@ func (o *Operator) ExecutePlan(plan *v1alpha1.InstallPlan) error {
 					plan.Status.Plan[i].Status = status
 
 				default:
-					if !isSupported(step.Resource.Kind) {
+					if !isSupported(step.Resource.Kind) && !additionalKinds.hasKind(step.Resource.Kind) {
 						// Not a supported resource
 						plan.Status.Plan[i].Status = v1alpha1.StepStatusUnsupportedResource
 						return v1alpha1.ErrInvalidInstallPlan
@@ -2253,6 +2611,15 @@ func (o *Operator) ExecutePlan(plan *v1alpha1.InstallPlan) error {
 						return err
 					}
 
+					// Kinds beyond the default set must be allowed for their group and scope
+					if !isSupported(step.Resource.Kind) {
+						if err := additionalKinds.check(gvk.GroupKind(), r.Namespaced); err != nil {
+							o.logger.WithError(err).WithField("step", step.Resource.Name).Info("unsupported bundle object")
+							plan.Status.Plan[i].Status = v1alpha1.StepStatusUnsupportedResource
+							return v1alpha1.ErrInvalidInstallPlan
+						}
+					}
+
 					// Create the GVR
 					gvr := schema.GroupVersionResource{
 						Group:    gvk.Group,
@@ -2293,8 +2660,8 @@ func (o *Operator) ExecutePlan(plan *v1alpha1.InstallPlan) error {
 						resourceInterface = dynamicClient.Resource(gvr)
 					}
 
-					// Ensure Unstructured Object
-					status, err := ensurer.EnsureUnstructuredObject(resourceInterface, unstructuredObject)
+					// Ensure Unstructured Object, keeping the existing owners of objects of additional kinds
+					status, err := ensurer.EnsureUnstructuredObject(resourceInterface, unstructuredObject, !isSupported(step.Resource.Kind))
 					if err != nil {
 						return err
 					}
@@ -2306,6 +2673,12 @@ func (o *Operator) ExecutePlan(plan *v1alpha1.InstallPlan) error {
 			}
 			return nil
 		}(i, step); err != nil {
+			var conflict *stepConflictError
+			if errors.As(err, &conflict) {
+				plan.Status.Plan[i].Status = v1alpha1.StepStatusConflict
+				plan.Status.Plan[i].Message = conflict.Error()
+				return err
+			}
 			if k8serrors.IsNotFound(err) {
 				// Check for APIVersions present in the installplan steps that are not available on the server.
 				// The check is made via discovery per step in the plan. Transient communication failures to the api-server are handled by the plan retry logic.
@@ -2314,6 +2687,11 @@ func (o *Operator) ExecutePlan(plan *v1alpha1.InstallPlan) error {
 					return notFoundErr
 				}
 			}
+			if k8serrors.IsForbidden(err) && plan.Status.AttenuatedServiceAccountRef != nil && olmConfig.MissingPermissionsAreReported() {
+				if missingErr := o.missingPermissionsError(plan, r); missingErr != nil {
+					return missingErr
+				}
+			}
 			return err
 		}
 	}
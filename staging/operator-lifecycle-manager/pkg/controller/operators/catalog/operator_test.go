@@ -26,12 +26,14 @@ import (
 	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	utilclock "k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/apiserver/pkg/storage/names"
 	fakedynamic "k8s.io/client-go/dynamic/fake"
@@ -305,7 +307,7 @@ func TestGCInstallPlans(t *testing.T) {
 			require.NoError(t, err)
 			out = outList.Items
 
-			if len(out) <= maxInstallPlanCount {
+			if len(out) <= operatorsv1.DefaultInstallPlanMaxCount {
 				break
 			}
 		}
@@ -319,14 +321,102 @@ func TestGCInstallPlans(t *testing.T) {
 		}
 		require.True(t, keptMax)
 
-		if len(ips) < maxInstallPlanCount {
+		if len(ips) < operatorsv1.DefaultInstallPlanMaxCount {
 			return len(out) == len(ips)
 		}
-		return len(out) == maxInstallPlanCount
+		return len(out) == operatorsv1.DefaultInstallPlanMaxCount
 	}
 	require.NoError(t, quick.Check(f, nil))
 }
 
+func TestGCInstallPlansRetention(t *testing.T) {
+	now := time.Date(2021, 3, 6, 12, 0, 0, 0, time.UTC)
+	plan := func(gen int, phase v1alpha1.InstallPlanPhase, age time.Duration) *v1alpha1.InstallPlan {
+		ip := installPlan(fmt.Sprintf("install-%d", gen), "ns", phase)
+		ip.Spec.Generation = gen
+		ip.CreationTimestamp = metav1.NewTime(now.Add(-age))
+		return ip
+	}
+	count := func(c int32) *int32 { return &c }
+
+	for _, tt := range []struct {
+		name      string
+		retention *operatorsv1.InstallPlanRetention
+		expected  []string
+	}{
+		{
+			name:     "Default",
+			expected: []string{"install-1", "install-2", "install-3", "install-4", "install-5"},
+		},
+		{
+			name: "MaxAgeOverride",
+			retention: &operatorsv1.InstallPlanRetention{
+				InstallPlanRetentionPolicy: operatorsv1.InstallPlanRetentionPolicy{MaxCount: count(10)},
+				Overrides: []operatorsv1.NamespaceInstallPlanRetention{
+					{Namespace: "other"},
+					{Namespace: "ns", InstallPlanRetentionPolicy: operatorsv1.InstallPlanRetentionPolicy{MaxAge: &metav1.Duration{Duration: time.Hour}}},
+				},
+			},
+			expected: []string{"install-3", "install-4", "install-5"},
+		},
+		{
+			name: "MaxCount",
+			retention: &operatorsv1.InstallPlanRetention{
+				InstallPlanRetentionPolicy: operatorsv1.InstallPlanRetentionPolicy{MaxCount: count(2)},
+			},
+			expected: []string{"install-4", "install-5"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.TODO())
+			defer cancel()
+
+			objs := []runtime.Object{
+				plan(1, v1alpha1.InstallPlanPhaseComplete, 2*time.Hour),
+				plan(2, v1alpha1.InstallPlanPhaseFailed, 2*time.Hour),
+				plan(3, v1alpha1.InstallPlanPhaseInstalling, 2*time.Hour),
+				plan(4, v1alpha1.InstallPlanPhaseComplete, 10*time.Minute),
+				plan(5, v1alpha1.InstallPlanPhaseComplete, 2*time.Hour),
+			}
+			if tt.retention != nil {
+				objs = append(objs, &operatorsv1.OLMConfig{
+					ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+					Spec:       operatorsv1.OLMConfigSpec{InstallPlanRetention: tt.retention},
+				})
+			}
+			op, err := NewFakeOperator(ctx, "ns", []string{"ns"}, withClock(utilclock.NewFakeClock(now)), withClientObjs(objs...))
+			require.NoError(t, err)
+
+			op.gcInstallPlans(logrus.New(), "ns")
+
+			outList, err := op.client.OperatorsV1alpha1().InstallPlans("ns").List(ctx, metav1.ListOptions{})
+			require.NoError(t, err)
+			var names []string
+			for _, ip := range outList.Items {
+				names = append(names, ip.GetName())
+			}
+			require.ElementsMatch(t, tt.expected, names)
+		})
+	}
+}
+
+func TestInstallPlansOverAgeIsBounded(t *testing.T) {
+	now := time.Date(2021, 3, 6, 12, 0, 0, 0, time.UTC)
+	var ips []*v1alpha1.InstallPlan
+	for gen := 1; gen <= maxDeletesPerSweep+3; gen++ {
+		ip := installPlan(fmt.Sprintf("install-%d", gen), "ns", v1alpha1.InstallPlanPhaseComplete)
+		ip.Spec.Generation = gen
+		ip.CreationTimestamp = metav1.NewTime(now.Add(-time.Duration(24-gen) * time.Hour))
+		ips = append(ips, ip)
+	}
+
+	toDelete := installPlansOverAge(ips, nil, time.Hour, now, maxDeletesPerSweep)
+	require.Len(t, toDelete, maxDeletesPerSweep)
+	for i, ip := range toDelete {
+		require.Equal(t, fmt.Sprintf("install-%d", i+1), ip.GetName(), "the oldest installplans are deleted first")
+	}
+}
+
 func TestExecutePlan(t *testing.T) {
 	namespace := "ns"
 
@@ -981,6 +1071,30 @@ func TestSyncCatalogSources(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			testName:  "CatalogSourceWithGrpcType/ValidatesGrpcPodConfig",
+			namespace: "cool-namespace",
+			catalogSource: &v1alpha1.CatalogSource{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "invalid-spec-catalog",
+					Namespace: "cool-namespace",
+					UID:       types.UID("catalog-uid"),
+					Labels:    map[string]string{"olm.catalogSource": "invalid-spec-catalog"},
+				},
+				Spec: v1alpha1.CatalogSourceSpec{
+					SourceType: v1alpha1.SourceTypeGrpc,
+					Image:      "catalog-image",
+					GrpcPodConfig: &v1alpha1.GrpcPodConfig{
+						VolumeMounts: []corev1.VolumeMount{{Name: "ca-bundle", MountPath: "/etc/pki"}},
+					},
+				},
+			},
+			expectedStatus: &v1alpha1.CatalogSourceStatus{
+				Message: "invalid grpcPodConfig: volume mount ca-bundle doesn't refer to a volume",
+				Reason:  v1alpha1.CatalogSourceSpecInvalidError,
+			},
+			expectedError: nil,
+		},
 		{
 			testName:  "CatalogSourceWithInternalType/EnsuresConfigMapIsSet",
 			namespace: "cool-namespace",
@@ -1545,28 +1659,43 @@ func NewFakeOperator(ctx context.Context, namespace string, namespaces []string,
 	subInformer := operatorsFactory.Operators().V1alpha1().Subscriptions()
 	ipInformer := operatorsFactory.Operators().V1alpha1().InstallPlans()
 	csvInformer := operatorsFactory.Operators().V1alpha1().ClusterServiceVersions()
-	sharedInformers = append(sharedInformers, catsrcInformer.Informer(), subInformer.Informer(), ipInformer.Informer(), csvInformer.Informer())
+	ogInformer := operatorsFactory.Operators().V1().OperatorGroups()
+	olmConfigInformer := operatorsFactory.Operators().V1().OLMConfigs()
+	sharedInformers = append(sharedInformers, catsrcInformer.Informer(), subInformer.Informer(), ipInformer.Informer(), csvInformer.Informer(), ogInformer.Informer(), olmConfigInformer.Informer())
 
 	lister.OperatorsV1alpha1().RegisterCatalogSourceLister(metav1.NamespaceAll, catsrcInformer.Lister())
 	lister.OperatorsV1alpha1().RegisterSubscriptionLister(metav1.NamespaceAll, subInformer.Lister())
 	lister.OperatorsV1alpha1().RegisterInstallPlanLister(metav1.NamespaceAll, ipInformer.Lister())
 	lister.OperatorsV1alpha1().RegisterClusterServiceVersionLister(metav1.NamespaceAll, csvInformer.Lister())
+	lister.OperatorsV1().RegisterOperatorGroupLister(metav1.NamespaceAll, ogInformer.Lister())
 
 	factory := informers.NewSharedInformerFactoryWithOptions(opClientFake.KubernetesInterface(), wakeupInterval, informers.WithNamespace(metav1.NamespaceAll))
 	roleInformer := factory.Rbac().V1().Roles()
 	roleBindingInformer := factory.Rbac().V1().RoleBindings()
+	clusterRoleInformer := factory.Rbac().V1().ClusterRoles()
+	clusterRoleBindingInformer := factory.Rbac().V1().ClusterRoleBindings()
 	serviceAccountInformer := factory.Core().V1().ServiceAccounts()
 	serviceInformer := factory.Core().V1().Services()
 	podInformer := factory.Core().V1().Pods()
 	configMapInformer := factory.Core().V1().ConfigMaps()
-	sharedInformers = append(sharedInformers, roleInformer.Informer(), roleBindingInformer.Informer(), serviceAccountInformer.Informer(), serviceInformer.Informer(), podInformer.Informer(), configMapInformer.Informer())
+	deploymentInformer := factory.Apps().V1().Deployments()
+	sharedInformers = append(sharedInformers, roleInformer.Informer(), roleBindingInformer.Informer(), clusterRoleInformer.Informer(), clusterRoleBindingInformer.Informer(), serviceAccountInformer.Informer(), serviceInformer.Informer(), podInformer.Informer(), configMapInformer.Informer(), deploymentInformer.Informer())
 
 	lister.RbacV1().RegisterRoleLister(metav1.NamespaceAll, roleInformer.Lister())
 	lister.RbacV1().RegisterRoleBindingLister(metav1.NamespaceAll, roleBindingInformer.Lister())
+	lister.RbacV1().RegisterClusterRoleLister(clusterRoleInformer.Lister())
+	lister.RbacV1().RegisterClusterRoleBindingLister(clusterRoleBindingInformer.Lister())
 	lister.CoreV1().RegisterServiceAccountLister(metav1.NamespaceAll, serviceAccountInformer.Lister())
 	lister.CoreV1().RegisterServiceLister(metav1.NamespaceAll, serviceInformer.Lister())
 	lister.CoreV1().RegisterPodLister(metav1.NamespaceAll, podInformer.Lister())
 	lister.CoreV1().RegisterConfigMapLister(metav1.NamespaceAll, configMapInformer.Lister())
+	lister.AppsV1().RegisterDeploymentLister(metav1.NamespaceAll, deploymentInformer.Lister())
+
+	secretInformer := informers.NewSharedInformerFactoryWithOptions(opClientFake.KubernetesInterface(), wakeupInterval, informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+		options.LabelSelector = reconciler.CatalogSourceUpdateWebhookLabelKey
+	})).Core().V1().Secrets()
+	sharedInformers = append(sharedInformers, secretInformer.Informer())
+	lister.CoreV1().RegisterSecretLister(metav1.NamespaceAll, secretInformer.Lister())
 	logger := logrus.New()
 
 	// Create the new operator
@@ -1591,6 +1720,8 @@ func NewFakeOperator(ctx context.Context, namespace string, namespaces []string,
 				&workqueue.BucketRateLimiter{Limiter: rate.NewLimiter(rate.Limit(1), 100)},
 			), "resolver"),
 		resolver:              config.resolver,
+		sharedOperators:       resolver.NewGlobalOperatorGroupSharedOperators(lister.OperatorsV1().OperatorGroupLister(), lister.OperatorsV1alpha1().ClusterServiceVersionLister()),
+		olmConfigLister:       olmConfigInformer.Lister(),
 		reconciler:            config.reconciler,
 		recorder:              config.recorder,
 		clientAttenuator:      scoped.NewClientAttenuator(logger, &rest.Config{}, opClientFake),
@@ -1920,3 +2051,126 @@ func hasExpectedCondition(ip *v1alpha1.InstallPlan, expectedCondition v1alpha1.I
 	}
 	return false
 }
+
+func TestValidateGrpcPodConfig(t *testing.T) {
+	localhost := "profiles/catalog.json"
+	one := intstr.FromInt(1)
+	yes := true
+	volumes := []corev1.Volume{{
+		Name: "ca-bundle",
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "ca-bundle"}},
+		},
+	}}
+
+	for _, tt := range []struct {
+		name   string
+		config v1alpha1.GrpcPodConfig
+		err    string
+	}{
+		{
+			name: "Valid",
+			config: v1alpha1.GrpcPodConfig{
+				Resources: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("50Mi")},
+					Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("100Mi")},
+				},
+				SecurityContext: &corev1.PodSecurityContext{
+					SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeLocalhost, LocalhostProfile: &localhost},
+				},
+				Volumes:      volumes,
+				VolumeMounts: []corev1.VolumeMount{{Name: "ca-bundle", MountPath: "/etc/pki"}},
+			},
+		},
+		{
+			name: "RequestExceedsLimit",
+			config: v1alpha1.GrpcPodConfig{
+				Resources: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("200m")},
+					Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+				},
+			},
+			err: "invalid grpcPodConfig: cpu request 200m exceeds limit 100m",
+		},
+		{
+			name: "LocalhostSeccompProfileUnset",
+			config: v1alpha1.GrpcPodConfig{
+				ContainerSecurityContext: &corev1.SecurityContext{
+					SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeLocalhost},
+				},
+			},
+			err: "invalid grpcPodConfig: localhostProfile must be set for seccomp profile type Localhost",
+		},
+		{
+			name:   "DuplicateVolume",
+			config: v1alpha1.GrpcPodConfig{Volumes: append(volumes, volumes...)},
+			err:    "invalid grpcPodConfig: duplicate volume ca-bundle",
+		},
+		{
+			name: "HostPathVolume",
+			config: v1alpha1.GrpcPodConfig{
+				Volumes: []corev1.Volume{{
+					Name:         "host",
+					VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/"}},
+				}},
+			},
+			err: "invalid grpcPodConfig: volume host: only configMap, secret, projected, emptyDir and downwardAPI volumes are allowed",
+		},
+		{
+			name: "PrivilegedContainer",
+			config: v1alpha1.GrpcPodConfig{
+				ContainerSecurityContext: &corev1.SecurityContext{Privileged: &yes},
+			},
+			err: "invalid grpcPodConfig: privileged containers are not allowed",
+		},
+		{
+			name: "PrivilegeEscalation",
+			config: v1alpha1.GrpcPodConfig{
+				ContainerSecurityContext: &corev1.SecurityContext{AllowPrivilegeEscalation: &yes},
+			},
+			err: "invalid grpcPodConfig: privilege escalation is not allowed",
+		},
+		{
+			name: "AddedCapabilities",
+			config: v1alpha1.GrpcPodConfig{
+				ContainerSecurityContext: &corev1.SecurityContext{
+					Capabilities: &corev1.Capabilities{Add: []corev1.Capability{"SYS_ADMIN"}},
+				},
+			},
+			err: "invalid grpcPodConfig: adding capabilities is not allowed",
+		},
+		{
+			name: "HostProcess",
+			config: v1alpha1.GrpcPodConfig{
+				SecurityContext: &corev1.PodSecurityContext{
+					WindowsOptions: &corev1.WindowsSecurityContextOptions{HostProcess: &yes},
+				},
+			},
+			err: "invalid grpcPodConfig: host process containers are not allowed",
+		},
+		{
+			name: "RelativeMountPath",
+			config: v1alpha1.GrpcPodConfig{
+				Volumes:      volumes,
+				VolumeMounts: []corev1.VolumeMount{{Name: "ca-bundle", MountPath: "etc/pki"}},
+			},
+			err: `invalid grpcPodConfig: mount path "etc/pki" of volume mount ca-bundle must be absolute`,
+		},
+		{
+			name: "PodDisruptionBudgetOverconstrained",
+			config: v1alpha1.GrpcPodConfig{
+				PodDisruptionBudget: &v1alpha1.CatalogPodDisruptionBudget{MinAvailable: &one, MaxUnavailable: &one},
+			},
+			err: "invalid grpcPodConfig: podDisruptionBudget minAvailable and maxUnavailable are mutually exclusive",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateGrpcPodConfig(&tt.config)
+			if tt.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,449 @@
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/operator-framework/api/pkg/operators/reference"
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	crdlib "github.com/operator-framework/operator-lifecycle-manager/pkg/lib/crd"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/lib/ownerutil"
+)
+
+// previousInstallation records the ClusterServiceVersions replaced by the given InstallPlan, the InstallPlans that
+// installed them and the storage versions of the CRDs the InstallPlan updates. It returns nil if the InstallPlan
+// can't be rolled back because it has no RollbackPolicy, or if it doesn't replace any ClusterServiceVersions.
+func (o *Operator) previousInstallation(plan *v1alpha1.InstallPlan) (*v1alpha1.PreviousInstallation, error) {
+	if plan.Spec.RollbackPolicy == "" {
+		return nil, nil
+	}
+	r := newManifestResolver(plan.GetNamespace(), o.lister.CoreV1().ConfigMapLister(), o.bundleLoader, o.logger)
+
+	previous := &v1alpha1.PreviousInstallation{
+		Replaced:           map[string]string{},
+		InstallPlans:       map[string]string{},
+		CRDStorageVersions: map[string]string{},
+	}
+	for _, step := range plan.Status.Plan {
+		switch step.Resource.Kind {
+		case v1alpha1.ClusterServiceVersionKind:
+			manifest, err := r.ManifestForStep(step)
+			if err != nil {
+				return nil, err
+			}
+			var csv v1alpha1.ClusterServiceVersion
+			if err := json.Unmarshal([]byte(manifest), &csv); err != nil {
+				return nil, fmt.Errorf("error parsing step manifest %s: %v", step.Resource.Name, err)
+			}
+			if csv.Spec.Replaces == "" {
+				continue
+			}
+			if _, err := o.lister.OperatorsV1alpha1().ClusterServiceVersionLister().ClusterServiceVersions(plan.GetNamespace()).Get(csv.Spec.Replaces); err != nil {
+				if k8serrors.IsNotFound(err) {
+					continue
+				}
+				return nil, err
+			}
+			previous.Replaced[step.Resource.Name] = csv.Spec.Replaces
+		case crdKind:
+			crd, err := o.opClient.ApiextensionsInterface().ApiextensionsV1().CustomResourceDefinitions().Get(context.TODO(), step.Resource.Name, metav1.GetOptions{})
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			for _, version := range crd.Spec.Versions {
+				if version.Storage {
+					previous.CRDStorageVersions[crd.GetName()] = version.Name
+				}
+			}
+		}
+	}
+	if len(previous.Replaced) == 0 {
+		return nil, nil
+	}
+
+	for _, replaced := range previous.Replaced {
+		name, err := o.installPlanForCSV(plan.GetNamespace(), replaced)
+		if err != nil {
+			return nil, err
+		}
+		if name != "" {
+			previous.InstallPlans[replaced] = name
+		}
+	}
+
+	return previous, nil
+}
+
+// installPlanForCSV returns the name of the latest Complete InstallPlan that installed the given ClusterServiceVersion,
+// or "" if there is none.
+func (o *Operator) installPlanForCSV(namespace, name string) (string, error) {
+	plans, err := o.lister.OperatorsV1alpha1().InstallPlanLister().InstallPlans(namespace).List(labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	sort.Slice(plans, func(i, j int) bool {
+		return plans[j].CreationTimestamp.Before(&plans[i].CreationTimestamp)
+	})
+
+	for _, plan := range plans {
+		if plan.Status.Phase != v1alpha1.InstallPlanPhaseComplete {
+			continue
+		}
+		for _, step := range plan.Status.Plan {
+			if step.Resolving == name && step.Resource.Kind == v1alpha1.ClusterServiceVersionKind {
+				return plan.GetName(), nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// previousSteps returns the steps that install the ClusterServiceVersions replaced by an InstallPlan again.
+func (o *Operator) previousSteps(namespace string, previous *v1alpha1.PreviousInstallation) ([]*v1alpha1.Step, error) {
+	var replaced []string
+	for _, name := range previous.Replaced {
+		replaced = append(replaced, name)
+	}
+	sort.Strings(replaced)
+
+	var steps []*v1alpha1.Step
+	for _, name := range replaced {
+		s, err := o.stepsForInstalledCSV(namespace, name, previous.InstallPlans[name])
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, s...)
+	}
+	return steps, nil
+}
+
+// stepsForInstalledCSV returns the steps of the named InstallPlan that installed the given ClusterServiceVersion.
+// If the InstallPlan or the manifests of its steps are gone, the steps recreate the ClusterServiceVersion as it is on-cluster.
+func (o *Operator) stepsForInstalledCSV(namespace, name, planName string) ([]*v1alpha1.Step, error) {
+	if planName != "" {
+		steps, err := o.installPlanSteps(namespace, name, planName)
+		if err != nil {
+			return nil, err
+		}
+		if len(steps) > 0 {
+			return steps, nil
+		}
+	}
+
+	csv, err := o.client.OperatorsV1alpha1().ClusterServiceVersions(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil, fmt.Errorf("neither the InstallPlan that installed ClusterServiceVersion %q nor the ClusterServiceVersion itself remain", name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := json.Marshal(&v1alpha1.ClusterServiceVersion{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       v1alpha1.ClusterServiceVersionKind,
+			APIVersion: v1alpha1.ClusterServiceVersionAPIVersion,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        csv.GetName(),
+			Namespace:   csv.GetNamespace(),
+			Labels:      csv.GetLabels(),
+			Annotations: csv.GetAnnotations(),
+		},
+		Spec: csv.Spec,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return []*v1alpha1.Step{{
+		Resolving: name,
+		Resource: v1alpha1.StepResource{
+			Group:    v1alpha1.GroupName,
+			Version:  v1alpha1.GroupVersion,
+			Kind:     v1alpha1.ClusterServiceVersionKind,
+			Name:     name,
+			Manifest: string(manifest),
+		},
+		Status: v1alpha1.StepStatusUnknown,
+	}}, nil
+}
+
+// installPlanSteps returns the steps of the named InstallPlan that installed the given ClusterServiceVersion, or
+// nothing if the InstallPlan is gone or the manifests of its steps can't be resolved anymore. Manifests are left
+// as they are in the InstallPlan, so steps that reference the manifests of an unpacked bundle still do.
+func (o *Operator) installPlanSteps(namespace, name, planName string) ([]*v1alpha1.Step, error) {
+	plan, err := o.lister.OperatorsV1alpha1().InstallPlanLister().InstallPlans(namespace).Get(planName)
+	if k8serrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	r := newManifestResolver(namespace, o.lister.CoreV1().ConfigMapLister(), o.bundleLoader, o.logger)
+	var steps []*v1alpha1.Step
+	for _, step := range plan.Status.Plan {
+		if step.Resolving != name || step.Resource.Kind == v1alpha1.SubscriptionKind {
+			continue
+		}
+		if _, err := r.ManifestForStep(step); err != nil {
+			// the manifests of the bundle may have been garbage collected
+			o.logger.WithError(err).WithField("ip", plan.GetName()).Debug("unable to resolve manifest of previous step")
+			return nil, nil
+		}
+		out := step.DeepCopy()
+		out.Status = v1alpha1.StepStatusUnknown
+		steps = append(steps, out)
+	}
+	return steps, nil
+}
+
+// shouldRollback reports whether a Complete or Failed InstallPlan should be rolled back, either because it was
+// requested explicitly or because the InstallPlan or one of the ClusterServiceVersions it installed failed.
+func (o *Operator) shouldRollback(plan *v1alpha1.InstallPlan) bool {
+	if plan.Status.GetCondition(v1alpha1.InstallPlanRolledBack).Status != corev1.ConditionUnknown {
+		return false
+	}
+	if plan.Spec.Rollback {
+		return true
+	}
+	if plan.Spec.RollbackPolicy != v1alpha1.RollbackPolicyOnFailure || plan.Status.Previous == nil {
+		return false
+	}
+	if plan.Status.Phase == v1alpha1.InstallPlanPhaseFailed {
+		return true
+	}
+	for name := range plan.Status.Previous.Replaced {
+		csv, err := o.lister.OperatorsV1alpha1().ClusterServiceVersionLister().ClusterServiceVersions(plan.GetNamespace()).Get(name)
+		if err == nil && csv.Status.Phase == v1alpha1.CSVPhaseFailed {
+			return true
+		}
+	}
+	return false
+}
+
+// rollbackInstallPlan installs the ClusterServiceVersions replaced by the given InstallPlan again with a new
+// InstallPlan, deletes the ClusterServiceVersions that replaced them and points the InstallPlan's Subscriptions
+// at the new InstallPlan. The rollback is refused if it risks the loss of stored custom resources.
+// Each step is idempotent, so that a rollback interrupted before its condition is recorded is resumed when retried.
+func (o *Operator) rollbackInstallPlan(plan *v1alpha1.InstallPlan, logger *logrus.Entry) error {
+	now := o.now()
+	out := plan.DeepCopy()
+
+	previous := plan.Status.Previous
+	if previous == nil {
+		out.Status.SetCondition(v1alpha1.ConditionFailed(v1alpha1.InstallPlanRolledBack, v1alpha1.InstallPlanReasonRollbackRefused,
+			"no previous installation was recorded for the InstallPlan", &now))
+		_, err := o.client.OperatorsV1alpha1().InstallPlans(plan.GetNamespace()).UpdateStatus(context.TODO(), out, metav1.UpdateOptions{})
+		return err
+	}
+
+	steps, err := o.previousSteps(plan.GetNamespace(), previous)
+	if err != nil {
+		logger.WithError(err).Info("refusing to roll back installplan")
+		out.Status.SetCondition(v1alpha1.ConditionFailed(v1alpha1.InstallPlanRolledBack, v1alpha1.InstallPlanReasonRollbackRefused,
+			fmt.Sprintf("the previous installation is no longer available: %v", err), &now))
+		_, err := o.client.OperatorsV1alpha1().InstallPlans(plan.GetNamespace()).UpdateStatus(context.TODO(), out, metav1.UpdateOptions{})
+		return err
+	}
+
+	if err := o.checkRollbackSafety(plan.GetNamespace(), previous, steps); err != nil {
+		logger.WithError(err).Info("refusing to roll back installplan")
+		out.Status.SetCondition(v1alpha1.ConditionFailed(v1alpha1.InstallPlanRolledBack, v1alpha1.InstallPlanReasonRollbackRefused, err.Error(), &now))
+		_, err := o.client.OperatorsV1alpha1().InstallPlans(plan.GetNamespace()).UpdateStatus(context.TODO(), out, metav1.UpdateOptions{})
+		return err
+	}
+
+	logger.Info("rolling back installplan")
+
+	var csvNames []string
+	for _, replaced := range previous.Replaced {
+		csvNames = append(csvNames, replaced)
+	}
+	sort.Strings(csvNames)
+	ip := &v1alpha1.InstallPlan{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            rollbackInstallPlanName(plan),
+			Namespace:       plan.GetNamespace(),
+			OwnerReferences: plan.GetOwnerReferences(),
+		},
+		Spec: v1alpha1.InstallPlanSpec{
+			ClusterServiceVersionNames: csvNames,
+			Approval:                   v1alpha1.ApprovalAutomatic,
+			Approved:                   true,
+			Generation:                 plan.Spec.Generation,
+		},
+	}
+	res, err := o.client.OperatorsV1alpha1().InstallPlans(plan.GetNamespace()).Create(context.TODO(), ip, metav1.CreateOptions{})
+	if k8serrors.IsAlreadyExists(err) {
+		res, err = o.client.OperatorsV1alpha1().InstallPlans(plan.GetNamespace()).Get(context.TODO(), ip.GetName(), metav1.GetOptions{})
+	}
+	if err != nil {
+		return err
+	}
+	if res.Status.Phase == v1alpha1.InstallPlanPhaseNone {
+		res.Status = v1alpha1.InstallPlanStatus{
+			Phase:          v1alpha1.InstallPlanPhaseInstalling,
+			Plan:           steps,
+			CatalogSources: plan.Status.CatalogSources,
+		}
+		if res, err = o.client.OperatorsV1alpha1().InstallPlans(plan.GetNamespace()).UpdateStatus(context.TODO(), res, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+	ref, err := reference.GetReference(res)
+	if err != nil {
+		return err
+	}
+
+	for installed := range previous.Replaced {
+		err := o.client.OperatorsV1alpha1().ClusterServiceVersions(plan.GetNamespace()).Delete(context.TODO(), installed, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	var subs []*v1alpha1.Subscription
+	for _, owner := range ownerutil.GetOwnersByKind(plan, v1alpha1.SubscriptionKind) {
+		// the install history of the Subscription may have been recorded in the same sync, ahead of the lister
+		sub, err := o.client.OperatorsV1alpha1().Subscriptions(plan.GetNamespace()).Get(context.TODO(), owner.Name, metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		// Subscriptions that already point at the replaced ClusterServiceVersion were updated by an earlier attempt
+		replaced, ok := previous.Replaced[sub.Status.CurrentCSV]
+		if !ok {
+			continue
+		}
+		if !rolledBack(sub, sub.Status.CurrentCSV) {
+			sub.Status.RolledBackCSVs = append(sub.Status.RolledBackCSVs, sub.Status.CurrentCSV)
+		}
+		sub.Status.CurrentCSV = replaced
+		subs = append(subs, sub)
+	}
+	if _, err := o.updateSubscriptionStatuses(o.setIPReference(subs, plan.Spec.Generation, ref)); err != nil {
+		return err
+	}
+
+	out.Status.SetCondition(v1alpha1.InstallPlanCondition{
+		Type:               v1alpha1.InstallPlanRolledBack,
+		Status:             corev1.ConditionTrue,
+		Reason:             v1alpha1.InstallPlanReasonRollbackStarted,
+		Message:            fmt.Sprintf("rolled back by InstallPlan %s", res.GetName()),
+		LastUpdateTime:     &now,
+		LastTransitionTime: &now,
+	})
+	_, err = o.client.OperatorsV1alpha1().InstallPlans(plan.GetNamespace()).UpdateStatus(context.TODO(), out, metav1.UpdateOptions{})
+	return err
+}
+
+// rollbackInstallPlanName returns the name of the InstallPlan that rolls back the given InstallPlan.
+func rollbackInstallPlanName(plan *v1alpha1.InstallPlan) string {
+	return "rollback-" + plan.GetName()
+}
+
+// rolledBack reports whether the installation of the named ClusterServiceVersion was rolled back for the Subscription.
+func rolledBack(sub *v1alpha1.Subscription, name string) bool {
+	for _, csv := range sub.Status.RolledBackCSVs {
+		if csv == name {
+			return true
+		}
+	}
+	return false
+}
+
+// pruneRolledBackCSVs forgets the rolled back ClusterServiceVersions of a Subscription once it upgrades past the
+// ClusterServiceVersion it was rolled back to, since resolution doesn't lead back to them from there.
+func pruneRolledBackCSVs(sub *v1alpha1.Subscription, installed string) {
+	previous := sub.Status.InstalledCSV
+	if previous == "" || previous == installed || rolledBack(sub, previous) {
+		return
+	}
+	sub.Status.RolledBackCSVs = nil
+}
+
+// checkRollbackSafety returns an error if applying the previous CRDs would remove a version
+// that custom resources are stored in, or if existing custom resources don't validate against them.
+func (o *Operator) checkRollbackSafety(namespace string, previous *v1alpha1.PreviousInstallation, steps []*v1alpha1.Step) error {
+	r := newManifestResolver(namespace, o.lister.CoreV1().ConfigMapLister(), o.bundleLoader, o.logger)
+	for _, step := range steps {
+		if step.Resource.Kind != crdKind {
+			continue
+		}
+		current, err := o.opClient.ApiextensionsInterface().ApiextensionsV1().CustomResourceDefinitions().Get(context.TODO(), step.Resource.Name, metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		manifest, err := r.ManifestForStep(step)
+		if err != nil {
+			return err
+		}
+		version, err := crdlib.Version(&manifest)
+		if err != nil {
+			return err
+		}
+		if version != crdlib.V1Version {
+			crd, err := crdlib.UnmarshalV1Beta1(manifest)
+			if err != nil {
+				return err
+			}
+			if safe, err := crdlib.SafeStorageVersionUpgrade(current, crd); !safe {
+				return fmt.Errorf("rolling back CRD %q risks data loss: %v", step.Resource.Name, err)
+			}
+			continue
+		}
+
+		crd, err := crdlib.UnmarshalV1(manifest)
+		if err != nil {
+			return err
+		}
+		if safe, err := crdlib.SafeStorageVersionUpgrade(current, crd); !safe {
+			return fmt.Errorf("rolling back CRD %q from storage version %s risks data loss: %v", step.Resource.Name, previous.CRDStorageVersions[step.Resource.Name], err)
+		}
+		if err := validateV1CRDCompatibility(o.dynamicClient, current, crd); err != nil {
+			return fmt.Errorf("existing custom resources are invalid for the previous version of CRD %q: %v", step.Resource.Name, err)
+		}
+	}
+	return nil
+}
+
+// requeueInstallPlansForFailedCSV requeues the InstallPlans that installed a failed ClusterServiceVersion and roll back on failure.
+func (o *Operator) requeueInstallPlansForFailedCSV(obj interface{}) {
+	csv, ok := obj.(*v1alpha1.ClusterServiceVersion)
+	if !ok || csv.Status.Phase != v1alpha1.CSVPhaseFailed {
+		return
+	}
+
+	plans, err := o.lister.OperatorsV1alpha1().InstallPlanLister().InstallPlans(csv.GetNamespace()).List(labels.Everything())
+	if err != nil {
+		o.logger.WithError(err).Warn("unable to list installplans for failed csv")
+		return
+	}
+	for _, plan := range plans {
+		if plan.Spec.RollbackPolicy != v1alpha1.RollbackPolicyOnFailure || plan.Status.Previous == nil {
+			continue
+		}
+		if _, ok := plan.Status.Previous.Replaced[csv.GetName()]; !ok {
+			continue
+		}
+		if err := o.ipQueueSet.Requeue(plan.GetNamespace(), plan.GetName()); err != nil {
+			o.logger.WithError(err).Warn("unable to requeue installplan for failed csv")
+		}
+	}
+}
@@ -0,0 +1,364 @@
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/lib/ownerutil"
+)
+
+func csvStep(t *testing.T, csv *v1alpha1.ClusterServiceVersion) *v1alpha1.Step {
+	manifest, err := json.Marshal(csv)
+	require.NoError(t, err)
+	return &v1alpha1.Step{
+		Resolving: csv.GetName(),
+		Resource: v1alpha1.StepResource{
+			Group:    v1alpha1.GroupName,
+			Version:  v1alpha1.GroupVersion,
+			Kind:     v1alpha1.ClusterServiceVersionKind,
+			Name:     csv.GetName(),
+			Manifest: string(manifest),
+		},
+		Status: v1alpha1.StepStatusCreated,
+	}
+}
+
+func TestPreviousInstallation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+	namespace := "ns"
+
+	oldCSV := csv("csv-a", namespace, nil, nil)
+	newCSV := csv("csv-b", namespace, nil, nil)
+	newCSV.Spec.Replaces = oldCSV.GetName()
+
+	oldPlan := withSteps(installPlan("install-a", namespace, v1alpha1.InstallPlanPhaseComplete, "csv-a"), []*v1alpha1.Step{csvStep(t, oldCSV)})
+	newPlan := withSteps(installPlan("install-b", namespace, v1alpha1.InstallPlanPhaseInstalling, "csv-b"), []*v1alpha1.Step{csvStep(t, newCSV)})
+	newPlan.Spec.RollbackPolicy = v1alpha1.RollbackPolicyNever
+
+	v1 := v1crd("crd")
+	v1.Spec.Versions[0].Storage = true
+	crdManifest, err := json.Marshal(v1)
+	require.NoError(t, err)
+	newPlan.Status.Plan = append(newPlan.Status.Plan, &v1alpha1.Step{
+		Resolving: "csv-b",
+		Resource: v1alpha1.StepResource{
+			Group:    "apiextensions.k8s.io",
+			Version:  "v1",
+			Kind:     crdKind,
+			Name:     "crd",
+			Manifest: string(crdManifest),
+		},
+	})
+
+	op, err := NewFakeOperator(ctx, namespace, []string{namespace},
+		withClientObjs(oldCSV, oldPlan, newPlan),
+		withExtObjs(&v1))
+	require.NoError(t, err)
+
+	previous, err := op.previousInstallation(newPlan)
+	require.NoError(t, err)
+	require.NotNil(t, previous)
+	require.Equal(t, map[string]string{"csv-b": "csv-a"}, previous.Replaced)
+	require.Equal(t, map[string]string{"crd": "v1"}, previous.CRDStorageVersions)
+	require.Equal(t, map[string]string{"csv-a": "install-a"}, previous.InstallPlans)
+
+	steps, err := op.previousSteps(namespace, previous)
+	require.NoError(t, err)
+	require.Len(t, steps, 1)
+	require.Equal(t, "csv-a", steps[0].Resource.Name)
+	require.Equal(t, v1alpha1.StepStatusUnknown, steps[0].Status)
+	require.Equal(t, oldPlan.Status.Plan[0].Resource.Manifest, steps[0].Resource.Manifest)
+
+	// nothing is recorded for fresh installs
+	freshPlan := oldPlan.DeepCopy()
+	freshPlan.Spec.RollbackPolicy = v1alpha1.RollbackPolicyNever
+	previous, err = op.previousInstallation(freshPlan)
+	require.NoError(t, err)
+	require.Nil(t, previous)
+
+	// nor for InstallPlans that can't be rolled back
+	newPlan.Spec.RollbackPolicy = ""
+	previous, err = op.previousInstallation(newPlan)
+	require.NoError(t, err)
+	require.Nil(t, previous)
+}
+
+func TestRollbackInstallPlan(t *testing.T) {
+	namespace := "ns"
+
+	oldCSV := csv("csv-a", namespace, nil, nil)
+	newCSV := csv("csv-b", namespace, nil, nil)
+	newCSV.Spec.Replaces = oldCSV.GetName()
+
+	storedCRD := v1crd("crd")
+	storedCRD.Spec.Versions = append(storedCRD.Spec.Versions, apiextensionsv1.CustomResourceDefinitionVersion{Name: "v2", Served: true, Storage: true})
+	storedCRD.Status.StoredVersions = []string{"v1", "v2"}
+	previousCRD := v1crd("crd")
+	previousCRD.TypeMeta = metav1.TypeMeta{Kind: crdKind, APIVersion: apiextensionsv1.SchemeGroupVersion.String()}
+	previousCRD.Spec.Versions[0].Storage = true
+	crdManifest, err := json.Marshal(previousCRD)
+	require.NoError(t, err)
+
+	recorded := &v1alpha1.PreviousInstallation{
+		Replaced:     map[string]string{"csv-b": "csv-a"},
+		InstallPlans: map[string]string{"csv-a": "install-a"},
+	}
+	crdStep := &v1alpha1.Step{
+		Resolving: "csv-a",
+		Resource: v1alpha1.StepResource{
+			Group:    "apiextensions.k8s.io",
+			Version:  "v1",
+			Kind:     crdKind,
+			Name:     "crd",
+			Manifest: string(crdManifest),
+		},
+	}
+
+	for _, tt := range []struct {
+		name          string
+		policy        v1alpha1.RollbackPolicy
+		rollback      bool
+		previous      *v1alpha1.PreviousInstallation
+		previousSteps []*v1alpha1.Step
+		csvPhase      v1alpha1.ClusterServiceVersionPhase
+		extObjs       []runtime.Object
+		refused       bool
+		rolledBack    bool
+	}{
+		{
+			name:          "NotRequested",
+			csvPhase:      v1alpha1.CSVPhaseFailed,
+			previous:      recorded,
+			previousSteps: []*v1alpha1.Step{csvStep(t, oldCSV)},
+		},
+		{
+			name:          "OnFailureWithSucceededCSV",
+			policy:        v1alpha1.RollbackPolicyOnFailure,
+			csvPhase:      v1alpha1.CSVPhaseSucceeded,
+			previous:      recorded,
+			previousSteps: []*v1alpha1.Step{csvStep(t, oldCSV)},
+		},
+		{
+			name:     "RequestedWithoutPreviousInstallation",
+			rollback: true,
+			csvPhase: v1alpha1.CSVPhaseSucceeded,
+			refused:  true,
+		},
+		{
+			name:     "RefusedForRemovedStorageVersion",
+			policy:   v1alpha1.RollbackPolicyOnFailure,
+			csvPhase: v1alpha1.CSVPhaseFailed,
+			previous: &v1alpha1.PreviousInstallation{
+				Replaced:           map[string]string{"csv-b": "csv-a"},
+				InstallPlans:       map[string]string{"csv-a": "install-a"},
+				CRDStorageVersions: map[string]string{"crd": "v2"},
+			},
+			previousSteps: []*v1alpha1.Step{csvStep(t, oldCSV), crdStep},
+			extObjs:       []runtime.Object{&storedCRD},
+			refused:       true,
+		},
+		{
+			name:          "OnFailureWithFailedCSV",
+			policy:        v1alpha1.RollbackPolicyOnFailure,
+			csvPhase:      v1alpha1.CSVPhaseFailed,
+			previous:      recorded,
+			previousSteps: []*v1alpha1.Step{csvStep(t, oldCSV)},
+			rolledBack:    true,
+		},
+		{
+			name:          "Requested",
+			policy:        v1alpha1.RollbackPolicyNever,
+			rollback:      true,
+			csvPhase:      v1alpha1.CSVPhaseSucceeded,
+			previous:      recorded,
+			previousSteps: []*v1alpha1.Step{csvStep(t, oldCSV)},
+			rolledBack:    true,
+		},
+		{
+			name:       "RequestedWithoutPreviousInstallPlan",
+			policy:     v1alpha1.RollbackPolicyNever,
+			rollback:   true,
+			csvPhase:   v1alpha1.CSVPhaseSucceeded,
+			previous:   recorded,
+			rolledBack: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.TODO())
+			defer cancel()
+
+			sub := &v1alpha1.Subscription{
+				ObjectMeta: metav1.ObjectMeta{Name: "sub", Namespace: namespace, UID: "sub-uid"},
+				Spec:       &v1alpha1.SubscriptionSpec{Package: "pkg", RollbackPolicy: tt.policy},
+				Status:     v1alpha1.SubscriptionStatus{CurrentCSV: "csv-b", InstalledCSV: "csv-b"},
+			}
+			sub.SetGroupVersionKind(v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.SubscriptionKind))
+
+			installed := newCSV.DeepCopy()
+			installed.Status.Phase = tt.csvPhase
+
+			plan := withSteps(installPlan("install-b", namespace, v1alpha1.InstallPlanPhaseComplete, "csv-b"), []*v1alpha1.Step{csvStep(t, newCSV)})
+			plan.Spec.RollbackPolicy = tt.policy
+			plan.Spec.Rollback = tt.rollback
+			plan.Status.Previous = tt.previous
+			ownerutil.AddNonBlockingOwner(plan, sub)
+
+			objs := []runtime.Object{sub, oldCSV, installed, plan, operatorGroup("og", "", namespace, nil)}
+			if tt.previousSteps != nil {
+				objs = append(objs, withSteps(installPlan("install-a", namespace, v1alpha1.InstallPlanPhaseComplete, "csv-a"), tt.previousSteps))
+			}
+			op, err := NewFakeOperator(ctx, namespace, []string{namespace},
+				withClientObjs(objs...),
+				withExtObjs(tt.extObjs...))
+			require.NoError(t, err)
+
+			require.NoError(t, op.syncInstallPlans(plan))
+
+			outPlan, err := op.client.OperatorsV1alpha1().InstallPlans(namespace).Get(ctx, plan.GetName(), metav1.GetOptions{})
+			require.NoError(t, err)
+			cond := outPlan.Status.GetCondition(v1alpha1.InstallPlanRolledBack)
+
+			outSub, err := op.client.OperatorsV1alpha1().Subscriptions(namespace).Get(ctx, sub.GetName(), metav1.GetOptions{})
+			require.NoError(t, err)
+
+			_, err = op.client.OperatorsV1alpha1().ClusterServiceVersions(namespace).Get(ctx, "csv-b", metav1.GetOptions{})
+
+			switch {
+			case tt.refused:
+				require.Equal(t, corev1.ConditionFalse, cond.Status)
+				require.Equal(t, v1alpha1.InstallPlanReasonRollbackRefused, cond.Reason)
+				require.NoError(t, err)
+				require.Equal(t, "csv-b", outSub.Status.CurrentCSV)
+			case tt.rolledBack:
+				require.Equal(t, corev1.ConditionTrue, cond.Status)
+				require.Equal(t, v1alpha1.InstallPlanReasonRollbackStarted, cond.Reason)
+				require.True(t, k8serrors.IsNotFound(err))
+
+				require.Equal(t, "csv-a", outSub.Status.CurrentCSV)
+				require.Equal(t, []string{"csv-b"}, outSub.Status.RolledBackCSVs)
+				require.NotNil(t, outSub.Status.InstallPlanRef)
+				require.Equal(t, "rollback-install-b", outSub.Status.InstallPlanRef.Name)
+
+				rollbackPlan, err := op.client.OperatorsV1alpha1().InstallPlans(namespace).Get(ctx, outSub.Status.InstallPlanRef.Name, metav1.GetOptions{})
+				require.NoError(t, err)
+				require.Equal(t, []string{"csv-a"}, rollbackPlan.Spec.ClusterServiceVersionNames)
+				require.True(t, rollbackPlan.Spec.Approved)
+				require.Equal(t, v1alpha1.InstallPlanPhaseInstalling, rollbackPlan.Status.Phase)
+				require.Len(t, rollbackPlan.Status.Plan, 1)
+				require.Equal(t, "csv-a", rollbackPlan.Status.Plan[0].Resource.Name)
+				require.Equal(t, v1alpha1.StepStatusUnknown, rollbackPlan.Status.Plan[0].Status)
+				if tt.previousSteps != nil {
+					// the steps of the previous InstallPlan are applied again
+					require.Equal(t, tt.previousSteps[0].Resource, rollbackPlan.Status.Plan[0].Resource)
+				}
+			default:
+				require.Equal(t, corev1.ConditionUnknown, cond.Status)
+				require.NoError(t, err)
+				require.Equal(t, "csv-b", outSub.Status.CurrentCSV)
+			}
+		})
+	}
+}
+
+func TestRollbackInstallPlanResumesInterruptedRollback(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+	namespace := "ns"
+
+	oldCSV := csv("csv-a", namespace, nil, nil)
+	oldPlan := withSteps(installPlan("install-a", namespace, v1alpha1.InstallPlanPhaseComplete, "csv-a"), []*v1alpha1.Step{csvStep(t, oldCSV)})
+	previous := &v1alpha1.PreviousInstallation{
+		Replaced:     map[string]string{"csv-b": "csv-a"},
+		InstallPlans: map[string]string{"csv-a": oldPlan.GetName()},
+	}
+
+	// an earlier attempt created the rollback InstallPlan and updated the Subscription, but failed to record the condition
+	rollbackPlan := installPlan("rollback-install-b", namespace, v1alpha1.InstallPlanPhaseInstalling, "csv-a")
+	rollbackPlan.Status.Plan = oldPlan.Status.Plan
+	sub := &v1alpha1.Subscription{
+		ObjectMeta: metav1.ObjectMeta{Name: "sub", Namespace: namespace, UID: "sub-uid"},
+		Spec:       &v1alpha1.SubscriptionSpec{Package: "pkg"},
+		Status: v1alpha1.SubscriptionStatus{
+			CurrentCSV:     "csv-a",
+			InstalledCSV:   "csv-b",
+			RolledBackCSVs: []string{"csv-b"},
+			InstallPlanRef: &corev1.ObjectReference{Name: rollbackPlan.GetName(), Namespace: namespace},
+		},
+	}
+	sub.SetGroupVersionKind(v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.SubscriptionKind))
+
+	newCSV := csv("csv-b", namespace, nil, nil)
+	newCSV.Spec.Replaces = oldCSV.GetName()
+	plan := withSteps(installPlan("install-b", namespace, v1alpha1.InstallPlanPhaseComplete, "csv-b"), []*v1alpha1.Step{csvStep(t, newCSV)})
+	plan.Spec.RollbackPolicy = v1alpha1.RollbackPolicyNever
+	plan.Spec.Rollback = true
+	plan.Status.Previous = previous
+	ownerutil.AddNonBlockingOwner(plan, sub)
+
+	op, err := NewFakeOperator(ctx, namespace, []string{namespace},
+		withClientObjs(sub, oldCSV, plan, oldPlan, rollbackPlan, operatorGroup("og", "", namespace, nil)))
+	require.NoError(t, err)
+
+	require.NoError(t, op.syncInstallPlans(plan))
+
+	plans, err := op.client.OperatorsV1alpha1().InstallPlans(namespace).List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, plans.Items, 3)
+
+	outPlan, err := op.client.OperatorsV1alpha1().InstallPlans(namespace).Get(ctx, plan.GetName(), metav1.GetOptions{})
+	require.NoError(t, err)
+	cond := outPlan.Status.GetCondition(v1alpha1.InstallPlanRolledBack)
+	require.Equal(t, corev1.ConditionTrue, cond.Status)
+	require.Contains(t, cond.Message, rollbackPlan.GetName())
+
+	outSub, err := op.client.OperatorsV1alpha1().Subscriptions(namespace).Get(ctx, sub.GetName(), metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "csv-a", outSub.Status.CurrentCSV)
+	require.Equal(t, []string{"csv-b"}, outSub.Status.RolledBackCSVs)
+}
+
+func TestPruneRolledBackCSVs(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		installed string
+		upgrade   string
+		pruned    bool
+	}{
+		{
+			name:      "RollbackTargetInstalled",
+			installed: "csv-b",
+			upgrade:   "csv-a",
+		},
+		{
+			name:      "Unchanged",
+			installed: "csv-a",
+			upgrade:   "csv-a",
+		},
+		{
+			name:      "UpgradedPastRollbackTarget",
+			installed: "csv-a",
+			upgrade:   "csv-c",
+			pruned:    true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			sub := &v1alpha1.Subscription{Status: v1alpha1.SubscriptionStatus{InstalledCSV: tt.installed, RolledBackCSVs: []string{"csv-b"}}}
+			pruneRolledBackCSVs(sub, tt.upgrade)
+			if tt.pruned {
+				require.Empty(t, sub.Status.RolledBackCSVs)
+				return
+			}
+			require.Equal(t, []string{"csv-b"}, sub.Status.RolledBackCSVs)
+		})
+	}
+}
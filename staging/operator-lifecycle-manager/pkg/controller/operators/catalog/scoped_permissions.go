@@ -0,0 +1,45 @@
+package catalog
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/install"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/lib/scoped"
+)
+
+// missingPermissionsError returns an error listing every permission that the attenuated ServiceAccount of the plan
+// is missing to execute it, or nil if none can be found.
+func (o *Operator) missingPermissionsError(plan *v1alpha1.InstallPlan, r *manifestResolver) error {
+	ref := plan.Status.AttenuatedServiceAccountRef
+	logger := o.logger.WithFields(logrus.Fields{
+		"ip":              plan.GetName(),
+		"namespace":       plan.GetNamespace(),
+		"service-account": ref.Name,
+	})
+
+	perms, err := scoped.PermissionsForSteps(plan.GetNamespace(), plan.Status.Plan, r.ManifestForStep, o.apiresourceFromGVK)
+	if err != nil {
+		logger.WithError(err).Info("unable to determine the permissions needed to execute the plan")
+		return nil
+	}
+
+	sa, err := o.lister.CoreV1().ServiceAccountLister().ServiceAccounts(ref.Namespace).Get(ref.Name)
+	if err != nil {
+		logger.WithError(err).Info("unable to get the attenuated service account")
+		return nil
+	}
+
+	// The roles of the ServiceAccount are authored by users, so they aren't owned by any CSV
+	ruleChecker := install.NewCSVRuleChecker(o.lister.RbacV1().RoleLister(), o.lister.RbacV1().RoleBindingLister(), o.lister.RbacV1().ClusterRoleLister(), o.lister.RbacV1().ClusterRoleBindingLister(), &v1alpha1.ClusterServiceVersion{})
+	missing, err := perms.Missing(ruleChecker, sa)
+	if err != nil {
+		logger.WithError(err).Info("unable to check the permissions of the attenuated service account")
+		return nil
+	}
+	if missing.Empty() {
+		return nil
+	}
+
+	return scoped.MissingPermissionsError{ServiceAccount: sa.GetName(), Missing: missing}
+}
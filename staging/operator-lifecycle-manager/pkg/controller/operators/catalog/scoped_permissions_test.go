@@ -0,0 +1,87 @@
+package catalog
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/lib/scoped"
+)
+
+func TestMissingPermissionsError(t *testing.T) {
+	namespace := "ns"
+
+	serviceAccount := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "scoped", Namespace: namespace}}
+	role := &rbacv1.Role{
+		ObjectMeta: metav1.ObjectMeta{Name: "scoped", Namespace: namespace},
+		Rules: []rbacv1.PolicyRule{
+			{Verbs: scoped.InstallVerbs, APIGroups: []string{""}, Resources: []string{"services"}},
+			{Verbs: []string{"get"}, APIGroups: []string{"example.com"}, Resources: []string{"widgets"}},
+		},
+	}
+	roleBinding := &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "scoped", Namespace: namespace},
+		Subjects:   []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: "scoped", Namespace: namespace}},
+		RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "Role", Name: "scoped"},
+	}
+	operatorRole := &rbacv1.Role{
+		TypeMeta:   metav1.TypeMeta{APIVersion: rbacv1.SchemeGroupVersion.String(), Kind: "Role"},
+		ObjectMeta: metav1.ObjectMeta{Name: "operator", Namespace: namespace},
+		Rules: []rbacv1.PolicyRule{
+			{Verbs: []string{"get"}, APIGroups: []string{"example.com"}, Resources: []string{"widgets"}},
+			{Verbs: []string{"list"}, APIGroups: []string{"example.com"}, Resources: []string{"widgets"}},
+		},
+	}
+	service := &corev1.Service{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+		ObjectMeta: metav1.ObjectMeta{Name: "service", Namespace: namespace},
+	}
+	serviceStep := &v1alpha1.Step{
+		Resource: v1alpha1.StepResource{Kind: "Service", Version: "v1", Name: service.GetName(), Manifest: toManifest(t, service)},
+		Status:   v1alpha1.StepStatusUnknown,
+	}
+	roleStep := &v1alpha1.Step{
+		Resource: v1alpha1.StepResource{Kind: "Role", Group: rbacv1.GroupName, Version: "v1", Name: operatorRole.GetName(), Manifest: toManifest(t, operatorRole)},
+		Status:   v1alpha1.StepStatusUnknown,
+	}
+
+	for _, tt := range []struct {
+		name     string
+		steps    []*v1alpha1.Step
+		expected string
+	}{
+		{
+			name:     "Missing",
+			steps:    []*v1alpha1.Step{serviceStep, roleStep},
+			expected: "service account ns/scoped is missing permissions: get,create,update,patch on roles.rbac.authorization.k8s.io in namespace ns; list on widgets.example.com in namespace ns",
+		},
+		{
+			name:  "Granted",
+			steps: []*v1alpha1.Step{serviceStep},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.TODO())
+			defer cancel()
+
+			plan := withSteps(installPlan("p", namespace, v1alpha1.InstallPlanPhaseInstalling, "csv"), tt.steps)
+			plan.Status.AttenuatedServiceAccountRef = &corev1.ObjectReference{Kind: "ServiceAccount", Name: "scoped", Namespace: namespace}
+
+			op, err := NewFakeOperator(ctx, namespace, []string{namespace}, withClientObjs(plan), withK8sObjs(serviceAccount, role, roleBinding))
+			require.NoError(t, err)
+
+			err = op.missingPermissionsError(plan, newManifestResolver(namespace, op.lister.CoreV1().ConfigMapLister(), nil, logrus.New()))
+			if tt.expected == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tt.expected)
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	operatorsv1 "github.com/operator-framework/api/pkg/operators/v1"
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/registry/resolver"
+)
+
+// ensureSubscriptionSharedDependencies records the shared operators that the CSV installed by
+// the Subscription depends on, and clears them once it no longer depends on any.
+func (o *Operator) ensureSubscriptionSharedDependencies(logger *logrus.Entry, sub *v1alpha1.Subscription) (*v1alpha1.Subscription, bool, error) {
+	var deps []v1alpha1.SharedDependency
+	if sub.Status.InstalledCSV != "" {
+		csvLister := o.lister.OperatorsV1alpha1().ClusterServiceVersionLister().ClusterServiceVersions(sub.GetNamespace())
+		csv, err := csvLister.Get(sub.Status.InstalledCSV)
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return nil, false, err
+		}
+		if err == nil {
+			local, err := csvLister.List(labels.Everything())
+			if err != nil {
+				return nil, false, err
+			}
+			shared, err := o.sharedOperators.SharedOperators(sub.GetNamespace())
+			if err != nil {
+				return nil, false, err
+			}
+			if deps, err = resolver.SharedDependencies(csv, local, shared); err != nil {
+				return nil, false, err
+			}
+		}
+	}
+
+	if len(deps) == 0 && len(sub.Status.SharedDependencies) == 0 || reflect.DeepEqual(deps, sub.Status.SharedDependencies) {
+		return sub, false, nil
+	}
+
+	out := sub.DeepCopy()
+	out.Status.SharedDependencies = deps
+	out.Status.LastUpdated = o.now()
+	updatedSub, err := o.client.OperatorsV1alpha1().Subscriptions(out.GetNamespace()).UpdateStatus(context.TODO(), out, metav1.UpdateOptions{})
+	if err != nil {
+		logger.WithError(err).Info("error updating subscription status")
+		return nil, false, fmt.Errorf("error updating Subscription status: " + err.Error())
+	}
+
+	return updatedSub, true, nil
+}
+
+// sharedPhaseChanged returns true if a CSV update starts or stops it from being shared,
+// since failed CSVs aren't shared.
+func sharedPhaseChanged(oldObj, obj interface{}) bool {
+	old, ok := oldObj.(*v1alpha1.ClusterServiceVersion)
+	if !ok {
+		return false
+	}
+	csv, ok := obj.(*v1alpha1.ClusterServiceVersion)
+	if !ok {
+		return false
+	}
+
+	return (old.Status.Phase == v1alpha1.CSVPhaseFailed) != (csv.Status.Phase == v1alpha1.CSVPhaseFailed)
+}
+
+// requeueSharedDependents requeues the namespace of the given CSV, which may provide APIs required
+// by its neighbours, and every namespace that may depend on it if it's shared.
+func (o *Operator) requeueSharedDependents(obj interface{}) {
+	csv, ok := obj.(*v1alpha1.ClusterServiceVersion)
+	if !ok || csv.IsCopied() {
+		return
+	}
+	o.nsResolveQueue.Add(csv.GetNamespace())
+
+	ogs, err := o.lister.OperatorsV1().OperatorGroupLister().OperatorGroups(csv.GetNamespace()).List(labels.Everything())
+	if err != nil {
+		o.logger.WithError(err).Warn("failed to list operatorgroups to requeue for shared dependencies")
+		return
+	}
+	for _, og := range ogs {
+		if targetsAllNamespaces(og) {
+			o.requeueSharingNamespaces(csv.GetNamespace())
+			return
+		}
+	}
+}
+
+// requeueForSharingOperatorGroup requeues every namespace that may depend on the operators of an
+// OperatorGroup that starts or stops targeting all namespaces.
+func (o *Operator) requeueForSharingOperatorGroup(oldObj, obj interface{}) {
+	old, _ := oldObj.(*operatorsv1.OperatorGroup)
+	og, _ := obj.(*operatorsv1.OperatorGroup)
+
+	wasShared := old != nil && targetsAllNamespaces(old)
+	isShared := og != nil && targetsAllNamespaces(og)
+	if wasShared == isShared {
+		return
+	}
+
+	if og == nil {
+		og = old
+	}
+	o.requeueSharingNamespaces(og.GetNamespace())
+}
+
+// requeueSharingNamespaces requeues every other namespace with an installed Subscription, since
+// any of them may depend on the operators shared from the given namespace.
+func (o *Operator) requeueSharingNamespaces(namespace string) {
+	subs, err := o.lister.OperatorsV1alpha1().SubscriptionLister().List(labels.Everything())
+	if err != nil {
+		o.logger.WithError(err).Warn("failed to list subscriptions to requeue for shared dependencies")
+		return
+	}
+
+	requeued := map[string]struct{}{}
+	for _, sub := range subs {
+		if _, ok := requeued[sub.GetNamespace()]; ok || sub.GetNamespace() == namespace || sub.Status.InstalledCSV == "" {
+			continue
+		}
+		requeued[sub.GetNamespace()] = struct{}{}
+		o.nsResolveQueue.Add(sub.GetNamespace())
+	}
+}
+
+func targetsAllNamespaces(og *operatorsv1.OperatorGroup) bool {
+	return len(og.Status.Namespaces) == 1 && og.Status.Namespaces[0] == corev1.NamespaceAll
+}
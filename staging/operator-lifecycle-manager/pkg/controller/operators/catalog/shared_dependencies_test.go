@@ -0,0 +1,117 @@
+package catalog
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	operatorsv1 "github.com/operator-framework/api/pkg/operators/v1"
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+)
+
+func TestEnsureSubscriptionSharedDependencies(t *testing.T) {
+	namespace := "ns"
+	staleDeps := []v1alpha1.SharedDependency{
+		{ClusterServiceVersion: "stale.v1", Namespace: "global", APIs: []string{"Stale.v1.example.com"}},
+	}
+
+	sharedCSV := func(name string, owned ...string) *v1alpha1.ClusterServiceVersion {
+		out := csv(name, "global", owned, nil)
+		out.SetAnnotations(map[string]string{operatorsv1.OperatorGroupAnnotationKey: "global-og"})
+		return out
+	}
+	globalOG := &operatorsv1.OperatorGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "global-og", Namespace: "global"},
+		Status:     operatorsv1.OperatorGroupStatus{Namespaces: []string{corev1.NamespaceAll}},
+	}
+
+	tests := []struct {
+		name         string
+		objs         []runtime.Object
+		installedCSV string
+		existing     []v1alpha1.SharedDependency
+		expected     []v1alpha1.SharedDependency
+		updated      bool
+	}{
+		{
+			name: "SelectsOneProviderPerAPI",
+			objs: []runtime.Object{
+				globalOG,
+				sharedCSV("b.v1", "foos.example.com"),
+				sharedCSV("a.v1", "foos.example.com"),
+				csv("installed.v1", namespace, nil, []string{"foos.example.com"}),
+			},
+			installedCSV: "installed.v1",
+			expected: []v1alpha1.SharedDependency{
+				{ClusterServiceVersion: "a.v1", Namespace: "global", APIs: []string{"foos.example.com.v1.example.com"}},
+			},
+			updated: true,
+		},
+		{
+			name: "SkipsAPIsProvidedInNamespace",
+			objs: []runtime.Object{
+				globalOG,
+				sharedCSV("a.v1", "foos.example.com"),
+				csv("installed.v1", namespace, nil, []string{"foos.example.com"}),
+				csv("local.v1", namespace, []string{"foos.example.com"}, nil),
+			},
+			installedCSV: "installed.v1",
+		},
+		{
+			name: "ClearsStaleDependencies",
+			objs: []runtime.Object{
+				globalOG,
+				csv("installed.v1", namespace, nil, nil),
+			},
+			installedCSV: "installed.v1",
+			existing:     staleDeps,
+			updated:      true,
+		},
+		{
+			name: "ClearsWithoutOperatorGroup",
+			objs: []runtime.Object{
+				sharedCSV("a.v1", "foos.example.com"),
+				csv("installed.v1", namespace, nil, []string{"foos.example.com"}),
+			},
+			installedCSV: "installed.v1",
+			existing:     staleDeps,
+			updated:      true,
+		},
+		{
+			name:     "ClearsWithoutInstalledCSV",
+			existing: staleDeps,
+			updated:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.TODO())
+			defer cancel()
+
+			sub := &v1alpha1.Subscription{
+				ObjectMeta: metav1.ObjectMeta{Name: "sub", Namespace: namespace},
+				Status: v1alpha1.SubscriptionStatus{
+					InstalledCSV:       tt.installedCSV,
+					SharedDependencies: tt.existing,
+				},
+			}
+			op, err := NewFakeOperator(ctx, namespace, []string{namespace, "global"}, withClientObjs(append(tt.objs, sub)...))
+			require.NoError(t, err)
+
+			out, updated, err := op.ensureSubscriptionSharedDependencies(logrus.NewEntry(op.logger), sub)
+			require.NoError(t, err)
+			require.Equal(t, tt.updated, updated)
+			require.Equal(t, tt.expected, out.Status.SharedDependencies)
+
+			persisted, err := op.client.OperatorsV1alpha1().Subscriptions(namespace).Get(ctx, sub.GetName(), metav1.GetOptions{})
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, persisted.Status.SharedDependencies)
+		})
+	}
+}
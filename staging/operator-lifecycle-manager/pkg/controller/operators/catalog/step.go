@@ -12,6 +12,8 @@ import (
 	apiextensionsv1beta1client "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/typed/apiextensions/v1beta1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/util/retry"
 
@@ -44,6 +46,9 @@ type builder struct {
 	manifestResolver ManifestResolver
 	logger           logrus.FieldLogger
 
+	// applier applies CRDs with server-side apply if set
+	applier *StepEnsurer
+
 	annotator alongside.Annotator
 }
 
@@ -58,6 +63,13 @@ func newBuilder(plan *v1alpha1.InstallPlan, csvLister listersv1alpha1.ClusterSer
 	}
 }
 
+// withServerSideApply returns a builder whose steps apply CRDs with server-side apply.
+func (b *builder) withServerSideApply(force bool) *builder {
+	out := *b
+	out.applier = newStepEnsurer(b.opclient, nil, b.dynamicClient).withServerSideApply(force)
+	return &out
+}
+
 type notSupportedStepperErr struct {
 	message string
 }
@@ -130,6 +142,27 @@ func (b *builder) NewCRDV1Step(client apiextensionsv1client.ApiextensionsV1Inter
 
 			setInstalledAlongsideAnnotation(b.annotator, crd, b.plan.GetNamespace(), step.Resolving, b.csvLister, crd)
 
+			if b.applier != nil {
+				currentCRD, err := client.CustomResourceDefinitions().Get(context.TODO(), crd.GetName(), metav1.GetOptions{})
+				if err == nil {
+					if err = validateV1CRDCompatibility(b.dynamicClient, currentCRD, crd); err != nil {
+						return v1alpha1.StepStatusUnknown, fmt.Errorf("error validating existing CRs against new CRD's schema for %q: %w", step.Resource.Name, err)
+					}
+					safe, err := crdlib.SafeStorageVersionUpgrade(currentCRD, crd)
+					if !safe {
+						b.logger.Errorf("risk of data loss updating %q: %s", step.Resource.Name, err)
+						return v1alpha1.StepStatusUnknown, fmt.Errorf("risk of data loss updating %q: %w", step.Resource.Name, err)
+					}
+					if err != nil {
+						return v1alpha1.StepStatusUnknown, fmt.Errorf("checking CRD for potential data loss updating %q: %w", step.Resource.Name, err)
+					}
+					setInstalledAlongsideAnnotation(b.annotator, crd, b.plan.GetNamespace(), step.Resolving, b.csvLister, crd, currentCRD)
+				} else if !k8serrors.IsNotFound(err) {
+					return v1alpha1.StepStatusUnknown, fmt.Errorf("error getting CRD %q: %w", step.Resource.Name, err)
+				}
+				return b.applyCRD(apiextensionsv1.SchemeGroupVersion.WithKind(crdKind), crd)
+			}
+
 			_, createError := client.CustomResourceDefinitions().Create(context.TODO(), crd, metav1.CreateOptions{})
 			if k8serrors.IsAlreadyExists(createError) {
 				err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
@@ -214,6 +247,27 @@ func (b *builder) NewCRDV1Beta1Step(client apiextensionsv1beta1client.Apiextensi
 
 			setInstalledAlongsideAnnotation(b.annotator, crd, b.plan.GetNamespace(), step.Resolving, b.csvLister, crd)
 
+			if b.applier != nil {
+				currentCRD, err := client.CustomResourceDefinitions().Get(context.TODO(), crd.GetName(), metav1.GetOptions{})
+				if err == nil {
+					if err = validateV1Beta1CRDCompatibility(b.dynamicClient, currentCRD, crd); err != nil {
+						return v1alpha1.StepStatusUnknown, fmt.Errorf("error validating existing CRs against new CRD's schema for %q: %w", step.Resource.Name, err)
+					}
+					safe, err := crdlib.SafeStorageVersionUpgrade(currentCRD, crd)
+					if !safe {
+						b.logger.Errorf("risk of data loss updating %q: %s", step.Resource.Name, err)
+						return v1alpha1.StepStatusUnknown, fmt.Errorf("risk of data loss updating %q: %w", step.Resource.Name, err)
+					}
+					if err != nil {
+						return v1alpha1.StepStatusUnknown, fmt.Errorf("checking CRD for potential data loss updating %q: %w", step.Resource.Name, err)
+					}
+					setInstalledAlongsideAnnotation(b.annotator, crd, b.plan.GetNamespace(), step.Resolving, b.csvLister, crd, currentCRD)
+				} else if !k8serrors.IsNotFound(err) {
+					return v1alpha1.StepStatusUnknown, fmt.Errorf("error getting CRD %q: %w", step.Resource.Name, err)
+				}
+				return b.applyCRD(apiextensionsv1beta1.SchemeGroupVersion.WithKind(crdKind), crd)
+			}
+
 			_, createError := client.CustomResourceDefinitions().Create(context.TODO(), crd, metav1.CreateOptions{})
 			if k8serrors.IsAlreadyExists(createError) {
 				err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
@@ -259,6 +313,18 @@ func (b *builder) NewCRDV1Beta1Step(client apiextensionsv1beta1client.Apiextensi
 	}
 }
 
+// applyCRD applies a CRD with server-side apply. A created CRD waits for its API to become available.
+func (b *builder) applyCRD(gvk schema.GroupVersionKind, crd runtime.Object) (v1alpha1.StepStatus, error) {
+	status, err := b.applier.apply(b.dynamicClient.Resource(gvk.GroupVersion().WithResource("customresourcedefinitions")), gvk, crd)
+	if err != nil {
+		return v1alpha1.StepStatusUnknown, err
+	}
+	if status == v1alpha1.StepStatusCreated {
+		return v1alpha1.StepStatusWaitingForAPI, nil
+	}
+	return status, nil
+}
+
 func setInstalledAlongsideAnnotation(a alongside.Annotator, dst metav1.Object, namespace string, name string, lister listersv1alpha1.ClusterServiceVersionLister, srcs ...metav1.Object) {
 	var (
 		nns []alongside.NamespacedName
@@ -0,0 +1,239 @@
+package catalog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	errorwrap "github.com/pkg/errors"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+)
+
+// InstallPlanFieldManager is the field manager of the fields OLM applies for InstallPlan steps with server-side apply.
+const InstallPlanFieldManager = "olm.installplan"
+
+// legacyFieldManagers are the field managers of the fields OLM created or updated for InstallPlan steps
+// before they were applied with server-side apply.
+var legacyFieldManagers = map[string]struct{}{
+	"catalog": {},
+}
+
+var conflictingManagerPattern = regexp.MustCompile(`conflict with "([^"]+)"`)
+
+// stepConflictError is returned when applying a step conflicts with fields owned by other field managers.
+type stepConflictError struct {
+	name     string
+	managers []string
+}
+
+func (e *stepConflictError) Error() string {
+	return fmt.Sprintf("applying %s conflicts with fields managed by %s", e.name, strings.Join(e.managers, ", "))
+}
+
+// withServerSideApply returns a StepEnsurer that applies step resources with server-side apply.
+// If force is true, fields managed by other field managers are taken over instead of causing a conflict.
+func (o *StepEnsurer) withServerSideApply(force bool) *StepEnsurer {
+	out := *o
+	out.serverSideApply = true
+	out.forceConflicts = force
+	return &out
+}
+
+// apply writes the given object with server-side apply.
+func (o *StepEnsurer) apply(client dynamic.ResourceInterface, gvk schema.GroupVersionKind, obj runtime.Object) (status v1alpha1.StepStatus, err error) {
+	return o.applyMerged(client, gvk, obj, nil)
+}
+
+// mergeFunc copies the fields of an existing object that must survive an apply into the object to apply.
+type mergeFunc func(existing, applied *unstructured.Unstructured) error
+
+// mergeOwners keeps the owner references of an existing object, which may be shared by several ClusterServiceVersions.
+func mergeOwners(existing, applied *unstructured.Unstructured) error {
+	applied.SetOwnerReferences(mergedOwnerReferences(existing.GetOwnerReferences(), applied.GetOwnerReferences()))
+	return nil
+}
+
+// applyMerged writes the given object with server-side apply. If the object exists, merge is called
+// first to keep the fields of the existing object that would otherwise be removed by the apply.
+func (o *StepEnsurer) applyMerged(client dynamic.ResourceInterface, gvk schema.GroupVersionKind, obj runtime.Object, merge mergeFunc) (status v1alpha1.StepStatus, err error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return
+	}
+	u := &unstructured.Unstructured{Object: content}
+	u.SetGroupVersionKind(gvk)
+	u.SetResourceVersion("")
+	u.SetUID("")
+	u.SetManagedFields(nil)
+	unstructured.RemoveNestedField(u.Object, "metadata", "creationTimestamp")
+	unstructured.RemoveNestedField(u.Object, "status")
+
+	existing, err := upgradeLegacyFields(client, u.GetName())
+	if err != nil {
+		err = errorwrap.Wrapf(err, "error upgrading managed fields of %s %s", gvk.Kind, u.GetName())
+		return
+	}
+	if existing != nil && merge != nil {
+		if err = merge(existing, u); err != nil {
+			err = errorwrap.Wrapf(err, "error merging existing %s %s", gvk.Kind, u.GetName())
+			return
+		}
+	}
+
+	data, err := json.Marshal(u)
+	if err != nil {
+		return
+	}
+
+	force := o.forceConflicts
+	applied, applyErr := client.Patch(context.TODO(), u.GetName(), types.ApplyPatchType, data, metav1.PatchOptions{
+		FieldManager: InstallPlanFieldManager,
+		Force:        &force,
+	})
+	if applyErr == nil {
+		// the object was created by the apply unless it is the one that existed before
+		status = v1alpha1.StepStatusCreated
+		if existing != nil && applied.GetUID() == existing.GetUID() {
+			status = v1alpha1.StepStatusPresent
+		}
+		return
+	}
+
+	if managers := conflictingManagers(applyErr); len(managers) > 0 {
+		err = &stepConflictError{name: fmt.Sprintf("%s %s", gvk.Kind, u.GetName()), managers: managers}
+		return
+	}
+	err = errorwrap.Wrapf(applyErr, "error applying %s %s", gvk.Kind, u.GetName())
+	return
+}
+
+// upgradeLegacyFields transfers the fields of the named object that were created or updated by legacyFieldManagers
+// to InstallPlanFieldManager, so that applying the object doesn't conflict with OLM's own fields and removes the
+// fields it no longer applies. It returns the object as it was before the transfer, or nil if it doesn't exist.
+func upgradeLegacyFields(client dynamic.ResourceInterface, name string) (*unstructured.Unstructured, error) {
+	existing, err := client.Get(context.TODO(), name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	entries, upgraded, err := upgradeManagedFields(existing.GetManagedFields())
+	if err != nil || !upgraded {
+		return existing, err
+	}
+
+	// replace the managed fields only if the object hasn't changed since they were read
+	patch, err := json.Marshal([]map[string]interface{}{
+		{"op": "test", "path": "/metadata/resourceVersion", "value": existing.GetResourceVersion()},
+		{"op": "replace", "path": "/metadata/managedFields", "value": entries},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := client.Patch(context.TODO(), name, types.JSONPatchType, patch, metav1.PatchOptions{}); err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
+
+// upgradeManagedFields merges the entries of legacyFieldManagers into the apply entry of InstallPlanFieldManager.
+// It returns false if there are no entries of legacyFieldManagers.
+func upgradeManagedFields(entries []metav1.ManagedFieldsEntry) ([]metav1.ManagedFieldsEntry, bool, error) {
+	var (
+		kept   []metav1.ManagedFieldsEntry
+		merged []metav1.ManagedFieldsEntry
+		legacy bool
+	)
+	for _, entry := range entries {
+		if entry.Subresource != "" {
+			kept = append(kept, entry)
+			continue
+		}
+		if _, ok := legacyFieldManagers[entry.Manager]; ok && entry.Operation == metav1.ManagedFieldsOperationUpdate {
+			merged = append(merged, entry)
+			legacy = true
+			continue
+		}
+		if entry.Manager == InstallPlanFieldManager && entry.Operation == metav1.ManagedFieldsOperationApply {
+			merged = append(merged, entry)
+			continue
+		}
+		kept = append(kept, entry)
+	}
+	if !legacy {
+		return entries, false, nil
+	}
+
+	applied := metav1.ManagedFieldsEntry{
+		Manager:    InstallPlanFieldManager,
+		Operation:  metav1.ManagedFieldsOperationApply,
+		FieldsType: "FieldsV1",
+	}
+	fields := &fieldpath.Set{}
+	for _, entry := range merged {
+		if entry.FieldsV1 != nil {
+			set := &fieldpath.Set{}
+			if err := set.FromJSON(bytes.NewReader(entry.FieldsV1.Raw)); err != nil {
+				return nil, false, err
+			}
+			fields = fields.Union(set)
+		}
+		// an existing apply entry keeps its version
+		if applied.APIVersion == "" || entry.Operation == metav1.ManagedFieldsOperationApply {
+			applied.APIVersion = entry.APIVersion
+		}
+		if applied.Time == nil || (entry.Time != nil && applied.Time.Before(entry.Time)) {
+			applied.Time = entry.Time
+		}
+	}
+	raw, err := fields.ToJSON()
+	if err != nil {
+		return nil, false, err
+	}
+	applied.FieldsV1 = &metav1.FieldsV1{Raw: raw}
+
+	return append(kept, applied), true, nil
+}
+
+// conflictingManagers returns the field managers an apply error conflicts with.
+func conflictingManagers(err error) []string {
+	if !k8serrors.IsConflict(err) {
+		return nil
+	}
+	apiStatus, ok := err.(k8serrors.APIStatus)
+	if !ok || apiStatus.Status().Details == nil {
+		return nil
+	}
+
+	unique := map[string]struct{}{}
+	for _, cause := range apiStatus.Status().Details.Causes {
+		if cause.Type != metav1.CauseTypeFieldManagerConflict {
+			continue
+		}
+		if match := conflictingManagerPattern.FindStringSubmatch(cause.Message); match != nil {
+			unique[match[1]] = struct{}{}
+		}
+	}
+
+	var managers []string
+	for m := range unique {
+		managers = append(managers, m)
+	}
+	sort.Strings(managers)
+	return managers
+}
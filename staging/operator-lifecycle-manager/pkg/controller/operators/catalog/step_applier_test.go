@@ -0,0 +1,416 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	operatorsv1 "github.com/operator-framework/api/pkg/operators/v1"
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+)
+
+func TestStepEnsurerServerSideApply(t *testing.T) {
+	namespace := "ns"
+	existing := &unstructured.Unstructured{}
+	existing.SetAPIVersion("v1")
+	existing.SetKind("ConfigMap")
+	existing.SetName("existing")
+	existing.SetNamespace(namespace)
+	existing.SetUID("existing-uid")
+	conflict := k8serrors.NewApplyConflict([]metav1.StatusCause{
+		{Type: metav1.CauseTypeFieldManagerConflict, Message: `conflict with "argocd-controller": .data.key`, Field: ".data.key"},
+		{Type: metav1.CauseTypeFieldManagerConflict, Message: `conflict with "argocd-controller": .data.other`, Field: ".data.other"},
+		{Type: metav1.CauseTypeFieldManagerConflict, Message: `conflict with "flux" using v1: .metadata.labels.app`, Field: ".metadata.labels.app"},
+	}, "Apply failed with 3 conflicts")
+
+	for _, tt := range []struct {
+		name      string
+		configMap string
+		force     bool
+		applyErr  error
+		expected  v1alpha1.StepStatus
+		managers  []string
+	}{
+		{
+			name:      "Created",
+			configMap: "new",
+			expected:  v1alpha1.StepStatusCreated,
+		},
+		{
+			name:      "Present",
+			configMap: "existing",
+			expected:  v1alpha1.StepStatusPresent,
+		},
+		{
+			name:      "Conflict",
+			configMap: "existing",
+			applyErr:  conflict,
+			managers:  []string{"argocd-controller", "flux"},
+		},
+		{
+			name:      "Forced",
+			configMap: "existing",
+			force:     true,
+			expected:  v1alpha1.StepStatusPresent,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			dynamicClient := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), existing)
+
+			var patch clienttesting.PatchAction
+			dynamicClient.PrependReactor("patch", "configmaps", func(action clienttesting.Action) (bool, runtime.Object, error) {
+				patch = action.(clienttesting.PatchAction)
+				if tt.applyErr != nil && !tt.force {
+					return true, nil, tt.applyErr
+				}
+				applied := existing.DeepCopy()
+				applied.SetName(tt.configMap)
+				if tt.configMap != existing.GetName() {
+					applied.SetUID("new-uid")
+				}
+				return true, applied, nil
+			})
+			recorder := &patchOptionsRecorder{Interface: dynamicClient}
+
+			ensurer := newStepEnsurer(nil, nil, recorder).withServerSideApply(tt.force)
+			status, err := ensurer.EnsureConfigMap(namespace, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: tt.configMap, Namespace: namespace, ResourceVersion: "1"},
+				Data:       map[string]string{"key": "value"},
+			})
+
+			require.NotNil(t, patch)
+			require.Equal(t, types.ApplyPatchType, patch.GetPatchType())
+			require.JSONEq(t, `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"`+tt.configMap+`","namespace":"ns"},"data":{"key":"value"}}`, string(patch.GetPatch()))
+			require.Len(t, recorder.options, 1)
+			require.Equal(t, InstallPlanFieldManager, recorder.options[0].FieldManager)
+			require.NotNil(t, recorder.options[0].Force)
+			require.Equal(t, tt.force, *recorder.options[0].Force)
+
+			if tt.managers != nil {
+				var conflictErr *stepConflictError
+				require.True(t, errors.As(err, &conflictErr))
+				require.Equal(t, tt.managers, conflictErr.managers)
+				require.Equal(t, `applying ConfigMap existing conflicts with fields managed by argocd-controller, flux`, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, status)
+		})
+	}
+}
+
+// patchOptionsRecorder records the options of the patches sent with a dynamic client.
+type patchOptionsRecorder struct {
+	dynamic.Interface
+	options []metav1.PatchOptions
+}
+
+func (r *patchOptionsRecorder) Resource(gvr schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	return &recordingResourceClient{NamespaceableResourceInterface: r.Interface.Resource(gvr), recorder: r}
+}
+
+type recordingResourceClient struct {
+	dynamic.NamespaceableResourceInterface
+	recorder *patchOptionsRecorder
+}
+
+func (c *recordingResourceClient) Namespace(namespace string) dynamic.ResourceInterface {
+	return &recordingNamespacedClient{ResourceInterface: c.NamespaceableResourceInterface.Namespace(namespace), recorder: c.recorder}
+}
+
+func (c *recordingResourceClient) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, options metav1.PatchOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	c.recorder.options = append(c.recorder.options, options)
+	return c.NamespaceableResourceInterface.Patch(ctx, name, pt, data, options, subresources...)
+}
+
+type recordingNamespacedClient struct {
+	dynamic.ResourceInterface
+	recorder *patchOptionsRecorder
+}
+
+func (c *recordingNamespacedClient) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, options metav1.PatchOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	c.recorder.options = append(c.recorder.options, options)
+	return c.ResourceInterface.Patch(ctx, name, pt, data, options, subresources...)
+}
+
+func TestStepEnsurerUpgradesLegacyFields(t *testing.T) {
+	namespace := "ns"
+	existing := &unstructured.Unstructured{}
+	existing.SetAPIVersion("v1")
+	existing.SetKind("ConfigMap")
+	existing.SetName("cm")
+	existing.SetNamespace(namespace)
+	existing.SetUID("uid")
+	existing.SetResourceVersion("7")
+	existing.SetManagedFields([]metav1.ManagedFieldsEntry{
+		{
+			Manager:    "catalog",
+			Operation:  metav1.ManagedFieldsOperationUpdate,
+			APIVersion: "v1",
+			FieldsType: "FieldsV1",
+			FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:data":{".":{},"f:key":{},"f:removed":{}}}`)},
+		},
+		{
+			Manager:    "kubectl-edit",
+			Operation:  metav1.ManagedFieldsOperationUpdate,
+			APIVersion: "v1",
+			FieldsType: "FieldsV1",
+			FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{".":{},"f:app":{}}}}`)},
+		},
+	})
+	dynamicClient := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), existing)
+
+	var patchTypes []types.PatchType
+	dynamicClient.PrependReactor("patch", "configmaps", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		patch := action.(clienttesting.PatchAction)
+		patchTypes = append(patchTypes, patch.GetPatchType())
+		if patch.GetPatchType() == types.ApplyPatchType {
+			return true, existing, nil
+		}
+		return false, nil, nil
+	})
+
+	status, err := newStepEnsurer(nil, nil, dynamicClient).withServerSideApply(false).EnsureConfigMap(namespace, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "cm", Namespace: namespace},
+		Data:       map[string]string{"key": "value"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1alpha1.StepStatusPresent, status)
+
+	// the legacy fields are taken over before the apply
+	require.Equal(t, []types.PatchType{types.JSONPatchType, types.ApplyPatchType}, patchTypes)
+	out, err := dynamicClient.Resource(corev1.SchemeGroupVersion.WithResource("configmaps")).Namespace(namespace).Get(context.TODO(), "cm", metav1.GetOptions{})
+	require.NoError(t, err)
+	managed := out.GetManagedFields()
+	require.Len(t, managed, 2)
+	require.Equal(t, "kubectl-edit", managed[0].Manager)
+	require.Equal(t, InstallPlanFieldManager, managed[1].Manager)
+	require.Equal(t, metav1.ManagedFieldsOperationApply, managed[1].Operation)
+	require.JSONEq(t, `{"f:data":{".":{},"f:key":{},"f:removed":{}}}`, string(managed[1].FieldsV1.Raw))
+}
+
+// applyAsReplace makes the fake client apply objects by replacing them, as an apply does when the applied
+// fields were all owned by the applying field manager.
+func applyAsReplace(t *testing.T, dynamicClient *fakedynamic.FakeDynamicClient, gvr schema.GroupVersionResource) {
+	dynamicClient.PrependReactor("patch", gvr.Resource, func(action clienttesting.Action) (bool, runtime.Object, error) {
+		patch := action.(clienttesting.PatchAction)
+		if patch.GetPatchType() != types.ApplyPatchType {
+			return false, nil, nil
+		}
+		applied := &unstructured.Unstructured{}
+		require.NoError(t, applied.UnmarshalJSON(patch.GetPatch()))
+		existing, err := dynamicClient.Tracker().Get(gvr, patch.GetNamespace(), patch.GetName())
+		if err != nil {
+			return true, nil, err
+		}
+		applied.SetUID(existing.(metav1.Object).GetUID())
+		return true, applied, dynamicClient.Tracker().Update(gvr, applied, patch.GetNamespace())
+	})
+}
+
+func TestStepEnsurerUpgradesSharedServiceAccount(t *testing.T) {
+	namespace := "ns"
+	gvr := corev1.SchemeGroupVersion.WithResource("serviceaccounts")
+	owner := func(name string) metav1.OwnerReference {
+		return metav1.OwnerReference{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: v1alpha1.ClusterServiceVersionKind, Name: name, UID: types.UID("uid-" + name)}
+	}
+
+	// the ServiceAccount was created for csv-a and updated for csv-b before steps were applied with server-side apply
+	existing := &unstructured.Unstructured{}
+	existing.SetAPIVersion("v1")
+	existing.SetKind("ServiceAccount")
+	existing.SetName("sa")
+	existing.SetNamespace(namespace)
+	existing.SetUID("uid")
+	existing.SetResourceVersion("7")
+	existing.SetOwnerReferences([]metav1.OwnerReference{owner("csv-a"), owner("csv-b")})
+	require.NoError(t, unstructured.SetNestedSlice(existing.Object, []interface{}{map[string]interface{}{"name": "sa-token"}}, "secrets"))
+	existing.SetManagedFields([]metav1.ManagedFieldsEntry{
+		{
+			Manager:    "catalog",
+			Operation:  metav1.ManagedFieldsOperationUpdate,
+			APIVersion: "v1",
+			FieldsType: "FieldsV1",
+			FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:ownerReferences":{".":{},"k:{\"uid\":\"uid-csv-a\"}":{},"k:{\"uid\":\"uid-csv-b\"}":{}}},"f:secrets":{}}`)},
+		},
+	})
+	dynamicClient := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), existing)
+	applyAsReplace(t, dynamicClient, gvr)
+
+	status, err := newStepEnsurer(nil, nil, dynamicClient).withServerSideApply(false).EnsureServiceAccount(namespace, &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: "sa", Namespace: namespace, OwnerReferences: []metav1.OwnerReference{owner("csv-b")}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1alpha1.StepStatusPresent, status)
+
+	out, err := dynamicClient.Resource(gvr).Namespace(namespace).Get(context.TODO(), "sa", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []metav1.OwnerReference{owner("csv-a"), owner("csv-b")}, out.GetOwnerReferences())
+	secrets, _, err := unstructured.NestedSlice(out.Object, "secrets")
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{map[string]interface{}{"name": "sa-token"}}, secrets)
+}
+
+func TestUpgradeManagedFields(t *testing.T) {
+	earlier := metav1.NewTime(time.Date(2021, 3, 6, 12, 0, 0, 0, time.UTC))
+	later := metav1.NewTime(earlier.Add(time.Hour))
+	entry := func(manager string, op metav1.ManagedFieldsOperationType, subresource string, t *metav1.Time, fields string) metav1.ManagedFieldsEntry {
+		return metav1.ManagedFieldsEntry{
+			Manager:     manager,
+			Operation:   op,
+			APIVersion:  "v1",
+			Time:        t,
+			FieldsType:  "FieldsV1",
+			FieldsV1:    &metav1.FieldsV1{Raw: []byte(fields)},
+			Subresource: subresource,
+		}
+	}
+
+	unchanged := []metav1.ManagedFieldsEntry{
+		entry(InstallPlanFieldManager, metav1.ManagedFieldsOperationApply, "", &earlier, `{"f:data":{"f:key":{}}}`),
+		entry("catalog", metav1.ManagedFieldsOperationUpdate, "status", &earlier, `{"f:status":{}}`),
+	}
+	out, upgraded, err := upgradeManagedFields(unchanged)
+	require.NoError(t, err)
+	require.False(t, upgraded)
+	require.Equal(t, unchanged, out)
+
+	out, upgraded, err = upgradeManagedFields([]metav1.ManagedFieldsEntry{
+		entry(InstallPlanFieldManager, metav1.ManagedFieldsOperationApply, "", &earlier, `{"f:data":{"f:key":{}}}`),
+		entry("catalog", metav1.ManagedFieldsOperationUpdate, "", &later, `{"f:data":{"f:other":{}}}`),
+		entry("catalog", metav1.ManagedFieldsOperationUpdate, "status", &earlier, `{"f:status":{}}`),
+		entry("kubectl", metav1.ManagedFieldsOperationUpdate, "", &earlier, `{"f:metadata":{}}`),
+	})
+	require.NoError(t, err)
+	require.True(t, upgraded)
+	require.Len(t, out, 3)
+	require.Equal(t, "status", out[0].Subresource)
+	require.Equal(t, "kubectl", out[1].Manager)
+	require.Equal(t, InstallPlanFieldManager, out[2].Manager)
+	require.Equal(t, metav1.ManagedFieldsOperationApply, out[2].Operation)
+	require.Equal(t, &later, out[2].Time)
+	require.JSONEq(t, `{"f:data":{"f:key":{},"f:other":{}}}`, string(out[2].FieldsV1.Raw))
+}
+
+func TestConflictingManagers(t *testing.T) {
+	require.Nil(t, conflictingManagers(errors.New("apply failed")))
+	require.Nil(t, conflictingManagers(k8serrors.NewConflict(corev1.Resource("configmaps"), "cm", errors.New("the object has been modified"))))
+	require.Equal(t, []string{"kubectl-client-side-apply"}, conflictingManagers(k8serrors.NewApplyConflict([]metav1.StatusCause{
+		{Type: metav1.CauseTypeFieldManagerConflict, Message: `conflict with "kubectl-client-side-apply" using v1: .spec.replicas`},
+	}, "Apply failed with 1 conflict")))
+}
+
+func TestExecutePlanServerSideApplyConflict(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	namespace := "ns"
+	cm := &corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Name: "cm"},
+		Data:       map[string]string{"key": "value"},
+	}
+	plan := withSteps(installPlan("p", namespace, v1alpha1.InstallPlanPhaseInstalling, "csv"), []*v1alpha1.Step{{
+		Resource: v1alpha1.StepResource{
+			Version:  "v1",
+			Kind:     "ConfigMap",
+			Name:     "cm",
+			Manifest: toManifest(t, cm),
+		},
+		Status: v1alpha1.StepStatusUnknown,
+	}})
+	enabled := true
+	config := &operatorsv1.OLMConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+		Spec:       operatorsv1.OLMConfigSpec{Features: &operatorsv1.Features{ServerSideApply: &enabled}},
+	}
+
+	op, err := NewFakeOperator(ctx, namespace, []string{namespace}, withClientObjs(plan, config))
+	require.NoError(t, err)
+	op.dynamicClient.(*fakedynamic.FakeDynamicClient).PrependReactor("patch", "configmaps", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, k8serrors.NewApplyConflict([]metav1.StatusCause{
+			{Type: metav1.CauseTypeFieldManagerConflict, Message: `conflict with "argocd-controller": .data.key`},
+		}, "Apply failed with 1 conflict")
+	})
+
+	require.Error(t, op.ExecutePlan(plan))
+	require.Equal(t, v1alpha1.StepStatusConflict, plan.Status.Plan[0].Status)
+	require.Equal(t, "applying ConfigMap cm conflicts with fields managed by argocd-controller", plan.Status.Plan[0].Message)
+}
+
+func TestExecutePlanServerSideApply(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	namespace := "ns"
+	crd := v1crd("crd-a")
+	crd.SetGroupVersionKind(apiextensionsv1.SchemeGroupVersion.WithKind(crdKind))
+	sub := &v1alpha1.Subscription{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: v1alpha1.SubscriptionKind},
+		ObjectMeta: metav1.ObjectMeta{Name: "sub"},
+		Spec:       &v1alpha1.SubscriptionSpec{Package: "pkg"},
+	}
+	installCSV := csvStep(t, csv("csv", namespace, nil, nil))
+	installCSV.Status = v1alpha1.StepStatusUnknown
+	plan := withSteps(installPlan("p", namespace, v1alpha1.InstallPlanPhaseInstalling, "csv"), []*v1alpha1.Step{
+		{
+			Resource: v1alpha1.StepResource{
+				Group:    apiextensionsv1.GroupName,
+				Version:  "v1",
+				Kind:     crdKind,
+				Name:     crd.GetName(),
+				Manifest: toManifest(t, &crd),
+			},
+			Status: v1alpha1.StepStatusUnknown,
+		},
+		installCSV,
+		{
+			Resource: v1alpha1.StepResource{
+				Group:    v1alpha1.GroupName,
+				Version:  v1alpha1.GroupVersion,
+				Kind:     v1alpha1.SubscriptionKind,
+				Name:     sub.GetName(),
+				Manifest: toManifest(t, sub),
+			},
+			Status: v1alpha1.StepStatusUnknown,
+		},
+	})
+	enabled := true
+	config := &operatorsv1.OLMConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+		Spec:       operatorsv1.OLMConfigSpec{Features: &operatorsv1.Features{ServerSideApply: &enabled}},
+	}
+
+	op, err := NewFakeOperator(ctx, namespace, []string{namespace}, withClientObjs(plan, config))
+	require.NoError(t, err)
+	var applied []string
+	op.dynamicClient.(*fakedynamic.FakeDynamicClient).PrependReactor("patch", "*", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		patch := action.(clienttesting.PatchAction)
+		require.Equal(t, types.ApplyPatchType, patch.GetPatchType())
+		applied = append(applied, patch.GetResource().Resource)
+		obj := &unstructured.Unstructured{}
+		obj.SetName(patch.GetName())
+		obj.SetUID(types.UID(patch.GetName() + "-uid"))
+		return true, obj, nil
+	})
+
+	require.NoError(t, op.ExecutePlan(plan))
+	require.Equal(t, []string{"customresourcedefinitions", "clusterserviceversions", "subscriptions"}, applied)
+	require.Equal(t, v1alpha1.StepStatusWaitingForAPI, plan.Status.Plan[0].Status)
+	require.Equal(t, v1alpha1.StepStatusCreated, plan.Status.Plan[1].Status)
+	require.Equal(t, v1alpha1.StepStatusCreated, plan.Status.Plan[2].Status)
+}
@@ -32,11 +32,19 @@ type StepEnsurer struct {
 	kubeClient    operatorclient.ClientInterface
 	crClient      versioned.Interface
 	dynamicClient dynamic.Interface
+
+	// serverSideApply makes the StepEnsurer apply resources with server-side apply rather than creating or updating them.
+	serverSideApply bool
+	forceConflicts  bool
 }
 
 // EnsureClusterServiceVersion writes the specified ClusterServiceVersion
 // object to the cluster.
 func (o *StepEnsurer) EnsureClusterServiceVersion(csv *v1alpha1.ClusterServiceVersion) (status v1alpha1.StepStatus, err error) {
+	if o.serverSideApply {
+		return o.apply(o.dynamicClient.Resource(v1alpha1.SchemeGroupVersion.WithResource("clusterserviceversions")).Namespace(csv.GetNamespace()), v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.ClusterServiceVersionKind), csv)
+	}
+
 	_, createErr := o.crClient.OperatorsV1alpha1().ClusterServiceVersions(csv.GetNamespace()).Create(context.TODO(), csv, metav1.CreateOptions{})
 	if createErr == nil {
 		status = v1alpha1.StepStatusCreated
@@ -54,6 +62,10 @@ func (o *StepEnsurer) EnsureClusterServiceVersion(csv *v1alpha1.ClusterServiceVe
 
 // EnsureSubscription writes the specified Subscription object to the cluster.
 func (o *StepEnsurer) EnsureSubscription(subscription *v1alpha1.Subscription) (status v1alpha1.StepStatus, err error) {
+	if o.serverSideApply {
+		return o.apply(o.dynamicClient.Resource(v1alpha1.SchemeGroupVersion.WithResource("subscriptions")).Namespace(subscription.GetNamespace()), v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.SubscriptionKind), subscription)
+	}
+
 	_, createErr := o.crClient.OperatorsV1alpha1().Subscriptions(subscription.GetNamespace()).Create(context.TODO(), subscription, metav1.CreateOptions{})
 	if createErr == nil {
 		status = v1alpha1.StepStatusCreated
@@ -112,6 +124,10 @@ func (o *StepEnsurer) EnsureSecret(operatorNamespace, planNamespace, name string
 
 // EnsureBundleSecret creates user-specified secrets from the bundle. Called when StepResource.Secret is true
 func (o *StepEnsurer) EnsureBundleSecret(namespace string, secret *corev1.Secret) (status v1alpha1.StepStatus, err error) {
+	if o.serverSideApply {
+		return o.apply(o.dynamicClient.Resource(corev1.SchemeGroupVersion.WithResource("secrets")).Namespace(namespace), corev1.SchemeGroupVersion.WithKind("Secret"), secret)
+	}
+
 	_, createErr := o.kubeClient.KubernetesInterface().CoreV1().Secrets(namespace).Create(context.TODO(), secret, metav1.CreateOptions{})
 	if createErr == nil {
 		status = v1alpha1.StepStatusCreated
@@ -136,6 +152,10 @@ func (o *StepEnsurer) EnsureBundleSecret(namespace string, secret *corev1.Secret
 
 // EnsureServiceAccount writes the specified ServiceAccount object to the cluster.
 func (o *StepEnsurer) EnsureServiceAccount(namespace string, sa *corev1.ServiceAccount) (status v1alpha1.StepStatus, err error) {
+	if o.serverSideApply {
+		return o.applyMerged(o.dynamicClient.Resource(corev1.SchemeGroupVersion.WithResource("serviceaccounts")).Namespace(namespace), corev1.SchemeGroupVersion.WithKind("ServiceAccount"), sa, mergeServiceAccount)
+	}
+
 	_, createErr := o.kubeClient.KubernetesInterface().CoreV1().ServiceAccounts(namespace).Create(context.TODO(), sa, metav1.CreateOptions{})
 	if createErr == nil {
 		status = v1alpha1.StepStatusCreated
@@ -172,8 +192,26 @@ func (o *StepEnsurer) EnsureServiceAccount(namespace string, sa *corev1.ServiceA
 	return
 }
 
+// mergeServiceAccount keeps the owner references and the secrets of an existing ServiceAccount, like updates do.
+func mergeServiceAccount(existing, applied *unstructured.Unstructured) error {
+	secrets, ok, err := unstructured.NestedSlice(existing.Object, "secrets")
+	if err != nil {
+		return err
+	}
+	if ok {
+		if err := unstructured.SetNestedSlice(applied.Object, secrets, "secrets"); err != nil {
+			return err
+		}
+	}
+	return mergeOwners(existing, applied)
+}
+
 // EnsureService writes the specified Service object to the cluster.
 func (o *StepEnsurer) EnsureService(namespace string, service *corev1.Service) (status v1alpha1.StepStatus, err error) {
+	if o.serverSideApply {
+		return o.apply(o.dynamicClient.Resource(corev1.SchemeGroupVersion.WithResource("services")).Namespace(namespace), corev1.SchemeGroupVersion.WithKind("Service"), service)
+	}
+
 	_, createErr := o.kubeClient.KubernetesInterface().CoreV1().Services(namespace).Create(context.TODO(), service, metav1.CreateOptions{})
 	if createErr == nil {
 		status = v1alpha1.StepStatusCreated
@@ -197,6 +235,15 @@ func (o *StepEnsurer) EnsureService(namespace string, service *corev1.Service) (
 
 // EnsureClusterRole writes the specified ClusterRole object to the cluster.
 func (o *StepEnsurer) EnsureClusterRole(cr *rbacv1.ClusterRole, step *v1alpha1.Step) (status v1alpha1.StepStatus, err error) {
+	if o.serverSideApply {
+		// point owner to the newest csv
+		if cr.ObjectMeta.Labels == nil {
+			cr.ObjectMeta.Labels = map[string]string{}
+		}
+		cr.ObjectMeta.Labels[ownerutil.OwnerKey] = step.Resolving
+		return o.apply(o.dynamicClient.Resource(rbacv1.SchemeGroupVersion.WithResource("clusterroles")), rbacv1.SchemeGroupVersion.WithKind("ClusterRole"), cr)
+	}
+
 	_, createErr := o.kubeClient.KubernetesInterface().RbacV1().ClusterRoles().Create(context.TODO(), cr, metav1.CreateOptions{})
 	if createErr == nil {
 		status = v1alpha1.StepStatusCreated
@@ -224,6 +271,15 @@ func (o *StepEnsurer) EnsureClusterRole(cr *rbacv1.ClusterRole, step *v1alpha1.S
 
 // EnsureClusterRoleBinding writes the specified ClusterRoleBinding object to the cluster.
 func (o *StepEnsurer) EnsureClusterRoleBinding(crb *rbacv1.ClusterRoleBinding, step *v1alpha1.Step) (status v1alpha1.StepStatus, err error) {
+	if o.serverSideApply {
+		// point owner to the newest csv
+		if crb.ObjectMeta.Labels == nil {
+			crb.ObjectMeta.Labels = map[string]string{}
+		}
+		crb.ObjectMeta.Labels[ownerutil.OwnerKey] = step.Resolving
+		return o.apply(o.dynamicClient.Resource(rbacv1.SchemeGroupVersion.WithResource("clusterrolebindings")), rbacv1.SchemeGroupVersion.WithKind("ClusterRoleBinding"), crb)
+	}
+
 	_, createErr := o.kubeClient.KubernetesInterface().RbacV1().ClusterRoleBindings().Create(context.TODO(), crb, metav1.CreateOptions{})
 	if createErr == nil {
 		status = v1alpha1.StepStatusCreated
@@ -251,6 +307,10 @@ func (o *StepEnsurer) EnsureClusterRoleBinding(crb *rbacv1.ClusterRoleBinding, s
 
 // EnsureRole writes the specified Role object to the cluster.
 func (o *StepEnsurer) EnsureRole(namespace string, role *rbacv1.Role) (status v1alpha1.StepStatus, err error) {
+	if o.serverSideApply {
+		return o.apply(o.dynamicClient.Resource(rbacv1.SchemeGroupVersion.WithResource("roles")).Namespace(namespace), rbacv1.SchemeGroupVersion.WithKind("Role"), role)
+	}
+
 	_, createErr := o.kubeClient.KubernetesInterface().RbacV1().Roles(namespace).Create(context.TODO(), role, metav1.CreateOptions{})
 	if createErr == nil {
 		status = v1alpha1.StepStatusCreated
@@ -275,6 +335,10 @@ func (o *StepEnsurer) EnsureRole(namespace string, role *rbacv1.Role) (status v1
 
 // EnsureRoleBinding writes the specified RoleBinding object to the cluster.
 func (o *StepEnsurer) EnsureRoleBinding(namespace string, rb *rbacv1.RoleBinding) (status v1alpha1.StepStatus, err error) {
+	if o.serverSideApply {
+		return o.apply(o.dynamicClient.Resource(rbacv1.SchemeGroupVersion.WithResource("rolebindings")).Namespace(namespace), rbacv1.SchemeGroupVersion.WithKind("RoleBinding"), rb)
+	}
+
 	_, createErr := o.kubeClient.KubernetesInterface().RbacV1().RoleBindings(namespace).Create(context.TODO(), rb, metav1.CreateOptions{})
 	if createErr == nil {
 		status = v1alpha1.StepStatusCreated
@@ -297,7 +361,15 @@ func (o *StepEnsurer) EnsureRoleBinding(namespace string, rb *rbacv1.RoleBinding
 }
 
 // EnsureUnstructuredObject writes the unspecified resource object to the cluster.
-func (o *StepEnsurer) EnsureUnstructuredObject(client dynamic.ResourceInterface, obj *unstructured.Unstructured) (status v1alpha1.StepStatus, err error) {
+// If keepOwners is set, the owner references of an existing object are kept on update.
+func (o *StepEnsurer) EnsureUnstructuredObject(client dynamic.ResourceInterface, obj *unstructured.Unstructured, keepOwners bool) (status v1alpha1.StepStatus, err error) {
+	if o.serverSideApply {
+		if keepOwners {
+			return o.applyMerged(client, obj.GroupVersionKind(), obj, mergeOwners)
+		}
+		return o.apply(client, obj.GroupVersionKind(), obj)
+	}
+
 	_, createErr := client.Create(context.TODO(), obj, metav1.CreateOptions{})
 	if createErr == nil {
 		status = v1alpha1.StepStatusCreated
@@ -318,6 +390,11 @@ func (o *StepEnsurer) EnsureUnstructuredObject(client dynamic.ResourceInterface,
 	// Set the objects resource version
 	obj.SetResourceVersion(original.GetResourceVersion())
 
+	// Keep the objects existing owners, as for service accounts
+	if keepOwners {
+		obj.SetOwnerReferences(mergedOwnerReferences(original.GetOwnerReferences(), obj.GetOwnerReferences()))
+	}
+
 	_, updateError := client.Update(context.TODO(), obj, metav1.UpdateOptions{})
 	if updateError != nil {
 		err = errorwrap.Wrapf(updateError, "error updating unstructured object %s", obj.GetName())
@@ -330,6 +407,10 @@ func (o *StepEnsurer) EnsureUnstructuredObject(client dynamic.ResourceInterface,
 
 // EnsureConfigMap writes the specified ConfigMap object to the cluster.
 func (o *StepEnsurer) EnsureConfigMap(namespace string, configmap *corev1.ConfigMap) (status v1alpha1.StepStatus, err error) {
+	if o.serverSideApply {
+		return o.apply(o.dynamicClient.Resource(corev1.SchemeGroupVersion.WithResource("configmaps")).Namespace(namespace), corev1.SchemeGroupVersion.WithKind("ConfigMap"), configmap)
+	}
+
 	_, createErr := o.kubeClient.KubernetesInterface().CoreV1().ConfigMaps(namespace).Create(context.TODO(), configmap, metav1.CreateOptions{})
 	if createErr == nil {
 		status = v1alpha1.StepStatusCreated
@@ -1,11 +1,19 @@
 package catalog
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
-
+	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
 )
 
 func TestMergedOwnerReferences(t *testing.T) {
@@ -188,3 +196,70 @@ func TestMergedOwnerReferences(t *testing.T) {
 		})
 	}
 }
+
+func TestEnsureUnstructuredObjectOwners(t *testing.T) {
+	gvr := schema.GroupVersionResource{Group: "monitoring.coreos.com", Version: "v1", Resource: "servicemonitors"}
+	object := func(owners ...string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion("monitoring.coreos.com/v1")
+		obj.SetKind("ServiceMonitor")
+		obj.SetName("monitor")
+		obj.SetNamespace("ns")
+		var refs []metav1.OwnerReference
+		for _, owner := range owners {
+			refs = append(refs, metav1.OwnerReference{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: v1alpha1.ClusterServiceVersionKind, Name: owner, UID: types.UID("uid-" + owner)})
+		}
+		obj.SetOwnerReferences(refs)
+		return obj
+	}
+
+	for _, tt := range []struct {
+		name            string
+		keepOwners      bool
+		serverSideApply bool
+		expected        []string
+	}{
+		{
+			name:     "Replaced",
+			expected: []string{"csv-b"},
+		},
+		{
+			name:       "Kept",
+			keepOwners: true,
+			expected:   []string{"csv-a", "csv-b"},
+		},
+		{
+			name:            "ReplacedByApply",
+			serverSideApply: true,
+			expected:        []string{"csv-b"},
+		},
+		{
+			name:            "KeptByApply",
+			keepOwners:      true,
+			serverSideApply: true,
+			expected:        []string{"csv-a", "csv-b"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			dynamicClient := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), object("csv-a"))
+			client := dynamicClient.Resource(gvr).Namespace("ns")
+
+			ensurer := newStepEnsurer(nil, nil, dynamicClient)
+			if tt.serverSideApply {
+				applyAsReplace(t, dynamicClient, gvr)
+				ensurer = ensurer.withServerSideApply(false)
+			}
+			status, err := ensurer.EnsureUnstructuredObject(client, object("csv-b"), tt.keepOwners)
+			require.NoError(t, err)
+			require.Equal(t, v1alpha1.StepStatusPresent, status)
+
+			out, err := client.Get(context.TODO(), "monitor", metav1.GetOptions{})
+			require.NoError(t, err)
+			var owners []string
+			for _, ref := range out.GetOwnerReferences() {
+				owners = append(owners, ref.Name)
+			}
+			assert.ElementsMatch(t, tt.expected, owners)
+		})
+	}
+}
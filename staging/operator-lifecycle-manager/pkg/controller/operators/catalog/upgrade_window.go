@@ -0,0 +1,107 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/lib/ownerutil"
+	olmtime "github.com/operator-framework/operator-lifecycle-manager/pkg/lib/time"
+)
+
+// holdForUpgradeWindows returns the UpgradeWindowClosed conditions of the given Subscriptions whose upgrade windows
+// are all closed at now, and the time at which the next of their windows opens. Automatic InstallPlans for the
+// Subscriptions must be held if any conditions are returned.
+func holdForUpgradeWindows(subs []*v1alpha1.Subscription, now metav1.Time) (map[*v1alpha1.Subscription]v1alpha1.SubscriptionCondition, time.Time) {
+	held := make(map[*v1alpha1.Subscription]v1alpha1.SubscriptionCondition)
+	var next time.Time
+	for _, sub := range subs {
+		if len(sub.Spec.UpgradeWindows) == 0 {
+			continue
+		}
+
+		cond := v1alpha1.SubscriptionCondition{
+			Type:               v1alpha1.SubscriptionUpgradeWindowClosed,
+			Status:             corev1.ConditionTrue,
+			LastTransitionTime: &now,
+		}
+		open, _, opens, err := olmtime.WindowsOpen(sub.Spec.UpgradeWindows, now.Time)
+		switch {
+		case err != nil:
+			cond.Reason = v1alpha1.InvalidUpgradeWindow
+			cond.Message = fmt.Sprintf("Automatic InstallPlans are held until the upgrade windows are fixed: %v", err)
+		case open:
+			continue
+		case opens.IsZero():
+			cond.Reason = v1alpha1.OutsideUpgradeWindow
+			cond.Message = "Automatic InstallPlans are held since none of the upgrade windows will ever open"
+		default:
+			cond.Reason = v1alpha1.OutsideUpgradeWindow
+			cond.Message = fmt.Sprintf("Automatic InstallPlans are held until the next upgrade window opens at %s", opens.UTC().Format(time.RFC3339))
+			if next.IsZero() || opens.Before(next) {
+				next = opens
+			}
+		}
+		// the condition only transitions when the reason the InstallPlans are held changes
+		if existing := sub.Status.GetCondition(cond.Type); existing.Status == cond.Status && existing.Reason == cond.Reason && existing.LastTransitionTime != nil {
+			cond.LastTransitionTime = existing.LastTransitionTime
+		}
+		held[sub] = cond
+	}
+	return held, next
+}
+
+// syncHeldInstallPlan approves an Automatic InstallPlan that was held outside of the upgrade windows of the
+// Subscriptions it changes once they open, and otherwise requeues it for when the next window opens.
+func (o *Operator) syncHeldInstallPlan(plan *v1alpha1.InstallPlan, logger *logrus.Entry) error {
+	var subs []*v1alpha1.Subscription
+	for _, owner := range ownerutil.GetOwnersByKind(plan, v1alpha1.SubscriptionKind) {
+		sub, err := o.lister.OperatorsV1alpha1().SubscriptionLister().Subscriptions(plan.GetNamespace()).Get(owner.Name)
+		if k8serrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		// every Subscription in the namespace owns the InstallPlan, but only those it changes refer to it
+		if ref := sub.Status.InstallPlanRef; ref == nil || ref.Name != plan.GetName() {
+			continue
+		}
+		subs = append(subs, sub.DeepCopy())
+	}
+
+	now := o.now()
+	held, next := holdForUpgradeWindows(subs, now)
+
+	var updated []*v1alpha1.Subscription
+	for _, sub := range subs {
+		if cond, ok := held[sub]; ok {
+			updated = append(updated, o.setSubsCond([]*v1alpha1.Subscription{sub}, cond)...)
+		} else {
+			updated = append(updated, o.removeSubsCond([]*v1alpha1.Subscription{sub}, v1alpha1.SubscriptionUpgradeWindowClosed)...)
+		}
+	}
+	if _, err := o.updateSubscriptionStatuses(updated); err != nil {
+		return err
+	}
+
+	if len(held) > 0 {
+		logger.WithField("next", next).Debug("outside of upgrade windows, holding installplan")
+		if next.IsZero() {
+			return nil
+		}
+		return o.ipQueueSet.RequeueAfter(plan.GetNamespace(), plan.GetName(), next.Sub(now.Time))
+	}
+
+	logger.Info("upgrade windows open, approving installplan")
+	out := plan.DeepCopy()
+	out.Spec.Approved = true
+	_, err := o.client.OperatorsV1alpha1().InstallPlans(plan.GetNamespace()).Update(context.TODO(), out, metav1.UpdateOptions{})
+	return err
+}
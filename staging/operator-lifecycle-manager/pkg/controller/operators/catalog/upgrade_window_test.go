@@ -0,0 +1,103 @@
+package catalog
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilclock "k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/lib/ownerutil"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/lib/queueinformer"
+)
+
+func TestSyncHeldInstallPlan(t *testing.T) {
+	namespace := "ns"
+
+	for _, tt := range []struct {
+		name     string
+		now      time.Time
+		approved bool
+	}{
+		{
+			name: "OutsideUpgradeWindow",
+			now:  time.Date(2021, 3, 6, 1, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "InsideUpgradeWindow",
+			now:      time.Date(2021, 3, 6, 2, 30, 0, 0, time.UTC),
+			approved: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.TODO())
+			defer cancel()
+
+			sub := &v1alpha1.Subscription{
+				ObjectMeta: metav1.ObjectMeta{Name: "sub", Namespace: namespace, UID: "sub-uid"},
+				Spec: &v1alpha1.SubscriptionSpec{
+					Package: "pkg",
+					UpgradeWindows: []v1alpha1.UpgradeWindow{
+						{Schedule: "0 2 * * *", Duration: metav1.Duration{Duration: time.Hour}},
+					},
+				},
+			}
+			sub.SetGroupVersionKind(v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.SubscriptionKind))
+			plan := installPlan("p", namespace, v1alpha1.InstallPlanPhaseRequiresApproval, "csv")
+			sub.Status.InstallPlanRef = &corev1.ObjectReference{Name: plan.GetName(), Namespace: namespace}
+			plan.Spec.Approval = v1alpha1.ApprovalAutomatic
+			plan.Status.Plan = []*v1alpha1.Step{{Resource: v1alpha1.StepResource{Kind: "ConfigMap", Name: "cm"}}}
+			ownerutil.AddNonBlockingOwner(plan, sub)
+
+			op, err := NewFakeOperator(ctx, namespace, []string{namespace},
+				withClock(utilclock.NewFakeClock(tt.now)),
+				withClientObjs(sub, plan, operatorGroup("og", "", namespace, nil)))
+			require.NoError(t, err)
+			op.ipQueueSet = queueinformer.NewEmptyResourceQueueSet()
+			op.ipQueueSet.Set(metav1.NamespaceAll, workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()))
+
+			require.NoError(t, op.syncInstallPlans(plan))
+
+			outPlan, err := op.client.OperatorsV1alpha1().InstallPlans(namespace).Get(ctx, plan.GetName(), metav1.GetOptions{})
+			require.NoError(t, err)
+			require.Equal(t, tt.approved, outPlan.Spec.Approved)
+
+			outSub, err := op.client.OperatorsV1alpha1().Subscriptions(namespace).Get(ctx, sub.GetName(), metav1.GetOptions{})
+			require.NoError(t, err)
+			cond := outSub.Status.GetCondition(v1alpha1.SubscriptionUpgradeWindowClosed)
+			if tt.approved {
+				require.Equal(t, corev1.ConditionUnknown, cond.Status)
+				return
+			}
+			require.Equal(t, corev1.ConditionTrue, cond.Status)
+			require.Equal(t, v1alpha1.OutsideUpgradeWindow, cond.Reason)
+			require.Contains(t, cond.Message, "2021-03-06T02:00:00Z")
+		})
+	}
+}
+
+func TestHoldForUpgradeWindowsKeepsTransitionTime(t *testing.T) {
+	sub := &v1alpha1.Subscription{
+		ObjectMeta: metav1.ObjectMeta{Name: "sub", Namespace: "ns"},
+		Spec: &v1alpha1.SubscriptionSpec{
+			Package: "pkg",
+			UpgradeWindows: []v1alpha1.UpgradeWindow{
+				{Schedule: "0 2 * * *", Duration: metav1.Duration{Duration: time.Hour}},
+			},
+		},
+	}
+	first := metav1.NewTime(time.Date(2021, 3, 6, 3, 0, 0, 0, time.UTC))
+	held, _ := holdForUpgradeWindows([]*v1alpha1.Subscription{sub}, first)
+	require.Contains(t, held, sub)
+	sub.Status.SetCondition(held[sub])
+
+	later := metav1.NewTime(first.Add(time.Hour))
+	held, _ = holdForUpgradeWindows([]*v1alpha1.Subscription{sub}, later)
+	require.Contains(t, held, sub)
+	require.True(t, first.Equal(held[sub].LastTransitionTime), "expected %s, got %s", first, held[sub].LastTransitionTime)
+}
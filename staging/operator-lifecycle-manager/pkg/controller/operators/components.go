@@ -1,6 +1,7 @@
 package operators
 
 import (
+	operatorsv1 "github.com/operator-framework/api/pkg/operators/v1"
 	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	operatorsv2 "github.com/operator-framework/api/pkg/operators/v2"
 	appsv1 "k8s.io/api/apps/v1"
@@ -19,6 +20,7 @@ func componentLists() []runtime.Object {
 		&corev1.NamespaceList{},
 		&apiregistrationv1.APIServiceList{},
 		&apiextensionsv1.CustomResourceDefinitionList{},
+		&operatorsv1.OperatorGroupList{},
 		&operatorsv1alpha1.SubscriptionList{},
 		&operatorsv1alpha1.InstallPlanList{},
 		&operatorsv1alpha1.ClusterServiceVersionList{},
@@ -298,6 +298,10 @@ func (a *Operator) getWebhookCABundle(csv *v1alpha1.ClusterServiceVersion, desc
 			if crd.Spec.Conversion == nil || crd.Spec.Conversion.Webhook == nil || crd.Spec.Conversion.Webhook.ClientConfig == nil && crd.Spec.Conversion.Webhook.ClientConfig.CABundle == nil {
 				continue
 			}
+			// the conversion webhook may be served by a CSV in another namespace
+			if !install.IsConversionWebhookOwner(csv, crd) {
+				continue
+			}
 
 			return crd.Spec.Conversion.Webhook.ClientConfig.CABundle, nil
 		}
@@ -475,6 +479,9 @@ func (a *Operator) areWebhooksAvailable(csv *v1alpha1.ClusterServiceVersion) (bo
 				if crd.Spec.Conversion == nil || crd.Spec.Conversion.Strategy != "Webhook" || crd.Spec.Conversion.Webhook == nil || crd.Spec.Conversion.Webhook.ClientConfig == nil && crd.Spec.Conversion.Webhook.ClientConfig.CABundle == nil {
 					return false, fmt.Errorf("ConversionWebhook not ready")
 				}
+				if !install.IsConversionWebhookOwner(csv, crd) {
+					return false, fmt.Errorf("ConversionWebhook of CRD %s is owned by %s", conversionCRD, crd.GetAnnotations()[install.ConversionWebhookOwnerAnnotationKey])
+				}
 				webhookCount++
 			}
 		}
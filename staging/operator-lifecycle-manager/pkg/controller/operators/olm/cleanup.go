@@ -0,0 +1,251 @@
+package olm
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	v1 "github.com/operator-framework/api/pkg/operators/v1"
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/lib/ownerutil"
+)
+
+const (
+	// CSVCleanupFinalizer is added to CSVs with cleanup enabled, and blocks their deletion until the custom resources,
+	// CRDs and APIServices they own have been removed.
+	CSVCleanupFinalizer = "operators.coreos.com/csv-cleanup"
+
+	// cleanupRequeueDelay is how long to wait before checking again on custom resources blocked on finalizers.
+	cleanupRequeueDelay = 5 * time.Second
+)
+
+// syncCleanup keeps the cleanup finalizer of a CSV in line with its cleanup spec, and runs the cleanup of CSVs being
+// deleted with the finalizer. It returns true when the CSV shouldn't be synced any further.
+func (a *Operator) syncCleanup(logger *logrus.Entry, csv *v1alpha1.ClusterServiceVersion) (bool, error) {
+	hasFinalizer := controllerutil.ContainsFinalizer(csv, CSVCleanupFinalizer)
+	if csv.GetDeletionTimestamp() != nil {
+		if !hasFinalizer {
+			return false, nil
+		}
+		return true, a.cleanupCSV(logger, csv)
+	}
+	if csv.Spec.Cleanup.Enabled == hasFinalizer {
+		return false, nil
+	}
+
+	out := csv.DeepCopy()
+	if csv.Spec.Cleanup.Enabled {
+		logger.Debug("adding cleanup finalizer")
+		controllerutil.AddFinalizer(out, CSVCleanupFinalizer)
+	} else {
+		logger.Debug("removing cleanup finalizer")
+		controllerutil.RemoveFinalizer(out, CSVCleanupFinalizer)
+	}
+	// the update requeues the CSV
+	_, err := a.client.OperatorsV1alpha1().ClusterServiceVersions(out.GetNamespace()).Update(context.TODO(), out, metav1.UpdateOptions{})
+	return true, err
+}
+
+// cleanupCSV removes the custom resources, CRDs and APIServices owned by a deleted CSV and then releases it.
+// CSVs being replaced by a newer version hand their APIs over to it, and are released without cleanup.
+func (a *Operator) cleanupCSV(logger *logrus.Entry, csv *v1alpha1.ClusterServiceVersion) error {
+	if !csv.Spec.Cleanup.Enabled {
+		logger.Info("cleanup disabled, skipping cleanup")
+		return a.removeCleanupFinalizer(csv)
+	}
+	if replacement := a.isBeingReplaced(csv, a.csvSet(csv.GetNamespace(), v1alpha1.CSVPhaseAny)); replacement != nil {
+		logger.WithField("replacement", replacement.GetName()).Info("csv is being replaced, skipping cleanup")
+		return a.removeCleanupFinalizer(csv)
+	}
+
+	pending, err := a.deleteOwnedCustomResources(csv)
+	if err != nil {
+		return err
+	}
+	if len(pending) > 0 {
+		logger.WithField("pending", len(pending)).Info("waiting for owned custom resources to be deleted")
+		out := csv.DeepCopy()
+		out.Status.Cleanup.PendingDeletion = pending
+		out.SetPhaseWithEventIfChanged(out.Status.Phase, v1alpha1.CSVReasonWaitingForCleanupToComplete, "waiting for owned custom resources to be deleted", a.now(), a.recorder)
+		if !reflect.DeepEqual(out.Status.Cleanup, csv.Status.Cleanup) || out.Status.Reason != csv.Status.Reason {
+			if _, err := a.client.OperatorsV1alpha1().ClusterServiceVersions(out.GetNamespace()).UpdateStatus(context.TODO(), out, metav1.UpdateOptions{}); err != nil {
+				return err
+			}
+		}
+		return a.csvQueueSet.RequeueAfter(csv.GetNamespace(), csv.GetName(), cleanupRequeueDelay)
+	}
+
+	if err := a.deleteOwnedCRDs(logger, csv); err != nil {
+		return err
+	}
+	if err := a.deleteOwnedAPIServices(logger, csv); err != nil {
+		return err
+	}
+	logger.Info("cleanup complete")
+	return a.removeCleanupFinalizer(csv)
+}
+
+func (a *Operator) removeCleanupFinalizer(csv *v1alpha1.ClusterServiceVersion) error {
+	out := csv.DeepCopy()
+	controllerutil.RemoveFinalizer(out, CSVCleanupFinalizer)
+	_, err := a.client.OperatorsV1alpha1().ClusterServiceVersions(out.GetNamespace()).Update(context.TODO(), out, metav1.UpdateOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// cleanupNamespaces returns the namespaces an operator manages custom resources in.
+func cleanupNamespaces(csv *v1alpha1.ClusterServiceVersion) []string {
+	targets, ok := csv.GetAnnotations()[v1.OperatorGroupTargetsAnnotationKey]
+	switch {
+	case !ok:
+		return []string{csv.GetNamespace()}
+	case targets == "":
+		return []string{metav1.NamespaceAll}
+	default:
+		return strings.Split(targets, ",")
+	}
+}
+
+// deleteOwnedCustomResources deletes the instances of the CRDs owned by a CSV in the namespaces it manages, and
+// returns the instances that still exist, usually because they're blocked on finalizers.
+func (a *Operator) deleteOwnedCustomResources(csv *v1alpha1.ClusterServiceVersion) ([]v1alpha1.ResourceList, error) {
+	var pending []v1alpha1.ResourceList
+	seen := map[string]struct{}{}
+	for _, desc := range csv.Spec.CustomResourceDefinitions.Owned {
+		// CSVs list a CRD once per version they own
+		if _, ok := seen[desc.Name]; ok {
+			continue
+		}
+		seen[desc.Name] = struct{}{}
+
+		crd, err := a.lister.APIExtensionsV1().CustomResourceDefinitionLister().Get(desc.Name)
+		if k8serrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		version := servedVersion(crd)
+		if version == "" {
+			continue
+		}
+
+		gvr := schema.GroupVersionResource{Group: crd.Spec.Group, Version: version, Resource: crd.Spec.Names.Plural}
+		namespaces := cleanupNamespaces(csv)
+		if crd.Spec.Scope == apiextensionsv1.ClusterScoped {
+			namespaces = []string{metav1.NamespaceAll}
+		}
+
+		remaining := v1alpha1.ResourceList{Group: crd.Spec.Group, Kind: crd.Spec.Names.Kind}
+		for _, namespace := range namespaces {
+			client := a.dynamicClient.Resource(gvr).Namespace(namespace)
+			crs, err := client.List(context.TODO(), metav1.ListOptions{})
+			if err != nil {
+				return nil, fmt.Errorf("error listing %s: %v", gvr.GroupResource(), err)
+			}
+			for _, cr := range crs.Items {
+				if cr.GetDeletionTimestamp() != nil {
+					continue
+				}
+				if err := a.dynamicClient.Resource(gvr).Namespace(cr.GetNamespace()).Delete(context.TODO(), cr.GetName(), metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+					return nil, fmt.Errorf("error deleting %s %s: %v", gvr.GroupResource(), cr.GetName(), err)
+				}
+			}
+
+			crs, err = client.List(context.TODO(), metav1.ListOptions{})
+			if err != nil {
+				return nil, fmt.Errorf("error listing %s: %v", gvr.GroupResource(), err)
+			}
+			for _, cr := range crs.Items {
+				remaining.Instances = append(remaining.Instances, v1alpha1.ResourceInstance{Name: cr.GetName(), Namespace: cr.GetNamespace()})
+			}
+		}
+		if len(remaining.Instances) > 0 {
+			pending = append(pending, remaining)
+		}
+	}
+	return pending, nil
+}
+
+// servedVersion returns the version custom resources of a CRD are listed at: its storage version if it's served,
+// or else its first served version. It returns an empty string if the CRD serves no version.
+func servedVersion(crd *apiextensionsv1.CustomResourceDefinition) string {
+	var served string
+	for _, version := range crd.Spec.Versions {
+		if !version.Served {
+			continue
+		}
+		if version.Storage {
+			return version.Name
+		}
+		if served == "" {
+			served = version.Name
+		}
+	}
+	return served
+}
+
+// deleteOwnedCRDs deletes the CRDs owned by a CSV that no other CSV owns.
+func (a *Operator) deleteOwnedCRDs(logger *logrus.Entry, csv *v1alpha1.ClusterServiceVersion) error {
+	csvs, err := a.lister.OperatorsV1alpha1().ClusterServiceVersionLister().List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	owners := map[string]bool{}
+	for _, other := range csvs {
+		if other.IsCopied() || other.GetDeletionTimestamp() != nil || (other.GetName() == csv.GetName() && other.GetNamespace() == csv.GetNamespace()) {
+			continue
+		}
+		for _, desc := range other.Spec.CustomResourceDefinitions.Owned {
+			owners[desc.Name] = true
+		}
+	}
+
+	for _, desc := range csv.Spec.CustomResourceDefinitions.Owned {
+		if owners[desc.Name] {
+			logger.WithField("crd", desc.Name).Info("crd owned by another csv, skipping deletion")
+			continue
+		}
+		logger.WithField("crd", desc.Name).Info("deleting owned crd")
+		err := a.opClient.ApiextensionsInterface().ApiextensionsV1().CustomResourceDefinitions().Delete(context.TODO(), desc.Name, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteOwnedAPIServices deletes the APIServices a CSV owns.
+func (a *Operator) deleteOwnedAPIServices(logger *logrus.Entry, csv *v1alpha1.ClusterServiceVersion) error {
+	for _, desc := range csv.Spec.APIServiceDefinitions.Owned {
+		apiServiceName := desc.GetName()
+		fetched, err := a.lister.APIRegistrationV1().APIServiceLister().Get(apiServiceName)
+		if k8serrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		apiServiceLabels := fetched.GetLabels()
+		if csv.GetName() != apiServiceLabels[ownerutil.OwnerKey] || csv.GetNamespace() != apiServiceLabels[ownerutil.OwnerNamespaceKey] {
+			continue
+		}
+		logger.WithField("apiservice", apiServiceName).Info("deleting owned apiservice")
+		if err := a.opClient.DeleteAPIService(apiServiceName, &metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
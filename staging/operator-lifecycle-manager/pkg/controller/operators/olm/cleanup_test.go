@@ -0,0 +1,207 @@
+package olm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
+
+	v1 "github.com/operator-framework/api/pkg/operators/v1"
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+)
+
+func TestSyncCleanup(t *testing.T) {
+	namespace := "ns"
+	now := metav1.Now()
+	gvr := schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}
+
+	widgetCRD := func() *apiextensionsv1.CustomResourceDefinition {
+		c := crd("Widget", "v1", "example.com")
+		c.SetName("widgets.example.com")
+		c.Spec.Names.Plural = "widgets"
+		c.Spec.Scope = apiextensionsv1.NamespaceScoped
+		return c
+	}
+	operatorCSV := func(name, replaces string, enabled bool, finalizers ...string) *v1alpha1.ClusterServiceVersion {
+		out := csv(name, namespace, "0.0.0", replaces, installStrategy("dep", nil, nil), []*apiextensionsv1.CustomResourceDefinition{widgetCRD()}, nil, v1alpha1.CSVPhaseSucceeded)
+		out.Spec.Cleanup.Enabled = enabled
+		out.Spec.APIServiceDefinitions.Owned = []v1alpha1.APIServiceDescription{{Group: "example.com", Version: "v1alpha1", Kind: "Gadget", DeploymentName: "dep"}}
+		out.SetAnnotations(map[string]string{v1.OperatorGroupTargetsAnnotationKey: namespace})
+		out.SetFinalizers(finalizers)
+		// CSVs are listed by UID across namespaces
+		out.SetUID(types.UID(name))
+		return out
+	}
+	ownedAt := func(version string, csv *v1alpha1.ClusterServiceVersion) *v1alpha1.ClusterServiceVersion {
+		for i := range csv.Spec.CustomResourceDefinitions.Owned {
+			csv.Spec.CustomResourceDefinitions.Owned[i].Version = version
+		}
+		return csv
+	}
+	deleted := func(csv *v1alpha1.ClusterServiceVersion) *v1alpha1.ClusterServiceVersion {
+		csv.SetDeletionTimestamp(&now)
+		return csv
+	}
+	widget := func(name string, deleting bool) *unstructured.Unstructured {
+		cr := &unstructured.Unstructured{}
+		cr.SetAPIVersion("example.com/v1")
+		cr.SetKind("Widget")
+		cr.SetName(name)
+		cr.SetNamespace(namespace)
+		if deleting {
+			cr.SetDeletionTimestamp(&now)
+			cr.SetFinalizers([]string{"example.com/widget"})
+		}
+		return cr
+	}
+	ownedAPIService := apiService("example.com", "v1alpha1", "svc", namespace, "dep", nil, apiregistrationv1.ConditionTrue, ownerLabelFromCSV("operator.v1", namespace))
+
+	for _, tt := range []struct {
+		name              string
+		csv               *v1alpha1.ClusterServiceVersion
+		clientObjs        []runtime.Object
+		crs               []runtime.Object
+		done              bool
+		expectFinalizer   bool
+		expectCRs         []string
+		expectCRD         bool
+		expectAPIService  bool
+		expectPending     []v1alpha1.ResourceList
+		expectStateReason v1alpha1.ConditionReason
+	}{
+		{
+			name:             "AddsFinalizer",
+			csv:              operatorCSV("operator.v1", "", true),
+			crs:              []runtime.Object{widget("a", false)},
+			done:             true,
+			expectFinalizer:  true,
+			expectCRs:        []string{"a"},
+			expectCRD:        true,
+			expectAPIService: true,
+		},
+		{
+			name:             "RemovesFinalizer",
+			csv:              operatorCSV("operator.v1", "", false, CSVCleanupFinalizer),
+			done:             true,
+			expectCRD:        true,
+			expectAPIService: true,
+		},
+		{
+			name:             "DeletionWithoutFinalizer",
+			csv:              deleted(operatorCSV("operator.v1", "", true)),
+			crs:              []runtime.Object{widget("a", false)},
+			expectCRs:        []string{"a"},
+			expectCRD:        true,
+			expectAPIService: true,
+		},
+		{
+			name: "DeletesOwnedAPIs",
+			csv:  deleted(operatorCSV("operator.v1", "", true, CSVCleanupFinalizer)),
+			crs:  []runtime.Object{widget("a", false), widget("b", false)},
+			done: true,
+		},
+		{
+			name: "DeletesOwnedAPIsAtStorageVersion",
+			csv:  ownedAt("v1alpha1", deleted(operatorCSV("operator.v1", "", true, CSVCleanupFinalizer))),
+			crs:  []runtime.Object{widget("a", false)},
+			done: true,
+		},
+		{
+			name:              "WaitsForFinalizers",
+			csv:               deleted(operatorCSV("operator.v1", "", true, CSVCleanupFinalizer)),
+			crs:               []runtime.Object{widget("a", false), widget("b", true)},
+			done:              true,
+			expectFinalizer:   true,
+			expectCRs:         []string{"b"},
+			expectCRD:         true,
+			expectAPIService:  true,
+			expectPending:     []v1alpha1.ResourceList{{Group: "example.com", Kind: "Widget", Instances: []v1alpha1.ResourceInstance{{Name: "b", Namespace: namespace}}}},
+			expectStateReason: v1alpha1.CSVReasonWaitingForCleanupToComplete,
+		},
+		{
+			name:             "BeingReplaced",
+			csv:              deleted(operatorCSV("operator.v1", "", true, CSVCleanupFinalizer)),
+			clientObjs:       []runtime.Object{operatorCSV("operator.v2", "operator.v1", true)},
+			crs:              []runtime.Object{widget("a", false)},
+			done:             true,
+			expectCRs:        []string{"a"},
+			expectCRD:        true,
+			expectAPIService: true,
+		},
+		{
+			name:       "CRDOwnedByAnotherCSV",
+			csv:        deleted(operatorCSV("operator.v1", "", true, CSVCleanupFinalizer)),
+			clientObjs: []runtime.Object{operatorCSV("other.v1", "", false)},
+			crs:        []runtime.Object{widget("a", false)},
+			done:       true,
+			expectCRD:  true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.TODO())
+			defer cancel()
+
+			dynamicClient := fakedynamic.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{gvr: "WidgetList"}, tt.crs...)
+			op, err := NewFakeOperator(ctx,
+				withNamespaces(namespace),
+				withClientObjs(append(tt.clientObjs, tt.csv)...),
+				withExtObjs(widgetCRD()),
+				withRegObjs(ownedAPIService),
+				withDynamicClient(dynamicClient),
+			)
+			require.NoError(t, err)
+
+			done, err := op.syncCleanup(logrus.NewEntry(op.logger), tt.csv.DeepCopy())
+			require.NoError(t, err)
+			require.Equal(t, tt.done, done)
+
+			out, err := op.client.OperatorsV1alpha1().ClusterServiceVersions(namespace).Get(ctx, tt.csv.GetName(), metav1.GetOptions{})
+			require.NoError(t, err)
+			require.Equal(t, tt.expectFinalizer, len(out.GetFinalizers()) > 0)
+			require.Equal(t, tt.expectPending, out.Status.Cleanup.PendingDeletion)
+			if tt.expectStateReason != "" {
+				require.Equal(t, tt.expectStateReason, out.Status.Reason)
+			}
+
+			crs, err := dynamicClient.Resource(gvr).Namespace(namespace).List(ctx, metav1.ListOptions{})
+			require.NoError(t, err)
+			var names []string
+			for _, cr := range crs.Items {
+				names = append(names, cr.GetName())
+			}
+			require.ElementsMatch(t, tt.expectCRs, names)
+
+			_, err = op.opClient.ApiextensionsInterface().ApiextensionsV1().CustomResourceDefinitions().Get(ctx, widgetCRD().GetName(), metav1.GetOptions{})
+			require.Equal(t, tt.expectCRD, err == nil, "unexpected crd presence: %v", err)
+			require.True(t, err == nil || k8serrors.IsNotFound(err))
+
+			_, err = op.opClient.ApiregistrationV1Interface().ApiregistrationV1().APIServices().Get(ctx, ownedAPIService.GetName(), metav1.GetOptions{})
+			require.Equal(t, tt.expectAPIService, err == nil, "unexpected apiservice presence: %v", err)
+		})
+	}
+}
+
+func TestServedVersion(t *testing.T) {
+	versions := func(versions ...apiextensionsv1.CustomResourceDefinitionVersion) *apiextensionsv1.CustomResourceDefinition {
+		return &apiextensionsv1.CustomResourceDefinition{Spec: apiextensionsv1.CustomResourceDefinitionSpec{Versions: versions}}
+	}
+	require.Equal(t, "v1", servedVersion(versions(
+		apiextensionsv1.CustomResourceDefinitionVersion{Name: "v1alpha1", Served: true},
+		apiextensionsv1.CustomResourceDefinitionVersion{Name: "v1", Served: true, Storage: true},
+	)))
+	require.Equal(t, "v1beta1", servedVersion(versions(
+		apiextensionsv1.CustomResourceDefinitionVersion{Name: "v1alpha1", Storage: true},
+		apiextensionsv1.CustomResourceDefinitionVersion{Name: "v1beta1", Served: true},
+	)))
+	require.Empty(t, servedVersion(versions(apiextensionsv1.CustomResourceDefinitionVersion{Name: "v1", Storage: true})))
+}
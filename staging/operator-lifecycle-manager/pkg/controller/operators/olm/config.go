@@ -9,6 +9,7 @@ import (
 	"github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilclock "k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/rest"
 
 	configv1client "github.com/openshift/client-go/config/clientset/versioned"
@@ -33,6 +34,7 @@ type operatorConfig struct {
 	apiLabeler        labeler.Labeler
 	restConfig        *rest.Config
 	configClient      configv1client.Interface
+	dynamicClient     dynamic.Interface
 }
 
 func (o *operatorConfig) apply(options []OperatorOption) {
@@ -159,3 +161,9 @@ func WithConfigClient(configClient configv1client.Interface) OperatorOption {
 		config.configClient = configClient
 	}
 }
+
+func WithDynamicClient(dynamicClient dynamic.Interface) OperatorOption {
+	return func(config *operatorConfig) {
+		config.dynamicClient = dynamicClient
+	}
+}
@@ -0,0 +1,350 @@
+package olm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+)
+
+const (
+	// defaultHealthCheckDeadline is how long the health checks of an upgraded CSV have to pass when they don't set a
+	// deadline.
+	defaultHealthCheckDeadline = 5 * time.Minute
+
+	// healthCheckRequeueDelay is how long to wait before running failing health checks again.
+	healthCheckRequeueDelay = 10 * time.Second
+
+	// healthCheckHTTPTimeout bounds the requests made by HTTP health checks.
+	healthCheckHTTPTimeout = 5 * time.Second
+)
+
+// errHealthCheckPending is the result of an HTTP health check until its first request completes.
+var errHealthCheckPending = errors.New("health check pending")
+
+// healthChecksFor returns the health checks of a CSV. The checks configured by the Subscription that installed the
+// CSV take precedence over the ones declared by the CSV.
+func (a *Operator) healthChecksFor(csv *v1alpha1.ClusterServiceVersion) *v1alpha1.HealthChecks {
+	subs, err := a.lister.OperatorsV1alpha1().SubscriptionLister().Subscriptions(csv.GetNamespace()).List(labels.Everything())
+	if err != nil {
+		a.logger.WithError(err).Debugf("failed to list subscriptions in namespace %s", csv.GetNamespace())
+	}
+	for _, sub := range subs {
+		if sub.Status.InstalledCSV != csv.GetName() && sub.Status.CurrentCSV != csv.GetName() {
+			continue
+		}
+		if sub.Spec != nil && sub.Spec.Config != nil && sub.Spec.Config.HealthChecks != nil {
+			return sub.Spec.Config.HealthChecks
+		}
+		break
+	}
+	if csv.Spec.HealthChecks != nil && len(csv.Spec.HealthChecks.Checks) > 0 {
+		return csv.Spec.HealthChecks
+	}
+	return nil
+}
+
+// isHealthVerified reports whether a CSV has no health checks to run, or its health checks have passed.
+func (a *Operator) isHealthVerified(csv *v1alpha1.ClusterServiceVersion) bool {
+	if csv.Status.HealthChecks != nil && csv.Status.HealthChecks.Passed != nil {
+		return true
+	}
+	checks := a.healthChecksFor(csv)
+	return checks == nil || len(checks.Checks) == 0
+}
+
+// checkUpgradeHealth runs the health checks of a succeeded CSV that replaces another one, until they pass or their
+// deadline expires. If they pass, the replaced CSV is free to be deleted; if the deadline expires, the replaced CSV's
+// deployments are restored and the upgrade is marked failed. An error is returned if the deployments couldn't be
+// restored, in which case the CSV is left as it is so that the restore is retried.
+func (a *Operator) checkUpgradeHealth(logger *logrus.Entry, csv *v1alpha1.ClusterServiceVersion) error {
+	if csv.Status.HealthChecks != nil && csv.Status.HealthChecks.Passed != nil {
+		return nil
+	}
+	checks := a.healthChecksFor(csv)
+	if checks == nil || len(checks.Checks) == 0 {
+		return nil
+	}
+	previous := a.isReplacing(csv)
+	if previous == nil {
+		return nil
+	}
+
+	now := a.now()
+	results := make([]v1alpha1.HealthCheckResult, 0, len(checks.Checks))
+	var failing []string
+	for _, check := range checks.Checks {
+		result := v1alpha1.HealthCheckResult{Name: check.Name, Healthy: true}
+		if err := a.runHealthCheck(csv, check); err != nil {
+			result.Healthy = false
+			result.Message = err.Error()
+			failing = append(failing, check.Name)
+		}
+		results = append(results, result)
+	}
+	status := &v1alpha1.HealthCheckStatus{Results: results}
+	if len(failing) == 0 {
+		status.Passed = now
+	}
+	if !equalHealthCheckStatus(csv.Status.HealthChecks, status) {
+		csv.Status.HealthChecks = status
+		// status changes are only written when the update time changes
+		csv.Status.LastUpdateTime = now
+	}
+
+	if len(failing) == 0 {
+		logger.WithField("replaces", previous.GetName()).Info("upgrade health checks passed")
+		if err := a.csvQueueSet.Requeue(previous.GetNamespace(), previous.GetName()); err != nil {
+			logger.Warn(err.Error())
+		}
+		return nil
+	}
+
+	deadline := defaultHealthCheckDeadline
+	if checks.Deadline != nil {
+		deadline = checks.Deadline.Duration
+	}
+	if csv.Status.LastTransitionTime == nil || a.now().Sub(csv.Status.LastTransitionTime.Time) < deadline {
+		logger.WithField("failing", failing).Debug("waiting for upgrade health checks")
+		if err := a.csvQueueSet.RequeueAfter(csv.GetNamespace(), csv.GetName(), healthCheckRequeueDelay); err != nil {
+			logger.Warn(err.Error())
+		}
+		return nil
+	}
+
+	logger.WithField("failing", failing).Warn("upgrade health checks failed, restoring replaced csv")
+	if err := a.restoreDeployments(previous, csv); err != nil {
+		return fmt.Errorf("health checks %s did not pass within %s, failed to restore %s: %v", strings.Join(failing, ", "), deadline, previous.GetName(), err)
+	}
+	csv.SetPhaseWithEvent(v1alpha1.CSVPhaseFailed, v1alpha1.CSVReasonUpgradeHealthChecksFailed, fmt.Sprintf("health checks %s did not pass within %s, restored %s", strings.Join(failing, ", "), deadline, previous.GetName()), now, a.recorder)
+	return nil
+}
+
+// restoreDeployments reinstalls the deployments of a replaced CSV, and deletes the deployments of the failed CSV
+// that replaced it that the replaced CSV doesn't restore.
+func (a *Operator) restoreDeployments(csv, failed *v1alpha1.ClusterServiceVersion) error {
+	out := csv.DeepCopy()
+	installer, strategy := a.parseStrategiesAndUpdateStatus(out)
+	if strategy == nil {
+		return fmt.Errorf("unable to resolve the install strategy")
+	}
+	strategy, err := a.updateDeploymentSpecsWithApiServiceData(out, strategy)
+	if err != nil {
+		return err
+	}
+	if err := installer.Install(strategy); err != nil {
+		return err
+	}
+
+	restored := map[string]struct{}{}
+	if details, ok := strategy.(*v1alpha1.StrategyDetailsDeployment); ok {
+		for _, spec := range details.DeploymentSpecs {
+			restored[spec.Name] = struct{}{}
+		}
+	}
+	failedStrategy, err := a.resolver.UnmarshalStrategy(failed.Spec.InstallStrategy)
+	if err != nil {
+		return err
+	}
+	failedDetails, ok := failedStrategy.(*v1alpha1.StrategyDetailsDeployment)
+	if !ok {
+		return nil
+	}
+	for _, spec := range failedDetails.DeploymentSpecs {
+		if _, ok := restored[spec.Name]; ok {
+			continue
+		}
+		if err := a.opClient.DeleteDeployment(failed.GetNamespace(), spec.Name, &metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// runHealthCheck returns an error describing why a health check doesn't pass, or nil if it does.
+func (a *Operator) runHealthCheck(csv *v1alpha1.ClusterServiceVersion, check v1alpha1.HealthCheck) error {
+	if err := check.Validate(); err != nil {
+		return err
+	}
+	switch {
+	case check.OperatorCondition != nil:
+		return a.checkOperatorConditionHealth(csv, check.OperatorCondition)
+	case check.HTTP != nil:
+		return a.checkHTTPHealth(csv, check.HTTP)
+	case check.CustomResource != nil:
+		return a.checkCustomResourceHealth(csv, check.CustomResource)
+	}
+	return fmt.Errorf("no probe set")
+}
+
+func (a *Operator) checkOperatorConditionHealth(csv *v1alpha1.ClusterServiceVersion, check *v1alpha1.OperatorConditionHealthCheck) error {
+	cond, err := a.lister.OperatorsV2().OperatorConditionLister().OperatorConditions(csv.GetNamespace()).Get(csv.GetName())
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return fmt.Errorf("operatorcondition %s not found", csv.GetName())
+		}
+		return err
+	}
+	// overrides take precedence over the conditions set by the operator
+	c := meta.FindStatusCondition(cond.Spec.Overrides, check.Type)
+	if c == nil {
+		c = meta.FindStatusCondition(cond.Spec.Conditions, check.Type)
+	}
+	if c == nil {
+		return fmt.Errorf("condition %s not found", check.Type)
+	}
+	return expectConditionStatus(check.Type, string(c.Status), check.Status)
+}
+
+func (a *Operator) checkHTTPHealth(csv *v1alpha1.ClusterServiceVersion, check *v1alpha1.HTTPHealthCheck) error {
+	path := check.Path
+	if path == "" {
+		path = "/"
+	} else if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return a.httpHealthChecks.check(fmt.Sprintf("http://%s.%s.svc:%d%s", check.Service, csv.GetNamespace(), check.Port, path))
+}
+
+// httpHealthChecks requests the endpoints of HTTP health checks in the background, so that slow endpoints don't
+// block CSV syncs. The result of a check is the outcome of its latest request.
+type httpHealthChecks struct {
+	client *http.Client
+	now    func() time.Time
+
+	mu      sync.Mutex
+	results map[string]*httpHealthCheckResult
+}
+
+type httpHealthCheckResult struct {
+	running bool
+	checked time.Time
+	err     error
+}
+
+func newHTTPHealthChecks(client *http.Client) *httpHealthChecks {
+	return &httpHealthChecks{
+		client:  client,
+		now:     time.Now,
+		results: map[string]*httpHealthCheckResult{},
+	}
+}
+
+// check returns the result of the latest request to url, and requests it again in the background if that result
+// is older than healthCheckRequeueDelay.
+func (h *httpHealthChecks) check(url string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := h.now()
+	for key, result := range h.results {
+		// forget the endpoints of checks that stopped running
+		if !result.running && now.Sub(result.checked) > defaultHealthCheckDeadline {
+			delete(h.results, key)
+		}
+	}
+
+	result, ok := h.results[url]
+	if !ok {
+		result = &httpHealthCheckResult{err: errHealthCheckPending}
+		h.results[url] = result
+	}
+	if !result.running && (!ok || now.Sub(result.checked) >= healthCheckRequeueDelay) {
+		result.running = true
+		go h.request(url, result)
+	}
+	return result.err
+}
+
+func (h *httpHealthChecks) request(url string, result *httpHealthCheckResult) {
+	err := func() error {
+		ctx, cancel := context.WithTimeout(context.TODO(), healthCheckHTTPTimeout)
+		defer cancel()
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return err
+		}
+		resp, err := h.client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			return fmt.Errorf("GET %s returned %s", url, resp.Status)
+		}
+		return nil
+	}()
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	result.running, result.checked, result.err = false, h.now(), err
+}
+
+func (a *Operator) checkCustomResourceHealth(csv *v1alpha1.ClusterServiceVersion, check *v1alpha1.CustomResourceHealthCheck) error {
+	gvr := schema.GroupVersionResource{Group: check.Group, Version: check.Version, Resource: check.Resource}
+	namespace := check.Namespace
+	if namespace == "" {
+		namespace = csv.GetNamespace()
+	}
+	cr, err := a.dynamicClient.Resource(gvr).Namespace(namespace).Get(context.TODO(), check.Name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		// the resource may be cluster-scoped
+		cr, err = a.dynamicClient.Resource(gvr).Get(context.TODO(), check.Name, metav1.GetOptions{})
+	}
+	if err != nil {
+		return err
+	}
+	conditions, _, err := unstructured.NestedSlice(cr.Object, "status", "conditions")
+	if err != nil {
+		return err
+	}
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok || condition["type"] != check.ConditionType {
+			continue
+		}
+		status, _ := condition["status"].(string)
+		return expectConditionStatus(check.ConditionType, status, check.Status)
+	}
+	return fmt.Errorf("condition %s not found", check.ConditionType)
+}
+
+// expectConditionStatus returns an error if a condition doesn't have the expected status, which defaults to True.
+func expectConditionStatus(conditionType, status string, expected metav1.ConditionStatus) error {
+	if expected == "" {
+		expected = metav1.ConditionTrue
+	}
+	if status != string(expected) {
+		return fmt.Errorf("condition %s is %s, expected %s", conditionType, status, expected)
+	}
+	return nil
+}
+
+// equalHealthCheckStatus compares the results of two health check runs, ignoring when they passed.
+func equalHealthCheckStatus(a, b *v1alpha1.HealthCheckStatus) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if (a.Passed == nil) != (b.Passed == nil) || len(a.Results) != len(b.Results) {
+		return false
+	}
+	for i := range a.Results {
+		if a.Results[i] != b.Results[i] {
+			return false
+		}
+	}
+	return true
+}
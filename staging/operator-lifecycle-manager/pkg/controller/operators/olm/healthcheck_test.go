@@ -0,0 +1,288 @@
+package olm
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	v1 "github.com/operator-framework/api/pkg/operators/v1"
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	operatorsv2 "github.com/operator-framework/api/pkg/operators/v2"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/lib/ownerutil"
+)
+
+// redirectTransport sends every request to a test server.
+type redirectTransport struct {
+	target *url.URL
+}
+
+func (t redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req.URL.Scheme = t.target.Scheme
+	req.URL.Host = t.target.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestCheckUpgradeHealth(t *testing.T) {
+	namespace := "ns"
+	gvr := schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/healthz" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+	target, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	conditionCheck := v1alpha1.HealthCheck{Name: "ready", OperatorCondition: &v1alpha1.OperatorConditionHealthCheck{Type: "Ready"}}
+	httpCheck := func(path string) v1alpha1.HealthCheck {
+		return v1alpha1.HealthCheck{Name: "http", HTTP: &v1alpha1.HTTPHealthCheck{Service: "operator", Port: 8080, Path: path}}
+	}
+	crCheck := v1alpha1.HealthCheck{Name: "widget", CustomResource: &v1alpha1.CustomResourceHealthCheck{Group: "example.com", Version: "v1", Resource: "widgets", Name: "default", ConditionType: "Available"}}
+
+	operatorGroup := &v1.OperatorGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "og", Namespace: namespace},
+		Spec:       v1.OperatorGroupSpec{TargetNamespaces: []string{namespace}},
+		Status:     v1.OperatorGroupStatus{Namespaces: []string{namespace}},
+	}
+	oldCSV := func() *v1alpha1.ClusterServiceVersion {
+		return csv("operator.v1", namespace, "0.0.0", "", installStrategy("dep", nil, nil), nil, nil, v1alpha1.CSVPhaseReplacing)
+	}
+	newCSV := func(transitioned time.Time, checks ...v1alpha1.HealthCheck) *v1alpha1.ClusterServiceVersion {
+		strategy := installStrategy("dep", nil, nil)
+		strategy.StrategySpec.DeploymentSpecs[0].Spec.Template.Spec.Containers[0].Image = "nginx:1.8.0"
+		strategy.StrategySpec.DeploymentSpecs = append(strategy.StrategySpec.DeploymentSpecs, v1alpha1.StrategyDeploymentSpec{Name: "dep-v2"})
+		out := csv("operator.v2", namespace, "0.0.0", "operator.v1", strategy, nil, nil, v1alpha1.CSVPhaseSucceeded)
+		if len(checks) > 0 {
+			out.Spec.HealthChecks = &v1alpha1.HealthChecks{Checks: checks, Deadline: &metav1.Duration{Duration: time.Minute}}
+		}
+		ltt := metav1.NewTime(transitioned)
+		out.Status.LastTransitionTime = &ltt
+		return out
+	}
+	operatorCondition := func(status metav1.ConditionStatus) *operatorsv2.OperatorCondition {
+		return &operatorsv2.OperatorCondition{
+			ObjectMeta: metav1.ObjectMeta{Name: "operator.v2", Namespace: namespace},
+			Spec: operatorsv2.OperatorConditionSpec{
+				Conditions: []metav1.Condition{{Type: "Ready", Status: status}},
+			},
+		}
+	}
+	widget := func(status string) *unstructured.Unstructured {
+		cr := &unstructured.Unstructured{}
+		cr.SetAPIVersion("example.com/v1")
+		cr.SetKind("Widget")
+		cr.SetName("default")
+		cr.SetNamespace(namespace)
+		require.NoError(t, unstructured.SetNestedSlice(cr.Object, []interface{}{map[string]interface{}{"type": "Available", "status": status}}, "status", "conditions"))
+		return cr
+	}
+	subscription := func(checks ...v1alpha1.HealthCheck) *v1alpha1.Subscription {
+		return &v1alpha1.Subscription{
+			ObjectMeta: metav1.ObjectMeta{Name: "operator", Namespace: namespace},
+			Spec: &v1alpha1.SubscriptionSpec{
+				Config: &v1alpha1.SubscriptionConfig{HealthChecks: &v1alpha1.HealthChecks{Checks: checks}},
+			},
+			Status: v1alpha1.SubscriptionStatus{CurrentCSV: "operator.v2", InstalledCSV: "operator.v2"},
+		}
+	}
+
+	for _, tt := range []struct {
+		name          string
+		csv           *v1alpha1.ClusterServiceVersion
+		clientObjs    []runtime.Object
+		crs           []runtime.Object
+		expectResults []v1alpha1.HealthCheckResult
+		expectPassed  bool
+		expectFailed  bool
+		restoreFails  bool
+	}{
+		{
+			name:         "NoChecks",
+			csv:          newCSV(time.Now()),
+			expectPassed: true,
+		},
+		{
+			name:          "OperatorConditionPasses",
+			csv:           newCSV(time.Now(), conditionCheck),
+			clientObjs:    []runtime.Object{operatorCondition(metav1.ConditionTrue)},
+			expectResults: []v1alpha1.HealthCheckResult{{Name: "ready", Healthy: true}},
+			expectPassed:  true,
+		},
+		{
+			name:          "OperatorConditionFails",
+			csv:           newCSV(time.Now(), conditionCheck),
+			clientObjs:    []runtime.Object{operatorCondition(metav1.ConditionFalse)},
+			expectResults: []v1alpha1.HealthCheckResult{{Name: "ready", Message: "condition Ready is False, expected True"}},
+		},
+		{
+			name:          "HTTPPasses",
+			csv:           newCSV(time.Now(), httpCheck("healthz")),
+			expectResults: []v1alpha1.HealthCheckResult{{Name: "http", Healthy: true}},
+			expectPassed:  true,
+		},
+		{
+			name:          "HTTPFails",
+			csv:           newCSV(time.Now(), httpCheck("broken")),
+			expectResults: []v1alpha1.HealthCheckResult{{Name: "http", Message: "GET http://operator.ns.svc:8080/broken returned 503 Service Unavailable"}},
+		},
+		{
+			name:          "InvalidCheck",
+			csv:           newCSV(time.Now(), v1alpha1.HealthCheck{Name: "none"}),
+			expectResults: []v1alpha1.HealthCheckResult{{Name: "none", Message: "health check none must set exactly one of operatorCondition, http and customResource"}},
+		},
+		{
+			name:          "CustomResourcePasses",
+			csv:           newCSV(time.Now(), crCheck),
+			crs:           []runtime.Object{widget("True")},
+			expectResults: []v1alpha1.HealthCheckResult{{Name: "widget", Healthy: true}},
+			expectPassed:  true,
+		},
+		{
+			name:          "SubscriptionOverridesChecks",
+			csv:           newCSV(time.Now(), conditionCheck),
+			clientObjs:    []runtime.Object{subscription(crCheck)},
+			crs:           []runtime.Object{widget("False")},
+			expectResults: []v1alpha1.HealthCheckResult{{Name: "widget", Message: "condition Available is False, expected True"}},
+		},
+		{
+			name:          "DeadlineExceeded",
+			csv:           newCSV(time.Now().Add(-2*time.Minute), crCheck),
+			crs:           []runtime.Object{widget("False")},
+			expectResults: []v1alpha1.HealthCheckResult{{Name: "widget", Message: "condition Available is False, expected True"}},
+			expectFailed:  true,
+		},
+		{
+			name:          "RestoreRetried",
+			csv:           newCSV(time.Now().Add(-2*time.Minute), crCheck),
+			crs:           []runtime.Object{widget("False")},
+			expectResults: []v1alpha1.HealthCheckResult{{Name: "widget", Message: "condition Available is False, expected True"}},
+			expectFailed:  true,
+			restoreFails:  true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.TODO())
+			defer cancel()
+
+			dynamicClient := fakedynamic.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{gvr: "WidgetList"}, tt.crs...)
+			op, err := NewFakeOperator(ctx,
+				withNamespaces(namespace),
+				withClientObjs(append(tt.clientObjs, operatorGroup, oldCSV(), tt.csv)...),
+				withDynamicClient(dynamicClient),
+			)
+			require.NoError(t, err)
+			op.httpHealthChecks = newHTTPHealthChecks(&http.Client{Transport: redirectTransport{target: target}})
+			if checks := op.healthChecksFor(tt.csv); checks != nil {
+				for _, check := range checks.Checks {
+					if check.HTTP == nil {
+						continue
+					}
+					// wait for the background request of the check
+					require.Eventually(t, func() bool {
+						return op.checkHTTPHealth(tt.csv, check.HTTP) != errHealthCheckPending
+					}, 5*time.Second, 10*time.Millisecond)
+				}
+			}
+			// the upgrade added a deployment
+			_, err = op.opClient.KubernetesInterface().AppsV1().Deployments(namespace).Create(ctx, &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "dep-v2", Namespace: namespace}}, metav1.CreateOptions{})
+			require.NoError(t, err)
+
+			failRestore := tt.restoreFails
+			op.opClient.KubernetesInterface().(*k8sfake.Clientset).PrependReactor("delete", "deployments", func(clienttesting.Action) (bool, runtime.Object, error) {
+				if failRestore {
+					return true, nil, errors.New("unavailable")
+				}
+				return false, nil, nil
+			})
+
+			out := tt.csv.DeepCopy()
+			if tt.restoreFails {
+				// the upgrade isn't marked failed until its restore succeeds
+				require.Error(t, op.checkUpgradeHealth(logrus.NewEntry(op.logger), out))
+				require.Equal(t, v1alpha1.CSVPhaseSucceeded, out.Status.Phase)
+				failRestore = false
+			}
+			require.NoError(t, op.checkUpgradeHealth(logrus.NewEntry(op.logger), out))
+
+			if tt.expectResults == nil {
+				require.Nil(t, out.Status.HealthChecks)
+			} else {
+				require.NotNil(t, out.Status.HealthChecks)
+				require.Equal(t, tt.expectResults, out.Status.HealthChecks.Results)
+				require.Equal(t, tt.expectPassed, out.Status.HealthChecks.Passed != nil)
+			}
+			require.Equal(t, tt.expectPassed, op.isHealthVerified(out))
+
+			if !tt.expectFailed {
+				require.Equal(t, v1alpha1.CSVPhaseSucceeded, out.Status.Phase)
+				return
+			}
+			require.Equal(t, v1alpha1.CSVPhaseFailed, out.Status.Phase)
+			require.Equal(t, v1alpha1.CSVReasonUpgradeHealthChecksFailed, out.Status.Reason)
+
+			// the replaced csv's deployment is restored
+			dep, err := op.opClient.KubernetesInterface().AppsV1().Deployments(namespace).Get(ctx, "dep", metav1.GetOptions{})
+			require.NoError(t, err)
+			require.Equal(t, "operator.v1", dep.GetLabels()[ownerutil.OwnerKey])
+			require.Equal(t, "nginx:1.7.9", dep.Spec.Template.Spec.Containers[0].Image)
+
+			// the deployments the replaced csv doesn't restore are removed
+			_, err = op.opClient.KubernetesInterface().AppsV1().Deployments(namespace).Get(ctx, "dep-v2", metav1.GetOptions{})
+			require.True(t, k8serrors.IsNotFound(err))
+		})
+	}
+}
+
+func TestHTTPHealthChecks(t *testing.T) {
+	requests := make(chan struct{}, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests <- struct{}{}
+	}))
+	defer server.Close()
+
+	checks := newHTTPHealthChecks(server.Client())
+	now := time.Date(2021, 3, 6, 3, 0, 0, 0, time.UTC)
+	checks.now = func() time.Time { return now }
+	wait := func() error {
+		var err error
+		require.Eventually(t, func() bool {
+			checks.mu.Lock()
+			defer checks.mu.Unlock()
+			result := checks.results[server.URL]
+			err = result.err
+			return !result.running
+		}, 5*time.Second, 10*time.Millisecond)
+		return err
+	}
+
+	// the first check is pending until its request completes
+	require.Equal(t, errHealthCheckPending, checks.check(server.URL))
+	require.NoError(t, wait())
+	require.Len(t, requests, 1)
+
+	// results are reused until they're older than the requeue delay
+	require.NoError(t, checks.check(server.URL))
+	require.Len(t, requests, 1)
+	now = now.Add(healthCheckRequeueDelay)
+	require.NoError(t, checks.check(server.URL))
+	require.NoError(t, wait())
+	require.Len(t, requests, 2)
+}
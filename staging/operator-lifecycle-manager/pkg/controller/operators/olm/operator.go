@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"reflect"
 	"strings"
 	"time"
 
@@ -22,6 +24,7 @@ import (
 	utilclock "k8s.io/apimachinery/pkg/util/clock"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/informers"
 	k8sscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/cache"
@@ -33,6 +36,7 @@ import (
 	"github.com/operator-framework/api/pkg/operators/v1alpha1"
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/clientset/versioned"
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/informers/externalversions"
+	operatorsv1listers "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/listers/operators/v1"
 	operatorsv1alpha1listers "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/listers/operators/v1alpha1"
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/certs"
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/install"
@@ -88,6 +92,12 @@ type Operator struct {
 	clientAttenuator      *scoped.ClientAttenuator
 	serviceAccountQuerier *scoped.UserDefinedServiceAccountQuerier
 	clientFactory         clients.Factory
+	dynamicClient         dynamic.Interface
+	httpHealthChecks      *httpHealthChecks
+	overrides             *overrides.DeploymentInitializer
+	proxyConfig           *v1.ProxyConfig
+	olmConfigLister       operatorsv1listers.OLMConfigLister
+	tenantPermissions     *tenantPermissions
 }
 
 func NewOperator(ctx context.Context, options ...OperatorOption) (*Operator, error) {
@@ -112,6 +122,15 @@ func newOperatorWithConfig(ctx context.Context, config *operatorConfig) (*Operat
 		return nil, err
 	}
 
+	if config.dynamicClient == nil {
+		// Create a new client for dynamic types (CRs)
+		dynamicClient, err := dynamic.NewForConfig(config.restConfig)
+		if err != nil {
+			return nil, err
+		}
+		config.dynamicClient = dynamicClient
+	}
+
 	lister := operatorlister.NewLister()
 
 	scheme := runtime.NewScheme()
@@ -144,6 +163,9 @@ func newOperatorWithConfig(ctx context.Context, config *operatorConfig) (*Operat
 		clientAttenuator:      scoped.NewClientAttenuator(config.logger, config.restConfig, config.operatorClient),
 		serviceAccountQuerier: scoped.NewUserDefinedServiceAccountQuerier(config.logger, config.externalClient),
 		clientFactory:         clients.NewFactory(config.restConfig),
+		dynamicClient:         config.dynamicClient,
+		httpHealthChecks:      newHTTPHealthChecks(&http.Client{}),
+		tenantPermissions:     newTenantPermissions(),
 	}
 
 	// Set up syncing for namespace-scoped resources
@@ -372,6 +394,24 @@ func newOperatorWithConfig(ctx context.Context, config *operatorConfig) (*Operat
 			return nil, err
 		}
 
+		// Register operator index QueueInformer
+		operatorIndexInformer := informers.NewSharedInformerFactoryWithOptions(op.opClient.KubernetesInterface(), config.resyncPeriod(), informers.WithNamespace(namespace), informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.LabelSelector = OperatorIndexLabelKey
+		})).Core().V1().ConfigMaps()
+		op.lister.CoreV1().RegisterConfigMapLister(namespace, operatorIndexInformer.Lister())
+		operatorIndexQueueInformer, err := queueinformer.NewQueueInformer(
+			ctx,
+			queueinformer.WithLogger(op.logger),
+			queueinformer.WithInformer(operatorIndexInformer.Informer()),
+			queueinformer.WithSyncer(queueinformer.LegacySyncHandler(op.syncOperatorIndex).ToSyncerWithDelete(op.requeueOperatorIndexOwner)),
+		)
+		if err != nil {
+			return nil, err
+		}
+		if err := op.RegisterQueueInformer(operatorIndexQueueInformer); err != nil {
+			return nil, err
+		}
+
 		// Register Service QueueInformer
 		serviceInformer := k8sInformerFactory.Core().V1().Services()
 		op.lister.CoreV1().RegisterServiceLister(namespace, serviceInformer.Lister())
@@ -453,6 +493,7 @@ func newOperatorWithConfig(ctx context.Context, config *operatorConfig) (*Operat
 	if err != nil {
 		return nil, err
 	}
+	op.olmConfigLister = operatorsv1listers.NewOLMConfigLister(olmConfigInformer.GetIndexer())
 	if err := op.RegisterQueueInformer(olmConfigQueueInformer); err != nil {
 		return nil, err
 	}
@@ -496,6 +537,7 @@ func newOperatorWithConfig(ctx context.Context, config *operatorConfig) (*Operat
 		&cache.ResourceEventHandlerFuncs{
 			DeleteFunc: op.namespaceAddedOrRemoved,
 			AddFunc:    op.namespaceAddedOrRemoved,
+			UpdateFunc: op.namespaceUpdated,
 		},
 	)
 	namespaceQueueInformer, err := queueinformer.NewQueueInformer(
@@ -580,9 +622,12 @@ func newOperatorWithConfig(ctx context.Context, config *operatorConfig) (*Operat
 		}
 	}
 
-	overridesBuilderFunc := overrides.NewDeploymentInitializer(op.logger, proxyQuerierInUse, op.lister)
+	// The proxy configured by the OLMConfig takes precedence over the cluster's
+	olmConfigProxyQuerier := proxy.NewOLMConfigQuerier(op.logger, op.olmConfigLister, proxyQuerierInUse)
+	op.overrides = overrides.NewDeploymentInitializer(op.logger, olmConfigProxyQuerier, op.lister)
 	op.resolver = &install.StrategyResolver{
-		OverridesBuilderFunc: overridesBuilderFunc.GetDeploymentInitializer,
+		OverridesBuilderFunc: op.overrides.GetDeploymentInitializer,
+		CertIssuerFunc:       op.certIssuerConfig,
 	}
 
 	return op, nil
@@ -871,7 +916,13 @@ func (a *Operator) namespaceAddedOrRemoved(obj interface{}) {
 	// Check to see if any operator groups are associated with this namespace
 	namespace, ok := obj.(*corev1.Namespace)
 	if !ok {
-		return
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			return
+		}
+		if namespace, ok = tombstone.Obj.(*corev1.Namespace); !ok {
+			return
+		}
 	}
 
 	logger := a.logger.WithFields(logrus.Fields{
@@ -885,7 +936,8 @@ func (a *Operator) namespaceAddedOrRemoved(obj interface{}) {
 	}
 
 	for _, group := range operatorGroupList {
-		if NewNamespaceSet(group.Status.Namespaces).Contains(namespace.GetName()) {
+		// Groups selecting the namespace track it as soon as it's created
+		if NewNamespaceSet(group.Status.Namespaces).Contains(namespace.GetName()) || operatorGroupSelects(group, namespace) {
 			if err := a.ogQueueSet.Requeue(group.Namespace, group.Name); err != nil {
 				logger.WithError(err).Warn("error requeuing operatorgroup")
 			}
@@ -894,6 +946,39 @@ func (a *Operator) namespaceAddedOrRemoved(obj interface{}) {
 	return
 }
 
+// namespaceUpdated requeues the operator groups whose target namespaces change with the namespace's labels.
+func (a *Operator) namespaceUpdated(oldObj, newObj interface{}) {
+	oldNamespace, ok := oldObj.(*corev1.Namespace)
+	if !ok {
+		return
+	}
+	namespace, ok := newObj.(*corev1.Namespace)
+	if !ok {
+		return
+	}
+	if labels.Equals(oldNamespace.GetLabels(), namespace.GetLabels()) {
+		return
+	}
+
+	logger := a.logger.WithFields(logrus.Fields{
+		"name": namespace.GetName(),
+	})
+
+	operatorGroupList, err := a.lister.OperatorsV1().OperatorGroupLister().OperatorGroups(metav1.NamespaceAll).List(labels.Everything())
+	if err != nil {
+		logger.WithError(err).Warn("lister failed")
+		return
+	}
+
+	for _, group := range operatorGroupList {
+		if NewNamespaceSet(group.Status.Namespaces).Contains(namespace.GetName()) != operatorGroupSelects(group, namespace) {
+			if err := a.ogQueueSet.Requeue(group.Namespace, group.Name); err != nil {
+				logger.WithError(err).Warn("error requeuing operatorgroup")
+			}
+		}
+	}
+}
+
 func (a *Operator) syncNamespace(obj interface{}) error {
 	// Check to see if any operator groups are associated with this namespace
 	namespace, ok := obj.(*corev1.Namespace)
@@ -969,6 +1054,7 @@ func (a *Operator) handleClusterServiceVersionDeletion(obj interface{}) {
 	})
 
 	metrics.DeleteCSVMetric(clusterServiceVersion)
+	a.tenantPermissions.forget(clusterServiceVersion)
 
 	if clusterServiceVersion.IsCopied() {
 		logger.Warning("deleted csv is copied. skipping additional cleanup steps") // should not happen?
@@ -1182,6 +1268,10 @@ func (a *Operator) syncClusterServiceVersion(obj interface{}) (syncError error)
 		return
 	}
 
+	if done, err := a.syncCleanup(logger, clusterServiceVersion); done || err != nil {
+		return err
+	}
+
 	outCSV, syncError := a.transitionCSVState(*clusterServiceVersion)
 
 	if outCSV == nil {
@@ -1236,7 +1326,9 @@ func (a *Operator) syncClusterServiceVersion(obj interface{}) (syncError error)
 	return
 }
 
-func (a *Operator) allNamespaceOperatorGroups() ([]*v1.OperatorGroup, error) {
+// copyingOperatorGroups returns the OperatorGroups targeting namespaces other than their own, whose operators are
+// copied into those namespaces when copied CSVs are enabled.
+func (a *Operator) copyingOperatorGroups() ([]*v1.OperatorGroup, error) {
 	operatorGroups, err := a.lister.OperatorsV1().OperatorGroupLister().List(labels.Everything())
 	if err != nil {
 		return nil, err
@@ -1244,8 +1336,11 @@ func (a *Operator) allNamespaceOperatorGroups() ([]*v1.OperatorGroup, error) {
 
 	result := []*v1.OperatorGroup{}
 	for _, operatorGroup := range operatorGroups {
-		if NewNamespaceSet(operatorGroup.Status.Namespaces).IsAllNamespaces() {
-			result = append(result, operatorGroup.DeepCopy())
+		for _, ns := range operatorGroup.Status.Namespaces {
+			if ns != operatorGroup.GetNamespace() {
+				result = append(result, operatorGroup.DeepCopy())
+				break
+			}
 		}
 	}
 	return result, nil
@@ -1258,8 +1353,8 @@ func (a *Operator) syncOLMConfig(obj interface{}) (syncError error) {
 		return fmt.Errorf("casting OLMConfig failed")
 	}
 
-	// Generate an array of allNamespace OperatorGroups
-	allNSOperatorGroups, err := a.allNamespaceOperatorGroups()
+	// Generate an array of OperatorGroups whose operators may be copied
+	operatorGroups, err := a.copyingOperatorGroups()
 	if err != nil {
 		return err
 	}
@@ -1270,7 +1365,7 @@ func (a *Operator) syncOLMConfig(obj interface{}) (syncError error) {
 	}
 
 	csvIsRequeued := false
-	for _, og := range allNSOperatorGroups {
+	for _, og := range operatorGroups {
 		// Get all copied CSVs owned by this operatorGroup
 		copiedCSVRequirement, err := labels.NewRequirement(v1alpha1.CopiedLabelKey, selection.Equals, []string{og.GetNamespace()})
 		if err != nil {
@@ -1294,6 +1389,11 @@ func (a *Operator) syncOLMConfig(obj interface{}) (syncError error) {
 		}
 
 		for _, csv := range csvs {
+			// Neither copied nor indexed
+			if csv.IsUncopiable() || csv.GetAnnotations()[v1.OperatorGroupAnnotationKey] != og.GetName() {
+				continue
+			}
+
 			// If the correct number of copied CSVs were found, continue
 			if _, ok := uniqueCopiedCSVs[csv.GetName()]; ok == olmConfig.CopiedCSVsAreEnabled() {
 				continue
@@ -1306,6 +1406,20 @@ func (a *Operator) syncOLMConfig(obj interface{}) (syncError error) {
 		}
 	}
 
+	// Deployments are updated with the proxy configuration when their CSV is synced
+	if !reflect.DeepEqual(olmConfig.ProxyConfig(), a.proxyConfig) {
+		csvs, err := a.lister.OperatorsV1alpha1().ClusterServiceVersionLister().List(labels.NewSelector().Add(*nonCopiedCSVRequirement))
+		if err != nil {
+			return err
+		}
+		for _, csv := range csvs {
+			if err := a.csvQueueSet.Requeue(csv.GetNamespace(), csv.GetName()); err != nil {
+				a.logger.WithError(err).Warn("unable to requeue")
+			}
+		}
+		a.proxyConfig = olmConfig.ProxyConfig().DeepCopy()
+	}
+
 	// Update the olmConfig status if it has changed.
 	condition := getCopiedCSVsCondition(!olmConfig.CopiedCSVsAreEnabled(), csvIsRequeued)
 	if !isStatusConditionPresentAndAreTypeReasonMessageStatusEqual(olmConfig.Status.Conditions, condition) {
@@ -1346,13 +1460,13 @@ func getCopiedCSVsCondition(isDisabled, csvIsRequeued bool) metav1.Condition {
 
 	if csvIsRequeued {
 		condition.Reason = "CopiedCSVsFound"
-		condition.Message = "Copied CSVs are disabled and at least one copied CSV was found for an operator installed in AllNamespace mode"
+		condition.Message = "Copied CSVs are disabled and at least one copied CSV was found"
 		return condition
 	}
 
 	condition.Status = metav1.ConditionTrue
 	condition.Reason = "NoCopiedCSVsFound"
-	condition.Message = "Copied CSVs are disabled and none were found for operators installed in AllNamespace mode"
+	condition.Message = "Copied CSVs are disabled and none were found"
 
 	return condition
 }
@@ -1401,18 +1515,23 @@ func (a *Operator) syncCopyCSV(obj interface{}) (syncError error) {
 
 	// Check if we need to do any copying / annotation for the operatorgroup
 	namespaceSet := NewNamespaceSet(operatorGroup.Status.Namespaces)
-	if copiedCSVsAreEnabled || !namespaceSet.IsAllNamespaces() {
+	if copiedCSVsAreEnabled {
+		// Remove the indexes listing the operatorgroup's operators while copied CSVs were disabled
+		if _, err := a.syncOperatorIndexes(operatorGroup); err != nil {
+			return err
+		}
+
 		if err := a.ensureCSVsInNamespaces(clusterServiceVersion, operatorGroup, namespaceSet); err != nil {
 			logger.WithError(err).Info("couldn't copy CSV to target namespaces")
 			syncError = err
+		} else {
+			a.requeueUnconvergedOperatorGroup(logger, operatorGroup)
 		}
 
-		// If the CSV was installed in AllNamespace mode, remove any "CSV Copying Disabled" events
-		// in which the related object's name, namespace, and uid match the given CSV's.
-		if namespaceSet.IsAllNamespaces() {
-			if err := a.deleteCSVCopyingDisabledEvent(clusterServiceVersion); err != nil {
-				return err
-			}
+		// Remove any "CSV Copying Disabled" events in which the related object's name, namespace,
+		// and uid match the given CSV's.
+		if err := a.deleteCSVCopyingDisabledEvent(clusterServiceVersion); err != nil {
+			return err
 		}
 		return
 	}
@@ -1434,6 +1553,17 @@ func (a *Operator) syncCopyCSV(obj interface{}) (syncError error) {
 		}
 	}
 
+	// Operators in groups targeting specific namespaces are listed by indexes in those namespaces instead, and still
+	// need permissions there
+	indexes, err := a.syncOperatorIndexes(operatorGroup)
+	if err != nil {
+		return err
+	}
+	if err := a.ensureTenantRBACInIndexedNamespaces(clusterServiceVersion, indexes); err != nil {
+		return err
+	}
+	a.requeueUnconvergedOperatorGroup(logger, operatorGroup)
+
 	if err := a.createCSVCopyingDisabledEvent(clusterServiceVersion); err != nil {
 		return err
 	}
@@ -1441,11 +1571,23 @@ func (a *Operator) syncCopyCSV(obj interface{}) (syncError error) {
 	return
 }
 
+// requeueUnconvergedOperatorGroup requeues the operatorgroup if the convergence of its target namespaces changed, to
+// report it without waiting for the next resync of the operatorgroup.
+func (a *Operator) requeueUnconvergedOperatorGroup(logger *logrus.Entry, operatorGroup *v1.OperatorGroup) {
+	namespaceStatuses, err := a.namespaceStatuses(operatorGroup, operatorGroup.Status.Namespaces)
+	if err != nil || reflect.DeepEqual(namespaceStatuses, operatorGroup.Status.NamespaceStatuses) {
+		return
+	}
+	if err := a.ogQueueSet.Requeue(operatorGroup.GetNamespace(), operatorGroup.GetName()); err != nil {
+		logger.WithError(err).Warn("error requeuing operatorgroup")
+	}
+}
+
 // copiedCSVsAreEnabled determines if csv copying is enabled for OLM.
 //
-// This method will first attempt to get the "cluster" olmConfig resource,
-// if any error other than "IsNotFound" is encountered, false and the error
-// will be returned.
+// This method will first attempt to get the "cluster" olmConfig resource
+// from the cache, if any error other than "IsNotFound" is encountered, false
+// and the error will be returned.
 //
 // If the "cluster" olmConfig resource is found, the value of
 // olmConfig.spec.features.disableCopiedCSVs will be returned along with a
@@ -1454,7 +1596,7 @@ func (a *Operator) syncCopyCSV(obj interface{}) (syncError error) {
 // If the "cluster" olmConfig resource is not found, true will be returned
 // without an error.
 func (a *Operator) copiedCSVsAreEnabled() (bool, error) {
-	olmConfig, err := a.client.OperatorsV1().OLMConfigs().Get(context.TODO(), "cluster", metav1.GetOptions{})
+	olmConfig, err := a.olmConfigLister.Get("cluster")
 	if err != nil {
 		// Default to true if olmConfig singleton cannot be found
 		if k8serrors.IsNotFound(err) {
@@ -1468,6 +1610,18 @@ func (a *Operator) copiedCSVsAreEnabled() (bool, error) {
 	return olmConfig.CopiedCSVsAreEnabled(), nil
 }
 
+// certIssuerConfig returns the cert issuer configured by the "cluster" olmConfig resource, or nil if there's none.
+func (a *Operator) certIssuerConfig() *v1alpha1.CertIssuer {
+	olmConfig, err := a.olmConfigLister.Get("cluster")
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			a.logger.WithError(err).Warn("could not get olmConfig, using the default cert issuer")
+		}
+		return nil
+	}
+	return olmConfig.Spec.CertIssuer
+}
+
 func (a *Operator) getCopiedCSVDisabledEventsForCSV(csv *v1alpha1.ClusterServiceVersion) ([]corev1.Event, error) {
 	result := []corev1.Event{}
 	if csv == nil {
@@ -1893,11 +2047,25 @@ func (a *Operator) transitionCSVState(in v1alpha1.ClusterServiceVersion) (out *v
 			now := metav1.Now()
 			expiration := now.Add(install.DefaultCertValidFor)
 			rotateAt := expiration.Add(-1 * install.DefaultCertMinFresh)
+			// Installers know when the certificates they issued expire
+			if tracker, ok := installer.(install.CertRotationTracker); ok && !tracker.CertsRotateAt().IsZero() {
+				rotateAt = tracker.CertsRotateAt()
+			}
 			rotateTime := metav1.NewTime(rotateAt)
 			out.Status.CertsLastUpdated = &now
 			out.Status.CertsRotateAt = &rotateTime
 		}
 
+		// Record what was injected into the deployments from the Subscription and the cluster proxy
+		if strategyDetails, ok := strategy.(*v1alpha1.StrategyDetailsDeployment); ok {
+			injected, err := a.overrides.GetInjectedConfig(out, strategyDetails)
+			if err != nil {
+				logger.WithError(err).Warn("could not determine the injected configuration")
+			} else {
+				out.Status.InjectedConfig = injected
+			}
+		}
+
 		out.SetPhaseWithEvent(v1alpha1.CSVPhaseInstalling, v1alpha1.CSVReasonInstallSuccessful, "waiting for install components to report healthy", now, a.recorder)
 		err := a.csvQueueSet.Requeue(out.GetNamespace(), out.GetName())
 		if err != nil {
@@ -1997,7 +2165,20 @@ func (a *Operator) transitionCSVState(in v1alpha1.ClusterServiceVersion) (out *v
 			return
 		}
 
+		// Verify the health of an upgraded operator before the CSV it replaces is removed
+		if err := a.checkUpgradeHealth(logger, out); err != nil {
+			logger.WithError(err).Warn("failed to roll back upgrade")
+			syncError = err
+			return
+		}
+
 	case v1alpha1.CSVPhaseFailed:
+		// An upgrade that failed its health checks has been rolled back, don't reinstall it
+		if out.Status.Reason == v1alpha1.CSVReasonUpgradeHealthChecksFailed {
+			logger.Debug("skipping sync for CSV that failed its upgrade health checks")
+			return
+		}
+
 		installer, strategy := a.parseStrategiesAndUpdateStatus(out)
 		if strategy == nil {
 			return
@@ -2091,7 +2272,14 @@ func (a *Operator) transitionCSVState(in v1alpha1.ClusterServiceVersion) (out *v
 		// If there is a succeeded replacement, mark this for deletion
 		if next := a.isBeingReplaced(out, a.csvSet(out.GetNamespace(), v1alpha1.CSVPhaseAny)); next != nil {
 			if next.Status.Phase == v1alpha1.CSVPhaseSucceeded {
+				// The replacement requeues this CSV once its health checks pass
+				if !a.isHealthVerified(next) {
+					logger.Debugf("waiting for health checks of %s", next.GetName())
+					return
+				}
 				out.SetPhaseWithEvent(v1alpha1.CSVPhaseDeleting, v1alpha1.CSVReasonReplaced, "has been replaced by a newer ClusterServiceVersion that has successfully installed.", now, a.recorder)
+			} else if next.Status.Phase == v1alpha1.CSVPhaseFailed && next.Status.Reason == v1alpha1.CSVReasonUpgradeHealthChecksFailed {
+				logger.Debugf("replacement %s failed its health checks", next.GetName())
 			} else {
 				// If there's a replacement, but it's not yet succeeded, requeue both (this is an active replacement)
 				if err := a.csvQueueSet.Requeue(next.GetNamespace(), next.GetName()); err != nil {
@@ -37,6 +37,8 @@ import (
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
 	k8sscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/pkg/version"
@@ -249,6 +251,12 @@ func withExtObjs(extObjs ...runtime.Object) fakeOperatorOption {
 	}
 }
 
+func withDynamicClient(dynamicClient dynamic.Interface) fakeOperatorOption {
+	return func(config *fakeOperatorConfig) {
+		config.dynamicClient = dynamicClient
+	}
+}
+
 func withRegObjs(regObjs ...runtime.Object) fakeOperatorOption {
 	return func(config *fakeOperatorConfig) {
 		config.regObjs = regObjs
@@ -286,6 +294,9 @@ func NewFakeOperator(ctx context.Context, options ...fakeOperatorOption) (*Opera
 	k8sClientFake.Resources = apiResourcesForObjects(append(config.extObjs, config.regObjs...))
 	config.operatorClient = operatorclient.NewClient(k8sClientFake, apiextensionsfake.NewSimpleClientset(config.extObjs...), apiregistrationfake.NewSimpleClientset(config.regObjs...))
 	config.configClient = configfake.NewSimpleClientset()
+	if config.dynamicClient == nil {
+		config.dynamicClient = fakedynamic.NewSimpleDynamicClient(runtime.NewScheme())
+	}
 
 	for _, ns := range config.namespaces {
 		_, err := config.operatorClient.KubernetesInterface().CoreV1().Namespaces().Create(context.TODO(), &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}}, metav1.CreateOptions{})
@@ -3994,6 +4005,9 @@ func TestSyncOperatorGroups(t *testing.T) {
 			expectedStatus: v1.OperatorGroupStatus{
 				Namespaces:  []string{targetNamespace},
 				LastUpdated: &now,
+				NamespaceStatuses: []v1.OperatorGroupNamespaceStatus{
+					{Name: targetNamespace, Converged: true},
+				},
 			},
 		},
 		{
@@ -4035,6 +4049,10 @@ func TestSyncOperatorGroups(t *testing.T) {
 			expectedStatus: v1.OperatorGroupStatus{
 				Namespaces:  []string{operatorNamespace, targetNamespace},
 				LastUpdated: &now,
+				NamespaceStatuses: []v1.OperatorGroupNamespaceStatus{
+					{Name: operatorNamespace, Converged: true},
+					{Name: targetNamespace, Converged: true},
+				},
 			},
 			final: final{objects: map[string][]runtime.Object{
 				operatorNamespace: {
@@ -4137,6 +4155,10 @@ func TestSyncOperatorGroups(t *testing.T) {
 			expectedStatus: v1.OperatorGroupStatus{
 				Namespaces:  []string{operatorNamespace, targetNamespace},
 				LastUpdated: &now,
+				NamespaceStatuses: []v1.OperatorGroupNamespaceStatus{
+					{Name: operatorNamespace, Converged: true},
+					{Name: targetNamespace, Converged: true},
+				},
 			},
 			final: final{objects: map[string][]runtime.Object{
 				operatorNamespace: {
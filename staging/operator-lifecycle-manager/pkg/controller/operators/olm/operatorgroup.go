@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 	"hash/fnv"
+	"path"
 	"reflect"
+	"sort"
 	"strings"
+	"sync"
 
 	v1 "github.com/operator-framework/api/pkg/operators/v1"
 	utillabels "github.com/operator-framework/operator-lifecycle-manager/pkg/lib/kubernetes/pkg/util/labels"
@@ -16,6 +19,7 @@ import (
 	meta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/errors"
 
 	"github.com/operator-framework/api/pkg/operators/v1alpha1"
@@ -150,12 +154,19 @@ func (a *Operator) syncOperatorGroups(obj interface{}) error {
 		logger.Debug("OperatorGroup namespaces change detected")
 		outOfSyncNamespaces := namespacesAddedOrRemoved(op.Status.Namespaces, targetNamespaces)
 
+		namespaceStatuses, err := a.namespaceStatuses(op, targetNamespaces)
+		if err != nil {
+			logger.WithError(err).Warn("failed to determine the convergence of target namespaces")
+			return err
+		}
+
 		// Update operatorgroup target namespace selection
 		logger.WithField("targets", targetNamespaces).Debug("namespace change detected")
 		op.Status = v1.OperatorGroupStatus{
-			Namespaces:  targetNamespaces,
-			LastUpdated: a.now(),
-			Conditions:  op.Status.Conditions,
+			Namespaces:        targetNamespaces,
+			LastUpdated:       a.now(),
+			Conditions:        op.Status.Conditions,
+			NamespaceStatuses: namespaceStatuses,
 		}
 
 		if _, err = a.client.OperatorsV1().OperatorGroups(op.GetNamespace()).UpdateStatus(context.TODO(), op, metav1.UpdateOptions{}); err != nil && !k8serrors.IsNotFound(err) {
@@ -184,6 +195,25 @@ func (a *Operator) syncOperatorGroups(obj interface{}) error {
 	}
 	logger.Debug("OperatorGroup CSV annotation completed")
 
+	if _, err := a.syncOperatorIndexes(op); err != nil {
+		logger.WithError(err).Warn("failed to sync operator indexes")
+		return err
+	}
+
+	namespaceStatuses, err := a.namespaceStatuses(op, targetNamespaces)
+	if err != nil {
+		logger.WithError(err).Warn("failed to determine the convergence of target namespaces")
+		return err
+	}
+	if !reflect.DeepEqual(namespaceStatuses, op.Status.NamespaceStatuses) {
+		op.Status.NamespaceStatuses = namespaceStatuses
+		if _, err = a.client.OperatorsV1().OperatorGroups(op.GetNamespace()).UpdateStatus(context.TODO(), op, metav1.UpdateOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+			logger.WithError(err).Warn("operatorgroup update failed")
+			return err
+		}
+		logger.Debug("operatorgroup namespace statuses updated")
+	}
+
 	// Requeue all CSVs that provide the same APIs (including those removed). This notifies conflicting CSVs in
 	// intersecting groups that their conflict has possibly been resolved, either through resizing or through
 	// deletion of the conflicting CSV.
@@ -246,6 +276,19 @@ func (a *Operator) operatorGroupDeleted(obj interface{}) {
 		}
 	}
 
+	// Tenant RBAC owned by the indexes is garbage collected along with them
+	indexes, err := a.lister.CoreV1().ConfigMapLister().List(operatorIndexSelector(op.GetNamespace()))
+	if err != nil {
+		logger.WithError(err).Error("failed to list operator indexes for garbage collection")
+		return
+	}
+	for _, index := range indexes {
+		err = a.opClient.DeleteConfigMap(index.GetNamespace(), index.GetName(), &metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			logger.WithError(err).Error("failed to delete operator index during garbage collection")
+		}
+	}
+
 	// Trigger a sync on namespaces
 	logger.Debug("OperatorGroup deleted, requeueing out of sync namespaces")
 	for _, ns := range op.Status.Namespaces {
@@ -584,7 +627,7 @@ func (a *Operator) ensureSingletonRBAC(operatorNamespace string, csv *v1alpha1.C
 	return nil
 }
 
-func (a *Operator) ensureTenantRBAC(operatorNamespace, targetNamespace string, csv *v1alpha1.ClusterServiceVersion, targetCSV *v1alpha1.ClusterServiceVersion) error {
+func (a *Operator) ensureTenantRBAC(operatorNamespace, targetNamespace string, csv *v1alpha1.ClusterServiceVersion, targetCSV *v1alpha1.ClusterServiceVersion, owner metav1.OwnerReference) error {
 	if operatorNamespace == targetNamespace {
 		return nil
 	}
@@ -632,7 +675,7 @@ func (a *Operator) ensureTenantRBAC(operatorNamespace, targetNamespace string, c
 		targetRole := ownedRole.DeepCopy()
 		targetRole.SetResourceVersion("0")
 		targetRole.SetNamespace(targetNamespace)
-		targetRole.SetOwnerReferences([]metav1.OwnerReference{ownerutil.NonBlockingOwner(targetCSV)})
+		targetRole.SetOwnerReferences([]metav1.OwnerReference{owner})
 		if err := ownerutil.AddOwnerLabels(targetRole, targetCSV); err != nil {
 			return err
 		}
@@ -676,7 +719,7 @@ func (a *Operator) ensureTenantRBAC(operatorNamespace, targetNamespace string, c
 		ownedRoleBinding = ownedRoleBinding.DeepCopy()
 		ownedRoleBinding.SetNamespace(targetNamespace)
 		ownedRoleBinding.SetResourceVersion("0")
-		ownedRoleBinding.SetOwnerReferences([]metav1.OwnerReference{ownerutil.NonBlockingOwner(targetCSV)})
+		ownedRoleBinding.SetOwnerReferences([]metav1.OwnerReference{owner})
 		if err := ownerutil.AddOwnerLabels(ownedRoleBinding, targetCSV); err != nil {
 			return err
 		}
@@ -737,6 +780,7 @@ func (a *Operator) ensureCSVsInNamespaces(csv *v1alpha1.ClusterServiceVersion, o
 		permMet, _, err := a.permissionStatus(strategyDetailsDeployment, ruleChecker, ns, csv)
 		if err != nil {
 			logger.WithError(err).Debug("permission status")
+			a.tenantPermissions.set(csv, ns, false)
 			return err
 		}
 		logger.WithField("target", ns).WithField("permMet", permMet).Debug("permission status")
@@ -744,6 +788,7 @@ func (a *Operator) ensureCSVsInNamespaces(csv *v1alpha1.ClusterServiceVersion, o
 		// operator already has access in the target namespace
 		if permMet {
 			logger.Debug("operator has access")
+			a.tenantPermissions.set(csv, ns, true)
 			continue
 		} else {
 			logger.Debug("operator needs access, going to create permissions")
@@ -751,12 +796,15 @@ func (a *Operator) ensureCSVsInNamespaces(csv *v1alpha1.ClusterServiceVersion, o
 
 		targetCSV, ok := targetCSVs[ns]
 		if !ok {
+			a.tenantPermissions.set(csv, ns, false)
 			return fmt.Errorf("bug: no target CSV for namespace %v", ns)
 		}
-		if err := a.ensureTenantRBAC(operatorGroup.GetNamespace(), ns, csv, targetCSV); err != nil {
+		if err := a.ensureTenantRBAC(operatorGroup.GetNamespace(), ns, csv, targetCSV, ownerutil.NonBlockingOwner(targetCSV)); err != nil {
 			logger.WithError(err).Debug("ensuring tenant rbac")
+			a.tenantPermissions.set(csv, ns, false)
 			return err
 		}
+		a.tenantPermissions.set(csv, ns, true)
 		logger.Debug("permissions created")
 	}
 
@@ -934,12 +982,6 @@ func namespacesChanged(clusterNamespaces []string, statusNamespaces []string) bo
 }
 
 func (a *Operator) getOperatorGroupTargets(op *v1.OperatorGroup) (map[string]struct{}, error) {
-	selector, err := metav1.LabelSelectorAsSelector(op.Spec.Selector)
-
-	if err != nil {
-		return nil, err
-	}
-
 	namespaceSet := make(map[string]struct{})
 	if op.Spec.TargetNamespaces != nil && len(op.Spec.TargetNamespaces) > 0 {
 		for _, ns := range op.Spec.TargetNamespaces {
@@ -948,23 +990,258 @@ func (a *Operator) getOperatorGroupTargets(op *v1.OperatorGroup) (map[string]str
 			}
 			namespaceSet[ns] = struct{}{}
 		}
-	} else if selector == nil || selector.Empty() || selector == labels.Nothing() {
+		return namespaceSet, nil
+	}
+
+	matcher, err := newNamespaceMatcher(op.Spec)
+	if err != nil {
+		return nil, err
+	}
+	if matcher.selectsAll() {
 		namespaceSet[corev1.NamespaceAll] = struct{}{}
-	} else {
-		matchedNamespaces, err := a.lister.CoreV1().NamespaceLister().List(selector)
-		if err != nil {
-			return nil, err
-		} else if len(matchedNamespaces) == 0 {
-			a.logger.Debugf("No matched TargetNamespaces are found for given selector: %#v\n", selector)
-		}
+		return namespaceSet, nil
+	}
 
-		for _, ns := range matchedNamespaces {
+	namespaces, err := a.lister.CoreV1().NamespaceLister().List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, ns := range namespaces {
+		if matcher.matches(ns) {
 			namespaceSet[ns.GetName()] = struct{}{}
 		}
 	}
+	if len(namespaceSet) == 0 {
+		a.logger.Debugf("No matched TargetNamespaces are found for OperatorGroup %s/%s", op.GetNamespace(), op.GetName())
+	}
 	return namespaceSet, nil
 }
 
+// namespaceStatuses reports, for each target namespace, whether the operator group's installed CSVs have been copied
+// into it, or listed by its operator index, and have the permissions they require there. Permissions are taken from
+// the outcome of the CSVs' last sync rather than checked again for every namespace.
+func (a *Operator) namespaceStatuses(op *v1.OperatorGroup, targetNamespaces []string) ([]v1.OperatorGroupNamespaceStatus, error) {
+	if NewNamespaceSet(targetNamespaces).IsAllNamespaces() {
+		return nil, nil
+	}
+
+	csvs, err := a.lister.OperatorsV1alpha1().ClusterServiceVersionLister().ClusterServiceVersions(op.GetNamespace()).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	indexed, err := a.operatorIndexesAreEnabled(op)
+	if err != nil {
+		return nil, err
+	}
+
+	var installed []*v1alpha1.ClusterServiceVersion
+	for _, csv := range csvs {
+		if csv.IsCopied() || csv.Status.Phase != v1alpha1.CSVPhaseSucceeded || csv.GetAnnotations()[v1.OperatorGroupAnnotationKey] != op.GetName() {
+			continue
+		}
+		installed = append(installed, csv)
+	}
+
+	namespaces := append([]string{}, targetNamespaces...)
+	sort.Strings(namespaces)
+	var statuses []v1.OperatorGroupNamespaceStatus
+	for _, ns := range namespaces {
+		var waiting []string
+		// CSVs aren't copied or indexed into their own namespace
+		if ns != op.GetNamespace() {
+			for _, csv := range installed {
+				listed, err := a.isListedInNamespace(op, csv, ns, indexed)
+				if err != nil {
+					return nil, err
+				}
+				if !listed {
+					listing := "copy"
+					if indexed {
+						listing = "index entry"
+					}
+					waiting = append(waiting, fmt.Sprintf("%s of %s", listing, csv.GetName()))
+					continue
+				}
+
+				if !a.tenantPermissions.granted(csv, ns) {
+					waiting = append(waiting, fmt.Sprintf("permissions of %s", csv.GetName()))
+				}
+			}
+		}
+
+		status := v1.OperatorGroupNamespaceStatus{Name: ns, Converged: len(waiting) == 0}
+		if len(waiting) > 0 {
+			status.Message = "waiting for " + strings.Join(waiting, ", ")
+		}
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+// tenantPermissions records whether CSVs had, or were granted, their permissions in each of their target namespaces
+// when they were last synced.
+type tenantPermissions struct {
+	mu      sync.Mutex
+	records map[types.UID]*tenantPermissionsRecord
+}
+
+type tenantPermissionsRecord struct {
+	// generation of the CSV the namespaces were recorded for, since spec changes may change its permissions
+	generation int64
+	namespaces map[string]bool
+}
+
+func newTenantPermissions() *tenantPermissions {
+	return &tenantPermissions{records: map[types.UID]*tenantPermissionsRecord{}}
+}
+
+// set records whether the CSV has its permissions in the namespace.
+func (p *tenantPermissions) set(csv *v1alpha1.ClusterServiceVersion, namespace string, granted bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	record, ok := p.records[csv.GetUID()]
+	if !ok || record.generation != csv.GetGeneration() {
+		record = &tenantPermissionsRecord{generation: csv.GetGeneration(), namespaces: map[string]bool{}}
+		p.records[csv.GetUID()] = record
+	}
+	record.namespaces[namespace] = granted
+}
+
+// granted returns true if the CSV had its permissions in the namespace when it was last synced.
+func (p *tenantPermissions) granted(csv *v1alpha1.ClusterServiceVersion, namespace string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	record, ok := p.records[csv.GetUID()]
+	return ok && record.generation == csv.GetGeneration() && record.namespaces[namespace]
+}
+
+// forget drops what was recorded for the CSV.
+func (p *tenantPermissions) forget(csv *v1alpha1.ClusterServiceVersion) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.records, csv.GetUID())
+}
+
+// isListedInNamespace returns true if the CSV is copied into the namespace, or listed by the namespace's operator
+// index when the group's operators are indexed instead.
+func (a *Operator) isListedInNamespace(op *v1.OperatorGroup, csv *v1alpha1.ClusterServiceVersion, namespace string, indexed bool) (bool, error) {
+	var err error
+	if indexed {
+		var index *corev1.ConfigMap
+		if index, err = a.lister.CoreV1().ConfigMapLister().ConfigMaps(namespace).Get(operatorIndexName(op.GetNamespace())); err == nil {
+			_, ok := index.Data[csv.GetName()]
+			return ok, nil
+		}
+	} else if _, err = a.copiedCSVLister.ClusterServiceVersions(namespace).Get(csv.GetName()); err == nil {
+		return true, nil
+	}
+	if k8serrors.IsNotFound(err) {
+		return false, nil
+	}
+	return false, err
+}
+
+// operatorGroupSelects returns true if the namespace is one of the operator group's target namespaces, according to
+// its spec.
+func operatorGroupSelects(op *v1.OperatorGroup, ns *corev1.Namespace) bool {
+	if len(op.Spec.TargetNamespaces) > 0 {
+		for _, target := range op.Spec.TargetNamespaces {
+			if target == ns.GetName() {
+				return true
+			}
+		}
+		return false
+	}
+
+	matcher, err := newNamespaceMatcher(op.Spec)
+	if err != nil {
+		return false
+	}
+	return matcher.selectsAll() || matcher.matches(ns)
+}
+
+// namespaceMatcher selects the target namespaces of an OperatorGroup that doesn't list them explicitly.
+type namespaceMatcher struct {
+	include         labels.Selector
+	includePatterns []string
+	exclude         labels.Selector
+	excludePatterns []string
+}
+
+func newNamespaceMatcher(spec v1.OperatorGroupSpec) (*namespaceMatcher, error) {
+	include, err := optionalSelector(spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	exclude, err := optionalSelector(spec.ExcludeSelector)
+	if err != nil {
+		return nil, err
+	}
+	for _, pattern := range append(append([]string{}, spec.NamePatterns...), spec.ExcludeNamePatterns...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid namespace name pattern %q: %v", pattern, err)
+		}
+	}
+
+	return &namespaceMatcher{
+		include:         include,
+		includePatterns: spec.NamePatterns,
+		exclude:         exclude,
+		excludePatterns: spec.ExcludeNamePatterns,
+	}, nil
+}
+
+// optionalSelector returns nil for unset or empty selectors, which don't restrict the selection.
+func optionalSelector(selector *metav1.LabelSelector) (labels.Selector, error) {
+	s, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	if s == nil || s.Empty() || s == labels.Nothing() {
+		return nil, nil
+	}
+	return s, nil
+}
+
+// selectsAll returns true if nothing restricts the selection, i.e. the OperatorGroup targets all namespaces.
+func (m *namespaceMatcher) selectsAll() bool {
+	return m.include == nil && len(m.includePatterns) == 0 && m.exclude == nil && len(m.excludePatterns) == 0
+}
+
+// matches returns true if the namespace is included by the selector or a name pattern, and isn't excluded. Without
+// a selector or name patterns, every namespace that isn't excluded matches.
+func (m *namespaceMatcher) matches(ns *corev1.Namespace) bool {
+	nsLabels := labels.Set(ns.GetLabels())
+	included := m.include == nil && len(m.includePatterns) == 0
+	if m.include != nil && m.include.Matches(nsLabels) {
+		included = true
+	}
+	if matchesAnyPattern(m.includePatterns, ns.GetName()) {
+		included = true
+	}
+	if !included {
+		return false
+	}
+
+	if m.exclude != nil && m.exclude.Matches(nsLabels) {
+		return false
+	}
+	return !matchesAnyPattern(m.excludePatterns, ns.GetName())
+}
+
+func matchesAnyPattern(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
 func (a *Operator) updateNamespaceList(op *v1.OperatorGroup) ([]string, error) {
 	namespaceSet, err := a.getOperatorGroupTargets(op)
 	if err != nil {
@@ -1,6 +1,7 @@
 package olm
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -8,11 +9,15 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	ktesting "k8s.io/client-go/testing"
 
+	v1 "github.com/operator-framework/api/pkg/operators/v1"
 	"github.com/operator-framework/api/pkg/operators/v1alpha1"
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/clientset/versioned/fake"
 	listersv1alpha1 "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/listers/operators/v1alpha1"
@@ -404,3 +409,132 @@ func TestCSVCopyPrototype(t *testing.T) {
 		},
 	}, dst)
 }
+
+func TestOperatorGroupSelects(t *testing.T) {
+	namespace := func(name string, nsLabels map[string]string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: nsLabels}}
+	}
+	teamA := &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}}
+
+	for _, tt := range []struct {
+		name     string
+		spec     v1.OperatorGroupSpec
+		ns       *corev1.Namespace
+		expected bool
+	}{
+		{
+			name:     "AllNamespaces",
+			ns:       namespace("ns", nil),
+			expected: true,
+		},
+		{
+			name:     "TargetNamespacesIgnoreSelectors",
+			spec:     v1.OperatorGroupSpec{TargetNamespaces: []string{"ns"}, ExcludeNamePatterns: []string{"ns"}},
+			ns:       namespace("ns", nil),
+			expected: true,
+		},
+		{
+			name:     "SelectorMatches",
+			spec:     v1.OperatorGroupSpec{Selector: teamA},
+			ns:       namespace("ns", map[string]string{"team": "a"}),
+			expected: true,
+		},
+		{
+			name: "SelectorDoesNotMatch",
+			spec: v1.OperatorGroupSpec{Selector: teamA},
+			ns:   namespace("ns", map[string]string{"team": "b"}),
+		},
+		{
+			name:     "NamePatternMatches",
+			spec:     v1.OperatorGroupSpec{Selector: teamA, NamePatterns: []string{"team-a-*"}},
+			ns:       namespace("team-a-dev", nil),
+			expected: true,
+		},
+		{
+			name: "ExcludedBySelector",
+			spec: v1.OperatorGroupSpec{Selector: teamA, ExcludeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"frozen": "true"}}},
+			ns:   namespace("ns", map[string]string{"team": "a", "frozen": "true"}),
+		},
+		{
+			name: "ExcludedByNamePattern",
+			spec: v1.OperatorGroupSpec{ExcludeNamePatterns: []string{"kube-*"}},
+			ns:   namespace("kube-system", nil),
+		},
+		{
+			name:     "NotExcludedByNamePattern",
+			spec:     v1.OperatorGroupSpec{ExcludeNamePatterns: []string{"kube-*"}},
+			ns:       namespace("ns", nil),
+			expected: true,
+		},
+		{
+			name: "InvalidNamePattern",
+			spec: v1.OperatorGroupSpec{NamePatterns: []string{"["}},
+			ns:   namespace("ns", nil),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			op := &v1.OperatorGroup{Spec: tt.spec}
+			require.Equal(t, tt.expected, operatorGroupSelects(op, tt.ns))
+		})
+	}
+}
+
+func TestOperatorGroupNamespaceStatuses(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	operatorNamespace := "operator-ns"
+	rule := rbacv1.PolicyRule{Verbs: []string{"get"}, APIGroups: []string{"example.com"}, Resources: []string{"widgets"}}
+	permissions := []v1alpha1.StrategyDeploymentPermissions{{ServiceAccountName: "sa", Rules: []rbacv1.PolicyRule{rule}}}
+
+	operatorGroup := &v1.OperatorGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "og", Namespace: operatorNamespace},
+		Spec:       v1.OperatorGroupSpec{TargetNamespaces: []string{operatorNamespace, "bound-ns", "unbound-ns", "uncopied-ns"}},
+	}
+	installed := csv("operator.v1", operatorNamespace, "0.0.0", "", installStrategy("dep", permissions, nil), nil, nil, v1alpha1.CSVPhaseSucceeded)
+	installed.SetAnnotations(map[string]string{v1.OperatorGroupAnnotationKey: operatorGroup.GetName()})
+	// Failed CSVs aren't expected to be copied
+	failed := csv("failed.v1", operatorNamespace, "0.0.0", "", installStrategy("failed", nil, nil), nil, nil, v1alpha1.CSVPhaseFailed)
+	failed.SetAnnotations(map[string]string{v1.OperatorGroupAnnotationKey: operatorGroup.GetName()})
+
+	var clientObjs []runtime.Object
+	clientObjs = append(clientObjs, operatorGroup, installed, failed)
+	for _, ns := range []string{"bound-ns", "unbound-ns"} {
+		copied := &v1alpha1.ClusterServiceVersion{}
+		csvCopyPrototype(installed, copied)
+		copied.SetNamespace(ns)
+		clientObjs = append(clientObjs, copied)
+	}
+
+	op, err := NewFakeOperator(ctx,
+		withNamespaces(append(operatorGroup.Spec.TargetNamespaces, "other-ns")...),
+		withClientObjs(clientObjs...),
+	)
+	require.NoError(t, err)
+
+	// The permissions found or granted when the CSV was last synced are reported
+	op.tenantPermissions.set(installed, "bound-ns", true)
+	op.tenantPermissions.set(installed, "unbound-ns", false)
+
+	statuses, err := op.namespaceStatuses(operatorGroup, []string{"uncopied-ns", "unbound-ns", operatorNamespace, "bound-ns"})
+	require.NoError(t, err)
+	require.Equal(t, []v1.OperatorGroupNamespaceStatus{
+		{Name: "bound-ns", Converged: true},
+		{Name: operatorNamespace, Converged: true},
+		{Name: "unbound-ns", Message: "waiting for permissions of operator.v1"},
+		{Name: "uncopied-ns", Message: "waiting for copy of operator.v1"},
+	}, statuses)
+
+	// Permissions recorded for an earlier generation of the CSV are outdated
+	updated := installed.DeepCopy()
+	updated.SetGeneration(installed.GetGeneration() + 1)
+	require.False(t, op.tenantPermissions.granted(updated, "bound-ns"))
+
+	// Deleted CSVs are forgotten
+	op.tenantPermissions.forget(installed)
+	require.False(t, op.tenantPermissions.granted(installed, "bound-ns"))
+
+	statuses, err = op.namespaceStatuses(operatorGroup, []string{corev1.NamespaceAll})
+	require.NoError(t, err)
+	require.Nil(t, statuses)
+}
@@ -0,0 +1,272 @@
+package olm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "github.com/operator-framework/api/pkg/operators/v1"
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/install"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/lib/ownerutil"
+)
+
+const (
+	// OperatorIndexLabelKey labels the ConfigMaps that list the operators serving a namespace when copied CSVs are
+	// disabled. Each of them is named after the namespace of the OperatorGroup whose operators it lists, and its data
+	// maps the name of each operator's CSV to an OperatorIndexEntry.
+	OperatorIndexLabelKey = "olm.operator-index"
+
+	operatorIndexNamePrefix = "olm-operators-"
+)
+
+// OperatorIndexEntry describes an operator serving the namespace of the index that lists it.
+type OperatorIndexEntry struct {
+	Name         string                              `json:"name"`
+	Namespace    string                              `json:"namespace"`
+	DisplayName  string                              `json:"displayName,omitempty"`
+	Version      string                              `json:"version,omitempty"`
+	Phase        v1alpha1.ClusterServiceVersionPhase `json:"phase,omitempty"`
+	ProvidedAPIs string                              `json:"providedAPIs,omitempty"`
+}
+
+func operatorIndexName(operatorNamespace string) string {
+	return operatorIndexNamePrefix + operatorNamespace
+}
+
+func operatorIndexSelector(operatorNamespace string) labels.Selector {
+	return labels.SelectorFromSet(map[string]string{
+		OperatorIndexLabelKey:   "",
+		v1alpha1.CopiedLabelKey: operatorNamespace,
+	})
+}
+
+// operatorIndexesAreEnabled returns true if the operators of the given group are listed by operator indexes rather
+// than by copied CSVs. Operators targeting all namespaces aren't listed by either when copied CSVs are disabled.
+func (a *Operator) operatorIndexesAreEnabled(op *v1.OperatorGroup) (bool, error) {
+	copiedCSVsAreEnabled, err := a.copiedCSVsAreEnabled()
+	if err != nil {
+		return false, err
+	}
+	return !copiedCSVsAreEnabled && !NewNamespaceSet(op.Status.Namespaces).IsAllNamespaces(), nil
+}
+
+// operatorIndexEntries returns the index entries of the operators in the group, keyed by CSV name.
+func (a *Operator) operatorIndexEntries(op *v1.OperatorGroup) (map[string]string, error) {
+	csvs, err := a.lister.OperatorsV1alpha1().ClusterServiceVersionLister().ClusterServiceVersions(op.GetNamespace()).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	entries := map[string]string{}
+	for _, csv := range csvs {
+		// Index the same CSVs that would otherwise be copied
+		if csv.IsCopied() || csv.IsUncopiable() || csv.GetAnnotations()[v1.OperatorGroupAnnotationKey] != op.GetName() {
+			continue
+		}
+
+		entry := OperatorIndexEntry{
+			Name:        csv.GetName(),
+			Namespace:   csv.GetNamespace(),
+			DisplayName: csv.Spec.DisplayName,
+			Version:     csv.Spec.Version.String(),
+			Phase:       csv.Status.Phase,
+		}
+		if surface, err := apiSurfaceOfCSV(csv); err == nil && len(surface.ProvidedAPIs) > 0 {
+			entry.ProvidedAPIs = surface.ProvidedAPIs.StripPlural().String()
+		}
+		data, err := json.Marshal(entry)
+		if err != nil {
+			return nil, err
+		}
+		entries[csv.GetName()] = string(data)
+	}
+
+	return entries, nil
+}
+
+// syncOperatorIndexes ensures an index of the group's operators exists in each of its target namespaces when copied
+// CSVs are disabled, and removes the group's indexes otherwise. It returns the indexes, keyed by namespace.
+func (a *Operator) syncOperatorIndexes(op *v1.OperatorGroup) (map[string]*corev1.ConfigMap, error) {
+	enabled, err := a.operatorIndexesAreEnabled(op)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := a.lister.CoreV1().ConfigMapLister().List(operatorIndexSelector(op.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	targets := NewNamespaceSet(op.Status.Namespaces)
+	indexes := map[string]*corev1.ConfigMap{}
+	for _, index := range existing {
+		if enabled && index.GetName() == operatorIndexName(op.GetNamespace()) && index.GetNamespace() != op.GetNamespace() && targets.Contains(index.GetNamespace()) {
+			indexes[index.GetNamespace()] = index
+			continue
+		}
+
+		// Tenant RBAC owned by the index is garbage collected along with it
+		if err := a.opClient.DeleteConfigMap(index.GetNamespace(), index.GetName(), &metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+	if !enabled {
+		return nil, nil
+	}
+
+	entries, err := a.operatorIndexEntries(op)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ns := range op.Status.Namespaces {
+		if ns == op.GetNamespace() {
+			continue
+		}
+		index, err := a.ensureOperatorIndex(op, ns, indexes[ns], entries)
+		if err != nil {
+			return nil, err
+		}
+		indexes[ns] = index
+	}
+
+	return indexes, nil
+}
+
+func (a *Operator) ensureOperatorIndex(op *v1.OperatorGroup, namespace string, existing *corev1.ConfigMap, entries map[string]string) (*corev1.ConfigMap, error) {
+	if existing == nil {
+		index := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      operatorIndexName(op.GetNamespace()),
+				Namespace: namespace,
+				Labels: map[string]string{
+					OperatorIndexLabelKey:   "",
+					v1alpha1.CopiedLabelKey: op.GetNamespace(),
+				},
+				Annotations: map[string]string{
+					v1.OperatorGroupAnnotationKey:          op.GetName(),
+					v1.OperatorGroupNamespaceAnnotationKey: op.GetNamespace(),
+				},
+			},
+			Data: entries,
+		}
+		created, err := a.opClient.CreateConfigMap(index)
+		if k8serrors.IsAlreadyExists(err) {
+			// The cache hasn't caught up yet
+			return a.opClient.GetConfigMap(namespace, index.GetName())
+		}
+		return created, err
+	}
+
+	if reflect.DeepEqual(existing.Data, entries) || (len(existing.Data) == 0 && len(entries) == 0) {
+		return existing, nil
+	}
+
+	// Operators that are no longer listed don't need their permissions in the namespace anymore
+	for name := range existing.Data {
+		if _, ok := entries[name]; ok {
+			continue
+		}
+		if err := a.pruneTenantRBAC(op.GetNamespace(), namespace, name); err != nil {
+			return nil, err
+		}
+	}
+
+	index := existing.DeepCopy()
+	index.Data = entries
+	return a.opClient.KubernetesInterface().CoreV1().ConfigMaps(namespace).Update(context.TODO(), index, metav1.UpdateOptions{})
+}
+
+// pruneTenantRBAC deletes the roles and role bindings granted in the target namespace to the CSV of the given name.
+func (a *Operator) pruneTenantRBAC(operatorNamespace, targetNamespace, csvName string) error {
+	selector := labels.SelectorFromSet(labels.Merge(
+		ownerutil.OwnerLabel(&v1alpha1.ClusterServiceVersion{ObjectMeta: metav1.ObjectMeta{Name: csvName, Namespace: targetNamespace}}, v1alpha1.ClusterServiceVersionKind),
+		map[string]string{v1alpha1.CopiedLabelKey: operatorNamespace},
+	))
+
+	roleBindings, err := a.lister.RbacV1().RoleBindingLister().RoleBindings(targetNamespace).List(selector)
+	if err != nil {
+		return err
+	}
+	for _, roleBinding := range roleBindings {
+		if err := a.opClient.DeleteRoleBinding(targetNamespace, roleBinding.GetName(), &metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	roles, err := a.lister.RbacV1().RoleLister().Roles(targetNamespace).List(selector)
+	if err != nil {
+		return err
+	}
+	for _, role := range roles {
+		if err := a.opClient.DeleteRole(targetNamespace, role.GetName(), &metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ensureTenantRBACInIndexedNamespaces grants the CSV its permissions in each namespace indexing it. The roles and
+// role bindings are owned by the indexes.
+func (a *Operator) ensureTenantRBACInIndexedNamespaces(csv *v1alpha1.ClusterServiceVersion, indexes map[string]*corev1.ConfigMap) error {
+	ruleChecker := install.NewCSVRuleChecker(a.lister.RbacV1().RoleLister(), a.lister.RbacV1().RoleBindingLister(), a.lister.RbacV1().ClusterRoleLister(), a.lister.RbacV1().ClusterRoleBindingLister(), csv)
+	for ns, index := range indexes {
+		if _, ok := index.Data[csv.GetName()]; !ok {
+			continue
+		}
+
+		permMet, _, err := a.permissionStatus(&csv.Spec.InstallStrategy.StrategySpec, ruleChecker, ns, csv)
+		if err != nil {
+			a.tenantPermissions.set(csv, ns, false)
+			return err
+		}
+		if permMet {
+			a.tenantPermissions.set(csv, ns, true)
+			continue
+		}
+
+		// Tenant RBAC is labeled as if owned by a copied CSV so that it's found the same way
+		target := &v1alpha1.ClusterServiceVersion{ObjectMeta: metav1.ObjectMeta{Name: csv.GetName(), Namespace: ns}}
+		if err := a.ensureTenantRBAC(csv.GetNamespace(), ns, csv, target, ownerutil.NonBlockingOwner(index.DeepCopy())); err != nil {
+			a.tenantPermissions.set(csv, ns, false)
+			return err
+		}
+		a.tenantPermissions.set(csv, ns, true)
+	}
+
+	return nil
+}
+
+func (a *Operator) syncOperatorIndex(obj interface{}) error {
+	a.requeueOperatorIndexOwner(obj)
+	return nil
+}
+
+// requeueOperatorIndexOwner requeues the operator group whose operators are listed by the given index, so that
+// indexes that are modified or deleted are restored.
+func (a *Operator) requeueOperatorIndexOwner(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	index, ok := obj.(*corev1.ConfigMap)
+	if !ok {
+		return
+	}
+
+	namespace, name := index.GetAnnotations()[v1.OperatorGroupNamespaceAnnotationKey], index.GetAnnotations()[v1.OperatorGroupAnnotationKey]
+	if namespace == "" || name == "" {
+		return
+	}
+	if err := a.ogQueueSet.Requeue(namespace, name); err != nil {
+		a.logger.WithError(err).WithField("index", fmt.Sprintf("%s/%s", index.GetNamespace(), index.GetName())).Warn("error requeuing operatorgroup")
+	}
+}
@@ -0,0 +1,174 @@
+package olm
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	v1 "github.com/operator-framework/api/pkg/operators/v1"
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/lib/ownerutil"
+)
+
+func TestSyncCopyCSVWithOperatorIndexes(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	operatorNamespace, targetNamespace := "operator-ns", "target-ns"
+	rule := rbacv1.PolicyRule{Verbs: []string{"get"}, APIGroups: []string{"example.com"}, Resources: []string{"widgets"}}
+	permissions := []v1alpha1.StrategyDeploymentPermissions{{ServiceAccountName: "sa", Rules: []rbacv1.PolicyRule{rule}}}
+
+	disabled := true
+	olmConfig := &v1.OLMConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+		Spec:       v1.OLMConfigSpec{Features: &v1.Features{DisableCopiedCSVs: &disabled}},
+	}
+	operatorGroup := &v1.OperatorGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "og", Namespace: operatorNamespace},
+		Spec:       v1.OperatorGroupSpec{TargetNamespaces: []string{operatorNamespace, targetNamespace}},
+		Status:     v1.OperatorGroupStatus{Namespaces: []string{operatorNamespace, targetNamespace}},
+	}
+	installed := csv("operator.v1", operatorNamespace, "0.0.0", "", installStrategy("dep", permissions, nil), nil, nil, v1alpha1.CSVPhaseSucceeded)
+	installed.SetUID(types.UID("csv-uid"))
+	installed.SetAnnotations(map[string]string{
+		v1.OperatorGroupAnnotationKey:          operatorGroup.GetName(),
+		v1.OperatorGroupNamespaceAnnotationKey: operatorNamespace,
+		v1.OperatorGroupTargetsAnnotationKey:   operatorGroup.BuildTargetNamespaces(),
+	})
+	copied := &v1alpha1.ClusterServiceVersion{}
+	csvCopyPrototype(installed, copied)
+	copied.SetNamespace(targetNamespace)
+
+	role := &rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: "operator.v1-widgets", Namespace: operatorNamespace}, Rules: []rbacv1.PolicyRule{rule}}
+	roleBinding := &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "operator.v1-widgets", Namespace: operatorNamespace},
+		Subjects:   []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: "sa", Namespace: operatorNamespace}},
+		RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "Role", Name: role.GetName()},
+	}
+	for _, obj := range []metav1.Object{role, roleBinding} {
+		ownerutil.AddNonBlockingOwner(obj, installed)
+		require.NoError(t, ownerutil.AddOwnerLabels(obj, installed))
+	}
+
+	op, err := NewFakeOperator(ctx,
+		withNamespaces(operatorNamespace, targetNamespace),
+		withClientObjs(olmConfig, operatorGroup, installed, copied),
+		withK8sObjs(&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "sa", Namespace: operatorNamespace}}, role, roleBinding),
+	)
+	require.NoError(t, err)
+
+	poll := func(condition func() (bool, error)) {
+		require.NoError(t, wait.PollImmediate(10*time.Millisecond, 5*time.Second, condition))
+	}
+	indexName := operatorIndexName(operatorNamespace)
+
+	// The copied CSV is replaced by an index entry, and the operator is granted its permissions in the namespace
+	require.NoError(t, op.syncCopyCSV(installed))
+
+	_, err = op.client.OperatorsV1alpha1().ClusterServiceVersions(targetNamespace).Get(ctx, installed.GetName(), metav1.GetOptions{})
+	require.True(t, k8serrors.IsNotFound(err))
+
+	index, err := op.opClient.KubernetesInterface().CoreV1().ConfigMaps(targetNamespace).Get(ctx, indexName, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, operatorNamespace, index.GetLabels()[v1alpha1.CopiedLabelKey])
+	var entry OperatorIndexEntry
+	require.NoError(t, json.Unmarshal([]byte(index.Data[installed.GetName()]), &entry))
+	require.Equal(t, OperatorIndexEntry{
+		Name:      installed.GetName(),
+		Namespace: operatorNamespace,
+		Version:   "0.0.0",
+		Phase:     v1alpha1.CSVPhaseSucceeded,
+	}, entry)
+
+	tenantRole, err := op.opClient.KubernetesInterface().RbacV1().Roles(targetNamespace).Get(ctx, role.GetName(), metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "ConfigMap", tenantRole.GetOwnerReferences()[0].Kind)
+	require.Equal(t, indexName, tenantRole.GetOwnerReferences()[0].Name)
+	_, err = op.opClient.KubernetesInterface().RbacV1().RoleBindings(targetNamespace).Get(ctx, roleBinding.GetName(), metav1.GetOptions{})
+	require.NoError(t, err)
+
+	// The namespace converges once the operator is listed and has its permissions
+	poll(func() (bool, error) {
+		statuses, err := op.namespaceStatuses(operatorGroup, operatorGroup.Status.Namespaces)
+		return err == nil && len(statuses) == 2 && statuses[1].Converged, err
+	})
+
+	// Deleted operators are removed from the index along with their permissions
+	require.NoError(t, op.client.OperatorsV1alpha1().ClusterServiceVersions(operatorNamespace).Delete(ctx, installed.GetName(), metav1.DeleteOptions{}))
+	poll(func() (bool, error) {
+		_, err := op.lister.OperatorsV1alpha1().ClusterServiceVersionLister().ClusterServiceVersions(operatorNamespace).Get(installed.GetName())
+		return k8serrors.IsNotFound(err), nil
+	})
+	poll(func() (bool, error) {
+		_, err := op.lister.RbacV1().RoleLister().Roles(targetNamespace).Get(role.GetName())
+		return err == nil, nil
+	})
+	_, err = op.syncOperatorIndexes(operatorGroup)
+	require.NoError(t, err)
+
+	index, err = op.opClient.KubernetesInterface().CoreV1().ConfigMaps(targetNamespace).Get(ctx, indexName, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Empty(t, index.Data)
+	_, err = op.opClient.KubernetesInterface().RbacV1().Roles(targetNamespace).Get(ctx, role.GetName(), metav1.GetOptions{})
+	require.True(t, k8serrors.IsNotFound(err))
+	_, err = op.opClient.KubernetesInterface().RbacV1().RoleBindings(targetNamespace).Get(ctx, roleBinding.GetName(), metav1.GetOptions{})
+	require.True(t, k8serrors.IsNotFound(err))
+
+	// Indexes are removed when copied CSVs are reenabled
+	disabled = false
+	_, err = op.client.OperatorsV1().OLMConfigs().Update(ctx, olmConfig, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	poll(func() (bool, error) {
+		config, err := op.olmConfigLister.Get(olmConfig.GetName())
+		return err == nil && config.CopiedCSVsAreEnabled(), nil
+	})
+	poll(func() (bool, error) {
+		_, err := op.lister.CoreV1().ConfigMapLister().ConfigMaps(targetNamespace).Get(indexName)
+		return err == nil, nil
+	})
+	_, err = op.syncOperatorIndexes(operatorGroup)
+	require.NoError(t, err)
+
+	_, err = op.opClient.KubernetesInterface().CoreV1().ConfigMaps(targetNamespace).Get(ctx, indexName, metav1.GetOptions{})
+	require.True(t, k8serrors.IsNotFound(err))
+}
+
+func TestSyncOperatorIndexesAllNamespaces(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	disabled := true
+	operatorGroup := &v1.OperatorGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "og", Namespace: "operator-ns"},
+		Status:     v1.OperatorGroupStatus{Namespaces: []string{metav1.NamespaceAll}},
+	}
+	op, err := NewFakeOperator(ctx,
+		withNamespaces("operator-ns", "other-ns"),
+		withClientObjs(
+			&v1.OLMConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+				Spec:       v1.OLMConfigSpec{Features: &v1.Features{DisableCopiedCSVs: &disabled}},
+			},
+			operatorGroup,
+		),
+	)
+	require.NoError(t, err)
+
+	// Operators targeting all namespaces aren't indexed in every namespace
+	indexes, err := op.syncOperatorIndexes(operatorGroup)
+	require.NoError(t, err)
+	require.Empty(t, indexes)
+
+	configMaps, err := op.opClient.KubernetesInterface().CoreV1().ConfigMaps(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Empty(t, configMaps.Items)
+}
@@ -2,7 +2,9 @@ package overrides
 
 import (
 	"fmt"
+	"reflect"
 
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/install"
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/operators/olm/overrides/inject"
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/lib/operatorlister"
@@ -42,59 +44,275 @@ func (d *DeploymentInitializer) GetDeploymentInitializer(ownerCSV ownerutil.Owne
 // Initialize initializes a deployment object with appropriate global cluster
 // level proxy env variable(s).
 func (d *DeploymentInitializer) initialize(ownerCSV ownerutil.Owner, deployment *appsv1.Deployment) error {
-	var envVarOverrides, proxyEnvVar, merged []corev1.EnvVar
-	var err error
+	config, cluster, err := d.getConfig(ownerCSV)
+	if err != nil {
+		return err
+	}
+
+	if config == nil && len(cluster.proxyEnvVar) == 0 && cluster.trustedCAVolume == nil {
+		d.logger.WithField("csv", ownerCSV.GetName()).Debug("no env var to inject into csv")
+	}
+
+	return injectConfig(&deployment.Spec.Template, deployment.GetName(), config, cluster)
+}
+
+// GetInjectedConfig returns the configuration injected into each deployment of the install strategy. Deployments
+// that nothing is injected into are omitted, and env variables are reported without their values.
+func (d *DeploymentInitializer) GetInjectedConfig(ownerCSV ownerutil.Owner, strategy *v1alpha1.StrategyDetailsDeployment) ([]v1alpha1.DeploymentConfig, error) {
+	config, cluster, err := d.getConfig(ownerCSV)
+	if err != nil {
+		return nil, err
+	}
+
+	var injected []v1alpha1.DeploymentConfig
+	for _, spec := range strategy.DeploymentSpecs {
+		// Inject into an empty pod template to find out what is injected
+		template := &corev1.PodTemplateSpec{}
+		for _, container := range spec.Spec.Template.Spec.Containers {
+			template.Spec.Containers = append(template.Spec.Containers, corev1.Container{Name: container.Name})
+		}
+		if err := injectConfig(template, spec.Name, config, cluster); err != nil {
+			return nil, err
+		}
+
+		deploymentConfig := v1alpha1.DeploymentConfig{
+			Name:              spec.Name,
+			NodeSelector:      template.Spec.NodeSelector,
+			Tolerations:       template.Spec.Tolerations,
+			Affinity:          template.Spec.Affinity,
+			Annotations:       template.GetAnnotations(),
+			Labels:            template.GetLabels(),
+			PriorityClassName: template.Spec.PriorityClassName,
+			Volumes:           template.Spec.Volumes,
+		}
+		for _, container := range template.Spec.Containers {
+			containerConfig := v1alpha1.ContainerConfig{Name: container.Name}
+			// Injection may leave empty rather than nil slices behind. Env values, such as proxy URLs, may carry
+			// credentials, so only their names are reported.
+			for _, env := range container.Env {
+				containerConfig.Env = append(containerConfig.Env, corev1.EnvVar{Name: env.Name})
+			}
+			if len(container.VolumeMounts) > 0 {
+				containerConfig.VolumeMounts = container.VolumeMounts
+			}
+			if !reflect.DeepEqual(container.Resources, corev1.ResourceRequirements{}) {
+				containerConfig.Resources = container.Resources.DeepCopy()
+			}
+			if !reflect.DeepEqual(containerConfig, v1alpha1.ContainerConfig{Name: container.Name}) {
+				deploymentConfig.Containers = append(deploymentConfig.Containers, containerConfig)
+			}
+		}
+		if !reflect.DeepEqual(deploymentConfig, v1alpha1.DeploymentConfig{Name: spec.Name}) {
+			injected = append(injected, deploymentConfig)
+		}
+	}
+
+	return injected, nil
+}
 
-	envVarOverrides, volumeOverrides, volumeMountOverrides, tolerationOverrides, resourcesOverride, nodeSelectorOverride, err := d.config.GetConfigOverrides(ownerCSV)
+// clusterConfig is the cluster level configuration injected into the deployments of a CSV.
+type clusterConfig struct {
+	proxyEnvVar          []corev1.EnvVar
+	trustedCAVolume      *corev1.Volume
+	trustedCAVolumeMount *corev1.VolumeMount
+	trustedCAEnvVar      []corev1.EnvVar
+}
+
+// getConfig returns the Subscription's configuration for the CSV, and the cluster level configuration: the cluster
+// proxy env variable(s) unless the Subscription overrides them, and the trusted CA volume if the querier provides
+// one.
+func (d *DeploymentInitializer) getConfig(ownerCSV ownerutil.Owner) (config *v1alpha1.SubscriptionConfig, cluster clusterConfig, err error) {
+	config, err = d.config.GetConfig(ownerCSV)
 	if err != nil {
 		err = fmt.Errorf("failed to get subscription pod configuration - %v", err)
-		return err
+		return
 	}
 
+	var envVarOverrides []corev1.EnvVar
+	if config != nil {
+		envVarOverrides = config.Env
+	}
 	if !proxy.IsOverridden(envVarOverrides) {
-		proxyEnvVar, err = d.querier.QueryProxyConfig()
+		cluster.proxyEnvVar, err = d.querier.QueryProxyConfig()
 		if err != nil {
 			err = fmt.Errorf("failed to query cluster proxy configuration - %v", err)
-			return err
+			return
 		}
 
-		proxyEnvVar = dropEmptyProxyEnv(proxyEnvVar)
+		cluster.proxyEnvVar = dropEmptyProxyEnv(cluster.proxyEnvVar)
 	}
 
-	merged = append(envVarOverrides, proxyEnvVar...)
+	if querier, ok := d.querier.(proxy.TrustedCAQuerier); ok {
+		cluster.trustedCAVolume, cluster.trustedCAVolumeMount, cluster.trustedCAEnvVar, err = querier.QueryTrustedCA()
+		if err != nil {
+			err = fmt.Errorf("failed to query cluster trusted CA configuration - %v", err)
+			return
+		}
+	}
 
-	if len(merged) == 0 {
-		d.logger.WithField("csv", ownerCSV.GetName()).Debug("no env var to inject into csv")
+	return
+}
+
+// injectConfig injects the Subscription's configuration, then the cluster level configuration, into the pod template
+// of a deployment.
+func injectConfig(template *corev1.PodTemplateSpec, deploymentName string, config *v1alpha1.SubscriptionConfig, cluster clusterConfig) error {
+	if err := injectSubscriptionConfig(template, deploymentName, config, cluster.proxyEnvVar); err != nil {
+		return err
 	}
 
-	podSpec := &deployment.Spec.Template.Spec
+	if cluster.trustedCAVolume == nil {
+		return nil
+	}
+
+	// Injected last, so that the volumes, volumeMounts and env variables of the deployment and the Subscription take
+	// precedence
+	if err := inject.InjectMissingVolumeIntoDeployment(&template.Spec, *cluster.trustedCAVolume, *cluster.trustedCAVolumeMount, cluster.trustedCAEnvVar); err != nil {
+		return fmt.Errorf("failed to inject trusted CA volume into deployment spec name=%s - %v", deploymentName, err)
+	}
+
+	return nil
+}
+
+// injectSubscriptionConfig injects the Subscription's configuration into the pod template of a deployment: first the
+// configuration shared by all deployments, then the configuration targeting the deployment and its containers.
+func injectSubscriptionConfig(template *corev1.PodTemplateSpec, deploymentName string, config *v1alpha1.SubscriptionConfig, proxyEnvVar []corev1.EnvVar) error {
+	if config == nil {
+		config = &v1alpha1.SubscriptionConfig{}
+	}
+
+	merged := append(append([]corev1.EnvVar{}, config.Env...), proxyEnvVar...)
+	podSpec := &template.Spec
 	if err := inject.InjectEnvIntoDeployment(podSpec, merged); err != nil {
-		return fmt.Errorf("failed to inject proxy env variable(s) into deployment spec name=%s - %v", deployment.Name, err)
+		return fmt.Errorf("failed to inject proxy env variable(s) into deployment spec name=%s - %v", deploymentName, err)
 	}
 
-	if err = inject.InjectVolumesIntoDeployment(podSpec, volumeOverrides); err != nil {
-		return fmt.Errorf("failed to inject volume(s) into deployment spec name=%s - %v", deployment.Name, err)
+	if err := inject.InjectVolumesIntoDeployment(podSpec, config.Volumes); err != nil {
+		return fmt.Errorf("failed to inject volume(s) into deployment spec name=%s - %v", deploymentName, err)
 	}
 
-	if err = inject.InjectVolumeMountsIntoDeployment(podSpec, volumeMountOverrides); err != nil {
-		return fmt.Errorf("failed to inject volumeMounts(s) into deployment spec name=%s - %v", deployment.Name, err)
+	if err := inject.InjectVolumeMountsIntoDeployment(podSpec, config.VolumeMounts); err != nil {
+		return fmt.Errorf("failed to inject volumeMounts(s) into deployment spec name=%s - %v", deploymentName, err)
 	}
 
-	if err = inject.InjectTolerationsIntoDeployment(podSpec, tolerationOverrides); err != nil {
-		return fmt.Errorf("failed to inject toleration(s) into deployment spec name=%s - %v", deployment.Name, err)
+	if err := inject.InjectTolerationsIntoDeployment(podSpec, config.Tolerations); err != nil {
+		return fmt.Errorf("failed to inject toleration(s) into deployment spec name=%s - %v", deploymentName, err)
 	}
 
-	if err = inject.InjectResourcesIntoDeployment(podSpec, resourcesOverride); err != nil {
-		return fmt.Errorf("failed to inject resources into deployment spec name=%s - %v", deployment.Name, err)
+	if err := inject.InjectResourcesIntoDeployment(podSpec, config.Resources); err != nil {
+		return fmt.Errorf("failed to inject resources into deployment spec name=%s - %v", deploymentName, err)
 	}
 
-	if err = inject.InjectNodeSelectorIntoDeployment(podSpec, nodeSelectorOverride); err != nil {
-		return fmt.Errorf("failed to inject nodeSelector into deployment spec name=%s - %v", deployment.Name, err)
+	if err := inject.InjectNodeSelectorIntoDeployment(podSpec, config.NodeSelector); err != nil {
+		return fmt.Errorf("failed to inject nodeSelector into deployment spec name=%s - %v", deploymentName, err)
+	}
+
+	if err := inject.InjectAffinityIntoDeployment(podSpec, config.Affinity); err != nil {
+		return fmt.Errorf("failed to inject affinity into deployment spec name=%s - %v", deploymentName, err)
+	}
+
+	if err := inject.InjectPriorityClassNameIntoDeployment(podSpec, config.PriorityClassName); err != nil {
+		return fmt.Errorf("failed to inject priorityClassName into deployment spec name=%s - %v", deploymentName, err)
+	}
+
+	// The deployment's own annotations and labels take precedence. They are merged before injecting, since injected
+	// labels never replace existing ones
+	annotations, labels := config.Annotations, config.Labels
+	deploymentConfig := findDeploymentConfig(config.Deployments, deploymentName)
+	if deploymentConfig != nil {
+		annotations = mergeMaps(annotations, deploymentConfig.Annotations)
+		labels = mergeMaps(labels, deploymentConfig.Labels)
+	}
+
+	if err := inject.InjectAnnotationsIntoDeployment(template, annotations); err != nil {
+		return fmt.Errorf("failed to inject annotations into deployment spec name=%s - %v", deploymentName, err)
+	}
+
+	if err := inject.InjectLabelsIntoDeployment(template, labels); err != nil {
+		return fmt.Errorf("failed to inject labels into deployment spec name=%s - %v", deploymentName, err)
+	}
+
+	if deploymentConfig == nil {
+		return nil
+	}
+
+	if err := inject.InjectVolumesIntoDeployment(podSpec, deploymentConfig.Volumes); err != nil {
+		return fmt.Errorf("failed to inject volume(s) into deployment spec name=%s - %v", deploymentName, err)
+	}
+
+	if err := inject.InjectTolerationsIntoDeployment(podSpec, deploymentConfig.Tolerations); err != nil {
+		return fmt.Errorf("failed to inject toleration(s) into deployment spec name=%s - %v", deploymentName, err)
+	}
+
+	if err := inject.InjectNodeSelectorIntoDeployment(podSpec, deploymentConfig.NodeSelector); err != nil {
+		return fmt.Errorf("failed to inject nodeSelector into deployment spec name=%s - %v", deploymentName, err)
+	}
+
+	if err := inject.InjectAffinityIntoDeployment(podSpec, deploymentConfig.Affinity); err != nil {
+		return fmt.Errorf("failed to inject affinity into deployment spec name=%s - %v", deploymentName, err)
+	}
+
+	if err := inject.InjectPriorityClassNameIntoDeployment(podSpec, deploymentConfig.PriorityClassName); err != nil {
+		return fmt.Errorf("failed to inject priorityClassName into deployment spec name=%s - %v", deploymentName, err)
+	}
+
+	for _, containerConfig := range deploymentConfig.Containers {
+		container := findContainer(podSpec, containerConfig.Name)
+		if container == nil {
+			continue
+		}
+
+		if err := inject.InjectEnvIntoContainer(container, containerConfig.Env); err != nil {
+			return fmt.Errorf("failed to inject env variable(s) into container name=%s of deployment spec name=%s - %v", container.Name, deploymentName, err)
+		}
+
+		if err := inject.InjectVolumeMountsIntoContainer(container, containerConfig.VolumeMounts); err != nil {
+			return fmt.Errorf("failed to inject volumeMounts(s) into container name=%s of deployment spec name=%s - %v", container.Name, deploymentName, err)
+		}
+
+		if err := inject.InjectResourcesIntoContainer(container, containerConfig.Resources); err != nil {
+			return fmt.Errorf("failed to inject resources into container name=%s of deployment spec name=%s - %v", container.Name, deploymentName, err)
+		}
+	}
+
+	return nil
+}
+
+func findDeploymentConfig(configs []v1alpha1.DeploymentConfig, name string) *v1alpha1.DeploymentConfig {
+	for i := range configs {
+		if configs[i].Name == name {
+			return &configs[i]
+		}
 	}
 
 	return nil
 }
 
+func findContainer(podSpec *corev1.PodSpec, name string) *corev1.Container {
+	for i := range podSpec.Containers {
+		if podSpec.Containers[i].Name == name {
+			return &podSpec.Containers[i]
+		}
+	}
+
+	return nil
+}
+
+func mergeMaps(base, overrides map[string]string) map[string]string {
+	if len(overrides) == 0 {
+		return base
+	}
+
+	merged := make(map[string]string, len(base)+len(overrides))
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, v := range overrides {
+		merged[k] = v
+	}
+
+	return merged
+}
+
 func dropEmptyProxyEnv(in []corev1.EnvVar) (out []corev1.EnvVar) {
 	out = make([]corev1.EnvVar, 0)
 	for i := range in {
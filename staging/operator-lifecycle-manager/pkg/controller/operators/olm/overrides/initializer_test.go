@@ -0,0 +1,190 @@
+package overrides
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	operatorsv1 "github.com/operator-framework/api/pkg/operators/v1"
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	listersv1 "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/listers/operators/v1"
+	listersv1alpha1 "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/listers/operators/v1alpha1"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/lib/operatorlister"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/lib/proxy"
+)
+
+type fakeQuerier []corev1.EnvVar
+
+func (q fakeQuerier) QueryProxyConfig() ([]corev1.EnvVar, error) {
+	return q, nil
+}
+
+func TestDeploymentInitializer(t *testing.T) {
+	namespace := "ns"
+	proxyEnv := []corev1.EnvVar{{Name: "HTTP_PROXY", Value: "http://proxy:3128"}}
+	resources := &corev1.ResourceRequirements{
+		Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("128Mi")},
+	}
+	affinity := &corev1.Affinity{
+		PodAntiAffinity: &corev1.PodAntiAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{{TopologyKey: "kubernetes.io/hostname"}},
+		},
+	}
+
+	csv := &v1alpha1.ClusterServiceVersion{ObjectMeta: metav1.ObjectMeta{Name: "operator.v1", Namespace: namespace}}
+	sub := &v1alpha1.Subscription{
+		ObjectMeta: metav1.ObjectMeta{Name: "operator", Namespace: namespace},
+		Spec: &v1alpha1.SubscriptionSpec{
+			Config: &v1alpha1.SubscriptionConfig{
+				Env:               []corev1.EnvVar{{Name: "LOG_LEVEL", Value: "info"}},
+				Annotations:       map[string]string{"example.com/team": "a", "example.com/tier": "gold"},
+				Labels:            map[string]string{"app": "overridden", "example.com/owner": "a"},
+				PriorityClassName: "high",
+				Deployments: []v1alpha1.DeploymentConfig{{
+					Name:        "manager",
+					Affinity:    affinity,
+					Annotations: map[string]string{"example.com/tier": "silver"},
+					Containers: []v1alpha1.ContainerConfig{
+						{Name: "manager", Env: []corev1.EnvVar{{Name: "LOG_LEVEL", Value: "debug"}}, Resources: resources},
+						{Name: "missing", Env: []corev1.EnvVar{{Name: "IGNORED", Value: "true"}}},
+					},
+				}},
+			},
+		},
+		Status: v1alpha1.SubscriptionStatus{InstalledCSV: csv.GetName()},
+	}
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	require.NoError(t, indexer.Add(sub))
+	lister := operatorlister.NewLister()
+	lister.OperatorsV1alpha1().RegisterSubscriptionLister(namespace, listersv1alpha1.NewSubscriptionLister(indexer))
+	initializer := NewDeploymentInitializer(logrus.New(), fakeQuerier(proxyEnv), lister)
+
+	deploymentSpec := func(name string) appsv1.DeploymentSpec {
+		return appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": name}},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "manager"}, {Name: "proxy"}},
+				},
+			},
+		}
+	}
+
+	// The targeted deployment gets the shared configuration overridden by its own
+	manager := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "manager"}, Spec: deploymentSpec("manager")}
+	require.NoError(t, initializer.GetDeploymentInitializer(csv)(manager))
+	podTemplate := manager.Spec.Template
+	require.Equal(t, map[string]string{"app": "manager", "example.com/owner": "a"}, podTemplate.GetLabels())
+	require.Equal(t, map[string]string{"example.com/team": "a", "example.com/tier": "silver"}, podTemplate.GetAnnotations())
+	require.Equal(t, "high", podTemplate.Spec.PriorityClassName)
+	require.Equal(t, affinity, podTemplate.Spec.Affinity)
+	require.Equal(t, []corev1.EnvVar{{Name: "LOG_LEVEL", Value: "debug"}, proxyEnv[0]}, podTemplate.Spec.Containers[0].Env)
+	require.Equal(t, *resources, podTemplate.Spec.Containers[0].Resources)
+	require.Equal(t, []corev1.EnvVar{{Name: "LOG_LEVEL", Value: "info"}, proxyEnv[0]}, podTemplate.Spec.Containers[1].Env)
+	require.Empty(t, podTemplate.Spec.Containers[1].Resources)
+
+	// Other deployments only get the shared configuration
+	webhook := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "webhook"}, Spec: deploymentSpec("webhook")}
+	require.NoError(t, initializer.GetDeploymentInitializer(csv)(webhook))
+	require.Nil(t, webhook.Spec.Template.Spec.Affinity)
+	require.Equal(t, map[string]string{"example.com/team": "a", "example.com/tier": "gold"}, webhook.Spec.Template.GetAnnotations())
+
+	// The injected configuration is reported per deployment, without env values
+	injected, err := initializer.GetInjectedConfig(csv, &v1alpha1.StrategyDetailsDeployment{
+		DeploymentSpecs: []v1alpha1.StrategyDeploymentSpec{{Name: "manager", Spec: deploymentSpec("manager")}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []v1alpha1.DeploymentConfig{{
+		Name:              "manager",
+		Affinity:          affinity,
+		Annotations:       map[string]string{"example.com/team": "a", "example.com/tier": "silver"},
+		Labels:            map[string]string{"app": "overridden", "example.com/owner": "a"},
+		PriorityClassName: "high",
+		Containers: []v1alpha1.ContainerConfig{
+			{Name: "manager", Env: []corev1.EnvVar{{Name: "LOG_LEVEL"}, {Name: proxyEnv[0].Name}}, Resources: resources},
+			{Name: "proxy", Env: []corev1.EnvVar{{Name: "LOG_LEVEL"}, {Name: proxyEnv[0].Name}}},
+		},
+	}}, injected)
+
+	// The subscription in the cache is left untouched
+	require.Equal(t, map[string]string{"example.com/tier": "silver"}, sub.Spec.Config.Deployments[0].Annotations)
+	require.Equal(t, []corev1.EnvVar{{Name: "LOG_LEVEL", Value: "info"}}, sub.Spec.Config.Env)
+}
+
+func TestDeploymentInitializerTrustedCA(t *testing.T) {
+	namespace := "ns"
+	olmConfig := &operatorsv1.OLMConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+		Spec: operatorsv1.OLMConfigSpec{
+			Proxy: &operatorsv1.ProxyConfig{
+				HTTPSProxy: "http://proxy:3128",
+				TrustedCA:  &operatorsv1.TrustedCAConfigMap{Name: "trusted-ca"},
+			},
+		},
+	}
+	csv := &v1alpha1.ClusterServiceVersion{ObjectMeta: metav1.ObjectMeta{Name: "operator.v1", Namespace: namespace}}
+	sub := &v1alpha1.Subscription{
+		ObjectMeta: metav1.ObjectMeta{Name: "operator", Namespace: namespace},
+		Spec: &v1alpha1.SubscriptionSpec{
+			Config: &v1alpha1.SubscriptionConfig{
+				Deployments: []v1alpha1.DeploymentConfig{{
+					Name: "manager",
+					Containers: []v1alpha1.ContainerConfig{{
+						Name:         "proxy",
+						VolumeMounts: []corev1.VolumeMount{{Name: "certs", MountPath: "/etc/olm/trusted-ca"}},
+					}},
+				}},
+			},
+		},
+		Status: v1alpha1.SubscriptionStatus{InstalledCSV: csv.GetName()},
+	}
+
+	olmConfigIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	require.NoError(t, olmConfigIndexer.Add(olmConfig))
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	require.NoError(t, indexer.Add(sub))
+	lister := operatorlister.NewLister()
+	lister.OperatorsV1alpha1().RegisterSubscriptionLister(namespace, listersv1alpha1.NewSubscriptionLister(indexer))
+	querier := proxy.NewOLMConfigQuerier(logrus.New(), listersv1.NewOLMConfigLister(olmConfigIndexer), fakeQuerier{{Name: "HTTP_PROXY", Value: "http://cluster:3128"}})
+	initializer := NewDeploymentInitializer(logrus.New(), querier, lister)
+
+	manager := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "manager"},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "manager"}, {Name: "proxy"}},
+				},
+			},
+		},
+	}
+	require.NoError(t, initializer.GetDeploymentInitializer(csv)(manager))
+
+	// The OLMConfig's proxy takes precedence over the cluster's, and its CA is mounted and pointed at by SSL_CERT_FILE
+	// unless the mount path is in use
+	volume, volumeMount, envVar, err := querier.QueryTrustedCA()
+	require.NoError(t, err)
+	require.Equal(t, "trusted-ca", volume.ConfigMap.Name)
+	require.Equal(t, []corev1.KeyToPath{{Key: operatorsv1.DefaultTrustedCAKey, Path: "ca-bundle.crt"}}, volume.ConfigMap.Items)
+	require.Equal(t, []corev1.EnvVar{{Name: "SSL_CERT_FILE", Value: "/etc/olm/trusted-ca/ca-bundle.crt"}}, envVar)
+	podSpec := manager.Spec.Template.Spec
+	require.Equal(t, []corev1.Volume{*volume}, podSpec.Volumes)
+	require.Equal(t, append([]corev1.EnvVar{{Name: "HTTPS_PROXY", Value: "http://proxy:3128"}}, envVar...), podSpec.Containers[0].Env)
+	require.Equal(t, []corev1.VolumeMount{*volumeMount}, podSpec.Containers[0].VolumeMounts)
+	require.Equal(t, []corev1.EnvVar{{Name: "HTTPS_PROXY", Value: "http://proxy:3128"}}, podSpec.Containers[1].Env)
+	require.Equal(t, []corev1.VolumeMount{{Name: "certs", MountPath: "/etc/olm/trusted-ca"}}, podSpec.Containers[1].VolumeMounts)
+
+	// Proxy env variable(s) of the Subscription override the OLMConfig's
+	sub.Spec.Config.Env = []corev1.EnvVar{{Name: "NO_PROXY", Value: "example.com"}}
+	manager.Spec.Template.Spec = corev1.PodSpec{Containers: []corev1.Container{{Name: "manager"}}}
+	require.NoError(t, initializer.GetDeploymentInitializer(csv)(manager))
+	require.Equal(t, append(sub.Spec.Config.Env, envVar...), manager.Spec.Template.Spec.Containers[0].Env)
+	require.Equal(t, []corev1.VolumeMount{*volumeMount}, manager.Spec.Template.Spec.Containers[0].VolumeMounts)
+}
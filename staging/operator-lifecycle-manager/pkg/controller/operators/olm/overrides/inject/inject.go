@@ -154,6 +154,62 @@ func findVolumeMount(volumeMounts []corev1.VolumeMount, name string) (foundVolum
 	return
 }
 
+// InjectMissingVolumeIntoDeployment injects the provided Volume into
+// the given PodSpec, and mounts it into its container(s) along with the
+// provided env variables pointing at it.
+//
+// Volumes, VolumeMounts and env variables already defined take precedence:
+// nothing is injected if the PodSpec already defines a Volume of the same
+// name, the Volume is not mounted into any Container that already defines a
+// VolumeMount of the same name or at the same path, and env variables a
+// Container already defines are left as they are.
+func InjectMissingVolumeIntoDeployment(podSpec *corev1.PodSpec, volume corev1.Volume, volumeMount corev1.VolumeMount, envVars []corev1.EnvVar) error {
+	if podSpec == nil {
+		return errors.New("no pod spec provided")
+	}
+
+	if _, found := findVolume(podSpec.Volumes, volume.Name); found {
+		return nil
+	}
+	podSpec.Volumes = append(podSpec.Volumes, volume)
+
+	for i := range podSpec.Containers {
+		container := &podSpec.Containers[i]
+		if _, found := findVolumeMount(container.VolumeMounts, volumeMount.Name); found || isMountPathInUse(container.VolumeMounts, volumeMount.MountPath) {
+			continue
+		}
+
+		container.VolumeMounts = append(container.VolumeMounts, volumeMount)
+		for _, envVar := range envVars {
+			if !isEnvVarDefined(container.Env, envVar.Name) {
+				container.Env = append(container.Env, envVar)
+			}
+		}
+	}
+
+	return nil
+}
+
+func isEnvVarDefined(envVars []corev1.EnvVar, name string) bool {
+	for i := range envVars {
+		if name == envVars[i].Name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isMountPathInUse(volumeMounts []corev1.VolumeMount, mountPath string) bool {
+	for i := range volumeMounts {
+		if mountPath == volumeMounts[i].MountPath {
+			return true
+		}
+	}
+
+	return false
+}
+
 // InjectTolerationsIntoDeployment injects provided Tolerations
 // into the given Pod Spec
 //
@@ -230,3 +286,135 @@ func InjectNodeSelectorIntoDeployment(podSpec *corev1.PodSpec, nodeSelector map[
 
 	return nil
 }
+
+// InjectAffinityIntoDeployment injects the provided Affinity
+// into the given PodSpec.
+//
+// If the PodSpec already defines an Affinity it will be overwritten.
+func InjectAffinityIntoDeployment(podSpec *corev1.PodSpec, affinity *corev1.Affinity) error {
+	if podSpec == nil {
+		return errors.New("no pod spec provided")
+	}
+
+	if affinity != nil {
+		podSpec.Affinity = affinity.DeepCopy()
+	}
+
+	return nil
+}
+
+// InjectPriorityClassNameIntoDeployment injects the provided
+// PriorityClassName into the given PodSpec.
+//
+// If the PodSpec already defines a PriorityClassName it will be overwritten.
+func InjectPriorityClassNameIntoDeployment(podSpec *corev1.PodSpec, priorityClassName string) error {
+	if podSpec == nil {
+		return errors.New("no pod spec provided")
+	}
+
+	if priorityClassName != "" {
+		podSpec.PriorityClassName = priorityClassName
+	}
+
+	return nil
+}
+
+// InjectAnnotationsIntoDeployment injects the provided Annotations
+// into the given PodTemplateSpec.
+//
+// If the PodTemplateSpec already defines an Annotation of the same key
+// as any of the provided Annotations then it will be overwritten.
+func InjectAnnotationsIntoDeployment(template *corev1.PodTemplateSpec, annotations map[string]string) error {
+	if template == nil {
+		return errors.New("no pod template provided")
+	}
+
+	if len(annotations) == 0 {
+		return nil
+	}
+
+	merged := template.GetAnnotations()
+	if merged == nil {
+		merged = map[string]string{}
+	}
+	for k, v := range annotations {
+		merged[k] = v
+	}
+	template.SetAnnotations(merged)
+
+	return nil
+}
+
+// InjectLabelsIntoDeployment injects the provided Labels
+// into the given PodTemplateSpec.
+//
+// Labels already defined by the PodTemplateSpec are kept, since the
+// Deployment's selector may depend on them.
+func InjectLabelsIntoDeployment(template *corev1.PodTemplateSpec, labels map[string]string) error {
+	if template == nil {
+		return errors.New("no pod template provided")
+	}
+
+	if len(labels) == 0 {
+		return nil
+	}
+
+	merged := template.GetLabels()
+	if merged == nil {
+		merged = map[string]string{}
+	}
+	for k, v := range labels {
+		if _, ok := merged[k]; !ok {
+			merged[k] = v
+		}
+	}
+	template.SetLabels(merged)
+
+	return nil
+}
+
+// InjectEnvIntoContainer injects the provided env variables
+// into the given Container.
+//
+// If the Container already defines an env variable of the same name
+// as any of the provided env variables then it will be overwritten.
+func InjectEnvIntoContainer(container *corev1.Container, envVars []corev1.EnvVar) error {
+	if container == nil {
+		return errors.New("no container provided")
+	}
+
+	container.Env = mergeEnvVars(container.Env, envVars)
+
+	return nil
+}
+
+// InjectVolumeMountsIntoContainer injects the provided VolumeMounts
+// into the given Container.
+//
+// If the Container already defines a VolumeMount of the same name
+// as any of the provided VolumeMounts then it will be overwritten.
+func InjectVolumeMountsIntoContainer(container *corev1.Container, volumeMounts []corev1.VolumeMount) error {
+	if container == nil {
+		return errors.New("no container provided")
+	}
+
+	container.VolumeMounts = mergeVolumeMounts(container.VolumeMounts, volumeMounts)
+
+	return nil
+}
+
+// InjectResourcesIntoContainer injects the provided Resources
+// into the given Container.
+//
+// If the Container already defines Resources, they will be overwritten.
+func InjectResourcesIntoContainer(container *corev1.Container, resources *corev1.ResourceRequirements) error {
+	if container == nil {
+		return errors.New("no container provided")
+	}
+
+	if resources != nil {
+		container.Resources = *resources
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/operators/olm/overrides/inject"
 )
@@ -309,6 +310,103 @@ func TestInjectVolumeIntoDeployment(t *testing.T) {
 	}
 }
 
+func TestInjectMissingVolumeIntoDeployment(t *testing.T) {
+	volume := corev1.Volume{
+		Name: "ca",
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "ca"}},
+		},
+	}
+	volumeMount := corev1.VolumeMount{
+		Name:      "ca",
+		MountPath: "/etc/ca",
+	}
+	envVar := corev1.EnvVar{Name: "SSL_CERT_FILE", Value: "/etc/ca/ca-bundle.crt"}
+
+	tests := []struct {
+		name     string
+		podSpec  *corev1.PodSpec
+		expected *corev1.PodSpec
+	}{
+		{
+			// The PodSpec defines neither the Volume nor its VolumeMount.
+			// Expected: The Volume is injected and mounted into every Container,
+			// along with the env variable pointing at it.
+			name: "WithoutVolume",
+			podSpec: &corev1.PodSpec{
+				Volumes: defaultVolumes,
+				Containers: []corev1.Container{
+					{Name: "a"},
+					{Name: "b", VolumeMounts: defaultVolumeMounts},
+				},
+			},
+			expected: &corev1.PodSpec{
+				Volumes: append(append([]corev1.Volume{}, defaultVolumes...), volume),
+				Containers: []corev1.Container{
+					{Name: "a", VolumeMounts: []corev1.VolumeMount{volumeMount}, Env: []corev1.EnvVar{envVar}},
+					{Name: "b", VolumeMounts: append(append([]corev1.VolumeMount{}, defaultVolumeMounts...), volumeMount), Env: []corev1.EnvVar{envVar}},
+				},
+			},
+		},
+		{
+			// A Container already defines the env variable.
+			// Expected: The Volume is mounted but the env variable is left as it is.
+			name: "WithEnvVar",
+			podSpec: &corev1.PodSpec{
+				Containers: []corev1.Container{
+					{Name: "a", Env: []corev1.EnvVar{{Name: "SSL_CERT_FILE", Value: "/etc/ssl/cert.pem"}}},
+				},
+			},
+			expected: &corev1.PodSpec{
+				Volumes: []corev1.Volume{volume},
+				Containers: []corev1.Container{
+					{Name: "a", VolumeMounts: []corev1.VolumeMount{volumeMount}, Env: []corev1.EnvVar{{Name: "SSL_CERT_FILE", Value: "/etc/ssl/cert.pem"}}},
+				},
+			},
+		},
+		{
+			// The PodSpec already defines a Volume of the same name.
+			// Expected: The PodSpec is unchanged.
+			name: "WithVolume",
+			podSpec: &corev1.PodSpec{
+				Volumes:    []corev1.Volume{{Name: "ca"}},
+				Containers: []corev1.Container{{Name: "a"}},
+			},
+			expected: &corev1.PodSpec{
+				Volumes:    []corev1.Volume{{Name: "ca"}},
+				Containers: []corev1.Container{{Name: "a"}},
+			},
+		},
+		{
+			// A Container already mounts another Volume at the same path.
+			// Expected: The Volume is injected but neither mounted nor pointed
+			// at in that Container.
+			name: "WithMountPathInUse",
+			podSpec: &corev1.PodSpec{
+				Containers: []corev1.Container{
+					{Name: "a", VolumeMounts: []corev1.VolumeMount{{Name: "foo", MountPath: "/etc/ca"}}},
+					{Name: "b"},
+				},
+			},
+			expected: &corev1.PodSpec{
+				Volumes: []corev1.Volume{volume},
+				Containers: []corev1.Container{
+					{Name: "a", VolumeMounts: []corev1.VolumeMount{{Name: "foo", MountPath: "/etc/ca"}}},
+					{Name: "b", VolumeMounts: []corev1.VolumeMount{volumeMount}, Env: []corev1.EnvVar{envVar}},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := inject.InjectMissingVolumeIntoDeployment(tt.podSpec, volume, volumeMount, []corev1.EnvVar{envVar})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, tt.podSpec)
+		})
+	}
+}
+
 func TestInjectEnvIntoDeployment(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -792,3 +890,106 @@ func TestInjectNodeSelectorIntoDeployment(t *testing.T) {
 		})
 	}
 }
+
+func TestInjectLabelsIntoDeployment(t *testing.T) {
+	tests := []struct {
+		name     string
+		template *corev1.PodTemplateSpec
+		labels   map[string]string
+		expected *corev1.PodTemplateSpec
+	}{
+		{
+			// PodTemplateSpec with no Labels is injected with labels
+			// Expected: Labels are set
+			name:     "WithNoLabels",
+			template: &corev1.PodTemplateSpec{},
+			labels:   map[string]string{"foo": "bar"},
+			expected: &corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"foo": "bar"}},
+			},
+		},
+		{
+			// PodTemplateSpec with existing Labels is injected with labels
+			// Expected: Existing Labels are kept, others are added
+			name: "WithExistingLabels",
+			template: &corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "operator"}},
+			},
+			labels: map[string]string{"app": "other", "foo": "bar"},
+			expected: &corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "operator", "foo": "bar"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inject.InjectLabelsIntoDeployment(tt.template, tt.labels)
+
+			assert.Equal(t, tt.expected, tt.template)
+		})
+	}
+}
+
+func TestInjectAnnotationsIntoDeployment(t *testing.T) {
+	tests := []struct {
+		name        string
+		template    *corev1.PodTemplateSpec
+		annotations map[string]string
+		expected    *corev1.PodTemplateSpec
+	}{
+		{
+			// PodTemplateSpec with no Annotations is injected with annotations
+			// Expected: Annotations are set
+			name:        "WithNoAnnotations",
+			template:    &corev1.PodTemplateSpec{},
+			annotations: map[string]string{"foo": "bar"},
+			expected: &corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"foo": "bar"}},
+			},
+		},
+		{
+			// PodTemplateSpec with existing Annotations is injected with annotations
+			// Expected: Existing Annotations of the same key are overwritten
+			name: "WithExistingAnnotations",
+			template: &corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"foo": "baz", "keep": "me"}},
+			},
+			annotations: map[string]string{"foo": "bar"},
+			expected: &corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"foo": "bar", "keep": "me"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inject.InjectAnnotationsIntoDeployment(tt.template, tt.annotations)
+
+			assert.Equal(t, tt.expected, tt.template)
+		})
+	}
+}
+
+func TestInjectAffinityAndPriorityClassNameIntoDeployment(t *testing.T) {
+	affinity := &corev1.Affinity{
+		NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+					MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "kubernetes.io/arch", Operator: corev1.NodeSelectorOpIn, Values: []string{"amd64"}}},
+				}},
+			},
+		},
+	}
+
+	// Unset values leave the PodSpec alone
+	podSpec := &corev1.PodSpec{PriorityClassName: "low"}
+	inject.InjectAffinityIntoDeployment(podSpec, nil)
+	inject.InjectPriorityClassNameIntoDeployment(podSpec, "")
+	assert.Equal(t, &corev1.PodSpec{PriorityClassName: "low"}, podSpec)
+
+	// Set values overwrite the PodSpec
+	inject.InjectAffinityIntoDeployment(podSpec, affinity)
+	inject.InjectPriorityClassNameIntoDeployment(podSpec, "high")
+	assert.Equal(t, &corev1.PodSpec{Affinity: affinity, PriorityClassName: "high"}, podSpec)
+}
@@ -59,6 +59,8 @@ type OperatorReconciler struct {
 // +kubebuilder:rbac:groups=operators.coreos.com,resources=operators,verbs=create;update;patch;delete
 // +kubebuilder:rbac:groups=operators.coreos.com,resources=operators/status,verbs=update;patch
 // +kubebuilder:rbac:groups=*,resources=*,verbs=get;list;watch
+// +kubebuilder:rbac:groups=operators.coreos.com,resources=subscriptions;operatorgroups;clusterserviceversions,verbs=create;update;delete
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=create;delete
 
 // SetupWithManager adds the operator reconciler to the given controller manager.
 func (r *OperatorReconciler) SetupWithManager(mgr ctrl.Manager) error {
@@ -72,6 +74,7 @@ func (r *OperatorReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Watches(&source.Kind{Type: &corev1.Namespace{}}, enqueueOperator).
 		Watches(&source.Kind{Type: &apiextensionsv1.CustomResourceDefinition{}}, enqueueOperator).
 		Watches(&source.Kind{Type: &apiregistrationv1.APIService{}}, enqueueOperator).
+		Watches(&source.Kind{Type: &operatorsv1.OperatorGroup{}}, enqueueOperator).
 		Watches(&source.Kind{Type: &operatorsv1alpha1.Subscription{}}, enqueueOperator).
 		Watches(&source.Kind{Type: &operatorsv1alpha1.InstallPlan{}}, enqueueOperator).
 		Watches(&source.Kind{Type: &operatorsv1alpha1.ClusterServiceVersion{}}, enqueueOperator).
@@ -139,6 +142,22 @@ func (r *OperatorReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		}
 	}
 
+	if !create && installs(in) {
+		operator, err := r.factory.NewOperator(in)
+		if err != nil {
+			log.Error(err, "Could not wrap Operator with convenience decorator")
+			return reconcile.Result{Requeue: true}, nil
+		}
+		if in.GetDeletionTimestamp() != nil || in.Spec.Package == "" {
+			return r.uninstall(ctx, log, operator)
+		}
+		if err := r.install(ctx, log, operator); err != nil {
+			log.Error(err, "Could not install Operator")
+			return reconcile.Result{Requeue: true}, nil
+		}
+		in = operator.Operator
+	}
+
 	rv, ok := r.getLastResourceVersion(req.NamespacedName)
 	if !create && ok && rv == in.ResourceVersion {
 		log.V(1).Info("Operator is already up-to-date")
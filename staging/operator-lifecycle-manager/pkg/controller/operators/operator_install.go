@@ -0,0 +1,254 @@
+package operators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	operatorsv1 "github.com/operator-framework/api/pkg/operators/v1"
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/operators/decorators"
+)
+
+const (
+	// OperatorUninstallFinalizer is added to Operators with a package, and blocks their deletion until the package
+	// has been uninstalled.
+	OperatorUninstallFinalizer = "operators.coreos.com/uninstall"
+
+	// uninstallRequeueDelay is how long to wait before checking again on the resources of an Operator being uninstalled.
+	uninstallRequeueDelay = 5 * time.Second
+)
+
+var operatorGVK = operatorsv1.GroupVersion.WithKind("Operator")
+
+// installNamespace returns the namespace the package of an Operator is installed in.
+func installNamespace(in *operatorsv1.Operator) string {
+	if in.Spec.InstallNamespace != "" {
+		return in.Spec.InstallNamespace
+	}
+	return in.Spec.Package
+}
+
+// installs reports whether the Operator installs a package, or only aggregates its components.
+func installs(in *operatorsv1.Operator) bool {
+	return in.Spec.Package != "" || controllerutil.ContainsFinalizer(in, OperatorUninstallFinalizer)
+}
+
+// install ensures that the package of an Operator is installed by a Subscription in its install namespace, creating
+// the namespace and an OperatorGroup if they don't exist. The resources it creates are controlled by the Operator.
+func (r *OperatorReconciler) install(ctx context.Context, log logr.Logger, operator *decorators.Operator) error {
+	in := operator.Operator
+	if in.Spec.Source == "" || in.Spec.SourceNamespace == "" {
+		return fmt.Errorf("operator %s installs package %s without a source and source namespace", in.GetName(), in.Spec.Package)
+	}
+	if !controllerutil.ContainsFinalizer(in, OperatorUninstallFinalizer) {
+		controllerutil.AddFinalizer(in, OperatorUninstallFinalizer)
+		if err := r.Update(ctx, in); err != nil {
+			return err
+		}
+	}
+
+	key, err := operator.ComponentLabelKey()
+	if err != nil {
+		return err
+	}
+	namespace := installNamespace(in)
+	newMeta := func(name, namespace string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       namespace,
+			Labels:          map[string]string{key: ""},
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(in, operatorGVK)},
+		}
+	}
+
+	ns := &corev1.Namespace{}
+	if err := r.Get(ctx, client.ObjectKey{Name: namespace}, ns); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		ns.ObjectMeta = newMeta(namespace, "")
+		log.V(1).Info("creating install namespace", "namespace", namespace)
+		if err := r.Create(ctx, ns); err != nil {
+			return err
+		}
+	} else if ns.GetDeletionTimestamp() != nil {
+		return fmt.Errorf("install namespace %s is being deleted", namespace)
+	}
+
+	// OperatorGroups aren't labeled as components: their status can't be converted before OLM first updates it
+	ogMeta := newMeta(in.GetName(), namespace)
+	ogMeta.Labels = nil
+	if err := r.ensureOperatorGroup(ctx, log, in, ogMeta); err != nil {
+		return err
+	}
+
+	sub := &operatorsv1alpha1.Subscription{}
+	spec := &operatorsv1alpha1.SubscriptionSpec{
+		Package:                in.Spec.Package,
+		Channel:                in.Spec.Channel,
+		VersionRange:           in.Spec.VersionRange,
+		CatalogSource:          in.Spec.Source,
+		CatalogSourceNamespace: in.Spec.SourceNamespace,
+		InstallPlanApproval:    operatorsv1alpha1.ApprovalAutomatic,
+	}
+	if in.Spec.InstallPlanApproval != "" {
+		spec.InstallPlanApproval = operatorsv1alpha1.Approval(in.Spec.InstallPlanApproval)
+	}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: namespace, Name: in.Spec.Package}, sub); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		sub.ObjectMeta = newMeta(in.Spec.Package, namespace)
+		sub.Spec = spec
+		log.V(1).Info("creating subscription", "namespace", namespace, "subscription", in.Spec.Package)
+		return r.Create(ctx, sub)
+	}
+	if !metav1.IsControlledBy(sub, in) {
+		return fmt.Errorf("subscription %s/%s already exists and isn't controlled by operator %s", namespace, sub.GetName(), in.GetName())
+	}
+	if sub.Spec != nil {
+		// keep the fields the Operator doesn't manage
+		spec.StartingCSV = sub.Spec.StartingCSV
+		spec.Config = sub.Spec.Config
+		spec.UpgradeMode = sub.Spec.UpgradeMode
+		spec.UpgradeWindows = sub.Spec.UpgradeWindows
+		spec.RollbackPolicy = sub.Spec.RollbackPolicy
+	}
+	if equality.Semantic.DeepEqual(sub.Spec, spec) {
+		return nil
+	}
+	sub.Spec = spec
+	log.V(1).Info("updating subscription", "namespace", namespace, "subscription", sub.GetName())
+	return r.Update(ctx, sub)
+}
+
+// ensureOperatorGroup ensures that the install namespace of an Operator has an OperatorGroup. OperatorGroups the
+// Operator didn't create are left as they are.
+func (r *OperatorReconciler) ensureOperatorGroup(ctx context.Context, log logr.Logger, in *operatorsv1.Operator, meta metav1.ObjectMeta) error {
+	ogs := &operatorsv1.OperatorGroupList{}
+	if err := r.List(ctx, ogs, client.InNamespace(meta.Namespace)); err != nil {
+		return err
+	}
+	if len(ogs.Items) == 0 {
+		og := &operatorsv1.OperatorGroup{
+			ObjectMeta: meta,
+			Spec:       operatorsv1.OperatorGroupSpec{TargetNamespaces: in.Spec.TargetNamespaces},
+		}
+		log.V(1).Info("creating operatorgroup", "namespace", meta.Namespace, "operatorgroup", meta.Name)
+		return r.Create(ctx, og)
+	}
+	for i := range ogs.Items {
+		og := &ogs.Items[i]
+		if !metav1.IsControlledBy(og, in) || equality.Semantic.DeepEqual(og.Spec.TargetNamespaces, in.Spec.TargetNamespaces) {
+			continue
+		}
+		og.Spec.TargetNamespaces = in.Spec.TargetNamespaces
+		log.V(1).Info("updating operatorgroup", "namespace", og.GetNamespace(), "operatorgroup", og.GetName())
+		if err := r.Update(ctx, og); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// uninstall removes the Subscriptions of an Operator being deleted and the CSVs they installed, then the
+// OperatorGroups and namespaces it created, and finally releases the Operator once they're all gone.
+func (r *OperatorReconciler) uninstall(ctx context.Context, log logr.Logger, operator *decorators.Operator) (ctrl.Result, error) {
+	in := operator.Operator
+	key, err := operator.ComponentLabelKey()
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	selector := client.HasLabels{key}
+
+	subs := &operatorsv1alpha1.SubscriptionList{}
+	if err := r.List(ctx, subs, selector); err != nil {
+		return ctrl.Result{}, err
+	}
+	csvs := &operatorsv1alpha1.ClusterServiceVersionList{}
+	if err := r.List(ctx, csvs, selector); err != nil {
+		return ctrl.Result{}, err
+	}
+	// only the CSVs installed by the Operator's Subscriptions are deleted, and CSVs the Operator merely adopted are
+	// left alone. Once a Subscription is gone, the deletion of its CSVs is still awaited.
+	var remaining []client.Object
+	deleting := map[client.ObjectKey]bool{}
+	for i := range subs.Items {
+		sub := &subs.Items[i]
+		if !metav1.IsControlledBy(sub, in) {
+			continue
+		}
+		for _, name := range []string{sub.Status.InstalledCSV, sub.Status.CurrentCSV} {
+			key := client.ObjectKey{Namespace: sub.GetNamespace(), Name: name}
+			if name == "" || deleting[key] {
+				continue
+			}
+			csv := &operatorsv1alpha1.ClusterServiceVersion{}
+			if err := r.Get(ctx, key, csv); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return ctrl.Result{}, err
+			}
+			deleting[key] = true
+			remaining = append(remaining, csv)
+		}
+		remaining = append(remaining, sub)
+	}
+	for i := range csvs.Items {
+		csv := &csvs.Items[i]
+		if key := client.ObjectKeyFromObject(csv); !csv.IsCopied() && csv.GetDeletionTimestamp() != nil && !deleting[key] {
+			deleting[key] = true
+			remaining = append(remaining, csv)
+		}
+	}
+
+	// the operator's namespace goes last, so that the operator can take part in the deletion of its CSVs
+	if len(remaining) == 0 {
+		ogs := &operatorsv1.OperatorGroupList{}
+		if err := r.List(ctx, ogs); err != nil {
+			return ctrl.Result{}, err
+		}
+		for i := range ogs.Items {
+			if metav1.IsControlledBy(&ogs.Items[i], in) {
+				remaining = append(remaining, &ogs.Items[i])
+			}
+		}
+		namespaces := &corev1.NamespaceList{}
+		if err := r.List(ctx, namespaces, selector); err != nil {
+			return ctrl.Result{}, err
+		}
+		for i := range namespaces.Items {
+			if metav1.IsControlledBy(&namespaces.Items[i], in) {
+				remaining = append(remaining, &namespaces.Items[i])
+			}
+		}
+	}
+
+	if len(remaining) > 0 {
+		for _, obj := range remaining {
+			if obj.GetDeletionTimestamp() != nil {
+				continue
+			}
+			log.V(1).Info("deleting operator resource", "namespace", obj.GetNamespace(), "name", obj.GetName())
+			if err := r.Delete(ctx, obj, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !apierrors.IsNotFound(err) {
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{RequeueAfter: uninstallRequeueDelay}, nil
+	}
+
+	log.Info("operator uninstalled")
+	controllerutil.RemoveFinalizer(in, OperatorUninstallFinalizer)
+	return ctrl.Result{}, r.Update(ctx, in)
+}
@@ -0,0 +1,175 @@
+package operators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	operatorsv1 "github.com/operator-framework/api/pkg/operators/v1"
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/operators/decorators"
+)
+
+func TestOperatorInstall(t *testing.T) {
+	newReconciler := func(t *testing.T, objs ...client.Object) (*OperatorReconciler, client.Client) {
+		scheme := runtime.NewScheme()
+		require.NoError(t, AddToScheme(scheme))
+		cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+		r, err := NewOperatorReconciler(cli, logr.Discard(), scheme)
+		require.NoError(t, err)
+		return r, cli
+	}
+	newOperator := func() *operatorsv1.Operator {
+		return &operatorsv1.Operator{
+			ObjectMeta: metav1.ObjectMeta{Name: "etcd"},
+			Spec: operatorsv1.OperatorSpec{
+				Package:          "etcd",
+				Channel:          "stable",
+				Source:           "operatorhub",
+				SourceNamespace:  "olm",
+				InstallNamespace: "etcd-system",
+				TargetNamespaces: []string{"apps"},
+			},
+		}
+	}
+	reconcile := func(t *testing.T, r *OperatorReconciler) ctrl.Result {
+		result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "etcd"}})
+		require.NoError(t, err)
+		return result
+	}
+	componentLabel := decorators.ComponentLabelKeyPrefix + "etcd"
+
+	t.Run("InstallsPackage", func(t *testing.T) {
+		ctx := context.Background()
+		r, cli := newReconciler(t, newOperator())
+		require.False(t, reconcile(t, r).Requeue)
+
+		operator := &operatorsv1.Operator{}
+		require.NoError(t, cli.Get(ctx, client.ObjectKey{Name: "etcd"}, operator))
+		require.Contains(t, operator.GetFinalizers(), OperatorUninstallFinalizer)
+
+		ns := &corev1.Namespace{}
+		require.NoError(t, cli.Get(ctx, client.ObjectKey{Name: "etcd-system"}, ns))
+		require.True(t, metav1.IsControlledBy(ns, operator))
+		require.Contains(t, ns.GetLabels(), componentLabel)
+
+		og := &operatorsv1.OperatorGroup{}
+		require.NoError(t, cli.Get(ctx, client.ObjectKey{Namespace: "etcd-system", Name: "etcd"}, og))
+		require.True(t, metav1.IsControlledBy(og, operator))
+		require.Equal(t, []string{"apps"}, og.Spec.TargetNamespaces)
+
+		sub := &operatorsv1alpha1.Subscription{}
+		require.NoError(t, cli.Get(ctx, client.ObjectKey{Namespace: "etcd-system", Name: "etcd"}, sub))
+		require.True(t, metav1.IsControlledBy(sub, operator))
+		require.Equal(t, &operatorsv1alpha1.SubscriptionSpec{
+			Package:                "etcd",
+			Channel:                "stable",
+			CatalogSource:          "operatorhub",
+			CatalogSourceNamespace: "olm",
+			InstallPlanApproval:    operatorsv1alpha1.ApprovalAutomatic,
+		}, sub.Spec)
+
+		// spec changes are carried over to the subscription and operatorgroup
+		operator.Spec.Channel = "fast"
+		operator.Spec.InstallPlanApproval = string(operatorsv1alpha1.ApprovalManual)
+		operator.Spec.TargetNamespaces = nil
+		require.NoError(t, cli.Update(ctx, operator))
+		reconcile(t, r)
+		require.NoError(t, cli.Get(ctx, client.ObjectKey{Namespace: "etcd-system", Name: "etcd"}, sub))
+		require.Equal(t, "fast", sub.Spec.Channel)
+		require.Equal(t, operatorsv1alpha1.ApprovalManual, sub.Spec.InstallPlanApproval)
+		og = &operatorsv1.OperatorGroup{}
+		require.NoError(t, cli.Get(ctx, client.ObjectKey{Namespace: "etcd-system", Name: "etcd"}, og))
+		require.Empty(t, og.Spec.TargetNamespaces)
+	})
+
+	t.Run("KeepsExistingOperatorGroup", func(t *testing.T) {
+		ctx := context.Background()
+		existing := &operatorsv1.OperatorGroup{ObjectMeta: metav1.ObjectMeta{Namespace: "etcd-system", Name: "global"}}
+		r, cli := newReconciler(t, newOperator(), &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "etcd-system"}}, existing)
+		reconcile(t, r)
+
+		ogs := &operatorsv1.OperatorGroupList{}
+		require.NoError(t, cli.List(ctx, ogs, client.InNamespace("etcd-system")))
+		require.Len(t, ogs.Items, 1)
+		require.Empty(t, ogs.Items[0].Spec.TargetNamespaces)
+
+		ns := &corev1.Namespace{}
+		require.NoError(t, cli.Get(ctx, client.ObjectKey{Name: "etcd-system"}, ns))
+		require.Empty(t, ns.GetOwnerReferences())
+	})
+
+	t.Run("RejectsUnownedSubscription", func(t *testing.T) {
+		ctx := context.Background()
+		existing := &operatorsv1alpha1.Subscription{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "etcd-system", Name: "etcd"},
+			Spec:       &operatorsv1alpha1.SubscriptionSpec{Package: "etcd", Channel: "alpha"},
+		}
+		r, cli := newReconciler(t, newOperator(), existing)
+		require.True(t, reconcile(t, r).Requeue)
+
+		sub := &operatorsv1alpha1.Subscription{}
+		require.NoError(t, cli.Get(ctx, client.ObjectKey{Namespace: "etcd-system", Name: "etcd"}, sub))
+		require.Equal(t, "alpha", sub.Spec.Channel)
+		require.Empty(t, sub.GetOwnerReferences())
+	})
+
+	t.Run("UninstallsPackage", func(t *testing.T) {
+		ctx := context.Background()
+		r, cli := newReconciler(t, newOperator())
+		reconcile(t, r)
+
+		sub := &operatorsv1alpha1.Subscription{}
+		require.NoError(t, cli.Get(ctx, client.ObjectKey{Namespace: "etcd-system", Name: "etcd"}, sub))
+		sub.Status.InstalledCSV = "etcd.v1"
+		require.NoError(t, cli.Status().Update(ctx, sub))
+		csv := &operatorsv1alpha1.ClusterServiceVersion{ObjectMeta: metav1.ObjectMeta{
+			Namespace:  "etcd-system",
+			Name:       "etcd.v1",
+			Labels:     map[string]string{componentLabel: ""},
+			Finalizers: []string{"operators.coreos.com/csv-cleanup"},
+		}}
+		require.NoError(t, cli.Create(ctx, csv))
+		adopted := &operatorsv1alpha1.ClusterServiceVersion{ObjectMeta: metav1.ObjectMeta{
+			Namespace: "apps",
+			Name:      "etcd-tools.v1",
+			Labels:    map[string]string{componentLabel: ""},
+		}}
+		require.NoError(t, cli.Create(ctx, adopted))
+
+		operator := &operatorsv1.Operator{}
+		require.NoError(t, cli.Get(ctx, client.ObjectKey{Name: "etcd"}, operator))
+		require.NoError(t, cli.Delete(ctx, operator))
+
+		// the subscription is removed, and the namespace is kept until the csv is gone
+		require.NotZero(t, reconcile(t, r).RequeueAfter)
+		require.True(t, apierrors.IsNotFound(cli.Get(ctx, client.ObjectKey{Namespace: "etcd-system", Name: "etcd"}, sub)))
+		require.NoError(t, cli.Get(ctx, client.ObjectKey{Namespace: "etcd-system", Name: "etcd.v1"}, csv))
+		require.NotNil(t, csv.GetDeletionTimestamp())
+		require.NotZero(t, reconcile(t, r).RequeueAfter)
+		require.NoError(t, cli.Get(ctx, client.ObjectKey{Name: "etcd-system"}, &corev1.Namespace{}))
+
+		csv.SetFinalizers(nil)
+		require.NoError(t, cli.Update(ctx, csv))
+		require.NotZero(t, reconcile(t, r).RequeueAfter)
+		require.True(t, apierrors.IsNotFound(cli.Get(ctx, client.ObjectKey{Namespace: "etcd-system", Name: "etcd"}, &operatorsv1.OperatorGroup{})))
+		require.True(t, apierrors.IsNotFound(cli.Get(ctx, client.ObjectKey{Name: "etcd-system"}, &corev1.Namespace{})))
+
+		require.Zero(t, reconcile(t, r))
+		require.True(t, apierrors.IsNotFound(cli.Get(ctx, client.ObjectKey{Name: "etcd"}, operator)))
+
+		// csvs the operator only adopted aren't deleted
+		require.NoError(t, cli.Get(ctx, client.ObjectKeyFromObject(adopted), adopted))
+		require.Nil(t, adopted.GetDeletionTimestamp())
+	})
+}
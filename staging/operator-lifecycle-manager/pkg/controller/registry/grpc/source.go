@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"fmt"
 	"net"
 	"net/url"
 	"os"
@@ -25,6 +26,12 @@ type SourceMeta struct {
 	ConnectionState connectivity.State
 }
 
+// ConnectionID identifies the connection to the source, which changes whenever the connection changes state.
+// Registries that replace their content break the connections to them, so the ID also changes in that case.
+func (m *SourceMeta) ConnectionID() string {
+	return fmt.Sprintf("%s@%d", m.Address, m.LastConnect.UnixNano())
+}
+
 type SourceState struct {
 	Key   registry.CatalogKey
 	State connectivity.State
@@ -87,6 +94,15 @@ func (s *SourceStore) GetMeta(key registry.CatalogKey) *SourceMeta {
 	return &source.SourceMeta
 }
 
+// ConnectionID returns the ID of the connection to the given source, or "" if there's no such source.
+func (s *SourceStore) ConnectionID(key registry.CatalogKey) string {
+	meta := s.GetMeta(key)
+	if meta == nil {
+		return ""
+	}
+	return meta.ConnectionID()
+}
+
 func (s *SourceStore) Exists(key registry.CatalogKey) bool {
 	s.sourcesLock.RLock()
 	_, ok := s.sources[key]
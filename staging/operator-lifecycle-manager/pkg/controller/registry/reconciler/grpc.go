@@ -128,10 +128,11 @@ func (s *grpcCatalogSourceDecorator) Pod(saName string) *corev1.Pod {
 }
 
 type GrpcRegistryReconciler struct {
-	now       nowFunc
-	Lister    operatorlister.OperatorLister
-	OpClient  operatorclient.ClientInterface
-	SSAClient *controllerclient.ServerSideApplier
+	now             nowFunc
+	Lister          operatorlister.OperatorLister
+	OpClient        operatorclient.ClientInterface
+	SSAClient       *controllerclient.ServerSideApplier
+	ContentDigester ContentDigester
 }
 
 var _ RegistryReconciler = &GrpcRegistryReconciler{}
@@ -152,7 +153,7 @@ func (c *GrpcRegistryReconciler) currentPods(source grpcCatalogSourceDecorator)
 		logrus.WithError(err).Warn("couldn't find pod in cache")
 		return nil
 	}
-	if len(pods) > 1 {
+	if len(pods) > 1 && source.Replicas() == nil {
 		logrus.WithField("selector", source.Selector()).Warn("multiple pods found for selector")
 	}
 	return pods
@@ -201,8 +202,18 @@ func (c *GrpcRegistryReconciler) EnsureRegistryServer(catalogSource *v1alpha1.Ca
 	if err != nil && !k8serror.IsAlreadyExists(err) {
 		return errors.Wrapf(err, "error ensuring service account: %s", source.GetName())
 	}
-	if err := c.ensurePod(source, sa.GetName(), overwritePod); err != nil {
-		return errors.Wrapf(err, "error ensuring pod: %s", source.Pod(sa.Name).GetName())
+	if source.Replicas() != nil {
+		if err := c.ensureDeployment(source, sa.GetName()); err != nil {
+			return errors.Wrapf(err, "error ensuring deployment: %s", source.GetName())
+		}
+	} else {
+		// replace the replicas of a catalog that no longer requests them with a single pod
+		if err := c.removeDeployment(source, sa.GetName()); err != nil {
+			return err
+		}
+		if err := c.ensurePod(source, sa.GetName(), overwritePod); err != nil {
+			return errors.Wrapf(err, "error ensuring pod: %s", source.Pod(sa.Name).GetName())
+		}
 	}
 	if err := c.ensureUpdatePod(source, sa.Name); err != nil {
 		if _, ok := err.(UpdateNotReadyErr); ok {
@@ -215,6 +226,8 @@ func (c *GrpcRegistryReconciler) EnsureRegistryServer(catalogSource *v1alpha1.Ca
 	}
 
 	if overwritePod {
+		// the content of the new pod hasn't been digested yet
+		catalogSource.Status.ContentDigest = ""
 		now := c.now()
 		service := source.Service()
 		catalogSource.Status.RegistryServiceStatus = &v1alpha1.RegistryServiceStatus{
@@ -273,7 +286,11 @@ func (c *GrpcRegistryReconciler) ensureUpdatePod(source grpcCatalogSourceDecorat
 	currentLivePods := c.currentPods(source)
 	currentUpdatePods := c.currentUpdatePods(source)
 
-	if source.Update() && len(currentUpdatePods) == 0 {
+	due, err := updateDue(source, c.now().Time)
+	if err != nil {
+		return errors.Wrapf(err, "invalid catalog update strategy")
+	}
+	if due && len(currentUpdatePods) == 0 {
 		logrus.WithField("CatalogSource", source.GetName()).Infof("catalog update required at %s", time.Now().String())
 		pod, err := c.createUpdatePod(source, saName)
 		if err != nil {
@@ -299,17 +316,46 @@ func (c *GrpcRegistryReconciler) ensureUpdatePod(source grpcCatalogSourceDecorat
 	}
 
 	for _, updatePod := range currentUpdatePods {
+		source.Status.LastCheckedDigest = imageID(updatePod)
 		// if container imageID IDs are different, switch the serving pods
 		if imageChanged(updatePod, currentLivePods) {
-			err := c.promoteCatalog(updatePod, source.GetName())
-			if err != nil {
-				return fmt.Errorf("detected imageID change: error during update: %s", err)
+			if source.Spec.UpdateStrategy.ContentAware {
+				changed, err := c.contentChanged(source, updatePod, currentLivePods)
+				if _, ok := err.(UpdateNotReadyErr); ok {
+					return err
+				}
+				if err != nil {
+					return errors.Wrapf(err, "detected imageID change: error comparing catalog content")
+				}
+				if !changed {
+					logrus.WithField("CatalogSource", source.GetName()).Info("catalog polling result: imageID changed without content changes, no update")
+					if err := c.removePods([]*corev1.Pod{updatePod}, source.GetNamespace()); err != nil {
+						return errors.Wrapf(err, "error deleting duplicate catalog polling pod: %s", updatePod.GetName())
+					}
+					continue
+				}
 			}
-			// remove old catalog source pod
-			err = c.removePods(currentLivePods, source.GetNamespace())
-			if err != nil {
-				return errors.Wrapf(err, "detected imageID change: error deleting old catalog source pod")
+			if source.Replicas() != nil {
+				// roll the replicas out to the new image instead of promoting the single update pod
+				if err := c.rolloutDeployment(source, imageID(updatePod)); err != nil {
+					return fmt.Errorf("detected imageID change: error during update: %s", err)
+				}
+				if err := c.removePods([]*corev1.Pod{updatePod}, source.GetNamespace()); err != nil {
+					return errors.Wrapf(err, "detected imageID change: error deleting catalog polling pod")
+				}
+			} else {
+				err := c.promoteCatalog(updatePod, source.GetName())
+				if err != nil {
+					return fmt.Errorf("detected imageID change: error during update: %s", err)
+				}
+				// remove old catalog source pod
+				err = c.removePods(currentLivePods, source.GetNamespace())
+				if err != nil {
+					return errors.Wrapf(err, "detected imageID change: error deleting old catalog source pod")
+				}
 			}
+			now := c.now()
+			source.Status.LastContentChange = &now
 			// done syncing
 			logrus.WithField("CatalogSource", source.GetName()).Infof("detected imageID change: catalogsource pod updated at %s", time.Now().String())
 			return nil
@@ -441,7 +487,8 @@ func (c *GrpcRegistryReconciler) CheckRegistryServer(catalogSource *v1alpha1.Cat
 	// Check on registry resources
 	// TODO: add gRPC health check
 	if len(c.currentPodsWithCorrectImageAndSpec(source, source.ServiceAccount().GetName())) < 1 ||
-		c.currentService(source) == nil {
+		c.currentService(source) == nil ||
+		(source.Replicas() != nil && c.currentDeployment(source) == nil) {
 		healthy = false
 		return
 	}
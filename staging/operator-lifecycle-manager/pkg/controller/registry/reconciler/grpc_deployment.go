@@ -0,0 +1,279 @@
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/rand"
+
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	hashutil "github.com/operator-framework/operator-lifecycle-manager/pkg/lib/kubernetes/pkg/util/hash"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/lib/ownerutil"
+)
+
+const (
+	// DeploymentHashLabelKey is the label holding the hash of the spec of a catalog Deployment, used to detect spec changes.
+	DeploymentHashLabelKey = "olm.deployment-spec-hash"
+
+	// CatalogImageIDAnnotationKey is the pod template annotation recording the catalog image ID a catalog Deployment
+	// was last rolled out for. Updating it rolls out the catalog image again, pulling its latest content.
+	CatalogImageIDAnnotationKey = "catalogsource.operators.coreos.com/image-id"
+)
+
+// Replicas returns the number of registry server replicas requested for the catalog, or nil if the registry
+// server runs as a single pod.
+func (s *grpcCatalogSourceDecorator) Replicas() *int32 {
+	if s.Spec.GrpcPodConfig == nil {
+		return nil
+	}
+	return s.Spec.GrpcPodConfig.Replicas
+}
+
+// Deployment returns the Deployment running the registry server replicas of the catalog.
+func (s *grpcCatalogSourceDecorator) Deployment(saName string) *appsv1.Deployment {
+	pod := s.Pod(saName)
+	// update requests change the catalog's annotations without changing what the replicas serve
+	annotations := map[string]string{}
+	for key, value := range pod.GetAnnotations() {
+		if key != CatalogSourceUpdateRequestedAnnotationKey {
+			annotations[key] = value
+		}
+	}
+	template := corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels:      pod.GetLabels(),
+			Annotations: annotations,
+		},
+		Spec: pod.Spec,
+	}
+	if config := s.Spec.GrpcPodConfig; config != nil && len(config.TopologySpreadConstraints) > 0 {
+		template.Spec.TopologySpreadConstraints = make([]corev1.TopologySpreadConstraint, len(config.TopologySpreadConstraints))
+		for i, constraint := range config.TopologySpreadConstraints {
+			template.Spec.TopologySpreadConstraints[i] = *constraint.DeepCopy()
+		}
+	} else if replicas := s.Replicas(); replicas != nil && *replicas > 1 {
+		// Spread the replicas across nodes so that draining a node doesn't take the catalog down
+		template.Spec.TopologySpreadConstraints = []corev1.TopologySpreadConstraint{{
+			MaxSkew:           1,
+			TopologyKey:       corev1.LabelHostname,
+			WhenUnsatisfiable: corev1.ScheduleAnyway,
+			LabelSelector:     &metav1.LabelSelector{MatchLabels: s.Labels()},
+		}}
+	}
+
+	maxUnavailable := intstr.FromInt(0)
+	maxSurge := intstr.FromInt(1)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      s.GetName(),
+			Namespace: s.GetNamespace(),
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: s.Replicas(),
+			Selector: &metav1.LabelSelector{MatchLabels: s.Labels()},
+			Strategy: appsv1.DeploymentStrategy{
+				Type: appsv1.RollingUpdateDeploymentStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateDeployment{
+					MaxUnavailable: &maxUnavailable,
+					MaxSurge:       &maxSurge,
+				},
+			},
+			Template: template,
+		},
+	}
+
+	labels := s.Labels()
+	labels[DeploymentHashLabelKey] = hashDeploymentSpec(deployment.Spec)
+	deployment.SetLabels(labels)
+	ownerutil.AddOwner(deployment, s.CatalogSource, false, false)
+	return deployment
+}
+
+// PodDisruptionBudget returns the PodDisruptionBudget of the registry server replicas of the catalog,
+// or nil if the catalog doesn't request one.
+func (s *grpcCatalogSourceDecorator) PodDisruptionBudget() *policyv1.PodDisruptionBudget {
+	if s.Spec.GrpcPodConfig == nil || s.Spec.GrpcPodConfig.Replicas == nil || s.Spec.GrpcPodConfig.PodDisruptionBudget == nil {
+		return nil
+	}
+	config := s.Spec.GrpcPodConfig.PodDisruptionBudget
+	pdb := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      s.GetName(),
+			Namespace: s.GetNamespace(),
+			Labels:    s.Labels(),
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: s.Labels()},
+		},
+	}
+	switch {
+	case config.MinAvailable != nil:
+		minAvailable := *config.MinAvailable
+		pdb.Spec.MinAvailable = &minAvailable
+	case config.MaxUnavailable != nil:
+		maxUnavailable := *config.MaxUnavailable
+		pdb.Spec.MaxUnavailable = &maxUnavailable
+	default:
+		maxUnavailable := intstr.FromInt(1)
+		pdb.Spec.MaxUnavailable = &maxUnavailable
+	}
+	ownerutil.AddOwner(pdb, s.CatalogSource, false, false)
+	return pdb
+}
+
+// hashDeploymentSpec calculates a hash given a copy of the deployment spec
+func hashDeploymentSpec(spec appsv1.DeploymentSpec) string {
+	hasher := fnv.New32a()
+	hashutil.DeepHashObject(hasher, &spec)
+	return rand.SafeEncodeString(fmt.Sprint(hasher.Sum32()))
+}
+
+func (c *GrpcRegistryReconciler) currentDeployment(source grpcCatalogSourceDecorator) *appsv1.Deployment {
+	deployment, err := c.Lister.AppsV1().DeploymentLister().Deployments(source.GetNamespace()).Get(source.GetName())
+	if err != nil {
+		if !k8serror.IsNotFound(err) {
+			logrus.WithError(err).WithField("deployment", source.GetName()).Warn("couldn't find deployment in cache")
+		}
+		return nil
+	}
+	if !ownerutil.IsOwnedBy(deployment, source.CatalogSource) {
+		return nil
+	}
+	return deployment
+}
+
+// ensureDeployment ensures that the registry server replicas run the latest spec of the catalog.
+// Spec changes are rolled out without taking the catalog down, and the single registry server pod
+// a catalog may have had before is removed once a replica is available.
+func (c *GrpcRegistryReconciler) ensureDeployment(source grpcCatalogSourceDecorator, saName string) error {
+	deployment := source.Deployment(saName)
+	current := c.currentDeployment(source)
+	switch {
+	case current == nil:
+		if _, err := c.OpClient.CreateDeployment(deployment); err != nil {
+			return err
+		}
+	case current.GetLabels()[DeploymentHashLabelKey] != deployment.GetLabels()[DeploymentHashLabelKey]:
+		// keep rolling out the image the catalog was last updated to
+		if imageID, ok := current.Spec.Template.GetAnnotations()[CatalogImageIDAnnotationKey]; ok {
+			deployment.Spec.Template.Annotations[CatalogImageIDAnnotationKey] = imageID
+		}
+		deployment.SetResourceVersion(current.GetResourceVersion())
+		if _, err := c.OpClient.KubernetesInterface().AppsV1().Deployments(deployment.GetNamespace()).Update(context.TODO(), deployment, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	case current.Status.AvailableReplicas > 0:
+		var pods []*corev1.Pod
+		for _, p := range c.currentPods(source) {
+			if ownerutil.IsOwnedByKind(p, v1alpha1.CatalogSourceKind) {
+				pods = append(pods, p)
+			}
+		}
+		if err := c.removePods(pods, source.GetNamespace()); err != nil {
+			return errors.Wrapf(err, "error deleting registry server pod replaced by deployment")
+		}
+	}
+	return c.ensurePodDisruptionBudget(source)
+}
+
+// rolloutDeployment rolls out the registry server replicas again for a new catalog image ID.
+func (c *GrpcRegistryReconciler) rolloutDeployment(source grpcCatalogSourceDecorator, imageID string) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"annotations": map[string]string{
+						CatalogImageIDAnnotationKey: imageID,
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = c.OpClient.KubernetesInterface().AppsV1().Deployments(source.GetNamespace()).Patch(context.TODO(), source.GetName(), types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
+
+// removeDeployment replaces the registry server replicas of a catalog that no longer requests replicas with a
+// single registry server pod. The replicas are only removed once that pod is ready, and an UpdateNotReadyErr is
+// returned until then.
+func (c *GrpcRegistryReconciler) removeDeployment(source grpcCatalogSourceDecorator, saName string) error {
+	if c.currentDeployment(source) == nil {
+		return nil
+	}
+	var pods []*corev1.Pod
+	for _, p := range c.currentPods(source) {
+		if ownerutil.IsOwnedByKind(p, v1alpha1.CatalogSourceKind) {
+			pods = append(pods, p)
+		}
+	}
+	if len(pods) == 0 {
+		pod, err := c.OpClient.KubernetesInterface().CoreV1().Pods(source.GetNamespace()).Create(context.TODO(), source.Pod(saName), metav1.CreateOptions{})
+		if err != nil {
+			return errors.Wrapf(err, "error creating registry server pod replacing deployment: %s", source.GetName())
+		}
+		return UpdateNotReadyErr{catalogName: source.GetName(), podName: pod.GetName()}
+	}
+	for _, p := range pods {
+		if !podReady(p) {
+			return UpdateNotReadyErr{catalogName: source.GetName(), podName: p.GetName()}
+		}
+	}
+
+	if err := c.ensurePodDisruptionBudget(source); err != nil {
+		return err
+	}
+	if err := c.OpClient.DeleteDeployment(source.GetNamespace(), source.GetName(), &metav1.DeleteOptions{}); err != nil && !k8serror.IsNotFound(err) {
+		return errors.Wrapf(err, "error deleting registry server deployment: %s", source.GetName())
+	}
+	return nil
+}
+
+// ensurePodDisruptionBudget ensures that the catalog has the PodDisruptionBudget it requests, if any.
+func (c *GrpcRegistryReconciler) ensurePodDisruptionBudget(source grpcCatalogSourceDecorator) error {
+	client := c.OpClient.KubernetesInterface().PolicyV1().PodDisruptionBudgets(source.GetNamespace())
+	pdb := source.PodDisruptionBudget()
+	current, err := client.Get(context.TODO(), source.GetName(), metav1.GetOptions{})
+	if k8serror.IsNotFound(err) {
+		if pdb == nil {
+			return nil
+		}
+		_, err = client.Create(context.TODO(), pdb, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if !ownerutil.IsOwnedBy(current, source.CatalogSource) {
+		if pdb == nil {
+			return nil
+		}
+		return fmt.Errorf("pod disruption budget %s is not owned by the catalog source", current.GetName())
+	}
+	if pdb == nil {
+		if err := client.Delete(context.TODO(), current.GetName(), metav1.DeleteOptions{}); err != nil && !k8serror.IsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+	if equality.Semantic.DeepEqual(current.Spec, pdb.Spec) {
+		return nil
+	}
+	pdb.SetResourceVersion(current.GetResourceVersion())
+	_, err = client.Update(context.TODO(), pdb, metav1.UpdateOptions{})
+	return err
+}
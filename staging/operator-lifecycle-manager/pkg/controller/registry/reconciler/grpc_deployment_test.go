@@ -0,0 +1,154 @@
+package reconciler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/lib/operatorclient"
+)
+
+func TestGrpcRegistryReconcilerDeployment(t *testing.T) {
+	now := func() metav1.Time { return metav1.Date(2018, time.January, 26, 20, 40, 0, 0, time.UTC) }
+	replicas := int32(3)
+	minAvailable := intstr.FromInt(2)
+	withReplicas := func(pdb *v1alpha1.CatalogPodDisruptionBudget) *v1alpha1.CatalogSource {
+		catsrc := validGrpcCatalogSource("test-img", "")
+		catsrc.Spec.GrpcPodConfig = &v1alpha1.GrpcPodConfig{Replicas: &replicas, PodDisruptionBudget: pdb}
+		return catsrc
+	}
+	deploymentFor := func(catsrc *v1alpha1.CatalogSource) *appsv1.Deployment {
+		decorated := grpcCatalogSourceDecorator{catsrc}
+		return decorated.Deployment(decorated.ServiceAccount().GetName())
+	}
+	listPods := func(t *testing.T, client operatorclient.ClientInterface) []corev1.Pod {
+		listOptions := metav1.ListOptions{LabelSelector: labels.SelectorFromSet(labels.Set{CatalogSourceLabelKey: "img-catalog"}).String()}
+		pods, err := client.KubernetesInterface().CoreV1().Pods(testNamespace).List(context.TODO(), listOptions)
+		require.NoError(t, err)
+		return pods.Items
+	}
+
+	t.Run("CreatesReplicas", func(t *testing.T) {
+		stopc := make(chan struct{})
+		defer close(stopc)
+
+		catsrc := withReplicas(&v1alpha1.CatalogPodDisruptionBudget{})
+		factory, client := fakeReconcilerFactory(t, stopc, withNow(now))
+		require.NoError(t, factory.ReconcilerForSource(catsrc).EnsureRegistryServer(catsrc))
+		require.Equal(t, "img-catalog", catsrc.Status.RegistryServiceStatus.ServiceName)
+
+		deployment, err := client.KubernetesInterface().AppsV1().Deployments(testNamespace).Get(context.TODO(), "img-catalog", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.Equal(t, replicas, *deployment.Spec.Replicas)
+		require.Equal(t, intstr.FromInt(0), *deployment.Spec.Strategy.RollingUpdate.MaxUnavailable)
+		require.Equal(t, map[string]string{CatalogSourceLabelKey: "img-catalog"}, deployment.Spec.Selector.MatchLabels)
+		require.Len(t, deployment.Spec.Template.Spec.TopologySpreadConstraints, 1)
+		require.Equal(t, corev1.LabelHostname, deployment.Spec.Template.Spec.TopologySpreadConstraints[0].TopologyKey)
+
+		pdb, err := client.KubernetesInterface().PolicyV1().PodDisruptionBudgets(testNamespace).Get(context.TODO(), "img-catalog", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.Equal(t, intstr.FromInt(1), *pdb.Spec.MaxUnavailable)
+		require.Nil(t, pdb.Spec.MinAvailable)
+
+		// the deployment runs the registry server pods
+		require.Empty(t, listPods(t, client))
+	})
+
+	t.Run("UpdatesReplicasAndReplacesSinglePod", func(t *testing.T) {
+		stopc := make(chan struct{})
+		defer close(stopc)
+
+		catsrc := withReplicas(&v1alpha1.CatalogPodDisruptionBudget{MinAvailable: &minAvailable})
+		old := withReplicas(nil)
+		old.Spec.GrpcPodConfig.Replicas = nil
+		deployment := deploymentFor(old)
+		deployment.Spec.Template.Annotations[CatalogImageIDAnnotationKey] = "quay.io/catalog@sha256:abc"
+		objs := append(objectsForCatalogSource(old), deployment)
+
+		factory, client := fakeReconcilerFactory(t, stopc, withNow(now), withK8sObjs(objs...))
+		rec := factory.ReconcilerForSource(catsrc)
+		require.NoError(t, rec.EnsureRegistryServer(catsrc))
+
+		updated, err := client.KubernetesInterface().AppsV1().Deployments(testNamespace).Get(context.TODO(), "img-catalog", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.Equal(t, replicas, *updated.Spec.Replicas)
+		require.Equal(t, deploymentFor(catsrc).GetLabels(), updated.GetLabels())
+		require.Equal(t, "quay.io/catalog@sha256:abc", updated.Spec.Template.Annotations[CatalogImageIDAnnotationKey])
+
+		pdb, err := client.KubernetesInterface().PolicyV1().PodDisruptionBudgets(testNamespace).Get(context.TODO(), "img-catalog", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.Equal(t, minAvailable, *pdb.Spec.MinAvailable)
+
+		// the single pod is removed once the deployment has available replicas
+		require.NotEmpty(t, listPods(t, client))
+		updated.Status.AvailableReplicas = 1
+		_, err = client.KubernetesInterface().AppsV1().Deployments(testNamespace).UpdateStatus(context.TODO(), updated, metav1.UpdateOptions{})
+		require.NoError(t, err)
+		require.Eventually(t, func() bool {
+			return rec.EnsureRegistryServer(catsrc) == nil && len(listPods(t, client)) == 0
+		}, 5*time.Second, 100*time.Millisecond)
+	})
+
+	t.Run("RollsOutNewImage", func(t *testing.T) {
+		stopc := make(chan struct{})
+		defer close(stopc)
+
+		catsrc := withReplicas(nil)
+		factory, client := fakeReconcilerFactory(t, stopc, withNow(now), withK8sObjs(deploymentFor(catsrc)))
+		rec := factory.ReconcilerForSource(catsrc).(*GrpcRegistryReconciler)
+		require.NoError(t, rec.rolloutDeployment(grpcCatalogSourceDecorator{catsrc}, "quay.io/catalog@sha256:def"))
+
+		updated, err := client.KubernetesInterface().AppsV1().Deployments(testNamespace).Get(context.TODO(), "img-catalog", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.Equal(t, "quay.io/catalog@sha256:def", updated.Spec.Template.Annotations[CatalogImageIDAnnotationKey])
+		// rollouts don't count as spec changes
+		require.Equal(t, deploymentFor(catsrc).GetLabels(), updated.GetLabels())
+	})
+
+	t.Run("RemovesReplicas", func(t *testing.T) {
+		stopc := make(chan struct{})
+		defer close(stopc)
+
+		old := withReplicas(&v1alpha1.CatalogPodDisruptionBudget{})
+		decorated := grpcCatalogSourceDecorator{old}
+		objs := []runtime.Object{deploymentFor(old), decorated.PodDisruptionBudget(), decorated.Service(), decorated.ServiceAccount()}
+		catsrc := validGrpcCatalogSource("test-img", "")
+
+		factory, client := fakeReconcilerFactory(t, stopc, withNow(now), withK8sObjs(objs...))
+		rec := factory.ReconcilerForSource(catsrc)
+		require.IsType(t, UpdateNotReadyErr{}, rec.EnsureRegistryServer(catsrc))
+
+		// the replicas are kept until the replacement pod is ready
+		_, err := client.KubernetesInterface().AppsV1().Deployments(testNamespace).Get(context.TODO(), "img-catalog", metav1.GetOptions{})
+		require.NoError(t, err)
+		pods := listPods(t, client)
+		require.Len(t, pods, 1)
+		require.Eventually(t, func() bool {
+			return rec.EnsureRegistryServer(catsrc) != nil && len(listPods(t, client)) == 1
+		}, 5*time.Second, 100*time.Millisecond)
+
+		pod := pods[0]
+		pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+		_, err = client.KubernetesInterface().CoreV1().Pods(testNamespace).UpdateStatus(context.TODO(), &pod, metav1.UpdateOptions{})
+		require.NoError(t, err)
+		require.Eventually(t, func() bool {
+			return rec.EnsureRegistryServer(catsrc) == nil
+		}, 5*time.Second, 100*time.Millisecond)
+
+		_, err = client.KubernetesInterface().AppsV1().Deployments(testNamespace).Get(context.TODO(), "img-catalog", metav1.GetOptions{})
+		require.True(t, k8serrors.IsNotFound(err))
+		_, err = client.KubernetesInterface().PolicyV1().PodDisruptionBudgets(testNamespace).Get(context.TODO(), "img-catalog", metav1.GetOptions{})
+		require.True(t, k8serrors.IsNotFound(err))
+		require.Len(t, listPods(t, client), 1)
+	})
+}
@@ -7,12 +7,29 @@ import (
 
 	"github.com/operator-framework/api/pkg/operators/v1alpha1"
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/lib/queueinformer"
+	sharedtime "github.com/operator-framework/operator-lifecycle-manager/pkg/lib/time"
 )
 
 // SyncRegistryUpdateInterval returns a duration to use when requeuing the catalog source for reconciliation.
 // This ensures that the catalog is being synced on the correct time interval based on its spec.
 // Note: this function assumes the catalog has an update strategy set.
 func SyncRegistryUpdateInterval(source *v1alpha1.CatalogSource, now time.Time) time.Duration {
+	resync := queueinformer.DefaultResyncPeriod
+	if strategy := source.Spec.UpdateStrategy; strategy.RegistryPoll != nil && strategy.Interval != nil {
+		resync = pollingResync(source, now)
+	}
+
+	// Resync when the next update window opens if that's sooner
+	if open, _, next, err := sharedtime.WindowsOpen(source.Spec.UpdateStrategy.Windows, now); err == nil && !open && !next.IsZero() {
+		if untilOpen := next.Sub(now); untilOpen < resync {
+			return untilOpen
+		}
+	}
+	return resync
+}
+
+// pollingResync returns a duration to use when requeuing a catalog source with a polling interval.
+func pollingResync(source *v1alpha1.CatalogSource, now time.Time) time.Duration {
 	pollingInterval := source.Spec.UpdateStrategy.Interval.Duration
 	latestPoll := source.Status.LatestImageRegistryPoll
 	creationTimestamp := source.CreationTimestamp.Time
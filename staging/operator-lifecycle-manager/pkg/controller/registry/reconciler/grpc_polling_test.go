@@ -167,6 +167,34 @@ func TestSyncRegistryUpdateInterval(t *testing.T) {
 			},
 			expected: queueinformer.DefaultResyncPeriod,
 		},
+		{
+			name: "UpdateWindowOpensBeforeDefaultResyncPeriod",
+			source: &v1alpha1.CatalogSource{
+				Spec: v1alpha1.CatalogSourceSpec{
+					UpdateStrategy: &v1alpha1.UpdateStrategy{
+						Windows: []v1alpha1.UpgradeWindow{{
+							Schedule: "0 15 * * *",
+							Duration: metav1.Duration{Duration: time.Hour},
+						}},
+					},
+				},
+			},
+			expected: 13 * time.Minute,
+		},
+		{
+			name: "UpdateWindowOpensAfterDefaultResyncPeriod",
+			source: &v1alpha1.CatalogSource{
+				Spec: v1alpha1.CatalogSourceSpec{
+					UpdateStrategy: &v1alpha1.UpdateStrategy{
+						Windows: []v1alpha1.UpgradeWindow{{
+							Schedule: "0 16 * * *",
+							Duration: metav1.Duration{Duration: time.Hour},
+						}},
+					},
+				},
+			},
+			expected: queueinformer.DefaultResyncPeriod,
+		},
 	}
 
 	for _, tt := range tests {
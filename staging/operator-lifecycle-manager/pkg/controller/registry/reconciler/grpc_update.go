@@ -0,0 +1,208 @@
+package reconciler
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	sharedtime "github.com/operator-framework/operator-lifecycle-manager/pkg/lib/time"
+	"github.com/operator-framework/operator-registry/pkg/client"
+)
+
+const (
+	// CatalogSourceUpdateRequestedAnnotationKey is the key of an annotation recording when an update of a
+	// CatalogSource with a webhook update strategy was last requested, in RFC 3339 format.
+	CatalogSourceUpdateRequestedAnnotationKey = "catalogsource.operators.coreos.com/update-requested"
+
+	// CatalogSourceUpdateWebhookLabelKey is the key of a label that must be present on the Secret of a webhook update
+	// strategy for the catalog operator to watch it.
+	CatalogSourceUpdateWebhookLabelKey = "catalogsource.operators.coreos.com/update-webhook"
+
+	// contentDigestTimeout bounds the time spent listing the content of a catalog pod.
+	contentDigestTimeout = 2 * time.Minute
+
+	// contentDigestTTL is how long the content digest of a pod is kept after it was computed.
+	contentDigestTTL = 30 * time.Minute
+)
+
+// errContentDigestPending is returned while the content of a catalog pod is being digested.
+var errContentDigestPending = errors.New("catalog content digest pending")
+
+// UpdateRequested returns true if an update of the catalog was requested through its webhook since it was last checked for updates.
+func UpdateRequested(source *v1alpha1.CatalogSource) bool {
+	if source.Spec.UpdateStrategy == nil || source.Spec.UpdateStrategy.Webhook == nil {
+		return false
+	}
+	value, ok := source.GetAnnotations()[CatalogSourceUpdateRequestedAnnotationKey]
+	if !ok {
+		return false
+	}
+	requested, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return false
+	}
+	latest := source.Status.LatestImageRegistryPoll
+	return latest.IsZero() || requested.After(latest.Time)
+}
+
+// updateDue returns true if the catalog should be checked for an update at now: when its polling interval elapsed or
+// an update was requested, as long as one of its update windows is open. Without a polling interval, catalogs with
+// update windows are also checked once each time a window opens.
+func updateDue(source grpcCatalogSourceDecorator, now time.Time) (bool, error) {
+	due := source.Update() || UpdateRequested(source.CatalogSource)
+	strategy := source.Spec.UpdateStrategy
+	if len(strategy.Windows) == 0 {
+		return due, nil
+	}
+
+	open, opened, _, err := sharedtime.WindowsOpen(strategy.Windows, now)
+	if err != nil || !open {
+		return false, err
+	}
+	if due {
+		return true, nil
+	}
+	latest := source.Status.LatestImageRegistryPoll
+	return strategy.RegistryPoll == nil && (latest.IsZero() || latest.Time.Before(opened)), nil
+}
+
+// ContentDigester computes the digest of the content served by a catalog pod.
+type ContentDigester interface {
+	ContentDigest(pod *corev1.Pod) (string, error)
+}
+
+// digestRegistryContent digests the bundles listed by the registry API of a catalog pod.
+func digestRegistryContent(pod *corev1.Pod) (string, error) {
+	if pod.Status.PodIP == "" {
+		return "", fmt.Errorf("pod %s has no IP yet", pod.GetName())
+	}
+	c, err := client.NewClient(net.JoinHostPort(pod.Status.PodIP, "50051"))
+	if err != nil {
+		return "", err
+	}
+	defer c.Close()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), contentDigestTimeout)
+	defer cancel()
+	it, err := c.ListBundles(ctx)
+	if err != nil {
+		return "", fmt.Errorf("error listing bundles of pod %s: %v", pod.GetName(), err)
+	}
+	var entries []string
+	for b := it.Next(); b != nil; b = it.Next() {
+		data, err := json.Marshal(b)
+		if err != nil {
+			return "", err
+		}
+		entries = append(entries, string(data))
+	}
+	if err := it.Error(); err != nil {
+		return "", fmt.Errorf("error listing bundles of pod %s: %v", pod.GetName(), err)
+	}
+	return contentDigest(entries), nil
+}
+
+// backgroundContentDigester digests the content of catalog pods in the background, so that listing their content
+// doesn't block CatalogSource syncs. ContentDigest returns errContentDigestPending until the digest of a pod is known.
+type backgroundContentDigester struct {
+	digest func(pod *corev1.Pod) (string, error)
+	now    func() time.Time
+
+	mu      sync.Mutex
+	digests map[types.UID]*podContentDigest
+}
+
+type podContentDigest struct {
+	done     bool
+	finished time.Time
+	digest   string
+	err      error
+}
+
+func newBackgroundContentDigester(digest func(pod *corev1.Pod) (string, error)) *backgroundContentDigester {
+	return &backgroundContentDigester{
+		digest:  digest,
+		now:     time.Now,
+		digests: map[types.UID]*podContentDigest{},
+	}
+}
+
+func (d *backgroundContentDigester) ContentDigest(pod *corev1.Pod) (string, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+	for uid, result := range d.digests {
+		if result.done && now.Sub(result.finished) > contentDigestTTL {
+			delete(d.digests, uid)
+		}
+	}
+
+	result, ok := d.digests[pod.GetUID()]
+	if !ok {
+		result = &podContentDigest{}
+		d.digests[pod.GetUID()] = result
+		go d.run(pod.DeepCopy(), result)
+		return "", errContentDigestPending
+	}
+	if !result.done {
+		return "", errContentDigestPending
+	}
+	if result.err != nil {
+		// retry failed digests on the next attempt
+		delete(d.digests, pod.GetUID())
+	}
+	return result.digest, result.err
+}
+
+func (d *backgroundContentDigester) run(pod *corev1.Pod, result *podContentDigest) {
+	digest, err := d.digest(pod)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	result.done, result.finished, result.digest, result.err = true, d.now(), digest, err
+}
+
+// contentDigest returns a digest of catalog content entries that doesn't depend on the order in which they were listed.
+func contentDigest(entries []string) string {
+	sort.Strings(entries)
+	hasher := sha256.New()
+	for _, entry := range entries {
+		hasher.Write([]byte(entry))
+		hasher.Write([]byte{'\n'})
+	}
+	return fmt.Sprintf("sha256:%x", hasher.Sum(nil))
+}
+
+// contentChanged returns true if the content served by the update pod differs from the content of the serving pods,
+// and records the content digest of the update pod in the CatalogSource's status. An UpdateNotReadyErr is returned
+// while the content of either pod is still being digested.
+func (c *GrpcRegistryReconciler) contentChanged(source grpcCatalogSourceDecorator, updatePod *corev1.Pod, servingPods []*corev1.Pod) (bool, error) {
+	updated, updatedErr := c.ContentDigester.ContentDigest(updatePod)
+	serving, servingErr := source.Status.ContentDigest, error(nil)
+	if serving == "" && len(servingPods) > 0 {
+		serving, servingErr = c.ContentDigester.ContentDigest(servingPods[0])
+	}
+	for _, err := range []error{updatedErr, servingErr} {
+		if err == errContentDigestPending {
+			return false, UpdateNotReadyErr{catalogName: source.GetName(), podName: updatePod.GetName()}
+		}
+	}
+	for _, err := range []error{updatedErr, servingErr} {
+		if err != nil {
+			return false, err
+		}
+	}
+	source.Status.ContentDigest = updated
+	return updated != serving, nil
+}
@@ -0,0 +1,255 @@
+package reconciler
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+)
+
+func TestUpdateDue(t *testing.T) {
+	// Saturday
+	now := time.Date(2021, 3, 6, 3, 0, 0, 0, time.UTC)
+	saturdays := []v1alpha1.UpgradeWindow{{Schedule: "0 2 * * 6", Duration: metav1.Duration{Duration: 2 * time.Hour}}}
+	sundays := []v1alpha1.UpgradeWindow{{Schedule: "0 2 * * 0", Duration: metav1.Duration{Duration: 2 * time.Hour}}}
+	requested := map[string]string{CatalogSourceUpdateRequestedAnnotationKey: now.Add(-time.Minute).Format(time.RFC3339)}
+	poll := func(at time.Time) *metav1.Time {
+		t := metav1.NewTime(at)
+		return &t
+	}
+
+	for _, tt := range []struct {
+		name        string
+		strategy    v1alpha1.UpdateStrategy
+		annotations map[string]string
+		latestPoll  *metav1.Time
+		due         bool
+		err         bool
+	}{
+		{
+			name:     "PollingIntervalElapsed",
+			strategy: v1alpha1.UpdateStrategy{RegistryPoll: &v1alpha1.RegistryPoll{Interval: &metav1.Duration{Duration: time.Minute}}},
+			due:      true,
+		},
+		{
+			name:       "PollingIntervalNotElapsed",
+			strategy:   v1alpha1.UpdateStrategy{RegistryPoll: &v1alpha1.RegistryPoll{Interval: &metav1.Duration{Duration: time.Hour}}},
+			latestPoll: poll(time.Now()),
+		},
+		{
+			name:        "WebhookRequested",
+			strategy:    v1alpha1.UpdateStrategy{Webhook: &v1alpha1.WebhookUpdate{SecretName: "token"}},
+			annotations: requested,
+			latestPoll:  poll(now.Add(-time.Hour)),
+			due:         true,
+		},
+		{
+			name:        "WebhookAlreadyChecked",
+			strategy:    v1alpha1.UpdateStrategy{Webhook: &v1alpha1.WebhookUpdate{SecretName: "token"}},
+			annotations: requested,
+			latestPoll:  poll(now),
+		},
+		{
+			name:        "WebhookRequestedOutsideWindow",
+			strategy:    v1alpha1.UpdateStrategy{Webhook: &v1alpha1.WebhookUpdate{SecretName: "token"}, Windows: sundays},
+			annotations: requested,
+			latestPoll:  poll(now.Add(-time.Hour)),
+		},
+		{
+			name:        "WebhookRequestedInWindow",
+			strategy:    v1alpha1.UpdateStrategy{Webhook: &v1alpha1.WebhookUpdate{SecretName: "token"}, Windows: saturdays},
+			annotations: requested,
+			latestPoll:  poll(now.Add(-time.Minute * 2)),
+			due:         true,
+		},
+		{
+			name:       "WindowOpened",
+			strategy:   v1alpha1.UpdateStrategy{Windows: saturdays},
+			latestPoll: poll(now.Add(-24 * time.Hour)),
+			due:        true,
+		},
+		{
+			name:       "WindowAlreadyChecked",
+			strategy:   v1alpha1.UpdateStrategy{Windows: saturdays},
+			latestPoll: poll(now.Add(-30 * time.Minute)),
+		},
+		{
+			name:     "WindowClosed",
+			strategy: v1alpha1.UpdateStrategy{Windows: sundays},
+		},
+		{
+			name:     "InvalidWindow",
+			strategy: v1alpha1.UpdateStrategy{Windows: []v1alpha1.UpgradeWindow{{Schedule: "invalid"}}},
+			err:      true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			strategy := tt.strategy
+			source := grpcCatalogSourceDecorator{&v1alpha1.CatalogSource{
+				ObjectMeta: metav1.ObjectMeta{Name: "catalog", Namespace: "ns", Annotations: tt.annotations},
+				Spec: v1alpha1.CatalogSourceSpec{
+					SourceType:     v1alpha1.SourceTypeGrpc,
+					Image:          "quay.io/catalog:latest",
+					UpdateStrategy: &strategy,
+				},
+				Status: v1alpha1.CatalogSourceStatus{LatestImageRegistryPoll: tt.latestPoll},
+			}}
+
+			due, err := updateDue(source, now)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.due, due)
+		})
+	}
+}
+
+func TestContentDigest(t *testing.T) {
+	require.Equal(t, contentDigest([]string{"a", "b"}), contentDigest([]string{"b", "a"}))
+	require.NotEqual(t, contentDigest([]string{"a", "b"}), contentDigest([]string{"ab"}))
+}
+
+type fakeContentDigester map[string]string
+
+func (f fakeContentDigester) ContentDigest(pod *corev1.Pod) (string, error) {
+	digest, ok := f[pod.GetName()]
+	if !ok {
+		return "", errors.New("unreachable pod")
+	}
+	if digest == "pending" {
+		return "", errContentDigestPending
+	}
+	return digest, nil
+}
+
+func TestContentChanged(t *testing.T) {
+	servingPod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "serving"}}
+	updatePod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "update"}}
+
+	for _, tt := range []struct {
+		name     string
+		recorded string
+		digests  fakeContentDigester
+		changed  bool
+		err      bool
+		notReady bool
+	}{
+		{
+			name:     "Unchanged",
+			recorded: "sha256:a",
+			digests:  fakeContentDigester{"update": "sha256:a"},
+		},
+		{
+			name:     "Changed",
+			recorded: "sha256:a",
+			digests:  fakeContentDigester{"update": "sha256:b"},
+			changed:  true,
+		},
+		{
+			name:    "UnchangedFromServingPod",
+			digests: fakeContentDigester{"update": "sha256:a", "serving": "sha256:a"},
+		},
+		{
+			name:    "UpdatePodUnreachable",
+			digests: fakeContentDigester{"serving": "sha256:a"},
+			err:     true,
+		},
+		{
+			name:     "UpdatePodPending",
+			recorded: "sha256:a",
+			digests:  fakeContentDigester{"update": "pending"},
+			notReady: true,
+		},
+		{
+			name:     "ServingPodPending",
+			digests:  fakeContentDigester{"update": "sha256:a", "serving": "pending"},
+			notReady: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			source := grpcCatalogSourceDecorator{&v1alpha1.CatalogSource{
+				Status: v1alpha1.CatalogSourceStatus{ContentDigest: tt.recorded},
+			}}
+			c := &GrpcRegistryReconciler{ContentDigester: tt.digests}
+
+			changed, err := c.contentChanged(source, updatePod, []*corev1.Pod{servingPod})
+			if tt.notReady {
+				require.IsType(t, UpdateNotReadyErr{}, err)
+				require.Equal(t, tt.recorded, source.Status.ContentDigest)
+				return
+			}
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.changed, changed)
+			require.Equal(t, tt.digests["update"], source.Status.ContentDigest)
+		})
+	}
+}
+
+func TestBackgroundContentDigester(t *testing.T) {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", UID: types.UID("uid")}}
+	release := make(chan struct{})
+	calls := 0
+	d := newBackgroundContentDigester(func(*corev1.Pod) (string, error) {
+		calls++
+		<-release
+		if calls == 1 {
+			return "", errors.New("unreachable pod")
+		}
+		return "sha256:a", nil
+	})
+	now := time.Date(2021, 3, 6, 3, 0, 0, 0, time.UTC)
+	d.now = func() time.Time { return now }
+
+	waitForDigest := func() (string, error) {
+		var digest string
+		var err error
+		require.Eventually(t, func() bool {
+			digest, err = d.ContentDigest(pod)
+			return err != errContentDigestPending
+		}, 5*time.Second, 10*time.Millisecond)
+		return digest, err
+	}
+
+	// the first digest is computed in the background and fails
+	_, err := d.ContentDigest(pod)
+	require.Equal(t, errContentDigestPending, err)
+	_, err = d.ContentDigest(pod)
+	require.Equal(t, errContentDigestPending, err)
+	release <- struct{}{}
+	_, err = waitForDigest()
+	require.EqualError(t, err, "unreachable pod")
+
+	// failures are retried
+	_, err = d.ContentDigest(pod)
+	require.Equal(t, errContentDigestPending, err)
+	release <- struct{}{}
+	digest, err := waitForDigest()
+	require.NoError(t, err)
+	require.Equal(t, "sha256:a", digest)
+
+	// successful digests are kept until they expire
+	digest, err = d.ContentDigest(pod)
+	require.NoError(t, err)
+	require.Equal(t, "sha256:a", digest)
+	require.Equal(t, 2, calls)
+
+	now = now.Add(contentDigestTTL + time.Second)
+	_, err = d.ContentDigest(pod)
+	require.Equal(t, errContentDigestPending, err)
+	release <- struct{}{}
+	_, err = waitForDigest()
+	require.NoError(t, err)
+	require.Equal(t, 3, calls)
+}
@@ -59,6 +59,7 @@ type registryReconcilerFactory struct {
 	OpClient             operatorclient.ClientInterface
 	ConfigMapServerImage string
 	SSAClient            *controllerclient.ServerSideApplier
+	contentDigester      ContentDigester
 }
 
 // ReconcilerForSource returns a RegistryReconciler based on the configuration of the given CatalogSource.
@@ -75,10 +76,11 @@ func (r *registryReconcilerFactory) ReconcilerForSource(source *v1alpha1.Catalog
 	case v1alpha1.SourceTypeGrpc:
 		if source.Spec.Image != "" {
 			return &GrpcRegistryReconciler{
-				now:       r.now,
-				Lister:    r.Lister,
-				OpClient:  r.OpClient,
-				SSAClient: r.SSAClient,
+				now:             r.now,
+				Lister:          r.Lister,
+				OpClient:        r.OpClient,
+				SSAClient:       r.SSAClient,
+				ContentDigester: r.contentDigester,
 			}
 		} else if source.Spec.Address != "" {
 			return &GrpcAddressRegistryReconciler{
@@ -97,6 +99,7 @@ func NewRegistryReconcilerFactory(lister operatorlister.OperatorLister, opClient
 		OpClient:             opClient,
 		ConfigMapServerImage: configMapServerImage,
 		SSAClient:            ssaClient,
+		contentDigester:      newBackgroundContentDigester(digestRegistryContent),
 	}
 }
 
@@ -193,6 +196,36 @@ func Pod(source *v1alpha1.CatalogSource, name string, image string, saName strin
 				pod.Spec.Tolerations[index] = *toleration.DeepCopy()
 			}
 		}
+
+		// Override resources
+		if grpcPodConfig.Resources != nil {
+			pod.Spec.Containers[0].Resources = *grpcPodConfig.Resources.DeepCopy()
+		}
+
+		// Override security contexts
+		if grpcPodConfig.SecurityContext != nil {
+			pod.Spec.SecurityContext = grpcPodConfig.SecurityContext.DeepCopy()
+		}
+		if grpcPodConfig.ContainerSecurityContext != nil {
+			securityContext := grpcPodConfig.ContainerSecurityContext.DeepCopy()
+			if securityContext.ReadOnlyRootFilesystem == nil {
+				securityContext.ReadOnlyRootFilesystem = pod.Spec.Containers[0].SecurityContext.ReadOnlyRootFilesystem
+			}
+			pod.Spec.Containers[0].SecurityContext = securityContext
+		}
+
+		// Override affinity
+		if grpcPodConfig.Affinity != nil {
+			pod.Spec.Affinity = grpcPodConfig.Affinity.DeepCopy()
+		}
+
+		// Add volumes
+		for _, volume := range grpcPodConfig.Volumes {
+			pod.Spec.Volumes = append(pod.Spec.Volumes, *volume.DeepCopy())
+		}
+		for _, mount := range grpcPodConfig.VolumeMounts {
+			pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts, *mount.DeepCopy())
+		}
 	}
 
 	// Set priorityclass if its annotation exists
@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/operator-framework/api/pkg/operators/v1alpha1"
@@ -270,3 +271,85 @@ func TestPodSchedulingOverrides(t *testing.T) {
 		require.Equal(t, testCase.expectedTolerations, pod.Spec.Tolerations)
 	}
 }
+
+func TestPodSpecOverrides(t *testing.T) {
+	// This test ensures that the resources, security contexts, affinity and volumes
+	// defined in spec.grpcPodConfig are applied to the catalog source pod, and that
+	// changing them changes the pod spec hash so that the pod is recreated.
+	runAsNonRoot := true
+	readOnlyRootFilesystem := true
+	resources := &corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("50m"),
+			corev1.ResourceMemory: resource.MustParse("100Mi"),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceMemory: resource.MustParse("200Mi"),
+		},
+	}
+	podSecurityContext := &corev1.PodSecurityContext{
+		RunAsNonRoot:   &runAsNonRoot,
+		SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+	}
+	containerSecurityContext := &corev1.SecurityContext{
+		ReadOnlyRootFilesystem: &readOnlyRootFilesystem,
+	}
+	affinity := &corev1.Affinity{
+		NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+					MatchExpressions: []corev1.NodeSelectorRequirement{{
+						Key:      "node-role.kubernetes.io/infra",
+						Operator: corev1.NodeSelectorOpExists,
+					}},
+				}},
+			},
+		},
+	}
+	volumes := []corev1.Volume{{
+		Name: "ca-bundle",
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "trusted-ca"}},
+		},
+	}}
+	volumeMounts := []corev1.VolumeMount{{Name: "ca-bundle", MountPath: "/etc/pki/ca-trust/extracted/pem", ReadOnly: true}}
+
+	catsrc := &v1alpha1.CatalogSource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "testns",
+		},
+		Spec: v1alpha1.CatalogSourceSpec{
+			SourceType: v1alpha1.SourceTypeGrpc,
+			Image:      "repo/image:tag",
+		},
+	}
+	defaultPod := Pod(catsrc, "hello", "busybox", "", map[string]string{}, nil, int32(0), int32(0))
+
+	catsrc.Spec.GrpcPodConfig = &v1alpha1.GrpcPodConfig{
+		Resources:                resources,
+		SecurityContext:          podSecurityContext,
+		ContainerSecurityContext: containerSecurityContext,
+		Affinity:                 affinity,
+		Volumes:                  volumes,
+		VolumeMounts:             volumeMounts,
+	}
+	pod := Pod(catsrc, "hello", "busybox", "", map[string]string{}, nil, int32(0), int32(0))
+	require.Equal(t, *resources, pod.Spec.Containers[0].Resources)
+	require.Equal(t, podSecurityContext, pod.Spec.SecurityContext)
+	require.Equal(t, containerSecurityContext, pod.Spec.Containers[0].SecurityContext)
+	require.Equal(t, affinity, pod.Spec.Affinity)
+	require.Equal(t, volumes, pod.Spec.Volumes)
+	require.Equal(t, volumeMounts, pod.Spec.Containers[0].VolumeMounts)
+	require.False(t, podHashMatch(defaultPod, pod))
+
+	catsrc.Spec.GrpcPodConfig.Resources = nil
+	pod = Pod(catsrc, "hello", "busybox", "", map[string]string{}, nil, int32(0), int32(0))
+	require.Equal(t, defaultPod.Spec.Containers[0].Resources, pod.Spec.Containers[0].Resources)
+
+	// container security context overrides are merged into the default security context
+	catsrc.Spec.GrpcPodConfig.ContainerSecurityContext = &corev1.SecurityContext{RunAsNonRoot: &runAsNonRoot}
+	pod = Pod(catsrc, "hello", "busybox", "", map[string]string{}, nil, int32(0), int32(0))
+	require.Equal(t, &runAsNonRoot, pod.Spec.Containers[0].SecurityContext.RunAsNonRoot)
+	require.Equal(t, defaultPod.Spec.Containers[0].SecurityContext.ReadOnlyRootFilesystem, pod.Spec.Containers[0].SecurityContext.ReadOnlyRootFilesystem)
+}
@@ -11,11 +11,22 @@ import (
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/util/errors"
 
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/listers/operators/v1alpha1"
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/lib/operatorlister"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/metrics"
 )
 
-const existingOperatorKey = "@existing"
+const (
+	existingOperatorKey = "@existing"
+
+	// DefaultSourceTTL is the default duration for which a catalog snapshot is considered fresh.
+	DefaultSourceTTL = 5 * time.Minute
+
+	// DefaultMaxConcurrentSnapshotUpdates is the default limit on the number of catalog snapshots
+	// that may be refreshed at the same time.
+	DefaultMaxConcurrentSnapshotUpdates = 4
+)
 
 type SourceKey struct {
 	Name      string
@@ -50,6 +61,24 @@ type Source interface {
 	Snapshot(context.Context) (*Snapshot, error)
 }
 
+// VersionedSource is a Source that can tell which version of its content it serves.
+type VersionedSource interface {
+	Source
+	// ContentVersion returns an identifier that changes whenever the content served by the source may have
+	// changed, or "" if that can't be known.
+	ContentVersion() string
+}
+
+// ManagedContent returns true if OLM runs the registry pods serving the content of the given CatalogSource. The
+// content of those pods never changes, so the content of the catalog can only change when they are replaced.
+func ManagedContent(catsrc *operatorsv1alpha1.CatalogSource) bool {
+	switch catsrc.Spec.SourceType {
+	case operatorsv1alpha1.SourceTypeConfigmap, operatorsv1alpha1.SourceTypeInternal:
+		return true
+	}
+	return catsrc.Spec.Image != ""
+}
+
 type SourceProvider interface {
 	// TODO: namespaces parameter is an artifact of SourceStore
 	Sources(namespaces ...string) map[SourceKey]Source
@@ -81,6 +110,7 @@ type Cache struct {
 	catsrcLister v1alpha1.CatalogSourceLister
 	snapshots    map[SourceKey]*snapshotHeader
 	ttl          time.Duration
+	maxUpdates   int
 	sem          chan struct{}
 	m            sync.RWMutex
 }
@@ -103,11 +133,23 @@ func WithCatalogSourceLister(catalogSourceLister v1alpha1.CatalogSourceLister) O
 	}
 }
 
-func New(sp SourceProvider, options ...Option) *Cache {
-	const (
-		MaxConcurrentSnapshotUpdates = 4
-	)
+// WithSourceTTL sets the duration for which a catalog snapshot is considered fresh.
+func WithSourceTTL(ttl time.Duration) Option {
+	return func(c *Cache) {
+		c.ttl = ttl
+	}
+}
 
+// WithMaxConcurrentSnapshotUpdates sets the number of catalog snapshots that may be refreshed at the same time.
+func WithMaxConcurrentSnapshotUpdates(n int) Option {
+	return func(c *Cache) {
+		if n > 0 {
+			c.maxUpdates = n
+		}
+	}
+}
+
+func New(sp SourceProvider, options ...Option) *Cache {
 	cache := Cache{
 		logger: func() logrus.StdLogger {
 			logger := logrus.New()
@@ -117,13 +159,14 @@ func New(sp SourceProvider, options ...Option) *Cache {
 		sp:           sp,
 		catsrcLister: operatorlister.NewLister().OperatorsV1alpha1().CatalogSourceLister(),
 		snapshots:    make(map[SourceKey]*snapshotHeader),
-		ttl:          5 * time.Minute,
-		sem:          make(chan struct{}, MaxConcurrentSnapshotUpdates),
+		ttl:          DefaultSourceTTL,
+		maxUpdates:   DefaultMaxConcurrentSnapshotUpdates,
 	}
 
 	for _, opt := range options {
 		opt(&cache)
 	}
+	cache.sem = make(chan struct{}, cache.maxUpdates)
 
 	return &cache
 }
@@ -180,6 +223,7 @@ func (c *Cache) Namespaced(namespaces ...string) MultiCatalogOperatorFinder {
 					defer snapshot.m.RUnlock()
 					if snapshot.Valid(now) {
 						result.snapshots[key] = snapshot
+						metrics.EmitCatalogSnapshotAge(key.Name, key.Namespace, now.Sub(snapshot.created))
 					} else {
 						misses = append(misses, key)
 					}
@@ -198,12 +242,17 @@ func (c *Cache) Namespaced(namespaces ...string) MultiCatalogOperatorFinder {
 	c.m.Lock()
 	defer c.m.Unlock()
 
-	// Take the opportunity to clear expired snapshots while holding the lock.
+	// Take the opportunity to clear expired snapshots while holding the lock. The content of
+	// expired snapshots is kept aside so that unchanged catalogs don't have to be listed again.
 	var expired []SourceKey
+	previous := make(map[SourceKey]*Snapshot)
 	for key, snapshot := range c.snapshots {
 		if !snapshot.Valid(now) {
 			snapshot.Cancel()
 			expired = append(expired, key)
+			if prev := snapshot.Content(); prev != nil {
+				previous[key] = prev
+			}
 		}
 	}
 	for _, key := range expired {
@@ -231,8 +280,23 @@ func (c *Cache) Namespaced(namespaces ...string) MultiCatalogOperatorFinder {
 		}
 
 		// Ignoring error and treat catsrc priority as 0 if not found.
+		managed := false
 		if catsrc, _ := c.catsrcLister.CatalogSources(miss.Namespace).Get(miss.Name); catsrc != nil {
 			hdr.priority = catsrc.Spec.Priority
+			managed = ManagedContent(catsrc)
+		}
+
+		// The content of a managed catalog can't have changed while it's served by the same registry,
+		// so its previous snapshot is kept without listing the content of the catalog again.
+		if prev := previous[miss]; managed && prev != nil && prev.Version != "" {
+			if versioned, ok := sources[miss].(VersionedSource); ok && versioned.ContentVersion() == prev.Version {
+				cancel()
+				hdr.snapshot = prev.DeepCopy()
+				hdr.created = now
+				c.snapshots[miss] = &hdr
+				result.snapshots[miss] = &hdr
+				continue
+			}
 		}
 
 		hdr.m.Lock()
@@ -243,7 +307,14 @@ func (c *Cache) Namespaced(namespaces ...string) MultiCatalogOperatorFinder {
 			defer hdr.m.Unlock()
 			c.sem <- struct{}{}
 			defer func() { <-c.sem }()
+
+			start := time.Now()
 			hdr.snapshot, hdr.err = source.Snapshot(ctx)
+			hdr.created = time.Now()
+			if hdr.err != nil {
+				return
+			}
+			metrics.EmitCatalogSnapshotRefresh(hdr.key.Name, hdr.key.Namespace, len(hdr.snapshot.Entries), hdr.created.Sub(start))
 		}(ctx, &hdr, sources[miss])
 	}
 
@@ -291,12 +362,49 @@ func (c *NamespacedOperatorCache) WithExistingOperators(snapshot *Snapshot, name
 	return o
 }
 
+// WithSharedOperators adds a virtual catalog of the operators installed in namespace that are
+// shared with the namespaces being resolved. Shared operators are preferred over catalog
+// operators, but not over existing operators.
+func (c *NamespacedOperatorCache) WithSharedOperators(snapshot *Snapshot, namespace string) MultiCatalogOperatorFinder {
+	key := NewVirtualSourceKey(namespace)
+	o := &NamespacedOperatorCache{
+		existing: c.existing,
+		snapshots: map[SourceKey]*snapshotHeader{
+			key: {
+				key:      key,
+				snapshot: snapshot,
+			},
+		},
+	}
+	for k, v := range c.snapshots {
+		if _, ok := o.snapshots[k]; !ok {
+			o.snapshots[k] = v
+		}
+	}
+	return o
+}
+
 func (c *NamespacedOperatorCache) Find(p ...Predicate) []*Entry {
 	return c.FindPreferred(nil, "", p...)
 }
 
 type Snapshot struct {
 	Entries []*Entry
+
+	// Version optionally identifies the version of the source's content the Snapshot was taken from.
+	Version string
+}
+
+// DeepCopy returns a copy of the Snapshot whose entries can be modified without affecting the original.
+func (s *Snapshot) DeepCopy() *Snapshot {
+	out := &Snapshot{
+		Entries: make([]*Entry, len(s.Entries)),
+		Version: s.Version,
+	}
+	for i, e := range s.Entries {
+		out.Entries[i] = e.DeepCopy()
+	}
+	return out
 }
 
 var _ Source = &Snapshot{}
@@ -310,6 +418,7 @@ type snapshotHeader struct {
 
 	key      SourceKey
 	expiry   time.Time
+	created  time.Time
 	m        sync.RWMutex
 	pop      context.CancelFunc
 	err      error
@@ -320,6 +429,16 @@ func (hdr *snapshotHeader) Cancel() {
 	hdr.pop()
 }
 
+// Content returns the snapshot's entries if it was successfully populated, or nil otherwise.
+func (hdr *snapshotHeader) Content() *Snapshot {
+	hdr.m.RLock()
+	defer hdr.m.RUnlock()
+	if hdr.err != nil {
+		return nil
+	}
+	return hdr.snapshot
+}
+
 func (hdr *snapshotHeader) Valid(at time.Time) bool {
 	hdr.m.RLock()
 	defer hdr.m.RUnlock()
@@ -368,6 +487,11 @@ func (s sortableSnapshots) Less(i, j int) bool {
 		return false
 	}
 
+	// shared operators are preferred over catalog operators
+	if iv, jv := s.snapshots[i].key.Virtual(), s.snapshots[j].key.Virtual(); iv != jv {
+		return iv
+	}
+
 	// preferred catalog is less than all other catalogs
 	if s.preferred != nil &&
 		s.snapshots[i].key.Name == s.preferred.Name &&
@@ -419,6 +543,7 @@ type MultiCatalogOperatorFinder interface {
 	Catalog(SourceKey) OperatorFinder
 	FindPreferred(preferred *SourceKey, preferredNamespace string, predicates ...Predicate) []*Entry
 	WithExistingOperators(snapshot *Snapshot, namespace string) MultiCatalogOperatorFinder
+	WithSharedOperators(snapshot *Snapshot, namespace string) MultiCatalogOperatorFinder
 	Error() error
 	OperatorFinder
 }
@@ -11,6 +11,11 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8scache "k8s.io/client-go/tools/cache"
+
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	listers "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/listers/operators/v1alpha1"
 )
 
 func TestOperatorCacheConcurrency(t *testing.T) {
@@ -105,6 +110,85 @@ func TestOperatorCacheReuse(t *testing.T) {
 	require.Len(t, c.Namespaced("dummynamespace").Catalog(key).Find(CSVNamePredicate("v1")), 1)
 }
 
+type versionedSource struct {
+	snapshot *Snapshot
+	version  string
+	listed   int
+}
+
+func (s *versionedSource) Snapshot(ctx context.Context) (*Snapshot, error) {
+	s.listed++
+	return s.snapshot, nil
+}
+
+func (s *versionedSource) ContentVersion() string {
+	return s.version
+}
+
+func TestOperatorCacheKeepsUnchangedManagedCatalog(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		catsrc    v1alpha1.CatalogSourceSpec
+		reconnect bool
+		listed    int
+	}{
+		{
+			name:   "ManagedWithoutReconnect",
+			catsrc: v1alpha1.CatalogSourceSpec{SourceType: v1alpha1.SourceTypeGrpc, Image: "quay.io/catalog:latest"},
+			listed: 1,
+		},
+		{
+			name:      "ManagedAfterReconnect",
+			catsrc:    v1alpha1.CatalogSourceSpec{SourceType: v1alpha1.SourceTypeGrpc, Image: "quay.io/catalog:latest"},
+			reconnect: true,
+			listed:    2,
+		},
+		{
+			name:   "UnmanagedAddress",
+			catsrc: v1alpha1.CatalogSourceSpec{SourceType: v1alpha1.SourceTypeGrpc, Address: "catalog:50051"},
+			listed: 2,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			key := SourceKey{Namespace: "dummynamespace", Name: "dummyname"}
+			indexer := k8scache.NewIndexer(k8scache.MetaNamespaceKeyFunc, k8scache.Indexers{k8scache.NamespaceIndex: k8scache.MetaNamespaceIndexFunc})
+			require.NoError(t, indexer.Add(&v1alpha1.CatalogSource{
+				ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+				Spec:       tt.catsrc,
+			}))
+
+			v1 := &Snapshot{Entries: []*Entry{{Name: "v1", SourceInfo: &OperatorSourceInfo{}}}, Version: "connection-1"}
+			source := &versionedSource{snapshot: v1, version: "connection-1"}
+			c := New(StaticSourceProvider{key: source}, WithCatalogSourceLister(listers.NewCatalogSourceLister(indexer)))
+			require.Len(t, c.Namespaced("dummynamespace").Catalog(key).Find(CSVNamePredicate("v1")), 1)
+
+			if tt.reconnect {
+				source.version = "connection-2"
+			}
+			c.Expire(key)
+			found := c.Namespaced("dummynamespace").Catalog(key).Find(CSVNamePredicate("v1"))
+			require.Len(t, found, 1)
+			assert.Equal(t, tt.listed, source.listed)
+
+			if tt.listed == 1 {
+				// The entries of a kept snapshot are copies
+				assert.NotSame(t, v1.Entries[0], found[0])
+				assert.NotSame(t, v1.Entries[0].SourceInfo, found[0].SourceInfo)
+			}
+		})
+	}
+}
+
+func TestOperatorCacheOptions(t *testing.T) {
+	c := New(StaticSourceProvider{}, WithSourceTTL(time.Second), WithMaxConcurrentSnapshotUpdates(16))
+	assert.Equal(t, time.Second, c.ttl)
+	assert.Equal(t, 16, cap(c.sem))
+
+	c = New(StaticSourceProvider{}, WithMaxConcurrentSnapshotUpdates(0))
+	assert.Equal(t, DefaultSourceTTL, c.ttl)
+	assert.Equal(t, DefaultMaxConcurrentSnapshotUpdates, cap(c.sem))
+}
+
 func TestCatalogSnapshotValid(t *testing.T) {
 	type tc struct {
 		Name     string
@@ -223,6 +223,49 @@ type Entry struct {
 	Bundle *api.Bundle
 }
 
+// DeepCopy returns a copy of the entry that can be modified without affecting the original. The inlined
+// bundle content is shared, since it's never modified.
+func (o *Entry) DeepCopy() *Entry {
+	if o == nil {
+		return nil
+	}
+	out := *o
+	if o.Skips != nil {
+		out.Skips = append([]string(nil), o.Skips...)
+	}
+	if o.ProvidedAPIs != nil {
+		out.ProvidedAPIs = make(APISet, len(o.ProvidedAPIs))
+		for k, v := range o.ProvidedAPIs {
+			out.ProvidedAPIs[k] = v
+		}
+	}
+	if o.RequiredAPIs != nil {
+		out.RequiredAPIs = make(APISet, len(o.RequiredAPIs))
+		for k, v := range o.RequiredAPIs {
+			out.RequiredAPIs[k] = v
+		}
+	}
+	if o.Version != nil {
+		version := *o.Version
+		version.Pre = append([]semver.PRVersion(nil), o.Version.Pre...)
+		version.Build = append([]string(nil), o.Version.Build...)
+		out.Version = &version
+	}
+	if o.SourceInfo != nil {
+		info := *o.SourceInfo
+		out.SourceInfo = &info
+	}
+	if o.Properties != nil {
+		out.Properties = make([]*api.Property, len(o.Properties))
+		for i, p := range o.Properties {
+			if p != nil {
+				out.Properties[i] = &api.Property{Type: p.Type, Value: p.Value}
+			}
+		}
+	}
+	return &out
+}
+
 func (o *Entry) Package() string {
 	if si := o.SourceInfo; si != nil {
 		return si.Package
@@ -361,6 +361,7 @@ type celPredicate struct {
 	program        constraints.CelProgram
 	rule           string
 	failureMessage string
+	clusterFacts   map[string]interface{}
 }
 
 func (cp *celPredicate) Test(entry *Entry) bool {
@@ -376,19 +377,59 @@ func (cp *celPredicate) Test(entry *Entry) bool {
 		}
 	}
 
-	ok, err := cp.program.Evaluate(map[string]interface{}{"properties": props})
+	return cp.evaluate(props)
+}
+
+func (cp *celPredicate) evaluate(props []map[string]interface{}) bool {
+	data := make(map[string]interface{}, len(cp.clusterFacts)+1)
+	for k, v := range cp.clusterFacts {
+		data[k] = v
+	}
+	data[constraints.PropertiesKey] = props
+
+	ok, err := cp.program.Evaluate(data)
 	if err != nil {
 		return false
 	}
 	return ok
 }
 
-func CreateCelPredicate(env *constraints.CelEnvironment, rule string, failureMessage string) (Predicate, error) {
+// ClusterPredicate is a Predicate whose outcome depends only on facts about
+// the cluster, so it yields the same result for every Entry.
+type ClusterPredicate interface {
+	Predicate
+	// Satisfied returns true if the cluster satisfies the predicate.
+	Satisfied() bool
+}
+
+type clusterCelPredicate struct {
+	*celPredicate
+}
+
+func (cp clusterCelPredicate) Satisfied() bool {
+	return cp.evaluate(nil)
+}
+
+// CreateCelPredicate compiles rule into a Predicate. The values returned by
+// clusterFacts are made available to the rule alongside the properties of the
+// tested Entry; clusterFacts is only called if the rule refers to cluster facts,
+// and may be nil if there are none. If the rule refers only to cluster facts, the
+// returned Predicate is a ClusterPredicate.
+func CreateCelPredicate(env *constraints.CelEnvironment, rule string, failureMessage string, clusterFacts func() (map[string]interface{}, error)) (Predicate, error) {
 	prog, err := env.Validate(rule)
 	if err != nil {
 		return nil, err
 	}
-	return &celPredicate{program: prog, rule: rule, failureMessage: failureMessage}, nil
+	cp := &celPredicate{program: prog, rule: rule, failureMessage: failureMessage}
+	if prog.ReferencesCluster() && clusterFacts != nil {
+		if cp.clusterFacts, err = clusterFacts(); err != nil {
+			return nil, err
+		}
+	}
+	if prog.ClusterOnly() {
+		return clusterCelPredicate{cp}, nil
+	}
+	return cp, nil
 }
 
 func (cp *celPredicate) String() string {
@@ -0,0 +1,205 @@
+package resolver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/operator-framework/api/pkg/constraints"
+	v1alpha1listers "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/listers/operators/v1alpha1"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/registry/resolver/projection"
+)
+
+const (
+	// ClusterFactsConfigMapName is the name of the ConfigMap, in the global catalog namespace,
+	// whose data is exposed to olm.constraint CEL expressions as custom cluster facts.
+	ClusterFactsConfigMapName = "olm-cluster-facts"
+
+	maxOpenShiftVersionProperty = "olm.maxOpenShiftVersion"
+
+	defaultClusterFactsTTL = time.Minute
+)
+
+// ClusterFactsProvider supplies facts about the cluster to olm.constraint CEL expressions.
+type ClusterFactsProvider interface {
+	// ClusterFacts returns CEL input data keyed by the variable names declared by the
+	// constraints package's CEL environment.
+	ClusterFacts() (map[string]interface{}, error)
+}
+
+type clusterFactsProvider struct {
+	discovery  discovery.DiscoveryInterface
+	kubeclient kubernetes.Interface
+	csvLister  v1alpha1listers.ClusterServiceVersionLister
+	namespace  string
+	ttl        time.Duration
+	now        func() time.Time
+
+	mu      sync.Mutex
+	facts   map[string]interface{}
+	expires time.Time
+}
+
+// NewClusterFactsProvider returns a ClusterFactsProvider that gathers the server version and served APIs via discovery,
+// the cluster's maximum OpenShift version from installed CSVs, and custom facts from the ClusterFactsConfigMapName
+// ConfigMap in namespace. Gathered facts are cached for a short time so that consecutive resolutions don't each
+// hit the API server.
+func NewClusterFactsProvider(kubeclient kubernetes.Interface, csvLister v1alpha1listers.ClusterServiceVersionLister, namespace string) ClusterFactsProvider {
+	return &clusterFactsProvider{
+		discovery:  kubeclient.Discovery(),
+		kubeclient: kubeclient,
+		csvLister:  csvLister,
+		namespace:  namespace,
+		ttl:        defaultClusterFactsTTL,
+		now:        time.Now,
+	}
+}
+
+func (p *clusterFactsProvider) ClusterFacts() (map[string]interface{}, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.facts != nil && p.now().Before(p.expires) {
+		return p.facts, nil
+	}
+
+	facts, err := p.gather()
+	if err != nil {
+		return nil, err
+	}
+	p.facts = facts
+	p.expires = p.now().Add(p.ttl)
+
+	return p.facts, nil
+}
+
+func (p *clusterFactsProvider) gather() (map[string]interface{}, error) {
+	version, err := p.discovery.ServerVersion()
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover server version: %w", err)
+	}
+
+	apis, err := p.servedAPIs()
+	if err != nil {
+		return nil, err
+	}
+
+	maxOpenShiftVersion, err := p.maxOpenShiftVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	custom, err := p.customFacts()
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		constraints.ServerVersionKey:       version.GitVersion,
+		constraints.APIsKey:                apis,
+		constraints.MaxOpenShiftVersionKey: maxOpenShiftVersion,
+		constraints.FactsKey:               custom,
+	}, nil
+}
+
+func (p *clusterFactsProvider) servedAPIs() ([]map[string]string, error) {
+	_, lists, err := p.discovery.ServerGroupsAndResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		// Partial results are acceptable: an unavailable aggregated API shouldn't block resolution.
+		return nil, fmt.Errorf("failed to discover served apis: %w", err)
+	}
+
+	var apis []map[string]string
+	for _, list := range lists {
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for _, resource := range list.APIResources {
+			if strings.Contains(resource.Name, "/") {
+				// Skip subresources
+				continue
+			}
+			apis = append(apis, map[string]string{
+				"group":   gv.Group,
+				"version": gv.Version,
+				"kind":    resource.Kind,
+			})
+		}
+	}
+
+	return apis, nil
+}
+
+// maxOpenShiftVersion returns the lowest olm.maxOpenShiftVersion declared by any installed operator, or an empty
+// string if no operator declares one. Invalid declarations are ignored here; they are reported by the controller
+// that guards cluster upgrades.
+func (p *clusterFactsProvider) maxOpenShiftVersion() (string, error) {
+	csvs, err := p.csvLister.List(labels.Everything())
+	if err != nil {
+		return "", fmt.Errorf("failed to list csvs: %w", err)
+	}
+
+	var max *semver.Version
+	for _, csv := range csvs {
+		if csv.IsCopied() {
+			continue
+		}
+		annotation, ok := csv.GetAnnotations()[projection.PropertiesAnnotationKey]
+		if !ok {
+			continue
+		}
+		properties, err := projection.PropertyListFromPropertiesAnnotation(annotation)
+		if err != nil {
+			continue
+		}
+		for _, property := range properties {
+			if property.Type != maxOpenShiftVersionProperty {
+				continue
+			}
+			v, err := semver.ParseTolerant(strings.Trim(property.Value, "\""))
+			if err != nil {
+				continue
+			}
+			v = semver.Version{Major: v.Major, Minor: v.Minor}
+			if max == nil || v.LT(*max) {
+				max = &v
+			}
+		}
+	}
+
+	if max == nil {
+		return "", nil
+	}
+	return fmt.Sprintf("%d.%d", max.Major, max.Minor), nil
+}
+
+func (p *clusterFactsProvider) customFacts() (map[string]string, error) {
+	facts := map[string]string{}
+	if p.namespace == "" {
+		return facts, nil
+	}
+
+	cm, err := p.kubeclient.CoreV1().ConfigMaps(p.namespace).Get(context.TODO(), ClusterFactsConfigMapName, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return facts, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster facts configmap %s/%s: %w", p.namespace, ClusterFactsConfigMapName, err)
+	}
+	for k, v := range cm.Data {
+		facts[k] = v
+	}
+
+	return facts, nil
+}
@@ -0,0 +1,108 @@
+package resolver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8scache "k8s.io/client-go/tools/cache"
+
+	"github.com/operator-framework/api/pkg/constraints"
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	listersv1alpha1 "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/listers/operators/v1alpha1"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/registry/resolver/projection"
+)
+
+func csvWithMaxOpenShiftVersion(name, max string, copied bool) *v1alpha1.ClusterServiceVersion {
+	csv := &v1alpha1.ClusterServiceVersion{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "ns",
+			Annotations: map[string]string{
+				projection.PropertiesAnnotationKey: `{"properties":[{"type":"olm.maxOpenShiftVersion","value":"` + max + `"}]}`,
+			},
+		},
+	}
+	if copied {
+		csv.Status.Reason = v1alpha1.CSVReasonCopied
+	}
+	return csv
+}
+
+func TestClusterFacts(t *testing.T) {
+	kubeclient := k8sfake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ClusterFactsConfigMapName,
+			Namespace: "olm",
+		},
+		Data: map[string]string{
+			"workerNodes": "3",
+		},
+	})
+	fakeDiscovery := kubeclient.Discovery().(*fakediscovery.FakeDiscovery)
+	fakeDiscovery.FakedServerVersion = &version.Info{GitVersion: "v1.22.3"}
+	fakeDiscovery.Resources = []*metav1.APIResourceList{
+		{
+			GroupVersion: "config.openshift.io/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "clusterversions", Kind: "ClusterVersion"},
+				{Name: "clusterversions/status", Kind: "ClusterVersion"},
+			},
+		},
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "pods", Kind: "Pod"},
+			},
+		},
+	}
+
+	indexer := k8scache.NewIndexer(k8scache.MetaNamespaceKeyFunc, k8scache.Indexers{})
+	require.NoError(t, indexer.Add(csvWithMaxOpenShiftVersion("a", "4.10", false)))
+	require.NoError(t, indexer.Add(csvWithMaxOpenShiftVersion("b", "4.9", false)))
+	require.NoError(t, indexer.Add(csvWithMaxOpenShiftVersion("c", "4.8", true)))
+
+	provider := NewClusterFactsProvider(kubeclient, listersv1alpha1.NewClusterServiceVersionLister(indexer), "olm").(*clusterFactsProvider)
+	now := time.Now()
+	provider.now = func() time.Time { return now }
+
+	facts, err := provider.ClusterFacts()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		constraints.ServerVersionKey: "v1.22.3",
+		constraints.APIsKey: []map[string]string{
+			{"group": "config.openshift.io", "version": "v1", "kind": "ClusterVersion"},
+			{"group": "", "version": "v1", "kind": "Pod"},
+		},
+		constraints.MaxOpenShiftVersionKey: "4.9",
+		constraints.FactsKey:               map[string]string{"workerNodes": "3"},
+	}, facts)
+
+	// Facts are cached until the ttl elapses
+	fakeDiscovery.FakedServerVersion = &version.Info{GitVersion: "v1.23.0"}
+	facts, err = provider.ClusterFacts()
+	require.NoError(t, err)
+	assert.Equal(t, "v1.22.3", facts[constraints.ServerVersionKey])
+
+	now = now.Add(defaultClusterFactsTTL)
+	facts, err = provider.ClusterFacts()
+	require.NoError(t, err)
+	assert.Equal(t, "v1.23.0", facts[constraints.ServerVersionKey])
+}
+
+func TestClusterFactsWithoutConfigMap(t *testing.T) {
+	kubeclient := k8sfake.NewSimpleClientset()
+	kubeclient.Discovery().(*fakediscovery.FakeDiscovery).FakedServerVersion = &version.Info{GitVersion: "v1.22.3"}
+	indexer := k8scache.NewIndexer(k8scache.MetaNamespaceKeyFunc, k8scache.Indexers{})
+
+	facts, err := NewClusterFactsProvider(kubeclient, listersv1alpha1.NewClusterServiceVersionLister(indexer), "olm").ClusterFacts()
+	require.NoError(t, err)
+	assert.Equal(t, "", facts[constraints.MaxOpenShiftVersionKey])
+	assert.Equal(t, map[string]string{}, facts[constraints.FactsKey])
+}
@@ -55,6 +55,10 @@ func bundleId(bundle, channel string, catalog cache.SourceKey) solver.Identifier
 	return solver.IdentifierFromString(fmt.Sprintf("%s/%s/%s", catalog.String(), channel, bundle))
 }
 
+func subscriptionId(name string) solver.Identifier {
+	return solver.IdentifierFromString(fmt.Sprintf("subscription:%s", name))
+}
+
 func NewBundleInstallableFromOperator(o *cache.Entry) (BundleInstallable, error) {
 	if o.SourceInfo == nil {
 		return BundleInstallable{}, fmt.Errorf("unable to resolve the source of bundle %s", o.Name)
@@ -100,7 +104,7 @@ func (i GenericInstallable) Constraints() []solver.Constraint {
 
 func NewInvalidSubscriptionInstallable(name string, reason string) solver.Installable {
 	return GenericInstallable{
-		identifier: solver.IdentifierFromString(fmt.Sprintf("subscription:%s", name)),
+		identifier: subscriptionId(name),
 		constraints: []solver.Constraint{
 			PrettyConstraint(solver.Mandatory(), fmt.Sprintf("subscription %s exists", name)),
 			PrettyConstraint(solver.Prohibited(), reason),
@@ -110,7 +114,7 @@ func NewInvalidSubscriptionInstallable(name string, reason string) solver.Instal
 
 func NewSubscriptionInstallable(name string, dependencies []solver.Identifier) solver.Installable {
 	result := GenericInstallable{
-		identifier: solver.IdentifierFromString(fmt.Sprintf("subscription:%s", name)),
+		identifier: subscriptionId(name),
 		constraints: []solver.Constraint{
 			PrettyConstraint(solver.Mandatory(), fmt.Sprintf("subscription %s exists", name)),
 		},
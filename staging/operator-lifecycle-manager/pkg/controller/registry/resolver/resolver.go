@@ -25,19 +25,31 @@ type OperatorResolver interface {
 	SolveOperators(csvs []*v1alpha1.ClusterServiceVersion, subs []*v1alpha1.Subscription, add map[cache.OperatorSourceInfo]struct{}) (cache.OperatorSet, error)
 }
 
+// SharedOperatorsProvider lists the operators installed in other namespaces that are available to a namespace.
+type SharedOperatorsProvider interface {
+	// SharedOperators returns the CSVs of operators installed outside of namespace whose APIs
+	// may be used to satisfy the dependencies of operators installed in namespace.
+	SharedOperators(namespace string) ([]*v1alpha1.ClusterServiceVersion, error)
+}
+
 type SatResolver struct {
-	cache cache.OperatorCacheProvider
-	log   logrus.FieldLogger
-	pc    *predicateConverter
+	cache   cache.OperatorCacheProvider
+	log     logrus.FieldLogger
+	pc      *predicateConverter
+	shared  SharedOperatorsProvider
+	backend solver.Backend
 }
 
-func NewDefaultSatResolver(rcp cache.SourceProvider, catsrcLister v1alpha1listers.CatalogSourceLister, logger logrus.FieldLogger) *SatResolver {
+func NewDefaultSatResolver(rcp cache.SourceProvider, catsrcLister v1alpha1listers.CatalogSourceLister, facts ClusterFactsProvider, shared SharedOperatorsProvider, backend solver.Backend, logger logrus.FieldLogger, cacheOptions ...cache.Option) *SatResolver {
 	return &SatResolver{
-		cache: cache.New(rcp, cache.WithLogger(logger), cache.WithCatalogSourceLister(catsrcLister)),
+		cache: cache.New(rcp, append([]cache.Option{cache.WithLogger(logger), cache.WithCatalogSourceLister(catsrcLister)}, cacheOptions...)...),
 		log:   logger,
 		pc: &predicateConverter{
 			celEnv: constraints.NewCelEnvironment(),
+			facts:  facts,
 		},
+		shared:  shared,
+		backend: backend,
 	}
 }
 
@@ -60,6 +72,9 @@ func (r *SatResolver) SolveOperators(namespaces []string, csvs []*v1alpha1.Clust
 	// TODO: better abstraction
 	startingCSVs := make(map[string]struct{})
 
+	// subscriptions that keep their installed operator unless a change is required
+	var deferred []solver.Identifier
+
 	// build a virtual catalog of all currently installed CSVs
 	existingSnapshot, err := r.newSnapshotForNamespace(namespaces[0], subs, csvs)
 	if err != nil {
@@ -67,6 +82,28 @@ func (r *SatResolver) SolveOperators(namespaces []string, csvs []*v1alpha1.Clust
 	}
 	namespacedCache := r.cache.Namespaced(namespaces...).WithExistingOperators(existingSnapshot, namespaces[0])
 
+	// add a virtual catalog for each namespace with operators shared with this one
+	if r.shared != nil {
+		sharedCSVs, err := r.shared.SharedOperators(namespaces[0])
+		if err != nil {
+			return nil, err
+		}
+		sharedByNamespace := make(map[string][]*v1alpha1.ClusterServiceVersion)
+		for _, csv := range sharedCSVs {
+			if csv.GetNamespace() == namespaces[0] {
+				continue
+			}
+			sharedByNamespace[csv.GetNamespace()] = append(sharedByNamespace[csv.GetNamespace()], csv)
+		}
+		for namespace, csvs := range sharedByNamespace {
+			sharedSnapshot, err := r.newSnapshotForNamespace(namespace, nil, csvs)
+			if err != nil {
+				return nil, err
+			}
+			namespacedCache = namespacedCache.WithSharedOperators(sharedSnapshot, namespace)
+		}
+	}
+
 	_, existingInstallables, err := r.getBundleInstallables(namespaces[0], cache.Filter(existingSnapshot.Entries, cache.True()), namespacedCache, visited)
 	if err != nil {
 		return nil, err
@@ -104,6 +141,10 @@ func (r *SatResolver) SolveOperators(namespaces []string, csvs []*v1alpha1.Clust
 		for _, i := range subInstallables {
 			installables[i.Identifier()] = i
 		}
+
+		if current != nil && sub.Spec.UpgradeMode == v1alpha1.UpgradeModeMinimalChange {
+			deferred = append(deferred, subscriptionId(sub.GetName()))
+		}
 	}
 
 	r.addInvariants(namespacedCache, installables)
@@ -120,7 +161,11 @@ func (r *SatResolver) SolveOperators(namespaces []string, csvs []*v1alpha1.Clust
 	if len(errs) > 0 {
 		return nil, utilerrors.NewAggregate(errs)
 	}
-	s, err := solver.New(solver.WithInput(input), solver.WithTracer(solver.LoggingTracer{Writer: &debugWriter{r.log}}))
+	backend := r.backend
+	if backend == nil {
+		backend = solver.SearchBackend
+	}
+	s, err := backend.New(solver.WithInput(input), solver.WithTracer(solver.LoggingTracer{Writer: &debugWriter{r.log}}), solver.WithDeferredAnchors(deferred...))
 	if err != nil {
 		return nil, err
 	}
@@ -199,6 +244,22 @@ func (r *SatResolver) getSubscriptionInstallables(sub *v1alpha1.Subscription, cu
 			csvPredicate = cache.CSVNamePredicate(sub.Spec.StartingCSV)
 		}
 
+		if sub.Spec.VersionRange != "" {
+			// filter the channel, rather than the catalog, so that replacement chains stay intact
+			versionRange, err := semver.ParseRange(sub.Spec.VersionRange)
+			if err != nil {
+				si := NewInvalidSubscriptionInstallable(sub.GetName(), fmt.Sprintf("invalid version range %q in subscription %s: %v", sub.Spec.VersionRange, sub.GetName(), err))
+				installables[si.Identifier()] = si
+				return installables, nil
+			}
+			channelPredicates = append(channelPredicates, cache.VersionInRangePredicate(versionRange, sub.Spec.VersionRange))
+		}
+
+		// operators whose installation was rolled back aren't installed again
+		for _, name := range sub.Status.RolledBackCSVs {
+			channelPredicates = append(channelPredicates, cache.Not(cache.CSVNamePredicate(name)))
+		}
+
 		cachePredicates = append(cachePredicates, cache.And(
 			cache.CountingPredicate(cache.True(), &nall),
 			cache.CountingPredicate(cache.PkgPredicate(sub.Spec.Package), &npkg),
@@ -263,8 +324,15 @@ func (r *SatResolver) getSubscriptionInstallables(sub *v1alpha1.Subscription, cu
 		}
 	}
 
+	inChannel := cache.Filter(sortedBundles, channelPredicates...)
+	if len(inChannel) == 0 && current == nil && sub.Spec.VersionRange != "" {
+		si := NewInvalidSubscriptionInstallable(sub.GetName(), fmt.Sprintf("no operators found in version range %s of channel %s of package %s in the catalog referenced by subscription %s", sub.Spec.VersionRange, sub.Spec.Channel, sub.Spec.Package, sub.GetName()))
+		installables[si.Identifier()] = si
+		return installables, nil
+	}
+
 	candidates := make([]*BundleInstallable, 0)
-	for _, o := range cache.Filter(sortedBundles, channelPredicates...) {
+	for _, o := range inChannel {
 		predicates := append(cachePredicates, cache.CSVNamePredicate(o.Name))
 		stack := namespacedCache.Catalog(catalog).Find(predicates...)
 		id, installable, err := r.getBundleInstallables(sub.Namespace, stack, namespacedCache, visited)
@@ -284,6 +352,10 @@ func (r *SatResolver) getSubscriptionInstallables(sub *v1alpha1.Subscription, cu
 	}
 
 	depIds := make([]solver.Identifier, 0)
+	if current != nil && sub.Spec.UpgradeMode == v1alpha1.UpgradeModeMinimalChange {
+		// prefer the installed operator, so that it's only replaced when required by other operators
+		depIds = append(depIds, bundleId(current.Name, current.Channel(), cache.NewVirtualSourceKey(sub.GetNamespace())))
+	}
 	for _, c := range candidates {
 		// track which operator this is replacing, so that it can be realized when creating the resources on cluster
 		if current != nil {
@@ -300,7 +372,7 @@ func (r *SatResolver) getSubscriptionInstallables(sub *v1alpha1.Subscription, cu
 		}
 		depIds = append(depIds, c.Identifier())
 	}
-	if current != nil {
+	if current != nil && sub.Spec.UpgradeMode != v1alpha1.UpgradeModeMinimalChange {
 		depIds = append(depIds, bundleId(current.Name, current.Channel(), cache.NewVirtualSourceKey(sub.GetNamespace())))
 	}
 
@@ -334,6 +406,18 @@ func (r *SatResolver) getBundleInstallables(preferredNamespace string, bundleSta
 			continue
 		}
 
+		if si := bundle.SourceInfo; si != nil && si.Catalog.Virtual() && si.Catalog.Namespace != preferredNamespace {
+			// Operators shared from other namespaces
+			// are already installed and have their
+			// dependencies satisfied there, so they
+			// may satisfy dependencies but contribute
+			// none of their own.
+			bundleInstallable := BundleInstallable{identifier: bundleId(bundle.Name, bundle.Channel(), si.Catalog)}
+			visited[bundle] = &bundleInstallable
+			installables[bundleInstallable.Identifier()] = &bundleInstallable
+			continue
+		}
+
 		bundleInstallable, err := NewBundleInstallableFromOperator(bundle)
 		if err != nil {
 			errs = append(errs, err)
@@ -349,6 +433,19 @@ func (r *SatResolver) getBundleInstallables(preferredNamespace string, bundleSta
 		}
 
 		for _, d := range dependencyPredicates {
+			// Constraints on the cluster itself don't
+			// depend on other bundles: either the bundle
+			// can be installed on this cluster or not.
+			if cp, ok := d.(cache.ClusterPredicate); ok {
+				if !cp.Satisfied() {
+					bundleInstallable.AddConstraint(PrettyConstraint(
+						solver.Prohibited(),
+						fmt.Sprintf("bundle %s requires a cluster %s", bundle.Name, d.String()),
+					))
+				}
+				continue
+			}
+
 			sourcePredicate := cache.False()
 			// Build a filter matching all (catalog,
 			// package, channel) combinations that contain
@@ -741,6 +838,16 @@ func sortChannel(bundles []*cache.Entry) ([]*cache.Entry, error) {
 // predicateConverter configures olm.constraint value -> predicate conversion for the resolver.
 type predicateConverter struct {
 	celEnv *constraints.CelEnvironment
+	// facts supplies cluster facts to CEL constraints, if set.
+	facts ClusterFactsProvider
+}
+
+// clusterFacts returns the current cluster facts for CEL constraints, or nil if no provider is configured.
+func (pc *predicateConverter) clusterFacts() (map[string]interface{}, error) {
+	if pc.facts == nil {
+		return nil, nil
+	}
+	return pc.facts.ClusterFacts()
 }
 
 // convertDependencyProperties converts all known constraint properties to predicates.
@@ -819,7 +926,7 @@ func (pc *predicateConverter) convertConstraints(constraints ...constraints.Cons
 			subs, perr := pc.convertConstraints(constraint.Not.Constraints...)
 			preds[i], err = cache.Not(subs...), perr
 		case constraint.Cel != nil:
-			preds[i], err = cache.CreateCelPredicate(pc.celEnv, constraint.Cel.Rule, constraint.FailureMessage)
+			preds[i], err = cache.CreateCelPredicate(pc.celEnv, constraint.Cel.Rule, constraint.FailureMessage, pc.clusterFacts)
 		default:
 			// Unknown constraint types are handled by constraints.Parse(),
 			// but parsed constraints may be empty.
@@ -66,6 +66,192 @@ func TestSolveOperators(t *testing.T) {
 	require.EqualValues(t, expected, operators)
 }
 
+func TestSolveOperators_MemoizedBackend(t *testing.T) {
+	const namespace = "test-namespace"
+	catalog := cache.SourceKey{Name: "test-catalog", Namespace: namespace}
+
+	csv := existingOperator(namespace, "packageA.v1", "packageA", "alpha", "", nil, nil, nil, nil)
+	csvs := []*v1alpha1.ClusterServiceVersion{csv}
+	subs := []*v1alpha1.Subscription{existingSub(namespace, "packageA.v1", "packageA", "alpha", catalog)}
+
+	backend := solver.NewMemoizedBackend(solver.DefaultMemoizedBackendSize)
+	resolverFor := func(entries ...*cache.Entry) SatResolver {
+		return SatResolver{
+			cache: cache.New(cache.StaticSourceProvider{
+				catalog: &cache.Snapshot{Entries: entries},
+			}),
+			log:     logrus.New(),
+			backend: backend,
+		}
+	}
+
+	v1 := genOperator("packageA.v1", "0.0.1", "", "packageA", "alpha", catalog.Name, catalog.Namespace, nil, nil, nil, "", false)
+	satResolver := resolverFor(v1)
+	for i := 0; i < 2; i++ {
+		operators, err := satResolver.SolveOperators([]string{namespace}, csvs, subs)
+		require.NoError(t, err)
+		require.Len(t, operators, 0)
+	}
+
+	// A new input isn't answered with a previous solution
+	v2 := genOperator("packageA.v2", "0.0.2", "packageA.v1", "packageA", "alpha", catalog.Name, catalog.Namespace, nil, nil, nil, "", false)
+	satResolver = resolverFor(v1, v2)
+	operators, err := satResolver.SolveOperators([]string{namespace}, csvs, subs)
+	require.NoError(t, err)
+	require.Len(t, operators, 1)
+	require.Contains(t, operators, "packageA.v2")
+}
+
+func TestSolveOperators_MinimalChangeUpgradeMode(t *testing.T) {
+	const namespace = "test-namespace"
+	catalog := cache.SourceKey{Name: "test-catalog", Namespace: namespace}
+
+	csvs := []*v1alpha1.ClusterServiceVersion{
+		existingOperator(namespace, "packageA.v1", "packageA", "alpha", "", nil, nil, nil, nil),
+		existingOperator(namespace, "packageB.v1", "packageB", "alpha", "", nil, nil, nil, nil),
+	}
+	requiresB2 := []*api.Dependency{
+		{
+			Type:  "olm.package",
+			Value: `{"packageName":"packageB","version":">=2.0.0"}`,
+		},
+	}
+
+	for _, tt := range []struct {
+		name     string
+		modeA    v1alpha1.UpgradeMode
+		modeB    v1alpha1.UpgradeMode
+		depsA    []*api.Dependency
+		expected []string
+	}{
+		{
+			name:     "DefaultUpgradesEverything",
+			modeA:    v1alpha1.UpgradeModeDefault,
+			modeB:    v1alpha1.UpgradeModeDefault,
+			expected: []string{"packageA.v2", "packageB.v2"},
+		},
+		{
+			name:     "MinimalChangeKeepsInstalledOperator",
+			modeA:    v1alpha1.UpgradeModeDefault,
+			modeB:    v1alpha1.UpgradeModeMinimalChange,
+			expected: []string{"packageA.v2"},
+		},
+		{
+			name:     "MinimalChangeUpgradesRequiredDependency",
+			modeA:    v1alpha1.UpgradeModeDefault,
+			modeB:    v1alpha1.UpgradeModeMinimalChange,
+			depsA:    requiresB2,
+			expected: []string{"packageA.v2", "packageB.v2"},
+		},
+		{
+			name:     "MinimalChangeEverywhereKeepsEverything",
+			modeA:    v1alpha1.UpgradeModeMinimalChange,
+			modeB:    v1alpha1.UpgradeModeMinimalChange,
+			depsA:    requiresB2,
+			expected: []string{},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			subA := existingSub(namespace, "packageA.v1", "packageA", "alpha", catalog)
+			subA.Spec.UpgradeMode = tt.modeA
+			subB := existingSub(namespace, "packageB.v1", "packageB", "alpha", catalog)
+			subB.Spec.UpgradeMode = tt.modeB
+
+			satResolver := SatResolver{
+				cache: cache.New(cache.StaticSourceProvider{
+					catalog: &cache.Snapshot{
+						Entries: []*cache.Entry{
+							genOperator("packageA.v1", "1.0.0", "", "packageA", "alpha", catalog.Name, catalog.Namespace, nil, nil, nil, "", false),
+							genOperator("packageA.v2", "2.0.0", "packageA.v1", "packageA", "alpha", catalog.Name, catalog.Namespace, nil, nil, tt.depsA, "", false),
+							genOperator("packageB.v1", "1.0.0", "", "packageB", "alpha", catalog.Name, catalog.Namespace, nil, nil, nil, "", false),
+							genOperator("packageB.v2", "2.0.0", "packageB.v1", "packageB", "alpha", catalog.Name, catalog.Namespace, nil, nil, nil, "", false),
+						},
+					},
+				}),
+				log: logrus.New(),
+			}
+
+			operators, err := satResolver.SolveOperators([]string{namespace}, csvs, []*v1alpha1.Subscription{subA, subB})
+			require.NoError(t, err)
+
+			var names []string
+			for name := range operators {
+				names = append(names, name)
+			}
+			require.ElementsMatch(t, tt.expected, names)
+		})
+	}
+}
+
+func TestSolveOperators_VersionRange(t *testing.T) {
+	const namespace = "test-namespace"
+	catalog := cache.SourceKey{Name: "test-catalog", Namespace: namespace}
+
+	satResolver := SatResolver{
+		cache: cache.New(cache.StaticSourceProvider{
+			catalog: &cache.Snapshot{
+				Entries: []*cache.Entry{
+					genOperator("packageA.v1", "1.0.0", "", "packageA", "alpha", catalog.Name, catalog.Namespace, nil, nil, nil, "", false),
+					genOperator("packageA.v1.1", "1.1.0", "packageA.v1", "packageA", "alpha", catalog.Name, catalog.Namespace, nil, nil, nil, "", false),
+					genOperator("packageA.v2", "2.0.0", "packageA.v1.1", "packageA", "alpha", catalog.Name, catalog.Namespace, nil, nil, nil, "", false),
+				},
+			},
+		}),
+		log: logrus.New(),
+	}
+
+	for _, tt := range []struct {
+		name         string
+		versionRange string
+		expected     []string
+		err          string
+	}{
+		{
+			name:     "NoRange",
+			expected: []string{"packageA.v2"},
+		},
+		{
+			name:         "MaxVersion",
+			versionRange: "<2.0.0",
+			expected:     []string{"packageA.v1.1"},
+		},
+		{
+			name:         "Range",
+			versionRange: ">=1.0.0 <1.1.0",
+			expected:     []string{"packageA.v1"},
+		},
+		{
+			name:         "NoneInRange",
+			versionRange: ">=3.0.0",
+			err:          "no operators found in version range >=3.0.0 of channel alpha of package packageA",
+		},
+		{
+			name:         "InvalidRange",
+			versionRange: "latest",
+			err:          `invalid version range "latest"`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			sub := newSub(namespace, "packageA", "alpha", catalog)
+			sub.Spec.VersionRange = tt.versionRange
+
+			operators, err := satResolver.SolveOperators([]string{namespace}, nil, []*v1alpha1.Subscription{sub})
+			if tt.err != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.err)
+				return
+			}
+			require.NoError(t, err)
+
+			var names []string
+			for name := range operators {
+				names = append(names, name)
+			}
+			require.ElementsMatch(t, tt.expected, names)
+		})
+	}
+}
+
 func TestDisjointChannelGraph(t *testing.T) {
 	const namespace = "test-namespace"
 	catalog := cache.SourceKey{Name: "test-catalog", Namespace: namespace}
@@ -2503,3 +2689,213 @@ func TestSolveOperators_GenericConstraint(t *testing.T) {
 		})
 	}
 }
+
+type staticClusterFacts map[string]interface{}
+
+func (f staticClusterFacts) ClusterFacts() (map[string]interface{}, error) {
+	return f, nil
+}
+
+func TestSolveOperators_ClusterConstraint(t *testing.T) {
+	namespace := "olm"
+	catalog := cache.SourceKey{Name: "community", Namespace: namespace}
+
+	facts := staticClusterFacts{
+		constraints.ServerVersionKey:       "v1.22.3",
+		constraints.APIsKey:                []map[string]string{},
+		constraints.MaxOpenShiftVersionKey: "",
+		constraints.FactsKey:               map[string]string{"workerNodes": "3"},
+	}
+
+	requireVersion := func(version string) []*api.Dependency {
+		return []*api.Dependency{
+			{
+				Type:  "olm.constraint",
+				Value: fmt.Sprintf(`{"failureMessage":"requires kubernetes %[1]s","cel":{"rule":"semver_compare(serverVersion, '%[1]s') >= 0"}}`, version),
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		isErr    bool
+		catalog  cache.Source
+		expected cache.OperatorSet
+		message  string
+	}{
+		{
+			name: "Satisfied",
+			catalog: &cache.Snapshot{
+				Entries: []*cache.Entry{
+					genOperator("opA.v1.0.0", "1.0.0", "", "packageA", "stable", catalog.Name, catalog.Namespace, nil, nil, requireVersion("1.21.0"), "", false),
+				},
+			},
+			expected: cache.OperatorSet{
+				"opA.v1.0.0": genOperator("opA.v1.0.0", "1.0.0", "", "packageA", "stable", catalog.Name, catalog.Namespace, nil, nil, requireVersion("1.21.0"), "", false),
+			},
+		},
+		{
+			name:  "Unsatisfied",
+			isErr: true,
+			catalog: &cache.Snapshot{
+				Entries: []*cache.Entry{
+					genOperator("opA.v1.0.0", "1.0.0", "", "packageA", "stable", catalog.Name, catalog.Namespace, nil, nil, requireVersion("1.25.0"), "", false),
+				},
+			},
+			message: "requires kubernetes 1.25.0",
+		},
+		{
+			name: "Unsatisfied/FallsBackToOlderBundle",
+			catalog: &cache.Snapshot{
+				Entries: []*cache.Entry{
+					genOperator("opA.v1.0.0", "1.0.0", "", "packageA", "stable", catalog.Name, catalog.Namespace, nil, nil, requireVersion("1.21.0"), "", false),
+					genOperator("opA.v1.0.1", "1.0.1", "opA.v1.0.0", "packageA", "stable", catalog.Name, catalog.Namespace, nil, nil, requireVersion("1.25.0"), "stable", false),
+				},
+			},
+			expected: cache.OperatorSet{
+				"opA.v1.0.0": genOperator("opA.v1.0.0", "1.0.0", "", "packageA", "stable", catalog.Name, catalog.Namespace, nil, nil, requireVersion("1.21.0"), "", false),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			satResolver := SatResolver{
+				cache: cache.New(cache.StaticSourceProvider{
+					catalog: tt.catalog,
+				}),
+				log: logrus.New(),
+				pc: &predicateConverter{
+					celEnv: constraints.NewCelEnvironment(),
+					facts:  facts,
+				},
+			}
+
+			operators, err := satResolver.SolveOperators([]string{namespace}, nil, []*v1alpha1.Subscription{newSub(namespace, "packageA", "stable", catalog)})
+			if tt.isErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.message)
+			} else {
+				assert.NoError(t, err)
+				for k := range tt.expected {
+					require.NotNil(t, operators[k])
+					assert.EqualValues(t, k, operators[k].Name)
+				}
+			}
+			assert.Equal(t, len(tt.expected), len(operators))
+		})
+	}
+}
+
+type failingClusterFacts struct{}
+
+func (failingClusterFacts) ClusterFacts() (map[string]interface{}, error) {
+	return nil, fmt.Errorf("discovery failed")
+}
+
+func TestSolveOperators_ConstraintWithoutClusterFacts(t *testing.T) {
+	namespace := "olm"
+	catalog := cache.SourceKey{Name: "community", Namespace: namespace}
+
+	dependencies := func(rule string) []*api.Dependency {
+		return []*api.Dependency{
+			{
+				Type:  "olm.constraint",
+				Value: fmt.Sprintf(`{"failureMessage":"unsatisfied","cel":{"rule":%q}}`, rule),
+			},
+		}
+	}
+	solve := func(rule string) (cache.OperatorSet, error) {
+		satResolver := SatResolver{
+			cache: cache.New(cache.StaticSourceProvider{
+				catalog: &cache.Snapshot{
+					Entries: []*cache.Entry{
+						genOperator("opA.v1.0.0", "1.0.0", "", "packageA", "stable", catalog.Name, catalog.Namespace, nil, nil, dependencies(rule), "", false),
+					},
+				},
+			}),
+			log: logrus.New(),
+			pc: &predicateConverter{
+				celEnv: constraints.NewCelEnvironment(),
+				facts:  failingClusterFacts{},
+			},
+		}
+		return satResolver.SolveOperators([]string{namespace}, nil, []*v1alpha1.Subscription{newSub(namespace, "packageA", "stable", catalog)})
+	}
+
+	// Rules that don't refer to cluster facts don't need them
+	operators, err := solve("properties.size() >= 0")
+	require.NoError(t, err)
+	require.Len(t, operators, 1)
+
+	_, err = solve("semver_compare(serverVersion, '1.21.0') >= 0")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "discovery failed")
+}
+
+type staticSharedOperators []*v1alpha1.ClusterServiceVersion
+
+func (s staticSharedOperators) SharedOperators(string) ([]*v1alpha1.ClusterServiceVersion, error) {
+	return s, nil
+}
+
+func TestSolveOperators_SharedOperators(t *testing.T) {
+	APISet := cache.APISet{testGVKKey: struct{}{}}
+
+	const namespace = "test-namespace"
+	catalog := cache.SourceKey{Name: "test-catalog", Namespace: namespace}
+	subs := []*v1alpha1.Subscription{newSub(namespace, "packageA", "alpha", catalog)}
+	entries := []*cache.Entry{
+		genOperator("packageA.v1", "0.0.1", "", "packageA", "alpha", catalog.Name, catalog.Namespace, APISet, nil, nil, "", false),
+		genOperator("packageB.v1", "1.0.1", "", "packageB", "alpha", catalog.Name, catalog.Namespace, nil, APISet, nil, "", false),
+	}
+
+	tests := []struct {
+		name     string
+		shared   staticSharedOperators
+		expected []string
+	}{
+		{
+			name:     "NoSharedOperators",
+			expected: []string{"packageA.v1", "packageB.v1"},
+		},
+		{
+			name: "SharedProviderInOtherNamespace",
+			shared: staticSharedOperators{
+				existingOperator("global-operators", "packageB.v1", "packageB", "alpha", "", APISet, nil, nil, nil),
+			},
+			expected: []string{"packageA.v1"},
+		},
+		{
+			name: "SharedOperatorDoesNotProvideRequiredAPI",
+			shared: staticSharedOperators{
+				existingOperator("global-operators", "packageC.v1", "packageC", "alpha", "", nil, nil, nil, nil),
+			},
+			expected: []string{"packageA.v1", "packageB.v1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			satResolver := SatResolver{
+				cache: cache.New(cache.StaticSourceProvider{
+					catalog: &cache.Snapshot{Entries: entries},
+				}),
+				log: logrus.New(),
+				pc: &predicateConverter{
+					celEnv: constraints.NewCelEnvironment(),
+				},
+				shared: tt.shared,
+			}
+
+			operators, err := satResolver.SolveOperators([]string{namespace}, nil, subs)
+			require.NoError(t, err)
+
+			var names []string
+			for name := range operators {
+				names = append(names, name)
+			}
+			assert.ElementsMatch(t, tt.expected, names)
+		})
+	}
+}
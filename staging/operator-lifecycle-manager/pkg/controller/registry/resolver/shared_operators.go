@@ -0,0 +1,137 @@
+package resolver
+
+import (
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	operatorsv1 "github.com/operator-framework/api/pkg/operators/v1"
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	v1listers "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/listers/operators/v1"
+	v1alpha1listers "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/listers/operators/v1alpha1"
+	opregistry "github.com/operator-framework/operator-registry/pkg/registry"
+)
+
+type globalOperatorGroupSharedOperators struct {
+	ogLister  v1listers.OperatorGroupLister
+	csvLister v1alpha1listers.ClusterServiceVersionLister
+}
+
+var _ SharedOperatorsProvider = &globalOperatorGroupSharedOperators{}
+
+// NewGlobalOperatorGroupSharedOperators returns a SharedOperatorsProvider that shares the operators installed by
+// OperatorGroups targeting all namespaces with every other namespace, since those operators already watch them.
+func NewGlobalOperatorGroupSharedOperators(ogLister v1listers.OperatorGroupLister, csvLister v1alpha1listers.ClusterServiceVersionLister) SharedOperatorsProvider {
+	return &globalOperatorGroupSharedOperators{
+		ogLister:  ogLister,
+		csvLister: csvLister,
+	}
+}
+
+func (s *globalOperatorGroupSharedOperators) SharedOperators(namespace string) ([]*v1alpha1.ClusterServiceVersion, error) {
+	ogs, err := s.ogLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list operatorgroups: %w", err)
+	}
+
+	var shared []*v1alpha1.ClusterServiceVersion
+	for _, og := range ogs {
+		if og.GetNamespace() == namespace || !targetsAllNamespaces(og) {
+			continue
+		}
+
+		csvs, err := s.csvLister.ClusterServiceVersions(og.GetNamespace()).List(labels.Everything())
+		if err != nil {
+			return nil, fmt.Errorf("failed to list csvs in namespace %s: %w", og.GetNamespace(), err)
+		}
+		for _, csv := range csvs {
+			if csv.IsCopied() || csv.Status.Phase == v1alpha1.CSVPhaseFailed {
+				continue
+			}
+			if csv.GetAnnotations()[operatorsv1.OperatorGroupAnnotationKey] != og.GetName() {
+				continue
+			}
+			shared = append(shared, csv)
+		}
+	}
+
+	return shared, nil
+}
+
+func targetsAllNamespaces(og *operatorsv1.OperatorGroup) bool {
+	return len(og.Status.Namespaces) == 1 && og.Status.Namespaces[0] == corev1.NamespaceAll
+}
+
+// SharedDependencies returns the shared operators that the given installed CSV depends on. Every API required by
+// the CSV that no CSV in its own namespace provides is attributed to a single shared operator, the first in
+// namespace and name order, so that the result stays stable across syncs.
+func SharedDependencies(csv *v1alpha1.ClusterServiceVersion, local, shared []*v1alpha1.ClusterServiceVersion) ([]v1alpha1.SharedDependency, error) {
+	op, err := newOperatorFromV1Alpha1CSV(csv)
+	if err != nil {
+		return nil, err
+	}
+	if len(op.RequiredAPIs) == 0 {
+		return nil, nil
+	}
+
+	// Compare by GVK only, since plurals aren't always known
+	required := make(map[opregistry.APIKey]struct{}, len(op.RequiredAPIs))
+	for api := range op.RequiredAPIs {
+		required[opregistry.APIKey{Group: api.Group, Version: api.Version, Kind: api.Kind}] = struct{}{}
+	}
+	for _, c := range local {
+		if c.IsCopied() {
+			continue
+		}
+		provider, err := newOperatorFromV1Alpha1CSV(c)
+		if err != nil {
+			return nil, err
+		}
+		for api := range provider.ProvidedAPIs {
+			delete(required, opregistry.APIKey{Group: api.Group, Version: api.Version, Kind: api.Kind})
+		}
+	}
+
+	shared = append([]*v1alpha1.ClusterServiceVersion(nil), shared...)
+	sort.Slice(shared, func(i, j int) bool {
+		if shared[i].GetNamespace() != shared[j].GetNamespace() {
+			return shared[i].GetNamespace() < shared[j].GetNamespace()
+		}
+		return shared[i].GetName() < shared[j].GetName()
+	})
+
+	var deps []v1alpha1.SharedDependency
+	for _, c := range shared {
+		if len(required) == 0 {
+			break
+		}
+		provider, err := newOperatorFromV1Alpha1CSV(c)
+		if err != nil {
+			return nil, err
+		}
+
+		var apis []string
+		for api := range provider.ProvidedAPIs {
+			key := opregistry.APIKey{Group: api.Group, Version: api.Version, Kind: api.Kind}
+			if _, ok := required[key]; !ok {
+				continue
+			}
+			delete(required, key)
+			apis = append(apis, fmt.Sprintf("%s.%s.%s", api.Kind, api.Version, api.Group))
+		}
+		if len(apis) == 0 {
+			continue
+		}
+		sort.Strings(apis)
+
+		deps = append(deps, v1alpha1.SharedDependency{
+			ClusterServiceVersion: c.GetName(),
+			Namespace:             c.GetNamespace(),
+			APIs:                  apis,
+		})
+	}
+
+	return deps, nil
+}
@@ -0,0 +1,90 @@
+package resolver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8scache "k8s.io/client-go/tools/cache"
+
+	operatorsv1 "github.com/operator-framework/api/pkg/operators/v1"
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	listersv1 "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/listers/operators/v1"
+	listersv1alpha1 "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/listers/operators/v1alpha1"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/registry/resolver/cache"
+)
+
+func operatorGroup(namespace, name string, targets ...string) *operatorsv1.OperatorGroup {
+	return &operatorsv1.OperatorGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Status: operatorsv1.OperatorGroupStatus{
+			Namespaces: targets,
+		},
+	}
+}
+
+func csvInOperatorGroup(csv *v1alpha1.ClusterServiceVersion, og string) *v1alpha1.ClusterServiceVersion {
+	csv.SetAnnotations(map[string]string{operatorsv1.OperatorGroupAnnotationKey: og})
+	return csv
+}
+
+func TestGlobalOperatorGroupSharedOperators(t *testing.T) {
+	APISet := cache.APISet{testGVKKey: struct{}{}}
+
+	global := csvInOperatorGroup(existingOperator("global", "global.v1", "global", "alpha", "", APISet, nil, nil, nil), "global-og")
+	copied := csvInOperatorGroup(existingOperator("global", "copied.v1", "copied", "alpha", "", APISet, nil, nil, nil), "global-og")
+	copied.Status.Reason = v1alpha1.CSVReasonCopied
+	failed := csvInOperatorGroup(existingOperator("global", "failed.v1", "failed", "alpha", "", APISet, nil, nil, nil), "global-og")
+	failed.Status.Phase = v1alpha1.CSVPhaseFailed
+	scoped := csvInOperatorGroup(existingOperator("scoped", "scoped.v1", "scoped", "alpha", "", APISet, nil, nil, nil), "scoped-og")
+	local := csvInOperatorGroup(existingOperator("local", "local.v1", "local", "alpha", "", APISet, nil, nil, nil), "local-og")
+
+	ogIndexer := k8scache.NewIndexer(k8scache.MetaNamespaceKeyFunc, k8scache.Indexers{k8scache.NamespaceIndex: k8scache.MetaNamespaceIndexFunc})
+	for _, og := range []*operatorsv1.OperatorGroup{
+		operatorGroup("global", "global-og", corev1.NamespaceAll),
+		operatorGroup("scoped", "scoped-og", "scoped", "local"),
+		operatorGroup("local", "local-og", corev1.NamespaceAll),
+	} {
+		require.NoError(t, ogIndexer.Add(og))
+	}
+	csvIndexer := k8scache.NewIndexer(k8scache.MetaNamespaceKeyFunc, k8scache.Indexers{k8scache.NamespaceIndex: k8scache.MetaNamespaceIndexFunc})
+	for _, csv := range []*v1alpha1.ClusterServiceVersion{global, copied, failed, scoped, local} {
+		require.NoError(t, csvIndexer.Add(csv))
+	}
+
+	provider := NewGlobalOperatorGroupSharedOperators(listersv1.NewOperatorGroupLister(ogIndexer), listersv1alpha1.NewClusterServiceVersionLister(csvIndexer))
+	shared, err := provider.SharedOperators("local")
+	require.NoError(t, err)
+	assert.Equal(t, []*v1alpha1.ClusterServiceVersion{global}, shared)
+}
+
+func TestSharedDependencies(t *testing.T) {
+	required := cache.APISet{testGVKKey: struct{}{}}
+	installed := existingOperator("ns", "packageA.v1", "packageA", "alpha", "", nil, required, nil, nil)
+
+	shared := []*v1alpha1.ClusterServiceVersion{
+		existingOperator("global", "provider-b.v1", "provider-b", "alpha", "", required, nil, nil, nil),
+		existingOperator("global", "provider-a.v1", "provider-a", "alpha", "", required, nil, nil, nil),
+		existingOperator("global", "other.v1", "other", "alpha", "", nil, nil, nil, nil),
+	}
+
+	deps, err := SharedDependencies(installed, []*v1alpha1.ClusterServiceVersion{installed}, shared)
+	require.NoError(t, err)
+	assert.Equal(t, []v1alpha1.SharedDependency{
+		{
+			ClusterServiceVersion: "provider-a.v1",
+			Namespace:             "global",
+			APIs:                  []string{"k.v.g"},
+		},
+	}, deps)
+
+	local := existingOperator("ns", "local.v1", "local", "alpha", "", required, nil, nil, nil)
+	deps, err = SharedDependencies(installed, []*v1alpha1.ClusterServiceVersion{installed, local}, shared)
+	require.NoError(t, err)
+	assert.Empty(t, deps)
+}
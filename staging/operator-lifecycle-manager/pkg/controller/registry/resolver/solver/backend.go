@@ -0,0 +1,246 @@
+package solver
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+const (
+	// SearchBackendName is the name of the Backend that searches for every solution from scratch.
+	SearchBackendName = "search"
+
+	// MemoizedBackendName is the name of the Backend that reuses the solutions to identical inputs, and
+	// the choices of previous solutions when searching for the solutions to other inputs.
+	MemoizedBackendName = "memoized"
+
+	// DefaultMemoizedBackendSize is the number of solutions retained by a memoized Backend.
+	DefaultMemoizedBackendSize = 128
+)
+
+// Backend creates the Solver used for a single resolution. A Backend may retain state across the
+// Solvers it creates, and must be safe for concurrent use.
+type Backend interface {
+	New(options ...Option) (Solver, error)
+}
+
+// SearchBackend creates Solvers that search for a solution from scratch.
+var SearchBackend Backend = searchBackend{}
+
+type searchBackend struct{}
+
+func (searchBackend) New(options ...Option) (Solver, error) {
+	return New(options...)
+}
+
+// NewBackend returns a new Backend given its name.
+func NewBackend(name string) (Backend, error) {
+	switch name {
+	case "", SearchBackendName:
+		return SearchBackend, nil
+	case MemoizedBackendName:
+		return NewMemoizedBackend(DefaultMemoizedBackendSize), nil
+	default:
+		return nil, fmt.Errorf("unknown solver backend %q, expected one of %q or %q", name, SearchBackendName, MemoizedBackendName)
+	}
+}
+
+// memoizedBackend remembers the solutions to recently solved inputs. When an identical input is
+// seen again, which is common since namespaces are re-resolved whenever any of their operators
+// change, the previous solution is returned without translating the input into clauses or
+// searching for a solution.
+//
+// Any other input is searched for a solution, assuming the choices made by the most recent
+// solution to an input with the same anchors: a dependency whose candidates haven't changed
+// is first satisfied by the candidate that was selected for it previously. Search therefore
+// only backtracks over the choices that changed, but a previously selected candidate is kept
+// even if a more preferred one would now be permitted.
+type memoizedBackend struct {
+	mu        sync.Mutex
+	solutions *memo // of []Identifier, by inputDigest
+	choices   *memo // of map[string]Identifier, by anchorsDigest
+}
+
+// NewMemoizedBackend returns a Backend that retains the solutions to the most recent size inputs.
+func NewMemoizedBackend(size int) Backend {
+	if size <= 0 {
+		size = DefaultMemoizedBackendSize
+	}
+	return &memoizedBackend{
+		solutions: newMemo(size),
+		choices:   newMemo(size),
+	}
+}
+
+func (b *memoizedBackend) New(options ...Option) (Solver, error) {
+	s, err := configure(options...)
+	if err != nil {
+		return nil, err
+	}
+	return &memoizedSolver{solver: s, backend: b}, nil
+}
+
+func (b *memoizedBackend) lookup(input, anchors string) ([]Identifier, map[string]Identifier, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if ids, ok := b.solutions.get(input); ok {
+		return ids.([]Identifier), nil, true
+	}
+	choices, _ := b.choices.get(anchors)
+	previous, _ := choices.(map[string]Identifier)
+	return nil, previous, false
+}
+
+func (b *memoizedBackend) store(input, anchors string, ids []Identifier, choices map[string]Identifier) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.solutions.put(input, ids)
+	b.choices.put(anchors, choices)
+}
+
+type memoizedSolver struct {
+	*solver
+	backend *memoizedBackend
+}
+
+// Solve returns the previous solution to an identical input, or otherwise searches for
+// a solution. Inputs are compared independently of the order of their Installables.
+func (s *memoizedSolver) Solve(ctx context.Context) ([]Installable, error) {
+	inputKey, anchorsKey := inputDigest(s.input, s.deferred), anchorsDigest(s.input, s.deferred)
+	solution, previous, ok := s.backend.lookup(inputKey, anchorsKey)
+	if ok {
+		return s.reuse(solution), nil
+	}
+
+	if err := s.solver.mapInput(); err != nil {
+		return nil, err
+	}
+	s.solver.previous = previous
+	result, err := s.solver.Solve(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]Identifier, len(result))
+	for i, installable := range result {
+		ids[i] = installable.Identifier()
+	}
+	s.backend.store(inputKey, anchorsKey, ids, choicesOf(result))
+
+	return result, nil
+}
+
+// reuse returns the Installables of the input with the given Identifiers, in input order.
+func (s *memoizedSolver) reuse(ids []Identifier) []Installable {
+	selected := make(map[Identifier]struct{}, len(ids))
+	for _, id := range ids {
+		selected[id] = struct{}{}
+	}
+
+	result := make([]Installable, 0, len(ids))
+	for _, installable := range s.input {
+		if _, ok := selected[installable.Identifier()]; ok {
+			result = append(result, installable)
+		}
+	}
+	return result
+}
+
+// choicesOf returns the first candidate of each dependency of the given solution that
+// was selected to satisfy it, by choiceKey.
+func choicesOf(solution []Installable) map[string]Identifier {
+	selected := make(map[Identifier]struct{}, len(solution))
+	for _, installable := range solution {
+		selected[installable.Identifier()] = struct{}{}
+	}
+
+	choices := make(map[string]Identifier)
+	for _, installable := range solution {
+		for _, constraint := range installable.Constraints() {
+			ids := constraint.order()
+			for _, id := range ids {
+				if _, ok := selected[id]; ok {
+					choices[choiceKey(installable.Identifier(), ids)] = id
+					break
+				}
+			}
+		}
+	}
+	return choices
+}
+
+// inputDigest returns a digest of the given Installables, their Constraints and the deferred
+// anchors that doesn't depend on the order of the Installables. Constraints are described by
+// the type and the description of their base Constraint, which covers everything that affects
+// how they are applied, including the order of the candidates of dependencies.
+func inputDigest(input []Installable, deferred []Identifier) string {
+	sorted := make([]Installable, len(input))
+	copy(sorted, input)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Identifier() < sorted[j].Identifier()
+	})
+
+	h := sha256.New()
+	for _, installable := range sorted {
+		fmt.Fprintf(h, "%q", installable.Identifier())
+		for _, constraint := range installable.Constraints() {
+			base := constraint.base()
+			fmt.Fprintf(h, "%T%q", base, base.String(installable.Identifier()))
+		}
+		h.Write([]byte{0})
+	}
+	fmt.Fprintf(h, "%q", deferred)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// anchorsDigest returns a digest of the anchors among the given Installables and of the
+// deferred anchors that doesn't depend on the order of the Installables.
+func anchorsDigest(input []Installable, deferred []Identifier) string {
+	var anchors []string
+	for _, installable := range input {
+		for _, constraint := range installable.Constraints() {
+			if constraint.anchor() {
+				anchors = append(anchors, string(installable.Identifier()))
+				break
+			}
+		}
+	}
+	sort.Strings(anchors)
+
+	h := sha256.New()
+	fmt.Fprintf(h, "%q%q", anchors, deferred)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// memo retains the values stored for the most recent size keys.
+type memo struct {
+	size   int
+	values map[string]interface{}
+	order  []string
+}
+
+func newMemo(size int) *memo {
+	return &memo{
+		size:   size,
+		values: make(map[string]interface{}, size),
+	}
+}
+
+func (m *memo) get(key string) (interface{}, bool) {
+	value, ok := m.values[key]
+	return value, ok
+}
+
+func (m *memo) put(key string, value interface{}) {
+	if _, ok := m.values[key]; !ok {
+		m.order = append(m.order, key)
+	}
+	m.values[key] = value
+	for len(m.order) > m.size {
+		delete(m.values, m.order[0])
+		m.order = m.order[1:]
+	}
+}
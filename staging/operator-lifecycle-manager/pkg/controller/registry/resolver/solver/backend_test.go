@@ -0,0 +1,177 @@
+package solver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBackend(t *testing.T) {
+	b, err := NewBackend("")
+	require.NoError(t, err)
+	assert.Equal(t, SearchBackend, b)
+
+	b, err = NewBackend(MemoizedBackendName)
+	require.NoError(t, err)
+	assert.IsType(t, &memoizedBackend{}, b)
+
+	_, err = NewBackend("unknown")
+	assert.Error(t, err)
+}
+
+func TestMemoizedBackend(t *testing.T) {
+	input := []Installable{
+		installable("a", Mandatory(), Dependency("b", "c")),
+		installable("b", Conflict("d")),
+		installable("c"),
+		installable("d", Mandatory()),
+	}
+	reordered := []Installable{input[3], input[2], input[1], input[0]}
+
+	backend := NewMemoizedBackend(1).(*memoizedBackend)
+
+	solve := func(input []Installable) []Installable {
+		s, err := backend.New(WithInput(input))
+		require.NoError(t, err)
+		installed, err := s.Solve(context.Background())
+		require.NoError(t, err)
+		return installed
+	}
+
+	expected, err := SearchBackend.New(WithInput(input))
+	require.NoError(t, err)
+	want, err := expected.Solve(context.Background())
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, want, solve(input))
+	require.Len(t, backend.solutions.values, 1)
+
+	// The solution is reused regardless of input order
+	assert.ElementsMatch(t, want, solve(reordered))
+	require.Len(t, backend.solutions.values, 1)
+
+	// A different input replaces the oldest solution
+	changed := []Installable{
+		installable("a", Mandatory(), Dependency("b", "c")),
+		installable("b"),
+		installable("c"),
+	}
+	assert.ElementsMatch(t, []Installable{changed[0], changed[1]}, solve(changed))
+	require.Len(t, backend.solutions.values, 1)
+}
+
+// describedConstraint describes a Constraint with a fixed message, like the
+// Constraints built by the resolver.
+type describedConstraint struct {
+	Constraint
+	msg string
+}
+
+func (c describedConstraint) String(Identifier) string {
+	return c.msg
+}
+
+func TestMemoizedBackendReorderedCandidates(t *testing.T) {
+	backend := NewMemoizedBackend(DefaultMemoizedBackendSize)
+	solve := func(candidates ...Identifier) []Installable {
+		s, err := backend.New(WithInput([]Installable{
+			installable("a", Mandatory(), describedConstraint{Constraint: Dependency(candidates...), msg: "a requires an operator"}),
+			installable("b"),
+			installable("c"),
+		}))
+		require.NoError(t, err)
+		installed, err := s.Solve(context.Background())
+		require.NoError(t, err)
+		return installed
+	}
+
+	assert.ElementsMatch(t, []Identifier{"a", "c"}, identifiers(solve("c", "b")))
+	// Candidates are preferred in order, even if their constraints are described identically
+	assert.ElementsMatch(t, []Identifier{"a", "b"}, identifiers(solve("b", "c")))
+}
+
+func TestMemoizedBackendPrefersPreviousChoices(t *testing.T) {
+	backend := NewMemoizedBackend(DefaultMemoizedBackendSize)
+	solve := func(input ...Installable) []Identifier {
+		s, err := backend.New(WithInput(input))
+		require.NoError(t, err)
+		installed, err := s.Solve(context.Background())
+		require.NoError(t, err)
+		return identifiers(installed)
+	}
+
+	assert.ElementsMatch(t, []Identifier{"a", "c"}, solve(
+		installable("a", Mandatory(), Dependency("b", "c")),
+		installable("b", Prohibited()),
+		installable("c"),
+	))
+
+	// The previously selected candidate is kept while it's permitted
+	assert.ElementsMatch(t, []Identifier{"a", "c"}, solve(
+		installable("a", Mandatory(), Dependency("b", "c")),
+		installable("b"),
+		installable("c"),
+	))
+
+	// but not once it's ruled out
+	assert.ElementsMatch(t, []Identifier{"a", "b"}, solve(
+		installable("a", Mandatory(), Dependency("b", "c")),
+		installable("b"),
+		installable("c", Prohibited()),
+	))
+
+	// Dependencies whose candidates changed are satisfied in order
+	assert.ElementsMatch(t, []Identifier{"a", "d"}, solve(
+		installable("a", Mandatory(), Dependency("d", "b", "c")),
+		installable("b"),
+		installable("c"),
+		installable("d"),
+	))
+}
+
+func identifiers(installables []Installable) []Identifier {
+	ids := make([]Identifier, len(installables))
+	for i, installable := range installables {
+		ids[i] = installable.Identifier()
+	}
+	return ids
+}
+
+func TestInputDigest(t *testing.T) {
+	input := []Installable{
+		installable("a", Mandatory(), Dependency("b", "c")),
+		installable("b", AtMost(1, "c", "d")),
+	}
+	// Identical inputs have the same digest, even if their constraints are different values
+	same := []Installable{
+		installable("b", AtMost(1, "c", "d")),
+		installable("a", Mandatory(), Dependency("b", "c")),
+	}
+	assert.Equal(t, inputDigest(input, nil), inputDigest(same, nil))
+
+	for name, different := range map[string][]Installable{
+		"DependencyOrder": {
+			installable("a", Mandatory(), Dependency("c", "b")),
+			installable("b", AtMost(1, "c", "d")),
+		},
+		"AtMostCount": {
+			installable("a", Mandatory(), Dependency("b", "c")),
+			installable("b", AtMost(2, "c", "d")),
+		},
+		"ConstraintType": {
+			installable("a", Prohibited(), Dependency("b", "c")),
+			installable("b", AtMost(1, "c", "d")),
+		},
+	} {
+		assert.NotEqual(t, inputDigest(input, nil), inputDigest(different, nil), name)
+	}
+	assert.NotEqual(t, inputDigest(input, nil), inputDigest(input, []Identifier{"a"}))
+
+	// Described constraints are compared by the constraints they describe
+	described := func(ids ...Identifier) []Installable {
+		return []Installable{installable("a", Mandatory(), describedConstraint{Constraint: Dependency(ids...), msg: "a requires an operator"})}
+	}
+	assert.NotEqual(t, inputDigest(described("b", "c"), nil), inputDigest(described("c", "b"), nil))
+}
@@ -2,6 +2,7 @@ package solver
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"strconv"
 	"testing"
@@ -81,3 +82,143 @@ func BenchmarkNewInput(b *testing.B) {
 		}
 	}
 }
+
+// catalogScale describes the shape of a generated resolution problem that
+// resembles the input built by the resolver for a namespace: every package has
+// a channel of bundles, at most one bundle may be installed per package, bundles
+// depend on APIs provided by the bundles of other packages, and each
+// subscription requires some bundle of its package.
+type catalogScale struct {
+	name          string
+	packages      int
+	versions      int
+	subscriptions int
+	pDependency   float64
+	nDependency   int
+}
+
+var catalogScales = []catalogScale{
+	{name: "small", packages: 50, versions: 5, subscriptions: 5, pDependency: .2, nDependency: 2},
+	{name: "medium", packages: 250, versions: 10, subscriptions: 25, pDependency: .2, nDependency: 3},
+	{name: "large", packages: 1000, versions: 20, subscriptions: 100, pDependency: .2, nDependency: 3},
+}
+
+func generateCatalogInput(scale catalogScale, seed int64) []Installable {
+	rand := rand.New(rand.NewSource(seed))
+
+	bundle := func(p, v int) Identifier {
+		return Identifier(fmt.Sprintf("catalog/package-%d/stable/package-%d.v%d", p, p, v))
+	}
+	// channel returns the bundles of a package, newest first, which is the
+	// order in which the resolver prefers them.
+	channel := func(p int) []Identifier {
+		ids := make([]Identifier, scale.versions)
+		for v := range ids {
+			ids[v] = bundle(p, scale.versions-1-v)
+		}
+		return ids
+	}
+
+	var result []Installable
+	for p := 0; p < scale.packages; p++ {
+		ids := channel(p)
+		for v := 0; v < scale.versions; v++ {
+			var c []Constraint
+			if rand.Float64() < scale.pDependency {
+				for x := rand.Intn(scale.nDependency) + 1; x > 0; x-- {
+					q := p
+					for q == p {
+						q = rand.Intn(scale.packages)
+					}
+					// Depend on an API that only some versions of the providing package serve
+					c = append(c, Dependency(channel(q)[:rand.Intn(scale.versions)+1]...))
+				}
+			}
+			result = append(result, TestInstallable{identifier: bundle(p, v), constraints: c})
+		}
+		result = append(result, TestInstallable{
+			identifier:  Identifier(fmt.Sprintf("package-%d uniqueness", p)),
+			constraints: []Constraint{Mandatory(), AtMost(1, ids...)},
+		})
+	}
+
+	for i, p := range rand.Perm(scale.packages)[:scale.subscriptions] {
+		result = append(result, TestInstallable{
+			identifier:  Identifier(fmt.Sprintf("subscription-%d", i)),
+			constraints: []Constraint{Mandatory(), Dependency(channel(p)...)},
+		})
+	}
+
+	return result
+}
+
+// BenchmarkBackends compares Solver backends across catalogs of increasing
+// size. Each iteration solves the same input, as happens when a namespace is
+// re-resolved without any changes to its catalogs, so backends that reuse
+// previous solutions are measured in their steady state.
+func BenchmarkBackends(b *testing.B) {
+	for _, scale := range catalogScales {
+		input := generateCatalogInput(scale, 9)
+		for _, name := range []string{SearchBackendName, MemoizedBackendName} {
+			b.Run(fmt.Sprintf("%s/%s", scale.name, name), func(b *testing.B) {
+				backend, err := NewBackend(name)
+				if err != nil {
+					b.Fatalf("failed to initialize backend: %s", err)
+				}
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					s, err := backend.New(WithInput(input))
+					if err != nil {
+						b.Fatalf("failed to initialize solver: %s", err)
+					}
+					if _, err := s.Solve(context.Background()); err != nil {
+						b.Fatalf("failed to solve: %s", err)
+					}
+				}
+			})
+		}
+	}
+}
+
+// BenchmarkBackendsChangingInput compares Solver backends when every
+// resolution has a different input, so that no previous solution can be
+// reused as a whole, although the choices made for the dependencies that
+// didn't change can be.
+func BenchmarkBackendsChangingInput(b *testing.B) {
+	for _, scale := range catalogScales {
+		inputs := make([][]Installable, 8)
+		for i := range inputs {
+			inputs[i] = generateCatalogInput(scale, int64(i))
+		}
+		for _, name := range []string{SearchBackendName, MemoizedBackendName} {
+			b.Run(fmt.Sprintf("%s/%s", scale.name, name), func(b *testing.B) {
+				backend, err := NewBackend(name)
+				if err != nil {
+					b.Fatalf("failed to initialize backend: %s", err)
+				}
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					s, err := backend.New(WithInput(inputs[i%len(inputs)]))
+					if err != nil {
+						b.Fatalf("failed to initialize solver: %s", err)
+					}
+					s.Solve(context.Background())
+				}
+			})
+		}
+	}
+}
+
+func TestGeneratedCatalogInputSolvable(t *testing.T) {
+	for _, scale := range catalogScales[:2] {
+		t.Run(scale.name, func(t *testing.T) {
+			s, err := New(WithInput(generateCatalogInput(scale, 9)))
+			if err != nil {
+				t.Fatalf("failed to initialize solver: %s", err)
+			}
+			if _, err := s.Solve(context.Background()); err != nil {
+				t.Fatalf("failed to solve generated input: %s", err)
+			}
+		})
+	}
+}
@@ -15,6 +15,10 @@ type Constraint interface {
 	apply(c *logic.C, lm *litMapping, subject Identifier) z.Lit
 	order() []Identifier
 	anchor() bool
+	// base returns the Constraint that determines how the receiver is
+	// applied. Types that wrap a Constraint, for example to describe it
+	// differently, inherit it from the wrapped Constraint.
+	base() Constraint
 }
 
 // zeroConstraint is returned by ConstraintOf in error cases.
@@ -38,6 +42,10 @@ func (zeroConstraint) anchor() bool {
 	return false
 }
 
+func (zeroConstraint) base() Constraint {
+	return zeroConstraint{}
+}
+
 // AppliedConstraint values compose a single Constraint with the
 // Installable it applies to.
 type AppliedConstraint struct {
@@ -69,6 +77,10 @@ func (constraint mandatory) anchor() bool {
 	return true
 }
 
+func (constraint mandatory) base() Constraint {
+	return constraint
+}
+
 // Mandatory returns a Constraint that will permit only solutions that
 // contain a particular Installable.
 func Mandatory() Constraint {
@@ -93,6 +105,10 @@ func (constraint prohibited) anchor() bool {
 	return false
 }
 
+func (constraint prohibited) base() Constraint {
+	return constraint
+}
+
 // Prohibited returns a Constraint that will reject any solution that
 // contains a particular Installable. Callers may also decide to omit
 // an Installable from input to Solve rather than apply such a
@@ -130,6 +146,10 @@ func (constraint dependency) anchor() bool {
 	return false
 }
 
+func (constraint dependency) base() Constraint {
+	return constraint
+}
+
 // Dependency returns a Constraint that will only permit solutions
 // containing a given Installable on the condition that at least one
 // of the Installables identified by the given Identifiers also
@@ -157,6 +177,10 @@ func (constraint conflict) anchor() bool {
 	return false
 }
 
+func (constraint conflict) base() Constraint {
+	return constraint
+}
+
 // Conflict returns a Constraint that will permit solutions containing
 // either the constrained Installable, the Installable identified by
 // the given Identifier, or neither, but not both.
@@ -193,6 +217,10 @@ func (constraint leq) anchor() bool {
 	return false
 }
 
+func (constraint leq) base() Constraint {
+	return constraint
+}
+
 // AtMost returns a Constraint that forbids solutions that contain
 // more than n of the Installables identified by the given
 // Identifiers.
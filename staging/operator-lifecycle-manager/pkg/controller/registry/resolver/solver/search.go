@@ -2,6 +2,7 @@ package solver
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/go-air/gini/inter"
 	"github.com/go-air/gini/z"
@@ -18,14 +19,18 @@ type guess struct {
 	index      int   // index of guessed literal in candidates
 	children   int   // number of choices introduced by making this guess
 	candidates []z.Lit
+	deferred   bool // whether this choice was taken from the deque of deferred choices
 }
 
 type search struct {
 	s                      inter.S
 	lits                   *litMapping
-	assumptions            map[z.Lit]struct{} // set of assumed lits - duplicates guess stack - for fast lookup
-	guesses                []guess            // stack of assumed guesses
-	headChoice, tailChoice *choice            // deque of unmade choices
+	assumptions            map[z.Lit]struct{}    // set of assumed lits - duplicates guess stack - for fast lookup
+	guesses                []guess               // stack of assumed guesses
+	headChoice, tailChoice *choice               // deque of unmade choices
+	deferred               map[z.Lit]struct{}    // set of anchors whose choices are made after all others
+	headDeferred           *choice               // stack of unmade deferred choices
+	previous               map[string]Identifier // candidates selected by a previous solution, by choiceKey
 	heap                   []choice
 	position               int
 	tracer                 Tracer
@@ -34,11 +39,19 @@ type search struct {
 }
 
 func (h *search) PushGuess() {
-	c := h.PopChoiceFront()
+	var c choice
+	var deferred bool
+	if h.headChoice != nil {
+		c = h.PopChoiceFront()
+	} else {
+		c = h.PopDeferred()
+		deferred = true
+	}
 	g := guess{
 		m:          z.LitNull,
 		index:      c.index,
 		candidates: c.candidates,
+		deferred:   deferred,
 	}
 	if g.index < len(g.candidates) {
 		g.m = g.candidates[g.index]
@@ -66,7 +79,7 @@ func (h *search) PushGuess() {
 		}
 		if len(ms) > 0 {
 			h.guesses[len(h.guesses)-1].children++
-			h.PushChoiceBack(choice{candidates: ms})
+			h.PushChoiceBack(choice{candidates: h.PreferPrevious(installable.Identifier(), constraint.order(), ms)})
 		}
 	}
 
@@ -96,9 +109,52 @@ func (h *search) PopGuess() {
 	if g.m != z.LitNull {
 		c.index++
 	}
+	if g.deferred {
+		h.PushDeferred(c)
+		return
+	}
 	h.PushChoiceFront(c)
 }
 
+// PreferPrevious moves the candidate that a previous solution selected for
+// the same choice to the front of the given candidates, so that it's kept as
+// long as it's still permitted. Choices are the same only if they have the
+// same subject and the same candidates, in the same order.
+func (h *search) PreferPrevious(subject Identifier, ids []Identifier, candidates []z.Lit) []z.Lit {
+	previous, ok := h.previous[choiceKey(subject, ids)]
+	if !ok {
+		return candidates
+	}
+	for i, id := range ids {
+		if id != previous || i == 0 {
+			continue
+		}
+		preferred := make([]z.Lit, 0, len(candidates))
+		preferred = append(preferred, candidates[i])
+		preferred = append(preferred, candidates[:i]...)
+		return append(preferred, candidates[i+1:]...)
+	}
+	return candidates
+}
+
+// choiceKey identifies the choice between the given candidates made for a subject.
+func choiceKey(subject Identifier, ids []Identifier) string {
+	return fmt.Sprintf("%q%q", subject, ids)
+}
+
+// PushDeferred adds a choice to be made only once no other choices remain.
+func (h *search) PushDeferred(c choice) {
+	c.next = h.headDeferred
+	h.headDeferred = &c
+}
+
+func (h *search) PopDeferred() choice {
+	c := h.headDeferred
+	h.headDeferred = c.next
+	c.next = nil
+	return *c
+}
+
 func (h *search) PushChoiceFront(c choice) {
 	if h.headChoice == nil {
 		h.headChoice = &c
@@ -158,15 +214,24 @@ func (h *search) Lits() []z.Lit {
 }
 
 func (h *search) Do(ctx context.Context, anchors []z.Lit) (int, []z.Lit, map[z.Lit]struct{}) {
+	var deferred []z.Lit
 	for _, m := range anchors {
+		if _, ok := h.deferred[m]; ok {
+			deferred = append(deferred, m)
+			continue
+		}
 		h.PushChoiceBack(choice{candidates: []z.Lit{m}})
 	}
+	// Deferred choices are made in input order.
+	for i := len(deferred) - 1; i >= 0; i-- {
+		h.PushDeferred(choice{candidates: []z.Lit{deferred[i]}})
+	}
 
 	for {
 		// Need to have a definitive result once all choices
 		// have been made to decide whether to end or
 		// backtrack.
-		if h.headChoice == nil && h.result == unknown {
+		if h.headChoice == nil && h.headDeferred == nil && h.result == unknown {
 			h.result = h.s.Solve()
 		}
 
@@ -181,7 +246,7 @@ func (h *search) Do(ctx context.Context, anchors []z.Lit) (int, []z.Lit, map[z.L
 		}
 
 		// Satisfiable and no decisions left!
-		if h.headChoice == nil {
+		if h.headChoice == nil && h.headDeferred == nil {
 			break
 		}
 
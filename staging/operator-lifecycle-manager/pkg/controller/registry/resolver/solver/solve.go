@@ -34,10 +34,13 @@ type Solver interface {
 }
 
 type solver struct {
-	g      inter.S
-	litMap *litMapping
-	tracer Tracer
-	buffer []z.Lit
+	g        inter.S
+	input    []Installable
+	litMap   *litMapping
+	tracer   Tracer
+	buffer   []z.Lit
+	deferred []Identifier
+	previous map[string]Identifier
 }
 
 const (
@@ -73,13 +76,18 @@ func (s *solver) Solve(ctx context.Context) (result []Installable, err error) {
 	s.litMap.AssumeConstraints(s.g)
 	s.g.Assume(assumptions...)
 
+	deferred := make(map[z.Lit]struct{}, len(s.deferred))
+	for _, id := range s.deferred {
+		deferred[s.litMap.LitOf(id)] = struct{}{}
+	}
+
 	var aset map[z.Lit]struct{}
 	// push a new test scope with the baseline assumptions, to prevent them from being cleared during search
 	outcome, _ := s.g.Test(nil)
 	if outcome != satisfiable && outcome != unsatisfiable {
 		// searcher for solutions in input order, so that preferences
 		// can be taken into acount (i.e. prefer one catalog to another)
-		outcome, assumptions, aset = (&search{s: s.g, lits: s.litMap, tracer: s.tracer}).Do(context.Background(), assumptions)
+		outcome, assumptions, aset = (&search{s: s.g, lits: s.litMap, tracer: s.tracer, deferred: deferred, previous: s.previous}).Do(context.Background(), assumptions)
 	}
 	switch outcome {
 	case satisfiable:
@@ -118,6 +126,22 @@ func (s *solver) Solve(ctx context.Context) (result []Installable, err error) {
 }
 
 func New(options ...Option) (Solver, error) {
+	return newSolver(options...)
+}
+
+func newSolver(options ...Option) (*solver, error) {
+	s, err := configure(options...)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.mapInput(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// configure returns a solver with the given options applied, whose input isn't mapped to literals yet.
+func configure(options ...Option) (*solver, error) {
 	s := solver{g: gini.New()}
 	for _, option := range append(options, defaults...) {
 		if err := option(&s); err != nil {
@@ -127,13 +151,34 @@ func New(options ...Option) (Solver, error) {
 	return &s, nil
 }
 
+// mapInput maps the solver's input to literals, unless it already was.
+func (s *solver) mapInput() error {
+	if s.litMap != nil {
+		return nil
+	}
+	var err error
+	s.litMap, err = newLitMapping(s.input)
+	return err
+}
+
 type Option func(s *solver) error
 
 func WithInput(input []Installable) Option {
 	return func(s *solver) error {
-		var err error
-		s.litMap, err = newLitMapping(input)
-		return err
+		s.input = input
+		return nil
+	}
+}
+
+// WithDeferredAnchors defers the choices made for the given anchor
+// Installables, and for their dependencies, until every other choice has
+// been made. Preferred candidates of deferred choices are therefore kept
+// unless they are ruled out by the rest of the solution, which minimizes
+// how often a less preferred candidate is selected for them.
+func WithDeferredAnchors(ids ...Identifier) Option {
+	return func(s *solver) error {
+		s.deferred = append(s.deferred, ids...)
+		return nil
 	}
 }
 
@@ -145,14 +190,6 @@ func WithTracer(t Tracer) Option {
 }
 
 var defaults = []Option{
-	func(s *solver) error {
-		if s.litMap == nil {
-			var err error
-			s.litMap, err = newLitMapping(nil)
-			return err
-		}
-		return nil
-	},
 	func(s *solver) error {
 		if s.tracer == nil {
 			s.tracer = DefaultTracer{}
@@ -361,3 +361,71 @@ func TestDuplicateIdentifier(t *testing.T) {
 	}))
 	assert.Equal(t, DuplicateIdentifier("a"), err)
 }
+
+func TestSolveDeferredAnchors(t *testing.T) {
+	type tc struct {
+		Name         string
+		Installables []Installable
+		Deferred     []Identifier
+		Installed    []Identifier
+	}
+
+	// "a" and "b" stand in for subscriptions, where "b" prefers its
+	// currently installed candidate "b1" over the upgrade "b2".
+	for _, tt := range []tc{
+		{
+			Name: "preferred candidate of earlier choice rules out upgrade",
+			Installables: []Installable{
+				installable("b", Mandatory(), Dependency("b1", "b2")),
+				installable("a", Mandatory(), Dependency("a2", "a1")),
+				installable("a2", Dependency("b2")),
+				installable("a1"),
+				installable("b1", Conflict("b2")),
+				installable("b2"),
+			},
+			Installed: []Identifier{"b", "a", "a1", "b1"},
+		},
+		{
+			Name: "deferred choice is changed when required",
+			Installables: []Installable{
+				installable("b", Mandatory(), Dependency("b1", "b2")),
+				installable("a", Mandatory(), Dependency("a2", "a1")),
+				installable("a2", Dependency("b2")),
+				installable("a1"),
+				installable("b1", Conflict("b2")),
+				installable("b2"),
+			},
+			Deferred:  []Identifier{"b"},
+			Installed: []Identifier{"b", "a", "a2", "b2"},
+		},
+		{
+			Name: "deferred choice is kept when not required to change",
+			Installables: []Installable{
+				installable("b", Mandatory(), Dependency("b1", "b2")),
+				installable("a", Mandatory(), Dependency("a2", "a1")),
+				installable("a2"),
+				installable("a1"),
+				installable("b1", Conflict("b2")),
+				installable("b2"),
+			},
+			Deferred:  []Identifier{"b"},
+			Installed: []Identifier{"b", "a", "a2", "b1"},
+		},
+	} {
+		t.Run(tt.Name, func(t *testing.T) {
+			s, err := New(WithInput(tt.Installables), WithDeferredAnchors(tt.Deferred...))
+			if err != nil {
+				t.Fatalf("failed to initialize solver: %s", err)
+			}
+
+			installed, err := s.Solve(context.Background())
+			assert.NoError(t, err)
+
+			var ids []Identifier
+			for _, installable := range installed {
+				ids = append(ids, installable.Identifier())
+			}
+			assert.Equal(t, tt.Installed, ids)
+		})
+	}
+}
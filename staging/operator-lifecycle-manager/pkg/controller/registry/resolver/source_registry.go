@@ -30,10 +30,24 @@ func SourceProviderFromRegistryClientProvider(rcp RegistryClientProvider, logger
 	}
 }
 
+// connectionIdentifier is implemented by RegistryClientProviders that track the connections to their sources.
+type connectionIdentifier interface {
+	ConnectionID(key registry.CatalogKey) string
+}
+
 type registrySource struct {
-	key    cache.SourceKey
-	client client.Interface
-	logger logrus.StdLogger
+	key     cache.SourceKey
+	client  client.Interface
+	logger  logrus.StdLogger
+	version string
+}
+
+var _ cache.VersionedSource = &registrySource{}
+
+// ContentVersion identifies the connection to the source's registry, which changes whenever the registry is replaced.
+// The cache keeps the snapshot of a managed catalog without listing its bundles again while it doesn't change.
+func (s *registrySource) ContentVersion() string {
+	return s.version
 }
 
 func (s *registrySource) Snapshot(ctx context.Context) (*cache.Snapshot, error) {
@@ -74,17 +88,22 @@ func (s *registrySource) Snapshot(ctx context.Context) (*cache.Snapshot, error)
 		return nil, fmt.Errorf("error encountered while listing bundles: %w", err)
 	}
 
-	return &cache.Snapshot{Entries: operators}, nil
+	return &cache.Snapshot{Entries: operators, Version: s.version}, nil
 }
 
 func (a *registryClientAdapter) Sources(namespaces ...string) map[cache.SourceKey]cache.Source {
 	result := make(map[cache.SourceKey]cache.Source)
+	connections, _ := a.rcp.(connectionIdentifier)
 	for key, client := range a.rcp.ClientsForNamespaces(namespaces...) {
-		result[cache.SourceKey(key)] = &registrySource{
+		source := &registrySource{
 			key:    cache.SourceKey(key),
 			client: client,
 			logger: a.logger,
 		}
+		if connections != nil {
+			source.version = connections.ConnectionID(key)
+		}
+		result[cache.SourceKey(key)] = source
 	}
 	return result
 }
@@ -1,16 +1,21 @@
 package resolver
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"testing"
 
 	"github.com/blang/semver/v4"
 	opver "github.com/operator-framework/api/pkg/lib/version"
 	"github.com/operator-framework/api/pkg/operators/v1alpha1"
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/registry/resolver/cache"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/registry/resolver/fakes"
 	"github.com/operator-framework/operator-registry/pkg/api"
+	"github.com/operator-framework/operator-registry/pkg/client"
 	opregistry "github.com/operator-framework/operator-registry/pkg/registry"
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
@@ -392,3 +397,38 @@ func TestNewOperatorFromBundleStripsPluralRequiredAndProvidedAPIKeys(t *testing.
 	assert.Equal(t, "K.v1.g", o.ProvidedAPIs.String())
 	assert.Equal(t, "K2.v2.g2", o.RequiredAPIs.String())
 }
+
+type bundleStream []*api.Bundle
+
+func (s *bundleStream) Recv() (*api.Bundle, error) {
+	if len(*s) == 0 {
+		return nil, io.EOF
+	}
+	b := (*s)[0]
+	*s = (*s)[1:]
+	return b, nil
+}
+
+func TestRegistrySourceSnapshotVersion(t *testing.T) {
+	fakeClient := &fakes.FakeClientInterface{}
+	fakeClient.GetPackageReturns(&api.Package{DefaultChannelName: "stable"}, nil)
+	fakeClient.ListBundlesCalls(func(context.Context) (*client.BundleIterator, error) {
+		stream := bundleStream{
+			{CsvName: "a.v1", PackageName: "a", ChannelName: "stable", Version: "1.0.0", BundlePath: "quay.io/a@sha256:1"},
+		}
+		return client.NewBundleIterator(&stream), nil
+	})
+
+	source := &registrySource{
+		key:     cache.SourceKey{Name: "catsrc", Namespace: "ns"},
+		client:  fakeClient,
+		logger:  logrus.New(),
+		version: "connection",
+	}
+	snapshot, err := source.Snapshot(context.Background())
+	require.NoError(t, err)
+	require.Len(t, snapshot.Entries, 1)
+	assert.True(t, snapshot.Entries[0].SourceInfo.DefaultChannel)
+	assert.Equal(t, "connection", snapshot.Version)
+	assert.Equal(t, "connection", source.ContentVersion())
+}
@@ -15,15 +15,22 @@ import (
 
 	"github.com/operator-framework/api/pkg/operators/v1alpha1"
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/clientset/versioned"
+	v1listers "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/listers/operators/v1"
 	v1alpha1listers "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/listers/operators/v1alpha1"
 	controllerbundle "github.com/operator-framework/operator-lifecycle-manager/pkg/controller/bundle"
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/registry/resolver/cache"
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/registry/resolver/projection"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/registry/resolver/solver"
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/lib/operatorlister"
+	sharedtime "github.com/operator-framework/operator-lifecycle-manager/pkg/lib/time"
 )
 
 const (
 	BundleLookupConditionPacked v1alpha1.BundleLookupConditionType = "BundleLookupNotPersisted"
+
+	// GeneratedByAnnotationKey is set on Subscriptions that OLM creates to install dependencies, and
+	// names the InstallPlan that created them.
+	GeneratedByAnnotationKey = "olm.generated-by"
 )
 
 var timeNow = func() metav1.Time { return metav1.NewTime(time.Now().UTC()) }
@@ -37,6 +44,7 @@ type OperatorStepResolver struct {
 	subLister              v1alpha1listers.SubscriptionLister
 	csvLister              v1alpha1listers.ClusterServiceVersionLister
 	ipLister               v1alpha1listers.InstallPlanLister
+	ogLister               v1listers.OperatorGroupLister
 	client                 versioned.Interface
 	kubeclient             kubernetes.Interface
 	globalCatalogNamespace string
@@ -47,15 +55,18 @@ type OperatorStepResolver struct {
 var _ StepResolver = &OperatorStepResolver{}
 
 func NewOperatorStepResolver(lister operatorlister.OperatorLister, client versioned.Interface, kubeclient kubernetes.Interface,
-	globalCatalogNamespace string, provider RegistryClientProvider, log logrus.FieldLogger) *OperatorStepResolver {
+	globalCatalogNamespace string, provider RegistryClientProvider, backend solver.Backend, log logrus.FieldLogger, cacheOptions ...cache.Option) *OperatorStepResolver {
+	facts := NewClusterFactsProvider(kubeclient, lister.OperatorsV1alpha1().ClusterServiceVersionLister(), globalCatalogNamespace)
+	shared := NewGlobalOperatorGroupSharedOperators(lister.OperatorsV1().OperatorGroupLister(), lister.OperatorsV1alpha1().ClusterServiceVersionLister())
 	return &OperatorStepResolver{
 		subLister:              lister.OperatorsV1alpha1().SubscriptionLister(),
 		csvLister:              lister.OperatorsV1alpha1().ClusterServiceVersionLister(),
 		ipLister:               lister.OperatorsV1alpha1().InstallPlanLister(),
+		ogLister:               lister.OperatorsV1().OperatorGroupLister(),
 		client:                 client,
 		kubeclient:             kubeclient,
 		globalCatalogNamespace: globalCatalogNamespace,
-		satResolver:            NewDefaultSatResolver(SourceProviderFromRegistryClientProvider(provider, log), lister.OperatorsV1alpha1().CatalogSourceLister(), log),
+		satResolver:            NewDefaultSatResolver(SourceProviderFromRegistryClientProvider(provider, log), lister.OperatorsV1alpha1().CatalogSourceLister(), facts, shared, backend, log, cacheOptions...),
 		log:                    log,
 	}
 }
@@ -87,7 +98,11 @@ func (r *OperatorStepResolver) ResolveSteps(namespace string) ([]*v1alpha1.Step,
 
 	var operators cache.OperatorSet
 	namespaces := []string{namespace, r.globalCatalogNamespace}
-	operators, err = r.satResolver.SolveOperators(namespaces, csvs, subs)
+	upgradeModeSubs, err := r.withUpgradeModes(namespace, subs)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	operators, err = r.satResolver.SolveOperators(namespaces, csvs, upgradeModeSubs)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -218,6 +233,44 @@ func (r *OperatorStepResolver) hasExistingCurrentCSV(sub *v1alpha1.Subscription)
 	return false, err // Can't answer this question right now.
 }
 
+// withUpgradeModes returns copies of the given Subscriptions with their upgrade mode set. Subscriptions
+// generated for dependencies default to the upgrade mode of the namespace's OperatorGroup, so that
+// the upgrades requested through other Subscriptions don't also upgrade their dependencies needlessly.
+// Installed Subscriptions whose upgrade windows are closed always use MinimalChange.
+func (r *OperatorStepResolver) withUpgradeModes(namespace string, subs []*v1alpha1.Subscription) ([]*v1alpha1.Subscription, error) {
+	mode := v1alpha1.UpgradeModeDefault
+	if r.ogLister != nil {
+		ogs, err := r.ogLister.OperatorGroups(namespace).List(labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		// the OperatorGroup is ignored if the namespace is misconfigured with more than one
+		if len(ogs) == 1 && ogs[0].Spec.UpgradeMode != "" {
+			mode = ogs[0].Spec.UpgradeMode
+		}
+	}
+
+	now := timeNow()
+	out := make([]*v1alpha1.Subscription, 0, len(subs))
+	for _, sub := range subs {
+		sub = sub.DeepCopy()
+		if sub.Spec.UpgradeMode == "" {
+			sub.Spec.UpgradeMode = v1alpha1.UpgradeModeDefault
+			if _, ok := sub.GetAnnotations()[GeneratedByAnnotationKey]; ok {
+				sub.Spec.UpgradeMode = mode
+			}
+		}
+		// installed operators are only changed outside of their upgrade windows if other operators require it
+		if sub.Status.InstalledCSV != "" && len(sub.Spec.UpgradeWindows) > 0 {
+			if open, _, _, err := sharedtime.WindowsOpen(sub.Spec.UpgradeWindows, now.Time); err != nil || !open {
+				sub.Spec.UpgradeMode = v1alpha1.UpgradeModeMinimalChange
+			}
+		}
+		out = append(out, sub)
+	}
+	return out, nil
+}
+
 func (r *OperatorStepResolver) listSubscriptions(namespace string) ([]*v1alpha1.Subscription, error) {
 	list, err := r.client.OperatorsV1alpha1().Subscriptions(namespace).List(context.TODO(), metav1.ListOptions{})
 	if err != nil {
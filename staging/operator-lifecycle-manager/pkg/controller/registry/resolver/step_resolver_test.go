@@ -15,6 +15,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
 
+	operatorsv1 "github.com/operator-framework/api/pkg/operators/v1"
 	"github.com/operator-framework/api/pkg/operators/v1alpha1"
 	"github.com/operator-framework/operator-registry/pkg/api"
 	opregistry "github.com/operator-framework/operator-registry/pkg/registry"
@@ -22,6 +23,7 @@ import (
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/clientset/versioned"
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/clientset/versioned/fake"
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/informers/externalversions"
+	v1listers "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/listers/operators/v1"
 	controllerbundle "github.com/operator-framework/operator-lifecycle-manager/pkg/controller/bundle"
 	resolvercache "github.com/operator-framework/operator-lifecycle-manager/pkg/controller/registry/resolver/cache"
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/registry/resolver/solver"
@@ -823,6 +825,7 @@ func TestResolver(t *testing.T) {
 			lister := operatorlister.NewLister()
 			lister.OperatorsV1alpha1().RegisterSubscriptionLister(namespace, informerFactory.Operators().V1alpha1().Subscriptions().Lister())
 			lister.OperatorsV1alpha1().RegisterClusterServiceVersionLister(namespace, informerFactory.Operators().V1alpha1().ClusterServiceVersions().Lister())
+			lister.OperatorsV1().RegisterOperatorGroupLister(namespace, informerFactory.Operators().V1().OperatorGroups().Lister())
 			kClientFake := k8sfake.NewSimpleClientset()
 
 			ssp := make(resolvercache.StaticSourceProvider)
@@ -842,7 +845,7 @@ func TestResolver(t *testing.T) {
 				cache: resolvercache.New(ssp),
 				log:   log,
 			}
-			resolver := NewOperatorStepResolver(lister, clientFake, kClientFake, "", nil, log)
+			resolver := NewOperatorStepResolver(lister, clientFake, kClientFake, "", nil, nil, log)
 			resolver.satResolver = satresolver
 
 			steps, lookups, subs, err := resolver.ResolveSteps(namespace)
@@ -971,6 +974,7 @@ func TestNamespaceResolverRBAC(t *testing.T) {
 			lister := operatorlister.NewLister()
 			lister.OperatorsV1alpha1().RegisterSubscriptionLister(namespace, informerFactory.Operators().V1alpha1().Subscriptions().Lister())
 			lister.OperatorsV1alpha1().RegisterClusterServiceVersionLister(namespace, informerFactory.Operators().V1alpha1().ClusterServiceVersions().Lister())
+			lister.OperatorsV1().RegisterOperatorGroupLister(namespace, informerFactory.Operators().V1().OperatorGroups().Lister())
 
 			stubSnapshot := &resolvercache.Snapshot{}
 			for _, bundle := range tt.bundlesInCatalog {
@@ -985,7 +989,7 @@ func TestNamespaceResolverRBAC(t *testing.T) {
 					catalog: stubSnapshot,
 				}),
 			}
-			resolver := NewOperatorStepResolver(lister, clientFake, kClientFake, "", nil, logrus.New())
+			resolver := NewOperatorStepResolver(lister, clientFake, kClientFake, "", nil, nil, logrus.New())
 			resolver.satResolver = satresolver
 			steps, _, subs, err := resolver.ResolveSteps(namespace)
 			require.Equal(t, tt.out.err, err)
@@ -995,6 +999,72 @@ func TestNamespaceResolverRBAC(t *testing.T) {
 	}
 }
 
+func TestWithUpgradeModes(t *testing.T) {
+	namespace := "catsrc-namespace"
+	catalog := resolvercache.SourceKey{Name: "catsrc", Namespace: namespace}
+
+	userSub := newSub(namespace, "a", "alpha", catalog)
+	generatedSub := newSub(namespace, "b", "alpha", catalog)
+	generatedSub.SetAnnotations(map[string]string{GeneratedByAnnotationKey: "install-abcde"})
+	explicitSub := newSub(namespace, "c", "alpha", catalog)
+	explicitSub.SetAnnotations(map[string]string{GeneratedByAnnotationKey: "install-abcde"})
+	explicitSub.Spec.UpgradeMode = v1alpha1.UpgradeModeDefault
+	windowSub := newSub(namespace, "d", "alpha", catalog)
+	windowSub.Spec.UpgradeWindows = []v1alpha1.UpgradeWindow{{Schedule: "0 2 * * *", Duration: metav1.Duration{Duration: time.Hour}}}
+	windowSub.Status.InstalledCSV = "d.v1"
+
+	// outside of windowSub's upgrade window
+	defer func(now func() metav1.Time) { timeNow = now }(timeNow)
+	timeNow = func() metav1.Time { return metav1.NewTime(time.Date(2021, 3, 6, 3, 0, 0, 0, time.UTC)) }
+
+	tests := []struct {
+		name string
+		mode v1alpha1.UpgradeMode
+		want map[string]v1alpha1.UpgradeMode
+	}{
+		{
+			name: "NoOperatorGroupMode",
+			want: map[string]v1alpha1.UpgradeMode{
+				userSub.GetName():      v1alpha1.UpgradeModeDefault,
+				generatedSub.GetName(): v1alpha1.UpgradeModeDefault,
+				explicitSub.GetName():  v1alpha1.UpgradeModeDefault,
+				windowSub.GetName():    v1alpha1.UpgradeModeMinimalChange,
+			},
+		},
+		{
+			name: "MinimalChangeOnlyAppliesToGeneratedSubscriptions",
+			mode: v1alpha1.UpgradeModeMinimalChange,
+			want: map[string]v1alpha1.UpgradeMode{
+				userSub.GetName():      v1alpha1.UpgradeModeDefault,
+				generatedSub.GetName(): v1alpha1.UpgradeModeMinimalChange,
+				explicitSub.GetName():  v1alpha1.UpgradeModeDefault,
+				windowSub.GetName():    v1alpha1.UpgradeModeMinimalChange,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+			require.NoError(t, indexer.Add(&operatorsv1.OperatorGroup{
+				ObjectMeta: metav1.ObjectMeta{Name: "og", Namespace: namespace},
+				Spec:       operatorsv1.OperatorGroupSpec{UpgradeMode: tt.mode},
+			}))
+			r := &OperatorStepResolver{ogLister: v1listers.NewOperatorGroupLister(indexer)}
+
+			subs := []*v1alpha1.Subscription{userSub, generatedSub, explicitSub, windowSub}
+			out, err := r.withUpgradeModes(namespace, subs)
+			require.NoError(t, err)
+
+			got := map[string]v1alpha1.UpgradeMode{}
+			for _, sub := range out {
+				got[sub.GetName()] = sub.Spec.UpgradeMode
+			}
+			require.Equal(t, tt.want, got)
+			require.Empty(t, userSub.Spec.UpgradeMode, "input subscriptions must not be modified")
+		})
+	}
+}
+
 // Helpers for resolver tests
 
 func StartResolverInformers(namespace string, stopCh <-chan struct{}, objs ...runtime.Object) (versioned.Interface, externalversions.SharedInformerFactory, []cache.InformerSynced) {
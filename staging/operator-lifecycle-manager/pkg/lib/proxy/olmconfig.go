@@ -0,0 +1,137 @@
+package proxy
+
+import (
+	"path"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	operatorsv1 "github.com/operator-framework/api/pkg/operators/v1"
+	listers "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/listers/operators/v1"
+)
+
+const (
+	// This is the cluster level OLMConfig object name.
+	olmConfigName = "cluster"
+
+	// The trusted CA certificates are mounted into a directory of their own,
+	// rather than over the image's CA bundle, and pointed at by SSL_CERT_FILE.
+	// Go still loads the system's certificates from their directories then.
+	trustedCAVolumeName = "olm-trusted-ca"
+	trustedCAMountPath  = "/etc/olm/trusted-ca"
+	trustedCAFileName   = "ca-bundle.crt"
+	envSSLCertFileName  = "SSL_CERT_FILE"
+)
+
+// TrustedCAQuerier is an interface that wraps the QueryTrustedCA method.
+//
+// QueryTrustedCA returns the global cluster level volume of trusted CA
+// certificates, how to mount it, and the env variable(s) pointing at the
+// mounted certificates, or nil if there's none.
+type TrustedCAQuerier interface {
+	QueryTrustedCA() (volume *corev1.Volume, volumeMount *corev1.VolumeMount, envVar []corev1.EnvVar, err error)
+}
+
+// NewOLMConfigQuerier returns a querier of the proxy configured by the cluster
+// OLMConfig. The proxy env variable(s) of the given querier are returned
+// instead when the OLMConfig doesn't configure any.
+func NewOLMConfigQuerier(logger *logrus.Logger, lister listers.OLMConfigLister, fallback Querier) *OLMConfigQuerier {
+	return &OLMConfigQuerier{
+		logger:   logger,
+		lister:   lister,
+		fallback: fallback,
+	}
+}
+
+// OLMConfigQuerier lets the caller query for the proxy configuration of the
+// cluster OLMConfig object.
+type OLMConfigQuerier struct {
+	logger   *logrus.Logger
+	lister   listers.OLMConfigLister
+	fallback Querier
+}
+
+// QueryProxyConfig returns the proxy env variable(s) of the OLMConfig object,
+// or those of the fallback querier if it configures none.
+func (q *OLMConfigQuerier) QueryProxyConfig() (proxy []corev1.EnvVar, err error) {
+	config, err := q.proxyConfig()
+	if err != nil {
+		return
+	}
+
+	if config == nil || (config.HTTPProxy == "" && config.HTTPSProxy == "" && config.NoProxy == "") {
+		return q.fallback.QueryProxyConfig()
+	}
+
+	proxy = []corev1.EnvVar{
+		{
+			Name:  envHTTPProxyName,
+			Value: config.HTTPProxy,
+		},
+		{
+			Name:  envHTTPSProxyName,
+			Value: config.HTTPSProxy,
+		},
+		{
+			Name:  envNoProxyName,
+			Value: config.NoProxy,
+		},
+	}
+	return
+}
+
+// QueryTrustedCA returns a volume of the trusted CA ConfigMap referenced by the
+// OLMConfig object, its mount, and the SSL_CERT_FILE env variable pointing at
+// it. The volume is optional, since the ConfigMap may not have been distributed
+// to every namespace, and only ever mounted at a path of its own so that the
+// image's CA bundle is left in place when it's missing.
+func (q *OLMConfigQuerier) QueryTrustedCA() (volume *corev1.Volume, volumeMount *corev1.VolumeMount, envVar []corev1.EnvVar, err error) {
+	config, err := q.proxyConfig()
+	if err != nil || config == nil || config.TrustedCA == nil || config.TrustedCA.Name == "" {
+		return
+	}
+
+	key := config.TrustedCA.Key
+	if key == "" {
+		key = operatorsv1.DefaultTrustedCAKey
+	}
+
+	optional := true
+	volume = &corev1.Volume{
+		Name: trustedCAVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: config.TrustedCA.Name},
+				Items:                []corev1.KeyToPath{{Key: key, Path: trustedCAFileName}},
+				Optional:             &optional,
+			},
+		},
+	}
+	volumeMount = &corev1.VolumeMount{
+		Name:      trustedCAVolumeName,
+		MountPath: trustedCAMountPath,
+		ReadOnly:  true,
+	}
+	envVar = []corev1.EnvVar{
+		{
+			Name:  envSSLCertFileName,
+			Value: path.Join(trustedCAMountPath, trustedCAFileName),
+		},
+	}
+	return
+}
+
+func (q *OLMConfigQuerier) proxyConfig() (*operatorsv1.ProxyConfig, error) {
+	config, err := q.lister.Get(olmConfigName)
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return nil, err
+		}
+
+		q.logger.Debugf("OLMConfig '%s' not defined - %v", olmConfigName, err)
+		return nil, nil
+	}
+
+	return config.ProxyConfig(), nil
+}
@@ -0,0 +1,101 @@
+package proxy_test
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	operatorsv1 "github.com/operator-framework/api/pkg/operators/v1"
+	listers "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/listers/operators/v1"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/lib/proxy"
+)
+
+type fakeQuerier []corev1.EnvVar
+
+func (q fakeQuerier) QueryProxyConfig() ([]corev1.EnvVar, error) {
+	return q, nil
+}
+
+func TestOLMConfigQuerier(t *testing.T) {
+	tests := []struct {
+		name             string
+		config           *operatorsv1.ProxyConfig
+		expectedProxy    []corev1.EnvVar
+		expectedVolume   *corev1.Volume
+		expectedMount    *corev1.VolumeMount
+		expectedEnv      []corev1.EnvVar
+		withoutOLMConfig bool
+	}{
+		{
+			name:             "WithoutOLMConfig",
+			withoutOLMConfig: true,
+			expectedProxy:    globalProxyConfig,
+		},
+		{
+			name:          "WithoutProxy",
+			expectedProxy: globalProxyConfig,
+		},
+		{
+			name: "WithTrustedCAOnly",
+			config: &operatorsv1.ProxyConfig{
+				TrustedCA: &operatorsv1.TrustedCAConfigMap{Name: "trusted-ca", Key: "ca.crt"},
+			},
+			expectedProxy: globalProxyConfig,
+			expectedVolume: &corev1.Volume{
+				Name: "olm-trusted-ca",
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "trusted-ca"},
+						Items:                []corev1.KeyToPath{{Key: "ca.crt", Path: "ca-bundle.crt"}},
+						Optional:             func() *bool { b := true; return &b }(),
+					},
+				},
+			},
+			expectedMount: &corev1.VolumeMount{
+				Name:      "olm-trusted-ca",
+				MountPath: "/etc/olm/trusted-ca",
+				ReadOnly:  true,
+			},
+			expectedEnv: []corev1.EnvVar{{Name: "SSL_CERT_FILE", Value: "/etc/olm/trusted-ca/ca-bundle.crt"}},
+		},
+		{
+			name: "WithProxy",
+			config: &operatorsv1.ProxyConfig{
+				HTTPProxy: "http://proxy:3128",
+			},
+			expectedProxy: []corev1.EnvVar{
+				{Name: "HTTP_PROXY", Value: "http://proxy:3128"},
+				{Name: "HTTPS_PROXY"},
+				{Name: "NO_PROXY"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			if !tt.withoutOLMConfig {
+				require.NoError(t, indexer.Add(&operatorsv1.OLMConfig{
+					ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+					Spec:       operatorsv1.OLMConfigSpec{Proxy: tt.config},
+				}))
+			}
+			querier := proxy.NewOLMConfigQuerier(logrus.New(), listers.NewOLMConfigLister(indexer), fakeQuerier(globalProxyConfig))
+
+			proxyEnvVar, err := querier.QueryProxyConfig()
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedProxy, proxyEnvVar)
+
+			volume, volumeMount, envVar, err := querier.QueryTrustedCA()
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedVolume, volume)
+			assert.Equal(t, tt.expectedMount, volumeMount)
+			assert.Equal(t, tt.expectedEnv, envVar)
+		})
+	}
+}
@@ -0,0 +1,288 @@
+package scoped
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/install"
+)
+
+// InstallVerbs are the verbs OLM uses to apply the objects of an InstallPlan.
+var InstallVerbs = []string{"get", "create", "update", "patch"}
+
+// APIResourceFunc returns the API resource serving the given kind.
+type APIResourceFunc func(gvk schema.GroupVersionKind) (metav1.APIResource, error)
+
+// ManifestFunc returns the manifest of the object applied by the given step.
+type ManifestFunc func(step *v1alpha1.Step) (string, error)
+
+// stepResources are the resources of the kinds OLM applies without querying discovery.
+var stepResources = map[string]metav1.APIResource{
+	v1alpha1.ClusterServiceVersionKind: {Group: v1alpha1.GroupName, Name: "clusterserviceversions", Namespaced: true},
+	v1alpha1.SubscriptionKind:          {Group: v1alpha1.GroupName, Name: "subscriptions", Namespaced: true},
+	"Secret":                           {Name: "secrets", Namespaced: true},
+	"BundleSecret":                     {Name: "secrets", Namespaced: true},
+	"ServiceAccount":                   {Name: "serviceaccounts", Namespaced: true},
+	"Service":                          {Name: "services", Namespaced: true},
+	"ConfigMap":                        {Name: "configmaps", Namespaced: true},
+	"ClusterRole":                      {Group: rbacv1.GroupName, Name: "clusterroles"},
+	"ClusterRoleBinding":               {Group: rbacv1.GroupName, Name: "clusterrolebindings"},
+	roleKind:                           {Group: rbacv1.GroupName, Name: "roles", Namespaced: true},
+	roleBindingKind:                    {Group: rbacv1.GroupName, Name: "rolebindings", Namespaced: true},
+}
+
+// InstallPermissions are the rules a ServiceAccount needs to execute an InstallPlan on behalf of an OperatorGroup.
+type InstallPermissions struct {
+	// Namespace is the namespace of the InstallPlan.
+	Namespace string
+
+	// Rules must be granted in the namespace of the InstallPlan.
+	Rules []rbacv1.PolicyRule
+
+	// ClusterRules must be granted cluster-wide.
+	ClusterRules []rbacv1.PolicyRule
+}
+
+// PermissionsForSteps returns the permissions needed to execute the given steps in a namespace.
+// The ServiceAccount needs to manage the objects applied by the steps, and to hold every rule of the
+// roles they grant, since the API server prevents it from granting permissions it doesn't have.
+// Custom resource definitions are applied by OLM itself, so they don't require any permission.
+func PermissionsForSteps(namespace string, steps []*v1alpha1.Step, manifestFor ManifestFunc, apiResourceFor APIResourceFunc) (*InstallPermissions, error) {
+	// Resources managed by the ServiceAccount, keyed by API group
+	namespaced, clusterScoped := map[string][]string{}, map[string][]string{}
+
+	perms := &InstallPermissions{Namespace: namespace}
+	for _, step := range steps {
+		kind := step.Resource.Kind
+		if kind == "CustomResourceDefinition" {
+			continue
+		}
+
+		resource, ok := stepResources[kind]
+		if !ok {
+			var err error
+			resource, err = apiResourceFor(schema.GroupVersionKind{Group: step.Resource.Group, Version: step.Resource.Version, Kind: kind})
+			if err != nil {
+				return nil, err
+			}
+			resource.Group = step.Resource.Group
+		}
+		if resource.Namespaced {
+			namespaced[resource.Group] = append(namespaced[resource.Group], resource.Name)
+		} else {
+			clusterScoped[resource.Group] = append(clusterScoped[resource.Group], resource.Name)
+		}
+
+		switch kind {
+		case "Secret":
+			// Pull secrets are copied from the namespace of OLM
+			perms.ClusterRules = appendRule(perms.ClusterRules, rbacv1.PolicyRule{
+				Verbs:         []string{"get"},
+				APIGroups:     []string{""},
+				Resources:     []string{"secrets"},
+				ResourceNames: []string{step.Resource.Name},
+			})
+		case roleKind, "ClusterRole":
+			manifest, err := manifestFor(step)
+			if err != nil {
+				return nil, err
+			}
+			var role rbacv1.ClusterRole
+			if err := json.Unmarshal([]byte(manifest), &role); err != nil {
+				return nil, fmt.Errorf("error parsing step manifest %s: %v", step.Resource.Name, err)
+			}
+			for _, rule := range role.Rules {
+				if kind == roleKind {
+					perms.Rules = appendRule(perms.Rules, rule)
+				} else {
+					perms.ClusterRules = appendRule(perms.ClusterRules, rule)
+				}
+			}
+		}
+	}
+
+	perms.Rules = append(installRules(namespaced), perms.Rules...)
+	perms.ClusterRules = append(installRules(clusterScoped), perms.ClusterRules...)
+
+	return perms, nil
+}
+
+// installRules returns the rules granting the verbs OLM uses on the given resources, keyed by API group.
+func installRules(resources map[string][]string) []rbacv1.PolicyRule {
+	groups := make([]string, 0, len(resources))
+	for group := range resources {
+		groups = append(groups, group)
+	}
+	sort.Strings(groups)
+
+	var rules []rbacv1.PolicyRule
+	for _, group := range groups {
+		names := map[string]struct{}{}
+		for _, name := range resources[group] {
+			names[name] = struct{}{}
+		}
+		rule := rbacv1.PolicyRule{
+			Verbs:     append([]string(nil), InstallVerbs...),
+			APIGroups: []string{group},
+		}
+		for name := range names {
+			rule.Resources = append(rule.Resources, name)
+		}
+		sort.Strings(rule.Resources)
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func appendRule(rules []rbacv1.PolicyRule, rule rbacv1.PolicyRule) []rbacv1.PolicyRule {
+	for _, r := range rules {
+		if reflect.DeepEqual(r, rule) {
+			return rules
+		}
+	}
+	return append(rules, rule)
+}
+
+// Empty returns true if no permission is needed.
+func (p *InstallPermissions) Empty() bool {
+	return len(p.Rules) == 0 && len(p.ClusterRules) == 0
+}
+
+// RBACForServiceAccount returns a Role and a ClusterRole granting the permissions, along with the bindings
+// granting them to the ServiceAccount of the given name. The cluster-scoped objects are nil if no rule
+// must be granted cluster-wide.
+func (p *InstallPermissions) RBACForServiceAccount(name string) (*rbacv1.Role, *rbacv1.RoleBinding, *rbacv1.ClusterRole, *rbacv1.ClusterRoleBinding) {
+	subjects := []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: name, Namespace: p.Namespace}}
+
+	role := &rbacv1.Role{
+		TypeMeta:   metav1.TypeMeta{APIVersion: rbacv1.SchemeGroupVersion.String(), Kind: roleKind},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: p.Namespace},
+		Rules:      p.Rules,
+	}
+	roleBinding := &rbacv1.RoleBinding{
+		TypeMeta:   metav1.TypeMeta{APIVersion: rbacv1.SchemeGroupVersion.String(), Kind: roleBindingKind},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: p.Namespace},
+		Subjects:   subjects,
+		RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: roleKind, Name: name},
+	}
+	if len(p.ClusterRules) == 0 {
+		return role, roleBinding, nil, nil
+	}
+
+	// Cluster-scoped names must be unique across namespaces
+	clusterName := fmt.Sprintf("%s-%s", p.Namespace, name)
+	clusterRole := &rbacv1.ClusterRole{
+		TypeMeta:   metav1.TypeMeta{APIVersion: rbacv1.SchemeGroupVersion.String(), Kind: "ClusterRole"},
+		ObjectMeta: metav1.ObjectMeta{Name: clusterName},
+		Rules:      p.ClusterRules,
+	}
+	clusterRoleBinding := &rbacv1.ClusterRoleBinding{
+		TypeMeta:   metav1.TypeMeta{APIVersion: rbacv1.SchemeGroupVersion.String(), Kind: "ClusterRoleBinding"},
+		ObjectMeta: metav1.ObjectMeta{Name: clusterName},
+		Subjects:   subjects,
+		RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: clusterName},
+	}
+
+	return role, roleBinding, clusterRole, clusterRoleBinding
+}
+
+// Missing returns the permissions that the given ServiceAccount doesn't have.
+func (p *InstallPermissions) Missing(ruleChecker install.RuleChecker, sa *corev1.ServiceAccount) (*InstallPermissions, error) {
+	missing := &InstallPermissions{Namespace: p.Namespace}
+
+	var err error
+	if missing.Rules, err = missingRules(ruleChecker, sa, p.Namespace, p.Rules); err != nil {
+		return nil, err
+	}
+	if missing.ClusterRules, err = missingRules(ruleChecker, sa, metav1.NamespaceAll, p.ClusterRules); err != nil {
+		return nil, err
+	}
+
+	return missing, nil
+}
+
+// missingRules returns the rules that aren't satisfied for the ServiceAccount in the namespace, narrowed down
+// to the resources it can't access.
+func missingRules(ruleChecker install.RuleChecker, sa *corev1.ServiceAccount, namespace string, rules []rbacv1.PolicyRule) ([]rbacv1.PolicyRule, error) {
+	var missing []rbacv1.PolicyRule
+	for _, rule := range rules {
+		if len(rule.Resources) <= 1 {
+			satisfied, err := ruleChecker.RuleSatisfied(sa, namespace, rule)
+			if err != nil {
+				return nil, err
+			}
+			if !satisfied {
+				missing = append(missing, rule)
+			}
+			continue
+		}
+
+		narrowed := rule
+		narrowed.Resources = nil
+		for _, resource := range rule.Resources {
+			single := rule
+			single.Resources = []string{resource}
+			satisfied, err := ruleChecker.RuleSatisfied(sa, namespace, single)
+			if err != nil {
+				return nil, err
+			}
+			if !satisfied {
+				narrowed.Resources = append(narrowed.Resources, resource)
+			}
+		}
+		if len(narrowed.Resources) > 0 {
+			missing = append(missing, narrowed)
+		}
+	}
+
+	return missing, nil
+}
+
+// MissingPermissionsError is returned when a ServiceAccount lacks permissions needed to execute an InstallPlan.
+type MissingPermissionsError struct {
+	ServiceAccount string
+	Missing        *InstallPermissions
+}
+
+func (e MissingPermissionsError) Error() string {
+	var missing []string
+	for _, rule := range e.Missing.Rules {
+		missing = append(missing, fmt.Sprintf("%s in namespace %s", ruleString(rule), e.Missing.Namespace))
+	}
+	for _, rule := range e.Missing.ClusterRules {
+		missing = append(missing, fmt.Sprintf("%s at the cluster scope", ruleString(rule)))
+	}
+	return fmt.Sprintf("service account %s/%s is missing permissions: %s", e.Missing.Namespace, e.ServiceAccount, strings.Join(missing, "; "))
+}
+
+func ruleString(rule rbacv1.PolicyRule) string {
+	if len(rule.NonResourceURLs) > 0 {
+		return fmt.Sprintf("%s on %s", strings.Join(rule.Verbs, ","), strings.Join(rule.NonResourceURLs, ","))
+	}
+
+	resources := make([]string, 0, len(rule.Resources))
+	for _, resource := range rule.Resources {
+		for _, group := range rule.APIGroups {
+			if group == "" {
+				resources = append(resources, resource)
+				continue
+			}
+			resources = append(resources, fmt.Sprintf("%s.%s", resource, group))
+		}
+	}
+	s := fmt.Sprintf("%s on %s", strings.Join(rule.Verbs, ","), strings.Join(resources, ","))
+	if len(rule.ResourceNames) > 0 {
+		s = fmt.Sprintf("%s named %s", s, strings.Join(rule.ResourceNames, ","))
+	}
+	return s
+}
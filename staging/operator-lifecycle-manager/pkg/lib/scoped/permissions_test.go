@@ -0,0 +1,146 @@
+package scoped
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/operator-framework/operator-lifecycle-manager/pkg/controller/registry/resolver"
+)
+
+func TestPermissionsForSteps(t *testing.T) {
+	rule := rbacv1.PolicyRule{Verbs: []string{"get"}, APIGroups: []string{"example.com"}, Resources: []string{"widgets"}}
+	clusterRule := rbacv1.PolicyRule{Verbs: []string{"list"}, APIGroups: []string{""}, Resources: []string{"nodes"}}
+
+	csv := &v1alpha1.ClusterServiceVersion{
+		TypeMeta:   metav1.TypeMeta{Kind: v1alpha1.ClusterServiceVersionKind, APIVersion: v1alpha1.SchemeGroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{Name: "operator.v1", Namespace: "ns"},
+		Spec: v1alpha1.ClusterServiceVersionSpec{
+			InstallStrategy: v1alpha1.NamedInstallStrategy{
+				StrategyName: v1alpha1.InstallStrategyNameDeployment,
+				StrategySpec: v1alpha1.StrategyDetailsDeployment{
+					Permissions:        []v1alpha1.StrategyDeploymentPermissions{{ServiceAccountName: "operator", Rules: []rbacv1.PolicyRule{rule}}},
+					ClusterPermissions: []v1alpha1.StrategyDeploymentPermissions{{ServiceAccountName: "operator", Rules: []rbacv1.PolicyRule{clusterRule}}},
+				},
+			},
+		},
+	}
+	resources, err := resolver.NewServiceAccountStepResources(csv, "catalog", "catalog-ns")
+	require.NoError(t, err)
+	csvStep, err := resolver.NewStepResourceFromObject(csv, "catalog", "catalog-ns")
+	require.NoError(t, err)
+	resources = append(resources, csvStep,
+		v1alpha1.StepResource{Kind: "CustomResourceDefinition", Group: "apiextensions.k8s.io", Version: "v1", Name: "widgets.example.com"},
+		v1alpha1.StepResource{Kind: "Secret", Version: "v1", Name: "pull-secret"},
+		v1alpha1.StepResource{Kind: "PrometheusRule", Group: "monitoring.coreos.com", Version: "v1", Name: "rule"},
+	)
+	var steps []*v1alpha1.Step
+	for _, resource := range resources {
+		steps = append(steps, &v1alpha1.Step{Resolving: csv.GetName(), Resource: resource})
+	}
+
+	manifestFor := func(step *v1alpha1.Step) (string, error) {
+		return step.Resource.Manifest, nil
+	}
+	apiResourceFor := func(gvk schema.GroupVersionKind) (metav1.APIResource, error) {
+		if gvk.Kind != "PrometheusRule" {
+			return metav1.APIResource{}, fmt.Errorf("unexpected kind %s", gvk)
+		}
+		return metav1.APIResource{Name: "prometheusrules", Namespaced: true, Kind: gvk.Kind}, nil
+	}
+
+	perms, err := PermissionsForSteps("ns", steps, manifestFor, apiResourceFor)
+	require.NoError(t, err)
+	require.Equal(t, &InstallPermissions{
+		Namespace: "ns",
+		Rules: []rbacv1.PolicyRule{
+			{Verbs: InstallVerbs, APIGroups: []string{""}, Resources: []string{"secrets", "serviceaccounts"}},
+			{Verbs: InstallVerbs, APIGroups: []string{"monitoring.coreos.com"}, Resources: []string{"prometheusrules"}},
+			{Verbs: InstallVerbs, APIGroups: []string{v1alpha1.GroupName}, Resources: []string{"clusterserviceversions"}},
+			{Verbs: InstallVerbs, APIGroups: []string{rbacv1.GroupName}, Resources: []string{"rolebindings", "roles"}},
+			rule,
+		},
+		ClusterRules: []rbacv1.PolicyRule{
+			{Verbs: InstallVerbs, APIGroups: []string{rbacv1.GroupName}, Resources: []string{"clusterrolebindings", "clusterroles"}},
+			clusterRule,
+			{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"secrets"}, ResourceNames: []string{"pull-secret"}},
+		},
+	}, perms)
+
+	role, roleBinding, clusterRole, clusterRoleBinding := perms.RBACForServiceAccount("scoped")
+	require.Equal(t, perms.Rules, role.Rules)
+	require.Equal(t, "ns", role.GetNamespace())
+	require.Equal(t, role.GetName(), roleBinding.RoleRef.Name)
+	require.Equal(t, []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: "scoped", Namespace: "ns"}}, roleBinding.Subjects)
+	require.Equal(t, perms.ClusterRules, clusterRole.Rules)
+	require.Equal(t, "ns-scoped", clusterRole.GetName())
+	require.Equal(t, clusterRole.GetName(), clusterRoleBinding.RoleRef.Name)
+	require.Equal(t, roleBinding.Subjects, clusterRoleBinding.Subjects)
+
+	_, _, clusterRole, clusterRoleBinding = (&InstallPermissions{Namespace: "ns", Rules: perms.Rules}).RBACForServiceAccount("scoped")
+	require.Nil(t, clusterRole)
+	require.Nil(t, clusterRoleBinding)
+}
+
+// fakeRuleChecker satisfies the rules whose resources are all allowed in the namespace they map to.
+type fakeRuleChecker map[string]string
+
+func (c fakeRuleChecker) RuleSatisfied(sa *corev1.ServiceAccount, namespace string, rule rbacv1.PolicyRule) (bool, error) {
+	for _, resource := range rule.Resources {
+		if ns, ok := c[resource]; !ok || ns != namespace {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func TestInstallPermissionsMissing(t *testing.T) {
+	perms := &InstallPermissions{
+		Namespace: "ns",
+		Rules: []rbacv1.PolicyRule{
+			{Verbs: InstallVerbs, APIGroups: []string{""}, Resources: []string{"secrets", "serviceaccounts", "services"}},
+			{Verbs: []string{"get"}, APIGroups: []string{"example.com"}, Resources: []string{"widgets"}},
+		},
+		ClusterRules: []rbacv1.PolicyRule{
+			{Verbs: InstallVerbs, APIGroups: []string{rbacv1.GroupName}, Resources: []string{"clusterrolebindings", "clusterroles"}},
+			{Verbs: []string{"list"}, APIGroups: []string{""}, Resources: []string{"nodes"}},
+		},
+	}
+	ruleChecker := fakeRuleChecker{
+		"serviceaccounts": "ns",
+		"widgets":         "ns",
+		"clusterroles":    metav1.NamespaceAll,
+		// Cluster-wide rules must be granted cluster-wide
+		"nodes": "ns",
+	}
+
+	missing, err := perms.Missing(ruleChecker, &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "scoped", Namespace: "ns"}})
+	require.NoError(t, err)
+	require.Equal(t, &InstallPermissions{
+		Namespace: "ns",
+		Rules: []rbacv1.PolicyRule{
+			{Verbs: InstallVerbs, APIGroups: []string{""}, Resources: []string{"secrets", "services"}},
+		},
+		ClusterRules: []rbacv1.PolicyRule{
+			{Verbs: InstallVerbs, APIGroups: []string{rbacv1.GroupName}, Resources: []string{"clusterrolebindings"}},
+			{Verbs: []string{"list"}, APIGroups: []string{""}, Resources: []string{"nodes"}},
+		},
+	}, missing)
+	require.False(t, missing.Empty())
+
+	require.Equal(t, "service account ns/scoped is missing permissions: "+
+		"get,create,update,patch on secrets,services in namespace ns; "+
+		"get,create,update,patch on clusterrolebindings.rbac.authorization.k8s.io at the cluster scope; "+
+		"list on nodes at the cluster scope",
+		MissingPermissionsError{ServiceAccount: "scoped", Missing: missing}.Error())
+
+	missing, err = (&InstallPermissions{Namespace: "ns", Rules: perms.Rules[1:]}).Missing(ruleChecker, &corev1.ServiceAccount{})
+	require.NoError(t, err)
+	require.True(t, missing.Empty())
+}
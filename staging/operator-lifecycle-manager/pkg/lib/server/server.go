@@ -43,12 +43,26 @@ func WithDebug(debug bool) Option {
 	}
 }
 
+// WithHandler serves the given handler for requests matching pattern alongside the health, metrics and profiling endpoints.
+// Handlers are only served over TLS.
+func WithHandler(pattern string, handler http.Handler) Option {
+	return func(sc *serverConfig) {
+		sc.handlers = append(sc.handlers, patternHandler{pattern: pattern, handler: handler})
+	}
+}
+
+type patternHandler struct {
+	pattern string
+	handler http.Handler
+}
+
 type serverConfig struct {
 	logger       *logrus.Logger
 	tlsCertPath  *string
 	tlsKeyPath   *string
 	clientCAPath *string
 	debug        bool
+	handlers     []patternHandler
 }
 
 func (sc *serverConfig) apply(options []Option) {
@@ -88,6 +102,9 @@ func (sc serverConfig) getListenAndServeFunc() (func() error, error) {
 	if err != nil {
 		return nil, fmt.Errorf("both --tls-key and --tls-crt must be provided for TLS to be enabled")
 	}
+	if !tlsEnabled && len(sc.handlers) > 0 {
+		return nil, fmt.Errorf("both --tls-key and --tls-crt must be provided to serve additional handlers")
+	}
 
 	mux := http.NewServeMux()
 	mux.Handle("/metrics", promhttp.Handler())
@@ -95,6 +112,9 @@ func (sc serverConfig) getListenAndServeFunc() (func() error, error) {
 		w.WriteHeader(http.StatusOK)
 	})
 	profile.RegisterHandlers(mux, profile.WithTLS(tlsEnabled || !sc.debug))
+	for _, h := range sc.handlers {
+		mux.Handle(h.pattern, h.handler)
+	}
 
 	s := http.Server{
 		Handler: mux,
@@ -0,0 +1,51 @@
+package time
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+)
+
+// WindowsOpen reports whether any of the given windows is open at now and, if so, when the most recently opened of them opened.
+// If none are open, it returns the time at which the next window opens instead, which is zero if none ever will.
+func WindowsOpen(windows []v1alpha1.UpgradeWindow, now time.Time) (open bool, opened, next time.Time, err error) {
+	for _, window := range windows {
+		tz := "UTC"
+		if window.TimeZone != "" {
+			if _, err := time.LoadLocation(window.TimeZone); err != nil {
+				return false, time.Time{}, time.Time{}, fmt.Errorf("invalid time zone %q: %v", window.TimeZone, err)
+			}
+			tz = window.TimeZone
+		}
+		schedule, err := cron.ParseStandard(fmt.Sprintf("CRON_TZ=%s %s", tz, window.Schedule))
+		if err != nil {
+			return false, time.Time{}, time.Time{}, fmt.Errorf("invalid schedule %q: %v", window.Schedule, err)
+		}
+		if window.Duration.Duration <= 0 {
+			return false, time.Time{}, time.Time{}, fmt.Errorf("invalid duration %s for schedule %q, expected a positive duration", window.Duration.Duration, window.Schedule)
+		}
+
+		// the window is open if it was last opened less than its duration ago
+		opens := schedule.Next(now.Add(-window.Duration.Duration))
+		if opens.IsZero() {
+			continue
+		}
+		if !opens.After(now) {
+			open = true
+			if opens.After(opened) {
+				opened = opens
+			}
+			continue
+		}
+		if next.IsZero() || opens.Before(next) {
+			next = opens
+		}
+	}
+	if open {
+		return true, opened, time.Time{}, nil
+	}
+	return false, time.Time{}, next, nil
+}
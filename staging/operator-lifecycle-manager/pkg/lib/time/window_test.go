@@ -0,0 +1,92 @@
+package time
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+)
+
+func TestWindowsOpen(t *testing.T) {
+	// Saturday
+	now := time.Date(2021, 3, 6, 3, 0, 0, 0, time.UTC)
+	window := func(schedule string, duration time.Duration, tz string) v1alpha1.UpgradeWindow {
+		return v1alpha1.UpgradeWindow{Schedule: schedule, Duration: metav1.Duration{Duration: duration}, TimeZone: tz}
+	}
+
+	for _, tt := range []struct {
+		name    string
+		windows []v1alpha1.UpgradeWindow
+		open    bool
+		opened  time.Time
+		next    time.Time
+		err     bool
+	}{
+		{
+			name:    "Open",
+			windows: []v1alpha1.UpgradeWindow{window("0 2 * * 6", 2*time.Hour, "")},
+			open:    true,
+			opened:  time.Date(2021, 3, 6, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "ClosedAtEnd",
+			windows: []v1alpha1.UpgradeWindow{window("0 2 * * 6", time.Hour, "")},
+			next:    time.Date(2021, 3, 13, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "EarliestNextWindow",
+			windows: []v1alpha1.UpgradeWindow{window("0 2 * * 6", time.Hour, ""), window("0 22 * * *", time.Hour, "")},
+			next:    time.Date(2021, 3, 6, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "AnyWindowOpen",
+			windows: []v1alpha1.UpgradeWindow{window("0 22 * * *", time.Hour, ""), window("30 2 * * *", time.Hour, ""), window("0 2 * * *", 2*time.Hour, "")},
+			open:    true,
+			opened:  time.Date(2021, 3, 6, 2, 30, 0, 0, time.UTC),
+		},
+		{
+			name:    "NeverOpens",
+			windows: []v1alpha1.UpgradeWindow{window("0 0 31 2 *", time.Hour, "")},
+		},
+		{
+			name:    "TimeZone",
+			windows: []v1alpha1.UpgradeWindow{window("0 2 * * 6", 2*time.Hour, "Europe/Berlin")},
+			next:    time.Date(2021, 3, 13, 1, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "InvalidSchedule",
+			windows: []v1alpha1.UpgradeWindow{window("0 2 * *", time.Hour, "")},
+			err:     true,
+		},
+		{
+			name:    "ScheduleWithTimeZone",
+			windows: []v1alpha1.UpgradeWindow{window("CRON_TZ=Europe/Berlin 0 2 * * *", time.Hour, "")},
+			err:     true,
+		},
+		{
+			name:    "InvalidDuration",
+			windows: []v1alpha1.UpgradeWindow{window("0 2 * * *", 0, "")},
+			err:     true,
+		},
+		{
+			name:    "InvalidTimeZone",
+			windows: []v1alpha1.UpgradeWindow{window("0 2 * * *", time.Hour, "Nowhere/Special")},
+			err:     true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			open, opened, next, err := WindowsOpen(tt.windows, now)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.open, open)
+			require.True(t, tt.opened.Equal(opened), "expected %s, got %s", tt.opened, opened)
+			require.True(t, tt.next.Equal(next), "expected %s, got %s", tt.next, next)
+		})
+	}
+}
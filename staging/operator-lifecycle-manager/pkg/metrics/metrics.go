@@ -193,6 +193,31 @@ var (
 		[]string{Outcome},
 	)
 
+	catalogSnapshotAge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "catalogsource_snapshot_age_seconds",
+			Help: "Age of the resolver's cached snapshot of a CatalogSource when it was last used for resolution",
+		},
+		[]string{NAMESPACE_LABEL, NAME_LABEL},
+	)
+
+	catalogSnapshotSize = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "catalogsource_snapshot_entries",
+			Help: "Number of bundle entries in the resolver's cached snapshot of a CatalogSource",
+		},
+		[]string{NAMESPACE_LABEL, NAME_LABEL},
+	)
+
+	catalogSnapshotRefreshDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "catalogsource_snapshot_refresh_duration_seconds",
+			Help:    "The duration of a refresh of the resolver's cached snapshot of a CatalogSource",
+			Buckets: prometheus.ExponentialBuckets(0.05, 2, 12),
+		},
+		[]string{NAMESPACE_LABEL, NAME_LABEL},
+	)
+
 	installPlanWarningCount = prometheus.NewCounter(
 		prometheus.CounterOpts{
 			Name: "installplan_warnings_total",
@@ -228,6 +253,9 @@ func RegisterCatalog() {
 	prometheus.MustRegister(SubscriptionSyncCount)
 	prometheus.MustRegister(dependencyResolutionSummary)
 	prometheus.MustRegister(installPlanWarningCount)
+	prometheus.MustRegister(catalogSnapshotAge)
+	prometheus.MustRegister(catalogSnapshotSize)
+	prometheus.MustRegister(catalogSnapshotRefreshDuration)
 }
 
 func CounterForSubscription(name, installedCSV, channelName, packageName, planApprovalStrategy string) prometheus.Counter {
@@ -245,6 +273,9 @@ func RegisterCatalogSourceState(name, namespace string, state connectivity.State
 
 func DeleteCatalogSourceStateMetric(name, namespace string) {
 	catalogSourceReady.DeleteLabelValues(namespace, name)
+	catalogSnapshotAge.DeleteLabelValues(namespace, name)
+	catalogSnapshotSize.DeleteLabelValues(namespace, name)
+	catalogSnapshotRefreshDuration.DeleteLabelValues(namespace, name)
 }
 
 func DeleteCSVMetric(oldCSV *olmv1alpha1.ClusterServiceVersion) {
@@ -334,3 +365,14 @@ func RegisterDependencyResolutionFailure(duration time.Duration) {
 func EmitInstallPlanWarning() {
 	installPlanWarningCount.Inc()
 }
+
+// EmitCatalogSnapshotRefresh records the size of a freshly populated catalog snapshot and the time it took to populate it.
+func EmitCatalogSnapshotRefresh(name, namespace string, entries int, duration time.Duration) {
+	catalogSnapshotSize.WithLabelValues(namespace, name).Set(float64(entries))
+	catalogSnapshotRefreshDuration.WithLabelValues(namespace, name).Observe(duration.Seconds())
+}
+
+// EmitCatalogSnapshotAge records the age of a catalog snapshot being used for resolution.
+func EmitCatalogSnapshotAge(name, namespace string, age time.Duration) {
+	catalogSnapshotAge.WithLabelValues(namespace, name).Set(age.Seconds())
+}
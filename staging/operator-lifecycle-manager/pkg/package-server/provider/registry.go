@@ -25,6 +25,7 @@ import (
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/informers/externalversions"
 	operatorslisters "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/listers/operators/v1alpha1"
 	registrygrpc "github.com/operator-framework/operator-lifecycle-manager/pkg/controller/registry/grpc"
+	resolvercache "github.com/operator-framework/operator-lifecycle-manager/pkg/controller/registry/resolver/cache"
 	utillabels "github.com/operator-framework/operator-lifecycle-manager/pkg/lib/kubernetes/pkg/util/labels"
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/lib/queueinformer"
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/package-server/apis/operators"
@@ -121,13 +122,32 @@ type RegistryProvider struct {
 	cache           cache.Indexer
 	pkgLister       pkglisters.PackageManifestLister
 	catsrcLister    operatorslisters.CatalogSourceLister
+
+	// snapshotTTL is the duration for which a refreshed catalog whose content isn't managed by OLM
+	// is considered fresh when its CatalogSource is resynced without a change in connection state.
+	snapshotTTL time.Duration
+	sem         chan struct{}
+	refreshedMu sync.Mutex
+	refreshed   map[registry.CatalogKey]catalogRefresh
+}
+
+// catalogRefresh records when the packages of a catalog were last refreshed, and from which connection.
+type catalogRefresh struct {
+	time         time.Time
+	connectionID string
 }
 
 var _ PackageManifestProvider = &RegistryProvider{}
 
-func NewRegistryProvider(ctx context.Context, crClient versioned.Interface, operator queueinformer.Operator, wakeupInterval time.Duration, globalNamespace string) (*RegistryProvider, error) {
+func NewRegistryProvider(ctx context.Context, crClient versioned.Interface, operator queueinformer.Operator, wakeupInterval time.Duration, globalNamespace string, snapshotTTL time.Duration, maxConcurrentSnapshotUpdates int) (*RegistryProvider, error) {
+	if maxConcurrentSnapshotUpdates <= 0 {
+		maxConcurrentSnapshotUpdates = resolvercache.DefaultMaxConcurrentSnapshotUpdates
+	}
 	p := &RegistryProvider{
-		Operator: operator,
+		Operator:    operator,
+		snapshotTTL: snapshotTTL,
+		sem:         make(chan struct{}, maxConcurrentSnapshotUpdates),
+		refreshed:   map[registry.CatalogKey]catalogRefresh{},
 
 		globalNamespace: globalNamespace,
 		cache: cache.NewIndexer(PackageManifestKeyFunc, cache.Indexers{
@@ -192,6 +212,10 @@ func (p *RegistryProvider) syncCatalogSource(obj interface{}) (syncError error)
 	}
 
 	if sourceMeta := p.sources.GetMeta(key); sourceMeta != nil && sourceMeta.Address == address {
+		if p.fresh(key, source, sourceMeta) {
+			logger.Debugf("PackageManifests for %v were recently refreshed, skipping update", key)
+			return
+		}
 		logger.Infof("updating PackageManifest based on CatalogSource changes: %v", key)
 		timeout, cancel := context.WithTimeout(context.Background(), cacheTimeout)
 		defer cancel()
@@ -277,6 +301,18 @@ func (p *RegistryProvider) refreshCache(ctx context.Context, client *registryCli
 		"source": key,
 	})
 
+	// Bound the number of catalogs listed at once, since each refresh lists every package of its catalog.
+	select {
+	case p.sem <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer func() { <-p.sem }()
+
+	// Record the connection the packages are listed from before listing them, so that a change of
+	// connection while listing results in another refresh.
+	connectionID := p.sources.ConnectionID(key)
+
 	stream, err := client.ListPackages(ctx, &api.ListPackageRequest{})
 	if err != nil {
 		logger.WithField("err", err.Error()).Warnf("error getting stream")
@@ -328,10 +364,30 @@ func (p *RegistryProvider) refreshCache(ctx context.Context, client *registryCli
 	wg.Wait()
 	logger.Debug("new packages cached")
 
+	p.refreshedMu.Lock()
+	p.refreshed[key] = catalogRefresh{time: time.Now(), connectionID: connectionID}
+	p.refreshedMu.Unlock()
+
 	// Garbage collect orphaned packagemanifests from the cache
 	return p.gcPackages(key, added)
 }
 
+// fresh returns true if the packages of the given catalog don't need to be refreshed. The content of catalogs
+// managed by OLM can only change along with the connection to their registry, while other catalogs are
+// considered fresh for the snapshot ttl.
+func (p *RegistryProvider) fresh(key registry.CatalogKey, source *operatorsv1alpha1.CatalogSource, meta *registrygrpc.SourceMeta) bool {
+	p.refreshedMu.Lock()
+	defer p.refreshedMu.Unlock()
+	refreshed, ok := p.refreshed[key]
+	if !ok {
+		return false
+	}
+	if resolvercache.ManagedContent(source) {
+		return refreshed.connectionID == meta.ConnectionID()
+	}
+	return time.Since(refreshed.time) < p.snapshotTTL
+}
+
 func (p *RegistryProvider) gcPackages(key registry.CatalogKey, keep map[string]struct{}) error {
 	logger := logrus.WithFields(logrus.Fields{
 		"action": "gc cache",
@@ -89,7 +89,7 @@ func NewFakeRegistryProvider(ctx context.Context, clientObjs []runtime.Object, k
 
 	resyncInterval := 5 * time.Minute
 
-	return NewRegistryProvider(ctx, clientFake, op, resyncInterval, globalNamespace)
+	return NewRegistryProvider(ctx, clientFake, op, resyncInterval, globalNamespace, 0, 0)
 }
 
 func catalogSource(name, namespace string) *operatorsv1alpha1.CatalogSource {
@@ -18,6 +18,7 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/api/client"
+	resolvercache "github.com/operator-framework/operator-lifecycle-manager/pkg/controller/registry/resolver/cache"
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/lib/queueinformer"
 	"github.com/operator-framework/operator-lifecycle-manager/pkg/package-server/apiserver"
 	genericpackageserver "github.com/operator-framework/operator-lifecycle-manager/pkg/package-server/apiserver/generic"
@@ -41,6 +42,8 @@ func NewCommandStartPackageServer(ctx context.Context, defaults *PackageServerOp
 	flags := cmd.Flags()
 	flags.DurationVar(&defaults.WakeupInterval, "interval", defaults.WakeupInterval, "interval at which to re-sync CatalogSources")
 	flags.StringVar(&defaults.GlobalNamespace, "global-namespace", defaults.GlobalNamespace, "Name of the namespace where the global CatalogSources are located")
+	flags.DurationVar(&defaults.SnapshotTTL, "catalog-snapshot-ttl", defaults.SnapshotTTL, "the duration for which the packages of a catalog not served by OLM-managed pods are considered fresh when its CatalogSource is resynced")
+	flags.IntVar(&defaults.MaxConcurrentSnapshotUpdates, "max-concurrent-snapshot-updates", defaults.MaxConcurrentSnapshotUpdates, "the maximum number of catalogs whose packages may be refreshed at the same time")
 	flags.StringVar(&defaults.Kubeconfig, "kubeconfig", defaults.Kubeconfig, "path to the kubeconfig used to connect to the Kubernetes API server and the Kubelets (defaults to in-cluster config)")
 	flags.BoolVar(&defaults.Debug, "debug", defaults.Debug, "use debug log level")
 
@@ -61,6 +64,9 @@ type PackageServerOptions struct {
 	GlobalNamespace string
 	WakeupInterval  time.Duration
 
+	SnapshotTTL                  time.Duration
+	MaxConcurrentSnapshotUpdates int
+
 	Kubeconfig   string
 	RegistryAddr string
 
@@ -84,6 +90,9 @@ func NewPackageServerOptions(out, errOut io.Writer) *PackageServerOptions {
 
 		WakeupInterval: 5 * time.Minute,
 
+		SnapshotTTL:                  resolvercache.DefaultSourceTTL,
+		MaxConcurrentSnapshotUpdates: resolvercache.DefaultMaxConcurrentSnapshotUpdates,
+
 		DisableAuthForTesting: false,
 		Debug:                 false,
 
@@ -215,7 +224,7 @@ func (o *PackageServerOptions) Run(ctx context.Context) error {
 		return err
 	}
 
-	sourceProvider, err := provider.NewRegistryProvider(ctx, crClient, queueOperator, o.WakeupInterval, o.GlobalNamespace)
+	sourceProvider, err := provider.NewRegistryProvider(ctx, crClient, queueOperator, o.WakeupInterval, o.GlobalNamespace, o.SnapshotTTL, o.MaxConcurrentSnapshotUpdates)
 	if err != nil {
 		return err
 	}
@@ -4321,7 +4321,7 @@ var _ = Describe("Disabling copied CSVs", func() {
 
 				expectedCondition := metav1.Condition{
 					Reason:  "NoCopiedCSVsFound",
-					Message: "Copied CSVs are disabled and none were found for operators installed in AllNamespace mode",
+					Message: "Copied CSVs are disabled and none were found",
 					Status:  metav1.ConditionTrue,
 				}
 